@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"net/http"
 	"os"
@@ -29,6 +30,25 @@ type CredentialsProvider interface {
 	Credentials() (*Credentials, error)
 }
 
+// A ContextCredentialsProvider is a CredentialsProvider which can abandon
+// retrieving credentials when a context is done.
+type ContextCredentialsProvider interface {
+	CredentialsProvider
+
+	// CredentialsWithContext is like Credentials, but returns the context's
+	// error if it is done before credentials are retrieved.
+	CredentialsWithContext(ctx context.Context) (*Credentials, error)
+}
+
+// credentialsWithContext returns credentials from the provider, using the
+// context if the provider supports it.
+func credentialsWithContext(ctx context.Context, p CredentialsProvider) (*Credentials, error) {
+	if cp, ok := p.(ContextCredentialsProvider); ok {
+		return cp.CredentialsWithContext(ctx)
+	}
+	return p.Credentials()
+}
+
 var (
 	// ErrAccessKeyIDNotFound is returned when the AWS Access Key ID can't be
 	// found in the process's environment.
@@ -43,34 +63,19 @@ var (
 // If the access key ID and secret access key are provided, it returns a basic
 // provider.
 //
-// If credentials are available via environment variables, it returns an
-// environment provider.
-//
-// If a profile configuration file is available in the default location and has
-// a default profile configured, it returns a profile provider.
-//
-// Otherwise, it returns an IAM instance provider.
+// Otherwise, it returns a chain of providers (see ChainProvider), which uses
+// the first to succeed of: the environment (see EnvCreds), the profile named
+// by AWS_PROFILE, or the default one, of the shared config and credentials
+// files (see SharedCreds), the ECS task's role (see ContainerCreds), and the
+// local EC2 instance's IAM roles. A profile named by AWS_PROFILE which can't be
+// used stops the chain with a *ProfileError. Profiles whose roles require an
+// MFA token code need their own SharedProvider with a TokenCode.
 func DetectCreds(accessKeyID, secretAccessKey, securityToken string) CredentialsProvider {
 	if accessKeyID != "" && secretAccessKey != "" {
 		return Creds(accessKeyID, secretAccessKey, securityToken)
 	}
 
-	env, err := EnvCreds()
-	if err == nil {
-		return env
-	}
-
-	profile, err := ProfileCreds("", "", 10*time.Minute)
-	if err != nil {
-		return IAMCreds()
-	}
-
-	_, err = profile.Credentials()
-	if err != nil {
-		return IAMCreds()
-	}
-
-	return profile
+	return Chain(envProvider{}, SharedCreds("", 10*time.Minute), ContainerCreds(), IAMCreds())
 }
 
 // EnvCreds returns a static provider of AWS credentials from the process's
@@ -97,6 +102,18 @@ func EnvCreds() (CredentialsProvider, error) {
 	return Creds(id, secret, os.Getenv("AWS_SESSION_TOKEN")), nil
 }
 
+// envProvider provides credentials from the process's environment as it is
+// each time they're needed.
+type envProvider struct{}
+
+func (envProvider) Credentials() (*Credentials, error) {
+	p, err := EnvCreds()
+	if err != nil {
+		return nil, err
+	}
+	return p.Credentials()
+}
+
 // Creds returns a static provider of credentials.
 func Creds(accessKeyID, secretAccessKey, securityToken string) CredentialsProvider {
 	return staticCredentialsProvider{
@@ -110,13 +127,14 @@ func Creds(accessKeyID, secretAccessKey, securityToken string) CredentialsProvid
 
 // IAMCreds returns a provider which pulls credentials from the local EC2
 // instance's IAM roles.
-func IAMCreds() CredentialsProvider {
-	return &iamProvider{}
+func IAMCreds() *IAMProvider {
+	return &IAMProvider{}
 }
 
-// ProfileCreds returns a provider which pulls credentials from the profile
-// configuration file.
-func ProfileCreds(filename, profile string, expiry time.Duration) (CredentialsProvider, error) {
+// ProfileCreds returns a provider which pulls static credentials from the
+// profile configuration file. SharedCreds supports the shared config file and
+// roles too.
+func ProfileCreds(filename, profile string, expiry time.Duration) (*ProfileProvider, error) {
 	if filename == "" {
 		u, err := user.Current()
 		if err != nil {
@@ -130,14 +148,20 @@ func ProfileCreds(filename, profile string, expiry time.Duration) (CredentialsPr
 		profile = "default"
 	}
 
-	return &profileProvider{
+	return &ProfileProvider{
 		filename: filename,
 		profile:  profile,
 		expiry:   expiry,
 	}, nil
 }
 
-type profileProvider struct {
+// A ProfileProvider provides the static credentials of a profile of a
+// credentials file, which is read again once they expire.
+type ProfileProvider struct {
+	// Clock tells the time the credentials expire by. If nil, the system
+	// clock is used.
+	Clock Clock
+
 	filename string
 	profile  string
 	expiry   time.Duration
@@ -147,11 +171,12 @@ type profileProvider struct {
 	expiration time.Time
 }
 
-func (p *profileProvider) Credentials() (*Credentials, error) {
+func (p *ProfileProvider) Credentials() (*Credentials, error) {
 	p.m.Lock()
 	defer p.m.Unlock()
 
-	if p.expiration.After(currentTime()) {
+	now := clockNow(p.Clock)
+	if p.expiration.After(now) {
 		return &p.creds, nil
 	}
 
@@ -178,12 +203,18 @@ func (p *profileProvider) Credentials() (*Credentials, error) {
 		SecretAccessKey: secretAccessKey,
 		SecurityToken:   securityToken,
 	}
-	p.expiration = currentTime().Add(p.expiry)
+	p.expiration = now.Add(p.expiry)
 
 	return &p.creds, nil
 }
 
-type iamProvider struct {
+// An IAMProvider provides the credentials of the local EC2 instance's IAM
+// role, which are retrieved again once they expire.
+type IAMProvider struct {
+	// Clock tells the time the credentials expire by. If nil, the system
+	// clock is used.
+	Clock Clock
+
 	creds      Credentials
 	m          sync.Mutex
 	expiration time.Time
@@ -191,11 +222,15 @@ type iamProvider struct {
 
 var metadataCredentialsEndpoint = "http://169.254.169.254/latest/meta-data/iam/security-credentials/"
 
-func (p *iamProvider) Credentials() (*Credentials, error) {
+func (p *IAMProvider) Credentials() (*Credentials, error) {
+	return p.CredentialsWithContext(context.Background())
+}
+
+func (p *IAMProvider) CredentialsWithContext(ctx context.Context) (*Credentials, error) {
 	p.m.Lock()
 	defer p.m.Unlock()
 
-	if p.expiration.After(currentTime()) {
+	if p.expiration.After(clockNow(p.Clock)) {
 		return &p.creds, nil
 	}
 
@@ -206,7 +241,7 @@ func (p *iamProvider) Credentials() (*Credentials, error) {
 		Token           string
 	}
 
-	resp, err := http.Get(metadataCredentialsEndpoint)
+	resp, err := getWithContext(ctx, metadataCredentialsEndpoint)
 	if err != nil {
 		return nil, errors.Annotate(err, "listing IAM credentials")
 	}
@@ -222,7 +257,7 @@ func (p *iamProvider) Credentials() (*Credentials, error) {
 		return nil, errors.Annotate(s.Err(), "listing IAM credentials")
 	}
 
-	resp, err = http.Get(metadataCredentialsEndpoint + s.Text())
+	resp, err = getWithContext(ctx, metadataCredentialsEndpoint+s.Text())
 	if err != nil {
 		return nil, errors.Annotatef(err, "getting %s IAM credentials", s.Text())
 	}
@@ -244,6 +279,14 @@ func (p *iamProvider) Credentials() (*Credentials, error) {
 	return &p.creds, nil
 }
 
+func getWithContext(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
+
 type staticCredentialsProvider struct {
 	creds Credentials
 }
@@ -1,10 +1,13 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -102,13 +105,6 @@ func TestIAMCreds(t *testing.T) {
 	}(metadataCredentialsEndpoint)
 	metadataCredentialsEndpoint = server.URL
 
-	defer func() {
-		currentTime = time.Now
-	}()
-	currentTime = func() time.Time {
-		return time.Date(2014, 12, 15, 21, 26, 0, 0, time.UTC)
-	}
-
 	prov := IAMCreds()
 	creds, err := prov.Credentials()
 	if err != nil {
@@ -128,6 +124,192 @@ func TestIAMCreds(t *testing.T) {
 	}
 }
 
+func TestIAMCredsWithContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Credentials were requested with a canceled context")
+	}))
+	defer server.Close()
+
+	defer func(s string) {
+		metadataCredentialsEndpoint = s
+	}(metadataCredentialsEndpoint)
+	metadataCredentialsEndpoint = server.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	prov := IAMCreds()
+	if _, err := prov.CredentialsWithContext(ctx); err == nil {
+		t.Fatal("Expected an error but none was returned")
+	}
+}
+
+// containerServer returns a server of container credentials, which expire
+// at the given time. It requires the authorization token and counts the
+// requests it serves.
+func containerServer(t *testing.T, token string, expiration *time.Time, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Authorization"); v != token {
+			http.Error(w, "bad token", http.StatusUnauthorized)
+			return
+		}
+		if v, want := r.URL.Path, "/v2/credentials/task"; v != want {
+			t.Errorf("Path was %v, but expected %v", v, want)
+		}
+		*requests++
+		fmt.Fprintf(w, `{
+  "AccessKeyId" : "accessKey",
+  "SecretAccessKey" : "secret",
+  "Token" : "token",
+  "Expiration" : "%s"
+}`, expiration.UTC().Format(time.RFC3339))
+	}))
+}
+
+func TestContainerCreds(t *testing.T) {
+	var requests int
+	expiration := time.Now().Add(-time.Minute)
+	server := containerServer(t, "", &expiration, &requests)
+	defer server.Close()
+
+	defer func(s string) {
+		containerCredentialsEndpoint = s
+	}(containerCredentialsEndpoint)
+	containerCredentialsEndpoint = server.URL
+
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "/v2/credentials/task")
+
+	prov := ContainerCreds()
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := *creds, (Credentials{AccessKeyID: "accessKey", SecretAccessKey: "secret", SecurityToken: "token"}); v != want {
+		t.Errorf("Credentials were %+v, but expected %+v", v, want)
+	}
+
+	// expired credentials are refreshed
+	expiration = time.Now().Add(time.Hour)
+	if _, err := prov.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := requests, 2; v != want {
+		t.Errorf("%d requests were made, but expected %d", v, want)
+	}
+
+	// and the rest are cached until they expire
+	if _, err := prov.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := requests, 2; v != want {
+		t.Errorf("%d requests were made, but expected %d", v, want)
+	}
+}
+
+func TestContainerCredsExpiryWindow(t *testing.T) {
+	var requests int
+	now := time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC)
+	expiration := now.Add(time.Hour)
+	server := containerServer(t, "", &expiration, &requests)
+	defer server.Close()
+
+	defer func(s string) {
+		containerCredentialsEndpoint = s
+	}(containerCredentialsEndpoint)
+	containerCredentialsEndpoint = server.URL
+
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "/v2/credentials/task")
+
+	prov := ContainerCreds()
+	prov.Clock = ClockFunc(func() time.Time { return now })
+
+	// the credentials are cached until shortly before they expire
+	for _, test := range []struct {
+		after    time.Duration
+		requests int
+	}{
+		{0, 1},
+		{58 * time.Minute, 1},
+		{90 * time.Second, 2},
+	} {
+		now = now.Add(test.after)
+		if _, err := prov.Credentials(); err != nil {
+			t.Fatal(err)
+		}
+		if v := requests; v != test.requests {
+			t.Errorf("%d requests were made at %v, but expected %d", v, now, test.requests)
+		}
+	}
+}
+
+func TestContainerCredsFullURI(t *testing.T) {
+	var requests int
+	expiration := time.Now().Add(time.Hour)
+	server := containerServer(t, "Basic secret", &expiration, &requests)
+	defer server.Close()
+
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "")
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/v2/credentials/task")
+	t.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN", "")
+
+	if _, err := ContainerCreds().Credentials(); err == nil || !strings.Contains(err.Error(), "401 Unauthorized: bad token") {
+		t.Errorf("Error was %v, but expected the server's", err)
+	}
+
+	token := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(token, []byte("Basic secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE", token)
+
+	creds, err := ContainerCreds().Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "accessKey"; v != want {
+		t.Errorf("AccessKeyID was %v, but expected %v", v, want)
+	}
+
+	// credentials aren't sent to other hosts in the clear
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", "http://example.com/v2/credentials/task")
+	if _, err := ContainerCreds().Credentials(); err == nil || !strings.Contains(err.Error(), "must be HTTPS") {
+		t.Errorf("Error was %v, but expected the URI to be refused", err)
+	}
+
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", "")
+	if _, err := ContainerCreds().Credentials(); err != ErrContainerURINotFound {
+		t.Errorf("Error was %v, but expected %v", err, ErrContainerURINotFound)
+	}
+}
+
+func TestDetectCredsFromContainer(t *testing.T) {
+	var requests int
+	expiration := time.Now().Add(time.Hour)
+	server := containerServer(t, "token", &expiration, &requests)
+	defer server.Close()
+
+	for _, name := range []string{"AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY", "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/v2/credentials/task")
+	t.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN", "token")
+
+	chain := DetectCreds("", "", "").(*ChainProvider)
+	creds, err := chain.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "accessKey"; v != want {
+		t.Errorf("AccessKeyID was %v, but expected %v", v, want)
+	}
+	if _, ok := chain.Current().(*ContainerProvider); !ok {
+		t.Errorf("Current provider was %T, but expected the container's", chain.Current())
+	}
+}
+
 func TestProfileCreds(t *testing.T) {
 	prov, err := ProfileCreds("example.ini", "", 10*time.Minute)
 	if err != nil {
@@ -176,6 +358,62 @@ func TestProfileCredsWithoutToken(t *testing.T) {
 	}
 }
 
+func TestProfileCredsExpiry(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filename, []byte("[default]\naws_access_key_id = oldKey\naws_secret_access_key = secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC)
+	prov, err := ProfileCreds(filename, "", 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prov.Clock = ClockFunc(func() time.Time { return now })
+	if _, err := prov.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the file isn't read again until the credentials expire
+	if err := os.WriteFile(filename, []byte("[default]\naws_access_key_id = newKey\naws_secret_access_key = secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"oldKey", "newKey"} {
+		creds, err := prov.Credentials()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := creds.AccessKeyID; v != want {
+			t.Errorf("AccessKeyID was %v, but expected %v", v, want)
+		}
+		now = now.Add(10 * time.Minute)
+	}
+}
+
+func TestDetectCredsFromEnv(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_ACCESS_KEY_ID", "access")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	chain, ok := DetectCreds("", "", "").(*ChainProvider)
+	if !ok {
+		t.Fatal("Expected a chain of providers")
+	}
+
+	creds, err := chain.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := creds.AccessKeyID, "access"; v != want {
+		t.Errorf("AccessKeyID was %v, but expected %v", v, want)
+	}
+
+	if _, ok := chain.Current().(envProvider); !ok {
+		t.Errorf("Current provider was %T, but expected the environment's", chain.Current())
+	}
+}
+
 func BenchmarkProfileCreds(b *testing.B) {
 	prov, err := ProfileCreds("example.ini", "", 10*time.Minute)
 	if err != nil {
@@ -214,13 +452,6 @@ func BenchmarkIAMCreds(b *testing.B) {
 	}(metadataCredentialsEndpoint)
 	metadataCredentialsEndpoint = server.URL
 
-	defer func() {
-		currentTime = time.Now
-	}()
-	currentTime = func() time.Time {
-		return time.Date(2014, 12, 15, 21, 26, 0, 0, time.UTC)
-	}
-
 	b.ResetTimer()
 
 	prov := IAMCreds()
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// A ChainProvider is a provider of the credentials of the first of its
+// providers to succeed. That provider is used alone until it fails, when the
+// providers are tried in order again. A provider which is configured, but
+// can't be used (see ProfileError and NoRoleAssumerError), stops the chain
+// with its error. The providers are called without the chain's lock held, so a
+// slow provider only holds up the calls which need it.
+type ChainProvider struct {
+	Providers []CredentialsProvider
+
+	m       sync.Mutex
+	current CredentialsProvider
+	index   int
+}
+
+// Chain returns a provider which tries the given providers in order.
+func Chain(providers ...CredentialsProvider) *ChainProvider {
+	return &ChainProvider{Providers: providers}
+}
+
+// Credentials returns the credentials of the current provider, or else of the
+// first provider to succeed, or a *ChainError if they all fail.
+func (p *ChainProvider) Credentials() (*Credentials, error) {
+	return p.CredentialsWithContext(context.Background())
+}
+
+// CredentialsWithContext is like Credentials, but returns the context's error
+// if it is done before credentials are retrieved.
+func (p *ChainProvider) CredentialsWithContext(ctx context.Context) (*Credentials, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	p.m.Lock()
+	current, index := p.current, p.index
+	p.m.Unlock()
+
+	var currentErr error
+	if current != nil {
+		creds, err := credentialsWithContext(ctx, current)
+		if err == nil || stopsChain(err) {
+			return creds, err
+		}
+		currentErr = err
+	}
+
+	var errs []error
+	for i, provider := range p.Providers {
+		if current != nil && i == index {
+			// the current provider has just failed
+			errs = append(errs, currentErr)
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		creds, err := credentialsWithContext(ctx, provider)
+		if err == nil {
+			p.m.Lock()
+			p.current, p.index = provider, i
+			p.m.Unlock()
+			return creds, nil
+		}
+		if stopsChain(err) {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+
+	return nil, &ChainError{Errors: errs}
+}
+
+// stopsChain returns whether the error is one which a ChainProvider returns
+// rather than trying its other providers.
+func stopsChain(err error) bool {
+	var stopper interface{ stopsChain() }
+	return errors.As(err, &stopper)
+}
+
+// Current returns the provider which produced the most recent credentials, or
+// nil if none has.
+func (p *ChainProvider) Current() CredentialsProvider {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.current
+}
+
+// A ChainError is returned by a ChainProvider whose providers all failed. It
+// has each of their errors, in order.
+type ChainError struct {
+	Errors []error
+}
+
+func (e *ChainError) Error() string {
+	if len(e.Errors) == 0 {
+		return "aws: no credentials providers"
+	}
+
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("aws: no credentials from any provider: %s", strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the providers' errors, for errors.Is and errors.As.
+func (e *ChainError) Unwrap() []error {
+	return e.Errors
+}
@@ -0,0 +1,150 @@
+package aws_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+// fakeProvider provides its credentials, or its error if it has one.
+type fakeProvider struct {
+	creds aws.Credentials
+	err   error
+	calls int
+}
+
+func (p *fakeProvider) Credentials() (*aws.Credentials, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &p.creds, nil
+}
+
+func TestChainProvider(t *testing.T) {
+	first := &fakeProvider{err: errors.New("no profile")}
+	second := &fakeProvider{creds: aws.Credentials{AccessKeyID: "second"}}
+	third := &fakeProvider{creds: aws.Credentials{AccessKeyID: "third"}}
+	chain := aws.Chain(first, second, third)
+
+	if v := chain.Current(); v != nil {
+		t.Errorf("Current provider was %v before any credentials were provided", v)
+	}
+
+	creds, err := chain.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "second"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+	if v := chain.Current(); v != second {
+		t.Errorf("Current provider was %v but expected the second", v)
+	}
+	if v := third.calls; v != 0 {
+		t.Errorf("The third provider was called %d times after the second succeeded", v)
+	}
+
+	// the second provider is used alone until it fails
+	first.err = nil
+	first.creds = aws.Credentials{AccessKeyID: "first"}
+	if _, err := chain.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := first.calls, 1; v != want {
+		t.Errorf("The first provider was called %d times but expected %d", v, want)
+	}
+
+	second.err = errors.New("expired")
+	creds, err = chain.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "first"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+	if v := chain.Current(); v != first {
+		t.Errorf("Current provider was %v but expected the first", v)
+	}
+	if v, want := second.calls, 3; v != want {
+		t.Errorf("The second provider was called %d times but expected %d", v, want)
+	}
+}
+
+func TestChainProviderErrors(t *testing.T) {
+	errProfile, errIAM := errors.New("no profile"), errors.New("no instance role")
+	chain := aws.Chain(&fakeProvider{err: errProfile}, &fakeProvider{err: errIAM})
+
+	_, err := chain.Credentials()
+	var e *aws.ChainError
+	if !errors.As(err, &e) {
+		t.Fatalf("Error was %v but expected a *ChainError", err)
+	}
+	if v, want := len(e.Errors), 2; v != want {
+		t.Fatalf("Chain error had %d errors but expected %d", v, want)
+	}
+	if !errors.Is(err, errProfile) || !errors.Is(err, errIAM) {
+		t.Errorf("Chain error %v doesn't wrap each provider's error", err)
+	}
+	if v, want := err.Error(), "aws: no credentials from any provider: no profile; no instance role"; v != want {
+		t.Errorf("Error message was %q but expected %q", v, want)
+	}
+}
+
+func TestChainProviderContext(t *testing.T) {
+	p := &fakeProvider{creds: aws.Credentials{AccessKeyID: "id"}}
+	chain := aws.Chain(p)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := chain.CredentialsWithContext(ctx); err != context.Canceled {
+		t.Errorf("Error was %v but expected %v", err, context.Canceled)
+	}
+	if v := p.calls; v != 0 {
+		t.Errorf("Provider was called %d times after the context was done", v)
+	}
+}
+
+// blockingProvider provides credentials once release is closed, after
+// signalling on called.
+type blockingProvider struct {
+	called, release chan struct{}
+}
+
+func (p *blockingProvider) Credentials() (*aws.Credentials, error) {
+	p.called <- struct{}{}
+	<-p.release
+	return &aws.Credentials{AccessKeyID: "slow"}, nil
+}
+
+func TestChainProviderUnlocked(t *testing.T) {
+	slow := &blockingProvider{called: make(chan struct{}), release: make(chan struct{})}
+	chain := aws.Chain(slow)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := chain.Credentials(); err != nil {
+			t.Error(err)
+		}
+	}()
+	<-slow.called
+
+	// the chain isn't locked while a provider is called
+	current := make(chan aws.CredentialsProvider)
+	go func() { current <- chain.Current() }()
+	select {
+	case <-current:
+	case <-time.After(time.Second):
+		t.Error("The chain was locked while a provider was called")
+	}
+
+	close(slow.release)
+	<-done
+	if v := chain.Current(); v != slow {
+		t.Errorf("Current provider was %v but expected the slow one", v)
+	}
+}
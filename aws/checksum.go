@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+// A ChecksumError is returned when a response's body doesn't match the
+// checksum in its header, as when it was truncated or corrupted in transit.
+// See RetryCondition.Checksum.
+type ChecksumError struct {
+	Header   string
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("aws: response body doesn't match its %s checksum: expected %s, but computed %s", e.Header, e.Expected, e.Actual)
+}
+
+// setContentMD5 sets the Content-MD5 header of the request, unless it's already
+// set or the request has no body. Bodies which can't be read ahead of sending
+// without being buffered are only hashed if their payloads are signed, as
+// signing buffers them anyway.
+func (c *Context) setContentMD5(r *http.Request) error {
+	if r.Body == nil || r.Body == http.NoBody || r.Header.Get("Content-Md5") != "" {
+		return nil
+	}
+	if _, ok := r.Body.(io.Seeker); !ok && r.GetBody == nil && c.PayloadSigning != SignedPayload {
+		return nil
+	}
+
+	h := md5.New()
+	if err := hashBody(r, h); err != nil {
+		return err
+	}
+	r.Header.Set("Content-Md5", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+	return nil
+}
+
+// verifyCRC32 checks the response's body against the CRC32 checksum of its
+// x-amz-crc32 header, if it has one, buffering the body to be decoded.
+func verifyCRC32(r *Request) {
+	s := r.HTTPResponse.Header.Get("X-Amz-Crc32")
+	if s == "" {
+		return
+	}
+
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	_ = r.HTTPResponse.Body.Close()
+	r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	actual := strconv.FormatUint(uint64(crc32.ChecksumIEEE(b)), 10)
+	if expected, err := strconv.ParseUint(s, 10, 32); err != nil || strconv.FormatUint(expected, 10) != actual {
+		r.Error = &ChecksumError{Header: "x-amz-crc32", Expected: s, Actual: actual}
+	}
+}
@@ -0,0 +1,122 @@
+package aws_test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestJSONResponseCRC32(t *testing.T) {
+	var m sync.Mutex
+	var attempts int
+
+	const body = `{"TailWagged":true}`
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			attempts++
+			w.Header().Set("X-Amz-Crc32", fmt.Sprint(crc32.ChecksumIEEE([]byte(body))))
+			if attempts == 1 {
+				fmt.Fprint(w, body[:10]) // truncated
+				return
+			}
+			fmt.Fprint(w, body)
+		},
+	))
+	defer server.Close()
+
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+
+	var resp fakeJSONResponse
+	err := client.Do("PetTheDog", "POST", "/", fakeJSONRequest{Name: "Penny"}, &resp)
+	var e *aws.ChecksumError
+	if !errors.As(err, &e) {
+		t.Fatalf("Error was %v but expected a checksum error", err)
+	}
+	if v, want := e.Header, "x-amz-crc32"; v != want {
+		t.Errorf("Checksum header was %q but expected %q", v, want)
+	}
+
+	client.Retry = &aws.RetryPolicy{
+		MaxAttempts: 3,
+		Delay:       aws.Backoff{Base: time.Millisecond, GrowthFactor: 2},
+		Conditions:  []aws.RetryCondition{{Checksum: true}},
+	}
+	attempts = 0
+	if err := client.Do("PetTheDog", "POST", "/", fakeJSONRequest{Name: "Penny"}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if !resp.TailWagged {
+		t.Error("Response wasn't decoded")
+	}
+	if v, want := attempts, 2; v != want {
+		t.Errorf("Made %d attempts but expected %d", v, want)
+	}
+}
+
+func TestRestContentMD5(t *testing.T) {
+	var m sync.Mutex
+	var headers []string
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			if _, err := ioutil.ReadAll(r.Body); err != nil {
+				t.Error(err)
+			}
+			headers = append(headers, r.Header.Get("Content-Md5"))
+		},
+	))
+	defer server.Close()
+
+	client := aws.RestClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		ContentMD5: true,
+	}
+
+	for _, body := range []string{"", "woof"} {
+		req, err := http.NewRequest("PUT", server.URL+"/dogs", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	sum := md5.Sum([]byte("woof"))
+	want := []string{"", base64.StdEncoding.EncodeToString(sum[:])}
+	if fmt.Sprint(headers) != fmt.Sprint(want) {
+		t.Errorf("Content-MD5 headers were %q but expected %q", headers, want)
+	}
+}
@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// A Clock tells the time.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface.
+type ClockFunc func() time.Time
+
+// Now returns f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is the Clock which tells the system's time.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// maxClockSkew is the smallest change in the difference from the server's
+// clock which is corrected for. Servers report their time to the second, and
+// reject requests signed more than 5 minutes adrift.
+const maxClockSkew = time.Minute
+
+// clockNow returns the clock's time, or the system's if it's nil.
+func clockNow(c Clock) time.Time {
+	if c == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
+
+// now returns the time requests are signed at: the context's clock, corrected
+// for its skew from the server's.
+func (c *Context) now() time.Time {
+	return clockNow(c.Clock).Add(c.ClockSkew())
+}
+
+// ClockSkew returns how far the server's clock was found to be ahead of the
+// context's, which is added to the time requests are signed at.
+func (c *Context) ClockSkew() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.skew))
+}
+
+// updateClockSkew records the skew between the response's Date header and the
+// context's clock, if it differs from the recorded skew by enough to matter,
+// noting on the request that it changed.
+func (c *Context) updateClockSkew(r *Request) {
+	date, err := http.ParseTime(r.HTTPResponse.Header.Get("Date"))
+	if err != nil {
+		return
+	}
+
+	skew := date.Sub(clockNow(c.Clock))
+	if d := skew - c.ClockSkew(); -maxClockSkew < d && d < maxClockSkew {
+		return
+	}
+	if -maxClockSkew < skew && skew < maxClockSkew {
+		skew = 0
+	}
+	atomic.StoreInt64(&c.skew, int64(skew))
+	r.clockSkewed = true
+}
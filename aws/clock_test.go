@@ -0,0 +1,124 @@
+package aws_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+// skewServer rejects requests signed more than 5 minutes from its clock, which
+// is an hour ahead of the system's.
+func skewServer(t *testing.T, requests *int, alwaysReject bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			*requests++
+			now := time.Now().Add(time.Hour)
+			w.Header().Set("Date", now.UTC().Format(http.TimeFormat))
+
+			signed, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+			if err != nil {
+				t.Error(err)
+			}
+			if d := now.Sub(signed); alwaysReject || d > 5*time.Minute || d < -5*time.Minute {
+				w.WriteHeader(400)
+				fmt.Fprintln(w, `{"__type":"com.amazon.coral.service#InvalidSignatureException","message":"Signature expired"}`)
+				return
+			}
+			fmt.Fprintln(w, `{}`)
+		},
+	))
+}
+
+func skewClient(url string) *aws.JSONClient {
+	return &aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     url,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+}
+
+func TestClockSkewCorrection(t *testing.T) {
+	var requests int
+	server := skewServer(t, &requests, false)
+	defer server.Close()
+
+	client := skewClient(server.URL)
+	if err := client.Do("PetTheDog", "POST", "/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := requests, 2; v != want {
+		t.Errorf("Server got %d requests but expected %d", v, want)
+	}
+
+	if v := client.Context.ClockSkew(); v < 59*time.Minute || v > 61*time.Minute {
+		t.Errorf("Clock skew was %v but expected an hour", v)
+	}
+
+	// later requests are signed with the corrected time straight away
+	requests = 0
+	if err := client.Do("PetTheDog", "POST", "/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := requests, 1; v != want {
+		t.Errorf("Server got %d requests but expected %d", v, want)
+	}
+}
+
+func TestClockSkewRetriedOnce(t *testing.T) {
+	var requests int
+	server := skewServer(t, &requests, true)
+	defer server.Close()
+
+	client := skewClient(server.URL)
+	err := client.Do("PetTheDog", "POST", "/", nil, nil)
+	if !aws.IsClockSkew(err) {
+		t.Errorf("Error was %v but expected a clock skew error", err)
+	}
+
+	if v, want := requests, 2; v != want {
+		t.Errorf("Server got %d requests but expected %d", v, want)
+	}
+
+	// with the skew already corrected, the error isn't retried
+	requests = 0
+	_ = client.Do("PetTheDog", "POST", "/", nil, nil)
+
+	if v, want := requests, 1; v != want {
+		t.Errorf("Server got %d requests but expected %d", v, want)
+	}
+}
+
+func TestClockInjection(t *testing.T) {
+	var date string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			date = r.Header.Get("X-Amz-Date")
+			fmt.Fprintln(w, `{}`)
+		},
+	))
+	defer server.Close()
+
+	client := skewClient(server.URL)
+	client.Context.Clock = aws.ClockFunc(func() time.Time {
+		return time.Date(2014, 12, 10, 13, 40, 0, 0, time.UTC)
+	})
+	if err := client.Do("PetTheDog", "POST", "/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := date, "20141210T134000Z"; v != want {
+		t.Errorf("Request was signed at %v but expected %v", v, want)
+	}
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"strings"
+)
+
+// acceptEncoding is the Accept-Encoding of requests for compressed responses.
+const acceptEncoding = "gzip, deflate"
+
+// setAcceptEncoding asks for a compressed response if the client's Compression
+// is set, or else for an uncompressed one. Otherwise http.Transport would ask
+// for gzip itself and decompress the response out of sight, hiding the bytes
+// checksums such as DynamoDB's x-amz-crc32 are computed from. Requests which
+// already have an Accept-Encoding keep it, as when the generated clients ask
+// for streamed bodies as they're stored.
+func (c *Context) setAcceptEncoding(r *Request) {
+	h := r.HTTPRequest.Header
+	if h.Get("Accept-Encoding") != "" {
+		return
+	}
+	if c.Compression {
+		h.Set("Accept-Encoding", acceptEncoding)
+	} else {
+		h.Set("Accept-Encoding", "identity")
+	}
+}
+
+// decompress replaces the body of a response which was compressed as asked for
+// with one which decompresses it. It runs after any checksums of the body are
+// verified, as they're of its compressed bytes.
+func decompress(r *Request) {
+	resp := r.HTTPResponse
+	encoding := responseEncoding(r)
+	if encoding == "" {
+		return
+	}
+
+	resp.Body = &decompressingBody{body: resp.Body, encoding: encoding}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+}
+
+// responseEncoding returns the encoding of a response which was compressed as
+// asked for, or "" if it wasn't.
+func responseEncoding(r *Request) string {
+	if r.HTTPRequest.Header.Get("Accept-Encoding") != acceptEncoding {
+		return ""
+	}
+
+	encoding := strings.ToLower(strings.TrimSpace(r.HTTPResponse.Header.Get("Content-Encoding")))
+	if encoding != "gzip" && encoding != "deflate" {
+		return ""
+	}
+	return encoding
+}
+
+// A decompressingBody decompresses a response's body as it's read. Its
+// decompressor is only created on the first read, so that empty bodies, as of
+// HEAD requests, aren't read as malformed.
+type decompressingBody struct {
+	body     io.ReadCloser
+	encoding string
+	r        io.Reader
+	err      error
+}
+
+func (b *decompressingBody) Read(p []byte) (int, error) {
+	if b.r == nil && b.err == nil {
+		if b.encoding == "gzip" {
+			b.r, b.err = gzip.NewReader(b.body)
+		} else {
+			b.r, b.err = newDeflateReader(b.body)
+		}
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.r.Read(p)
+}
+
+func (b *decompressingBody) Close() error {
+	return b.body.Close()
+}
+
+// newDeflateReader returns a reader which decompresses the deflate encoding,
+// which should be zlib-wrapped, but which some servers send raw.
+func newDeflateReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(2)
+	if err != nil {
+		return nil, err
+	}
+	if header[0]&0x0f == 8 && (uint(header[0])<<8|uint(header[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+	return flate.NewReader(br), nil
+}
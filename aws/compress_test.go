@@ -0,0 +1,155 @@
+package aws_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func compress(t *testing.T, encoding, s string) []byte {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "deflate":
+		w = zlib.NewWriter(&buf)
+	case "raw deflate":
+		var err error
+		if w, err = flate.NewWriter(&buf, flate.DefaultCompression); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := io.WriteString(w, s); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestJSONCompression(t *testing.T) {
+	for _, encoding := range []string{"gzip", "deflate", "raw deflate"} {
+		body := compress(t, encoding, `{"TailWagged":true}`)
+		server := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if v, want := r.Header.Get("Accept-Encoding"), "gzip, deflate"; v != want {
+					t.Errorf("Accept-Encoding was %q but expected %q", v, want)
+				}
+				w.Header().Set("Content-Encoding", "gzip")
+				if encoding != "gzip" {
+					w.Header().Set("Content-Encoding", "deflate")
+				}
+				// the checksum is of the compressed body
+				w.Header().Set("X-Amz-Crc32", fmt.Sprint(crc32.ChecksumIEEE(body)))
+				_, _ = w.Write(body)
+			},
+		))
+
+		client := aws.JSONClient{
+			Context: aws.Context{
+				Service:     "animals",
+				Region:      "us-west-2",
+				Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+				Compression: true,
+			},
+			Endpoint:     server.URL,
+			TargetPrefix: "Animals",
+			JSONVersion:  "1.1",
+		}
+
+		var resp fakeJSONResponse
+		if err := client.Do("PetTheDog", "POST", "/", fakeJSONRequest{Name: "Penny"}, &resp); err != nil {
+			t.Errorf("%s: %v", encoding, err)
+		} else if !resp.TailWagged {
+			t.Errorf("%s: response wasn't decoded", encoding)
+		}
+		server.Close()
+	}
+}
+
+func TestQueryCompressionDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if v, want := r.Header.Get("Accept-Encoding"), "identity"; v != want {
+				t.Errorf("Accept-Encoding was %q but expected %q", v, want)
+			}
+			fmt.Fprintln(w, `<Thing><IpAddress>woo</IpAddress></Thing>`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.QueryClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+	}
+
+	var resp fakeQueryResponse
+	if err := client.Do("GetIP", "POST", "/", nil, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := resp.IPAddress, "woo"; v != want {
+		t.Errorf("IP address was %v but expected %v", v, want)
+	}
+}
+
+func TestLogCompressedResponse(t *testing.T) {
+	body := compress(t, "gzip", `{"Secret":"hunter3"}`)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Header().Set("X-Amz-Crc32", fmt.Sprint(crc32.ChecksumIEEE(body)))
+			_, _ = w.Write(body)
+		},
+	))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+			Compression: true,
+			Logger:      log.New(&buf, "", 0),
+			LogLevel:    aws.LogBodies,
+		},
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+
+	var resp fakeSensitiveResponse
+	if err := client.Do("PetTheDog", "POST", "/", fakeSensitiveRequest{Name: "spot"}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := resp.Secret, "hunter3"; v != want {
+		t.Errorf("Secret was %v but expected %v", v, want)
+	}
+
+	// the body is logged decompressed, and redacted
+	out := buf.String()
+	if strings.Contains(out, "hunter3") || strings.Contains(out, string(body[:10])) {
+		t.Errorf("Log contained the secret or the compressed body:\n%s", out)
+	}
+	if want := `"Secret":"[REDACTED]"`; !strings.Contains(out, want) {
+		t.Errorf("Log didn't contain %q:\n%s", want, out)
+	}
+}
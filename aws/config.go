@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Config is the configuration of a generated client. Its defaults are looked
+// up for the client's service and region, then overridden by the environment
+// and by the Options passed to the client's New function.
+type Config struct {
+	// Endpoint is the base URL requests are sent to, e.g.
+	// http://localhost:8000.
+	Endpoint string
+
+	// SigningRegion and SigningName are the region and service name requests
+	// are signed for.
+	SigningRegion string
+	SigningName   string
+
+	// HTTPClient sends requests. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	// UserAgent is appended to the User-Agent header of requests.
+	UserAgent string
+
+	// Retry is the retry policy. If nil, requests aren't retried.
+	Retry *RetryPolicy
+
+	// Clock tells the time requests are signed at. If nil, the system clock
+	// is used.
+	Clock Clock
+
+	// Logger, if set, is sent the parts of each request selected by LogLevel.
+	Logger   Logger
+	LogLevel LogLevel
+
+	// Compression asks for compressed responses. See Context.Compression.
+	Compression bool
+}
+
+// An Option overrides part of a client's configuration.
+type Option func(*Config)
+
+// WithEndpoint sends requests to the given base URL instead of the service's
+// endpoint, e.g. to use a local stand-in, a VPC endpoint or a proxy.
+func WithEndpoint(url string) Option {
+	return func(c *Config) {
+		c.Endpoint = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithSigningRegion signs requests for the given region.
+func WithSigningRegion(region string) Option {
+	return func(c *Config) {
+		c.SigningRegion = region
+	}
+}
+
+// WithSigningName signs requests for the given service name.
+func WithSigningName(service string) Option {
+	return func(c *Config) {
+		c.SigningName = service
+	}
+}
+
+// WithHTTPClient sends requests with the given HTTP client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Config) {
+		c.HTTPClient = client
+	}
+}
+
+// WithUserAgent appends the given product tokens to the User-Agent header of
+// requests.
+func WithUserAgent(suffix string) Option {
+	return func(c *Config) {
+		c.UserAgent = strings.TrimSpace(c.UserAgent + " " + suffix)
+	}
+}
+
+// WithRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(c *Config) {
+		c.Retry = p
+	}
+}
+
+// WithClock signs requests with the time told by the clock, corrected for any
+// skew from the server's clock.
+func WithClock(clock Clock) Option {
+	return func(c *Config) {
+		c.Clock = clock
+	}
+}
+
+// WithLogger sends the parts of each request selected by level to the logger.
+func WithLogger(l Logger, level LogLevel) Option {
+	return func(c *Config) {
+		c.Logger = l
+		c.LogLevel = level
+	}
+}
+
+// WithCompression asks for gzip or deflate compressed responses, which are
+// decompressed transparently. See Context.Compression.
+func WithCompression() Option {
+	return func(c *Config) {
+		c.Compression = true
+	}
+}
+
+// NewConfig returns the configuration of a generated client, given its
+// defaults. Unless AWS_IGNORE_CONFIGURED_ENDPOINT_URLS is true, the endpoint
+// is overridden by the named environment variable (e.g.
+// AWS_ENDPOINT_URL_DYNAMODB), or else by AWS_ENDPOINT_URL. The options are
+// applied last.
+func NewConfig(defaults Config, endpointEnv string, opts ...Option) Config {
+	c := defaults
+
+	if !strings.EqualFold(os.Getenv("AWS_IGNORE_CONFIGURED_ENDPOINT_URLS"), "true") {
+		for _, name := range []string{endpointEnv, "AWS_ENDPOINT_URL"} {
+			if url := os.Getenv(name); url != "" {
+				c.Endpoint = strings.TrimSuffix(url, "/")
+				break
+			}
+		}
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = http.DefaultClient
+	}
+	return c
+}
+
+// userAgent returns the User-Agent header of the context's requests.
+func (c *Context) userAgent() string {
+	if c.UserAgent == "" {
+		return "aws-go"
+	}
+	return "aws-go " + c.UserAgent
+}
@@ -0,0 +1,91 @@
+package aws_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestNewConfigDefaults(t *testing.T) {
+	t.Setenv("AWS_ENDPOINT_URL_ANIMALS", "")
+	t.Setenv("AWS_ENDPOINT_URL", "")
+
+	c := aws.NewConfig(aws.Config{
+		Endpoint:      "https://animals.us-west-2.amazonaws.com",
+		SigningRegion: "us-west-2",
+		SigningName:   "animals",
+	}, "AWS_ENDPOINT_URL_ANIMALS")
+
+	if v, want := c.Endpoint, "https://animals.us-west-2.amazonaws.com"; v != want {
+		t.Errorf("Endpoint was %v but expected %v", v, want)
+	}
+
+	if c.HTTPClient != http.DefaultClient {
+		t.Errorf("HTTP client was %v but expected the default client", c.HTTPClient)
+	}
+}
+
+func TestNewConfigEndpointEnv(t *testing.T) {
+	t.Setenv("AWS_ENDPOINT_URL_ANIMALS", "http://localhost:8000/")
+	t.Setenv("AWS_ENDPOINT_URL", "http://localhost:9000")
+
+	defaults := aws.Config{Endpoint: "https://animals.us-west-2.amazonaws.com"}
+
+	c := aws.NewConfig(defaults, "AWS_ENDPOINT_URL_ANIMALS")
+	if v, want := c.Endpoint, "http://localhost:8000"; v != want {
+		t.Errorf("Endpoint was %v but expected %v", v, want)
+	}
+
+	c = aws.NewConfig(defaults, "AWS_ENDPOINT_URL_PLANTS")
+	if v, want := c.Endpoint, "http://localhost:9000"; v != want {
+		t.Errorf("Endpoint was %v but expected %v", v, want)
+	}
+
+	c = aws.NewConfig(defaults, "AWS_ENDPOINT_URL_ANIMALS", aws.WithEndpoint("http://proxy"))
+	if v, want := c.Endpoint, "http://proxy"; v != want {
+		t.Errorf("Endpoint was %v but expected %v", v, want)
+	}
+
+	t.Setenv("AWS_IGNORE_CONFIGURED_ENDPOINT_URLS", "true")
+	c = aws.NewConfig(defaults, "AWS_ENDPOINT_URL_ANIMALS")
+	if v, want := c.Endpoint, defaults.Endpoint; v != want {
+		t.Errorf("Endpoint was %v but expected %v", v, want)
+	}
+}
+
+func TestNewConfigOptions(t *testing.T) {
+	client := &http.Client{}
+	c := aws.NewConfig(aws.Config{
+		SigningRegion: "us-west-2",
+		SigningName:   "animals",
+		Retry:         &aws.RetryPolicy{MaxAttempts: 3},
+	}, "AWS_ENDPOINT_URL_ANIMALS",
+		aws.WithSigningRegion("us-east-1"),
+		aws.WithSigningName("pets"),
+		aws.WithHTTPClient(client),
+		aws.WithUserAgent("myapp/1.0"),
+		aws.WithUserAgent("extra"),
+		aws.WithRetryPolicy(nil),
+	)
+
+	if v, want := c.SigningRegion, "us-east-1"; v != want {
+		t.Errorf("Signing region was %v but expected %v", v, want)
+	}
+
+	if v, want := c.SigningName, "pets"; v != want {
+		t.Errorf("Signing name was %v but expected %v", v, want)
+	}
+
+	if c.HTTPClient != client {
+		t.Errorf("HTTP client was %v but expected %v", c.HTTPClient, client)
+	}
+
+	if v, want := c.UserAgent, "myapp/1.0 extra"; v != want {
+		t.Errorf("User agent was %v but expected %v", v, want)
+	}
+
+	if c.Retry != nil {
+		t.Errorf("Retry policy was %v but expected nil", c.Retry)
+	}
+}
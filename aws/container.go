@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// ErrContainerURINotFound is returned when the container credentials
+// endpoint can't be found in the process's environment.
+var ErrContainerURINotFound = errors.NotFoundf("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI in environment")
+
+// DefaultContainerExpiryWindow is how long before they expire a
+// ContainerProvider's credentials are refreshed, unless it says otherwise.
+const DefaultContainerExpiryWindow = time.Minute
+
+// containerCredentialsEndpoint is the ECS agent's endpoint, which
+// AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is relative to.
+var containerCredentialsEndpoint = "http://169.254.170.2"
+
+// ContainerCreds returns a provider which pulls credentials from the
+// endpoint an ECS task's role is served on: the one named by
+// AWS_CONTAINER_CREDENTIALS_RELATIVE_URI, or else by
+// AWS_CONTAINER_CREDENTIALS_FULL_URI, authorized by
+// AWS_CONTAINER_AUTHORIZATION_TOKEN, or else by the contents of the file named
+// by AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE, if either is set. The
+// environment is read each time the credentials are refreshed.
+func ContainerCreds() *ContainerProvider {
+	return &ContainerProvider{}
+}
+
+// A ContainerProvider provides the credentials of an ECS task's role, which
+// are retrieved again shortly before they expire.
+type ContainerProvider struct {
+	// ExpiryWindow is how long before they expire credentials are refreshed.
+	// If zero, DefaultContainerExpiryWindow is used.
+	ExpiryWindow time.Duration
+
+	// Clock tells the time the credentials expire by. If nil, the system
+	// clock is used.
+	Clock Clock
+
+	creds      Credentials
+	m          sync.Mutex
+	expiration time.Time
+}
+
+func (p *ContainerProvider) Credentials() (*Credentials, error) {
+	return p.CredentialsWithContext(context.Background())
+}
+
+func (p *ContainerProvider) CredentialsWithContext(ctx context.Context) (*Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	window := p.ExpiryWindow
+	if window == 0 {
+		window = DefaultContainerExpiryWindow
+	}
+	if clockNow(p.Clock).Before(p.expiration.Add(-window)) {
+		return &p.creds, nil
+	}
+
+	req, err := containerCredentialsRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting container credentials")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.Errorf("getting container credentials: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var body struct {
+		Expiration      time.Time
+		AccessKeyID     string
+		SecretAccessKey string
+		Token           string
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, errors.Annotate(err, "decoding container credentials")
+	}
+
+	p.creds = Credentials{
+		AccessKeyID:     body.AccessKeyID,
+		SecretAccessKey: body.SecretAccessKey,
+		SecurityToken:   body.Token,
+	}
+	p.expiration = body.Expiration
+
+	return &p.creds, nil
+}
+
+// containerCredentialsRequest returns the request for the container's
+// credentials, as configured by the process's environment.
+func containerCredentialsRequest(ctx context.Context) (*http.Request, error) {
+	var uri string
+	if relative := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); relative != "" {
+		uri = containerCredentialsEndpoint + relative
+	} else if full := os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI"); full != "" {
+		if err := checkContainerURI(full); err != nil {
+			return nil, err
+		}
+		uri = full
+	} else {
+		return nil, ErrContainerURINotFound
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting container credentials")
+	}
+
+	token := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN")
+	if filename := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"); token == "" && filename != "" {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, errors.Annotate(err, "reading container authorization token")
+		}
+		token = strings.TrimSpace(string(b))
+	}
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+	return req, nil
+}
+
+// checkContainerURI returns an error unless the full URI is HTTPS, or is
+// served by the container's own host or the ECS or EKS agents, as the
+// credentials would otherwise be sent over the network in the clear.
+func checkContainerURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return errors.Annotate(err, "parsing AWS_CONTAINER_CREDENTIALS_FULL_URI")
+	}
+	if u.Scheme == "https" {
+		return nil
+	}
+	if u.Scheme != "http" {
+		return errors.Errorf("AWS_CONTAINER_CREDENTIALS_FULL_URI %s must be HTTP or HTTPS", uri)
+	}
+
+	host := u.Hostname()
+	if host == "localhost" || host == "169.254.170.2" || host == "169.254.170.23" || host == "fd00:ec2::23" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return errors.Errorf("AWS_CONTAINER_CREDENTIALS_FULL_URI %s must be HTTPS, or a loopback or ECS or EKS agent address", uri)
+}
@@ -1,14 +1,14 @@
 package aws
 
 import (
+	"context"
 	"encoding/xml"
-	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"reflect"
-	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // EC2Client is the underlying client for EC2 APIs.
@@ -17,55 +17,143 @@ type EC2Client struct {
 	Client     *http.Client
 	Endpoint   string
 	APIVersion string
+	Retry      *RetryPolicy
+	Exceptions Exceptions
+
+	handlers     *Handlers
+	handlersOnce sync.Once
+}
+
+// Handlers returns the client's request handlers. By default, these are
+// aws.EC2Build, aws.AcceptEncoding, aws.Sign, aws.LogRequest, aws.Send,
+// aws.ClockSkew, aws.LogResponse, aws.Decompress, aws.EC2ValidateResponse and
+// aws.EC2Unmarshal.
+func (c *EC2Client) Handlers() *Handlers {
+	c.handlersOnce.Do(func() {
+		c.handlers = &Handlers{}
+		c.handlers.Build.PushBack(Handler{Name: "aws.EC2Build", Fn: c.build})
+		c.handlers.Build.PushBack(Handler{Name: "aws.AcceptEncoding", Fn: c.Context.setAcceptEncoding})
+		c.handlers.Sign.PushBack(Handler{Name: "aws.Sign", Fn: c.Context.signRequest})
+		c.handlers.Sign.PushBack(Handler{Name: "aws.LogRequest", Fn: c.Context.logRequest})
+		c.handlers.Send.PushBack(Handler{Name: "aws.Send", Fn: c.send})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.ClockSkew", Fn: c.Context.updateClockSkew})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.LogResponse", Fn: c.Context.logResponse})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.Decompress", Fn: decompress})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.EC2ValidateResponse", Fn: c.validateResponse})
+		c.handlers.Unmarshal.PushBack(Handler{Name: "aws.EC2Unmarshal", Fn: c.unmarshal})
+	})
+	return c.handlers
 }
 
 // Do sends an HTTP request and returns an HTTP response, following policy
-// (e.g. redirects, cookies, auth) as configured on the client.
+// (e.g. redirects, cookies, auth) as configured on the client. Failed requests
+// are retried according to the client's retry policy.
 func (c *EC2Client) Do(op, method, uri string, req, resp interface{}) error {
-	body := url.Values{"Action": {op}, "Version": {c.APIVersion}}
-	if err := c.loadValues(body, req, ""); err != nil {
-		return err
+	return c.DoWithContext(context.Background(), op, method, uri, req, resp)
+}
+
+// DoWithContext is like Do, but the request, including any retries, is
+// abandoned when the given context is done.
+func (c *EC2Client) DoWithContext(ctx context.Context, op, method, uri string, req, resp interface{}) error {
+	r := &Request{
+		Operation:  op,
+		HTTPMethod: method,
+		HTTPPath:   uri,
+		Params:     req,
+		Data:       resp,
+		ctx:        ctx,
+	}
+	return c.Handlers().run(r, c.Retry)
+}
+
+// Presign returns a URL for the operation, signed with query parameters which
+// expire after the given duration. The operation's parameters are sent in the
+// URL's query, so it's made with a GET.
+func (c *EC2Client) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	r := &Request{
+		Operation:  op,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+		Params:     req,
+	}
+	c.Handlers().Build.Run(r)
+	return c.Context.presignForm(r, expires)
+}
+
+func (c *EC2Client) build(r *Request) {
+	body := url.Values{"Action": {r.Operation}, "Version": {c.APIVersion}}
+	if err := ec2Encoding.marshal(body, r.Params); err != nil {
+		r.Error = err
+		return
 	}
 
-	httpReq, err := http.NewRequest(method, c.Endpoint+uri, strings.NewReader(body.Encode()))
+	httpReq, err := http.NewRequestWithContext(r.Context(), r.HTTPMethod, c.Endpoint+r.HTTPPath, strings.NewReader(body.Encode()))
 	if err != nil {
-		return err
+		r.Error = err
+		return
 	}
 	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	httpReq.Header.Set("User-Agent", "aws-go")
-	if err := c.Context.sign(httpReq); err != nil {
-		return err
+	httpReq.Header.Set("User-Agent", c.Context.userAgent())
+	r.HTTPRequest = httpReq
+}
+
+func (c *EC2Client) send(r *Request) {
+	sendRequest(c.Client, r)
+}
+
+func (c *EC2Client) validateResponse(r *Request) {
+	httpResp := r.HTTPResponse
+	if httpResp.StatusCode == http.StatusOK {
+		return
 	}
+	defer func() {
+		_ = httpResp.Body.Close()
+	}()
 
-	httpResp, err := c.Client.Do(httpReq)
+	bodyBytes, err := ioutil.ReadAll(httpResp.Body)
 	if err != nil {
-		return err
+		r.Error = err
+		return
+	}
+	if len(bodyBytes) == 0 {
+		r.Error = c.Context.apiError(r, c.Exceptions, APIError{
+			StatusCode: httpResp.StatusCode,
+		}, nil)
+		return
 	}
+	var ec2Err ec2ErrorResponse
+	if err := xml.Unmarshal(bodyBytes, &ec2Err); err != nil {
+		// not an AWS error, e.g. a proxy's HTML error page
+		r.Error = c.Context.apiError(r, c.Exceptions, APIError{
+			StatusCode: httpResp.StatusCode,
+			Message:    string(bodyBytes),
+		}, nil)
+		return
+	}
+	r.Error = c.Context.apiError(r, c.Exceptions, ec2Err.Err(httpResp.StatusCode), func(v interface{}) error {
+		return unmarshalXMLError(bodyBytes, v)
+	})
+}
+
+func (c *EC2Client) unmarshal(r *Request) {
 	defer func() {
-		_ = httpResp.Body.Close()
+		_ = r.HTTPResponse.Body.Close()
 	}()
 
-	if httpResp.StatusCode != http.StatusOK {
-		bodyBytes, err := ioutil.ReadAll(httpResp.Body)
-		if err != nil {
-			return err
-		}
-		if len(bodyBytes) == 0 {
-			return APIError{
-				StatusCode: httpResp.StatusCode,
-			}
+	// the request ID isn't always sent in a header, so it's read from the
+	// body as it's decoded
+	if headerRequestID(r.HTTPResponse.Header) != "" {
+		if r.Data != nil {
+			r.Error = xml.NewDecoder(r.HTTPResponse.Body).Decode(r.Data)
 		}
-		var ec2Err ec2ErrorResponse
-		if err := xml.Unmarshal(bodyBytes, &ec2Err); err != nil {
-			return err
-		}
-		return ec2Err.Err(httpResp.StatusCode)
+		return
 	}
 
-	if resp != nil {
-		return xml.NewDecoder(httpResp.Body).Decode(resp)
+	requestID, err := decodeXMLWithRequestID(r.HTTPResponse.Body, r.Data, "requestId")
+	r.requestID = requestID
+	if r.Data != nil {
+		r.Error = err
 	}
-	return nil
 }
 
 type ec2ErrorResponse struct {
@@ -76,7 +164,7 @@ type ec2ErrorResponse struct {
 	RequestID string   `xml:"RequestID"`
 }
 
-func (e ec2ErrorResponse) Err(StatusCode int) error {
+func (e ec2ErrorResponse) Err(StatusCode int) APIError {
 	return APIError{
 		StatusCode: StatusCode,
 		Type:       e.Type,
@@ -85,84 +173,3 @@ func (e ec2ErrorResponse) Err(StatusCode int) error {
 		RequestID:  e.RequestID,
 	}
 }
-
-func (c *EC2Client) loadValues(v url.Values, i interface{}, prefix string) error {
-	value := reflect.ValueOf(i)
-
-	// follow any pointers
-	for value.Kind() == reflect.Ptr {
-		value = value.Elem()
-	}
-
-	if value.Kind() == reflect.Slice {
-		for i := 0; i < value.Len(); i++ {
-			if prefix == "" {
-				prefix = strconv.Itoa(i + 1)
-			} else {
-				prefix = prefix + "." + strconv.Itoa(i+1)
-			}
-			if err := c.loadValues(v, value.Index(i).Interface(), prefix); err != nil {
-				return err
-			}
-		}
-		return nil
-	}
-
-	return c.loadStruct(v, value, prefix)
-}
-
-func (c *EC2Client) loadStruct(v url.Values, value reflect.Value, prefix string) error {
-	if !value.IsValid() {
-		return nil
-	}
-
-	t := value.Type()
-	for i := 0; i < value.NumField(); i++ {
-		value := value.Field(i)
-		name := t.Field(i).Tag.Get("ec2")
-
-		if name == "" {
-			name = t.Field(i).Name
-		}
-		if prefix != "" {
-			name = prefix + "." + name
-		}
-		switch casted := value.Interface().(type) {
-		case StringValue:
-			if casted != nil {
-				v.Set(name, *casted)
-			}
-		case BooleanValue:
-			if casted != nil {
-				v.Set(name, strconv.FormatBool(*casted))
-			}
-		case LongValue:
-			if casted != nil {
-				v.Set(name, strconv.FormatInt(*casted, 10))
-			}
-		case IntegerValue:
-			if casted != nil {
-				v.Set(name, strconv.Itoa(*casted))
-			}
-		case DoubleValue:
-			if casted != nil {
-				v.Set(name, strconv.FormatFloat(*casted, 'f', -1, 64))
-			}
-		case FloatValue:
-			if casted != nil {
-				v.Set(name, strconv.FormatFloat(float64(*casted), 'f', -1, 32))
-			}
-		case []string:
-			if len(casted) != 0 {
-				for i, val := range casted {
-					v.Set(fmt.Sprintf("%s.%d", name, i+1), val)
-				}
-			}
-		default:
-			if err := c.loadValues(v, value.Interface(), name); err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
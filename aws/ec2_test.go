@@ -114,20 +114,15 @@ func TestEC2Request(t *testing.T) {
 
 func TestEC2RequestError(t *testing.T) {
 	var m sync.Mutex
-	var httpReq *http.Request
-	var form url.Values
 
 	server := httptest.NewServer(http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			m.Lock()
 			defer m.Unlock()
 
-			httpReq = r
-
 			if err := r.ParseForm(); err != nil {
 				t.Fatal(err)
 			}
-			form = r.Form
 
 			w.WriteHeader(400)
 			fmt.Fprintln(w, `<Response>
@@ -1,8 +1,23 @@
 package aws
 
-// An APIError is an error returned by an AWS API.
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// An APIError is an error returned by an AWS API. Typed exceptions embed it, so
+// errors.As with an *APIError target matches them too.
 type APIError struct {
-	StatusCode int // HTTP status code e.g. 200
+	Service    string // service name e.g. dynamodb
+	Operation  string // operation name e.g. PutItem
+	StatusCode int    // HTTP status code e.g. 200
 	Type       string
 	Code       string
 	Message    string
@@ -11,6 +26,237 @@ type APIError struct {
 	Specifics  map[string]string
 }
 
+// Error returns the error's service, operation, code, message, status code and
+// request ID, omitting any which are empty.
 func (e APIError) Error() string {
-	return e.Message
+	var parts []string
+	if op := strings.Trim(e.Service+"/"+e.Operation, "/"); op != "" {
+		parts = append(parts, op)
+	}
+	if e.Code != "" {
+		parts = append(parts, e.Code)
+	}
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	if msg != "" {
+		parts = append(parts, msg)
+	}
+	s := strings.Join(parts, ": ")
+
+	var details []string
+	if e.StatusCode != 0 {
+		details = append(details, "status "+strconv.Itoa(e.StatusCode))
+	}
+	if e.RequestID != "" {
+		details = append(details, "request ID "+e.RequestID)
+	}
+	if len(details) != 0 {
+		s += " (" + strings.Join(details, ", ") + ")"
+	}
+	return s
+}
+
+// Is reports whether the target is an APIError whose non-zero Service,
+// Operation, StatusCode and Code fields all match the error's, e.g.
+// errors.Is(err, aws.APIError{Code: "ConditionalCheckFailedException"}).
+func (e APIError) Is(target error) bool {
+	t, ok := target.(APIError)
+	if !ok {
+		return false
+	}
+	return (t.Service == "" || t.Service == e.Service) &&
+		(t.Operation == "" || t.Operation == e.Operation) &&
+		(t.StatusCode == 0 || t.StatusCode == e.StatusCode) &&
+		(t.Code == "" || t.Code == e.Code)
+}
+
+// As sets an *APIError target to the error. It's promoted to the typed
+// exceptions which embed APIError, which errors.As can't otherwise convert.
+func (e APIError) As(target interface{}) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	*t = e
+	return true
+}
+
+// throttleCodes are the error codes services use for throttled requests.
+var throttleCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"RequestThrottledException":              true,
+	"TooManyRequestsException":               true,
+	"ProvisionedThroughputExceededException": true,
+	"RequestLimitExceeded":                   true,
+	"BandwidthLimitExceeded":                 true,
+	"LimitExceededException":                 true,
+	"RequestThrottled":                       true,
+	"SlowDown":                               true,
+	"PriorRequestNotComplete":                true,
+	"EC2ThrottledException":                  true,
+}
+
+// transientCodes are the error codes services use for transient failures.
+var transientCodes = map[string]bool{
+	"RequestTimeout":          true,
+	"RequestTimeoutException": true,
+	"InternalError":           true,
+	"InternalFailure":         true,
+	"ServiceUnavailable":      true,
+	"IDPCommunicationError":   true,
+}
+
+// accessDeniedCodes are the error codes services use for unauthorized
+// requests.
+var accessDeniedCodes = map[string]bool{
+	"AccessDenied":                true,
+	"AccessDeniedException":       true,
+	"UnauthorizedOperation":       true,
+	"AuthorizationError":          true,
+	"AuthorizationErrorException": true,
+	"NotAuthorized":               true,
+	"Forbidden":                   true,
+}
+
+// clockSkewCodes are the error codes services use for requests signed at a
+// time too far from the server's.
+var clockSkewCodes = map[string]bool{
+	"RequestTimeTooSkewed":      true,
+	"RequestExpired":            true,
+	"RequestInTheFuture":        true,
+	"InvalidSignatureException": true,
+	"SignatureDoesNotMatch":     true,
+	"AuthFailure":               true,
+}
+
+// IsThrottle returns true if the error is an API error for a throttled
+// request.
+func IsThrottle(err error) bool {
+	var e APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return throttleCodes[e.Code] || e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsRetryable returns true if the request which failed with the error may
+// succeed if it's retried: it was throttled, failed with a server or transient
+// error, failed before a response was received, or its response's body was
+// corrupted. Requests whose context was canceled or timed out aren't.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	var checksumErr *ChecksumError
+	if errors.As(err, &checksumErr) {
+		return true
+	}
+
+	var e APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+	if IsThrottle(e) || transientCodes[e.Code] {
+		return true
+	}
+	return e.StatusCode >= 500 && e.StatusCode != http.StatusNotImplemented
+}
+
+// IsNotFound returns true if the error is an API error for a resource which
+// doesn't exist.
+func IsNotFound(err error) bool {
+	var e APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+	if e.StatusCode == http.StatusNotFound || strings.HasPrefix(e.Code, "NoSuch") {
+		return true
+	}
+	for _, suffix := range []string{"NotFound", "NotFoundException", "NotFoundFault"} {
+		if strings.HasSuffix(e.Code, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAccessDenied returns true if the error is an API error for a request which
+// wasn't authorized.
+func IsAccessDenied(err error) bool {
+	var e APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return accessDeniedCodes[e.Code] || (e.StatusCode == http.StatusForbidden && e.Code == "")
+}
+
+// IsClockSkew returns true if the error is an API error which may be caused by
+// the request being signed at a time too far from the server's. Some services
+// return the same codes for other signature errors.
+func IsClockSkew(err error) bool {
+	var e APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return clockSkewCodes[e.Code]
+}
+
+// apiError completes an API error returned for the request with the client's
+// service, the request's operation and, if the error response's body didn't
+// have one, the request ID from its headers. It returns the typed exception for
+// the error's code, if there is one.
+func (c *Context) apiError(r *Request, x Exceptions, e APIError, unmarshal func(v interface{}) error) error {
+	e.Service = c.Service
+	e.Operation = r.Operation
+	if e.RequestID == "" && r.HTTPResponse != nil {
+		e.RequestID = headerRequestID(r.HTTPResponse.Header)
+	}
+	return x.decode(e, unmarshal)
+}
+
+// Exceptions maps a service's error codes to its typed exceptions. Each is the
+// zero value of a struct which embeds APIError, with fields for the
+// exception's modeled members.
+type Exceptions map[string]error
+
+// decode returns the typed exception for the API error's code, with its fields
+// decoded by the given function, or the error itself if there's none.
+func (x Exceptions) decode(e APIError, unmarshal func(v interface{}) error) error {
+	exception, ok := x[e.Code]
+	if !ok {
+		return e
+	}
+
+	v := reflect.New(reflect.TypeOf(exception))
+	// the modeled members are a bonus, so the error is returned regardless
+	if unmarshal != nil {
+		_ = unmarshal(v.Interface())
+	}
+	v.Elem().FieldByName("APIError").Set(reflect.ValueOf(e))
+	return v.Elem().Interface().(error)
+}
+
+// unmarshalXMLError decodes the first Error element of an XML error response
+// into v.
+func unmarshalXMLError(body []byte, v interface{}) error {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return err
+		}
+		if start, ok := t.(xml.StartElement); ok && start.Name.Local == "Error" {
+			return d.DecodeElement(v, &start)
+		}
+	}
 }
@@ -0,0 +1,302 @@
+package aws_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+type fakeJSONException struct {
+	aws.APIError
+	Reason aws.StringValue `json:"reason,omitempty"`
+}
+
+type fakeXMLException struct {
+	aws.APIError
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+func TestJSONException(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(400)
+			fmt.Fprintln(w, `{"__type":"com.amazonaws.animals#DogAsleepException","message":"zzz","reason":"tired"}`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+		Exceptions: aws.Exceptions{
+			"DogAsleepException": fakeJSONException{},
+		},
+	}
+
+	err := client.Do("PetTheDog", "POST", "/", nil, nil)
+
+	var e fakeJSONException
+	if !errors.As(err, &e) {
+		t.Fatalf("Error was %#v but expected a fakeJSONException", err)
+	}
+
+	if v, want := e.Code, "DogAsleepException"; v != want {
+		t.Errorf("Code was %v but expected %v", v, want)
+	}
+
+	if v, want := e.Message, "zzz"; v != want {
+		t.Errorf("Message was %v but expected %v", v, want)
+	}
+
+	if v, want := e.StatusCode, 400; v != want {
+		t.Errorf("Status code was %v but expected %v", v, want)
+	}
+
+	if v, want := e.Operation, "PetTheDog"; v != want {
+		t.Errorf("Operation was %v but expected %v", v, want)
+	}
+
+	if e.Reason == nil || *e.Reason != "tired" {
+		t.Errorf("Reason was %v but expected tired", e.Reason)
+	}
+}
+
+func TestQueryException(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(404)
+			fmt.Fprintln(w, `<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchDog</Code><Message>woof?</Message><BoxUsage>0.5</BoxUsage></Error><RequestId>abc</RequestId></ErrorResponse>`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.QueryClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+		Exceptions: aws.Exceptions{
+			"NoSuchDog": fakeXMLException{},
+		},
+	}
+
+	err := client.Do("GetIP", "POST", "/", nil, nil)
+
+	var e fakeXMLException
+	if !errors.As(err, &e) {
+		t.Fatalf("Error was %#v but expected a fakeXMLException", err)
+	}
+
+	if v, want := e.RequestID, "abc"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+
+	if e.BoxUsage == nil || *e.BoxUsage != 0.5 {
+		t.Errorf("BoxUsage was %v but expected 0.5", e.BoxUsage)
+	}
+}
+
+func TestRestJSONExceptionFromHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Amzn-ErrorType", "DogAsleepException:http://internal.amazon.com/coral/com.amazonaws.animals/")
+			w.Header().Set("X-Amzn-RequestId", "req-1")
+			w.WriteHeader(409)
+			fmt.Fprintln(w, `{"message":"zzz","reason":"tired"}`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.RestClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client: http.DefaultClient,
+		Exceptions: aws.Exceptions{
+			"DogAsleepException": fakeJSONException{},
+		},
+	}
+
+	req, err := http.NewRequest("GET", server.URL+"/dogs/spot", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Do(req)
+
+	var e fakeJSONException
+	if !errors.As(err, &e) {
+		t.Fatalf("Error was %#v but expected a fakeJSONException", err)
+	}
+
+	if e.Reason == nil || *e.Reason != "tired" {
+		t.Errorf("Reason was %v but expected tired", e.Reason)
+	}
+
+	if v, want := e.RequestID, "req-1"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+}
+
+func TestUnmodeledException(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(400)
+			fmt.Fprintln(w, `{"__type":"com.amazonaws.animals#CatException","message":"meow"}`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+		Exceptions: aws.Exceptions{
+			"DogAsleepException": fakeJSONException{},
+		},
+	}
+
+	err := client.Do("PetTheDog", "POST", "/", nil, nil)
+	if e, ok := err.(aws.APIError); !ok || e.Code != "CatException" {
+		t.Errorf("Error was %#v but expected a CatException APIError", err)
+	}
+}
+
+func TestRetryConditionMatchesException(t *testing.T) {
+	err := fakeJSONException{
+		APIError: aws.APIError{StatusCode: 400, Code: "DogAsleepException"},
+	}
+
+	c := aws.RetryCondition{Code: "DogAsleepException", StatusCode: 400}
+	if !c.Matches(err) {
+		t.Errorf("%#v didn't match %#v", c, err)
+	}
+}
+
+func TestAPIErrorText(t *testing.T) {
+	for _, test := range []struct {
+		err  aws.APIError
+		want string
+	}{
+		{
+			aws.APIError{
+				Service:    "dynamodb",
+				Operation:  "PutItem",
+				StatusCode: 400,
+				Code:       "ThrottlingException",
+				Message:    "Rate exceeded",
+				RequestID:  "abc",
+			},
+			"dynamodb/PutItem: ThrottlingException: Rate exceeded (status 400, request ID abc)",
+		},
+		{
+			aws.APIError{Service: "s3", Operation: "HeadObject", StatusCode: 404},
+			"s3/HeadObject: Not Found (status 404)",
+		},
+		{
+			aws.APIError{Message: "zzz"},
+			"zzz",
+		},
+	} {
+		if v := test.err.Error(); v != test.want {
+			t.Errorf("Error was %q but expected %q", v, test.want)
+		}
+	}
+}
+
+func TestAPIErrorIsAndAs(t *testing.T) {
+	err := fmt.Errorf("petting: %w", fakeJSONException{
+		APIError: aws.APIError{
+			Service:    "animals",
+			Operation:  "PetTheDog",
+			StatusCode: 400,
+			Code:       "DogAsleepException",
+		},
+	})
+
+	if !errors.Is(err, aws.APIError{Code: "DogAsleepException"}) {
+		t.Errorf("%v wasn't a DogAsleepException", err)
+	}
+	if !errors.Is(err, aws.APIError{Service: "animals", StatusCode: 400}) {
+		t.Errorf("%v wasn't an animals 400", err)
+	}
+	if errors.Is(err, aws.APIError{Code: "CatException"}) {
+		t.Errorf("%v was a CatException", err)
+	}
+
+	var e aws.APIError
+	if !errors.As(err, &e) {
+		t.Fatalf("%v wasn't an APIError", err)
+	}
+	if v, want := e.Operation, "PetTheDog"; v != want {
+		t.Errorf("Operation was %v but expected %v", v, want)
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	for _, test := range []struct {
+		err                                         error
+		throttle, retryable, notFound, accessDenied bool
+	}{
+		{aws.APIError{StatusCode: 400, Code: "ThrottlingException"}, true, true, false, false},
+		{aws.APIError{StatusCode: 503, Code: "RequestLimitExceeded"}, true, true, false, false},
+		{aws.APIError{StatusCode: 429}, true, true, false, false},
+		{aws.APIError{StatusCode: 500, Code: "InternalError"}, false, true, false, false},
+		{aws.APIError{StatusCode: 501, Code: "NotImplemented"}, false, false, false, false},
+		{aws.APIError{StatusCode: 400, Code: "RequestTimeout"}, false, true, false, false},
+		{aws.APIError{StatusCode: 404, Code: "NoSuchKey"}, false, false, true, false},
+		{aws.APIError{StatusCode: 400, Code: "InvalidInstanceID.NotFound"}, false, false, true, false},
+		{aws.APIError{StatusCode: 400, Code: "ResourceNotFoundException"}, false, false, true, false},
+		{aws.APIError{StatusCode: 403, Code: "AccessDenied"}, false, false, false, true},
+		{aws.APIError{StatusCode: 400, Code: "UnauthorizedOperation"}, false, false, false, true},
+		{aws.APIError{StatusCode: 403}, false, false, false, true},
+		{aws.APIError{StatusCode: 403, Code: "SignatureDoesNotMatch"}, false, false, false, false},
+		{fakeJSONException{APIError: aws.APIError{StatusCode: 400, Code: "ProvisionedThroughputExceededException"}}, true, true, false, false},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: errors.New("connection refused")}, false, true, false, false},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: context.Canceled}, false, false, false, false},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: context.DeadlineExceeded}, false, false, false, false},
+		{context.DeadlineExceeded, false, false, false, false},
+		{&aws.ChecksumError{Header: "x-amz-crc32", Expected: "1", Actual: "2"}, false, true, false, false},
+		{fmt.Errorf("reading body: %w", &aws.ChecksumError{Header: "x-amz-crc32"}), false, true, false, false},
+		{errors.New("boom"), false, false, false, false},
+	} {
+		if v := aws.IsThrottle(test.err); v != test.throttle {
+			t.Errorf("IsThrottle(%v) was %v but expected %v", test.err, v, test.throttle)
+		}
+		if v := aws.IsRetryable(test.err); v != test.retryable {
+			t.Errorf("IsRetryable(%v) was %v but expected %v", test.err, v, test.retryable)
+		}
+		if v := aws.IsNotFound(test.err); v != test.notFound {
+			t.Errorf("IsNotFound(%v) was %v but expected %v", test.err, v, test.notFound)
+		}
+		if v := aws.IsAccessDenied(test.err); v != test.accessDenied {
+			t.Errorf("IsAccessDenied(%v) was %v but expected %v", test.err, v, test.accessDenied)
+		}
+	}
+}
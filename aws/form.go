@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A queryEncoder serializes request parameters into the form of a Query or
+// EC2 request, naming them after the tags of their fields.
+//
+// A field's tag is its name, followed by a descriptor for each list or map it
+// contains, from the outermost in:
+//
+//	list          elements are named Name.1, Name.2, ...
+//	list:member   elements are named Name.member.1, ...
+//	map:e:k:v     entries are named Name.e.1.k and Name.e.1.v, or Name.1.k
+//	              and Name.1.v if e is empty
+//
+// Lists and maps without a descriptor are treated as "list" and
+// "map::key:value".
+type queryEncoder struct {
+	// tag is the name of the field tags, e.g. "query".
+	tag string
+
+	// emptyLists sends empty, non-nil lists as an empty parameter.
+	emptyLists bool
+}
+
+var (
+	queryEncoding = queryEncoder{tag: "query", emptyLists: true}
+	ec2Encoding   = queryEncoder{tag: "ec2"}
+)
+
+// A QueryMarshaler adds its own parameters to the form of a Query request,
+// named as a queryEncoder would name them, but without reflection. The shapes
+// of requests generate their MarshalQuery methods.
+type QueryMarshaler interface {
+	MarshalQuery(form url.Values, prefix string) error
+}
+
+// An EC2Marshaler adds its own parameters to the form of an EC2 request, like
+// a QueryMarshaler does for Query requests.
+type EC2Marshaler interface {
+	MarshalEC2(form url.Values, prefix string) error
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	xmlNameType = reflect.TypeOf(xml.Name{})
+)
+
+// encode adds the parameters of the given value to v.
+func (e queryEncoder) encode(v url.Values, i interface{}) error {
+	return e.encodeValue(v, reflect.ValueOf(i), "", nil)
+}
+
+// marshal adds the parameters of the given value to v, with its marshaler if
+// it has one.
+func (e queryEncoder) marshal(v url.Values, i interface{}) error {
+	switch e.tag {
+	case "query":
+		if m, ok := i.(QueryMarshaler); ok {
+			return m.MarshalQuery(v, "")
+		}
+	case "ec2":
+		if m, ok := i.(EC2Marshaler); ok {
+			return m.MarshalEC2(v, "")
+		}
+	}
+	return e.encode(v, i)
+}
+
+func (e queryEncoder) encodeValue(v url.Values, value reflect.Value, name string, containers []string) error {
+	// follow any pointers and interfaces
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	// no need to handle zero values
+	if !value.IsValid() {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		if value.Type() == timeType {
+			if t := value.Interface().(time.Time); !t.IsZero() {
+				v.Set(name, t.UTC().Format(iso8601))
+			}
+			return nil
+		}
+		return e.encodeStruct(v, value, name)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			if !value.IsNil() {
+				v.Set(name, base64.StdEncoding.EncodeToString(value.Bytes()))
+			}
+			return nil
+		}
+		return e.encodeList(v, value, name, containers)
+	case reflect.Map:
+		return e.encodeMap(v, value, name, containers)
+	case reflect.String:
+		v.Set(name, value.String())
+	case reflect.Bool:
+		v.Set(name, strconv.FormatBool(value.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.Set(name, strconv.FormatInt(value.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.Set(name, strconv.FormatUint(value.Uint(), 10))
+	case reflect.Float32:
+		v.Set(name, strconv.FormatFloat(value.Float(), 'f', -1, 32))
+	case reflect.Float64:
+		v.Set(name, strconv.FormatFloat(value.Float(), 'f', -1, 64))
+	default:
+		return fmt.Errorf("aws: can't encode %s as a %s parameter", value.Type(), e.tag)
+	}
+	return nil
+}
+
+func (e queryEncoder) encodeStruct(v url.Values, value reflect.Value, prefix string) error {
+	t := value.Type()
+	for i := 0; i < value.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || field.Type == xmlNameType {
+			continue
+		}
+
+		tag := field.Tag.Get(e.tag)
+		if tag == "-" {
+			continue
+		}
+
+		// plain string fields are omitted when empty; optional ones are
+		// pointers
+		if field.Type.Kind() == reflect.String && value.Field(i).String() == "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		if name == "" {
+			name = field.Name
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		if err := e.encodeValue(v, value.Field(i), name, parts[1:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e queryEncoder) encodeList(v url.Values, value reflect.Value, name string, containers []string) error {
+	if value.IsNil() {
+		return nil
+	}
+	if value.Len() == 0 {
+		if e.emptyLists {
+			v.Set(name, "")
+		}
+		return nil
+	}
+
+	var desc string
+	if len(containers) != 0 {
+		desc, containers = containers[0], containers[1:]
+	}
+	if member := strings.TrimPrefix(strings.TrimPrefix(desc, "list"), ":"); member != "" {
+		name = name + "." + member
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		if err := e.encodeValue(v, value.Index(i), name+"."+strconv.Itoa(i+1), containers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e queryEncoder) encodeMap(v url.Values, value reflect.Value, name string, containers []string) error {
+	entry, key, val := "", "key", "value"
+	if len(containers) != 0 {
+		var desc string
+		desc, containers = containers[0], containers[1:]
+		if parts := strings.Split(desc, ":"); len(parts) == 4 {
+			entry, key, val = parts[1], parts[2], parts[3]
+		}
+	}
+	if entry != "" {
+		name = name + "." + entry
+	}
+
+	// entries are numbered in order of their keys
+	keys := value.MapKeys()
+	sorted := make([]string, len(keys))
+	byString := make(map[string]reflect.Value, len(keys))
+	for i, k := range keys {
+		sorted[i] = fmt.Sprint(k.Interface())
+		byString[sorted[i]] = k
+	}
+	sort.Strings(sorted)
+
+	for i, s := range sorted {
+		prefix := name + "." + strconv.Itoa(i+1) + "."
+		k := byString[s]
+		if err := e.encodeValue(v, k, prefix+key, nil); err != nil {
+			return err
+		}
+		if err := e.encodeValue(v, value.MapIndex(k), prefix+val, containers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package aws
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type recursiveStruct struct {
+	NoRecurse       StringValue                `query:"NoRecurse"`
+	RecursiveStruct *recursiveStruct           `query:"RecursiveStruct"`
+	RecursiveList   []recursiveStruct          `query:"RecursiveList,list:member"`
+	RecursiveMap    map[string]recursiveStruct `query:"RecursiveMap,map:entry:key:value"`
+}
+
+// The cases are botocore's protocol tests for the Query protocol
+// (tests/unit/protocols/input/query.json), with the field tags the model
+// generates for their shapes.
+var queryEncodingTests = []struct {
+	name   string
+	params interface{}
+	want   string
+}{
+	{
+		"scalar members",
+		&struct {
+			Foo StringValue `query:"Foo"`
+			Bar StringValue `query:"Bar"`
+		}{Foo: String("val1"), Bar: String("val2")},
+		"Bar=val2&Foo=val1",
+	},
+	{
+		"nested structure members",
+		&struct {
+			StructArg *struct {
+				ScalarArg StringValue `query:"ScalarArg"`
+			} `query:"StructArg"`
+		}{StructArg: &struct {
+			ScalarArg StringValue `query:"ScalarArg"`
+		}{ScalarArg: String("foo")}},
+		"StructArg.ScalarArg=foo",
+	},
+	{
+		"list types",
+		&struct {
+			ListArg []string `query:"ListArg,list:member"`
+		}{ListArg: []string{"foo", "bar", "baz"}},
+		"ListArg.member.1=foo&ListArg.member.2=bar&ListArg.member.3=baz",
+	},
+	{
+		"empty list types",
+		&struct {
+			ListArg []string `query:"ListArg,list:member"`
+		}{ListArg: []string{}},
+		"ListArg=",
+	},
+	{
+		"flattened list",
+		&struct {
+			ListArg []string `query:"ListArg,list"`
+		}{ListArg: []string{"a", "b", "c"}},
+		"ListArg.1=a&ListArg.2=b&ListArg.3=c",
+	},
+	{
+		"flattened lists alongside a scalar, one with a member locationName",
+		&struct {
+			ScalarArg    StringValue `query:"ScalarArg"`
+			ListArg      []string    `query:"ListArg,list"`
+			NamedListArg []string    `query:"Foo,list"`
+		}{ScalarArg: String("foo"), ListArg: []string{"a", "b", "c"}, NamedListArg: []string{"a"}},
+		"Foo.1=a&ListArg.1=a&ListArg.2=b&ListArg.3=c&ScalarArg=foo",
+	},
+	{
+		"serialize flattened map type",
+		&struct {
+			MapArg map[string]string `query:"MapArg,map::key:value"`
+		}{MapArg: map[string]string{"key1": "val1", "key2": "val2"}},
+		"MapArg.1.key=key1&MapArg.1.value=val1&MapArg.2.key=key2&MapArg.2.value=val2",
+	},
+	{
+		"non flattened list with locationName",
+		&struct {
+			ListArg []string `query:"ListArg,list:item"`
+		}{ListArg: []string{"a", "b", "c"}},
+		"ListArg.item.1=a&ListArg.item.2=b&ListArg.item.3=c",
+	},
+	{
+		"flattened list with locationName",
+		&struct {
+			ScalarArg StringValue `query:"ScalarArg"`
+			ListArg   []string    `query:"ListArgLocation,list"`
+		}{ScalarArg: String("foo"), ListArg: []string{"a", "b", "c"}},
+		"ListArgLocation.1=a&ListArgLocation.2=b&ListArgLocation.3=c&ScalarArg=foo",
+	},
+	{
+		"serialize map type",
+		&struct {
+			MapArg map[string]string `query:"MapArg,map:entry:key:value"`
+		}{MapArg: map[string]string{"key1": "val1", "key2": "val2"}},
+		"MapArg.entry.1.key=key1&MapArg.entry.1.value=val1&MapArg.entry.2.key=key2&MapArg.entry.2.value=val2",
+	},
+	{
+		"serialize map type with locationName",
+		&struct {
+			MapArg map[string]string `query:"MapArg,map:entry:TheKey:TheValue"`
+		}{MapArg: map[string]string{"key1": "val1", "key2": "val2"}},
+		"MapArg.entry.1.TheKey=key1&MapArg.entry.1.TheValue=val1&MapArg.entry.2.TheKey=key2&MapArg.entry.2.TheValue=val2",
+	},
+	{
+		"base64 encoded blobs",
+		&struct {
+			BlobArg []byte `query:"BlobArg"`
+		}{BlobArg: []byte("foo")},
+		"BlobArg=Zm9v",
+	},
+	{
+		"timestamp values",
+		&struct {
+			TimeArg time.Time `query:"TimeArg"`
+		}{TimeArg: time.Unix(1422172800, 0)},
+		"TimeArg=2015-01-25T08%3A00%3A00Z",
+	},
+	{
+		"recursive structure",
+		&struct {
+			RecursiveStruct *recursiveStruct `query:"RecursiveStruct"`
+		}{RecursiveStruct: &recursiveStruct{
+			RecursiveStruct: &recursiveStruct{
+				RecursiveStruct: &recursiveStruct{NoRecurse: String("foo")},
+			},
+		}},
+		"RecursiveStruct.RecursiveStruct.RecursiveStruct.NoRecurse=foo",
+	},
+	{
+		"recursive list",
+		&struct {
+			RecursiveStruct *recursiveStruct `query:"RecursiveStruct"`
+		}{RecursiveStruct: &recursiveStruct{
+			RecursiveList: []recursiveStruct{
+				{NoRecurse: String("foo")},
+				{RecursiveStruct: &recursiveStruct{NoRecurse: String("bar")}},
+			},
+		}},
+		"RecursiveStruct.RecursiveList.member.1.NoRecurse=foo&RecursiveStruct.RecursiveList.member.2.RecursiveStruct.NoRecurse=bar",
+	},
+	{
+		"recursive map",
+		&struct {
+			RecursiveStruct *recursiveStruct `query:"RecursiveStruct"`
+		}{RecursiveStruct: &recursiveStruct{
+			RecursiveMap: map[string]recursiveStruct{
+				"foo": {NoRecurse: String("foo")},
+				"bar": {NoRecurse: String("bar")},
+			},
+		}},
+		"RecursiveStruct.RecursiveMap.entry.1.key=bar&RecursiveStruct.RecursiveMap.entry.1.value.NoRecurse=bar&" +
+			"RecursiveStruct.RecursiveMap.entry.2.key=foo&RecursiveStruct.RecursiveMap.entry.2.value.NoRecurse=foo",
+	},
+	{
+		"boolean values",
+		&struct {
+			BooleanArg BooleanValue `query:"BooleanArg"`
+		}{BooleanArg: True()},
+		"BooleanArg=true",
+	},
+	{
+		"nested lists",
+		&struct {
+			ListArg [][]string `query:"ListArg,list:member,list:member"`
+		}{ListArg: [][]string{{"a", "b"}, {"c"}}},
+		"ListArg.member.1.member.1=a&ListArg.member.1.member.2=b&ListArg.member.2.member.1=c",
+	},
+	{
+		"non flattened map of non flattened lists",
+		&struct {
+			MapArg map[string][]string `query:"MapArg,map:entry:key:value,list:member"`
+		}{MapArg: map[string][]string{"k": {"a", "b"}}},
+		"MapArg.entry.1.key=k&MapArg.entry.1.value.member.1=a&MapArg.entry.1.value.member.2=b",
+	},
+}
+
+func TestQueryEncoding(t *testing.T) {
+	for _, test := range queryEncodingTests {
+		testEncoding(t, queryEncoding, test.name, test.params, test.want)
+	}
+}
+
+// The cases are botocore's protocol tests for the EC2 protocol
+// (tests/unit/protocols/input/ec2.json), with the field tags the model
+// generates for their shapes.
+var ec2EncodingTests = []struct {
+	name   string
+	params interface{}
+	want   string
+}{
+	{
+		"scalar members",
+		&struct {
+			Foo StringValue `ec2:"Foo"`
+			Bar StringValue `ec2:"Bar"`
+		}{Foo: String("val1"), Bar: String("val2")},
+		"Bar=val2&Foo=val1",
+	},
+	{
+		"structure with locationName and queryName applied to members",
+		&struct {
+			Foo  StringValue `ec2:"BarLocationName"`
+			Yuck StringValue `ec2:"yuckQueryName"`
+		}{Foo: String("val1"), Yuck: String("val2")},
+		"BarLocationName=val1&yuckQueryName=val2",
+	},
+	{
+		"nested structure members",
+		&struct {
+			StructArg *struct {
+				ScalarArg StringValue `ec2:"Scalar"`
+			} `ec2:"Struct"`
+		}{StructArg: &struct {
+			ScalarArg StringValue `ec2:"Scalar"`
+		}{ScalarArg: String("foo")}},
+		"Struct.Scalar=foo",
+	},
+	{
+		"list types",
+		&struct {
+			ListArg []string `ec2:"ListArg,list"`
+		}{ListArg: []string{"foo", "bar", "baz"}},
+		"ListArg.1=foo&ListArg.2=bar&ListArg.3=baz",
+	},
+	{
+		"empty list types",
+		&struct {
+			ListArg []string `ec2:"ListArg,list"`
+		}{ListArg: []string{}},
+		"",
+	},
+	{
+		"list with location name applied to member",
+		&struct {
+			ListArg []string `ec2:"ListMemberName,list"`
+		}{ListArg: []string{"a", "b", "c"}},
+		"ListMemberName.1=a&ListMemberName.2=b&ListMemberName.3=c",
+	},
+	{
+		"list with locationName and queryName",
+		&struct {
+			ListArg []string `ec2:"ListQueryName,list"`
+		}{ListArg: []string{"a", "b", "c"}},
+		"ListQueryName.1=a&ListQueryName.2=b&ListQueryName.3=c",
+	},
+	{
+		"nested lists of structures",
+		&struct {
+			ListArg []struct {
+				Values []string `ec2:"Value,list"`
+			} `ec2:"Filter,list"`
+		}{ListArg: []struct {
+			Values []string `ec2:"Value,list"`
+		}{{Values: []string{"a", "b"}}, {Values: []string{"c"}}}},
+		"Filter.1.Value.1=a&Filter.1.Value.2=b&Filter.2.Value.1=c",
+	},
+	{
+		"base64 encoded blobs",
+		&struct {
+			BlobArg []byte `ec2:"BlobArg"`
+		}{BlobArg: []byte("foo")},
+		"BlobArg=Zm9v",
+	},
+	{
+		"timestamp values",
+		&struct {
+			TimeArg time.Time `ec2:"TimeArg"`
+		}{TimeArg: time.Unix(1422172800, 0)},
+		"TimeArg=2015-01-25T08%3A00%3A00Z",
+	},
+}
+
+func TestEC2Encoding(t *testing.T) {
+	for _, test := range ec2EncodingTests {
+		testEncoding(t, ec2Encoding, test.name, test.params, test.want)
+	}
+}
+
+func testEncoding(t *testing.T, e queryEncoder, name string, params interface{}, want string) {
+	v := url.Values{}
+	if err := e.encode(v, params); err != nil {
+		t.Errorf("%s: %v", name, err)
+		return
+	}
+
+	expected, err := url.ParseQuery(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("%s: parameters were\n%s\nbut expected\n%s", name, v.Encode(), expected.Encode())
+	}
+}
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+)
+
+// A Request is an API request as it passes through a client's handlers.
+type Request struct {
+	Operation  string
+	HTTPMethod string
+	HTTPPath   string
+
+	// Params is the operation's input, and Data is the value its output is
+	// decoded into. Either may be nil.
+	Params interface{}
+	Data   interface{}
+
+	HTTPRequest  *http.Request
+	HTTPResponse *http.Response
+
+	// Error is set by the first handler to fail, which stops the remaining
+	// handlers from running.
+	Error error
+
+	// Attempt is the number of the current attempt, starting at 1.
+	Attempt int
+
+	// clockSkewed is set when the attempt's response changed the client's
+	// clock skew, so a clock skew error is worth retrying.
+	clockSkewed bool
+	skewRetried bool
+
+	// requestID is the request ID from the response's body, for protocols
+	// which don't always send it in a header.
+	requestID string
+
+	ctx context.Context
+}
+
+// Context returns the request's context.
+func (r *Request) Context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+// A Handler is a named step in sending a request.
+type Handler struct {
+	Name string
+	Fn   func(*Request)
+}
+
+// A HandlerList is an ordered list of handlers.
+type HandlerList struct {
+	list []Handler
+}
+
+// PushBack adds the handler to the end of the list.
+func (l *HandlerList) PushBack(h Handler) {
+	l.list = append(l.list, h)
+}
+
+// PushFront adds the handler to the start of the list.
+func (l *HandlerList) PushFront(h Handler) {
+	l.list = append([]Handler{h}, l.list...)
+}
+
+// Remove removes all handlers with the given name.
+func (l *HandlerList) Remove(name string) {
+	list := l.list[:0]
+	for _, h := range l.list {
+		if h.Name != name {
+			list = append(list, h)
+		}
+	}
+	l.list = list
+}
+
+// Replace replaces the handlers with the same name as the given handler,
+// returning false if there are none.
+func (l *HandlerList) Replace(h Handler) bool {
+	var found bool
+	for i := range l.list {
+		if l.list[i].Name == h.Name {
+			l.list[i] = h
+			found = true
+		}
+	}
+	return found
+}
+
+// Names returns the names of the handlers in the list, in order.
+func (l *HandlerList) Names() []string {
+	names := make([]string, len(l.list))
+	for i, h := range l.list {
+		names[i] = h.Name
+	}
+	return names
+}
+
+// Run runs the handlers in order, stopping at the first to set the request's
+// error.
+func (l *HandlerList) Run(r *Request) {
+	for _, h := range l.list {
+		if r.Error != nil {
+			return
+		}
+		h.Fn(r)
+	}
+}
+
+// Handlers are the phases a request passes through. Build runs once, then
+// Sign, Send and ValidateResponse run for each attempt, and Unmarshal runs
+// once the request has succeeded.
+type Handlers struct {
+	Build            HandlerList
+	Sign             HandlerList
+	Send             HandlerList
+	ValidateResponse HandlerList
+	Unmarshal        HandlerList
+}
+
+// run sends the request through the handlers, retrying according to the given
+// policy.
+func (h *Handlers) run(r *Request, p *RetryPolicy) error {
+	h.Build.Run(r)
+	if r.Error != nil {
+		return r.Error
+	}
+
+	attempt := func() error {
+		r.Attempt++
+		r.clockSkewed = false
+		if r.Attempt > 1 {
+			if r.HTTPResponse != nil {
+				_ = r.HTTPResponse.Body.Close()
+				r.HTTPResponse = nil
+			}
+			r.Error = rewindBody(r.HTTPRequest)
+		}
+
+		h.Sign.Run(r)
+		h.Send.Run(r)
+		h.ValidateResponse.Run(r)
+
+		err := r.Error
+		r.Error = nil
+		return err
+	}
+
+	r.Error = p.retry(r.Context(), func() error {
+		err := attempt()
+		// a request rejected for the skew which was just corrected is re-signed
+		// and sent again, once, regardless of the retry policy
+		if r.clockSkewed && !r.skewRetried && IsClockSkew(err) && r.Context().Err() == nil {
+			r.skewRetried = true
+			err = attempt()
+		}
+		return err
+	})
+
+	h.Unmarshal.Run(r)
+	recordMetadata(r)
+	return r.Error
+}
+
+func (c *Context) signRequest(r *Request) {
+	if c.Signer == nil {
+		r.Error = c.sign(r.HTTPRequest)
+		return
+	}
+	r.Error = c.Signer.Sign(c, r.HTTPRequest)
+}
+
+func sendRequest(client *http.Client, r *Request) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	r.HTTPResponse, r.Error = client.Do(r.HTTPRequest)
+}
@@ -0,0 +1,145 @@
+package aws_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestHandlerList(t *testing.T) {
+	var l aws.HandlerList
+	noop := func(r *aws.Request) {}
+
+	l.PushBack(aws.Handler{Name: "b", Fn: noop})
+	l.PushBack(aws.Handler{Name: "c", Fn: noop})
+	l.PushFront(aws.Handler{Name: "a", Fn: noop})
+
+	if v, want := l.Names(), []string{"a", "b", "c"}; !reflect.DeepEqual(v, want) {
+		t.Errorf("Handlers were %v but expected %v", v, want)
+	}
+
+	l.Remove("b")
+	if v, want := l.Names(), []string{"a", "c"}; !reflect.DeepEqual(v, want) {
+		t.Errorf("Handlers were %v but expected %v", v, want)
+	}
+
+	if l.Replace(aws.Handler{Name: "b", Fn: noop}) {
+		t.Error("Replaced a handler which wasn't in the list")
+	}
+}
+
+func TestHandlerListStopsAtError(t *testing.T) {
+	var l aws.HandlerList
+	var ran []string
+
+	l.PushBack(aws.Handler{Name: "a", Fn: func(r *aws.Request) {
+		ran = append(ran, "a")
+		r.Error = errors.New("uh oh")
+	}})
+	l.PushBack(aws.Handler{Name: "b", Fn: func(r *aws.Request) {
+		ran = append(ran, "b")
+	}})
+
+	l.Run(&aws.Request{})
+
+	if v, want := ran, []string{"a"}; !reflect.DeepEqual(v, want) {
+		t.Errorf("Ran %v but expected %v", v, want)
+	}
+}
+
+func TestHandlersInjectSignedHeader(t *testing.T) {
+	var m sync.Mutex
+	var httpReq *http.Request
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			httpReq = r
+			fmt.Fprintln(w, `<Thing><IpAddress>woo</IpAddress></Thing>`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.QueryClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+	}
+
+	client.Handlers().Build.PushBack(aws.Handler{
+		Name: "test.Header",
+		Fn: func(r *aws.Request) {
+			r.HTTPRequest.Header.Set("X-Amz-Woof", r.Operation)
+		},
+	})
+
+	var resp fakeQueryResponse
+	if err := client.Do("GetIP", "POST", "/", nil, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if v, want := httpReq.Header.Get("X-Amz-Woof"), "GetIP"; v != want {
+		t.Errorf("X-Amz-Woof was %v but expected %v", v, want)
+	}
+
+	if auth := httpReq.Header.Get("Authorization"); !strings.Contains(auth, "x-amz-woof") {
+		t.Errorf("X-Amz-Woof wasn't signed: %v", auth)
+	}
+}
+
+func TestHandlersReplaceValidateResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(418)
+			fmt.Fprintln(w, `I'm a teapot`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+
+	teapot := errors.New("teapot")
+	replaced := client.Handlers().ValidateResponse.Replace(aws.Handler{
+		Name: "aws.JSONValidateResponse",
+		Fn: func(r *aws.Request) {
+			defer r.HTTPResponse.Body.Close()
+			if r.HTTPResponse.StatusCode == 418 {
+				r.Error = teapot
+			}
+		},
+	})
+	if !replaced {
+		t.Fatal("aws.JSONValidateResponse wasn't replaced")
+	}
+
+	if err := client.Do("PetTheDog", "POST", "/", nil, nil); err != teapot {
+		t.Errorf("Error was %#v but expected %#v", err, teapot)
+	}
+}
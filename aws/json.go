@@ -2,9 +2,12 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"sync"
 )
 
 // JSONClient is the underlying client for JSON APIs.
@@ -14,57 +17,131 @@ type JSONClient struct {
 	Endpoint     string
 	TargetPrefix string
 	JSONVersion  string
+	Retry        *RetryPolicy
+	Exceptions   Exceptions
+
+	handlers     *Handlers
+	handlersOnce sync.Once
+}
+
+// Handlers returns the client's request handlers. By default, these are
+// aws.JSONBuild, aws.AcceptEncoding, aws.Sign, aws.LogRequest, aws.Send,
+// aws.ClockSkew, aws.LogResponse, aws.VerifyCRC32, aws.Decompress,
+// aws.JSONValidateResponse and aws.JSONUnmarshal.
+func (c *JSONClient) Handlers() *Handlers {
+	c.handlersOnce.Do(func() {
+		c.handlers = &Handlers{}
+		c.handlers.Build.PushBack(Handler{Name: "aws.JSONBuild", Fn: c.build})
+		c.handlers.Build.PushBack(Handler{Name: "aws.AcceptEncoding", Fn: c.Context.setAcceptEncoding})
+		c.handlers.Sign.PushBack(Handler{Name: "aws.Sign", Fn: c.Context.signRequest})
+		c.handlers.Sign.PushBack(Handler{Name: "aws.LogRequest", Fn: c.Context.logRequest})
+		c.handlers.Send.PushBack(Handler{Name: "aws.Send", Fn: c.send})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.ClockSkew", Fn: c.Context.updateClockSkew})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.LogResponse", Fn: c.Context.logResponse})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.VerifyCRC32", Fn: verifyCRC32})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.Decompress", Fn: decompress})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.JSONValidateResponse", Fn: c.validateResponse})
+		c.handlers.Unmarshal.PushBack(Handler{Name: "aws.JSONUnmarshal", Fn: c.unmarshal})
+	})
+	return c.handlers
 }
 
 // Do sends an HTTP request and returns an HTTP response, following policy
-// (e.g. redirects, cookies, auth) as configured on the client.
+// (e.g. redirects, cookies, auth) as configured on the client. Failed requests
+// are retried according to the client's retry policy.
 func (c *JSONClient) Do(op, method, uri string, req, resp interface{}) error {
-	b, err := json.Marshal(req)
+	return c.DoWithContext(context.Background(), op, method, uri, req, resp)
+}
+
+// DoWithContext is like Do, but the request, including any retries, is
+// abandoned when the given context is done.
+func (c *JSONClient) DoWithContext(ctx context.Context, op, method, uri string, req, resp interface{}) error {
+	r := &Request{
+		Operation:  op,
+		HTTPMethod: method,
+		HTTPPath:   uri,
+		Params:     req,
+		Data:       resp,
+		ctx:        ctx,
+	}
+	return c.Handlers().run(r, c.Retry)
+}
+
+func (c *JSONClient) build(r *Request) {
+	b, err := marshalJSON(r.Params)
 	if err != nil {
-		return err
+		r.Error = err
+		return
 	}
 
-	httpReq, err := http.NewRequest(method, c.Endpoint+uri, bytes.NewReader(b))
+	httpReq, err := http.NewRequestWithContext(r.Context(), r.HTTPMethod, c.Endpoint+r.HTTPPath, bytes.NewReader(b))
 	if err != nil {
-		return err
+		r.Error = err
+		return
 	}
-	httpReq.Header.Set("User-Agent", "aws-go")
-	httpReq.Header.Set("X-Amz-Target", c.TargetPrefix+"."+op)
+	httpReq.Header.Set("User-Agent", c.Context.userAgent())
+	httpReq.Header.Set("X-Amz-Target", c.TargetPrefix+"."+r.Operation)
 	httpReq.Header.Set("Content-Type", "application/x-amz-json-"+c.JSONVersion)
-	if err := c.Context.sign(httpReq); err != nil {
-		return err
+	r.HTTPRequest = httpReq
+}
+
+// marshalJSON encodes the request parameters, calling their MarshalJSON method
+// directly if they have one, rather than through json.Marshal, which would
+// check its result.
+func marshalJSON(params interface{}) ([]byte, error) {
+	if m, ok := params.(json.Marshaler); ok {
+		return m.MarshalJSON()
 	}
+	return json.Marshal(params)
+}
 
-	httpResp, err := c.Client.Do(httpReq)
-	if err != nil {
-		return err
+func (c *JSONClient) send(r *Request) {
+	sendRequest(c.Client, r)
+}
+
+func (c *JSONClient) validateResponse(r *Request) {
+	httpResp := r.HTTPResponse
+	if httpResp.StatusCode == http.StatusOK {
+		return
 	}
 	defer func() {
 		_ = httpResp.Body.Close()
 	}()
 
-	if httpResp.StatusCode != http.StatusOK {
-		bodyBytes, err := ioutil.ReadAll(httpResp.Body)
-		if err != nil {
-			return err
-		}
-		if len(bodyBytes) == 0 {
-			return APIError{
-				StatusCode: httpResp.StatusCode,
-			}
-		}
-		var jsonErr jsonErrorResponse
-		if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
-			return err
-		}
-		reqid := httpResp.Header.Get("X-Amzn-RequestId")
-		return jsonErr.Err(httpResp.StatusCode, reqid)
+	bodyBytes, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		r.Error = err
+		return
 	}
+	if len(bodyBytes) == 0 {
+		r.Error = c.Context.apiError(r, c.Exceptions, APIError{
+			StatusCode: httpResp.StatusCode,
+		}, nil)
+		return
+	}
+	var jsonErr jsonErrorResponse
+	if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
+		// not an AWS error, e.g. a proxy's HTML error page
+		r.Error = c.Context.apiError(r, c.Exceptions, APIError{
+			StatusCode: httpResp.StatusCode,
+			Message:    string(bodyBytes),
+		}, nil)
+		return
+	}
+	reqid := httpResp.Header.Get("X-Amzn-RequestId")
+	r.Error = c.Context.apiError(r, c.Exceptions, jsonErr.Err(httpResp.StatusCode, reqid), func(v interface{}) error {
+		return json.Unmarshal(bodyBytes, v)
+	})
+}
 
-	if resp != nil {
-		return json.NewDecoder(httpResp.Body).Decode(resp)
+func (c *JSONClient) unmarshal(r *Request) {
+	defer func() {
+		_ = r.HTTPResponse.Body.Close()
+	}()
+
+	if r.Data != nil {
+		r.Error = json.NewDecoder(r.HTTPResponse.Body).Decode(r.Data)
 	}
-	return nil
 }
 
 type jsonErrorResponse struct {
@@ -72,11 +149,18 @@ type jsonErrorResponse struct {
 	Message string `json:"message"`
 }
 
-func (e jsonErrorResponse) Err(StatusCode int, RequestID string) error {
+func (e jsonErrorResponse) Err(StatusCode int, RequestID string) APIError {
+	// e.g. com.amazonaws.dynamodb.v20120810#ProvisionedThroughputExceededException
+	code := e.Type
+	if i := strings.LastIndex(code, "#"); i >= 0 {
+		code = code[i+1:]
+	}
+
 	return APIError{
 		StatusCode: StatusCode,
 		RequestID:  RequestID,
 		Type:       e.Type,
+		Code:       code,
 		Message:    e.Message,
 	}
 }
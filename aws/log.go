@@ -0,0 +1,292 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// A Logger writes debug output. *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// A LogLevel selects what a client's Logger is sent.
+type LogLevel uint
+
+const (
+	// LogHeaders logs the headers of signed requests and their responses.
+	LogHeaders LogLevel = 1 << iota
+
+	// LogBodies logs the headers and bodies of signed requests and their
+	// responses. Response bodies are buffered in memory to be logged.
+	LogBodies
+
+	// LogSigning logs the canonical request and string to sign of each
+	// signature.
+	LogSigning
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are always redacted from logs.
+var sensitiveHeaders = []string{"Authorization", "X-Amz-Security-Token"}
+
+func (c *Context) logging(level LogLevel) bool {
+	return c.Logger != nil && c.LogLevel&level != 0
+}
+
+func (c *Context) logRequest(r *Request) {
+	if !c.logging(LogHeaders | LogBodies) {
+		return
+	}
+
+	// streamed payloads aren't read ahead of sending, even to be logged
+	body := c.logging(LogBodies)
+	switch r.HTTPRequest.Header.Get("X-Amz-Content-Sha256") {
+	case unsignedPayloadHash, streamingPayloadHash:
+		body = false
+	}
+
+	sensitive := sensitiveNames(r.Params)
+	req := new(http.Request)
+	*req = *r.HTTPRequest
+	req.Header = redactHeader(req.Header, sensitive)
+	if req.URL.RawQuery != "" && len(sensitive) != 0 {
+		u := *req.URL
+		u.RawQuery = redactQuery(u.RawQuery, sensitive)
+		req.URL = &u
+	}
+	req.Body = nil
+	if body && r.HTTPRequest.GetBody != nil {
+		b, err := r.HTTPRequest.GetBody()
+		if err != nil {
+			r.Error = err
+			return
+		}
+		req.Body = b
+	}
+
+	dump, err := httputil.DumpRequest(req, body && req.Body != nil)
+	if err != nil {
+		r.Error = err
+		return
+	}
+	if req.Body != nil {
+		// GetBody may share state with the request's body
+		if err := rewindBody(r.HTTPRequest); err != nil {
+			r.Error = err
+			return
+		}
+	}
+
+	c.Logger.Printf(
+		"aws: %s/%s request (attempt %d):\n%s",
+		c.Service, r.Operation, r.Attempt, redactBody(dump, r.Params),
+	)
+}
+
+func (c *Context) logResponse(r *Request) {
+	if !c.logging(LogHeaders | LogBodies) {
+		return
+	}
+
+	body := c.logging(LogBodies)
+	resp := new(http.Response)
+	*resp = *r.HTTPResponse
+	resp.Header = redactHeader(resp.Header, sensitiveNames(r.Data))
+	if body {
+		b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+		_ = r.HTTPResponse.Body.Close()
+		if err != nil {
+			r.Error = err
+			return
+		}
+		r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(b))
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+		// log compressed bodies decompressed, so they can be read and
+		// redacted; the response itself is decompressed by aws.Decompress
+		if encoding := responseEncoding(r); encoding != "" {
+			d, err := ioutil.ReadAll(&decompressingBody{body: ioutil.NopCloser(bytes.NewReader(b)), encoding: encoding})
+			if err == nil {
+				resp.Header.Del("Content-Encoding")
+				resp.ContentLength = int64(len(d))
+				resp.Body = ioutil.NopCloser(bytes.NewReader(d))
+			}
+		}
+	}
+
+	dump, err := httputil.DumpResponse(resp, body)
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	c.Logger.Printf(
+		"aws: %s/%s response (attempt %d):\n%s",
+		c.Service, r.Operation, r.Attempt, redactBody(dump, r.Data),
+	)
+}
+
+func (c *Context) logSigning(canonicalRequest, stringToSign, securityToken string) {
+	if securityToken != "" {
+		// presigned requests carry the token escaped in their query
+		canonicalRequest = strings.Replace(canonicalRequest, url.QueryEscape(securityToken), redacted, -1)
+		canonicalRequest = strings.Replace(canonicalRequest, securityToken, redacted, -1)
+	}
+	c.Logger.Printf(
+		"aws: %s canonical request:\n%s\n\nstring to sign:\n%s",
+		c.Service, canonicalRequest, stringToSign,
+	)
+}
+
+func redactHeader(h http.Header, sensitive map[string]bool) http.Header {
+	h = cloneHeader(h)
+	for _, name := range sensitiveHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, redacted)
+		}
+	}
+	for name := range h {
+		if sensitive[strings.ToLower(name)] {
+			h.Set(name, redacted)
+		}
+	}
+	return h
+}
+
+func cloneHeader(h http.Header) http.Header {
+	h2 := make(http.Header, len(h))
+	for k, v := range h {
+		h2[k] = append([]string(nil), v...)
+	}
+	return h2
+}
+
+// redactQuery redacts the values of sensitive members from a query string,
+// leaving its other parameters as they are.
+func redactQuery(rawQuery string, sensitive map[string]bool) string {
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		name := param
+		if j := strings.Index(param, "="); j >= 0 {
+			name = param[:j]
+		}
+		if k, err := url.QueryUnescape(name); err == nil && sensitive[strings.ToLower(k)] {
+			params[i] = name + "=" + url.QueryEscape(redacted)
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// redactBody redacts the values of sensitive members from the body of an HTTP
+// dump.
+func redactBody(dump []byte, v interface{}) []byte {
+	sensitive := sensitiveNames(v)
+	if len(sensitive) == 0 {
+		return dump
+	}
+
+	i := bytes.Index(dump, []byte("\r\n\r\n"))
+	if i < 0 {
+		return dump
+	}
+	head, body := dump[:i+4], dump[i+4:]
+	if len(body) == 0 {
+		return dump
+	}
+
+	switch {
+	case body[0] == '{':
+		var doc interface{}
+		if err := json.Unmarshal(body, &doc); err != nil {
+			return dump
+		}
+		redactJSON(doc, sensitive)
+		b, err := json.Marshal(doc)
+		if err != nil {
+			return dump
+		}
+		body = b
+	case body[0] == '<':
+		body = xmlElement.ReplaceAllFunc(body, func(e []byte) []byte {
+			m := xmlElement.FindSubmatch(e)
+			if !sensitive[strings.ToLower(string(m[1]))] {
+				return e
+			}
+			return []byte("<" + string(m[1]) + ">" + redacted + "</" + string(m[1]) + ">")
+		})
+	default:
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return dump
+		}
+		for k := range form {
+			name := k
+			if i := strings.LastIndex(name, "."); i >= 0 {
+				name = name[i+1:]
+			}
+			if sensitive[strings.ToLower(name)] || sensitive[strings.ToLower(k)] {
+				form.Set(k, redacted)
+			}
+		}
+		body = []byte(form.Encode())
+	}
+
+	return append(head[:len(head):len(head)], body...)
+}
+
+var xmlElement = regexp.MustCompile(`<([\w:-]+)>([^<]*)</[\w:-]+>`)
+
+func redactJSON(doc interface{}, sensitive map[string]bool) {
+	switch doc := doc.(type) {
+	case map[string]interface{}:
+		for k, v := range doc {
+			if sensitive[strings.ToLower(k)] {
+				doc[k] = redacted
+			} else {
+				redactJSON(v, sensitive)
+			}
+		}
+	case []interface{}:
+		for _, v := range doc {
+			redactJSON(v, sensitive)
+		}
+	}
+}
+
+// sensitiveNames returns the lower-cased wire names of the members of the
+// given value's type which are tagged as sensitive.
+func sensitiveNames(v interface{}) map[string]bool {
+	names := map[string]bool{}
+	if v != nil {
+		collectSensitiveNames(reflect.TypeOf(v), names, map[reflect.Type]bool{})
+	}
+	return names
+}
+
+func collectSensitiveNames(t reflect.Type, names map[string]bool, seen map[reflect.Type]bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || seen[t] {
+		return
+	}
+	seen[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if name := f.Tag.Get("sensitive"); name != "" {
+			names[strings.ToLower(name)] = true
+		}
+		collectSensitiveNames(f.Type, names, seen)
+	}
+}
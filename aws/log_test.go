@@ -0,0 +1,190 @@
+package aws_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+type fakeSensitiveRequest struct {
+	Name     string `json:"Name"`
+	Password string `json:"Password" sensitive:"Password"`
+}
+
+type fakeSensitiveResponse struct {
+	Secret string `json:"Secret" sensitive:"Secret"`
+}
+
+func TestLogRedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `{"Secret":"hunter3"}`)
+		},
+	))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", "securityToken"),
+			Logger:      log.New(&buf, "", 0),
+			LogLevel:    aws.LogBodies | aws.LogSigning,
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+
+	req := fakeSensitiveRequest{Name: "spot", Password: "hunter2"}
+	var resp fakeSensitiveResponse
+	if err := client.Do("PetTheDog", "POST", "/", req, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.Secret, "hunter3"; v != want {
+		t.Errorf("Secret was %v but expected %v", v, want)
+	}
+
+	out := buf.String()
+	for _, s := range []string{"hunter2", "hunter3", "securityToken", "Signature="} {
+		if strings.Contains(out, s) {
+			t.Errorf("Log contained %q:\n%s", s, out)
+		}
+	}
+
+	for _, s := range []string{
+		"animals/PetTheDog request (attempt 1)",
+		"animals/PetTheDog response (attempt 1)",
+		`"Name":"spot"`,
+		"string to sign:\nAWS4-HMAC-SHA256",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("Log didn't contain %q:\n%s", s, out)
+		}
+	}
+}
+
+func TestLogRedactsSensitiveQueryParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `<Thing><IpAddress>woo</IpAddress></Thing>`)
+		},
+	))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := aws.QueryClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+			Logger:      log.New(&buf, "", 0),
+			LogLevel:    aws.LogBodies,
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+	}
+
+	req := struct {
+		Password aws.StringValue `query:"Password" sensitive:"Password"`
+	}{aws.String("hunter2")}
+
+	var resp fakeQueryResponse
+	if err := client.Do("GetIP", "POST", "/", &req, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "hunter2") {
+		t.Errorf("Log contained the password:\n%s", out)
+	}
+
+	if !strings.Contains(out, "Password=%5BREDACTED%5D") {
+		t.Errorf("Log didn't contain the redacted password:\n%s", out)
+	}
+}
+
+func TestLogRedactsSensitiveQueryStringMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `woo`)
+		},
+	))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := aws.RestClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+			Logger:      log.New(&buf, "", 0),
+			LogLevel:    aws.LogHeaders,
+		},
+		Client: http.DefaultClient,
+	}
+
+	params := struct {
+		Password aws.StringValue `xml:"-" sensitive:"x-password"`
+	}{aws.String("hunter2")}
+
+	req, err := http.NewRequest("GET", server.URL+"/yay?name=spot&x-password=hunter2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.DoOperation("GetDog", req, &params, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	out := buf.String()
+	if strings.Contains(out, "hunter2") {
+		t.Errorf("Log contained the password:\n%s", out)
+	}
+
+	if !strings.Contains(out, "/yay?name=spot&x-password=%5BREDACTED%5D") {
+		t.Errorf("Log didn't contain the redacted password:\n%s", out)
+	}
+}
+
+func TestLogDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `{}`)
+		},
+	))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+			Logger:      log.New(&buf, "", 0),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+
+	if err := client.Do("PetTheDog", "POST", "/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("Logged with no log level:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,76 @@
+package aws_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestAppendJSONString(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"plain",
+		"<script>&amp;</script>",
+		"quote \" backslash \\ slash /",
+		"\b\f\n\r\t\x00\x1f\x7f",
+		"line para ",
+		"invalid \xff\xfe UTF-8 \xe2\x82",
+		"héllo, 世界 🌍",
+	} {
+		expected, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := aws.AppendJSONString([]byte("x"), s); string(actual) != "x"+string(expected) {
+			t.Errorf("%q was encoded as %s, but expected %s", s, actual[1:], expected)
+		}
+	}
+}
+
+func TestAppendJSONFloat(t *testing.T) {
+	for _, f := range []float64{0, math.Copysign(0, -1), 1, -1.5, 0.1, 1e-6, 1e-7, 123456789, 1e20, 1e21, -1e21, 1e-300, math.MaxFloat64} {
+		expected, err := json.Marshal(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := aws.AppendJSONFloat(nil, f, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != string(expected) {
+			t.Errorf("%v was encoded as %s, but expected %s", f, actual, expected)
+		}
+
+		expected, err = json.Marshal(float32(f))
+		if err != nil {
+			continue // out of range
+		}
+		actual, err = aws.AppendJSONFloat(nil, float64(float32(f)), 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != string(expected) {
+			t.Errorf("float32 %v was encoded as %s, but expected %s", f, actual, expected)
+		}
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := aws.AppendJSONFloat(nil, f, 64); err == nil {
+			t.Errorf("%v was encoded, but expected an error", f)
+		}
+	}
+}
+
+func TestAppendJSONBytes(t *testing.T) {
+	for _, p := range [][]byte{nil, {}, []byte("foo")} {
+		expected, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := aws.AppendJSONBytes(nil, p); string(actual) != string(expected) {
+			t.Errorf("%q was encoded as %s, but expected %s", p, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+)
+
+// ResponseMetadata describes the response to a request.
+type ResponseMetadata struct {
+	StatusCode int
+
+	// RequestID identifies the request to AWS Support.
+	RequestID string
+
+	// ExtendedRequestID is S3's host ID (x-amz-id-2), which AWS Support needs
+	// along with the request ID for S3 requests.
+	ExtendedRequestID string
+
+	Header http.Header
+}
+
+type responseMetadataKey struct{}
+
+// WithResponseMetadata returns a copy of the context which has requests made
+// with it, e.g. by a generated client's WithContext methods, record the
+// metadata of their final response in md. Unlike their output, it's also
+// recorded for requests which fail with an API error.
+func WithResponseMetadata(ctx context.Context, md *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, md)
+}
+
+// headerRequestID returns the request ID from the response's headers.
+func headerRequestID(h http.Header) string {
+	if id := h.Get("X-Amzn-RequestId"); id != "" {
+		return id
+	}
+	return h.Get("X-Amz-Request-Id")
+}
+
+// recordMetadata records the metadata of the request's response, if its
+// context asks for it.
+func recordMetadata(r *Request) {
+	md, ok := r.Context().Value(responseMetadataKey{}).(*ResponseMetadata)
+	if !ok || r.HTTPResponse == nil {
+		return
+	}
+
+	resp := r.HTTPResponse
+	*md = ResponseMetadata{
+		StatusCode:        resp.StatusCode,
+		RequestID:         headerRequestID(resp.Header),
+		ExtendedRequestID: resp.Header.Get("X-Amz-Id-2"),
+		Header:            resp.Header,
+	}
+	if md.RequestID == "" {
+		md.RequestID = r.requestID
+	}
+}
+
+// decodeXMLWithRequestID decodes the XML body into v, which may be nil, and
+// returns the text of the element at the given path below the root element,
+// which is read in the same pass.
+func decodeXMLWithRequestID(body io.Reader, v interface{}, path ...string) (string, error) {
+	if v == nil {
+		v = &struct{}{}
+	}
+	t := &requestIDTokenReader{d: xml.NewDecoder(body), path: path}
+	err := xml.NewTokenDecoder(t).Decode(v)
+	return t.requestID, err
+}
+
+// A requestIDTokenReader passes on its decoder's tokens, noting the text of
+// the element at its path below the root element as they go by.
+type requestIDTokenReader struct {
+	d         *xml.Decoder
+	path      []string
+	stack     []string
+	requestID string
+}
+
+func (t *requestIDTokenReader) Token() (xml.Token, error) {
+	tok, err := t.d.Token()
+	switch tok := tok.(type) {
+	case xml.StartElement:
+		t.stack = append(t.stack, tok.Name.Local)
+	case xml.EndElement:
+		if len(t.stack) != 0 {
+			t.stack = t.stack[:len(t.stack)-1]
+		}
+	case xml.CharData:
+		if t.atPath() {
+			t.requestID += string(tok)
+		}
+	}
+	return tok, err
+}
+
+func (t *requestIDTokenReader) atPath() bool {
+	if len(t.stack) != len(t.path)+1 {
+		return false
+	}
+	for i, name := range t.path {
+		if t.stack[i+1] != name {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+package aws_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestResponseMetadataFromHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Amzn-RequestId", "abc")
+			fmt.Fprintln(w, `{}`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+
+	var md aws.ResponseMetadata
+	ctx := aws.WithResponseMetadata(context.Background(), &md)
+	if err := client.DoWithContext(ctx, "PetTheDog", "POST", "/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := md.RequestID, "abc"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+
+	if v, want := md.StatusCode, 200; v != want {
+		t.Errorf("Status code was %v but expected %v", v, want)
+	}
+
+	if v, want := md.Header.Get("Content-Type"), "text/plain; charset=utf-8"; v != want {
+		t.Errorf("Content type was %v but expected %v", v, want)
+	}
+}
+
+func TestResponseMetadataFromQueryBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `<GetIPResponse><GetIPResult><IpAddress>woo</IpAddress></GetIPResult><ResponseMetadata><RequestId>def</RequestId></ResponseMetadata></GetIPResponse>`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.QueryClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+	}
+
+	var resp struct {
+		IPAddress string `xml:"GetIPResult>IpAddress"`
+	}
+	var md aws.ResponseMetadata
+	ctx := aws.WithResponseMetadata(context.Background(), &md)
+	if err := client.DoWithContext(ctx, "GetIP", "POST", "/", nil, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.IPAddress, "woo"; v != want {
+		t.Errorf("IP address was %v but expected %v", v, want)
+	}
+
+	if v, want := md.RequestID, "def"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+}
+
+func TestResponseMetadataFromEC2Body(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `<DescribeDogsResponse><requestId>ghi</requestId></DescribeDogsResponse>`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.EC2Client{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+	}
+
+	var md aws.ResponseMetadata
+	ctx := aws.WithResponseMetadata(context.Background(), &md)
+	if err := client.DoWithContext(ctx, "DescribeDogs", "POST", "/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := md.RequestID, "ghi"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+}
+
+func TestResponseMetadataFromNamespacedEC2Body(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `<DescribeDogsResponse xmlns="http://ec2.amazonaws.com/doc/2014-10-01/"><dogSet><item><requestId>decoy</requestId><name>spot</name></item></dogSet><requestId>jkl</requestId></DescribeDogsResponse>`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.EC2Client{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+	}
+
+	var resp struct {
+		Names []string `xml:"dogSet>item>name"`
+	}
+	var md aws.ResponseMetadata
+	ctx := aws.WithResponseMetadata(context.Background(), &md)
+	if err := client.DoWithContext(ctx, "DescribeDogs", "POST", "/", nil, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.Names, []string{"spot"}; !reflect.DeepEqual(v, want) {
+		t.Errorf("Names were %v but expected %v", v, want)
+	}
+
+	// only the root element's request ID is the response's
+	if v, want := md.RequestID, "jkl"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+}
+
+func TestResponseMetadataOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Amz-Request-Id", "jkl")
+			w.Header().Set("X-Amz-Id-2", "mno")
+			w.WriteHeader(404)
+		},
+	))
+	defer server.Close()
+
+	client := aws.RestClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client: http.DefaultClient,
+	}
+
+	var md aws.ResponseMetadata
+	ctx := aws.WithResponseMetadata(context.Background(), &md)
+	req, err := http.NewRequestWithContext(ctx, "GET", server.URL+"/dogs/spot", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Do(req); !aws.IsNotFound(err) {
+		t.Errorf("Error was %v but expected a not found error", err)
+	}
+
+	if v, want := md.StatusCode, 404; v != want {
+		t.Errorf("Status code was %v but expected %v", v, want)
+	}
+
+	if v, want := md.RequestID, "jkl"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+
+	if v, want := md.ExtendedRequestID, "mno"; v != want {
+		t.Errorf("Extended request ID was %v but expected %v", v, want)
+	}
+}
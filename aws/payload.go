@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// PayloadSigning selects how the bodies of signed requests are signed.
+type PayloadSigning int
+
+const (
+	// SignedPayload signs the SHA-256 hash of the body. Seekable bodies (e.g.
+	// *os.File) and bodies which can be read again (see
+	// http.Request.GetBody) are hashed in a streaming pass, and seekable
+	// bodies are rewound rather than closed so they can be retried. Other
+	// bodies are buffered in memory.
+	SignedPayload PayloadSigning = iota
+
+	// UnsignedPayload leaves bodies unsigned, relying on TLS for their
+	// integrity, so bodies are never read ahead of sending. Requests which
+	// aren't made over HTTPS are signed as with SignedPayload.
+	UnsignedPayload
+
+	// StreamingPayload signs bodies in chunks as they're sent, using the
+	// aws-chunked content encoding. Bodies are never read ahead of sending,
+	// but their length must be known. Only S3 supports this.
+	StreamingPayload
+)
+
+const (
+	emptyPayloadHash     = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	unsignedPayloadHash  = "UNSIGNED-PAYLOAD"
+	streamingPayloadHash = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+	// streamingChunkSize is the size of each chunk of a streamed payload,
+	// apart from the last.
+	streamingChunkSize = 64 * 1024
+)
+
+// ErrUnknownContentLength is returned when a streamed payload's length isn't
+// known.
+var ErrUnknownContentLength = errors.New("aws: streamed payloads must have a known content length")
+
+// payloadHash prepares the request's body for signing, and returns the value
+// of its x-amz-content-sha256 header.
+func (c *Context) payloadHash(r *http.Request) (string, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return emptyPayloadHash, nil
+	}
+
+	switch c.PayloadSigning {
+	case UnsignedPayload:
+		if r.URL.Scheme == "https" {
+			return unsignedPayloadHash, nil
+		}
+	case StreamingPayload:
+		return streamingPayloadHash, prepareStreamingBody(r)
+	}
+	return c.hashContent(r)
+}
+
+func (c *Context) hashContent(r *http.Request) (string, error) {
+	// If the payload is empty, use the empty string as the input to the SHA256 function
+	// http://docs.amazonwebservices.com/general/latest/gr/sigv4-create-canonical-request.html
+	if r.Body == nil || r.Body == http.NoBody {
+		return emptyPayloadHash, nil
+	}
+
+	h := sha256.New()
+	if err := hashBody(r, h); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashBody writes the request's body to the hash, leaving the body ready to be
+// sent. Seekable bodies and bodies which can be read again are read in a
+// streaming pass, and other bodies are buffered in memory.
+func hashBody(r *http.Request, h io.Writer) error {
+	if body, ok := r.Body.(io.ReadSeeker); ok {
+		start, err := body.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(h, body); err != nil {
+			return err
+		}
+		if _, err := body.Seek(start, io.SeekStart); err != nil {
+			return err
+		}
+		rewindable(r, body, start)
+	} else if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(h, body)
+		_ = body.Close()
+		if err != nil {
+			return err
+		}
+		// GetBody may share state with the request's body, as when rewinding
+		// a seekable body
+		if err := rewindBody(r); err != nil {
+			return err
+		}
+	} else {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(b)), nil
+		}
+		_, _ = h.Write(b)
+	}
+
+	return nil
+}
+
+// rewindable replaces the request's seekable body with one which isn't closed
+// once sent, and which GetBody rewinds to the given offset for retries.
+func rewindable(r *http.Request, body io.ReadSeeker, start int64) {
+	r.Body = ioutil.NopCloser(body)
+	r.GetBody = func() (io.ReadCloser, error) {
+		_, err := body.Seek(start, io.SeekStart)
+		return ioutil.NopCloser(body), err
+	}
+}
+
+// prepareStreamingBody sets the headers of a request whose body will be sent
+// with the aws-chunked encoding.
+func prepareStreamingBody(r *http.Request) error {
+	// a retried request already carries the encoded length
+	n := r.ContentLength
+	if s := r.Header.Get("X-Amz-Decoded-Content-Length"); s != "" {
+		var err error
+		if n, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return err
+		}
+	}
+
+	if body, ok := r.Body.(io.ReadSeeker); ok {
+		start, err := body.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		if n <= 0 {
+			end, err := body.Seek(0, io.SeekEnd)
+			if err != nil {
+				return err
+			}
+			if _, err := body.Seek(start, io.SeekStart); err != nil {
+				return err
+			}
+			n = end - start
+		}
+		rewindable(r, body, start)
+	}
+	if n <= 0 {
+		return ErrUnknownContentLength
+	}
+
+	if enc := r.Header.Get("Content-Encoding"); enc == "" {
+		r.Header.Set("Content-Encoding", "aws-chunked")
+	} else if !strings.HasPrefix(enc, "aws-chunked") {
+		r.Header.Set("Content-Encoding", "aws-chunked,"+enc)
+	}
+	r.Header.Set("X-Amz-Decoded-Content-Length", strconv.FormatInt(n, 10))
+
+	// Content-Length is only written from r.ContentLength, but is signed from
+	// the header
+	r.ContentLength = streamingLength(n)
+	r.Header.Set("Content-Length", strconv.FormatInt(r.ContentLength, 10))
+	return nil
+}
+
+// streamingLength returns the encoded length of a payload of the given length.
+func streamingLength(n int64) int64 {
+	full, rest := n/streamingChunkSize, n%streamingChunkSize
+	length := full * chunkLength(streamingChunkSize)
+	if rest > 0 {
+		length += chunkLength(rest)
+	}
+	return length + chunkLength(0)
+}
+
+// chunkLength returns the encoded length of a chunk of the given length.
+func chunkLength(n int64) int64 {
+	return int64(len(strconv.FormatInt(n, 16))+len(";chunk-signature=")+64+len("\r\n")) +
+		n + int64(len("\r\n"))
+}
+
+// A chunkedBody encodes a payload with the aws-chunked encoding, signing each
+// chunk with the signature of the one before, starting with the request's.
+type chunkedBody struct {
+	body      io.ReadCloser
+	key       []byte
+	date      string
+	scope     string
+	signature string
+
+	chunk   []byte
+	encoded bytes.Buffer
+	done    bool
+}
+
+func newChunkedBody(body io.ReadCloser, key []byte, date, scope, signature string) *chunkedBody {
+	return &chunkedBody{
+		body:      body,
+		key:       key,
+		date:      date,
+		scope:     scope,
+		signature: signature,
+		chunk:     make([]byte, streamingChunkSize),
+	}
+}
+
+func (b *chunkedBody) Read(p []byte) (int, error) {
+	for b.encoded.Len() == 0 {
+		if b.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(b.body, b.chunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		b.writeChunk(b.chunk[:n])
+		b.done = n == 0
+	}
+	return b.encoded.Read(p)
+}
+
+func (b *chunkedBody) Close() error {
+	return b.body.Close()
+}
+
+func (b *chunkedBody) writeChunk(chunk []byte) {
+	h := hmac.New(sha256.New, b.key)
+	_, _ = io.WriteString(h, "AWS4-HMAC-SHA256-PAYLOAD")
+	_, _ = io.WriteString(h, lf)
+	_, _ = io.WriteString(h, b.date)
+	_, _ = io.WriteString(h, lf)
+	_, _ = io.WriteString(h, b.scope)
+	_, _ = io.WriteString(h, lf)
+	_, _ = io.WriteString(h, b.signature)
+	_, _ = io.WriteString(h, lf)
+	_, _ = io.WriteString(h, emptyPayloadHash)
+	_, _ = io.WriteString(h, lf)
+	sum := sha256.Sum256(chunk)
+	_, _ = io.WriteString(h, hex.EncodeToString(sum[:]))
+	b.signature = hex.EncodeToString(h.Sum(nil))
+
+	fmt.Fprintf(&b.encoded, "%x;chunk-signature=%s\r\n", len(chunk), b.signature)
+	_, _ = b.encoded.Write(chunk)
+	_, _ = b.encoded.WriteString("\r\n")
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MaxPresignExpiry is the longest a presigned URL can be valid for.
+const MaxPresignExpiry = 7 * 24 * time.Hour
+
+// ErrPresignExpiry is returned when a presigned URL's expiry is not positive
+// or is longer than MaxPresignExpiry.
+var ErrPresignExpiry = errors.New("aws: presigned URLs must expire within 7 days")
+
+// ErrPresignUnsupported is returned when presigning a request for a client
+// which doesn't use Signature Version 4.
+var ErrPresignUnsupported = errors.New("aws: only Signature Version 4 requests can be presigned")
+
+// Presign returns the request's URL, signed with query parameters which expire
+// after the given duration. Anyone holding the URL can make the request
+// without credentials until it expires, provided they send the same method and
+// any headers set on the request, apart from User-Agent and Accept-Encoding.
+//
+// S3 URLs are signed without their payload, so any body may be sent with
+// them. For other services the request's body is signed, and is normally
+// empty.
+func (c *Context) Presign(r *http.Request, expires time.Duration) (string, error) {
+	if _, v4 := c.Signer.(V4Signer); c.Signer != nil && !v4 {
+		return "", ErrPresignUnsupported
+	}
+
+	if expires <= 0 || expires > MaxPresignExpiry {
+		return "", ErrPresignExpiry
+	}
+
+	creds, err := credentialsWithContext(r.Context(), c.Credentials)
+	if err != nil {
+		return "", err
+	}
+
+	chash := unsignedPayloadHash
+	if c.Service != "s3" {
+		if chash, err = c.hashContent(r); err != nil {
+			return "", err
+		}
+	}
+
+	// sign a copy, with the headers which would otherwise carry the signature
+	// moved into the query
+	t := c.now().UTC()
+	u := *r.URL
+	presigned := new(http.Request)
+	*presigned = *r
+	presigned.URL = &u
+	presigned.Header = cloneHeader(r.Header)
+	for _, name := range []string{
+		"Authorization", "User-Agent", "Accept-Encoding", "X-Amz-Date",
+		"X-Amz-Content-Sha256", "X-Amz-Security-Token",
+	} {
+		presigned.Header.Del(name)
+	}
+	presigned.Header.Set("host", r.Host)
+
+	q := u.Query()
+	q.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	q.Set("X-Amz-Credential", creds.AccessKeyID+"/"+c.creds(t))
+	q.Set("X-Amz-Date", t.Format(iso8601BasicFormat))
+	q.Set("X-Amz-Expires", strconv.FormatInt(int64(expires/time.Second), 10))
+	if s := creds.SecurityToken; s != "" {
+		q.Set("X-Amz-Security-Token", s)
+	}
+	signedHeaders := new(bytes.Buffer)
+	c.writeHeaderList(signedHeaders, presigned)
+	q.Set("X-Amz-SignedHeaders", signedHeaders.String())
+	u.RawQuery = q.Encode()
+
+	h := hmac.New(sha256.New, c.signature(creds.SecretAccessKey, t))
+	c.writeStringToSign(h, t, presigned, chash)
+
+	if c.logging(LogSigning) {
+		canonicalRequest, stringToSign := new(bytes.Buffer), new(bytes.Buffer)
+		c.writeRequest(canonicalRequest, presigned, chash)
+		c.writeStringToSign(stringToSign, t, presigned, chash)
+		c.logSigning(canonicalRequest.String(), stringToSign.String(), creds.SecurityToken)
+	}
+
+	q.Set("X-Amz-Signature", fmt.Sprintf("%x", h.Sum(nil)))
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// presignForm presigns a built form-encoded request, moving its parameters
+// from the body into the URL's query so it can be made with a GET.
+func (c *Context) presignForm(r *Request, expires time.Duration) (string, error) {
+	if r.Error != nil {
+		return "", r.Error
+	}
+
+	httpReq := r.HTTPRequest
+	if httpReq.Body != nil {
+		b, err := ioutil.ReadAll(httpReq.Body)
+		if err != nil {
+			return "", err
+		}
+		_ = httpReq.Body.Close()
+
+		q := httpReq.URL.Query()
+		form, err := url.ParseQuery(string(b))
+		if err != nil {
+			return "", err
+		}
+		for k, v := range form {
+			q[k] = v
+		}
+		httpReq.URL.RawQuery = q.Encode()
+	}
+
+	httpReq.Method = "GET"
+	httpReq.Body = nil
+	httpReq.GetBody = nil
+	httpReq.ContentLength = 0
+	httpReq.Header.Del("Content-Type")
+	return c.Presign(httpReq, expires)
+}
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultProcessTimeout is how long a ProcessProvider's command may run,
+// unless it says otherwise.
+const DefaultProcessTimeout = time.Minute
+
+// A ProcessProvider provides the credentials printed by a command, following
+// the credential_process protocol: a JSON object with a Version of 1, an
+// AccessKeyId, a SecretAccessKey, and optionally a SessionToken and an ISO
+// 8601 Expiration. The credentials are cached until they expire, or forever
+// if they don't.
+type ProcessProvider struct {
+	// Command is the command line, which is run by the shell.
+	Command string
+
+	// Timeout is how long the command may run. If zero,
+	// DefaultProcessTimeout is used.
+	Timeout time.Duration
+
+	// ExpiryWindow is how long before they expire credentials are refreshed.
+	ExpiryWindow time.Duration
+
+	// Clock tells the time the credentials expire by. If nil, the system
+	// clock is used.
+	Clock Clock
+
+	m          sync.Mutex
+	creds      *Credentials
+	expiration time.Time
+}
+
+// ProcessCreds returns a provider of the credentials printed by the command.
+func ProcessCreds(command string) *ProcessProvider {
+	return &ProcessProvider{Command: command}
+}
+
+// A ProcessError is returned when a ProcessProvider's command fails, or
+// prints something other than credentials.
+type ProcessError struct {
+	Command string
+	Err     error
+
+	// Stderr is what the command printed to standard error, if anything.
+	Stderr string
+}
+
+func (e *ProcessError) Error() string {
+	msg := fmt.Sprintf("aws: credential_process %q: %v", e.Command, e.Err)
+	if e.Stderr != "" {
+		msg += ": " + e.Stderr
+	}
+	return msg
+}
+
+func (e *ProcessError) Unwrap() error {
+	return e.Err
+}
+
+// Credentials returns the command's credentials, running it again if they've
+// expired.
+func (p *ProcessProvider) Credentials() (*Credentials, error) {
+	return p.CredentialsWithContext(context.Background())
+}
+
+// CredentialsWithContext is like Credentials, but kills the command and
+// returns the context's error if it is done before the command exits.
+func (p *ProcessProvider) CredentialsWithContext(ctx context.Context) (*Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.creds != nil && (p.expiration.IsZero() || clockNow(p.Clock).Before(p.expiration.Add(-p.ExpiryWindow))) {
+		creds := *p.creds
+		return &creds, nil
+	}
+
+	out, err := p.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		Version         int
+		AccessKeyID     string `json:"AccessKeyId"`
+		SecretAccessKey string
+		SessionToken    string
+		Expiration      time.Time
+	}
+	if err := json.Unmarshal(out, &body); err != nil {
+		return nil, &ProcessError{Command: p.Command, Err: fmt.Errorf("decoding output: %w", err)}
+	}
+	switch {
+	case body.Version != 1:
+		return nil, &ProcessError{Command: p.Command, Err: fmt.Errorf("unsupported version %d", body.Version)}
+	case body.AccessKeyID == "":
+		return nil, &ProcessError{Command: p.Command, Err: errors.New("no AccessKeyId in output")}
+	case body.SecretAccessKey == "":
+		return nil, &ProcessError{Command: p.Command, Err: errors.New("no SecretAccessKey in output")}
+	}
+
+	p.creds = &Credentials{
+		AccessKeyID:     body.AccessKeyID,
+		SecretAccessKey: body.SecretAccessKey,
+		SecurityToken:   body.SessionToken,
+	}
+	p.expiration = body.Expiration
+	creds := *p.creds
+	return &creds, nil
+}
+
+// run runs the command, returning what it prints to standard output.
+func (p *ProcessProvider) run(ctx context.Context) ([]byte, error) {
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = DefaultProcessTimeout
+	}
+	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(cmdCtx, "cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(cmdCtx, "/bin/sh", "-c", p.Command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	// don't wait for the output of any children the shell leaves behind
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if cmdCtx.Err() != nil {
+			err = fmt.Errorf("timed out after %v", timeout)
+		}
+		return nil, &ProcessError{Command: p.Command, Err: err, Stderr: strings.TrimSpace(stderr.String())}
+	}
+	return stdout.Bytes(), nil
+}
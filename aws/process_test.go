@@ -0,0 +1,90 @@
+package aws_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestProcessProvider(t *testing.T) {
+	now := time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC)
+	runs := filepath.Join(t.TempDir(), "runs")
+
+	// the command prints the number of times it's been run as the key
+	p := aws.ProcessCreds(`echo x >> ` + runs + ` && printf '{"Version": 1, "AccessKeyId": "ASIA%d", "SecretAccessKey": "secret", "SessionToken": "token", "Expiration": "2015-01-25T09:00:00Z"}' $(wc -l < ` + runs + `)`)
+	p.ExpiryWindow = time.Minute
+	p.Clock = aws.ClockFunc(func() time.Time { return now })
+
+	creds, err := p.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := *creds, (aws.Credentials{AccessKeyID: "ASIA1", SecretAccessKey: "secret", SecurityToken: "token"}); v != want {
+		t.Errorf("Credentials were %+v but expected %+v", v, want)
+	}
+
+	// the credentials are cached until shortly before they expire
+	now = now.Add(58 * time.Minute)
+	if creds, err = p.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "ASIA1"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+
+	now = now.Add(90 * time.Second)
+	if creds, err = p.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "ASIA2"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+}
+
+func TestProcessProviderErrors(t *testing.T) {
+	for command, want := range map[string]string{
+		`echo "token expired; run sso login" >&2; exit 1`:                   "exit status 1: token expired; run sso login",
+		`echo '{"Version": 2, "AccessKeyId": "a", "SecretAccessKey": "s"}'`: "unsupported version 2",
+		`echo '{"Version": 1, "SecretAccessKey": "s"}'`:                     "no AccessKeyId in output",
+		`echo nope`: "decoding output",
+	} {
+		_, err := aws.ProcessCreds(command).Credentials()
+		var perr *aws.ProcessError
+		if !errors.As(err, &perr) || !strings.Contains(err.Error(), want) {
+			t.Errorf("Error for %s was %v but expected a *ProcessError containing %q", command, err, want)
+		}
+	}
+
+	p := aws.ProcessCreds("sleep 10")
+	p.Timeout = 50 * time.Millisecond
+	if _, err := p.Credentials(); err == nil || !strings.Contains(err.Error(), "timed out after 50ms") {
+		t.Errorf("Error was %v but expected a timeout", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := aws.ProcessCreds("sleep 10").CredentialsWithContext(ctx); err != context.Canceled {
+		t.Errorf("Error was %v but expected %v", err, context.Canceled)
+	}
+}
+
+func TestSharedConfigCredentialProcess(t *testing.T) {
+	writeSharedConfig(t, `
+[profile sso]
+credential_process = echo '{"Version": 1, "AccessKeyId": "ssoKey", "SecretAccessKey": "ssoSecret"}'
+`, "")
+	t.Setenv("AWS_PROFILE", "sso")
+
+	creds, err := aws.SharedCreds("", time.Minute).Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := *creds, (aws.Credentials{AccessKeyID: "ssoKey", SecretAccessKey: "ssoSecret"}); v != want {
+		t.Errorf("Credentials were %+v but expected %+v", v, want)
+	}
+}
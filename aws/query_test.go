@@ -129,20 +129,15 @@ func TestQueryRequest(t *testing.T) {
 
 func TestQueryRequestError(t *testing.T) {
 	var m sync.Mutex
-	var httpReq *http.Request
-	var form url.Values
 
 	server := httptest.NewServer(http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			m.Lock()
 			defer m.Unlock()
 
-			httpReq = r
-
 			if err := r.ParseForm(); err != nil {
 				t.Fatal(err)
 			}
-			form = r.Form
 
 			w.WriteHeader(400)
 			fmt.Fprintln(w, `<ErrorResponse>
@@ -224,8 +219,8 @@ type fakeQueryRequest struct {
 	PresentStructSlice []EmbeddedStruct `query:"PresentStructSlice"`
 	MissingStructSlice []EmbeddedStruct `query:"MissingStructSlice"`
 
-	PresentMap map[string]EmbeddedStruct `query:"PresentMap"`
-	MissingMap map[string]EmbeddedStruct `query:"MissingMap"`
+	PresentMap map[string]EmbeddedStruct `query:"PresentMap,map::Name:Value"`
+	MissingMap map[string]EmbeddedStruct `query:"MissingMap,map::Name:Value"`
 
 	PresentStruct *EmbeddedStruct `query:"PresentStruct"`
 	MissingStruct *EmbeddedStruct `query:"MissingStruct"`
@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"io/ioutil"
+	"mime"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 // RestClient is the underlying client for REST-JSON and REST-XML APIs.
@@ -13,68 +17,167 @@ type RestClient struct {
 	Client     *http.Client
 	Endpoint   string
 	APIVersion string
+	Retry      *RetryPolicy
+	Exceptions Exceptions
+
+	// ContentMD5 sets the Content-MD5 header of requests with bodies, as
+	// some S3 operations require.
+	ContentMD5 bool
+
+	handlers     *Handlers
+	handlersOnce sync.Once
+}
+
+// Handlers returns the client's request handlers. By default, these are
+// aws.RestBuild, aws.AcceptEncoding, aws.ContentMD5 (if the client's
+// ContentMD5 is set), aws.Sign, aws.LogRequest, aws.Send, aws.ClockSkew,
+// aws.LogResponse, aws.Decompress and aws.RestValidateResponse. Responses are decoded by the
+// generated clients, so there are no default Unmarshal handlers.
+func (c *RestClient) Handlers() *Handlers {
+	c.handlersOnce.Do(func() {
+		c.handlers = &Handlers{}
+		c.handlers.Build.PushBack(Handler{Name: "aws.RestBuild", Fn: c.build})
+		c.handlers.Build.PushBack(Handler{Name: "aws.AcceptEncoding", Fn: c.Context.setAcceptEncoding})
+		if c.ContentMD5 {
+			c.handlers.Build.PushBack(Handler{Name: "aws.ContentMD5", Fn: c.contentMD5})
+		}
+		c.handlers.Sign.PushBack(Handler{Name: "aws.Sign", Fn: c.Context.signRequest})
+		c.handlers.Sign.PushBack(Handler{Name: "aws.LogRequest", Fn: c.Context.logRequest})
+		c.handlers.Send.PushBack(Handler{Name: "aws.Send", Fn: c.send})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.ClockSkew", Fn: c.Context.updateClockSkew})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.LogResponse", Fn: c.Context.logResponse})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.Decompress", Fn: decompress})
+		c.handlers.ValidateResponse.PushBack(Handler{Name: "aws.RestValidateResponse", Fn: c.validateResponse})
+	})
+	return c.handlers
 }
 
 // Do sends an HTTP request and returns an HTTP response, following policy
-// (e.g. redirects, cookies, auth) as configured on the client.
+// (e.g. redirects, cookies, auth) as configured on the client. Failed requests
+// are retried according to the client's retry policy, which requires the
+// request's body to be rewindable (see http.Request.GetBody). The request,
+// including any retries, is abandoned when its context is done.
 func (c *RestClient) Do(req *http.Request) (*http.Response, error) {
-	req.Header.Set("User-Agent", "aws-go")
-	if err := c.Context.sign(req); err != nil {
+	return c.DoOperation("", req, nil, nil)
+}
+
+// DoOperation is like Do, but names the operation the request is for and
+// carries its input and output, for the client's handlers to inspect.
+func (c *RestClient) DoOperation(op string, req *http.Request, params, data interface{}) (*http.Response, error) {
+	r := &Request{
+		Operation:   op,
+		HTTPMethod:  req.Method,
+		HTTPPath:    req.URL.Path,
+		Params:      params,
+		Data:        data,
+		HTTPRequest: req,
+		ctx:         req.Context(),
+	}
+	if err := c.Handlers().run(r, c.Retry); err != nil {
 		return nil, err
 	}
+	return r.HTTPResponse, nil
+}
+
+// Presign returns the request's URL, signed with query parameters which expire
+// after the given duration. See Context.Presign.
+func (c *RestClient) Presign(req *http.Request, expires time.Duration) (string, error) {
+	r := &Request{
+		HTTPMethod:  req.Method,
+		HTTPPath:    req.URL.Path,
+		HTTPRequest: req,
+		ctx:         req.Context(),
+	}
+	c.Handlers().Build.Run(r)
+	if r.Error != nil {
+		return "", r.Error
+	}
+	return c.Context.Presign(r.HTTPRequest, expires)
+}
+
+func (c *RestClient) build(r *Request) {
+	r.HTTPRequest.Header.Set("User-Agent", c.Context.userAgent())
+}
 
-	resp, err := c.Client.Do(req)
+func (c *RestClient) contentMD5(r *Request) {
+	r.Error = c.Context.setContentMD5(r.HTTPRequest)
+}
+
+func (c *RestClient) send(r *Request) {
+	sendRequest(c.Client, r)
+}
+
+func (c *RestClient) validateResponse(r *Request) {
+	resp := r.HTTPResponse
+	if resp.StatusCode < 400 {
+		return
+	}
+
+	e, unmarshal, err := decodeRestError(resp)
 	if err != nil {
-		return nil, err
+		r.Error = err
+		return
 	}
+	r.Error = c.Context.apiError(r, c.Exceptions, e, unmarshal)
+}
 
-	if resp.StatusCode >= 400 {
-		bodyBytes, err := ioutil.ReadAll(resp.Body)
-		defer resp.Body.Close()
-		if err != nil {
-			return nil, err
+// decodeRestError decodes an error response, returning the function which
+// decodes the fields of its typed exception, if it may have one.
+func decodeRestError(resp *http.Response) (APIError, func(v interface{}) error, error) {
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return APIError{}, nil, err
+	}
+	if len(bodyBytes) == 0 {
+		return APIError{
+			StatusCode: resp.StatusCode,
+		}, nil, nil
+	}
+	var restErr restError
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/json" || strings.HasPrefix(mediaType, "application/x-amz-json"):
+		if err := json.Unmarshal(bodyBytes, &restErr); err != nil {
+			return APIError{}, nil, err
 		}
-		if len(bodyBytes) == 0 {
-			return nil, APIError{
-				StatusCode: resp.StatusCode,
-			}
+		if restErr.Code == "" {
+			// e.g. ResourceNotFoundException:http://internal.amazon.com/coral/com.amazonaws.lambda/
+			restErr.Code = strings.SplitN(resp.Header.Get("X-Amzn-ErrorType"), ":", 2)[0]
 		}
-		var restErr restError
-		switch resp.Header.Get("Content-Type") {
-		case "application/json":
-			if err := json.Unmarshal(bodyBytes, &restErr); err != nil {
-				return nil, err
-			}
-			return nil, restErr.Err(resp.StatusCode)
-		case "application/xml", "text/xml":
-			// AWS XML error documents can have a couple of different formats.
-			// Try each before returning a decode error.
-			var wrappedErr restErrorResponse
-			if err := xml.Unmarshal(bodyBytes, &wrappedErr); err == nil {
-				return nil, wrappedErr.Error.Err(resp.StatusCode)
-			}
-			if err := xml.Unmarshal(bodyBytes, &restErr); err != nil {
-				return nil, err
-			}
-			return nil, restErr.Err(resp.StatusCode)
-		default:
-			return nil, APIError{
-				StatusCode: resp.StatusCode,
-				Message:    string(bodyBytes),
-			}
+		return restErr.Err(resp.StatusCode), func(v interface{}) error {
+			return json.Unmarshal(bodyBytes, v)
+		}, nil
+	case mediaType == "application/xml" || mediaType == "text/xml":
+		unmarshal := func(v interface{}) error {
+			return unmarshalXMLError(bodyBytes, v)
 		}
-	}
 
-	return resp, nil
+		// AWS XML error documents can have a couple of different formats.
+		// Try each before returning a decode error.
+		var wrappedErr restErrorResponse
+		if err := xml.Unmarshal(bodyBytes, &wrappedErr); err == nil {
+			return wrappedErr.Error.Err(resp.StatusCode), unmarshal, nil
+		}
+		if err := xml.Unmarshal(bodyBytes, &restErr); err != nil {
+			return APIError{}, nil, err
+		}
+		return restErr.Err(resp.StatusCode), unmarshal, nil
+	default:
+		return APIError{
+			StatusCode: resp.StatusCode,
+			Message:    string(bodyBytes),
+		}, nil, nil
+	}
 }
 
 type restErrorResponse struct {
-	XMLName xml.Name `xml:"ErrorResponse",json:"-"`
+	XMLName xml.Name `xml:"ErrorResponse" json:"-"`
 	Error   restError
 }
 
 type restError struct {
-	XMLName    xml.Name `xml:"Error",json:"-"`
+	XMLName    xml.Name `xml:"Error" json:"-"`
 	Code       string
 	BucketName string
 	Message    string
@@ -82,7 +185,7 @@ type restError struct {
 	HostID     string
 }
 
-func (e restError) Err(StatusCode int) error {
+func (e restError) Err(StatusCode int) APIError {
 	return APIError{
 		StatusCode: StatusCode,
 		Code:       e.Code,
@@ -94,3 +197,38 @@ func (e restError) Err(StatusCode int) error {
 		},
 	}
 }
+
+// EscapePath escapes a URI parameter as RFC 3986 requires. Slashes are
+// escaped too, unless the parameter is greedy (e.g. an S3 object key), as its
+// segments are path segments of their own.
+func EscapePath(s string, greedy bool) string {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '.' || c == '_' || c == '~' || c == '/' && greedy {
+			b = append(b, c)
+			continue
+		}
+		b = append(b, '%', upperHexDigits[c>>4], upperHexDigits[c&0xf])
+	}
+	return string(b)
+}
+
+const upperHexDigits = "0123456789ABCDEF"
+
+// SplitHeader splits a header's comma-separated list of values, trimming their
+// spaces. RFC 822 timestamps have commas of their own, so if the values are
+// dates, each is rejoined with its day name.
+func SplitHeader(s string, dates bool) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if n := len(values); dates && n > 0 && len(values[n-1]) == 3 {
+			values[n-1] += ", " + v
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"sync"
 	"testing"
 
@@ -82,15 +83,12 @@ func TestRestRequest(t *testing.T) {
 
 func TestRestRequestXMLError(t *testing.T) {
 	var m sync.Mutex
-	var httpReq *http.Request
 
 	server := httptest.NewServer(http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			m.Lock()
 			defer m.Unlock()
 
-			httpReq = r
-
 			w.Header().Set("Content-Type", "application/xml")
 			w.WriteHeader(500)
 			fmt.Fprintln(w, `<Error>
@@ -142,15 +140,12 @@ func TestRestRequestXMLError(t *testing.T) {
 
 func TestRestRequestJSONError(t *testing.T) {
 	var m sync.Mutex
-	var httpReq *http.Request
 
 	server := httptest.NewServer(http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			m.Lock()
 			defer m.Unlock()
 
-			httpReq = r
-
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(500)
 			fmt.Fprintln(w, `{"Code":"bonus", "Message":"the bad thing"}`)
@@ -193,3 +188,40 @@ func TestRestRequestJSONError(t *testing.T) {
 		t.Errorf("Unknown error returned: %#v", err)
 	}
 }
+
+func TestEscapePath(t *testing.T) {
+	for _, test := range []struct {
+		s      string
+		greedy bool
+		want   string
+	}{
+		{"bucket", false, "bucket"},
+		{"a/b c+d", false, "a%2Fb%20c%2Bd"},
+		{"a/b c+d", true, "a/b%20c%2Bd"},
+		{"~-._é?#", true, "~-._%C3%A9%3F%23"},
+	} {
+		if v := aws.EscapePath(test.s, test.greedy); v != test.want {
+			t.Errorf("%q (greedy: %v) was escaped as %q, but expected %q", test.s, test.greedy, v, test.want)
+		}
+	}
+}
+
+func TestSplitHeader(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		dates bool
+		want  []string
+	}{
+		{"a", false, []string{"a"}},
+		{"a, b,c", false, []string{"a", "b", "c"}},
+		{
+			"Sun, 25 Jan 2015 08:00:00 GMT, Mon, 26 Jan 2015 09:30:00 GMT",
+			true,
+			[]string{"Sun, 25 Jan 2015 08:00:00 GMT", "Mon, 26 Jan 2015 09:30:00 GMT"},
+		},
+	} {
+		if v := aws.SplitHeader(test.s, test.dates); !reflect.DeepEqual(v, test.want) {
+			t.Errorf("%q was split into %q, but expected %q", test.s, v, test.want)
+		}
+	}
+}
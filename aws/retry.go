@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// A RetryPolicy describes which failed requests are retried, how many times,
+// and how long to wait between attempts. A nil *RetryPolicy makes exactly one
+// attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made, including the first.
+	MaxAttempts int
+
+	// Delay determines how long to wait before each retry.
+	Delay Backoff
+
+	// Conditions are the failures which are retried. A failure is retried if
+	// it matches any of the conditions.
+	Conditions []RetryCondition
+}
+
+// ShouldRetry returns true if the error matches any of the policy's
+// conditions.
+func (p *RetryPolicy) ShouldRetry(err error) bool {
+	if p == nil || err == nil {
+		return false
+	}
+
+	for _, c := range p.Conditions {
+		if c.Matches(err) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) retry(ctx context.Context, send func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := send()
+		if p == nil || attempt >= p.MaxAttempts || !p.ShouldRetry(err) || ctx.Err() != nil {
+			return err
+		}
+
+		t := time.NewTimer(p.Delay.Duration(attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Backoff is an exponential backoff. The delay after the nth attempt is
+// Base * GrowthFactor^(n-1).
+type Backoff struct {
+	Base         time.Duration
+	GrowthFactor float64
+
+	// Jitter scales Base by a random factor in [0, 1) for each delay.
+	Jitter bool
+}
+
+// Duration returns the delay after the given attempt, starting at 1.
+func (b Backoff) Duration(attempt int) time.Duration {
+	base := float64(b.Base)
+	if b.Jitter {
+		base *= rand.Float64()
+	}
+	return time.Duration(base * math.Pow(b.GrowthFactor, float64(attempt-1)))
+}
+
+// A RetryCondition matches failed requests which should be retried.
+type RetryCondition struct {
+	// Code is the service error code to match. If empty, any code matches.
+	Code string
+
+	// StatusCode is the HTTP status code to match. If zero, any status code
+	// matches.
+	StatusCode int
+
+	// ConnectionError matches requests which failed before a response was
+	// received. If set, Code and StatusCode are ignored.
+	ConnectionError bool
+
+	// Checksum matches responses whose bodies didn't match their checksums
+	// (see ChecksumError). If set, Code and StatusCode are ignored.
+	Checksum bool
+}
+
+// Matches returns true if the error matches the condition.
+func (c RetryCondition) Matches(err error) bool {
+	if c.ConnectionError {
+		var e *url.Error
+		return errors.As(err, &e)
+	}
+	if c.Checksum {
+		var e *ChecksumError
+		return errors.As(err, &e)
+	}
+
+	var e APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	if c.StatusCode != 0 && c.StatusCode != e.StatusCode {
+		return false
+	}
+
+	return c.Code == "" || c.Code == e.Code
+}
+
+// ErrBodyNotRewindable is returned when a request needs to be retried but its
+// body can't be read again.
+var ErrBodyNotRewindable = errors.New("aws: request body can't be rewound for a retry")
+
+// rewindBody resets the request's body to the start of its content.
+func rewindBody(r *http.Request) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	if r.GetBody == nil {
+		return ErrBodyNotRewindable
+	}
+
+	body, err := r.GetBody()
+	if err != nil {
+		return err
+	}
+	r.Body = body
+	return nil
+}
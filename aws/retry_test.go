@@ -0,0 +1,300 @@
+package aws_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+var testRetryPolicy = &aws.RetryPolicy{
+	MaxAttempts: 3,
+	Delay:       aws.Backoff{Base: time.Millisecond, GrowthFactor: 2},
+	Conditions: []aws.RetryCondition{
+		{StatusCode: 503},
+		{Code: "Throttling", StatusCode: 400},
+	},
+}
+
+func TestQueryRequestRetry(t *testing.T) {
+	var m sync.Mutex
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(400)
+				fmt.Fprintln(w, `<ErrorResponse><Error><Code>Throttling</Code></Error></ErrorResponse>`)
+				return
+			}
+			fmt.Fprintln(w, `<Thing><IpAddress>woo</IpAddress></Thing>`)
+		},
+	))
+	defer server.Close()
+
+	client := aws.QueryClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+		Retry:      testRetryPolicy,
+	}
+
+	var resp fakeQueryResponse
+	if err := client.Do("GetIP", "POST", "/", nil, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.IPAddress, "woo"; v != want {
+		t.Errorf("IP address was %v but expected %v", v, want)
+	}
+
+	if v, want := attempts, 2; v != want {
+		t.Errorf("Made %d attempts but expected %d", v, want)
+	}
+}
+
+func TestRetryHTMLError(t *testing.T) {
+	var m sync.Mutex
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			attempts++
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(503)
+			fmt.Fprint(w, `<html><body>503 Service Unavailable</body></html>`)
+		},
+	))
+	defer server.Close()
+
+	jsonClient := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+		Retry:        testRetryPolicy,
+	}
+	queryClient := aws.QueryClient{
+		Context:    jsonClient.Context,
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+		Retry:      testRetryPolicy,
+	}
+
+	for name, do := range map[string]func() error{
+		"JSON":  func() error { return jsonClient.Do("PetTheDog", "POST", "/", fakeJSONRequest{Name: "Penny"}, nil) },
+		"query": func() error { return queryClient.Do("GetIP", "POST", "/", nil, nil) },
+	} {
+		attempts = 0
+		err := do()
+		apiErr, ok := err.(aws.APIError)
+		if !ok {
+			t.Errorf("%s: error was %#v but expected an APIError", name, err)
+			continue
+		}
+		if v, want := apiErr.StatusCode, 503; v != want {
+			t.Errorf("%s: status code was %d but expected %d", name, v, want)
+		}
+		if !strings.Contains(apiErr.Message, "503 Service Unavailable") {
+			t.Errorf("%s: message was %q but expected the response's body", name, apiErr.Message)
+		}
+		if v, want := attempts, 3; v != want {
+			t.Errorf("%s: made %d attempts but expected %d", name, v, want)
+		}
+	}
+}
+
+func TestRestRequestRetryRewindsBody(t *testing.T) {
+	var m sync.Mutex
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			b, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bodies = append(bodies, string(b))
+
+			w.WriteHeader(503)
+		},
+	))
+	defer server.Close()
+
+	client := aws.RestClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client: http.DefaultClient,
+		Retry:  testRetryPolicy,
+	}
+
+	req, err := http.NewRequest("PUT", server.URL+"/yay", strings.NewReader("woof"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Do(req)
+	if err, ok := err.(aws.APIError); !ok || err.StatusCode != 503 {
+		t.Fatalf("Expected a 503 error but was %#v", err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if v, want := strings.Join(bodies, ","), "woof,woof,woof"; v != want {
+		t.Errorf("Bodies were %v but expected %v", v, want)
+	}
+}
+
+func TestRetryDisabled(t *testing.T) {
+	var m sync.Mutex
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			attempts++
+			w.WriteHeader(503)
+		},
+	))
+	defer server.Close()
+
+	client := aws.JSONClient{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:       http.DefaultClient,
+		Endpoint:     server.URL,
+		TargetPrefix: "Animals",
+		JSONVersion:  "1.1",
+	}
+
+	if err := client.Do("PetTheDog", "POST", "/", nil, nil); err == nil {
+		t.Fatal("Expected an error but none was returned")
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if v, want := attempts, 1; v != want {
+		t.Errorf("Made %d attempts but expected %d", v, want)
+	}
+}
+
+func TestRetryContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(503)
+		},
+	))
+	defer server.Close()
+
+	client := aws.EC2Client{
+		Context: aws.Context{
+			Service:     "animals",
+			Region:      "us-west-2",
+			Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		},
+		Client:     http.DefaultClient,
+		Endpoint:   server.URL,
+		APIVersion: "1.1",
+		Retry: &aws.RetryPolicy{
+			MaxAttempts: 2,
+			Delay:       aws.Backoff{Base: time.Hour, GrowthFactor: 1},
+			Conditions:  []aws.RetryCondition{{StatusCode: 503}},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := client.DoWithContext(ctx, "GetIP", "POST", "/", nil, nil)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Error was %#v but expected %#v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRetryConditionJSONCode(t *testing.T) {
+	err := aws.APIError{
+		StatusCode: 400,
+		Type:       "com.amazonaws.dynamodb.v20120810#ThrottlingException",
+		Code:       "ThrottlingException",
+	}
+
+	c := aws.RetryCondition{Code: "ThrottlingException", StatusCode: 400}
+	if !c.Matches(err) {
+		t.Errorf("%#v didn't match %#v", c, err)
+	}
+
+	c = aws.RetryCondition{Code: "ThrottlingException", StatusCode: 500}
+	if c.Matches(err) {
+		t.Errorf("%#v matched %#v", c, err)
+	}
+}
+
+func TestRetryConditionWrappedConnectionError(t *testing.T) {
+	err := fmt.Errorf("sending: %w", &url.Error{Op: "Post", URL: "http://localhost", Err: errors.New("connection refused")})
+
+	c := aws.RetryCondition{ConnectionError: true}
+	if !c.Matches(err) {
+		t.Errorf("%#v didn't match %v", c, err)
+	}
+}
+
+func TestBackoffDuration(t *testing.T) {
+	b := aws.Backoff{Base: 50 * time.Millisecond, GrowthFactor: 2}
+
+	for attempt, want := range []time.Duration{
+		50 * time.Millisecond,
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+	} {
+		if v := b.Duration(attempt + 1); v != want {
+			t.Errorf("Delay after attempt %d was %v but expected %v", attempt+1, v, want)
+		}
+	}
+
+	b.Jitter = true
+	for i := 0; i < 10; i++ {
+		if v := b.Duration(2); v < 0 || v >= 100*time.Millisecond {
+			t.Errorf("Jittered delay was %v but expected [0, 100ms)", v)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/juju/errors"
+)
+
+// ErrRegionNotFound is returned when the region can't be found in the
+// process's environment or the shared config file.
+var ErrRegionNotFound = errors.NotFoundf("region in AWS_REGION, AWS_DEFAULT_REGION or shared config")
+
+// A Session is the configuration shared by a set of clients. Each generated
+// package's NewFromSession function returns a client configured by it.
+type Session struct {
+	Credentials CredentialsProvider
+	Region      string
+
+	// HTTPClient sends requests. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	// Options configure every client made with the session, before the
+	// client's own options.
+	Options []Option
+}
+
+// NewSession returns a session with the credentials found by DetectCreds and
+// the region named by AWS_REGION or AWS_DEFAULT_REGION, or else by the profile
+// in the shared config file (see SharedConfig.Profile). The options configure
+// every client made with the session.
+func NewSession(opts ...Option) (*Session, error) {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	if region == "" {
+		if config, err := LoadSharedConfig(); err == nil {
+			if p, err := config.Profile(""); err == nil {
+				region = p.Region
+			}
+		}
+	}
+	if region == "" {
+		return nil, ErrRegionNotFound
+	}
+
+	return &Session{
+		Credentials: DetectCreds("", "", ""),
+		Region:      region,
+		Options:     opts,
+	}, nil
+}
+
+// ForRegion returns a copy of the session for the given region.
+func (s *Session) ForRegion(region string) *Session {
+	c := *s
+	c.Region = region
+	return &c
+}
+
+// ClientOptions returns the session's options followed by the given ones.
+func (s *Session) ClientOptions(opts []Option) []Option {
+	return append(append([]Option(nil), s.Options...), opts...)
+}
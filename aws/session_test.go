@@ -0,0 +1,78 @@
+package aws_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestNewSession(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "eu-west-1")
+
+	s, err := aws.NewSession(aws.WithUserAgent("myapp/1.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := s.Region, "eu-west-1"; v != want {
+		t.Errorf("Region was %v but expected %v", v, want)
+	}
+
+	creds, err := s.Credentials.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := creds.AccessKeyID, "access"; v != want {
+		t.Errorf("Access key ID was %v but expected %v", v, want)
+	}
+
+	if v, want := len(s.Options), 1; v != want {
+		t.Errorf("Session had %d options but expected %d", v, want)
+	}
+}
+
+func TestNewSessionWithoutRegion(t *testing.T) {
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+
+	if _, err := aws.NewSession(); err != aws.ErrRegionNotFound {
+		t.Errorf("Error was %v but expected %v", err, aws.ErrRegionNotFound)
+	}
+}
+
+func TestSessionForRegion(t *testing.T) {
+	s := &aws.Session{Region: "us-west-2"}
+	c := s.ForRegion("eu-west-1")
+
+	if v, want := c.Region, "eu-west-1"; v != want {
+		t.Errorf("Region was %v but expected %v", v, want)
+	}
+
+	if v, want := s.Region, "us-west-2"; v != want {
+		t.Errorf("Session's region was %v but expected %v", v, want)
+	}
+}
+
+func TestSessionClientOptions(t *testing.T) {
+	s := &aws.Session{
+		Options: make([]aws.Option, 1, 2),
+	}
+	s.Options[0] = aws.WithUserAgent("session")
+
+	a := s.ClientOptions([]aws.Option{aws.WithUserAgent("a")})
+	b := s.ClientOptions([]aws.Option{aws.WithUserAgent("b")})
+
+	if v, want := aws.NewConfig(aws.Config{}, "", a...).UserAgent, "session a"; v != want {
+		t.Errorf("User agent was %v but expected %v", v, want)
+	}
+
+	if v, want := aws.NewConfig(aws.Config{}, "", b...).UserAgent, "session b"; v != want {
+		t.Errorf("User agent was %v but expected %v", v, want)
+	}
+}
@@ -0,0 +1,393 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/vaughan0/go-ini"
+)
+
+// A Profile is a named profile of the shared config and credentials files.
+type Profile struct {
+	Name   string
+	Region string
+
+	// AccessKeyID, SecretAccessKey and SessionToken are the profile's static
+	// credentials, if it has any.
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	// CredentialProcess is the command printing the profile's credentials,
+	// if it has no static ones (see ProcessProvider).
+	CredentialProcess string
+
+	// RoleARN is the role the profile assumes, if any, with the credentials
+	// of the profile named by SourceProfile.
+	RoleARN         string
+	SourceProfile   string
+	ExternalID      string
+	MFASerial       string
+	RoleSessionName string
+	Duration        time.Duration
+
+	// Source is the profile named by SourceProfile, with its own source
+	// resolved in turn.
+	Source *Profile
+}
+
+// SharedConfig is the configuration in the shared config and credentials
+// files: by default, ~/.aws/config and ~/.aws/credentials.
+type SharedConfig struct {
+	// TokenCode is called for the current code of the MFA device with the
+	// given serial number when a profile's role requires one.
+	TokenCode func(mfaSerial string) (string, error)
+
+	files    string
+	sections map[string]ini.Section
+}
+
+// LoadSharedConfig loads the shared config file named by AWS_CONFIG_FILE, or
+// else ~/.aws/config, and the shared credentials file named by
+// AWS_SHARED_CREDENTIALS_FILE, or else ~/.aws/credentials.
+func LoadSharedConfig() (*SharedConfig, error) {
+	configFile := os.Getenv("AWS_CONFIG_FILE")
+	credentialsFile := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if configFile == "" || credentialsFile == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		if configFile == "" {
+			configFile = filepath.Join(home, ".aws", "config")
+		}
+		if credentialsFile == "" {
+			credentialsFile = filepath.Join(home, ".aws", "credentials")
+		}
+	}
+	return LoadSharedConfigFiles(configFile, credentialsFile)
+}
+
+// LoadSharedConfigFiles loads the given shared config and credentials files,
+// either of which may be missing. Profiles are the config file's [default]
+// and [profile name] sections, and the credentials file's [name] sections,
+// whose keys take precedence.
+func LoadSharedConfigFiles(configFile, credentialsFile string) (*SharedConfig, error) {
+	c := &SharedConfig{
+		files:    configFile + " or " + credentialsFile,
+		sections: map[string]ini.Section{},
+	}
+	if err := c.load(configFile, true); err != nil {
+		return nil, err
+	}
+	if err := c.load(credentialsFile, false); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// load merges the file's profiles into the config's.
+func (c *SharedConfig) load(filename string, config bool) error {
+	f, err := ini.LoadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Annotatef(err, "loading %s", filename)
+	}
+
+	for name, section := range f {
+		if config && name != "default" {
+			fields := strings.Fields(name)
+			if len(fields) != 2 || fields[0] != "profile" {
+				continue
+			}
+			name = fields[1]
+		}
+		if name == "" {
+			continue
+		}
+
+		merged := c.sections[name]
+		if merged == nil {
+			merged = ini.Section{}
+			c.sections[name] = merged
+		}
+		for k, v := range section {
+			merged[k] = v
+		}
+	}
+	return nil
+}
+
+// ProfileName returns the name of the profile to use: the given one, or else
+// the one named by AWS_PROFILE, or else "default".
+func ProfileName(name string) string {
+	if name == "" {
+		name = os.Getenv("AWS_PROFILE")
+	}
+	if name == "" {
+		name = "default"
+	}
+	return name
+}
+
+// Profile returns the named profile (see ProfileName), with the source
+// profiles of its role resolved.
+func (c *SharedConfig) Profile(name string) (*Profile, error) {
+	return c.profile(ProfileName(name), nil)
+}
+
+// profile returns the named profile, which is the source of the chain of
+// profiles before it.
+func (c *SharedConfig) profile(name string, chain []string) (*Profile, error) {
+	for i, n := range chain {
+		if n == name {
+			cycle := append(chain[i:len(chain):len(chain)], name)
+			return nil, errors.Errorf("aws: profiles' source_profiles form a cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	s, ok := c.sections[name]
+	if !ok {
+		return nil, errors.NotFoundf("profile %q in %s", name, c.files)
+	}
+
+	p := &Profile{
+		Name:              name,
+		Region:            s["region"],
+		AccessKeyID:       s["aws_access_key_id"],
+		SecretAccessKey:   s["aws_secret_access_key"],
+		SessionToken:      s["aws_session_token"],
+		CredentialProcess: s["credential_process"],
+		RoleARN:           s["role_arn"],
+		SourceProfile:     s["source_profile"],
+		ExternalID:        s["external_id"],
+		MFASerial:         s["mfa_serial"],
+		RoleSessionName:   s["role_session_name"],
+	}
+	if d, ok := s["duration_seconds"]; ok {
+		n, err := strconv.Atoi(d)
+		if err != nil || n <= 0 {
+			return nil, errors.Errorf("aws: profile %q has an invalid duration_seconds: %q", name, d)
+		}
+		p.Duration = time.Duration(n) * time.Second
+	}
+
+	if p.RoleARN == "" {
+		return p, nil
+	}
+	switch p.SourceProfile {
+	case "":
+		return nil, errors.Errorf("aws: profile %q has a role_arn, but no source_profile", name)
+	case name:
+		// The role is assumed with the profile's own credentials.
+		p.Source = &Profile{
+			Name:              name,
+			Region:            p.Region,
+			AccessKeyID:       p.AccessKeyID,
+			SecretAccessKey:   p.SecretAccessKey,
+			SessionToken:      p.SessionToken,
+			CredentialProcess: p.CredentialProcess,
+		}
+	default:
+		source, err := c.profile(p.SourceProfile, append(chain, name))
+		if err != nil {
+			return nil, errors.Annotatef(err, "source_profile of profile %q", name)
+		}
+		p.Source = source
+	}
+	return p, nil
+}
+
+// Credentials returns a provider of the named profile's credentials (see
+// ProfileName): those of its role, assumed with the credentials of its source
+// profile, or else its static credentials, or else those printed by its
+// credential_process. Roles are assumed by the registered RoleAssumer.
+func (c *SharedConfig) Credentials(name string) (CredentialsProvider, error) {
+	p, err := c.Profile(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.provider(p)
+}
+
+func (c *SharedConfig) provider(p *Profile) (CredentialsProvider, error) {
+	if p.RoleARN == "" {
+		if p.AccessKeyID == "" && p.CredentialProcess != "" {
+			return ProcessCreds(p.CredentialProcess), nil
+		}
+		if p.AccessKeyID == "" {
+			return nil, errors.NotFoundf("aws_access_key_id in profile %q", p.Name)
+		}
+		if p.SecretAccessKey == "" {
+			return nil, errors.NotFoundf("aws_secret_access_key in profile %q", p.Name)
+		}
+		return Creds(p.AccessKeyID, p.SecretAccessKey, p.SessionToken), nil
+	}
+
+	roleAssumerMu.Lock()
+	assume := roleAssumer
+	roleAssumerMu.Unlock()
+	if assume == nil {
+		return nil, &NoRoleAssumerError{Profile: p.Name, RoleARN: p.RoleARN}
+	}
+
+	source, err := c.provider(p.Source)
+	if err != nil {
+		return nil, errors.Annotatef(err, "source_profile of profile %q", p.Name)
+	}
+
+	var tokenCode func() (string, error)
+	if p.MFASerial != "" && c.TokenCode != nil {
+		serial := p.MFASerial
+		tokenCode = func() (string, error) { return c.TokenCode(serial) }
+	}
+	return assume(source, p, tokenCode), nil
+}
+
+// A RoleAssumer returns a provider of the credentials of the profile's role,
+// assumed with the source's credentials. tokenCode, if not nil, returns the
+// current code of the profile's MFA device.
+type RoleAssumer func(source CredentialsProvider, p *Profile, tokenCode func() (string, error)) CredentialsProvider
+
+var (
+	roleAssumerMu sync.Mutex
+	roleAssumer   RoleAssumer
+)
+
+// A NoRoleAssumerError is returned for a profile which assumes a role when no
+// RoleAssumer has been registered. A ChainProvider returns it rather than
+// trying the providers after the profile's, which would quietly provide other
+// credentials than the ones asked for.
+type NoRoleAssumerError struct {
+	Profile string
+	RoleARN string
+}
+
+func (e *NoRoleAssumerError) Error() string {
+	return fmt.Sprintf("aws: profile %q assumes role %s, which requires importing github.com/timesking/aws-go/aws/stscreds", e.Profile, e.RoleARN)
+}
+
+func (e *NoRoleAssumerError) stopsChain() {}
+
+// RegisterRoleAssumer makes f the RoleAssumer which profiles' roles are
+// assumed with. Package github.com/timesking/aws-go/aws/stscreds registers one
+// when it's imported.
+func RegisterRoleAssumer(f RoleAssumer) {
+	roleAssumerMu.Lock()
+	defer roleAssumerMu.Unlock()
+	roleAssumer = f
+}
+
+// A ProfileError is returned by a SharedProvider for a profile which was named
+// explicitly, by the provider's Profile or AWS_PROFILE, but whose credentials
+// can't be resolved or retrieved. A ChainProvider returns it rather than trying
+// the providers after the profile's, which would quietly provide other
+// credentials than the ones asked for.
+type ProfileError struct {
+	Profile string
+	Err     error
+}
+
+func (e *ProfileError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error, for errors.Is and errors.As.
+func (e *ProfileError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ProfileError) stopsChain() {}
+
+// A SharedProvider provides the credentials of a profile of the shared config
+// and credentials files (see SharedConfig.Credentials). The files are loaded
+// when credentials are first needed and, if the profile has static
+// credentials or can't be used, again once Expiry has passed. Until then, the
+// same credentials or error are returned without the files being read.
+type SharedProvider struct {
+	// Profile names the profile (see ProfileName). If it, or else AWS_PROFILE,
+	// is set, the profile's errors are ProfileErrors.
+	Profile string
+
+	// Expiry is how long static credentials and errors are kept.
+	Expiry time.Duration
+
+	// TokenCode is called for the current code of the MFA device with the
+	// given serial number when the profile's role requires one. Without it,
+	// such roles can't be assumed.
+	TokenCode func(mfaSerial string) (string, error)
+
+	// Clock tells the time the credentials and errors expire by. If nil, the
+	// system clock is used.
+	Clock Clock
+
+	m          sync.Mutex
+	provider   CredentialsProvider
+	err        error
+	expiration time.Time
+}
+
+// SharedCreds returns a provider of the named profile's credentials from the
+// shared config and credentials files, which are loaded again after the
+// expiry.
+func SharedCreds(profile string, expiry time.Duration) *SharedProvider {
+	return &SharedProvider{Profile: profile, Expiry: expiry}
+}
+
+// Credentials returns the profile's credentials, loading the files if they
+// haven't been or have expired.
+func (p *SharedProvider) Credentials() (*Credentials, error) {
+	return p.CredentialsWithContext(context.Background())
+}
+
+// CredentialsWithContext is like Credentials, but passes the context to the
+// profile's provider.
+func (p *SharedProvider) CredentialsWithContext(ctx context.Context) (*Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	now := clockNow(p.Clock)
+	if (p.provider == nil && p.err == nil) || (!p.expiration.IsZero() && !p.expiration.After(now)) {
+		p.provider, p.err = p.load()
+		p.expiration = time.Time{}
+		if _, static := p.provider.(staticCredentialsProvider); static || p.err != nil {
+			p.expiration = now.Add(p.Expiry)
+		}
+	}
+	if p.err != nil {
+		return nil, p.profileError(p.err)
+	}
+	creds, err := credentialsWithContext(ctx, p.provider)
+	if err != nil {
+		return nil, p.profileError(err)
+	}
+	return creds, nil
+}
+
+// profileError returns the error as a *ProfileError if the profile was named
+// explicitly, so a missing default profile doesn't stop a chain.
+func (p *SharedProvider) profileError(err error) error {
+	if p.Profile == "" && os.Getenv("AWS_PROFILE") == "" {
+		return err
+	}
+	return &ProfileError{Profile: ProfileName(p.Profile), Err: err}
+}
+
+// load returns a provider of the profile's credentials from the files.
+func (p *SharedProvider) load() (CredentialsProvider, error) {
+	config, err := LoadSharedConfig()
+	if err != nil {
+		return nil, err
+	}
+	config.TokenCode = p.TokenCode
+	return config.Credentials(p.Profile)
+}
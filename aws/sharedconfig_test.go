@@ -0,0 +1,266 @@
+package aws_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+// writeSharedConfig writes the shared config and credentials files, and
+// points AWS_CONFIG_FILE and AWS_SHARED_CREDENTIALS_FILE at them.
+func writeSharedConfig(t *testing.T, config, credentials string) {
+	dir := t.TempDir()
+	for name, body := range map[string]string{"config": config, "credentials": credentials} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	t.Setenv("AWS_PROFILE", "")
+}
+
+func TestSharedConfigProfiles(t *testing.T) {
+	writeSharedConfig(t, `
+[default]
+region = us-west-2
+
+[profile dev]
+region = eu-west-1
+aws_access_key_id = config
+
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = dev
+external_id = leash
+mfa_serial = arn:aws:iam::123456789012:mfa/penny
+role_session_name = walk
+duration_seconds = 900
+
+[dev]
+region = ignored
+`, `
+[default]
+aws_access_key_id = defaultKey
+aws_secret_access_key = defaultSecret
+
+[dev]
+aws_access_key_id = devKey
+aws_secret_access_key = devSecret
+aws_session_token = devToken
+`)
+
+	config, err := aws.LoadSharedConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := config.Profile("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := aws.Profile{Name: "default", Region: "us-west-2", AccessKeyID: "defaultKey", SecretAccessKey: "defaultSecret"}
+	if *p != want {
+		t.Errorf("Default profile was %+v but expected %+v", *p, want)
+	}
+
+	t.Setenv("AWS_PROFILE", "admin")
+	if p, err = config.Profile(""); err != nil {
+		t.Fatal(err)
+	}
+	if p.Source == nil {
+		t.Fatal("Admin profile had no source")
+	}
+	source := *p.Source
+	p.Source = nil
+	want = aws.Profile{
+		Name:            "admin",
+		RoleARN:         "arn:aws:iam::123456789012:role/admin",
+		SourceProfile:   "dev",
+		ExternalID:      "leash",
+		MFASerial:       "arn:aws:iam::123456789012:mfa/penny",
+		RoleSessionName: "walk",
+		Duration:        15 * time.Minute,
+	}
+	if *p != want {
+		t.Errorf("Admin profile was %+v but expected %+v", *p, want)
+	}
+
+	// the credentials file takes precedence over the config file
+	want = aws.Profile{Name: "dev", Region: "eu-west-1", AccessKeyID: "devKey", SecretAccessKey: "devSecret", SessionToken: "devToken"}
+	if source != want {
+		t.Errorf("Source profile was %+v but expected %+v", source, want)
+	}
+
+	// roles need a RoleAssumer, which package stscreds registers
+	if _, err := config.Credentials("admin"); err == nil || !strings.Contains(err.Error(), "aws/stscreds") {
+		t.Errorf("Error was %v but expected one asking for package stscreds", err)
+	}
+}
+
+func TestSharedConfigErrors(t *testing.T) {
+	writeSharedConfig(t, `
+[profile a]
+role_arn = arn:aws:iam::123456789012:role/a
+source_profile = b
+
+[profile b]
+role_arn = arn:aws:iam::123456789012:role/b
+source_profile = c
+
+[profile c]
+role_arn = arn:aws:iam::123456789012:role/c
+source_profile = a
+
+[profile orphan]
+role_arn = arn:aws:iam::123456789012:role/orphan
+
+[profile lost]
+role_arn = arn:aws:iam::123456789012:role/lost
+source_profile = missing
+
+[profile keyless]
+aws_access_key_id = key
+`, "")
+
+	config, err := aws.LoadSharedConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]string{
+		"b":       "source_profiles form a cycle: b -> c -> a -> b",
+		"orphan":  `profile "orphan" has a role_arn, but no source_profile`,
+		"lost":    `source_profile of profile "lost": profile "missing" in`,
+		"missing": `profile "missing" in`,
+		"keyless": `aws_secret_access_key in profile "keyless" not found`,
+	} {
+		_, err := config.Credentials(name)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Error for profile %s was %v but expected it to contain %q", name, err, want)
+		}
+	}
+}
+
+func TestSharedCreds(t *testing.T) {
+	writeSharedConfig(t, "", `
+[default]
+aws_access_key_id = defaultKey
+aws_secret_access_key = defaultSecret
+
+[dev]
+aws_access_key_id = devKey
+aws_secret_access_key = devSecret
+`)
+	t.Setenv("AWS_PROFILE", "dev")
+
+	creds, err := aws.SharedCreds("", time.Minute).Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := *creds, (aws.Credentials{AccessKeyID: "devKey", SecretAccessKey: "devSecret"}); v != want {
+		t.Errorf("Credentials were %+v but expected %+v", v, want)
+	}
+
+	// the files may be missing
+	writeSharedConfig(t, "", "")
+	if err := os.Remove(os.Getenv("AWS_CONFIG_FILE")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := aws.SharedCreds("default", time.Minute).Credentials(); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Error was %v but expected the profile not to be found", err)
+	}
+}
+
+func TestSharedCredsCachesErrors(t *testing.T) {
+	writeSharedConfig(t, "", "")
+	now := time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC)
+	p := aws.SharedCreds("dev", time.Minute)
+	p.Clock = aws.ClockFunc(func() time.Time { return now })
+	if _, err := p.Credentials(); err == nil {
+		t.Fatal("Expected an error but none was returned")
+	}
+
+	// the files aren't read again until the error expires
+	if err := os.WriteFile(os.Getenv("AWS_SHARED_CREDENTIALS_FILE"), []byte("[dev]\naws_access_key_id = devKey\naws_secret_access_key = devSecret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Credentials(); err == nil {
+		t.Error("The error wasn't cached")
+	}
+
+	now = now.Add(time.Minute)
+	creds, err := p.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "devKey"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+}
+
+func TestSharedCredsWithoutRoleAssumer(t *testing.T) {
+	writeSharedConfig(t, `
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = admin
+aws_access_key_id = key
+aws_secret_access_key = secret
+`, "")
+
+	// the chain stops at the profile rather than using other credentials
+	fallback := &fakeProvider{creds: aws.Credentials{AccessKeyID: "instance"}}
+	_, err := aws.Chain(aws.SharedCreds("admin", time.Minute), fallback).Credentials()
+	var e *aws.NoRoleAssumerError
+	if !errors.As(err, &e) {
+		t.Fatalf("Error was %v but expected a *NoRoleAssumerError", err)
+	}
+	if v, want := e.Profile, "admin"; v != want {
+		t.Errorf("Profile was %q but expected %q", v, want)
+	}
+	if v := fallback.calls; v != 0 {
+		t.Errorf("The fallback provider was called %d times", v)
+	}
+}
+
+func TestSharedCredsExplicitProfileStopsChain(t *testing.T) {
+	writeSharedConfig(t, `
+[profile keyless]
+aws_access_key_id = key
+`, "")
+
+	// a missing default profile falls through to the next provider
+	fallback := &fakeProvider{creds: aws.Credentials{AccessKeyID: "instance"}}
+	creds, err := aws.Chain(aws.SharedCreds("", time.Minute), fallback).Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "instance"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+
+	// but a named one which can't be used stops the chain
+	for _, test := range []struct {
+		env, profile, want string
+	}{
+		{"keyless", "", `aws_secret_access_key in profile "keyless" not found`},
+		{"", "keyless", `aws_secret_access_key in profile "keyless" not found`},
+		{"", "missing", `profile "missing" in`},
+	} {
+		t.Setenv("AWS_PROFILE", test.env)
+		fallback := &fakeProvider{creds: aws.Credentials{AccessKeyID: "instance"}}
+		_, err := aws.Chain(aws.SharedCreds(test.profile, time.Minute), fallback).Credentials()
+		var e *aws.ProfileError
+		if !errors.As(err, &e) || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Error for profile %q (AWS_PROFILE %q) was %v but expected a *ProfileError containing %q", test.profile, test.env, err, test.want)
+		}
+		if v := fallback.calls; v != 0 {
+			t.Errorf("The fallback provider was called %d times for profile %q (AWS_PROFILE %q)", v, test.profile, test.env)
+		}
+	}
+}
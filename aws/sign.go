@@ -6,7 +6,6 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path"
@@ -17,14 +16,58 @@ import (
 
 // Context encapsulates the context of a client's connection to an AWS service.
 type Context struct {
+	// skew is first so it's 64-bit aligned for atomic access.
+	skew int64
+
 	Service     string
 	Region      string
 	Credentials CredentialsProvider
+
+	// UserAgent, if set, is appended to the User-Agent header of requests.
+	UserAgent string
+
+	// Clock tells the time requests are signed at, which is corrected for any
+	// skew from the server's clock. If nil, the system clock is used.
+	Clock Clock
+
+	// Logger, if set, is sent the parts of each request selected by LogLevel.
+	// Credentials and members with sensitive shapes are redacted.
+	Logger   Logger
+	LogLevel LogLevel
+
+	// PayloadSigning selects how request bodies are signed.
+	PayloadSigning PayloadSigning
+
+	// Compression asks for gzip or deflate compressed responses, which are
+	// decompressed transparently. Streamed response bodies (e.g. S3 objects)
+	// are always sent as they're stored.
+	Compression bool
+
+	// Signer signs requests. If nil, requests are signed with Signature
+	// Version 4.
+	Signer Signer
+}
+
+// A Signer adds a signature to requests, using the credentials and other
+// details of a client's context.
+type Signer interface {
+	Sign(c *Context, r *http.Request) error
+}
+
+// V4Signer signs requests with Signature Version 4.
+type V4Signer struct{}
+
+// Sign signs the request with Signature Version 4.
+func (V4Signer) Sign(c *Context, r *http.Request) error {
+	return c.sign(r)
 }
 
 func (c *Context) sign(r *http.Request) error {
+	// a retried request still carries the signature of its previous attempt
+	r.Header.Del("Authorization")
+
 	date := r.Header.Get("Date")
-	t := currentTime().UTC()
+	t := c.now().UTC()
 	if date != "" {
 		var err error
 		t, err = time.Parse(http.TimeFormat, date)
@@ -34,13 +77,13 @@ func (c *Context) sign(r *http.Request) error {
 	}
 	r.Header.Set("x-amz-date", t.Format(iso8601BasicFormat))
 
-	chash, err := c.hashContent(r)
+	chash, err := c.payloadHash(r)
 	if err != nil {
 		return err
 	}
 	r.Header.Set("x-amz-content-sha256", chash)
 
-	creds, err := c.Credentials.Credentials()
+	creds, err := credentialsWithContext(r.Context(), c.Credentials)
 	if err != nil {
 		return err
 	}
@@ -53,15 +96,27 @@ func (c *Context) sign(r *http.Request) error {
 	h := hmac.New(sha256.New, k)
 	c.writeStringToSign(h, t, r, chash)
 
+	if c.logging(LogSigning) {
+		canonicalRequest, stringToSign := new(bytes.Buffer), new(bytes.Buffer)
+		c.writeRequest(canonicalRequest, r, chash)
+		c.writeStringToSign(stringToSign, t, r, chash)
+		c.logSigning(canonicalRequest.String(), stringToSign.String(), creds.SecurityToken)
+	}
+
 	auth := bytes.NewBufferString("AWS4-HMAC-SHA256 ")
 	_, _ = auth.WriteString("Credential=" + creds.AccessKeyID + "/" + c.creds(t))
 	_, _ = auth.WriteString(", ")
 	_, _ = auth.WriteString("SignedHeaders=")
 	c.writeHeaderList(auth, r)
 	_, _ = auth.WriteString(", ")
-	_, _ = auth.WriteString(fmt.Sprintf("Signature=%x", h.Sum(nil)))
+	signature := fmt.Sprintf("%x", h.Sum(nil))
+	_, _ = auth.WriteString("Signature=" + signature)
 
 	r.Header.Set("Authorization", auth.String())
+
+	if chash == streamingPayloadHash {
+		r.Body = newChunkedBody(r.Body, k, t.Format(iso8601BasicFormat), c.creds(t), signature)
+	}
 	return nil
 }
 
@@ -96,26 +151,6 @@ func (c *Context) writeRequest(w io.Writer, r *http.Request, chash string) {
 	fmt.Fprint(w, chash)
 }
 
-func (c *Context) hashContent(r *http.Request) (string, error) {
-	var b []byte
-	// If the payload is empty, use the empty string as the input to the SHA256 function
-	// http://docs.amazonwebservices.com/general/latest/gr/sigv4-create-canonical-request.html
-	if r.Body == nil {
-		b = []byte("")
-	} else {
-		body, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			return "", err
-		}
-		b = body
-		r.Body = ioutil.NopCloser(bytes.NewBuffer(b))
-	}
-
-	h := sha256.New()
-	_, _ = h.Write(b)
-	return fmt.Sprintf("%x", h.Sum(nil)), nil
-}
-
 func (c *Context) writeURI(w io.Writer, r *http.Request) {
 	p := r.URL.RequestURI()
 	if r.URL.RawQuery != "" {
@@ -203,8 +238,6 @@ func ghmac(key, data []byte) []byte {
 	return h.Sum(nil)
 }
 
-var currentTime = time.Now
-
 const (
 	lf                      = "\n"
 	iso8601BasicFormat      = "20060102T150405Z"
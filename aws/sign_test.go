@@ -9,13 +9,6 @@ import (
 )
 
 func TestSign(t *testing.T) {
-	currentTime = func() time.Time {
-		return time.Date(2014, 12, 10, 13, 40, 0, 0, time.FixedZone("PST", -8))
-	}
-	defer func() {
-		currentTime = time.Now
-	}()
-
 	req, err := http.NewRequest(
 		"POST",
 		"https://wobble.awsamazon.com/fliff?twimble=boo&flaff=hojo&plum",
@@ -31,6 +24,7 @@ func TestSign(t *testing.T) {
 		Service:     "wobble",
 		Region:      "us-east-1",
 		Credentials: Creds("accessKeyID", "secretAccessKey", ""),
+		Clock:       fixedClock(time.Date(2014, 12, 10, 13, 40, 0, 0, time.FixedZone("PST", -8))),
 	}
 	c.sign(req)
 
@@ -56,13 +50,6 @@ func TestSign(t *testing.T) {
 }
 
 func TestSignWithToken(t *testing.T) {
-	currentTime = func() time.Time {
-		return time.Date(2014, 12, 10, 13, 40, 0, 0, time.FixedZone("PST", -8))
-	}
-	defer func() {
-		currentTime = time.Now
-	}()
-
 	req, err := http.NewRequest(
 		"POST",
 		"https://wobble.awsamazon.com/fliff?twimble=boo&flaff=hojo&plum",
@@ -78,6 +65,7 @@ func TestSignWithToken(t *testing.T) {
 		Service:     "wobble",
 		Region:      "us-east-1",
 		Credentials: Creds("accessKeyID", "secretAccessKey", "token"),
+		Clock:       fixedClock(time.Date(2014, 12, 10, 13, 40, 0, 0, time.FixedZone("PST", -8))),
 	}
 	c.sign(req)
 
@@ -102,3 +90,9 @@ func TestSignWithToken(t *testing.T) {
 		t.Errorf("Signed request was \n%s\n but expected\n%s", actual, expected)
 	}
 }
+
+func fixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time {
+		return t
+	})
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// V2Signer signs requests with Signature Version 2 (HmacSHA256), as used by
+// SimpleDB and other older query APIs. The signature is added to the request's
+// form-encoded body, or to its query if it has no body.
+type V2Signer struct{}
+
+const v2TimeFormat = "2006-01-02T15:04:05Z"
+
+// Sign signs the request with Signature Version 2.
+func (V2Signer) Sign(c *Context, r *http.Request) error {
+	creds, err := credentialsWithContext(r.Context(), c.Credentials)
+	if err != nil {
+		return err
+	}
+
+	post := r.Body != nil && r.Body != http.NoBody
+	params := r.URL.Query()
+	if post {
+		b, err := ioutil.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			return err
+		}
+		if params, err = url.ParseQuery(string(b)); err != nil {
+			return err
+		}
+	}
+
+	// a retried request still carries the signature of its previous attempt
+	params.Del("Signature")
+	params.Del("SecurityToken")
+
+	params.Set("AWSAccessKeyId", creds.AccessKeyID)
+	params.Set("SignatureVersion", "2")
+	params.Set("SignatureMethod", "HmacSHA256")
+	params.Set("Timestamp", c.now().UTC().Format(v2TimeFormat))
+	if s := creds.SecurityToken; s != "" {
+		params.Set("SecurityToken", s)
+	}
+
+	// parameters in the URL's query are signed along with the body's
+	signed := url.Values{}
+	if post {
+		for k, v := range r.URL.Query() {
+			signed[k] = v
+		}
+	}
+	for k, v := range params {
+		signed[k] = v
+	}
+
+	stringToSign := new(bytes.Buffer)
+	writeV2StringToSign(stringToSign, r, signed)
+	if c.logging(LogSigning) {
+		s := stringToSign.String()
+		if creds.SecurityToken != "" {
+			s = strings.Replace(s, v2Escape(creds.SecurityToken), redacted, -1)
+		}
+		c.Logger.Printf("aws: %s string to sign:\n%s", c.Service, s)
+	}
+	params.Set("Signature", v2Signature(creds.SecretAccessKey, stringToSign.Bytes()))
+
+	if !post {
+		r.URL.RawQuery = params.Encode()
+		return nil
+	}
+
+	b := []byte(params.Encode())
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	r.ContentLength = int64(len(b))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), nil
+	}
+	return nil
+}
+
+func writeV2StringToSign(w io.Writer, r *http.Request, params url.Values) {
+	path := r.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	_, _ = io.WriteString(w, r.Method)
+	_, _ = io.WriteString(w, lf)
+	_, _ = io.WriteString(w, strings.ToLower(r.Host))
+	_, _ = io.WriteString(w, lf)
+	_, _ = io.WriteString(w, path)
+	_, _ = io.WriteString(w, lf)
+
+	// parameters are sorted by name, which sorting name=value pairs wouldn't do
+	// for names which prefix others
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var a []string
+	for _, k := range keys {
+		vs := append([]string(nil), params[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			a = append(a, v2Escape(k)+"="+v2Escape(v))
+		}
+	}
+	_, _ = io.WriteString(w, strings.Join(a, "&"))
+}
+
+func v2Signature(secretAccessKey string, stringToSign []byte) string {
+	h := hmac.New(sha256.New, []byte(secretAccessKey))
+	_, _ = h.Write(stringToSign)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// v2Escape escapes a string as RFC 3986 requires.
+func v2Escape(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.Replace(s, "+", "%20", -1)
+	s = strings.Replace(s, "*", "%2A", -1)
+	return strings.Replace(s, "%7E", "~", -1)
+}
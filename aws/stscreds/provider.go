@@ -0,0 +1,186 @@
+// Package stscreds provides the credentials of roles assumed with AWS Security
+// Token Service. Importing it lets the profiles of the shared config file
+// assume roles (see aws.SharedConfig).
+package stscreds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/sts"
+)
+
+// DefaultExpiryWindow is how long before they expire an AssumeRoleProvider's
+// credentials are refreshed, unless it says otherwise.
+const DefaultExpiryWindow = time.Minute
+
+// ErrNoTokenCode is returned when a role requires an MFA token code, but its
+// provider has no TokenCode function to get one.
+var ErrNoTokenCode = errors.New("stscreds: an MFA token code is required, but there's no TokenCode function")
+
+func init() {
+	aws.RegisterRoleAssumer(AssumeProfileRole)
+}
+
+// AssumeProfileRole is an aws.RoleAssumer, which returns a provider of the
+// profile's role, assumed with a client in the profile's region, or else
+// us-east-1. It's registered when the package is imported.
+func AssumeProfileRole(source aws.CredentialsProvider, p *aws.Profile, tokenCode func() (string, error)) aws.CredentialsProvider {
+	region := p.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	provider := NewAssumeRoleProvider(sts.New(source, region, nil), p.RoleARN)
+	provider.RoleSessionName = p.RoleSessionName
+	provider.ExternalID = p.ExternalID
+	provider.Duration = p.Duration
+	provider.SerialNumber = p.MFASerial
+	provider.TokenCode = tokenCode
+	return provider
+}
+
+// An AssumeRoleProvider provides the temporary credentials of a role, which it
+// assumes with its client's credentials. The credentials are refreshed
+// shortly before they expire.
+type AssumeRoleProvider struct {
+	Client  *sts.STS
+	RoleARN string
+
+	// RoleSessionName names the role's sessions, as shown in CloudTrail. If
+	// empty, each session is named after the time it starts.
+	RoleSessionName string
+
+	// ExternalID is the external ID the role's trust policy requires, if any.
+	ExternalID string
+
+	// Duration is how long each session lasts. If zero, STS's default of an
+	// hour is used.
+	Duration time.Duration
+
+	// Policy, if set, further restricts the role's permissions.
+	Policy string
+
+	// SerialNumber is the serial number or ARN of the MFA device the role's
+	// trust policy requires, if any. TokenCode is called for its current code
+	// each time the role is assumed.
+	SerialNumber string
+	TokenCode    func() (string, error)
+
+	// ExpiryWindow is how long before they expire credentials are refreshed.
+	// If zero, DefaultExpiryWindow is used.
+	ExpiryWindow time.Duration
+
+	// Clock tells the time the credentials expire by. If nil, the system
+	// clock is used.
+	Clock aws.Clock
+
+	m          sync.Mutex
+	creds      aws.Credentials
+	expiration time.Time
+}
+
+// NewAssumeRoleProvider returns a provider of the role's credentials, assumed
+// with the client.
+func NewAssumeRoleProvider(client *sts.STS, roleARN string) *AssumeRoleProvider {
+	return &AssumeRoleProvider{Client: client, RoleARN: roleARN}
+}
+
+// Credentials returns the role's credentials, assuming it again if they're
+// about to expire.
+func (p *AssumeRoleProvider) Credentials() (*aws.Credentials, error) {
+	return p.CredentialsWithContext(context.Background())
+}
+
+// CredentialsWithContext is like Credentials, but returns the context's error
+// if it is done before the role is assumed.
+func (p *AssumeRoleProvider) CredentialsWithContext(ctx context.Context) (*aws.Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	window := p.ExpiryWindow
+	if window == 0 {
+		window = DefaultExpiryWindow
+	}
+	now := p.now()
+	if now.Before(p.expiration.Add(-window)) {
+		creds := p.creds
+		return &creds, nil
+	}
+
+	req, err := p.request(now)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.Client.AssumeRoleWithContext(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("stscreds: assuming role %s: %w", p.RoleARN, err)
+	}
+	if resp.Credentials == nil {
+		return nil, fmt.Errorf("stscreds: assuming role %s returned no credentials", p.RoleARN)
+	}
+
+	p.creds = aws.Credentials{
+		AccessKeyID:     stringValue(resp.Credentials.AccessKeyID),
+		SecretAccessKey: stringValue(resp.Credentials.SecretAccessKey),
+		SecurityToken:   stringValue(resp.Credentials.SessionToken),
+	}
+	p.expiration = resp.Credentials.Expiration
+	creds := p.creds
+	return &creds, nil
+}
+
+// request returns the request which assumes the role.
+func (p *AssumeRoleProvider) request(now time.Time) (*sts.AssumeRoleRequest, error) {
+	name := p.RoleSessionName
+	if name == "" {
+		name = "aws-go-" + strconv.FormatInt(now.UnixNano(), 10)
+	}
+
+	req := &sts.AssumeRoleRequest{
+		RoleARN:         aws.String(p.RoleARN),
+		RoleSessionName: aws.String(name),
+	}
+	if p.ExternalID != "" {
+		req.ExternalID = aws.String(p.ExternalID)
+	}
+	if p.Duration != 0 {
+		req.DurationSeconds = aws.Integer(int(p.Duration / time.Second))
+	}
+	if p.Policy != "" {
+		req.Policy = aws.String(p.Policy)
+	}
+
+	if p.SerialNumber != "" {
+		if p.TokenCode == nil {
+			return nil, ErrNoTokenCode
+		}
+		code, err := p.TokenCode()
+		if err != nil {
+			return nil, fmt.Errorf("stscreds: getting MFA token code for %s: %w", p.SerialNumber, err)
+		}
+		req.SerialNumber = aws.String(p.SerialNumber)
+		req.TokenCode = aws.String(code)
+	}
+	return req, nil
+}
+
+func (p *AssumeRoleProvider) now() time.Time {
+	if p.Clock == nil {
+		return time.Now()
+	}
+	return p.Clock.Now()
+}
+
+func stringValue(s aws.StringValue) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
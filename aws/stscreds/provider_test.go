@@ -0,0 +1,123 @@
+package stscreds_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/aws/stscreds"
+	"github.com/timesking/aws-go/gen/sts"
+)
+
+// stsServer returns a server which assumes roles for an hour from the clock's
+// time, recording each request's form.
+func stsServer(t *testing.T, clock aws.Clock, forms *[]url.Values) *httptest.Server {
+	var m sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			if err := r.ParseForm(); err != nil {
+				t.Error(err)
+			}
+			*forms = append(*forms, r.PostForm)
+			fmt.Fprintf(w, `<AssumeRoleResponse><AssumeRoleResult><Credentials>
+  <AccessKeyId>ASIA%d</AccessKeyId>
+  <SecretAccessKey>secret</SecretAccessKey>
+  <SessionToken>token</SessionToken>
+  <Expiration>%s</Expiration>
+</Credentials></AssumeRoleResult></AssumeRoleResponse>`, len(*forms), clock.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		},
+	))
+}
+
+func TestAssumeRoleProvider(t *testing.T) {
+	now := time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC)
+	clock := aws.ClockFunc(func() time.Time { return now })
+
+	var forms []url.Values
+	server := stsServer(t, clock, &forms)
+	defer server.Close()
+
+	client := sts.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-east-1", nil, aws.WithEndpoint(server.URL), aws.WithClock(clock))
+	p := stscreds.NewAssumeRoleProvider(client, "arn:aws:iam::123456789012:role/dogs")
+	p.RoleSessionName = "walk"
+	p.ExternalID = "leash"
+	p.Duration = time.Hour
+	p.Policy = `{"Version":"2012-10-17"}`
+	p.SerialNumber = "arn:aws:iam::123456789012:mfa/penny"
+	p.TokenCode = func() (string, error) { return "123456", nil }
+	p.Clock = clock
+
+	creds, err := p.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := *creds, (aws.Credentials{AccessKeyID: "ASIA1", SecretAccessKey: "secret", SecurityToken: "token"}); v != want {
+		t.Errorf("Credentials were %+v but expected %+v", v, want)
+	}
+
+	for name, want := range map[string]string{
+		"Action":          "AssumeRole",
+		"RoleArn":         "arn:aws:iam::123456789012:role/dogs",
+		"RoleSessionName": "walk",
+		"ExternalId":      "leash",
+		"DurationSeconds": "3600",
+		"Policy":          `{"Version":"2012-10-17"}`,
+		"SerialNumber":    "arn:aws:iam::123456789012:mfa/penny",
+		"TokenCode":       "123456",
+	} {
+		if v := forms[0].Get(name); v != want {
+			t.Errorf("%s was %q but expected %q", name, v, want)
+		}
+	}
+
+	// the credentials are cached until shortly before they expire
+	now = now.Add(58 * time.Minute)
+	if creds, err = p.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "ASIA1"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+
+	now = now.Add(90 * time.Second)
+	if creds, err = p.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "ASIA2"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+}
+
+func TestAssumeRoleProviderWithoutTokenCode(t *testing.T) {
+	var forms []url.Values
+	server := stsServer(t, aws.SystemClock, &forms)
+	defer server.Close()
+
+	client := sts.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-east-1", nil, aws.WithEndpoint(server.URL))
+	p := stscreds.NewAssumeRoleProvider(client, "arn:aws:iam::123456789012:role/dogs")
+	p.SerialNumber = "arn:aws:iam::123456789012:mfa/penny"
+
+	if _, err := p.Credentials(); !errors.Is(err, stscreds.ErrNoTokenCode) {
+		t.Errorf("Error was %v but expected %v", err, stscreds.ErrNoTokenCode)
+	}
+	if len(forms) != 0 {
+		t.Errorf("Role was assumed without a token code")
+	}
+
+	p.SerialNumber = ""
+	if _, err := p.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v := forms[0].Get("RoleSessionName"); v == "" {
+		t.Error("Role session name was empty")
+	}
+}
@@ -0,0 +1,137 @@
+package stscreds_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/aws/stscreds"
+)
+
+func TestSharedConfigRoleChaining(t *testing.T) {
+	var forms []url.Values
+	server := stsServer(t, aws.SystemClock, &forms)
+	defer server.Close()
+
+	config := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(config, []byte(`
+[profile base]
+aws_access_key_id = baseKey
+aws_secret_access_key = baseSecret
+
+[profile dev]
+role_arn = arn:aws:iam::123456789012:role/dev
+source_profile = base
+
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = dev
+external_id = leash
+mfa_serial = arn:aws:iam::123456789012:mfa/penny
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_ENDPOINT_URL_STS", server.URL)
+
+	shared, err := aws.LoadSharedConfigFiles(config, filepath.Join(t.TempDir(), "credentials"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared.TokenCode = func(serial string) (string, error) {
+		if serial != "arn:aws:iam::123456789012:mfa/penny" {
+			t.Errorf("Token code was asked for %s", serial)
+		}
+		return "123456", nil
+	}
+
+	p, err := shared.Credentials("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := p.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "ASIA2"; v != want {
+		t.Errorf("Access key ID was %q but expected %q", v, want)
+	}
+
+	// the source role is assumed first, and then the profile's with its
+	// credentials
+	if v, want := len(forms), 2; v != want {
+		t.Fatalf("%d roles were assumed but expected %d", v, want)
+	}
+	for i, want := range []map[string]string{
+		{"RoleArn": "arn:aws:iam::123456789012:role/dev", "ExternalId": "", "TokenCode": ""},
+		{"RoleArn": "arn:aws:iam::123456789012:role/admin", "ExternalId": "leash", "TokenCode": "123456"},
+	} {
+		for name, want := range want {
+			if v := forms[i].Get(name); v != want {
+				t.Errorf("Request %d's %s was %q but expected %q", i, name, v, want)
+			}
+		}
+	}
+}
+
+func TestSharedCredsTokenCode(t *testing.T) {
+	var forms []url.Values
+	server := stsServer(t, aws.SystemClock, &forms)
+	defer server.Close()
+
+	config := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(config, []byte(`
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = admin
+aws_access_key_id = key
+aws_secret_access_key = secret
+mfa_serial = arn:aws:iam::123456789012:mfa/penny
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONFIG_FILE", config)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("AWS_ENDPOINT_URL_STS", server.URL)
+
+	p := aws.SharedCreds("admin", time.Minute)
+	p.TokenCode = func(serial string) (string, error) { return "654321", nil }
+	if _, err := p.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := forms[0].Get("TokenCode"), "654321"; v != want {
+		t.Errorf("TokenCode was %q but expected %q", v, want)
+	}
+}
+
+func TestSharedCredsWithoutTokenCode(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(config, []byte(`
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/admin
+source_profile = admin
+aws_access_key_id = key
+aws_secret_access_key = secret
+mfa_serial = arn:aws:iam::123456789012:mfa/penny
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONFIG_FILE", config)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("AWS_PROFILE", "admin")
+
+	// the chain stops at the profile rather than using the fallback's
+	// credentials
+	p := aws.Chain(aws.SharedCreds("", time.Minute), aws.Creds("fallbackKey", "fallbackSecret", ""))
+	_, err := p.Credentials()
+	var e *aws.ProfileError
+	if !errors.As(err, &e) || !errors.Is(err, stscreds.ErrNoTokenCode) {
+		t.Fatalf("Error was %v but expected a *ProfileError for %v", err, stscreds.ErrNoTokenCode)
+	}
+	if v, want := e.Profile, "admin"; v != want {
+		t.Errorf("Profile was %q but expected %q", v, want)
+	}
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The formats of timestamps, as named by API models.
+const (
+	ISO8601Timestamp = "iso8601"
+	RFC822Timestamp  = "rfc822"
+	UnixTimestamp    = "unixTimestamp"
+)
+
+// iso8601 is the layout of ISO 8601 timestamps, in UTC.
+const iso8601 = "2006-01-02T15:04:05Z"
+
+// FormatTimestamp formats the time as a header, query string or URI
+// parameter, in the given format: ISO 8601 or RFC 822 in UTC, or seconds
+// since the epoch.
+func FormatTimestamp(t time.Time, format string) string {
+	switch format {
+	case RFC822Timestamp:
+		return t.UTC().Format(http.TimeFormat)
+	case UnixTimestamp:
+		return strconv.FormatFloat(float64(t.UnixNano())/1e9, 'f', -1, 64)
+	}
+	return t.UTC().Format(iso8601)
+}
+
+// ParseTimestamp parses a timestamp from a header, in the given format.
+// Timestamps in the other formats are accepted too, as services don't always
+// send the one their models declare.
+func ParseTimestamp(s, format string) (time.Time, error) {
+	formats := []string{format}
+	for _, f := range []string{RFC822Timestamp, ISO8601Timestamp, UnixTimestamp} {
+		if f != format {
+			formats = append(formats, f)
+		}
+	}
+
+	for _, f := range formats {
+		if t, err := parseTimestamp(s, f); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("aws: can't parse %q as a timestamp (%s)", s, format)
+}
+
+func parseTimestamp(s, format string) (time.Time, error) {
+	switch format {
+	case RFC822Timestamp:
+		t, err := http.ParseTime(s)
+		if err != nil {
+			t, err = time.Parse(time.RFC1123Z, s)
+		}
+		return t.UTC(), err
+	case UnixTimestamp:
+		var t FloatTimestamp
+		err := t.UnmarshalJSON([]byte(s))
+		return t.Time, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	return t.UTC(), err
+}
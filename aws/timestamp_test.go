@@ -0,0 +1,56 @@
+package aws_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+func TestFormatTimestamp(t *testing.T) {
+	ts := time.Date(2015, 1, 25, 0, 0, 0, 500000000, time.FixedZone("PST", -8*60*60))
+
+	for format, want := range map[string]string{
+		aws.ISO8601Timestamp: "2015-01-25T08:00:00Z",
+		aws.RFC822Timestamp:  "Sun, 25 Jan 2015 08:00:00 GMT",
+		aws.UnixTimestamp:    "1422172800.5",
+	} {
+		if v := aws.FormatTimestamp(ts, format); v != want {
+			t.Errorf("%s timestamp was %q, but expected %q", format, v, want)
+		}
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		s, format string
+	}{
+		{"Sun, 25 Jan 2015 08:00:00 GMT", aws.RFC822Timestamp},
+		{"Sunday, 25-Jan-15 08:00:00 GMT", aws.RFC822Timestamp},
+		{"Sun, 25 Jan 2015 00:00:00 -0800", aws.RFC822Timestamp},
+		{"2015-01-25T08:00:00Z", aws.ISO8601Timestamp},
+		{"2015-01-25T08:00:00.000Z", aws.ISO8601Timestamp},
+		{"1422172800", aws.UnixTimestamp},
+
+		// services don't always use their declared format
+		{"2015-01-25T08:00:00Z", aws.RFC822Timestamp},
+		{"Sun, 25 Jan 2015 08:00:00 GMT", aws.ISO8601Timestamp},
+	}
+
+	for _, test := range tests {
+		v, err := aws.ParseTimestamp(test.s, test.format)
+		if err != nil {
+			t.Errorf("%q: %v", test.s, err)
+			continue
+		}
+		if !v.Equal(want) || v.Location() != time.UTC {
+			t.Errorf("%q was parsed as %v, but expected %v", test.s, v, want)
+		}
+	}
+
+	if _, err := aws.ParseTimestamp("tomorrow", aws.RFC822Timestamp); err == nil {
+		t.Error("An invalid timestamp was parsed, but expected an error")
+	}
+}
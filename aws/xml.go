@@ -176,3 +176,51 @@ func parseXMLTag(t string) xmlFieldInfo {
 		omit: omit,
 	}
 }
+
+// UnmarshalXMLMap decodes XML into m, a pointer to a map with string keys,
+// which encoding/xml can't do itself. It's used by the UnmarshalXML methods of
+// the map types of Query and EC2 responses, given the names of the key and
+// value elements of the map's entries.
+//
+// The start element is either a single entry, as each of the elements of a
+// flattened map is, or wraps a list of entries.
+func UnmarshalXMLMap(d *xml.Decoder, start xml.StartElement, m interface{}, key, value string) error {
+	mv := reflect.ValueOf(m).Elem()
+	if mv.IsNil() {
+		mv.Set(reflect.MakeMap(mv.Type()))
+	}
+
+	var k *string
+	v := reflect.New(mv.Type().Elem())
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case key:
+				k = new(string)
+				if err := d.DecodeElement(k, &t); err != nil {
+					return err
+				}
+			case value:
+				if err := d.DecodeElement(v.Interface(), &t); err != nil {
+					return err
+				}
+			default:
+				// an entry of a wrapped map
+				if err := UnmarshalXMLMap(d, t, m, key, value); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			if k != nil {
+				mv.SetMapIndex(reflect.ValueOf(*k).Convert(mv.Type().Key()), v.Elem())
+			}
+			return nil
+		}
+	}
+}
@@ -2,6 +2,7 @@ package aws_test
 
 import (
 	"encoding/xml"
+	"reflect"
 	"testing"
 
 	"github.com/timesking/aws-go/aws"
@@ -33,3 +34,58 @@ func TestMarshalingXML(t *testing.T) {
 		t.Errorf("XML was \n%s\n but expected \n%s", v, want)
 	}
 }
+
+type xmlMap map[string]string
+
+func (m *xmlMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "Name", "Value")
+}
+
+type xmlStruct struct {
+	Value aws.StringValue `xml:"Value"`
+}
+
+type xmlStructMap map[string]xmlStruct
+
+func (m *xmlStructMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
+func TestUnmarshalXMLMap(t *testing.T) {
+	var resp struct {
+		Flattened xmlMap       `xml:"Result>Attribute"`
+		Wrapped   xmlStructMap `xml:"Result>Attributes"`
+		Empty     xmlMap       `xml:"Result>Empty"`
+		Missing   xmlMap       `xml:"Result>Missing"`
+	}
+
+	body := `<Response><Result>
+  <Attribute><Name>a</Name><Value>1</Value></Attribute>
+  <Attribute><Value>2</Value><Name>b</Name></Attribute>
+  <Attribute><Name>c</Name></Attribute>
+  <Attributes>
+    <entry><key>x</key><value><Value>3</Value></value></entry>
+    <entry><key>y</key><value></value></entry>
+  </Attributes>
+  <Empty></Empty>
+</Result></Response>`
+	if err := xml.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.Flattened, (xmlMap{"a": "1", "b": "2", "c": ""}); !reflect.DeepEqual(v, want) {
+		t.Errorf("Flattened map was %v, but expected %v", v, want)
+	}
+
+	if v, want := resp.Wrapped, (xmlStructMap{"x": {Value: aws.String("3")}, "y": {}}); !reflect.DeepEqual(v, want) {
+		t.Errorf("Wrapped map was %v, but expected %v", v, want)
+	}
+
+	if v := resp.Empty; v == nil || len(v) != 0 {
+		t.Errorf("Empty map was %#v, but expected an empty map", v)
+	}
+
+	if v := resp.Missing; v != nil {
+		t.Errorf("Missing map was %#v, but expected nil", v)
+	}
+}
@@ -0,0 +1,34 @@
+// Command aws-gen-goretry parses a JSON description of the AWS retry policies
+// and generates a Go file which returns a service's retry policy.
+//
+//     aws-gen-goretry apis/_retry.json gen/retry/retry.go
+package main
+
+import (
+	"os"
+
+	"github.com/timesking/aws-go/model"
+)
+
+func main() {
+	in, err := os.Open(os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	defer in.Close()
+
+	var retries model.Retries
+	if err := retries.Parse(in); err != nil {
+		panic(err)
+	}
+
+	out, err := os.Create(os.Args[2])
+	if err != nil {
+		panic(err)
+	}
+	defer out.Close()
+
+	if err := retries.Generate(out); err != nil {
+		panic(err)
+	}
+}
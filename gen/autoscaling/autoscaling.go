@@ -4,11 +4,21 @@
 package autoscaling
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // AutoScaling is a client for Auto Scaling.
@@ -16,35 +26,83 @@ type AutoScaling struct {
 	client *aws.QueryClient
 }
 
-// New returns a new AutoScaling client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *AutoScaling {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "autoscaling"
-	endpoint, service, region := endpoints.Lookup("autoscaling", region)
+// New returns a new AutoScaling client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *AutoScaling {
+	cfg := newConfig(region, client, opts)
 
 	return &AutoScaling{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2011-01-01",
 		},
 	}
 }
 
+// NewFromSession returns a new AutoScaling client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *AutoScaling {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("autoscaling", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("autoscaling", "autoscaling"),
+	}, "AWS_ENDPOINT_URL_AUTO_SCALING", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *AutoScaling) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *AutoScaling) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *AutoScaling) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AttachInstances attaches one or more EC2 instances to the specified Auto
 // Scaling group. For more information, see Attach Amazon EC2 Instances to
 // Your Existing Auto Scaling Group in the Auto Scaling Developer Guide
 func (c *AutoScaling) AttachInstances(req *AttachInstancesQuery) (err error) {
+	return c.AttachInstancesWithContext(context.Background(), req)
+}
+
+// AttachInstancesWithContext is like AttachInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) AttachInstancesWithContext(ctx context.Context, req *AttachInstancesQuery) (err error) {
 	// NRE
-	err = c.client.Do("AttachInstances", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AttachInstances", "POST", "/", req, nil)
 	return
 }
 
@@ -61,8 +119,15 @@ func (c *AutoScaling) AttachInstances(req *AttachInstancesQuery) (err error) {
 // lifecycle action For more information, see Auto Scaling Pending State
 // and Auto Scaling Terminating State in the Auto Scaling Developer Guide
 func (c *AutoScaling) CompleteLifecycleAction(req *CompleteLifecycleActionType) (resp *CompleteLifecycleActionResult, err error) {
+	return c.CompleteLifecycleActionWithContext(context.Background(), req)
+}
+
+// CompleteLifecycleActionWithContext is like CompleteLifecycleAction, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) CompleteLifecycleActionWithContext(ctx context.Context, req *CompleteLifecycleActionType) (resp *CompleteLifecycleActionResult, err error) {
 	resp = &CompleteLifecycleActionResult{}
-	err = c.client.Do("CompleteLifecycleAction", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CompleteLifecycleAction", "POST", "/", req, resp)
 	return
 }
 
@@ -72,8 +137,15 @@ func (c *AutoScaling) CompleteLifecycleAction(req *CompleteLifecycleActionType)
 // information about viewing and updating these limits, see
 // DescribeAccountLimits
 func (c *AutoScaling) CreateAutoScalingGroup(req *CreateAutoScalingGroupType) (err error) {
+	return c.CreateAutoScalingGroupWithContext(context.Background(), req)
+}
+
+// CreateAutoScalingGroupWithContext is like CreateAutoScalingGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) CreateAutoScalingGroupWithContext(ctx context.Context, req *CreateAutoScalingGroupType) (err error) {
 	// NRE
-	err = c.client.Do("CreateAutoScalingGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateAutoScalingGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -82,8 +154,15 @@ func (c *AutoScaling) CreateAutoScalingGroup(req *CreateAutoScalingGroupType) (e
 // region, the call fails. For information about viewing and updating these
 // limits, see DescribeAccountLimits
 func (c *AutoScaling) CreateLaunchConfiguration(req *CreateLaunchConfigurationType) (err error) {
+	return c.CreateLaunchConfigurationWithContext(context.Background(), req)
+}
+
+// CreateLaunchConfigurationWithContext is like CreateLaunchConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) CreateLaunchConfigurationWithContext(ctx context.Context, req *CreateLaunchConfigurationType) (err error) {
 	// NRE
-	err = c.client.Do("CreateLaunchConfiguration", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateLaunchConfiguration", "POST", "/", req, nil)
 	return
 }
 
@@ -94,8 +173,15 @@ func (c *AutoScaling) CreateLaunchConfiguration(req *CreateLaunchConfigurationTy
 // information. For more information, see Add, Modify, or Remove Auto
 // Scaling Group Tags in the Auto Scaling Developer Guide
 func (c *AutoScaling) CreateOrUpdateTags(req *CreateOrUpdateTagsType) (err error) {
+	return c.CreateOrUpdateTagsWithContext(context.Background(), req)
+}
+
+// CreateOrUpdateTagsWithContext is like CreateOrUpdateTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) CreateOrUpdateTagsWithContext(ctx context.Context, req *CreateOrUpdateTagsType) (err error) {
 	// NRE
-	err = c.client.Do("CreateOrUpdateTags", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateOrUpdateTags", "POST", "/", req, nil)
 	return
 }
 
@@ -105,8 +191,15 @@ func (c *AutoScaling) CreateOrUpdateTags(req *CreateOrUpdateTagsType) (err error
 // call UpdateAutoScalingGroup to set the minimum and maximum size of the
 // AutoScalingGroup to zero.
 func (c *AutoScaling) DeleteAutoScalingGroup(req *DeleteAutoScalingGroupType) (err error) {
+	return c.DeleteAutoScalingGroupWithContext(context.Background(), req)
+}
+
+// DeleteAutoScalingGroupWithContext is like DeleteAutoScalingGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DeleteAutoScalingGroupWithContext(ctx context.Context, req *DeleteAutoScalingGroupType) (err error) {
 	// NRE
-	err = c.client.Do("DeleteAutoScalingGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteAutoScalingGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -115,8 +208,15 @@ func (c *AutoScaling) DeleteAutoScalingGroup(req *DeleteAutoScalingGroupType) (e
 // When this call completes, the launch configuration is no longer
 // available for use.
 func (c *AutoScaling) DeleteLaunchConfiguration(req *LaunchConfigurationNameType) (err error) {
+	return c.DeleteLaunchConfigurationWithContext(context.Background(), req)
+}
+
+// DeleteLaunchConfigurationWithContext is like DeleteLaunchConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DeleteLaunchConfigurationWithContext(ctx context.Context, req *LaunchConfigurationNameType) (err error) {
 	// NRE
-	err = c.client.Do("DeleteLaunchConfiguration", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteLaunchConfiguration", "POST", "/", req, nil)
 	return
 }
 
@@ -124,36 +224,71 @@ func (c *AutoScaling) DeleteLaunchConfiguration(req *LaunchConfigurationNameType
 // any outstanding lifecycle actions, they are completed first for
 // launching instances, for terminating instances).
 func (c *AutoScaling) DeleteLifecycleHook(req *DeleteLifecycleHookType) (resp *DeleteLifecycleHookResult, err error) {
+	return c.DeleteLifecycleHookWithContext(context.Background(), req)
+}
+
+// DeleteLifecycleHookWithContext is like DeleteLifecycleHook, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DeleteLifecycleHookWithContext(ctx context.Context, req *DeleteLifecycleHookType) (resp *DeleteLifecycleHookResult, err error) {
 	resp = &DeleteLifecycleHookResult{}
-	err = c.client.Do("DeleteLifecycleHook", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteLifecycleHook", "POST", "/", req, resp)
 	return
 }
 
 // DeleteNotificationConfiguration is undocumented.
 func (c *AutoScaling) DeleteNotificationConfiguration(req *DeleteNotificationConfigurationType) (err error) {
+	return c.DeleteNotificationConfigurationWithContext(context.Background(), req)
+}
+
+// DeleteNotificationConfigurationWithContext is like DeleteNotificationConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DeleteNotificationConfigurationWithContext(ctx context.Context, req *DeleteNotificationConfigurationType) (err error) {
 	// NRE
-	err = c.client.Do("DeleteNotificationConfiguration", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteNotificationConfiguration", "POST", "/", req, nil)
 	return
 }
 
 // DeletePolicy is undocumented.
 func (c *AutoScaling) DeletePolicy(req *DeletePolicyType) (err error) {
+	return c.DeletePolicyWithContext(context.Background(), req)
+}
+
+// DeletePolicyWithContext is like DeletePolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DeletePolicyWithContext(ctx context.Context, req *DeletePolicyType) (err error) {
 	// NRE
-	err = c.client.Do("DeletePolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeletePolicy", "POST", "/", req, nil)
 	return
 }
 
 // DeleteScheduledAction is undocumented.
 func (c *AutoScaling) DeleteScheduledAction(req *DeleteScheduledActionType) (err error) {
+	return c.DeleteScheduledActionWithContext(context.Background(), req)
+}
+
+// DeleteScheduledActionWithContext is like DeleteScheduledAction, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DeleteScheduledActionWithContext(ctx context.Context, req *DeleteScheduledActionType) (err error) {
 	// NRE
-	err = c.client.Do("DeleteScheduledAction", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteScheduledAction", "POST", "/", req, nil)
 	return
 }
 
 // DeleteTags is undocumented.
 func (c *AutoScaling) DeleteTags(req *DeleteTagsType) (err error) {
+	return c.DeleteTagsWithContext(context.Background(), req)
+}
+
+// DeleteTagsWithContext is like DeleteTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DeleteTagsWithContext(ctx context.Context, req *DeleteTagsType) (err error) {
 	// NRE
-	err = c.client.Do("DeleteTags", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteTags", "POST", "/", req, nil)
 	return
 }
 
@@ -161,16 +296,30 @@ func (c *AutoScaling) DeleteTags(req *DeleteTagsType) (err error) {
 // for your AWS account. For information about requesting an increase in
 // these limits, see AWS Service Limits
 func (c *AutoScaling) DescribeAccountLimits() (resp *DescribeAccountLimitsResult, err error) {
+	return c.DescribeAccountLimitsWithContext(context.Background())
+}
+
+// DescribeAccountLimitsWithContext is like DescribeAccountLimits, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeAccountLimitsWithContext(ctx context.Context) (resp *DescribeAccountLimitsResult, err error) {
 	resp = &DescribeAccountLimitsResult{}
-	err = c.client.Do("DescribeAccountLimits", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAccountLimits", "POST", "/", nil, resp)
 	return
 }
 
 // DescribeAdjustmentTypes lists the policy adjustment types for use with
 // PutScalingPolicy
 func (c *AutoScaling) DescribeAdjustmentTypes() (resp *DescribeAdjustmentTypesResult, err error) {
+	return c.DescribeAdjustmentTypesWithContext(context.Background())
+}
+
+// DescribeAdjustmentTypesWithContext is like DescribeAdjustmentTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeAdjustmentTypesWithContext(ctx context.Context) (resp *DescribeAdjustmentTypesResult, err error) {
 	resp = &DescribeAdjustmentTypesResult{}
-	err = c.client.Do("DescribeAdjustmentTypes", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAdjustmentTypes", "POST", "/", nil, resp)
 	return
 }
 
@@ -181,8 +330,15 @@ func (c *AutoScaling) DescribeAdjustmentTypes() (resp *DescribeAdjustmentTypesRe
 // token. To get the next set of items, repeat the call with the returned
 // token in the NextToken parameter.
 func (c *AutoScaling) DescribeAutoScalingGroups(req *AutoScalingGroupNamesType) (resp *DescribeAutoScalingGroupsResult, err error) {
+	return c.DescribeAutoScalingGroupsWithContext(context.Background(), req)
+}
+
+// DescribeAutoScalingGroupsWithContext is like DescribeAutoScalingGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeAutoScalingGroupsWithContext(ctx context.Context, req *AutoScalingGroupNamesType) (resp *DescribeAutoScalingGroupsResult, err error) {
 	resp = &DescribeAutoScalingGroupsResult{}
-	err = c.client.Do("DescribeAutoScalingGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAutoScalingGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -193,16 +349,30 @@ func (c *AutoScaling) DescribeAutoScalingGroups(req *AutoScalingGroupNamesType)
 // return, the call returns a token. To get the next set of items, repeat
 // the call with the returned token in the NextToken parameter.
 func (c *AutoScaling) DescribeAutoScalingInstances(req *DescribeAutoScalingInstancesType) (resp *DescribeAutoScalingInstancesResult, err error) {
+	return c.DescribeAutoScalingInstancesWithContext(context.Background(), req)
+}
+
+// DescribeAutoScalingInstancesWithContext is like DescribeAutoScalingInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeAutoScalingInstancesWithContext(ctx context.Context, req *DescribeAutoScalingInstancesType) (resp *DescribeAutoScalingInstancesResult, err error) {
 	resp = &DescribeAutoScalingInstancesResult{}
-	err = c.client.Do("DescribeAutoScalingInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAutoScalingInstances", "POST", "/", req, resp)
 	return
 }
 
 // DescribeAutoScalingNotificationTypes lists the notification types that
 // are supported by Auto Scaling.
 func (c *AutoScaling) DescribeAutoScalingNotificationTypes() (resp *DescribeAutoScalingNotificationTypesResult, err error) {
+	return c.DescribeAutoScalingNotificationTypesWithContext(context.Background())
+}
+
+// DescribeAutoScalingNotificationTypesWithContext is like DescribeAutoScalingNotificationTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeAutoScalingNotificationTypesWithContext(ctx context.Context) (resp *DescribeAutoScalingNotificationTypesResult, err error) {
 	resp = &DescribeAutoScalingNotificationTypesResult{}
-	err = c.client.Do("DescribeAutoScalingNotificationTypes", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAutoScalingNotificationTypes", "POST", "/", nil, resp)
 	return
 }
 
@@ -213,23 +383,44 @@ func (c *AutoScaling) DescribeAutoScalingNotificationTypes() (resp *DescribeAuto
 // call returns a token. To get the next set of items, repeat the call with
 // the returned token in the NextToken parameter.
 func (c *AutoScaling) DescribeLaunchConfigurations(req *LaunchConfigurationNamesType) (resp *DescribeLaunchConfigurationsResult, err error) {
+	return c.DescribeLaunchConfigurationsWithContext(context.Background(), req)
+}
+
+// DescribeLaunchConfigurationsWithContext is like DescribeLaunchConfigurations, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeLaunchConfigurationsWithContext(ctx context.Context, req *LaunchConfigurationNamesType) (resp *DescribeLaunchConfigurationsResult, err error) {
 	resp = &DescribeLaunchConfigurationsResult{}
-	err = c.client.Do("DescribeLaunchConfigurations", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLaunchConfigurations", "POST", "/", req, resp)
 	return
 }
 
 // DescribeLifecycleHookTypes is undocumented.
 func (c *AutoScaling) DescribeLifecycleHookTypes() (resp *DescribeLifecycleHookTypesResult, err error) {
+	return c.DescribeLifecycleHookTypesWithContext(context.Background())
+}
+
+// DescribeLifecycleHookTypesWithContext is like DescribeLifecycleHookTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeLifecycleHookTypesWithContext(ctx context.Context) (resp *DescribeLifecycleHookTypesResult, err error) {
 	resp = &DescribeLifecycleHookTypesResult{}
-	err = c.client.Do("DescribeLifecycleHookTypes", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLifecycleHookTypes", "POST", "/", nil, resp)
 	return
 }
 
 // DescribeLifecycleHooks describes the lifecycle hooks for the specified
 // Auto Scaling group.
 func (c *AutoScaling) DescribeLifecycleHooks(req *DescribeLifecycleHooksType) (resp *DescribeLifecycleHooksResult, err error) {
+	return c.DescribeLifecycleHooksWithContext(context.Background(), req)
+}
+
+// DescribeLifecycleHooksWithContext is like DescribeLifecycleHooks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeLifecycleHooksWithContext(ctx context.Context, req *DescribeLifecycleHooksType) (resp *DescribeLifecycleHooksResult, err error) {
 	resp = &DescribeLifecycleHooksResult{}
-	err = c.client.Do("DescribeLifecycleHooks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLifecycleHooks", "POST", "/", req, resp)
 	return
 }
 
@@ -238,16 +429,30 @@ func (c *AutoScaling) DescribeLifecycleHooks(req *DescribeLifecycleHooksType) (r
 // GroupStandbyInstances metric is not returned by default. You must
 // explicitly request it when calling EnableMetricsCollection
 func (c *AutoScaling) DescribeMetricCollectionTypes() (resp *DescribeMetricCollectionTypesResult, err error) {
+	return c.DescribeMetricCollectionTypesWithContext(context.Background())
+}
+
+// DescribeMetricCollectionTypesWithContext is like DescribeMetricCollectionTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeMetricCollectionTypesWithContext(ctx context.Context) (resp *DescribeMetricCollectionTypesResult, err error) {
 	resp = &DescribeMetricCollectionTypesResult{}
-	err = c.client.Do("DescribeMetricCollectionTypes", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeMetricCollectionTypes", "POST", "/", nil, resp)
 	return
 }
 
 // DescribeNotificationConfigurations describes the notification actions
 // associated with the specified Auto Scaling group.
 func (c *AutoScaling) DescribeNotificationConfigurations(req *DescribeNotificationConfigurationsType) (resp *DescribeNotificationConfigurationsResult, err error) {
+	return c.DescribeNotificationConfigurationsWithContext(context.Background(), req)
+}
+
+// DescribeNotificationConfigurationsWithContext is like DescribeNotificationConfigurations, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeNotificationConfigurationsWithContext(ctx context.Context, req *DescribeNotificationConfigurationsType) (resp *DescribeNotificationConfigurationsResult, err error) {
 	resp = &DescribeNotificationConfigurationsResult{}
-	err = c.client.Do("DescribeNotificationConfigurations", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeNotificationConfigurations", "POST", "/", req, resp)
 	return
 }
 
@@ -257,8 +462,15 @@ func (c *AutoScaling) DescribeNotificationConfigurations(req *DescribeNotificati
 // token. To get the next set of items, repeat the call with the returned
 // token in the NextToken parameter.
 func (c *AutoScaling) DescribePolicies(req *DescribePoliciesType) (resp *DescribePoliciesResult, err error) {
+	return c.DescribePoliciesWithContext(context.Background(), req)
+}
+
+// DescribePoliciesWithContext is like DescribePolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribePoliciesWithContext(ctx context.Context, req *DescribePoliciesType) (resp *DescribePoliciesResult, err error) {
 	resp = &DescribePoliciesResult{}
-	err = c.client.Do("DescribePolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribePolicies", "POST", "/", req, resp)
 	return
 }
 
@@ -271,16 +483,30 @@ func (c *AutoScaling) DescribePolicies(req *DescribePoliciesType) (resp *Describ
 // get the next set of items, repeat the call with the returned token in
 // the NextToken parameter.
 func (c *AutoScaling) DescribeScalingActivities(req *DescribeScalingActivitiesType) (resp *DescribeScalingActivitiesResult, err error) {
+	return c.DescribeScalingActivitiesWithContext(context.Background(), req)
+}
+
+// DescribeScalingActivitiesWithContext is like DescribeScalingActivities, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeScalingActivitiesWithContext(ctx context.Context, req *DescribeScalingActivitiesType) (resp *DescribeScalingActivitiesResult, err error) {
 	resp = &DescribeScalingActivitiesResult{}
-	err = c.client.Do("DescribeScalingActivities", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeScalingActivities", "POST", "/", req, resp)
 	return
 }
 
 // DescribeScalingProcessTypes returns scaling process types for use in the
 // ResumeProcesses and SuspendProcesses actions.
 func (c *AutoScaling) DescribeScalingProcessTypes() (resp *DescribeScalingProcessTypesResult, err error) {
+	return c.DescribeScalingProcessTypesWithContext(context.Background())
+}
+
+// DescribeScalingProcessTypesWithContext is like DescribeScalingProcessTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeScalingProcessTypesWithContext(ctx context.Context) (resp *DescribeScalingProcessTypesResult, err error) {
 	resp = &DescribeScalingProcessTypesResult{}
-	err = c.client.Do("DescribeScalingProcessTypes", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeScalingProcessTypes", "POST", "/", nil, resp)
 	return
 }
 
@@ -288,8 +514,15 @@ func (c *AutoScaling) DescribeScalingProcessTypes() (resp *DescribeScalingProces
 // Scaling group that haven't been executed. To list the actions that were
 // already executed, use DescribeScalingActivities
 func (c *AutoScaling) DescribeScheduledActions(req *DescribeScheduledActionsType) (resp *DescribeScheduledActionsResult, err error) {
+	return c.DescribeScheduledActionsWithContext(context.Background(), req)
+}
+
+// DescribeScheduledActionsWithContext is like DescribeScheduledActions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeScheduledActionsWithContext(ctx context.Context, req *DescribeScheduledActionsType) (resp *DescribeScheduledActionsResult, err error) {
 	resp = &DescribeScheduledActionsResult{}
-	err = c.client.Do("DescribeScheduledActions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeScheduledActions", "POST", "/", req, resp)
 	return
 }
 
@@ -301,16 +534,30 @@ func (c *AutoScaling) DescribeScheduledActions(req *DescribeScheduledActionsType
 // information for a particular tag only if it matches all the filters. If
 // there's no match, no special message is returned.
 func (c *AutoScaling) DescribeTags(req *DescribeTagsType) (resp *DescribeTagsResult, err error) {
+	return c.DescribeTagsWithContext(context.Background(), req)
+}
+
+// DescribeTagsWithContext is like DescribeTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeTagsWithContext(ctx context.Context, req *DescribeTagsType) (resp *DescribeTagsResult, err error) {
 	resp = &DescribeTagsResult{}
-	err = c.client.Do("DescribeTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTags", "POST", "/", req, resp)
 	return
 }
 
 // DescribeTerminationPolicyTypes lists the termination policies supported
 // by Auto Scaling.
 func (c *AutoScaling) DescribeTerminationPolicyTypes() (resp *DescribeTerminationPolicyTypesResult, err error) {
+	return c.DescribeTerminationPolicyTypesWithContext(context.Background())
+}
+
+// DescribeTerminationPolicyTypesWithContext is like DescribeTerminationPolicyTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DescribeTerminationPolicyTypesWithContext(ctx context.Context) (resp *DescribeTerminationPolicyTypesResult, err error) {
 	resp = &DescribeTerminationPolicyTypesResult{}
-	err = c.client.Do("DescribeTerminationPolicyTypes", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTerminationPolicyTypes", "POST", "/", nil, resp)
 	return
 }
 
@@ -320,16 +567,30 @@ func (c *AutoScaling) DescribeTerminationPolicyTypes() (resp *DescribeTerminatio
 // information, see Detach EC2 Instances from Your Auto Scaling Group in
 // the Auto Scaling Developer Guide
 func (c *AutoScaling) DetachInstances(req *DetachInstancesQuery) (resp *DetachInstancesResult, err error) {
+	return c.DetachInstancesWithContext(context.Background(), req)
+}
+
+// DetachInstancesWithContext is like DetachInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DetachInstancesWithContext(ctx context.Context, req *DetachInstancesQuery) (resp *DetachInstancesResult, err error) {
 	resp = &DetachInstancesResult{}
-	err = c.client.Do("DetachInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DetachInstances", "POST", "/", req, resp)
 	return
 }
 
 // DisableMetricsCollection disables monitoring of the specified metrics
 // for the specified Auto Scaling group.
 func (c *AutoScaling) DisableMetricsCollection(req *DisableMetricsCollectionQuery) (err error) {
+	return c.DisableMetricsCollectionWithContext(context.Background(), req)
+}
+
+// DisableMetricsCollectionWithContext is like DisableMetricsCollection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) DisableMetricsCollectionWithContext(ctx context.Context, req *DisableMetricsCollectionQuery) (err error) {
 	// NRE
-	err = c.client.Do("DisableMetricsCollection", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisableMetricsCollection", "POST", "/", req, nil)
 	return
 }
 
@@ -338,8 +599,15 @@ func (c *AutoScaling) DisableMetricsCollection(req *DisableMetricsCollectionQuer
 // if InstanceMonitoring in the launch configuration for the group is set
 // to True
 func (c *AutoScaling) EnableMetricsCollection(req *EnableMetricsCollectionQuery) (err error) {
+	return c.EnableMetricsCollectionWithContext(context.Background(), req)
+}
+
+// EnableMetricsCollectionWithContext is like EnableMetricsCollection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) EnableMetricsCollectionWithContext(ctx context.Context, req *EnableMetricsCollectionQuery) (err error) {
 	// NRE
-	err = c.client.Do("EnableMetricsCollection", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "EnableMetricsCollection", "POST", "/", req, nil)
 	return
 }
 
@@ -347,15 +615,29 @@ func (c *AutoScaling) EnableMetricsCollection(req *EnableMetricsCollectionQuery)
 // information, see Auto Scaling InService State in the Auto Scaling
 // Developer Guide
 func (c *AutoScaling) EnterStandby(req *EnterStandbyQuery) (resp *EnterStandbyResult, err error) {
+	return c.EnterStandbyWithContext(context.Background(), req)
+}
+
+// EnterStandbyWithContext is like EnterStandby, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) EnterStandbyWithContext(ctx context.Context, req *EnterStandbyQuery) (resp *EnterStandbyResult, err error) {
 	resp = &EnterStandbyResult{}
-	err = c.client.Do("EnterStandby", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EnterStandby", "POST", "/", req, resp)
 	return
 }
 
 // ExecutePolicy is undocumented.
 func (c *AutoScaling) ExecutePolicy(req *ExecutePolicyType) (err error) {
+	return c.ExecutePolicyWithContext(context.Background(), req)
+}
+
+// ExecutePolicyWithContext is like ExecutePolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) ExecutePolicyWithContext(ctx context.Context, req *ExecutePolicyType) (err error) {
 	// NRE
-	err = c.client.Do("ExecutePolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ExecutePolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -363,8 +645,15 @@ func (c *AutoScaling) ExecutePolicy(req *ExecutePolicyType) (err error) {
 // information, see Auto Scaling InService State in the Auto Scaling
 // Developer Guide
 func (c *AutoScaling) ExitStandby(req *ExitStandbyQuery) (resp *ExitStandbyResult, err error) {
+	return c.ExitStandbyWithContext(context.Background(), req)
+}
+
+// ExitStandbyWithContext is like ExitStandby, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) ExitStandbyWithContext(ctx context.Context, req *ExitStandbyQuery) (resp *ExitStandbyResult, err error) {
 	resp = &ExitStandbyResult{}
-	err = c.client.Do("ExitStandby", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ExitStandby", "POST", "/", req, resp)
 	return
 }
 
@@ -383,8 +672,15 @@ func (c *AutoScaling) ExitStandby(req *ExitStandbyQuery) (resp *ExitStandbyResul
 // lifecycle action. For more information, see Auto Scaling Pending State
 // and Auto Scaling Terminating State in the Auto Scaling Developer Guide
 func (c *AutoScaling) PutLifecycleHook(req *PutLifecycleHookType) (resp *PutLifecycleHookResult, err error) {
+	return c.PutLifecycleHookWithContext(context.Background(), req)
+}
+
+// PutLifecycleHookWithContext is like PutLifecycleHook, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) PutLifecycleHookWithContext(ctx context.Context, req *PutLifecycleHookType) (resp *PutLifecycleHookResult, err error) {
 	resp = &PutLifecycleHookResult{}
-	err = c.client.Do("PutLifecycleHook", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PutLifecycleHook", "POST", "/", req, resp)
 	return
 }
 
@@ -395,8 +691,15 @@ func (c *AutoScaling) PutLifecycleHook(req *PutLifecycleHookType) (resp *PutLife
 // Notifications When Your Auto Scaling Group Changes in the Auto Scaling
 // Developer Guide This configuration overwrites an existing configuration.
 func (c *AutoScaling) PutNotificationConfiguration(req *PutNotificationConfigurationType) (err error) {
+	return c.PutNotificationConfigurationWithContext(context.Background(), req)
+}
+
+// PutNotificationConfigurationWithContext is like PutNotificationConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) PutNotificationConfigurationWithContext(ctx context.Context, req *PutNotificationConfigurationType) (err error) {
 	// NRE
-	err = c.client.Do("PutNotificationConfiguration", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutNotificationConfiguration", "POST", "/", req, nil)
 	return
 }
 
@@ -405,8 +708,15 @@ func (c *AutoScaling) PutNotificationConfiguration(req *PutNotificationConfigura
 // parameters you want to change. Any existing parameter not changed in an
 // update to an existing policy is not changed in this update request.
 func (c *AutoScaling) PutScalingPolicy(req *PutScalingPolicyType) (resp *PutScalingPolicyResult, err error) {
+	return c.PutScalingPolicyWithContext(context.Background(), req)
+}
+
+// PutScalingPolicyWithContext is like PutScalingPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) PutScalingPolicyWithContext(ctx context.Context, req *PutScalingPolicyType) (resp *PutScalingPolicyResult, err error) {
 	resp = &PutScalingPolicyResult{}
-	err = c.client.Do("PutScalingPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PutScalingPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -418,8 +728,15 @@ func (c *AutoScaling) PutScalingPolicy(req *PutScalingPolicyType) (resp *PutScal
 // Auto Scaling supports the date and time expressed in
 // "YYYY-MM-DDThh:mm:ssZ" format in only.
 func (c *AutoScaling) PutScheduledUpdateGroupAction(req *PutScheduledUpdateGroupActionType) (err error) {
+	return c.PutScheduledUpdateGroupActionWithContext(context.Background(), req)
+}
+
+// PutScheduledUpdateGroupActionWithContext is like PutScheduledUpdateGroupAction, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) PutScheduledUpdateGroupActionWithContext(ctx context.Context, req *PutScheduledUpdateGroupActionType) (err error) {
 	// NRE
-	err = c.client.Do("PutScheduledUpdateGroupAction", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutScheduledUpdateGroupAction", "POST", "/", req, nil)
 	return
 }
 
@@ -437,8 +754,15 @@ func (c *AutoScaling) PutScheduledUpdateGroupAction(req *PutScheduledUpdateGroup
 // lifecycle action. For more information, see Auto Scaling Pending State
 // and Auto Scaling Terminating State in the Auto Scaling Developer Guide
 func (c *AutoScaling) RecordLifecycleActionHeartbeat(req *RecordLifecycleActionHeartbeatType) (resp *RecordLifecycleActionHeartbeatResult, err error) {
+	return c.RecordLifecycleActionHeartbeatWithContext(context.Background(), req)
+}
+
+// RecordLifecycleActionHeartbeatWithContext is like RecordLifecycleActionHeartbeat, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) RecordLifecycleActionHeartbeatWithContext(ctx context.Context, req *RecordLifecycleActionHeartbeatType) (resp *RecordLifecycleActionHeartbeatResult, err error) {
 	resp = &RecordLifecycleActionHeartbeatResult{}
-	err = c.client.Do("RecordLifecycleActionHeartbeat", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RecordLifecycleActionHeartbeat", "POST", "/", req, resp)
 	return
 }
 
@@ -448,23 +772,44 @@ func (c *AutoScaling) RecordLifecycleActionHeartbeat(req *RecordLifecycleActionH
 // ScalingProcesses parameter. For more information, see Suspend and Resume
 // Auto Scaling Processes in the Auto Scaling Developer Guide
 func (c *AutoScaling) ResumeProcesses(req *ScalingProcessQuery) (err error) {
+	return c.ResumeProcessesWithContext(context.Background(), req)
+}
+
+// ResumeProcessesWithContext is like ResumeProcesses, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) ResumeProcessesWithContext(ctx context.Context, req *ScalingProcessQuery) (err error) {
 	// NRE
-	err = c.client.Do("ResumeProcesses", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ResumeProcesses", "POST", "/", req, nil)
 	return
 }
 
 // SetDesiredCapacity is undocumented.
 func (c *AutoScaling) SetDesiredCapacity(req *SetDesiredCapacityType) (err error) {
+	return c.SetDesiredCapacityWithContext(context.Background(), req)
+}
+
+// SetDesiredCapacityWithContext is like SetDesiredCapacity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) SetDesiredCapacityWithContext(ctx context.Context, req *SetDesiredCapacityType) (err error) {
 	// NRE
-	err = c.client.Do("SetDesiredCapacity", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetDesiredCapacity", "POST", "/", req, nil)
 	return
 }
 
 // SetInstanceHealth sets the health status of the specified instance. For
 // more information, see Health Checks in the Auto Scaling Developer Guide
 func (c *AutoScaling) SetInstanceHealth(req *SetInstanceHealthQuery) (err error) {
+	return c.SetInstanceHealthWithContext(context.Background(), req)
+}
+
+// SetInstanceHealthWithContext is like SetInstanceHealth, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) SetInstanceHealthWithContext(ctx context.Context, req *SetInstanceHealthQuery) (err error) {
 	// NRE
-	err = c.client.Do("SetInstanceHealth", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetInstanceHealth", "POST", "/", req, nil)
 	return
 }
 
@@ -477,8 +822,15 @@ func (c *AutoScaling) SetInstanceHealth(req *SetInstanceHealthQuery) (err error)
 // ResumeProcesses For more information, see Suspend and Resume Auto
 // Scaling Processes in the Auto Scaling Developer Guide
 func (c *AutoScaling) SuspendProcesses(req *ScalingProcessQuery) (err error) {
+	return c.SuspendProcessesWithContext(context.Background(), req)
+}
+
+// SuspendProcessesWithContext is like SuspendProcesses, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) SuspendProcessesWithContext(ctx context.Context, req *ScalingProcessQuery) (err error) {
 	// NRE
-	err = c.client.Do("SuspendProcesses", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SuspendProcesses", "POST", "/", req, nil)
 	return
 }
 
@@ -486,8 +838,15 @@ func (c *AutoScaling) SuspendProcesses(req *ScalingProcessQuery) (err error) {
 // and optionally adjusts the desired group size. This call simply makes a
 // termination request. The instances is not terminated immediately.
 func (c *AutoScaling) TerminateInstanceInAutoScalingGroup(req *TerminateInstanceInAutoScalingGroupType) (resp *TerminateInstanceInAutoScalingGroupResult, err error) {
+	return c.TerminateInstanceInAutoScalingGroupWithContext(context.Background(), req)
+}
+
+// TerminateInstanceInAutoScalingGroupWithContext is like TerminateInstanceInAutoScalingGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) TerminateInstanceInAutoScalingGroupWithContext(ctx context.Context, req *TerminateInstanceInAutoScalingGroupType) (resp *TerminateInstanceInAutoScalingGroupResult, err error) {
 	resp = &TerminateInstanceInAutoScalingGroupResult{}
-	err = c.client.Do("TerminateInstanceInAutoScalingGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "TerminateInstanceInAutoScalingGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -511,14 +870,21 @@ func (c *AutoScaling) TerminateInstanceInAutoScalingGroup(req *TerminateInstance
 // SetDesiredCapacity to set the group to the new MaxSize . All other
 // optional parameters are left unchanged if not passed in the request.
 func (c *AutoScaling) UpdateAutoScalingGroup(req *UpdateAutoScalingGroupType) (err error) {
+	return c.UpdateAutoScalingGroupWithContext(context.Background(), req)
+}
+
+// UpdateAutoScalingGroupWithContext is like UpdateAutoScalingGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *AutoScaling) UpdateAutoScalingGroupWithContext(ctx context.Context, req *UpdateAutoScalingGroupType) (err error) {
 	// NRE
-	err = c.client.Do("UpdateAutoScalingGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateAutoScalingGroup", "POST", "/", req, nil)
 	return
 }
 
 // ActivitiesType is undocumented.
 type ActivitiesType struct {
-	Activities []Activity      `query:"Activities.member" xml:"DescribeScalingActivitiesResult>Activities>member"`
+	Activities []Activity      `query:"Activities,list:member" xml:"DescribeScalingActivitiesResult>Activities>member"`
 	NextToken  aws.StringValue `query:"NextToken" xml:"DescribeScalingActivitiesResult>NextToken"`
 }
 
@@ -555,43 +921,86 @@ type Alarm struct {
 // AttachInstancesQuery is undocumented.
 type AttachInstancesQuery struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	InstanceIDs          []string        `query:"InstanceIds.member" xml:"InstanceIds>member"`
+	InstanceIDs          []string        `query:"InstanceIds,list:member" xml:"InstanceIds>member"`
+}
+
+// MarshalQuery adds the parameters of the AttachInstancesQuery to the form, prefixing their names.
+func (v *AttachInstancesQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.InstanceIDs != nil {
+		if len(v.InstanceIDs) == 0 {
+			form.Set(prefix+"InstanceIds", "")
+		}
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceIds.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // AutoScalingGroup is undocumented.
 type AutoScalingGroup struct {
 	AutoScalingGroupARN     aws.StringValue    `query:"AutoScalingGroupARN" xml:"AutoScalingGroupARN"`
 	AutoScalingGroupName    aws.StringValue    `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	AvailabilityZones       []string           `query:"AvailabilityZones.member" xml:"AvailabilityZones>member"`
+	AvailabilityZones       []string           `query:"AvailabilityZones,list:member" xml:"AvailabilityZones>member"`
 	CreatedTime             time.Time          `query:"CreatedTime" xml:"CreatedTime"`
 	DefaultCooldown         aws.IntegerValue   `query:"DefaultCooldown" xml:"DefaultCooldown"`
 	DesiredCapacity         aws.IntegerValue   `query:"DesiredCapacity" xml:"DesiredCapacity"`
-	EnabledMetrics          []EnabledMetric    `query:"EnabledMetrics.member" xml:"EnabledMetrics>member"`
+	EnabledMetrics          []EnabledMetric    `query:"EnabledMetrics,list:member" xml:"EnabledMetrics>member"`
 	HealthCheckGracePeriod  aws.IntegerValue   `query:"HealthCheckGracePeriod" xml:"HealthCheckGracePeriod"`
 	HealthCheckType         aws.StringValue    `query:"HealthCheckType" xml:"HealthCheckType"`
-	Instances               []Instance         `query:"Instances.member" xml:"Instances>member"`
+	Instances               []Instance         `query:"Instances,list:member" xml:"Instances>member"`
 	LaunchConfigurationName aws.StringValue    `query:"LaunchConfigurationName" xml:"LaunchConfigurationName"`
-	LoadBalancerNames       []string           `query:"LoadBalancerNames.member" xml:"LoadBalancerNames>member"`
+	LoadBalancerNames       []string           `query:"LoadBalancerNames,list:member" xml:"LoadBalancerNames>member"`
 	MaxSize                 aws.IntegerValue   `query:"MaxSize" xml:"MaxSize"`
 	MinSize                 aws.IntegerValue   `query:"MinSize" xml:"MinSize"`
 	PlacementGroup          aws.StringValue    `query:"PlacementGroup" xml:"PlacementGroup"`
 	Status                  aws.StringValue    `query:"Status" xml:"Status"`
-	SuspendedProcesses      []SuspendedProcess `query:"SuspendedProcesses.member" xml:"SuspendedProcesses>member"`
-	Tags                    []TagDescription   `query:"Tags.member" xml:"Tags>member"`
-	TerminationPolicies     []string           `query:"TerminationPolicies.member" xml:"TerminationPolicies>member"`
+	SuspendedProcesses      []SuspendedProcess `query:"SuspendedProcesses,list:member" xml:"SuspendedProcesses>member"`
+	Tags                    []TagDescription   `query:"Tags,list:member" xml:"Tags>member"`
+	TerminationPolicies     []string           `query:"TerminationPolicies,list:member" xml:"TerminationPolicies>member"`
 	VPCZoneIdentifier       aws.StringValue    `query:"VPCZoneIdentifier" xml:"VPCZoneIdentifier"`
 }
 
 // AutoScalingGroupNamesType is undocumented.
 type AutoScalingGroupNamesType struct {
-	AutoScalingGroupNames []string         `query:"AutoScalingGroupNames.member" xml:"AutoScalingGroupNames>member"`
+	AutoScalingGroupNames []string         `query:"AutoScalingGroupNames,list:member" xml:"AutoScalingGroupNames>member"`
 	MaxRecords            aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken             aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the AutoScalingGroupNamesType to the form, prefixing their names.
+func (v *AutoScalingGroupNamesType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupNames != nil {
+		if len(v.AutoScalingGroupNames) == 0 {
+			form.Set(prefix+"AutoScalingGroupNames", "")
+		}
+		for i1 := range v.AutoScalingGroupNames {
+			name1 := prefix + "AutoScalingGroupNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AutoScalingGroupNames[i1])
+		}
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // AutoScalingGroupsType is undocumented.
 type AutoScalingGroupsType struct {
-	AutoScalingGroups []AutoScalingGroup `query:"AutoScalingGroups.member" xml:"DescribeAutoScalingGroupsResult>AutoScalingGroups>member"`
+	AutoScalingGroups []AutoScalingGroup `query:"AutoScalingGroups,list:member" xml:"DescribeAutoScalingGroupsResult>AutoScalingGroups>member"`
 	NextToken         aws.StringValue    `query:"NextToken" xml:"DescribeAutoScalingGroupsResult>NextToken"`
 }
 
@@ -607,7 +1016,7 @@ type AutoScalingInstanceDetails struct {
 
 // AutoScalingInstancesType is undocumented.
 type AutoScalingInstancesType struct {
-	AutoScalingInstances []AutoScalingInstanceDetails `query:"AutoScalingInstances.member" xml:"DescribeAutoScalingInstancesResult>AutoScalingInstances>member"`
+	AutoScalingInstances []AutoScalingInstanceDetails `query:"AutoScalingInstances,list:member" xml:"DescribeAutoScalingInstancesResult>AutoScalingInstances>member"`
 	NextToken            aws.StringValue              `query:"NextToken" xml:"DescribeAutoScalingInstancesResult>NextToken"`
 }
 
@@ -619,6 +1028,28 @@ type BlockDeviceMapping struct {
 	VirtualName aws.StringValue  `query:"VirtualName" xml:"VirtualName"`
 }
 
+// MarshalQuery adds the parameters of the BlockDeviceMapping to the form, prefixing their names.
+func (v *BlockDeviceMapping) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DeviceName != nil {
+		form.Set(prefix+"DeviceName", *v.DeviceName)
+	}
+	if v.EBS != nil {
+		if err := v.EBS.MarshalQuery(form, prefix+"Ebs."); err != nil {
+			return err
+		}
+	}
+	if v.NoDevice != nil {
+		form.Set(prefix+"NoDevice", strconv.FormatBool(*v.NoDevice))
+	}
+	if v.VirtualName != nil {
+		form.Set(prefix+"VirtualName", *v.VirtualName)
+	}
+	return nil
+}
+
 // CompleteLifecycleActionAnswer is undocumented.
 type CompleteLifecycleActionAnswer struct {
 }
@@ -631,29 +1062,128 @@ type CompleteLifecycleActionType struct {
 	LifecycleHookName     aws.StringValue `query:"LifecycleHookName" xml:"LifecycleHookName"`
 }
 
+// MarshalQuery adds the parameters of the CompleteLifecycleActionType to the form, prefixing their names.
+func (v *CompleteLifecycleActionType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.LifecycleActionResult != nil {
+		form.Set(prefix+"LifecycleActionResult", *v.LifecycleActionResult)
+	}
+	if v.LifecycleActionToken != nil {
+		form.Set(prefix+"LifecycleActionToken", *v.LifecycleActionToken)
+	}
+	if v.LifecycleHookName != nil {
+		form.Set(prefix+"LifecycleHookName", *v.LifecycleHookName)
+	}
+	return nil
+}
+
 // CreateAutoScalingGroupType is undocumented.
 type CreateAutoScalingGroupType struct {
 	AutoScalingGroupName    aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	AvailabilityZones       []string         `query:"AvailabilityZones.member" xml:"AvailabilityZones>member"`
+	AvailabilityZones       []string         `query:"AvailabilityZones,list:member" xml:"AvailabilityZones>member"`
 	DefaultCooldown         aws.IntegerValue `query:"DefaultCooldown" xml:"DefaultCooldown"`
 	DesiredCapacity         aws.IntegerValue `query:"DesiredCapacity" xml:"DesiredCapacity"`
 	HealthCheckGracePeriod  aws.IntegerValue `query:"HealthCheckGracePeriod" xml:"HealthCheckGracePeriod"`
 	HealthCheckType         aws.StringValue  `query:"HealthCheckType" xml:"HealthCheckType"`
 	InstanceID              aws.StringValue  `query:"InstanceId" xml:"InstanceId"`
 	LaunchConfigurationName aws.StringValue  `query:"LaunchConfigurationName" xml:"LaunchConfigurationName"`
-	LoadBalancerNames       []string         `query:"LoadBalancerNames.member" xml:"LoadBalancerNames>member"`
+	LoadBalancerNames       []string         `query:"LoadBalancerNames,list:member" xml:"LoadBalancerNames>member"`
 	MaxSize                 aws.IntegerValue `query:"MaxSize" xml:"MaxSize"`
 	MinSize                 aws.IntegerValue `query:"MinSize" xml:"MinSize"`
 	PlacementGroup          aws.StringValue  `query:"PlacementGroup" xml:"PlacementGroup"`
-	Tags                    []Tag            `query:"Tags.member" xml:"Tags>member"`
-	TerminationPolicies     []string         `query:"TerminationPolicies.member" xml:"TerminationPolicies>member"`
+	Tags                    []Tag            `query:"Tags,list:member" xml:"Tags>member"`
+	TerminationPolicies     []string         `query:"TerminationPolicies,list:member" xml:"TerminationPolicies>member"`
 	VPCZoneIdentifier       aws.StringValue  `query:"VPCZoneIdentifier" xml:"VPCZoneIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the CreateAutoScalingGroupType to the form, prefixing their names.
+func (v *CreateAutoScalingGroupType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.AvailabilityZones != nil {
+		if len(v.AvailabilityZones) == 0 {
+			form.Set(prefix+"AvailabilityZones", "")
+		}
+		for i1 := range v.AvailabilityZones {
+			name1 := prefix + "AvailabilityZones.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AvailabilityZones[i1])
+		}
+	}
+	if v.DefaultCooldown != nil {
+		form.Set(prefix+"DefaultCooldown", strconv.FormatInt(int64(*v.DefaultCooldown), 10))
+	}
+	if v.DesiredCapacity != nil {
+		form.Set(prefix+"DesiredCapacity", strconv.FormatInt(int64(*v.DesiredCapacity), 10))
+	}
+	if v.HealthCheckGracePeriod != nil {
+		form.Set(prefix+"HealthCheckGracePeriod", strconv.FormatInt(int64(*v.HealthCheckGracePeriod), 10))
+	}
+	if v.HealthCheckType != nil {
+		form.Set(prefix+"HealthCheckType", *v.HealthCheckType)
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.LaunchConfigurationName != nil {
+		form.Set(prefix+"LaunchConfigurationName", *v.LaunchConfigurationName)
+	}
+	if v.LoadBalancerNames != nil {
+		if len(v.LoadBalancerNames) == 0 {
+			form.Set(prefix+"LoadBalancerNames", "")
+		}
+		for i1 := range v.LoadBalancerNames {
+			name1 := prefix + "LoadBalancerNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.LoadBalancerNames[i1])
+		}
+	}
+	if v.MaxSize != nil {
+		form.Set(prefix+"MaxSize", strconv.FormatInt(int64(*v.MaxSize), 10))
+	}
+	if v.MinSize != nil {
+		form.Set(prefix+"MinSize", strconv.FormatInt(int64(*v.MinSize), 10))
+	}
+	if v.PlacementGroup != nil {
+		form.Set(prefix+"PlacementGroup", *v.PlacementGroup)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TerminationPolicies != nil {
+		if len(v.TerminationPolicies) == 0 {
+			form.Set(prefix+"TerminationPolicies", "")
+		}
+		for i1 := range v.TerminationPolicies {
+			name1 := prefix + "TerminationPolicies.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TerminationPolicies[i1])
+		}
+	}
+	if v.VPCZoneIdentifier != nil {
+		form.Set(prefix+"VPCZoneIdentifier", *v.VPCZoneIdentifier)
+	}
+	return nil
+}
+
 // CreateLaunchConfigurationType is undocumented.
 type CreateLaunchConfigurationType struct {
 	AssociatePublicIPAddress aws.BooleanValue     `query:"AssociatePublicIpAddress" xml:"AssociatePublicIpAddress"`
-	BlockDeviceMappings      []BlockDeviceMapping `query:"BlockDeviceMappings.member" xml:"BlockDeviceMappings>member"`
+	BlockDeviceMappings      []BlockDeviceMapping `query:"BlockDeviceMappings,list:member" xml:"BlockDeviceMappings>member"`
 	EBSOptimized             aws.BooleanValue     `query:"EbsOptimized" xml:"EbsOptimized"`
 	IAMInstanceProfile       aws.StringValue      `query:"IamInstanceProfile" xml:"IamInstanceProfile"`
 	ImageID                  aws.StringValue      `query:"ImageId" xml:"ImageId"`
@@ -665,14 +1195,105 @@ type CreateLaunchConfigurationType struct {
 	LaunchConfigurationName  aws.StringValue      `query:"LaunchConfigurationName" xml:"LaunchConfigurationName"`
 	PlacementTenancy         aws.StringValue      `query:"PlacementTenancy" xml:"PlacementTenancy"`
 	RAMDiskID                aws.StringValue      `query:"RamdiskId" xml:"RamdiskId"`
-	SecurityGroups           []string             `query:"SecurityGroups.member" xml:"SecurityGroups>member"`
+	SecurityGroups           []string             `query:"SecurityGroups,list:member" xml:"SecurityGroups>member"`
 	SpotPrice                aws.StringValue      `query:"SpotPrice" xml:"SpotPrice"`
 	UserData                 aws.StringValue      `query:"UserData" xml:"UserData"`
 }
 
+// MarshalQuery adds the parameters of the CreateLaunchConfigurationType to the form, prefixing their names.
+func (v *CreateLaunchConfigurationType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssociatePublicIPAddress != nil {
+		form.Set(prefix+"AssociatePublicIpAddress", strconv.FormatBool(*v.AssociatePublicIPAddress))
+	}
+	if v.BlockDeviceMappings != nil {
+		if len(v.BlockDeviceMappings) == 0 {
+			form.Set(prefix+"BlockDeviceMappings", "")
+		}
+		for i1 := range v.BlockDeviceMappings {
+			name1 := prefix + "BlockDeviceMappings.member." + strconv.Itoa(i1+1)
+			if err := v.BlockDeviceMappings[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.EBSOptimized != nil {
+		form.Set(prefix+"EbsOptimized", strconv.FormatBool(*v.EBSOptimized))
+	}
+	if v.IAMInstanceProfile != nil {
+		form.Set(prefix+"IamInstanceProfile", *v.IAMInstanceProfile)
+	}
+	if v.ImageID != nil {
+		form.Set(prefix+"ImageId", *v.ImageID)
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.InstanceMonitoring != nil {
+		if err := v.InstanceMonitoring.MarshalQuery(form, prefix+"InstanceMonitoring."); err != nil {
+			return err
+		}
+	}
+	if v.InstanceType != nil {
+		form.Set(prefix+"InstanceType", *v.InstanceType)
+	}
+	if v.KernelID != nil {
+		form.Set(prefix+"KernelId", *v.KernelID)
+	}
+	if v.KeyName != nil {
+		form.Set(prefix+"KeyName", *v.KeyName)
+	}
+	if v.LaunchConfigurationName != nil {
+		form.Set(prefix+"LaunchConfigurationName", *v.LaunchConfigurationName)
+	}
+	if v.PlacementTenancy != nil {
+		form.Set(prefix+"PlacementTenancy", *v.PlacementTenancy)
+	}
+	if v.RAMDiskID != nil {
+		form.Set(prefix+"RamdiskId", *v.RAMDiskID)
+	}
+	if v.SecurityGroups != nil {
+		if len(v.SecurityGroups) == 0 {
+			form.Set(prefix+"SecurityGroups", "")
+		}
+		for i1 := range v.SecurityGroups {
+			name1 := prefix + "SecurityGroups.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroups[i1])
+		}
+	}
+	if v.SpotPrice != nil {
+		form.Set(prefix+"SpotPrice", *v.SpotPrice)
+	}
+	if v.UserData != nil {
+		form.Set(prefix+"UserData", *v.UserData)
+	}
+	return nil
+}
+
 // CreateOrUpdateTagsType is undocumented.
 type CreateOrUpdateTagsType struct {
-	Tags []Tag `query:"Tags.member" xml:"Tags>member"`
+	Tags []Tag `query:"Tags,list:member" xml:"Tags>member"`
+}
+
+// MarshalQuery adds the parameters of the CreateOrUpdateTagsType to the form, prefixing their names.
+func (v *CreateOrUpdateTagsType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DeleteAutoScalingGroupType is undocumented.
@@ -681,6 +1302,20 @@ type DeleteAutoScalingGroupType struct {
 	ForceDelete          aws.BooleanValue `query:"ForceDelete" xml:"ForceDelete"`
 }
 
+// MarshalQuery adds the parameters of the DeleteAutoScalingGroupType to the form, prefixing their names.
+func (v *DeleteAutoScalingGroupType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.ForceDelete != nil {
+		form.Set(prefix+"ForceDelete", strconv.FormatBool(*v.ForceDelete))
+	}
+	return nil
+}
+
 // DeleteLifecycleHookAnswer is undocumented.
 type DeleteLifecycleHookAnswer struct {
 }
@@ -691,27 +1326,102 @@ type DeleteLifecycleHookType struct {
 	LifecycleHookName    aws.StringValue `query:"LifecycleHookName" xml:"LifecycleHookName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteLifecycleHookType to the form, prefixing their names.
+func (v *DeleteLifecycleHookType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.LifecycleHookName != nil {
+		form.Set(prefix+"LifecycleHookName", *v.LifecycleHookName)
+	}
+	return nil
+}
+
 // DeleteNotificationConfigurationType is undocumented.
 type DeleteNotificationConfigurationType struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	TopicARN             aws.StringValue `query:"TopicARN" xml:"TopicARN"`
 }
 
+// MarshalQuery adds the parameters of the DeleteNotificationConfigurationType to the form, prefixing their names.
+func (v *DeleteNotificationConfigurationType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicARN", *v.TopicARN)
+	}
+	return nil
+}
+
 // DeletePolicyType is undocumented.
 type DeletePolicyType struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	PolicyName           aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the DeletePolicyType to the form, prefixing their names.
+func (v *DeletePolicyType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // DeleteScheduledActionType is undocumented.
 type DeleteScheduledActionType struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	ScheduledActionName  aws.StringValue `query:"ScheduledActionName" xml:"ScheduledActionName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteScheduledActionType to the form, prefixing their names.
+func (v *DeleteScheduledActionType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.ScheduledActionName != nil {
+		form.Set(prefix+"ScheduledActionName", *v.ScheduledActionName)
+	}
+	return nil
+}
+
 // DeleteTagsType is undocumented.
 type DeleteTagsType struct {
-	Tags []Tag `query:"Tags.member" xml:"Tags>member"`
+	Tags []Tag `query:"Tags,list:member" xml:"Tags>member"`
+}
+
+// MarshalQuery adds the parameters of the DeleteTagsType to the form, prefixing their names.
+func (v *DeleteTagsType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DescribeAccountLimitsAnswer is undocumented.
@@ -722,110 +1432,328 @@ type DescribeAccountLimitsAnswer struct {
 
 // DescribeAdjustmentTypesAnswer is undocumented.
 type DescribeAdjustmentTypesAnswer struct {
-	AdjustmentTypes []AdjustmentType `query:"AdjustmentTypes.member" xml:"DescribeAdjustmentTypesResult>AdjustmentTypes>member"`
+	AdjustmentTypes []AdjustmentType `query:"AdjustmentTypes,list:member" xml:"DescribeAdjustmentTypesResult>AdjustmentTypes>member"`
 }
 
 // DescribeAutoScalingInstancesType is undocumented.
 type DescribeAutoScalingInstancesType struct {
-	InstanceIDs []string         `query:"InstanceIds.member" xml:"InstanceIds>member"`
+	InstanceIDs []string         `query:"InstanceIds,list:member" xml:"InstanceIds>member"`
 	MaxRecords  aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken   aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the DescribeAutoScalingInstancesType to the form, prefixing their names.
+func (v *DescribeAutoScalingInstancesType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceIDs != nil {
+		if len(v.InstanceIDs) == 0 {
+			form.Set(prefix+"InstanceIds", "")
+		}
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceIds.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // DescribeAutoScalingNotificationTypesAnswer is undocumented.
 type DescribeAutoScalingNotificationTypesAnswer struct {
-	AutoScalingNotificationTypes []string `query:"AutoScalingNotificationTypes.member" xml:"DescribeAutoScalingNotificationTypesResult>AutoScalingNotificationTypes>member"`
+	AutoScalingNotificationTypes []string `query:"AutoScalingNotificationTypes,list:member" xml:"DescribeAutoScalingNotificationTypesResult>AutoScalingNotificationTypes>member"`
 }
 
 // DescribeLifecycleHookTypesAnswer is undocumented.
 type DescribeLifecycleHookTypesAnswer struct {
-	LifecycleHookTypes []string `query:"LifecycleHookTypes.member" xml:"DescribeLifecycleHookTypesResult>LifecycleHookTypes>member"`
+	LifecycleHookTypes []string `query:"LifecycleHookTypes,list:member" xml:"DescribeLifecycleHookTypesResult>LifecycleHookTypes>member"`
 }
 
 // DescribeLifecycleHooksAnswer is undocumented.
 type DescribeLifecycleHooksAnswer struct {
-	LifecycleHooks []LifecycleHook `query:"LifecycleHooks.member" xml:"DescribeLifecycleHooksResult>LifecycleHooks>member"`
+	LifecycleHooks []LifecycleHook `query:"LifecycleHooks,list:member" xml:"DescribeLifecycleHooksResult>LifecycleHooks>member"`
 }
 
 // DescribeLifecycleHooksType is undocumented.
 type DescribeLifecycleHooksType struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	LifecycleHookNames   []string        `query:"LifecycleHookNames.member" xml:"LifecycleHookNames>member"`
+	LifecycleHookNames   []string        `query:"LifecycleHookNames,list:member" xml:"LifecycleHookNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeLifecycleHooksType to the form, prefixing their names.
+func (v *DescribeLifecycleHooksType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.LifecycleHookNames != nil {
+		if len(v.LifecycleHookNames) == 0 {
+			form.Set(prefix+"LifecycleHookNames", "")
+		}
+		for i1 := range v.LifecycleHookNames {
+			name1 := prefix + "LifecycleHookNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.LifecycleHookNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeMetricCollectionTypesAnswer is undocumented.
 type DescribeMetricCollectionTypesAnswer struct {
-	Granularities []MetricGranularityType `query:"Granularities.member" xml:"DescribeMetricCollectionTypesResult>Granularities>member"`
-	Metrics       []MetricCollectionType  `query:"Metrics.member" xml:"DescribeMetricCollectionTypesResult>Metrics>member"`
+	Granularities []MetricGranularityType `query:"Granularities,list:member" xml:"DescribeMetricCollectionTypesResult>Granularities>member"`
+	Metrics       []MetricCollectionType  `query:"Metrics,list:member" xml:"DescribeMetricCollectionTypesResult>Metrics>member"`
 }
 
 // DescribeNotificationConfigurationsAnswer is undocumented.
 type DescribeNotificationConfigurationsAnswer struct {
 	NextToken                  aws.StringValue             `query:"NextToken" xml:"DescribeNotificationConfigurationsResult>NextToken"`
-	NotificationConfigurations []NotificationConfiguration `query:"NotificationConfigurations.member" xml:"DescribeNotificationConfigurationsResult>NotificationConfigurations>member"`
+	NotificationConfigurations []NotificationConfiguration `query:"NotificationConfigurations,list:member" xml:"DescribeNotificationConfigurationsResult>NotificationConfigurations>member"`
 }
 
 // DescribeNotificationConfigurationsType is undocumented.
 type DescribeNotificationConfigurationsType struct {
-	AutoScalingGroupNames []string         `query:"AutoScalingGroupNames.member" xml:"AutoScalingGroupNames>member"`
+	AutoScalingGroupNames []string         `query:"AutoScalingGroupNames,list:member" xml:"AutoScalingGroupNames>member"`
 	MaxRecords            aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken             aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the DescribeNotificationConfigurationsType to the form, prefixing their names.
+func (v *DescribeNotificationConfigurationsType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupNames != nil {
+		if len(v.AutoScalingGroupNames) == 0 {
+			form.Set(prefix+"AutoScalingGroupNames", "")
+		}
+		for i1 := range v.AutoScalingGroupNames {
+			name1 := prefix + "AutoScalingGroupNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AutoScalingGroupNames[i1])
+		}
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // DescribePoliciesType is undocumented.
 type DescribePoliciesType struct {
 	AutoScalingGroupName aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken            aws.StringValue  `query:"NextToken" xml:"NextToken"`
-	PolicyNames          []string         `query:"PolicyNames.member" xml:"PolicyNames>member"`
+	PolicyNames          []string         `query:"PolicyNames,list:member" xml:"PolicyNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribePoliciesType to the form, prefixing their names.
+func (v *DescribePoliciesType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.PolicyNames != nil {
+		if len(v.PolicyNames) == 0 {
+			form.Set(prefix+"PolicyNames", "")
+		}
+		for i1 := range v.PolicyNames {
+			name1 := prefix + "PolicyNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PolicyNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeScalingActivitiesType is undocumented.
 type DescribeScalingActivitiesType struct {
-	ActivityIDs          []string         `query:"ActivityIds.member" xml:"ActivityIds>member"`
+	ActivityIDs          []string         `query:"ActivityIds,list:member" xml:"ActivityIds>member"`
 	AutoScalingGroupName aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken            aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the DescribeScalingActivitiesType to the form, prefixing their names.
+func (v *DescribeScalingActivitiesType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ActivityIDs != nil {
+		if len(v.ActivityIDs) == 0 {
+			form.Set(prefix+"ActivityIds", "")
+		}
+		for i1 := range v.ActivityIDs {
+			name1 := prefix + "ActivityIds.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ActivityIDs[i1])
+		}
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // DescribeScheduledActionsType is undocumented.
 type DescribeScheduledActionsType struct {
 	AutoScalingGroupName aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	EndTime              time.Time        `query:"EndTime" xml:"EndTime"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken            aws.StringValue  `query:"NextToken" xml:"NextToken"`
-	ScheduledActionNames []string         `query:"ScheduledActionNames.member" xml:"ScheduledActionNames>member"`
+	ScheduledActionNames []string         `query:"ScheduledActionNames,list:member" xml:"ScheduledActionNames>member"`
 	StartTime            time.Time        `query:"StartTime" xml:"StartTime"`
 }
 
+// MarshalQuery adds the parameters of the DescribeScheduledActionsType to the form, prefixing their names.
+func (v *DescribeScheduledActionsType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.ScheduledActionNames != nil {
+		if len(v.ScheduledActionNames) == 0 {
+			form.Set(prefix+"ScheduledActionNames", "")
+		}
+		for i1 := range v.ScheduledActionNames {
+			name1 := prefix + "ScheduledActionNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ScheduledActionNames[i1])
+		}
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // DescribeTagsType is undocumented.
 type DescribeTagsType struct {
-	Filters    []Filter         `query:"Filters.member" xml:"Filters>member"`
+	Filters    []Filter         `query:"Filters,list:member" xml:"Filters>member"`
 	MaxRecords aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken  aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the DescribeTagsType to the form, prefixing their names.
+func (v *DescribeTagsType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.member." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // DescribeTerminationPolicyTypesAnswer is undocumented.
 type DescribeTerminationPolicyTypesAnswer struct {
-	TerminationPolicyTypes []string `query:"TerminationPolicyTypes.member" xml:"DescribeTerminationPolicyTypesResult>TerminationPolicyTypes>member"`
+	TerminationPolicyTypes []string `query:"TerminationPolicyTypes,list:member" xml:"DescribeTerminationPolicyTypesResult>TerminationPolicyTypes>member"`
 }
 
 // DetachInstancesAnswer is undocumented.
 type DetachInstancesAnswer struct {
-	Activities []Activity `query:"Activities.member" xml:"DetachInstancesResult>Activities>member"`
+	Activities []Activity `query:"Activities,list:member" xml:"DetachInstancesResult>Activities>member"`
 }
 
 // DetachInstancesQuery is undocumented.
 type DetachInstancesQuery struct {
 	AutoScalingGroupName           aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	InstanceIDs                    []string         `query:"InstanceIds.member" xml:"InstanceIds>member"`
+	InstanceIDs                    []string         `query:"InstanceIds,list:member" xml:"InstanceIds>member"`
 	ShouldDecrementDesiredCapacity aws.BooleanValue `query:"ShouldDecrementDesiredCapacity" xml:"ShouldDecrementDesiredCapacity"`
 }
 
+// MarshalQuery adds the parameters of the DetachInstancesQuery to the form, prefixing their names.
+func (v *DetachInstancesQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.InstanceIDs != nil {
+		if len(v.InstanceIDs) == 0 {
+			form.Set(prefix+"InstanceIds", "")
+		}
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceIds.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	if v.ShouldDecrementDesiredCapacity != nil {
+		form.Set(prefix+"ShouldDecrementDesiredCapacity", strconv.FormatBool(*v.ShouldDecrementDesiredCapacity))
+	}
+	return nil
+}
+
 // DisableMetricsCollectionQuery is undocumented.
 type DisableMetricsCollectionQuery struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	Metrics              []string        `query:"Metrics.member" xml:"Metrics>member"`
+	Metrics              []string        `query:"Metrics,list:member" xml:"Metrics>member"`
+}
+
+// MarshalQuery adds the parameters of the DisableMetricsCollectionQuery to the form, prefixing their names.
+func (v *DisableMetricsCollectionQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.Metrics != nil {
+		if len(v.Metrics) == 0 {
+			form.Set(prefix+"Metrics", "")
+		}
+		for i1 := range v.Metrics {
+			name1 := prefix + "Metrics.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Metrics[i1])
+		}
+	}
+	return nil
 }
 
 // EBS is undocumented.
@@ -837,11 +1765,57 @@ type EBS struct {
 	VolumeType          aws.StringValue  `query:"VolumeType" xml:"VolumeType"`
 }
 
+// MarshalQuery adds the parameters of the EBS to the form, prefixing their names.
+func (v *EBS) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DeleteOnTermination != nil {
+		form.Set(prefix+"DeleteOnTermination", strconv.FormatBool(*v.DeleteOnTermination))
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.SnapshotID != nil {
+		form.Set(prefix+"SnapshotId", *v.SnapshotID)
+	}
+	if v.VolumeSize != nil {
+		form.Set(prefix+"VolumeSize", strconv.FormatInt(int64(*v.VolumeSize), 10))
+	}
+	if v.VolumeType != nil {
+		form.Set(prefix+"VolumeType", *v.VolumeType)
+	}
+	return nil
+}
+
 // EnableMetricsCollectionQuery is undocumented.
 type EnableMetricsCollectionQuery struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	Granularity          aws.StringValue `query:"Granularity" xml:"Granularity"`
-	Metrics              []string        `query:"Metrics.member" xml:"Metrics>member"`
+	Metrics              []string        `query:"Metrics,list:member" xml:"Metrics>member"`
+}
+
+// MarshalQuery adds the parameters of the EnableMetricsCollectionQuery to the form, prefixing their names.
+func (v *EnableMetricsCollectionQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.Granularity != nil {
+		form.Set(prefix+"Granularity", *v.Granularity)
+	}
+	if v.Metrics != nil {
+		if len(v.Metrics) == 0 {
+			form.Set(prefix+"Metrics", "")
+		}
+		for i1 := range v.Metrics {
+			name1 := prefix + "Metrics.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Metrics[i1])
+		}
+	}
+	return nil
 }
 
 // EnabledMetric is undocumented.
@@ -852,16 +1826,39 @@ type EnabledMetric struct {
 
 // EnterStandbyAnswer is undocumented.
 type EnterStandbyAnswer struct {
-	Activities []Activity `query:"Activities.member" xml:"EnterStandbyResult>Activities>member"`
+	Activities []Activity `query:"Activities,list:member" xml:"EnterStandbyResult>Activities>member"`
 }
 
 // EnterStandbyQuery is undocumented.
 type EnterStandbyQuery struct {
 	AutoScalingGroupName           aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	InstanceIDs                    []string         `query:"InstanceIds.member" xml:"InstanceIds>member"`
+	InstanceIDs                    []string         `query:"InstanceIds,list:member" xml:"InstanceIds>member"`
 	ShouldDecrementDesiredCapacity aws.BooleanValue `query:"ShouldDecrementDesiredCapacity" xml:"ShouldDecrementDesiredCapacity"`
 }
 
+// MarshalQuery adds the parameters of the EnterStandbyQuery to the form, prefixing their names.
+func (v *EnterStandbyQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.InstanceIDs != nil {
+		if len(v.InstanceIDs) == 0 {
+			form.Set(prefix+"InstanceIds", "")
+		}
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceIds.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	if v.ShouldDecrementDesiredCapacity != nil {
+		form.Set(prefix+"ShouldDecrementDesiredCapacity", strconv.FormatBool(*v.ShouldDecrementDesiredCapacity))
+	}
+	return nil
+}
+
 // ExecutePolicyType is undocumented.
 type ExecutePolicyType struct {
 	AutoScalingGroupName aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
@@ -869,21 +1866,78 @@ type ExecutePolicyType struct {
 	PolicyName           aws.StringValue  `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the ExecutePolicyType to the form, prefixing their names.
+func (v *ExecutePolicyType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.HonorCooldown != nil {
+		form.Set(prefix+"HonorCooldown", strconv.FormatBool(*v.HonorCooldown))
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // ExitStandbyAnswer is undocumented.
 type ExitStandbyAnswer struct {
-	Activities []Activity `query:"Activities.member" xml:"ExitStandbyResult>Activities>member"`
+	Activities []Activity `query:"Activities,list:member" xml:"ExitStandbyResult>Activities>member"`
 }
 
 // ExitStandbyQuery is undocumented.
 type ExitStandbyQuery struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	InstanceIDs          []string        `query:"InstanceIds.member" xml:"InstanceIds>member"`
+	InstanceIDs          []string        `query:"InstanceIds,list:member" xml:"InstanceIds>member"`
+}
+
+// MarshalQuery adds the parameters of the ExitStandbyQuery to the form, prefixing their names.
+func (v *ExitStandbyQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.InstanceIDs != nil {
+		if len(v.InstanceIDs) == 0 {
+			form.Set(prefix+"InstanceIds", "")
+		}
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceIds.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // Filter is undocumented.
 type Filter struct {
 	Name   aws.StringValue `query:"Name" xml:"Name"`
-	Values []string        `query:"Values.member" xml:"Values>member"`
+	Values []string        `query:"Values,list:member" xml:"Values>member"`
+}
+
+// MarshalQuery adds the parameters of the Filter to the form, prefixing their names.
+func (v *Filter) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Values != nil {
+		if len(v.Values) == 0 {
+			form.Set(prefix+"Values", "")
+		}
+		for i1 := range v.Values {
+			name1 := prefix + "Values.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Values[i1])
+		}
+	}
+	return nil
 }
 
 // Instance is undocumented.
@@ -900,10 +1954,21 @@ type InstanceMonitoring struct {
 	Enabled aws.BooleanValue `query:"Enabled" xml:"Enabled"`
 }
 
+// MarshalQuery adds the parameters of the InstanceMonitoring to the form, prefixing their names.
+func (v *InstanceMonitoring) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	return nil
+}
+
 // LaunchConfiguration is undocumented.
 type LaunchConfiguration struct {
 	AssociatePublicIPAddress aws.BooleanValue     `query:"AssociatePublicIpAddress" xml:"AssociatePublicIpAddress"`
-	BlockDeviceMappings      []BlockDeviceMapping `query:"BlockDeviceMappings.member" xml:"BlockDeviceMappings>member"`
+	BlockDeviceMappings      []BlockDeviceMapping `query:"BlockDeviceMappings,list:member" xml:"BlockDeviceMappings>member"`
 	CreatedTime              time.Time            `query:"CreatedTime" xml:"CreatedTime"`
 	EBSOptimized             aws.BooleanValue     `query:"EbsOptimized" xml:"EbsOptimized"`
 	IAMInstanceProfile       aws.StringValue      `query:"IamInstanceProfile" xml:"IamInstanceProfile"`
@@ -916,7 +1981,7 @@ type LaunchConfiguration struct {
 	LaunchConfigurationName  aws.StringValue      `query:"LaunchConfigurationName" xml:"LaunchConfigurationName"`
 	PlacementTenancy         aws.StringValue      `query:"PlacementTenancy" xml:"PlacementTenancy"`
 	RAMDiskID                aws.StringValue      `query:"RamdiskId" xml:"RamdiskId"`
-	SecurityGroups           []string             `query:"SecurityGroups.member" xml:"SecurityGroups>member"`
+	SecurityGroups           []string             `query:"SecurityGroups,list:member" xml:"SecurityGroups>member"`
 	SpotPrice                aws.StringValue      `query:"SpotPrice" xml:"SpotPrice"`
 	UserData                 aws.StringValue      `query:"UserData" xml:"UserData"`
 }
@@ -926,16 +1991,50 @@ type LaunchConfigurationNameType struct {
 	LaunchConfigurationName aws.StringValue `query:"LaunchConfigurationName" xml:"LaunchConfigurationName"`
 }
 
+// MarshalQuery adds the parameters of the LaunchConfigurationNameType to the form, prefixing their names.
+func (v *LaunchConfigurationNameType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LaunchConfigurationName != nil {
+		form.Set(prefix+"LaunchConfigurationName", *v.LaunchConfigurationName)
+	}
+	return nil
+}
+
 // LaunchConfigurationNamesType is undocumented.
 type LaunchConfigurationNamesType struct {
-	LaunchConfigurationNames []string         `query:"LaunchConfigurationNames.member" xml:"LaunchConfigurationNames>member"`
+	LaunchConfigurationNames []string         `query:"LaunchConfigurationNames,list:member" xml:"LaunchConfigurationNames>member"`
 	MaxRecords               aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken                aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the LaunchConfigurationNamesType to the form, prefixing their names.
+func (v *LaunchConfigurationNamesType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LaunchConfigurationNames != nil {
+		if len(v.LaunchConfigurationNames) == 0 {
+			form.Set(prefix+"LaunchConfigurationNames", "")
+		}
+		for i1 := range v.LaunchConfigurationNames {
+			name1 := prefix + "LaunchConfigurationNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.LaunchConfigurationNames[i1])
+		}
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // LaunchConfigurationsType is undocumented.
 type LaunchConfigurationsType struct {
-	LaunchConfigurations []LaunchConfiguration `query:"LaunchConfigurations.member" xml:"DescribeLaunchConfigurationsResult>LaunchConfigurations>member"`
+	LaunchConfigurations []LaunchConfiguration `query:"LaunchConfigurations,list:member" xml:"DescribeLaunchConfigurationsResult>LaunchConfigurations>member"`
 	NextToken            aws.StringValue       `query:"NextToken" xml:"DescribeLaunchConfigurationsResult>NextToken"`
 }
 
@@ -989,7 +2088,7 @@ type NotificationConfiguration struct {
 // PoliciesType is undocumented.
 type PoliciesType struct {
 	NextToken       aws.StringValue `query:"NextToken" xml:"DescribePoliciesResult>NextToken"`
-	ScalingPolicies []ScalingPolicy `query:"ScalingPolicies.member" xml:"DescribePoliciesResult>ScalingPolicies>member"`
+	ScalingPolicies []ScalingPolicy `query:"ScalingPolicies,list:member" xml:"DescribePoliciesResult>ScalingPolicies>member"`
 }
 
 // PolicyARNType is undocumented.
@@ -1004,7 +2103,7 @@ type ProcessType struct {
 
 // ProcessesType is undocumented.
 type ProcessesType struct {
-	Processes []ProcessType `query:"Processes.member" xml:"DescribeScalingProcessTypesResult>Processes>member"`
+	Processes []ProcessType `query:"Processes,list:member" xml:"DescribeScalingProcessTypesResult>Processes>member"`
 }
 
 // PutLifecycleHookAnswer is undocumented.
@@ -1023,13 +2122,68 @@ type PutLifecycleHookType struct {
 	RoleARN               aws.StringValue  `query:"RoleARN" xml:"RoleARN"`
 }
 
+// MarshalQuery adds the parameters of the PutLifecycleHookType to the form, prefixing their names.
+func (v *PutLifecycleHookType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.DefaultResult != nil {
+		form.Set(prefix+"DefaultResult", *v.DefaultResult)
+	}
+	if v.HeartbeatTimeout != nil {
+		form.Set(prefix+"HeartbeatTimeout", strconv.FormatInt(int64(*v.HeartbeatTimeout), 10))
+	}
+	if v.LifecycleHookName != nil {
+		form.Set(prefix+"LifecycleHookName", *v.LifecycleHookName)
+	}
+	if v.LifecycleTransition != nil {
+		form.Set(prefix+"LifecycleTransition", *v.LifecycleTransition)
+	}
+	if v.NotificationMetadata != nil {
+		form.Set(prefix+"NotificationMetadata", *v.NotificationMetadata)
+	}
+	if v.NotificationTargetARN != nil {
+		form.Set(prefix+"NotificationTargetARN", *v.NotificationTargetARN)
+	}
+	if v.RoleARN != nil {
+		form.Set(prefix+"RoleARN", *v.RoleARN)
+	}
+	return nil
+}
+
 // PutNotificationConfigurationType is undocumented.
 type PutNotificationConfigurationType struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	NotificationTypes    []string        `query:"NotificationTypes.member" xml:"NotificationTypes>member"`
+	NotificationTypes    []string        `query:"NotificationTypes,list:member" xml:"NotificationTypes>member"`
 	TopicARN             aws.StringValue `query:"TopicARN" xml:"TopicARN"`
 }
 
+// MarshalQuery adds the parameters of the PutNotificationConfigurationType to the form, prefixing their names.
+func (v *PutNotificationConfigurationType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.NotificationTypes != nil {
+		if len(v.NotificationTypes) == 0 {
+			form.Set(prefix+"NotificationTypes", "")
+		}
+		for i1 := range v.NotificationTypes {
+			name1 := prefix + "NotificationTypes.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.NotificationTypes[i1])
+		}
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicARN", *v.TopicARN)
+	}
+	return nil
+}
+
 // PutScalingPolicyType is undocumented.
 type PutScalingPolicyType struct {
 	AdjustmentType       aws.StringValue  `query:"AdjustmentType" xml:"AdjustmentType"`
@@ -1040,6 +2194,32 @@ type PutScalingPolicyType struct {
 	ScalingAdjustment    aws.IntegerValue `query:"ScalingAdjustment" xml:"ScalingAdjustment"`
 }
 
+// MarshalQuery adds the parameters of the PutScalingPolicyType to the form, prefixing their names.
+func (v *PutScalingPolicyType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AdjustmentType != nil {
+		form.Set(prefix+"AdjustmentType", *v.AdjustmentType)
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.Cooldown != nil {
+		form.Set(prefix+"Cooldown", strconv.FormatInt(int64(*v.Cooldown), 10))
+	}
+	if v.MinAdjustmentStep != nil {
+		form.Set(prefix+"MinAdjustmentStep", strconv.FormatInt(int64(*v.MinAdjustmentStep), 10))
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.ScalingAdjustment != nil {
+		form.Set(prefix+"ScalingAdjustment", strconv.FormatInt(int64(*v.ScalingAdjustment), 10))
+	}
+	return nil
+}
+
 // PutScheduledUpdateGroupActionType is undocumented.
 type PutScheduledUpdateGroupActionType struct {
 	AutoScalingGroupName aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
@@ -1053,6 +2233,41 @@ type PutScheduledUpdateGroupActionType struct {
 	Time                 time.Time        `query:"Time" xml:"Time"`
 }
 
+// MarshalQuery adds the parameters of the PutScheduledUpdateGroupActionType to the form, prefixing their names.
+func (v *PutScheduledUpdateGroupActionType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.DesiredCapacity != nil {
+		form.Set(prefix+"DesiredCapacity", strconv.FormatInt(int64(*v.DesiredCapacity), 10))
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.MaxSize != nil {
+		form.Set(prefix+"MaxSize", strconv.FormatInt(int64(*v.MaxSize), 10))
+	}
+	if v.MinSize != nil {
+		form.Set(prefix+"MinSize", strconv.FormatInt(int64(*v.MinSize), 10))
+	}
+	if v.Recurrence != nil {
+		form.Set(prefix+"Recurrence", *v.Recurrence)
+	}
+	if v.ScheduledActionName != nil {
+		form.Set(prefix+"ScheduledActionName", *v.ScheduledActionName)
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if !v.Time.IsZero() {
+		form.Set(prefix+"Time", v.Time.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // RecordLifecycleActionHeartbeatAnswer is undocumented.
 type RecordLifecycleActionHeartbeatAnswer struct {
 }
@@ -1064,6 +2279,23 @@ type RecordLifecycleActionHeartbeatType struct {
 	LifecycleHookName    aws.StringValue `query:"LifecycleHookName" xml:"LifecycleHookName"`
 }
 
+// MarshalQuery adds the parameters of the RecordLifecycleActionHeartbeatType to the form, prefixing their names.
+func (v *RecordLifecycleActionHeartbeatType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.LifecycleActionToken != nil {
+		form.Set(prefix+"LifecycleActionToken", *v.LifecycleActionToken)
+	}
+	if v.LifecycleHookName != nil {
+		form.Set(prefix+"LifecycleHookName", *v.LifecycleHookName)
+	}
+	return nil
+}
+
 // Possible values for AutoScaling.
 const (
 	ScalingActivityStatusCodeCancelled                       = "Cancelled"
@@ -1081,7 +2313,7 @@ const (
 // ScalingPolicy is undocumented.
 type ScalingPolicy struct {
 	AdjustmentType       aws.StringValue  `query:"AdjustmentType" xml:"AdjustmentType"`
-	Alarms               []Alarm          `query:"Alarms.member" xml:"Alarms>member"`
+	Alarms               []Alarm          `query:"Alarms,list:member" xml:"Alarms>member"`
 	AutoScalingGroupName aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
 	Cooldown             aws.IntegerValue `query:"Cooldown" xml:"Cooldown"`
 	MinAdjustmentStep    aws.IntegerValue `query:"MinAdjustmentStep" xml:"MinAdjustmentStep"`
@@ -1093,13 +2325,33 @@ type ScalingPolicy struct {
 // ScalingProcessQuery is undocumented.
 type ScalingProcessQuery struct {
 	AutoScalingGroupName aws.StringValue `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	ScalingProcesses     []string        `query:"ScalingProcesses.member" xml:"ScalingProcesses>member"`
+	ScalingProcesses     []string        `query:"ScalingProcesses,list:member" xml:"ScalingProcesses>member"`
+}
+
+// MarshalQuery adds the parameters of the ScalingProcessQuery to the form, prefixing their names.
+func (v *ScalingProcessQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.ScalingProcesses != nil {
+		if len(v.ScalingProcesses) == 0 {
+			form.Set(prefix+"ScalingProcesses", "")
+		}
+		for i1 := range v.ScalingProcesses {
+			name1 := prefix + "ScalingProcesses.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ScalingProcesses[i1])
+		}
+	}
+	return nil
 }
 
 // ScheduledActionsType is undocumented.
 type ScheduledActionsType struct {
 	NextToken                   aws.StringValue              `query:"NextToken" xml:"DescribeScheduledActionsResult>NextToken"`
-	ScheduledUpdateGroupActions []ScheduledUpdateGroupAction `query:"ScheduledUpdateGroupActions.member" xml:"DescribeScheduledActionsResult>ScheduledUpdateGroupActions>member"`
+	ScheduledUpdateGroupActions []ScheduledUpdateGroupAction `query:"ScheduledUpdateGroupActions,list:member" xml:"DescribeScheduledActionsResult>ScheduledUpdateGroupActions>member"`
 }
 
 // ScheduledUpdateGroupAction is undocumented.
@@ -1123,6 +2375,23 @@ type SetDesiredCapacityType struct {
 	HonorCooldown        aws.BooleanValue `query:"HonorCooldown" xml:"HonorCooldown"`
 }
 
+// MarshalQuery adds the parameters of the SetDesiredCapacityType to the form, prefixing their names.
+func (v *SetDesiredCapacityType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.DesiredCapacity != nil {
+		form.Set(prefix+"DesiredCapacity", strconv.FormatInt(int64(*v.DesiredCapacity), 10))
+	}
+	if v.HonorCooldown != nil {
+		form.Set(prefix+"HonorCooldown", strconv.FormatBool(*v.HonorCooldown))
+	}
+	return nil
+}
+
 // SetInstanceHealthQuery is undocumented.
 type SetInstanceHealthQuery struct {
 	HealthStatus             aws.StringValue  `query:"HealthStatus" xml:"HealthStatus"`
@@ -1130,6 +2399,23 @@ type SetInstanceHealthQuery struct {
 	ShouldRespectGracePeriod aws.BooleanValue `query:"ShouldRespectGracePeriod" xml:"ShouldRespectGracePeriod"`
 }
 
+// MarshalQuery adds the parameters of the SetInstanceHealthQuery to the form, prefixing their names.
+func (v *SetInstanceHealthQuery) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HealthStatus != nil {
+		form.Set(prefix+"HealthStatus", *v.HealthStatus)
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.ShouldRespectGracePeriod != nil {
+		form.Set(prefix+"ShouldRespectGracePeriod", strconv.FormatBool(*v.ShouldRespectGracePeriod))
+	}
+	return nil
+}
+
 // SuspendedProcess is undocumented.
 type SuspendedProcess struct {
 	ProcessName      aws.StringValue `query:"ProcessName" xml:"ProcessName"`
@@ -1145,6 +2431,29 @@ type Tag struct {
 	Value             aws.StringValue  `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Tag to the form, prefixing their names.
+func (v *Tag) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.PropagateAtLaunch != nil {
+		form.Set(prefix+"PropagateAtLaunch", strconv.FormatBool(*v.PropagateAtLaunch))
+	}
+	if v.ResourceID != nil {
+		form.Set(prefix+"ResourceId", *v.ResourceID)
+	}
+	if v.ResourceType != nil {
+		form.Set(prefix+"ResourceType", *v.ResourceType)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // TagDescription is undocumented.
 type TagDescription struct {
 	Key               aws.StringValue  `query:"Key" xml:"Key"`
@@ -1157,7 +2466,7 @@ type TagDescription struct {
 // TagsType is undocumented.
 type TagsType struct {
 	NextToken aws.StringValue  `query:"NextToken" xml:"DescribeTagsResult>NextToken"`
-	Tags      []TagDescription `query:"Tags.member" xml:"DescribeTagsResult>Tags>member"`
+	Tags      []TagDescription `query:"Tags,list:member" xml:"DescribeTagsResult>Tags>member"`
 }
 
 // TerminateInstanceInAutoScalingGroupType is undocumented.
@@ -1166,10 +2475,24 @@ type TerminateInstanceInAutoScalingGroupType struct {
 	ShouldDecrementDesiredCapacity aws.BooleanValue `query:"ShouldDecrementDesiredCapacity" xml:"ShouldDecrementDesiredCapacity"`
 }
 
+// MarshalQuery adds the parameters of the TerminateInstanceInAutoScalingGroupType to the form, prefixing their names.
+func (v *TerminateInstanceInAutoScalingGroupType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.ShouldDecrementDesiredCapacity != nil {
+		form.Set(prefix+"ShouldDecrementDesiredCapacity", strconv.FormatBool(*v.ShouldDecrementDesiredCapacity))
+	}
+	return nil
+}
+
 // UpdateAutoScalingGroupType is undocumented.
 type UpdateAutoScalingGroupType struct {
 	AutoScalingGroupName    aws.StringValue  `query:"AutoScalingGroupName" xml:"AutoScalingGroupName"`
-	AvailabilityZones       []string         `query:"AvailabilityZones.member" xml:"AvailabilityZones>member"`
+	AvailabilityZones       []string         `query:"AvailabilityZones,list:member" xml:"AvailabilityZones>member"`
 	DefaultCooldown         aws.IntegerValue `query:"DefaultCooldown" xml:"DefaultCooldown"`
 	DesiredCapacity         aws.IntegerValue `query:"DesiredCapacity" xml:"DesiredCapacity"`
 	HealthCheckGracePeriod  aws.IntegerValue `query:"HealthCheckGracePeriod" xml:"HealthCheckGracePeriod"`
@@ -1178,10 +2501,66 @@ type UpdateAutoScalingGroupType struct {
 	MaxSize                 aws.IntegerValue `query:"MaxSize" xml:"MaxSize"`
 	MinSize                 aws.IntegerValue `query:"MinSize" xml:"MinSize"`
 	PlacementGroup          aws.StringValue  `query:"PlacementGroup" xml:"PlacementGroup"`
-	TerminationPolicies     []string         `query:"TerminationPolicies.member" xml:"TerminationPolicies>member"`
+	TerminationPolicies     []string         `query:"TerminationPolicies,list:member" xml:"TerminationPolicies>member"`
 	VPCZoneIdentifier       aws.StringValue  `query:"VPCZoneIdentifier" xml:"VPCZoneIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the UpdateAutoScalingGroupType to the form, prefixing their names.
+func (v *UpdateAutoScalingGroupType) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoScalingGroupName != nil {
+		form.Set(prefix+"AutoScalingGroupName", *v.AutoScalingGroupName)
+	}
+	if v.AvailabilityZones != nil {
+		if len(v.AvailabilityZones) == 0 {
+			form.Set(prefix+"AvailabilityZones", "")
+		}
+		for i1 := range v.AvailabilityZones {
+			name1 := prefix + "AvailabilityZones.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AvailabilityZones[i1])
+		}
+	}
+	if v.DefaultCooldown != nil {
+		form.Set(prefix+"DefaultCooldown", strconv.FormatInt(int64(*v.DefaultCooldown), 10))
+	}
+	if v.DesiredCapacity != nil {
+		form.Set(prefix+"DesiredCapacity", strconv.FormatInt(int64(*v.DesiredCapacity), 10))
+	}
+	if v.HealthCheckGracePeriod != nil {
+		form.Set(prefix+"HealthCheckGracePeriod", strconv.FormatInt(int64(*v.HealthCheckGracePeriod), 10))
+	}
+	if v.HealthCheckType != nil {
+		form.Set(prefix+"HealthCheckType", *v.HealthCheckType)
+	}
+	if v.LaunchConfigurationName != nil {
+		form.Set(prefix+"LaunchConfigurationName", *v.LaunchConfigurationName)
+	}
+	if v.MaxSize != nil {
+		form.Set(prefix+"MaxSize", strconv.FormatInt(int64(*v.MaxSize), 10))
+	}
+	if v.MinSize != nil {
+		form.Set(prefix+"MinSize", strconv.FormatInt(int64(*v.MinSize), 10))
+	}
+	if v.PlacementGroup != nil {
+		form.Set(prefix+"PlacementGroup", *v.PlacementGroup)
+	}
+	if v.TerminationPolicies != nil {
+		if len(v.TerminationPolicies) == 0 {
+			form.Set(prefix+"TerminationPolicies", "")
+		}
+		for i1 := range v.TerminationPolicies {
+			name1 := prefix + "TerminationPolicies.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TerminationPolicies[i1])
+		}
+	}
+	if v.VPCZoneIdentifier != nil {
+		form.Set(prefix+"VPCZoneIdentifier", *v.VPCZoneIdentifier)
+	}
+	return nil
+}
+
 // CompleteLifecycleActionResult is a wrapper for CompleteLifecycleActionAnswer.
 type CompleteLifecycleActionResult struct {
 }
@@ -1198,101 +2577,101 @@ type DescribeAccountLimitsResult struct {
 
 // DescribeAdjustmentTypesResult is a wrapper for DescribeAdjustmentTypesAnswer.
 type DescribeAdjustmentTypesResult struct {
-	AdjustmentTypes []AdjustmentType `query:"AdjustmentTypes.member" xml:"DescribeAdjustmentTypesResult>AdjustmentTypes>member"`
+	AdjustmentTypes []AdjustmentType `query:"AdjustmentTypes,list:member" xml:"DescribeAdjustmentTypesResult>AdjustmentTypes>member"`
 }
 
 // DescribeAutoScalingGroupsResult is a wrapper for AutoScalingGroupsType.
 type DescribeAutoScalingGroupsResult struct {
-	AutoScalingGroups []AutoScalingGroup `query:"AutoScalingGroups.member" xml:"DescribeAutoScalingGroupsResult>AutoScalingGroups>member"`
+	AutoScalingGroups []AutoScalingGroup `query:"AutoScalingGroups,list:member" xml:"DescribeAutoScalingGroupsResult>AutoScalingGroups>member"`
 	NextToken         aws.StringValue    `query:"NextToken" xml:"DescribeAutoScalingGroupsResult>NextToken"`
 }
 
 // DescribeAutoScalingInstancesResult is a wrapper for AutoScalingInstancesType.
 type DescribeAutoScalingInstancesResult struct {
-	AutoScalingInstances []AutoScalingInstanceDetails `query:"AutoScalingInstances.member" xml:"DescribeAutoScalingInstancesResult>AutoScalingInstances>member"`
+	AutoScalingInstances []AutoScalingInstanceDetails `query:"AutoScalingInstances,list:member" xml:"DescribeAutoScalingInstancesResult>AutoScalingInstances>member"`
 	NextToken            aws.StringValue              `query:"NextToken" xml:"DescribeAutoScalingInstancesResult>NextToken"`
 }
 
 // DescribeAutoScalingNotificationTypesResult is a wrapper for DescribeAutoScalingNotificationTypesAnswer.
 type DescribeAutoScalingNotificationTypesResult struct {
-	AutoScalingNotificationTypes []string `query:"AutoScalingNotificationTypes.member" xml:"DescribeAutoScalingNotificationTypesResult>AutoScalingNotificationTypes>member"`
+	AutoScalingNotificationTypes []string `query:"AutoScalingNotificationTypes,list:member" xml:"DescribeAutoScalingNotificationTypesResult>AutoScalingNotificationTypes>member"`
 }
 
 // DescribeLaunchConfigurationsResult is a wrapper for LaunchConfigurationsType.
 type DescribeLaunchConfigurationsResult struct {
-	LaunchConfigurations []LaunchConfiguration `query:"LaunchConfigurations.member" xml:"DescribeLaunchConfigurationsResult>LaunchConfigurations>member"`
+	LaunchConfigurations []LaunchConfiguration `query:"LaunchConfigurations,list:member" xml:"DescribeLaunchConfigurationsResult>LaunchConfigurations>member"`
 	NextToken            aws.StringValue       `query:"NextToken" xml:"DescribeLaunchConfigurationsResult>NextToken"`
 }
 
 // DescribeLifecycleHookTypesResult is a wrapper for DescribeLifecycleHookTypesAnswer.
 type DescribeLifecycleHookTypesResult struct {
-	LifecycleHookTypes []string `query:"LifecycleHookTypes.member" xml:"DescribeLifecycleHookTypesResult>LifecycleHookTypes>member"`
+	LifecycleHookTypes []string `query:"LifecycleHookTypes,list:member" xml:"DescribeLifecycleHookTypesResult>LifecycleHookTypes>member"`
 }
 
 // DescribeLifecycleHooksResult is a wrapper for DescribeLifecycleHooksAnswer.
 type DescribeLifecycleHooksResult struct {
-	LifecycleHooks []LifecycleHook `query:"LifecycleHooks.member" xml:"DescribeLifecycleHooksResult>LifecycleHooks>member"`
+	LifecycleHooks []LifecycleHook `query:"LifecycleHooks,list:member" xml:"DescribeLifecycleHooksResult>LifecycleHooks>member"`
 }
 
 // DescribeMetricCollectionTypesResult is a wrapper for DescribeMetricCollectionTypesAnswer.
 type DescribeMetricCollectionTypesResult struct {
-	Granularities []MetricGranularityType `query:"Granularities.member" xml:"DescribeMetricCollectionTypesResult>Granularities>member"`
-	Metrics       []MetricCollectionType  `query:"Metrics.member" xml:"DescribeMetricCollectionTypesResult>Metrics>member"`
+	Granularities []MetricGranularityType `query:"Granularities,list:member" xml:"DescribeMetricCollectionTypesResult>Granularities>member"`
+	Metrics       []MetricCollectionType  `query:"Metrics,list:member" xml:"DescribeMetricCollectionTypesResult>Metrics>member"`
 }
 
 // DescribeNotificationConfigurationsResult is a wrapper for DescribeNotificationConfigurationsAnswer.
 type DescribeNotificationConfigurationsResult struct {
 	NextToken                  aws.StringValue             `query:"NextToken" xml:"DescribeNotificationConfigurationsResult>NextToken"`
-	NotificationConfigurations []NotificationConfiguration `query:"NotificationConfigurations.member" xml:"DescribeNotificationConfigurationsResult>NotificationConfigurations>member"`
+	NotificationConfigurations []NotificationConfiguration `query:"NotificationConfigurations,list:member" xml:"DescribeNotificationConfigurationsResult>NotificationConfigurations>member"`
 }
 
 // DescribePoliciesResult is a wrapper for PoliciesType.
 type DescribePoliciesResult struct {
 	NextToken       aws.StringValue `query:"NextToken" xml:"DescribePoliciesResult>NextToken"`
-	ScalingPolicies []ScalingPolicy `query:"ScalingPolicies.member" xml:"DescribePoliciesResult>ScalingPolicies>member"`
+	ScalingPolicies []ScalingPolicy `query:"ScalingPolicies,list:member" xml:"DescribePoliciesResult>ScalingPolicies>member"`
 }
 
 // DescribeScalingActivitiesResult is a wrapper for ActivitiesType.
 type DescribeScalingActivitiesResult struct {
-	Activities []Activity      `query:"Activities.member" xml:"DescribeScalingActivitiesResult>Activities>member"`
+	Activities []Activity      `query:"Activities,list:member" xml:"DescribeScalingActivitiesResult>Activities>member"`
 	NextToken  aws.StringValue `query:"NextToken" xml:"DescribeScalingActivitiesResult>NextToken"`
 }
 
 // DescribeScalingProcessTypesResult is a wrapper for ProcessesType.
 type DescribeScalingProcessTypesResult struct {
-	Processes []ProcessType `query:"Processes.member" xml:"DescribeScalingProcessTypesResult>Processes>member"`
+	Processes []ProcessType `query:"Processes,list:member" xml:"DescribeScalingProcessTypesResult>Processes>member"`
 }
 
 // DescribeScheduledActionsResult is a wrapper for ScheduledActionsType.
 type DescribeScheduledActionsResult struct {
 	NextToken                   aws.StringValue              `query:"NextToken" xml:"DescribeScheduledActionsResult>NextToken"`
-	ScheduledUpdateGroupActions []ScheduledUpdateGroupAction `query:"ScheduledUpdateGroupActions.member" xml:"DescribeScheduledActionsResult>ScheduledUpdateGroupActions>member"`
+	ScheduledUpdateGroupActions []ScheduledUpdateGroupAction `query:"ScheduledUpdateGroupActions,list:member" xml:"DescribeScheduledActionsResult>ScheduledUpdateGroupActions>member"`
 }
 
 // DescribeTagsResult is a wrapper for TagsType.
 type DescribeTagsResult struct {
 	NextToken aws.StringValue  `query:"NextToken" xml:"DescribeTagsResult>NextToken"`
-	Tags      []TagDescription `query:"Tags.member" xml:"DescribeTagsResult>Tags>member"`
+	Tags      []TagDescription `query:"Tags,list:member" xml:"DescribeTagsResult>Tags>member"`
 }
 
 // DescribeTerminationPolicyTypesResult is a wrapper for DescribeTerminationPolicyTypesAnswer.
 type DescribeTerminationPolicyTypesResult struct {
-	TerminationPolicyTypes []string `query:"TerminationPolicyTypes.member" xml:"DescribeTerminationPolicyTypesResult>TerminationPolicyTypes>member"`
+	TerminationPolicyTypes []string `query:"TerminationPolicyTypes,list:member" xml:"DescribeTerminationPolicyTypesResult>TerminationPolicyTypes>member"`
 }
 
 // DetachInstancesResult is a wrapper for DetachInstancesAnswer.
 type DetachInstancesResult struct {
-	Activities []Activity `query:"Activities.member" xml:"DetachInstancesResult>Activities>member"`
+	Activities []Activity `query:"Activities,list:member" xml:"DetachInstancesResult>Activities>member"`
 }
 
 // EnterStandbyResult is a wrapper for EnterStandbyAnswer.
 type EnterStandbyResult struct {
-	Activities []Activity `query:"Activities.member" xml:"EnterStandbyResult>Activities>member"`
+	Activities []Activity `query:"Activities,list:member" xml:"EnterStandbyResult>Activities>member"`
 }
 
 // ExitStandbyResult is a wrapper for ExitStandbyAnswer.
 type ExitStandbyResult struct {
-	Activities []Activity `query:"Activities.member" xml:"ExitStandbyResult>Activities>member"`
+	Activities []Activity `query:"Activities,list:member" xml:"ExitStandbyResult>Activities>member"`
 }
 
 // PutLifecycleHookResult is a wrapper for PutLifecycleHookAnswer.
@@ -1313,5 +2692,45 @@ type TerminateInstanceInAutoScalingGroupResult struct {
 	Activity *Activity `query:"Activity" xml:"TerminateInstanceInAutoScalingGroupResult>Activity"`
 }
 
+// AlreadyExistsFault is the error returned for the AlreadyExists error code.
+type AlreadyExistsFault struct {
+	aws.APIError
+}
+
+// InvalidNextToken is the error returned for the InvalidNextToken error code.
+type InvalidNextToken struct {
+	aws.APIError
+}
+
+// LimitExceededFault is the error returned for the LimitExceeded error code.
+type LimitExceededFault struct {
+	aws.APIError
+}
+
+// ResourceInUseFault is the error returned for the ResourceInUse error code.
+type ResourceInUseFault struct {
+	aws.APIError
+}
+
+// ScalingActivityInProgressFault is the error returned for the ScalingActivityInProgress error code.
+type ScalingActivityInProgressFault struct {
+	aws.APIError
+}
+
+// exceptions maps AutoScaling's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AlreadyExists":             AlreadyExistsFault{},
+	"InvalidNextToken":          InvalidNextToken{},
+	"LimitExceeded":             LimitExceededFault{},
+	"ResourceInUse":             ResourceInUseFault{},
+	"ScalingActivityInProgress": ScalingActivityInProgressFault{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,11 +4,21 @@
 package cloudformation
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // CloudFormation is a client for AWS CloudFormation.
@@ -16,36 +26,84 @@ type CloudFormation struct {
 	client *aws.QueryClient
 }
 
-// New returns a new CloudFormation client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CloudFormation {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "cloudformation"
-	endpoint, service, region := endpoints.Lookup("cloudformation", region)
+// New returns a new CloudFormation client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CloudFormation {
+	cfg := newConfig(region, client, opts)
 
 	return &CloudFormation{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2010-05-15",
 		},
 	}
 }
 
+// NewFromSession returns a new CloudFormation client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CloudFormation {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("cloudformation", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("cloudformation", "cloudformation"),
+	}, "AWS_ENDPOINT_URL_CLOUDFORMATION", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CloudFormation) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CloudFormation) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *CloudFormation) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // CancelUpdateStack cancels an update on the specified stack. If the call
 // completes successfully, the stack will roll back the update and revert
 // to the previous stack configuration. Only stacks that are in the state
 // can be canceled.
 func (c *CloudFormation) CancelUpdateStack(req *CancelUpdateStackInput) (err error) {
+	return c.CancelUpdateStackWithContext(context.Background(), req)
+}
+
+// CancelUpdateStackWithContext is like CancelUpdateStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) CancelUpdateStackWithContext(ctx context.Context, req *CancelUpdateStackInput) (err error) {
 	// NRE
-	err = c.client.Do("CancelUpdateStack", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CancelUpdateStack", "POST", "/", req, nil)
 	return
 }
 
@@ -53,8 +111,15 @@ func (c *CloudFormation) CancelUpdateStack(req *CancelUpdateStackInput) (err err
 // completes successfully, the stack creation starts. You can check the
 // status of the stack via the DescribeStacks
 func (c *CloudFormation) CreateStack(req *CreateStackInput) (resp *CreateStackResult, err error) {
+	return c.CreateStackWithContext(context.Background(), req)
+}
+
+// CreateStackWithContext is like CreateStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) CreateStackWithContext(ctx context.Context, req *CreateStackInput) (resp *CreateStackResult, err error) {
 	resp = &CreateStackResult{}
-	err = c.client.Do("CreateStack", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateStack", "POST", "/", req, resp)
 	return
 }
 
@@ -62,8 +127,15 @@ func (c *CloudFormation) CreateStack(req *CreateStackInput) (resp *CreateStackRe
 // successfully, stack deletion starts. Deleted stacks do not show up in
 // the DescribeStacks API if the deletion has been completed successfully.
 func (c *CloudFormation) DeleteStack(req *DeleteStackInput) (err error) {
+	return c.DeleteStackWithContext(context.Background(), req)
+}
+
+// DeleteStackWithContext is like DeleteStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) DeleteStackWithContext(ctx context.Context, req *DeleteStackInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteStack", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteStack", "POST", "/", req, nil)
 	return
 }
 
@@ -73,8 +145,15 @@ func (c *CloudFormation) DeleteStack(req *DeleteStackInput) (err error) {
 // that have failed to create or have been deleted by specifying the unique
 // stack identifier (stack
 func (c *CloudFormation) DescribeStackEvents(req *DescribeStackEventsInput) (resp *DescribeStackEventsResult, err error) {
+	return c.DescribeStackEventsWithContext(context.Background(), req)
+}
+
+// DescribeStackEventsWithContext is like DescribeStackEvents, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) DescribeStackEventsWithContext(ctx context.Context, req *DescribeStackEventsInput) (resp *DescribeStackEventsResult, err error) {
 	resp = &DescribeStackEventsResult{}
-	err = c.client.Do("DescribeStackEvents", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStackEvents", "POST", "/", req, resp)
 	return
 }
 
@@ -82,8 +161,15 @@ func (c *CloudFormation) DescribeStackEvents(req *DescribeStackEventsInput) (res
 // the specified stack. For deleted stacks, DescribeStackResource returns
 // resource information for up to 90 days after the stack has been deleted.
 func (c *CloudFormation) DescribeStackResource(req *DescribeStackResourceInput) (resp *DescribeStackResourceResult, err error) {
+	return c.DescribeStackResourceWithContext(context.Background(), req)
+}
+
+// DescribeStackResourceWithContext is like DescribeStackResource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) DescribeStackResourceWithContext(ctx context.Context, req *DescribeStackResourceInput) (resp *DescribeStackResourceResult, err error) {
 	resp = &DescribeStackResourceResult{}
-	err = c.client.Do("DescribeStackResource", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStackResource", "POST", "/", req, resp)
 	return
 }
 
@@ -102,8 +188,15 @@ func (c *CloudFormation) DescribeStackResource(req *DescribeStackResourceInput)
 // User Guide A ValidationError is returned if you specify both StackName
 // and PhysicalResourceId in the same request.
 func (c *CloudFormation) DescribeStackResources(req *DescribeStackResourcesInput) (resp *DescribeStackResourcesResult, err error) {
+	return c.DescribeStackResourcesWithContext(context.Background(), req)
+}
+
+// DescribeStackResourcesWithContext is like DescribeStackResources, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) DescribeStackResourcesWithContext(ctx context.Context, req *DescribeStackResourcesInput) (resp *DescribeStackResourcesResult, err error) {
 	resp = &DescribeStackResourcesResult{}
-	err = c.client.Do("DescribeStackResources", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStackResources", "POST", "/", req, resp)
 	return
 }
 
@@ -111,8 +204,15 @@ func (c *CloudFormation) DescribeStackResources(req *DescribeStackResourcesInput
 // stack name was specified, then it returns the description for all the
 // stacks created.
 func (c *CloudFormation) DescribeStacks(req *DescribeStacksInput) (resp *DescribeStacksResult, err error) {
+	return c.DescribeStacksWithContext(context.Background(), req)
+}
+
+// DescribeStacksWithContext is like DescribeStacks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) DescribeStacksWithContext(ctx context.Context, req *DescribeStacksInput) (resp *DescribeStacksResult, err error) {
 	resp = &DescribeStacksResult{}
-	err = c.client.Do("DescribeStacks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStacks", "POST", "/", req, resp)
 	return
 }
 
@@ -120,16 +220,30 @@ func (c *CloudFormation) DescribeStacks(req *DescribeStacksInput) (resp *Describ
 // The return value is an AWS Simple Monthly Calculator URL with a query
 // string that describes the resources required to run the template.
 func (c *CloudFormation) EstimateTemplateCost(req *EstimateTemplateCostInput) (resp *EstimateTemplateCostResult, err error) {
+	return c.EstimateTemplateCostWithContext(context.Background(), req)
+}
+
+// EstimateTemplateCostWithContext is like EstimateTemplateCost, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) EstimateTemplateCostWithContext(ctx context.Context, req *EstimateTemplateCostInput) (resp *EstimateTemplateCostResult, err error) {
 	resp = &EstimateTemplateCostResult{}
-	err = c.client.Do("EstimateTemplateCost", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EstimateTemplateCost", "POST", "/", req, resp)
 	return
 }
 
 // GetStackPolicy returns the stack policy for a specified stack. If a
 // stack doesn't have a policy, a null value is returned.
 func (c *CloudFormation) GetStackPolicy(req *GetStackPolicyInput) (resp *GetStackPolicyResult, err error) {
+	return c.GetStackPolicyWithContext(context.Background(), req)
+}
+
+// GetStackPolicyWithContext is like GetStackPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) GetStackPolicyWithContext(ctx context.Context, req *GetStackPolicyInput) (resp *GetStackPolicyResult, err error) {
 	resp = &GetStackPolicyResult{}
-	err = c.client.Do("GetStackPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetStackPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -139,8 +253,15 @@ func (c *CloudFormation) GetStackPolicy(req *GetStackPolicyInput) (resp *GetStac
 // been deleted. If the template does not exist, a ValidationError is
 // returned.
 func (c *CloudFormation) GetTemplate(req *GetTemplateInput) (resp *GetTemplateResult, err error) {
+	return c.GetTemplateWithContext(context.Background(), req)
+}
+
+// GetTemplateWithContext is like GetTemplate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) GetTemplateWithContext(ctx context.Context, req *GetTemplateInput) (resp *GetTemplateResult, err error) {
 	resp = &GetTemplateResult{}
-	err = c.client.Do("GetTemplate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetTemplate", "POST", "/", req, resp)
 	return
 }
 
@@ -154,8 +275,15 @@ func (c *CloudFormation) GetTemplate(req *GetTemplateInput) (resp *GetTemplateRe
 // been deleted. If the template does not exist, a ValidationError is
 // returned.
 func (c *CloudFormation) GetTemplateSummary(req *GetTemplateSummaryInput) (resp *GetTemplateSummaryResult, err error) {
+	return c.GetTemplateSummaryWithContext(context.Background(), req)
+}
+
+// GetTemplateSummaryWithContext is like GetTemplateSummary, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) GetTemplateSummaryWithContext(ctx context.Context, req *GetTemplateSummaryInput) (resp *GetTemplateSummaryResult, err error) {
 	resp = &GetTemplateSummaryResult{}
-	err = c.client.Do("GetTemplateSummary", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetTemplateSummary", "POST", "/", req, resp)
 	return
 }
 
@@ -163,8 +291,15 @@ func (c *CloudFormation) GetTemplateSummary(req *GetTemplateSummaryInput) (resp
 // specified stack. For deleted stacks, ListStackResources returns resource
 // information for up to 90 days after the stack has been deleted.
 func (c *CloudFormation) ListStackResources(req *ListStackResourcesInput) (resp *ListStackResourcesResult, err error) {
+	return c.ListStackResourcesWithContext(context.Background(), req)
+}
+
+// ListStackResourcesWithContext is like ListStackResources, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) ListStackResourcesWithContext(ctx context.Context, req *ListStackResourcesInput) (resp *ListStackResourcesResult, err error) {
 	resp = &ListStackResourcesResult{}
-	err = c.client.Do("ListStackResources", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListStackResources", "POST", "/", req, resp)
 	return
 }
 
@@ -175,15 +310,29 @@ func (c *CloudFormation) ListStackResources(req *ListStackResourcesInput) (resp
 // is returned (including existing stacks and stacks that have been
 // deleted).
 func (c *CloudFormation) ListStacks(req *ListStacksInput) (resp *ListStacksResult, err error) {
+	return c.ListStacksWithContext(context.Background(), req)
+}
+
+// ListStacksWithContext is like ListStacks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) ListStacksWithContext(ctx context.Context, req *ListStacksInput) (resp *ListStacksResult, err error) {
 	resp = &ListStacksResult{}
-	err = c.client.Do("ListStacks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListStacks", "POST", "/", req, resp)
 	return
 }
 
 // SetStackPolicy is undocumented.
 func (c *CloudFormation) SetStackPolicy(req *SetStackPolicyInput) (err error) {
+	return c.SetStackPolicyWithContext(context.Background(), req)
+}
+
+// SetStackPolicyWithContext is like SetStackPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) SetStackPolicyWithContext(ctx context.Context, req *SetStackPolicyInput) (err error) {
 	// NRE
-	err = c.client.Do("SetStackPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetStackPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -195,8 +344,15 @@ func (c *CloudFormation) SetStackPolicy(req *SetStackPolicyInput) (err error) {
 // SignalResource API is useful in cases where you want to send signals
 // from anywhere other than an Amazon EC2 instance.
 func (c *CloudFormation) SignalResource(req *SignalResourceInput) (err error) {
+	return c.SignalResourceWithContext(context.Background(), req)
+}
+
+// SignalResourceWithContext is like SignalResource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) SignalResourceWithContext(ctx context.Context, req *SignalResourceInput) (err error) {
 	// NRE
-	err = c.client.Do("SignalResource", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SignalResource", "POST", "/", req, nil)
 	return
 }
 
@@ -209,15 +365,29 @@ func (c *CloudFormation) SignalResource(req *SignalResourceInput) (err error) {
 // information about creating an update template, updating a stack, and
 // monitoring the progress of the update, see Updating a Stack
 func (c *CloudFormation) UpdateStack(req *UpdateStackInput) (resp *UpdateStackResult, err error) {
+	return c.UpdateStackWithContext(context.Background(), req)
+}
+
+// UpdateStackWithContext is like UpdateStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) UpdateStackWithContext(ctx context.Context, req *UpdateStackInput) (resp *UpdateStackResult, err error) {
 	resp = &UpdateStackResult{}
-	err = c.client.Do("UpdateStack", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateStack", "POST", "/", req, resp)
 	return
 }
 
 // ValidateTemplate is undocumented.
 func (c *CloudFormation) ValidateTemplate(req *ValidateTemplateInput) (resp *ValidateTemplateResult, err error) {
+	return c.ValidateTemplateWithContext(context.Background(), req)
+}
+
+// ValidateTemplateWithContext is like ValidateTemplate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFormation) ValidateTemplateWithContext(ctx context.Context, req *ValidateTemplateInput) (resp *ValidateTemplateResult, err error) {
 	resp = &ValidateTemplateResult{}
-	err = c.client.Do("ValidateTemplate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ValidateTemplate", "POST", "/", req, resp)
 	return
 }
 
@@ -226,6 +396,17 @@ type CancelUpdateStackInput struct {
 	StackName aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the CancelUpdateStackInput to the form, prefixing their names.
+func (v *CancelUpdateStackInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // Possible values for CloudFormation.
 const (
 	CapabilityCapabilityIAM = "CAPABILITY_IAM"
@@ -233,20 +414,92 @@ const (
 
 // CreateStackInput is undocumented.
 type CreateStackInput struct {
-	Capabilities     []string         `query:"Capabilities.member" xml:"Capabilities>member"`
+	Capabilities     []string         `query:"Capabilities,list:member" xml:"Capabilities>member"`
 	DisableRollback  aws.BooleanValue `query:"DisableRollback" xml:"DisableRollback"`
-	NotificationARNs []string         `query:"NotificationARNs.member" xml:"NotificationARNs>member"`
+	NotificationARNs []string         `query:"NotificationARNs,list:member" xml:"NotificationARNs>member"`
 	OnFailure        aws.StringValue  `query:"OnFailure" xml:"OnFailure"`
-	Parameters       []Parameter      `query:"Parameters.member" xml:"Parameters>member"`
+	Parameters       []Parameter      `query:"Parameters,list:member" xml:"Parameters>member"`
 	StackName        aws.StringValue  `query:"StackName" xml:"StackName"`
 	StackPolicyBody  aws.StringValue  `query:"StackPolicyBody" xml:"StackPolicyBody"`
 	StackPolicyURL   aws.StringValue  `query:"StackPolicyURL" xml:"StackPolicyURL"`
-	Tags             []Tag            `query:"Tags.member" xml:"Tags>member"`
+	Tags             []Tag            `query:"Tags,list:member" xml:"Tags>member"`
 	TemplateBody     aws.StringValue  `query:"TemplateBody" xml:"TemplateBody"`
 	TemplateURL      aws.StringValue  `query:"TemplateURL" xml:"TemplateURL"`
 	TimeoutInMinutes aws.IntegerValue `query:"TimeoutInMinutes" xml:"TimeoutInMinutes"`
 }
 
+// MarshalQuery adds the parameters of the CreateStackInput to the form, prefixing their names.
+func (v *CreateStackInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Capabilities != nil {
+		if len(v.Capabilities) == 0 {
+			form.Set(prefix+"Capabilities", "")
+		}
+		for i1 := range v.Capabilities {
+			name1 := prefix + "Capabilities.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Capabilities[i1])
+		}
+	}
+	if v.DisableRollback != nil {
+		form.Set(prefix+"DisableRollback", strconv.FormatBool(*v.DisableRollback))
+	}
+	if v.NotificationARNs != nil {
+		if len(v.NotificationARNs) == 0 {
+			form.Set(prefix+"NotificationARNs", "")
+		}
+		for i1 := range v.NotificationARNs {
+			name1 := prefix + "NotificationARNs.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.NotificationARNs[i1])
+		}
+	}
+	if v.OnFailure != nil {
+		form.Set(prefix+"OnFailure", *v.OnFailure)
+	}
+	if v.Parameters != nil {
+		if len(v.Parameters) == 0 {
+			form.Set(prefix+"Parameters", "")
+		}
+		for i1 := range v.Parameters {
+			name1 := prefix + "Parameters.member." + strconv.Itoa(i1+1)
+			if err := v.Parameters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	if v.StackPolicyBody != nil {
+		form.Set(prefix+"StackPolicyBody", *v.StackPolicyBody)
+	}
+	if v.StackPolicyURL != nil {
+		form.Set(prefix+"StackPolicyURL", *v.StackPolicyURL)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TemplateBody != nil {
+		form.Set(prefix+"TemplateBody", *v.TemplateBody)
+	}
+	if v.TemplateURL != nil {
+		form.Set(prefix+"TemplateURL", *v.TemplateURL)
+	}
+	if v.TimeoutInMinutes != nil {
+		form.Set(prefix+"TimeoutInMinutes", strconv.FormatInt(int64(*v.TimeoutInMinutes), 10))
+	}
+	return nil
+}
+
 // CreateStackOutput is undocumented.
 type CreateStackOutput struct {
 	StackID aws.StringValue `query:"StackId" xml:"CreateStackResult>StackId"`
@@ -257,16 +510,41 @@ type DeleteStackInput struct {
 	StackName aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteStackInput to the form, prefixing their names.
+func (v *DeleteStackInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // DescribeStackEventsInput is undocumented.
 type DescribeStackEventsInput struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"NextToken"`
 	StackName aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeStackEventsInput to the form, prefixing their names.
+func (v *DescribeStackEventsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // DescribeStackEventsOutput is undocumented.
 type DescribeStackEventsOutput struct {
 	NextToken   aws.StringValue `query:"NextToken" xml:"DescribeStackEventsResult>NextToken"`
-	StackEvents []StackEvent    `query:"StackEvents.member" xml:"DescribeStackEventsResult>StackEvents>member"`
+	StackEvents []StackEvent    `query:"StackEvents,list:member" xml:"DescribeStackEventsResult>StackEvents>member"`
 }
 
 // DescribeStackResourceInput is undocumented.
@@ -275,6 +553,20 @@ type DescribeStackResourceInput struct {
 	StackName         aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeStackResourceInput to the form, prefixing their names.
+func (v *DescribeStackResourceInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LogicalResourceID != nil {
+		form.Set(prefix+"LogicalResourceId", *v.LogicalResourceID)
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // DescribeStackResourceOutput is undocumented.
 type DescribeStackResourceOutput struct {
 	StackResourceDetail *StackResourceDetail `query:"StackResourceDetail" xml:"DescribeStackResourceResult>StackResourceDetail"`
@@ -287,9 +579,26 @@ type DescribeStackResourcesInput struct {
 	StackName          aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeStackResourcesInput to the form, prefixing their names.
+func (v *DescribeStackResourcesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LogicalResourceID != nil {
+		form.Set(prefix+"LogicalResourceId", *v.LogicalResourceID)
+	}
+	if v.PhysicalResourceID != nil {
+		form.Set(prefix+"PhysicalResourceId", *v.PhysicalResourceID)
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // DescribeStackResourcesOutput is undocumented.
 type DescribeStackResourcesOutput struct {
-	StackResources []StackResource `query:"StackResources.member" xml:"DescribeStackResourcesResult>StackResources>member"`
+	StackResources []StackResource `query:"StackResources,list:member" xml:"DescribeStackResourcesResult>StackResources>member"`
 }
 
 // DescribeStacksInput is undocumented.
@@ -298,19 +607,58 @@ type DescribeStacksInput struct {
 	StackName aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeStacksInput to the form, prefixing their names.
+func (v *DescribeStacksInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // DescribeStacksOutput is undocumented.
 type DescribeStacksOutput struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"DescribeStacksResult>NextToken"`
-	Stacks    []Stack         `query:"Stacks.member" xml:"DescribeStacksResult>Stacks>member"`
+	Stacks    []Stack         `query:"Stacks,list:member" xml:"DescribeStacksResult>Stacks>member"`
 }
 
 // EstimateTemplateCostInput is undocumented.
 type EstimateTemplateCostInput struct {
-	Parameters   []Parameter     `query:"Parameters.member" xml:"Parameters>member"`
+	Parameters   []Parameter     `query:"Parameters,list:member" xml:"Parameters>member"`
 	TemplateBody aws.StringValue `query:"TemplateBody" xml:"TemplateBody"`
 	TemplateURL  aws.StringValue `query:"TemplateURL" xml:"TemplateURL"`
 }
 
+// MarshalQuery adds the parameters of the EstimateTemplateCostInput to the form, prefixing their names.
+func (v *EstimateTemplateCostInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Parameters != nil {
+		if len(v.Parameters) == 0 {
+			form.Set(prefix+"Parameters", "")
+		}
+		for i1 := range v.Parameters {
+			name1 := prefix + "Parameters.member." + strconv.Itoa(i1+1)
+			if err := v.Parameters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TemplateBody != nil {
+		form.Set(prefix+"TemplateBody", *v.TemplateBody)
+	}
+	if v.TemplateURL != nil {
+		form.Set(prefix+"TemplateURL", *v.TemplateURL)
+	}
+	return nil
+}
+
 // EstimateTemplateCostOutput is undocumented.
 type EstimateTemplateCostOutput struct {
 	URL aws.StringValue `query:"Url" xml:"EstimateTemplateCostResult>Url"`
@@ -321,6 +669,17 @@ type GetStackPolicyInput struct {
 	StackName aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the GetStackPolicyInput to the form, prefixing their names.
+func (v *GetStackPolicyInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // GetStackPolicyOutput is undocumented.
 type GetStackPolicyOutput struct {
 	StackPolicyBody aws.StringValue `query:"StackPolicyBody" xml:"GetStackPolicyResult>StackPolicyBody"`
@@ -331,6 +690,17 @@ type GetTemplateInput struct {
 	StackName aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the GetTemplateInput to the form, prefixing their names.
+func (v *GetTemplateInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // GetTemplateOutput is undocumented.
 type GetTemplateOutput struct {
 	TemplateBody aws.StringValue `query:"TemplateBody" xml:"GetTemplateResult>TemplateBody"`
@@ -343,12 +713,29 @@ type GetTemplateSummaryInput struct {
 	TemplateURL  aws.StringValue `query:"TemplateURL" xml:"TemplateURL"`
 }
 
+// MarshalQuery adds the parameters of the GetTemplateSummaryInput to the form, prefixing their names.
+func (v *GetTemplateSummaryInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	if v.TemplateBody != nil {
+		form.Set(prefix+"TemplateBody", *v.TemplateBody)
+	}
+	if v.TemplateURL != nil {
+		form.Set(prefix+"TemplateURL", *v.TemplateURL)
+	}
+	return nil
+}
+
 // GetTemplateSummaryOutput is undocumented.
 type GetTemplateSummaryOutput struct {
-	Capabilities       []string               `query:"Capabilities.member" xml:"GetTemplateSummaryResult>Capabilities>member"`
+	Capabilities       []string               `query:"Capabilities,list:member" xml:"GetTemplateSummaryResult>Capabilities>member"`
 	CapabilitiesReason aws.StringValue        `query:"CapabilitiesReason" xml:"GetTemplateSummaryResult>CapabilitiesReason"`
 	Description        aws.StringValue        `query:"Description" xml:"GetTemplateSummaryResult>Description"`
-	Parameters         []ParameterDeclaration `query:"Parameters.member" xml:"GetTemplateSummaryResult>Parameters>member"`
+	Parameters         []ParameterDeclaration `query:"Parameters,list:member" xml:"GetTemplateSummaryResult>Parameters>member"`
 	Version            aws.StringValue        `query:"Version" xml:"GetTemplateSummaryResult>Version"`
 }
 
@@ -358,22 +745,56 @@ type ListStackResourcesInput struct {
 	StackName aws.StringValue `query:"StackName" xml:"StackName"`
 }
 
+// MarshalQuery adds the parameters of the ListStackResourcesInput to the form, prefixing their names.
+func (v *ListStackResourcesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	return nil
+}
+
 // ListStackResourcesOutput is undocumented.
 type ListStackResourcesOutput struct {
 	NextToken              aws.StringValue        `query:"NextToken" xml:"ListStackResourcesResult>NextToken"`
-	StackResourceSummaries []StackResourceSummary `query:"StackResourceSummaries.member" xml:"ListStackResourcesResult>StackResourceSummaries>member"`
+	StackResourceSummaries []StackResourceSummary `query:"StackResourceSummaries,list:member" xml:"ListStackResourcesResult>StackResourceSummaries>member"`
 }
 
 // ListStacksInput is undocumented.
 type ListStacksInput struct {
 	NextToken         aws.StringValue `query:"NextToken" xml:"NextToken"`
-	StackStatusFilter []string        `query:"StackStatusFilter.member" xml:"StackStatusFilter>member"`
+	StackStatusFilter []string        `query:"StackStatusFilter,list:member" xml:"StackStatusFilter>member"`
+}
+
+// MarshalQuery adds the parameters of the ListStacksInput to the form, prefixing their names.
+func (v *ListStacksInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.StackStatusFilter != nil {
+		if len(v.StackStatusFilter) == 0 {
+			form.Set(prefix+"StackStatusFilter", "")
+		}
+		for i1 := range v.StackStatusFilter {
+			name1 := prefix + "StackStatusFilter.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.StackStatusFilter[i1])
+		}
+	}
+	return nil
 }
 
 // ListStacksOutput is undocumented.
 type ListStacksOutput struct {
 	NextToken      aws.StringValue `query:"NextToken" xml:"ListStacksResult>NextToken"`
-	StackSummaries []StackSummary  `query:"StackSummaries.member" xml:"ListStacksResult>StackSummaries>member"`
+	StackSummaries []StackSummary  `query:"StackSummaries,list:member" xml:"ListStacksResult>StackSummaries>member"`
 }
 
 // Possible values for CloudFormation.
@@ -397,6 +818,23 @@ type Parameter struct {
 	UsePreviousValue aws.BooleanValue `query:"UsePreviousValue" xml:"UsePreviousValue"`
 }
 
+// MarshalQuery adds the parameters of the Parameter to the form, prefixing their names.
+func (v *Parameter) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ParameterKey != nil {
+		form.Set(prefix+"ParameterKey", *v.ParameterKey)
+	}
+	if v.ParameterValue != nil {
+		form.Set(prefix+"ParameterValue", *v.ParameterValue)
+	}
+	if v.UsePreviousValue != nil {
+		form.Set(prefix+"UsePreviousValue", strconv.FormatBool(*v.UsePreviousValue))
+	}
+	return nil
+}
+
 // ParameterDeclaration is undocumented.
 type ParameterDeclaration struct {
 	DefaultValue  aws.StringValue  `query:"DefaultValue" xml:"DefaultValue"`
@@ -433,6 +871,23 @@ type SetStackPolicyInput struct {
 	StackPolicyURL  aws.StringValue `query:"StackPolicyURL" xml:"StackPolicyURL"`
 }
 
+// MarshalQuery adds the parameters of the SetStackPolicyInput to the form, prefixing their names.
+func (v *SetStackPolicyInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	if v.StackPolicyBody != nil {
+		form.Set(prefix+"StackPolicyBody", *v.StackPolicyBody)
+	}
+	if v.StackPolicyURL != nil {
+		form.Set(prefix+"StackPolicyURL", *v.StackPolicyURL)
+	}
+	return nil
+}
+
 // SignalResourceInput is undocumented.
 type SignalResourceInput struct {
 	LogicalResourceID aws.StringValue `query:"LogicalResourceId" xml:"LogicalResourceId"`
@@ -441,21 +896,41 @@ type SignalResourceInput struct {
 	UniqueID          aws.StringValue `query:"UniqueId" xml:"UniqueId"`
 }
 
+// MarshalQuery adds the parameters of the SignalResourceInput to the form, prefixing their names.
+func (v *SignalResourceInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LogicalResourceID != nil {
+		form.Set(prefix+"LogicalResourceId", *v.LogicalResourceID)
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	if v.Status != nil {
+		form.Set(prefix+"Status", *v.Status)
+	}
+	if v.UniqueID != nil {
+		form.Set(prefix+"UniqueId", *v.UniqueID)
+	}
+	return nil
+}
+
 // Stack is undocumented.
 type Stack struct {
-	Capabilities      []string         `query:"Capabilities.member" xml:"Capabilities>member"`
+	Capabilities      []string         `query:"Capabilities,list:member" xml:"Capabilities>member"`
 	CreationTime      time.Time        `query:"CreationTime" xml:"CreationTime"`
 	Description       aws.StringValue  `query:"Description" xml:"Description"`
 	DisableRollback   aws.BooleanValue `query:"DisableRollback" xml:"DisableRollback"`
 	LastUpdatedTime   time.Time        `query:"LastUpdatedTime" xml:"LastUpdatedTime"`
-	NotificationARNs  []string         `query:"NotificationARNs.member" xml:"NotificationARNs>member"`
-	Outputs           []Output         `query:"Outputs.member" xml:"Outputs>member"`
-	Parameters        []Parameter      `query:"Parameters.member" xml:"Parameters>member"`
+	NotificationARNs  []string         `query:"NotificationARNs,list:member" xml:"NotificationARNs>member"`
+	Outputs           []Output         `query:"Outputs,list:member" xml:"Outputs>member"`
+	Parameters        []Parameter      `query:"Parameters,list:member" xml:"Parameters>member"`
 	StackID           aws.StringValue  `query:"StackId" xml:"StackId"`
 	StackName         aws.StringValue  `query:"StackName" xml:"StackName"`
 	StackStatus       aws.StringValue  `query:"StackStatus" xml:"StackStatus"`
 	StackStatusReason aws.StringValue  `query:"StackStatusReason" xml:"StackStatusReason"`
-	Tags              []Tag            `query:"Tags.member" xml:"Tags>member"`
+	Tags              []Tag            `query:"Tags,list:member" xml:"Tags>member"`
 	TimeoutInMinutes  aws.IntegerValue `query:"TimeoutInMinutes" xml:"TimeoutInMinutes"`
 }
 
@@ -548,6 +1023,20 @@ type Tag struct {
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Tag to the form, prefixing their names.
+func (v *Tag) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // TemplateParameter is undocumented.
 type TemplateParameter struct {
 	DefaultValue aws.StringValue  `query:"DefaultValue" xml:"DefaultValue"`
@@ -558,9 +1047,9 @@ type TemplateParameter struct {
 
 // UpdateStackInput is undocumented.
 type UpdateStackInput struct {
-	Capabilities                []string         `query:"Capabilities.member" xml:"Capabilities>member"`
-	NotificationARNs            []string         `query:"NotificationARNs.member" xml:"NotificationARNs>member"`
-	Parameters                  []Parameter      `query:"Parameters.member" xml:"Parameters>member"`
+	Capabilities                []string         `query:"Capabilities,list:member" xml:"Capabilities>member"`
+	NotificationARNs            []string         `query:"NotificationARNs,list:member" xml:"NotificationARNs>member"`
+	Parameters                  []Parameter      `query:"Parameters,list:member" xml:"Parameters>member"`
 	StackName                   aws.StringValue  `query:"StackName" xml:"StackName"`
 	StackPolicyBody             aws.StringValue  `query:"StackPolicyBody" xml:"StackPolicyBody"`
 	StackPolicyDuringUpdateBody aws.StringValue  `query:"StackPolicyDuringUpdateBody" xml:"StackPolicyDuringUpdateBody"`
@@ -571,6 +1060,67 @@ type UpdateStackInput struct {
 	UsePreviousTemplate         aws.BooleanValue `query:"UsePreviousTemplate" xml:"UsePreviousTemplate"`
 }
 
+// MarshalQuery adds the parameters of the UpdateStackInput to the form, prefixing their names.
+func (v *UpdateStackInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Capabilities != nil {
+		if len(v.Capabilities) == 0 {
+			form.Set(prefix+"Capabilities", "")
+		}
+		for i1 := range v.Capabilities {
+			name1 := prefix + "Capabilities.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Capabilities[i1])
+		}
+	}
+	if v.NotificationARNs != nil {
+		if len(v.NotificationARNs) == 0 {
+			form.Set(prefix+"NotificationARNs", "")
+		}
+		for i1 := range v.NotificationARNs {
+			name1 := prefix + "NotificationARNs.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.NotificationARNs[i1])
+		}
+	}
+	if v.Parameters != nil {
+		if len(v.Parameters) == 0 {
+			form.Set(prefix+"Parameters", "")
+		}
+		for i1 := range v.Parameters {
+			name1 := prefix + "Parameters.member." + strconv.Itoa(i1+1)
+			if err := v.Parameters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.StackName != nil {
+		form.Set(prefix+"StackName", *v.StackName)
+	}
+	if v.StackPolicyBody != nil {
+		form.Set(prefix+"StackPolicyBody", *v.StackPolicyBody)
+	}
+	if v.StackPolicyDuringUpdateBody != nil {
+		form.Set(prefix+"StackPolicyDuringUpdateBody", *v.StackPolicyDuringUpdateBody)
+	}
+	if v.StackPolicyDuringUpdateURL != nil {
+		form.Set(prefix+"StackPolicyDuringUpdateURL", *v.StackPolicyDuringUpdateURL)
+	}
+	if v.StackPolicyURL != nil {
+		form.Set(prefix+"StackPolicyURL", *v.StackPolicyURL)
+	}
+	if v.TemplateBody != nil {
+		form.Set(prefix+"TemplateBody", *v.TemplateBody)
+	}
+	if v.TemplateURL != nil {
+		form.Set(prefix+"TemplateURL", *v.TemplateURL)
+	}
+	if v.UsePreviousTemplate != nil {
+		form.Set(prefix+"UsePreviousTemplate", strconv.FormatBool(*v.UsePreviousTemplate))
+	}
+	return nil
+}
+
 // UpdateStackOutput is undocumented.
 type UpdateStackOutput struct {
 	StackID aws.StringValue `query:"StackId" xml:"UpdateStackResult>StackId"`
@@ -582,12 +1132,26 @@ type ValidateTemplateInput struct {
 	TemplateURL  aws.StringValue `query:"TemplateURL" xml:"TemplateURL"`
 }
 
+// MarshalQuery adds the parameters of the ValidateTemplateInput to the form, prefixing their names.
+func (v *ValidateTemplateInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.TemplateBody != nil {
+		form.Set(prefix+"TemplateBody", *v.TemplateBody)
+	}
+	if v.TemplateURL != nil {
+		form.Set(prefix+"TemplateURL", *v.TemplateURL)
+	}
+	return nil
+}
+
 // ValidateTemplateOutput is undocumented.
 type ValidateTemplateOutput struct {
-	Capabilities       []string            `query:"Capabilities.member" xml:"ValidateTemplateResult>Capabilities>member"`
+	Capabilities       []string            `query:"Capabilities,list:member" xml:"ValidateTemplateResult>Capabilities>member"`
 	CapabilitiesReason aws.StringValue     `query:"CapabilitiesReason" xml:"ValidateTemplateResult>CapabilitiesReason"`
 	Description        aws.StringValue     `query:"Description" xml:"ValidateTemplateResult>Description"`
-	Parameters         []TemplateParameter `query:"Parameters.member" xml:"ValidateTemplateResult>Parameters>member"`
+	Parameters         []TemplateParameter `query:"Parameters,list:member" xml:"ValidateTemplateResult>Parameters>member"`
 }
 
 // CreateStackResult is a wrapper for CreateStackOutput.
@@ -598,7 +1162,7 @@ type CreateStackResult struct {
 // DescribeStackEventsResult is a wrapper for DescribeStackEventsOutput.
 type DescribeStackEventsResult struct {
 	NextToken   aws.StringValue `query:"NextToken" xml:"DescribeStackEventsResult>NextToken"`
-	StackEvents []StackEvent    `query:"StackEvents.member" xml:"DescribeStackEventsResult>StackEvents>member"`
+	StackEvents []StackEvent    `query:"StackEvents,list:member" xml:"DescribeStackEventsResult>StackEvents>member"`
 }
 
 // DescribeStackResourceResult is a wrapper for DescribeStackResourceOutput.
@@ -608,13 +1172,13 @@ type DescribeStackResourceResult struct {
 
 // DescribeStackResourcesResult is a wrapper for DescribeStackResourcesOutput.
 type DescribeStackResourcesResult struct {
-	StackResources []StackResource `query:"StackResources.member" xml:"DescribeStackResourcesResult>StackResources>member"`
+	StackResources []StackResource `query:"StackResources,list:member" xml:"DescribeStackResourcesResult>StackResources>member"`
 }
 
 // DescribeStacksResult is a wrapper for DescribeStacksOutput.
 type DescribeStacksResult struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"DescribeStacksResult>NextToken"`
-	Stacks    []Stack         `query:"Stacks.member" xml:"DescribeStacksResult>Stacks>member"`
+	Stacks    []Stack         `query:"Stacks,list:member" xml:"DescribeStacksResult>Stacks>member"`
 }
 
 // EstimateTemplateCostResult is a wrapper for EstimateTemplateCostOutput.
@@ -634,23 +1198,23 @@ type GetTemplateResult struct {
 
 // GetTemplateSummaryResult is a wrapper for GetTemplateSummaryOutput.
 type GetTemplateSummaryResult struct {
-	Capabilities       []string               `query:"Capabilities.member" xml:"GetTemplateSummaryResult>Capabilities>member"`
+	Capabilities       []string               `query:"Capabilities,list:member" xml:"GetTemplateSummaryResult>Capabilities>member"`
 	CapabilitiesReason aws.StringValue        `query:"CapabilitiesReason" xml:"GetTemplateSummaryResult>CapabilitiesReason"`
 	Description        aws.StringValue        `query:"Description" xml:"GetTemplateSummaryResult>Description"`
-	Parameters         []ParameterDeclaration `query:"Parameters.member" xml:"GetTemplateSummaryResult>Parameters>member"`
+	Parameters         []ParameterDeclaration `query:"Parameters,list:member" xml:"GetTemplateSummaryResult>Parameters>member"`
 	Version            aws.StringValue        `query:"Version" xml:"GetTemplateSummaryResult>Version"`
 }
 
 // ListStackResourcesResult is a wrapper for ListStackResourcesOutput.
 type ListStackResourcesResult struct {
 	NextToken              aws.StringValue        `query:"NextToken" xml:"ListStackResourcesResult>NextToken"`
-	StackResourceSummaries []StackResourceSummary `query:"StackResourceSummaries.member" xml:"ListStackResourcesResult>StackResourceSummaries>member"`
+	StackResourceSummaries []StackResourceSummary `query:"StackResourceSummaries,list:member" xml:"ListStackResourcesResult>StackResourceSummaries>member"`
 }
 
 // ListStacksResult is a wrapper for ListStacksOutput.
 type ListStacksResult struct {
 	NextToken      aws.StringValue `query:"NextToken" xml:"ListStacksResult>NextToken"`
-	StackSummaries []StackSummary  `query:"StackSummaries.member" xml:"ListStacksResult>StackSummaries>member"`
+	StackSummaries []StackSummary  `query:"StackSummaries,list:member" xml:"ListStacksResult>StackSummaries>member"`
 }
 
 // UpdateStackResult is a wrapper for UpdateStackOutput.
@@ -660,11 +1224,39 @@ type UpdateStackResult struct {
 
 // ValidateTemplateResult is a wrapper for ValidateTemplateOutput.
 type ValidateTemplateResult struct {
-	Capabilities       []string            `query:"Capabilities.member" xml:"ValidateTemplateResult>Capabilities>member"`
+	Capabilities       []string            `query:"Capabilities,list:member" xml:"ValidateTemplateResult>Capabilities>member"`
 	CapabilitiesReason aws.StringValue     `query:"CapabilitiesReason" xml:"ValidateTemplateResult>CapabilitiesReason"`
 	Description        aws.StringValue     `query:"Description" xml:"ValidateTemplateResult>Description"`
-	Parameters         []TemplateParameter `query:"Parameters.member" xml:"ValidateTemplateResult>Parameters>member"`
+	Parameters         []TemplateParameter `query:"Parameters,list:member" xml:"ValidateTemplateResult>Parameters>member"`
+}
+
+// AlreadyExistsException is the error returned for the AlreadyExistsException error code.
+type AlreadyExistsException struct {
+	aws.APIError
+}
+
+// InsufficientCapabilitiesException is the error returned for the InsufficientCapabilitiesException error code.
+type InsufficientCapabilitiesException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// exceptions maps CloudFormation's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AlreadyExistsException":            AlreadyExistsException{},
+	"InsufficientCapabilitiesException": InsufficientCapabilitiesException{},
+	"LimitExceededException":            LimitExceededException{},
 }
 
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
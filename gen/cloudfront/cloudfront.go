@@ -4,15 +4,18 @@
 package cloudfront
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
 )
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -27,33 +30,295 @@ type CloudFront struct {
 	client *aws.RestClient
 }
 
-// New returns a new CloudFront client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CloudFront {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "cloudfront"
-	endpoint, service, region := endpoints.Lookup("cloudfront", region)
+// New returns a new CloudFront client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CloudFront {
+	cfg := newConfig(region, client, opts)
 
 	return &CloudFront{
 		client: &aws.RestClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2014-10-21",
 		},
 	}
 }
 
+// NewFromSession returns a new CloudFront client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CloudFront {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("cloudfront", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("cloudfront", "cloudfront"),
+	}, "AWS_ENDPOINT_URL_CLOUDFRONT", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CloudFront) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CloudFront) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *CloudFront) SetPayloadSigning(p aws.PayloadSigning) {
+	c.client.Context.PayloadSigning = p
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *CloudFront) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch op {
+
+	case "CreateCloudFrontOriginAccessIdentity":
+
+		r, ok := req.(*CreateCloudFrontOriginAccessIdentityRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: CreateCloudFrontOriginAccessIdentity takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateCloudFrontOriginAccessIdentityRequest(context.Background(), r)
+
+	case "CreateDistribution":
+
+		r, ok := req.(*CreateDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: CreateDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateDistributionRequest(context.Background(), r)
+
+	case "CreateInvalidation":
+
+		r, ok := req.(*CreateInvalidationRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: CreateInvalidation takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateInvalidationRequest(context.Background(), r)
+
+	case "CreateStreamingDistribution":
+
+		r, ok := req.(*CreateStreamingDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: CreateStreamingDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateStreamingDistributionRequest(context.Background(), r)
+
+	case "DeleteCloudFrontOriginAccessIdentity":
+
+		r, ok := req.(*DeleteCloudFrontOriginAccessIdentityRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: DeleteCloudFrontOriginAccessIdentity takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteCloudFrontOriginAccessIdentityRequest(context.Background(), r)
+
+	case "DeleteDistribution":
+
+		r, ok := req.(*DeleteDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: DeleteDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteDistributionRequest(context.Background(), r)
+
+	case "DeleteStreamingDistribution":
+
+		r, ok := req.(*DeleteStreamingDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: DeleteStreamingDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteStreamingDistributionRequest(context.Background(), r)
+
+	case "GetCloudFrontOriginAccessIdentity":
+
+		r, ok := req.(*GetCloudFrontOriginAccessIdentityRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: GetCloudFrontOriginAccessIdentity takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetCloudFrontOriginAccessIdentityRequest(context.Background(), r)
+
+	case "GetCloudFrontOriginAccessIdentityConfig":
+
+		r, ok := req.(*GetCloudFrontOriginAccessIdentityConfigRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: GetCloudFrontOriginAccessIdentityConfig takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetCloudFrontOriginAccessIdentityConfigRequest(context.Background(), r)
+
+	case "GetDistribution":
+
+		r, ok := req.(*GetDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: GetDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetDistributionRequest(context.Background(), r)
+
+	case "GetDistributionConfig":
+
+		r, ok := req.(*GetDistributionConfigRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: GetDistributionConfig takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetDistributionConfigRequest(context.Background(), r)
+
+	case "GetInvalidation":
+
+		r, ok := req.(*GetInvalidationRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: GetInvalidation takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetInvalidationRequest(context.Background(), r)
+
+	case "GetStreamingDistribution":
+
+		r, ok := req.(*GetStreamingDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: GetStreamingDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetStreamingDistributionRequest(context.Background(), r)
+
+	case "GetStreamingDistributionConfig":
+
+		r, ok := req.(*GetStreamingDistributionConfigRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: GetStreamingDistributionConfig takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetStreamingDistributionConfigRequest(context.Background(), r)
+
+	case "ListCloudFrontOriginAccessIdentities":
+
+		r, ok := req.(*ListCloudFrontOriginAccessIdentitiesRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: ListCloudFrontOriginAccessIdentities takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListCloudFrontOriginAccessIdentitiesRequest(context.Background(), r)
+
+	case "ListDistributions":
+
+		r, ok := req.(*ListDistributionsRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: ListDistributions takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListDistributionsRequest(context.Background(), r)
+
+	case "ListInvalidations":
+
+		r, ok := req.(*ListInvalidationsRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: ListInvalidations takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListInvalidationsRequest(context.Background(), r)
+
+	case "ListStreamingDistributions":
+
+		r, ok := req.(*ListStreamingDistributionsRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: ListStreamingDistributions takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListStreamingDistributionsRequest(context.Background(), r)
+
+	case "UpdateCloudFrontOriginAccessIdentity":
+
+		r, ok := req.(*UpdateCloudFrontOriginAccessIdentityRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: UpdateCloudFrontOriginAccessIdentity takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdateCloudFrontOriginAccessIdentityRequest(context.Background(), r)
+
+	case "UpdateDistribution":
+
+		r, ok := req.(*UpdateDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: UpdateDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdateDistributionRequest(context.Background(), r)
+
+	case "UpdateStreamingDistribution":
+
+		r, ok := req.(*UpdateStreamingDistributionRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudfront: UpdateStreamingDistribution takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdateStreamingDistributionRequest(context.Background(), r)
+
+	default:
+		return "", fmt.Errorf("cloudfront: unknown operation %q", op)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
 // CreateCloudFrontOriginAccessIdentity is undocumented.
 func (c *CloudFront) CreateCloudFrontOriginAccessIdentity(req *CreateCloudFrontOriginAccessIdentityRequest) (resp *CreateCloudFrontOriginAccessIdentityResult, err error) {
+	return c.CreateCloudFrontOriginAccessIdentityWithContext(context.Background(), req)
+}
+
+// CreateCloudFrontOriginAccessIdentityWithContext is like CreateCloudFrontOriginAccessIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) CreateCloudFrontOriginAccessIdentityWithContext(ctx context.Context, req *CreateCloudFrontOriginAccessIdentityRequest) (resp *CreateCloudFrontOriginAccessIdentityResult, err error) {
 	resp = &CreateCloudFrontOriginAccessIdentityResult{}
 
+	httpReq, err := c.newCreateCloudFrontOriginAccessIdentityRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateCloudFrontOriginAccessIdentity", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	if s := httpResp.Header.Get("ETag"); s != "" {
+		resp.ETag = &s
+	}
+
+	if s := httpResp.Header.Get("Location"); s != "" {
+		resp.Location = &s
+	}
+
+	return
+}
+
+// newCreateCloudFrontOriginAccessIdentityRequest builds the HTTP request for CreateCloudFrontOriginAccessIdentity.
+func (c *CloudFront) newCreateCloudFrontOriginAccessIdentityRequest(ctx context.Context, req *CreateCloudFrontOriginAccessIdentityRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -75,10 +340,14 @@ func (c *CloudFront) CreateCloudFrontOriginAccessIdentity(req *CreateCloudFrontO
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -87,7 +356,26 @@ func (c *CloudFront) CreateCloudFrontOriginAccessIdentity(req *CreateCloudFrontO
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreateDistribution is undocumented.
+func (c *CloudFront) CreateDistribution(req *CreateDistributionRequest) (resp *CreateDistributionResult, err error) {
+	return c.CreateDistributionWithContext(context.Background(), req)
+}
+
+// CreateDistributionWithContext is like CreateDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) CreateDistributionWithContext(ctx context.Context, req *CreateDistributionRequest) (resp *CreateDistributionResult, err error) {
+	resp = &CreateDistributionResult{}
+
+	httpReq, err := c.newCreateDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateDistribution", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -99,24 +387,18 @@ func (c *CloudFront) CreateCloudFrontOriginAccessIdentity(req *CreateCloudFrontO
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	if s := httpResp.Header.Get("Location"); s != "" {
-
 		resp.Location = &s
-
 	}
 
 	return
 }
 
-// CreateDistribution is undocumented.
-func (c *CloudFront) CreateDistribution(req *CreateDistributionRequest) (resp *CreateDistributionResult, err error) {
-	resp = &CreateDistributionResult{}
-
+// newCreateDistributionRequest builds the HTTP request for CreateDistribution.
+func (c *CloudFront) newCreateDistributionRequest(ctx context.Context, req *CreateDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -138,10 +420,14 @@ func (c *CloudFront) CreateDistribution(req *CreateDistributionRequest) (resp *C
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -150,7 +436,26 @@ func (c *CloudFront) CreateDistribution(req *CreateDistributionRequest) (resp *C
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreateInvalidation is undocumented.
+func (c *CloudFront) CreateInvalidation(req *CreateInvalidationRequest) (resp *CreateInvalidationResult, err error) {
+	return c.CreateInvalidationWithContext(context.Background(), req)
+}
+
+// CreateInvalidationWithContext is like CreateInvalidation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) CreateInvalidationWithContext(ctx context.Context, req *CreateInvalidationRequest) (resp *CreateInvalidationResult, err error) {
+	resp = &CreateInvalidationResult{}
+
+	httpReq, err := c.newCreateInvalidationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateInvalidation", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -161,25 +466,15 @@ func (c *CloudFront) CreateDistribution(req *CreateDistributionRequest) (resp *C
 		return
 	}
 
-	if s := httpResp.Header.Get("ETag"); s != "" {
-
-		resp.ETag = &s
-
-	}
-
 	if s := httpResp.Header.Get("Location"); s != "" {
-
 		resp.Location = &s
-
 	}
 
 	return
 }
 
-// CreateInvalidation is undocumented.
-func (c *CloudFront) CreateInvalidation(req *CreateInvalidationRequest) (resp *CreateInvalidationResult, err error) {
-	resp = &CreateInvalidationResult{}
-
+// newCreateInvalidationRequest builds the HTTP request for CreateInvalidation.
+func (c *CloudFront) newCreateInvalidationRequest(ctx context.Context, req *CreateInvalidationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -199,17 +494,21 @@ func (c *CloudFront) CreateInvalidation(req *CreateInvalidationRequest) (resp *C
 	uri := c.client.Endpoint + "/2014-10-21/distribution/{DistributionId}/invalidation"
 
 	if req.DistributionID != nil {
-		uri = strings.Replace(uri, "{"+"DistributionId"+"}", *req.DistributionID, -1)
-		uri = strings.Replace(uri, "{"+"DistributionId+"+"}", *req.DistributionID, -1)
+		uri = strings.Replace(uri, "{DistributionId}", aws.EscapePath(*req.DistributionID, false), -1)
+		uri = strings.Replace(uri, "{DistributionId+}", aws.EscapePath(*req.DistributionID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -218,7 +517,26 @@ func (c *CloudFront) CreateInvalidation(req *CreateInvalidationRequest) (resp *C
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreateStreamingDistribution is undocumented.
+func (c *CloudFront) CreateStreamingDistribution(req *CreateStreamingDistributionRequest) (resp *CreateStreamingDistributionResult, err error) {
+	return c.CreateStreamingDistributionWithContext(context.Background(), req)
+}
+
+// CreateStreamingDistributionWithContext is like CreateStreamingDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) CreateStreamingDistributionWithContext(ctx context.Context, req *CreateStreamingDistributionRequest) (resp *CreateStreamingDistributionResult, err error) {
+	resp = &CreateStreamingDistributionResult{}
+
+	httpReq, err := c.newCreateStreamingDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateStreamingDistribution", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -229,19 +547,19 @@ func (c *CloudFront) CreateInvalidation(req *CreateInvalidationRequest) (resp *C
 		return
 	}
 
-	if s := httpResp.Header.Get("Location"); s != "" {
+	if s := httpResp.Header.Get("ETag"); s != "" {
+		resp.ETag = &s
+	}
 
+	if s := httpResp.Header.Get("Location"); s != "" {
 		resp.Location = &s
-
 	}
 
 	return
 }
 
-// CreateStreamingDistribution is undocumented.
-func (c *CloudFront) CreateStreamingDistribution(req *CreateStreamingDistributionRequest) (resp *CreateStreamingDistributionResult, err error) {
-	resp = &CreateStreamingDistributionResult{}
-
+// newCreateStreamingDistributionRequest builds the HTTP request for CreateStreamingDistribution.
+func (c *CloudFront) newCreateStreamingDistributionRequest(ctx context.Context, req *CreateStreamingDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -263,10 +581,14 @@ func (c *CloudFront) CreateStreamingDistribution(req *CreateStreamingDistributio
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -275,53 +597,58 @@ func (c *CloudFront) CreateStreamingDistribution(req *CreateStreamingDistributio
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteCloudFrontOriginAccessIdentity is undocumented.
+func (c *CloudFront) DeleteCloudFrontOriginAccessIdentity(req *DeleteCloudFrontOriginAccessIdentityRequest) (err error) {
+	return c.DeleteCloudFrontOriginAccessIdentityWithContext(context.Background(), req)
+}
+
+// DeleteCloudFrontOriginAccessIdentityWithContext is like DeleteCloudFrontOriginAccessIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) DeleteCloudFrontOriginAccessIdentityWithContext(ctx context.Context, req *DeleteCloudFrontOriginAccessIdentityRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteCloudFrontOriginAccessIdentityRequest(ctx, req)
 	if err != nil {
 		return
 	}
 
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
+	httpResp, err := c.client.DoOperation("DeleteCloudFrontOriginAccessIdentity", httpReq, req, nil)
+	if err != nil {
 		return
 	}
 
-	if s := httpResp.Header.Get("ETag"); s != "" {
-
-		resp.ETag = &s
-
-	}
-
-	if s := httpResp.Header.Get("Location"); s != "" {
-
-		resp.Location = &s
-
-	}
+	defer httpResp.Body.Close()
 
 	return
 }
 
-// DeleteCloudFrontOriginAccessIdentity is undocumented.
-func (c *CloudFront) DeleteCloudFrontOriginAccessIdentity(req *DeleteCloudFrontOriginAccessIdentityRequest) (err error) {
-	// NRE
-
+// newDeleteCloudFrontOriginAccessIdentityRequest builds the HTTP request for DeleteCloudFrontOriginAccessIdentity.
+func (c *CloudFront) newDeleteCloudFrontOriginAccessIdentityRequest(ctx context.Context, req *DeleteCloudFrontOriginAccessIdentityRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/origin-access-identity/cloudfront/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -334,7 +661,26 @@ func (c *CloudFront) DeleteCloudFrontOriginAccessIdentity(req *DeleteCloudFrontO
 		httpReq.Header.Set("If-Match", *req.IfMatch)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteDistribution is undocumented.
+func (c *CloudFront) DeleteDistribution(req *DeleteDistributionRequest) (err error) {
+	return c.DeleteDistributionWithContext(context.Background(), req)
+}
+
+// DeleteDistributionWithContext is like DeleteDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) DeleteDistributionWithContext(ctx context.Context, req *DeleteDistributionRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteDistribution", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -344,27 +690,29 @@ func (c *CloudFront) DeleteCloudFrontOriginAccessIdentity(req *DeleteCloudFrontO
 	return
 }
 
-// DeleteDistribution is undocumented.
-func (c *CloudFront) DeleteDistribution(req *DeleteDistributionRequest) (err error) {
-	// NRE
-
+// newDeleteDistributionRequest builds the HTTP request for DeleteDistribution.
+func (c *CloudFront) newDeleteDistributionRequest(ctx context.Context, req *DeleteDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/distribution/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -377,7 +725,26 @@ func (c *CloudFront) DeleteDistribution(req *DeleteDistributionRequest) (err err
 		httpReq.Header.Set("If-Match", *req.IfMatch)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteStreamingDistribution is undocumented.
+func (c *CloudFront) DeleteStreamingDistribution(req *DeleteStreamingDistributionRequest) (err error) {
+	return c.DeleteStreamingDistributionWithContext(context.Background(), req)
+}
+
+// DeleteStreamingDistributionWithContext is like DeleteStreamingDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) DeleteStreamingDistributionWithContext(ctx context.Context, req *DeleteStreamingDistributionRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteStreamingDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteStreamingDistribution", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -387,27 +754,29 @@ func (c *CloudFront) DeleteDistribution(req *DeleteDistributionRequest) (err err
 	return
 }
 
-// DeleteStreamingDistribution is undocumented.
-func (c *CloudFront) DeleteStreamingDistribution(req *DeleteStreamingDistributionRequest) (err error) {
-	// NRE
-
+// newDeleteStreamingDistributionRequest builds the HTTP request for DeleteStreamingDistribution.
+func (c *CloudFront) newDeleteStreamingDistributionRequest(ctx context.Context, req *DeleteStreamingDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/streaming-distribution/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -420,38 +789,67 @@ func (c *CloudFront) DeleteStreamingDistribution(req *DeleteStreamingDistributio
 		httpReq.Header.Set("If-Match", *req.IfMatch)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetCloudFrontOriginAccessIdentity get the information about an origin
+// access identity.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentity(req *GetCloudFrontOriginAccessIdentityRequest) (resp *GetCloudFrontOriginAccessIdentityResult, err error) {
+	return c.GetCloudFrontOriginAccessIdentityWithContext(context.Background(), req)
+}
+
+// GetCloudFrontOriginAccessIdentityWithContext is like GetCloudFrontOriginAccessIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityWithContext(ctx context.Context, req *GetCloudFrontOriginAccessIdentityRequest) (resp *GetCloudFrontOriginAccessIdentityResult, err error) {
+	resp = &GetCloudFrontOriginAccessIdentityResult{}
+
+	httpReq, err := c.newGetCloudFrontOriginAccessIdentityRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetCloudFrontOriginAccessIdentity", httpReq, req, resp)
 	if err != nil {
 		return
 	}
 
 	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	if s := httpResp.Header.Get("ETag"); s != "" {
+		resp.ETag = &s
+	}
 
 	return
 }
 
-// GetCloudFrontOriginAccessIdentity get the information about an origin
-// access identity.
-func (c *CloudFront) GetCloudFrontOriginAccessIdentity(req *GetCloudFrontOriginAccessIdentityRequest) (resp *GetCloudFrontOriginAccessIdentityResult, err error) {
-	resp = &GetCloudFrontOriginAccessIdentityResult{}
-
+// newGetCloudFrontOriginAccessIdentityRequest builds the HTTP request for GetCloudFrontOriginAccessIdentity.
+func (c *CloudFront) newGetCloudFrontOriginAccessIdentityRequest(ctx context.Context, req *GetCloudFrontOriginAccessIdentityRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/origin-access-identity/cloudfront/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -460,7 +858,27 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentity(req *GetCloudFrontOriginA
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetCloudFrontOriginAccessIdentityConfig get the configuration
+// information about an origin access identity.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfig(req *GetCloudFrontOriginAccessIdentityConfigRequest) (resp *GetCloudFrontOriginAccessIdentityConfigResult, err error) {
+	return c.GetCloudFrontOriginAccessIdentityConfigWithContext(context.Background(), req)
+}
+
+// GetCloudFrontOriginAccessIdentityConfigWithContext is like GetCloudFrontOriginAccessIdentityConfig, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfigWithContext(ctx context.Context, req *GetCloudFrontOriginAccessIdentityConfigRequest) (resp *GetCloudFrontOriginAccessIdentityConfigResult, err error) {
+	resp = &GetCloudFrontOriginAccessIdentityConfigResult{}
+
+	httpReq, err := c.newGetCloudFrontOriginAccessIdentityConfigRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetCloudFrontOriginAccessIdentityConfig", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -472,36 +890,35 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentity(req *GetCloudFrontOriginA
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	return
 }
 
-// GetCloudFrontOriginAccessIdentityConfig get the configuration
-// information about an origin access identity.
-func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfig(req *GetCloudFrontOriginAccessIdentityConfigRequest) (resp *GetCloudFrontOriginAccessIdentityConfigResult, err error) {
-	resp = &GetCloudFrontOriginAccessIdentityConfigResult{}
-
+// newGetCloudFrontOriginAccessIdentityConfigRequest builds the HTTP request for GetCloudFrontOriginAccessIdentityConfig.
+func (c *CloudFront) newGetCloudFrontOriginAccessIdentityConfigRequest(ctx context.Context, req *GetCloudFrontOriginAccessIdentityConfigRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/origin-access-identity/cloudfront/{Id}/config"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -510,7 +927,26 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfig(req *GetCloudFrontO
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetDistribution is undocumented.
+func (c *CloudFront) GetDistribution(req *GetDistributionRequest) (resp *GetDistributionResult, err error) {
+	return c.GetDistributionWithContext(context.Background(), req)
+}
+
+// GetDistributionWithContext is like GetDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) GetDistributionWithContext(ctx context.Context, req *GetDistributionRequest) (resp *GetDistributionResult, err error) {
+	resp = &GetDistributionResult{}
+
+	httpReq, err := c.newGetDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetDistribution", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -522,35 +958,35 @@ func (c *CloudFront) GetCloudFrontOriginAccessIdentityConfig(req *GetCloudFrontO
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	return
 }
 
-// GetDistribution is undocumented.
-func (c *CloudFront) GetDistribution(req *GetDistributionRequest) (resp *GetDistributionResult, err error) {
-	resp = &GetDistributionResult{}
-
+// newGetDistributionRequest builds the HTTP request for GetDistribution.
+func (c *CloudFront) newGetDistributionRequest(ctx context.Context, req *GetDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/distribution/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -559,7 +995,27 @@ func (c *CloudFront) GetDistribution(req *GetDistributionRequest) (resp *GetDist
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetDistributionConfig get the configuration information about a
+// distribution.
+func (c *CloudFront) GetDistributionConfig(req *GetDistributionConfigRequest) (resp *GetDistributionConfigResult, err error) {
+	return c.GetDistributionConfigWithContext(context.Background(), req)
+}
+
+// GetDistributionConfigWithContext is like GetDistributionConfig, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) GetDistributionConfigWithContext(ctx context.Context, req *GetDistributionConfigRequest) (resp *GetDistributionConfigResult, err error) {
+	resp = &GetDistributionConfigResult{}
+
+	httpReq, err := c.newGetDistributionConfigRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetDistributionConfig", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -571,36 +1027,35 @@ func (c *CloudFront) GetDistribution(req *GetDistributionRequest) (resp *GetDist
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	return
 }
 
-// GetDistributionConfig get the configuration information about a
-// distribution.
-func (c *CloudFront) GetDistributionConfig(req *GetDistributionConfigRequest) (resp *GetDistributionConfigResult, err error) {
-	resp = &GetDistributionConfigResult{}
-
+// newGetDistributionConfigRequest builds the HTTP request for GetDistributionConfig.
+func (c *CloudFront) newGetDistributionConfigRequest(ctx context.Context, req *GetDistributionConfigRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/distribution/{Id}/config"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -609,7 +1064,26 @@ func (c *CloudFront) GetDistributionConfig(req *GetDistributionConfigRequest) (r
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetInvalidation is undocumented.
+func (c *CloudFront) GetInvalidation(req *GetInvalidationRequest) (resp *GetInvalidationResult, err error) {
+	return c.GetInvalidationWithContext(context.Background(), req)
+}
+
+// GetInvalidationWithContext is like GetInvalidation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) GetInvalidationWithContext(ctx context.Context, req *GetInvalidationRequest) (resp *GetInvalidationResult, err error) {
+	resp = &GetInvalidationResult{}
+
+	httpReq, err := c.newGetInvalidationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetInvalidation", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -620,41 +1094,37 @@ func (c *CloudFront) GetDistributionConfig(req *GetDistributionConfigRequest) (r
 		return
 	}
 
-	if s := httpResp.Header.Get("ETag"); s != "" {
-
-		resp.ETag = &s
-
-	}
-
 	return
 }
 
-// GetInvalidation is undocumented.
-func (c *CloudFront) GetInvalidation(req *GetInvalidationRequest) (resp *GetInvalidationResult, err error) {
-	resp = &GetInvalidationResult{}
-
+// newGetInvalidationRequest builds the HTTP request for GetInvalidation.
+func (c *CloudFront) newGetInvalidationRequest(ctx context.Context, req *GetInvalidationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/distribution/{DistributionId}/invalidation/{Id}"
 
 	if req.DistributionID != nil {
-		uri = strings.Replace(uri, "{"+"DistributionId"+"}", *req.DistributionID, -1)
-		uri = strings.Replace(uri, "{"+"DistributionId+"+"}", *req.DistributionID, -1)
+		uri = strings.Replace(uri, "{DistributionId}", aws.EscapePath(*req.DistributionID, false), -1)
+		uri = strings.Replace(uri, "{DistributionId+}", aws.EscapePath(*req.DistributionID, true), -1)
 	}
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -663,7 +1133,27 @@ func (c *CloudFront) GetInvalidation(req *GetInvalidationRequest) (resp *GetInva
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetStreamingDistribution get the information about a streaming
+// distribution.
+func (c *CloudFront) GetStreamingDistribution(req *GetStreamingDistributionRequest) (resp *GetStreamingDistributionResult, err error) {
+	return c.GetStreamingDistributionWithContext(context.Background(), req)
+}
+
+// GetStreamingDistributionWithContext is like GetStreamingDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) GetStreamingDistributionWithContext(ctx context.Context, req *GetStreamingDistributionRequest) (resp *GetStreamingDistributionResult, err error) {
+	resp = &GetStreamingDistributionResult{}
+
+	httpReq, err := c.newGetStreamingDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetStreamingDistribution", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -674,40 +1164,65 @@ func (c *CloudFront) GetInvalidation(req *GetInvalidationRequest) (resp *GetInva
 		return
 	}
 
+	if s := httpResp.Header.Get("ETag"); s != "" {
+		resp.ETag = &s
+	}
+
 	return
 }
 
-// GetStreamingDistribution get the information about a streaming
-// distribution.
-func (c *CloudFront) GetStreamingDistribution(req *GetStreamingDistributionRequest) (resp *GetStreamingDistributionResult, err error) {
-	resp = &GetStreamingDistributionResult{}
-
+// newGetStreamingDistributionRequest builds the HTTP request for GetStreamingDistribution.
+func (c *CloudFront) newGetStreamingDistributionRequest(ctx context.Context, req *GetStreamingDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/streaming-distribution/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
+	}
+
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
+	if err != nil {
+		return
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	return
+}
+
+// GetStreamingDistributionConfig get the configuration information about a
+// streaming distribution.
+func (c *CloudFront) GetStreamingDistributionConfig(req *GetStreamingDistributionConfigRequest) (resp *GetStreamingDistributionConfigResult, err error) {
+	return c.GetStreamingDistributionConfigWithContext(context.Background(), req)
+}
+
+// GetStreamingDistributionConfigWithContext is like GetStreamingDistributionConfig, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) GetStreamingDistributionConfigWithContext(ctx context.Context, req *GetStreamingDistributionConfigRequest) (resp *GetStreamingDistributionConfigResult, err error) {
+	resp = &GetStreamingDistributionConfigResult{}
+
+	httpReq, err := c.newGetStreamingDistributionConfigRequest(ctx, req)
 	if err != nil {
 		return
 	}
 
-	if contentType != "" {
-		httpReq.Header.Set("Content-Type", contentType)
-	}
-
-	httpResp, err := c.client.Do(httpReq)
+	httpResp, err := c.client.DoOperation("GetStreamingDistributionConfig", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -719,36 +1234,35 @@ func (c *CloudFront) GetStreamingDistribution(req *GetStreamingDistributionReque
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	return
 }
 
-// GetStreamingDistributionConfig get the configuration information about a
-// streaming distribution.
-func (c *CloudFront) GetStreamingDistributionConfig(req *GetStreamingDistributionConfigRequest) (resp *GetStreamingDistributionConfigResult, err error) {
-	resp = &GetStreamingDistributionConfigResult{}
-
+// newGetStreamingDistributionConfigRequest builds the HTTP request for GetStreamingDistributionConfig.
+func (c *CloudFront) newGetStreamingDistributionConfigRequest(ctx context.Context, req *GetStreamingDistributionConfigRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/streaming-distribution/{Id}/config"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -757,7 +1271,26 @@ func (c *CloudFront) GetStreamingDistributionConfig(req *GetStreamingDistributio
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListCloudFrontOriginAccessIdentities is undocumented.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentities(req *ListCloudFrontOriginAccessIdentitiesRequest) (resp *ListCloudFrontOriginAccessIdentitiesResult, err error) {
+	return c.ListCloudFrontOriginAccessIdentitiesWithContext(context.Background(), req)
+}
+
+// ListCloudFrontOriginAccessIdentitiesWithContext is like ListCloudFrontOriginAccessIdentities, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesWithContext(ctx context.Context, req *ListCloudFrontOriginAccessIdentitiesRequest) (resp *ListCloudFrontOriginAccessIdentitiesResult, err error) {
+	resp = &ListCloudFrontOriginAccessIdentitiesResult{}
+
+	httpReq, err := c.newListCloudFrontOriginAccessIdentitiesRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListCloudFrontOriginAccessIdentities", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -768,19 +1301,11 @@ func (c *CloudFront) GetStreamingDistributionConfig(req *GetStreamingDistributio
 		return
 	}
 
-	if s := httpResp.Header.Get("ETag"); s != "" {
-
-		resp.ETag = &s
-
-	}
-
 	return
 }
 
-// ListCloudFrontOriginAccessIdentities is undocumented.
-func (c *CloudFront) ListCloudFrontOriginAccessIdentities(req *ListCloudFrontOriginAccessIdentitiesRequest) (resp *ListCloudFrontOriginAccessIdentitiesResult, err error) {
-	resp = &ListCloudFrontOriginAccessIdentitiesResult{}
-
+// newListCloudFrontOriginAccessIdentitiesRequest builds the HTTP request for ListCloudFrontOriginAccessIdentities.
+func (c *CloudFront) newListCloudFrontOriginAccessIdentitiesRequest(ctx context.Context, req *ListCloudFrontOriginAccessIdentitiesRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -797,10 +1322,14 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentities(req *ListCloudFrontOri
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -809,7 +1338,26 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentities(req *ListCloudFrontOri
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListDistributions is undocumented.
+func (c *CloudFront) ListDistributions(req *ListDistributionsRequest) (resp *ListDistributionsResult, err error) {
+	return c.ListDistributionsWithContext(context.Background(), req)
+}
+
+// ListDistributionsWithContext is like ListDistributions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) ListDistributionsWithContext(ctx context.Context, req *ListDistributionsRequest) (resp *ListDistributionsResult, err error) {
+	resp = &ListDistributionsResult{}
+
+	httpReq, err := c.newListDistributionsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListDistributions", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -823,10 +1371,8 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentities(req *ListCloudFrontOri
 	return
 }
 
-// ListDistributions is undocumented.
-func (c *CloudFront) ListDistributions(req *ListDistributionsRequest) (resp *ListDistributionsResult, err error) {
-	resp = &ListDistributionsResult{}
-
+// newListDistributionsRequest builds the HTTP request for ListDistributions.
+func (c *CloudFront) newListDistributionsRequest(ctx context.Context, req *ListDistributionsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -843,10 +1389,14 @@ func (c *CloudFront) ListDistributions(req *ListDistributionsRequest) (resp *Lis
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -855,7 +1405,26 @@ func (c *CloudFront) ListDistributions(req *ListDistributionsRequest) (resp *Lis
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListInvalidations is undocumented.
+func (c *CloudFront) ListInvalidations(req *ListInvalidationsRequest) (resp *ListInvalidationsResult, err error) {
+	return c.ListInvalidationsWithContext(context.Background(), req)
+}
+
+// ListInvalidationsWithContext is like ListInvalidations, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) ListInvalidationsWithContext(ctx context.Context, req *ListInvalidationsRequest) (resp *ListInvalidationsResult, err error) {
+	resp = &ListInvalidationsResult{}
+
+	httpReq, err := c.newListInvalidationsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListInvalidations", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -869,18 +1438,16 @@ func (c *CloudFront) ListDistributions(req *ListDistributionsRequest) (resp *Lis
 	return
 }
 
-// ListInvalidations is undocumented.
-func (c *CloudFront) ListInvalidations(req *ListInvalidationsRequest) (resp *ListInvalidationsResult, err error) {
-	resp = &ListInvalidationsResult{}
-
+// newListInvalidationsRequest builds the HTTP request for ListInvalidations.
+func (c *CloudFront) newListInvalidationsRequest(ctx context.Context, req *ListInvalidationsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-10-21/distribution/{DistributionId}/invalidation"
 
 	if req.DistributionID != nil {
-		uri = strings.Replace(uri, "{"+"DistributionId"+"}", *req.DistributionID, -1)
-		uri = strings.Replace(uri, "{"+"DistributionId+"+"}", *req.DistributionID, -1)
+		uri = strings.Replace(uri, "{DistributionId}", aws.EscapePath(*req.DistributionID, false), -1)
+		uri = strings.Replace(uri, "{DistributionId+}", aws.EscapePath(*req.DistributionID, true), -1)
 	}
 
 	q := url.Values{}
@@ -894,10 +1461,14 @@ func (c *CloudFront) ListInvalidations(req *ListInvalidationsRequest) (resp *Lis
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -906,7 +1477,26 @@ func (c *CloudFront) ListInvalidations(req *ListInvalidationsRequest) (resp *Lis
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListStreamingDistributions is undocumented.
+func (c *CloudFront) ListStreamingDistributions(req *ListStreamingDistributionsRequest) (resp *ListStreamingDistributionsResult, err error) {
+	return c.ListStreamingDistributionsWithContext(context.Background(), req)
+}
+
+// ListStreamingDistributionsWithContext is like ListStreamingDistributions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) ListStreamingDistributionsWithContext(ctx context.Context, req *ListStreamingDistributionsRequest) (resp *ListStreamingDistributionsResult, err error) {
+	resp = &ListStreamingDistributionsResult{}
+
+	httpReq, err := c.newListStreamingDistributionsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListStreamingDistributions", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -920,10 +1510,8 @@ func (c *CloudFront) ListInvalidations(req *ListInvalidationsRequest) (resp *Lis
 	return
 }
 
-// ListStreamingDistributions is undocumented.
-func (c *CloudFront) ListStreamingDistributions(req *ListStreamingDistributionsRequest) (resp *ListStreamingDistributionsResult, err error) {
-	resp = &ListStreamingDistributionsResult{}
-
+// newListStreamingDistributionsRequest builds the HTTP request for ListStreamingDistributions.
+func (c *CloudFront) newListStreamingDistributionsRequest(ctx context.Context, req *ListStreamingDistributionsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -940,10 +1528,14 @@ func (c *CloudFront) ListStreamingDistributions(req *ListStreamingDistributionsR
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -952,7 +1544,26 @@ func (c *CloudFront) ListStreamingDistributions(req *ListStreamingDistributionsR
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdateCloudFrontOriginAccessIdentity is undocumented.
+func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(req *UpdateCloudFrontOriginAccessIdentityRequest) (resp *UpdateCloudFrontOriginAccessIdentityResult, err error) {
+	return c.UpdateCloudFrontOriginAccessIdentityWithContext(context.Background(), req)
+}
+
+// UpdateCloudFrontOriginAccessIdentityWithContext is like UpdateCloudFrontOriginAccessIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) UpdateCloudFrontOriginAccessIdentityWithContext(ctx context.Context, req *UpdateCloudFrontOriginAccessIdentityRequest) (resp *UpdateCloudFrontOriginAccessIdentityResult, err error) {
+	resp = &UpdateCloudFrontOriginAccessIdentityResult{}
+
+	httpReq, err := c.newUpdateCloudFrontOriginAccessIdentityRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdateCloudFrontOriginAccessIdentity", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -963,13 +1574,15 @@ func (c *CloudFront) ListStreamingDistributions(req *ListStreamingDistributionsR
 		return
 	}
 
+	if s := httpResp.Header.Get("ETag"); s != "" {
+		resp.ETag = &s
+	}
+
 	return
 }
 
-// UpdateCloudFrontOriginAccessIdentity is undocumented.
-func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(req *UpdateCloudFrontOriginAccessIdentityRequest) (resp *UpdateCloudFrontOriginAccessIdentityResult, err error) {
-	resp = &UpdateCloudFrontOriginAccessIdentityResult{}
-
+// newUpdateCloudFrontOriginAccessIdentityRequest builds the HTTP request for UpdateCloudFrontOriginAccessIdentity.
+func (c *CloudFront) newUpdateCloudFrontOriginAccessIdentityRequest(ctx context.Context, req *UpdateCloudFrontOriginAccessIdentityRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -989,17 +1602,21 @@ func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(req *UpdateCloudFrontO
 	uri := c.client.Endpoint + "/2014-10-21/origin-access-identity/cloudfront/{Id}/config"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -1012,7 +1629,26 @@ func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(req *UpdateCloudFrontO
 		httpReq.Header.Set("If-Match", *req.IfMatch)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdateDistribution is undocumented.
+func (c *CloudFront) UpdateDistribution(req *UpdateDistributionRequest) (resp *UpdateDistributionResult, err error) {
+	return c.UpdateDistributionWithContext(context.Background(), req)
+}
+
+// UpdateDistributionWithContext is like UpdateDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) UpdateDistributionWithContext(ctx context.Context, req *UpdateDistributionRequest) (resp *UpdateDistributionResult, err error) {
+	resp = &UpdateDistributionResult{}
+
+	httpReq, err := c.newUpdateDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdateDistribution", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1024,18 +1660,14 @@ func (c *CloudFront) UpdateCloudFrontOriginAccessIdentity(req *UpdateCloudFrontO
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	return
 }
 
-// UpdateDistribution is undocumented.
-func (c *CloudFront) UpdateDistribution(req *UpdateDistributionRequest) (resp *UpdateDistributionResult, err error) {
-	resp = &UpdateDistributionResult{}
-
+// newUpdateDistributionRequest builds the HTTP request for UpdateDistribution.
+func (c *CloudFront) newUpdateDistributionRequest(ctx context.Context, req *UpdateDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1055,17 +1687,21 @@ func (c *CloudFront) UpdateDistribution(req *UpdateDistributionRequest) (resp *U
 	uri := c.client.Endpoint + "/2014-10-21/distribution/{Id}/config"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -1078,7 +1714,26 @@ func (c *CloudFront) UpdateDistribution(req *UpdateDistributionRequest) (resp *U
 		httpReq.Header.Set("If-Match", *req.IfMatch)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdateStreamingDistribution is undocumented.
+func (c *CloudFront) UpdateStreamingDistribution(req *UpdateStreamingDistributionRequest) (resp *UpdateStreamingDistributionResult, err error) {
+	return c.UpdateStreamingDistributionWithContext(context.Background(), req)
+}
+
+// UpdateStreamingDistributionWithContext is like UpdateStreamingDistribution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudFront) UpdateStreamingDistributionWithContext(ctx context.Context, req *UpdateStreamingDistributionRequest) (resp *UpdateStreamingDistributionResult, err error) {
+	resp = &UpdateStreamingDistributionResult{}
+
+	httpReq, err := c.newUpdateStreamingDistributionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdateStreamingDistribution", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1090,18 +1745,14 @@ func (c *CloudFront) UpdateDistribution(req *UpdateDistributionRequest) (resp *U
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	return
 }
 
-// UpdateStreamingDistribution is undocumented.
-func (c *CloudFront) UpdateStreamingDistribution(req *UpdateStreamingDistributionRequest) (resp *UpdateStreamingDistributionResult, err error) {
-	resp = &UpdateStreamingDistributionResult{}
-
+// newUpdateStreamingDistributionRequest builds the HTTP request for UpdateStreamingDistribution.
+func (c *CloudFront) newUpdateStreamingDistributionRequest(ctx context.Context, req *UpdateStreamingDistributionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1121,17 +1772,21 @@ func (c *CloudFront) UpdateStreamingDistribution(req *UpdateStreamingDistributio
 	uri := c.client.Endpoint + "/2014-10-21/streaming-distribution/{Id}/config"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -1144,23 +1799,6 @@ func (c *CloudFront) UpdateStreamingDistribution(req *UpdateStreamingDistributio
 		httpReq.Header.Set("If-Match", *req.IfMatch)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
-	if s := httpResp.Header.Get("ETag"); s != "" {
-
-		resp.ETag = &s
-
-	}
-
 	return
 }
 
@@ -2289,6 +2927,286 @@ const (
 	ViewerProtocolPolicyRedirectToHTTPS = "redirect-to-https"
 )
 
+// AccessDenied is the error returned for the AccessDenied error code.
+type AccessDenied struct {
+	aws.APIError
+}
+
+// BatchTooLarge is the error returned for the BatchTooLarge error code.
+type BatchTooLarge struct {
+	aws.APIError
+}
+
+// CNAMEAlreadyExists is the error returned for the CNAMEAlreadyExists error code.
+type CNAMEAlreadyExists struct {
+	aws.APIError
+}
+
+// CloudFrontOriginAccessIdentityAlreadyExists is the error returned for the CloudFrontOriginAccessIdentityAlreadyExists error code.
+type CloudFrontOriginAccessIdentityAlreadyExists struct {
+	aws.APIError
+}
+
+// CloudFrontOriginAccessIdentityInUse is the error returned for the CloudFrontOriginAccessIdentityInUse error code.
+type CloudFrontOriginAccessIdentityInUse struct {
+	aws.APIError
+}
+
+// DistributionAlreadyExists is the error returned for the DistributionAlreadyExists error code.
+type DistributionAlreadyExists struct {
+	aws.APIError
+}
+
+// DistributionNotDisabled is the error returned for the DistributionNotDisabled error code.
+type DistributionNotDisabled struct {
+	aws.APIError
+}
+
+// IllegalUpdate is the error returned for the IllegalUpdate error code.
+type IllegalUpdate struct {
+	aws.APIError
+}
+
+// InconsistentQuantities is the error returned for the InconsistentQuantities error code.
+type InconsistentQuantities struct {
+	aws.APIError
+}
+
+// InvalidArgument is the error returned for the InvalidArgument error code.
+type InvalidArgument struct {
+	aws.APIError
+}
+
+// InvalidDefaultRootObject is the error returned for the InvalidDefaultRootObject error code.
+type InvalidDefaultRootObject struct {
+	aws.APIError
+}
+
+// InvalidErrorCode is the error returned for the InvalidErrorCode error code.
+type InvalidErrorCode struct {
+	aws.APIError
+}
+
+// InvalidForwardCookies is the error returned for the InvalidForwardCookies error code.
+type InvalidForwardCookies struct {
+	aws.APIError
+}
+
+// InvalidGeoRestrictionParameter is the error returned for the InvalidGeoRestrictionParameter error code.
+type InvalidGeoRestrictionParameter struct {
+	aws.APIError
+}
+
+// InvalidHeadersForS3Origin is the error returned for the InvalidHeadersForS3Origin error code.
+type InvalidHeadersForS3Origin struct {
+	aws.APIError
+}
+
+// InvalidIfMatchVersion is the error returned for the InvalidIfMatchVersion error code.
+type InvalidIfMatchVersion struct {
+	aws.APIError
+}
+
+// InvalidLocationCode is the error returned for the InvalidLocationCode error code.
+type InvalidLocationCode struct {
+	aws.APIError
+}
+
+// InvalidOrigin is the error returned for the InvalidOrigin error code.
+type InvalidOrigin struct {
+	aws.APIError
+}
+
+// InvalidOriginAccessIdentity is the error returned for the InvalidOriginAccessIdentity error code.
+type InvalidOriginAccessIdentity struct {
+	aws.APIError
+}
+
+// InvalidProtocolSettings is the error returned for the InvalidProtocolSettings error code.
+type InvalidProtocolSettings struct {
+	aws.APIError
+}
+
+// InvalidRelativePath is the error returned for the InvalidRelativePath error code.
+type InvalidRelativePath struct {
+	aws.APIError
+}
+
+// InvalidRequiredProtocol is the error returned for the InvalidRequiredProtocol error code.
+type InvalidRequiredProtocol struct {
+	aws.APIError
+}
+
+// InvalidResponseCode is the error returned for the InvalidResponseCode error code.
+type InvalidResponseCode struct {
+	aws.APIError
+}
+
+// InvalidViewerCertificate is the error returned for the InvalidViewerCertificate error code.
+type InvalidViewerCertificate struct {
+	aws.APIError
+}
+
+// MissingBody is the error returned for the MissingBody error code.
+type MissingBody struct {
+	aws.APIError
+}
+
+// NoSuchCloudFrontOriginAccessIdentity is the error returned for the NoSuchCloudFrontOriginAccessIdentity error code.
+type NoSuchCloudFrontOriginAccessIdentity struct {
+	aws.APIError
+}
+
+// NoSuchDistribution is the error returned for the NoSuchDistribution error code.
+type NoSuchDistribution struct {
+	aws.APIError
+}
+
+// NoSuchInvalidation is the error returned for the NoSuchInvalidation error code.
+type NoSuchInvalidation struct {
+	aws.APIError
+}
+
+// NoSuchOrigin is the error returned for the NoSuchOrigin error code.
+type NoSuchOrigin struct {
+	aws.APIError
+}
+
+// NoSuchStreamingDistribution is the error returned for the NoSuchStreamingDistribution error code.
+type NoSuchStreamingDistribution struct {
+	aws.APIError
+}
+
+// PreconditionFailed is the error returned for the PreconditionFailed error code.
+type PreconditionFailed struct {
+	aws.APIError
+}
+
+// StreamingDistributionAlreadyExists is the error returned for the StreamingDistributionAlreadyExists error code.
+type StreamingDistributionAlreadyExists struct {
+	aws.APIError
+}
+
+// StreamingDistributionNotDisabled is the error returned for the StreamingDistributionNotDisabled error code.
+type StreamingDistributionNotDisabled struct {
+	aws.APIError
+}
+
+// TooManyCacheBehaviors is the error returned for the TooManyCacheBehaviors error code.
+type TooManyCacheBehaviors struct {
+	aws.APIError
+}
+
+// TooManyCertificates is the error returned for the TooManyCertificates error code.
+type TooManyCertificates struct {
+	aws.APIError
+}
+
+// TooManyCloudFrontOriginAccessIdentities is the error returned for the TooManyCloudFrontOriginAccessIdentities error code.
+type TooManyCloudFrontOriginAccessIdentities struct {
+	aws.APIError
+}
+
+// TooManyCookieNamesInWhiteList is the error returned for the TooManyCookieNamesInWhiteList error code.
+type TooManyCookieNamesInWhiteList struct {
+	aws.APIError
+}
+
+// TooManyDistributionCNAMEs is the error returned for the TooManyDistributionCNAMEs error code.
+type TooManyDistributionCNAMEs struct {
+	aws.APIError
+}
+
+// TooManyDistributions is the error returned for the TooManyDistributions error code.
+type TooManyDistributions struct {
+	aws.APIError
+}
+
+// TooManyHeadersInForwardedValues is the error returned for the TooManyHeadersInForwardedValues error code.
+type TooManyHeadersInForwardedValues struct {
+	aws.APIError
+}
+
+// TooManyInvalidationsInProgress is the error returned for the TooManyInvalidationsInProgress error code.
+type TooManyInvalidationsInProgress struct {
+	aws.APIError
+}
+
+// TooManyOrigins is the error returned for the TooManyOrigins error code.
+type TooManyOrigins struct {
+	aws.APIError
+}
+
+// TooManyStreamingDistributionCNAMEs is the error returned for the TooManyStreamingDistributionCNAMEs error code.
+type TooManyStreamingDistributionCNAMEs struct {
+	aws.APIError
+}
+
+// TooManyStreamingDistributions is the error returned for the TooManyStreamingDistributions error code.
+type TooManyStreamingDistributions struct {
+	aws.APIError
+}
+
+// TooManyTrustedSigners is the error returned for the TooManyTrustedSigners error code.
+type TooManyTrustedSigners struct {
+	aws.APIError
+}
+
+// TrustedSignerDoesNotExist is the error returned for the TrustedSignerDoesNotExist error code.
+type TrustedSignerDoesNotExist struct {
+	aws.APIError
+}
+
+// exceptions maps CloudFront's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AccessDenied":       AccessDenied{},
+	"BatchTooLarge":      BatchTooLarge{},
+	"CNAMEAlreadyExists": CNAMEAlreadyExists{},
+	"CloudFrontOriginAccessIdentityAlreadyExists": CloudFrontOriginAccessIdentityAlreadyExists{},
+	"CloudFrontOriginAccessIdentityInUse":         CloudFrontOriginAccessIdentityInUse{},
+	"DistributionAlreadyExists":                   DistributionAlreadyExists{},
+	"DistributionNotDisabled":                     DistributionNotDisabled{},
+	"IllegalUpdate":                               IllegalUpdate{},
+	"InconsistentQuantities":                      InconsistentQuantities{},
+	"InvalidArgument":                             InvalidArgument{},
+	"InvalidDefaultRootObject":                    InvalidDefaultRootObject{},
+	"InvalidErrorCode":                            InvalidErrorCode{},
+	"InvalidForwardCookies":                       InvalidForwardCookies{},
+	"InvalidGeoRestrictionParameter":              InvalidGeoRestrictionParameter{},
+	"InvalidHeadersForS3Origin":                   InvalidHeadersForS3Origin{},
+	"InvalidIfMatchVersion":                       InvalidIfMatchVersion{},
+	"InvalidLocationCode":                         InvalidLocationCode{},
+	"InvalidOrigin":                               InvalidOrigin{},
+	"InvalidOriginAccessIdentity":                 InvalidOriginAccessIdentity{},
+	"InvalidProtocolSettings":                     InvalidProtocolSettings{},
+	"InvalidRelativePath":                         InvalidRelativePath{},
+	"InvalidRequiredProtocol":                     InvalidRequiredProtocol{},
+	"InvalidResponseCode":                         InvalidResponseCode{},
+	"InvalidViewerCertificate":                    InvalidViewerCertificate{},
+	"MissingBody":                                 MissingBody{},
+	"NoSuchCloudFrontOriginAccessIdentity":        NoSuchCloudFrontOriginAccessIdentity{},
+	"NoSuchDistribution":                          NoSuchDistribution{},
+	"NoSuchInvalidation":                          NoSuchInvalidation{},
+	"NoSuchOrigin":                                NoSuchOrigin{},
+	"NoSuchStreamingDistribution":                 NoSuchStreamingDistribution{},
+	"PreconditionFailed":                          PreconditionFailed{},
+	"StreamingDistributionAlreadyExists":          StreamingDistributionAlreadyExists{},
+	"StreamingDistributionNotDisabled":            StreamingDistributionNotDisabled{},
+	"TooManyCacheBehaviors":                       TooManyCacheBehaviors{},
+	"TooManyCertificates":                         TooManyCertificates{},
+	"TooManyCloudFrontOriginAccessIdentities":     TooManyCloudFrontOriginAccessIdentities{},
+	"TooManyCookieNamesInWhiteList":               TooManyCookieNamesInWhiteList{},
+	"TooManyDistributionCNAMEs":                   TooManyDistributionCNAMEs{},
+	"TooManyDistributions":                        TooManyDistributions{},
+	"TooManyHeadersInForwardedValues":             TooManyHeadersInForwardedValues{},
+	"TooManyInvalidationsInProgress":              TooManyInvalidationsInProgress{},
+	"TooManyOrigins":                              TooManyOrigins{},
+	"TooManyStreamingDistributionCNAMEs":          TooManyStreamingDistributionCNAMEs{},
+	"TooManyStreamingDistributions":               TooManyStreamingDistributions{},
+	"TooManyTrustedSigners":                       TooManyTrustedSigners{},
+	"TrustedSignerDoesNotExist":                   TrustedSignerDoesNotExist{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
 
@@ -2298,3 +3216,4 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
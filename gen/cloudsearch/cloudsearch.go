@@ -4,11 +4,21 @@
 package cloudsearch
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // CloudSearch is a client for Amazon CloudSearch.
@@ -16,42 +26,97 @@ type CloudSearch struct {
 	client *aws.QueryClient
 }
 
-// New returns a new CloudSearch client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CloudSearch {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "cloudsearch"
-	endpoint, service, region := endpoints.Lookup("cloudsearch", region)
+// New returns a new CloudSearch client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CloudSearch {
+	cfg := newConfig(region, client, opts)
 
 	return &CloudSearch{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2013-01-01",
 		},
 	}
 }
 
+// NewFromSession returns a new CloudSearch client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CloudSearch {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("cloudsearch", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("cloudsearch", "cloudsearch"),
+	}, "AWS_ENDPOINT_URL_CLOUDSEARCH", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CloudSearch) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CloudSearch) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *CloudSearch) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // BuildSuggesters indexes the search suggestions. For more information,
 // see Configuring Suggesters in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) BuildSuggesters(req *BuildSuggestersRequest) (resp *BuildSuggestersResult, err error) {
+	return c.BuildSuggestersWithContext(context.Background(), req)
+}
+
+// BuildSuggestersWithContext is like BuildSuggesters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) BuildSuggestersWithContext(ctx context.Context, req *BuildSuggestersRequest) (resp *BuildSuggestersResult, err error) {
 	resp = &BuildSuggestersResult{}
-	err = c.client.Do("BuildSuggesters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "BuildSuggesters", "POST", "/", req, resp)
 	return
 }
 
 // CreateDomain creates a new search domain. For more information, see
 // Creating a Search Domain in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) CreateDomain(req *CreateDomainRequest) (resp *CreateDomainResult, err error) {
+	return c.CreateDomainWithContext(context.Background(), req)
+}
+
+// CreateDomainWithContext is like CreateDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) CreateDomainWithContext(ctx context.Context, req *CreateDomainRequest) (resp *CreateDomainResult, err error) {
 	resp = &CreateDomainResult{}
-	err = c.client.Do("CreateDomain", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDomain", "POST", "/", req, resp)
 	return
 }
 
@@ -60,8 +125,15 @@ func (c *CloudSearch) CreateDomain(req *CreateDomainRequest) (resp *CreateDomain
 // processing options. For more information, see Configuring Analysis
 // Schemes in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DefineAnalysisScheme(req *DefineAnalysisSchemeRequest) (resp *DefineAnalysisSchemeResult, err error) {
+	return c.DefineAnalysisSchemeWithContext(context.Background(), req)
+}
+
+// DefineAnalysisSchemeWithContext is like DefineAnalysisScheme, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DefineAnalysisSchemeWithContext(ctx context.Context, req *DefineAnalysisSchemeRequest) (resp *DefineAnalysisSchemeResult, err error) {
 	resp = &DefineAnalysisSchemeResult{}
-	err = c.client.Do("DefineAnalysisScheme", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DefineAnalysisScheme", "POST", "/", req, resp)
 	return
 }
 
@@ -71,8 +143,15 @@ func (c *CloudSearch) DefineAnalysisScheme(req *DefineAnalysisSchemeRequest) (re
 // information, see Configuring Expressions in the Amazon CloudSearch
 // Developer Guide
 func (c *CloudSearch) DefineExpression(req *DefineExpressionRequest) (resp *DefineExpressionResult, err error) {
+	return c.DefineExpressionWithContext(context.Background(), req)
+}
+
+// DefineExpressionWithContext is like DefineExpression, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DefineExpressionWithContext(ctx context.Context, req *DefineExpressionRequest) (resp *DefineExpressionResult, err error) {
 	resp = &DefineExpressionResult{}
-	err = c.client.Do("DefineExpression", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DefineExpression", "POST", "/", req, resp)
 	return
 }
 
@@ -85,8 +164,15 @@ func (c *CloudSearch) DefineExpression(req *DefineExpressionRequest) (resp *Defi
 // configuration replaces the old one. For more information, see
 // Configuring Index Fields in the Amazon CloudSearch Developer Guide .
 func (c *CloudSearch) DefineIndexField(req *DefineIndexFieldRequest) (resp *DefineIndexFieldResult, err error) {
+	return c.DefineIndexFieldWithContext(context.Background(), req)
+}
+
+// DefineIndexFieldWithContext is like DefineIndexField, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DefineIndexFieldWithContext(ctx context.Context, req *DefineIndexFieldRequest) (resp *DefineIndexFieldResult, err error) {
 	resp = &DefineIndexFieldResult{}
-	err = c.client.Do("DefineIndexField", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DefineIndexField", "POST", "/", req, resp)
 	return
 }
 
@@ -97,8 +183,15 @@ func (c *CloudSearch) DefineIndexField(req *DefineIndexFieldRequest) (resp *Defi
 // for the suggester. For more information, see Getting Search Suggestions
 // in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DefineSuggester(req *DefineSuggesterRequest) (resp *DefineSuggesterResult, err error) {
+	return c.DefineSuggesterWithContext(context.Background(), req)
+}
+
+// DefineSuggesterWithContext is like DefineSuggester, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DefineSuggesterWithContext(ctx context.Context, req *DefineSuggesterRequest) (resp *DefineSuggesterResult, err error) {
 	resp = &DefineSuggesterResult{}
-	err = c.client.Do("DefineSuggester", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DefineSuggester", "POST", "/", req, resp)
 	return
 }
 
@@ -106,8 +199,15 @@ func (c *CloudSearch) DefineSuggester(req *DefineSuggesterRequest) (resp *Define
 // see Configuring Analysis Schemes in the Amazon CloudSearch Developer
 // Guide .
 func (c *CloudSearch) DeleteAnalysisScheme(req *DeleteAnalysisSchemeRequest) (resp *DeleteAnalysisSchemeResult, err error) {
+	return c.DeleteAnalysisSchemeWithContext(context.Background(), req)
+}
+
+// DeleteAnalysisSchemeWithContext is like DeleteAnalysisScheme, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DeleteAnalysisSchemeWithContext(ctx context.Context, req *DeleteAnalysisSchemeRequest) (resp *DeleteAnalysisSchemeResult, err error) {
 	resp = &DeleteAnalysisSchemeResult{}
-	err = c.client.Do("DeleteAnalysisScheme", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteAnalysisScheme", "POST", "/", req, resp)
 	return
 }
 
@@ -116,8 +216,15 @@ func (c *CloudSearch) DeleteAnalysisScheme(req *DeleteAnalysisSchemeRequest) (re
 // information, see Deleting a Search Domain in the Amazon CloudSearch
 // Developer Guide .
 func (c *CloudSearch) DeleteDomain(req *DeleteDomainRequest) (resp *DeleteDomainResult, err error) {
+	return c.DeleteDomainWithContext(context.Background(), req)
+}
+
+// DeleteDomainWithContext is like DeleteDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DeleteDomainWithContext(ctx context.Context, req *DeleteDomainRequest) (resp *DeleteDomainResult, err error) {
 	resp = &DeleteDomainResult{}
-	err = c.client.Do("DeleteDomain", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteDomain", "POST", "/", req, resp)
 	return
 }
 
@@ -125,8 +232,15 @@ func (c *CloudSearch) DeleteDomain(req *DeleteDomainRequest) (resp *DeleteDomain
 // information, see Configuring Expressions in the Amazon CloudSearch
 // Developer Guide
 func (c *CloudSearch) DeleteExpression(req *DeleteExpressionRequest) (resp *DeleteExpressionResult, err error) {
+	return c.DeleteExpressionWithContext(context.Background(), req)
+}
+
+// DeleteExpressionWithContext is like DeleteExpression, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DeleteExpressionWithContext(ctx context.Context, req *DeleteExpressionRequest) (resp *DeleteExpressionResult, err error) {
 	resp = &DeleteExpressionResult{}
-	err = c.client.Do("DeleteExpression", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteExpression", "POST", "/", req, resp)
 	return
 }
 
@@ -134,16 +248,30 @@ func (c *CloudSearch) DeleteExpression(req *DeleteExpressionRequest) (resp *Dele
 // information, see Configuring Index Fields in the Amazon CloudSearch
 // Developer Guide
 func (c *CloudSearch) DeleteIndexField(req *DeleteIndexFieldRequest) (resp *DeleteIndexFieldResult, err error) {
+	return c.DeleteIndexFieldWithContext(context.Background(), req)
+}
+
+// DeleteIndexFieldWithContext is like DeleteIndexField, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DeleteIndexFieldWithContext(ctx context.Context, req *DeleteIndexFieldRequest) (resp *DeleteIndexFieldResult, err error) {
 	resp = &DeleteIndexFieldResult{}
-	err = c.client.Do("DeleteIndexField", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteIndexField", "POST", "/", req, resp)
 	return
 }
 
 // DeleteSuggester deletes a suggester. For more information, see Getting
 // Search Suggestions in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DeleteSuggester(req *DeleteSuggesterRequest) (resp *DeleteSuggesterResult, err error) {
+	return c.DeleteSuggesterWithContext(context.Background(), req)
+}
+
+// DeleteSuggesterWithContext is like DeleteSuggester, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DeleteSuggesterWithContext(ctx context.Context, req *DeleteSuggesterRequest) (resp *DeleteSuggesterResult, err error) {
 	resp = &DeleteSuggesterResult{}
-	err = c.client.Do("DeleteSuggester", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteSuggester", "POST", "/", req, resp)
 	return
 }
 
@@ -156,8 +284,15 @@ func (c *CloudSearch) DeleteSuggester(req *DeleteSuggesterRequest) (resp *Delete
 // information, see Configuring Analysis Schemes in the Amazon CloudSearch
 // Developer Guide
 func (c *CloudSearch) DescribeAnalysisSchemes(req *DescribeAnalysisSchemesRequest) (resp *DescribeAnalysisSchemesResult, err error) {
+	return c.DescribeAnalysisSchemesWithContext(context.Background(), req)
+}
+
+// DescribeAnalysisSchemesWithContext is like DescribeAnalysisSchemes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeAnalysisSchemesWithContext(ctx context.Context, req *DescribeAnalysisSchemesRequest) (resp *DescribeAnalysisSchemesResult, err error) {
 	resp = &DescribeAnalysisSchemesResult{}
-	err = c.client.Do("DescribeAnalysisSchemes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAnalysisSchemes", "POST", "/", req, resp)
 	return
 }
 
@@ -167,8 +302,15 @@ func (c *CloudSearch) DescribeAnalysisSchemes(req *DescribeAnalysisSchemesReques
 // exclude pending changes. For more information, see Configuring
 // Availability Options in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DescribeAvailabilityOptions(req *DescribeAvailabilityOptionsRequest) (resp *DescribeAvailabilityOptionsResult, err error) {
+	return c.DescribeAvailabilityOptionsWithContext(context.Background(), req)
+}
+
+// DescribeAvailabilityOptionsWithContext is like DescribeAvailabilityOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeAvailabilityOptionsWithContext(ctx context.Context, req *DescribeAvailabilityOptionsRequest) (resp *DescribeAvailabilityOptionsResult, err error) {
 	resp = &DescribeAvailabilityOptionsResult{}
-	err = c.client.Do("DescribeAvailabilityOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAvailabilityOptions", "POST", "/", req, resp)
 	return
 }
 
@@ -180,8 +322,15 @@ func (c *CloudSearch) DescribeAvailabilityOptions(req *DescribeAvailabilityOptio
 // Getting Information about a Search Domain in the Amazon CloudSearch
 // Developer Guide
 func (c *CloudSearch) DescribeDomains(req *DescribeDomainsRequest) (resp *DescribeDomainsResult, err error) {
+	return c.DescribeDomainsWithContext(context.Background(), req)
+}
+
+// DescribeDomainsWithContext is like DescribeDomains, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeDomainsWithContext(ctx context.Context, req *DescribeDomainsRequest) (resp *DescribeDomainsResult, err error) {
 	resp = &DescribeDomainsResult{}
-	err = c.client.Do("DescribeDomains", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDomains", "POST", "/", req, resp)
 	return
 }
 
@@ -192,8 +341,15 @@ func (c *CloudSearch) DescribeDomains(req *DescribeDomainsRequest) (resp *Descri
 // configuration and exclude pending changes. For more information, see
 // Configuring Expressions in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DescribeExpressions(req *DescribeExpressionsRequest) (resp *DescribeExpressionsResult, err error) {
+	return c.DescribeExpressionsWithContext(context.Background(), req)
+}
+
+// DescribeExpressionsWithContext is like DescribeExpressions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeExpressionsWithContext(ctx context.Context, req *DescribeExpressionsRequest) (resp *DescribeExpressionsResult, err error) {
 	resp = &DescribeExpressionsResult{}
-	err = c.client.Do("DescribeExpressions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeExpressions", "POST", "/", req, resp)
 	return
 }
 
@@ -204,8 +360,15 @@ func (c *CloudSearch) DescribeExpressions(req *DescribeExpressionsRequest) (resp
 // configuration and exclude pending changes. For more information, see
 // Getting Domain Information in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DescribeIndexFields(req *DescribeIndexFieldsRequest) (resp *DescribeIndexFieldsResult, err error) {
+	return c.DescribeIndexFieldsWithContext(context.Background(), req)
+}
+
+// DescribeIndexFieldsWithContext is like DescribeIndexFields, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeIndexFieldsWithContext(ctx context.Context, req *DescribeIndexFieldsRequest) (resp *DescribeIndexFieldsResult, err error) {
 	resp = &DescribeIndexFieldsResult{}
-	err = c.client.Do("DescribeIndexFields", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeIndexFields", "POST", "/", req, resp)
 	return
 }
 
@@ -214,8 +377,15 @@ func (c *CloudSearch) DescribeIndexFields(req *DescribeIndexFieldsRequest) (resp
 // instance type and replication count. For more information, see
 // Configuring Scaling Options in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DescribeScalingParameters(req *DescribeScalingParametersRequest) (resp *DescribeScalingParametersResult, err error) {
+	return c.DescribeScalingParametersWithContext(context.Background(), req)
+}
+
+// DescribeScalingParametersWithContext is like DescribeScalingParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeScalingParametersWithContext(ctx context.Context, req *DescribeScalingParametersRequest) (resp *DescribeScalingParametersResult, err error) {
 	resp = &DescribeScalingParametersResult{}
-	err = c.client.Do("DescribeScalingParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeScalingParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -226,8 +396,15 @@ func (c *CloudSearch) DescribeScalingParameters(req *DescribeScalingParametersRe
 // pending changes. For more information, see Configuring Access for a
 // Search Domain in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DescribeServiceAccessPolicies(req *DescribeServiceAccessPoliciesRequest) (resp *DescribeServiceAccessPoliciesResult, err error) {
+	return c.DescribeServiceAccessPoliciesWithContext(context.Background(), req)
+}
+
+// DescribeServiceAccessPoliciesWithContext is like DescribeServiceAccessPolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeServiceAccessPoliciesWithContext(ctx context.Context, req *DescribeServiceAccessPoliciesRequest) (resp *DescribeServiceAccessPoliciesResult, err error) {
 	resp = &DescribeServiceAccessPoliciesResult{}
-	err = c.client.Do("DescribeServiceAccessPolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeServiceAccessPolicies", "POST", "/", req, resp)
 	return
 }
 
@@ -239,8 +416,15 @@ func (c *CloudSearch) DescribeServiceAccessPolicies(req *DescribeServiceAccessPo
 // configuration and exclude pending changes. For more information, see
 // Getting Search Suggestions in the Amazon CloudSearch Developer Guide
 func (c *CloudSearch) DescribeSuggesters(req *DescribeSuggestersRequest) (resp *DescribeSuggestersResult, err error) {
+	return c.DescribeSuggestersWithContext(context.Background(), req)
+}
+
+// DescribeSuggestersWithContext is like DescribeSuggesters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) DescribeSuggestersWithContext(ctx context.Context, req *DescribeSuggestersRequest) (resp *DescribeSuggestersResult, err error) {
 	resp = &DescribeSuggestersResult{}
-	err = c.client.Do("DescribeSuggesters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSuggesters", "POST", "/", req, resp)
 	return
 }
 
@@ -248,15 +432,29 @@ func (c *CloudSearch) DescribeSuggesters(req *DescribeSuggestersRequest) (resp *
 // using the latest indexing options. This operation must be invoked to
 // activate options whose OptionStatus is RequiresIndexDocuments
 func (c *CloudSearch) IndexDocuments(req *IndexDocumentsRequest) (resp *IndexDocumentsResult, err error) {
+	return c.IndexDocumentsWithContext(context.Background(), req)
+}
+
+// IndexDocumentsWithContext is like IndexDocuments, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) IndexDocumentsWithContext(ctx context.Context, req *IndexDocumentsRequest) (resp *IndexDocumentsResult, err error) {
 	resp = &IndexDocumentsResult{}
-	err = c.client.Do("IndexDocuments", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "IndexDocuments", "POST", "/", req, resp)
 	return
 }
 
 // ListDomainNames is undocumented.
 func (c *CloudSearch) ListDomainNames() (resp *ListDomainNamesResult, err error) {
+	return c.ListDomainNamesWithContext(context.Background())
+}
+
+// ListDomainNamesWithContext is like ListDomainNames, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) ListDomainNamesWithContext(ctx context.Context) (resp *ListDomainNamesResult, err error) {
 	resp = &ListDomainNamesResult{}
-	err = c.client.Do("ListDomainNames", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "ListDomainNames", "POST", "/", nil, resp)
 	return
 }
 
@@ -268,8 +466,15 @@ func (c *CloudSearch) ListDomainNames() (resp *ListDomainNamesResult, err error)
 // information, see Configuring Availability Options in the Amazon
 // CloudSearch Developer Guide
 func (c *CloudSearch) UpdateAvailabilityOptions(req *UpdateAvailabilityOptionsRequest) (resp *UpdateAvailabilityOptionsResult, err error) {
+	return c.UpdateAvailabilityOptionsWithContext(context.Background(), req)
+}
+
+// UpdateAvailabilityOptionsWithContext is like UpdateAvailabilityOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) UpdateAvailabilityOptionsWithContext(ctx context.Context, req *UpdateAvailabilityOptionsRequest) (resp *UpdateAvailabilityOptionsResult, err error) {
 	resp = &UpdateAvailabilityOptionsResult{}
-	err = c.client.Do("UpdateAvailabilityOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateAvailabilityOptions", "POST", "/", req, resp)
 	return
 }
 
@@ -282,8 +487,15 @@ func (c *CloudSearch) UpdateAvailabilityOptions(req *UpdateAvailabilityOptionsRe
 // For more information, see Configuring Scaling Options in the Amazon
 // CloudSearch Developer Guide .
 func (c *CloudSearch) UpdateScalingParameters(req *UpdateScalingParametersRequest) (resp *UpdateScalingParametersResult, err error) {
+	return c.UpdateScalingParametersWithContext(context.Background(), req)
+}
+
+// UpdateScalingParametersWithContext is like UpdateScalingParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) UpdateScalingParametersWithContext(ctx context.Context, req *UpdateScalingParametersRequest) (resp *UpdateScalingParametersResult, err error) {
 	resp = &UpdateScalingParametersResult{}
-	err = c.client.Do("UpdateScalingParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateScalingParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -291,8 +503,15 @@ func (c *CloudSearch) UpdateScalingParameters(req *UpdateScalingParametersReques
 // access to the domain's document and search endpoints. For more
 // information, see Configuring Access for an Amazon CloudSearch Domain
 func (c *CloudSearch) UpdateServiceAccessPolicies(req *UpdateServiceAccessPoliciesRequest) (resp *UpdateServiceAccessPoliciesResult, err error) {
+	return c.UpdateServiceAccessPoliciesWithContext(context.Background(), req)
+}
+
+// UpdateServiceAccessPoliciesWithContext is like UpdateServiceAccessPolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearch) UpdateServiceAccessPoliciesWithContext(ctx context.Context, req *UpdateServiceAccessPoliciesRequest) (resp *UpdateServiceAccessPoliciesResult, err error) {
 	resp = &UpdateServiceAccessPoliciesResult{}
-	err = c.client.Do("UpdateServiceAccessPolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateServiceAccessPolicies", "POST", "/", req, resp)
 	return
 }
 
@@ -319,6 +538,29 @@ type AnalysisOptions struct {
 	Synonyms                       aws.StringValue `query:"Synonyms" xml:"Synonyms"`
 }
 
+// MarshalQuery adds the parameters of the AnalysisOptions to the form, prefixing their names.
+func (v *AnalysisOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AlgorithmicStemming != nil {
+		form.Set(prefix+"AlgorithmicStemming", *v.AlgorithmicStemming)
+	}
+	if v.JapaneseTokenizationDictionary != nil {
+		form.Set(prefix+"JapaneseTokenizationDictionary", *v.JapaneseTokenizationDictionary)
+	}
+	if v.StemmingDictionary != nil {
+		form.Set(prefix+"StemmingDictionary", *v.StemmingDictionary)
+	}
+	if v.Stopwords != nil {
+		form.Set(prefix+"Stopwords", *v.Stopwords)
+	}
+	if v.Synonyms != nil {
+		form.Set(prefix+"Synonyms", *v.Synonyms)
+	}
+	return nil
+}
+
 // AnalysisScheme is undocumented.
 type AnalysisScheme struct {
 	AnalysisOptions        *AnalysisOptions `query:"AnalysisOptions" xml:"AnalysisOptions"`
@@ -326,6 +568,25 @@ type AnalysisScheme struct {
 	AnalysisSchemeName     aws.StringValue  `query:"AnalysisSchemeName" xml:"AnalysisSchemeName"`
 }
 
+// MarshalQuery adds the parameters of the AnalysisScheme to the form, prefixing their names.
+func (v *AnalysisScheme) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AnalysisOptions != nil {
+		if err := v.AnalysisOptions.MarshalQuery(form, prefix+"AnalysisOptions."); err != nil {
+			return err
+		}
+	}
+	if v.AnalysisSchemeLanguage != nil {
+		form.Set(prefix+"AnalysisSchemeLanguage", *v.AnalysisSchemeLanguage)
+	}
+	if v.AnalysisSchemeName != nil {
+		form.Set(prefix+"AnalysisSchemeName", *v.AnalysisSchemeName)
+	}
+	return nil
+}
+
 // Possible values for CloudSearch.
 const (
 	AnalysisSchemeLanguageAr     = "ar"
@@ -382,9 +643,20 @@ type BuildSuggestersRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the BuildSuggestersRequest to the form, prefixing their names.
+func (v *BuildSuggestersRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // BuildSuggestersResponse is undocumented.
 type BuildSuggestersResponse struct {
-	FieldNames []string `query:"FieldNames.member" xml:"BuildSuggestersResult>FieldNames>member"`
+	FieldNames []string `query:"FieldNames,list:member" xml:"BuildSuggestersResult>FieldNames>member"`
 }
 
 // CreateDomainRequest is undocumented.
@@ -392,6 +664,17 @@ type CreateDomainRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the CreateDomainRequest to the form, prefixing their names.
+func (v *CreateDomainRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // CreateDomainResponse is undocumented.
 type CreateDomainResponse struct {
 	DomainStatus *DomainStatus `query:"DomainStatus" xml:"CreateDomainResult>DomainStatus"`
@@ -406,6 +689,29 @@ type DateArrayOptions struct {
 	SourceFields  aws.StringValue  `query:"SourceFields" xml:"SourceFields"`
 }
 
+// MarshalQuery adds the parameters of the DateArrayOptions to the form, prefixing their names.
+func (v *DateArrayOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SourceFields != nil {
+		form.Set(prefix+"SourceFields", *v.SourceFields)
+	}
+	return nil
+}
+
 // DateOptions is undocumented.
 type DateOptions struct {
 	DefaultValue  aws.StringValue  `query:"DefaultValue" xml:"DefaultValue"`
@@ -416,12 +722,54 @@ type DateOptions struct {
 	SourceField   aws.StringValue  `query:"SourceField" xml:"SourceField"`
 }
 
+// MarshalQuery adds the parameters of the DateOptions to the form, prefixing their names.
+func (v *DateOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SortEnabled != nil {
+		form.Set(prefix+"SortEnabled", strconv.FormatBool(*v.SortEnabled))
+	}
+	if v.SourceField != nil {
+		form.Set(prefix+"SourceField", *v.SourceField)
+	}
+	return nil
+}
+
 // DefineAnalysisSchemeRequest is undocumented.
 type DefineAnalysisSchemeRequest struct {
 	AnalysisScheme *AnalysisScheme `query:"AnalysisScheme" xml:"AnalysisScheme"`
 	DomainName     aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DefineAnalysisSchemeRequest to the form, prefixing their names.
+func (v *DefineAnalysisSchemeRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AnalysisScheme != nil {
+		if err := v.AnalysisScheme.MarshalQuery(form, prefix+"AnalysisScheme."); err != nil {
+			return err
+		}
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DefineAnalysisSchemeResponse is undocumented.
 type DefineAnalysisSchemeResponse struct {
 	AnalysisScheme *AnalysisSchemeStatus `query:"AnalysisScheme" xml:"DefineAnalysisSchemeResult>AnalysisScheme"`
@@ -433,6 +781,22 @@ type DefineExpressionRequest struct {
 	Expression *Expression     `query:"Expression" xml:"Expression"`
 }
 
+// MarshalQuery adds the parameters of the DefineExpressionRequest to the form, prefixing their names.
+func (v *DefineExpressionRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.Expression != nil {
+		if err := v.Expression.MarshalQuery(form, prefix+"Expression."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DefineExpressionResponse is undocumented.
 type DefineExpressionResponse struct {
 	Expression *ExpressionStatus `query:"Expression" xml:"DefineExpressionResult>Expression"`
@@ -444,6 +808,22 @@ type DefineIndexFieldRequest struct {
 	IndexField *IndexField     `query:"IndexField" xml:"IndexField"`
 }
 
+// MarshalQuery adds the parameters of the DefineIndexFieldRequest to the form, prefixing their names.
+func (v *DefineIndexFieldRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.IndexField != nil {
+		if err := v.IndexField.MarshalQuery(form, prefix+"IndexField."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DefineIndexFieldResponse is undocumented.
 type DefineIndexFieldResponse struct {
 	IndexField *IndexFieldStatus `query:"IndexField" xml:"DefineIndexFieldResult>IndexField"`
@@ -455,6 +835,22 @@ type DefineSuggesterRequest struct {
 	Suggester  *Suggester      `query:"Suggester" xml:"Suggester"`
 }
 
+// MarshalQuery adds the parameters of the DefineSuggesterRequest to the form, prefixing their names.
+func (v *DefineSuggesterRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.Suggester != nil {
+		if err := v.Suggester.MarshalQuery(form, prefix+"Suggester."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DefineSuggesterResponse is undocumented.
 type DefineSuggesterResponse struct {
 	Suggester *SuggesterStatus `query:"Suggester" xml:"DefineSuggesterResult>Suggester"`
@@ -466,6 +862,20 @@ type DeleteAnalysisSchemeRequest struct {
 	DomainName         aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteAnalysisSchemeRequest to the form, prefixing their names.
+func (v *DeleteAnalysisSchemeRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AnalysisSchemeName != nil {
+		form.Set(prefix+"AnalysisSchemeName", *v.AnalysisSchemeName)
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DeleteAnalysisSchemeResponse is undocumented.
 type DeleteAnalysisSchemeResponse struct {
 	AnalysisScheme *AnalysisSchemeStatus `query:"AnalysisScheme" xml:"DeleteAnalysisSchemeResult>AnalysisScheme"`
@@ -476,6 +886,17 @@ type DeleteDomainRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteDomainRequest to the form, prefixing their names.
+func (v *DeleteDomainRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DeleteDomainResponse is undocumented.
 type DeleteDomainResponse struct {
 	DomainStatus *DomainStatus `query:"DomainStatus" xml:"DeleteDomainResult>DomainStatus"`
@@ -487,6 +908,20 @@ type DeleteExpressionRequest struct {
 	ExpressionName aws.StringValue `query:"ExpressionName" xml:"ExpressionName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteExpressionRequest to the form, prefixing their names.
+func (v *DeleteExpressionRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.ExpressionName != nil {
+		form.Set(prefix+"ExpressionName", *v.ExpressionName)
+	}
+	return nil
+}
+
 // DeleteExpressionResponse is undocumented.
 type DeleteExpressionResponse struct {
 	Expression *ExpressionStatus `query:"Expression" xml:"DeleteExpressionResult>Expression"`
@@ -498,6 +933,20 @@ type DeleteIndexFieldRequest struct {
 	IndexFieldName aws.StringValue `query:"IndexFieldName" xml:"IndexFieldName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteIndexFieldRequest to the form, prefixing their names.
+func (v *DeleteIndexFieldRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.IndexFieldName != nil {
+		form.Set(prefix+"IndexFieldName", *v.IndexFieldName)
+	}
+	return nil
+}
+
 // DeleteIndexFieldResponse is undocumented.
 type DeleteIndexFieldResponse struct {
 	IndexField *IndexFieldStatus `query:"IndexField" xml:"DeleteIndexFieldResult>IndexField"`
@@ -509,6 +958,20 @@ type DeleteSuggesterRequest struct {
 	SuggesterName aws.StringValue `query:"SuggesterName" xml:"SuggesterName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteSuggesterRequest to the form, prefixing their names.
+func (v *DeleteSuggesterRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.SuggesterName != nil {
+		form.Set(prefix+"SuggesterName", *v.SuggesterName)
+	}
+	return nil
+}
+
 // DeleteSuggesterResponse is undocumented.
 type DeleteSuggesterResponse struct {
 	Suggester *SuggesterStatus `query:"Suggester" xml:"DeleteSuggesterResult>Suggester"`
@@ -516,14 +979,37 @@ type DeleteSuggesterResponse struct {
 
 // DescribeAnalysisSchemesRequest is undocumented.
 type DescribeAnalysisSchemesRequest struct {
-	AnalysisSchemeNames []string         `query:"AnalysisSchemeNames.member" xml:"AnalysisSchemeNames>member"`
+	AnalysisSchemeNames []string         `query:"AnalysisSchemeNames,list:member" xml:"AnalysisSchemeNames>member"`
 	Deployed            aws.BooleanValue `query:"Deployed" xml:"Deployed"`
 	DomainName          aws.StringValue  `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeAnalysisSchemesRequest to the form, prefixing their names.
+func (v *DescribeAnalysisSchemesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AnalysisSchemeNames != nil {
+		if len(v.AnalysisSchemeNames) == 0 {
+			form.Set(prefix+"AnalysisSchemeNames", "")
+		}
+		for i1 := range v.AnalysisSchemeNames {
+			name1 := prefix + "AnalysisSchemeNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AnalysisSchemeNames[i1])
+		}
+	}
+	if v.Deployed != nil {
+		form.Set(prefix+"Deployed", strconv.FormatBool(*v.Deployed))
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DescribeAnalysisSchemesResponse is undocumented.
 type DescribeAnalysisSchemesResponse struct {
-	AnalysisSchemes []AnalysisSchemeStatus `query:"AnalysisSchemes.member" xml:"DescribeAnalysisSchemesResult>AnalysisSchemes>member"`
+	AnalysisSchemes []AnalysisSchemeStatus `query:"AnalysisSchemes,list:member" xml:"DescribeAnalysisSchemesResult>AnalysisSchemes>member"`
 }
 
 // DescribeAvailabilityOptionsRequest is undocumented.
@@ -532,6 +1018,20 @@ type DescribeAvailabilityOptionsRequest struct {
 	DomainName aws.StringValue  `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeAvailabilityOptionsRequest to the form, prefixing their names.
+func (v *DescribeAvailabilityOptionsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Deployed != nil {
+		form.Set(prefix+"Deployed", strconv.FormatBool(*v.Deployed))
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DescribeAvailabilityOptionsResponse is undocumented.
 type DescribeAvailabilityOptionsResponse struct {
 	AvailabilityOptions *AvailabilityOptionsStatus `query:"AvailabilityOptions" xml:"DescribeAvailabilityOptionsResult>AvailabilityOptions"`
@@ -539,36 +1039,99 @@ type DescribeAvailabilityOptionsResponse struct {
 
 // DescribeDomainsRequest is undocumented.
 type DescribeDomainsRequest struct {
-	DomainNames []string `query:"DomainNames.member" xml:"DomainNames>member"`
+	DomainNames []string `query:"DomainNames,list:member" xml:"DomainNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeDomainsRequest to the form, prefixing their names.
+func (v *DescribeDomainsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainNames != nil {
+		if len(v.DomainNames) == 0 {
+			form.Set(prefix+"DomainNames", "")
+		}
+		for i1 := range v.DomainNames {
+			name1 := prefix + "DomainNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.DomainNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeDomainsResponse is undocumented.
 type DescribeDomainsResponse struct {
-	DomainStatusList []DomainStatus `query:"DomainStatusList.member" xml:"DescribeDomainsResult>DomainStatusList>member"`
+	DomainStatusList []DomainStatus `query:"DomainStatusList,list:member" xml:"DescribeDomainsResult>DomainStatusList>member"`
 }
 
 // DescribeExpressionsRequest is undocumented.
 type DescribeExpressionsRequest struct {
 	Deployed        aws.BooleanValue `query:"Deployed" xml:"Deployed"`
 	DomainName      aws.StringValue  `query:"DomainName" xml:"DomainName"`
-	ExpressionNames []string         `query:"ExpressionNames.member" xml:"ExpressionNames>member"`
+	ExpressionNames []string         `query:"ExpressionNames,list:member" xml:"ExpressionNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeExpressionsRequest to the form, prefixing their names.
+func (v *DescribeExpressionsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Deployed != nil {
+		form.Set(prefix+"Deployed", strconv.FormatBool(*v.Deployed))
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.ExpressionNames != nil {
+		if len(v.ExpressionNames) == 0 {
+			form.Set(prefix+"ExpressionNames", "")
+		}
+		for i1 := range v.ExpressionNames {
+			name1 := prefix + "ExpressionNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ExpressionNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeExpressionsResponse is undocumented.
 type DescribeExpressionsResponse struct {
-	Expressions []ExpressionStatus `query:"Expressions.member" xml:"DescribeExpressionsResult>Expressions>member"`
+	Expressions []ExpressionStatus `query:"Expressions,list:member" xml:"DescribeExpressionsResult>Expressions>member"`
 }
 
 // DescribeIndexFieldsRequest is undocumented.
 type DescribeIndexFieldsRequest struct {
 	Deployed   aws.BooleanValue `query:"Deployed" xml:"Deployed"`
 	DomainName aws.StringValue  `query:"DomainName" xml:"DomainName"`
-	FieldNames []string         `query:"FieldNames.member" xml:"FieldNames>member"`
+	FieldNames []string         `query:"FieldNames,list:member" xml:"FieldNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeIndexFieldsRequest to the form, prefixing their names.
+func (v *DescribeIndexFieldsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Deployed != nil {
+		form.Set(prefix+"Deployed", strconv.FormatBool(*v.Deployed))
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.FieldNames != nil {
+		if len(v.FieldNames) == 0 {
+			form.Set(prefix+"FieldNames", "")
+		}
+		for i1 := range v.FieldNames {
+			name1 := prefix + "FieldNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.FieldNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeIndexFieldsResponse is undocumented.
 type DescribeIndexFieldsResponse struct {
-	IndexFields []IndexFieldStatus `query:"IndexFields.member" xml:"DescribeIndexFieldsResult>IndexFields>member"`
+	IndexFields []IndexFieldStatus `query:"IndexFields,list:member" xml:"DescribeIndexFieldsResult>IndexFields>member"`
 }
 
 // DescribeScalingParametersRequest is undocumented.
@@ -576,6 +1139,17 @@ type DescribeScalingParametersRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeScalingParametersRequest to the form, prefixing their names.
+func (v *DescribeScalingParametersRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DescribeScalingParametersResponse is undocumented.
 type DescribeScalingParametersResponse struct {
 	ScalingParameters *ScalingParametersStatus `query:"ScalingParameters" xml:"DescribeScalingParametersResult>ScalingParameters"`
@@ -587,6 +1161,20 @@ type DescribeServiceAccessPoliciesRequest struct {
 	DomainName aws.StringValue  `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeServiceAccessPoliciesRequest to the form, prefixing their names.
+func (v *DescribeServiceAccessPoliciesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Deployed != nil {
+		form.Set(prefix+"Deployed", strconv.FormatBool(*v.Deployed))
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DescribeServiceAccessPoliciesResponse is undocumented.
 type DescribeServiceAccessPoliciesResponse struct {
 	AccessPolicies *AccessPoliciesStatus `query:"AccessPolicies" xml:"DescribeServiceAccessPoliciesResult>AccessPolicies"`
@@ -596,12 +1184,35 @@ type DescribeServiceAccessPoliciesResponse struct {
 type DescribeSuggestersRequest struct {
 	Deployed       aws.BooleanValue `query:"Deployed" xml:"Deployed"`
 	DomainName     aws.StringValue  `query:"DomainName" xml:"DomainName"`
-	SuggesterNames []string         `query:"SuggesterNames.member" xml:"SuggesterNames>member"`
+	SuggesterNames []string         `query:"SuggesterNames,list:member" xml:"SuggesterNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeSuggestersRequest to the form, prefixing their names.
+func (v *DescribeSuggestersRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Deployed != nil {
+		form.Set(prefix+"Deployed", strconv.FormatBool(*v.Deployed))
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.SuggesterNames != nil {
+		if len(v.SuggesterNames) == 0 {
+			form.Set(prefix+"SuggesterNames", "")
+		}
+		for i1 := range v.SuggesterNames {
+			name1 := prefix + "SuggesterNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SuggesterNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeSuggestersResponse is undocumented.
 type DescribeSuggestersResponse struct {
-	Suggesters []SuggesterStatus `query:"Suggesters.member" xml:"DescribeSuggestersResult>Suggesters>member"`
+	Suggesters []SuggesterStatus `query:"Suggesters,list:member" xml:"DescribeSuggestersResult>Suggesters>member"`
 }
 
 // DocumentSuggesterOptions is undocumented.
@@ -611,6 +1222,31 @@ type DocumentSuggesterOptions struct {
 	SourceField    aws.StringValue `query:"SourceField" xml:"SourceField"`
 }
 
+// MarshalQuery adds the parameters of the DocumentSuggesterOptions to the form, prefixing their names.
+func (v *DocumentSuggesterOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.FuzzyMatching != nil {
+		form.Set(prefix+"FuzzyMatching", *v.FuzzyMatching)
+	}
+	if v.SortExpression != nil {
+		form.Set(prefix+"SortExpression", *v.SortExpression)
+	}
+	if v.SourceField != nil {
+		form.Set(prefix+"SourceField", *v.SourceField)
+	}
+	return nil
+}
+
+// DomainNameMap is undocumented.
+type DomainNameMap map[string]string
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *DomainNameMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
 // DomainStatus is undocumented.
 type DomainStatus struct {
 	ARN                    aws.StringValue  `query:"ARN" xml:"ARN"`
@@ -637,6 +1273,29 @@ type DoubleArrayOptions struct {
 	SourceFields  aws.StringValue  `query:"SourceFields" xml:"SourceFields"`
 }
 
+// MarshalQuery adds the parameters of the DoubleArrayOptions to the form, prefixing their names.
+func (v *DoubleArrayOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", strconv.FormatFloat(float64(*v.DefaultValue), 'f', -1, 64))
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SourceFields != nil {
+		form.Set(prefix+"SourceFields", *v.SourceFields)
+	}
+	return nil
+}
+
 // DoubleOptions is undocumented.
 type DoubleOptions struct {
 	DefaultValue  aws.DoubleValue  `query:"DefaultValue" xml:"DefaultValue"`
@@ -647,12 +1306,52 @@ type DoubleOptions struct {
 	SourceField   aws.StringValue  `query:"SourceField" xml:"SourceField"`
 }
 
+// MarshalQuery adds the parameters of the DoubleOptions to the form, prefixing their names.
+func (v *DoubleOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", strconv.FormatFloat(float64(*v.DefaultValue), 'f', -1, 64))
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SortEnabled != nil {
+		form.Set(prefix+"SortEnabled", strconv.FormatBool(*v.SortEnabled))
+	}
+	if v.SourceField != nil {
+		form.Set(prefix+"SourceField", *v.SourceField)
+	}
+	return nil
+}
+
 // Expression is undocumented.
 type Expression struct {
 	ExpressionName  aws.StringValue `query:"ExpressionName" xml:"ExpressionName"`
 	ExpressionValue aws.StringValue `query:"ExpressionValue" xml:"ExpressionValue"`
 }
 
+// MarshalQuery adds the parameters of the Expression to the form, prefixing their names.
+func (v *Expression) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ExpressionName != nil {
+		form.Set(prefix+"ExpressionName", *v.ExpressionName)
+	}
+	if v.ExpressionValue != nil {
+		form.Set(prefix+"ExpressionValue", *v.ExpressionValue)
+	}
+	return nil
+}
+
 // ExpressionStatus is undocumented.
 type ExpressionStatus struct {
 	Options *Expression   `query:"Options" xml:"Options"`
@@ -664,9 +1363,20 @@ type IndexDocumentsRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the IndexDocumentsRequest to the form, prefixing their names.
+func (v *IndexDocumentsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // IndexDocumentsResponse is undocumented.
 type IndexDocumentsResponse struct {
-	FieldNames []string `query:"FieldNames.member" xml:"IndexDocumentsResult>FieldNames>member"`
+	FieldNames []string `query:"FieldNames,list:member" xml:"IndexDocumentsResult>FieldNames>member"`
 }
 
 // IndexField is undocumented.
@@ -686,6 +1396,75 @@ type IndexField struct {
 	TextOptions         *TextOptions         `query:"TextOptions" xml:"TextOptions"`
 }
 
+// MarshalQuery adds the parameters of the IndexField to the form, prefixing their names.
+func (v *IndexField) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DateArrayOptions != nil {
+		if err := v.DateArrayOptions.MarshalQuery(form, prefix+"DateArrayOptions."); err != nil {
+			return err
+		}
+	}
+	if v.DateOptions != nil {
+		if err := v.DateOptions.MarshalQuery(form, prefix+"DateOptions."); err != nil {
+			return err
+		}
+	}
+	if v.DoubleArrayOptions != nil {
+		if err := v.DoubleArrayOptions.MarshalQuery(form, prefix+"DoubleArrayOptions."); err != nil {
+			return err
+		}
+	}
+	if v.DoubleOptions != nil {
+		if err := v.DoubleOptions.MarshalQuery(form, prefix+"DoubleOptions."); err != nil {
+			return err
+		}
+	}
+	if v.IndexFieldName != nil {
+		form.Set(prefix+"IndexFieldName", *v.IndexFieldName)
+	}
+	if v.IndexFieldType != nil {
+		form.Set(prefix+"IndexFieldType", *v.IndexFieldType)
+	}
+	if v.IntArrayOptions != nil {
+		if err := v.IntArrayOptions.MarshalQuery(form, prefix+"IntArrayOptions."); err != nil {
+			return err
+		}
+	}
+	if v.IntOptions != nil {
+		if err := v.IntOptions.MarshalQuery(form, prefix+"IntOptions."); err != nil {
+			return err
+		}
+	}
+	if v.LatLonOptions != nil {
+		if err := v.LatLonOptions.MarshalQuery(form, prefix+"LatLonOptions."); err != nil {
+			return err
+		}
+	}
+	if v.LiteralArrayOptions != nil {
+		if err := v.LiteralArrayOptions.MarshalQuery(form, prefix+"LiteralArrayOptions."); err != nil {
+			return err
+		}
+	}
+	if v.LiteralOptions != nil {
+		if err := v.LiteralOptions.MarshalQuery(form, prefix+"LiteralOptions."); err != nil {
+			return err
+		}
+	}
+	if v.TextArrayOptions != nil {
+		if err := v.TextArrayOptions.MarshalQuery(form, prefix+"TextArrayOptions."); err != nil {
+			return err
+		}
+	}
+	if v.TextOptions != nil {
+		if err := v.TextOptions.MarshalQuery(form, prefix+"TextOptions."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // IndexFieldStatus is undocumented.
 type IndexFieldStatus struct {
 	Options *IndexField   `query:"Options" xml:"Options"`
@@ -716,6 +1495,29 @@ type IntArrayOptions struct {
 	SourceFields  aws.StringValue  `query:"SourceFields" xml:"SourceFields"`
 }
 
+// MarshalQuery adds the parameters of the IntArrayOptions to the form, prefixing their names.
+func (v *IntArrayOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", strconv.FormatInt(int64(*v.DefaultValue), 10))
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SourceFields != nil {
+		form.Set(prefix+"SourceFields", *v.SourceFields)
+	}
+	return nil
+}
+
 // IntOptions is undocumented.
 type IntOptions struct {
 	DefaultValue  aws.LongValue    `query:"DefaultValue" xml:"DefaultValue"`
@@ -726,6 +1528,32 @@ type IntOptions struct {
 	SourceField   aws.StringValue  `query:"SourceField" xml:"SourceField"`
 }
 
+// MarshalQuery adds the parameters of the IntOptions to the form, prefixing their names.
+func (v *IntOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", strconv.FormatInt(int64(*v.DefaultValue), 10))
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SortEnabled != nil {
+		form.Set(prefix+"SortEnabled", strconv.FormatBool(*v.SortEnabled))
+	}
+	if v.SourceField != nil {
+		form.Set(prefix+"SourceField", *v.SourceField)
+	}
+	return nil
+}
+
 // LatLonOptions is undocumented.
 type LatLonOptions struct {
 	DefaultValue  aws.StringValue  `query:"DefaultValue" xml:"DefaultValue"`
@@ -736,6 +1564,32 @@ type LatLonOptions struct {
 	SourceField   aws.StringValue  `query:"SourceField" xml:"SourceField"`
 }
 
+// MarshalQuery adds the parameters of the LatLonOptions to the form, prefixing their names.
+func (v *LatLonOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SortEnabled != nil {
+		form.Set(prefix+"SortEnabled", strconv.FormatBool(*v.SortEnabled))
+	}
+	if v.SourceField != nil {
+		form.Set(prefix+"SourceField", *v.SourceField)
+	}
+	return nil
+}
+
 // Limits is undocumented.
 type Limits struct {
 	MaximumPartitionCount   aws.IntegerValue `query:"MaximumPartitionCount" xml:"MaximumPartitionCount"`
@@ -744,7 +1598,7 @@ type Limits struct {
 
 // ListDomainNamesResponse is undocumented.
 type ListDomainNamesResponse struct {
-	DomainNames map[string]string `query:"DomainNames" xml:"ListDomainNamesResult>DomainNames"`
+	DomainNames DomainNameMap `query:"DomainNames,map:entry:key:value" xml:"ListDomainNamesResult>DomainNames"`
 }
 
 // LiteralArrayOptions is undocumented.
@@ -756,6 +1610,29 @@ type LiteralArrayOptions struct {
 	SourceFields  aws.StringValue  `query:"SourceFields" xml:"SourceFields"`
 }
 
+// MarshalQuery adds the parameters of the LiteralArrayOptions to the form, prefixing their names.
+func (v *LiteralArrayOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SourceFields != nil {
+		form.Set(prefix+"SourceFields", *v.SourceFields)
+	}
+	return nil
+}
+
 // LiteralOptions is undocumented.
 type LiteralOptions struct {
 	DefaultValue  aws.StringValue  `query:"DefaultValue" xml:"DefaultValue"`
@@ -766,6 +1643,32 @@ type LiteralOptions struct {
 	SourceField   aws.StringValue  `query:"SourceField" xml:"SourceField"`
 }
 
+// MarshalQuery adds the parameters of the LiteralOptions to the form, prefixing their names.
+func (v *LiteralOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.FacetEnabled != nil {
+		form.Set(prefix+"FacetEnabled", strconv.FormatBool(*v.FacetEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SearchEnabled != nil {
+		form.Set(prefix+"SearchEnabled", strconv.FormatBool(*v.SearchEnabled))
+	}
+	if v.SortEnabled != nil {
+		form.Set(prefix+"SortEnabled", strconv.FormatBool(*v.SortEnabled))
+	}
+	if v.SourceField != nil {
+		form.Set(prefix+"SourceField", *v.SourceField)
+	}
+	return nil
+}
+
 // Possible values for CloudSearch.
 const (
 	OptionStateActive                 = "Active"
@@ -798,6 +1701,23 @@ type ScalingParameters struct {
 	DesiredReplicationCount aws.IntegerValue `query:"DesiredReplicationCount" xml:"DesiredReplicationCount"`
 }
 
+// MarshalQuery adds the parameters of the ScalingParameters to the form, prefixing their names.
+func (v *ScalingParameters) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DesiredInstanceType != nil {
+		form.Set(prefix+"DesiredInstanceType", *v.DesiredInstanceType)
+	}
+	if v.DesiredPartitionCount != nil {
+		form.Set(prefix+"DesiredPartitionCount", strconv.FormatInt(int64(*v.DesiredPartitionCount), 10))
+	}
+	if v.DesiredReplicationCount != nil {
+		form.Set(prefix+"DesiredReplicationCount", strconv.FormatInt(int64(*v.DesiredReplicationCount), 10))
+	}
+	return nil
+}
+
 // ScalingParametersStatus is undocumented.
 type ScalingParametersStatus struct {
 	Options *ScalingParameters `query:"Options" xml:"Options"`
@@ -815,6 +1735,22 @@ type Suggester struct {
 	SuggesterName            aws.StringValue           `query:"SuggesterName" xml:"SuggesterName"`
 }
 
+// MarshalQuery adds the parameters of the Suggester to the form, prefixing their names.
+func (v *Suggester) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DocumentSuggesterOptions != nil {
+		if err := v.DocumentSuggesterOptions.MarshalQuery(form, prefix+"DocumentSuggesterOptions."); err != nil {
+			return err
+		}
+	}
+	if v.SuggesterName != nil {
+		form.Set(prefix+"SuggesterName", *v.SuggesterName)
+	}
+	return nil
+}
+
 // Possible values for CloudSearch.
 const (
 	SuggesterFuzzyMatchingHigh = "high"
@@ -837,6 +1773,29 @@ type TextArrayOptions struct {
 	SourceFields     aws.StringValue  `query:"SourceFields" xml:"SourceFields"`
 }
 
+// MarshalQuery adds the parameters of the TextArrayOptions to the form, prefixing their names.
+func (v *TextArrayOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AnalysisScheme != nil {
+		form.Set(prefix+"AnalysisScheme", *v.AnalysisScheme)
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.HighlightEnabled != nil {
+		form.Set(prefix+"HighlightEnabled", strconv.FormatBool(*v.HighlightEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SourceFields != nil {
+		form.Set(prefix+"SourceFields", *v.SourceFields)
+	}
+	return nil
+}
+
 // TextOptions is undocumented.
 type TextOptions struct {
 	AnalysisScheme   aws.StringValue  `query:"AnalysisScheme" xml:"AnalysisScheme"`
@@ -847,12 +1806,52 @@ type TextOptions struct {
 	SourceField      aws.StringValue  `query:"SourceField" xml:"SourceField"`
 }
 
+// MarshalQuery adds the parameters of the TextOptions to the form, prefixing their names.
+func (v *TextOptions) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AnalysisScheme != nil {
+		form.Set(prefix+"AnalysisScheme", *v.AnalysisScheme)
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.HighlightEnabled != nil {
+		form.Set(prefix+"HighlightEnabled", strconv.FormatBool(*v.HighlightEnabled))
+	}
+	if v.ReturnEnabled != nil {
+		form.Set(prefix+"ReturnEnabled", strconv.FormatBool(*v.ReturnEnabled))
+	}
+	if v.SortEnabled != nil {
+		form.Set(prefix+"SortEnabled", strconv.FormatBool(*v.SortEnabled))
+	}
+	if v.SourceField != nil {
+		form.Set(prefix+"SourceField", *v.SourceField)
+	}
+	return nil
+}
+
 // UpdateAvailabilityOptionsRequest is undocumented.
 type UpdateAvailabilityOptionsRequest struct {
 	DomainName aws.StringValue  `query:"DomainName" xml:"DomainName"`
 	MultiAZ    aws.BooleanValue `query:"MultiAZ" xml:"MultiAZ"`
 }
 
+// MarshalQuery adds the parameters of the UpdateAvailabilityOptionsRequest to the form, prefixing their names.
+func (v *UpdateAvailabilityOptionsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.MultiAZ != nil {
+		form.Set(prefix+"MultiAZ", strconv.FormatBool(*v.MultiAZ))
+	}
+	return nil
+}
+
 // UpdateAvailabilityOptionsResponse is undocumented.
 type UpdateAvailabilityOptionsResponse struct {
 	AvailabilityOptions *AvailabilityOptionsStatus `query:"AvailabilityOptions" xml:"UpdateAvailabilityOptionsResult>AvailabilityOptions"`
@@ -864,6 +1863,22 @@ type UpdateScalingParametersRequest struct {
 	ScalingParameters *ScalingParameters `query:"ScalingParameters" xml:"ScalingParameters"`
 }
 
+// MarshalQuery adds the parameters of the UpdateScalingParametersRequest to the form, prefixing their names.
+func (v *UpdateScalingParametersRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.ScalingParameters != nil {
+		if err := v.ScalingParameters.MarshalQuery(form, prefix+"ScalingParameters."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // UpdateScalingParametersResponse is undocumented.
 type UpdateScalingParametersResponse struct {
 	ScalingParameters *ScalingParametersStatus `query:"ScalingParameters" xml:"UpdateScalingParametersResult>ScalingParameters"`
@@ -875,6 +1890,20 @@ type UpdateServiceAccessPoliciesRequest struct {
 	DomainName     aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateServiceAccessPoliciesRequest to the form, prefixing their names.
+func (v *UpdateServiceAccessPoliciesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccessPolicies != nil {
+		form.Set(prefix+"AccessPolicies", *v.AccessPolicies)
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // UpdateServiceAccessPoliciesResponse is undocumented.
 type UpdateServiceAccessPoliciesResponse struct {
 	AccessPolicies *AccessPoliciesStatus `query:"AccessPolicies" xml:"UpdateServiceAccessPoliciesResult>AccessPolicies"`
@@ -882,7 +1911,7 @@ type UpdateServiceAccessPoliciesResponse struct {
 
 // BuildSuggestersResult is a wrapper for BuildSuggestersResponse.
 type BuildSuggestersResult struct {
-	FieldNames []string `query:"FieldNames.member" xml:"BuildSuggestersResult>FieldNames>member"`
+	FieldNames []string `query:"FieldNames,list:member" xml:"BuildSuggestersResult>FieldNames>member"`
 }
 
 // CreateDomainResult is a wrapper for CreateDomainResponse.
@@ -937,7 +1966,7 @@ type DeleteSuggesterResult struct {
 
 // DescribeAnalysisSchemesResult is a wrapper for DescribeAnalysisSchemesResponse.
 type DescribeAnalysisSchemesResult struct {
-	AnalysisSchemes []AnalysisSchemeStatus `query:"AnalysisSchemes.member" xml:"DescribeAnalysisSchemesResult>AnalysisSchemes>member"`
+	AnalysisSchemes []AnalysisSchemeStatus `query:"AnalysisSchemes,list:member" xml:"DescribeAnalysisSchemesResult>AnalysisSchemes>member"`
 }
 
 // DescribeAvailabilityOptionsResult is a wrapper for DescribeAvailabilityOptionsResponse.
@@ -947,17 +1976,17 @@ type DescribeAvailabilityOptionsResult struct {
 
 // DescribeDomainsResult is a wrapper for DescribeDomainsResponse.
 type DescribeDomainsResult struct {
-	DomainStatusList []DomainStatus `query:"DomainStatusList.member" xml:"DescribeDomainsResult>DomainStatusList>member"`
+	DomainStatusList []DomainStatus `query:"DomainStatusList,list:member" xml:"DescribeDomainsResult>DomainStatusList>member"`
 }
 
 // DescribeExpressionsResult is a wrapper for DescribeExpressionsResponse.
 type DescribeExpressionsResult struct {
-	Expressions []ExpressionStatus `query:"Expressions.member" xml:"DescribeExpressionsResult>Expressions>member"`
+	Expressions []ExpressionStatus `query:"Expressions,list:member" xml:"DescribeExpressionsResult>Expressions>member"`
 }
 
 // DescribeIndexFieldsResult is a wrapper for DescribeIndexFieldsResponse.
 type DescribeIndexFieldsResult struct {
-	IndexFields []IndexFieldStatus `query:"IndexFields.member" xml:"DescribeIndexFieldsResult>IndexFields>member"`
+	IndexFields []IndexFieldStatus `query:"IndexFields,list:member" xml:"DescribeIndexFieldsResult>IndexFields>member"`
 }
 
 // DescribeScalingParametersResult is a wrapper for DescribeScalingParametersResponse.
@@ -972,17 +2001,17 @@ type DescribeServiceAccessPoliciesResult struct {
 
 // DescribeSuggestersResult is a wrapper for DescribeSuggestersResponse.
 type DescribeSuggestersResult struct {
-	Suggesters []SuggesterStatus `query:"Suggesters.member" xml:"DescribeSuggestersResult>Suggesters>member"`
+	Suggesters []SuggesterStatus `query:"Suggesters,list:member" xml:"DescribeSuggestersResult>Suggesters>member"`
 }
 
 // IndexDocumentsResult is a wrapper for IndexDocumentsResponse.
 type IndexDocumentsResult struct {
-	FieldNames []string `query:"FieldNames.member" xml:"IndexDocumentsResult>FieldNames>member"`
+	FieldNames []string `query:"FieldNames,list:member" xml:"IndexDocumentsResult>FieldNames>member"`
 }
 
 // ListDomainNamesResult is a wrapper for ListDomainNamesResponse.
 type ListDomainNamesResult struct {
-	DomainNames map[string]string `query:"DomainNames" xml:"ListDomainNamesResult>DomainNames"`
+	DomainNames DomainNameMap `query:"DomainNames,map:entry:key:value" xml:"ListDomainNamesResult>DomainNames"`
 }
 
 // UpdateAvailabilityOptionsResult is a wrapper for UpdateAvailabilityOptionsResponse.
@@ -1000,5 +2029,51 @@ type UpdateServiceAccessPoliciesResult struct {
 	AccessPolicies *AccessPoliciesStatus `query:"AccessPolicies" xml:"UpdateServiceAccessPoliciesResult>AccessPolicies"`
 }
 
+// BaseException is the error returned for the BaseException error code.
+type BaseException struct {
+	aws.APIError
+}
+
+// DisabledOperationException is the error returned for the DisabledAction error code.
+type DisabledOperationException struct {
+	aws.APIError
+}
+
+// InternalException is the error returned for the InternalException error code.
+type InternalException struct {
+	aws.APIError
+}
+
+// InvalidTypeException is the error returned for the InvalidType error code.
+type InvalidTypeException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceeded error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFound error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// exceptions maps CloudSearch's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"BaseException":     BaseException{},
+	"DisabledAction":    DisabledOperationException{},
+	"InternalException": InternalException{},
+	"InvalidType":       InvalidTypeException{},
+	"LimitExceeded":     LimitExceededException{},
+	"ResourceNotFound":  ResourceNotFoundException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
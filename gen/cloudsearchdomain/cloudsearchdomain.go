@@ -4,15 +4,18 @@
 package cloudsearchdomain
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
 )
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,29 +30,111 @@ type CloudSearchDomain struct {
 	client *aws.RestClient
 }
 
-// New returns a new CloudSearchDomain client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CloudSearchDomain {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "cloudsearchdomain"
-	endpoint, service, region := endpoints.Lookup("cloudsearchdomain", region)
+// New returns a new CloudSearchDomain client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CloudSearchDomain {
+	cfg := newConfig(region, client, opts)
 
 	return &CloudSearchDomain{
 		client: &aws.RestClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2013-01-01",
 		},
 	}
 }
 
+// NewFromSession returns a new CloudSearchDomain client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CloudSearchDomain {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("cloudsearchdomain", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("cloudsearchdomain", "cloudsearchdomain"),
+	}, "AWS_ENDPOINT_URL_CLOUDSEARCH_DOMAIN", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CloudSearchDomain) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CloudSearchDomain) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *CloudSearchDomain) SetPayloadSigning(p aws.PayloadSigning) {
+	c.client.Context.PayloadSigning = p
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *CloudSearchDomain) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch op {
+
+	case "Search":
+
+		r, ok := req.(*SearchRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudsearchdomain: Search takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newSearchRequest(context.Background(), r)
+
+	case "Suggest":
+
+		r, ok := req.(*SuggestRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudsearchdomain: Suggest takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newSuggestRequest(context.Background(), r)
+
+	case "UploadDocuments":
+
+		r, ok := req.(*UploadDocumentsRequest)
+		if !ok {
+			return "", fmt.Errorf("cloudsearchdomain: UploadDocuments takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUploadDocumentsRequest(context.Background(), r)
+
+	default:
+		return "", fmt.Errorf("cloudsearchdomain: unknown operation %q", op)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
 // Search retrieves a list of documents that match the specified search
 // criteria. How you specify the search criteria depends on which query
 // parser you use. Amazon CloudSearch supports four query parsers: simple :
@@ -68,8 +153,37 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Clo
 // endpoints are also displayed on the domain dashboard in the Amazon
 // CloudSearch console.
 func (c *CloudSearchDomain) Search(req *SearchRequest) (resp *SearchResponse, err error) {
+	return c.SearchWithContext(context.Background(), req)
+}
+
+// SearchWithContext is like Search, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearchDomain) SearchWithContext(ctx context.Context, req *SearchRequest) (resp *SearchResponse, err error) {
 	resp = &SearchResponse{}
 
+	httpReq, err := c.newSearchRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("Search", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newSearchRequest builds the HTTP request for Search.
+func (c *CloudSearchDomain) newSearchRequest(ctx context.Context, req *SearchRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -98,7 +212,7 @@ func (c *CloudSearchDomain) Search(req *SearchRequest) (resp *SearchResponse, er
 	}
 
 	if req.Partial != nil {
-		q.Set("partial", fmt.Sprintf("%v", req.Partial))
+		q.Set("partial", strconv.FormatBool(*req.Partial))
 	}
 
 	if req.Query != nil {
@@ -118,7 +232,7 @@ func (c *CloudSearchDomain) Search(req *SearchRequest) (resp *SearchResponse, er
 	}
 
 	if req.Size != nil {
-		q.Set("size", fmt.Sprintf("%v", req.Size))
+		q.Set("size", strconv.FormatInt(*req.Size, 10))
 	}
 
 	if req.Sort != nil {
@@ -126,14 +240,18 @@ func (c *CloudSearchDomain) Search(req *SearchRequest) (resp *SearchResponse, er
 	}
 
 	if req.Start != nil {
-		q.Set("start", fmt.Sprintf("%v", req.Start))
+		q.Set("start", strconv.FormatInt(*req.Start, 10))
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -142,18 +260,6 @@ func (c *CloudSearchDomain) Search(req *SearchRequest) (resp *SearchResponse, er
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -172,8 +278,37 @@ func (c *CloudSearchDomain) Search(req *SearchRequest) (resp *SearchResponse, er
 // service DescribeDomains action. A domain's endpoints are also displayed
 // on the domain dashboard in the Amazon CloudSearch console.
 func (c *CloudSearchDomain) Suggest(req *SuggestRequest) (resp *SuggestResponse, err error) {
+	return c.SuggestWithContext(context.Background(), req)
+}
+
+// SuggestWithContext is like Suggest, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearchDomain) SuggestWithContext(ctx context.Context, req *SuggestRequest) (resp *SuggestResponse, err error) {
 	resp = &SuggestResponse{}
 
+	httpReq, err := c.newSuggestRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("Suggest", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newSuggestRequest builds the HTTP request for Suggest.
+func (c *CloudSearchDomain) newSuggestRequest(ctx context.Context, req *SuggestRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -186,7 +321,7 @@ func (c *CloudSearchDomain) Suggest(req *SuggestRequest) (resp *SuggestResponse,
 	}
 
 	if req.Size != nil {
-		q.Set("size", fmt.Sprintf("%v", req.Size))
+		q.Set("size", strconv.FormatInt(*req.Size, 10))
 	}
 
 	if req.Suggester != nil {
@@ -194,10 +329,14 @@ func (c *CloudSearchDomain) Suggest(req *SuggestRequest) (resp *SuggestResponse,
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -206,18 +345,6 @@ func (c *CloudSearchDomain) Suggest(req *SuggestRequest) (resp *SuggestResponse,
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -242,8 +369,37 @@ func (c *CloudSearchDomain) Suggest(req *SuggestRequest) (resp *SuggestResponse,
 // information about uploading data for indexing, see Uploading Data in the
 // Amazon CloudSearch Developer Guide .
 func (c *CloudSearchDomain) UploadDocuments(req *UploadDocumentsRequest) (resp *UploadDocumentsResponse, err error) {
+	return c.UploadDocumentsWithContext(context.Background(), req)
+}
+
+// UploadDocumentsWithContext is like UploadDocuments, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudSearchDomain) UploadDocumentsWithContext(ctx context.Context, req *UploadDocumentsRequest) (resp *UploadDocumentsResponse, err error) {
 	resp = &UploadDocumentsResponse{}
 
+	httpReq, err := c.newUploadDocumentsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UploadDocuments", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newUploadDocumentsRequest builds the HTTP request for UploadDocuments.
+func (c *CloudSearchDomain) newUploadDocumentsRequest(ctx context.Context, req *UploadDocumentsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -259,10 +415,14 @@ func (c *CloudSearchDomain) UploadDocuments(req *UploadDocumentsRequest) (resp *
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -275,18 +435,6 @@ func (c *CloudSearchDomain) UploadDocuments(req *UploadDocumentsRequest) (resp *
 		httpReq.Header.Set("Content-Type", *req.ContentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -412,6 +560,24 @@ type UploadDocumentsResponse struct {
 	Warnings []DocumentServiceWarning `json:"warnings,omitempty"`
 }
 
+// DocumentServiceException is the error returned for the DocumentServiceException error code.
+type DocumentServiceException struct {
+	aws.APIError
+
+	Status aws.StringValue `json:"status,omitempty"`
+}
+
+// SearchException is the error returned for the SearchException error code.
+type SearchException struct {
+	aws.APIError
+}
+
+// exceptions maps CloudSearchDomain's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"DocumentServiceException": DocumentServiceException{},
+	"SearchException":          SearchException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
 
@@ -421,4 +587,5 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
 var _ json.RawMessage
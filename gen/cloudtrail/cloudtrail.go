@@ -4,11 +4,18 @@
 package cloudtrail
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // CloudTrail is a client for AWS CloudTrail.
@@ -16,50 +23,106 @@ type CloudTrail struct {
 	client *aws.JSONClient
 }
 
-// New returns a new CloudTrail client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CloudTrail {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "cloudtrail"
-	endpoint, service, region := endpoints.Lookup("cloudtrail", region)
+// New returns a new CloudTrail client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CloudTrail {
+	cfg := newConfig(region, client, opts)
 
 	return &CloudTrail{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "com.amazonaws.cloudtrail.v20131101.CloudTrail_20131101",
 		},
 	}
 }
 
+// NewFromSession returns a new CloudTrail client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CloudTrail {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("cloudtrail", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("cloudtrail", "cloudtrail"),
+	}, "AWS_ENDPOINT_URL_CLOUDTRAIL", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CloudTrail) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CloudTrail) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // CreateTrail from the command line, use create-subscription . Creates a
 // trail that specifies the settings for delivery of log data to an Amazon
 // S3 bucket.
 func (c *CloudTrail) CreateTrail(req *CreateTrailRequest) (resp *CreateTrailResponse, err error) {
+	return c.CreateTrailWithContext(context.Background(), req)
+}
+
+// CreateTrailWithContext is like CreateTrail, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudTrail) CreateTrailWithContext(ctx context.Context, req *CreateTrailRequest) (resp *CreateTrailResponse, err error) {
 	resp = &CreateTrailResponse{}
-	err = c.client.Do("CreateTrail", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateTrail", "POST", "/", req, resp)
 	return
 }
 
 // DeleteTrail is undocumented.
 func (c *CloudTrail) DeleteTrail(req *DeleteTrailRequest) (resp *DeleteTrailResponse, err error) {
+	return c.DeleteTrailWithContext(context.Background(), req)
+}
+
+// DeleteTrailWithContext is like DeleteTrail, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudTrail) DeleteTrailWithContext(ctx context.Context, req *DeleteTrailRequest) (resp *DeleteTrailResponse, err error) {
 	resp = &DeleteTrailResponse{}
-	err = c.client.Do("DeleteTrail", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteTrail", "POST", "/", req, resp)
 	return
 }
 
 // DescribeTrails retrieves settings for the trail associated with the
 // current region for your account.
 func (c *CloudTrail) DescribeTrails(req *DescribeTrailsRequest) (resp *DescribeTrailsResponse, err error) {
+	return c.DescribeTrailsWithContext(context.Background(), req)
+}
+
+// DescribeTrailsWithContext is like DescribeTrails, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudTrail) DescribeTrailsWithContext(ctx context.Context, req *DescribeTrailsRequest) (resp *DescribeTrailsResponse, err error) {
 	resp = &DescribeTrailsResponse{}
-	err = c.client.Do("DescribeTrails", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTrails", "POST", "/", req, resp)
 	return
 }
 
@@ -68,16 +131,30 @@ func (c *CloudTrail) DescribeTrails(req *DescribeTrailsRequest) (resp *DescribeT
 // SNS and Amazon S3 errors, and start and stop logging times for each
 // trail.
 func (c *CloudTrail) GetTrailStatus(req *GetTrailStatusRequest) (resp *GetTrailStatusResponse, err error) {
+	return c.GetTrailStatusWithContext(context.Background(), req)
+}
+
+// GetTrailStatusWithContext is like GetTrailStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudTrail) GetTrailStatusWithContext(ctx context.Context, req *GetTrailStatusRequest) (resp *GetTrailStatusResponse, err error) {
 	resp = &GetTrailStatusResponse{}
-	err = c.client.Do("GetTrailStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetTrailStatus", "POST", "/", req, resp)
 	return
 }
 
 // StartLogging starts the recording of AWS API calls and log file delivery
 // for a trail.
 func (c *CloudTrail) StartLogging(req *StartLoggingRequest) (resp *StartLoggingResponse, err error) {
+	return c.StartLoggingWithContext(context.Background(), req)
+}
+
+// StartLoggingWithContext is like StartLogging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudTrail) StartLoggingWithContext(ctx context.Context, req *StartLoggingRequest) (resp *StartLoggingResponse, err error) {
 	resp = &StartLoggingResponse{}
-	err = c.client.Do("StartLogging", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "StartLogging", "POST", "/", req, resp)
 	return
 }
 
@@ -86,8 +163,15 @@ func (c *CloudTrail) StartLogging(req *StartLoggingRequest) (resp *StartLoggingR
 // need to use this action. You can update a trail without stopping it
 // first. This action is the only way to stop recording.
 func (c *CloudTrail) StopLogging(req *StopLoggingRequest) (resp *StopLoggingResponse, err error) {
+	return c.StopLoggingWithContext(context.Background(), req)
+}
+
+// StopLoggingWithContext is like StopLogging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudTrail) StopLoggingWithContext(ctx context.Context, req *StopLoggingRequest) (resp *StopLoggingResponse, err error) {
 	resp = &StopLoggingResponse{}
-	err = c.client.Do("StopLogging", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "StopLogging", "POST", "/", req, resp)
 	return
 }
 
@@ -98,8 +182,15 @@ func (c *CloudTrail) StopLogging(req *StopLoggingRequest) (resp *StopLoggingResp
 // been a target for CloudTrail log files, an IAM policy exists for the
 // bucket.
 func (c *CloudTrail) UpdateTrail(req *UpdateTrailRequest) (resp *UpdateTrailResponse, err error) {
+	return c.UpdateTrailWithContext(context.Background(), req)
+}
+
+// UpdateTrailWithContext is like UpdateTrail, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudTrail) UpdateTrailWithContext(ctx context.Context, req *UpdateTrailRequest) (resp *UpdateTrailResponse, err error) {
 	resp = &UpdateTrailResponse{}
-	err = c.client.Do("UpdateTrail", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateTrail", "POST", "/", req, resp)
 	return
 }
 
@@ -114,6 +205,65 @@ type CreateTrailRequest struct {
 	SNSTopicName               aws.StringValue  `json:"SnsTopicName,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateTrailRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateTrailRequest to b.
+func (v *CreateTrailRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CloudWatchLogsLogGroupARN != nil {
+		b = append(b, "\"CloudWatchLogsLogGroupArn\":"...)
+		b = aws.AppendJSONString(b, *v.CloudWatchLogsLogGroupARN)
+		b = append(b, ',')
+	}
+	if v.CloudWatchLogsRoleARN != nil {
+		b = append(b, "\"CloudWatchLogsRoleArn\":"...)
+		b = aws.AppendJSONString(b, *v.CloudWatchLogsRoleARN)
+		b = append(b, ',')
+	}
+	if v.IncludeGlobalServiceEvents != nil {
+		b = append(b, "\"IncludeGlobalServiceEvents\":"...)
+		b = strconv.AppendBool(b, *v.IncludeGlobalServiceEvents)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"S3BucketName\":"...)
+	if v.S3BucketName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.S3BucketName)
+	}
+	b = append(b, ',')
+	if v.S3KeyPrefix != nil {
+		b = append(b, "\"S3KeyPrefix\":"...)
+		b = aws.AppendJSONString(b, *v.S3KeyPrefix)
+		b = append(b, ',')
+	}
+	if v.SNSTopicName != nil {
+		b = append(b, "\"SnsTopicName\":"...)
+		b = aws.AppendJSONString(b, *v.SNSTopicName)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateTrailResponse is undocumented.
 type CreateTrailResponse struct {
 	CloudWatchLogsLogGroupARN  aws.StringValue  `json:"CloudWatchLogsLogGroupArn,omitempty"`
@@ -130,6 +280,33 @@ type DeleteTrailRequest struct {
 	Name aws.StringValue `json:"Name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteTrailRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteTrailRequest to b.
+func (v *DeleteTrailRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteTrailResponse is undocumented.
 type DeleteTrailResponse struct {
 }
@@ -139,6 +316,40 @@ type DescribeTrailsRequest struct {
 	TrailNameList []string `json:"trailNameList,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTrailsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTrailsRequest to b.
+func (v *DescribeTrailsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.TrailNameList) != 0 {
+		b = append(b, "\"trailNameList\":"...)
+		b = append(b, '[')
+		for i1 := range v.TrailNameList {
+			b = aws.AppendJSONString(b, v.TrailNameList[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTrailsResponse is undocumented.
 type DescribeTrailsResponse struct {
 	TrailList []Trail `json:"trailList,omitempty"`
@@ -149,17 +360,44 @@ type GetTrailStatusRequest struct {
 	Name aws.StringValue `json:"Name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetTrailStatusRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetTrailStatusRequest to b.
+func (v *GetTrailStatusRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetTrailStatusResponse is undocumented.
 type GetTrailStatusResponse struct {
-	IsLogging                         aws.BooleanValue `json:"IsLogging,omitempty"`
-	LatestCloudWatchLogsDeliveryError aws.StringValue  `json:"LatestCloudWatchLogsDeliveryError,omitempty"`
-	LatestCloudWatchLogsDeliveryTime  time.Time        `json:"LatestCloudWatchLogsDeliveryTime,omitempty"`
-	LatestDeliveryError               aws.StringValue  `json:"LatestDeliveryError,omitempty"`
-	LatestDeliveryTime                time.Time        `json:"LatestDeliveryTime,omitempty"`
-	LatestNotificationError           aws.StringValue  `json:"LatestNotificationError,omitempty"`
-	LatestNotificationTime            time.Time        `json:"LatestNotificationTime,omitempty"`
-	StartLoggingTime                  time.Time        `json:"StartLoggingTime,omitempty"`
-	StopLoggingTime                   time.Time        `json:"StopLoggingTime,omitempty"`
+	IsLogging                         aws.BooleanValue    `json:"IsLogging,omitempty"`
+	LatestCloudWatchLogsDeliveryError aws.StringValue     `json:"LatestCloudWatchLogsDeliveryError,omitempty"`
+	LatestCloudWatchLogsDeliveryTime  *aws.FloatTimestamp `json:"LatestCloudWatchLogsDeliveryTime,omitempty"`
+	LatestDeliveryError               aws.StringValue     `json:"LatestDeliveryError,omitempty"`
+	LatestDeliveryTime                *aws.FloatTimestamp `json:"LatestDeliveryTime,omitempty"`
+	LatestNotificationError           aws.StringValue     `json:"LatestNotificationError,omitempty"`
+	LatestNotificationTime            *aws.FloatTimestamp `json:"LatestNotificationTime,omitempty"`
+	StartLoggingTime                  *aws.FloatTimestamp `json:"StartLoggingTime,omitempty"`
+	StopLoggingTime                   *aws.FloatTimestamp `json:"StopLoggingTime,omitempty"`
 }
 
 // StartLoggingRequest is undocumented.
@@ -167,6 +405,33 @@ type StartLoggingRequest struct {
 	Name aws.StringValue `json:"Name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartLoggingRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartLoggingRequest to b.
+func (v *StartLoggingRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StartLoggingResponse is undocumented.
 type StartLoggingResponse struct {
 }
@@ -176,6 +441,33 @@ type StopLoggingRequest struct {
 	Name aws.StringValue `json:"Name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StopLoggingRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StopLoggingRequest to b.
+func (v *StopLoggingRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StopLoggingResponse is undocumented.
 type StopLoggingResponse struct {
 }
@@ -202,6 +494,63 @@ type UpdateTrailRequest struct {
 	SNSTopicName               aws.StringValue  `json:"SnsTopicName,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateTrailRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateTrailRequest to b.
+func (v *UpdateTrailRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CloudWatchLogsLogGroupARN != nil {
+		b = append(b, "\"CloudWatchLogsLogGroupArn\":"...)
+		b = aws.AppendJSONString(b, *v.CloudWatchLogsLogGroupARN)
+		b = append(b, ',')
+	}
+	if v.CloudWatchLogsRoleARN != nil {
+		b = append(b, "\"CloudWatchLogsRoleArn\":"...)
+		b = aws.AppendJSONString(b, *v.CloudWatchLogsRoleARN)
+		b = append(b, ',')
+	}
+	if v.IncludeGlobalServiceEvents != nil {
+		b = append(b, "\"IncludeGlobalServiceEvents\":"...)
+		b = strconv.AppendBool(b, *v.IncludeGlobalServiceEvents)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if v.S3BucketName != nil {
+		b = append(b, "\"S3BucketName\":"...)
+		b = aws.AppendJSONString(b, *v.S3BucketName)
+		b = append(b, ',')
+	}
+	if v.S3KeyPrefix != nil {
+		b = append(b, "\"S3KeyPrefix\":"...)
+		b = aws.AppendJSONString(b, *v.S3KeyPrefix)
+		b = append(b, ',')
+	}
+	if v.SNSTopicName != nil {
+		b = append(b, "\"SnsTopicName\":"...)
+		b = aws.AppendJSONString(b, *v.SNSTopicName)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateTrailResponse is undocumented.
 type UpdateTrailResponse struct {
 	CloudWatchLogsLogGroupARN  aws.StringValue  `json:"CloudWatchLogsLogGroupArn,omitempty"`
@@ -213,5 +562,90 @@ type UpdateTrailResponse struct {
 	SNSTopicName               aws.StringValue  `json:"SnsTopicName,omitempty"`
 }
 
+// CloudWatchLogsDeliveryUnavailableException is the error returned for the CloudWatchLogsDeliveryUnavailableException error code.
+type CloudWatchLogsDeliveryUnavailableException struct {
+	aws.APIError
+}
+
+// InsufficientS3BucketPolicyException is the error returned for the InsufficientS3BucketPolicyException error code.
+type InsufficientS3BucketPolicyException struct {
+	aws.APIError
+}
+
+// InsufficientSNSTopicPolicyException is the error returned for the InsufficientSnsTopicPolicyException error code.
+type InsufficientSNSTopicPolicyException struct {
+	aws.APIError
+}
+
+// InvalidCloudWatchLogsLogGroupARNException is the error returned for the InvalidCloudWatchLogsLogGroupArnException error code.
+type InvalidCloudWatchLogsLogGroupARNException struct {
+	aws.APIError
+}
+
+// InvalidCloudWatchLogsRoleARNException is the error returned for the InvalidCloudWatchLogsRoleArnException error code.
+type InvalidCloudWatchLogsRoleARNException struct {
+	aws.APIError
+}
+
+// InvalidS3BucketNameException is the error returned for the InvalidS3BucketNameException error code.
+type InvalidS3BucketNameException struct {
+	aws.APIError
+}
+
+// InvalidS3PrefixException is the error returned for the InvalidS3PrefixException error code.
+type InvalidS3PrefixException struct {
+	aws.APIError
+}
+
+// InvalidSNSTopicNameException is the error returned for the InvalidSnsTopicNameException error code.
+type InvalidSNSTopicNameException struct {
+	aws.APIError
+}
+
+// InvalidTrailNameException is the error returned for the InvalidTrailNameException error code.
+type InvalidTrailNameException struct {
+	aws.APIError
+}
+
+// MaximumNumberOfTrailsExceededException is the error returned for the MaximumNumberOfTrailsExceededException error code.
+type MaximumNumberOfTrailsExceededException struct {
+	aws.APIError
+}
+
+// S3BucketDoesNotExistException is the error returned for the S3BucketDoesNotExistException error code.
+type S3BucketDoesNotExistException struct {
+	aws.APIError
+}
+
+// TrailAlreadyExistsException is the error returned for the TrailAlreadyExistsException error code.
+type TrailAlreadyExistsException struct {
+	aws.APIError
+}
+
+// TrailNotFoundException is the error returned for the TrailNotFoundException error code.
+type TrailNotFoundException struct {
+	aws.APIError
+}
+
+// exceptions maps CloudTrail's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"CloudWatchLogsDeliveryUnavailableException": CloudWatchLogsDeliveryUnavailableException{},
+	"InsufficientS3BucketPolicyException":        InsufficientS3BucketPolicyException{},
+	"InsufficientSnsTopicPolicyException":        InsufficientSNSTopicPolicyException{},
+	"InvalidCloudWatchLogsLogGroupArnException":  InvalidCloudWatchLogsLogGroupARNException{},
+	"InvalidCloudWatchLogsRoleArnException":      InvalidCloudWatchLogsRoleARNException{},
+	"InvalidS3BucketNameException":               InvalidS3BucketNameException{},
+	"InvalidS3PrefixException":                   InvalidS3PrefixException{},
+	"InvalidSnsTopicNameException":               InvalidSNSTopicNameException{},
+	"InvalidTrailNameException":                  InvalidTrailNameException{},
+	"MaximumNumberOfTrailsExceededException":     MaximumNumberOfTrailsExceededException{},
+	"S3BucketDoesNotExistException":              S3BucketDoesNotExistException{},
+	"TrailAlreadyExistsException":                TrailAlreadyExistsException{},
+	"TrailNotFoundException":                     TrailNotFoundException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -4,11 +4,21 @@
 package cloudwatch
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // CloudWatch is a client for Amazon CloudWatch.
@@ -16,34 +26,82 @@ type CloudWatch struct {
 	client *aws.QueryClient
 }
 
-// New returns a new CloudWatch client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CloudWatch {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "monitoring"
-	endpoint, service, region := endpoints.Lookup("monitoring", region)
+// New returns a new CloudWatch client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CloudWatch {
+	cfg := newConfig(region, client, opts)
 
 	return &CloudWatch{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2010-08-01",
 		},
 	}
 }
 
+// NewFromSession returns a new CloudWatch client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CloudWatch {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("monitoring", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("monitoring", "cloudwatch"),
+	}, "AWS_ENDPOINT_URL_CLOUDWATCH", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CloudWatch) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CloudWatch) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *CloudWatch) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // DeleteAlarms deletes all specified alarms. In the event of an error, no
 // alarms are deleted.
 func (c *CloudWatch) DeleteAlarms(req *DeleteAlarmsInput) (err error) {
+	return c.DeleteAlarmsWithContext(context.Background(), req)
+}
+
+// DeleteAlarmsWithContext is like DeleteAlarms, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) DeleteAlarmsWithContext(ctx context.Context, req *DeleteAlarmsInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteAlarms", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteAlarms", "POST", "/", req, nil)
 	return
 }
 
@@ -51,8 +109,15 @@ func (c *CloudWatch) DeleteAlarms(req *DeleteAlarmsInput) (err error) {
 // alarms by date range or item type. If an alarm name is not specified,
 // Amazon CloudWatch returns histories for all of the owner's alarms.
 func (c *CloudWatch) DescribeAlarmHistory(req *DescribeAlarmHistoryInput) (resp *DescribeAlarmHistoryResult, err error) {
+	return c.DescribeAlarmHistoryWithContext(context.Background(), req)
+}
+
+// DescribeAlarmHistoryWithContext is like DescribeAlarmHistory, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) DescribeAlarmHistoryWithContext(ctx context.Context, req *DescribeAlarmHistoryInput) (resp *DescribeAlarmHistoryResult, err error) {
 	resp = &DescribeAlarmHistoryResult{}
-	err = c.client.Do("DescribeAlarmHistory", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAlarmHistory", "POST", "/", req, resp)
 	return
 }
 
@@ -61,8 +126,15 @@ func (c *CloudWatch) DescribeAlarmHistory(req *DescribeAlarmHistoryInput) (resp
 // by using only a prefix for the alarm name, the alarm state, or a prefix
 // for any action.
 func (c *CloudWatch) DescribeAlarms(req *DescribeAlarmsInput) (resp *DescribeAlarmsResult, err error) {
+	return c.DescribeAlarmsWithContext(context.Background(), req)
+}
+
+// DescribeAlarmsWithContext is like DescribeAlarms, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) DescribeAlarmsWithContext(ctx context.Context, req *DescribeAlarmsInput) (resp *DescribeAlarmsResult, err error) {
 	resp = &DescribeAlarmsResult{}
-	err = c.client.Do("DescribeAlarms", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAlarms", "POST", "/", req, resp)
 	return
 }
 
@@ -70,8 +142,15 @@ func (c *CloudWatch) DescribeAlarms(req *DescribeAlarmsInput) (resp *DescribeAla
 // Specify a statistic, period, or unit to filter the set of alarms
 // further.
 func (c *CloudWatch) DescribeAlarmsForMetric(req *DescribeAlarmsForMetricInput) (resp *DescribeAlarmsForMetricResult, err error) {
+	return c.DescribeAlarmsForMetricWithContext(context.Background(), req)
+}
+
+// DescribeAlarmsForMetricWithContext is like DescribeAlarmsForMetric, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) DescribeAlarmsForMetricWithContext(ctx context.Context, req *DescribeAlarmsForMetricInput) (resp *DescribeAlarmsForMetricResult, err error) {
 	resp = &DescribeAlarmsForMetricResult{}
-	err = c.client.Do("DescribeAlarmsForMetric", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAlarmsForMetric", "POST", "/", req, resp)
 	return
 }
 
@@ -79,15 +158,29 @@ func (c *CloudWatch) DescribeAlarmsForMetric(req *DescribeAlarmsForMetricInput)
 // alarm's actions are disabled the alarm's state may change, but none of
 // the alarm's actions will execute.
 func (c *CloudWatch) DisableAlarmActions(req *DisableAlarmActionsInput) (err error) {
+	return c.DisableAlarmActionsWithContext(context.Background(), req)
+}
+
+// DisableAlarmActionsWithContext is like DisableAlarmActions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) DisableAlarmActionsWithContext(ctx context.Context, req *DisableAlarmActionsInput) (err error) {
 	// NRE
-	err = c.client.Do("DisableAlarmActions", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisableAlarmActions", "POST", "/", req, nil)
 	return
 }
 
 // EnableAlarmActions is undocumented.
 func (c *CloudWatch) EnableAlarmActions(req *EnableAlarmActionsInput) (err error) {
+	return c.EnableAlarmActionsWithContext(context.Background(), req)
+}
+
+// EnableAlarmActionsWithContext is like EnableAlarmActions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) EnableAlarmActionsWithContext(ctx context.Context, req *EnableAlarmActionsInput) (err error) {
 	// NRE
-	err = c.client.Do("EnableAlarmActions", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "EnableAlarmActions", "POST", "/", req, nil)
 	return
 }
 
@@ -114,8 +207,15 @@ func (c *CloudWatch) EnableAlarmActions(req *EnableAlarmActionsInput) (err error
 // Amazon CloudWatch Metrics, Namespaces, and Dimensions Reference in the
 // Amazon CloudWatch Developer Guide .
 func (c *CloudWatch) GetMetricStatistics(req *GetMetricStatisticsInput) (resp *GetMetricStatisticsResult, err error) {
+	return c.GetMetricStatisticsWithContext(context.Background(), req)
+}
+
+// GetMetricStatisticsWithContext is like GetMetricStatistics, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) GetMetricStatisticsWithContext(ctx context.Context, req *GetMetricStatisticsInput) (resp *GetMetricStatisticsResult, err error) {
 	resp = &GetMetricStatisticsResult{}
-	err = c.client.Do("GetMetricStatistics", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetMetricStatistics", "POST", "/", req, resp)
 	return
 }
 
@@ -123,8 +223,15 @@ func (c *CloudWatch) GetMetricStatistics(req *GetMetricStatisticsInput) (resp *G
 // owner. Returned metrics can be used with GetMetricStatistics to obtain
 // statistical data for a given metric.
 func (c *CloudWatch) ListMetrics(req *ListMetricsInput) (resp *ListMetricsResult, err error) {
+	return c.ListMetricsWithContext(context.Background(), req)
+}
+
+// ListMetricsWithContext is like ListMetrics, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) ListMetricsWithContext(ctx context.Context, req *ListMetricsInput) (resp *ListMetricsResult, err error) {
 	resp = &ListMetricsResult{}
-	err = c.client.Do("ListMetrics", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListMetrics", "POST", "/", req, resp)
 	return
 }
 
@@ -136,8 +243,15 @@ func (c *CloudWatch) ListMetrics(req *ListMetricsInput) (resp *ListMetricsResult
 // appropriately. Any actions associated with the StateValue is then
 // executed.
 func (c *CloudWatch) PutMetricAlarm(req *PutMetricAlarmInput) (err error) {
+	return c.PutMetricAlarmWithContext(context.Background(), req)
+}
+
+// PutMetricAlarmWithContext is like PutMetricAlarm, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) PutMetricAlarmWithContext(ctx context.Context, req *PutMetricAlarmInput) (err error) {
 	// NRE
-	err = c.client.Do("PutMetricAlarm", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutMetricAlarm", "POST", "/", req, nil)
 	return
 }
 
@@ -154,8 +268,15 @@ func (c *CloudWatch) PutMetricAlarm(req *PutMetricAlarmInput) (err error) {
 // in the past may take in excess of 48 hours to become available from
 // submission time using GetMetricStatistics
 func (c *CloudWatch) PutMetricData(req *PutMetricDataInput) (err error) {
+	return c.PutMetricDataWithContext(context.Background(), req)
+}
+
+// PutMetricDataWithContext is like PutMetricData, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) PutMetricDataWithContext(ctx context.Context, req *PutMetricDataInput) (err error) {
 	// NRE
-	err = c.client.Do("PutMetricData", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutMetricData", "POST", "/", req, nil)
 	return
 }
 
@@ -165,8 +286,15 @@ func (c *CloudWatch) PutMetricData(req *PutMetricDataInput) (err error) {
 // next periodic alarm check (in about a minute) will set the alarm to its
 // actual state.
 func (c *CloudWatch) SetAlarmState(req *SetAlarmStateInput) (err error) {
+	return c.SetAlarmStateWithContext(context.Background(), req)
+}
+
+// SetAlarmStateWithContext is like SetAlarmState, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CloudWatch) SetAlarmStateWithContext(ctx context.Context, req *SetAlarmStateInput) (err error) {
 	// NRE
-	err = c.client.Do("SetAlarmState", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetAlarmState", "POST", "/", req, nil)
 	return
 }
 
@@ -200,7 +328,24 @@ type Datapoint struct {
 
 // DeleteAlarmsInput is undocumented.
 type DeleteAlarmsInput struct {
-	AlarmNames []string `query:"AlarmNames.member" xml:"AlarmNames>member"`
+	AlarmNames []string `query:"AlarmNames,list:member" xml:"AlarmNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DeleteAlarmsInput to the form, prefixing their names.
+func (v *DeleteAlarmsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AlarmNames != nil {
+		if len(v.AlarmNames) == 0 {
+			form.Set(prefix+"AlarmNames", "")
+		}
+		for i1 := range v.AlarmNames {
+			name1 := prefix + "AlarmNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AlarmNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeAlarmHistoryInput is undocumented.
@@ -213,15 +358,41 @@ type DescribeAlarmHistoryInput struct {
 	StartDate       time.Time        `query:"StartDate" xml:"StartDate"`
 }
 
+// MarshalQuery adds the parameters of the DescribeAlarmHistoryInput to the form, prefixing their names.
+func (v *DescribeAlarmHistoryInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AlarmName != nil {
+		form.Set(prefix+"AlarmName", *v.AlarmName)
+	}
+	if !v.EndDate.IsZero() {
+		form.Set(prefix+"EndDate", v.EndDate.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.HistoryItemType != nil {
+		form.Set(prefix+"HistoryItemType", *v.HistoryItemType)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if !v.StartDate.IsZero() {
+		form.Set(prefix+"StartDate", v.StartDate.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // DescribeAlarmHistoryOutput is undocumented.
 type DescribeAlarmHistoryOutput struct {
-	AlarmHistoryItems []AlarmHistoryItem `query:"AlarmHistoryItems.member" xml:"DescribeAlarmHistoryResult>AlarmHistoryItems>member"`
+	AlarmHistoryItems []AlarmHistoryItem `query:"AlarmHistoryItems,list:member" xml:"DescribeAlarmHistoryResult>AlarmHistoryItems>member"`
 	NextToken         aws.StringValue    `query:"NextToken" xml:"DescribeAlarmHistoryResult>NextToken"`
 }
 
 // DescribeAlarmsForMetricInput is undocumented.
 type DescribeAlarmsForMetricInput struct {
-	Dimensions []Dimension      `query:"Dimensions.member" xml:"Dimensions>member"`
+	Dimensions []Dimension      `query:"Dimensions,list:member" xml:"Dimensions>member"`
 	MetricName aws.StringValue  `query:"MetricName" xml:"MetricName"`
 	Namespace  aws.StringValue  `query:"Namespace" xml:"Namespace"`
 	Period     aws.IntegerValue `query:"Period" xml:"Period"`
@@ -229,24 +400,90 @@ type DescribeAlarmsForMetricInput struct {
 	Unit       aws.StringValue  `query:"Unit" xml:"Unit"`
 }
 
+// MarshalQuery adds the parameters of the DescribeAlarmsForMetricInput to the form, prefixing their names.
+func (v *DescribeAlarmsForMetricInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Dimensions != nil {
+		if len(v.Dimensions) == 0 {
+			form.Set(prefix+"Dimensions", "")
+		}
+		for i1 := range v.Dimensions {
+			name1 := prefix + "Dimensions.member." + strconv.Itoa(i1+1)
+			if err := v.Dimensions[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MetricName != nil {
+		form.Set(prefix+"MetricName", *v.MetricName)
+	}
+	if v.Namespace != nil {
+		form.Set(prefix+"Namespace", *v.Namespace)
+	}
+	if v.Period != nil {
+		form.Set(prefix+"Period", strconv.FormatInt(int64(*v.Period), 10))
+	}
+	if v.Statistic != nil {
+		form.Set(prefix+"Statistic", *v.Statistic)
+	}
+	if v.Unit != nil {
+		form.Set(prefix+"Unit", *v.Unit)
+	}
+	return nil
+}
+
 // DescribeAlarmsForMetricOutput is undocumented.
 type DescribeAlarmsForMetricOutput struct {
-	MetricAlarms []MetricAlarm `query:"MetricAlarms.member" xml:"DescribeAlarmsForMetricResult>MetricAlarms>member"`
+	MetricAlarms []MetricAlarm `query:"MetricAlarms,list:member" xml:"DescribeAlarmsForMetricResult>MetricAlarms>member"`
 }
 
 // DescribeAlarmsInput is undocumented.
 type DescribeAlarmsInput struct {
 	ActionPrefix    aws.StringValue  `query:"ActionPrefix" xml:"ActionPrefix"`
 	AlarmNamePrefix aws.StringValue  `query:"AlarmNamePrefix" xml:"AlarmNamePrefix"`
-	AlarmNames      []string         `query:"AlarmNames.member" xml:"AlarmNames>member"`
+	AlarmNames      []string         `query:"AlarmNames,list:member" xml:"AlarmNames>member"`
 	MaxRecords      aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	NextToken       aws.StringValue  `query:"NextToken" xml:"NextToken"`
 	StateValue      aws.StringValue  `query:"StateValue" xml:"StateValue"`
 }
 
+// MarshalQuery adds the parameters of the DescribeAlarmsInput to the form, prefixing their names.
+func (v *DescribeAlarmsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ActionPrefix != nil {
+		form.Set(prefix+"ActionPrefix", *v.ActionPrefix)
+	}
+	if v.AlarmNamePrefix != nil {
+		form.Set(prefix+"AlarmNamePrefix", *v.AlarmNamePrefix)
+	}
+	if v.AlarmNames != nil {
+		if len(v.AlarmNames) == 0 {
+			form.Set(prefix+"AlarmNames", "")
+		}
+		for i1 := range v.AlarmNames {
+			name1 := prefix + "AlarmNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AlarmNames[i1])
+		}
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.StateValue != nil {
+		form.Set(prefix+"StateValue", *v.StateValue)
+	}
+	return nil
+}
+
 // DescribeAlarmsOutput is undocumented.
 type DescribeAlarmsOutput struct {
-	MetricAlarms []MetricAlarm   `query:"MetricAlarms.member" xml:"DescribeAlarmsResult>MetricAlarms>member"`
+	MetricAlarms []MetricAlarm   `query:"MetricAlarms,list:member" xml:"DescribeAlarmsResult>MetricAlarms>member"`
 	NextToken    aws.StringValue `query:"NextToken" xml:"DescribeAlarmsResult>NextToken"`
 }
 
@@ -256,37 +493,145 @@ type Dimension struct {
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Dimension to the form, prefixing their names.
+func (v *Dimension) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // DimensionFilter is undocumented.
 type DimensionFilter struct {
 	Name  aws.StringValue `query:"Name" xml:"Name"`
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the DimensionFilter to the form, prefixing their names.
+func (v *DimensionFilter) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // DisableAlarmActionsInput is undocumented.
 type DisableAlarmActionsInput struct {
-	AlarmNames []string `query:"AlarmNames.member" xml:"AlarmNames>member"`
+	AlarmNames []string `query:"AlarmNames,list:member" xml:"AlarmNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DisableAlarmActionsInput to the form, prefixing their names.
+func (v *DisableAlarmActionsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AlarmNames != nil {
+		if len(v.AlarmNames) == 0 {
+			form.Set(prefix+"AlarmNames", "")
+		}
+		for i1 := range v.AlarmNames {
+			name1 := prefix + "AlarmNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AlarmNames[i1])
+		}
+	}
+	return nil
 }
 
 // EnableAlarmActionsInput is undocumented.
 type EnableAlarmActionsInput struct {
-	AlarmNames []string `query:"AlarmNames.member" xml:"AlarmNames>member"`
+	AlarmNames []string `query:"AlarmNames,list:member" xml:"AlarmNames>member"`
+}
+
+// MarshalQuery adds the parameters of the EnableAlarmActionsInput to the form, prefixing their names.
+func (v *EnableAlarmActionsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AlarmNames != nil {
+		if len(v.AlarmNames) == 0 {
+			form.Set(prefix+"AlarmNames", "")
+		}
+		for i1 := range v.AlarmNames {
+			name1 := prefix + "AlarmNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AlarmNames[i1])
+		}
+	}
+	return nil
 }
 
 // GetMetricStatisticsInput is undocumented.
 type GetMetricStatisticsInput struct {
-	Dimensions []Dimension      `query:"Dimensions.member" xml:"Dimensions>member"`
+	Dimensions []Dimension      `query:"Dimensions,list:member" xml:"Dimensions>member"`
 	EndTime    time.Time        `query:"EndTime" xml:"EndTime"`
 	MetricName aws.StringValue  `query:"MetricName" xml:"MetricName"`
 	Namespace  aws.StringValue  `query:"Namespace" xml:"Namespace"`
 	Period     aws.IntegerValue `query:"Period" xml:"Period"`
 	StartTime  time.Time        `query:"StartTime" xml:"StartTime"`
-	Statistics []string         `query:"Statistics.member" xml:"Statistics>member"`
+	Statistics []string         `query:"Statistics,list:member" xml:"Statistics>member"`
 	Unit       aws.StringValue  `query:"Unit" xml:"Unit"`
 }
 
+// MarshalQuery adds the parameters of the GetMetricStatisticsInput to the form, prefixing their names.
+func (v *GetMetricStatisticsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Dimensions != nil {
+		if len(v.Dimensions) == 0 {
+			form.Set(prefix+"Dimensions", "")
+		}
+		for i1 := range v.Dimensions {
+			name1 := prefix + "Dimensions.member." + strconv.Itoa(i1+1)
+			if err := v.Dimensions[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.MetricName != nil {
+		form.Set(prefix+"MetricName", *v.MetricName)
+	}
+	if v.Namespace != nil {
+		form.Set(prefix+"Namespace", *v.Namespace)
+	}
+	if v.Period != nil {
+		form.Set(prefix+"Period", strconv.FormatInt(int64(*v.Period), 10))
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Statistics != nil {
+		if len(v.Statistics) == 0 {
+			form.Set(prefix+"Statistics", "")
+		}
+		for i1 := range v.Statistics {
+			name1 := prefix + "Statistics.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Statistics[i1])
+		}
+	}
+	if v.Unit != nil {
+		form.Set(prefix+"Unit", *v.Unit)
+	}
+	return nil
+}
+
 // GetMetricStatisticsOutput is undocumented.
 type GetMetricStatisticsOutput struct {
-	Datapoints []Datapoint     `query:"Datapoints.member" xml:"GetMetricStatisticsResult>Datapoints>member"`
+	Datapoints []Datapoint     `query:"Datapoints,list:member" xml:"GetMetricStatisticsResult>Datapoints>member"`
 	Label      aws.StringValue `query:"Label" xml:"GetMetricStatisticsResult>Label"`
 }
 
@@ -299,21 +644,49 @@ const (
 
 // ListMetricsInput is undocumented.
 type ListMetricsInput struct {
-	Dimensions []DimensionFilter `query:"Dimensions.member" xml:"Dimensions>member"`
+	Dimensions []DimensionFilter `query:"Dimensions,list:member" xml:"Dimensions>member"`
 	MetricName aws.StringValue   `query:"MetricName" xml:"MetricName"`
 	Namespace  aws.StringValue   `query:"Namespace" xml:"Namespace"`
 	NextToken  aws.StringValue   `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the ListMetricsInput to the form, prefixing their names.
+func (v *ListMetricsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Dimensions != nil {
+		if len(v.Dimensions) == 0 {
+			form.Set(prefix+"Dimensions", "")
+		}
+		for i1 := range v.Dimensions {
+			name1 := prefix + "Dimensions.member." + strconv.Itoa(i1+1)
+			if err := v.Dimensions[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MetricName != nil {
+		form.Set(prefix+"MetricName", *v.MetricName)
+	}
+	if v.Namespace != nil {
+		form.Set(prefix+"Namespace", *v.Namespace)
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // ListMetricsOutput is undocumented.
 type ListMetricsOutput struct {
-	Metrics   []Metric        `query:"Metrics.member" xml:"ListMetricsResult>Metrics>member"`
+	Metrics   []Metric        `query:"Metrics,list:member" xml:"ListMetricsResult>Metrics>member"`
 	NextToken aws.StringValue `query:"NextToken" xml:"ListMetricsResult>NextToken"`
 }
 
 // Metric is undocumented.
 type Metric struct {
-	Dimensions []Dimension     `query:"Dimensions.member" xml:"Dimensions>member"`
+	Dimensions []Dimension     `query:"Dimensions,list:member" xml:"Dimensions>member"`
 	MetricName aws.StringValue `query:"MetricName" xml:"MetricName"`
 	Namespace  aws.StringValue `query:"Namespace" xml:"Namespace"`
 }
@@ -321,18 +694,18 @@ type Metric struct {
 // MetricAlarm is undocumented.
 type MetricAlarm struct {
 	ActionsEnabled                     aws.BooleanValue `query:"ActionsEnabled" xml:"ActionsEnabled"`
-	AlarmActions                       []string         `query:"AlarmActions.member" xml:"AlarmActions>member"`
+	AlarmActions                       []string         `query:"AlarmActions,list:member" xml:"AlarmActions>member"`
 	AlarmARN                           aws.StringValue  `query:"AlarmArn" xml:"AlarmArn"`
 	AlarmConfigurationUpdatedTimestamp time.Time        `query:"AlarmConfigurationUpdatedTimestamp" xml:"AlarmConfigurationUpdatedTimestamp"`
 	AlarmDescription                   aws.StringValue  `query:"AlarmDescription" xml:"AlarmDescription"`
 	AlarmName                          aws.StringValue  `query:"AlarmName" xml:"AlarmName"`
 	ComparisonOperator                 aws.StringValue  `query:"ComparisonOperator" xml:"ComparisonOperator"`
-	Dimensions                         []Dimension      `query:"Dimensions.member" xml:"Dimensions>member"`
+	Dimensions                         []Dimension      `query:"Dimensions,list:member" xml:"Dimensions>member"`
 	EvaluationPeriods                  aws.IntegerValue `query:"EvaluationPeriods" xml:"EvaluationPeriods"`
-	InsufficientDataActions            []string         `query:"InsufficientDataActions.member" xml:"InsufficientDataActions>member"`
+	InsufficientDataActions            []string         `query:"InsufficientDataActions,list:member" xml:"InsufficientDataActions>member"`
 	MetricName                         aws.StringValue  `query:"MetricName" xml:"MetricName"`
 	Namespace                          aws.StringValue  `query:"Namespace" xml:"Namespace"`
-	OKActions                          []string         `query:"OKActions.member" xml:"OKActions>member"`
+	OKActions                          []string         `query:"OKActions,list:member" xml:"OKActions>member"`
 	Period                             aws.IntegerValue `query:"Period" xml:"Period"`
 	StateReason                        aws.StringValue  `query:"StateReason" xml:"StateReason"`
 	StateReasonData                    aws.StringValue  `query:"StateReasonData" xml:"StateReasonData"`
@@ -345,7 +718,7 @@ type MetricAlarm struct {
 
 // MetricDatum is undocumented.
 type MetricDatum struct {
-	Dimensions      []Dimension     `query:"Dimensions.member" xml:"Dimensions>member"`
+	Dimensions      []Dimension     `query:"Dimensions,list:member" xml:"Dimensions>member"`
 	MetricName      aws.StringValue `query:"MetricName" xml:"MetricName"`
 	StatisticValues *StatisticSet   `query:"StatisticValues" xml:"StatisticValues"`
 	Timestamp       time.Time       `query:"Timestamp" xml:"Timestamp"`
@@ -353,31 +726,168 @@ type MetricDatum struct {
 	Value           aws.DoubleValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the MetricDatum to the form, prefixing their names.
+func (v *MetricDatum) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Dimensions != nil {
+		if len(v.Dimensions) == 0 {
+			form.Set(prefix+"Dimensions", "")
+		}
+		for i1 := range v.Dimensions {
+			name1 := prefix + "Dimensions.member." + strconv.Itoa(i1+1)
+			if err := v.Dimensions[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MetricName != nil {
+		form.Set(prefix+"MetricName", *v.MetricName)
+	}
+	if v.StatisticValues != nil {
+		if err := v.StatisticValues.MarshalQuery(form, prefix+"StatisticValues."); err != nil {
+			return err
+		}
+	}
+	if !v.Timestamp.IsZero() {
+		form.Set(prefix+"Timestamp", v.Timestamp.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Unit != nil {
+		form.Set(prefix+"Unit", *v.Unit)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", strconv.FormatFloat(float64(*v.Value), 'f', -1, 64))
+	}
+	return nil
+}
+
 // PutMetricAlarmInput is undocumented.
 type PutMetricAlarmInput struct {
 	ActionsEnabled          aws.BooleanValue `query:"ActionsEnabled" xml:"ActionsEnabled"`
-	AlarmActions            []string         `query:"AlarmActions.member" xml:"AlarmActions>member"`
+	AlarmActions            []string         `query:"AlarmActions,list:member" xml:"AlarmActions>member"`
 	AlarmDescription        aws.StringValue  `query:"AlarmDescription" xml:"AlarmDescription"`
 	AlarmName               aws.StringValue  `query:"AlarmName" xml:"AlarmName"`
 	ComparisonOperator      aws.StringValue  `query:"ComparisonOperator" xml:"ComparisonOperator"`
-	Dimensions              []Dimension      `query:"Dimensions.member" xml:"Dimensions>member"`
+	Dimensions              []Dimension      `query:"Dimensions,list:member" xml:"Dimensions>member"`
 	EvaluationPeriods       aws.IntegerValue `query:"EvaluationPeriods" xml:"EvaluationPeriods"`
-	InsufficientDataActions []string         `query:"InsufficientDataActions.member" xml:"InsufficientDataActions>member"`
+	InsufficientDataActions []string         `query:"InsufficientDataActions,list:member" xml:"InsufficientDataActions>member"`
 	MetricName              aws.StringValue  `query:"MetricName" xml:"MetricName"`
 	Namespace               aws.StringValue  `query:"Namespace" xml:"Namespace"`
-	OKActions               []string         `query:"OKActions.member" xml:"OKActions>member"`
+	OKActions               []string         `query:"OKActions,list:member" xml:"OKActions>member"`
 	Period                  aws.IntegerValue `query:"Period" xml:"Period"`
 	Statistic               aws.StringValue  `query:"Statistic" xml:"Statistic"`
 	Threshold               aws.DoubleValue  `query:"Threshold" xml:"Threshold"`
 	Unit                    aws.StringValue  `query:"Unit" xml:"Unit"`
 }
 
+// MarshalQuery adds the parameters of the PutMetricAlarmInput to the form, prefixing their names.
+func (v *PutMetricAlarmInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ActionsEnabled != nil {
+		form.Set(prefix+"ActionsEnabled", strconv.FormatBool(*v.ActionsEnabled))
+	}
+	if v.AlarmActions != nil {
+		if len(v.AlarmActions) == 0 {
+			form.Set(prefix+"AlarmActions", "")
+		}
+		for i1 := range v.AlarmActions {
+			name1 := prefix + "AlarmActions.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AlarmActions[i1])
+		}
+	}
+	if v.AlarmDescription != nil {
+		form.Set(prefix+"AlarmDescription", *v.AlarmDescription)
+	}
+	if v.AlarmName != nil {
+		form.Set(prefix+"AlarmName", *v.AlarmName)
+	}
+	if v.ComparisonOperator != nil {
+		form.Set(prefix+"ComparisonOperator", *v.ComparisonOperator)
+	}
+	if v.Dimensions != nil {
+		if len(v.Dimensions) == 0 {
+			form.Set(prefix+"Dimensions", "")
+		}
+		for i1 := range v.Dimensions {
+			name1 := prefix + "Dimensions.member." + strconv.Itoa(i1+1)
+			if err := v.Dimensions[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.EvaluationPeriods != nil {
+		form.Set(prefix+"EvaluationPeriods", strconv.FormatInt(int64(*v.EvaluationPeriods), 10))
+	}
+	if v.InsufficientDataActions != nil {
+		if len(v.InsufficientDataActions) == 0 {
+			form.Set(prefix+"InsufficientDataActions", "")
+		}
+		for i1 := range v.InsufficientDataActions {
+			name1 := prefix + "InsufficientDataActions.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InsufficientDataActions[i1])
+		}
+	}
+	if v.MetricName != nil {
+		form.Set(prefix+"MetricName", *v.MetricName)
+	}
+	if v.Namespace != nil {
+		form.Set(prefix+"Namespace", *v.Namespace)
+	}
+	if v.OKActions != nil {
+		if len(v.OKActions) == 0 {
+			form.Set(prefix+"OKActions", "")
+		}
+		for i1 := range v.OKActions {
+			name1 := prefix + "OKActions.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.OKActions[i1])
+		}
+	}
+	if v.Period != nil {
+		form.Set(prefix+"Period", strconv.FormatInt(int64(*v.Period), 10))
+	}
+	if v.Statistic != nil {
+		form.Set(prefix+"Statistic", *v.Statistic)
+	}
+	if v.Threshold != nil {
+		form.Set(prefix+"Threshold", strconv.FormatFloat(float64(*v.Threshold), 'f', -1, 64))
+	}
+	if v.Unit != nil {
+		form.Set(prefix+"Unit", *v.Unit)
+	}
+	return nil
+}
+
 // PutMetricDataInput is undocumented.
 type PutMetricDataInput struct {
-	MetricData []MetricDatum   `query:"MetricData.member" xml:"MetricData>member"`
+	MetricData []MetricDatum   `query:"MetricData,list:member" xml:"MetricData>member"`
 	Namespace  aws.StringValue `query:"Namespace" xml:"Namespace"`
 }
 
+// MarshalQuery adds the parameters of the PutMetricDataInput to the form, prefixing their names.
+func (v *PutMetricDataInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.MetricData != nil {
+		if len(v.MetricData) == 0 {
+			form.Set(prefix+"MetricData", "")
+		}
+		for i1 := range v.MetricData {
+			name1 := prefix + "MetricData.member." + strconv.Itoa(i1+1)
+			if err := v.MetricData[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Namespace != nil {
+		form.Set(prefix+"Namespace", *v.Namespace)
+	}
+	return nil
+}
+
 // SetAlarmStateInput is undocumented.
 type SetAlarmStateInput struct {
 	AlarmName       aws.StringValue `query:"AlarmName" xml:"AlarmName"`
@@ -386,6 +896,26 @@ type SetAlarmStateInput struct {
 	StateValue      aws.StringValue `query:"StateValue" xml:"StateValue"`
 }
 
+// MarshalQuery adds the parameters of the SetAlarmStateInput to the form, prefixing their names.
+func (v *SetAlarmStateInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AlarmName != nil {
+		form.Set(prefix+"AlarmName", *v.AlarmName)
+	}
+	if v.StateReason != nil {
+		form.Set(prefix+"StateReason", *v.StateReason)
+	}
+	if v.StateReasonData != nil {
+		form.Set(prefix+"StateReasonData", *v.StateReasonData)
+	}
+	if v.StateValue != nil {
+		form.Set(prefix+"StateValue", *v.StateValue)
+	}
+	return nil
+}
+
 // Possible values for CloudWatch.
 const (
 	StandardUnitBits            = "Bits"
@@ -441,34 +971,112 @@ type StatisticSet struct {
 	Sum         aws.DoubleValue `query:"Sum" xml:"Sum"`
 }
 
+// MarshalQuery adds the parameters of the StatisticSet to the form, prefixing their names.
+func (v *StatisticSet) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Maximum != nil {
+		form.Set(prefix+"Maximum", strconv.FormatFloat(float64(*v.Maximum), 'f', -1, 64))
+	}
+	if v.Minimum != nil {
+		form.Set(prefix+"Minimum", strconv.FormatFloat(float64(*v.Minimum), 'f', -1, 64))
+	}
+	if v.SampleCount != nil {
+		form.Set(prefix+"SampleCount", strconv.FormatFloat(float64(*v.SampleCount), 'f', -1, 64))
+	}
+	if v.Sum != nil {
+		form.Set(prefix+"Sum", strconv.FormatFloat(float64(*v.Sum), 'f', -1, 64))
+	}
+	return nil
+}
+
 // DescribeAlarmHistoryResult is a wrapper for DescribeAlarmHistoryOutput.
 type DescribeAlarmHistoryResult struct {
-	AlarmHistoryItems []AlarmHistoryItem `query:"AlarmHistoryItems.member" xml:"DescribeAlarmHistoryResult>AlarmHistoryItems>member"`
+	AlarmHistoryItems []AlarmHistoryItem `query:"AlarmHistoryItems,list:member" xml:"DescribeAlarmHistoryResult>AlarmHistoryItems>member"`
 	NextToken         aws.StringValue    `query:"NextToken" xml:"DescribeAlarmHistoryResult>NextToken"`
 }
 
 // DescribeAlarmsForMetricResult is a wrapper for DescribeAlarmsForMetricOutput.
 type DescribeAlarmsForMetricResult struct {
-	MetricAlarms []MetricAlarm `query:"MetricAlarms.member" xml:"DescribeAlarmsForMetricResult>MetricAlarms>member"`
+	MetricAlarms []MetricAlarm `query:"MetricAlarms,list:member" xml:"DescribeAlarmsForMetricResult>MetricAlarms>member"`
 }
 
 // DescribeAlarmsResult is a wrapper for DescribeAlarmsOutput.
 type DescribeAlarmsResult struct {
-	MetricAlarms []MetricAlarm   `query:"MetricAlarms.member" xml:"DescribeAlarmsResult>MetricAlarms>member"`
+	MetricAlarms []MetricAlarm   `query:"MetricAlarms,list:member" xml:"DescribeAlarmsResult>MetricAlarms>member"`
 	NextToken    aws.StringValue `query:"NextToken" xml:"DescribeAlarmsResult>NextToken"`
 }
 
 // GetMetricStatisticsResult is a wrapper for GetMetricStatisticsOutput.
 type GetMetricStatisticsResult struct {
-	Datapoints []Datapoint     `query:"Datapoints.member" xml:"GetMetricStatisticsResult>Datapoints>member"`
+	Datapoints []Datapoint     `query:"Datapoints,list:member" xml:"GetMetricStatisticsResult>Datapoints>member"`
 	Label      aws.StringValue `query:"Label" xml:"GetMetricStatisticsResult>Label"`
 }
 
 // ListMetricsResult is a wrapper for ListMetricsOutput.
 type ListMetricsResult struct {
-	Metrics   []Metric        `query:"Metrics.member" xml:"ListMetricsResult>Metrics>member"`
+	Metrics   []Metric        `query:"Metrics,list:member" xml:"ListMetricsResult>Metrics>member"`
 	NextToken aws.StringValue `query:"NextToken" xml:"ListMetricsResult>NextToken"`
 }
 
+// InternalServiceFault is the error returned for the InternalServiceError error code.
+type InternalServiceFault struct {
+	aws.APIError
+}
+
+// InvalidFormatFault is the error returned for the InvalidFormat error code.
+type InvalidFormatFault struct {
+	aws.APIError
+}
+
+// InvalidNextToken is the error returned for the InvalidNextToken error code.
+type InvalidNextToken struct {
+	aws.APIError
+}
+
+// InvalidParameterCombinationException is the error returned for the InvalidParameterCombination error code.
+type InvalidParameterCombinationException struct {
+	aws.APIError
+}
+
+// InvalidParameterValueException is the error returned for the InvalidParameterValue error code.
+type InvalidParameterValueException struct {
+	aws.APIError
+}
+
+// LimitExceededFault is the error returned for the LimitExceeded error code.
+type LimitExceededFault struct {
+	aws.APIError
+}
+
+// MissingRequiredParameterException is the error returned for the MissingParameter error code.
+type MissingRequiredParameterException struct {
+	aws.APIError
+}
+
+// ResourceNotFound is the error returned for the ResourceNotFound error code.
+type ResourceNotFound struct {
+	aws.APIError
+}
+
+// exceptions maps CloudWatch's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InternalServiceError":        InternalServiceFault{},
+	"InvalidFormat":               InvalidFormatFault{},
+	"InvalidNextToken":            InvalidNextToken{},
+	"InvalidParameterCombination": InvalidParameterCombinationException{},
+	"InvalidParameterValue":       InvalidParameterValueException{},
+	"LimitExceeded":               LimitExceededFault{},
+	"MissingParameter":            MissingRequiredParameterException{},
+	"ResourceNotFound":            ResourceNotFound{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
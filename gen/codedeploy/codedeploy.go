@@ -4,11 +4,18 @@
 package codedeploy
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // CodeDeploy is a client for AWS CodeDeploy.
@@ -16,77 +23,161 @@ type CodeDeploy struct {
 	client *aws.JSONClient
 }
 
-// New returns a new CodeDeploy client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CodeDeploy {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "codedeploy"
-	endpoint, service, region := endpoints.Lookup("codedeploy", region)
+// New returns a new CodeDeploy client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CodeDeploy {
+	cfg := newConfig(region, client, opts)
 
 	return &CodeDeploy{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "CodeDeploy_20141006",
 		},
 	}
 }
 
+// NewFromSession returns a new CodeDeploy client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CodeDeploy {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("codedeploy", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("codedeploy", "codedeploy"),
+	}, "AWS_ENDPOINT_URL_CODEDEPLOY", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CodeDeploy) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CodeDeploy) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // BatchGetApplications is undocumented.
 func (c *CodeDeploy) BatchGetApplications(req *BatchGetApplicationsInput) (resp *BatchGetApplicationsOutput, err error) {
+	return c.BatchGetApplicationsWithContext(context.Background(), req)
+}
+
+// BatchGetApplicationsWithContext is like BatchGetApplications, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) BatchGetApplicationsWithContext(ctx context.Context, req *BatchGetApplicationsInput) (resp *BatchGetApplicationsOutput, err error) {
 	resp = &BatchGetApplicationsOutput{}
-	err = c.client.Do("BatchGetApplications", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "BatchGetApplications", "POST", "/", req, resp)
 	return
 }
 
 // BatchGetDeployments is undocumented.
 func (c *CodeDeploy) BatchGetDeployments(req *BatchGetDeploymentsInput) (resp *BatchGetDeploymentsOutput, err error) {
+	return c.BatchGetDeploymentsWithContext(context.Background(), req)
+}
+
+// BatchGetDeploymentsWithContext is like BatchGetDeployments, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) BatchGetDeploymentsWithContext(ctx context.Context, req *BatchGetDeploymentsInput) (resp *BatchGetDeploymentsOutput, err error) {
 	resp = &BatchGetDeploymentsOutput{}
-	err = c.client.Do("BatchGetDeployments", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "BatchGetDeployments", "POST", "/", req, resp)
 	return
 }
 
 // CreateApplication is undocumented.
 func (c *CodeDeploy) CreateApplication(req *CreateApplicationInput) (resp *CreateApplicationOutput, err error) {
+	return c.CreateApplicationWithContext(context.Background(), req)
+}
+
+// CreateApplicationWithContext is like CreateApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) CreateApplicationWithContext(ctx context.Context, req *CreateApplicationInput) (resp *CreateApplicationOutput, err error) {
 	resp = &CreateApplicationOutput{}
-	err = c.client.Do("CreateApplication", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateApplication", "POST", "/", req, resp)
 	return
 }
 
 // CreateDeployment deploys an application revision to the specified
 // deployment group.
 func (c *CodeDeploy) CreateDeployment(req *CreateDeploymentInput) (resp *CreateDeploymentOutput, err error) {
+	return c.CreateDeploymentWithContext(context.Background(), req)
+}
+
+// CreateDeploymentWithContext is like CreateDeployment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) CreateDeploymentWithContext(ctx context.Context, req *CreateDeploymentInput) (resp *CreateDeploymentOutput, err error) {
 	resp = &CreateDeploymentOutput{}
-	err = c.client.Do("CreateDeployment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDeployment", "POST", "/", req, resp)
 	return
 }
 
 // CreateDeploymentConfig is undocumented.
 func (c *CodeDeploy) CreateDeploymentConfig(req *CreateDeploymentConfigInput) (resp *CreateDeploymentConfigOutput, err error) {
+	return c.CreateDeploymentConfigWithContext(context.Background(), req)
+}
+
+// CreateDeploymentConfigWithContext is like CreateDeploymentConfig, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) CreateDeploymentConfigWithContext(ctx context.Context, req *CreateDeploymentConfigInput) (resp *CreateDeploymentConfigOutput, err error) {
 	resp = &CreateDeploymentConfigOutput{}
-	err = c.client.Do("CreateDeploymentConfig", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDeploymentConfig", "POST", "/", req, resp)
 	return
 }
 
 // CreateDeploymentGroup creates a new deployment group for application
 // revisions to be deployed to.
 func (c *CodeDeploy) CreateDeploymentGroup(req *CreateDeploymentGroupInput) (resp *CreateDeploymentGroupOutput, err error) {
+	return c.CreateDeploymentGroupWithContext(context.Background(), req)
+}
+
+// CreateDeploymentGroupWithContext is like CreateDeploymentGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) CreateDeploymentGroupWithContext(ctx context.Context, req *CreateDeploymentGroupInput) (resp *CreateDeploymentGroupOutput, err error) {
 	resp = &CreateDeploymentGroupOutput{}
-	err = c.client.Do("CreateDeploymentGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDeploymentGroup", "POST", "/", req, resp)
 	return
 }
 
 // DeleteApplication is undocumented.
 func (c *CodeDeploy) DeleteApplication(req *DeleteApplicationInput) (err error) {
+	return c.DeleteApplicationWithContext(context.Background(), req)
+}
+
+// DeleteApplicationWithContext is like DeleteApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) DeleteApplicationWithContext(ctx context.Context, req *DeleteApplicationInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteApplication", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteApplication", "POST", "/", req, nil)
 	return
 }
 
@@ -94,145 +185,271 @@ func (c *CodeDeploy) DeleteApplication(req *DeleteApplicationInput) (err error)
 // configuration cannot be deleted if it is currently in use. Also,
 // predefined configurations cannot be deleted.
 func (c *CodeDeploy) DeleteDeploymentConfig(req *DeleteDeploymentConfigInput) (err error) {
+	return c.DeleteDeploymentConfigWithContext(context.Background(), req)
+}
+
+// DeleteDeploymentConfigWithContext is like DeleteDeploymentConfig, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) DeleteDeploymentConfigWithContext(ctx context.Context, req *DeleteDeploymentConfigInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteDeploymentConfig", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteDeploymentConfig", "POST", "/", req, nil)
 	return
 }
 
 // DeleteDeploymentGroup is undocumented.
 func (c *CodeDeploy) DeleteDeploymentGroup(req *DeleteDeploymentGroupInput) (resp *DeleteDeploymentGroupOutput, err error) {
+	return c.DeleteDeploymentGroupWithContext(context.Background(), req)
+}
+
+// DeleteDeploymentGroupWithContext is like DeleteDeploymentGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) DeleteDeploymentGroupWithContext(ctx context.Context, req *DeleteDeploymentGroupInput) (resp *DeleteDeploymentGroupOutput, err error) {
 	resp = &DeleteDeploymentGroupOutput{}
-	err = c.client.Do("DeleteDeploymentGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteDeploymentGroup", "POST", "/", req, resp)
 	return
 }
 
 // GetApplication is undocumented.
 func (c *CodeDeploy) GetApplication(req *GetApplicationInput) (resp *GetApplicationOutput, err error) {
+	return c.GetApplicationWithContext(context.Background(), req)
+}
+
+// GetApplicationWithContext is like GetApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) GetApplicationWithContext(ctx context.Context, req *GetApplicationInput) (resp *GetApplicationOutput, err error) {
 	resp = &GetApplicationOutput{}
-	err = c.client.Do("GetApplication", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetApplication", "POST", "/", req, resp)
 	return
 }
 
 // GetApplicationRevision is undocumented.
 func (c *CodeDeploy) GetApplicationRevision(req *GetApplicationRevisionInput) (resp *GetApplicationRevisionOutput, err error) {
+	return c.GetApplicationRevisionWithContext(context.Background(), req)
+}
+
+// GetApplicationRevisionWithContext is like GetApplicationRevision, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) GetApplicationRevisionWithContext(ctx context.Context, req *GetApplicationRevisionInput) (resp *GetApplicationRevisionOutput, err error) {
 	resp = &GetApplicationRevisionOutput{}
-	err = c.client.Do("GetApplicationRevision", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetApplicationRevision", "POST", "/", req, resp)
 	return
 }
 
 // GetDeployment is undocumented.
 func (c *CodeDeploy) GetDeployment(req *GetDeploymentInput) (resp *GetDeploymentOutput, err error) {
+	return c.GetDeploymentWithContext(context.Background(), req)
+}
+
+// GetDeploymentWithContext is like GetDeployment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) GetDeploymentWithContext(ctx context.Context, req *GetDeploymentInput) (resp *GetDeploymentOutput, err error) {
 	resp = &GetDeploymentOutput{}
-	err = c.client.Do("GetDeployment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetDeployment", "POST", "/", req, resp)
 	return
 }
 
 // GetDeploymentConfig is undocumented.
 func (c *CodeDeploy) GetDeploymentConfig(req *GetDeploymentConfigInput) (resp *GetDeploymentConfigOutput, err error) {
+	return c.GetDeploymentConfigWithContext(context.Background(), req)
+}
+
+// GetDeploymentConfigWithContext is like GetDeploymentConfig, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) GetDeploymentConfigWithContext(ctx context.Context, req *GetDeploymentConfigInput) (resp *GetDeploymentConfigOutput, err error) {
 	resp = &GetDeploymentConfigOutput{}
-	err = c.client.Do("GetDeploymentConfig", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetDeploymentConfig", "POST", "/", req, resp)
 	return
 }
 
 // GetDeploymentGroup is undocumented.
 func (c *CodeDeploy) GetDeploymentGroup(req *GetDeploymentGroupInput) (resp *GetDeploymentGroupOutput, err error) {
+	return c.GetDeploymentGroupWithContext(context.Background(), req)
+}
+
+// GetDeploymentGroupWithContext is like GetDeploymentGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) GetDeploymentGroupWithContext(ctx context.Context, req *GetDeploymentGroupInput) (resp *GetDeploymentGroupOutput, err error) {
 	resp = &GetDeploymentGroupOutput{}
-	err = c.client.Do("GetDeploymentGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetDeploymentGroup", "POST", "/", req, resp)
 	return
 }
 
 // GetDeploymentInstance gets information about an Amazon EC2 instance as
 // part of a deployment.
 func (c *CodeDeploy) GetDeploymentInstance(req *GetDeploymentInstanceInput) (resp *GetDeploymentInstanceOutput, err error) {
+	return c.GetDeploymentInstanceWithContext(context.Background(), req)
+}
+
+// GetDeploymentInstanceWithContext is like GetDeploymentInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) GetDeploymentInstanceWithContext(ctx context.Context, req *GetDeploymentInstanceInput) (resp *GetDeploymentInstanceOutput, err error) {
 	resp = &GetDeploymentInstanceOutput{}
-	err = c.client.Do("GetDeploymentInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetDeploymentInstance", "POST", "/", req, resp)
 	return
 }
 
 // ListApplicationRevisions lists information about revisions for an
 // application.
 func (c *CodeDeploy) ListApplicationRevisions(req *ListApplicationRevisionsInput) (resp *ListApplicationRevisionsOutput, err error) {
+	return c.ListApplicationRevisionsWithContext(context.Background(), req)
+}
+
+// ListApplicationRevisionsWithContext is like ListApplicationRevisions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) ListApplicationRevisionsWithContext(ctx context.Context, req *ListApplicationRevisionsInput) (resp *ListApplicationRevisionsOutput, err error) {
 	resp = &ListApplicationRevisionsOutput{}
-	err = c.client.Do("ListApplicationRevisions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListApplicationRevisions", "POST", "/", req, resp)
 	return
 }
 
 // ListApplications lists the applications registered within the AWS user
 // account.
 func (c *CodeDeploy) ListApplications(req *ListApplicationsInput) (resp *ListApplicationsOutput, err error) {
+	return c.ListApplicationsWithContext(context.Background(), req)
+}
+
+// ListApplicationsWithContext is like ListApplications, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) ListApplicationsWithContext(ctx context.Context, req *ListApplicationsInput) (resp *ListApplicationsOutput, err error) {
 	resp = &ListApplicationsOutput{}
-	err = c.client.Do("ListApplications", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListApplications", "POST", "/", req, resp)
 	return
 }
 
 // ListDeploymentConfigs lists the deployment configurations within the AWS
 // user account.
 func (c *CodeDeploy) ListDeploymentConfigs(req *ListDeploymentConfigsInput) (resp *ListDeploymentConfigsOutput, err error) {
+	return c.ListDeploymentConfigsWithContext(context.Background(), req)
+}
+
+// ListDeploymentConfigsWithContext is like ListDeploymentConfigs, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) ListDeploymentConfigsWithContext(ctx context.Context, req *ListDeploymentConfigsInput) (resp *ListDeploymentConfigsOutput, err error) {
 	resp = &ListDeploymentConfigsOutput{}
-	err = c.client.Do("ListDeploymentConfigs", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDeploymentConfigs", "POST", "/", req, resp)
 	return
 }
 
 // ListDeploymentGroups lists the deployment groups for an application
 // registered within the AWS user account.
 func (c *CodeDeploy) ListDeploymentGroups(req *ListDeploymentGroupsInput) (resp *ListDeploymentGroupsOutput, err error) {
+	return c.ListDeploymentGroupsWithContext(context.Background(), req)
+}
+
+// ListDeploymentGroupsWithContext is like ListDeploymentGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) ListDeploymentGroupsWithContext(ctx context.Context, req *ListDeploymentGroupsInput) (resp *ListDeploymentGroupsOutput, err error) {
 	resp = &ListDeploymentGroupsOutput{}
-	err = c.client.Do("ListDeploymentGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDeploymentGroups", "POST", "/", req, resp)
 	return
 }
 
 // ListDeploymentInstances lists the Amazon EC2 instances for a deployment
 // within the AWS user account.
 func (c *CodeDeploy) ListDeploymentInstances(req *ListDeploymentInstancesInput) (resp *ListDeploymentInstancesOutput, err error) {
+	return c.ListDeploymentInstancesWithContext(context.Background(), req)
+}
+
+// ListDeploymentInstancesWithContext is like ListDeploymentInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) ListDeploymentInstancesWithContext(ctx context.Context, req *ListDeploymentInstancesInput) (resp *ListDeploymentInstancesOutput, err error) {
 	resp = &ListDeploymentInstancesOutput{}
-	err = c.client.Do("ListDeploymentInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDeploymentInstances", "POST", "/", req, resp)
 	return
 }
 
 // ListDeployments lists the deployments under a deployment group for an
 // application registered within the AWS user account.
 func (c *CodeDeploy) ListDeployments(req *ListDeploymentsInput) (resp *ListDeploymentsOutput, err error) {
+	return c.ListDeploymentsWithContext(context.Background(), req)
+}
+
+// ListDeploymentsWithContext is like ListDeployments, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) ListDeploymentsWithContext(ctx context.Context, req *ListDeploymentsInput) (resp *ListDeploymentsOutput, err error) {
 	resp = &ListDeploymentsOutput{}
-	err = c.client.Do("ListDeployments", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDeployments", "POST", "/", req, resp)
 	return
 }
 
 // RegisterApplicationRevision registers with AWS CodeDeploy a revision for
 // the specified application.
 func (c *CodeDeploy) RegisterApplicationRevision(req *RegisterApplicationRevisionInput) (err error) {
+	return c.RegisterApplicationRevisionWithContext(context.Background(), req)
+}
+
+// RegisterApplicationRevisionWithContext is like RegisterApplicationRevision, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) RegisterApplicationRevisionWithContext(ctx context.Context, req *RegisterApplicationRevisionInput) (err error) {
 	// NRE
-	err = c.client.Do("RegisterApplicationRevision", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RegisterApplicationRevision", "POST", "/", req, nil)
 	return
 }
 
 // StopDeployment is undocumented.
 func (c *CodeDeploy) StopDeployment(req *StopDeploymentInput) (resp *StopDeploymentOutput, err error) {
+	return c.StopDeploymentWithContext(context.Background(), req)
+}
+
+// StopDeploymentWithContext is like StopDeployment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) StopDeploymentWithContext(ctx context.Context, req *StopDeploymentInput) (resp *StopDeploymentOutput, err error) {
 	resp = &StopDeploymentOutput{}
-	err = c.client.Do("StopDeployment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "StopDeployment", "POST", "/", req, resp)
 	return
 }
 
 // UpdateApplication is undocumented.
 func (c *CodeDeploy) UpdateApplication(req *UpdateApplicationInput) (err error) {
+	return c.UpdateApplicationWithContext(context.Background(), req)
+}
+
+// UpdateApplicationWithContext is like UpdateApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) UpdateApplicationWithContext(ctx context.Context, req *UpdateApplicationInput) (err error) {
 	// NRE
-	err = c.client.Do("UpdateApplication", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateApplication", "POST", "/", req, nil)
 	return
 }
 
 // UpdateDeploymentGroup changes information about an existing deployment
 // group.
 func (c *CodeDeploy) UpdateDeploymentGroup(req *UpdateDeploymentGroupInput) (resp *UpdateDeploymentGroupOutput, err error) {
+	return c.UpdateDeploymentGroupWithContext(context.Background(), req)
+}
+
+// UpdateDeploymentGroupWithContext is like UpdateDeploymentGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CodeDeploy) UpdateDeploymentGroupWithContext(ctx context.Context, req *UpdateDeploymentGroupInput) (resp *UpdateDeploymentGroupOutput, err error) {
 	resp = &UpdateDeploymentGroupOutput{}
-	err = c.client.Do("UpdateDeploymentGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateDeploymentGroup", "POST", "/", req, resp)
 	return
 }
 
 // ApplicationInfo is undocumented.
 type ApplicationInfo struct {
-	ApplicationID   aws.StringValue    `json:"applicationId,omitempty"`
-	ApplicationName aws.StringValue    `json:"applicationName,omitempty"`
-	CreateTime      *aws.LongTimestamp `json:"createTime,omitempty"`
-	LinkedToGitHub  aws.BooleanValue   `json:"linkedToGitHub,omitempty"`
+	ApplicationID   aws.StringValue     `json:"applicationId,omitempty"`
+	ApplicationName aws.StringValue     `json:"applicationName,omitempty"`
+	CreateTime      *aws.FloatTimestamp `json:"createTime,omitempty"`
+	LinkedToGitHub  aws.BooleanValue    `json:"linkedToGitHub,omitempty"`
 }
 
 // Possible values for CodeDeploy.
@@ -253,6 +470,40 @@ type BatchGetApplicationsInput struct {
 	ApplicationNames []string `json:"applicationNames,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *BatchGetApplicationsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the BatchGetApplicationsInput to b.
+func (v *BatchGetApplicationsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.ApplicationNames) != 0 {
+		b = append(b, "\"applicationNames\":"...)
+		b = append(b, '[')
+		for i1 := range v.ApplicationNames {
+			b = aws.AppendJSONString(b, v.ApplicationNames[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // BatchGetApplicationsOutput is undocumented.
 type BatchGetApplicationsOutput struct {
 	ApplicationsInfo []ApplicationInfo `json:"applicationsInfo,omitempty"`
@@ -263,6 +514,40 @@ type BatchGetDeploymentsInput struct {
 	DeploymentIDs []string `json:"deploymentIds,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *BatchGetDeploymentsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the BatchGetDeploymentsInput to b.
+func (v *BatchGetDeploymentsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.DeploymentIDs) != 0 {
+		b = append(b, "\"deploymentIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.DeploymentIDs {
+			b = aws.AppendJSONString(b, v.DeploymentIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // BatchGetDeploymentsOutput is undocumented.
 type BatchGetDeploymentsOutput struct {
 	DeploymentsInfo []DeploymentInfo `json:"deploymentsInfo,omitempty"`
@@ -280,6 +565,33 @@ type CreateApplicationInput struct {
 	ApplicationName aws.StringValue `json:"applicationName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateApplicationInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateApplicationInput to b.
+func (v *CreateApplicationInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateApplicationOutput is undocumented.
 type CreateApplicationOutput struct {
 	ApplicationID aws.StringValue `json:"applicationId,omitempty"`
@@ -291,6 +603,41 @@ type CreateDeploymentConfigInput struct {
 	MinimumHealthyHosts  *MinimumHealthyHosts `json:"minimumHealthyHosts,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateDeploymentConfigInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateDeploymentConfigInput to b.
+func (v *CreateDeploymentConfigInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deploymentConfigName\":"...)
+	if v.DeploymentConfigName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentConfigName)
+	}
+	b = append(b, ',')
+	if v.MinimumHealthyHosts != nil {
+		b = append(b, "\"minimumHealthyHosts\":"...)
+		b, err = v.MinimumHealthyHosts.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateDeploymentConfigOutput is undocumented.
 type CreateDeploymentConfigOutput struct {
 	DeploymentConfigID aws.StringValue `json:"deploymentConfigId,omitempty"`
@@ -306,6 +653,81 @@ type CreateDeploymentGroupInput struct {
 	ServiceRoleARN       aws.StringValue `json:"serviceRoleArn,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateDeploymentGroupInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateDeploymentGroupInput to b.
+func (v *CreateDeploymentGroupInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if len(v.AutoScalingGroups) != 0 {
+		b = append(b, "\"autoScalingGroups\":"...)
+		b = append(b, '[')
+		for i1 := range v.AutoScalingGroups {
+			b = aws.AppendJSONString(b, v.AutoScalingGroups[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.DeploymentConfigName != nil {
+		b = append(b, "\"deploymentConfigName\":"...)
+		b = aws.AppendJSONString(b, *v.DeploymentConfigName)
+		b = append(b, ',')
+	}
+	b = append(b, "\"deploymentGroupName\":"...)
+	if v.DeploymentGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentGroupName)
+	}
+	b = append(b, ',')
+	if len(v.EC2TagFilters) != 0 {
+		b = append(b, "\"ec2TagFilters\":"...)
+		b = append(b, '[')
+		for i1 := range v.EC2TagFilters {
+			b, err = v.EC2TagFilters[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ServiceRoleARN != nil {
+		b = append(b, "\"serviceRoleArn\":"...)
+		b = aws.AppendJSONString(b, *v.ServiceRoleARN)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateDeploymentGroupOutput is undocumented.
 type CreateDeploymentGroupOutput struct {
 	DeploymentGroupID aws.StringValue `json:"deploymentGroupId,omitempty"`
@@ -321,6 +743,61 @@ type CreateDeploymentInput struct {
 	Revision                      *RevisionLocation `json:"revision,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateDeploymentInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateDeploymentInput to b.
+func (v *CreateDeploymentInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if v.DeploymentConfigName != nil {
+		b = append(b, "\"deploymentConfigName\":"...)
+		b = aws.AppendJSONString(b, *v.DeploymentConfigName)
+		b = append(b, ',')
+	}
+	if v.DeploymentGroupName != nil {
+		b = append(b, "\"deploymentGroupName\":"...)
+		b = aws.AppendJSONString(b, *v.DeploymentGroupName)
+		b = append(b, ',')
+	}
+	if v.Description != nil {
+		b = append(b, "\"description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	if v.IgnoreApplicationStopFailures != nil {
+		b = append(b, "\"ignoreApplicationStopFailures\":"...)
+		b = strconv.AppendBool(b, *v.IgnoreApplicationStopFailures)
+		b = append(b, ',')
+	}
+	if v.Revision != nil {
+		b = append(b, "\"revision\":"...)
+		b, err = v.Revision.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateDeploymentOutput is undocumented.
 type CreateDeploymentOutput struct {
 	DeploymentID aws.StringValue `json:"deploymentId,omitempty"`
@@ -331,17 +808,105 @@ type DeleteApplicationInput struct {
 	ApplicationName aws.StringValue `json:"applicationName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteApplicationInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteApplicationInput to b.
+func (v *DeleteApplicationInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteDeploymentConfigInput is undocumented.
 type DeleteDeploymentConfigInput struct {
 	DeploymentConfigName aws.StringValue `json:"deploymentConfigName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteDeploymentConfigInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteDeploymentConfigInput to b.
+func (v *DeleteDeploymentConfigInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deploymentConfigName\":"...)
+	if v.DeploymentConfigName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentConfigName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteDeploymentGroupInput is undocumented.
 type DeleteDeploymentGroupInput struct {
 	ApplicationName     aws.StringValue `json:"applicationName"`
 	DeploymentGroupName aws.StringValue `json:"deploymentGroupName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteDeploymentGroupInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteDeploymentGroupInput to b.
+func (v *DeleteDeploymentGroupInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"deploymentGroupName\":"...)
+	if v.DeploymentGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentGroupName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteDeploymentGroupOutput is undocumented.
 type DeleteDeploymentGroupOutput struct {
 	HooksNotCleanedUp []AutoScalingGroup `json:"hooksNotCleanedUp,omitempty"`
@@ -349,7 +914,7 @@ type DeleteDeploymentGroupOutput struct {
 
 // DeploymentConfigInfo is undocumented.
 type DeploymentConfigInfo struct {
-	CreateTime           *aws.LongTimestamp   `json:"createTime,omitempty"`
+	CreateTime           *aws.FloatTimestamp  `json:"createTime,omitempty"`
 	DeploymentConfigID   aws.StringValue      `json:"deploymentConfigId,omitempty"`
 	DeploymentConfigName aws.StringValue      `json:"deploymentConfigName,omitempty"`
 	MinimumHealthyHosts  *MinimumHealthyHosts `json:"minimumHealthyHosts,omitempty"`
@@ -376,8 +941,8 @@ type DeploymentGroupInfo struct {
 // DeploymentInfo is undocumented.
 type DeploymentInfo struct {
 	ApplicationName               aws.StringValue     `json:"applicationName,omitempty"`
-	CompleteTime                  *aws.LongTimestamp  `json:"completeTime,omitempty"`
-	CreateTime                    *aws.LongTimestamp  `json:"createTime,omitempty"`
+	CompleteTime                  *aws.FloatTimestamp `json:"completeTime,omitempty"`
+	CreateTime                    *aws.FloatTimestamp `json:"createTime,omitempty"`
 	Creator                       aws.StringValue     `json:"creator,omitempty"`
 	DeploymentConfigName          aws.StringValue     `json:"deploymentConfigName,omitempty"`
 	DeploymentGroupName           aws.StringValue     `json:"deploymentGroupName,omitempty"`
@@ -387,7 +952,7 @@ type DeploymentInfo struct {
 	ErrorInformation              *ErrorInformation   `json:"errorInformation,omitempty"`
 	IgnoreApplicationStopFailures aws.BooleanValue    `json:"ignoreApplicationStopFailures,omitempty"`
 	Revision                      *RevisionLocation   `json:"revision,omitempty"`
-	StartTime                     *aws.LongTimestamp  `json:"startTime,omitempty"`
+	StartTime                     *aws.FloatTimestamp `json:"startTime,omitempty"`
 	Status                        aws.StringValue     `json:"status,omitempty"`
 }
 
@@ -425,6 +990,41 @@ type EC2TagFilter struct {
 	Value aws.StringValue `json:"Value,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EC2TagFilter) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EC2TagFilter to b.
+func (v *EC2TagFilter) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Key != nil {
+		b = append(b, "\"Key\":"...)
+		b = aws.AppendJSONString(b, *v.Key)
+		b = append(b, ',')
+	}
+	if v.Type != nil {
+		b = append(b, "\"Type\":"...)
+		b = aws.AppendJSONString(b, *v.Type)
+		b = append(b, ',')
+	}
+	if v.Value != nil {
+		b = append(b, "\"Value\":"...)
+		b = aws.AppendJSONString(b, *v.Value)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for CodeDeploy.
 const (
 	EC2TagFilterTypeKeyAndValue = "KEY_AND_VALUE"
@@ -455,11 +1055,11 @@ type ErrorInformation struct {
 
 // GenericRevisionInfo is undocumented.
 type GenericRevisionInfo struct {
-	DeploymentGroups []string           `json:"deploymentGroups,omitempty"`
-	Description      aws.StringValue    `json:"description,omitempty"`
-	FirstUsedTime    *aws.LongTimestamp `json:"firstUsedTime,omitempty"`
-	LastUsedTime     *aws.LongTimestamp `json:"lastUsedTime,omitempty"`
-	RegisterTime     *aws.LongTimestamp `json:"registerTime,omitempty"`
+	DeploymentGroups []string            `json:"deploymentGroups,omitempty"`
+	Description      aws.StringValue     `json:"description,omitempty"`
+	FirstUsedTime    *aws.FloatTimestamp `json:"firstUsedTime,omitempty"`
+	LastUsedTime     *aws.FloatTimestamp `json:"lastUsedTime,omitempty"`
+	RegisterTime     *aws.FloatTimestamp `json:"registerTime,omitempty"`
 }
 
 // GetApplicationInput is undocumented.
@@ -467,6 +1067,33 @@ type GetApplicationInput struct {
 	ApplicationName aws.StringValue `json:"applicationName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetApplicationInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetApplicationInput to b.
+func (v *GetApplicationInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetApplicationOutput is undocumented.
 type GetApplicationOutput struct {
 	Application *ApplicationInfo `json:"application,omitempty"`
@@ -478,6 +1105,43 @@ type GetApplicationRevisionInput struct {
 	Revision        *RevisionLocation `json:"revision"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetApplicationRevisionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetApplicationRevisionInput to b.
+func (v *GetApplicationRevisionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"revision\":"...)
+	if v.Revision == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Revision.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetApplicationRevisionOutput is undocumented.
 type GetApplicationRevisionOutput struct {
 	ApplicationName aws.StringValue      `json:"applicationName,omitempty"`
@@ -490,6 +1154,33 @@ type GetDeploymentConfigInput struct {
 	DeploymentConfigName aws.StringValue `json:"deploymentConfigName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetDeploymentConfigInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetDeploymentConfigInput to b.
+func (v *GetDeploymentConfigInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deploymentConfigName\":"...)
+	if v.DeploymentConfigName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentConfigName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetDeploymentConfigOutput is undocumented.
 type GetDeploymentConfigOutput struct {
 	DeploymentConfigInfo *DeploymentConfigInfo `json:"deploymentConfigInfo,omitempty"`
@@ -501,6 +1192,40 @@ type GetDeploymentGroupInput struct {
 	DeploymentGroupName aws.StringValue `json:"deploymentGroupName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetDeploymentGroupInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetDeploymentGroupInput to b.
+func (v *GetDeploymentGroupInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"deploymentGroupName\":"...)
+	if v.DeploymentGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentGroupName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetDeploymentGroupOutput is undocumented.
 type GetDeploymentGroupOutput struct {
 	DeploymentGroupInfo *DeploymentGroupInfo `json:"deploymentGroupInfo,omitempty"`
@@ -511,12 +1236,73 @@ type GetDeploymentInput struct {
 	DeploymentID aws.StringValue `json:"deploymentId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetDeploymentInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetDeploymentInput to b.
+func (v *GetDeploymentInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deploymentId\":"...)
+	if v.DeploymentID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetDeploymentInstanceInput is undocumented.
 type GetDeploymentInstanceInput struct {
 	DeploymentID aws.StringValue `json:"deploymentId"`
 	InstanceID   aws.StringValue `json:"instanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetDeploymentInstanceInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetDeploymentInstanceInput to b.
+func (v *GetDeploymentInstanceInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deploymentId\":"...)
+	if v.DeploymentID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"instanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetDeploymentInstanceOutput is undocumented.
 type GetDeploymentInstanceOutput struct {
 	InstanceSummary *InstanceSummary `json:"instanceSummary,omitempty"`
@@ -533,6 +1319,36 @@ type GitHubLocation struct {
 	Repository aws.StringValue `json:"repository,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GitHubLocation) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GitHubLocation to b.
+func (v *GitHubLocation) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CommitID != nil {
+		b = append(b, "\"commitId\":"...)
+		b = aws.AppendJSONString(b, *v.CommitID)
+		b = append(b, ',')
+	}
+	if v.Repository != nil {
+		b = append(b, "\"repository\":"...)
+		b = aws.AppendJSONString(b, *v.Repository)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for CodeDeploy.
 const (
 	InstanceStatusFailed     = "Failed"
@@ -545,11 +1361,11 @@ const (
 
 // InstanceSummary is undocumented.
 type InstanceSummary struct {
-	DeploymentID    aws.StringValue    `json:"deploymentId,omitempty"`
-	InstanceID      aws.StringValue    `json:"instanceId,omitempty"`
-	LastUpdatedAt   *aws.LongTimestamp `json:"lastUpdatedAt,omitempty"`
-	LifecycleEvents []LifecycleEvent   `json:"lifecycleEvents,omitempty"`
-	Status          aws.StringValue    `json:"status,omitempty"`
+	DeploymentID    aws.StringValue     `json:"deploymentId,omitempty"`
+	InstanceID      aws.StringValue     `json:"instanceId,omitempty"`
+	LastUpdatedAt   *aws.FloatTimestamp `json:"lastUpdatedAt,omitempty"`
+	LifecycleEvents []LifecycleEvent    `json:"lifecycleEvents,omitempty"`
+	Status          aws.StringValue     `json:"status,omitempty"`
 }
 
 // Possible values for CodeDeploy.
@@ -564,11 +1380,11 @@ const (
 
 // LifecycleEvent is undocumented.
 type LifecycleEvent struct {
-	Diagnostics        *Diagnostics       `json:"diagnostics,omitempty"`
-	EndTime            *aws.LongTimestamp `json:"endTime,omitempty"`
-	LifecycleEventName aws.StringValue    `json:"lifecycleEventName,omitempty"`
-	StartTime          *aws.LongTimestamp `json:"startTime,omitempty"`
-	Status             aws.StringValue    `json:"status,omitempty"`
+	Diagnostics        *Diagnostics        `json:"diagnostics,omitempty"`
+	EndTime            *aws.FloatTimestamp `json:"endTime,omitempty"`
+	LifecycleEventName aws.StringValue     `json:"lifecycleEventName,omitempty"`
+	StartTime          *aws.FloatTimestamp `json:"startTime,omitempty"`
+	Status             aws.StringValue     `json:"status,omitempty"`
 }
 
 // Possible values for CodeDeploy.
@@ -592,6 +1408,63 @@ type ListApplicationRevisionsInput struct {
 	SortOrder       aws.StringValue `json:"sortOrder,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListApplicationRevisionsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListApplicationRevisionsInput to b.
+func (v *ListApplicationRevisionsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if v.Deployed != nil {
+		b = append(b, "\"deployed\":"...)
+		b = aws.AppendJSONString(b, *v.Deployed)
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if v.S3Bucket != nil {
+		b = append(b, "\"s3Bucket\":"...)
+		b = aws.AppendJSONString(b, *v.S3Bucket)
+		b = append(b, ',')
+	}
+	if v.S3KeyPrefix != nil {
+		b = append(b, "\"s3KeyPrefix\":"...)
+		b = aws.AppendJSONString(b, *v.S3KeyPrefix)
+		b = append(b, ',')
+	}
+	if v.SortBy != nil {
+		b = append(b, "\"sortBy\":"...)
+		b = aws.AppendJSONString(b, *v.SortBy)
+		b = append(b, ',')
+	}
+	if v.SortOrder != nil {
+		b = append(b, "\"sortOrder\":"...)
+		b = aws.AppendJSONString(b, *v.SortOrder)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListApplicationRevisionsOutput is undocumented.
 type ListApplicationRevisionsOutput struct {
 	NextToken aws.StringValue    `json:"nextToken,omitempty"`
@@ -603,6 +1476,31 @@ type ListApplicationsInput struct {
 	NextToken aws.StringValue `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListApplicationsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListApplicationsInput to b.
+func (v *ListApplicationsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListApplicationsOutput is undocumented.
 type ListApplicationsOutput struct {
 	Applications []string        `json:"applications,omitempty"`
@@ -614,6 +1512,31 @@ type ListDeploymentConfigsInput struct {
 	NextToken aws.StringValue `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListDeploymentConfigsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListDeploymentConfigsInput to b.
+func (v *ListDeploymentConfigsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListDeploymentConfigsOutput is undocumented.
 type ListDeploymentConfigsOutput struct {
 	DeploymentConfigsList []string        `json:"deploymentConfigsList,omitempty"`
@@ -626,6 +1549,38 @@ type ListDeploymentGroupsInput struct {
 	NextToken       aws.StringValue `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListDeploymentGroupsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListDeploymentGroupsInput to b.
+func (v *ListDeploymentGroupsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListDeploymentGroupsOutput is undocumented.
 type ListDeploymentGroupsOutput struct {
 	ApplicationName  aws.StringValue `json:"applicationName,omitempty"`
@@ -640,6 +1595,52 @@ type ListDeploymentInstancesInput struct {
 	NextToken            aws.StringValue `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListDeploymentInstancesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListDeploymentInstancesInput to b.
+func (v *ListDeploymentInstancesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deploymentId\":"...)
+	if v.DeploymentID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentID)
+	}
+	b = append(b, ',')
+	if len(v.InstanceStatusFilter) != 0 {
+		b = append(b, "\"instanceStatusFilter\":"...)
+		b = append(b, '[')
+		for i1 := range v.InstanceStatusFilter {
+			b = aws.AppendJSONString(b, v.InstanceStatusFilter[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListDeploymentInstancesOutput is undocumented.
 type ListDeploymentInstancesOutput struct {
 	InstancesList []string        `json:"instancesList,omitempty"`
@@ -655,6 +1656,63 @@ type ListDeploymentsInput struct {
 	NextToken           aws.StringValue `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListDeploymentsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListDeploymentsInput to b.
+func (v *ListDeploymentsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ApplicationName != nil {
+		b = append(b, "\"applicationName\":"...)
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+		b = append(b, ',')
+	}
+	if v.CreateTimeRange != nil {
+		b = append(b, "\"createTimeRange\":"...)
+		b, err = v.CreateTimeRange.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.DeploymentGroupName != nil {
+		b = append(b, "\"deploymentGroupName\":"...)
+		b = aws.AppendJSONString(b, *v.DeploymentGroupName)
+		b = append(b, ',')
+	}
+	if len(v.IncludeOnlyStatuses) != 0 {
+		b = append(b, "\"includeOnlyStatuses\":"...)
+		b = append(b, '[')
+		for i1 := range v.IncludeOnlyStatuses {
+			b = aws.AppendJSONString(b, v.IncludeOnlyStatuses[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListDeploymentsOutput is undocumented.
 type ListDeploymentsOutput struct {
 	Deployments []string        `json:"deployments,omitempty"`
@@ -674,6 +1732,36 @@ type MinimumHealthyHosts struct {
 	Value aws.IntegerValue `json:"value,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *MinimumHealthyHosts) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the MinimumHealthyHosts to b.
+func (v *MinimumHealthyHosts) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Type != nil {
+		b = append(b, "\"type\":"...)
+		b = aws.AppendJSONString(b, *v.Type)
+		b = append(b, ',')
+	}
+	if v.Value != nil {
+		b = append(b, "\"value\":"...)
+		b = strconv.AppendInt(b, int64(*v.Value), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for CodeDeploy.
 const (
 	MinimumHealthyHostsTypeFleetPercent = "FLEET_PERCENT"
@@ -687,6 +1775,48 @@ type RegisterApplicationRevisionInput struct {
 	Revision        *RevisionLocation `json:"revision"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterApplicationRevisionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterApplicationRevisionInput to b.
+func (v *RegisterApplicationRevisionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if v.Description != nil {
+		b = append(b, "\"description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	b = append(b, "\"revision\":"...)
+	if v.Revision == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Revision.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RevisionLocation is undocumented.
 type RevisionLocation struct {
 	GitHubLocation *GitHubLocation `json:"gitHubLocation,omitempty"`
@@ -694,6 +1824,47 @@ type RevisionLocation struct {
 	S3Location     *S3Location     `json:"s3Location,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RevisionLocation) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RevisionLocation to b.
+func (v *RevisionLocation) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.GitHubLocation != nil {
+		b = append(b, "\"gitHubLocation\":"...)
+		b, err = v.GitHubLocation.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.RevisionType != nil {
+		b = append(b, "\"revisionType\":"...)
+		b = aws.AppendJSONString(b, *v.RevisionType)
+		b = append(b, ',')
+	}
+	if v.S3Location != nil {
+		b = append(b, "\"s3Location\":"...)
+		b, err = v.S3Location.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for CodeDeploy.
 const (
 	RevisionLocationTypeGitHub = "GitHub"
@@ -709,6 +1880,51 @@ type S3Location struct {
 	Version    aws.StringValue `json:"version,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *S3Location) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the S3Location to b.
+func (v *S3Location) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Bucket != nil {
+		b = append(b, "\"bucket\":"...)
+		b = aws.AppendJSONString(b, *v.Bucket)
+		b = append(b, ',')
+	}
+	if v.BundleType != nil {
+		b = append(b, "\"bundleType\":"...)
+		b = aws.AppendJSONString(b, *v.BundleType)
+		b = append(b, ',')
+	}
+	if v.ETag != nil {
+		b = append(b, "\"eTag\":"...)
+		b = aws.AppendJSONString(b, *v.ETag)
+		b = append(b, ',')
+	}
+	if v.Key != nil {
+		b = append(b, "\"key\":"...)
+		b = aws.AppendJSONString(b, *v.Key)
+		b = append(b, ',')
+	}
+	if v.Version != nil {
+		b = append(b, "\"version\":"...)
+		b = aws.AppendJSONString(b, *v.Version)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for CodeDeploy.
 const (
 	SortOrderAscending  = "ascending"
@@ -720,6 +1936,33 @@ type StopDeploymentInput struct {
 	DeploymentID aws.StringValue `json:"deploymentId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StopDeploymentInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StopDeploymentInput to b.
+func (v *StopDeploymentInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deploymentId\":"...)
+	if v.DeploymentID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeploymentID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StopDeploymentOutput is undocumented.
 type StopDeploymentOutput struct {
 	Status        aws.StringValue `json:"status,omitempty"`
@@ -734,8 +1977,44 @@ const (
 
 // TimeRange is undocumented.
 type TimeRange struct {
-	End   *aws.LongTimestamp `json:"end,omitempty"`
-	Start *aws.LongTimestamp `json:"start,omitempty"`
+	End   *aws.FloatTimestamp `json:"end,omitempty"`
+	Start *aws.FloatTimestamp `json:"start,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *TimeRange) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the TimeRange to b.
+func (v *TimeRange) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.End != nil {
+		b = append(b, "\"end\":"...)
+		b, err = aws.AppendJSON(b, v.End)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.Start != nil {
+		b = append(b, "\"start\":"...)
+		b, err = aws.AppendJSON(b, v.Start)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // UpdateApplicationInput is undocumented.
@@ -744,6 +2023,36 @@ type UpdateApplicationInput struct {
 	NewApplicationName aws.StringValue `json:"newApplicationName,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateApplicationInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateApplicationInput to b.
+func (v *UpdateApplicationInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ApplicationName != nil {
+		b = append(b, "\"applicationName\":"...)
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+		b = append(b, ',')
+	}
+	if v.NewApplicationName != nil {
+		b = append(b, "\"newApplicationName\":"...)
+		b = aws.AppendJSONString(b, *v.NewApplicationName)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateDeploymentGroupInput is undocumented.
 type UpdateDeploymentGroupInput struct {
 	ApplicationName            aws.StringValue `json:"applicationName"`
@@ -755,10 +2064,361 @@ type UpdateDeploymentGroupInput struct {
 	ServiceRoleARN             aws.StringValue `json:"serviceRoleArn,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateDeploymentGroupInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateDeploymentGroupInput to b.
+func (v *UpdateDeploymentGroupInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"applicationName\":"...)
+	if v.ApplicationName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ApplicationName)
+	}
+	b = append(b, ',')
+	if len(v.AutoScalingGroups) != 0 {
+		b = append(b, "\"autoScalingGroups\":"...)
+		b = append(b, '[')
+		for i1 := range v.AutoScalingGroups {
+			b = aws.AppendJSONString(b, v.AutoScalingGroups[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"currentDeploymentGroupName\":"...)
+	if v.CurrentDeploymentGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CurrentDeploymentGroupName)
+	}
+	b = append(b, ',')
+	if v.DeploymentConfigName != nil {
+		b = append(b, "\"deploymentConfigName\":"...)
+		b = aws.AppendJSONString(b, *v.DeploymentConfigName)
+		b = append(b, ',')
+	}
+	if len(v.EC2TagFilters) != 0 {
+		b = append(b, "\"ec2TagFilters\":"...)
+		b = append(b, '[')
+		for i1 := range v.EC2TagFilters {
+			b, err = v.EC2TagFilters[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.NewDeploymentGroupName != nil {
+		b = append(b, "\"newDeploymentGroupName\":"...)
+		b = aws.AppendJSONString(b, *v.NewDeploymentGroupName)
+		b = append(b, ',')
+	}
+	if v.ServiceRoleARN != nil {
+		b = append(b, "\"serviceRoleArn\":"...)
+		b = aws.AppendJSONString(b, *v.ServiceRoleARN)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateDeploymentGroupOutput is undocumented.
 type UpdateDeploymentGroupOutput struct {
 	HooksNotCleanedUp []AutoScalingGroup `json:"hooksNotCleanedUp,omitempty"`
 }
 
+// ApplicationAlreadyExistsException is the error returned for the ApplicationAlreadyExistsException error code.
+type ApplicationAlreadyExistsException struct {
+	aws.APIError
+}
+
+// ApplicationDoesNotExistException is the error returned for the ApplicationDoesNotExistException error code.
+type ApplicationDoesNotExistException struct {
+	aws.APIError
+}
+
+// ApplicationLimitExceededException is the error returned for the ApplicationLimitExceededException error code.
+type ApplicationLimitExceededException struct {
+	aws.APIError
+}
+
+// ApplicationNameRequiredException is the error returned for the ApplicationNameRequiredException error code.
+type ApplicationNameRequiredException struct {
+	aws.APIError
+}
+
+// BucketNameFilterRequiredException is the error returned for the BucketNameFilterRequiredException error code.
+type BucketNameFilterRequiredException struct {
+	aws.APIError
+}
+
+// DeploymentAlreadyCompletedException is the error returned for the DeploymentAlreadyCompletedException error code.
+type DeploymentAlreadyCompletedException struct {
+	aws.APIError
+}
+
+// DeploymentConfigAlreadyExistsException is the error returned for the DeploymentConfigAlreadyExistsException error code.
+type DeploymentConfigAlreadyExistsException struct {
+	aws.APIError
+}
+
+// DeploymentConfigDoesNotExistException is the error returned for the DeploymentConfigDoesNotExistException error code.
+type DeploymentConfigDoesNotExistException struct {
+	aws.APIError
+}
+
+// DeploymentConfigInUseException is the error returned for the DeploymentConfigInUseException error code.
+type DeploymentConfigInUseException struct {
+	aws.APIError
+}
+
+// DeploymentConfigLimitExceededException is the error returned for the DeploymentConfigLimitExceededException error code.
+type DeploymentConfigLimitExceededException struct {
+	aws.APIError
+}
+
+// DeploymentConfigNameRequiredException is the error returned for the DeploymentConfigNameRequiredException error code.
+type DeploymentConfigNameRequiredException struct {
+	aws.APIError
+}
+
+// DeploymentDoesNotExistException is the error returned for the DeploymentDoesNotExistException error code.
+type DeploymentDoesNotExistException struct {
+	aws.APIError
+}
+
+// DeploymentGroupAlreadyExistsException is the error returned for the DeploymentGroupAlreadyExistsException error code.
+type DeploymentGroupAlreadyExistsException struct {
+	aws.APIError
+}
+
+// DeploymentGroupDoesNotExistException is the error returned for the DeploymentGroupDoesNotExistException error code.
+type DeploymentGroupDoesNotExistException struct {
+	aws.APIError
+}
+
+// DeploymentGroupLimitExceededException is the error returned for the DeploymentGroupLimitExceededException error code.
+type DeploymentGroupLimitExceededException struct {
+	aws.APIError
+}
+
+// DeploymentGroupNameRequiredException is the error returned for the DeploymentGroupNameRequiredException error code.
+type DeploymentGroupNameRequiredException struct {
+	aws.APIError
+}
+
+// DeploymentIDRequiredException is the error returned for the DeploymentIdRequiredException error code.
+type DeploymentIDRequiredException struct {
+	aws.APIError
+}
+
+// DeploymentLimitExceededException is the error returned for the DeploymentLimitExceededException error code.
+type DeploymentLimitExceededException struct {
+	aws.APIError
+}
+
+// DeploymentNotStartedException is the error returned for the DeploymentNotStartedException error code.
+type DeploymentNotStartedException struct {
+	aws.APIError
+}
+
+// DescriptionTooLongException is the error returned for the DescriptionTooLongException error code.
+type DescriptionTooLongException struct {
+	aws.APIError
+}
+
+// InstanceDoesNotExistException is the error returned for the InstanceDoesNotExistException error code.
+type InstanceDoesNotExistException struct {
+	aws.APIError
+}
+
+// InstanceIDRequiredException is the error returned for the InstanceIdRequiredException error code.
+type InstanceIDRequiredException struct {
+	aws.APIError
+}
+
+// InvalidApplicationNameException is the error returned for the InvalidApplicationNameException error code.
+type InvalidApplicationNameException struct {
+	aws.APIError
+}
+
+// InvalidAutoScalingGroupException is the error returned for the InvalidAutoScalingGroupException error code.
+type InvalidAutoScalingGroupException struct {
+	aws.APIError
+}
+
+// InvalidBucketNameFilterException is the error returned for the InvalidBucketNameFilterException error code.
+type InvalidBucketNameFilterException struct {
+	aws.APIError
+}
+
+// InvalidDeployedStateFilterException is the error returned for the InvalidDeployedStateFilterException error code.
+type InvalidDeployedStateFilterException struct {
+	aws.APIError
+}
+
+// InvalidDeploymentConfigNameException is the error returned for the InvalidDeploymentConfigNameException error code.
+type InvalidDeploymentConfigNameException struct {
+	aws.APIError
+}
+
+// InvalidDeploymentGroupNameException is the error returned for the InvalidDeploymentGroupNameException error code.
+type InvalidDeploymentGroupNameException struct {
+	aws.APIError
+}
+
+// InvalidDeploymentIDException is the error returned for the InvalidDeploymentIdException error code.
+type InvalidDeploymentIDException struct {
+	aws.APIError
+}
+
+// InvalidDeploymentStatusException is the error returned for the InvalidDeploymentStatusException error code.
+type InvalidDeploymentStatusException struct {
+	aws.APIError
+}
+
+// InvalidEC2TagException is the error returned for the InvalidEC2TagException error code.
+type InvalidEC2TagException struct {
+	aws.APIError
+}
+
+// InvalidInstanceStatusException is the error returned for the InvalidInstanceStatusException error code.
+type InvalidInstanceStatusException struct {
+	aws.APIError
+}
+
+// InvalidKeyPrefixFilterException is the error returned for the InvalidKeyPrefixFilterException error code.
+type InvalidKeyPrefixFilterException struct {
+	aws.APIError
+}
+
+// InvalidMinimumHealthyHostValueException is the error returned for the InvalidMinimumHealthyHostValueException error code.
+type InvalidMinimumHealthyHostValueException struct {
+	aws.APIError
+}
+
+// InvalidNextTokenException is the error returned for the InvalidNextTokenException error code.
+type InvalidNextTokenException struct {
+	aws.APIError
+}
+
+// InvalidOperationException is the error returned for the InvalidOperationException error code.
+type InvalidOperationException struct {
+	aws.APIError
+}
+
+// InvalidRevisionException is the error returned for the InvalidRevisionException error code.
+type InvalidRevisionException struct {
+	aws.APIError
+}
+
+// InvalidRoleException is the error returned for the InvalidRoleException error code.
+type InvalidRoleException struct {
+	aws.APIError
+}
+
+// InvalidSortByException is the error returned for the InvalidSortByException error code.
+type InvalidSortByException struct {
+	aws.APIError
+}
+
+// InvalidSortOrderException is the error returned for the InvalidSortOrderException error code.
+type InvalidSortOrderException struct {
+	aws.APIError
+}
+
+// InvalidTimeRangeException is the error returned for the InvalidTimeRangeException error code.
+type InvalidTimeRangeException struct {
+	aws.APIError
+}
+
+// RevisionDoesNotExistException is the error returned for the RevisionDoesNotExistException error code.
+type RevisionDoesNotExistException struct {
+	aws.APIError
+}
+
+// RevisionRequiredException is the error returned for the RevisionRequiredException error code.
+type RevisionRequiredException struct {
+	aws.APIError
+}
+
+// RoleRequiredException is the error returned for the RoleRequiredException error code.
+type RoleRequiredException struct {
+	aws.APIError
+}
+
+// exceptions maps CodeDeploy's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"ApplicationAlreadyExistsException":       ApplicationAlreadyExistsException{},
+	"ApplicationDoesNotExistException":        ApplicationDoesNotExistException{},
+	"ApplicationLimitExceededException":       ApplicationLimitExceededException{},
+	"ApplicationNameRequiredException":        ApplicationNameRequiredException{},
+	"BucketNameFilterRequiredException":       BucketNameFilterRequiredException{},
+	"DeploymentAlreadyCompletedException":     DeploymentAlreadyCompletedException{},
+	"DeploymentConfigAlreadyExistsException":  DeploymentConfigAlreadyExistsException{},
+	"DeploymentConfigDoesNotExistException":   DeploymentConfigDoesNotExistException{},
+	"DeploymentConfigInUseException":          DeploymentConfigInUseException{},
+	"DeploymentConfigLimitExceededException":  DeploymentConfigLimitExceededException{},
+	"DeploymentConfigNameRequiredException":   DeploymentConfigNameRequiredException{},
+	"DeploymentDoesNotExistException":         DeploymentDoesNotExistException{},
+	"DeploymentGroupAlreadyExistsException":   DeploymentGroupAlreadyExistsException{},
+	"DeploymentGroupDoesNotExistException":    DeploymentGroupDoesNotExistException{},
+	"DeploymentGroupLimitExceededException":   DeploymentGroupLimitExceededException{},
+	"DeploymentGroupNameRequiredException":    DeploymentGroupNameRequiredException{},
+	"DeploymentIdRequiredException":           DeploymentIDRequiredException{},
+	"DeploymentLimitExceededException":        DeploymentLimitExceededException{},
+	"DeploymentNotStartedException":           DeploymentNotStartedException{},
+	"DescriptionTooLongException":             DescriptionTooLongException{},
+	"InstanceDoesNotExistException":           InstanceDoesNotExistException{},
+	"InstanceIdRequiredException":             InstanceIDRequiredException{},
+	"InvalidApplicationNameException":         InvalidApplicationNameException{},
+	"InvalidAutoScalingGroupException":        InvalidAutoScalingGroupException{},
+	"InvalidBucketNameFilterException":        InvalidBucketNameFilterException{},
+	"InvalidDeployedStateFilterException":     InvalidDeployedStateFilterException{},
+	"InvalidDeploymentConfigNameException":    InvalidDeploymentConfigNameException{},
+	"InvalidDeploymentGroupNameException":     InvalidDeploymentGroupNameException{},
+	"InvalidDeploymentIdException":            InvalidDeploymentIDException{},
+	"InvalidDeploymentStatusException":        InvalidDeploymentStatusException{},
+	"InvalidEC2TagException":                  InvalidEC2TagException{},
+	"InvalidInstanceStatusException":          InvalidInstanceStatusException{},
+	"InvalidKeyPrefixFilterException":         InvalidKeyPrefixFilterException{},
+	"InvalidMinimumHealthyHostValueException": InvalidMinimumHealthyHostValueException{},
+	"InvalidNextTokenException":               InvalidNextTokenException{},
+	"InvalidOperationException":               InvalidOperationException{},
+	"InvalidRevisionException":                InvalidRevisionException{},
+	"InvalidRoleException":                    InvalidRoleException{},
+	"InvalidSortByException":                  InvalidSortByException{},
+	"InvalidSortOrderException":               InvalidSortOrderException{},
+	"InvalidTimeRangeException":               InvalidTimeRangeException{},
+	"RevisionDoesNotExistException":           RevisionDoesNotExistException{},
+	"RevisionRequiredException":               RevisionRequiredException{},
+	"RoleRequiredException":                   RoleRequiredException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
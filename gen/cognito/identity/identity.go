@@ -4,11 +4,18 @@
 package cognitoidentity
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // CognitoIdentity is a client for Amazon Cognito Identity.
@@ -16,43 +23,92 @@ type CognitoIdentity struct {
 	client *aws.JSONClient
 }
 
-// New returns a new CognitoIdentity client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CognitoIdentity {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "cognito-identity"
-	endpoint, service, region := endpoints.Lookup("cognito-identity", region)
+// New returns a new CognitoIdentity client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CognitoIdentity {
+	cfg := newConfig(region, client, opts)
 
 	return &CognitoIdentity{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "AWSCognitoIdentityService",
 		},
 	}
 }
 
+// NewFromSession returns a new CognitoIdentity client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CognitoIdentity {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("cognito-identity", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("cognito-identity", "cognitoidentity"),
+	}, "AWS_ENDPOINT_URL_COGNITO_IDENTITY", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CognitoIdentity) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CognitoIdentity) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // CreateIdentityPool creates a new identity pool. The identity pool is a
 // store of user identity information that is specific to your AWS account.
 // The limit on identity pools is 60 per account.
 func (c *CognitoIdentity) CreateIdentityPool(req *CreateIdentityPoolInput) (resp *IdentityPool, err error) {
+	return c.CreateIdentityPoolWithContext(context.Background(), req)
+}
+
+// CreateIdentityPoolWithContext is like CreateIdentityPool, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) CreateIdentityPoolWithContext(ctx context.Context, req *CreateIdentityPoolInput) (resp *IdentityPool, err error) {
 	resp = &IdentityPool{}
-	err = c.client.Do("CreateIdentityPool", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateIdentityPool", "POST", "/", req, resp)
 	return
 }
 
 // DeleteIdentityPool deletes a user pool. Once a pool is deleted, users
 // will not be able to authenticate with the pool.
 func (c *CognitoIdentity) DeleteIdentityPool(req *DeleteIdentityPoolInput) (err error) {
+	return c.DeleteIdentityPoolWithContext(context.Background(), req)
+}
+
+// DeleteIdentityPoolWithContext is like DeleteIdentityPool, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) DeleteIdentityPoolWithContext(ctx context.Context, req *DeleteIdentityPoolInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteIdentityPool", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteIdentityPool", "POST", "/", req, nil)
 	return
 }
 
@@ -60,16 +116,30 @@ func (c *CognitoIdentity) DeleteIdentityPool(req *DeleteIdentityPoolInput) (err
 // including the pool name, ID description, creation date, and current
 // number of users.
 func (c *CognitoIdentity) DescribeIdentityPool(req *DescribeIdentityPoolInput) (resp *IdentityPool, err error) {
+	return c.DescribeIdentityPoolWithContext(context.Background(), req)
+}
+
+// DescribeIdentityPoolWithContext is like DescribeIdentityPool, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) DescribeIdentityPoolWithContext(ctx context.Context, req *DescribeIdentityPoolInput) (resp *IdentityPool, err error) {
 	resp = &IdentityPool{}
-	err = c.client.Do("DescribeIdentityPool", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeIdentityPool", "POST", "/", req, resp)
 	return
 }
 
 // GetID generates (or retrieves) a Cognito ID. Supplying multiple logins
 // will create an implicit linked account.
 func (c *CognitoIdentity) GetID(req *GetIDInput) (resp *GetIDResponse, err error) {
+	return c.GetIDWithContext(context.Background(), req)
+}
+
+// GetIDWithContext is like GetID, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) GetIDWithContext(ctx context.Context, req *GetIDInput) (resp *GetIDResponse, err error) {
 	resp = &GetIDResponse{}
-	err = c.client.Do("GetId", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetId", "POST", "/", req, resp)
 	return
 }
 
@@ -78,8 +148,15 @@ func (c *CognitoIdentity) GetID(req *GetIDInput) (resp *GetIDResponse, err error
 // additional logins for the identity. Supplying multiple logins creates an
 // implicit link. The OpenId token is valid for 15 minutes.
 func (c *CognitoIdentity) GetOpenIDToken(req *GetOpenIDTokenInput) (resp *GetOpenIDTokenResponse, err error) {
+	return c.GetOpenIDTokenWithContext(context.Background(), req)
+}
+
+// GetOpenIDTokenWithContext is like GetOpenIDToken, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) GetOpenIDTokenWithContext(ctx context.Context, req *GetOpenIDTokenInput) (resp *GetOpenIDTokenResponse, err error) {
 	resp = &GetOpenIDTokenResponse{}
-	err = c.client.Do("GetOpenIdToken", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetOpenIdToken", "POST", "/", req, resp)
 	return
 }
 
@@ -98,23 +175,44 @@ func (c *CognitoIdentity) GetOpenIDToken(req *GetOpenIDTokenInput) (resp *GetOpe
 // existing IdentityId . This API will create the identity in the specified
 // IdentityPoolId
 func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentity(req *GetOpenIDTokenForDeveloperIdentityInput) (resp *GetOpenIDTokenForDeveloperIdentityResponse, err error) {
+	return c.GetOpenIDTokenForDeveloperIdentityWithContext(context.Background(), req)
+}
+
+// GetOpenIDTokenForDeveloperIdentityWithContext is like GetOpenIDTokenForDeveloperIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) GetOpenIDTokenForDeveloperIdentityWithContext(ctx context.Context, req *GetOpenIDTokenForDeveloperIdentityInput) (resp *GetOpenIDTokenForDeveloperIdentityResponse, err error) {
 	resp = &GetOpenIDTokenForDeveloperIdentityResponse{}
-	err = c.client.Do("GetOpenIdTokenForDeveloperIdentity", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetOpenIdTokenForDeveloperIdentity", "POST", "/", req, resp)
 	return
 }
 
 // ListIdentities is undocumented.
 func (c *CognitoIdentity) ListIdentities(req *ListIdentitiesInput) (resp *ListIdentitiesResponse, err error) {
+	return c.ListIdentitiesWithContext(context.Background(), req)
+}
+
+// ListIdentitiesWithContext is like ListIdentities, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) ListIdentitiesWithContext(ctx context.Context, req *ListIdentitiesInput) (resp *ListIdentitiesResponse, err error) {
 	resp = &ListIdentitiesResponse{}
-	err = c.client.Do("ListIdentities", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListIdentities", "POST", "/", req, resp)
 	return
 }
 
 // ListIdentityPools lists all of the Cognito identity pools registered for
 // your account.
 func (c *CognitoIdentity) ListIdentityPools(req *ListIdentityPoolsInput) (resp *ListIdentityPoolsResponse, err error) {
+	return c.ListIdentityPoolsWithContext(context.Background(), req)
+}
+
+// ListIdentityPoolsWithContext is like ListIdentityPools, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) ListIdentityPoolsWithContext(ctx context.Context, req *ListIdentityPoolsInput) (resp *ListIdentityPoolsResponse, err error) {
 	resp = &ListIdentityPoolsResponse{}
-	err = c.client.Do("ListIdentityPools", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListIdentityPools", "POST", "/", req, resp)
 	return
 }
 
@@ -129,8 +227,15 @@ func (c *CognitoIdentity) ListIdentityPools(req *ListIdentityPoolsInput) (resp *
 // values and is the same as the request. Otherwise a
 // ResourceConflictException is thrown.
 func (c *CognitoIdentity) LookupDeveloperIdentity(req *LookupDeveloperIdentityInput) (resp *LookupDeveloperIdentityResponse, err error) {
+	return c.LookupDeveloperIdentityWithContext(context.Background(), req)
+}
+
+// LookupDeveloperIdentityWithContext is like LookupDeveloperIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) LookupDeveloperIdentityWithContext(ctx context.Context, req *LookupDeveloperIdentityInput) (resp *LookupDeveloperIdentityResponse, err error) {
 	resp = &LookupDeveloperIdentityResponse{}
-	err = c.client.Do("LookupDeveloperIdentity", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "LookupDeveloperIdentity", "POST", "/", req, resp)
 	return
 }
 
@@ -144,8 +249,15 @@ func (c *CognitoIdentity) LookupDeveloperIdentity(req *LookupDeveloperIdentityIn
 // are associated with the same public provider, but as two different
 // users, an exception will be thrown.
 func (c *CognitoIdentity) MergeDeveloperIdentities(req *MergeDeveloperIdentitiesInput) (resp *MergeDeveloperIdentitiesResponse, err error) {
+	return c.MergeDeveloperIdentitiesWithContext(context.Background(), req)
+}
+
+// MergeDeveloperIdentitiesWithContext is like MergeDeveloperIdentities, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) MergeDeveloperIdentitiesWithContext(ctx context.Context, req *MergeDeveloperIdentitiesInput) (resp *MergeDeveloperIdentitiesResponse, err error) {
 	resp = &MergeDeveloperIdentitiesResponse{}
-	err = c.client.Do("MergeDeveloperIdentities", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "MergeDeveloperIdentities", "POST", "/", req, resp)
 	return
 }
 
@@ -155,8 +267,15 @@ func (c *CognitoIdentity) MergeDeveloperIdentities(req *MergeDeveloperIdentities
 // you remove all federated identities as well as the developer user
 // identifier, the Cognito identity becomes inaccessible.
 func (c *CognitoIdentity) UnlinkDeveloperIdentity(req *UnlinkDeveloperIdentityInput) (err error) {
+	return c.UnlinkDeveloperIdentityWithContext(context.Background(), req)
+}
+
+// UnlinkDeveloperIdentityWithContext is like UnlinkDeveloperIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) UnlinkDeveloperIdentityWithContext(ctx context.Context, req *UnlinkDeveloperIdentityInput) (err error) {
 	// NRE
-	err = c.client.Do("UnlinkDeveloperIdentity", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UnlinkDeveloperIdentity", "POST", "/", req, nil)
 	return
 }
 
@@ -165,15 +284,29 @@ func (c *CognitoIdentity) UnlinkDeveloperIdentity(req *UnlinkDeveloperIdentityIn
 // seen. Removing the last linked login will make this identity
 // inaccessible.
 func (c *CognitoIdentity) UnlinkIdentity(req *UnlinkIdentityInput) (err error) {
+	return c.UnlinkIdentityWithContext(context.Background(), req)
+}
+
+// UnlinkIdentityWithContext is like UnlinkIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) UnlinkIdentityWithContext(ctx context.Context, req *UnlinkIdentityInput) (err error) {
 	// NRE
-	err = c.client.Do("UnlinkIdentity", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UnlinkIdentity", "POST", "/", req, nil)
 	return
 }
 
 // UpdateIdentityPool is undocumented.
 func (c *CognitoIdentity) UpdateIdentityPool(req *IdentityPool) (resp *IdentityPool, err error) {
+	return c.UpdateIdentityPoolWithContext(context.Background(), req)
+}
+
+// UpdateIdentityPoolWithContext is like UpdateIdentityPool, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoIdentity) UpdateIdentityPoolWithContext(ctx context.Context, req *IdentityPool) (resp *IdentityPool, err error) {
 	resp = &IdentityPool{}
-	err = c.client.Do("UpdateIdentityPool", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateIdentityPool", "POST", "/", req, resp)
 	return
 }
 
@@ -186,16 +319,145 @@ type CreateIdentityPoolInput struct {
 	SupportedLoginProviders        map[string]string `json:"SupportedLoginProviders,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateIdentityPoolInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateIdentityPoolInput to b.
+func (v *CreateIdentityPoolInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AllowUnauthenticatedIdentities\":"...)
+	if v.AllowUnauthenticatedIdentities == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendBool(b, *v.AllowUnauthenticatedIdentities)
+	}
+	b = append(b, ',')
+	if v.DeveloperProviderName != nil {
+		b = append(b, "\"DeveloperProviderName\":"...)
+		b = aws.AppendJSONString(b, *v.DeveloperProviderName)
+		b = append(b, ',')
+	}
+	b = append(b, "\"IdentityPoolName\":"...)
+	if v.IdentityPoolName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolName)
+	}
+	b = append(b, ',')
+	if len(v.OpenIDConnectProviderARNs) != 0 {
+		b = append(b, "\"OpenIdConnectProviderARNs\":"...)
+		b = append(b, '[')
+		for i1 := range v.OpenIDConnectProviderARNs {
+			b = aws.AppendJSONString(b, v.OpenIDConnectProviderARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.SupportedLoginProviders) != 0 {
+		b = append(b, "\"SupportedLoginProviders\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.SupportedLoginProviders))
+		for k := range v.SupportedLoginProviders {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.SupportedLoginProviders[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteIdentityPoolInput is undocumented.
 type DeleteIdentityPoolInput struct {
 	IdentityPoolID aws.StringValue `json:"IdentityPoolId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteIdentityPoolInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteIdentityPoolInput to b.
+func (v *DeleteIdentityPoolInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeIdentityPoolInput is undocumented.
 type DescribeIdentityPoolInput struct {
 	IdentityPoolID aws.StringValue `json:"IdentityPoolId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeIdentityPoolInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeIdentityPoolInput to b.
+func (v *DescribeIdentityPoolInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetIDInput is undocumented.
 type GetIDInput struct {
 	AccountID      aws.StringValue   `json:"AccountId"`
@@ -203,6 +465,62 @@ type GetIDInput struct {
 	Logins         map[string]string `json:"Logins,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetIDInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetIDInput to b.
+func (v *GetIDInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AccountId\":"...)
+	if v.AccountID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AccountID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	if len(v.Logins) != 0 {
+		b = append(b, "\"Logins\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Logins))
+		for k := range v.Logins {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Logins[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetIDResponse is undocumented.
 type GetIDResponse struct {
 	IdentityID aws.StringValue `json:"IdentityId,omitempty"`
@@ -216,6 +534,67 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	TokenDuration  aws.LongValue     `json:"TokenDuration,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetOpenIDTokenForDeveloperIdentityInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetOpenIDTokenForDeveloperIdentityInput to b.
+func (v *GetOpenIDTokenForDeveloperIdentityInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.IdentityID != nil {
+		b = append(b, "\"IdentityId\":"...)
+		b = aws.AppendJSONString(b, *v.IdentityID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Logins\":"...)
+	if v.Logins == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Logins))
+		for k := range v.Logins {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Logins[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.TokenDuration != nil {
+		b = append(b, "\"TokenDuration\":"...)
+		b = strconv.AppendInt(b, int64(*v.TokenDuration), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetOpenIDTokenForDeveloperIdentityResponse is undocumented.
 type GetOpenIDTokenForDeveloperIdentityResponse struct {
 	IdentityID aws.StringValue `json:"IdentityId,omitempty"`
@@ -228,6 +607,55 @@ type GetOpenIDTokenInput struct {
 	Logins     map[string]string `json:"Logins,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetOpenIDTokenInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetOpenIDTokenInput to b.
+func (v *GetOpenIDTokenInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IdentityId\":"...)
+	if v.IdentityID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityID)
+	}
+	b = append(b, ',')
+	if len(v.Logins) != 0 {
+		b = append(b, "\"Logins\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Logins))
+		for k := range v.Logins {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Logins[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetOpenIDTokenResponse is undocumented.
 type GetOpenIDTokenResponse struct {
 	IdentityID aws.StringValue `json:"IdentityId,omitempty"`
@@ -250,6 +678,88 @@ type IdentityPool struct {
 	SupportedLoginProviders        map[string]string `json:"SupportedLoginProviders,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *IdentityPool) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the IdentityPool to b.
+func (v *IdentityPool) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AllowUnauthenticatedIdentities\":"...)
+	if v.AllowUnauthenticatedIdentities == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendBool(b, *v.AllowUnauthenticatedIdentities)
+	}
+	b = append(b, ',')
+	if v.DeveloperProviderName != nil {
+		b = append(b, "\"DeveloperProviderName\":"...)
+		b = aws.AppendJSONString(b, *v.DeveloperProviderName)
+		b = append(b, ',')
+	}
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"IdentityPoolName\":"...)
+	if v.IdentityPoolName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolName)
+	}
+	b = append(b, ',')
+	if len(v.OpenIDConnectProviderARNs) != 0 {
+		b = append(b, "\"OpenIdConnectProviderARNs\":"...)
+		b = append(b, '[')
+		for i1 := range v.OpenIDConnectProviderARNs {
+			b = aws.AppendJSONString(b, v.OpenIDConnectProviderARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.SupportedLoginProviders) != 0 {
+		b = append(b, "\"SupportedLoginProviders\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.SupportedLoginProviders))
+		for k := range v.SupportedLoginProviders {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.SupportedLoginProviders[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // IdentityPoolShortDescription is undocumented.
 type IdentityPoolShortDescription struct {
 	IdentityPoolID   aws.StringValue `json:"IdentityPoolId,omitempty"`
@@ -263,6 +773,45 @@ type ListIdentitiesInput struct {
 	NextToken      aws.StringValue  `json:"NextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListIdentitiesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListIdentitiesInput to b.
+func (v *ListIdentitiesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"MaxResults\":"...)
+	if v.MaxResults == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.MaxResults), 10)
+	}
+	b = append(b, ',')
+	if v.NextToken != nil {
+		b = append(b, "\"NextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListIdentitiesResponse is undocumented.
 type ListIdentitiesResponse struct {
 	Identities     []IdentityDescription `json:"Identities,omitempty"`
@@ -276,6 +825,38 @@ type ListIdentityPoolsInput struct {
 	NextToken  aws.StringValue  `json:"NextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListIdentityPoolsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListIdentityPoolsInput to b.
+func (v *ListIdentityPoolsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"MaxResults\":"...)
+	if v.MaxResults == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.MaxResults), 10)
+	}
+	b = append(b, ',')
+	if v.NextToken != nil {
+		b = append(b, "\"NextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListIdentityPoolsResponse is undocumented.
 type ListIdentityPoolsResponse struct {
 	IdentityPools []IdentityPoolShortDescription `json:"IdentityPools,omitempty"`
@@ -291,6 +872,53 @@ type LookupDeveloperIdentityInput struct {
 	NextToken               aws.StringValue  `json:"NextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *LookupDeveloperIdentityInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the LookupDeveloperIdentityInput to b.
+func (v *LookupDeveloperIdentityInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DeveloperUserIdentifier != nil {
+		b = append(b, "\"DeveloperUserIdentifier\":"...)
+		b = aws.AppendJSONString(b, *v.DeveloperUserIdentifier)
+		b = append(b, ',')
+	}
+	if v.IdentityID != nil {
+		b = append(b, "\"IdentityId\":"...)
+		b = aws.AppendJSONString(b, *v.IdentityID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	if v.MaxResults != nil {
+		b = append(b, "\"MaxResults\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaxResults), 10)
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"NextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // LookupDeveloperIdentityResponse is undocumented.
 type LookupDeveloperIdentityResponse struct {
 	DeveloperUserIdentifierList []string        `json:"DeveloperUserIdentifierList,omitempty"`
@@ -306,6 +934,54 @@ type MergeDeveloperIdentitiesInput struct {
 	SourceUserIdentifier      aws.StringValue `json:"SourceUserIdentifier"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *MergeDeveloperIdentitiesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the MergeDeveloperIdentitiesInput to b.
+func (v *MergeDeveloperIdentitiesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DestinationUserIdentifier\":"...)
+	if v.DestinationUserIdentifier == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DestinationUserIdentifier)
+	}
+	b = append(b, ',')
+	b = append(b, "\"DeveloperProviderName\":"...)
+	if v.DeveloperProviderName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeveloperProviderName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"SourceUserIdentifier\":"...)
+	if v.SourceUserIdentifier == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.SourceUserIdentifier)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // MergeDeveloperIdentitiesResponse is undocumented.
 type MergeDeveloperIdentitiesResponse struct {
 	IdentityID aws.StringValue `json:"IdentityId,omitempty"`
@@ -319,6 +995,54 @@ type UnlinkDeveloperIdentityInput struct {
 	IdentityPoolID          aws.StringValue `json:"IdentityPoolId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UnlinkDeveloperIdentityInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UnlinkDeveloperIdentityInput to b.
+func (v *UnlinkDeveloperIdentityInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DeveloperProviderName\":"...)
+	if v.DeveloperProviderName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeveloperProviderName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"DeveloperUserIdentifier\":"...)
+	if v.DeveloperUserIdentifier == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeveloperUserIdentifier)
+	}
+	b = append(b, ',')
+	b = append(b, "\"IdentityId\":"...)
+	if v.IdentityID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"IdentityPoolId\":"...)
+	if v.IdentityPoolID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityPoolID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UnlinkIdentityInput is undocumented.
 type UnlinkIdentityInput struct {
 	IdentityID     aws.StringValue   `json:"IdentityId"`
@@ -326,5 +1050,127 @@ type UnlinkIdentityInput struct {
 	LoginsToRemove []string          `json:"LoginsToRemove"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UnlinkIdentityInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UnlinkIdentityInput to b.
+func (v *UnlinkIdentityInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IdentityId\":"...)
+	if v.IdentityID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IdentityID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Logins\":"...)
+	if v.Logins == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Logins))
+		for k := range v.Logins {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Logins[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"LoginsToRemove\":"...)
+	if v.LoginsToRemove == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.LoginsToRemove {
+			b = aws.AppendJSONString(b, v.LoginsToRemove[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
+// DeveloperUserAlreadyRegisteredException is the error returned for the DeveloperUserAlreadyRegisteredException error code.
+type DeveloperUserAlreadyRegisteredException struct {
+	aws.APIError
+}
+
+// InternalErrorException is the error returned for the InternalErrorException error code.
+type InternalErrorException struct {
+	aws.APIError
+}
+
+// InvalidParameterException is the error returned for the InvalidParameterException error code.
+type InvalidParameterException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// NotAuthorizedException is the error returned for the NotAuthorizedException error code.
+type NotAuthorizedException struct {
+	aws.APIError
+}
+
+// ResourceConflictException is the error returned for the ResourceConflictException error code.
+type ResourceConflictException struct {
+	aws.APIError
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// TooManyRequestsException is the error returned for the TooManyRequestsException error code.
+type TooManyRequestsException struct {
+	aws.APIError
+}
+
+// exceptions maps CognitoIdentity's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"DeveloperUserAlreadyRegisteredException": DeveloperUserAlreadyRegisteredException{},
+	"InternalErrorException":                  InternalErrorException{},
+	"InvalidParameterException":               InvalidParameterException{},
+	"LimitExceededException":                  LimitExceededException{},
+	"NotAuthorizedException":                  NotAuthorizedException{},
+	"ResourceConflictException":               ResourceConflictException{},
+	"ResourceNotFoundException":               ResourceNotFoundException{},
+	"TooManyRequestsException":                TooManyRequestsException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -4,15 +4,18 @@
 package cognitosync
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
 )
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,63 +30,258 @@ type CognitoSync struct {
 	client *aws.RestClient
 }
 
-// New returns a new CognitoSync client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *CognitoSync {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "cognito-sync"
-	endpoint, service, region := endpoints.Lookup("cognito-sync", region)
+// New returns a new CognitoSync client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *CognitoSync {
+	cfg := newConfig(region, client, opts)
 
 	return &CognitoSync{
 		client: &aws.RestClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2014-06-30",
 		},
 	}
 }
 
+// NewFromSession returns a new CognitoSync client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *CognitoSync {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("cognito-sync", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("cognito-sync", "cognitosync"),
+	}, "AWS_ENDPOINT_URL_COGNITO_SYNC", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *CognitoSync) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *CognitoSync) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *CognitoSync) SetPayloadSigning(p aws.PayloadSigning) {
+	c.client.Context.PayloadSigning = p
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *CognitoSync) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch op {
+
+	case "DeleteDataset":
+
+		r, ok := req.(*DeleteDatasetRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: DeleteDataset takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteDatasetRequest(context.Background(), r)
+
+	case "DescribeDataset":
+
+		r, ok := req.(*DescribeDatasetRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: DescribeDataset takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDescribeDatasetRequest(context.Background(), r)
+
+	case "DescribeIdentityPoolUsage":
+
+		r, ok := req.(*DescribeIdentityPoolUsageRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: DescribeIdentityPoolUsage takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDescribeIdentityPoolUsageRequest(context.Background(), r)
+
+	case "DescribeIdentityUsage":
+
+		r, ok := req.(*DescribeIdentityUsageRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: DescribeIdentityUsage takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDescribeIdentityUsageRequest(context.Background(), r)
+
+	case "GetIdentityPoolConfiguration":
+
+		r, ok := req.(*GetIdentityPoolConfigurationRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: GetIdentityPoolConfiguration takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetIdentityPoolConfigurationRequest(context.Background(), r)
+
+	case "ListDatasets":
+
+		r, ok := req.(*ListDatasetsRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: ListDatasets takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListDatasetsRequest(context.Background(), r)
+
+	case "ListIdentityPoolUsage":
+
+		r, ok := req.(*ListIdentityPoolUsageRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: ListIdentityPoolUsage takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListIdentityPoolUsageRequest(context.Background(), r)
+
+	case "ListRecords":
+
+		r, ok := req.(*ListRecordsRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: ListRecords takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListRecordsRequest(context.Background(), r)
+
+	case "RegisterDevice":
+
+		r, ok := req.(*RegisterDeviceRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: RegisterDevice takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newRegisterDeviceRequest(context.Background(), r)
+
+	case "SetIdentityPoolConfiguration":
+
+		r, ok := req.(*SetIdentityPoolConfigurationRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: SetIdentityPoolConfiguration takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newSetIdentityPoolConfigurationRequest(context.Background(), r)
+
+	case "SubscribeToDataset":
+
+		r, ok := req.(*SubscribeToDatasetRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: SubscribeToDataset takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newSubscribeToDatasetRequest(context.Background(), r)
+
+	case "UnsubscribeFromDataset":
+
+		r, ok := req.(*UnsubscribeFromDatasetRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: UnsubscribeFromDataset takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUnsubscribeFromDatasetRequest(context.Background(), r)
+
+	case "UpdateRecords":
+
+		r, ok := req.(*UpdateRecordsRequest)
+		if !ok {
+			return "", fmt.Errorf("cognitosync: UpdateRecords takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdateRecordsRequest(context.Background(), r)
+
+	default:
+		return "", fmt.Errorf("cognitosync: unknown operation %q", op)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
 // DeleteDataset deletes the specific dataset. The dataset will be deleted
 // permanently, and the action can't be undone. Datasets that this dataset
 // was merged with will no longer report the merge. Any consequent
 // operation on this dataset will result in a ResourceNotFoundException.
 func (c *CognitoSync) DeleteDataset(req *DeleteDatasetRequest) (resp *DeleteDatasetResponse, err error) {
+	return c.DeleteDatasetWithContext(context.Background(), req)
+}
+
+// DeleteDatasetWithContext is like DeleteDataset, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) DeleteDatasetWithContext(ctx context.Context, req *DeleteDatasetRequest) (resp *DeleteDatasetResponse, err error) {
 	resp = &DeleteDatasetResponse{}
 
+	httpReq, err := c.newDeleteDatasetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteDataset", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newDeleteDatasetRequest builds the HTTP request for DeleteDataset.
+func (c *CognitoSync) newDeleteDatasetRequest(ctx context.Context, req *DeleteDatasetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}/datasets/{DatasetName}"
 
 	if req.DatasetName != nil {
-		uri = strings.Replace(uri, "{"+"DatasetName"+"}", *req.DatasetName, -1)
-		uri = strings.Replace(uri, "{"+"DatasetName+"+"}", *req.DatasetName, -1)
+		uri = strings.Replace(uri, "{DatasetName}", aws.EscapePath(*req.DatasetName, false), -1)
+		uri = strings.Replace(uri, "{DatasetName+}", aws.EscapePath(*req.DatasetName, true), -1)
 	}
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -92,7 +290,30 @@ func (c *CognitoSync) DeleteDataset(req *DeleteDatasetRequest) (resp *DeleteData
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DescribeDataset gets metadata about a dataset by identity and dataset
+// name. The credentials used to make this API call need to have access to
+// the identity data. With Amazon Cognito Sync, each identity has access
+// only to its own data. You should use Amazon Cognito Identity service to
+// retrieve the credentials necessary to make this API call.
+func (c *CognitoSync) DescribeDataset(req *DescribeDatasetRequest) (resp *DescribeDatasetResponse, err error) {
+	return c.DescribeDatasetWithContext(context.Background(), req)
+}
+
+// DescribeDatasetWithContext is like DescribeDataset, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) DescribeDatasetWithContext(ctx context.Context, req *DescribeDatasetRequest) (resp *DescribeDatasetResponse, err error) {
+	resp = &DescribeDatasetResponse{}
+
+	httpReq, err := c.newDescribeDatasetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DescribeDataset", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -107,41 +328,39 @@ func (c *CognitoSync) DeleteDataset(req *DeleteDatasetRequest) (resp *DeleteData
 	return
 }
 
-// DescribeDataset gets metadata about a dataset by identity and dataset
-// name. The credentials used to make this API call need to have access to
-// the identity data. With Amazon Cognito Sync, each identity has access
-// only to its own data. You should use Amazon Cognito Identity service to
-// retrieve the credentials necessary to make this API call.
-func (c *CognitoSync) DescribeDataset(req *DescribeDatasetRequest) (resp *DescribeDatasetResponse, err error) {
-	resp = &DescribeDatasetResponse{}
-
+// newDescribeDatasetRequest builds the HTTP request for DescribeDataset.
+func (c *CognitoSync) newDescribeDatasetRequest(ctx context.Context, req *DescribeDatasetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}/datasets/{DatasetName}"
 
 	if req.DatasetName != nil {
-		uri = strings.Replace(uri, "{"+"DatasetName"+"}", *req.DatasetName, -1)
-		uri = strings.Replace(uri, "{"+"DatasetName+"+"}", *req.DatasetName, -1)
+		uri = strings.Replace(uri, "{DatasetName}", aws.EscapePath(*req.DatasetName, false), -1)
+		uri = strings.Replace(uri, "{DatasetName+}", aws.EscapePath(*req.DatasetName, true), -1)
 	}
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -150,7 +369,27 @@ func (c *CognitoSync) DescribeDataset(req *DescribeDatasetRequest) (resp *Descri
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DescribeIdentityPoolUsage gets usage details (for example, data storage)
+// about a particular identity pool.
+func (c *CognitoSync) DescribeIdentityPoolUsage(req *DescribeIdentityPoolUsageRequest) (resp *DescribeIdentityPoolUsageResponse, err error) {
+	return c.DescribeIdentityPoolUsageWithContext(context.Background(), req)
+}
+
+// DescribeIdentityPoolUsageWithContext is like DescribeIdentityPoolUsage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) DescribeIdentityPoolUsageWithContext(ctx context.Context, req *DescribeIdentityPoolUsageRequest) (resp *DescribeIdentityPoolUsageResponse, err error) {
+	resp = &DescribeIdentityPoolUsageResponse{}
+
+	httpReq, err := c.newDescribeIdentityPoolUsageRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DescribeIdentityPoolUsage", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -165,28 +404,29 @@ func (c *CognitoSync) DescribeDataset(req *DescribeDatasetRequest) (resp *Descri
 	return
 }
 
-// DescribeIdentityPoolUsage gets usage details (for example, data storage)
-// about a particular identity pool.
-func (c *CognitoSync) DescribeIdentityPoolUsage(req *DescribeIdentityPoolUsageRequest) (resp *DescribeIdentityPoolUsageResponse, err error) {
-	resp = &DescribeIdentityPoolUsageResponse{}
-
+// newDescribeIdentityPoolUsageRequest builds the HTTP request for DescribeIdentityPoolUsage.
+func (c *CognitoSync) newDescribeIdentityPoolUsageRequest(ctx context.Context, req *DescribeIdentityPoolUsageRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}"
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -195,7 +435,27 @@ func (c *CognitoSync) DescribeIdentityPoolUsage(req *DescribeIdentityPoolUsageRe
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DescribeIdentityUsage gets usage information for an identity, including
+// number of datasets and data usage.
+func (c *CognitoSync) DescribeIdentityUsage(req *DescribeIdentityUsageRequest) (resp *DescribeIdentityUsageResponse, err error) {
+	return c.DescribeIdentityUsageWithContext(context.Background(), req)
+}
+
+// DescribeIdentityUsageWithContext is like DescribeIdentityUsage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) DescribeIdentityUsageWithContext(ctx context.Context, req *DescribeIdentityUsageRequest) (resp *DescribeIdentityUsageResponse, err error) {
+	resp = &DescribeIdentityUsageResponse{}
+
+	httpReq, err := c.newDescribeIdentityUsageRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DescribeIdentityUsage", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -210,33 +470,34 @@ func (c *CognitoSync) DescribeIdentityPoolUsage(req *DescribeIdentityPoolUsageRe
 	return
 }
 
-// DescribeIdentityUsage gets usage information for an identity, including
-// number of datasets and data usage.
-func (c *CognitoSync) DescribeIdentityUsage(req *DescribeIdentityUsageRequest) (resp *DescribeIdentityUsageResponse, err error) {
-	resp = &DescribeIdentityUsageResponse{}
-
+// newDescribeIdentityUsageRequest builds the HTTP request for DescribeIdentityUsage.
+func (c *CognitoSync) newDescribeIdentityUsageRequest(ctx context.Context, req *DescribeIdentityUsageRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}"
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -245,7 +506,27 @@ func (c *CognitoSync) DescribeIdentityUsage(req *DescribeIdentityUsageRequest) (
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetIdentityPoolConfiguration gets the configuration settings of an
+// identity pool.
+func (c *CognitoSync) GetIdentityPoolConfiguration(req *GetIdentityPoolConfigurationRequest) (resp *GetIdentityPoolConfigurationResponse, err error) {
+	return c.GetIdentityPoolConfigurationWithContext(context.Background(), req)
+}
+
+// GetIdentityPoolConfigurationWithContext is like GetIdentityPoolConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) GetIdentityPoolConfigurationWithContext(ctx context.Context, req *GetIdentityPoolConfigurationRequest) (resp *GetIdentityPoolConfigurationResponse, err error) {
+	resp = &GetIdentityPoolConfigurationResponse{}
+
+	httpReq, err := c.newGetIdentityPoolConfigurationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetIdentityPoolConfiguration", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -260,28 +541,29 @@ func (c *CognitoSync) DescribeIdentityUsage(req *DescribeIdentityUsageRequest) (
 	return
 }
 
-// GetIdentityPoolConfiguration gets the configuration settings of an
-// identity pool.
-func (c *CognitoSync) GetIdentityPoolConfiguration(req *GetIdentityPoolConfigurationRequest) (resp *GetIdentityPoolConfigurationResponse, err error) {
-	resp = &GetIdentityPoolConfigurationResponse{}
-
+// newGetIdentityPoolConfigurationRequest builds the HTTP request for GetIdentityPoolConfiguration.
+func (c *CognitoSync) newGetIdentityPoolConfigurationRequest(ctx context.Context, req *GetIdentityPoolConfigurationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/configuration"
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -290,7 +572,30 @@ func (c *CognitoSync) GetIdentityPoolConfiguration(req *GetIdentityPoolConfigura
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListDatasets lists datasets for an identity. The credentials used to
+// make this API call need to have access to the identity data. With Amazon
+// Cognito Sync, each identity has access only to its own data. You should
+// use Amazon Cognito Identity service to retrieve the credentials
+// necessary to make this API call.
+func (c *CognitoSync) ListDatasets(req *ListDatasetsRequest) (resp *ListDatasetsResponse, err error) {
+	return c.ListDatasetsWithContext(context.Background(), req)
+}
+
+// ListDatasetsWithContext is like ListDatasets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) ListDatasetsWithContext(ctx context.Context, req *ListDatasetsRequest) (resp *ListDatasetsResponse, err error) {
+	resp = &ListDatasetsResponse{}
+
+	httpReq, err := c.newListDatasetsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListDatasets", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -305,27 +610,21 @@ func (c *CognitoSync) GetIdentityPoolConfiguration(req *GetIdentityPoolConfigura
 	return
 }
 
-// ListDatasets lists datasets for an identity. The credentials used to
-// make this API call need to have access to the identity data. With Amazon
-// Cognito Sync, each identity has access only to its own data. You should
-// use Amazon Cognito Identity service to retrieve the credentials
-// necessary to make this API call.
-func (c *CognitoSync) ListDatasets(req *ListDatasetsRequest) (resp *ListDatasetsResponse, err error) {
-	resp = &ListDatasetsResponse{}
-
+// newListDatasetsRequest builds the HTTP request for ListDatasets.
+func (c *CognitoSync) newListDatasetsRequest(ctx context.Context, req *ListDatasetsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}/datasets"
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
@@ -339,10 +638,14 @@ func (c *CognitoSync) ListDatasets(req *ListDatasetsRequest) (resp *ListDatasets
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -351,7 +654,27 @@ func (c *CognitoSync) ListDatasets(req *ListDatasetsRequest) (resp *ListDatasets
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListIdentityPoolUsage gets a list of identity pools registered with
+// Cognito.
+func (c *CognitoSync) ListIdentityPoolUsage(req *ListIdentityPoolUsageRequest) (resp *ListIdentityPoolUsageResponse, err error) {
+	return c.ListIdentityPoolUsageWithContext(context.Background(), req)
+}
+
+// ListIdentityPoolUsageWithContext is like ListIdentityPoolUsage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) ListIdentityPoolUsageWithContext(ctx context.Context, req *ListIdentityPoolUsageRequest) (resp *ListIdentityPoolUsageResponse, err error) {
+	resp = &ListIdentityPoolUsageResponse{}
+
+	httpReq, err := c.newListIdentityPoolUsageRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListIdentityPoolUsage", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -366,11 +689,8 @@ func (c *CognitoSync) ListDatasets(req *ListDatasetsRequest) (resp *ListDatasets
 	return
 }
 
-// ListIdentityPoolUsage gets a list of identity pools registered with
-// Cognito.
-func (c *CognitoSync) ListIdentityPoolUsage(req *ListIdentityPoolUsageRequest) (resp *ListIdentityPoolUsageResponse, err error) {
-	resp = &ListIdentityPoolUsageResponse{}
-
+// newListIdentityPoolUsageRequest builds the HTTP request for ListIdentityPoolUsage.
+func (c *CognitoSync) newListIdentityPoolUsageRequest(ctx context.Context, req *ListIdentityPoolUsageRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -387,10 +707,14 @@ func (c *CognitoSync) ListIdentityPoolUsage(req *ListIdentityPoolUsageRequest) (
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -399,7 +723,31 @@ func (c *CognitoSync) ListIdentityPoolUsage(req *ListIdentityPoolUsageRequest) (
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListRecords gets paginated records, optionally changed after a
+// particular sync count for a dataset and identity. The credentials used
+// to make this API call need to have access to the identity data. With
+// Amazon Cognito Sync, each identity has access only to its own data. You
+// should use Amazon Cognito Identity service to retrieve the credentials
+// necessary to make this API call.
+func (c *CognitoSync) ListRecords(req *ListRecordsRequest) (resp *ListRecordsResponse, err error) {
+	return c.ListRecordsWithContext(context.Background(), req)
+}
+
+// ListRecordsWithContext is like ListRecords, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) ListRecordsWithContext(ctx context.Context, req *ListRecordsRequest) (resp *ListRecordsResponse, err error) {
+	resp = &ListRecordsResponse{}
+
+	httpReq, err := c.newListRecordsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListRecords", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -414,39 +762,32 @@ func (c *CognitoSync) ListIdentityPoolUsage(req *ListIdentityPoolUsageRequest) (
 	return
 }
 
-// ListRecords gets paginated records, optionally changed after a
-// particular sync count for a dataset and identity. The credentials used
-// to make this API call need to have access to the identity data. With
-// Amazon Cognito Sync, each identity has access only to its own data. You
-// should use Amazon Cognito Identity service to retrieve the credentials
-// necessary to make this API call.
-func (c *CognitoSync) ListRecords(req *ListRecordsRequest) (resp *ListRecordsResponse, err error) {
-	resp = &ListRecordsResponse{}
-
+// newListRecordsRequest builds the HTTP request for ListRecords.
+func (c *CognitoSync) newListRecordsRequest(ctx context.Context, req *ListRecordsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}/datasets/{DatasetName}/records"
 
 	if req.DatasetName != nil {
-		uri = strings.Replace(uri, "{"+"DatasetName"+"}", *req.DatasetName, -1)
-		uri = strings.Replace(uri, "{"+"DatasetName+"+"}", *req.DatasetName, -1)
+		uri = strings.Replace(uri, "{DatasetName}", aws.EscapePath(*req.DatasetName, false), -1)
+		uri = strings.Replace(uri, "{DatasetName+}", aws.EscapePath(*req.DatasetName, true), -1)
 	}
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if req.LastSyncCount != nil {
-		q.Set("lastSyncCount", fmt.Sprintf("%v", req.LastSyncCount))
+		q.Set("lastSyncCount", strconv.FormatInt(*req.LastSyncCount, 10))
 	}
 
 	if req.MaxResults != nil {
@@ -462,10 +803,14 @@ func (c *CognitoSync) ListRecords(req *ListRecordsRequest) (resp *ListRecordsRes
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -474,7 +819,26 @@ func (c *CognitoSync) ListRecords(req *ListRecordsRequest) (resp *ListRecordsRes
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// RegisterDevice registers a device to receive push sync notifications.
+func (c *CognitoSync) RegisterDevice(req *RegisterDeviceRequest) (resp *RegisterDeviceResponse, err error) {
+	return c.RegisterDeviceWithContext(context.Background(), req)
+}
+
+// RegisterDeviceWithContext is like RegisterDevice, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) RegisterDeviceWithContext(ctx context.Context, req *RegisterDeviceRequest) (resp *RegisterDeviceResponse, err error) {
+	resp = &RegisterDeviceResponse{}
+
+	httpReq, err := c.newRegisterDeviceRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("RegisterDevice", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -489,32 +853,34 @@ func (c *CognitoSync) ListRecords(req *ListRecordsRequest) (resp *ListRecordsRes
 	return
 }
 
-// RegisterDevice registers a device to receive push sync notifications.
-func (c *CognitoSync) RegisterDevice(req *RegisterDeviceRequest) (resp *RegisterDeviceResponse, err error) {
-	resp = &RegisterDeviceResponse{}
-
+// newRegisterDeviceRequest builds the HTTP request for RegisterDevice.
+func (c *CognitoSync) newRegisterDeviceRequest(ctx context.Context, req *RegisterDeviceRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identity/{IdentityId}/device"
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -523,7 +889,26 @@ func (c *CognitoSync) RegisterDevice(req *RegisterDeviceRequest) (resp *Register
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// SetIdentityPoolConfiguration is undocumented.
+func (c *CognitoSync) SetIdentityPoolConfiguration(req *SetIdentityPoolConfigurationRequest) (resp *SetIdentityPoolConfigurationResponse, err error) {
+	return c.SetIdentityPoolConfigurationWithContext(context.Background(), req)
+}
+
+// SetIdentityPoolConfigurationWithContext is like SetIdentityPoolConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) SetIdentityPoolConfigurationWithContext(ctx context.Context, req *SetIdentityPoolConfigurationRequest) (resp *SetIdentityPoolConfigurationResponse, err error) {
+	resp = &SetIdentityPoolConfigurationResponse{}
+
+	httpReq, err := c.newSetIdentityPoolConfigurationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("SetIdentityPoolConfiguration", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -538,27 +923,29 @@ func (c *CognitoSync) RegisterDevice(req *RegisterDeviceRequest) (resp *Register
 	return
 }
 
-// SetIdentityPoolConfiguration is undocumented.
-func (c *CognitoSync) SetIdentityPoolConfiguration(req *SetIdentityPoolConfigurationRequest) (resp *SetIdentityPoolConfigurationResponse, err error) {
-	resp = &SetIdentityPoolConfigurationResponse{}
-
+// newSetIdentityPoolConfigurationRequest builds the HTTP request for SetIdentityPoolConfiguration.
+func (c *CognitoSync) newSetIdentityPoolConfigurationRequest(ctx context.Context, req *SetIdentityPoolConfigurationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/configuration"
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -567,7 +954,27 @@ func (c *CognitoSync) SetIdentityPoolConfiguration(req *SetIdentityPoolConfigura
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// SubscribeToDataset subscribes to receive notifications when a dataset is
+// modified by another device.
+func (c *CognitoSync) SubscribeToDataset(req *SubscribeToDatasetRequest) (resp *SubscribeToDatasetResponse, err error) {
+	return c.SubscribeToDatasetWithContext(context.Background(), req)
+}
+
+// SubscribeToDatasetWithContext is like SubscribeToDataset, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) SubscribeToDatasetWithContext(ctx context.Context, req *SubscribeToDatasetRequest) (resp *SubscribeToDatasetResponse, err error) {
+	resp = &SubscribeToDatasetResponse{}
+
+	httpReq, err := c.newSubscribeToDatasetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("SubscribeToDataset", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -582,43 +989,44 @@ func (c *CognitoSync) SetIdentityPoolConfiguration(req *SetIdentityPoolConfigura
 	return
 }
 
-// SubscribeToDataset subscribes to receive notifications when a dataset is
-// modified by another device.
-func (c *CognitoSync) SubscribeToDataset(req *SubscribeToDatasetRequest) (resp *SubscribeToDatasetResponse, err error) {
-	resp = &SubscribeToDatasetResponse{}
-
+// newSubscribeToDatasetRequest builds the HTTP request for SubscribeToDataset.
+func (c *CognitoSync) newSubscribeToDatasetRequest(ctx context.Context, req *SubscribeToDatasetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}/datasets/{DatasetName}/subscriptions/{DeviceId}"
 
 	if req.DatasetName != nil {
-		uri = strings.Replace(uri, "{"+"DatasetName"+"}", *req.DatasetName, -1)
-		uri = strings.Replace(uri, "{"+"DatasetName+"+"}", *req.DatasetName, -1)
+		uri = strings.Replace(uri, "{DatasetName}", aws.EscapePath(*req.DatasetName, false), -1)
+		uri = strings.Replace(uri, "{DatasetName+}", aws.EscapePath(*req.DatasetName, true), -1)
 	}
 
 	if req.DeviceID != nil {
-		uri = strings.Replace(uri, "{"+"DeviceId"+"}", *req.DeviceID, -1)
-		uri = strings.Replace(uri, "{"+"DeviceId+"+"}", *req.DeviceID, -1)
+		uri = strings.Replace(uri, "{DeviceId}", aws.EscapePath(*req.DeviceID, false), -1)
+		uri = strings.Replace(uri, "{DeviceId+}", aws.EscapePath(*req.DeviceID, true), -1)
 	}
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -627,7 +1035,27 @@ func (c *CognitoSync) SubscribeToDataset(req *SubscribeToDatasetRequest) (resp *
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UnsubscribeFromDataset unsubscribe from receiving notifications when a
+// dataset is modified by another device.
+func (c *CognitoSync) UnsubscribeFromDataset(req *UnsubscribeFromDatasetRequest) (resp *UnsubscribeFromDatasetResponse, err error) {
+	return c.UnsubscribeFromDatasetWithContext(context.Background(), req)
+}
+
+// UnsubscribeFromDatasetWithContext is like UnsubscribeFromDataset, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) UnsubscribeFromDatasetWithContext(ctx context.Context, req *UnsubscribeFromDatasetRequest) (resp *UnsubscribeFromDatasetResponse, err error) {
+	resp = &UnsubscribeFromDatasetResponse{}
+
+	httpReq, err := c.newUnsubscribeFromDatasetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UnsubscribeFromDataset", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -642,43 +1070,44 @@ func (c *CognitoSync) SubscribeToDataset(req *SubscribeToDatasetRequest) (resp *
 	return
 }
 
-// UnsubscribeFromDataset unsubscribe from receiving notifications when a
-// dataset is modified by another device.
-func (c *CognitoSync) UnsubscribeFromDataset(req *UnsubscribeFromDatasetRequest) (resp *UnsubscribeFromDatasetResponse, err error) {
-	resp = &UnsubscribeFromDatasetResponse{}
-
+// newUnsubscribeFromDatasetRequest builds the HTTP request for UnsubscribeFromDataset.
+func (c *CognitoSync) newUnsubscribeFromDatasetRequest(ctx context.Context, req *UnsubscribeFromDatasetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}/datasets/{DatasetName}/subscriptions/{DeviceId}"
 
 	if req.DatasetName != nil {
-		uri = strings.Replace(uri, "{"+"DatasetName"+"}", *req.DatasetName, -1)
-		uri = strings.Replace(uri, "{"+"DatasetName+"+"}", *req.DatasetName, -1)
+		uri = strings.Replace(uri, "{DatasetName}", aws.EscapePath(*req.DatasetName, false), -1)
+		uri = strings.Replace(uri, "{DatasetName+}", aws.EscapePath(*req.DatasetName, true), -1)
 	}
 
 	if req.DeviceID != nil {
-		uri = strings.Replace(uri, "{"+"DeviceId"+"}", *req.DeviceID, -1)
-		uri = strings.Replace(uri, "{"+"DeviceId+"+"}", *req.DeviceID, -1)
+		uri = strings.Replace(uri, "{DeviceId}", aws.EscapePath(*req.DeviceID, false), -1)
+		uri = strings.Replace(uri, "{DeviceId+}", aws.EscapePath(*req.DeviceID, true), -1)
 	}
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -687,7 +1116,31 @@ func (c *CognitoSync) UnsubscribeFromDataset(req *UnsubscribeFromDatasetRequest)
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdateRecords posts updates to records and add and delete records for a
+// dataset and user. The credentials used to make this API call need to
+// have access to the identity data. With Amazon Cognito Sync, each
+// identity has access only to its own data. You should use Amazon Cognito
+// Identity service to retrieve the credentials necessary to make this API
+// call.
+func (c *CognitoSync) UpdateRecords(req *UpdateRecordsRequest) (resp *UpdateRecordsResponse, err error) {
+	return c.UpdateRecordsWithContext(context.Background(), req)
+}
+
+// UpdateRecordsWithContext is like UpdateRecords, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *CognitoSync) UpdateRecordsWithContext(ctx context.Context, req *UpdateRecordsRequest) (resp *UpdateRecordsResponse, err error) {
+	resp = &UpdateRecordsResponse{}
+
+	httpReq, err := c.newUpdateRecordsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdateRecords", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -702,42 +1155,39 @@ func (c *CognitoSync) UnsubscribeFromDataset(req *UnsubscribeFromDatasetRequest)
 	return
 }
 
-// UpdateRecords posts updates to records and add and delete records for a
-// dataset and user. The credentials used to make this API call need to
-// have access to the identity data. With Amazon Cognito Sync, each
-// identity has access only to its own data. You should use Amazon Cognito
-// Identity service to retrieve the credentials necessary to make this API
-// call.
-func (c *CognitoSync) UpdateRecords(req *UpdateRecordsRequest) (resp *UpdateRecordsResponse, err error) {
-	resp = &UpdateRecordsResponse{}
-
+// newUpdateRecordsRequest builds the HTTP request for UpdateRecords.
+func (c *CognitoSync) newUpdateRecordsRequest(ctx context.Context, req *UpdateRecordsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/identitypools/{IdentityPoolId}/identities/{IdentityId}/datasets/{DatasetName}"
 
 	if req.DatasetName != nil {
-		uri = strings.Replace(uri, "{"+"DatasetName"+"}", *req.DatasetName, -1)
-		uri = strings.Replace(uri, "{"+"DatasetName+"+"}", *req.DatasetName, -1)
+		uri = strings.Replace(uri, "{DatasetName}", aws.EscapePath(*req.DatasetName, false), -1)
+		uri = strings.Replace(uri, "{DatasetName+}", aws.EscapePath(*req.DatasetName, true), -1)
 	}
 
 	if req.IdentityID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityId"+"}", *req.IdentityID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityId+"+"}", *req.IdentityID, -1)
+		uri = strings.Replace(uri, "{IdentityId}", aws.EscapePath(*req.IdentityID, false), -1)
+		uri = strings.Replace(uri, "{IdentityId+}", aws.EscapePath(*req.IdentityID, true), -1)
 	}
 
 	if req.IdentityPoolID != nil {
-		uri = strings.Replace(uri, "{"+"IdentityPoolId"+"}", *req.IdentityPoolID, -1)
-		uri = strings.Replace(uri, "{"+"IdentityPoolId+"+"}", *req.IdentityPoolID, -1)
+		uri = strings.Replace(uri, "{IdentityPoolId}", aws.EscapePath(*req.IdentityPoolID, false), -1)
+		uri = strings.Replace(uri, "{IdentityPoolId+}", aws.EscapePath(*req.IdentityPoolID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -750,30 +1200,18 @@ func (c *CognitoSync) UpdateRecords(req *UpdateRecordsRequest) (resp *UpdateReco
 		httpReq.Header.Set("x-amz-Client-Context", *req.ClientContext)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
 // Dataset is undocumented.
 type Dataset struct {
-	CreationDate     time.Time       `json:"CreationDate,omitempty"`
-	DataStorage      aws.LongValue   `json:"DataStorage,omitempty"`
-	DatasetName      aws.StringValue `json:"DatasetName,omitempty"`
-	IdentityID       aws.StringValue `json:"IdentityId,omitempty"`
-	LastModifiedBy   aws.StringValue `json:"LastModifiedBy,omitempty"`
-	LastModifiedDate time.Time       `json:"LastModifiedDate,omitempty"`
-	NumRecords       aws.LongValue   `json:"NumRecords,omitempty"`
+	CreationDate     *aws.FloatTimestamp `json:"CreationDate,omitempty"`
+	DataStorage      aws.LongValue       `json:"DataStorage,omitempty"`
+	DatasetName      aws.StringValue     `json:"DatasetName,omitempty"`
+	IdentityID       aws.StringValue     `json:"IdentityId,omitempty"`
+	LastModifiedBy   aws.StringValue     `json:"LastModifiedBy,omitempty"`
+	LastModifiedDate *aws.FloatTimestamp `json:"LastModifiedDate,omitempty"`
+	NumRecords       aws.LongValue       `json:"NumRecords,omitempty"`
 }
 
 // DeleteDatasetRequest is undocumented.
@@ -834,19 +1272,19 @@ type GetIdentityPoolConfigurationResponse struct {
 
 // IdentityPoolUsage is undocumented.
 type IdentityPoolUsage struct {
-	DataStorage       aws.LongValue   `json:"DataStorage,omitempty"`
-	IdentityPoolID    aws.StringValue `json:"IdentityPoolId,omitempty"`
-	LastModifiedDate  time.Time       `json:"LastModifiedDate,omitempty"`
-	SyncSessionsCount aws.LongValue   `json:"SyncSessionsCount,omitempty"`
+	DataStorage       aws.LongValue       `json:"DataStorage,omitempty"`
+	IdentityPoolID    aws.StringValue     `json:"IdentityPoolId,omitempty"`
+	LastModifiedDate  *aws.FloatTimestamp `json:"LastModifiedDate,omitempty"`
+	SyncSessionsCount aws.LongValue       `json:"SyncSessionsCount,omitempty"`
 }
 
 // IdentityUsage is undocumented.
 type IdentityUsage struct {
-	DataStorage      aws.LongValue    `json:"DataStorage,omitempty"`
-	DatasetCount     aws.IntegerValue `json:"DatasetCount,omitempty"`
-	IdentityID       aws.StringValue  `json:"IdentityId,omitempty"`
-	IdentityPoolID   aws.StringValue  `json:"IdentityPoolId,omitempty"`
-	LastModifiedDate time.Time        `json:"LastModifiedDate,omitempty"`
+	DataStorage      aws.LongValue       `json:"DataStorage,omitempty"`
+	DatasetCount     aws.IntegerValue    `json:"DatasetCount,omitempty"`
+	IdentityID       aws.StringValue     `json:"IdentityId,omitempty"`
+	IdentityPoolID   aws.StringValue     `json:"IdentityPoolId,omitempty"`
+	LastModifiedDate *aws.FloatTimestamp `json:"LastModifiedDate,omitempty"`
 }
 
 // ListDatasetsRequest is undocumented.
@@ -924,21 +1362,21 @@ type PushSync struct {
 
 // Record is undocumented.
 type Record struct {
-	DeviceLastModifiedDate time.Time       `json:"DeviceLastModifiedDate,omitempty"`
-	Key                    aws.StringValue `json:"Key,omitempty"`
-	LastModifiedBy         aws.StringValue `json:"LastModifiedBy,omitempty"`
-	LastModifiedDate       time.Time       `json:"LastModifiedDate,omitempty"`
-	SyncCount              aws.LongValue   `json:"SyncCount,omitempty"`
-	Value                  aws.StringValue `json:"Value,omitempty"`
+	DeviceLastModifiedDate *aws.FloatTimestamp `json:"DeviceLastModifiedDate,omitempty"`
+	Key                    aws.StringValue     `json:"Key,omitempty"`
+	LastModifiedBy         aws.StringValue     `json:"LastModifiedBy,omitempty"`
+	LastModifiedDate       *aws.FloatTimestamp `json:"LastModifiedDate,omitempty"`
+	SyncCount              aws.LongValue       `json:"SyncCount,omitempty"`
+	Value                  aws.StringValue     `json:"Value,omitempty"`
 }
 
 // RecordPatch is undocumented.
 type RecordPatch struct {
-	DeviceLastModifiedDate time.Time       `json:"DeviceLastModifiedDate,omitempty"`
-	Key                    aws.StringValue `json:"Key"`
-	Op                     aws.StringValue `json:"Op"`
-	SyncCount              aws.LongValue   `json:"SyncCount"`
-	Value                  aws.StringValue `json:"Value,omitempty"`
+	DeviceLastModifiedDate *aws.FloatTimestamp `json:"DeviceLastModifiedDate,omitempty"`
+	Key                    aws.StringValue     `json:"Key"`
+	Op                     aws.StringValue     `json:"Op"`
+	SyncCount              aws.LongValue       `json:"SyncCount"`
+	Value                  aws.StringValue     `json:"Value,omitempty"`
 }
 
 // RegisterDeviceRequest is undocumented.
@@ -1006,6 +1444,58 @@ type UpdateRecordsResponse struct {
 	Records []Record `json:"Records,omitempty"`
 }
 
+// InternalErrorException is the error returned for the InternalErrorException error code.
+type InternalErrorException struct {
+	aws.APIError
+}
+
+// InvalidConfigurationException is the error returned for the InvalidConfigurationException error code.
+type InvalidConfigurationException struct {
+	aws.APIError
+}
+
+// InvalidParameterException is the error returned for the InvalidParameterException error code.
+type InvalidParameterException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// NotAuthorizedException is the error returned for the NotAuthorizedException error code.
+type NotAuthorizedException struct {
+	aws.APIError
+}
+
+// ResourceConflictException is the error returned for the ResourceConflictException error code.
+type ResourceConflictException struct {
+	aws.APIError
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// TooManyRequestsException is the error returned for the TooManyRequestsException error code.
+type TooManyRequestsException struct {
+	aws.APIError
+}
+
+// exceptions maps CognitoSync's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InternalErrorException":        InternalErrorException{},
+	"InvalidConfigurationException": InvalidConfigurationException{},
+	"InvalidParameterException":     InvalidParameterException{},
+	"LimitExceededException":        LimitExceededException{},
+	"NotAuthorizedException":        NotAuthorizedException{},
+	"ResourceConflictException":     ResourceConflictException{},
+	"ResourceNotFoundException":     ResourceNotFoundException{},
+	"TooManyRequestsException":      TooManyRequestsException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
 
@@ -1015,4 +1505,5 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
 var _ json.RawMessage
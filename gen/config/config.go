@@ -4,11 +4,18 @@
 package config
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // Config is a client for AWS Config.
@@ -16,37 +23,79 @@ type Config struct {
 	client *aws.JSONClient
 }
 
-// New returns a new Config client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *Config {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "config"
-	endpoint, service, region := endpoints.Lookup("config", region)
+// New returns a new Config client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *Config {
+	cfg := newConfig(region, client, opts)
 
 	return &Config{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "StarlingDoveService",
 		},
 	}
 }
 
+// NewFromSession returns a new Config client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *Config {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("config", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("config", "config"),
+	}, "AWS_ENDPOINT_URL_CONFIG_SERVICE", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *Config) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *Config) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // DeleteDeliveryChannel deletes the specified delivery channel. The
 // delivery channel cannot be deleted if it is the only delivery channel
 // and the configuration recorder is still running. To delete the delivery
 // channel, stop the running configuration recorder using the
 // StopConfigurationRecorder action.
 func (c *Config) DeleteDeliveryChannel(req *DeleteDeliveryChannelRequest) (err error) {
+	return c.DeleteDeliveryChannelWithContext(context.Background(), req)
+}
+
+// DeleteDeliveryChannelWithContext is like DeleteDeliveryChannel, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) DeleteDeliveryChannelWithContext(ctx context.Context, req *DeleteDeliveryChannelRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteDeliveryChannel", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteDeliveryChannel", "POST", "/", req, nil)
 	return
 }
 
@@ -58,8 +107,15 @@ func (c *Config) DeleteDeliveryChannel(req *DeleteDeliveryChannelRequest) (err e
 // successfully completed. Notification of delivery failure, if the
 // delivery failed to complete.
 func (c *Config) DeliverConfigSnapshot(req *DeliverConfigSnapshotRequest) (resp *DeliverConfigSnapshotResponse, err error) {
+	return c.DeliverConfigSnapshotWithContext(context.Background(), req)
+}
+
+// DeliverConfigSnapshotWithContext is like DeliverConfigSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) DeliverConfigSnapshotWithContext(ctx context.Context, req *DeliverConfigSnapshotRequest) (resp *DeliverConfigSnapshotResponse, err error) {
 	resp = &DeliverConfigSnapshotResponse{}
-	err = c.client.Do("DeliverConfigSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeliverConfigSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -68,8 +124,15 @@ func (c *Config) DeliverConfigSnapshot(req *DeliverConfigSnapshotRequest) (resp
 // specified, this action returns the status of all configuration recorder
 // associated with the account.
 func (c *Config) DescribeConfigurationRecorderStatus(req *DescribeConfigurationRecorderStatusRequest) (resp *DescribeConfigurationRecorderStatusResponse, err error) {
+	return c.DescribeConfigurationRecorderStatusWithContext(context.Background(), req)
+}
+
+// DescribeConfigurationRecorderStatusWithContext is like DescribeConfigurationRecorderStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) DescribeConfigurationRecorderStatusWithContext(ctx context.Context, req *DescribeConfigurationRecorderStatusRequest) (resp *DescribeConfigurationRecorderStatusResponse, err error) {
 	resp = &DescribeConfigurationRecorderStatusResponse{}
-	err = c.client.Do("DescribeConfigurationRecorderStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeConfigurationRecorderStatus", "POST", "/", req, resp)
 	return
 }
 
@@ -78,8 +141,15 @@ func (c *Config) DescribeConfigurationRecorderStatus(req *DescribeConfigurationR
 // action returns the names of all the configuration recorders associated
 // with the account.
 func (c *Config) DescribeConfigurationRecorders(req *DescribeConfigurationRecordersRequest) (resp *DescribeConfigurationRecordersResponse, err error) {
+	return c.DescribeConfigurationRecordersWithContext(context.Background(), req)
+}
+
+// DescribeConfigurationRecordersWithContext is like DescribeConfigurationRecorders, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) DescribeConfigurationRecordersWithContext(ctx context.Context, req *DescribeConfigurationRecordersRequest) (resp *DescribeConfigurationRecordersResponse, err error) {
 	resp = &DescribeConfigurationRecordersResponse{}
-	err = c.client.Do("DescribeConfigurationRecorders", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeConfigurationRecorders", "POST", "/", req, resp)
 	return
 }
 
@@ -88,8 +158,15 @@ func (c *Config) DescribeConfigurationRecorders(req *DescribeConfigurationRecord
 // action returns the current status of all delivery channels associated
 // with the account.
 func (c *Config) DescribeDeliveryChannelStatus(req *DescribeDeliveryChannelStatusRequest) (resp *DescribeDeliveryChannelStatusResponse, err error) {
+	return c.DescribeDeliveryChannelStatusWithContext(context.Background(), req)
+}
+
+// DescribeDeliveryChannelStatusWithContext is like DescribeDeliveryChannelStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) DescribeDeliveryChannelStatusWithContext(ctx context.Context, req *DescribeDeliveryChannelStatusRequest) (resp *DescribeDeliveryChannelStatusResponse, err error) {
 	resp = &DescribeDeliveryChannelStatusResponse{}
-	err = c.client.Do("DescribeDeliveryChannelStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDeliveryChannelStatus", "POST", "/", req, resp)
 	return
 }
 
@@ -97,8 +174,15 @@ func (c *Config) DescribeDeliveryChannelStatus(req *DescribeDeliveryChannelStatu
 // channel. If a delivery channel is not specified, this action returns the
 // details of all delivery channels associated with the account.
 func (c *Config) DescribeDeliveryChannels(req *DescribeDeliveryChannelsRequest) (resp *DescribeDeliveryChannelsResponse, err error) {
+	return c.DescribeDeliveryChannelsWithContext(context.Background(), req)
+}
+
+// DescribeDeliveryChannelsWithContext is like DescribeDeliveryChannels, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) DescribeDeliveryChannelsWithContext(ctx context.Context, req *DescribeDeliveryChannelsRequest) (resp *DescribeDeliveryChannelsResponse, err error) {
 	resp = &DescribeDeliveryChannelsResponse{}
-	err = c.client.Do("DescribeDeliveryChannels", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDeliveryChannels", "POST", "/", req, resp)
 	return
 }
 
@@ -109,8 +193,15 @@ func (c *Config) DescribeDeliveryChannels(req *DescribeDeliveryChannelsRequest)
 // nextToken is returned as part of the result that you can use to continue
 // this request.
 func (c *Config) GetResourceConfigHistory(req *GetResourceConfigHistoryRequest) (resp *GetResourceConfigHistoryResponse, err error) {
+	return c.GetResourceConfigHistoryWithContext(context.Background(), req)
+}
+
+// GetResourceConfigHistoryWithContext is like GetResourceConfigHistory, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) GetResourceConfigHistoryWithContext(ctx context.Context, req *GetResourceConfigHistoryRequest) (resp *GetResourceConfigHistoryResponse, err error) {
 	resp = &GetResourceConfigHistoryResponse{}
-	err = c.client.Do("GetResourceConfigHistory", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetResourceConfigHistory", "POST", "/", req, resp)
 	return
 }
 
@@ -119,8 +210,15 @@ func (c *Config) GetResourceConfigHistory(req *GetResourceConfigHistoryRequest)
 // roleARN ) of an existing recorder. To change the role, call the action
 // on the existing configuration recorder and specify a role.
 func (c *Config) PutConfigurationRecorder(req *PutConfigurationRecorderRequest) (err error) {
+	return c.PutConfigurationRecorderWithContext(context.Background(), req)
+}
+
+// PutConfigurationRecorderWithContext is like PutConfigurationRecorder, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) PutConfigurationRecorderWithContext(ctx context.Context, req *PutConfigurationRecorderRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutConfigurationRecorder", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutConfigurationRecorder", "POST", "/", req, nil)
 	return
 }
 
@@ -133,8 +231,15 @@ func (c *Config) PutConfigurationRecorder(req *PutConfigurationRecorderRequest)
 // different value for either the S3 bucket or the SNS topic, this action
 // will keep the existing value for the parameter that is not changed.
 func (c *Config) PutDeliveryChannel(req *PutDeliveryChannelRequest) (err error) {
+	return c.PutDeliveryChannelWithContext(context.Background(), req)
+}
+
+// PutDeliveryChannelWithContext is like PutDeliveryChannel, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) PutDeliveryChannelWithContext(ctx context.Context, req *PutDeliveryChannelRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutDeliveryChannel", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutDeliveryChannel", "POST", "/", req, nil)
 	return
 }
 
@@ -142,16 +247,30 @@ func (c *Config) PutDeliveryChannel(req *PutDeliveryChannelRequest) (err error)
 // resources associated with the account. You must have created at least
 // one delivery channel to successfully start the configuration recorder.
 func (c *Config) StartConfigurationRecorder(req *StartConfigurationRecorderRequest) (err error) {
+	return c.StartConfigurationRecorderWithContext(context.Background(), req)
+}
+
+// StartConfigurationRecorderWithContext is like StartConfigurationRecorder, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) StartConfigurationRecorderWithContext(ctx context.Context, req *StartConfigurationRecorderRequest) (err error) {
 	// NRE
-	err = c.client.Do("StartConfigurationRecorder", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "StartConfigurationRecorder", "POST", "/", req, nil)
 	return
 }
 
 // StopConfigurationRecorder stops recording configurations of all the
 // resources associated with the account.
 func (c *Config) StopConfigurationRecorder(req *StopConfigurationRecorderRequest) (err error) {
+	return c.StopConfigurationRecorderWithContext(context.Background(), req)
+}
+
+// StopConfigurationRecorderWithContext is like StopConfigurationRecorder, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Config) StopConfigurationRecorderWithContext(ctx context.Context, req *StopConfigurationRecorderRequest) (err error) {
 	// NRE
-	err = c.client.Do("StopConfigurationRecorder", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "StopConfigurationRecorder", "POST", "/", req, nil)
 	return
 }
 
@@ -163,38 +282,38 @@ const (
 
 // ConfigExportDeliveryInfo is undocumented.
 type ConfigExportDeliveryInfo struct {
-	LastAttemptTime    time.Time       `json:"lastAttemptTime,omitempty"`
-	LastErrorCode      aws.StringValue `json:"lastErrorCode,omitempty"`
-	LastErrorMessage   aws.StringValue `json:"lastErrorMessage,omitempty"`
-	LastStatus         aws.StringValue `json:"lastStatus,omitempty"`
-	LastSuccessfulTime time.Time       `json:"lastSuccessfulTime,omitempty"`
+	LastAttemptTime    *aws.FloatTimestamp `json:"lastAttemptTime,omitempty"`
+	LastErrorCode      aws.StringValue     `json:"lastErrorCode,omitempty"`
+	LastErrorMessage   aws.StringValue     `json:"lastErrorMessage,omitempty"`
+	LastStatus         aws.StringValue     `json:"lastStatus,omitempty"`
+	LastSuccessfulTime *aws.FloatTimestamp `json:"lastSuccessfulTime,omitempty"`
 }
 
 // ConfigStreamDeliveryInfo is undocumented.
 type ConfigStreamDeliveryInfo struct {
-	LastErrorCode        aws.StringValue `json:"lastErrorCode,omitempty"`
-	LastErrorMessage     aws.StringValue `json:"lastErrorMessage,omitempty"`
-	LastStatus           aws.StringValue `json:"lastStatus,omitempty"`
-	LastStatusChangeTime time.Time       `json:"lastStatusChangeTime,omitempty"`
+	LastErrorCode        aws.StringValue     `json:"lastErrorCode,omitempty"`
+	LastErrorMessage     aws.StringValue     `json:"lastErrorMessage,omitempty"`
+	LastStatus           aws.StringValue     `json:"lastStatus,omitempty"`
+	LastStatusChangeTime *aws.FloatTimestamp `json:"lastStatusChangeTime,omitempty"`
 }
 
 // ConfigurationItem is undocumented.
 type ConfigurationItem struct {
-	AccountID                    aws.StringValue   `json:"accountId,omitempty"`
-	ARN                          aws.StringValue   `json:"arn,omitempty"`
-	AvailabilityZone             aws.StringValue   `json:"availabilityZone,omitempty"`
-	Configuration                aws.StringValue   `json:"configuration,omitempty"`
-	ConfigurationItemCaptureTime time.Time         `json:"configurationItemCaptureTime,omitempty"`
-	ConfigurationItemMD5Hash     aws.StringValue   `json:"configurationItemMD5Hash,omitempty"`
-	ConfigurationItemStatus      aws.StringValue   `json:"configurationItemStatus,omitempty"`
-	ConfigurationStateID         aws.StringValue   `json:"configurationStateId,omitempty"`
-	RelatedEvents                []string          `json:"relatedEvents,omitempty"`
-	Relationships                []Relationship    `json:"relationships,omitempty"`
-	ResourceCreationTime         time.Time         `json:"resourceCreationTime,omitempty"`
-	ResourceID                   aws.StringValue   `json:"resourceId,omitempty"`
-	ResourceType                 aws.StringValue   `json:"resourceType,omitempty"`
-	Tags                         map[string]string `json:"tags,omitempty"`
-	Version                      aws.StringValue   `json:"version,omitempty"`
+	AccountID                    aws.StringValue     `json:"accountId,omitempty"`
+	ARN                          aws.StringValue     `json:"arn,omitempty"`
+	AvailabilityZone             aws.StringValue     `json:"availabilityZone,omitempty"`
+	Configuration                aws.StringValue     `json:"configuration,omitempty"`
+	ConfigurationItemCaptureTime *aws.FloatTimestamp `json:"configurationItemCaptureTime,omitempty"`
+	ConfigurationItemMD5Hash     aws.StringValue     `json:"configurationItemMD5Hash,omitempty"`
+	ConfigurationItemStatus      aws.StringValue     `json:"configurationItemStatus,omitempty"`
+	ConfigurationStateID         aws.StringValue     `json:"configurationStateId,omitempty"`
+	RelatedEvents                []string            `json:"relatedEvents,omitempty"`
+	Relationships                []Relationship      `json:"relationships,omitempty"`
+	ResourceCreationTime         *aws.FloatTimestamp `json:"resourceCreationTime,omitempty"`
+	ResourceID                   aws.StringValue     `json:"resourceId,omitempty"`
+	ResourceType                 aws.StringValue     `json:"resourceType,omitempty"`
+	Tags                         map[string]string   `json:"tags,omitempty"`
+	Version                      aws.StringValue     `json:"version,omitempty"`
 }
 
 // Possible values for Config.
@@ -211,16 +330,46 @@ type ConfigurationRecorder struct {
 	RoleARN aws.StringValue `json:"roleARN,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ConfigurationRecorder) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ConfigurationRecorder to b.
+func (v *ConfigurationRecorder) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Name != nil {
+		b = append(b, "\"name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.RoleARN != nil {
+		b = append(b, "\"roleARN\":"...)
+		b = aws.AppendJSONString(b, *v.RoleARN)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ConfigurationRecorderStatus is undocumented.
 type ConfigurationRecorderStatus struct {
-	LastErrorCode        aws.StringValue  `json:"lastErrorCode,omitempty"`
-	LastErrorMessage     aws.StringValue  `json:"lastErrorMessage,omitempty"`
-	LastStartTime        time.Time        `json:"lastStartTime,omitempty"`
-	LastStatus           aws.StringValue  `json:"lastStatus,omitempty"`
-	LastStatusChangeTime time.Time        `json:"lastStatusChangeTime,omitempty"`
-	LastStopTime         time.Time        `json:"lastStopTime,omitempty"`
-	Name                 aws.StringValue  `json:"name,omitempty"`
-	Recording            aws.BooleanValue `json:"recording,omitempty"`
+	LastErrorCode        aws.StringValue     `json:"lastErrorCode,omitempty"`
+	LastErrorMessage     aws.StringValue     `json:"lastErrorMessage,omitempty"`
+	LastStartTime        *aws.FloatTimestamp `json:"lastStartTime,omitempty"`
+	LastStatus           aws.StringValue     `json:"lastStatus,omitempty"`
+	LastStatusChangeTime *aws.FloatTimestamp `json:"lastStatusChangeTime,omitempty"`
+	LastStopTime         *aws.FloatTimestamp `json:"lastStopTime,omitempty"`
+	Name                 aws.StringValue     `json:"name,omitempty"`
+	Recording            aws.BooleanValue    `json:"recording,omitempty"`
 }
 
 // DeleteDeliveryChannelRequest is undocumented.
@@ -228,11 +377,65 @@ type DeleteDeliveryChannelRequest struct {
 	DeliveryChannelName aws.StringValue `json:"DeliveryChannelName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteDeliveryChannelRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteDeliveryChannelRequest to b.
+func (v *DeleteDeliveryChannelRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DeliveryChannelName\":"...)
+	if v.DeliveryChannelName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeliveryChannelName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeliverConfigSnapshotRequest is undocumented.
 type DeliverConfigSnapshotRequest struct {
 	DeliveryChannelName aws.StringValue `json:"deliveryChannelName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeliverConfigSnapshotRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeliverConfigSnapshotRequest to b.
+func (v *DeliverConfigSnapshotRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"deliveryChannelName\":"...)
+	if v.DeliveryChannelName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DeliveryChannelName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeliverConfigSnapshotResponse is undocumented.
 type DeliverConfigSnapshotResponse struct {
 	ConfigSnapshotID aws.StringValue `json:"configSnapshotId,omitempty"`
@@ -246,6 +449,46 @@ type DeliveryChannel struct {
 	SNSTopicARN  aws.StringValue `json:"snsTopicARN,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeliveryChannel) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeliveryChannel to b.
+func (v *DeliveryChannel) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Name != nil {
+		b = append(b, "\"name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.S3BucketName != nil {
+		b = append(b, "\"s3BucketName\":"...)
+		b = aws.AppendJSONString(b, *v.S3BucketName)
+		b = append(b, ',')
+	}
+	if v.S3KeyPrefix != nil {
+		b = append(b, "\"s3KeyPrefix\":"...)
+		b = aws.AppendJSONString(b, *v.S3KeyPrefix)
+		b = append(b, ',')
+	}
+	if v.SNSTopicARN != nil {
+		b = append(b, "\"snsTopicARN\":"...)
+		b = aws.AppendJSONString(b, *v.SNSTopicARN)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeliveryChannelStatus is undocumented.
 type DeliveryChannelStatus struct {
 	ConfigHistoryDeliveryInfo  *ConfigExportDeliveryInfo `json:"configHistoryDeliveryInfo,omitempty"`
@@ -265,6 +508,40 @@ type DescribeConfigurationRecorderStatusRequest struct {
 	ConfigurationRecorderNames []string `json:"ConfigurationRecorderNames,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeConfigurationRecorderStatusRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeConfigurationRecorderStatusRequest to b.
+func (v *DescribeConfigurationRecorderStatusRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.ConfigurationRecorderNames) != 0 {
+		b = append(b, "\"ConfigurationRecorderNames\":"...)
+		b = append(b, '[')
+		for i1 := range v.ConfigurationRecorderNames {
+			b = aws.AppendJSONString(b, v.ConfigurationRecorderNames[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeConfigurationRecorderStatusResponse is undocumented.
 type DescribeConfigurationRecorderStatusResponse struct {
 	ConfigurationRecordersStatus []ConfigurationRecorderStatus `json:"ConfigurationRecordersStatus,omitempty"`
@@ -275,6 +552,40 @@ type DescribeConfigurationRecordersRequest struct {
 	ConfigurationRecorderNames []string `json:"ConfigurationRecorderNames,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeConfigurationRecordersRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeConfigurationRecordersRequest to b.
+func (v *DescribeConfigurationRecordersRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.ConfigurationRecorderNames) != 0 {
+		b = append(b, "\"ConfigurationRecorderNames\":"...)
+		b = append(b, '[')
+		for i1 := range v.ConfigurationRecorderNames {
+			b = aws.AppendJSONString(b, v.ConfigurationRecorderNames[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeConfigurationRecordersResponse is undocumented.
 type DescribeConfigurationRecordersResponse struct {
 	ConfigurationRecorders []ConfigurationRecorder `json:"ConfigurationRecorders,omitempty"`
@@ -285,6 +596,40 @@ type DescribeDeliveryChannelStatusRequest struct {
 	DeliveryChannelNames []string `json:"DeliveryChannelNames,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeDeliveryChannelStatusRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeDeliveryChannelStatusRequest to b.
+func (v *DescribeDeliveryChannelStatusRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.DeliveryChannelNames) != 0 {
+		b = append(b, "\"DeliveryChannelNames\":"...)
+		b = append(b, '[')
+		for i1 := range v.DeliveryChannelNames {
+			b = aws.AppendJSONString(b, v.DeliveryChannelNames[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeDeliveryChannelStatusResponse is undocumented.
 type DescribeDeliveryChannelStatusResponse struct {
 	DeliveryChannelsStatus []DeliveryChannelStatus `json:"DeliveryChannelsStatus,omitempty"`
@@ -295,6 +640,40 @@ type DescribeDeliveryChannelsRequest struct {
 	DeliveryChannelNames []string `json:"DeliveryChannelNames,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeDeliveryChannelsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeDeliveryChannelsRequest to b.
+func (v *DescribeDeliveryChannelsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.DeliveryChannelNames) != 0 {
+		b = append(b, "\"DeliveryChannelNames\":"...)
+		b = append(b, '[')
+		for i1 := range v.DeliveryChannelNames {
+			b = aws.AppendJSONString(b, v.DeliveryChannelNames[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeDeliveryChannelsResponse is undocumented.
 type DescribeDeliveryChannelsResponse struct {
 	DeliveryChannels []DeliveryChannel `json:"DeliveryChannels,omitempty"`
@@ -302,13 +681,78 @@ type DescribeDeliveryChannelsResponse struct {
 
 // GetResourceConfigHistoryRequest is undocumented.
 type GetResourceConfigHistoryRequest struct {
-	ChronologicalOrder aws.StringValue  `json:"chronologicalOrder,omitempty"`
-	EarlierTime        time.Time        `json:"earlierTime,omitempty"`
-	LaterTime          time.Time        `json:"laterTime,omitempty"`
-	Limit              aws.IntegerValue `json:"limit,omitempty"`
-	NextToken          aws.StringValue  `json:"nextToken,omitempty"`
-	ResourceID         aws.StringValue  `json:"resourceId"`
-	ResourceType       aws.StringValue  `json:"resourceType"`
+	ChronologicalOrder aws.StringValue     `json:"chronologicalOrder,omitempty"`
+	EarlierTime        *aws.FloatTimestamp `json:"earlierTime,omitempty"`
+	LaterTime          *aws.FloatTimestamp `json:"laterTime,omitempty"`
+	Limit              aws.IntegerValue    `json:"limit,omitempty"`
+	NextToken          aws.StringValue     `json:"nextToken,omitempty"`
+	ResourceID         aws.StringValue     `json:"resourceId"`
+	ResourceType       aws.StringValue     `json:"resourceType"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetResourceConfigHistoryRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetResourceConfigHistoryRequest to b.
+func (v *GetResourceConfigHistoryRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ChronologicalOrder != nil {
+		b = append(b, "\"chronologicalOrder\":"...)
+		b = aws.AppendJSONString(b, *v.ChronologicalOrder)
+		b = append(b, ',')
+	}
+	if v.EarlierTime != nil {
+		b = append(b, "\"earlierTime\":"...)
+		b, err = aws.AppendJSON(b, v.EarlierTime)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.LaterTime != nil {
+		b = append(b, "\"laterTime\":"...)
+		b, err = aws.AppendJSON(b, v.LaterTime)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	b = append(b, "\"resourceId\":"...)
+	if v.ResourceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ResourceID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"resourceType\":"...)
+	if v.ResourceType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ResourceType)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // GetResourceConfigHistoryResponse is undocumented.
@@ -322,11 +766,71 @@ type PutConfigurationRecorderRequest struct {
 	ConfigurationRecorder *ConfigurationRecorder `json:"ConfigurationRecorder"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutConfigurationRecorderRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutConfigurationRecorderRequest to b.
+func (v *PutConfigurationRecorderRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ConfigurationRecorder\":"...)
+	if v.ConfigurationRecorder == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ConfigurationRecorder.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutDeliveryChannelRequest is undocumented.
 type PutDeliveryChannelRequest struct {
 	DeliveryChannel *DeliveryChannel `json:"DeliveryChannel"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutDeliveryChannelRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutDeliveryChannelRequest to b.
+func (v *PutDeliveryChannelRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DeliveryChannel\":"...)
+	if v.DeliveryChannel == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.DeliveryChannel.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for Config.
 const (
 	RecorderStatusFailure = "Failure"
@@ -364,10 +868,191 @@ type StartConfigurationRecorderRequest struct {
 	ConfigurationRecorderName aws.StringValue `json:"ConfigurationRecorderName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartConfigurationRecorderRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartConfigurationRecorderRequest to b.
+func (v *StartConfigurationRecorderRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ConfigurationRecorderName\":"...)
+	if v.ConfigurationRecorderName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConfigurationRecorderName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StopConfigurationRecorderRequest is undocumented.
 type StopConfigurationRecorderRequest struct {
 	ConfigurationRecorderName aws.StringValue `json:"ConfigurationRecorderName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StopConfigurationRecorderRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StopConfigurationRecorderRequest to b.
+func (v *StopConfigurationRecorderRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ConfigurationRecorderName\":"...)
+	if v.ConfigurationRecorderName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConfigurationRecorderName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
+// InsufficientDeliveryPolicyException is the error returned for the InsufficientDeliveryPolicyException error code.
+type InsufficientDeliveryPolicyException struct {
+	aws.APIError
+}
+
+// InvalidConfigurationRecorderNameException is the error returned for the InvalidConfigurationRecorderNameException error code.
+type InvalidConfigurationRecorderNameException struct {
+	aws.APIError
+}
+
+// InvalidDeliveryChannelNameException is the error returned for the InvalidDeliveryChannelNameException error code.
+type InvalidDeliveryChannelNameException struct {
+	aws.APIError
+}
+
+// InvalidLimitException is the error returned for the InvalidLimitException error code.
+type InvalidLimitException struct {
+	aws.APIError
+}
+
+// InvalidNextTokenException is the error returned for the InvalidNextTokenException error code.
+type InvalidNextTokenException struct {
+	aws.APIError
+}
+
+// InvalidRoleException is the error returned for the InvalidRoleException error code.
+type InvalidRoleException struct {
+	aws.APIError
+}
+
+// InvalidS3KeyPrefixException is the error returned for the InvalidS3KeyPrefixException error code.
+type InvalidS3KeyPrefixException struct {
+	aws.APIError
+}
+
+// InvalidSNSTopicARNException is the error returned for the InvalidSNSTopicARNException error code.
+type InvalidSNSTopicARNException struct {
+	aws.APIError
+}
+
+// InvalidTimeRangeException is the error returned for the InvalidTimeRangeException error code.
+type InvalidTimeRangeException struct {
+	aws.APIError
+}
+
+// LastDeliveryChannelDeleteFailedException is the error returned for the LastDeliveryChannelDeleteFailedException error code.
+type LastDeliveryChannelDeleteFailedException struct {
+	aws.APIError
+}
+
+// MaxNumberOfConfigurationRecordersExceededException is the error returned for the MaxNumberOfConfigurationRecordersExceededException error code.
+type MaxNumberOfConfigurationRecordersExceededException struct {
+	aws.APIError
+}
+
+// MaxNumberOfDeliveryChannelsExceededException is the error returned for the MaxNumberOfDeliveryChannelsExceededException error code.
+type MaxNumberOfDeliveryChannelsExceededException struct {
+	aws.APIError
+}
+
+// NoAvailableConfigurationRecorderException is the error returned for the NoAvailableConfigurationRecorderException error code.
+type NoAvailableConfigurationRecorderException struct {
+	aws.APIError
+}
+
+// NoAvailableDeliveryChannelException is the error returned for the NoAvailableDeliveryChannelException error code.
+type NoAvailableDeliveryChannelException struct {
+	aws.APIError
+}
+
+// NoRunningConfigurationRecorderException is the error returned for the NoRunningConfigurationRecorderException error code.
+type NoRunningConfigurationRecorderException struct {
+	aws.APIError
+}
+
+// NoSuchBucketException is the error returned for the NoSuchBucketException error code.
+type NoSuchBucketException struct {
+	aws.APIError
+}
+
+// NoSuchConfigurationRecorderException is the error returned for the NoSuchConfigurationRecorderException error code.
+type NoSuchConfigurationRecorderException struct {
+	aws.APIError
+}
+
+// NoSuchDeliveryChannelException is the error returned for the NoSuchDeliveryChannelException error code.
+type NoSuchDeliveryChannelException struct {
+	aws.APIError
+}
+
+// ResourceNotDiscoveredException is the error returned for the ResourceNotDiscoveredException error code.
+type ResourceNotDiscoveredException struct {
+	aws.APIError
+}
+
+// ValidationException is the error returned for the ValidationException error code.
+type ValidationException struct {
+	aws.APIError
+}
+
+// exceptions maps Config's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InsufficientDeliveryPolicyException":                InsufficientDeliveryPolicyException{},
+	"InvalidConfigurationRecorderNameException":          InvalidConfigurationRecorderNameException{},
+	"InvalidDeliveryChannelNameException":                InvalidDeliveryChannelNameException{},
+	"InvalidLimitException":                              InvalidLimitException{},
+	"InvalidNextTokenException":                          InvalidNextTokenException{},
+	"InvalidRoleException":                               InvalidRoleException{},
+	"InvalidS3KeyPrefixException":                        InvalidS3KeyPrefixException{},
+	"InvalidSNSTopicARNException":                        InvalidSNSTopicARNException{},
+	"InvalidTimeRangeException":                          InvalidTimeRangeException{},
+	"LastDeliveryChannelDeleteFailedException":           LastDeliveryChannelDeleteFailedException{},
+	"MaxNumberOfConfigurationRecordersExceededException": MaxNumberOfConfigurationRecordersExceededException{},
+	"MaxNumberOfDeliveryChannelsExceededException":       MaxNumberOfDeliveryChannelsExceededException{},
+	"NoAvailableConfigurationRecorderException":          NoAvailableConfigurationRecorderException{},
+	"NoAvailableDeliveryChannelException":                NoAvailableDeliveryChannelException{},
+	"NoRunningConfigurationRecorderException":            NoRunningConfigurationRecorderException{},
+	"NoSuchBucketException":                              NoSuchBucketException{},
+	"NoSuchConfigurationRecorderException":               NoSuchConfigurationRecorderException{},
+	"NoSuchDeliveryChannelException":                     NoSuchDeliveryChannelException{},
+	"ResourceNotDiscoveredException":                     ResourceNotDiscoveredException{},
+	"ValidationException":                                ValidationException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -4,11 +4,18 @@
 package datapipeline
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // DataPipeline is a client for AWS Data Pipeline.
@@ -16,29 +23,64 @@ type DataPipeline struct {
 	client *aws.JSONClient
 }
 
-// New returns a new DataPipeline client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *DataPipeline {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "datapipeline"
-	endpoint, service, region := endpoints.Lookup("datapipeline", region)
+// New returns a new DataPipeline client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *DataPipeline {
+	cfg := newConfig(region, client, opts)
 
 	return &DataPipeline{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "DataPipeline",
 		},
 	}
 }
 
+// NewFromSession returns a new DataPipeline client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *DataPipeline {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("datapipeline", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("datapipeline", "datapipeline"),
+	}, "AWS_ENDPOINT_URL_DATA_PIPELINE", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *DataPipeline) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *DataPipeline) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // ActivatePipeline validates a pipeline and initiates processing. If the
 // pipeline does not pass validation, activation fails. You cannot perform
 // this operation on pipelines and attempting to do so will return an
@@ -47,8 +89,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Dat
 // PutPipelineDefinition actions. A pipeline cannot be modified after it
 // has been successfully activated.
 func (c *DataPipeline) ActivatePipeline(req *ActivatePipelineInput) (resp *ActivatePipelineOutput, err error) {
+	return c.ActivatePipelineWithContext(context.Background(), req)
+}
+
+// ActivatePipelineWithContext is like ActivatePipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) ActivatePipelineWithContext(ctx context.Context, req *ActivatePipelineInput) (resp *ActivatePipelineOutput, err error) {
 	resp = &ActivatePipelineOutput{}
-	err = c.client.Do("ActivatePipeline", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ActivatePipeline", "POST", "/", req, resp)
 	return
 }
 
@@ -56,8 +105,15 @@ func (c *DataPipeline) ActivatePipeline(req *ActivatePipelineInput) (resp *Activ
 // you can then use the PutPipelineDefinition action to populate the
 // pipeline.
 func (c *DataPipeline) CreatePipeline(req *CreatePipelineInput) (resp *CreatePipelineOutput, err error) {
+	return c.CreatePipelineWithContext(context.Background(), req)
+}
+
+// CreatePipelineWithContext is like CreatePipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) CreatePipelineWithContext(ctx context.Context, req *CreatePipelineInput) (resp *CreatePipelineOutput, err error) {
 	resp = &CreatePipelineOutput{}
-	err = c.client.Do("CreatePipeline", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreatePipeline", "POST", "/", req, resp)
 	return
 }
 
@@ -69,8 +125,15 @@ func (c *DataPipeline) CreatePipeline(req *CreatePipelineInput) (resp *CreatePip
 // deleting it, call SetStatus with the status set to Pause on individual
 // components. Components that are paused by SetStatus can be resumed.
 func (c *DataPipeline) DeletePipeline(req *DeletePipelineInput) (err error) {
+	return c.DeletePipelineWithContext(context.Background(), req)
+}
+
+// DeletePipelineWithContext is like DeletePipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) DeletePipelineWithContext(ctx context.Context, req *DeletePipelineInput) (err error) {
 	// NRE
-	err = c.client.Do("DeletePipeline", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeletePipeline", "POST", "/", req, nil)
 	return
 }
 
@@ -78,8 +141,15 @@ func (c *DataPipeline) DeletePipeline(req *DeletePipelineInput) (err error) {
 // associated with the pipeline. Object definitions are composed of a set
 // of fields that define the properties of the object.
 func (c *DataPipeline) DescribeObjects(req *DescribeObjectsInput) (resp *DescribeObjectsOutput, err error) {
+	return c.DescribeObjectsWithContext(context.Background(), req)
+}
+
+// DescribeObjectsWithContext is like DescribeObjects, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) DescribeObjectsWithContext(ctx context.Context, req *DescribeObjectsInput) (resp *DescribeObjectsOutput, err error) {
 	resp = &DescribeObjectsOutput{}
-	err = c.client.Do("DescribeObjects", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeObjects", "POST", "/", req, resp)
 	return
 }
 
@@ -93,8 +163,15 @@ func (c *DataPipeline) DescribeObjects(req *DescribeObjectsInput) (resp *Describ
 // instead of metadata about the pipeline, call the GetPipelineDefinition
 // action.
 func (c *DataPipeline) DescribePipelines(req *DescribePipelinesInput) (resp *DescribePipelinesOutput, err error) {
+	return c.DescribePipelinesWithContext(context.Background(), req)
+}
+
+// DescribePipelinesWithContext is like DescribePipelines, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) DescribePipelinesWithContext(ctx context.Context, req *DescribePipelinesInput) (resp *DescribePipelinesOutput, err error) {
 	resp = &DescribePipelinesOutput{}
-	err = c.client.Do("DescribePipelines", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribePipelines", "POST", "/", req, resp)
 	return
 }
 
@@ -102,8 +179,15 @@ func (c *DataPipeline) DescribePipelines(req *DescribePipelinesInput) (resp *Des
 // object. A task runner can use this action to evaluate SQL queries stored
 // in Amazon S3.
 func (c *DataPipeline) EvaluateExpression(req *EvaluateExpressionInput) (resp *EvaluateExpressionOutput, err error) {
+	return c.EvaluateExpressionWithContext(context.Background(), req)
+}
+
+// EvaluateExpressionWithContext is like EvaluateExpression, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) EvaluateExpressionWithContext(ctx context.Context, req *EvaluateExpressionInput) (resp *EvaluateExpressionOutput, err error) {
 	resp = &EvaluateExpressionOutput{}
-	err = c.client.Do("EvaluateExpression", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EvaluateExpression", "POST", "/", req, resp)
 	return
 }
 
@@ -111,8 +195,15 @@ func (c *DataPipeline) EvaluateExpression(req *EvaluateExpressionInput) (resp *E
 // You can call GetPipelineDefinition to retrieve the pipeline definition
 // you provided using PutPipelineDefinition
 func (c *DataPipeline) GetPipelineDefinition(req *GetPipelineDefinitionInput) (resp *GetPipelineDefinitionOutput, err error) {
+	return c.GetPipelineDefinitionWithContext(context.Background(), req)
+}
+
+// GetPipelineDefinitionWithContext is like GetPipelineDefinition, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) GetPipelineDefinitionWithContext(ctx context.Context, req *GetPipelineDefinitionInput) (resp *GetPipelineDefinitionOutput, err error) {
 	resp = &GetPipelineDefinitionOutput{}
-	err = c.client.Do("GetPipelineDefinition", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetPipelineDefinition", "POST", "/", req, resp)
 	return
 }
 
@@ -120,8 +211,15 @@ func (c *DataPipeline) GetPipelineDefinition(req *GetPipelineDefinitionInput) (r
 // pipelines. Identifiers are returned only for pipelines you have
 // permission to access.
 func (c *DataPipeline) ListPipelines(req *ListPipelinesInput) (resp *ListPipelinesOutput, err error) {
+	return c.ListPipelinesWithContext(context.Background(), req)
+}
+
+// ListPipelinesWithContext is like ListPipelines, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) ListPipelinesWithContext(ctx context.Context, req *ListPipelinesInput) (resp *ListPipelinesOutput, err error) {
 	resp = &ListPipelinesOutput{}
-	err = c.client.Do("ListPipelines", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListPipelines", "POST", "/", req, resp)
 	return
 }
 
@@ -140,8 +238,15 @@ func (c *DataPipeline) ListPipelines(req *ListPipelinesInput) (resp *ListPipelin
 // PollForTask again on the same workerGroup until it receives a response,
 // and this may take up to 90 seconds.
 func (c *DataPipeline) PollForTask(req *PollForTaskInput) (resp *PollForTaskOutput, err error) {
+	return c.PollForTaskWithContext(context.Background(), req)
+}
+
+// PollForTaskWithContext is like PollForTask, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) PollForTaskWithContext(ctx context.Context, req *PollForTaskInput) (resp *PollForTaskOutput, err error) {
 	resp = &PollForTaskOutput{}
-	err = c.client.Do("PollForTask", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PollForTask", "POST", "/", req, resp)
 	return
 }
 
@@ -156,8 +261,15 @@ func (c *DataPipeline) PollForTask(req *PollForTaskInput) (resp *PollForTaskOutp
 // definitions are passed to the PutPipelineDefinition action and returned
 // by the GetPipelineDefinition action.
 func (c *DataPipeline) PutPipelineDefinition(req *PutPipelineDefinitionInput) (resp *PutPipelineDefinitionOutput, err error) {
+	return c.PutPipelineDefinitionWithContext(context.Background(), req)
+}
+
+// PutPipelineDefinitionWithContext is like PutPipelineDefinition, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) PutPipelineDefinitionWithContext(ctx context.Context, req *PutPipelineDefinitionInput) (resp *PutPipelineDefinitionOutput, err error) {
 	resp = &PutPipelineDefinitionOutput{}
-	err = c.client.Do("PutPipelineDefinition", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PutPipelineDefinition", "POST", "/", req, resp)
 	return
 }
 
@@ -169,8 +281,15 @@ func (c *DataPipeline) PutPipelineDefinition(req *PutPipelineDefinitionInput) (r
 // QueryObjects , passing in the returned value for marker, until
 // HasMoreResults returns False
 func (c *DataPipeline) QueryObjects(req *QueryObjectsInput) (resp *QueryObjectsOutput, err error) {
+	return c.QueryObjectsWithContext(context.Background(), req)
+}
+
+// QueryObjectsWithContext is like QueryObjects, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) QueryObjectsWithContext(ctx context.Context, req *QueryObjectsInput) (resp *QueryObjectsOutput, err error) {
 	resp = &QueryObjectsOutput{}
-	err = c.client.Do("QueryObjects", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "QueryObjects", "POST", "/", req, resp)
 	return
 }
 
@@ -188,8 +307,15 @@ func (c *DataPipeline) QueryObjects(req *QueryObjectsInput) (resp *QueryObjectsO
 // and will reassign the task in a subsequent response to PollForTask .
 // task runners should call ReportTaskProgress every 60 seconds.
 func (c *DataPipeline) ReportTaskProgress(req *ReportTaskProgressInput) (resp *ReportTaskProgressOutput, err error) {
+	return c.ReportTaskProgressWithContext(context.Background(), req)
+}
+
+// ReportTaskProgressWithContext is like ReportTaskProgress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) ReportTaskProgressWithContext(ctx context.Context, req *ReportTaskProgressInput) (resp *ReportTaskProgressOutput, err error) {
 	resp = &ReportTaskProgressOutput{}
-	err = c.client.Do("ReportTaskProgress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ReportTaskProgress", "POST", "/", req, resp)
 	return
 }
 
@@ -199,8 +325,15 @@ func (c *DataPipeline) ReportTaskProgress(req *ReportTaskProgressInput) (resp *R
 // Pipeline, the web service can use this call to detect when the task
 // runner application has failed and restart a new instance.
 func (c *DataPipeline) ReportTaskRunnerHeartbeat(req *ReportTaskRunnerHeartbeatInput) (resp *ReportTaskRunnerHeartbeatOutput, err error) {
+	return c.ReportTaskRunnerHeartbeatWithContext(context.Background(), req)
+}
+
+// ReportTaskRunnerHeartbeatWithContext is like ReportTaskRunnerHeartbeat, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) ReportTaskRunnerHeartbeatWithContext(ctx context.Context, req *ReportTaskRunnerHeartbeatInput) (resp *ReportTaskRunnerHeartbeatOutput, err error) {
 	resp = &ReportTaskRunnerHeartbeatOutput{}
-	err = c.client.Do("ReportTaskRunnerHeartbeat", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ReportTaskRunnerHeartbeat", "POST", "/", req, resp)
 	return
 }
 
@@ -211,8 +344,15 @@ func (c *DataPipeline) ReportTaskRunnerHeartbeat(req *ReportTaskRunnerHeartbeatI
 // perform this operation on pipelines and attempting to do so will return
 // an InvalidRequestException.
 func (c *DataPipeline) SetStatus(req *SetStatusInput) (err error) {
+	return c.SetStatusWithContext(context.Background(), req)
+}
+
+// SetStatusWithContext is like SetStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) SetStatusWithContext(ctx context.Context, req *SetStatusInput) (err error) {
 	// NRE
-	err = c.client.Do("SetStatus", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetStatus", "POST", "/", req, nil)
 	return
 }
 
@@ -222,8 +362,15 @@ func (c *DataPipeline) SetStatus(req *SetStatusInput) (err error) {
 // does not need to call SetTaskStatus for tasks that are canceled by the
 // web service during a call to ReportTaskProgress .
 func (c *DataPipeline) SetTaskStatus(req *SetTaskStatusInput) (resp *SetTaskStatusOutput, err error) {
+	return c.SetTaskStatusWithContext(context.Background(), req)
+}
+
+// SetTaskStatusWithContext is like SetTaskStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) SetTaskStatusWithContext(ctx context.Context, req *SetTaskStatusInput) (resp *SetTaskStatusOutput, err error) {
 	resp = &SetTaskStatusOutput{}
-	err = c.client.Do("SetTaskStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SetTaskStatus", "POST", "/", req, resp)
 	return
 }
 
@@ -231,8 +378,15 @@ func (c *DataPipeline) SetTaskStatus(req *SetTaskStatusInput) (resp *SetTaskStat
 // validation checks to ensure that it is well formed and can run without
 // error.
 func (c *DataPipeline) ValidatePipelineDefinition(req *ValidatePipelineDefinitionInput) (resp *ValidatePipelineDefinitionOutput, err error) {
+	return c.ValidatePipelineDefinitionWithContext(context.Background(), req)
+}
+
+// ValidatePipelineDefinitionWithContext is like ValidatePipelineDefinition, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DataPipeline) ValidatePipelineDefinitionWithContext(ctx context.Context, req *ValidatePipelineDefinitionInput) (resp *ValidatePipelineDefinitionOutput, err error) {
 	resp = &ValidatePipelineDefinitionOutput{}
-	err = c.client.Do("ValidatePipelineDefinition", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ValidatePipelineDefinition", "POST", "/", req, resp)
 	return
 }
 
@@ -242,6 +396,50 @@ type ActivatePipelineInput struct {
 	PipelineID      aws.StringValue  `json:"pipelineId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ActivatePipelineInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ActivatePipelineInput to b.
+func (v *ActivatePipelineInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.ParameterValues) != 0 {
+		b = append(b, "\"parameterValues\":"...)
+		b = append(b, '[')
+		for i1 := range v.ParameterValues {
+			b, err = v.ParameterValues[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ActivatePipelineOutput is undocumented.
 type ActivatePipelineOutput struct {
 }
@@ -253,6 +451,45 @@ type CreatePipelineInput struct {
 	UniqueID    aws.StringValue `json:"uniqueId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreatePipelineInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreatePipelineInput to b.
+func (v *CreatePipelineInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Description != nil {
+		b = append(b, "\"description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"uniqueId\":"...)
+	if v.UniqueID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.UniqueID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreatePipelineOutput is undocumented.
 type CreatePipelineOutput struct {
 	PipelineID aws.StringValue `json:"pipelineId"`
@@ -263,6 +500,33 @@ type DeletePipelineInput struct {
 	PipelineID aws.StringValue `json:"pipelineId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeletePipelineInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeletePipelineInput to b.
+func (v *DeletePipelineInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeObjectsInput is undocumented.
 type DescribeObjectsInput struct {
 	EvaluateExpressions aws.BooleanValue `json:"evaluateExpressions,omitempty"`
@@ -271,6 +535,59 @@ type DescribeObjectsInput struct {
 	PipelineID          aws.StringValue  `json:"pipelineId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeObjectsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeObjectsInput to b.
+func (v *DescribeObjectsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.EvaluateExpressions != nil {
+		b = append(b, "\"evaluateExpressions\":"...)
+		b = strconv.AppendBool(b, *v.EvaluateExpressions)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	b = append(b, "\"objectIds\":"...)
+	if v.ObjectIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.ObjectIDs {
+			b = aws.AppendJSONString(b, v.ObjectIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeObjectsOutput is undocumented.
 type DescribeObjectsOutput struct {
 	HasMoreResults  aws.BooleanValue `json:"hasMoreResults,omitempty"`
@@ -283,6 +600,42 @@ type DescribePipelinesInput struct {
 	PipelineIDs []string `json:"pipelineIds"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribePipelinesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribePipelinesInput to b.
+func (v *DescribePipelinesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"pipelineIds\":"...)
+	if v.PipelineIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.PipelineIDs {
+			b = aws.AppendJSONString(b, v.PipelineIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribePipelinesOutput is undocumented.
 type DescribePipelinesOutput struct {
 	PipelineDescriptionList []PipelineDescription `json:"pipelineDescriptionList"`
@@ -295,6 +648,47 @@ type EvaluateExpressionInput struct {
 	PipelineID aws.StringValue `json:"pipelineId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EvaluateExpressionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EvaluateExpressionInput to b.
+func (v *EvaluateExpressionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"expression\":"...)
+	if v.Expression == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Expression)
+	}
+	b = append(b, ',')
+	b = append(b, "\"objectId\":"...)
+	if v.ObjectID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ObjectID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // EvaluateExpressionOutput is undocumented.
 type EvaluateExpressionOutput struct {
 	EvaluatedExpression aws.StringValue `json:"evaluatedExpression"`
@@ -307,12 +701,81 @@ type Field struct {
 	StringValue aws.StringValue `json:"stringValue,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Field) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Field to b.
+func (v *Field) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"key\":"...)
+	if v.Key == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Key)
+	}
+	b = append(b, ',')
+	if v.RefValue != nil {
+		b = append(b, "\"refValue\":"...)
+		b = aws.AppendJSONString(b, *v.RefValue)
+		b = append(b, ',')
+	}
+	if v.StringValue != nil {
+		b = append(b, "\"stringValue\":"...)
+		b = aws.AppendJSONString(b, *v.StringValue)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetPipelineDefinitionInput is undocumented.
 type GetPipelineDefinitionInput struct {
 	PipelineID aws.StringValue `json:"pipelineId"`
 	Version    aws.StringValue `json:"version,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetPipelineDefinitionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetPipelineDefinitionInput to b.
+func (v *GetPipelineDefinitionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	if v.Version != nil {
+		b = append(b, "\"version\":"...)
+		b = aws.AppendJSONString(b, *v.Version)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetPipelineDefinitionOutput is undocumented.
 type GetPipelineDefinitionOutput struct {
 	ParameterObjects []ParameterObject `json:"parameterObjects,omitempty"`
@@ -326,11 +789,66 @@ type InstanceIdentity struct {
 	Signature aws.StringValue `json:"signature,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *InstanceIdentity) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the InstanceIdentity to b.
+func (v *InstanceIdentity) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Document != nil {
+		b = append(b, "\"document\":"...)
+		b = aws.AppendJSONString(b, *v.Document)
+		b = append(b, ',')
+	}
+	if v.Signature != nil {
+		b = append(b, "\"signature\":"...)
+		b = aws.AppendJSONString(b, *v.Signature)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListPipelinesInput is undocumented.
 type ListPipelinesInput struct {
 	Marker aws.StringValue `json:"marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListPipelinesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListPipelinesInput to b.
+func (v *ListPipelinesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Marker != nil {
+		b = append(b, "\"marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListPipelinesOutput is undocumented.
 type ListPipelinesOutput struct {
 	HasMoreResults aws.BooleanValue `json:"hasMoreResults,omitempty"`
@@ -344,6 +862,45 @@ type Operator struct {
 	Values []string        `json:"values,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Operator) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Operator to b.
+func (v *Operator) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Type != nil {
+		b = append(b, "\"type\":"...)
+		b = aws.AppendJSONString(b, *v.Type)
+		b = append(b, ',')
+	}
+	if len(v.Values) != 0 {
+		b = append(b, "\"values\":"...)
+		b = append(b, '[')
+		for i1 := range v.Values {
+			b = aws.AppendJSONString(b, v.Values[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for DataPipeline.
 const (
 	OperatorTypeBetween = "BETWEEN"
@@ -359,18 +916,132 @@ type ParameterAttribute struct {
 	StringValue aws.StringValue `json:"stringValue"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ParameterAttribute) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ParameterAttribute to b.
+func (v *ParameterAttribute) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"key\":"...)
+	if v.Key == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Key)
+	}
+	b = append(b, ',')
+	b = append(b, "\"stringValue\":"...)
+	if v.StringValue == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StringValue)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ParameterObject is undocumented.
 type ParameterObject struct {
 	Attributes []ParameterAttribute `json:"attributes"`
 	ID         aws.StringValue      `json:"id"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ParameterObject) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ParameterObject to b.
+func (v *ParameterObject) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"attributes\":"...)
+	if v.Attributes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Attributes {
+			b, err = v.Attributes[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"id\":"...)
+	if v.ID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ParameterValue is undocumented.
 type ParameterValue struct {
 	ID          aws.StringValue `json:"id"`
 	StringValue aws.StringValue `json:"stringValue"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ParameterValue) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ParameterValue to b.
+func (v *ParameterValue) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"id\":"...)
+	if v.ID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"stringValue\":"...)
+	if v.StringValue == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StringValue)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PipelineDescription is undocumented.
 type PipelineDescription struct {
 	Description aws.StringValue `json:"description,omitempty"`
@@ -392,6 +1063,59 @@ type PipelineObject struct {
 	Name   aws.StringValue `json:"name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PipelineObject) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PipelineObject to b.
+func (v *PipelineObject) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"fields\":"...)
+	if v.Fields == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Fields {
+			b, err = v.Fields[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"id\":"...)
+	if v.ID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PollForTaskInput is undocumented.
 type PollForTaskInput struct {
 	Hostname         aws.StringValue   `json:"hostname,omitempty"`
@@ -399,6 +1123,46 @@ type PollForTaskInput struct {
 	WorkerGroup      aws.StringValue   `json:"workerGroup"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PollForTaskInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PollForTaskInput to b.
+func (v *PollForTaskInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Hostname != nil {
+		b = append(b, "\"hostname\":"...)
+		b = aws.AppendJSONString(b, *v.Hostname)
+		b = append(b, ',')
+	}
+	if v.InstanceIdentity != nil {
+		b = append(b, "\"instanceIdentity\":"...)
+		b, err = v.InstanceIdentity.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"workerGroup\":"...)
+	if v.WorkerGroup == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkerGroup)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PollForTaskOutput is undocumented.
 type PollForTaskOutput struct {
 	TaskObject *TaskObject `json:"taskObject,omitempty"`
@@ -412,6 +1176,86 @@ type PutPipelineDefinitionInput struct {
 	PipelineObjects  []PipelineObject  `json:"pipelineObjects"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutPipelineDefinitionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutPipelineDefinitionInput to b.
+func (v *PutPipelineDefinitionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.ParameterObjects) != 0 {
+		b = append(b, "\"parameterObjects\":"...)
+		b = append(b, '[')
+		for i1 := range v.ParameterObjects {
+			b, err = v.ParameterObjects[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ParameterValues) != 0 {
+		b = append(b, "\"parameterValues\":"...)
+		b = append(b, '[')
+		for i1 := range v.ParameterValues {
+			b, err = v.ParameterValues[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"pipelineObjects\":"...)
+	if v.PipelineObjects == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.PipelineObjects {
+			b, err = v.PipelineObjects[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutPipelineDefinitionOutput is undocumented.
 type PutPipelineDefinitionOutput struct {
 	Errored            aws.BooleanValue    `json:"errored"`
@@ -424,6 +1268,43 @@ type Query struct {
 	Selectors []Selector `json:"selectors,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Query) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Query to b.
+func (v *Query) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Selectors) != 0 {
+		b = append(b, "\"selectors\":"...)
+		b = append(b, '[')
+		for i1 := range v.Selectors {
+			b, err = v.Selectors[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // QueryObjectsInput is undocumented.
 type QueryObjectsInput struct {
 	Limit      aws.IntegerValue `json:"limit,omitempty"`
@@ -433,6 +1314,58 @@ type QueryObjectsInput struct {
 	Sphere     aws.StringValue  `json:"sphere"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *QueryObjectsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the QueryObjectsInput to b.
+func (v *QueryObjectsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	if v.Query != nil {
+		b = append(b, "\"query\":"...)
+		b, err = v.Query.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"sphere\":"...)
+	if v.Sphere == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Sphere)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // QueryObjectsOutput is undocumented.
 type QueryObjectsOutput struct {
 	HasMoreResults aws.BooleanValue `json:"hasMoreResults,omitempty"`
@@ -446,6 +1379,50 @@ type ReportTaskProgressInput struct {
 	TaskID aws.StringValue `json:"taskId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ReportTaskProgressInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ReportTaskProgressInput to b.
+func (v *ReportTaskProgressInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Fields) != 0 {
+		b = append(b, "\"fields\":"...)
+		b = append(b, '[')
+		for i1 := range v.Fields {
+			b, err = v.Fields[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskId\":"...)
+	if v.TaskID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ReportTaskProgressOutput is undocumented.
 type ReportTaskProgressOutput struct {
 	Canceled aws.BooleanValue `json:"canceled"`
@@ -458,6 +1435,43 @@ type ReportTaskRunnerHeartbeatInput struct {
 	WorkerGroup  aws.StringValue `json:"workerGroup,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ReportTaskRunnerHeartbeatInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ReportTaskRunnerHeartbeatInput to b.
+func (v *ReportTaskRunnerHeartbeatInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Hostname != nil {
+		b = append(b, "\"hostname\":"...)
+		b = aws.AppendJSONString(b, *v.Hostname)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskrunnerId\":"...)
+	if v.TaskrunnerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskrunnerID)
+	}
+	b = append(b, ',')
+	if v.WorkerGroup != nil {
+		b = append(b, "\"workerGroup\":"...)
+		b = aws.AppendJSONString(b, *v.WorkerGroup)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ReportTaskRunnerHeartbeatOutput is undocumented.
 type ReportTaskRunnerHeartbeatOutput struct {
 	Terminate aws.BooleanValue `json:"terminate"`
@@ -469,6 +1483,39 @@ type Selector struct {
 	Operator  *Operator       `json:"operator,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Selector) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Selector to b.
+func (v *Selector) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.FieldName != nil {
+		b = append(b, "\"fieldName\":"...)
+		b = aws.AppendJSONString(b, *v.FieldName)
+		b = append(b, ',')
+	}
+	if v.Operator != nil {
+		b = append(b, "\"operator\":"...)
+		b, err = v.Operator.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SetStatusInput is undocumented.
 type SetStatusInput struct {
 	ObjectIDs  []string        `json:"objectIds"`
@@ -476,6 +1523,56 @@ type SetStatusInput struct {
 	Status     aws.StringValue `json:"status"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SetStatusInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SetStatusInput to b.
+func (v *SetStatusInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"objectIds\":"...)
+	if v.ObjectIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.ObjectIDs {
+			b = aws.AppendJSONString(b, v.ObjectIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"status\":"...)
+	if v.Status == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Status)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SetTaskStatusInput is undocumented.
 type SetTaskStatusInput struct {
 	ErrorID         aws.StringValue `json:"errorId,omitempty"`
@@ -485,6 +1582,55 @@ type SetTaskStatusInput struct {
 	TaskStatus      aws.StringValue `json:"taskStatus"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SetTaskStatusInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SetTaskStatusInput to b.
+func (v *SetTaskStatusInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ErrorID != nil {
+		b = append(b, "\"errorId\":"...)
+		b = aws.AppendJSONString(b, *v.ErrorID)
+		b = append(b, ',')
+	}
+	if v.ErrorMessage != nil {
+		b = append(b, "\"errorMessage\":"...)
+		b = aws.AppendJSONString(b, *v.ErrorMessage)
+		b = append(b, ',')
+	}
+	if v.ErrorStackTrace != nil {
+		b = append(b, "\"errorStackTrace\":"...)
+		b = aws.AppendJSONString(b, *v.ErrorStackTrace)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskId\":"...)
+	if v.TaskID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"taskStatus\":"...)
+	if v.TaskStatus == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskStatus)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SetTaskStatusOutput is undocumented.
 type SetTaskStatusOutput struct {
 }
@@ -512,6 +1658,86 @@ type ValidatePipelineDefinitionInput struct {
 	PipelineObjects  []PipelineObject  `json:"pipelineObjects"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ValidatePipelineDefinitionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ValidatePipelineDefinitionInput to b.
+func (v *ValidatePipelineDefinitionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.ParameterObjects) != 0 {
+		b = append(b, "\"parameterObjects\":"...)
+		b = append(b, '[')
+		for i1 := range v.ParameterObjects {
+			b, err = v.ParameterObjects[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ParameterValues) != 0 {
+		b = append(b, "\"parameterValues\":"...)
+		b = append(b, '[')
+		for i1 := range v.ParameterValues {
+			b, err = v.ParameterValues[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"pipelineId\":"...)
+	if v.PipelineID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PipelineID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"pipelineObjects\":"...)
+	if v.PipelineObjects == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.PipelineObjects {
+			b, err = v.PipelineObjects[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ValidatePipelineDefinitionOutput is undocumented.
 type ValidatePipelineDefinitionOutput struct {
 	Errored            aws.BooleanValue    `json:"errored"`
@@ -531,5 +1757,42 @@ type ValidationWarning struct {
 	Warnings []string        `json:"warnings,omitempty"`
 }
 
+// InternalServiceError is the error returned for the InternalServiceError error code.
+type InternalServiceError struct {
+	aws.APIError
+}
+
+// InvalidRequestException is the error returned for the InvalidRequestException error code.
+type InvalidRequestException struct {
+	aws.APIError
+}
+
+// PipelineDeletedException is the error returned for the PipelineDeletedException error code.
+type PipelineDeletedException struct {
+	aws.APIError
+}
+
+// PipelineNotFoundException is the error returned for the PipelineNotFoundException error code.
+type PipelineNotFoundException struct {
+	aws.APIError
+}
+
+// TaskNotFoundException is the error returned for the TaskNotFoundException error code.
+type TaskNotFoundException struct {
+	aws.APIError
+}
+
+// exceptions maps DataPipeline's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InternalServiceError":      InternalServiceError{},
+	"InvalidRequestException":   InvalidRequestException{},
+	"PipelineDeletedException":  PipelineDeletedException{},
+	"PipelineNotFoundException": PipelineNotFoundException{},
+	"TaskNotFoundException":     TaskNotFoundException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
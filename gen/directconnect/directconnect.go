@@ -4,11 +4,18 @@
 package directconnect
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // DirectConnect is a client for AWS Direct Connect.
@@ -16,35 +23,77 @@ type DirectConnect struct {
 	client *aws.JSONClient
 }
 
-// New returns a new DirectConnect client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *DirectConnect {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "directconnect"
-	endpoint, service, region := endpoints.Lookup("directconnect", region)
+// New returns a new DirectConnect client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *DirectConnect {
+	cfg := newConfig(region, client, opts)
 
 	return &DirectConnect{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "OvertureService",
 		},
 	}
 }
 
+// NewFromSession returns a new DirectConnect client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *DirectConnect {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("directconnect", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("directconnect", "directconnect"),
+	}, "AWS_ENDPOINT_URL_DIRECT_CONNECT", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *DirectConnect) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *DirectConnect) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // AllocateConnectionOnInterconnect creates a hosted connection on an
 // interconnect. Allocates a number and a specified amount of bandwidth for
 // use by a hosted connection on the given interconnect.
 func (c *DirectConnect) AllocateConnectionOnInterconnect(req *AllocateConnectionOnInterconnectRequest) (resp *Connection, err error) {
+	return c.AllocateConnectionOnInterconnectWithContext(context.Background(), req)
+}
+
+// AllocateConnectionOnInterconnectWithContext is like AllocateConnectionOnInterconnect, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) AllocateConnectionOnInterconnectWithContext(ctx context.Context, req *AllocateConnectionOnInterconnectRequest) (resp *Connection, err error) {
 	resp = &Connection{}
-	err = c.client.Do("AllocateConnectionOnInterconnect", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AllocateConnectionOnInterconnect", "POST", "/", req, resp)
 	return
 }
 
@@ -57,8 +106,15 @@ func (c *DirectConnect) AllocateConnectionOnInterconnect(req *AllocateConnection
 // virtual interface will be in 'Confirming' state, and will not be
 // available for handling traffic.
 func (c *DirectConnect) AllocatePrivateVirtualInterface(req *AllocatePrivateVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
+	return c.AllocatePrivateVirtualInterfaceWithContext(context.Background(), req)
+}
+
+// AllocatePrivateVirtualInterfaceWithContext is like AllocatePrivateVirtualInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) AllocatePrivateVirtualInterfaceWithContext(ctx context.Context, req *AllocatePrivateVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
 	resp = &VirtualInterface{}
-	err = c.client.Do("AllocatePrivateVirtualInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AllocatePrivateVirtualInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -71,8 +127,15 @@ func (c *DirectConnect) AllocatePrivateVirtualInterface(req *AllocatePrivateVirt
 // virtual interface will be in 'Confirming' state, and will not be
 // available for handling traffic.
 func (c *DirectConnect) AllocatePublicVirtualInterface(req *AllocatePublicVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
+	return c.AllocatePublicVirtualInterfaceWithContext(context.Background(), req)
+}
+
+// AllocatePublicVirtualInterfaceWithContext is like AllocatePublicVirtualInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) AllocatePublicVirtualInterfaceWithContext(ctx context.Context, req *AllocatePublicVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
 	resp = &VirtualInterface{}
-	err = c.client.Do("AllocatePublicVirtualInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AllocatePublicVirtualInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -81,8 +144,15 @@ func (c *DirectConnect) AllocatePublicVirtualInterface(req *AllocatePublicVirtua
 // 'Ordering' state, and will remain in this state until the owner calls
 // ConfirmConnection to confirm creation of the hosted connection.
 func (c *DirectConnect) ConfirmConnection(req *ConfirmConnectionRequest) (resp *ConfirmConnectionResponse, err error) {
+	return c.ConfirmConnectionWithContext(context.Background(), req)
+}
+
+// ConfirmConnectionWithContext is like ConfirmConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) ConfirmConnectionWithContext(ctx context.Context, req *ConfirmConnectionRequest) (resp *ConfirmConnectionResponse, err error) {
 	resp = &ConfirmConnectionResponse{}
-	err = c.client.Do("ConfirmConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ConfirmConnection", "POST", "/", req, resp)
 	return
 }
 
@@ -92,8 +162,15 @@ func (c *DirectConnect) ConfirmConnection(req *ConfirmConnectionRequest) (resp *
 // to the given virtual private gateway, and will be available for handling
 // traffic.
 func (c *DirectConnect) ConfirmPrivateVirtualInterface(req *ConfirmPrivateVirtualInterfaceRequest) (resp *ConfirmPrivateVirtualInterfaceResponse, err error) {
+	return c.ConfirmPrivateVirtualInterfaceWithContext(context.Background(), req)
+}
+
+// ConfirmPrivateVirtualInterfaceWithContext is like ConfirmPrivateVirtualInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) ConfirmPrivateVirtualInterfaceWithContext(ctx context.Context, req *ConfirmPrivateVirtualInterfaceRequest) (resp *ConfirmPrivateVirtualInterfaceResponse, err error) {
 	resp = &ConfirmPrivateVirtualInterfaceResponse{}
-	err = c.client.Do("ConfirmPrivateVirtualInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ConfirmPrivateVirtualInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -102,8 +179,15 @@ func (c *DirectConnect) ConfirmPrivateVirtualInterface(req *ConfirmPrivateVirtua
 // calls this function, the specified virtual interface will be created and
 // made available for handling traffic.
 func (c *DirectConnect) ConfirmPublicVirtualInterface(req *ConfirmPublicVirtualInterfaceRequest) (resp *ConfirmPublicVirtualInterfaceResponse, err error) {
+	return c.ConfirmPublicVirtualInterfaceWithContext(context.Background(), req)
+}
+
+// ConfirmPublicVirtualInterfaceWithContext is like ConfirmPublicVirtualInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) ConfirmPublicVirtualInterfaceWithContext(ctx context.Context, req *ConfirmPublicVirtualInterfaceRequest) (resp *ConfirmPublicVirtualInterfaceResponse, err error) {
 	resp = &ConfirmPublicVirtualInterfaceResponse{}
-	err = c.client.Do("ConfirmPublicVirtualInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ConfirmPublicVirtualInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -117,8 +201,15 @@ func (c *DirectConnect) ConfirmPublicVirtualInterface(req *ConfirmPublicVirtualI
 // Direct Connect locations in multiple regions, but a connection in one
 // region does not provide connectivity to other regions.
 func (c *DirectConnect) CreateConnection(req *CreateConnectionRequest) (resp *Connection, err error) {
+	return c.CreateConnectionWithContext(context.Background(), req)
+}
+
+// CreateConnectionWithContext is like CreateConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) CreateConnectionWithContext(ctx context.Context, req *CreateConnectionRequest) (resp *Connection, err error) {
 	resp = &Connection{}
-	err = c.client.Do("CreateConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateConnection", "POST", "/", req, resp)
 	return
 }
 
@@ -138,8 +229,15 @@ func (c *DirectConnect) CreateConnection(req *CreateConnectionRequest) (resp *Co
 // connection, using the assigned to them by the AWS Direct Connect
 // partner.
 func (c *DirectConnect) CreateInterconnect(req *CreateInterconnectRequest) (resp *Interconnect, err error) {
+	return c.CreateInterconnectWithContext(context.Background(), req)
+}
+
+// CreateInterconnectWithContext is like CreateInterconnect, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) CreateInterconnectWithContext(ctx context.Context, req *CreateInterconnectRequest) (resp *Interconnect, err error) {
 	resp = &Interconnect{}
-	err = c.client.Do("CreateInterconnect", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateInterconnect", "POST", "/", req, resp)
 	return
 }
 
@@ -148,8 +246,15 @@ func (c *DirectConnect) CreateInterconnect(req *CreateInterconnectRequest) (resp
 // private virtual interface supports sending traffic to a single virtual
 // private cloud
 func (c *DirectConnect) CreatePrivateVirtualInterface(req *CreatePrivateVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
+	return c.CreatePrivateVirtualInterfaceWithContext(context.Background(), req)
+}
+
+// CreatePrivateVirtualInterfaceWithContext is like CreatePrivateVirtualInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) CreatePrivateVirtualInterfaceWithContext(ctx context.Context, req *CreatePrivateVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
 	resp = &VirtualInterface{}
-	err = c.client.Do("CreatePrivateVirtualInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreatePrivateVirtualInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -158,8 +263,15 @@ func (c *DirectConnect) CreatePrivateVirtualInterface(req *CreatePrivateVirtualI
 // public virtual interface supports sending traffic to public services of
 // AWS such as Amazon Simple Storage Service (Amazon S3).
 func (c *DirectConnect) CreatePublicVirtualInterface(req *CreatePublicVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
+	return c.CreatePublicVirtualInterfaceWithContext(context.Background(), req)
+}
+
+// CreatePublicVirtualInterfaceWithContext is like CreatePublicVirtualInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) CreatePublicVirtualInterfaceWithContext(ctx context.Context, req *CreatePublicVirtualInterfaceRequest) (resp *VirtualInterface, err error) {
 	resp = &VirtualInterface{}
-	err = c.client.Do("CreatePublicVirtualInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreatePublicVirtualInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -169,22 +281,43 @@ func (c *DirectConnect) CreatePublicVirtualInterface(req *CreatePublicVirtualInt
 // cross-connects or network circuits that connect you to the AWS Direct
 // Connect location.
 func (c *DirectConnect) DeleteConnection(req *DeleteConnectionRequest) (resp *Connection, err error) {
+	return c.DeleteConnectionWithContext(context.Background(), req)
+}
+
+// DeleteConnectionWithContext is like DeleteConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DeleteConnectionWithContext(ctx context.Context, req *DeleteConnectionRequest) (resp *Connection, err error) {
 	resp = &Connection{}
-	err = c.client.Do("DeleteConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteConnection", "POST", "/", req, resp)
 	return
 }
 
 // DeleteInterconnect is undocumented.
 func (c *DirectConnect) DeleteInterconnect(req *DeleteInterconnectRequest) (resp *DeleteInterconnectResponse, err error) {
+	return c.DeleteInterconnectWithContext(context.Background(), req)
+}
+
+// DeleteInterconnectWithContext is like DeleteInterconnect, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DeleteInterconnectWithContext(ctx context.Context, req *DeleteInterconnectRequest) (resp *DeleteInterconnectResponse, err error) {
 	resp = &DeleteInterconnectResponse{}
-	err = c.client.Do("DeleteInterconnect", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteInterconnect", "POST", "/", req, resp)
 	return
 }
 
 // DeleteVirtualInterface is undocumented.
 func (c *DirectConnect) DeleteVirtualInterface(req *DeleteVirtualInterfaceRequest) (resp *DeleteVirtualInterfaceResponse, err error) {
+	return c.DeleteVirtualInterfaceWithContext(context.Background(), req)
+}
+
+// DeleteVirtualInterfaceWithContext is like DeleteVirtualInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DeleteVirtualInterfaceWithContext(ctx context.Context, req *DeleteVirtualInterfaceRequest) (resp *DeleteVirtualInterfaceResponse, err error) {
 	resp = &DeleteVirtualInterfaceResponse{}
-	err = c.client.Do("DeleteVirtualInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteVirtualInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -192,16 +325,30 @@ func (c *DirectConnect) DeleteVirtualInterface(req *DeleteVirtualInterfaceReques
 // connection ID is provided, the call returns only that particular
 // connection.
 func (c *DirectConnect) DescribeConnections(req *DescribeConnectionsRequest) (resp *Connections, err error) {
+	return c.DescribeConnectionsWithContext(context.Background(), req)
+}
+
+// DescribeConnectionsWithContext is like DescribeConnections, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DescribeConnectionsWithContext(ctx context.Context, req *DescribeConnectionsRequest) (resp *Connections, err error) {
 	resp = &Connections{}
-	err = c.client.Do("DescribeConnections", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeConnections", "POST", "/", req, resp)
 	return
 }
 
 // DescribeConnectionsOnInterconnect return a list of connections that have
 // been provisioned on the given interconnect.
 func (c *DirectConnect) DescribeConnectionsOnInterconnect(req *DescribeConnectionsOnInterconnectRequest) (resp *Connections, err error) {
+	return c.DescribeConnectionsOnInterconnectWithContext(context.Background(), req)
+}
+
+// DescribeConnectionsOnInterconnectWithContext is like DescribeConnectionsOnInterconnect, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DescribeConnectionsOnInterconnectWithContext(ctx context.Context, req *DescribeConnectionsOnInterconnectRequest) (resp *Connections, err error) {
 	resp = &Connections{}
-	err = c.client.Do("DescribeConnectionsOnInterconnect", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeConnectionsOnInterconnect", "POST", "/", req, resp)
 	return
 }
 
@@ -209,8 +356,15 @@ func (c *DirectConnect) DescribeConnectionsOnInterconnect(req *DescribeConnectio
 // account. If an interconnect ID is provided, it will only return this
 // particular interconnect.
 func (c *DirectConnect) DescribeInterconnects(req *DescribeInterconnectsRequest) (resp *Interconnects, err error) {
+	return c.DescribeInterconnectsWithContext(context.Background(), req)
+}
+
+// DescribeInterconnectsWithContext is like DescribeInterconnects, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DescribeInterconnectsWithContext(ctx context.Context, req *DescribeInterconnectsRequest) (resp *Interconnects, err error) {
 	resp = &Interconnects{}
-	err = c.client.Do("DescribeInterconnects", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeInterconnects", "POST", "/", req, resp)
 	return
 }
 
@@ -218,8 +372,15 @@ func (c *DirectConnect) DescribeInterconnects(req *DescribeInterconnectsRequest)
 // the current AWS region. These are the locations that may be selected
 // when calling CreateConnection or CreateInterconnect.
 func (c *DirectConnect) DescribeLocations() (resp *Locations, err error) {
+	return c.DescribeLocationsWithContext(context.Background())
+}
+
+// DescribeLocationsWithContext is like DescribeLocations, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DescribeLocationsWithContext(ctx context.Context) (resp *Locations, err error) {
 	resp = &Locations{}
-	err = c.client.Do("DescribeLocations", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLocations", "POST", "/", nil, resp)
 	return
 }
 
@@ -229,8 +390,15 @@ func (c *DirectConnect) DescribeLocations() (resp *Locations, err error) {
 // virtual private gateway can be managed via Amazon Virtual Private Cloud
 // console or the EC2 CreateVpnGateway action.
 func (c *DirectConnect) DescribeVirtualGateways() (resp *VirtualGateways, err error) {
+	return c.DescribeVirtualGatewaysWithContext(context.Background())
+}
+
+// DescribeVirtualGatewaysWithContext is like DescribeVirtualGateways, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DescribeVirtualGatewaysWithContext(ctx context.Context) (resp *VirtualGateways, err error) {
 	resp = &VirtualGateways{}
-	err = c.client.Do("DescribeVirtualGateways", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVirtualGateways", "POST", "/", nil, resp)
 	return
 }
 
@@ -246,8 +414,15 @@ func (c *DirectConnect) DescribeVirtualGateways() (resp *VirtualGateways, err er
 // interface ID is provided, only this particular virtual interface will be
 // returned.
 func (c *DirectConnect) DescribeVirtualInterfaces(req *DescribeVirtualInterfacesRequest) (resp *VirtualInterfaces, err error) {
+	return c.DescribeVirtualInterfacesWithContext(context.Background(), req)
+}
+
+// DescribeVirtualInterfacesWithContext is like DescribeVirtualInterfaces, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DirectConnect) DescribeVirtualInterfacesWithContext(ctx context.Context, req *DescribeVirtualInterfacesRequest) (resp *VirtualInterfaces, err error) {
 	resp = &VirtualInterfaces{}
-	err = c.client.Do("DescribeVirtualInterfaces", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVirtualInterfaces", "POST", "/", req, resp)
 	return
 }
 
@@ -260,6 +435,61 @@ type AllocateConnectionOnInterconnectRequest struct {
 	VLAN           aws.IntegerValue `json:"vlan"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AllocateConnectionOnInterconnectRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AllocateConnectionOnInterconnectRequest to b.
+func (v *AllocateConnectionOnInterconnectRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"bandwidth\":"...)
+	if v.Bandwidth == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Bandwidth)
+	}
+	b = append(b, ',')
+	b = append(b, "\"connectionName\":"...)
+	if v.ConnectionName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"interconnectId\":"...)
+	if v.InterconnectID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InterconnectID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"ownerAccount\":"...)
+	if v.OwnerAccount == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.OwnerAccount)
+	}
+	b = append(b, ',')
+	b = append(b, "\"vlan\":"...)
+	if v.VLAN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.VLAN), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AllocatePrivateVirtualInterfaceRequest is undocumented.
 type AllocatePrivateVirtualInterfaceRequest struct {
 	ConnectionID                         aws.StringValue                       `json:"connectionId"`
@@ -267,6 +497,50 @@ type AllocatePrivateVirtualInterfaceRequest struct {
 	OwnerAccount                         aws.StringValue                       `json:"ownerAccount"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AllocatePrivateVirtualInterfaceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AllocatePrivateVirtualInterfaceRequest to b.
+func (v *AllocatePrivateVirtualInterfaceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"connectionId\":"...)
+	if v.ConnectionID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"newPrivateVirtualInterfaceAllocation\":"...)
+	if v.NewPrivateVirtualInterfaceAllocation == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.NewPrivateVirtualInterfaceAllocation.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"ownerAccount\":"...)
+	if v.OwnerAccount == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.OwnerAccount)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AllocatePublicVirtualInterfaceRequest is undocumented.
 type AllocatePublicVirtualInterfaceRequest struct {
 	ConnectionID                        aws.StringValue                      `json:"connectionId"`
@@ -274,11 +548,82 @@ type AllocatePublicVirtualInterfaceRequest struct {
 	OwnerAccount                        aws.StringValue                      `json:"ownerAccount"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AllocatePublicVirtualInterfaceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AllocatePublicVirtualInterfaceRequest to b.
+func (v *AllocatePublicVirtualInterfaceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"connectionId\":"...)
+	if v.ConnectionID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"newPublicVirtualInterfaceAllocation\":"...)
+	if v.NewPublicVirtualInterfaceAllocation == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.NewPublicVirtualInterfaceAllocation.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"ownerAccount\":"...)
+	if v.OwnerAccount == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.OwnerAccount)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ConfirmConnectionRequest is undocumented.
 type ConfirmConnectionRequest struct {
 	ConnectionID aws.StringValue `json:"connectionId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ConfirmConnectionRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ConfirmConnectionRequest to b.
+func (v *ConfirmConnectionRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"connectionId\":"...)
+	if v.ConnectionID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ConfirmConnectionResponse is undocumented.
 type ConfirmConnectionResponse struct {
 	ConnectionState aws.StringValue `json:"connectionState,omitempty"`
@@ -290,6 +635,40 @@ type ConfirmPrivateVirtualInterfaceRequest struct {
 	VirtualInterfaceID aws.StringValue `json:"virtualInterfaceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ConfirmPrivateVirtualInterfaceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ConfirmPrivateVirtualInterfaceRequest to b.
+func (v *ConfirmPrivateVirtualInterfaceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"virtualGatewayId\":"...)
+	if v.VirtualGatewayID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualGatewayID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"virtualInterfaceId\":"...)
+	if v.VirtualInterfaceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ConfirmPrivateVirtualInterfaceResponse is undocumented.
 type ConfirmPrivateVirtualInterfaceResponse struct {
 	VirtualInterfaceState aws.StringValue `json:"virtualInterfaceState,omitempty"`
@@ -300,6 +679,33 @@ type ConfirmPublicVirtualInterfaceRequest struct {
 	VirtualInterfaceID aws.StringValue `json:"virtualInterfaceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ConfirmPublicVirtualInterfaceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ConfirmPublicVirtualInterfaceRequest to b.
+func (v *ConfirmPublicVirtualInterfaceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"virtualInterfaceId\":"...)
+	if v.VirtualInterfaceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ConfirmPublicVirtualInterfaceResponse is undocumented.
 type ConfirmPublicVirtualInterfaceResponse struct {
 	VirtualInterfaceState aws.StringValue `json:"virtualInterfaceState,omitempty"`
@@ -342,6 +748,47 @@ type CreateConnectionRequest struct {
 	Location       aws.StringValue `json:"location"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateConnectionRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateConnectionRequest to b.
+func (v *CreateConnectionRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"bandwidth\":"...)
+	if v.Bandwidth == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Bandwidth)
+	}
+	b = append(b, ',')
+	b = append(b, "\"connectionName\":"...)
+	if v.ConnectionName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"location\":"...)
+	if v.Location == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Location)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateInterconnectRequest is undocumented.
 type CreateInterconnectRequest struct {
 	Bandwidth        aws.StringValue `json:"bandwidth"`
@@ -349,28 +796,197 @@ type CreateInterconnectRequest struct {
 	Location         aws.StringValue `json:"location"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateInterconnectRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateInterconnectRequest to b.
+func (v *CreateInterconnectRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"bandwidth\":"...)
+	if v.Bandwidth == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Bandwidth)
+	}
+	b = append(b, ',')
+	b = append(b, "\"interconnectName\":"...)
+	if v.InterconnectName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InterconnectName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"location\":"...)
+	if v.Location == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Location)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreatePrivateVirtualInterfaceRequest is undocumented.
 type CreatePrivateVirtualInterfaceRequest struct {
 	ConnectionID               aws.StringValue             `json:"connectionId"`
 	NewPrivateVirtualInterface *NewPrivateVirtualInterface `json:"newPrivateVirtualInterface"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreatePrivateVirtualInterfaceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreatePrivateVirtualInterfaceRequest to b.
+func (v *CreatePrivateVirtualInterfaceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"connectionId\":"...)
+	if v.ConnectionID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"newPrivateVirtualInterface\":"...)
+	if v.NewPrivateVirtualInterface == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.NewPrivateVirtualInterface.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreatePublicVirtualInterfaceRequest is undocumented.
 type CreatePublicVirtualInterfaceRequest struct {
 	ConnectionID              aws.StringValue            `json:"connectionId"`
 	NewPublicVirtualInterface *NewPublicVirtualInterface `json:"newPublicVirtualInterface"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreatePublicVirtualInterfaceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreatePublicVirtualInterfaceRequest to b.
+func (v *CreatePublicVirtualInterfaceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"connectionId\":"...)
+	if v.ConnectionID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"newPublicVirtualInterface\":"...)
+	if v.NewPublicVirtualInterface == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.NewPublicVirtualInterface.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteConnectionRequest is undocumented.
 type DeleteConnectionRequest struct {
 	ConnectionID aws.StringValue `json:"connectionId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteConnectionRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteConnectionRequest to b.
+func (v *DeleteConnectionRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"connectionId\":"...)
+	if v.ConnectionID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteInterconnectRequest is undocumented.
 type DeleteInterconnectRequest struct {
 	InterconnectID aws.StringValue `json:"interconnectId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteInterconnectRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteInterconnectRequest to b.
+func (v *DeleteInterconnectRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"interconnectId\":"...)
+	if v.InterconnectID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InterconnectID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteInterconnectResponse is undocumented.
 type DeleteInterconnectResponse struct {
 	InterconnectState aws.StringValue `json:"interconnectState,omitempty"`
@@ -381,6 +997,33 @@ type DeleteVirtualInterfaceRequest struct {
 	VirtualInterfaceID aws.StringValue `json:"virtualInterfaceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteVirtualInterfaceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteVirtualInterfaceRequest to b.
+func (v *DeleteVirtualInterfaceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"virtualInterfaceId\":"...)
+	if v.VirtualInterfaceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteVirtualInterfaceResponse is undocumented.
 type DeleteVirtualInterfaceResponse struct {
 	VirtualInterfaceState aws.StringValue `json:"virtualInterfaceState,omitempty"`
@@ -391,22 +1034,129 @@ type DescribeConnectionsOnInterconnectRequest struct {
 	InterconnectID aws.StringValue `json:"interconnectId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeConnectionsOnInterconnectRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeConnectionsOnInterconnectRequest to b.
+func (v *DescribeConnectionsOnInterconnectRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"interconnectId\":"...)
+	if v.InterconnectID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InterconnectID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeConnectionsRequest is undocumented.
 type DescribeConnectionsRequest struct {
 	ConnectionID aws.StringValue `json:"connectionId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeConnectionsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeConnectionsRequest to b.
+func (v *DescribeConnectionsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ConnectionID != nil {
+		b = append(b, "\"connectionId\":"...)
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeInterconnectsRequest is undocumented.
 type DescribeInterconnectsRequest struct {
 	InterconnectID aws.StringValue `json:"interconnectId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeInterconnectsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeInterconnectsRequest to b.
+func (v *DescribeInterconnectsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.InterconnectID != nil {
+		b = append(b, "\"interconnectId\":"...)
+		b = aws.AppendJSONString(b, *v.InterconnectID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeVirtualInterfacesRequest is undocumented.
 type DescribeVirtualInterfacesRequest struct {
 	ConnectionID       aws.StringValue `json:"connectionId,omitempty"`
 	VirtualInterfaceID aws.StringValue `json:"virtualInterfaceId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeVirtualInterfacesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeVirtualInterfacesRequest to b.
+func (v *DescribeVirtualInterfacesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ConnectionID != nil {
+		b = append(b, "\"connectionId\":"...)
+		b = aws.AppendJSONString(b, *v.ConnectionID)
+		b = append(b, ',')
+	}
+	if v.VirtualInterfaceID != nil {
+		b = append(b, "\"virtualInterfaceId\":"...)
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Interconnect is undocumented.
 type Interconnect struct {
 	Bandwidth         aws.StringValue `json:"bandwidth,omitempty"`
@@ -454,6 +1204,69 @@ type NewPrivateVirtualInterface struct {
 	VLAN                 aws.IntegerValue `json:"vlan"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *NewPrivateVirtualInterface) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the NewPrivateVirtualInterface to b.
+func (v *NewPrivateVirtualInterface) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AmazonAddress != nil {
+		b = append(b, "\"amazonAddress\":"...)
+		b = aws.AppendJSONString(b, *v.AmazonAddress)
+		b = append(b, ',')
+	}
+	b = append(b, "\"asn\":"...)
+	if v.ASN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.ASN), 10)
+	}
+	b = append(b, ',')
+	if v.AuthKey != nil {
+		b = append(b, "\"authKey\":"...)
+		b = aws.AppendJSONString(b, *v.AuthKey)
+		b = append(b, ',')
+	}
+	if v.CustomerAddress != nil {
+		b = append(b, "\"customerAddress\":"...)
+		b = aws.AppendJSONString(b, *v.CustomerAddress)
+		b = append(b, ',')
+	}
+	b = append(b, "\"virtualGatewayId\":"...)
+	if v.VirtualGatewayID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualGatewayID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"virtualInterfaceName\":"...)
+	if v.VirtualInterfaceName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"vlan\":"...)
+	if v.VLAN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.VLAN), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // NewPrivateVirtualInterfaceAllocation is undocumented.
 type NewPrivateVirtualInterfaceAllocation struct {
 	AmazonAddress        aws.StringValue  `json:"amazonAddress,omitempty"`
@@ -464,6 +1277,62 @@ type NewPrivateVirtualInterfaceAllocation struct {
 	VLAN                 aws.IntegerValue `json:"vlan"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *NewPrivateVirtualInterfaceAllocation) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the NewPrivateVirtualInterfaceAllocation to b.
+func (v *NewPrivateVirtualInterfaceAllocation) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AmazonAddress != nil {
+		b = append(b, "\"amazonAddress\":"...)
+		b = aws.AppendJSONString(b, *v.AmazonAddress)
+		b = append(b, ',')
+	}
+	b = append(b, "\"asn\":"...)
+	if v.ASN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.ASN), 10)
+	}
+	b = append(b, ',')
+	if v.AuthKey != nil {
+		b = append(b, "\"authKey\":"...)
+		b = aws.AppendJSONString(b, *v.AuthKey)
+		b = append(b, ',')
+	}
+	if v.CustomerAddress != nil {
+		b = append(b, "\"customerAddress\":"...)
+		b = aws.AppendJSONString(b, *v.CustomerAddress)
+		b = append(b, ',')
+	}
+	b = append(b, "\"virtualInterfaceName\":"...)
+	if v.VirtualInterfaceName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"vlan\":"...)
+	if v.VLAN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.VLAN), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // NewPublicVirtualInterface is undocumented.
 type NewPublicVirtualInterface struct {
 	AmazonAddress        aws.StringValue     `json:"amazonAddress"`
@@ -475,6 +1344,85 @@ type NewPublicVirtualInterface struct {
 	VLAN                 aws.IntegerValue    `json:"vlan"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *NewPublicVirtualInterface) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the NewPublicVirtualInterface to b.
+func (v *NewPublicVirtualInterface) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"amazonAddress\":"...)
+	if v.AmazonAddress == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AmazonAddress)
+	}
+	b = append(b, ',')
+	b = append(b, "\"asn\":"...)
+	if v.ASN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.ASN), 10)
+	}
+	b = append(b, ',')
+	if v.AuthKey != nil {
+		b = append(b, "\"authKey\":"...)
+		b = aws.AppendJSONString(b, *v.AuthKey)
+		b = append(b, ',')
+	}
+	b = append(b, "\"customerAddress\":"...)
+	if v.CustomerAddress == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CustomerAddress)
+	}
+	b = append(b, ',')
+	b = append(b, "\"routeFilterPrefixes\":"...)
+	if v.RouteFilterPrefixes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.RouteFilterPrefixes {
+			b, err = v.RouteFilterPrefixes[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"virtualInterfaceName\":"...)
+	if v.VirtualInterfaceName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"vlan\":"...)
+	if v.VLAN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.VLAN), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // NewPublicVirtualInterfaceAllocation is undocumented.
 type NewPublicVirtualInterfaceAllocation struct {
 	AmazonAddress        aws.StringValue     `json:"amazonAddress"`
@@ -486,11 +1434,115 @@ type NewPublicVirtualInterfaceAllocation struct {
 	VLAN                 aws.IntegerValue    `json:"vlan"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *NewPublicVirtualInterfaceAllocation) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the NewPublicVirtualInterfaceAllocation to b.
+func (v *NewPublicVirtualInterfaceAllocation) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"amazonAddress\":"...)
+	if v.AmazonAddress == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AmazonAddress)
+	}
+	b = append(b, ',')
+	b = append(b, "\"asn\":"...)
+	if v.ASN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.ASN), 10)
+	}
+	b = append(b, ',')
+	if v.AuthKey != nil {
+		b = append(b, "\"authKey\":"...)
+		b = aws.AppendJSONString(b, *v.AuthKey)
+		b = append(b, ',')
+	}
+	b = append(b, "\"customerAddress\":"...)
+	if v.CustomerAddress == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CustomerAddress)
+	}
+	b = append(b, ',')
+	b = append(b, "\"routeFilterPrefixes\":"...)
+	if v.RouteFilterPrefixes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.RouteFilterPrefixes {
+			b, err = v.RouteFilterPrefixes[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"virtualInterfaceName\":"...)
+	if v.VirtualInterfaceName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VirtualInterfaceName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"vlan\":"...)
+	if v.VLAN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.VLAN), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RouteFilterPrefix is undocumented.
 type RouteFilterPrefix struct {
 	CIDR aws.StringValue `json:"cidr,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RouteFilterPrefix) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RouteFilterPrefix to b.
+func (v *RouteFilterPrefix) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CIDR != nil {
+		b = append(b, "\"cidr\":"...)
+		b = aws.AppendJSONString(b, *v.CIDR)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // VirtualGateway is undocumented.
 type VirtualGateway struct {
 	VirtualGatewayID    aws.StringValue `json:"virtualGatewayId,omitempty"`
@@ -537,5 +1589,24 @@ type VirtualInterfaces struct {
 	VirtualInterfaces []VirtualInterface `json:"virtualInterfaces,omitempty"`
 }
 
+// DirectConnectClientException is the error returned for the DirectConnectClientException error code.
+type DirectConnectClientException struct {
+	aws.APIError
+}
+
+// DirectConnectServerException is the error returned for the DirectConnectServerException error code.
+type DirectConnectServerException struct {
+	aws.APIError
+}
+
+// exceptions maps DirectConnect's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"DirectConnectClientException": DirectConnectClientException{},
+	"DirectConnectServerException": DirectConnectServerException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -4,11 +4,18 @@
 package dynamodb
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // DynamoDB is a client for Amazon DynamoDB.
@@ -16,29 +23,64 @@ type DynamoDB struct {
 	client *aws.JSONClient
 }
 
-// New returns a new DynamoDB client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *DynamoDB {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "dynamodb"
-	endpoint, service, region := endpoints.Lookup("dynamodb", region)
+// New returns a new DynamoDB client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *DynamoDB {
+	cfg := newConfig(region, client, opts)
 
 	return &DynamoDB{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.0",
 			TargetPrefix: "DynamoDB_20120810",
 		},
 	}
 }
 
+// NewFromSession returns a new DynamoDB client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *DynamoDB {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("dynamodb", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("dynamodb", "dynamodb"),
+	}, "AWS_ENDPOINT_URL_DYNAMODB", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *DynamoDB) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *DynamoDB) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // BatchGetItem the BatchGetItem operation returns the attributes of one or
 // more items from one or more tables. You identify requested items by
 // primary key. A single operation can retrieve up to 16 MB of data, which
@@ -79,8 +121,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Dyn
 // information, see Capacity Units Calculations in the Amazon DynamoDB
 // Developer Guide
 func (c *DynamoDB) BatchGetItem(req *BatchGetItemInput) (resp *BatchGetItemOutput, err error) {
+	return c.BatchGetItemWithContext(context.Background(), req)
+}
+
+// BatchGetItemWithContext is like BatchGetItem, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) BatchGetItemWithContext(ctx context.Context, req *BatchGetItemInput) (resp *BatchGetItemOutput, err error) {
 	resp = &BatchGetItemOutput{}
-	err = c.client.Do("BatchGetItem", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "BatchGetItem", "POST", "/", req, resp)
 	return
 }
 
@@ -135,8 +184,15 @@ func (c *DynamoDB) BatchGetItem(req *BatchGetItemInput) (resp *BatchGetItemOutpu
 // same item in the same BatchWriteItem request. There are more than 25
 // requests in the batch.
 func (c *DynamoDB) BatchWriteItem(req *BatchWriteItemInput) (resp *BatchWriteItemOutput, err error) {
+	return c.BatchWriteItemWithContext(context.Background(), req)
+}
+
+// BatchWriteItemWithContext is like BatchWriteItem, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) BatchWriteItemWithContext(ctx context.Context, req *BatchWriteItemInput) (resp *BatchWriteItemOutput, err error) {
 	resp = &BatchWriteItemOutput{}
-	err = c.client.Do("BatchWriteItem", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "BatchWriteItem", "POST", "/", req, resp)
 	return
 }
 
@@ -152,8 +208,15 @@ func (c *DynamoDB) BatchWriteItem(req *BatchWriteItemInput) (resp *BatchWriteIte
 // indexes can be in the state at any given time. You can use the
 // DescribeTable API to check the table status.
 func (c *DynamoDB) CreateTable(req *CreateTableInput) (resp *CreateTableOutput, err error) {
+	return c.CreateTableWithContext(context.Background(), req)
+}
+
+// CreateTableWithContext is like CreateTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) CreateTableWithContext(ctx context.Context, req *CreateTableInput) (resp *CreateTableOutput, err error) {
 	resp = &CreateTableOutput{}
-	err = c.client.Do("CreateTable", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateTable", "POST", "/", req, resp)
 	return
 }
 
@@ -168,8 +231,15 @@ func (c *DynamoDB) CreateTable(req *CreateTableInput) (resp *CreateTableOutput,
 // specific conditions are met. If those conditions are met, DynamoDB
 // performs the delete. Otherwise, the item is not deleted.
 func (c *DynamoDB) DeleteItem(req *DeleteItemInput) (resp *DeleteItemOutput, err error) {
+	return c.DeleteItemWithContext(context.Background(), req)
+}
+
+// DeleteItemWithContext is like DeleteItem, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) DeleteItemWithContext(ctx context.Context, req *DeleteItemInput) (resp *DeleteItemOutput, err error) {
 	resp = &DeleteItemOutput{}
-	err = c.client.Do("DeleteItem", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteItem", "POST", "/", req, resp)
 	return
 }
 
@@ -183,8 +253,15 @@ func (c *DynamoDB) DeleteItem(req *DeleteItemInput) (resp *DeleteItemOutput, err
 // are also deleted. Use the DescribeTable API to check the status of the
 // table.
 func (c *DynamoDB) DeleteTable(req *DeleteTableInput) (resp *DeleteTableOutput, err error) {
+	return c.DeleteTableWithContext(context.Background(), req)
+}
+
+// DeleteTableWithContext is like DeleteTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) DeleteTableWithContext(ctx context.Context, req *DeleteTableInput) (resp *DeleteTableOutput, err error) {
 	resp = &DeleteTableOutput{}
-	err = c.client.Do("DeleteTable", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteTable", "POST", "/", req, resp)
 	return
 }
 
@@ -192,8 +269,15 @@ func (c *DynamoDB) DeleteTable(req *DeleteTableInput) (resp *DeleteTableOutput,
 // status of the table, when it was created, the primary key schema, and
 // any indexes on the table.
 func (c *DynamoDB) DescribeTable(req *DescribeTableInput) (resp *DescribeTableOutput, err error) {
+	return c.DescribeTableWithContext(context.Background(), req)
+}
+
+// DescribeTableWithContext is like DescribeTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) DescribeTableWithContext(ctx context.Context, req *DescribeTableInput) (resp *DescribeTableOutput, err error) {
 	resp = &DescribeTableOutput{}
-	err = c.client.Do("DescribeTable", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTable", "POST", "/", req, resp)
 	return
 }
 
@@ -205,8 +289,15 @@ func (c *DynamoDB) DescribeTable(req *DescribeTableInput) (resp *DescribeTableOu
 // more time than an eventually consistent read, it always returns the last
 // updated value.
 func (c *DynamoDB) GetItem(req *GetItemInput) (resp *GetItemOutput, err error) {
+	return c.GetItemWithContext(context.Background(), req)
+}
+
+// GetItemWithContext is like GetItem, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) GetItemWithContext(ctx context.Context, req *GetItemInput) (resp *GetItemOutput, err error) {
 	resp = &GetItemOutput{}
-	err = c.client.Do("GetItem", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetItem", "POST", "/", req, resp)
 	return
 }
 
@@ -214,8 +305,15 @@ func (c *DynamoDB) GetItem(req *GetItemInput) (resp *GetItemOutput, err error) {
 // account and endpoint. The output from ListTables is paginated, with each
 // page returning a maximum of 100 table names.
 func (c *DynamoDB) ListTables(req *ListTablesInput) (resp *ListTablesOutput, err error) {
+	return c.ListTablesWithContext(context.Background(), req)
+}
+
+// ListTablesWithContext is like ListTables, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) ListTablesWithContext(ctx context.Context, req *ListTablesInput) (resp *ListTablesOutput, err error) {
 	resp = &ListTablesOutput{}
-	err = c.client.Do("ListTables", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListTables", "POST", "/", req, resp)
 	return
 }
 
@@ -237,8 +335,15 @@ func (c *DynamoDB) ListTables(req *ListTablesInput) (resp *ListTablesOutput, err
 // information about using this see Working with Items in the Amazon
 // DynamoDB Developer Guide
 func (c *DynamoDB) PutItem(req *PutItemInput) (resp *PutItemOutput, err error) {
+	return c.PutItemWithContext(context.Background(), req)
+}
+
+// PutItemWithContext is like PutItem, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) PutItemWithContext(ctx context.Context, req *PutItemInput) (resp *PutItemOutput, err error) {
 	resp = &PutItemOutput{}
-	err = c.client.Do("PutItem", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PutItem", "POST", "/", req, resp)
 	return
 }
 
@@ -262,8 +367,15 @@ func (c *DynamoDB) PutItem(req *PutItemInput) (resp *PutItemOutput, err error) {
 // eventually consistent reads only, so do not specify ConsistentRead when
 // querying a global secondary index.
 func (c *DynamoDB) Query(req *QueryInput) (resp *QueryOutput, err error) {
+	return c.QueryWithContext(context.Background(), req)
+}
+
+// QueryWithContext is like Query, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) QueryWithContext(ctx context.Context, req *QueryInput) (resp *QueryOutput, err error) {
 	resp = &QueryOutput{}
-	err = c.client.Do("Query", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "Query", "POST", "/", req, resp)
 	return
 }
 
@@ -281,8 +393,15 @@ func (c *DynamoDB) Query(req *QueryInput) (resp *QueryOutput, err error) {
 // parameters. For more information, see Parallel Scan in the Amazon
 // DynamoDB Developer Guide
 func (c *DynamoDB) Scan(req *ScanInput) (resp *ScanOutput, err error) {
+	return c.ScanWithContext(context.Background(), req)
+}
+
+// ScanWithContext is like Scan, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) ScanWithContext(ctx context.Context, req *ScanInput) (resp *ScanOutput, err error) {
 	resp = &ScanOutput{}
-	err = c.client.Do("Scan", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "Scan", "POST", "/", req, resp)
 	return
 }
 
@@ -294,8 +413,15 @@ func (c *DynamoDB) Scan(req *ScanInput) (resp *ScanOutput, err error) {
 // You can also return the item's attribute values in the same UpdateItem
 // operation using the ReturnValues parameter.
 func (c *DynamoDB) UpdateItem(req *UpdateItemInput) (resp *UpdateItemOutput, err error) {
+	return c.UpdateItemWithContext(context.Background(), req)
+}
+
+// UpdateItemWithContext is like UpdateItem, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) UpdateItemWithContext(ctx context.Context, req *UpdateItemInput) (resp *UpdateItemOutput, err error) {
 	resp = &UpdateItemOutput{}
-	err = c.client.Do("UpdateItem", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateItem", "POST", "/", req, resp)
 	return
 }
 
@@ -313,8 +439,15 @@ func (c *DynamoDB) UpdateItem(req *UpdateItemInput) (resp *UpdateItemOutput, err
 // modify or delete indexes using UpdateTable . Indexes can only be defined
 // at table creation time.
 func (c *DynamoDB) UpdateTable(req *UpdateTableInput) (resp *UpdateTableOutput, err error) {
+	return c.UpdateTableWithContext(context.Background(), req)
+}
+
+// UpdateTableWithContext is like UpdateTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *DynamoDB) UpdateTableWithContext(ctx context.Context, req *UpdateTableInput) (resp *UpdateTableOutput, err error) {
 	resp = &UpdateTableOutput{}
-	err = c.client.Do("UpdateTable", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateTable", "POST", "/", req, resp)
 	return
 }
 
@@ -331,6 +464,40 @@ type AttributeDefinition struct {
 	AttributeType aws.StringValue `json:"AttributeType"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AttributeDefinition) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AttributeDefinition to b.
+func (v *AttributeDefinition) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AttributeName\":"...)
+	if v.AttributeName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AttributeName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"AttributeType\":"...)
+	if v.AttributeType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AttributeType)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AttributeValue is undocumented.
 type AttributeValue struct {
 	B    []byte                    `json:"B,omitempty"`
@@ -345,18 +512,232 @@ type AttributeValue struct {
 	SS   []string                  `json:"SS,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AttributeValue) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AttributeValue to b.
+func (v *AttributeValue) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.B) != 0 {
+		b = append(b, "\"B\":"...)
+		b = aws.AppendJSONBytes(b, v.B)
+		b = append(b, ',')
+	}
+	if v.BOOL != nil {
+		b = append(b, "\"BOOL\":"...)
+		b = strconv.AppendBool(b, *v.BOOL)
+		b = append(b, ',')
+	}
+	if len(v.BS) != 0 {
+		b = append(b, "\"BS\":"...)
+		b = append(b, '[')
+		for i1 := range v.BS {
+			b = aws.AppendJSONBytes(b, v.BS[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.L) != 0 {
+		b = append(b, "\"L\":"...)
+		b = append(b, '[')
+		for i1 := range v.L {
+			b, err = v.L[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.M) != 0 {
+		b = append(b, "\"M\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.M))
+		for k := range v.M {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.M[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.N != nil {
+		b = append(b, "\"N\":"...)
+		b = aws.AppendJSONString(b, *v.N)
+		b = append(b, ',')
+	}
+	if len(v.NS) != 0 {
+		b = append(b, "\"NS\":"...)
+		b = append(b, '[')
+		for i1 := range v.NS {
+			b = aws.AppendJSONString(b, v.NS[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.NULL != nil {
+		b = append(b, "\"NULL\":"...)
+		b = strconv.AppendBool(b, *v.NULL)
+		b = append(b, ',')
+	}
+	if v.S != nil {
+		b = append(b, "\"S\":"...)
+		b = aws.AppendJSONString(b, *v.S)
+		b = append(b, ',')
+	}
+	if len(v.SS) != 0 {
+		b = append(b, "\"SS\":"...)
+		b = append(b, '[')
+		for i1 := range v.SS {
+			b = aws.AppendJSONString(b, v.SS[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AttributeValueUpdate is undocumented.
 type AttributeValueUpdate struct {
 	Action aws.StringValue `json:"Action,omitempty"`
 	Value  *AttributeValue `json:"Value,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AttributeValueUpdate) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AttributeValueUpdate to b.
+func (v *AttributeValueUpdate) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Action != nil {
+		b = append(b, "\"Action\":"...)
+		b = aws.AppendJSONString(b, *v.Action)
+		b = append(b, ',')
+	}
+	if v.Value != nil {
+		b = append(b, "\"Value\":"...)
+		b, err = v.Value.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // BatchGetItemInput is undocumented.
 type BatchGetItemInput struct {
 	RequestItems           map[string]KeysAndAttributes `json:"RequestItems"`
 	ReturnConsumedCapacity aws.StringValue              `json:"ReturnConsumedCapacity,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *BatchGetItemInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the BatchGetItemInput to b.
+func (v *BatchGetItemInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"RequestItems\":"...)
+	if v.RequestItems == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.RequestItems))
+		for k := range v.RequestItems {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.RequestItems[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // BatchGetItemOutput is undocumented.
 type BatchGetItemOutput struct {
 	ConsumedCapacity []ConsumedCapacity                     `json:"ConsumedCapacity,omitempty"`
@@ -371,6 +752,76 @@ type BatchWriteItemInput struct {
 	ReturnItemCollectionMetrics aws.StringValue           `json:"ReturnItemCollectionMetrics,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *BatchWriteItemInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the BatchWriteItemInput to b.
+func (v *BatchWriteItemInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"RequestItems\":"...)
+	if v.RequestItems == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.RequestItems))
+		for k := range v.RequestItems {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.RequestItems[k1]
+			if v1 == nil {
+				b = append(b, "null"...)
+			} else {
+				b = append(b, '[')
+				for i2 := range v1 {
+					b, err = v1[i2].appendJSON(b)
+					if err != nil {
+						return nil, err
+					}
+					b = append(b, ',')
+				}
+				if b[len(b)-1] == ',' {
+					b[len(b)-1] = ']'
+				} else {
+					b = append(b, ']')
+				}
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	if v.ReturnItemCollectionMetrics != nil {
+		b = append(b, "\"ReturnItemCollectionMetrics\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnItemCollectionMetrics)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // BatchWriteItemOutput is undocumented.
 type BatchWriteItemOutput struct {
 	ConsumedCapacity      []ConsumedCapacity                 `json:"ConsumedCapacity,omitempty"`
@@ -406,6 +857,50 @@ type Condition struct {
 	ComparisonOperator aws.StringValue  `json:"ComparisonOperator"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Condition) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Condition to b.
+func (v *Condition) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AttributeValueList) != 0 {
+		b = append(b, "\"AttributeValueList\":"...)
+		b = append(b, '[')
+		for i1 := range v.AttributeValueList {
+			b, err = v.AttributeValueList[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"ComparisonOperator\":"...)
+	if v.ComparisonOperator == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ComparisonOperator)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for DynamoDB.
 const (
 	ConditionalOperatorAnd = "AND"
@@ -431,6 +926,115 @@ type CreateTableInput struct {
 	TableName              aws.StringValue        `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateTableInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateTableInput to b.
+func (v *CreateTableInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AttributeDefinitions\":"...)
+	if v.AttributeDefinitions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.AttributeDefinitions {
+			b, err = v.AttributeDefinitions[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if len(v.GlobalSecondaryIndexes) != 0 {
+		b = append(b, "\"GlobalSecondaryIndexes\":"...)
+		b = append(b, '[')
+		for i1 := range v.GlobalSecondaryIndexes {
+			b, err = v.GlobalSecondaryIndexes[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"KeySchema\":"...)
+	if v.KeySchema == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.KeySchema {
+			b, err = v.KeySchema[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if len(v.LocalSecondaryIndexes) != 0 {
+		b = append(b, "\"LocalSecondaryIndexes\":"...)
+		b = append(b, '[')
+		for i1 := range v.LocalSecondaryIndexes {
+			b, err = v.LocalSecondaryIndexes[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"ProvisionedThroughput\":"...)
+	if v.ProvisionedThroughput == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ProvisionedThroughput.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateTableOutput is undocumented.
 type CreateTableOutput struct {
 	TableDescription *TableDescription `json:"TableDescription,omitempty"`
@@ -450,6 +1054,157 @@ type DeleteItemInput struct {
 	TableName                   aws.StringValue                   `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteItemInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteItemInput to b.
+func (v *DeleteItemInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ConditionExpression != nil {
+		b = append(b, "\"ConditionExpression\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionExpression)
+		b = append(b, ',')
+	}
+	if v.ConditionalOperator != nil {
+		b = append(b, "\"ConditionalOperator\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionalOperator)
+		b = append(b, ',')
+	}
+	if len(v.Expected) != 0 {
+		b = append(b, "\"Expected\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Expected))
+		for k := range v.Expected {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Expected[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeNames) != 0 {
+		b = append(b, "\"ExpressionAttributeNames\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeNames))
+		for k := range v.ExpressionAttributeNames {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeNames[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeValues) != 0 {
+		b = append(b, "\"ExpressionAttributeValues\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeValues))
+		for k := range v.ExpressionAttributeValues {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeValues[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Key\":"...)
+	if v.Key == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Key))
+		for k := range v.Key {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Key[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	if v.ReturnItemCollectionMetrics != nil {
+		b = append(b, "\"ReturnItemCollectionMetrics\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnItemCollectionMetrics)
+		b = append(b, ',')
+	}
+	if v.ReturnValues != nil {
+		b = append(b, "\"ReturnValues\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnValues)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteItemOutput is undocumented.
 type DeleteItemOutput struct {
 	Attributes            map[string]AttributeValue `json:"Attributes,omitempty"`
@@ -462,11 +1217,85 @@ type DeleteRequest struct {
 	Key map[string]AttributeValue `json:"Key"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteRequest to b.
+func (v *DeleteRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Key\":"...)
+	if v.Key == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Key))
+		for k := range v.Key {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Key[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteTableInput is undocumented.
 type DeleteTableInput struct {
 	TableName aws.StringValue `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteTableInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteTableInput to b.
+func (v *DeleteTableInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteTableOutput is undocumented.
 type DeleteTableOutput struct {
 	TableDescription *TableDescription `json:"TableDescription,omitempty"`
@@ -477,6 +1306,33 @@ type DescribeTableInput struct {
 	TableName aws.StringValue `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTableInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTableInput to b.
+func (v *DescribeTableInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTableOutput is undocumented.
 type DescribeTableOutput struct {
 	Table *TableDescription `json:"Table,omitempty"`
@@ -490,6 +1346,61 @@ type ExpectedAttributeValue struct {
 	Value              *AttributeValue  `json:"Value,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ExpectedAttributeValue) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ExpectedAttributeValue to b.
+func (v *ExpectedAttributeValue) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AttributeValueList) != 0 {
+		b = append(b, "\"AttributeValueList\":"...)
+		b = append(b, '[')
+		for i1 := range v.AttributeValueList {
+			b, err = v.AttributeValueList[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ComparisonOperator != nil {
+		b = append(b, "\"ComparisonOperator\":"...)
+		b = aws.AppendJSONString(b, *v.ComparisonOperator)
+		b = append(b, ',')
+	}
+	if v.Exists != nil {
+		b = append(b, "\"Exists\":"...)
+		b = strconv.AppendBool(b, *v.Exists)
+		b = append(b, ',')
+	}
+	if v.Value != nil {
+		b = append(b, "\"Value\":"...)
+		b, err = v.Value.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetItemInput is undocumented.
 type GetItemInput struct {
 	AttributesToGet          []string                  `json:"AttributesToGet,omitempty"`
@@ -501,6 +1412,111 @@ type GetItemInput struct {
 	TableName                aws.StringValue           `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetItemInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetItemInput to b.
+func (v *GetItemInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AttributesToGet) != 0 {
+		b = append(b, "\"AttributesToGet\":"...)
+		b = append(b, '[')
+		for i1 := range v.AttributesToGet {
+			b = aws.AppendJSONString(b, v.AttributesToGet[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ConsistentRead != nil {
+		b = append(b, "\"ConsistentRead\":"...)
+		b = strconv.AppendBool(b, *v.ConsistentRead)
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeNames) != 0 {
+		b = append(b, "\"ExpressionAttributeNames\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeNames))
+		for k := range v.ExpressionAttributeNames {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeNames[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Key\":"...)
+	if v.Key == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Key))
+		for k := range v.Key {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Key[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.ProjectionExpression != nil {
+		b = append(b, "\"ProjectionExpression\":"...)
+		b = aws.AppendJSONString(b, *v.ProjectionExpression)
+		b = append(b, ',')
+	}
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetItemOutput is undocumented.
 type GetItemOutput struct {
 	ConsumedCapacity *ConsumedCapacity         `json:"ConsumedCapacity,omitempty"`
@@ -515,6 +1531,72 @@ type GlobalSecondaryIndex struct {
 	ProvisionedThroughput *ProvisionedThroughput `json:"ProvisionedThroughput"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GlobalSecondaryIndex) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GlobalSecondaryIndex to b.
+func (v *GlobalSecondaryIndex) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IndexName\":"...)
+	if v.IndexName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IndexName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"KeySchema\":"...)
+	if v.KeySchema == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.KeySchema {
+			b, err = v.KeySchema[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"Projection\":"...)
+	if v.Projection == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Projection.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"ProvisionedThroughput\":"...)
+	if v.ProvisionedThroughput == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ProvisionedThroughput.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GlobalSecondaryIndexDescription is undocumented.
 type GlobalSecondaryIndexDescription struct {
 	IndexName             aws.StringValue                   `json:"IndexName,omitempty"`
@@ -531,6 +1613,34 @@ type GlobalSecondaryIndexUpdate struct {
 	Update *UpdateGlobalSecondaryIndexAction `json:"Update,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GlobalSecondaryIndexUpdate) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GlobalSecondaryIndexUpdate to b.
+func (v *GlobalSecondaryIndexUpdate) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Update != nil {
+		b = append(b, "\"Update\":"...)
+		b, err = v.Update.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for DynamoDB.
 const (
 	IndexStatusActive   = "ACTIVE"
@@ -551,6 +1661,40 @@ type KeySchemaElement struct {
 	KeyType       aws.StringValue `json:"KeyType"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *KeySchemaElement) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the KeySchemaElement to b.
+func (v *KeySchemaElement) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AttributeName\":"...)
+	if v.AttributeName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AttributeName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"KeyType\":"...)
+	if v.KeyType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyType)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for DynamoDB.
 const (
 	KeyTypeHash  = "HASH"
@@ -566,12 +1710,148 @@ type KeysAndAttributes struct {
 	ProjectionExpression     aws.StringValue             `json:"ProjectionExpression,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *KeysAndAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the KeysAndAttributes to b.
+func (v *KeysAndAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AttributesToGet) != 0 {
+		b = append(b, "\"AttributesToGet\":"...)
+		b = append(b, '[')
+		for i1 := range v.AttributesToGet {
+			b = aws.AppendJSONString(b, v.AttributesToGet[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ConsistentRead != nil {
+		b = append(b, "\"ConsistentRead\":"...)
+		b = strconv.AppendBool(b, *v.ConsistentRead)
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeNames) != 0 {
+		b = append(b, "\"ExpressionAttributeNames\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeNames))
+		for k := range v.ExpressionAttributeNames {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeNames[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Keys\":"...)
+	if v.Keys == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Keys {
+			if v.Keys[i1] == nil {
+				b = append(b, "null"...)
+			} else {
+				b = append(b, '{')
+				keys2 := make([]string, 0, len(v.Keys[i1]))
+				for k := range v.Keys[i1] {
+					keys2 = append(keys2, k)
+				}
+				sort.Strings(keys2)
+				for _, k2 := range keys2 {
+					b = aws.AppendJSONString(b, k2)
+					b = append(b, ':')
+					v2 := v.Keys[i1][k2]
+					b, err = v2.appendJSON(b)
+					if err != nil {
+						return nil, err
+					}
+					b = append(b, ',')
+				}
+				if b[len(b)-1] == ',' {
+					b[len(b)-1] = '}'
+				} else {
+					b = append(b, '}')
+				}
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if v.ProjectionExpression != nil {
+		b = append(b, "\"ProjectionExpression\":"...)
+		b = aws.AppendJSONString(b, *v.ProjectionExpression)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListTablesInput is undocumented.
 type ListTablesInput struct {
 	ExclusiveStartTableName aws.StringValue  `json:"ExclusiveStartTableName,omitempty"`
 	Limit                   aws.IntegerValue `json:"Limit,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListTablesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListTablesInput to b.
+func (v *ListTablesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ExclusiveStartTableName != nil {
+		b = append(b, "\"ExclusiveStartTableName\":"...)
+		b = aws.AppendJSONString(b, *v.ExclusiveStartTableName)
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListTablesOutput is undocumented.
 type ListTablesOutput struct {
 	LastEvaluatedTableName aws.StringValue `json:"LastEvaluatedTableName,omitempty"`
@@ -585,6 +1865,62 @@ type LocalSecondaryIndex struct {
 	Projection *Projection        `json:"Projection"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *LocalSecondaryIndex) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the LocalSecondaryIndex to b.
+func (v *LocalSecondaryIndex) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IndexName\":"...)
+	if v.IndexName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IndexName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"KeySchema\":"...)
+	if v.KeySchema == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.KeySchema {
+			b, err = v.KeySchema[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"Projection\":"...)
+	if v.Projection == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Projection.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // LocalSecondaryIndexDescription is undocumented.
 type LocalSecondaryIndexDescription struct {
 	IndexName      aws.StringValue    `json:"IndexName,omitempty"`
@@ -600,6 +1936,45 @@ type Projection struct {
 	ProjectionType   aws.StringValue `json:"ProjectionType,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Projection) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Projection to b.
+func (v *Projection) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.NonKeyAttributes) != 0 {
+		b = append(b, "\"NonKeyAttributes\":"...)
+		b = append(b, '[')
+		for i1 := range v.NonKeyAttributes {
+			b = aws.AppendJSONString(b, v.NonKeyAttributes[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ProjectionType != nil {
+		b = append(b, "\"ProjectionType\":"...)
+		b = aws.AppendJSONString(b, *v.ProjectionType)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for DynamoDB.
 const (
 	ProjectionTypeAll      = "ALL"
@@ -613,6 +1988,40 @@ type ProvisionedThroughput struct {
 	WriteCapacityUnits aws.LongValue `json:"WriteCapacityUnits"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ProvisionedThroughput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ProvisionedThroughput to b.
+func (v *ProvisionedThroughput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ReadCapacityUnits\":"...)
+	if v.ReadCapacityUnits == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.ReadCapacityUnits), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"WriteCapacityUnits\":"...)
+	if v.WriteCapacityUnits == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.WriteCapacityUnits), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ProvisionedThroughputDescription is undocumented.
 type ProvisionedThroughputDescription struct {
 	LastDecreaseDateTime   *aws.FloatTimestamp `json:"LastDecreaseDateTime,omitempty"`
@@ -636,6 +2045,157 @@ type PutItemInput struct {
 	TableName                   aws.StringValue                   `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutItemInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutItemInput to b.
+func (v *PutItemInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ConditionExpression != nil {
+		b = append(b, "\"ConditionExpression\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionExpression)
+		b = append(b, ',')
+	}
+	if v.ConditionalOperator != nil {
+		b = append(b, "\"ConditionalOperator\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionalOperator)
+		b = append(b, ',')
+	}
+	if len(v.Expected) != 0 {
+		b = append(b, "\"Expected\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Expected))
+		for k := range v.Expected {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Expected[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeNames) != 0 {
+		b = append(b, "\"ExpressionAttributeNames\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeNames))
+		for k := range v.ExpressionAttributeNames {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeNames[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeValues) != 0 {
+		b = append(b, "\"ExpressionAttributeValues\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeValues))
+		for k := range v.ExpressionAttributeValues {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeValues[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Item\":"...)
+	if v.Item == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Item))
+		for k := range v.Item {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Item[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	if v.ReturnItemCollectionMetrics != nil {
+		b = append(b, "\"ReturnItemCollectionMetrics\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnItemCollectionMetrics)
+		b = append(b, ',')
+	}
+	if v.ReturnValues != nil {
+		b = append(b, "\"ReturnValues\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnValues)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutItemOutput is undocumented.
 type PutItemOutput struct {
 	Attributes            map[string]AttributeValue `json:"Attributes,omitempty"`
@@ -648,6 +2208,53 @@ type PutRequest struct {
 	Item map[string]AttributeValue `json:"Item"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutRequest to b.
+func (v *PutRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Item\":"...)
+	if v.Item == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Item))
+		for k := range v.Item {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Item[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // QueryInput is undocumented.
 type QueryInput struct {
 	AttributesToGet           []string                  `json:"AttributesToGet,omitempty"`
@@ -668,6 +2275,216 @@ type QueryInput struct {
 	TableName                 aws.StringValue           `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *QueryInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the QueryInput to b.
+func (v *QueryInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AttributesToGet) != 0 {
+		b = append(b, "\"AttributesToGet\":"...)
+		b = append(b, '[')
+		for i1 := range v.AttributesToGet {
+			b = aws.AppendJSONString(b, v.AttributesToGet[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ConditionalOperator != nil {
+		b = append(b, "\"ConditionalOperator\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionalOperator)
+		b = append(b, ',')
+	}
+	if v.ConsistentRead != nil {
+		b = append(b, "\"ConsistentRead\":"...)
+		b = strconv.AppendBool(b, *v.ConsistentRead)
+		b = append(b, ',')
+	}
+	if len(v.ExclusiveStartKey) != 0 {
+		b = append(b, "\"ExclusiveStartKey\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExclusiveStartKey))
+		for k := range v.ExclusiveStartKey {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExclusiveStartKey[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeNames) != 0 {
+		b = append(b, "\"ExpressionAttributeNames\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeNames))
+		for k := range v.ExpressionAttributeNames {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeNames[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeValues) != 0 {
+		b = append(b, "\"ExpressionAttributeValues\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeValues))
+		for k := range v.ExpressionAttributeValues {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeValues[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.FilterExpression != nil {
+		b = append(b, "\"FilterExpression\":"...)
+		b = aws.AppendJSONString(b, *v.FilterExpression)
+		b = append(b, ',')
+	}
+	if v.IndexName != nil {
+		b = append(b, "\"IndexName\":"...)
+		b = aws.AppendJSONString(b, *v.IndexName)
+		b = append(b, ',')
+	}
+	b = append(b, "\"KeyConditions\":"...)
+	if v.KeyConditions == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.KeyConditions))
+		for k := range v.KeyConditions {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.KeyConditions[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.ProjectionExpression != nil {
+		b = append(b, "\"ProjectionExpression\":"...)
+		b = aws.AppendJSONString(b, *v.ProjectionExpression)
+		b = append(b, ',')
+	}
+	if len(v.QueryFilter) != 0 {
+		b = append(b, "\"QueryFilter\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.QueryFilter))
+		for k := range v.QueryFilter {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.QueryFilter[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	if v.ScanIndexForward != nil {
+		b = append(b, "\"ScanIndexForward\":"...)
+		b = strconv.AppendBool(b, *v.ScanIndexForward)
+		b = append(b, ',')
+	}
+	if v.Select != nil {
+		b = append(b, "\"Select\":"...)
+		b = aws.AppendJSONString(b, *v.Select)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // QueryOutput is undocumented.
 type QueryOutput struct {
 	ConsumedCapacity *ConsumedCapacity           `json:"ConsumedCapacity,omitempty"`
@@ -724,6 +2541,184 @@ type ScanInput struct {
 	TotalSegments             aws.IntegerValue          `json:"TotalSegments,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ScanInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ScanInput to b.
+func (v *ScanInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AttributesToGet) != 0 {
+		b = append(b, "\"AttributesToGet\":"...)
+		b = append(b, '[')
+		for i1 := range v.AttributesToGet {
+			b = aws.AppendJSONString(b, v.AttributesToGet[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ConditionalOperator != nil {
+		b = append(b, "\"ConditionalOperator\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionalOperator)
+		b = append(b, ',')
+	}
+	if len(v.ExclusiveStartKey) != 0 {
+		b = append(b, "\"ExclusiveStartKey\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExclusiveStartKey))
+		for k := range v.ExclusiveStartKey {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExclusiveStartKey[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeNames) != 0 {
+		b = append(b, "\"ExpressionAttributeNames\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeNames))
+		for k := range v.ExpressionAttributeNames {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeNames[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeValues) != 0 {
+		b = append(b, "\"ExpressionAttributeValues\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeValues))
+		for k := range v.ExpressionAttributeValues {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeValues[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.FilterExpression != nil {
+		b = append(b, "\"FilterExpression\":"...)
+		b = aws.AppendJSONString(b, *v.FilterExpression)
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.ProjectionExpression != nil {
+		b = append(b, "\"ProjectionExpression\":"...)
+		b = aws.AppendJSONString(b, *v.ProjectionExpression)
+		b = append(b, ',')
+	}
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	if len(v.ScanFilter) != 0 {
+		b = append(b, "\"ScanFilter\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ScanFilter))
+		for k := range v.ScanFilter {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ScanFilter[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.Segment != nil {
+		b = append(b, "\"Segment\":"...)
+		b = strconv.AppendInt(b, int64(*v.Segment), 10)
+		b = append(b, ',')
+	}
+	if v.Select != nil {
+		b = append(b, "\"Select\":"...)
+		b = aws.AppendJSONString(b, *v.Select)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if v.TotalSegments != nil {
+		b = append(b, "\"TotalSegments\":"...)
+		b = strconv.AppendInt(b, int64(*v.TotalSegments), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ScanOutput is undocumented.
 type ScanOutput struct {
 	ConsumedCapacity *ConsumedCapacity           `json:"ConsumedCapacity,omitempty"`
@@ -769,6 +2764,43 @@ type UpdateGlobalSecondaryIndexAction struct {
 	ProvisionedThroughput *ProvisionedThroughput `json:"ProvisionedThroughput"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateGlobalSecondaryIndexAction) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateGlobalSecondaryIndexAction to b.
+func (v *UpdateGlobalSecondaryIndexAction) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IndexName\":"...)
+	if v.IndexName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IndexName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"ProvisionedThroughput\":"...)
+	if v.ProvisionedThroughput == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ProvisionedThroughput.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateItemInput is undocumented.
 type UpdateItemInput struct {
 	AttributeUpdates            map[string]AttributeValueUpdate   `json:"AttributeUpdates,omitempty"`
@@ -785,6 +2817,187 @@ type UpdateItemInput struct {
 	UpdateExpression            aws.StringValue                   `json:"UpdateExpression,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateItemInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateItemInput to b.
+func (v *UpdateItemInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AttributeUpdates) != 0 {
+		b = append(b, "\"AttributeUpdates\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.AttributeUpdates))
+		for k := range v.AttributeUpdates {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.AttributeUpdates[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.ConditionExpression != nil {
+		b = append(b, "\"ConditionExpression\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionExpression)
+		b = append(b, ',')
+	}
+	if v.ConditionalOperator != nil {
+		b = append(b, "\"ConditionalOperator\":"...)
+		b = aws.AppendJSONString(b, *v.ConditionalOperator)
+		b = append(b, ',')
+	}
+	if len(v.Expected) != 0 {
+		b = append(b, "\"Expected\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Expected))
+		for k := range v.Expected {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Expected[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeNames) != 0 {
+		b = append(b, "\"ExpressionAttributeNames\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeNames))
+		for k := range v.ExpressionAttributeNames {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeNames[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.ExpressionAttributeValues) != 0 {
+		b = append(b, "\"ExpressionAttributeValues\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.ExpressionAttributeValues))
+		for k := range v.ExpressionAttributeValues {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.ExpressionAttributeValues[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Key\":"...)
+	if v.Key == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Key))
+		for k := range v.Key {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Key[k1]
+			b, err = v1.appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if v.ReturnConsumedCapacity != nil {
+		b = append(b, "\"ReturnConsumedCapacity\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnConsumedCapacity)
+		b = append(b, ',')
+	}
+	if v.ReturnItemCollectionMetrics != nil {
+		b = append(b, "\"ReturnItemCollectionMetrics\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnItemCollectionMetrics)
+		b = append(b, ',')
+	}
+	if v.ReturnValues != nil {
+		b = append(b, "\"ReturnValues\":"...)
+		b = aws.AppendJSONString(b, *v.ReturnValues)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if v.UpdateExpression != nil {
+		b = append(b, "\"UpdateExpression\":"...)
+		b = aws.AppendJSONString(b, *v.UpdateExpression)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateItemOutput is undocumented.
 type UpdateItemOutput struct {
 	Attributes            map[string]AttributeValue `json:"Attributes,omitempty"`
@@ -799,6 +3012,58 @@ type UpdateTableInput struct {
 	TableName                   aws.StringValue              `json:"TableName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateTableInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateTableInput to b.
+func (v *UpdateTableInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.GlobalSecondaryIndexUpdates) != 0 {
+		b = append(b, "\"GlobalSecondaryIndexUpdates\":"...)
+		b = append(b, '[')
+		for i1 := range v.GlobalSecondaryIndexUpdates {
+			b, err = v.GlobalSecondaryIndexUpdates[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ProvisionedThroughput != nil {
+		b = append(b, "\"ProvisionedThroughput\":"...)
+		b, err = v.ProvisionedThroughput.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"TableName\":"...)
+	if v.TableName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TableName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateTableOutput is undocumented.
 type UpdateTableOutput struct {
 	TableDescription *TableDescription `json:"TableDescription,omitempty"`
@@ -810,5 +3075,90 @@ type WriteRequest struct {
 	PutRequest    *PutRequest    `json:"PutRequest,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *WriteRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the WriteRequest to b.
+func (v *WriteRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DeleteRequest != nil {
+		b = append(b, "\"DeleteRequest\":"...)
+		b, err = v.DeleteRequest.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.PutRequest != nil {
+		b = append(b, "\"PutRequest\":"...)
+		b, err = v.PutRequest.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
+// ConditionalCheckFailedException is the error returned for the ConditionalCheckFailedException error code.
+type ConditionalCheckFailedException struct {
+	aws.APIError
+}
+
+// InternalServerError is the error returned for the InternalServerError error code.
+type InternalServerError struct {
+	aws.APIError
+}
+
+// ItemCollectionSizeLimitExceededException is the error returned for the ItemCollectionSizeLimitExceededException error code.
+type ItemCollectionSizeLimitExceededException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// ProvisionedThroughputExceededException is the error returned for the ProvisionedThroughputExceededException error code.
+type ProvisionedThroughputExceededException struct {
+	aws.APIError
+}
+
+// ResourceInUseException is the error returned for the ResourceInUseException error code.
+type ResourceInUseException struct {
+	aws.APIError
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// exceptions maps DynamoDB's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"ConditionalCheckFailedException":          ConditionalCheckFailedException{},
+	"InternalServerError":                      InternalServerError{},
+	"ItemCollectionSizeLimitExceededException": ItemCollectionSizeLimitExceededException{},
+	"LimitExceededException":                   LimitExceededException{},
+	"ProvisionedThroughputExceededException":   ProvisionedThroughputExceededException{},
+	"ResourceInUseException":                   ResourceInUseException{},
+	"ResourceNotFoundException":                ResourceNotFoundException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
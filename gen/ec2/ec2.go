@@ -4,11 +4,21 @@
 package ec2
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // EC2 is a client for Amazon Elastic Compute Cloud.
@@ -16,37 +26,85 @@ type EC2 struct {
 	client *aws.EC2Client
 }
 
-// New returns a new EC2 client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *EC2 {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "ec2"
-	endpoint, service, region := endpoints.Lookup("ec2", region)
+// New returns a new EC2 client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *EC2 {
+	cfg := newConfig(region, client, opts)
 
 	return &EC2{
 		client: &aws.EC2Client{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2014-10-01",
 		},
 	}
 }
 
+// NewFromSession returns a new EC2 client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *EC2 {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("ec2", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("ec2", "ec2"),
+	}, "AWS_ENDPOINT_URL_EC2", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *EC2) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *EC2) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *EC2) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AcceptVPCPeeringConnection accept a VPC peering connection request. To
 // accept a request, the VPC peering connection must be in the
 // pending-acceptance state, and you must be the owner of the peer Use the
 // DescribeVpcPeeringConnections request to view your outstanding VPC
 // peering connection requests.
 func (c *EC2) AcceptVPCPeeringConnection(req *AcceptVPCPeeringConnectionRequest) (resp *AcceptVPCPeeringConnectionResult, err error) {
+	return c.AcceptVPCPeeringConnectionWithContext(context.Background(), req)
+}
+
+// AcceptVPCPeeringConnectionWithContext is like AcceptVPCPeeringConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AcceptVPCPeeringConnectionWithContext(ctx context.Context, req *AcceptVPCPeeringConnectionRequest) (resp *AcceptVPCPeeringConnectionResult, err error) {
 	resp = &AcceptVPCPeeringConnectionResult{}
-	err = c.client.Do("AcceptVpcPeeringConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AcceptVpcPeeringConnection", "POST", "/", req, resp)
 	return
 }
 
@@ -54,8 +112,15 @@ func (c *EC2) AcceptVPCPeeringConnection(req *AcceptVPCPeeringConnectionRequest)
 // for use either in the EC2-Classic platform or in a For more information,
 // see Elastic IP Addresses in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) AllocateAddress(req *AllocateAddressRequest) (resp *AllocateAddressResult, err error) {
+	return c.AllocateAddressWithContext(context.Background(), req)
+}
+
+// AllocateAddressWithContext is like AllocateAddress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AllocateAddressWithContext(ctx context.Context, req *AllocateAddressRequest) (resp *AllocateAddressResult, err error) {
 	resp = &AllocateAddressResult{}
-	err = c.client.Do("AllocateAddress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AllocateAddress", "POST", "/", req, resp)
 	return
 }
 
@@ -70,8 +135,15 @@ func (c *EC2) AllocateAddress(req *AllocateAddressRequest) (resp *AllocateAddres
 // Addresses in the Amazon Elastic Compute Cloud User Guide
 // AssignPrivateIpAddresses is available only in EC2-VPC.
 func (c *EC2) AssignPrivateIPAddresses(req *AssignPrivateIPAddressesRequest) (err error) {
+	return c.AssignPrivateIPAddressesWithContext(context.Background(), req)
+}
+
+// AssignPrivateIPAddressesWithContext is like AssignPrivateIPAddresses, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AssignPrivateIPAddressesWithContext(ctx context.Context, req *AssignPrivateIPAddressesRequest) (err error) {
 	// NRE
-	err = c.client.Do("AssignPrivateIpAddresses", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AssignPrivateIpAddresses", "POST", "/", req, nil)
 	return
 }
 
@@ -89,8 +161,15 @@ func (c *EC2) AssignPrivateIPAddresses(req *AssignPrivateIPAddressesRequest) (er
 // idempotent operation. If you perform the operation more than once,
 // Amazon EC2 doesn't return an error.
 func (c *EC2) AssociateAddress(req *AssociateAddressRequest) (resp *AssociateAddressResult, err error) {
+	return c.AssociateAddressWithContext(context.Background(), req)
+}
+
+// AssociateAddressWithContext is like AssociateAddress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AssociateAddressWithContext(ctx context.Context, req *AssociateAddressRequest) (resp *AssociateAddressResult, err error) {
 	resp = &AssociateAddressResult{}
-	err = c.client.Do("AssociateAddress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AssociateAddress", "POST", "/", req, resp)
 	return
 }
 
@@ -104,8 +183,15 @@ func (c *EC2) AssociateAddress(req *AssociateAddressRequest) (resp *AssociateAdd
 // system on the instance. For more information, see Options Sets in the
 // Amazon Virtual Private Cloud User Guide
 func (c *EC2) AssociateDHCPOptions(req *AssociateDHCPOptionsRequest) (err error) {
+	return c.AssociateDHCPOptionsWithContext(context.Background(), req)
+}
+
+// AssociateDHCPOptionsWithContext is like AssociateDHCPOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AssociateDHCPOptionsWithContext(ctx context.Context, req *AssociateDHCPOptionsRequest) (err error) {
 	// NRE
-	err = c.client.Do("AssociateDhcpOptions", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AssociateDhcpOptions", "POST", "/", req, nil)
 	return
 }
 
@@ -118,8 +204,15 @@ func (c *EC2) AssociateDHCPOptions(req *AssociateDHCPOptionsRequest) (err error)
 // about route tables, see Route Tables in the Amazon Virtual Private Cloud
 // User Guide
 func (c *EC2) AssociateRouteTable(req *AssociateRouteTableRequest) (resp *AssociateRouteTableResult, err error) {
+	return c.AssociateRouteTableWithContext(context.Background(), req)
+}
+
+// AssociateRouteTableWithContext is like AssociateRouteTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AssociateRouteTableWithContext(ctx context.Context, req *AssociateRouteTableRequest) (resp *AssociateRouteTableResult, err error) {
 	resp = &AssociateRouteTableResult{}
-	err = c.client.Do("AssociateRouteTable", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AssociateRouteTable", "POST", "/", req, resp)
 	return
 }
 
@@ -128,15 +221,29 @@ func (c *EC2) AssociateRouteTable(req *AssociateRouteTableRequest) (resp *Associ
 // your VPC and Internet gateway, see the Amazon Virtual Private Cloud User
 // Guide
 func (c *EC2) AttachInternetGateway(req *AttachInternetGatewayRequest) (err error) {
+	return c.AttachInternetGatewayWithContext(context.Background(), req)
+}
+
+// AttachInternetGatewayWithContext is like AttachInternetGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AttachInternetGatewayWithContext(ctx context.Context, req *AttachInternetGatewayRequest) (err error) {
 	// NRE
-	err = c.client.Do("AttachInternetGateway", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AttachInternetGateway", "POST", "/", req, nil)
 	return
 }
 
 // AttachNetworkInterface is undocumented.
 func (c *EC2) AttachNetworkInterface(req *AttachNetworkInterfaceRequest) (resp *AttachNetworkInterfaceResult, err error) {
+	return c.AttachNetworkInterfaceWithContext(context.Background(), req)
+}
+
+// AttachNetworkInterfaceWithContext is like AttachNetworkInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AttachNetworkInterfaceWithContext(ctx context.Context, req *AttachNetworkInterfaceRequest) (resp *AttachNetworkInterfaceResult, err error) {
 	resp = &AttachNetworkInterfaceResult{}
-	err = c.client.Do("AttachNetworkInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AttachNetworkInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -162,8 +269,15 @@ func (c *EC2) AttachNetworkInterface(req *AttachNetworkInterfaceRequest) (resp *
 // information about Amazon EBS volumes, see Attaching Amazon EBS Volumes
 // in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) AttachVolume(req *AttachVolumeRequest) (resp *VolumeAttachment, err error) {
+	return c.AttachVolumeWithContext(context.Background(), req)
+}
+
+// AttachVolumeWithContext is like AttachVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AttachVolumeWithContext(ctx context.Context, req *AttachVolumeRequest) (resp *VolumeAttachment, err error) {
 	resp = &VolumeAttachment{}
-	err = c.client.Do("AttachVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AttachVolume", "POST", "/", req, resp)
 	return
 }
 
@@ -171,8 +285,15 @@ func (c *EC2) AttachVolume(req *AttachVolumeRequest) (resp *VolumeAttachment, er
 // information, see Adding a Hardware Virtual Private Gateway to Your in
 // the Amazon Virtual Private Cloud User Guide
 func (c *EC2) AttachVPNGateway(req *AttachVPNGatewayRequest) (resp *AttachVPNGatewayResult, err error) {
+	return c.AttachVPNGatewayWithContext(context.Background(), req)
+}
+
+// AttachVPNGatewayWithContext is like AttachVPNGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AttachVPNGatewayWithContext(ctx context.Context, req *AttachVPNGatewayRequest) (resp *AttachVPNGatewayResult, err error) {
 	resp = &AttachVPNGatewayResult{}
-	err = c.client.Do("AttachVpnGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AttachVpnGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -192,8 +313,15 @@ func (c *EC2) AttachVPNGateway(req *AttachVPNGatewayRequest) (resp *AttachVPNGat
 // changes are propagated to affected instances as quickly as possible.
 // However, a small delay might occur.
 func (c *EC2) AuthorizeSecurityGroupEgress(req *AuthorizeSecurityGroupEgressRequest) (err error) {
+	return c.AuthorizeSecurityGroupEgressWithContext(context.Background(), req)
+}
+
+// AuthorizeSecurityGroupEgressWithContext is like AuthorizeSecurityGroupEgress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, req *AuthorizeSecurityGroupEgressRequest) (err error) {
 	// NRE
-	err = c.client.Do("AuthorizeSecurityGroupEgress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AuthorizeSecurityGroupEgress", "POST", "/", req, nil)
 	return
 }
 
@@ -212,8 +340,15 @@ func (c *EC2) AuthorizeSecurityGroupEgress(req *AuthorizeSecurityGroupEgressRequ
 // access a security group for your The security groups must all be for the
 // same
 func (c *EC2) AuthorizeSecurityGroupIngress(req *AuthorizeSecurityGroupIngressRequest) (err error) {
+	return c.AuthorizeSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// AuthorizeSecurityGroupIngressWithContext is like AuthorizeSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, req *AuthorizeSecurityGroupIngressRequest) (err error) {
 	// NRE
-	err = c.client.Do("AuthorizeSecurityGroupIngress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AuthorizeSecurityGroupIngress", "POST", "/", req, nil)
 	return
 }
 
@@ -224,16 +359,30 @@ func (c *EC2) AuthorizeSecurityGroupIngress(req *AuthorizeSecurityGroupIngressRe
 // by Amazon For more information, see Creating an Instance Store-Backed
 // Windows
 func (c *EC2) BundleInstance(req *BundleInstanceRequest) (resp *BundleInstanceResult, err error) {
+	return c.BundleInstanceWithContext(context.Background(), req)
+}
+
+// BundleInstanceWithContext is like BundleInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) BundleInstanceWithContext(ctx context.Context, req *BundleInstanceRequest) (resp *BundleInstanceResult, err error) {
 	resp = &BundleInstanceResult{}
-	err = c.client.Do("BundleInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "BundleInstance", "POST", "/", req, resp)
 	return
 }
 
 // CancelBundleTask cancels a bundling operation for an instance
 // store-backed Windows instance.
 func (c *EC2) CancelBundleTask(req *CancelBundleTaskRequest) (resp *CancelBundleTaskResult, err error) {
+	return c.CancelBundleTaskWithContext(context.Background(), req)
+}
+
+// CancelBundleTaskWithContext is like CancelBundleTask, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CancelBundleTaskWithContext(ctx context.Context, req *CancelBundleTaskRequest) (resp *CancelBundleTaskResult, err error) {
 	resp = &CancelBundleTaskResult{}
-	err = c.client.Do("CancelBundleTask", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CancelBundleTask", "POST", "/", req, resp)
 	return
 }
 
@@ -245,8 +394,15 @@ func (c *EC2) CancelBundleTask(req *CancelBundleTaskRequest) (resp *CancelBundle
 // information, see Using the Command Line Tools to Import Your Virtual
 // Machine to Amazon EC2 in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CancelConversionTask(req *CancelConversionRequest) (err error) {
+	return c.CancelConversionTaskWithContext(context.Background(), req)
+}
+
+// CancelConversionTaskWithContext is like CancelConversionTask, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CancelConversionTaskWithContext(ctx context.Context, req *CancelConversionRequest) (err error) {
 	// NRE
-	err = c.client.Do("CancelConversionTask", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CancelConversionTask", "POST", "/", req, nil)
 	return
 }
 
@@ -256,8 +412,15 @@ func (c *EC2) CancelConversionTask(req *CancelConversionRequest) (err error) {
 // transferring the final disk image, the command fails and returns an
 // error.
 func (c *EC2) CancelExportTask(req *CancelExportTaskRequest) (err error) {
+	return c.CancelExportTaskWithContext(context.Background(), req)
+}
+
+// CancelExportTaskWithContext is like CancelExportTask, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CancelExportTaskWithContext(ctx context.Context, req *CancelExportTaskRequest) (err error) {
 	// NRE
-	err = c.client.Do("CancelExportTask", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CancelExportTask", "POST", "/", req, nil)
 	return
 }
 
@@ -266,8 +429,15 @@ func (c *EC2) CancelExportTask(req *CancelExportTaskRequest) (err error) {
 // Reserved Instance Marketplace in the Amazon Elastic Compute Cloud User
 // Guide
 func (c *EC2) CancelReservedInstancesListing(req *CancelReservedInstancesListingRequest) (resp *CancelReservedInstancesListingResult, err error) {
+	return c.CancelReservedInstancesListingWithContext(context.Background(), req)
+}
+
+// CancelReservedInstancesListingWithContext is like CancelReservedInstancesListing, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CancelReservedInstancesListingWithContext(ctx context.Context, req *CancelReservedInstancesListingRequest) (resp *CancelReservedInstancesListingResult, err error) {
 	resp = &CancelReservedInstancesListingResult{}
-	err = c.client.Do("CancelReservedInstancesListing", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CancelReservedInstancesListing", "POST", "/", req, resp)
 	return
 }
 
@@ -280,8 +450,15 @@ func (c *EC2) CancelReservedInstancesListing(req *CancelReservedInstancesListing
 // Elastic Compute Cloud User Guide Canceling a Spot Instance request does
 // not terminate running Spot Instances associated with the request.
 func (c *EC2) CancelSpotInstanceRequests(req *CancelSpotInstanceRequestsRequest) (resp *CancelSpotInstanceRequestsResult, err error) {
+	return c.CancelSpotInstanceRequestsWithContext(context.Background(), req)
+}
+
+// CancelSpotInstanceRequestsWithContext is like CancelSpotInstanceRequests, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CancelSpotInstanceRequestsWithContext(ctx context.Context, req *CancelSpotInstanceRequestsRequest) (resp *CancelSpotInstanceRequestsResult, err error) {
 	resp = &CancelSpotInstanceRequestsResult{}
-	err = c.client.Do("CancelSpotInstanceRequests", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CancelSpotInstanceRequests", "POST", "/", req, resp)
 	return
 }
 
@@ -290,8 +467,15 @@ func (c *EC2) CancelSpotInstanceRequests(req *CancelSpotInstanceRequestsRequest)
 // product code. It is useful when a product code owner needs to verify
 // whether another user's instance is eligible for support.
 func (c *EC2) ConfirmProductInstance(req *ConfirmProductInstanceRequest) (resp *ConfirmProductInstanceResult, err error) {
+	return c.ConfirmProductInstanceWithContext(context.Background(), req)
+}
+
+// ConfirmProductInstanceWithContext is like ConfirmProductInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ConfirmProductInstanceWithContext(ctx context.Context, req *ConfirmProductInstanceRequest) (resp *ConfirmProductInstanceResult, err error) {
 	resp = &ConfirmProductInstanceResult{}
-	err = c.client.Do("ConfirmProductInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ConfirmProductInstance", "POST", "/", req, resp)
 	return
 }
 
@@ -302,8 +486,15 @@ func (c *EC2) ConfirmProductInstance(req *ConfirmProductInstanceRequest) (resp *
 // more information, see Copying AMIs in the Amazon Elastic Compute Cloud
 // User Guide
 func (c *EC2) CopyImage(req *CopyImageRequest) (resp *CopyImageResult, err error) {
+	return c.CopyImageWithContext(context.Background(), req)
+}
+
+// CopyImageWithContext is like CopyImage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CopyImageWithContext(ctx context.Context, req *CopyImageRequest) (resp *CopyImageResult, err error) {
 	resp = &CopyImageResult{}
-	err = c.client.Do("CopyImage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CopyImage", "POST", "/", req, resp)
 	return
 }
 
@@ -316,8 +507,15 @@ func (c *EC2) CopyImage(req *CopyImageRequest) (resp *CopyImageResult, err error
 // remain unencrypted. For more information, see Copying an Amazon EBS
 // Snapshot in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CopySnapshot(req *CopySnapshotRequest) (resp *CopySnapshotResult, err error) {
+	return c.CopySnapshotWithContext(context.Background(), req)
+}
+
+// CopySnapshotWithContext is like CopySnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CopySnapshotWithContext(ctx context.Context, req *CopySnapshotRequest) (resp *CopySnapshotResult, err error) {
 	resp = &CopySnapshotResult{}
-	err = c.client.Do("CopySnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CopySnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -338,8 +536,15 @@ func (c *EC2) CopySnapshot(req *CopySnapshotRequest) (resp *CopySnapshotResult,
 // Virtual Private Gateway to Your in the Amazon Virtual Private Cloud User
 // Guide
 func (c *EC2) CreateCustomerGateway(req *CreateCustomerGatewayRequest) (resp *CreateCustomerGatewayResult, err error) {
+	return c.CreateCustomerGatewayWithContext(context.Background(), req)
+}
+
+// CreateCustomerGatewayWithContext is like CreateCustomerGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateCustomerGatewayWithContext(ctx context.Context, req *CreateCustomerGatewayRequest) (resp *CreateCustomerGatewayResult, err error) {
 	resp = &CreateCustomerGatewayResult{}
-	err = c.client.Do("CreateCustomerGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCustomerGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -369,8 +574,15 @@ func (c *EC2) CreateCustomerGateway(req *CreateCustomerGatewayRequest) (resp *Cr
 // For more information about options, see Options Sets in the Amazon
 // Virtual Private Cloud User Guide
 func (c *EC2) CreateDHCPOptions(req *CreateDHCPOptionsRequest) (resp *CreateDHCPOptionsResult, err error) {
+	return c.CreateDHCPOptionsWithContext(context.Background(), req)
+}
+
+// CreateDHCPOptionsWithContext is like CreateDHCPOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateDHCPOptionsWithContext(ctx context.Context, req *CreateDHCPOptionsRequest) (resp *CreateDHCPOptionsResult, err error) {
 	resp = &CreateDHCPOptionsResult{}
-	err = c.client.Do("CreateDhcpOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDhcpOptions", "POST", "/", req, resp)
 	return
 }
 
@@ -383,8 +595,15 @@ func (c *EC2) CreateDHCPOptions(req *CreateDHCPOptionsRequest) (resp *CreateDHCP
 // more information, see Creating Amazon EBS-Backed Linux AMIs in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateImage(req *CreateImageRequest) (resp *CreateImageResult, err error) {
+	return c.CreateImageWithContext(context.Background(), req)
+}
+
+// CreateImageWithContext is like CreateImage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateImageWithContext(ctx context.Context, req *CreateImageRequest) (resp *CreateImageResult, err error) {
 	resp = &CreateImageResult{}
-	err = c.client.Do("CreateImage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateImage", "POST", "/", req, resp)
 	return
 }
 
@@ -394,8 +613,15 @@ func (c *EC2) CreateImage(req *CreateImageRequest) (resp *CreateImageResult, err
 // export, see Exporting EC2 Instances in the Amazon Elastic Compute Cloud
 // User Guide
 func (c *EC2) CreateInstanceExportTask(req *CreateInstanceExportTaskRequest) (resp *CreateInstanceExportTaskResult, err error) {
+	return c.CreateInstanceExportTaskWithContext(context.Background(), req)
+}
+
+// CreateInstanceExportTaskWithContext is like CreateInstanceExportTask, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateInstanceExportTaskWithContext(ctx context.Context, req *CreateInstanceExportTaskRequest) (resp *CreateInstanceExportTaskResult, err error) {
 	resp = &CreateInstanceExportTaskResult{}
-	err = c.client.Do("CreateInstanceExportTask", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateInstanceExportTask", "POST", "/", req, resp)
 	return
 }
 
@@ -404,8 +630,15 @@ func (c *EC2) CreateInstanceExportTask(req *CreateInstanceExportTaskRequest) (re
 // AttachInternetGateway For more information about your VPC and Internet
 // gateway, see the Amazon Virtual Private Cloud User Guide
 func (c *EC2) CreateInternetGateway(req *CreateInternetGatewayRequest) (resp *CreateInternetGatewayResult, err error) {
+	return c.CreateInternetGatewayWithContext(context.Background(), req)
+}
+
+// CreateInternetGatewayWithContext is like CreateInternetGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateInternetGatewayWithContext(ctx context.Context, req *CreateInternetGatewayRequest) (resp *CreateInternetGatewayResult, err error) {
 	resp = &CreateInternetGatewayResult{}
-	err = c.client.Do("CreateInternetGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateInternetGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -419,8 +652,15 @@ func (c *EC2) CreateInternetGateway(req *CreateInternetGatewayRequest) (resp *Cr
 // available in all regions, use ImportKeyPair For more information about
 // key pairs, see Key Pairs in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateKeyPair(req *CreateKeyPairRequest) (resp *KeyPair, err error) {
+	return c.CreateKeyPairWithContext(context.Background(), req)
+}
+
+// CreateKeyPairWithContext is like CreateKeyPair, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateKeyPairWithContext(ctx context.Context, req *CreateKeyPairRequest) (resp *KeyPair, err error) {
 	resp = &KeyPair{}
-	err = c.client.Do("CreateKeyPair", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateKeyPair", "POST", "/", req, resp)
 	return
 }
 
@@ -429,8 +669,15 @@ func (c *EC2) CreateKeyPair(req *CreateKeyPairRequest) (resp *KeyPair, err error
 // instances in your For more information about network ACLs, see Network
 // ACLs in the Amazon Virtual Private Cloud User Guide
 func (c *EC2) CreateNetworkACL(req *CreateNetworkACLRequest) (resp *CreateNetworkACLResult, err error) {
+	return c.CreateNetworkACLWithContext(context.Background(), req)
+}
+
+// CreateNetworkACLWithContext is like CreateNetworkACL, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateNetworkACLWithContext(ctx context.Context, req *CreateNetworkACLRequest) (resp *CreateNetworkACLResult, err error) {
 	resp = &CreateNetworkACLResult{}
-	err = c.client.Do("CreateNetworkAcl", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateNetworkAcl", "POST", "/", req, resp)
 	return
 }
 
@@ -449,8 +696,15 @@ func (c *EC2) CreateNetworkACL(req *CreateNetworkACLRequest) (resp *CreateNetwor
 // more information about network ACLs, see Network ACLs in the Amazon
 // Virtual Private Cloud User Guide
 func (c *EC2) CreateNetworkACLEntry(req *CreateNetworkACLEntryRequest) (err error) {
+	return c.CreateNetworkACLEntryWithContext(context.Background(), req)
+}
+
+// CreateNetworkACLEntryWithContext is like CreateNetworkACLEntry, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateNetworkACLEntryWithContext(ctx context.Context, req *CreateNetworkACLEntryRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateNetworkAclEntry", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateNetworkAclEntry", "POST", "/", req, nil)
 	return
 }
 
@@ -458,8 +712,15 @@ func (c *EC2) CreateNetworkACLEntry(req *CreateNetworkACLEntryRequest) (err erro
 // subnet. For more information about network interfaces, see Elastic
 // Network Interfaces in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateNetworkInterface(req *CreateNetworkInterfaceRequest) (resp *CreateNetworkInterfaceResult, err error) {
+	return c.CreateNetworkInterfaceWithContext(context.Background(), req)
+}
+
+// CreateNetworkInterfaceWithContext is like CreateNetworkInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateNetworkInterfaceWithContext(ctx context.Context, req *CreateNetworkInterfaceRequest) (resp *CreateNetworkInterfaceResult, err error) {
 	resp = &CreateNetworkInterfaceResult{}
-	err = c.client.Do("CreateNetworkInterface", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateNetworkInterface", "POST", "/", req, resp)
 	return
 }
 
@@ -469,8 +730,15 @@ func (c *EC2) CreateNetworkInterface(req *CreateNetworkInterfaceRequest) (resp *
 // cluster instances, see Cluster Instances in the Amazon Elastic Compute
 // Cloud User Guide
 func (c *EC2) CreatePlacementGroup(req *CreatePlacementGroupRequest) (err error) {
+	return c.CreatePlacementGroupWithContext(context.Background(), req)
+}
+
+// CreatePlacementGroupWithContext is like CreatePlacementGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreatePlacementGroupWithContext(ctx context.Context, req *CreatePlacementGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreatePlacementGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreatePlacementGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -492,8 +760,15 @@ func (c *EC2) CreatePlacementGroup(req *CreatePlacementGroupRequest) (err error)
 // operation. For more information, see Reserved Instance Marketplace in
 // the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateReservedInstancesListing(req *CreateReservedInstancesListingRequest) (resp *CreateReservedInstancesListingResult, err error) {
+	return c.CreateReservedInstancesListingWithContext(context.Background(), req)
+}
+
+// CreateReservedInstancesListingWithContext is like CreateReservedInstancesListing, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateReservedInstancesListingWithContext(ctx context.Context, req *CreateReservedInstancesListingRequest) (resp *CreateReservedInstancesListingResult, err error) {
 	resp = &CreateReservedInstancesListingResult{}
-	err = c.client.Do("CreateReservedInstancesListing", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateReservedInstancesListing", "POST", "/", req, resp)
 	return
 }
 
@@ -510,8 +785,15 @@ func (c *EC2) CreateReservedInstancesListing(req *CreateReservedInstancesListing
 // target the traffic. For more information about route tables, see Route
 // Tables in the Amazon Virtual Private Cloud User Guide
 func (c *EC2) CreateRoute(req *CreateRouteRequest) (err error) {
+	return c.CreateRouteWithContext(context.Background(), req)
+}
+
+// CreateRouteWithContext is like CreateRoute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateRouteWithContext(ctx context.Context, req *CreateRouteRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateRoute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateRoute", "POST", "/", req, nil)
 	return
 }
 
@@ -520,8 +802,15 @@ func (c *EC2) CreateRoute(req *CreateRouteRequest) (err error) {
 // subnet. For more information about route tables, see Route Tables in the
 // Amazon Virtual Private Cloud User Guide
 func (c *EC2) CreateRouteTable(req *CreateRouteTableRequest) (resp *CreateRouteTableResult, err error) {
+	return c.CreateRouteTableWithContext(context.Background(), req)
+}
+
+// CreateRouteTableWithContext is like CreateRouteTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateRouteTableWithContext(ctx context.Context, req *CreateRouteTableRequest) (resp *CreateRouteTableResult, err error) {
 	resp = &CreateRouteTableResult{}
-	err = c.client.Do("CreateRouteTable", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateRouteTable", "POST", "/", req, resp)
 	return
 }
 
@@ -545,8 +834,15 @@ func (c *EC2) CreateRouteTable(req *CreateRouteTableRequest) (resp *CreateRouteT
 // AuthorizeSecurityGroupIngress , AuthorizeSecurityGroupEgress ,
 // RevokeSecurityGroupIngress , and RevokeSecurityGroupEgress
 func (c *EC2) CreateSecurityGroup(req *CreateSecurityGroupRequest) (resp *CreateSecurityGroupResult, err error) {
+	return c.CreateSecurityGroupWithContext(context.Background(), req)
+}
+
+// CreateSecurityGroupWithContext is like CreateSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateSecurityGroupWithContext(ctx context.Context, req *CreateSecurityGroupRequest) (resp *CreateSecurityGroupResult, err error) {
 	resp = &CreateSecurityGroupResult{}
-	err = c.client.Do("CreateSecurityGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSecurityGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -573,8 +869,15 @@ func (c *EC2) CreateSecurityGroup(req *CreateSecurityGroupRequest) (resp *Create
 // For more information, see Amazon Elastic Block Store and Amazon EBS
 // Encryption in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateSnapshot(req *CreateSnapshotRequest) (resp *Snapshot, err error) {
+	return c.CreateSnapshotWithContext(context.Background(), req)
+}
+
+// CreateSnapshotWithContext is like CreateSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateSnapshotWithContext(ctx context.Context, req *CreateSnapshotRequest) (resp *Snapshot, err error) {
 	resp = &Snapshot{}
-	err = c.client.Do("CreateSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -583,8 +886,15 @@ func (c *EC2) CreateSnapshot(req *CreateSnapshotRequest) (resp *Snapshot, err er
 // feed per AWS account. For more information, see Spot Instances in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateSpotDatafeedSubscription(req *CreateSpotDatafeedSubscriptionRequest) (resp *CreateSpotDatafeedSubscriptionResult, err error) {
+	return c.CreateSpotDatafeedSubscriptionWithContext(context.Background(), req)
+}
+
+// CreateSpotDatafeedSubscriptionWithContext is like CreateSpotDatafeedSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateSpotDatafeedSubscriptionWithContext(ctx context.Context, req *CreateSpotDatafeedSubscriptionRequest) (resp *CreateSpotDatafeedSubscriptionResult, err error) {
 	resp = &CreateSpotDatafeedSubscriptionResult{}
-	err = c.client.Do("CreateSpotDatafeedSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSpotDatafeedSubscription", "POST", "/", req, resp)
 	return
 }
 
@@ -607,8 +917,15 @@ func (c *EC2) CreateSpotDatafeedSubscription(req *CreateSpotDatafeedSubscription
 // information about subnets, see Your VPC and Subnets in the Amazon
 // Virtual Private Cloud User Guide
 func (c *EC2) CreateSubnet(req *CreateSubnetRequest) (resp *CreateSubnetResult, err error) {
+	return c.CreateSubnetWithContext(context.Background(), req)
+}
+
+// CreateSubnetWithContext is like CreateSubnet, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateSubnetWithContext(ctx context.Context, req *CreateSubnetRequest) (resp *CreateSubnetResult, err error) {
 	resp = &CreateSubnetResult{}
-	err = c.client.Do("CreateSubnet", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSubnet", "POST", "/", req, resp)
 	return
 }
 
@@ -618,8 +935,15 @@ func (c *EC2) CreateSubnet(req *CreateSubnetRequest) (resp *CreateSubnetResult,
 // resource. For more information about tags, see Tagging Your Resources in
 // the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateTags(req *CreateTagsRequest) (err error) {
+	return c.CreateTagsWithContext(context.Background(), req)
+}
+
+// CreateTagsWithContext is like CreateTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateTagsWithContext(ctx context.Context, req *CreateTagsRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateTags", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateTags", "POST", "/", req, nil)
 	return
 }
 
@@ -635,8 +959,15 @@ func (c *EC2) CreateTags(req *CreateTagsRequest) (err error) {
 // Cloud User Guide For more information, see Creating or Restoring an
 // Amazon EBS Volume in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) CreateVolume(req *CreateVolumeRequest) (resp *Volume, err error) {
+	return c.CreateVolumeWithContext(context.Background(), req)
+}
+
+// CreateVolumeWithContext is like CreateVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateVolumeWithContext(ctx context.Context, req *CreateVolumeRequest) (resp *Volume, err error) {
 	resp = &Volume{}
-	err = c.client.Do("CreateVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateVolume", "POST", "/", req, resp)
 	return
 }
 
@@ -649,8 +980,15 @@ func (c *EC2) CreateVolume(req *CreateVolumeRequest) (resp *Volume, err error) {
 // (AmazonProvidedDNS). For more information about options, see Options
 // Sets in the Amazon Virtual Private Cloud User Guide
 func (c *EC2) CreateVPC(req *CreateVPCRequest) (resp *CreateVPCResult, err error) {
+	return c.CreateVPCWithContext(context.Background(), req)
+}
+
+// CreateVPCWithContext is like CreateVPC, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateVPCWithContext(ctx context.Context, req *CreateVPCRequest) (resp *CreateVPCResult, err error) {
 	resp = &CreateVPCResult{}
-	err = c.client.Do("CreateVpc", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateVpc", "POST", "/", req, resp)
 	return
 }
 
@@ -664,8 +1002,15 @@ func (c *EC2) CreateVPC(req *CreateVPCRequest) (resp *CreateVPCResult, err error
 // CreateVpcPeeringConnection request between VPCs with overlapping blocks
 // results in the VPC peering connection having a status of failed
 func (c *EC2) CreateVPCPeeringConnection(req *CreateVPCPeeringConnectionRequest) (resp *CreateVPCPeeringConnectionResult, err error) {
+	return c.CreateVPCPeeringConnectionWithContext(context.Background(), req)
+}
+
+// CreateVPCPeeringConnectionWithContext is like CreateVPCPeeringConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateVPCPeeringConnectionWithContext(ctx context.Context, req *CreateVPCPeeringConnectionRequest) (resp *CreateVPCPeeringConnectionResult, err error) {
 	resp = &CreateVPCPeeringConnectionResult{}
-	err = c.client.Do("CreateVpcPeeringConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateVpcPeeringConnection", "POST", "/", req, resp)
 	return
 }
 
@@ -682,8 +1027,15 @@ func (c *EC2) CreateVPCPeeringConnection(req *CreateVPCPeeringConnectionRequest)
 // Hardware Virtual Private Gateway to Your in the Amazon Virtual Private
 // Cloud User Guide
 func (c *EC2) CreateVPNConnection(req *CreateVPNConnectionRequest) (resp *CreateVPNConnectionResult, err error) {
+	return c.CreateVPNConnectionWithContext(context.Background(), req)
+}
+
+// CreateVPNConnectionWithContext is like CreateVPNConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateVPNConnectionWithContext(ctx context.Context, req *CreateVPNConnectionRequest) (resp *CreateVPNConnectionResult, err error) {
 	resp = &CreateVPNConnectionResult{}
-	err = c.client.Do("CreateVpnConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateVpnConnection", "POST", "/", req, resp)
 	return
 }
 
@@ -694,8 +1046,15 @@ func (c *EC2) CreateVPNConnection(req *CreateVPNConnectionRequest) (resp *Create
 // information about VPN connections, see Adding a Hardware Virtual Private
 // Gateway to Your in the Amazon Virtual Private Cloud User Guide
 func (c *EC2) CreateVPNConnectionRoute(req *CreateVPNConnectionRouteRequest) (err error) {
+	return c.CreateVPNConnectionRouteWithContext(context.Background(), req)
+}
+
+// CreateVPNConnectionRouteWithContext is like CreateVPNConnectionRoute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateVPNConnectionRouteWithContext(ctx context.Context, req *CreateVPNConnectionRouteRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateVpnConnectionRoute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateVpnConnectionRoute", "POST", "/", req, nil)
 	return
 }
 
@@ -706,16 +1065,30 @@ func (c *EC2) CreateVPNConnectionRoute(req *CreateVPNConnectionRouteRequest) (er
 // Virtual Private Gateway to Your in the Amazon Virtual Private Cloud User
 // Guide
 func (c *EC2) CreateVPNGateway(req *CreateVPNGatewayRequest) (resp *CreateVPNGatewayResult, err error) {
+	return c.CreateVPNGatewayWithContext(context.Background(), req)
+}
+
+// CreateVPNGatewayWithContext is like CreateVPNGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) CreateVPNGatewayWithContext(ctx context.Context, req *CreateVPNGatewayRequest) (resp *CreateVPNGatewayResult, err error) {
 	resp = &CreateVPNGatewayResult{}
-	err = c.client.Do("CreateVpnGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateVpnGateway", "POST", "/", req, resp)
 	return
 }
 
 // DeleteCustomerGateway deletes the specified customer gateway. You must
 // delete the VPN connection before you can delete the customer gateway.
 func (c *EC2) DeleteCustomerGateway(req *DeleteCustomerGatewayRequest) (err error) {
+	return c.DeleteCustomerGatewayWithContext(context.Background(), req)
+}
+
+// DeleteCustomerGatewayWithContext is like DeleteCustomerGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteCustomerGatewayWithContext(ctx context.Context, req *DeleteCustomerGatewayRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteCustomerGateway", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteCustomerGateway", "POST", "/", req, nil)
 	return
 }
 
@@ -724,24 +1097,45 @@ func (c *EC2) DeleteCustomerGateway(req *DeleteCustomerGatewayRequest) (err erro
 // disassociate the set of options by associating either a new set of
 // options or the default set of options with the
 func (c *EC2) DeleteDHCPOptions(req *DeleteDHCPOptionsRequest) (err error) {
+	return c.DeleteDHCPOptionsWithContext(context.Background(), req)
+}
+
+// DeleteDHCPOptionsWithContext is like DeleteDHCPOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteDHCPOptionsWithContext(ctx context.Context, req *DeleteDHCPOptionsRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteDhcpOptions", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteDhcpOptions", "POST", "/", req, nil)
 	return
 }
 
 // DeleteInternetGateway deletes the specified Internet gateway. You must
 // detach the Internet gateway from the VPC before you can delete it.
 func (c *EC2) DeleteInternetGateway(req *DeleteInternetGatewayRequest) (err error) {
+	return c.DeleteInternetGatewayWithContext(context.Background(), req)
+}
+
+// DeleteInternetGatewayWithContext is like DeleteInternetGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteInternetGatewayWithContext(ctx context.Context, req *DeleteInternetGatewayRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteInternetGateway", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteInternetGateway", "POST", "/", req, nil)
 	return
 }
 
 // DeleteKeyPair deletes the specified key pair, by removing the public key
 // from Amazon EC2.
 func (c *EC2) DeleteKeyPair(req *DeleteKeyPairRequest) (err error) {
+	return c.DeleteKeyPairWithContext(context.Background(), req)
+}
+
+// DeleteKeyPairWithContext is like DeleteKeyPair, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteKeyPairWithContext(ctx context.Context, req *DeleteKeyPairRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteKeyPair", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteKeyPair", "POST", "/", req, nil)
 	return
 }
 
@@ -749,24 +1143,45 @@ func (c *EC2) DeleteKeyPair(req *DeleteKeyPairRequest) (err error) {
 // if it's associated with any subnets. You can't delete the default
 // network
 func (c *EC2) DeleteNetworkACL(req *DeleteNetworkACLRequest) (err error) {
+	return c.DeleteNetworkACLWithContext(context.Background(), req)
+}
+
+// DeleteNetworkACLWithContext is like DeleteNetworkACL, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteNetworkACLWithContext(ctx context.Context, req *DeleteNetworkACLRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteNetworkAcl", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteNetworkAcl", "POST", "/", req, nil)
 	return
 }
 
 // DeleteNetworkACLEntry deletes the specified ingress or egress entry
 // (rule) from the specified network
 func (c *EC2) DeleteNetworkACLEntry(req *DeleteNetworkACLEntryRequest) (err error) {
+	return c.DeleteNetworkACLEntryWithContext(context.Background(), req)
+}
+
+// DeleteNetworkACLEntryWithContext is like DeleteNetworkACLEntry, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteNetworkACLEntryWithContext(ctx context.Context, req *DeleteNetworkACLEntryRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteNetworkAclEntry", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteNetworkAclEntry", "POST", "/", req, nil)
 	return
 }
 
 // DeleteNetworkInterface deletes the specified network interface. You must
 // detach the network interface before you can delete it.
 func (c *EC2) DeleteNetworkInterface(req *DeleteNetworkInterfaceRequest) (err error) {
+	return c.DeleteNetworkInterfaceWithContext(context.Background(), req)
+}
+
+// DeleteNetworkInterfaceWithContext is like DeleteNetworkInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteNetworkInterfaceWithContext(ctx context.Context, req *DeleteNetworkInterfaceRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteNetworkInterface", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteNetworkInterface", "POST", "/", req, nil)
 	return
 }
 
@@ -776,15 +1191,29 @@ func (c *EC2) DeleteNetworkInterface(req *DeleteNetworkInterfaceRequest) (err er
 // instances, see Cluster Instances in the Amazon Elastic Compute Cloud
 // User Guide
 func (c *EC2) DeletePlacementGroup(req *DeletePlacementGroupRequest) (err error) {
+	return c.DeletePlacementGroupWithContext(context.Background(), req)
+}
+
+// DeletePlacementGroupWithContext is like DeletePlacementGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeletePlacementGroupWithContext(ctx context.Context, req *DeletePlacementGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeletePlacementGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeletePlacementGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteRoute deletes the specified route from the specified route table.
 func (c *EC2) DeleteRoute(req *DeleteRouteRequest) (err error) {
+	return c.DeleteRouteWithContext(context.Background(), req)
+}
+
+// DeleteRouteWithContext is like DeleteRoute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteRouteWithContext(ctx context.Context, req *DeleteRouteRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteRoute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteRoute", "POST", "/", req, nil)
 	return
 }
 
@@ -792,8 +1221,15 @@ func (c *EC2) DeleteRoute(req *DeleteRouteRequest) (err error) {
 // disassociate the route table from any subnets before you can delete it.
 // You can't delete the main route table.
 func (c *EC2) DeleteRouteTable(req *DeleteRouteTableRequest) (err error) {
+	return c.DeleteRouteTableWithContext(context.Background(), req)
+}
+
+// DeleteRouteTableWithContext is like DeleteRouteTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteRouteTableWithContext(ctx context.Context, req *DeleteRouteTableRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteRouteTable", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteRouteTable", "POST", "/", req, nil)
 	return
 }
 
@@ -802,8 +1238,15 @@ func (c *EC2) DeleteRouteTable(req *DeleteRouteTableRequest) (err error) {
 // another security group, the operation fails with InvalidGroup.InUse in
 // EC2-Classic or DependencyViolation in EC2-VPC.
 func (c *EC2) DeleteSecurityGroup(req *DeleteSecurityGroupRequest) (err error) {
+	return c.DeleteSecurityGroupWithContext(context.Background(), req)
+}
+
+// DeleteSecurityGroupWithContext is like DeleteSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteSecurityGroupWithContext(ctx context.Context, req *DeleteSecurityGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteSecurityGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteSecurityGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -819,8 +1262,15 @@ func (c *EC2) DeleteSecurityGroup(req *DeleteSecurityGroupRequest) (err error) {
 // For more information, see Deleting an Amazon EBS Snapshot in the Amazon
 // Elastic Compute Cloud User Guide
 func (c *EC2) DeleteSnapshot(req *DeleteSnapshotRequest) (err error) {
+	return c.DeleteSnapshotWithContext(context.Background(), req)
+}
+
+// DeleteSnapshotWithContext is like DeleteSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteSnapshotWithContext(ctx context.Context, req *DeleteSnapshotRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteSnapshot", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteSnapshot", "POST", "/", req, nil)
 	return
 }
 
@@ -828,16 +1278,30 @@ func (c *EC2) DeleteSnapshot(req *DeleteSnapshotRequest) (err error) {
 // For more information, see Spot Instances in the Amazon Elastic Compute
 // Cloud User Guide
 func (c *EC2) DeleteSpotDatafeedSubscription(req *DeleteSpotDatafeedSubscriptionRequest) (err error) {
+	return c.DeleteSpotDatafeedSubscriptionWithContext(context.Background(), req)
+}
+
+// DeleteSpotDatafeedSubscriptionWithContext is like DeleteSpotDatafeedSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteSpotDatafeedSubscriptionWithContext(ctx context.Context, req *DeleteSpotDatafeedSubscriptionRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteSpotDatafeedSubscription", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteSpotDatafeedSubscription", "POST", "/", req, nil)
 	return
 }
 
 // DeleteSubnet deletes the specified subnet. You must terminate all
 // running instances in the subnet before you can delete the subnet.
 func (c *EC2) DeleteSubnet(req *DeleteSubnetRequest) (err error) {
+	return c.DeleteSubnetWithContext(context.Background(), req)
+}
+
+// DeleteSubnetWithContext is like DeleteSubnet, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteSubnetWithContext(ctx context.Context, req *DeleteSubnetRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteSubnet", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteSubnet", "POST", "/", req, nil)
 	return
 }
 
@@ -846,8 +1310,15 @@ func (c *EC2) DeleteSubnet(req *DeleteSubnetRequest) (err error) {
 // more information about tags, see Tagging Your Resources in the Amazon
 // Elastic Compute Cloud User Guide
 func (c *EC2) DeleteTags(req *DeleteTagsRequest) (err error) {
+	return c.DeleteTagsWithContext(context.Background(), req)
+}
+
+// DeleteTagsWithContext is like DeleteTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteTagsWithContext(ctx context.Context, req *DeleteTagsRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteTags", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteTags", "POST", "/", req, nil)
 	return
 }
 
@@ -857,8 +1328,15 @@ func (c *EC2) DeleteTags(req *DeleteTagsRequest) (err error) {
 // see Deleting an Amazon EBS Volume in the Amazon Elastic Compute Cloud
 // User Guide
 func (c *EC2) DeleteVolume(req *DeleteVolumeRequest) (err error) {
+	return c.DeleteVolumeWithContext(context.Background(), req)
+}
+
+// DeleteVolumeWithContext is like DeleteVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteVolumeWithContext(ctx context.Context, req *DeleteVolumeRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteVolume", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteVolume", "POST", "/", req, nil)
 	return
 }
 
@@ -869,8 +1347,15 @@ func (c *EC2) DeleteVolume(req *DeleteVolumeRequest) (err error) {
 // all route tables associated with the VPC (except the default one), and
 // so on.
 func (c *EC2) DeleteVPC(req *DeleteVPCRequest) (err error) {
+	return c.DeleteVPCWithContext(context.Background(), req)
+}
+
+// DeleteVPCWithContext is like DeleteVPC, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteVPCWithContext(ctx context.Context, req *DeleteVPCRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteVpc", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteVpc", "POST", "/", req, nil)
 	return
 }
 
@@ -880,8 +1365,15 @@ func (c *EC2) DeleteVPC(req *DeleteVPCRequest) (err error) {
 // requester VPC can delete a VPC peering connection in the
 // pending-acceptance state.
 func (c *EC2) DeleteVPCPeeringConnection(req *DeleteVPCPeeringConnectionRequest) (resp *DeleteVPCPeeringConnectionResult, err error) {
+	return c.DeleteVPCPeeringConnectionWithContext(context.Background(), req)
+}
+
+// DeleteVPCPeeringConnectionWithContext is like DeleteVPCPeeringConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteVPCPeeringConnectionWithContext(ctx context.Context, req *DeleteVPCPeeringConnectionRequest) (resp *DeleteVPCPeeringConnectionResult, err error) {
 	resp = &DeleteVPCPeeringConnectionResult{}
-	err = c.client.Do("DeleteVpcPeeringConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteVpcPeeringConnection", "POST", "/", req, resp)
 	return
 }
 
@@ -896,8 +1388,15 @@ func (c *EC2) DeleteVPCPeeringConnection(req *DeleteVPCPeeringConnectionRequest)
 // using the new configuration information returned with the new VPN
 // connection
 func (c *EC2) DeleteVPNConnection(req *DeleteVPNConnectionRequest) (err error) {
+	return c.DeleteVPNConnectionWithContext(context.Background(), req)
+}
+
+// DeleteVPNConnectionWithContext is like DeleteVPNConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteVPNConnectionWithContext(ctx context.Context, req *DeleteVPNConnectionRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteVpnConnection", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteVpnConnection", "POST", "/", req, nil)
 	return
 }
 
@@ -906,8 +1405,15 @@ func (c *EC2) DeleteVPNConnection(req *DeleteVPNConnectionRequest) (err error) {
 // VPN customer gateway. The static route allows traffic to be routed from
 // the virtual private gateway to the VPN customer gateway.
 func (c *EC2) DeleteVPNConnectionRoute(req *DeleteVPNConnectionRouteRequest) (err error) {
+	return c.DeleteVPNConnectionRouteWithContext(context.Background(), req)
+}
+
+// DeleteVPNConnectionRouteWithContext is like DeleteVPNConnectionRoute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteVPNConnectionRouteWithContext(ctx context.Context, req *DeleteVPNConnectionRouteRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteVpnConnectionRoute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteVpnConnectionRoute", "POST", "/", req, nil)
 	return
 }
 
@@ -917,24 +1423,45 @@ func (c *EC2) DeleteVPNConnectionRoute(req *DeleteVPNConnectionRouteRequest) (er
 // to delete the virtual private gateway if you plan to delete and recreate
 // the VPN connection between your VPC and your network.
 func (c *EC2) DeleteVPNGateway(req *DeleteVPNGatewayRequest) (err error) {
+	return c.DeleteVPNGatewayWithContext(context.Background(), req)
+}
+
+// DeleteVPNGatewayWithContext is like DeleteVPNGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeleteVPNGatewayWithContext(ctx context.Context, req *DeleteVPNGatewayRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteVpnGateway", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteVpnGateway", "POST", "/", req, nil)
 	return
 }
 
 // DeregisterImage deregisters the specified After you deregister an it
 // can't be used to launch new instances. This command does not delete the
 func (c *EC2) DeregisterImage(req *DeregisterImageRequest) (err error) {
+	return c.DeregisterImageWithContext(context.Background(), req)
+}
+
+// DeregisterImageWithContext is like DeregisterImage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DeregisterImageWithContext(ctx context.Context, req *DeregisterImageRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeregisterImage", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeregisterImage", "POST", "/", req, nil)
 	return
 }
 
 // DescribeAccountAttributes describes the specified attribute of your AWS
 // account.
 func (c *EC2) DescribeAccountAttributes(req *DescribeAccountAttributesRequest) (resp *DescribeAccountAttributesResult, err error) {
+	return c.DescribeAccountAttributesWithContext(context.Background(), req)
+}
+
+// DescribeAccountAttributesWithContext is like DescribeAccountAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeAccountAttributesWithContext(ctx context.Context, req *DescribeAccountAttributesRequest) (resp *DescribeAccountAttributesResult, err error) {
 	resp = &DescribeAccountAttributesResult{}
-	err = c.client.Do("DescribeAccountAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAccountAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -943,8 +1470,15 @@ func (c *EC2) DescribeAccountAttributes(req *DescribeAccountAttributesRequest) (
 // For more information, see Elastic IP Addresses in the Amazon Elastic
 // Compute Cloud User Guide
 func (c *EC2) DescribeAddresses(req *DescribeAddressesRequest) (resp *DescribeAddressesResult, err error) {
+	return c.DescribeAddressesWithContext(context.Background(), req)
+}
+
+// DescribeAddressesWithContext is like DescribeAddresses, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeAddressesWithContext(ctx context.Context, req *DescribeAddressesRequest) (resp *DescribeAddressesResult, err error) {
 	resp = &DescribeAddressesResult{}
-	err = c.client.Do("DescribeAddresses", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAddresses", "POST", "/", req, resp)
 	return
 }
 
@@ -956,8 +1490,15 @@ func (c *EC2) DescribeAddresses(req *DescribeAddressesRequest) (resp *DescribeAd
 // Regions and Availability Zones in the Amazon Elastic Compute Cloud User
 // Guide
 func (c *EC2) DescribeAvailabilityZones(req *DescribeAvailabilityZonesRequest) (resp *DescribeAvailabilityZonesResult, err error) {
+	return c.DescribeAvailabilityZonesWithContext(context.Background(), req)
+}
+
+// DescribeAvailabilityZonesWithContext is like DescribeAvailabilityZones, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeAvailabilityZonesWithContext(ctx context.Context, req *DescribeAvailabilityZonesRequest) (resp *DescribeAvailabilityZonesResult, err error) {
 	resp = &DescribeAvailabilityZonesResult{}
-	err = c.client.Do("DescribeAvailabilityZones", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAvailabilityZones", "POST", "/", req, resp)
 	return
 }
 
@@ -967,8 +1508,15 @@ func (c *EC2) DescribeAvailabilityZones(req *DescribeAvailabilityZonesRequest) (
 // it. Just use RegisterImage with the Amazon S3 bucket name and image
 // manifest name you provided to the bundle task.
 func (c *EC2) DescribeBundleTasks(req *DescribeBundleTasksRequest) (resp *DescribeBundleTasksResult, err error) {
+	return c.DescribeBundleTasksWithContext(context.Background(), req)
+}
+
+// DescribeBundleTasksWithContext is like DescribeBundleTasks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeBundleTasksWithContext(ctx context.Context, req *DescribeBundleTasksRequest) (resp *DescribeBundleTasksResult, err error) {
 	resp = &DescribeBundleTasksResult{}
-	err = c.client.Do("DescribeBundleTasks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeBundleTasks", "POST", "/", req, resp)
 	return
 }
 
@@ -977,8 +1525,15 @@ func (c *EC2) DescribeBundleTasks(req *DescribeBundleTasksRequest) (resp *Descri
 // Virtual Machine to Amazon EC2 in the Amazon Elastic Compute Cloud User
 // Guide
 func (c *EC2) DescribeConversionTasks(req *DescribeConversionTasksRequest) (resp *DescribeConversionTasksResult, err error) {
+	return c.DescribeConversionTasksWithContext(context.Background(), req)
+}
+
+// DescribeConversionTasksWithContext is like DescribeConversionTasks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeConversionTasksWithContext(ctx context.Context, req *DescribeConversionTasksRequest) (resp *DescribeConversionTasksResult, err error) {
 	resp = &DescribeConversionTasksResult{}
-	err = c.client.Do("DescribeConversionTasks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeConversionTasks", "POST", "/", req, resp)
 	return
 }
 
@@ -987,8 +1542,15 @@ func (c *EC2) DescribeConversionTasks(req *DescribeConversionTasksRequest) (resp
 // Hardware Virtual Private Gateway to Your in the Amazon Virtual Private
 // Cloud User Guide
 func (c *EC2) DescribeCustomerGateways(req *DescribeCustomerGatewaysRequest) (resp *DescribeCustomerGatewaysResult, err error) {
+	return c.DescribeCustomerGatewaysWithContext(context.Background(), req)
+}
+
+// DescribeCustomerGatewaysWithContext is like DescribeCustomerGateways, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeCustomerGatewaysWithContext(ctx context.Context, req *DescribeCustomerGatewaysRequest) (resp *DescribeCustomerGatewaysResult, err error) {
 	resp = &DescribeCustomerGatewaysResult{}
-	err = c.client.Do("DescribeCustomerGateways", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCustomerGateways", "POST", "/", req, resp)
 	return
 }
 
@@ -996,23 +1558,44 @@ func (c *EC2) DescribeCustomerGateways(req *DescribeCustomerGatewaysRequest) (re
 // information about options sets, see Options Sets in the Amazon Virtual
 // Private Cloud User Guide
 func (c *EC2) DescribeDHCPOptions(req *DescribeDHCPOptionsRequest) (resp *DescribeDHCPOptionsResult, err error) {
+	return c.DescribeDHCPOptionsWithContext(context.Background(), req)
+}
+
+// DescribeDHCPOptionsWithContext is like DescribeDHCPOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeDHCPOptionsWithContext(ctx context.Context, req *DescribeDHCPOptionsRequest) (resp *DescribeDHCPOptionsResult, err error) {
 	resp = &DescribeDHCPOptionsResult{}
-	err = c.client.Do("DescribeDhcpOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDhcpOptions", "POST", "/", req, resp)
 	return
 }
 
 // DescribeExportTasks is undocumented.
 func (c *EC2) DescribeExportTasks(req *DescribeExportTasksRequest) (resp *DescribeExportTasksResult, err error) {
+	return c.DescribeExportTasksWithContext(context.Background(), req)
+}
+
+// DescribeExportTasksWithContext is like DescribeExportTasks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeExportTasksWithContext(ctx context.Context, req *DescribeExportTasksRequest) (resp *DescribeExportTasksResult, err error) {
 	resp = &DescribeExportTasksResult{}
-	err = c.client.Do("DescribeExportTasks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeExportTasks", "POST", "/", req, resp)
 	return
 }
 
 // DescribeImageAttribute describes the specified attribute of the
 // specified You can specify only one attribute at a time.
 func (c *EC2) DescribeImageAttribute(req *DescribeImageAttributeRequest) (resp *ImageAttribute, err error) {
+	return c.DescribeImageAttributeWithContext(context.Background(), req)
+}
+
+// DescribeImageAttributeWithContext is like DescribeImageAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeImageAttributeWithContext(ctx context.Context, req *DescribeImageAttributeRequest) (resp *ImageAttribute, err error) {
 	resp = &ImageAttribute{}
-	err = c.client.Do("DescribeImageAttribute", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeImageAttribute", "POST", "/", req, resp)
 	return
 }
 
@@ -1023,8 +1606,15 @@ func (c *EC2) DescribeImageAttribute(req *DescribeImageAttributeRequest) (resp *
 // Deregistered images are included in the returned results for an
 // unspecified interval after deregistration.
 func (c *EC2) DescribeImages(req *DescribeImagesRequest) (resp *DescribeImagesResult, err error) {
+	return c.DescribeImagesWithContext(context.Background(), req)
+}
+
+// DescribeImagesWithContext is like DescribeImages, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeImagesWithContext(ctx context.Context, req *DescribeImagesRequest) (resp *DescribeImagesResult, err error) {
 	resp = &DescribeImagesResult{}
-	err = c.client.Do("DescribeImages", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeImages", "POST", "/", req, resp)
 	return
 }
 
@@ -1035,8 +1625,15 @@ func (c *EC2) DescribeImages(req *DescribeImagesRequest) (resp *DescribeImagesRe
 // rootDeviceName | blockDeviceMapping | productCodes | sourceDestCheck |
 // groupSet | ebsOptimized | sriovNetSupport
 func (c *EC2) DescribeInstanceAttribute(req *DescribeInstanceAttributeRequest) (resp *InstanceAttribute, err error) {
+	return c.DescribeInstanceAttributeWithContext(context.Background(), req)
+}
+
+// DescribeInstanceAttributeWithContext is like DescribeInstanceAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeInstanceAttributeWithContext(ctx context.Context, req *DescribeInstanceAttributeRequest) (resp *InstanceAttribute, err error) {
 	resp = &InstanceAttribute{}
-	err = c.client.Do("DescribeInstanceAttribute", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeInstanceAttribute", "POST", "/", req, resp)
 	return
 }
 
@@ -1089,8 +1686,15 @@ func (c *EC2) DescribeInstanceAttribute(req *DescribeInstanceAttributeRequest) (
 // working with scheduled events, see Working with an Instance That Has a
 // Scheduled Event in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeInstanceStatus(req *DescribeInstanceStatusRequest) (resp *DescribeInstanceStatusResult, err error) {
+	return c.DescribeInstanceStatusWithContext(context.Background(), req)
+}
+
+// DescribeInstanceStatusWithContext is like DescribeInstanceStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeInstanceStatusWithContext(ctx context.Context, req *DescribeInstanceStatusRequest) (resp *DescribeInstanceStatusResult, err error) {
 	resp = &DescribeInstanceStatusResult{}
-	err = c.client.Do("DescribeInstanceStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeInstanceStatus", "POST", "/", req, resp)
 	return
 }
 
@@ -1103,15 +1707,29 @@ func (c *EC2) DescribeInstanceStatus(req *DescribeInstanceStatusRequest) (resp *
 // terminated instances might appear in the returned results. This interval
 // is usually less than one hour.
 func (c *EC2) DescribeInstances(req *DescribeInstancesRequest) (resp *DescribeInstancesResult, err error) {
+	return c.DescribeInstancesWithContext(context.Background(), req)
+}
+
+// DescribeInstancesWithContext is like DescribeInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeInstancesWithContext(ctx context.Context, req *DescribeInstancesRequest) (resp *DescribeInstancesResult, err error) {
 	resp = &DescribeInstancesResult{}
-	err = c.client.Do("DescribeInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeInstances", "POST", "/", req, resp)
 	return
 }
 
 // DescribeInternetGateways is undocumented.
 func (c *EC2) DescribeInternetGateways(req *DescribeInternetGatewaysRequest) (resp *DescribeInternetGatewaysResult, err error) {
+	return c.DescribeInternetGatewaysWithContext(context.Background(), req)
+}
+
+// DescribeInternetGatewaysWithContext is like DescribeInternetGateways, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeInternetGatewaysWithContext(ctx context.Context, req *DescribeInternetGatewaysRequest) (resp *DescribeInternetGatewaysResult, err error) {
 	resp = &DescribeInternetGatewaysResult{}
-	err = c.client.Do("DescribeInternetGateways", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeInternetGateways", "POST", "/", req, resp)
 	return
 }
 
@@ -1119,8 +1737,15 @@ func (c *EC2) DescribeInternetGateways(req *DescribeInternetGatewaysRequest) (re
 // information about key pairs, see Key Pairs in the Amazon Elastic Compute
 // Cloud User Guide
 func (c *EC2) DescribeKeyPairs(req *DescribeKeyPairsRequest) (resp *DescribeKeyPairsResult, err error) {
+	return c.DescribeKeyPairsWithContext(context.Background(), req)
+}
+
+// DescribeKeyPairsWithContext is like DescribeKeyPairs, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeKeyPairsWithContext(ctx context.Context, req *DescribeKeyPairsRequest) (resp *DescribeKeyPairsResult, err error) {
 	resp = &DescribeKeyPairsResult{}
-	err = c.client.Do("DescribeKeyPairs", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeKeyPairs", "POST", "/", req, resp)
 	return
 }
 
@@ -1128,23 +1753,44 @@ func (c *EC2) DescribeKeyPairs(req *DescribeKeyPairsRequest) (resp *DescribeKeyP
 // information about network ACLs, see Network ACLs in the Amazon Virtual
 // Private Cloud User Guide
 func (c *EC2) DescribeNetworkACLs(req *DescribeNetworkACLsRequest) (resp *DescribeNetworkACLsResult, err error) {
+	return c.DescribeNetworkACLsWithContext(context.Background(), req)
+}
+
+// DescribeNetworkACLsWithContext is like DescribeNetworkACLs, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeNetworkACLsWithContext(ctx context.Context, req *DescribeNetworkACLsRequest) (resp *DescribeNetworkACLsResult, err error) {
 	resp = &DescribeNetworkACLsResult{}
-	err = c.client.Do("DescribeNetworkAcls", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeNetworkAcls", "POST", "/", req, resp)
 	return
 }
 
 // DescribeNetworkInterfaceAttribute describes a network interface
 // attribute. You can specify only one attribute at a time.
 func (c *EC2) DescribeNetworkInterfaceAttribute(req *DescribeNetworkInterfaceAttributeRequest) (resp *DescribeNetworkInterfaceAttributeResult, err error) {
+	return c.DescribeNetworkInterfaceAttributeWithContext(context.Background(), req)
+}
+
+// DescribeNetworkInterfaceAttributeWithContext is like DescribeNetworkInterfaceAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeNetworkInterfaceAttributeWithContext(ctx context.Context, req *DescribeNetworkInterfaceAttributeRequest) (resp *DescribeNetworkInterfaceAttributeResult, err error) {
 	resp = &DescribeNetworkInterfaceAttributeResult{}
-	err = c.client.Do("DescribeNetworkInterfaceAttribute", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeNetworkInterfaceAttribute", "POST", "/", req, resp)
 	return
 }
 
 // DescribeNetworkInterfaces is undocumented.
 func (c *EC2) DescribeNetworkInterfaces(req *DescribeNetworkInterfacesRequest) (resp *DescribeNetworkInterfacesResult, err error) {
+	return c.DescribeNetworkInterfacesWithContext(context.Background(), req)
+}
+
+// DescribeNetworkInterfacesWithContext is like DescribeNetworkInterfaces, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeNetworkInterfacesWithContext(ctx context.Context, req *DescribeNetworkInterfacesRequest) (resp *DescribeNetworkInterfacesResult, err error) {
 	resp = &DescribeNetworkInterfacesResult{}
-	err = c.client.Do("DescribeNetworkInterfaces", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeNetworkInterfaces", "POST", "/", req, resp)
 	return
 }
 
@@ -1152,8 +1798,15 @@ func (c *EC2) DescribeNetworkInterfaces(req *DescribeNetworkInterfacesRequest) (
 // For more information about placement groups and cluster instances, see
 // Cluster Instances in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribePlacementGroups(req *DescribePlacementGroupsRequest) (resp *DescribePlacementGroupsResult, err error) {
+	return c.DescribePlacementGroupsWithContext(context.Background(), req)
+}
+
+// DescribePlacementGroupsWithContext is like DescribePlacementGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribePlacementGroupsWithContext(ctx context.Context, req *DescribePlacementGroupsRequest) (resp *DescribePlacementGroupsResult, err error) {
 	resp = &DescribePlacementGroupsResult{}
-	err = c.client.Do("DescribePlacementGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribePlacementGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -1161,8 +1814,15 @@ func (c *EC2) DescribePlacementGroups(req *DescribePlacementGroupsRequest) (resp
 // available to you. For a list of the regions supported by Amazon EC2, see
 // Regions and Endpoints
 func (c *EC2) DescribeRegions(req *DescribeRegionsRequest) (resp *DescribeRegionsResult, err error) {
+	return c.DescribeRegionsWithContext(context.Background(), req)
+}
+
+// DescribeRegionsWithContext is like DescribeRegions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeRegionsWithContext(ctx context.Context, req *DescribeRegionsRequest) (resp *DescribeRegionsResult, err error) {
 	resp = &DescribeRegionsResult{}
-	err = c.client.Do("DescribeRegions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeRegions", "POST", "/", req, resp)
 	return
 }
 
@@ -1171,8 +1831,15 @@ func (c *EC2) DescribeRegions(req *DescribeRegionsRequest) (resp *DescribeRegion
 // Instances, see Reserved Instances in the Amazon Elastic Compute Cloud
 // User Guide
 func (c *EC2) DescribeReservedInstances(req *DescribeReservedInstancesRequest) (resp *DescribeReservedInstancesResult, err error) {
+	return c.DescribeReservedInstancesWithContext(context.Background(), req)
+}
+
+// DescribeReservedInstancesWithContext is like DescribeReservedInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeReservedInstancesWithContext(ctx context.Context, req *DescribeReservedInstancesRequest) (resp *DescribeReservedInstancesResult, err error) {
 	resp = &DescribeReservedInstancesResult{}
-	err = c.client.Do("DescribeReservedInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -1194,8 +1861,15 @@ func (c *EC2) DescribeReservedInstances(req *DescribeReservedInstancesRequest) (
 // that you purchase. For more information, see Reserved Instance
 // Marketplace in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeReservedInstancesListings(req *DescribeReservedInstancesListingsRequest) (resp *DescribeReservedInstancesListingsResult, err error) {
+	return c.DescribeReservedInstancesListingsWithContext(context.Background(), req)
+}
+
+// DescribeReservedInstancesListingsWithContext is like DescribeReservedInstancesListings, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeReservedInstancesListingsWithContext(ctx context.Context, req *DescribeReservedInstancesListingsRequest) (resp *DescribeReservedInstancesListingsResult, err error) {
 	resp = &DescribeReservedInstancesListingsResult{}
-	err = c.client.Do("DescribeReservedInstancesListings", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedInstancesListings", "POST", "/", req, resp)
 	return
 }
 
@@ -1206,8 +1880,15 @@ func (c *EC2) DescribeReservedInstancesListings(req *DescribeReservedInstancesLi
 // modification is returned. For more information, see Modifying Reserved
 // Instances in the Amazon Elastic Compute Cloud User Guide.
 func (c *EC2) DescribeReservedInstancesModifications(req *DescribeReservedInstancesModificationsRequest) (resp *DescribeReservedInstancesModificationsResult, err error) {
+	return c.DescribeReservedInstancesModificationsWithContext(context.Background(), req)
+}
+
+// DescribeReservedInstancesModificationsWithContext is like DescribeReservedInstancesModifications, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeReservedInstancesModificationsWithContext(ctx context.Context, req *DescribeReservedInstancesModificationsRequest) (resp *DescribeReservedInstancesModificationsResult, err error) {
 	resp = &DescribeReservedInstancesModificationsResult{}
-	err = c.client.Do("DescribeReservedInstancesModifications", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedInstancesModifications", "POST", "/", req, resp)
 	return
 }
 
@@ -1219,8 +1900,15 @@ func (c *EC2) DescribeReservedInstancesModifications(req *DescribeReservedInstan
 // actual time used. For more information, see Reserved Instance
 // Marketplace in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeReservedInstancesOfferings(req *DescribeReservedInstancesOfferingsRequest) (resp *DescribeReservedInstancesOfferingsResult, err error) {
+	return c.DescribeReservedInstancesOfferingsWithContext(context.Background(), req)
+}
+
+// DescribeReservedInstancesOfferingsWithContext is like DescribeReservedInstancesOfferings, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeReservedInstancesOfferingsWithContext(ctx context.Context, req *DescribeReservedInstancesOfferingsRequest) (resp *DescribeReservedInstancesOfferingsResult, err error) {
 	resp = &DescribeReservedInstancesOfferingsResult{}
-	err = c.client.Do("DescribeReservedInstancesOfferings", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedInstancesOfferings", "POST", "/", req, resp)
 	return
 }
 
@@ -1228,8 +1916,15 @@ func (c *EC2) DescribeReservedInstancesOfferings(req *DescribeReservedInstancesO
 // information about route tables, see Route Tables in the Amazon Virtual
 // Private Cloud User Guide
 func (c *EC2) DescribeRouteTables(req *DescribeRouteTablesRequest) (resp *DescribeRouteTablesResult, err error) {
+	return c.DescribeRouteTablesWithContext(context.Background(), req)
+}
+
+// DescribeRouteTablesWithContext is like DescribeRouteTables, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeRouteTablesWithContext(ctx context.Context, req *DescribeRouteTablesRequest) (resp *DescribeRouteTablesResult, err error) {
 	resp = &DescribeRouteTablesResult{}
-	err = c.client.Do("DescribeRouteTables", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeRouteTables", "POST", "/", req, resp)
 	return
 }
 
@@ -1239,8 +1934,15 @@ func (c *EC2) DescribeRouteTables(req *DescribeRouteTablesRequest) (resp *Descri
 // Groups in the Amazon Elastic Compute Cloud User Guide and Security
 // Groups for Your in the Amazon Virtual Private Cloud User Guide
 func (c *EC2) DescribeSecurityGroups(req *DescribeSecurityGroupsRequest) (resp *DescribeSecurityGroupsResult, err error) {
+	return c.DescribeSecurityGroupsWithContext(context.Background(), req)
+}
+
+// DescribeSecurityGroupsWithContext is like DescribeSecurityGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeSecurityGroupsWithContext(ctx context.Context, req *DescribeSecurityGroupsRequest) (resp *DescribeSecurityGroupsResult, err error) {
 	resp = &DescribeSecurityGroupsResult{}
-	err = c.client.Do("DescribeSecurityGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSecurityGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -1249,8 +1951,15 @@ func (c *EC2) DescribeSecurityGroups(req *DescribeSecurityGroupsRequest) (resp *
 // more information about Amazon EBS snapshots, see Amazon EBS Snapshots in
 // the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeSnapshotAttribute(req *DescribeSnapshotAttributeRequest) (resp *DescribeSnapshotAttributeResult, err error) {
+	return c.DescribeSnapshotAttributeWithContext(context.Background(), req)
+}
+
+// DescribeSnapshotAttributeWithContext is like DescribeSnapshotAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeSnapshotAttributeWithContext(ctx context.Context, req *DescribeSnapshotAttributeRequest) (resp *DescribeSnapshotAttributeResult, err error) {
 	resp = &DescribeSnapshotAttributeResult{}
-	err = c.client.Do("DescribeSnapshotAttribute", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSnapshotAttribute", "POST", "/", req, resp)
 	return
 }
 
@@ -1283,8 +1992,15 @@ func (c *EC2) DescribeSnapshotAttribute(req *DescribeSnapshotAttributeRequest) (
 // information about Amazon EBS snapshots, see Amazon EBS Snapshots in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeSnapshots(req *DescribeSnapshotsRequest) (resp *DescribeSnapshotsResult, err error) {
+	return c.DescribeSnapshotsWithContext(context.Background(), req)
+}
+
+// DescribeSnapshotsWithContext is like DescribeSnapshots, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeSnapshotsWithContext(ctx context.Context, req *DescribeSnapshotsRequest) (resp *DescribeSnapshotsResult, err error) {
 	resp = &DescribeSnapshotsResult{}
-	err = c.client.Do("DescribeSnapshots", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSnapshots", "POST", "/", req, resp)
 	return
 }
 
@@ -1292,8 +2008,15 @@ func (c *EC2) DescribeSnapshots(req *DescribeSnapshotsRequest) (resp *DescribeSn
 // Instances. For more information, see Spot Instances in the Amazon
 // Elastic Compute Cloud User Guide
 func (c *EC2) DescribeSpotDatafeedSubscription(req *DescribeSpotDatafeedSubscriptionRequest) (resp *DescribeSpotDatafeedSubscriptionResult, err error) {
+	return c.DescribeSpotDatafeedSubscriptionWithContext(context.Background(), req)
+}
+
+// DescribeSpotDatafeedSubscriptionWithContext is like DescribeSpotDatafeedSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeSpotDatafeedSubscriptionWithContext(ctx context.Context, req *DescribeSpotDatafeedSubscriptionRequest) (resp *DescribeSpotDatafeedSubscriptionResult, err error) {
 	resp = &DescribeSpotDatafeedSubscriptionResult{}
-	err = c.client.Do("DescribeSpotDatafeedSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSpotDatafeedSubscription", "POST", "/", req, resp)
 	return
 }
 
@@ -1310,8 +2033,15 @@ func (c *EC2) DescribeSpotDatafeedSubscription(req *DescribeSpotDatafeedSubscrip
 // the instance. Alternatively, you can use DescribeInstances with a filter
 // to look for instances where the instance lifecycle is spot
 func (c *EC2) DescribeSpotInstanceRequests(req *DescribeSpotInstanceRequestsRequest) (resp *DescribeSpotInstanceRequestsResult, err error) {
+	return c.DescribeSpotInstanceRequestsWithContext(context.Background(), req)
+}
+
+// DescribeSpotInstanceRequestsWithContext is like DescribeSpotInstanceRequests, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeSpotInstanceRequestsWithContext(ctx context.Context, req *DescribeSpotInstanceRequestsRequest) (resp *DescribeSpotInstanceRequestsResult, err error) {
 	resp = &DescribeSpotInstanceRequestsResult{}
-	err = c.client.Do("DescribeSpotInstanceRequests", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSpotInstanceRequests", "POST", "/", req, resp)
 	return
 }
 
@@ -1335,8 +2065,15 @@ func (c *EC2) DescribeSpotInstanceRequests(req *DescribeSpotInstanceRequestsRequ
 // valid within the time period that you specified; the response merely
 // indicates the last time that the price changed.
 func (c *EC2) DescribeSpotPriceHistory(req *DescribeSpotPriceHistoryRequest) (resp *DescribeSpotPriceHistoryResult, err error) {
+	return c.DescribeSpotPriceHistoryWithContext(context.Background(), req)
+}
+
+// DescribeSpotPriceHistoryWithContext is like DescribeSpotPriceHistory, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeSpotPriceHistoryWithContext(ctx context.Context, req *DescribeSpotPriceHistoryRequest) (resp *DescribeSpotPriceHistoryResult, err error) {
 	resp = &DescribeSpotPriceHistoryResult{}
-	err = c.client.Do("DescribeSpotPriceHistory", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSpotPriceHistory", "POST", "/", req, resp)
 	return
 }
 
@@ -1344,8 +2081,15 @@ func (c *EC2) DescribeSpotPriceHistory(req *DescribeSpotPriceHistoryRequest) (re
 // information about subnets, see Your VPC and Subnets in the Amazon
 // Virtual Private Cloud User Guide
 func (c *EC2) DescribeSubnets(req *DescribeSubnetsRequest) (resp *DescribeSubnetsResult, err error) {
+	return c.DescribeSubnetsWithContext(context.Background(), req)
+}
+
+// DescribeSubnetsWithContext is like DescribeSubnets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeSubnetsWithContext(ctx context.Context, req *DescribeSubnetsRequest) (resp *DescribeSubnetsResult, err error) {
 	resp = &DescribeSubnetsResult{}
-	err = c.client.Do("DescribeSubnets", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSubnets", "POST", "/", req, resp)
 	return
 }
 
@@ -1353,8 +2097,15 @@ func (c *EC2) DescribeSubnets(req *DescribeSubnetsRequest) (resp *DescribeSubnet
 // For more information about tags, see Tagging Your Resources in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeTags(req *DescribeTagsRequest) (resp *DescribeTagsResult, err error) {
+	return c.DescribeTagsWithContext(context.Background(), req)
+}
+
+// DescribeTagsWithContext is like DescribeTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeTagsWithContext(ctx context.Context, req *DescribeTagsRequest) (resp *DescribeTagsResult, err error) {
 	resp = &DescribeTagsResult{}
-	err = c.client.Do("DescribeTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTags", "POST", "/", req, resp)
 	return
 }
 
@@ -1363,8 +2114,15 @@ func (c *EC2) DescribeTags(req *DescribeTagsRequest) (resp *DescribeTagsResult,
 // information about Amazon EBS volumes, see Amazon EBS Volumes in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeVolumeAttribute(req *DescribeVolumeAttributeRequest) (resp *DescribeVolumeAttributeResult, err error) {
+	return c.DescribeVolumeAttributeWithContext(context.Background(), req)
+}
+
+// DescribeVolumeAttributeWithContext is like DescribeVolumeAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVolumeAttributeWithContext(ctx context.Context, req *DescribeVolumeAttributeRequest) (resp *DescribeVolumeAttributeResult, err error) {
 	resp = &DescribeVolumeAttributeResult{}
-	err = c.client.Do("DescribeVolumeAttribute", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVolumeAttribute", "POST", "/", req, resp)
 	return
 }
 
@@ -1400,8 +2158,15 @@ func (c *EC2) DescribeVolumeAttribute(req *DescribeVolumeAttributeRequest) (resp
 // state. Therefore, volume status does not indicate volumes in the error
 // state (for example, when a volume is incapable of accepting
 func (c *EC2) DescribeVolumeStatus(req *DescribeVolumeStatusRequest) (resp *DescribeVolumeStatusResult, err error) {
+	return c.DescribeVolumeStatusWithContext(context.Background(), req)
+}
+
+// DescribeVolumeStatusWithContext is like DescribeVolumeStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVolumeStatusWithContext(ctx context.Context, req *DescribeVolumeStatusRequest) (resp *DescribeVolumeStatusResult, err error) {
 	resp = &DescribeVolumeStatusResult{}
-	err = c.client.Do("DescribeVolumeStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVolumeStatus", "POST", "/", req, resp)
 	return
 }
 
@@ -1415,31 +2180,59 @@ func (c *EC2) DescribeVolumeStatus(req *DescribeVolumeStatusRequest) (resp *Desc
 // information about Amazon EBS volumes, see Amazon EBS Volumes in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DescribeVolumes(req *DescribeVolumesRequest) (resp *DescribeVolumesResult, err error) {
+	return c.DescribeVolumesWithContext(context.Background(), req)
+}
+
+// DescribeVolumesWithContext is like DescribeVolumes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVolumesWithContext(ctx context.Context, req *DescribeVolumesRequest) (resp *DescribeVolumesResult, err error) {
 	resp = &DescribeVolumesResult{}
-	err = c.client.Do("DescribeVolumes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVolumes", "POST", "/", req, resp)
 	return
 }
 
 // DescribeVPCAttribute describes the specified attribute of the specified
 // You can specify only one attribute at a time.
 func (c *EC2) DescribeVPCAttribute(req *DescribeVPCAttributeRequest) (resp *DescribeVPCAttributeResult, err error) {
+	return c.DescribeVPCAttributeWithContext(context.Background(), req)
+}
+
+// DescribeVPCAttributeWithContext is like DescribeVPCAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVPCAttributeWithContext(ctx context.Context, req *DescribeVPCAttributeRequest) (resp *DescribeVPCAttributeResult, err error) {
 	resp = &DescribeVPCAttributeResult{}
-	err = c.client.Do("DescribeVpcAttribute", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVpcAttribute", "POST", "/", req, resp)
 	return
 }
 
 // DescribeVPCPeeringConnections describes one or more of your VPC peering
 // connections.
 func (c *EC2) DescribeVPCPeeringConnections(req *DescribeVPCPeeringConnectionsRequest) (resp *DescribeVPCPeeringConnectionsResult, err error) {
+	return c.DescribeVPCPeeringConnectionsWithContext(context.Background(), req)
+}
+
+// DescribeVPCPeeringConnectionsWithContext is like DescribeVPCPeeringConnections, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVPCPeeringConnectionsWithContext(ctx context.Context, req *DescribeVPCPeeringConnectionsRequest) (resp *DescribeVPCPeeringConnectionsResult, err error) {
 	resp = &DescribeVPCPeeringConnectionsResult{}
-	err = c.client.Do("DescribeVpcPeeringConnections", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVpcPeeringConnections", "POST", "/", req, resp)
 	return
 }
 
 // DescribeVPCs is undocumented.
 func (c *EC2) DescribeVPCs(req *DescribeVPCsRequest) (resp *DescribeVPCsResult, err error) {
+	return c.DescribeVPCsWithContext(context.Background(), req)
+}
+
+// DescribeVPCsWithContext is like DescribeVPCs, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVPCsWithContext(ctx context.Context, req *DescribeVPCsRequest) (resp *DescribeVPCsResult, err error) {
 	resp = &DescribeVPCsResult{}
-	err = c.client.Do("DescribeVpcs", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVpcs", "POST", "/", req, resp)
 	return
 }
 
@@ -1448,8 +2241,15 @@ func (c *EC2) DescribeVPCs(req *DescribeVPCsRequest) (resp *DescribeVPCsResult,
 // Virtual Private Gateway to Your in the Amazon Virtual Private Cloud User
 // Guide
 func (c *EC2) DescribeVPNConnections(req *DescribeVPNConnectionsRequest) (resp *DescribeVPNConnectionsResult, err error) {
+	return c.DescribeVPNConnectionsWithContext(context.Background(), req)
+}
+
+// DescribeVPNConnectionsWithContext is like DescribeVPNConnections, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVPNConnectionsWithContext(ctx context.Context, req *DescribeVPNConnectionsRequest) (resp *DescribeVPNConnectionsResult, err error) {
 	resp = &DescribeVPNConnectionsResult{}
-	err = c.client.Do("DescribeVpnConnections", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVpnConnections", "POST", "/", req, resp)
 	return
 }
 
@@ -1458,8 +2258,15 @@ func (c *EC2) DescribeVPNConnections(req *DescribeVPNConnectionsRequest) (resp *
 // Adding an IPsec Hardware VPN to Your in the Amazon Virtual Private Cloud
 // User Guide
 func (c *EC2) DescribeVPNGateways(req *DescribeVPNGatewaysRequest) (resp *DescribeVPNGatewaysResult, err error) {
+	return c.DescribeVPNGatewaysWithContext(context.Background(), req)
+}
+
+// DescribeVPNGatewaysWithContext is like DescribeVPNGateways, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DescribeVPNGatewaysWithContext(ctx context.Context, req *DescribeVPNGatewaysRequest) (resp *DescribeVPNGatewaysResult, err error) {
 	resp = &DescribeVPNGatewaysResult{}
-	err = c.client.Do("DescribeVpnGateways", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVpnGateways", "POST", "/", req, resp)
 	return
 }
 
@@ -1467,15 +2274,29 @@ func (c *EC2) DescribeVPNGateways(req *DescribeVPNGatewaysRequest) (resp *Descri
 // connectivity between the Internet and the The VPC must not contain any
 // running instances with Elastic IP addresses.
 func (c *EC2) DetachInternetGateway(req *DetachInternetGatewayRequest) (err error) {
+	return c.DetachInternetGatewayWithContext(context.Background(), req)
+}
+
+// DetachInternetGatewayWithContext is like DetachInternetGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DetachInternetGatewayWithContext(ctx context.Context, req *DetachInternetGatewayRequest) (err error) {
 	// NRE
-	err = c.client.Do("DetachInternetGateway", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DetachInternetGateway", "POST", "/", req, nil)
 	return
 }
 
 // DetachNetworkInterface is undocumented.
 func (c *EC2) DetachNetworkInterface(req *DetachNetworkInterfaceRequest) (err error) {
+	return c.DetachNetworkInterfaceWithContext(context.Background(), req)
+}
+
+// DetachNetworkInterfaceWithContext is like DetachNetworkInterface, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DetachNetworkInterfaceWithContext(ctx context.Context, req *DetachNetworkInterfaceRequest) (err error) {
 	// NRE
-	err = c.client.Do("DetachNetworkInterface", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DetachNetworkInterface", "POST", "/", req, nil)
 	return
 }
 
@@ -1490,8 +2311,15 @@ func (c *EC2) DetachNetworkInterface(req *DetachNetworkInterfaceRequest) (err er
 // longer associated with the instance. For more information, see Detaching
 // an Amazon EBS Volume in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) DetachVolume(req *DetachVolumeRequest) (resp *VolumeAttachment, err error) {
+	return c.DetachVolumeWithContext(context.Background(), req)
+}
+
+// DetachVolumeWithContext is like DetachVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DetachVolumeWithContext(ctx context.Context, req *DetachVolumeRequest) (resp *VolumeAttachment, err error) {
 	resp = &VolumeAttachment{}
-	err = c.client.Do("DetachVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DetachVolume", "POST", "/", req, resp)
 	return
 }
 
@@ -1503,16 +2331,30 @@ func (c *EC2) DetachVolume(req *DetachVolumeRequest) (resp *VolumeAttachment, er
 // attachment's state to switch to detached before you can delete the VPC
 // or attach a different VPC to the virtual private gateway.
 func (c *EC2) DetachVPNGateway(req *DetachVPNGatewayRequest) (err error) {
+	return c.DetachVPNGatewayWithContext(context.Background(), req)
+}
+
+// DetachVPNGatewayWithContext is like DetachVPNGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DetachVPNGatewayWithContext(ctx context.Context, req *DetachVPNGatewayRequest) (err error) {
 	// NRE
-	err = c.client.Do("DetachVpnGateway", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DetachVpnGateway", "POST", "/", req, nil)
 	return
 }
 
 // DisableVGWRoutePropagation disables a virtual private gateway from
 // propagating routes to a specified route table of a
 func (c *EC2) DisableVGWRoutePropagation(req *DisableVGWRoutePropagationRequest) (err error) {
+	return c.DisableVGWRoutePropagationWithContext(context.Background(), req)
+}
+
+// DisableVGWRoutePropagationWithContext is like DisableVGWRoutePropagation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DisableVGWRoutePropagationWithContext(ctx context.Context, req *DisableVGWRoutePropagationRequest) (err error) {
 	// NRE
-	err = c.client.Do("DisableVgwRoutePropagation", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisableVgwRoutePropagation", "POST", "/", req, nil)
 	return
 }
 
@@ -1523,8 +2365,15 @@ func (c *EC2) DisableVGWRoutePropagation(req *DisableVGWRoutePropagationRequest)
 // Cloud User Guide This is an idempotent operation. If you perform the
 // operation more than once, Amazon EC2 doesn't return an error.
 func (c *EC2) DisassociateAddress(req *DisassociateAddressRequest) (err error) {
+	return c.DisassociateAddressWithContext(context.Background(), req)
+}
+
+// DisassociateAddressWithContext is like DisassociateAddress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DisassociateAddressWithContext(ctx context.Context, req *DisassociateAddressRequest) (err error) {
 	// NRE
-	err = c.client.Do("DisassociateAddress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisassociateAddress", "POST", "/", req, nil)
 	return
 }
 
@@ -1534,16 +2383,30 @@ func (c *EC2) DisassociateAddress(req *DisassociateAddressRequest) (err error) {
 // For more information about route tables, see Route Tables in the Amazon
 // Virtual Private Cloud User Guide
 func (c *EC2) DisassociateRouteTable(req *DisassociateRouteTableRequest) (err error) {
+	return c.DisassociateRouteTableWithContext(context.Background(), req)
+}
+
+// DisassociateRouteTableWithContext is like DisassociateRouteTable, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) DisassociateRouteTableWithContext(ctx context.Context, req *DisassociateRouteTableRequest) (err error) {
 	// NRE
-	err = c.client.Do("DisassociateRouteTable", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisassociateRouteTable", "POST", "/", req, nil)
 	return
 }
 
 // EnableVGWRoutePropagation enables a virtual private gateway to propagate
 // routes to the specified route table of a
 func (c *EC2) EnableVGWRoutePropagation(req *EnableVGWRoutePropagationRequest) (err error) {
+	return c.EnableVGWRoutePropagationWithContext(context.Background(), req)
+}
+
+// EnableVGWRoutePropagationWithContext is like EnableVGWRoutePropagation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) EnableVGWRoutePropagationWithContext(ctx context.Context, req *EnableVGWRoutePropagationRequest) (err error) {
 	// NRE
-	err = c.client.Do("EnableVgwRoutePropagation", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "EnableVgwRoutePropagation", "POST", "/", req, nil)
 	return
 }
 
@@ -1551,8 +2414,15 @@ func (c *EC2) EnableVGWRoutePropagation(req *EnableVGWRoutePropagationRequest) (
 // operations disabled because the data on the volume was potentially
 // inconsistent.
 func (c *EC2) EnableVolumeIO(req *EnableVolumeIORequest) (err error) {
+	return c.EnableVolumeIOWithContext(context.Background(), req)
+}
+
+// EnableVolumeIOWithContext is like EnableVolumeIO, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) EnableVolumeIOWithContext(ctx context.Context, req *EnableVolumeIORequest) (err error) {
 	// NRE
-	err = c.client.Do("EnableVolumeIO", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "EnableVolumeIO", "POST", "/", req, nil)
 	return
 }
 
@@ -1571,8 +2441,15 @@ func (c *EC2) EnableVolumeIO(req *EnableVolumeIORequest) (err error) {
 // owner can retrieve it. For Windows instances, the instance console
 // output displays the last three system event log errors.
 func (c *EC2) GetConsoleOutput(req *GetConsoleOutputRequest) (resp *GetConsoleOutputResult, err error) {
+	return c.GetConsoleOutputWithContext(context.Background(), req)
+}
+
+// GetConsoleOutputWithContext is like GetConsoleOutput, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) GetConsoleOutputWithContext(ctx context.Context, req *GetConsoleOutputRequest) (resp *GetConsoleOutputResult, err error) {
 	resp = &GetConsoleOutputResult{}
-	err = c.client.Do("GetConsoleOutput", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetConsoleOutput", "POST", "/", req, resp)
 	return
 }
 
@@ -1588,8 +2465,15 @@ func (c *EC2) GetConsoleOutput(req *GetConsoleOutputRequest) (resp *GetConsoleOu
 // wait up to 15 minutes after launching an instance before trying to
 // retrieve the generated password.
 func (c *EC2) GetPasswordData(req *GetPasswordDataRequest) (resp *GetPasswordDataResult, err error) {
+	return c.GetPasswordDataWithContext(context.Background(), req)
+}
+
+// GetPasswordDataWithContext is like GetPasswordData, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) GetPasswordDataWithContext(ctx context.Context, req *GetPasswordDataRequest) (resp *GetPasswordDataResult, err error) {
 	resp = &GetPasswordDataResult{}
-	err = c.client.Do("GetPasswordData", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetPasswordData", "POST", "/", req, resp)
 	return
 }
 
@@ -1599,8 +2483,15 @@ func (c *EC2) GetPasswordData(req *GetPasswordDataRequest) (resp *GetPasswordDat
 // see Using the Command Line Tools to Import Your Virtual Machine to
 // Amazon EC2
 func (c *EC2) ImportInstance(req *ImportInstanceRequest) (resp *ImportInstanceResult, err error) {
-	resp = &ImportInstanceResult{}
-	err = c.client.Do("ImportInstance", "POST", "/", req, resp)
+	return c.ImportInstanceWithContext(context.Background(), req)
+}
+
+// ImportInstanceWithContext is like ImportInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ImportInstanceWithContext(ctx context.Context, req *ImportInstanceRequest) (resp *ImportInstanceResult, err error) {
+	resp = &ImportInstanceResult{}
+	err = c.client.DoWithContext(ctx, "ImportInstance", "POST", "/", req, resp)
 	return
 }
 
@@ -1612,8 +2503,15 @@ func (c *EC2) ImportInstance(req *ImportInstanceRequest) (resp *ImportInstanceRe
 // you and For more information about key pairs, see Key Pairs in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) ImportKeyPair(req *ImportKeyPairRequest) (resp *ImportKeyPairResult, err error) {
+	return c.ImportKeyPairWithContext(context.Background(), req)
+}
+
+// ImportKeyPairWithContext is like ImportKeyPair, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ImportKeyPairWithContext(ctx context.Context, req *ImportKeyPairRequest) (resp *ImportKeyPairResult, err error) {
 	resp = &ImportKeyPairResult{}
-	err = c.client.Do("ImportKeyPair", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ImportKeyPair", "POST", "/", req, resp)
 	return
 }
 
@@ -1624,8 +2522,15 @@ func (c *EC2) ImportKeyPair(req *ImportKeyPairRequest) (resp *ImportKeyPairResul
 // Virtual Machine to Amazon EC2 in the Amazon Elastic Compute Cloud User
 // Guide
 func (c *EC2) ImportVolume(req *ImportVolumeRequest) (resp *ImportVolumeResult, err error) {
+	return c.ImportVolumeWithContext(context.Background(), req)
+}
+
+// ImportVolumeWithContext is like ImportVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ImportVolumeWithContext(ctx context.Context, req *ImportVolumeRequest) (resp *ImportVolumeResult, err error) {
 	resp = &ImportVolumeResult{}
-	err = c.client.Do("ImportVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ImportVolume", "POST", "/", req, resp)
 	return
 }
 
@@ -1634,8 +2539,15 @@ func (c *EC2) ImportVolume(req *ImportVolumeRequest) (resp *ImportVolumeResult,
 // codes cannot be modified. Images with an AWS Marketplace product code
 // cannot be made public.
 func (c *EC2) ModifyImageAttribute(req *ModifyImageAttributeRequest) (err error) {
+	return c.ModifyImageAttributeWithContext(context.Background(), req)
+}
+
+// ModifyImageAttributeWithContext is like ModifyImageAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifyImageAttributeWithContext(ctx context.Context, req *ModifyImageAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ModifyImageAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifyImageAttribute", "POST", "/", req, nil)
 	return
 }
 
@@ -1645,16 +2557,30 @@ func (c *EC2) ModifyImageAttribute(req *ModifyImageAttributeRequest) (err error)
 // information, see Modifying Attributes of a Stopped Instance in the
 // Amazon Elastic Compute Cloud User Guide
 func (c *EC2) ModifyInstanceAttribute(req *ModifyInstanceAttributeRequest) (err error) {
+	return c.ModifyInstanceAttributeWithContext(context.Background(), req)
+}
+
+// ModifyInstanceAttributeWithContext is like ModifyInstanceAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifyInstanceAttributeWithContext(ctx context.Context, req *ModifyInstanceAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ModifyInstanceAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifyInstanceAttribute", "POST", "/", req, nil)
 	return
 }
 
 // ModifyNetworkInterfaceAttribute modifies the specified network interface
 // attribute. You can specify only one attribute at a time.
 func (c *EC2) ModifyNetworkInterfaceAttribute(req *ModifyNetworkInterfaceAttributeRequest) (err error) {
+	return c.ModifyNetworkInterfaceAttributeWithContext(context.Background(), req)
+}
+
+// ModifyNetworkInterfaceAttributeWithContext is like ModifyNetworkInterfaceAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifyNetworkInterfaceAttributeWithContext(ctx context.Context, req *ModifyNetworkInterfaceAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ModifyNetworkInterfaceAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifyNetworkInterfaceAttribute", "POST", "/", req, nil)
 	return
 }
 
@@ -1665,8 +2591,15 @@ func (c *EC2) ModifyNetworkInterfaceAttribute(req *ModifyNetworkInterfaceAttribu
 // type. For more information, see Modifying Reserved Instances in the
 // Amazon Elastic Compute Cloud User Guide.
 func (c *EC2) ModifyReservedInstances(req *ModifyReservedInstancesRequest) (resp *ModifyReservedInstancesResult, err error) {
+	return c.ModifyReservedInstancesWithContext(context.Background(), req)
+}
+
+// ModifyReservedInstancesWithContext is like ModifyReservedInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifyReservedInstancesWithContext(ctx context.Context, req *ModifyReservedInstancesRequest) (resp *ModifyReservedInstancesResult, err error) {
 	resp = &ModifyReservedInstancesResult{}
-	err = c.client.Do("ModifyReservedInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyReservedInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -1679,15 +2612,29 @@ func (c *EC2) ModifyReservedInstances(req *ModifyReservedInstancesRequest) (resp
 // Elastic Compute Cloud User Guide Snapshots with AWS Marketplace product
 // codes cannot be made public.
 func (c *EC2) ModifySnapshotAttribute(req *ModifySnapshotAttributeRequest) (err error) {
+	return c.ModifySnapshotAttributeWithContext(context.Background(), req)
+}
+
+// ModifySnapshotAttributeWithContext is like ModifySnapshotAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifySnapshotAttributeWithContext(ctx context.Context, req *ModifySnapshotAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ModifySnapshotAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifySnapshotAttribute", "POST", "/", req, nil)
 	return
 }
 
 // ModifySubnetAttribute is undocumented.
 func (c *EC2) ModifySubnetAttribute(req *ModifySubnetAttributeRequest) (err error) {
+	return c.ModifySubnetAttributeWithContext(context.Background(), req)
+}
+
+// ModifySubnetAttributeWithContext is like ModifySubnetAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifySubnetAttributeWithContext(ctx context.Context, req *ModifySubnetAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ModifySubnetAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifySubnetAttribute", "POST", "/", req, nil)
 	return
 }
 
@@ -1700,15 +2647,29 @@ func (c *EC2) ModifySubnetAttribute(req *ModifySubnetAttributeRequest) (err erro
 // recommend that you change this only for boot volumes or for volumes that
 // are stateless or disposable.
 func (c *EC2) ModifyVolumeAttribute(req *ModifyVolumeAttributeRequest) (err error) {
+	return c.ModifyVolumeAttributeWithContext(context.Background(), req)
+}
+
+// ModifyVolumeAttributeWithContext is like ModifyVolumeAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifyVolumeAttributeWithContext(ctx context.Context, req *ModifyVolumeAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ModifyVolumeAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifyVolumeAttribute", "POST", "/", req, nil)
 	return
 }
 
 // ModifyVPCAttribute is undocumented.
 func (c *EC2) ModifyVPCAttribute(req *ModifyVPCAttributeRequest) (err error) {
+	return c.ModifyVPCAttributeWithContext(context.Background(), req)
+}
+
+// ModifyVPCAttributeWithContext is like ModifyVPCAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ModifyVPCAttributeWithContext(ctx context.Context, req *ModifyVPCAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ModifyVpcAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifyVpcAttribute", "POST", "/", req, nil)
 	return
 }
 
@@ -1716,8 +2677,15 @@ func (c *EC2) ModifyVPCAttribute(req *ModifyVPCAttributeRequest) (err error) {
 // information about monitoring instances, see Monitoring Your Instances
 // and Volumes in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) MonitorInstances(req *MonitorInstancesRequest) (resp *MonitorInstancesResult, err error) {
+	return c.MonitorInstancesWithContext(context.Background(), req)
+}
+
+// MonitorInstancesWithContext is like MonitorInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) MonitorInstancesWithContext(ctx context.Context, req *MonitorInstancesRequest) (resp *MonitorInstancesResult, err error) {
 	resp = &MonitorInstancesResult{}
-	err = c.client.Do("MonitorInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "MonitorInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -1733,8 +2701,15 @@ func (c *EC2) MonitorInstances(req *MonitorInstancesRequest) (resp *MonitorInsta
 // Reserved Instances and Reserved Instance Marketplace in the Amazon
 // Elastic Compute Cloud User Guide
 func (c *EC2) PurchaseReservedInstancesOffering(req *PurchaseReservedInstancesOfferingRequest) (resp *PurchaseReservedInstancesOfferingResult, err error) {
+	return c.PurchaseReservedInstancesOfferingWithContext(context.Background(), req)
+}
+
+// PurchaseReservedInstancesOfferingWithContext is like PurchaseReservedInstancesOffering, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) PurchaseReservedInstancesOfferingWithContext(ctx context.Context, req *PurchaseReservedInstancesOfferingRequest) (resp *PurchaseReservedInstancesOfferingResult, err error) {
 	resp = &PurchaseReservedInstancesOfferingResult{}
-	err = c.client.Do("PurchaseReservedInstancesOffering", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PurchaseReservedInstancesOffering", "POST", "/", req, resp)
 	return
 }
 
@@ -1747,8 +2722,15 @@ func (c *EC2) PurchaseReservedInstancesOffering(req *PurchaseReservedInstancesOf
 // troubleshooting, see Getting Console Output and Rebooting Instances in
 // the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) RebootInstances(req *RebootInstancesRequest) (err error) {
+	return c.RebootInstancesWithContext(context.Background(), req)
+}
+
+// RebootInstancesWithContext is like RebootInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) RebootInstancesWithContext(ctx context.Context, req *RebootInstancesRequest) (err error) {
 	// NRE
-	err = c.client.Do("RebootInstances", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RebootInstances", "POST", "/", req, nil)
 	return
 }
 
@@ -1767,8 +2749,15 @@ func (c *EC2) RebootInstances(req *RebootInstancesRequest) (err error) {
 // new image. You can't register an image where a secondary (non-root)
 // snapshot has AWS Marketplace product codes.
 func (c *EC2) RegisterImage(req *RegisterImageRequest) (resp *RegisterImageResult, err error) {
+	return c.RegisterImageWithContext(context.Background(), req)
+}
+
+// RegisterImageWithContext is like RegisterImage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) RegisterImageWithContext(ctx context.Context, req *RegisterImageRequest) (resp *RegisterImageResult, err error) {
 	resp = &RegisterImageResult{}
-	err = c.client.Do("RegisterImage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RegisterImage", "POST", "/", req, resp)
 	return
 }
 
@@ -1779,8 +2768,15 @@ func (c *EC2) RegisterImage(req *RegisterImageRequest) (resp *RegisterImageResul
 // or to delete a VPC peering connection request that you initiated, use
 // DeleteVpcPeeringConnection
 func (c *EC2) RejectVPCPeeringConnection(req *RejectVPCPeeringConnectionRequest) (resp *RejectVPCPeeringConnectionResult, err error) {
+	return c.RejectVPCPeeringConnectionWithContext(context.Background(), req)
+}
+
+// RejectVPCPeeringConnectionWithContext is like RejectVPCPeeringConnection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) RejectVPCPeeringConnectionWithContext(ctx context.Context, req *RejectVPCPeeringConnectionRequest) (resp *RejectVPCPeeringConnectionResult, err error) {
 	resp = &RejectVPCPeeringConnectionResult{}
-	err = c.client.Do("RejectVpcPeeringConnection", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RejectVpcPeeringConnection", "POST", "/", req, resp)
 	return
 }
 
@@ -1797,8 +2793,15 @@ func (c *EC2) RejectVPCPeeringConnection(req *RejectVPCPeeringConnectionRequest)
 // disassociate the Elastic IP address before you try to release it.
 // Otherwise, Amazon EC2 returns an error InvalidIPAddress.InUse
 func (c *EC2) ReleaseAddress(req *ReleaseAddressRequest) (err error) {
+	return c.ReleaseAddressWithContext(context.Background(), req)
+}
+
+// ReleaseAddressWithContext is like ReleaseAddress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ReleaseAddressWithContext(ctx context.Context, req *ReleaseAddressRequest) (err error) {
 	// NRE
-	err = c.client.Do("ReleaseAddress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ReleaseAddress", "POST", "/", req, nil)
 	return
 }
 
@@ -1807,8 +2810,15 @@ func (c *EC2) ReleaseAddress(req *ReleaseAddressRequest) (err error) {
 // associated with the default network For more information about network
 // ACLs, see Network ACLs in the Amazon Virtual Private Cloud User Guide
 func (c *EC2) ReplaceNetworkACLAssociation(req *ReplaceNetworkACLAssociationRequest) (resp *ReplaceNetworkACLAssociationResult, err error) {
+	return c.ReplaceNetworkACLAssociationWithContext(context.Background(), req)
+}
+
+// ReplaceNetworkACLAssociationWithContext is like ReplaceNetworkACLAssociation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ReplaceNetworkACLAssociationWithContext(ctx context.Context, req *ReplaceNetworkACLAssociationRequest) (resp *ReplaceNetworkACLAssociationResult, err error) {
 	resp = &ReplaceNetworkACLAssociationResult{}
-	err = c.client.Do("ReplaceNetworkAclAssociation", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ReplaceNetworkAclAssociation", "POST", "/", req, resp)
 	return
 }
 
@@ -1816,8 +2826,15 @@ func (c *EC2) ReplaceNetworkACLAssociation(req *ReplaceNetworkACLAssociationRequ
 // information about network ACLs, see Network ACLs in the Amazon Virtual
 // Private Cloud User Guide
 func (c *EC2) ReplaceNetworkACLEntry(req *ReplaceNetworkACLEntryRequest) (err error) {
+	return c.ReplaceNetworkACLEntryWithContext(context.Background(), req)
+}
+
+// ReplaceNetworkACLEntryWithContext is like ReplaceNetworkACLEntry, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ReplaceNetworkACLEntryWithContext(ctx context.Context, req *ReplaceNetworkACLEntryRequest) (err error) {
 	// NRE
-	err = c.client.Do("ReplaceNetworkAclEntry", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ReplaceNetworkAclEntry", "POST", "/", req, nil)
 	return
 }
 
@@ -1827,8 +2844,15 @@ func (c *EC2) ReplaceNetworkACLEntry(req *ReplaceNetworkACLEntryRequest) (err er
 // interface. For more information about route tables, see Route Tables in
 // the Amazon Virtual Private Cloud User Guide
 func (c *EC2) ReplaceRoute(req *ReplaceRouteRequest) (err error) {
+	return c.ReplaceRouteWithContext(context.Background(), req)
+}
+
+// ReplaceRouteWithContext is like ReplaceRoute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ReplaceRouteWithContext(ctx context.Context, req *ReplaceRouteRequest) (err error) {
 	// NRE
-	err = c.client.Do("ReplaceRoute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ReplaceRoute", "POST", "/", req, nil)
 	return
 }
 
@@ -1841,8 +2865,15 @@ func (c *EC2) ReplaceRoute(req *ReplaceRouteRequest) (err error) {
 // table's association ID and the route table to be the new main route
 // table.
 func (c *EC2) ReplaceRouteTableAssociation(req *ReplaceRouteTableAssociationRequest) (resp *ReplaceRouteTableAssociationResult, err error) {
+	return c.ReplaceRouteTableAssociationWithContext(context.Background(), req)
+}
+
+// ReplaceRouteTableAssociationWithContext is like ReplaceRouteTableAssociation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ReplaceRouteTableAssociationWithContext(ctx context.Context, req *ReplaceRouteTableAssociationRequest) (resp *ReplaceRouteTableAssociationResult, err error) {
 	resp = &ReplaceRouteTableAssociationResult{}
-	err = c.client.Do("ReplaceRouteTableAssociation", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ReplaceRouteTableAssociation", "POST", "/", req, resp)
 	return
 }
 
@@ -1854,8 +2885,15 @@ func (c *EC2) ReplaceRouteTableAssociation(req *ReplaceRouteTableAssociationRequ
 // improve the accuracy of status checks. Use of this action does not
 // change the value returned by DescribeInstanceStatus
 func (c *EC2) ReportInstanceStatus(req *ReportInstanceStatusRequest) (err error) {
+	return c.ReportInstanceStatusWithContext(context.Background(), req)
+}
+
+// ReportInstanceStatusWithContext is like ReportInstanceStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ReportInstanceStatusWithContext(ctx context.Context, req *ReportInstanceStatusRequest) (err error) {
 	// NRE
-	err = c.client.Do("ReportInstanceStatus", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ReportInstanceStatus", "POST", "/", req, nil)
 	return
 }
 
@@ -1868,15 +2906,29 @@ func (c *EC2) ReportInstanceStatus(req *ReportInstanceStatusRequest) (err error)
 // Guide Users must be subscribed to the required product to run an
 // instance with AWS Marketplace product codes.
 func (c *EC2) RequestSpotInstances(req *RequestSpotInstancesRequest) (resp *RequestSpotInstancesResult, err error) {
+	return c.RequestSpotInstancesWithContext(context.Background(), req)
+}
+
+// RequestSpotInstancesWithContext is like RequestSpotInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) RequestSpotInstancesWithContext(ctx context.Context, req *RequestSpotInstancesRequest) (resp *RequestSpotInstancesResult, err error) {
 	resp = &RequestSpotInstancesResult{}
-	err = c.client.Do("RequestSpotInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RequestSpotInstances", "POST", "/", req, resp)
 	return
 }
 
 // ResetImageAttribute resets an attribute of an AMI to its default value.
 func (c *EC2) ResetImageAttribute(req *ResetImageAttributeRequest) (err error) {
+	return c.ResetImageAttributeWithContext(context.Background(), req)
+}
+
+// ResetImageAttributeWithContext is like ResetImageAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ResetImageAttributeWithContext(ctx context.Context, req *ResetImageAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ResetImageAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ResetImageAttribute", "POST", "/", req, nil)
 	return
 }
 
@@ -1889,16 +2941,30 @@ func (c *EC2) ResetImageAttribute(req *ResetImageAttributeRequest) (err error) {
 // instance to perform For more information, see NAT Instances in the
 // Amazon Virtual Private Cloud User Guide
 func (c *EC2) ResetInstanceAttribute(req *ResetInstanceAttributeRequest) (err error) {
+	return c.ResetInstanceAttributeWithContext(context.Background(), req)
+}
+
+// ResetInstanceAttributeWithContext is like ResetInstanceAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ResetInstanceAttributeWithContext(ctx context.Context, req *ResetInstanceAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ResetInstanceAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ResetInstanceAttribute", "POST", "/", req, nil)
 	return
 }
 
 // ResetNetworkInterfaceAttribute resets a network interface attribute. You
 // can specify only one attribute at a time.
 func (c *EC2) ResetNetworkInterfaceAttribute(req *ResetNetworkInterfaceAttributeRequest) (err error) {
+	return c.ResetNetworkInterfaceAttributeWithContext(context.Background(), req)
+}
+
+// ResetNetworkInterfaceAttributeWithContext is like ResetNetworkInterfaceAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ResetNetworkInterfaceAttributeWithContext(ctx context.Context, req *ResetNetworkInterfaceAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ResetNetworkInterfaceAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ResetNetworkInterfaceAttribute", "POST", "/", req, nil)
 	return
 }
 
@@ -1906,8 +2972,15 @@ func (c *EC2) ResetNetworkInterfaceAttribute(req *ResetNetworkInterfaceAttribute
 // snapshot. For more information on modifying snapshot permissions, see
 // Sharing Snapshots in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) ResetSnapshotAttribute(req *ResetSnapshotAttributeRequest) (err error) {
+	return c.ResetSnapshotAttributeWithContext(context.Background(), req)
+}
+
+// ResetSnapshotAttributeWithContext is like ResetSnapshotAttribute, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) ResetSnapshotAttributeWithContext(ctx context.Context, req *ResetSnapshotAttributeRequest) (err error) {
 	// NRE
-	err = c.client.Do("ResetSnapshotAttribute", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ResetSnapshotAttribute", "POST", "/", req, nil)
 	return
 }
 
@@ -1921,8 +2994,15 @@ func (c *EC2) ResetSnapshotAttribute(req *ResetSnapshotAttributeRequest) (err er
 // instances within the security group as quickly as possible. However, a
 // small delay might occur.
 func (c *EC2) RevokeSecurityGroupEgress(req *RevokeSecurityGroupEgressRequest) (err error) {
+	return c.RevokeSecurityGroupEgressWithContext(context.Background(), req)
+}
+
+// RevokeSecurityGroupEgressWithContext is like RevokeSecurityGroupEgress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) RevokeSecurityGroupEgressWithContext(ctx context.Context, req *RevokeSecurityGroupEgressRequest) (err error) {
 	// NRE
-	err = c.client.Do("RevokeSecurityGroupEgress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RevokeSecurityGroupEgress", "POST", "/", req, nil)
 	return
 }
 
@@ -1936,8 +3016,15 @@ func (c *EC2) RevokeSecurityGroupEgress(req *RevokeSecurityGroupEgressRequest) (
 // within the security group as quickly as possible. However, a small delay
 // might occur.
 func (c *EC2) RevokeSecurityGroupIngress(req *RevokeSecurityGroupIngressRequest) (err error) {
+	return c.RevokeSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// RevokeSecurityGroupIngressWithContext is like RevokeSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) RevokeSecurityGroupIngressWithContext(ctx context.Context, req *RevokeSecurityGroupIngressRequest) (err error) {
 	// NRE
-	err = c.client.Do("RevokeSecurityGroupIngress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RevokeSecurityGroupIngress", "POST", "/", req, nil)
 	return
 }
 
@@ -1963,8 +3050,15 @@ func (c *EC2) RevokeSecurityGroupIngress(req *RevokeSecurityGroupIngressRequest)
 // Troubleshooting Connecting to Your Instance in the Amazon Elastic
 // Compute Cloud User Guide
 func (c *EC2) RunInstances(req *RunInstancesRequest) (resp *Reservation, err error) {
+	return c.RunInstancesWithContext(context.Background(), req)
+}
+
+// RunInstancesWithContext is like RunInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) RunInstancesWithContext(ctx context.Context, req *RunInstancesRequest) (resp *Reservation, err error) {
 	resp = &Reservation{}
-	err = c.client.Do("RunInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RunInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -1983,8 +3077,15 @@ func (c *EC2) RunInstances(req *RunInstancesRequest) (resp *Reservation, err err
 // its root device returns an error. For more information, see Stopping
 // Instances in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) StartInstances(req *StartInstancesRequest) (resp *StartInstancesResult, err error) {
+	return c.StartInstancesWithContext(context.Background(), req)
+}
+
+// StartInstancesWithContext is like StartInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) StartInstancesWithContext(ctx context.Context, req *StartInstancesRequest) (resp *StartInstancesResult, err error) {
 	resp = &StartInstancesResult{}
-	err = c.client.Do("StartInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "StartInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -2013,8 +3114,15 @@ func (c *EC2) StartInstances(req *StartInstancesRequest) (resp *StartInstancesRe
 // Troubleshooting Stopping Your Instance in the Amazon Elastic Compute
 // Cloud User Guide
 func (c *EC2) StopInstances(req *StopInstancesRequest) (resp *StopInstancesResult, err error) {
+	return c.StopInstancesWithContext(context.Background(), req)
+}
+
+// StopInstancesWithContext is like StopInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) StopInstancesWithContext(ctx context.Context, req *StopInstancesRequest) (resp *StopInstancesResult, err error) {
 	resp = &StopInstancesResult{}
-	err = c.client.Do("StopInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "StopInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -2036,16 +3144,30 @@ func (c *EC2) StopInstances(req *StopInstancesRequest) (resp *StopInstancesResul
 // Troubleshooting Terminating Your Instance in the Amazon Elastic Compute
 // Cloud User Guide
 func (c *EC2) TerminateInstances(req *TerminateInstancesRequest) (resp *TerminateInstancesResult, err error) {
+	return c.TerminateInstancesWithContext(context.Background(), req)
+}
+
+// TerminateInstancesWithContext is like TerminateInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) TerminateInstancesWithContext(ctx context.Context, req *TerminateInstancesRequest) (resp *TerminateInstancesResult, err error) {
 	resp = &TerminateInstancesResult{}
-	err = c.client.Do("TerminateInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "TerminateInstances", "POST", "/", req, resp)
 	return
 }
 
 // UnassignPrivateIPAddresses unassigns one or more secondary private IP
 // addresses from a network interface.
 func (c *EC2) UnassignPrivateIPAddresses(req *UnassignPrivateIPAddressesRequest) (err error) {
+	return c.UnassignPrivateIPAddressesWithContext(context.Background(), req)
+}
+
+// UnassignPrivateIPAddressesWithContext is like UnassignPrivateIPAddresses, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) UnassignPrivateIPAddressesWithContext(ctx context.Context, req *UnassignPrivateIPAddressesRequest) (err error) {
 	// NRE
-	err = c.client.Do("UnassignPrivateIpAddresses", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UnassignPrivateIpAddresses", "POST", "/", req, nil)
 	return
 }
 
@@ -2053,8 +3175,15 @@ func (c *EC2) UnassignPrivateIPAddresses(req *UnassignPrivateIPAddressesRequest)
 // information about monitoring instances, see Monitoring Your Instances
 // and Volumes in the Amazon Elastic Compute Cloud User Guide
 func (c *EC2) UnmonitorInstances(req *UnmonitorInstancesRequest) (resp *UnmonitorInstancesResult, err error) {
+	return c.UnmonitorInstancesWithContext(context.Background(), req)
+}
+
+// UnmonitorInstancesWithContext is like UnmonitorInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EC2) UnmonitorInstancesWithContext(ctx context.Context, req *UnmonitorInstancesRequest) (resp *UnmonitorInstancesResult, err error) {
 	resp = &UnmonitorInstancesResult{}
-	err = c.client.Do("UnmonitorInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UnmonitorInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -2064,6 +3193,20 @@ type AcceptVPCPeeringConnectionRequest struct {
 	VPCPeeringConnectionID aws.StringValue  `ec2:"VpcPeeringConnectionId" xml:"vpcPeeringConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the AcceptVPCPeeringConnectionRequest to the form, prefixing their names.
+func (v *AcceptVPCPeeringConnectionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCPeeringConnectionID != nil {
+		form.Set(prefix+"VpcPeeringConnectionId", *v.VPCPeeringConnectionID)
+	}
+	return nil
+}
+
 // AcceptVPCPeeringConnectionResult is undocumented.
 type AcceptVPCPeeringConnectionResult struct {
 	VPCPeeringConnection *VPCPeeringConnection `ec2:"VpcPeeringConnection" xml:"vpcPeeringConnection"`
@@ -2072,7 +3215,7 @@ type AcceptVPCPeeringConnectionResult struct {
 // AccountAttribute is undocumented.
 type AccountAttribute struct {
 	AttributeName   aws.StringValue         `ec2:"AttributeName" xml:"attributeName"`
-	AttributeValues []AccountAttributeValue `ec2:"AttributeValues" xml:"attributeValueSet>item"`
+	AttributeValues []AccountAttributeValue `ec2:"AttributeValueSet,list" xml:"attributeValueSet>item"`
 }
 
 // Possible values for EC2.
@@ -2104,6 +3247,20 @@ type AllocateAddressRequest struct {
 	DryRun aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the AllocateAddressRequest to the form, prefixing their names.
+func (v *AllocateAddressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		form.Set(prefix+"Domain", *v.Domain)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // AllocateAddressResult is undocumented.
 type AllocateAddressResult struct {
 	AllocationID aws.StringValue `ec2:"AllocationId" xml:"allocationId"`
@@ -2121,10 +3278,33 @@ const (
 type AssignPrivateIPAddressesRequest struct {
 	AllowReassignment              aws.BooleanValue `ec2:"AllowReassignment" xml:"allowReassignment"`
 	NetworkInterfaceID             aws.StringValue  `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
-	PrivateIPAddresses             []string         `ec2:"PrivateIpAddresses" xml:"privateIpAddress>PrivateIpAddress"`
+	PrivateIPAddresses             []string         `ec2:"PrivateIpAddress,list" xml:"privateIpAddress>PrivateIpAddress"`
 	SecondaryPrivateIPAddressCount aws.IntegerValue `ec2:"SecondaryPrivateIpAddressCount" xml:"secondaryPrivateIpAddressCount"`
 }
 
+// MarshalEC2 adds the parameters of the AssignPrivateIPAddressesRequest to the form, prefixing their names.
+func (v *AssignPrivateIPAddressesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowReassignment != nil {
+		form.Set(prefix+"AllowReassignment", strconv.FormatBool(*v.AllowReassignment))
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.PrivateIPAddresses != nil {
+		for i1 := range v.PrivateIPAddresses {
+			name1 := prefix + "PrivateIpAddress." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PrivateIPAddresses[i1])
+		}
+	}
+	if v.SecondaryPrivateIPAddressCount != nil {
+		form.Set(prefix+"SecondaryPrivateIpAddressCount", strconv.FormatInt(int64(*v.SecondaryPrivateIPAddressCount), 10))
+	}
+	return nil
+}
+
 // AssociateAddressRequest is undocumented.
 type AssociateAddressRequest struct {
 	AllocationID       aws.StringValue  `ec2:"AllocationId" xml:"AllocationId"`
@@ -2136,6 +3316,35 @@ type AssociateAddressRequest struct {
 	PublicIP           aws.StringValue  `ec2:"PublicIp" xml:"PublicIp"`
 }
 
+// MarshalEC2 adds the parameters of the AssociateAddressRequest to the form, prefixing their names.
+func (v *AssociateAddressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllocationID != nil {
+		form.Set(prefix+"AllocationId", *v.AllocationID)
+	}
+	if v.AllowReassociation != nil {
+		form.Set(prefix+"AllowReassociation", strconv.FormatBool(*v.AllowReassociation))
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.PrivateIPAddress != nil {
+		form.Set(prefix+"PrivateIpAddress", *v.PrivateIPAddress)
+	}
+	if v.PublicIP != nil {
+		form.Set(prefix+"PublicIp", *v.PublicIP)
+	}
+	return nil
+}
+
 // AssociateAddressResult is undocumented.
 type AssociateAddressResult struct {
 	AssociationID aws.StringValue `ec2:"AssociationId" xml:"associationId"`
@@ -2148,6 +3357,23 @@ type AssociateDHCPOptionsRequest struct {
 	VPCID         aws.StringValue  `ec2:"VpcId" xml:"VpcId"`
 }
 
+// MarshalEC2 adds the parameters of the AssociateDHCPOptionsRequest to the form, prefixing their names.
+func (v *AssociateDHCPOptionsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DHCPOptionsID != nil {
+		form.Set(prefix+"DhcpOptionsId", *v.DHCPOptionsID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // AssociateRouteTableRequest is undocumented.
 type AssociateRouteTableRequest struct {
 	DryRun       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -2155,6 +3381,23 @@ type AssociateRouteTableRequest struct {
 	SubnetID     aws.StringValue  `ec2:"SubnetId" xml:"subnetId"`
 }
 
+// MarshalEC2 adds the parameters of the AssociateRouteTableRequest to the form, prefixing their names.
+func (v *AssociateRouteTableRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	return nil
+}
+
 // AssociateRouteTableResult is undocumented.
 type AssociateRouteTableResult struct {
 	AssociationID aws.StringValue `ec2:"AssociationId" xml:"associationId"`
@@ -2167,6 +3410,23 @@ type AttachInternetGatewayRequest struct {
 	VPCID             aws.StringValue  `ec2:"VpcId" xml:"vpcId"`
 }
 
+// MarshalEC2 adds the parameters of the AttachInternetGatewayRequest to the form, prefixing their names.
+func (v *AttachInternetGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InternetGatewayID != nil {
+		form.Set(prefix+"InternetGatewayId", *v.InternetGatewayID)
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // AttachNetworkInterfaceRequest is undocumented.
 type AttachNetworkInterfaceRequest struct {
 	DeviceIndex        aws.IntegerValue `ec2:"DeviceIndex" xml:"deviceIndex"`
@@ -2175,6 +3435,26 @@ type AttachNetworkInterfaceRequest struct {
 	NetworkInterfaceID aws.StringValue  `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 }
 
+// MarshalEC2 adds the parameters of the AttachNetworkInterfaceRequest to the form, prefixing their names.
+func (v *AttachNetworkInterfaceRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DeviceIndex != nil {
+		form.Set(prefix+"DeviceIndex", strconv.FormatInt(int64(*v.DeviceIndex), 10))
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	return nil
+}
+
 // AttachNetworkInterfaceResult is undocumented.
 type AttachNetworkInterfaceResult struct {
 	AttachmentID aws.StringValue `ec2:"AttachmentId" xml:"attachmentId"`
@@ -2188,6 +3468,26 @@ type AttachVolumeRequest struct {
 	VolumeID   aws.StringValue  `ec2:"VolumeId" xml:"VolumeId"`
 }
 
+// MarshalEC2 adds the parameters of the AttachVolumeRequest to the form, prefixing their names.
+func (v *AttachVolumeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Device != nil {
+		form.Set(prefix+"Device", *v.Device)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // AttachVPNGatewayRequest is undocumented.
 type AttachVPNGatewayRequest struct {
 	DryRun       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -2195,9 +3495,26 @@ type AttachVPNGatewayRequest struct {
 	VPNGatewayID aws.StringValue  `ec2:"VpnGatewayId" xml:"VpnGatewayId"`
 }
 
+// MarshalEC2 adds the parameters of the AttachVPNGatewayRequest to the form, prefixing their names.
+func (v *AttachVPNGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	if v.VPNGatewayID != nil {
+		form.Set(prefix+"VpnGatewayId", *v.VPNGatewayID)
+	}
+	return nil
+}
+
 // AttachVPNGatewayResult is undocumented.
 type AttachVPNGatewayResult struct {
-	VPCAttachment *VPCAttachment `ec2:"VpcAttachment" xml:"attachment"`
+	VPCAttachment *VPCAttachment `ec2:"Attachment" xml:"attachment"`
 }
 
 // Possible values for EC2.
@@ -2213,24 +3530,86 @@ type AttributeBooleanValue struct {
 	Value aws.BooleanValue `ec2:"Value" xml:"value"`
 }
 
+// MarshalEC2 adds the parameters of the AttributeBooleanValue to the form, prefixing their names.
+func (v *AttributeBooleanValue) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", strconv.FormatBool(*v.Value))
+	}
+	return nil
+}
+
 // AttributeValue is undocumented.
 type AttributeValue struct {
 	Value aws.StringValue `ec2:"Value" xml:"value"`
 }
 
+// MarshalEC2 adds the parameters of the AttributeValue to the form, prefixing their names.
+func (v *AttributeValue) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // AuthorizeSecurityGroupEgressRequest is undocumented.
 type AuthorizeSecurityGroupEgressRequest struct {
 	CIDRIP                     aws.StringValue  `ec2:"CidrIp" xml:"cidrIp"`
 	DryRun                     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	FromPort                   aws.IntegerValue `ec2:"FromPort" xml:"fromPort"`
 	GroupID                    aws.StringValue  `ec2:"GroupId" xml:"groupId"`
-	IPPermissions              []IPPermission   `ec2:"IpPermissions" xml:"ipPermissions>item"`
+	IPPermissions              []IPPermission   `ec2:"IpPermissions,list" xml:"ipPermissions>item"`
 	IPProtocol                 aws.StringValue  `ec2:"IpProtocol" xml:"ipProtocol"`
 	SourceSecurityGroupName    aws.StringValue  `ec2:"SourceSecurityGroupName" xml:"sourceSecurityGroupName"`
 	SourceSecurityGroupOwnerID aws.StringValue  `ec2:"SourceSecurityGroupOwnerId" xml:"sourceSecurityGroupOwnerId"`
 	ToPort                     aws.IntegerValue `ec2:"ToPort" xml:"toPort"`
 }
 
+// MarshalEC2 adds the parameters of the AuthorizeSecurityGroupEgressRequest to the form, prefixing their names.
+func (v *AuthorizeSecurityGroupEgressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CidrIp", *v.CIDRIP)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.FromPort != nil {
+		form.Set(prefix+"FromPort", strconv.FormatInt(int64(*v.FromPort), 10))
+	}
+	if v.GroupID != nil {
+		form.Set(prefix+"GroupId", *v.GroupID)
+	}
+	if v.IPPermissions != nil {
+		for i1 := range v.IPPermissions {
+			name1 := prefix + "IpPermissions." + strconv.Itoa(i1+1)
+			if err := v.IPPermissions[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.IPProtocol != nil {
+		form.Set(prefix+"IpProtocol", *v.IPProtocol)
+	}
+	if v.SourceSecurityGroupName != nil {
+		form.Set(prefix+"SourceSecurityGroupName", *v.SourceSecurityGroupName)
+	}
+	if v.SourceSecurityGroupOwnerID != nil {
+		form.Set(prefix+"SourceSecurityGroupOwnerId", *v.SourceSecurityGroupOwnerID)
+	}
+	if v.ToPort != nil {
+		form.Set(prefix+"ToPort", strconv.FormatInt(int64(*v.ToPort), 10))
+	}
+	return nil
+}
+
 // AuthorizeSecurityGroupIngressRequest is undocumented.
 type AuthorizeSecurityGroupIngressRequest struct {
 	CIDRIP                     aws.StringValue  `ec2:"CidrIp" xml:"CidrIp"`
@@ -2238,18 +3617,61 @@ type AuthorizeSecurityGroupIngressRequest struct {
 	FromPort                   aws.IntegerValue `ec2:"FromPort" xml:"FromPort"`
 	GroupID                    aws.StringValue  `ec2:"GroupId" xml:"GroupId"`
 	GroupName                  aws.StringValue  `ec2:"GroupName" xml:"GroupName"`
-	IPPermissions              []IPPermission   `ec2:"IpPermissions" xml:"IpPermissions>item"`
+	IPPermissions              []IPPermission   `ec2:"IpPermissions,list" xml:"IpPermissions>item"`
 	IPProtocol                 aws.StringValue  `ec2:"IpProtocol" xml:"IpProtocol"`
 	SourceSecurityGroupName    aws.StringValue  `ec2:"SourceSecurityGroupName" xml:"SourceSecurityGroupName"`
 	SourceSecurityGroupOwnerID aws.StringValue  `ec2:"SourceSecurityGroupOwnerId" xml:"SourceSecurityGroupOwnerId"`
 	ToPort                     aws.IntegerValue `ec2:"ToPort" xml:"ToPort"`
 }
 
+// MarshalEC2 adds the parameters of the AuthorizeSecurityGroupIngressRequest to the form, prefixing their names.
+func (v *AuthorizeSecurityGroupIngressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CidrIp", *v.CIDRIP)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.FromPort != nil {
+		form.Set(prefix+"FromPort", strconv.FormatInt(int64(*v.FromPort), 10))
+	}
+	if v.GroupID != nil {
+		form.Set(prefix+"GroupId", *v.GroupID)
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.IPPermissions != nil {
+		for i1 := range v.IPPermissions {
+			name1 := prefix + "IpPermissions." + strconv.Itoa(i1+1)
+			if err := v.IPPermissions[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.IPProtocol != nil {
+		form.Set(prefix+"IpProtocol", *v.IPProtocol)
+	}
+	if v.SourceSecurityGroupName != nil {
+		form.Set(prefix+"SourceSecurityGroupName", *v.SourceSecurityGroupName)
+	}
+	if v.SourceSecurityGroupOwnerID != nil {
+		form.Set(prefix+"SourceSecurityGroupOwnerId", *v.SourceSecurityGroupOwnerID)
+	}
+	if v.ToPort != nil {
+		form.Set(prefix+"ToPort", strconv.FormatInt(int64(*v.ToPort), 10))
+	}
+	return nil
+}
+
 // AvailabilityZone is undocumented.
 type AvailabilityZone struct {
-	Messages   []AvailabilityZoneMessage `ec2:"Messages" xml:"messageSet>item"`
+	Messages   []AvailabilityZoneMessage `ec2:"MessageSet,list" xml:"messageSet>item"`
 	RegionName aws.StringValue           `ec2:"RegionName" xml:"regionName"`
-	State      aws.StringValue           `ec2:"State" xml:"zoneState"`
+	State      aws.StringValue           `ec2:"ZoneState" xml:"zoneState"`
 	ZoneName   aws.StringValue           `ec2:"ZoneName" xml:"zoneName"`
 }
 
@@ -2268,6 +3690,17 @@ type BlobAttributeValue struct {
 	Value []byte `ec2:"Value" xml:"value"`
 }
 
+// MarshalEC2 adds the parameters of the BlobAttributeValue to the form, prefixing their names.
+func (v *BlobAttributeValue) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", base64.StdEncoding.EncodeToString(v.Value))
+	}
+	return nil
+}
+
 // BlockDeviceMapping is undocumented.
 type BlockDeviceMapping struct {
 	DeviceName  aws.StringValue `ec2:"DeviceName" xml:"deviceName"`
@@ -2276,6 +3709,28 @@ type BlockDeviceMapping struct {
 	VirtualName aws.StringValue `ec2:"VirtualName" xml:"virtualName"`
 }
 
+// MarshalEC2 adds the parameters of the BlockDeviceMapping to the form, prefixing their names.
+func (v *BlockDeviceMapping) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DeviceName != nil {
+		form.Set(prefix+"DeviceName", *v.DeviceName)
+	}
+	if v.EBS != nil {
+		if err := v.EBS.MarshalEC2(form, prefix+"Ebs."); err != nil {
+			return err
+		}
+	}
+	if v.NoDevice != nil {
+		form.Set(prefix+"NoDevice", *v.NoDevice)
+	}
+	if v.VirtualName != nil {
+		form.Set(prefix+"VirtualName", *v.VirtualName)
+	}
+	return nil
+}
+
 // BundleInstanceRequest is undocumented.
 type BundleInstanceRequest struct {
 	DryRun     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -2283,15 +3738,34 @@ type BundleInstanceRequest struct {
 	Storage    *Storage         `ec2:"Storage" xml:"Storage"`
 }
 
+// MarshalEC2 adds the parameters of the BundleInstanceRequest to the form, prefixing their names.
+func (v *BundleInstanceRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.Storage != nil {
+		if err := v.Storage.MarshalEC2(form, prefix+"Storage."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BundleInstanceResult is undocumented.
 type BundleInstanceResult struct {
-	BundleTask *BundleTask `ec2:"BundleTask" xml:"bundleInstanceTask"`
+	BundleTask *BundleTask `ec2:"BundleInstanceTask" xml:"bundleInstanceTask"`
 }
 
 // BundleTask is undocumented.
 type BundleTask struct {
 	BundleID        aws.StringValue  `ec2:"BundleId" xml:"bundleId"`
-	BundleTaskError *BundleTaskError `ec2:"BundleTaskError" xml:"error"`
+	BundleTaskError *BundleTaskError `ec2:"Error" xml:"error"`
 	InstanceID      aws.StringValue  `ec2:"InstanceId" xml:"instanceId"`
 	Progress        aws.StringValue  `ec2:"Progress" xml:"progress"`
 	StartTime       time.Time        `ec2:"StartTime" xml:"startTime"`
@@ -2323,9 +3797,23 @@ type CancelBundleTaskRequest struct {
 	DryRun   aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the CancelBundleTaskRequest to the form, prefixing their names.
+func (v *CancelBundleTaskRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BundleID != nil {
+		form.Set(prefix+"BundleId", *v.BundleID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // CancelBundleTaskResult is undocumented.
 type CancelBundleTaskResult struct {
-	BundleTask *BundleTask `ec2:"BundleTask" xml:"bundleInstanceTask"`
+	BundleTask *BundleTask `ec2:"BundleInstanceTask" xml:"bundleInstanceTask"`
 }
 
 // CancelConversionRequest is undocumented.
@@ -2335,19 +3823,58 @@ type CancelConversionRequest struct {
 	ReasonMessage    aws.StringValue  `ec2:"ReasonMessage" xml:"reasonMessage"`
 }
 
+// MarshalEC2 adds the parameters of the CancelConversionRequest to the form, prefixing their names.
+func (v *CancelConversionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ConversionTaskID != nil {
+		form.Set(prefix+"ConversionTaskId", *v.ConversionTaskID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.ReasonMessage != nil {
+		form.Set(prefix+"ReasonMessage", *v.ReasonMessage)
+	}
+	return nil
+}
+
 // CancelExportTaskRequest is undocumented.
 type CancelExportTaskRequest struct {
 	ExportTaskID aws.StringValue `ec2:"ExportTaskId" xml:"exportTaskId"`
 }
 
+// MarshalEC2 adds the parameters of the CancelExportTaskRequest to the form, prefixing their names.
+func (v *CancelExportTaskRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ExportTaskID != nil {
+		form.Set(prefix+"ExportTaskId", *v.ExportTaskID)
+	}
+	return nil
+}
+
 // CancelReservedInstancesListingRequest is undocumented.
 type CancelReservedInstancesListingRequest struct {
 	ReservedInstancesListingID aws.StringValue `ec2:"ReservedInstancesListingId" xml:"reservedInstancesListingId"`
 }
 
+// MarshalEC2 adds the parameters of the CancelReservedInstancesListingRequest to the form, prefixing their names.
+func (v *CancelReservedInstancesListingRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ReservedInstancesListingID != nil {
+		form.Set(prefix+"ReservedInstancesListingId", *v.ReservedInstancesListingID)
+	}
+	return nil
+}
+
 // CancelReservedInstancesListingResult is undocumented.
 type CancelReservedInstancesListingResult struct {
-	ReservedInstancesListings []ReservedInstancesListing `ec2:"ReservedInstancesListings" xml:"reservedInstancesListingsSet>item"`
+	ReservedInstancesListings []ReservedInstancesListing `ec2:"ReservedInstancesListingsSet,list" xml:"reservedInstancesListingsSet>item"`
 }
 
 // Possible values for EC2.
@@ -2362,12 +3889,29 @@ const (
 // CancelSpotInstanceRequestsRequest is undocumented.
 type CancelSpotInstanceRequestsRequest struct {
 	DryRun                 aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	SpotInstanceRequestIDs []string         `ec2:"SpotInstanceRequestId" xml:"SpotInstanceRequestId>SpotInstanceRequestId"`
+	SpotInstanceRequestIDs []string         `ec2:"SpotInstanceRequestId,list" xml:"SpotInstanceRequestId>SpotInstanceRequestId"`
+}
+
+// MarshalEC2 adds the parameters of the CancelSpotInstanceRequestsRequest to the form, prefixing their names.
+func (v *CancelSpotInstanceRequestsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.SpotInstanceRequestIDs != nil {
+		for i1 := range v.SpotInstanceRequestIDs {
+			name1 := prefix + "SpotInstanceRequestId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SpotInstanceRequestIDs[i1])
+		}
+	}
+	return nil
 }
 
 // CancelSpotInstanceRequestsResult is undocumented.
 type CancelSpotInstanceRequestsResult struct {
-	CancelledSpotInstanceRequests []CancelledSpotInstanceRequest `ec2:"CancelledSpotInstanceRequests" xml:"spotInstanceRequestSet>item"`
+	CancelledSpotInstanceRequests []CancelledSpotInstanceRequest `ec2:"SpotInstanceRequestSet,list" xml:"spotInstanceRequestSet>item"`
 }
 
 // CancelledSpotInstanceRequest is undocumented.
@@ -2383,6 +3927,23 @@ type ConfirmProductInstanceRequest struct {
 	ProductCode aws.StringValue  `ec2:"ProductCode" xml:"ProductCode"`
 }
 
+// MarshalEC2 adds the parameters of the ConfirmProductInstanceRequest to the form, prefixing their names.
+func (v *ConfirmProductInstanceRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.ProductCode != nil {
+		form.Set(prefix+"ProductCode", *v.ProductCode)
+	}
+	return nil
+}
+
 // ConfirmProductInstanceResult is undocumented.
 type ConfirmProductInstanceResult struct {
 	OwnerID aws.StringValue `ec2:"OwnerId" xml:"ownerId"`
@@ -2401,7 +3962,7 @@ type ConversionTask struct {
 	ImportVolume     *ImportVolumeTaskDetails   `ec2:"ImportVolume" xml:"importVolume"`
 	State            aws.StringValue            `ec2:"State" xml:"state"`
 	StatusMessage    aws.StringValue            `ec2:"StatusMessage" xml:"statusMessage"`
-	Tags             []Tag                      `ec2:"Tags" xml:"tagSet>item"`
+	Tags             []Tag                      `ec2:"TagSet,list" xml:"tagSet>item"`
 }
 
 // Possible values for EC2.
@@ -2422,6 +3983,32 @@ type CopyImageRequest struct {
 	SourceRegion  aws.StringValue  `ec2:"SourceRegion" xml:"SourceRegion"`
 }
 
+// MarshalEC2 adds the parameters of the CopyImageRequest to the form, prefixing their names.
+func (v *CopyImageRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClientToken != nil {
+		form.Set(prefix+"ClientToken", *v.ClientToken)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.SourceImageID != nil {
+		form.Set(prefix+"SourceImageId", *v.SourceImageID)
+	}
+	if v.SourceRegion != nil {
+		form.Set(prefix+"SourceRegion", *v.SourceRegion)
+	}
+	return nil
+}
+
 // CopyImageResult is undocumented.
 type CopyImageResult struct {
 	ImageID aws.StringValue `ec2:"ImageId" xml:"imageId"`
@@ -2437,6 +4024,32 @@ type CopySnapshotRequest struct {
 	SourceSnapshotID  aws.StringValue  `ec2:"SourceSnapshotId" xml:"SourceSnapshotId"`
 }
 
+// MarshalEC2 adds the parameters of the CopySnapshotRequest to the form, prefixing their names.
+func (v *CopySnapshotRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DestinationRegion != nil {
+		form.Set(prefix+"DestinationRegion", *v.DestinationRegion)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.PresignedURL != nil {
+		form.Set(prefix+"PresignedUrl", *v.PresignedURL)
+	}
+	if v.SourceRegion != nil {
+		form.Set(prefix+"SourceRegion", *v.SourceRegion)
+	}
+	if v.SourceSnapshotID != nil {
+		form.Set(prefix+"SourceSnapshotId", *v.SourceSnapshotID)
+	}
+	return nil
+}
+
 // CopySnapshotResult is undocumented.
 type CopySnapshotResult struct {
 	SnapshotID aws.StringValue `ec2:"SnapshotId" xml:"snapshotId"`
@@ -2450,6 +4063,26 @@ type CreateCustomerGatewayRequest struct {
 	Type     aws.StringValue  `ec2:"Type" xml:"Type"`
 }
 
+// MarshalEC2 adds the parameters of the CreateCustomerGatewayRequest to the form, prefixing their names.
+func (v *CreateCustomerGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BGPASN != nil {
+		form.Set(prefix+"BgpAsn", strconv.FormatInt(int64(*v.BGPASN), 10))
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.PublicIP != nil {
+		form.Set(prefix+"IpAddress", *v.PublicIP)
+	}
+	if v.Type != nil {
+		form.Set(prefix+"Type", *v.Type)
+	}
+	return nil
+}
+
 // CreateCustomerGatewayResult is undocumented.
 type CreateCustomerGatewayResult struct {
 	CustomerGateway *CustomerGateway `ec2:"CustomerGateway" xml:"customerGateway"`
@@ -2457,10 +4090,29 @@ type CreateCustomerGatewayResult struct {
 
 // CreateDHCPOptionsRequest is undocumented.
 type CreateDHCPOptionsRequest struct {
-	DHCPConfigurations []NewDHCPConfiguration `ec2:"DhcpConfigurations" xml:"dhcpConfiguration>item"`
+	DHCPConfigurations []NewDHCPConfiguration `ec2:"DhcpConfiguration,list" xml:"dhcpConfiguration>item"`
 	DryRun             aws.BooleanValue       `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the CreateDHCPOptionsRequest to the form, prefixing their names.
+func (v *CreateDHCPOptionsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DHCPConfigurations != nil {
+		for i1 := range v.DHCPConfigurations {
+			name1 := prefix + "DhcpConfiguration." + strconv.Itoa(i1+1)
+			if err := v.DHCPConfigurations[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // CreateDHCPOptionsResult is undocumented.
 type CreateDHCPOptionsResult struct {
 	DHCPOptions *DHCPOptions `ec2:"DhcpOptions" xml:"dhcpOptions"`
@@ -2468,7 +4120,7 @@ type CreateDHCPOptionsResult struct {
 
 // CreateImageRequest is undocumented.
 type CreateImageRequest struct {
-	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>BlockDeviceMapping"`
+	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>BlockDeviceMapping"`
 	Description         aws.StringValue      `ec2:"Description" xml:"description"`
 	DryRun              aws.BooleanValue     `ec2:"DryRun" xml:"dryRun"`
 	InstanceID          aws.StringValue      `ec2:"InstanceId" xml:"instanceId"`
@@ -2476,6 +4128,37 @@ type CreateImageRequest struct {
 	NoReboot            aws.BooleanValue     `ec2:"NoReboot" xml:"noReboot"`
 }
 
+// MarshalEC2 adds the parameters of the CreateImageRequest to the form, prefixing their names.
+func (v *CreateImageRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BlockDeviceMappings != nil {
+		for i1 := range v.BlockDeviceMappings {
+			name1 := prefix + "BlockDeviceMapping." + strconv.Itoa(i1+1)
+			if err := v.BlockDeviceMappings[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.NoReboot != nil {
+		form.Set(prefix+"NoReboot", strconv.FormatBool(*v.NoReboot))
+	}
+	return nil
+}
+
 // CreateImageResult is undocumented.
 type CreateImageResult struct {
 	ImageID aws.StringValue `ec2:"ImageId" xml:"imageId"`
@@ -2484,12 +4167,34 @@ type CreateImageResult struct {
 // CreateInstanceExportTaskRequest is undocumented.
 type CreateInstanceExportTaskRequest struct {
 	Description       aws.StringValue              `ec2:"Description" xml:"description"`
-	ExportToS3Task    *ExportToS3TaskSpecification `ec2:"ExportToS3Task" xml:"exportToS3"`
+	ExportToS3Task    *ExportToS3TaskSpecification `ec2:"ExportToS3" xml:"exportToS3"`
 	InstanceID        aws.StringValue              `ec2:"InstanceId" xml:"instanceId"`
 	TargetEnvironment aws.StringValue              `ec2:"TargetEnvironment" xml:"targetEnvironment"`
 }
 
-// CreateInstanceExportTaskResult is undocumented.
+// MarshalEC2 adds the parameters of the CreateInstanceExportTaskRequest to the form, prefixing their names.
+func (v *CreateInstanceExportTaskRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.ExportToS3Task != nil {
+		if err := v.ExportToS3Task.MarshalEC2(form, prefix+"ExportToS3."); err != nil {
+			return err
+		}
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.TargetEnvironment != nil {
+		form.Set(prefix+"TargetEnvironment", *v.TargetEnvironment)
+	}
+	return nil
+}
+
+// CreateInstanceExportTaskResult is undocumented.
 type CreateInstanceExportTaskResult struct {
 	ExportTask *ExportTask `ec2:"ExportTask" xml:"exportTask"`
 }
@@ -2499,6 +4204,17 @@ type CreateInternetGatewayRequest struct {
 	DryRun aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the CreateInternetGatewayRequest to the form, prefixing their names.
+func (v *CreateInternetGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // CreateInternetGatewayResult is undocumented.
 type CreateInternetGatewayResult struct {
 	InternetGateway *InternetGateway `ec2:"InternetGateway" xml:"internetGateway"`
@@ -2510,6 +4226,20 @@ type CreateKeyPairRequest struct {
 	KeyName aws.StringValue  `ec2:"KeyName" xml:"KeyName"`
 }
 
+// MarshalEC2 adds the parameters of the CreateKeyPairRequest to the form, prefixing their names.
+func (v *CreateKeyPairRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.KeyName != nil {
+		form.Set(prefix+"KeyName", *v.KeyName)
+	}
+	return nil
+}
+
 // CreateNetworkACLEntryRequest is undocumented.
 type CreateNetworkACLEntryRequest struct {
 	CIDRBlock    aws.StringValue  `ec2:"CidrBlock" xml:"cidrBlock"`
@@ -2523,12 +4253,65 @@ type CreateNetworkACLEntryRequest struct {
 	RuleNumber   aws.IntegerValue `ec2:"RuleNumber" xml:"ruleNumber"`
 }
 
+// MarshalEC2 adds the parameters of the CreateNetworkACLEntryRequest to the form, prefixing their names.
+func (v *CreateNetworkACLEntryRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRBlock != nil {
+		form.Set(prefix+"CidrBlock", *v.CIDRBlock)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Egress != nil {
+		form.Set(prefix+"Egress", strconv.FormatBool(*v.Egress))
+	}
+	if v.ICMPTypeCode != nil {
+		if err := v.ICMPTypeCode.MarshalEC2(form, prefix+"Icmp."); err != nil {
+			return err
+		}
+	}
+	if v.NetworkACLID != nil {
+		form.Set(prefix+"NetworkAclId", *v.NetworkACLID)
+	}
+	if v.PortRange != nil {
+		if err := v.PortRange.MarshalEC2(form, prefix+"PortRange."); err != nil {
+			return err
+		}
+	}
+	if v.Protocol != nil {
+		form.Set(prefix+"Protocol", *v.Protocol)
+	}
+	if v.RuleAction != nil {
+		form.Set(prefix+"RuleAction", *v.RuleAction)
+	}
+	if v.RuleNumber != nil {
+		form.Set(prefix+"RuleNumber", strconv.FormatInt(int64(*v.RuleNumber), 10))
+	}
+	return nil
+}
+
 // CreateNetworkACLRequest is undocumented.
 type CreateNetworkACLRequest struct {
 	DryRun aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	VPCID  aws.StringValue  `ec2:"VpcId" xml:"vpcId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateNetworkACLRequest to the form, prefixing their names.
+func (v *CreateNetworkACLRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // CreateNetworkACLResult is undocumented.
 type CreateNetworkACLResult struct {
 	NetworkACL *NetworkACL `ec2:"NetworkAcl" xml:"networkAcl"`
@@ -2538,13 +4321,50 @@ type CreateNetworkACLResult struct {
 type CreateNetworkInterfaceRequest struct {
 	Description                    aws.StringValue                 `ec2:"Description" xml:"description"`
 	DryRun                         aws.BooleanValue                `ec2:"DryRun" xml:"dryRun"`
-	Groups                         []string                        `ec2:"SecurityGroupId" xml:"SecurityGroupId>SecurityGroupId"`
+	Groups                         []string                        `ec2:"SecurityGroupId,list" xml:"SecurityGroupId>SecurityGroupId"`
 	PrivateIPAddress               aws.StringValue                 `ec2:"PrivateIpAddress" xml:"privateIpAddress"`
-	PrivateIPAddresses             []PrivateIPAddressSpecification `ec2:"PrivateIpAddresses" xml:"privateIpAddresses>item"`
+	PrivateIPAddresses             []PrivateIPAddressSpecification `ec2:"PrivateIpAddresses,list" xml:"privateIpAddresses>item"`
 	SecondaryPrivateIPAddressCount aws.IntegerValue                `ec2:"SecondaryPrivateIpAddressCount" xml:"secondaryPrivateIpAddressCount"`
 	SubnetID                       aws.StringValue                 `ec2:"SubnetId" xml:"subnetId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateNetworkInterfaceRequest to the form, prefixing their names.
+func (v *CreateNetworkInterfaceRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Groups != nil {
+		for i1 := range v.Groups {
+			name1 := prefix + "SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Groups[i1])
+		}
+	}
+	if v.PrivateIPAddress != nil {
+		form.Set(prefix+"PrivateIpAddress", *v.PrivateIPAddress)
+	}
+	if v.PrivateIPAddresses != nil {
+		for i1 := range v.PrivateIPAddresses {
+			name1 := prefix + "PrivateIpAddresses." + strconv.Itoa(i1+1)
+			if err := v.PrivateIPAddresses[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SecondaryPrivateIPAddressCount != nil {
+		form.Set(prefix+"SecondaryPrivateIpAddressCount", strconv.FormatInt(int64(*v.SecondaryPrivateIPAddressCount), 10))
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	return nil
+}
+
 // CreateNetworkInterfaceResult is undocumented.
 type CreateNetworkInterfaceResult struct {
 	NetworkInterface *NetworkInterface `ec2:"NetworkInterface" xml:"networkInterface"`
@@ -2557,17 +4377,59 @@ type CreatePlacementGroupRequest struct {
 	Strategy  aws.StringValue  `ec2:"Strategy" xml:"strategy"`
 }
 
+// MarshalEC2 adds the parameters of the CreatePlacementGroupRequest to the form, prefixing their names.
+func (v *CreatePlacementGroupRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.Strategy != nil {
+		form.Set(prefix+"Strategy", *v.Strategy)
+	}
+	return nil
+}
+
 // CreateReservedInstancesListingRequest is undocumented.
 type CreateReservedInstancesListingRequest struct {
 	ClientToken         aws.StringValue              `ec2:"ClientToken" xml:"clientToken"`
 	InstanceCount       aws.IntegerValue             `ec2:"InstanceCount" xml:"instanceCount"`
-	PriceSchedules      []PriceScheduleSpecification `ec2:"PriceSchedules" xml:"priceSchedules>item"`
+	PriceSchedules      []PriceScheduleSpecification `ec2:"PriceSchedules,list" xml:"priceSchedules>item"`
 	ReservedInstancesID aws.StringValue              `ec2:"ReservedInstancesId" xml:"reservedInstancesId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateReservedInstancesListingRequest to the form, prefixing their names.
+func (v *CreateReservedInstancesListingRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClientToken != nil {
+		form.Set(prefix+"ClientToken", *v.ClientToken)
+	}
+	if v.InstanceCount != nil {
+		form.Set(prefix+"InstanceCount", strconv.FormatInt(int64(*v.InstanceCount), 10))
+	}
+	if v.PriceSchedules != nil {
+		for i1 := range v.PriceSchedules {
+			name1 := prefix + "PriceSchedules." + strconv.Itoa(i1+1)
+			if err := v.PriceSchedules[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ReservedInstancesID != nil {
+		form.Set(prefix+"ReservedInstancesId", *v.ReservedInstancesID)
+	}
+	return nil
+}
+
 // CreateReservedInstancesListingResult is undocumented.
 type CreateReservedInstancesListingResult struct {
-	ReservedInstancesListings []ReservedInstancesListing `ec2:"ReservedInstancesListings" xml:"reservedInstancesListingsSet>item"`
+	ReservedInstancesListings []ReservedInstancesListing `ec2:"ReservedInstancesListingsSet,list" xml:"reservedInstancesListingsSet>item"`
 }
 
 // CreateRouteRequest is undocumented.
@@ -2581,12 +4443,55 @@ type CreateRouteRequest struct {
 	VPCPeeringConnectionID aws.StringValue  `ec2:"VpcPeeringConnectionId" xml:"vpcPeeringConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateRouteRequest to the form, prefixing their names.
+func (v *CreateRouteRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DestinationCIDRBlock != nil {
+		form.Set(prefix+"DestinationCidrBlock", *v.DestinationCIDRBlock)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.GatewayID != nil {
+		form.Set(prefix+"GatewayId", *v.GatewayID)
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	if v.VPCPeeringConnectionID != nil {
+		form.Set(prefix+"VpcPeeringConnectionId", *v.VPCPeeringConnectionID)
+	}
+	return nil
+}
+
 // CreateRouteTableRequest is undocumented.
 type CreateRouteTableRequest struct {
 	DryRun aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	VPCID  aws.StringValue  `ec2:"VpcId" xml:"vpcId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateRouteTableRequest to the form, prefixing their names.
+func (v *CreateRouteTableRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // CreateRouteTableResult is undocumented.
 type CreateRouteTableResult struct {
 	RouteTable *RouteTable `ec2:"RouteTable" xml:"routeTable"`
@@ -2600,6 +4505,26 @@ type CreateSecurityGroupRequest struct {
 	VPCID       aws.StringValue  `ec2:"VpcId" xml:"VpcId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateSecurityGroupRequest to the form, prefixing their names.
+func (v *CreateSecurityGroupRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"GroupDescription", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // CreateSecurityGroupResult is undocumented.
 type CreateSecurityGroupResult struct {
 	GroupID aws.StringValue `ec2:"GroupId" xml:"groupId"`
@@ -2612,6 +4537,23 @@ type CreateSnapshotRequest struct {
 	VolumeID    aws.StringValue  `ec2:"VolumeId" xml:"VolumeId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateSnapshotRequest to the form, prefixing their names.
+func (v *CreateSnapshotRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // CreateSpotDatafeedSubscriptionRequest is undocumented.
 type CreateSpotDatafeedSubscriptionRequest struct {
 	Bucket aws.StringValue  `ec2:"Bucket" xml:"bucket"`
@@ -2619,6 +4561,23 @@ type CreateSpotDatafeedSubscriptionRequest struct {
 	Prefix aws.StringValue  `ec2:"Prefix" xml:"prefix"`
 }
 
+// MarshalEC2 adds the parameters of the CreateSpotDatafeedSubscriptionRequest to the form, prefixing their names.
+func (v *CreateSpotDatafeedSubscriptionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Bucket != nil {
+		form.Set(prefix+"Bucket", *v.Bucket)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Prefix != nil {
+		form.Set(prefix+"Prefix", *v.Prefix)
+	}
+	return nil
+}
+
 // CreateSpotDatafeedSubscriptionResult is undocumented.
 type CreateSpotDatafeedSubscriptionResult struct {
 	SpotDatafeedSubscription *SpotDatafeedSubscription `ec2:"SpotDatafeedSubscription" xml:"spotDatafeedSubscription"`
@@ -2632,6 +4591,26 @@ type CreateSubnetRequest struct {
 	VPCID            aws.StringValue  `ec2:"VpcId" xml:"VpcId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateSubnetRequest to the form, prefixing their names.
+func (v *CreateSubnetRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.CIDRBlock != nil {
+		form.Set(prefix+"CidrBlock", *v.CIDRBlock)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // CreateSubnetResult is undocumented.
 type CreateSubnetResult struct {
 	Subnet *Subnet `ec2:"Subnet" xml:"subnet"`
@@ -2640,8 +4619,33 @@ type CreateSubnetResult struct {
 // CreateTagsRequest is undocumented.
 type CreateTagsRequest struct {
 	DryRun    aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Resources []string         `ec2:"ResourceId" xml:"ResourceId>member"`
-	Tags      []Tag            `ec2:"Tag" xml:"Tag>item"`
+	Resources []string         `ec2:"ResourceId,list" xml:"ResourceId>member"`
+	Tags      []Tag            `ec2:"Tag,list" xml:"Tag>item"`
+}
+
+// MarshalEC2 adds the parameters of the CreateTagsRequest to the form, prefixing their names.
+func (v *CreateTagsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Resources != nil {
+		for i1 := range v.Resources {
+			name1 := prefix + "ResourceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Resources[i1])
+		}
+	}
+	if v.Tags != nil {
+		for i1 := range v.Tags {
+			name1 := prefix + "Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateVolumePermission is undocumented.
@@ -2650,10 +4654,48 @@ type CreateVolumePermission struct {
 	UserID aws.StringValue `ec2:"UserId" xml:"userId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateVolumePermission to the form, prefixing their names.
+func (v *CreateVolumePermission) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Group != nil {
+		form.Set(prefix+"Group", *v.Group)
+	}
+	if v.UserID != nil {
+		form.Set(prefix+"UserId", *v.UserID)
+	}
+	return nil
+}
+
 // CreateVolumePermissionModifications is undocumented.
 type CreateVolumePermissionModifications struct {
-	Add    []CreateVolumePermission `ec2:"Add" xml:"Add>item"`
-	Remove []CreateVolumePermission `ec2:"Remove" xml:"Remove>item"`
+	Add    []CreateVolumePermission `ec2:"Add,list" xml:"Add>item"`
+	Remove []CreateVolumePermission `ec2:"Remove,list" xml:"Remove>item"`
+}
+
+// MarshalEC2 adds the parameters of the CreateVolumePermissionModifications to the form, prefixing their names.
+func (v *CreateVolumePermissionModifications) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Add != nil {
+		for i1 := range v.Add {
+			name1 := prefix + "Add." + strconv.Itoa(i1+1)
+			if err := v.Add[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Remove != nil {
+		for i1 := range v.Remove {
+			name1 := prefix + "Remove." + strconv.Itoa(i1+1)
+			if err := v.Remove[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateVolumeRequest is undocumented.
@@ -2668,6 +4710,38 @@ type CreateVolumeRequest struct {
 	VolumeType       aws.StringValue  `ec2:"VolumeType" xml:"VolumeType"`
 }
 
+// MarshalEC2 adds the parameters of the CreateVolumeRequest to the form, prefixing their names.
+func (v *CreateVolumeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Encrypted != nil {
+		form.Set(prefix+"Encrypted", strconv.FormatBool(*v.Encrypted))
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.KMSKeyID != nil {
+		form.Set(prefix+"KmsKeyId", *v.KMSKeyID)
+	}
+	if v.Size != nil {
+		form.Set(prefix+"Size", strconv.FormatInt(int64(*v.Size), 10))
+	}
+	if v.SnapshotID != nil {
+		form.Set(prefix+"SnapshotId", *v.SnapshotID)
+	}
+	if v.VolumeType != nil {
+		form.Set(prefix+"VolumeType", *v.VolumeType)
+	}
+	return nil
+}
+
 // CreateVPCPeeringConnectionRequest is undocumented.
 type CreateVPCPeeringConnectionRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -2676,6 +4750,26 @@ type CreateVPCPeeringConnectionRequest struct {
 	VPCID       aws.StringValue  `ec2:"VpcId" xml:"vpcId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateVPCPeeringConnectionRequest to the form, prefixing their names.
+func (v *CreateVPCPeeringConnectionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.PeerOwnerID != nil {
+		form.Set(prefix+"PeerOwnerId", *v.PeerOwnerID)
+	}
+	if v.PeerVPCID != nil {
+		form.Set(prefix+"PeerVpcId", *v.PeerVPCID)
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // CreateVPCPeeringConnectionResult is undocumented.
 type CreateVPCPeeringConnectionResult struct {
 	VPCPeeringConnection *VPCPeeringConnection `ec2:"VpcPeeringConnection" xml:"vpcPeeringConnection"`
@@ -2688,6 +4782,23 @@ type CreateVPCRequest struct {
 	InstanceTenancy aws.StringValue  `ec2:"InstanceTenancy" xml:"instanceTenancy"`
 }
 
+// MarshalEC2 adds the parameters of the CreateVPCRequest to the form, prefixing their names.
+func (v *CreateVPCRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRBlock != nil {
+		form.Set(prefix+"CidrBlock", *v.CIDRBlock)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceTenancy != nil {
+		form.Set(prefix+"InstanceTenancy", *v.InstanceTenancy)
+	}
+	return nil
+}
+
 // CreateVPCResult is undocumented.
 type CreateVPCResult struct {
 	VPC *VPC `ec2:"Vpc" xml:"vpc"`
@@ -2702,6 +4813,31 @@ type CreateVPNConnectionRequest struct {
 	VPNGatewayID      aws.StringValue                    `ec2:"VpnGatewayId" xml:"VpnGatewayId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateVPNConnectionRequest to the form, prefixing their names.
+func (v *CreateVPNConnectionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CustomerGatewayID != nil {
+		form.Set(prefix+"CustomerGatewayId", *v.CustomerGatewayID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Options != nil {
+		if err := v.Options.MarshalEC2(form, prefix+"Options."); err != nil {
+			return err
+		}
+	}
+	if v.Type != nil {
+		form.Set(prefix+"Type", *v.Type)
+	}
+	if v.VPNGatewayID != nil {
+		form.Set(prefix+"VpnGatewayId", *v.VPNGatewayID)
+	}
+	return nil
+}
+
 // CreateVPNConnectionResult is undocumented.
 type CreateVPNConnectionResult struct {
 	VPNConnection *VPNConnection `ec2:"VpnConnection" xml:"vpnConnection"`
@@ -2713,6 +4849,20 @@ type CreateVPNConnectionRouteRequest struct {
 	VPNConnectionID      aws.StringValue `ec2:"VpnConnectionId" xml:"VpnConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the CreateVPNConnectionRouteRequest to the form, prefixing their names.
+func (v *CreateVPNConnectionRouteRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DestinationCIDRBlock != nil {
+		form.Set(prefix+"DestinationCidrBlock", *v.DestinationCIDRBlock)
+	}
+	if v.VPNConnectionID != nil {
+		form.Set(prefix+"VpnConnectionId", *v.VPNConnectionID)
+	}
+	return nil
+}
+
 // CreateVPNGatewayRequest is undocumented.
 type CreateVPNGatewayRequest struct {
 	AvailabilityZone aws.StringValue  `ec2:"AvailabilityZone" xml:"AvailabilityZone"`
@@ -2720,6 +4870,23 @@ type CreateVPNGatewayRequest struct {
 	Type             aws.StringValue  `ec2:"Type" xml:"Type"`
 }
 
+// MarshalEC2 adds the parameters of the CreateVPNGatewayRequest to the form, prefixing their names.
+func (v *CreateVPNGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Type != nil {
+		form.Set(prefix+"Type", *v.Type)
+	}
+	return nil
+}
+
 // CreateVPNGatewayResult is undocumented.
 type CreateVPNGatewayResult struct {
 	VPNGateway *VPNGateway `ec2:"VpnGateway" xml:"vpnGateway"`
@@ -2736,7 +4903,7 @@ type CustomerGateway struct {
 	CustomerGatewayID aws.StringValue `ec2:"CustomerGatewayId" xml:"customerGatewayId"`
 	IPAddress         aws.StringValue `ec2:"IpAddress" xml:"ipAddress"`
 	State             aws.StringValue `ec2:"State" xml:"state"`
-	Tags              []Tag           `ec2:"Tags" xml:"tagSet>item"`
+	Tags              []Tag           `ec2:"TagSet,list" xml:"tagSet>item"`
 	Type              aws.StringValue `ec2:"Type" xml:"type"`
 }
 
@@ -2752,24 +4919,80 @@ type DeleteCustomerGatewayRequest struct {
 	DryRun            aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteCustomerGatewayRequest to the form, prefixing their names.
+func (v *DeleteCustomerGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CustomerGatewayID != nil {
+		form.Set(prefix+"CustomerGatewayId", *v.CustomerGatewayID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // DeleteDHCPOptionsRequest is undocumented.
 type DeleteDHCPOptionsRequest struct {
 	DHCPOptionsID aws.StringValue  `ec2:"DhcpOptionsId" xml:"DhcpOptionsId"`
 	DryRun        aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteDHCPOptionsRequest to the form, prefixing their names.
+func (v *DeleteDHCPOptionsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DHCPOptionsID != nil {
+		form.Set(prefix+"DhcpOptionsId", *v.DHCPOptionsID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // DeleteInternetGatewayRequest is undocumented.
 type DeleteInternetGatewayRequest struct {
 	DryRun            aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	InternetGatewayID aws.StringValue  `ec2:"InternetGatewayId" xml:"internetGatewayId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteInternetGatewayRequest to the form, prefixing their names.
+func (v *DeleteInternetGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InternetGatewayID != nil {
+		form.Set(prefix+"InternetGatewayId", *v.InternetGatewayID)
+	}
+	return nil
+}
+
 // DeleteKeyPairRequest is undocumented.
 type DeleteKeyPairRequest struct {
 	DryRun  aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	KeyName aws.StringValue  `ec2:"KeyName" xml:"KeyName"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteKeyPairRequest to the form, prefixing their names.
+func (v *DeleteKeyPairRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.KeyName != nil {
+		form.Set(prefix+"KeyName", *v.KeyName)
+	}
+	return nil
+}
+
 // DeleteNetworkACLEntryRequest is undocumented.
 type DeleteNetworkACLEntryRequest struct {
 	DryRun       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -2778,24 +5001,86 @@ type DeleteNetworkACLEntryRequest struct {
 	RuleNumber   aws.IntegerValue `ec2:"RuleNumber" xml:"ruleNumber"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteNetworkACLEntryRequest to the form, prefixing their names.
+func (v *DeleteNetworkACLEntryRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Egress != nil {
+		form.Set(prefix+"Egress", strconv.FormatBool(*v.Egress))
+	}
+	if v.NetworkACLID != nil {
+		form.Set(prefix+"NetworkAclId", *v.NetworkACLID)
+	}
+	if v.RuleNumber != nil {
+		form.Set(prefix+"RuleNumber", strconv.FormatInt(int64(*v.RuleNumber), 10))
+	}
+	return nil
+}
+
 // DeleteNetworkACLRequest is undocumented.
 type DeleteNetworkACLRequest struct {
 	DryRun       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	NetworkACLID aws.StringValue  `ec2:"NetworkAclId" xml:"networkAclId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteNetworkACLRequest to the form, prefixing their names.
+func (v *DeleteNetworkACLRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.NetworkACLID != nil {
+		form.Set(prefix+"NetworkAclId", *v.NetworkACLID)
+	}
+	return nil
+}
+
 // DeleteNetworkInterfaceRequest is undocumented.
 type DeleteNetworkInterfaceRequest struct {
 	DryRun             aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	NetworkInterfaceID aws.StringValue  `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteNetworkInterfaceRequest to the form, prefixing their names.
+func (v *DeleteNetworkInterfaceRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	return nil
+}
+
 // DeletePlacementGroupRequest is undocumented.
 type DeletePlacementGroupRequest struct {
 	DryRun    aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	GroupName aws.StringValue  `ec2:"GroupName" xml:"groupName"`
 }
 
+// MarshalEC2 adds the parameters of the DeletePlacementGroupRequest to the form, prefixing their names.
+func (v *DeletePlacementGroupRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	return nil
+}
+
 // DeleteRouteRequest is undocumented.
 type DeleteRouteRequest struct {
 	DestinationCIDRBlock aws.StringValue  `ec2:"DestinationCidrBlock" xml:"destinationCidrBlock"`
@@ -2803,12 +5088,43 @@ type DeleteRouteRequest struct {
 	RouteTableID         aws.StringValue  `ec2:"RouteTableId" xml:"routeTableId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteRouteRequest to the form, prefixing their names.
+func (v *DeleteRouteRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DestinationCIDRBlock != nil {
+		form.Set(prefix+"DestinationCidrBlock", *v.DestinationCIDRBlock)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	return nil
+}
+
 // DeleteRouteTableRequest is undocumented.
 type DeleteRouteTableRequest struct {
 	DryRun       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	RouteTableID aws.StringValue  `ec2:"RouteTableId" xml:"routeTableId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteRouteTableRequest to the form, prefixing their names.
+func (v *DeleteRouteTableRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	return nil
+}
+
 // DeleteSecurityGroupRequest is undocumented.
 type DeleteSecurityGroupRequest struct {
 	DryRun    aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -2816,28 +5132,109 @@ type DeleteSecurityGroupRequest struct {
 	GroupName aws.StringValue  `ec2:"GroupName" xml:"GroupName"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteSecurityGroupRequest to the form, prefixing their names.
+func (v *DeleteSecurityGroupRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.GroupID != nil {
+		form.Set(prefix+"GroupId", *v.GroupID)
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	return nil
+}
+
 // DeleteSnapshotRequest is undocumented.
 type DeleteSnapshotRequest struct {
 	DryRun     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	SnapshotID aws.StringValue  `ec2:"SnapshotId" xml:"SnapshotId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteSnapshotRequest to the form, prefixing their names.
+func (v *DeleteSnapshotRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.SnapshotID != nil {
+		form.Set(prefix+"SnapshotId", *v.SnapshotID)
+	}
+	return nil
+}
+
 // DeleteSpotDatafeedSubscriptionRequest is undocumented.
 type DeleteSpotDatafeedSubscriptionRequest struct {
 	DryRun aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteSpotDatafeedSubscriptionRequest to the form, prefixing their names.
+func (v *DeleteSpotDatafeedSubscriptionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // DeleteSubnetRequest is undocumented.
 type DeleteSubnetRequest struct {
 	DryRun   aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	SubnetID aws.StringValue  `ec2:"SubnetId" xml:"SubnetId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteSubnetRequest to the form, prefixing their names.
+func (v *DeleteSubnetRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	return nil
+}
+
 // DeleteTagsRequest is undocumented.
 type DeleteTagsRequest struct {
 	DryRun    aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Resources []string         `ec2:"Resources" xml:"resourceId>member"`
-	Tags      []Tag            `ec2:"Tags" xml:"tag>item"`
+	Resources []string         `ec2:"ResourceId,list" xml:"resourceId>member"`
+	Tags      []Tag            `ec2:"Tag,list" xml:"tag>item"`
+}
+
+// MarshalEC2 adds the parameters of the DeleteTagsRequest to the form, prefixing their names.
+func (v *DeleteTagsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Resources != nil {
+		for i1 := range v.Resources {
+			name1 := prefix + "ResourceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Resources[i1])
+		}
+	}
+	if v.Tags != nil {
+		for i1 := range v.Tags {
+			name1 := prefix + "Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DeleteVolumeRequest is undocumented.
@@ -2846,12 +5243,40 @@ type DeleteVolumeRequest struct {
 	VolumeID aws.StringValue  `ec2:"VolumeId" xml:"VolumeId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteVolumeRequest to the form, prefixing their names.
+func (v *DeleteVolumeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // DeleteVPCPeeringConnectionRequest is undocumented.
 type DeleteVPCPeeringConnectionRequest struct {
 	DryRun                 aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	VPCPeeringConnectionID aws.StringValue  `ec2:"VpcPeeringConnectionId" xml:"vpcPeeringConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteVPCPeeringConnectionRequest to the form, prefixing their names.
+func (v *DeleteVPCPeeringConnectionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCPeeringConnectionID != nil {
+		form.Set(prefix+"VpcPeeringConnectionId", *v.VPCPeeringConnectionID)
+	}
+	return nil
+}
+
 // DeleteVPCPeeringConnectionResult is undocumented.
 type DeleteVPCPeeringConnectionResult struct {
 	Return aws.BooleanValue `ec2:"Return" xml:"return"`
@@ -2863,122 +5288,379 @@ type DeleteVPCRequest struct {
 	VPCID  aws.StringValue  `ec2:"VpcId" xml:"VpcId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteVPCRequest to the form, prefixing their names.
+func (v *DeleteVPCRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // DeleteVPNConnectionRequest is undocumented.
 type DeleteVPNConnectionRequest struct {
 	DryRun          aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	VPNConnectionID aws.StringValue  `ec2:"VpnConnectionId" xml:"VpnConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteVPNConnectionRequest to the form, prefixing their names.
+func (v *DeleteVPNConnectionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPNConnectionID != nil {
+		form.Set(prefix+"VpnConnectionId", *v.VPNConnectionID)
+	}
+	return nil
+}
+
 // DeleteVPNConnectionRouteRequest is undocumented.
 type DeleteVPNConnectionRouteRequest struct {
 	DestinationCIDRBlock aws.StringValue `ec2:"DestinationCidrBlock" xml:"DestinationCidrBlock"`
 	VPNConnectionID      aws.StringValue `ec2:"VpnConnectionId" xml:"VpnConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteVPNConnectionRouteRequest to the form, prefixing their names.
+func (v *DeleteVPNConnectionRouteRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DestinationCIDRBlock != nil {
+		form.Set(prefix+"DestinationCidrBlock", *v.DestinationCIDRBlock)
+	}
+	if v.VPNConnectionID != nil {
+		form.Set(prefix+"VpnConnectionId", *v.VPNConnectionID)
+	}
+	return nil
+}
+
 // DeleteVPNGatewayRequest is undocumented.
 type DeleteVPNGatewayRequest struct {
 	DryRun       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	VPNGatewayID aws.StringValue  `ec2:"VpnGatewayId" xml:"VpnGatewayId"`
 }
 
+// MarshalEC2 adds the parameters of the DeleteVPNGatewayRequest to the form, prefixing their names.
+func (v *DeleteVPNGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPNGatewayID != nil {
+		form.Set(prefix+"VpnGatewayId", *v.VPNGatewayID)
+	}
+	return nil
+}
+
 // DeregisterImageRequest is undocumented.
 type DeregisterImageRequest struct {
 	DryRun  aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	ImageID aws.StringValue  `ec2:"ImageId" xml:"ImageId"`
 }
 
+// MarshalEC2 adds the parameters of the DeregisterImageRequest to the form, prefixing their names.
+func (v *DeregisterImageRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.ImageID != nil {
+		form.Set(prefix+"ImageId", *v.ImageID)
+	}
+	return nil
+}
+
 // DescribeAccountAttributesRequest is undocumented.
 type DescribeAccountAttributesRequest struct {
-	AttributeNames []string         `ec2:"AttributeNames" xml:"attributeName>attributeName"`
+	AttributeNames []string         `ec2:"AttributeName,list" xml:"attributeName>attributeName"`
 	DryRun         aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeAccountAttributesRequest to the form, prefixing their names.
+func (v *DescribeAccountAttributesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttributeNames != nil {
+		for i1 := range v.AttributeNames {
+			name1 := prefix + "AttributeName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AttributeNames[i1])
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // DescribeAccountAttributesResult is undocumented.
 type DescribeAccountAttributesResult struct {
-	AccountAttributes []AccountAttribute `ec2:"AccountAttributes" xml:"accountAttributeSet>item"`
+	AccountAttributes []AccountAttribute `ec2:"AccountAttributeSet,list" xml:"accountAttributeSet>item"`
 }
 
 // DescribeAddressesRequest is undocumented.
 type DescribeAddressesRequest struct {
-	AllocationIDs []string         `ec2:"AllocationId" xml:"AllocationId>AllocationId"`
+	AllocationIDs []string         `ec2:"AllocationId,list" xml:"AllocationId>AllocationId"`
 	DryRun        aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters       []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	PublicIPs     []string         `ec2:"PublicIp" xml:"PublicIp>PublicIp"`
+	Filters       []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	PublicIPs     []string         `ec2:"PublicIp,list" xml:"PublicIp>PublicIp"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeAddressesRequest to the form, prefixing their names.
+func (v *DescribeAddressesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllocationIDs != nil {
+		for i1 := range v.AllocationIDs {
+			name1 := prefix + "AllocationId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AllocationIDs[i1])
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.PublicIPs != nil {
+		for i1 := range v.PublicIPs {
+			name1 := prefix + "PublicIp." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PublicIPs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeAddressesResult is undocumented.
 type DescribeAddressesResult struct {
-	Addresses []Address `ec2:"Addresses" xml:"addressesSet>item"`
+	Addresses []Address `ec2:"AddressesSet,list" xml:"addressesSet>item"`
 }
 
 // DescribeAvailabilityZonesRequest is undocumented.
 type DescribeAvailabilityZonesRequest struct {
 	DryRun    aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters   []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	ZoneNames []string         `ec2:"ZoneName" xml:"ZoneName>ZoneName"`
+	Filters   []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	ZoneNames []string         `ec2:"ZoneName,list" xml:"ZoneName>ZoneName"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeAvailabilityZonesRequest to the form, prefixing their names.
+func (v *DescribeAvailabilityZonesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ZoneNames != nil {
+		for i1 := range v.ZoneNames {
+			name1 := prefix + "ZoneName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ZoneNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeAvailabilityZonesResult is undocumented.
 type DescribeAvailabilityZonesResult struct {
-	AvailabilityZones []AvailabilityZone `ec2:"AvailabilityZones" xml:"availabilityZoneInfo>item"`
+	AvailabilityZones []AvailabilityZone `ec2:"AvailabilityZoneInfo,list" xml:"availabilityZoneInfo>item"`
 }
 
 // DescribeBundleTasksRequest is undocumented.
 type DescribeBundleTasksRequest struct {
-	BundleIDs []string         `ec2:"BundleId" xml:"BundleId>BundleId"`
+	BundleIDs []string         `ec2:"BundleId,list" xml:"BundleId>BundleId"`
 	DryRun    aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters   []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters   []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeBundleTasksRequest to the form, prefixing their names.
+func (v *DescribeBundleTasksRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BundleIDs != nil {
+		for i1 := range v.BundleIDs {
+			name1 := prefix + "BundleId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.BundleIDs[i1])
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DescribeBundleTasksResult is undocumented.
 type DescribeBundleTasksResult struct {
-	BundleTasks []BundleTask `ec2:"BundleTasks" xml:"bundleInstanceTasksSet>item"`
+	BundleTasks []BundleTask `ec2:"BundleInstanceTasksSet,list" xml:"bundleInstanceTasksSet>item"`
 }
 
 // DescribeConversionTasksRequest is undocumented.
 type DescribeConversionTasksRequest struct {
-	ConversionTaskIDs []string         `ec2:"ConversionTaskIds" xml:"conversionTaskId>item"`
+	ConversionTaskIDs []string         `ec2:"ConversionTaskId,list" xml:"conversionTaskId>item"`
 	DryRun            aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters           []Filter         `ec2:"Filters" xml:"filter>Filter"`
+	Filters           []Filter         `ec2:"Filter,list" xml:"filter>Filter"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeConversionTasksRequest to the form, prefixing their names.
+func (v *DescribeConversionTasksRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ConversionTaskIDs != nil {
+		for i1 := range v.ConversionTaskIDs {
+			name1 := prefix + "ConversionTaskId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ConversionTaskIDs[i1])
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DescribeConversionTasksResult is undocumented.
 type DescribeConversionTasksResult struct {
-	ConversionTasks []ConversionTask `ec2:"ConversionTasks" xml:"conversionTasks>item"`
+	ConversionTasks []ConversionTask `ec2:"ConversionTasks,list" xml:"conversionTasks>item"`
 }
 
 // DescribeCustomerGatewaysRequest is undocumented.
 type DescribeCustomerGatewaysRequest struct {
-	CustomerGatewayIDs []string         `ec2:"CustomerGatewayId" xml:"CustomerGatewayId>CustomerGatewayId"`
+	CustomerGatewayIDs []string         `ec2:"CustomerGatewayId,list" xml:"CustomerGatewayId>CustomerGatewayId"`
 	DryRun             aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters            []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters            []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeCustomerGatewaysRequest to the form, prefixing their names.
+func (v *DescribeCustomerGatewaysRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CustomerGatewayIDs != nil {
+		for i1 := range v.CustomerGatewayIDs {
+			name1 := prefix + "CustomerGatewayId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CustomerGatewayIDs[i1])
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DescribeCustomerGatewaysResult is undocumented.
 type DescribeCustomerGatewaysResult struct {
-	CustomerGateways []CustomerGateway `ec2:"CustomerGateways" xml:"customerGatewaySet>item"`
+	CustomerGateways []CustomerGateway `ec2:"CustomerGatewaySet,list" xml:"customerGatewaySet>item"`
 }
 
 // DescribeDHCPOptionsRequest is undocumented.
 type DescribeDHCPOptionsRequest struct {
-	DHCPOptionsIDs []string         `ec2:"DhcpOptionsId" xml:"DhcpOptionsId>DhcpOptionsId"`
+	DHCPOptionsIDs []string         `ec2:"DhcpOptionsId,list" xml:"DhcpOptionsId>DhcpOptionsId"`
 	DryRun         aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters        []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters        []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeDHCPOptionsRequest to the form, prefixing their names.
+func (v *DescribeDHCPOptionsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DHCPOptionsIDs != nil {
+		for i1 := range v.DHCPOptionsIDs {
+			name1 := prefix + "DhcpOptionsId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.DHCPOptionsIDs[i1])
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DescribeDHCPOptionsResult is undocumented.
 type DescribeDHCPOptionsResult struct {
-	DHCPOptions []DHCPOptions `ec2:"DhcpOptions" xml:"dhcpOptionsSet>item"`
+	DHCPOptions []DHCPOptions `ec2:"DhcpOptionsSet,list" xml:"dhcpOptionsSet>item"`
 }
 
 // DescribeExportTasksRequest is undocumented.
 type DescribeExportTasksRequest struct {
-	ExportTaskIDs []string `ec2:"ExportTaskIds" xml:"exportTaskId>ExportTaskId"`
+	ExportTaskIDs []string `ec2:"ExportTaskId,list" xml:"exportTaskId>ExportTaskId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeExportTasksRequest to the form, prefixing their names.
+func (v *DescribeExportTasksRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ExportTaskIDs != nil {
+		for i1 := range v.ExportTaskIDs {
+			name1 := prefix + "ExportTaskId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ExportTaskIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeExportTasksResult is undocumented.
 type DescribeExportTasksResult struct {
-	ExportTasks []ExportTask `ec2:"ExportTasks" xml:"exportTaskSet>item"`
+	ExportTasks []ExportTask `ec2:"ExportTaskSet,list" xml:"exportTaskSet>item"`
 }
 
 // DescribeImageAttributeRequest is undocumented.
@@ -2988,18 +5670,72 @@ type DescribeImageAttributeRequest struct {
 	ImageID   aws.StringValue  `ec2:"ImageId" xml:"ImageId"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeImageAttributeRequest to the form, prefixing their names.
+func (v *DescribeImageAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.ImageID != nil {
+		form.Set(prefix+"ImageId", *v.ImageID)
+	}
+	return nil
+}
+
 // DescribeImagesRequest is undocumented.
 type DescribeImagesRequest struct {
 	DryRun          aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	ExecutableUsers []string         `ec2:"ExecutableBy" xml:"ExecutableBy>ExecutableBy"`
-	Filters         []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	ImageIDs        []string         `ec2:"ImageId" xml:"ImageId>ImageId"`
-	Owners          []string         `ec2:"Owner" xml:"Owner>Owner"`
+	ExecutableUsers []string         `ec2:"ExecutableBy,list" xml:"ExecutableBy>ExecutableBy"`
+	Filters         []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	ImageIDs        []string         `ec2:"ImageId,list" xml:"ImageId>ImageId"`
+	Owners          []string         `ec2:"Owner,list" xml:"Owner>Owner"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeImagesRequest to the form, prefixing their names.
+func (v *DescribeImagesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.ExecutableUsers != nil {
+		for i1 := range v.ExecutableUsers {
+			name1 := prefix + "ExecutableBy." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ExecutableUsers[i1])
+		}
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ImageIDs != nil {
+		for i1 := range v.ImageIDs {
+			name1 := prefix + "ImageId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ImageIDs[i1])
+		}
+	}
+	if v.Owners != nil {
+		for i1 := range v.Owners {
+			name1 := prefix + "Owner." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Owners[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeImagesResult is undocumented.
 type DescribeImagesResult struct {
-	Images []Image `ec2:"Images" xml:"imagesSet>item"`
+	Images []Image `ec2:"ImagesSet,list" xml:"imagesSet>item"`
 }
 
 // DescribeInstanceAttributeRequest is undocumented.
@@ -3009,71 +5745,228 @@ type DescribeInstanceAttributeRequest struct {
 	InstanceID aws.StringValue  `ec2:"InstanceId" xml:"instanceId"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeInstanceAttributeRequest to the form, prefixing their names.
+func (v *DescribeInstanceAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	return nil
+}
+
 // DescribeInstanceStatusRequest is undocumented.
 type DescribeInstanceStatusRequest struct {
 	DryRun              aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters             []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters             []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
 	IncludeAllInstances aws.BooleanValue `ec2:"IncludeAllInstances" xml:"includeAllInstances"`
-	InstanceIDs         []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	InstanceIDs         []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
 	MaxResults          aws.IntegerValue `ec2:"MaxResults" xml:"MaxResults"`
 	NextToken           aws.StringValue  `ec2:"NextToken" xml:"NextToken"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeInstanceStatusRequest to the form, prefixing their names.
+func (v *DescribeInstanceStatusRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.IncludeAllInstances != nil {
+		form.Set(prefix+"IncludeAllInstances", strconv.FormatBool(*v.IncludeAllInstances))
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	if v.MaxResults != nil {
+		form.Set(prefix+"MaxResults", strconv.FormatInt(int64(*v.MaxResults), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // DescribeInstanceStatusResult is undocumented.
 type DescribeInstanceStatusResult struct {
-	InstanceStatuses []InstanceStatus `ec2:"InstanceStatuses" xml:"instanceStatusSet>item"`
+	InstanceStatuses []InstanceStatus `ec2:"InstanceStatusSet,list" xml:"instanceStatusSet>item"`
 	NextToken        aws.StringValue  `ec2:"NextToken" xml:"nextToken"`
 }
 
 // DescribeInstancesRequest is undocumented.
 type DescribeInstancesRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters     []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	InstanceIDs []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	Filters     []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	InstanceIDs []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
 	MaxResults  aws.IntegerValue `ec2:"MaxResults" xml:"maxResults"`
 	NextToken   aws.StringValue  `ec2:"NextToken" xml:"nextToken"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeInstancesRequest to the form, prefixing their names.
+func (v *DescribeInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	if v.MaxResults != nil {
+		form.Set(prefix+"MaxResults", strconv.FormatInt(int64(*v.MaxResults), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // DescribeInstancesResult is undocumented.
 type DescribeInstancesResult struct {
 	NextToken    aws.StringValue `ec2:"NextToken" xml:"nextToken"`
-	Reservations []Reservation   `ec2:"Reservations" xml:"reservationSet>item"`
+	Reservations []Reservation   `ec2:"ReservationSet,list" xml:"reservationSet>item"`
 }
 
 // DescribeInternetGatewaysRequest is undocumented.
 type DescribeInternetGatewaysRequest struct {
 	DryRun             aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters            []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	InternetGatewayIDs []string         `ec2:"InternetGatewayIds" xml:"internetGatewayId>item"`
+	Filters            []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	InternetGatewayIDs []string         `ec2:"InternetGatewayId,list" xml:"internetGatewayId>item"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeInternetGatewaysRequest to the form, prefixing their names.
+func (v *DescribeInternetGatewaysRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.InternetGatewayIDs != nil {
+		for i1 := range v.InternetGatewayIDs {
+			name1 := prefix + "InternetGatewayId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InternetGatewayIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeInternetGatewaysResult is undocumented.
 type DescribeInternetGatewaysResult struct {
-	InternetGateways []InternetGateway `ec2:"InternetGateways" xml:"internetGatewaySet>item"`
+	InternetGateways []InternetGateway `ec2:"InternetGatewaySet,list" xml:"internetGatewaySet>item"`
 }
 
 // DescribeKeyPairsRequest is undocumented.
 type DescribeKeyPairsRequest struct {
 	DryRun   aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters  []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	KeyNames []string         `ec2:"KeyName" xml:"KeyName>KeyName"`
+	Filters  []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	KeyNames []string         `ec2:"KeyName,list" xml:"KeyName>KeyName"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeKeyPairsRequest to the form, prefixing their names.
+func (v *DescribeKeyPairsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.KeyNames != nil {
+		for i1 := range v.KeyNames {
+			name1 := prefix + "KeyName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.KeyNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeKeyPairsResult is undocumented.
 type DescribeKeyPairsResult struct {
-	KeyPairs []KeyPairInfo `ec2:"KeyPairs" xml:"keySet>item"`
+	KeyPairs []KeyPairInfo `ec2:"KeySet,list" xml:"keySet>item"`
 }
 
 // DescribeNetworkACLsRequest is undocumented.
 type DescribeNetworkACLsRequest struct {
 	DryRun        aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters       []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	NetworkACLIDs []string         `ec2:"NetworkAclId" xml:"NetworkAclId>item"`
+	Filters       []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	NetworkACLIDs []string         `ec2:"NetworkAclId,list" xml:"NetworkAclId>item"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeNetworkACLsRequest to the form, prefixing their names.
+func (v *DescribeNetworkACLsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.NetworkACLIDs != nil {
+		for i1 := range v.NetworkACLIDs {
+			name1 := prefix + "NetworkAclId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.NetworkACLIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeNetworkACLsResult is undocumented.
 type DescribeNetworkACLsResult struct {
-	NetworkACLs []NetworkACL `ec2:"NetworkAcls" xml:"networkAclSet>item"`
+	NetworkACLs []NetworkACL `ec2:"NetworkAclSet,list" xml:"networkAclSet>item"`
 }
 
 // DescribeNetworkInterfaceAttributeRequest is undocumented.
@@ -3083,11 +5976,28 @@ type DescribeNetworkInterfaceAttributeRequest struct {
 	NetworkInterfaceID aws.StringValue  `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeNetworkInterfaceAttributeRequest to the form, prefixing their names.
+func (v *DescribeNetworkInterfaceAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	return nil
+}
+
 // DescribeNetworkInterfaceAttributeResult is undocumented.
 type DescribeNetworkInterfaceAttributeResult struct {
 	Attachment         *NetworkInterfaceAttachment `ec2:"Attachment" xml:"attachment"`
 	Description        *AttributeValue             `ec2:"Description" xml:"description"`
-	Groups             []GroupIdentifier           `ec2:"Groups" xml:"groupSet>item"`
+	Groups             []GroupIdentifier           `ec2:"GroupSet,list" xml:"groupSet>item"`
 	NetworkInterfaceID aws.StringValue             `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 	SourceDestCheck    *AttributeBooleanValue      `ec2:"SourceDestCheck" xml:"sourceDestCheck"`
 }
@@ -3095,69 +6005,191 @@ type DescribeNetworkInterfaceAttributeResult struct {
 // DescribeNetworkInterfacesRequest is undocumented.
 type DescribeNetworkInterfacesRequest struct {
 	DryRun              aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters             []Filter         `ec2:"Filters" xml:"filter>Filter"`
-	NetworkInterfaceIDs []string         `ec2:"NetworkInterfaceId" xml:"NetworkInterfaceId>item"`
+	Filters             []Filter         `ec2:"Filter,list" xml:"filter>Filter"`
+	NetworkInterfaceIDs []string         `ec2:"NetworkInterfaceId,list" xml:"NetworkInterfaceId>item"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeNetworkInterfacesRequest to the form, prefixing their names.
+func (v *DescribeNetworkInterfacesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.NetworkInterfaceIDs != nil {
+		for i1 := range v.NetworkInterfaceIDs {
+			name1 := prefix + "NetworkInterfaceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.NetworkInterfaceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeNetworkInterfacesResult is undocumented.
 type DescribeNetworkInterfacesResult struct {
-	NetworkInterfaces []NetworkInterface `ec2:"NetworkInterfaces" xml:"networkInterfaceSet>item"`
+	NetworkInterfaces []NetworkInterface `ec2:"NetworkInterfaceSet,list" xml:"networkInterfaceSet>item"`
 }
 
 // DescribePlacementGroupsRequest is undocumented.
 type DescribePlacementGroupsRequest struct {
 	DryRun     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters    []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	GroupNames []string         `ec2:"GroupNames" xml:"groupName>member"`
+	Filters    []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	GroupNames []string         `ec2:"GroupName,list" xml:"groupName>member"`
+}
+
+// MarshalEC2 adds the parameters of the DescribePlacementGroupsRequest to the form, prefixing their names.
+func (v *DescribePlacementGroupsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.GroupNames != nil {
+		for i1 := range v.GroupNames {
+			name1 := prefix + "GroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.GroupNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribePlacementGroupsResult is undocumented.
 type DescribePlacementGroupsResult struct {
-	PlacementGroups []PlacementGroup `ec2:"PlacementGroups" xml:"placementGroupSet>item"`
+	PlacementGroups []PlacementGroup `ec2:"PlacementGroupSet,list" xml:"placementGroupSet>item"`
 }
 
 // DescribeRegionsRequest is undocumented.
 type DescribeRegionsRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters     []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	RegionNames []string         `ec2:"RegionName" xml:"RegionName>RegionName"`
+	Filters     []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	RegionNames []string         `ec2:"RegionName,list" xml:"RegionName>RegionName"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeRegionsRequest to the form, prefixing their names.
+func (v *DescribeRegionsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.RegionNames != nil {
+		for i1 := range v.RegionNames {
+			name1 := prefix + "RegionName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.RegionNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeRegionsResult is undocumented.
 type DescribeRegionsResult struct {
-	Regions []Region `ec2:"Regions" xml:"regionInfo>item"`
+	Regions []Region `ec2:"RegionInfo,list" xml:"regionInfo>item"`
 }
 
 // DescribeReservedInstancesListingsRequest is undocumented.
 type DescribeReservedInstancesListingsRequest struct {
-	Filters                    []Filter        `ec2:"Filters" xml:"filters>Filter"`
+	Filters                    []Filter        `ec2:"Filters,list" xml:"filters>Filter"`
 	ReservedInstancesID        aws.StringValue `ec2:"ReservedInstancesId" xml:"reservedInstancesId"`
 	ReservedInstancesListingID aws.StringValue `ec2:"ReservedInstancesListingId" xml:"reservedInstancesListingId"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeReservedInstancesListingsRequest to the form, prefixing their names.
+func (v *DescribeReservedInstancesListingsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ReservedInstancesID != nil {
+		form.Set(prefix+"ReservedInstancesId", *v.ReservedInstancesID)
+	}
+	if v.ReservedInstancesListingID != nil {
+		form.Set(prefix+"ReservedInstancesListingId", *v.ReservedInstancesListingID)
+	}
+	return nil
+}
+
 // DescribeReservedInstancesListingsResult is undocumented.
 type DescribeReservedInstancesListingsResult struct {
-	ReservedInstancesListings []ReservedInstancesListing `ec2:"ReservedInstancesListings" xml:"reservedInstancesListingsSet>item"`
+	ReservedInstancesListings []ReservedInstancesListing `ec2:"ReservedInstancesListingsSet,list" xml:"reservedInstancesListingsSet>item"`
 }
 
 // DescribeReservedInstancesModificationsRequest is undocumented.
 type DescribeReservedInstancesModificationsRequest struct {
-	Filters                          []Filter        `ec2:"Filter" xml:"Filter>Filter"`
+	Filters                          []Filter        `ec2:"Filter,list" xml:"Filter>Filter"`
 	NextToken                        aws.StringValue `ec2:"NextToken" xml:"nextToken"`
-	ReservedInstancesModificationIDs []string        `ec2:"ReservedInstancesModificationId" xml:"ReservedInstancesModificationId>ReservedInstancesModificationId"`
+	ReservedInstancesModificationIDs []string        `ec2:"ReservedInstancesModificationId,list" xml:"ReservedInstancesModificationId>ReservedInstancesModificationId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeReservedInstancesModificationsRequest to the form, prefixing their names.
+func (v *DescribeReservedInstancesModificationsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.ReservedInstancesModificationIDs != nil {
+		for i1 := range v.ReservedInstancesModificationIDs {
+			name1 := prefix + "ReservedInstancesModificationId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ReservedInstancesModificationIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeReservedInstancesModificationsResult is undocumented.
 type DescribeReservedInstancesModificationsResult struct {
 	NextToken                      aws.StringValue                 `ec2:"NextToken" xml:"nextToken"`
-	ReservedInstancesModifications []ReservedInstancesModification `ec2:"ReservedInstancesModifications" xml:"reservedInstancesModificationsSet>item"`
+	ReservedInstancesModifications []ReservedInstancesModification `ec2:"ReservedInstancesModificationsSet,list" xml:"reservedInstancesModificationsSet>item"`
 }
 
 // DescribeReservedInstancesOfferingsRequest is undocumented.
 type DescribeReservedInstancesOfferingsRequest struct {
 	AvailabilityZone             aws.StringValue  `ec2:"AvailabilityZone" xml:"AvailabilityZone"`
 	DryRun                       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters                      []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters                      []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
 	IncludeMarketplace           aws.BooleanValue `ec2:"IncludeMarketplace" xml:"IncludeMarketplace"`
 	InstanceTenancy              aws.StringValue  `ec2:"InstanceTenancy" xml:"instanceTenancy"`
 	InstanceType                 aws.StringValue  `ec2:"InstanceType" xml:"InstanceType"`
@@ -3168,51 +6200,193 @@ type DescribeReservedInstancesOfferingsRequest struct {
 	NextToken                    aws.StringValue  `ec2:"NextToken" xml:"nextToken"`
 	OfferingType                 aws.StringValue  `ec2:"OfferingType" xml:"offeringType"`
 	ProductDescription           aws.StringValue  `ec2:"ProductDescription" xml:"ProductDescription"`
-	ReservedInstancesOfferingIDs []string         `ec2:"ReservedInstancesOfferingId" xml:"ReservedInstancesOfferingId>member"`
+	ReservedInstancesOfferingIDs []string         `ec2:"ReservedInstancesOfferingId,list" xml:"ReservedInstancesOfferingId>member"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeReservedInstancesOfferingsRequest to the form, prefixing their names.
+func (v *DescribeReservedInstancesOfferingsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.IncludeMarketplace != nil {
+		form.Set(prefix+"IncludeMarketplace", strconv.FormatBool(*v.IncludeMarketplace))
+	}
+	if v.InstanceTenancy != nil {
+		form.Set(prefix+"InstanceTenancy", *v.InstanceTenancy)
+	}
+	if v.InstanceType != nil {
+		form.Set(prefix+"InstanceType", *v.InstanceType)
+	}
+	if v.MaxDuration != nil {
+		form.Set(prefix+"MaxDuration", strconv.FormatInt(int64(*v.MaxDuration), 10))
+	}
+	if v.MaxInstanceCount != nil {
+		form.Set(prefix+"MaxInstanceCount", strconv.FormatInt(int64(*v.MaxInstanceCount), 10))
+	}
+	if v.MaxResults != nil {
+		form.Set(prefix+"MaxResults", strconv.FormatInt(int64(*v.MaxResults), 10))
+	}
+	if v.MinDuration != nil {
+		form.Set(prefix+"MinDuration", strconv.FormatInt(int64(*v.MinDuration), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.OfferingType != nil {
+		form.Set(prefix+"OfferingType", *v.OfferingType)
+	}
+	if v.ProductDescription != nil {
+		form.Set(prefix+"ProductDescription", *v.ProductDescription)
+	}
+	if v.ReservedInstancesOfferingIDs != nil {
+		for i1 := range v.ReservedInstancesOfferingIDs {
+			name1 := prefix + "ReservedInstancesOfferingId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ReservedInstancesOfferingIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeReservedInstancesOfferingsResult is undocumented.
 type DescribeReservedInstancesOfferingsResult struct {
 	NextToken                  aws.StringValue             `ec2:"NextToken" xml:"nextToken"`
-	ReservedInstancesOfferings []ReservedInstancesOffering `ec2:"ReservedInstancesOfferings" xml:"reservedInstancesOfferingsSet>item"`
+	ReservedInstancesOfferings []ReservedInstancesOffering `ec2:"ReservedInstancesOfferingsSet,list" xml:"reservedInstancesOfferingsSet>item"`
 }
 
 // DescribeReservedInstancesRequest is undocumented.
 type DescribeReservedInstancesRequest struct {
 	DryRun               aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters              []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters              []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
 	OfferingType         aws.StringValue  `ec2:"OfferingType" xml:"offeringType"`
-	ReservedInstancesIDs []string         `ec2:"ReservedInstancesId" xml:"ReservedInstancesId>ReservedInstancesId"`
+	ReservedInstancesIDs []string         `ec2:"ReservedInstancesId,list" xml:"ReservedInstancesId>ReservedInstancesId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeReservedInstancesRequest to the form, prefixing their names.
+func (v *DescribeReservedInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.OfferingType != nil {
+		form.Set(prefix+"OfferingType", *v.OfferingType)
+	}
+	if v.ReservedInstancesIDs != nil {
+		for i1 := range v.ReservedInstancesIDs {
+			name1 := prefix + "ReservedInstancesId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ReservedInstancesIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeReservedInstancesResult is undocumented.
 type DescribeReservedInstancesResult struct {
-	ReservedInstances []ReservedInstances `ec2:"ReservedInstances" xml:"reservedInstancesSet>item"`
+	ReservedInstances []ReservedInstances `ec2:"ReservedInstancesSet,list" xml:"reservedInstancesSet>item"`
 }
 
 // DescribeRouteTablesRequest is undocumented.
 type DescribeRouteTablesRequest struct {
 	DryRun        aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters       []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	RouteTableIDs []string         `ec2:"RouteTableId" xml:"RouteTableId>item"`
+	Filters       []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	RouteTableIDs []string         `ec2:"RouteTableId,list" xml:"RouteTableId>item"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeRouteTablesRequest to the form, prefixing their names.
+func (v *DescribeRouteTablesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.RouteTableIDs != nil {
+		for i1 := range v.RouteTableIDs {
+			name1 := prefix + "RouteTableId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.RouteTableIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeRouteTablesResult is undocumented.
 type DescribeRouteTablesResult struct {
-	RouteTables []RouteTable `ec2:"RouteTables" xml:"routeTableSet>item"`
+	RouteTables []RouteTable `ec2:"RouteTableSet,list" xml:"routeTableSet>item"`
 }
 
 // DescribeSecurityGroupsRequest is undocumented.
 type DescribeSecurityGroupsRequest struct {
 	DryRun     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters    []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	GroupIDs   []string         `ec2:"GroupId" xml:"GroupId>groupId"`
-	GroupNames []string         `ec2:"GroupName" xml:"GroupName>GroupName"`
+	Filters    []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	GroupIDs   []string         `ec2:"GroupId,list" xml:"GroupId>groupId"`
+	GroupNames []string         `ec2:"GroupName,list" xml:"GroupName>GroupName"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeSecurityGroupsRequest to the form, prefixing their names.
+func (v *DescribeSecurityGroupsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.GroupIDs != nil {
+		for i1 := range v.GroupIDs {
+			name1 := prefix + "GroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.GroupIDs[i1])
+		}
+	}
+	if v.GroupNames != nil {
+		for i1 := range v.GroupNames {
+			name1 := prefix + "GroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.GroupNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeSecurityGroupsResult is undocumented.
 type DescribeSecurityGroupsResult struct {
-	SecurityGroups []SecurityGroup `ec2:"SecurityGroups" xml:"securityGroupInfo>item"`
+	SecurityGroups []SecurityGroup `ec2:"SecurityGroupInfo,list" xml:"securityGroupInfo>item"`
 }
 
 // DescribeSnapshotAttributeRequest is undocumented.
@@ -3222,25 +6396,79 @@ type DescribeSnapshotAttributeRequest struct {
 	SnapshotID aws.StringValue  `ec2:"SnapshotId" xml:"SnapshotId"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeSnapshotAttributeRequest to the form, prefixing their names.
+func (v *DescribeSnapshotAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.SnapshotID != nil {
+		form.Set(prefix+"SnapshotId", *v.SnapshotID)
+	}
+	return nil
+}
+
 // DescribeSnapshotAttributeResult is undocumented.
 type DescribeSnapshotAttributeResult struct {
-	CreateVolumePermissions []CreateVolumePermission `ec2:"CreateVolumePermissions" xml:"createVolumePermission>item"`
-	ProductCodes            []ProductCode            `ec2:"ProductCodes" xml:"productCodes>item"`
+	CreateVolumePermissions []CreateVolumePermission `ec2:"CreateVolumePermission,list" xml:"createVolumePermission>item"`
+	ProductCodes            []ProductCode            `ec2:"ProductCodes,list" xml:"productCodes>item"`
 	SnapshotID              aws.StringValue          `ec2:"SnapshotId" xml:"snapshotId"`
 }
 
 // DescribeSnapshotsRequest is undocumented.
 type DescribeSnapshotsRequest struct {
 	DryRun              aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters             []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	OwnerIDs            []string         `ec2:"Owner" xml:"Owner>Owner"`
-	RestorableByUserIDs []string         `ec2:"RestorableBy" xml:"RestorableBy>member"`
-	SnapshotIDs         []string         `ec2:"SnapshotId" xml:"SnapshotId>SnapshotId"`
+	Filters             []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	OwnerIDs            []string         `ec2:"Owner,list" xml:"Owner>Owner"`
+	RestorableByUserIDs []string         `ec2:"RestorableBy,list" xml:"RestorableBy>member"`
+	SnapshotIDs         []string         `ec2:"SnapshotId,list" xml:"SnapshotId>SnapshotId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeSnapshotsRequest to the form, prefixing their names.
+func (v *DescribeSnapshotsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.OwnerIDs != nil {
+		for i1 := range v.OwnerIDs {
+			name1 := prefix + "Owner." + strconv.Itoa(i1+1)
+			form.Set(name1, v.OwnerIDs[i1])
+		}
+	}
+	if v.RestorableByUserIDs != nil {
+		for i1 := range v.RestorableByUserIDs {
+			name1 := prefix + "RestorableBy." + strconv.Itoa(i1+1)
+			form.Set(name1, v.RestorableByUserIDs[i1])
+		}
+	}
+	if v.SnapshotIDs != nil {
+		for i1 := range v.SnapshotIDs {
+			name1 := prefix + "SnapshotId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SnapshotIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeSnapshotsResult is undocumented.
 type DescribeSnapshotsResult struct {
-	Snapshots []Snapshot `ec2:"Snapshots" xml:"snapshotSet>item"`
+	Snapshots []Snapshot `ec2:"SnapshotSet,list" xml:"snapshotSet>item"`
 }
 
 // DescribeSpotDatafeedSubscriptionRequest is undocumented.
@@ -3248,6 +6476,17 @@ type DescribeSpotDatafeedSubscriptionRequest struct {
 	DryRun aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeSpotDatafeedSubscriptionRequest to the form, prefixing their names.
+func (v *DescribeSpotDatafeedSubscriptionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // DescribeSpotDatafeedSubscriptionResult is undocumented.
 type DescribeSpotDatafeedSubscriptionResult struct {
 	SpotDatafeedSubscription *SpotDatafeedSubscription `ec2:"SpotDatafeedSubscription" xml:"spotDatafeedSubscription"`
@@ -3256,13 +6495,38 @@ type DescribeSpotDatafeedSubscriptionResult struct {
 // DescribeSpotInstanceRequestsRequest is undocumented.
 type DescribeSpotInstanceRequestsRequest struct {
 	DryRun                 aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters                []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	SpotInstanceRequestIDs []string         `ec2:"SpotInstanceRequestId" xml:"SpotInstanceRequestId>SpotInstanceRequestId"`
+	Filters                []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	SpotInstanceRequestIDs []string         `ec2:"SpotInstanceRequestId,list" xml:"SpotInstanceRequestId>SpotInstanceRequestId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeSpotInstanceRequestsRequest to the form, prefixing their names.
+func (v *DescribeSpotInstanceRequestsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SpotInstanceRequestIDs != nil {
+		for i1 := range v.SpotInstanceRequestIDs {
+			name1 := prefix + "SpotInstanceRequestId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SpotInstanceRequestIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeSpotInstanceRequestsResult is undocumented.
 type DescribeSpotInstanceRequestsResult struct {
-	SpotInstanceRequests []SpotInstanceRequest `ec2:"SpotInstanceRequests" xml:"spotInstanceRequestSet>item"`
+	SpotInstanceRequests []SpotInstanceRequest `ec2:"SpotInstanceRequestSet,list" xml:"spotInstanceRequestSet>item"`
 }
 
 // DescribeSpotPriceHistoryRequest is undocumented.
@@ -3270,44 +6534,140 @@ type DescribeSpotPriceHistoryRequest struct {
 	AvailabilityZone    aws.StringValue  `ec2:"AvailabilityZone" xml:"availabilityZone"`
 	DryRun              aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	EndTime             time.Time        `ec2:"EndTime" xml:"endTime"`
-	Filters             []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	InstanceTypes       []string         `ec2:"InstanceType" xml:"InstanceType>member"`
+	Filters             []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	InstanceTypes       []string         `ec2:"InstanceType,list" xml:"InstanceType>member"`
 	MaxResults          aws.IntegerValue `ec2:"MaxResults" xml:"maxResults"`
 	NextToken           aws.StringValue  `ec2:"NextToken" xml:"nextToken"`
-	ProductDescriptions []string         `ec2:"ProductDescription" xml:"ProductDescription>member"`
+	ProductDescriptions []string         `ec2:"ProductDescription,list" xml:"ProductDescription>member"`
 	StartTime           time.Time        `ec2:"StartTime" xml:"startTime"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeSpotPriceHistoryRequest to the form, prefixing their names.
+func (v *DescribeSpotPriceHistoryRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.InstanceTypes != nil {
+		for i1 := range v.InstanceTypes {
+			name1 := prefix + "InstanceType." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceTypes[i1])
+		}
+	}
+	if v.MaxResults != nil {
+		form.Set(prefix+"MaxResults", strconv.FormatInt(int64(*v.MaxResults), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.ProductDescriptions != nil {
+		for i1 := range v.ProductDescriptions {
+			name1 := prefix + "ProductDescription." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ProductDescriptions[i1])
+		}
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // DescribeSpotPriceHistoryResult is undocumented.
 type DescribeSpotPriceHistoryResult struct {
 	NextToken        aws.StringValue `ec2:"NextToken" xml:"nextToken"`
-	SpotPriceHistory []SpotPrice     `ec2:"SpotPriceHistory" xml:"spotPriceHistorySet>item"`
+	SpotPriceHistory []SpotPrice     `ec2:"SpotPriceHistorySet,list" xml:"spotPriceHistorySet>item"`
 }
 
 // DescribeSubnetsRequest is undocumented.
 type DescribeSubnetsRequest struct {
 	DryRun    aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters   []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	SubnetIDs []string         `ec2:"SubnetId" xml:"SubnetId>SubnetId"`
+	Filters   []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	SubnetIDs []string         `ec2:"SubnetId,list" xml:"SubnetId>SubnetId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeSubnetsRequest to the form, prefixing their names.
+func (v *DescribeSubnetsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SubnetIDs != nil {
+		for i1 := range v.SubnetIDs {
+			name1 := prefix + "SubnetId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SubnetIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeSubnetsResult is undocumented.
 type DescribeSubnetsResult struct {
-	Subnets []Subnet `ec2:"Subnets" xml:"subnetSet>item"`
+	Subnets []Subnet `ec2:"SubnetSet,list" xml:"subnetSet>item"`
 }
 
 // DescribeTagsRequest is undocumented.
 type DescribeTagsRequest struct {
 	DryRun     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters    []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters    []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
 	MaxResults aws.IntegerValue `ec2:"MaxResults" xml:"maxResults"`
 	NextToken  aws.StringValue  `ec2:"NextToken" xml:"nextToken"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeTagsRequest to the form, prefixing their names.
+func (v *DescribeTagsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MaxResults != nil {
+		form.Set(prefix+"MaxResults", strconv.FormatInt(int64(*v.MaxResults), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // DescribeTagsResult is undocumented.
 type DescribeTagsResult struct {
 	NextToken aws.StringValue  `ec2:"NextToken" xml:"nextToken"`
-	Tags      []TagDescription `ec2:"Tags" xml:"tagSet>item"`
+	Tags      []TagDescription `ec2:"TagSet,list" xml:"tagSet>item"`
 }
 
 // DescribeVolumeAttributeRequest is undocumented.
@@ -3317,41 +6677,120 @@ type DescribeVolumeAttributeRequest struct {
 	VolumeID  aws.StringValue  `ec2:"VolumeId" xml:"VolumeId"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeVolumeAttributeRequest to the form, prefixing their names.
+func (v *DescribeVolumeAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // DescribeVolumeAttributeResult is undocumented.
 type DescribeVolumeAttributeResult struct {
 	AutoEnableIO *AttributeBooleanValue `ec2:"AutoEnableIO" xml:"autoEnableIO"`
-	ProductCodes []ProductCode          `ec2:"ProductCodes" xml:"productCodes>item"`
+	ProductCodes []ProductCode          `ec2:"ProductCodes,list" xml:"productCodes>item"`
 	VolumeID     aws.StringValue        `ec2:"VolumeId" xml:"volumeId"`
 }
 
 // DescribeVolumeStatusRequest is undocumented.
 type DescribeVolumeStatusRequest struct {
 	DryRun     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters    []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters    []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
 	MaxResults aws.IntegerValue `ec2:"MaxResults" xml:"MaxResults"`
 	NextToken  aws.StringValue  `ec2:"NextToken" xml:"NextToken"`
-	VolumeIDs  []string         `ec2:"VolumeId" xml:"VolumeId>VolumeId"`
+	VolumeIDs  []string         `ec2:"VolumeId,list" xml:"VolumeId>VolumeId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeVolumeStatusRequest to the form, prefixing their names.
+func (v *DescribeVolumeStatusRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MaxResults != nil {
+		form.Set(prefix+"MaxResults", strconv.FormatInt(int64(*v.MaxResults), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.VolumeIDs != nil {
+		for i1 := range v.VolumeIDs {
+			name1 := prefix + "VolumeId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VolumeIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeVolumeStatusResult is undocumented.
 type DescribeVolumeStatusResult struct {
 	NextToken      aws.StringValue    `ec2:"NextToken" xml:"nextToken"`
-	VolumeStatuses []VolumeStatusItem `ec2:"VolumeStatuses" xml:"volumeStatusSet>item"`
+	VolumeStatuses []VolumeStatusItem `ec2:"VolumeStatusSet,list" xml:"volumeStatusSet>item"`
 }
 
 // DescribeVolumesRequest is undocumented.
 type DescribeVolumesRequest struct {
 	DryRun     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters    []Filter         `ec2:"Filter" xml:"Filter>Filter"`
+	Filters    []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
 	MaxResults aws.IntegerValue `ec2:"MaxResults" xml:"maxResults"`
 	NextToken  aws.StringValue  `ec2:"NextToken" xml:"nextToken"`
-	VolumeIDs  []string         `ec2:"VolumeId" xml:"VolumeId>VolumeId"`
+	VolumeIDs  []string         `ec2:"VolumeId,list" xml:"VolumeId>VolumeId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeVolumesRequest to the form, prefixing their names.
+func (v *DescribeVolumesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MaxResults != nil {
+		form.Set(prefix+"MaxResults", strconv.FormatInt(int64(*v.MaxResults), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.VolumeIDs != nil {
+		for i1 := range v.VolumeIDs {
+			name1 := prefix + "VolumeId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VolumeIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeVolumesResult is undocumented.
 type DescribeVolumesResult struct {
 	NextToken aws.StringValue `ec2:"NextToken" xml:"nextToken"`
-	Volumes   []Volume        `ec2:"Volumes" xml:"volumeSet>item"`
+	Volumes   []Volume        `ec2:"VolumeSet,list" xml:"volumeSet>item"`
 }
 
 // DescribeVPCAttributeRequest is undocumented.
@@ -3361,6 +6800,23 @@ type DescribeVPCAttributeRequest struct {
 	VPCID     aws.StringValue  `ec2:"VpcId" xml:"VpcId"`
 }
 
+// MarshalEC2 adds the parameters of the DescribeVPCAttributeRequest to the form, prefixing their names.
+func (v *DescribeVPCAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // DescribeVPCAttributeResult is undocumented.
 type DescribeVPCAttributeResult struct {
 	EnableDNSHostnames *AttributeBooleanValue `ec2:"EnableDnsHostnames" xml:"enableDnsHostnames"`
@@ -3371,49 +6827,149 @@ type DescribeVPCAttributeResult struct {
 // DescribeVPCPeeringConnectionsRequest is undocumented.
 type DescribeVPCPeeringConnectionsRequest struct {
 	DryRun                  aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters                 []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	VPCPeeringConnectionIDs []string         `ec2:"VpcPeeringConnectionId" xml:"VpcPeeringConnectionId>item"`
+	Filters                 []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	VPCPeeringConnectionIDs []string         `ec2:"VpcPeeringConnectionId,list" xml:"VpcPeeringConnectionId>item"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeVPCPeeringConnectionsRequest to the form, prefixing their names.
+func (v *DescribeVPCPeeringConnectionsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.VPCPeeringConnectionIDs != nil {
+		for i1 := range v.VPCPeeringConnectionIDs {
+			name1 := prefix + "VpcPeeringConnectionId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCPeeringConnectionIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeVPCPeeringConnectionsResult is undocumented.
 type DescribeVPCPeeringConnectionsResult struct {
-	VPCPeeringConnections []VPCPeeringConnection `ec2:"VpcPeeringConnections" xml:"vpcPeeringConnectionSet>item"`
+	VPCPeeringConnections []VPCPeeringConnection `ec2:"VpcPeeringConnectionSet,list" xml:"vpcPeeringConnectionSet>item"`
 }
 
 // DescribeVPCsRequest is undocumented.
 type DescribeVPCsRequest struct {
 	DryRun  aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	VPCIDs  []string         `ec2:"VpcId" xml:"VpcId>VpcId"`
+	Filters []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	VPCIDs  []string         `ec2:"VpcId,list" xml:"VpcId>VpcId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeVPCsRequest to the form, prefixing their names.
+func (v *DescribeVPCsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.VPCIDs != nil {
+		for i1 := range v.VPCIDs {
+			name1 := prefix + "VpcId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeVPCsResult is undocumented.
 type DescribeVPCsResult struct {
-	VPCs []VPC `ec2:"Vpcs" xml:"vpcSet>item"`
+	VPCs []VPC `ec2:"VpcSet,list" xml:"vpcSet>item"`
 }
 
 // DescribeVPNConnectionsRequest is undocumented.
 type DescribeVPNConnectionsRequest struct {
 	DryRun           aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters          []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	VPNConnectionIDs []string         `ec2:"VpnConnectionId" xml:"VpnConnectionId>VpnConnectionId"`
+	Filters          []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	VPNConnectionIDs []string         `ec2:"VpnConnectionId,list" xml:"VpnConnectionId>VpnConnectionId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeVPNConnectionsRequest to the form, prefixing their names.
+func (v *DescribeVPNConnectionsRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.VPNConnectionIDs != nil {
+		for i1 := range v.VPNConnectionIDs {
+			name1 := prefix + "VpnConnectionId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPNConnectionIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeVPNConnectionsResult is undocumented.
 type DescribeVPNConnectionsResult struct {
-	VPNConnections []VPNConnection `ec2:"VpnConnections" xml:"vpnConnectionSet>item"`
+	VPNConnections []VPNConnection `ec2:"VpnConnectionSet,list" xml:"vpnConnectionSet>item"`
 }
 
 // DescribeVPNGatewaysRequest is undocumented.
 type DescribeVPNGatewaysRequest struct {
 	DryRun        aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	Filters       []Filter         `ec2:"Filter" xml:"Filter>Filter"`
-	VPNGatewayIDs []string         `ec2:"VpnGatewayId" xml:"VpnGatewayId>VpnGatewayId"`
+	Filters       []Filter         `ec2:"Filter,list" xml:"Filter>Filter"`
+	VPNGatewayIDs []string         `ec2:"VpnGatewayId,list" xml:"VpnGatewayId>VpnGatewayId"`
+}
+
+// MarshalEC2 adds the parameters of the DescribeVPNGatewaysRequest to the form, prefixing their names.
+func (v *DescribeVPNGatewaysRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Filters != nil {
+		for i1 := range v.Filters {
+			name1 := prefix + "Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.VPNGatewayIDs != nil {
+		for i1 := range v.VPNGatewayIDs {
+			name1 := prefix + "VpnGatewayId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPNGatewayIDs[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeVPNGatewaysResult is undocumented.
 type DescribeVPNGatewaysResult struct {
-	VPNGateways []VPNGateway `ec2:"VpnGateways" xml:"vpnGatewaySet>item"`
+	VPNGateways []VPNGateway `ec2:"VpnGatewaySet,list" xml:"vpnGatewaySet>item"`
 }
 
 // DetachInternetGatewayRequest is undocumented.
@@ -3423,6 +6979,23 @@ type DetachInternetGatewayRequest struct {
 	VPCID             aws.StringValue  `ec2:"VpcId" xml:"vpcId"`
 }
 
+// MarshalEC2 adds the parameters of the DetachInternetGatewayRequest to the form, prefixing their names.
+func (v *DetachInternetGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InternetGatewayID != nil {
+		form.Set(prefix+"InternetGatewayId", *v.InternetGatewayID)
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // DetachNetworkInterfaceRequest is undocumented.
 type DetachNetworkInterfaceRequest struct {
 	AttachmentID aws.StringValue  `ec2:"AttachmentId" xml:"attachmentId"`
@@ -3430,6 +7003,23 @@ type DetachNetworkInterfaceRequest struct {
 	Force        aws.BooleanValue `ec2:"Force" xml:"force"`
 }
 
+// MarshalEC2 adds the parameters of the DetachNetworkInterfaceRequest to the form, prefixing their names.
+func (v *DetachNetworkInterfaceRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttachmentID != nil {
+		form.Set(prefix+"AttachmentId", *v.AttachmentID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Force != nil {
+		form.Set(prefix+"Force", strconv.FormatBool(*v.Force))
+	}
+	return nil
+}
+
 // DetachVolumeRequest is undocumented.
 type DetachVolumeRequest struct {
 	Device     aws.StringValue  `ec2:"Device" xml:"Device"`
@@ -3439,6 +7029,29 @@ type DetachVolumeRequest struct {
 	VolumeID   aws.StringValue  `ec2:"VolumeId" xml:"VolumeId"`
 }
 
+// MarshalEC2 adds the parameters of the DetachVolumeRequest to the form, prefixing their names.
+func (v *DetachVolumeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Device != nil {
+		form.Set(prefix+"Device", *v.Device)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Force != nil {
+		form.Set(prefix+"Force", strconv.FormatBool(*v.Force))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // DetachVPNGatewayRequest is undocumented.
 type DetachVPNGatewayRequest struct {
 	DryRun       aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -3446,6 +7059,23 @@ type DetachVPNGatewayRequest struct {
 	VPNGatewayID aws.StringValue  `ec2:"VpnGatewayId" xml:"VpnGatewayId"`
 }
 
+// MarshalEC2 adds the parameters of the DetachVPNGatewayRequest to the form, prefixing their names.
+func (v *DetachVPNGatewayRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	if v.VPNGatewayID != nil {
+		form.Set(prefix+"VpnGatewayId", *v.VPNGatewayID)
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	DeviceTypeEBS           = "ebs"
@@ -3455,14 +7085,14 @@ const (
 // DHCPConfiguration is undocumented.
 type DHCPConfiguration struct {
 	Key    aws.StringValue  `ec2:"Key" xml:"key"`
-	Values []AttributeValue `ec2:"Values" xml:"valueSet>item"`
+	Values []AttributeValue `ec2:"ValueSet,list" xml:"valueSet>item"`
 }
 
 // DHCPOptions is undocumented.
 type DHCPOptions struct {
-	DHCPConfigurations []DHCPConfiguration `ec2:"DhcpConfigurations" xml:"dhcpConfigurationSet>item"`
+	DHCPConfigurations []DHCPConfiguration `ec2:"DhcpConfigurationSet,list" xml:"dhcpConfigurationSet>item"`
 	DHCPOptionsID      aws.StringValue     `ec2:"DhcpOptionsId" xml:"dhcpOptionsId"`
-	Tags               []Tag               `ec2:"Tags" xml:"tagSet>item"`
+	Tags               []Tag               `ec2:"TagSet,list" xml:"tagSet>item"`
 }
 
 // DisableVGWRoutePropagationRequest is undocumented.
@@ -3471,6 +7101,20 @@ type DisableVGWRoutePropagationRequest struct {
 	RouteTableID aws.StringValue `ec2:"RouteTableId" xml:"RouteTableId"`
 }
 
+// MarshalEC2 adds the parameters of the DisableVGWRoutePropagationRequest to the form, prefixing their names.
+func (v *DisableVGWRoutePropagationRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GatewayID != nil {
+		form.Set(prefix+"GatewayId", *v.GatewayID)
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	return nil
+}
+
 // DisassociateAddressRequest is undocumented.
 type DisassociateAddressRequest struct {
 	AssociationID aws.StringValue  `ec2:"AssociationId" xml:"AssociationId"`
@@ -3478,12 +7122,43 @@ type DisassociateAddressRequest struct {
 	PublicIP      aws.StringValue  `ec2:"PublicIp" xml:"PublicIp"`
 }
 
+// MarshalEC2 adds the parameters of the DisassociateAddressRequest to the form, prefixing their names.
+func (v *DisassociateAddressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssociationID != nil {
+		form.Set(prefix+"AssociationId", *v.AssociationID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.PublicIP != nil {
+		form.Set(prefix+"PublicIp", *v.PublicIP)
+	}
+	return nil
+}
+
 // DisassociateRouteTableRequest is undocumented.
 type DisassociateRouteTableRequest struct {
 	AssociationID aws.StringValue  `ec2:"AssociationId" xml:"associationId"`
 	DryRun        aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 }
 
+// MarshalEC2 adds the parameters of the DisassociateRouteTableRequest to the form, prefixing their names.
+func (v *DisassociateRouteTableRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssociationID != nil {
+		form.Set(prefix+"AssociationId", *v.AssociationID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	return nil
+}
+
 // DiskImage is undocumented.
 type DiskImage struct {
 	Description aws.StringValue  `ec2:"Description" xml:"Description"`
@@ -3491,6 +7166,27 @@ type DiskImage struct {
 	Volume      *VolumeDetail    `ec2:"Volume" xml:"Volume"`
 }
 
+// MarshalEC2 adds the parameters of the DiskImage to the form, prefixing their names.
+func (v *DiskImage) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.Image != nil {
+		if err := v.Image.MarshalEC2(form, prefix+"Image."); err != nil {
+			return err
+		}
+	}
+	if v.Volume != nil {
+		if err := v.Volume.MarshalEC2(form, prefix+"Volume."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DiskImageDescription is undocumented.
 type DiskImageDescription struct {
 	Checksum          aws.StringValue `ec2:"Checksum" xml:"checksum"`
@@ -3506,6 +7202,23 @@ type DiskImageDetail struct {
 	ImportManifestURL aws.StringValue `ec2:"ImportManifestUrl" xml:"importManifestUrl"`
 }
 
+// MarshalEC2 adds the parameters of the DiskImageDetail to the form, prefixing their names.
+func (v *DiskImageDetail) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Bytes != nil {
+		form.Set(prefix+"Bytes", strconv.FormatInt(int64(*v.Bytes), 10))
+	}
+	if v.Format != nil {
+		form.Set(prefix+"Format", *v.Format)
+	}
+	if v.ImportManifestURL != nil {
+		form.Set(prefix+"ImportManifestUrl", *v.ImportManifestURL)
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	DiskImageFormatRaw  = "RAW"
@@ -3535,6 +7248,32 @@ type EBSBlockDevice struct {
 	VolumeType          aws.StringValue  `ec2:"VolumeType" xml:"volumeType"`
 }
 
+// MarshalEC2 adds the parameters of the EBSBlockDevice to the form, prefixing their names.
+func (v *EBSBlockDevice) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DeleteOnTermination != nil {
+		form.Set(prefix+"DeleteOnTermination", strconv.FormatBool(*v.DeleteOnTermination))
+	}
+	if v.Encrypted != nil {
+		form.Set(prefix+"Encrypted", strconv.FormatBool(*v.Encrypted))
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.SnapshotID != nil {
+		form.Set(prefix+"SnapshotId", *v.SnapshotID)
+	}
+	if v.VolumeSize != nil {
+		form.Set(prefix+"VolumeSize", strconv.FormatInt(int64(*v.VolumeSize), 10))
+	}
+	if v.VolumeType != nil {
+		form.Set(prefix+"VolumeType", *v.VolumeType)
+	}
+	return nil
+}
+
 // EBSInstanceBlockDevice is undocumented.
 type EBSInstanceBlockDevice struct {
 	AttachTime          time.Time        `ec2:"AttachTime" xml:"attachTime"`
@@ -3549,18 +7288,60 @@ type EBSInstanceBlockDeviceSpecification struct {
 	VolumeID            aws.StringValue  `ec2:"VolumeId" xml:"volumeId"`
 }
 
+// MarshalEC2 adds the parameters of the EBSInstanceBlockDeviceSpecification to the form, prefixing their names.
+func (v *EBSInstanceBlockDeviceSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DeleteOnTermination != nil {
+		form.Set(prefix+"DeleteOnTermination", strconv.FormatBool(*v.DeleteOnTermination))
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // EnableVGWRoutePropagationRequest is undocumented.
 type EnableVGWRoutePropagationRequest struct {
 	GatewayID    aws.StringValue `ec2:"GatewayId" xml:"GatewayId"`
 	RouteTableID aws.StringValue `ec2:"RouteTableId" xml:"RouteTableId"`
 }
 
+// MarshalEC2 adds the parameters of the EnableVGWRoutePropagationRequest to the form, prefixing their names.
+func (v *EnableVGWRoutePropagationRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GatewayID != nil {
+		form.Set(prefix+"GatewayId", *v.GatewayID)
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	return nil
+}
+
 // EnableVolumeIORequest is undocumented.
 type EnableVolumeIORequest struct {
 	DryRun   aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	VolumeID aws.StringValue  `ec2:"VolumeId" xml:"volumeId"`
 }
 
+// MarshalEC2 adds the parameters of the EnableVolumeIORequest to the form, prefixing their names.
+func (v *EnableVolumeIORequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	EventCodeInstanceReboot     = "instance-reboot"
@@ -3581,8 +7362,8 @@ const (
 type ExportTask struct {
 	Description           aws.StringValue        `ec2:"Description" xml:"description"`
 	ExportTaskID          aws.StringValue        `ec2:"ExportTaskId" xml:"exportTaskId"`
-	ExportToS3Task        *ExportToS3Task        `ec2:"ExportToS3Task" xml:"exportToS3"`
-	InstanceExportDetails *InstanceExportDetails `ec2:"InstanceExportDetails" xml:"instanceExport"`
+	ExportToS3Task        *ExportToS3Task        `ec2:"ExportToS3" xml:"exportToS3"`
+	InstanceExportDetails *InstanceExportDetails `ec2:"InstanceExport" xml:"instanceExport"`
 	State                 aws.StringValue        `ec2:"State" xml:"state"`
 	StatusMessage         aws.StringValue        `ec2:"StatusMessage" xml:"statusMessage"`
 }
@@ -3611,10 +7392,47 @@ type ExportToS3TaskSpecification struct {
 	S3Prefix        aws.StringValue `ec2:"S3Prefix" xml:"s3Prefix"`
 }
 
+// MarshalEC2 adds the parameters of the ExportToS3TaskSpecification to the form, prefixing their names.
+func (v *ExportToS3TaskSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ContainerFormat != nil {
+		form.Set(prefix+"ContainerFormat", *v.ContainerFormat)
+	}
+	if v.DiskImageFormat != nil {
+		form.Set(prefix+"DiskImageFormat", *v.DiskImageFormat)
+	}
+	if v.S3Bucket != nil {
+		form.Set(prefix+"S3Bucket", *v.S3Bucket)
+	}
+	if v.S3Prefix != nil {
+		form.Set(prefix+"S3Prefix", *v.S3Prefix)
+	}
+	return nil
+}
+
 // Filter is undocumented.
 type Filter struct {
 	Name   aws.StringValue `ec2:"Name" xml:"Name"`
-	Values []string        `ec2:"Value" xml:"Value>item"`
+	Values []string        `ec2:"Value,list" xml:"Value>item"`
+}
+
+// MarshalEC2 adds the parameters of the Filter to the form, prefixing their names.
+func (v *Filter) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Values != nil {
+		for i1 := range v.Values {
+			name1 := prefix + "Value." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Values[i1])
+		}
+	}
+	return nil
 }
 
 // Possible values for EC2.
@@ -3628,6 +7446,20 @@ type GetConsoleOutputRequest struct {
 	InstanceID aws.StringValue  `ec2:"InstanceId" xml:"InstanceId"`
 }
 
+// MarshalEC2 adds the parameters of the GetConsoleOutputRequest to the form, prefixing their names.
+func (v *GetConsoleOutputRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	return nil
+}
+
 // GetConsoleOutputResult is undocumented.
 type GetConsoleOutputResult struct {
 	InstanceID aws.StringValue `ec2:"InstanceId" xml:"instanceId"`
@@ -3641,6 +7473,20 @@ type GetPasswordDataRequest struct {
 	InstanceID aws.StringValue  `ec2:"InstanceId" xml:"InstanceId"`
 }
 
+// MarshalEC2 adds the parameters of the GetPasswordDataRequest to the form, prefixing their names.
+func (v *GetPasswordDataRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	return nil
+}
+
 // GetPasswordDataResult is undocumented.
 type GetPasswordDataResult struct {
 	InstanceID   aws.StringValue `ec2:"InstanceId" xml:"instanceId"`
@@ -3672,16 +7518,44 @@ type IAMInstanceProfileSpecification struct {
 	Name aws.StringValue `ec2:"Name" xml:"name"`
 }
 
+// MarshalEC2 adds the parameters of the IAMInstanceProfileSpecification to the form, prefixing their names.
+func (v *IAMInstanceProfileSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ARN != nil {
+		form.Set(prefix+"Arn", *v.ARN)
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	return nil
+}
+
 // ICMPTypeCode is undocumented.
 type ICMPTypeCode struct {
 	Code aws.IntegerValue `ec2:"Code" xml:"code"`
 	Type aws.IntegerValue `ec2:"Type" xml:"type"`
 }
 
+// MarshalEC2 adds the parameters of the ICMPTypeCode to the form, prefixing their names.
+func (v *ICMPTypeCode) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Code != nil {
+		form.Set(prefix+"Code", strconv.FormatInt(int64(*v.Code), 10))
+	}
+	if v.Type != nil {
+		form.Set(prefix+"Type", strconv.FormatInt(int64(*v.Type), 10))
+	}
+	return nil
+}
+
 // Image is undocumented.
 type Image struct {
 	Architecture        aws.StringValue      `ec2:"Architecture" xml:"architecture"`
-	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>item"`
+	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>item"`
 	Description         aws.StringValue      `ec2:"Description" xml:"description"`
 	Hypervisor          aws.StringValue      `ec2:"Hypervisor" xml:"hypervisor"`
 	ImageID             aws.StringValue      `ec2:"ImageId" xml:"imageId"`
@@ -3690,29 +7564,29 @@ type Image struct {
 	ImageType           aws.StringValue      `ec2:"ImageType" xml:"imageType"`
 	KernelID            aws.StringValue      `ec2:"KernelId" xml:"kernelId"`
 	Name                aws.StringValue      `ec2:"Name" xml:"name"`
-	OwnerID             aws.StringValue      `ec2:"OwnerId" xml:"imageOwnerId"`
+	OwnerID             aws.StringValue      `ec2:"ImageOwnerId" xml:"imageOwnerId"`
 	Platform            aws.StringValue      `ec2:"Platform" xml:"platform"`
-	ProductCodes        []ProductCode        `ec2:"ProductCodes" xml:"productCodes>item"`
-	Public              aws.BooleanValue     `ec2:"Public" xml:"isPublic"`
+	ProductCodes        []ProductCode        `ec2:"ProductCodes,list" xml:"productCodes>item"`
+	Public              aws.BooleanValue     `ec2:"IsPublic" xml:"isPublic"`
 	RAMDiskID           aws.StringValue      `ec2:"RamdiskId" xml:"ramdiskId"`
 	RootDeviceName      aws.StringValue      `ec2:"RootDeviceName" xml:"rootDeviceName"`
 	RootDeviceType      aws.StringValue      `ec2:"RootDeviceType" xml:"rootDeviceType"`
 	SRIOVNetSupport     aws.StringValue      `ec2:"SriovNetSupport" xml:"sriovNetSupport"`
-	State               aws.StringValue      `ec2:"State" xml:"imageState"`
+	State               aws.StringValue      `ec2:"ImageState" xml:"imageState"`
 	StateReason         *StateReason         `ec2:"StateReason" xml:"stateReason"`
-	Tags                []Tag                `ec2:"Tags" xml:"tagSet>item"`
+	Tags                []Tag                `ec2:"TagSet,list" xml:"tagSet>item"`
 	VirtualizationType  aws.StringValue      `ec2:"VirtualizationType" xml:"virtualizationType"`
 }
 
 // ImageAttribute is undocumented.
 type ImageAttribute struct {
-	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>item"`
+	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>item"`
 	Description         *AttributeValue      `ec2:"Description" xml:"description"`
 	ImageID             aws.StringValue      `ec2:"ImageId" xml:"imageId"`
-	KernelID            *AttributeValue      `ec2:"KernelId" xml:"kernel"`
-	LaunchPermissions   []LaunchPermission   `ec2:"LaunchPermissions" xml:"launchPermission>item"`
-	ProductCodes        []ProductCode        `ec2:"ProductCodes" xml:"productCodes>item"`
-	RAMDiskID           *AttributeValue      `ec2:"RamdiskId" xml:"ramdisk"`
+	KernelID            *AttributeValue      `ec2:"Kernel" xml:"kernel"`
+	LaunchPermissions   []LaunchPermission   `ec2:"LaunchPermission,list" xml:"launchPermission>item"`
+	ProductCodes        []ProductCode        `ec2:"ProductCodes,list" xml:"productCodes>item"`
+	RAMDiskID           *AttributeValue      `ec2:"Ramdisk" xml:"ramdisk"`
 	SRIOVNetSupport     *AttributeValue      `ec2:"SriovNetSupport" xml:"sriovNetSupport"`
 }
 
@@ -3743,8 +7617,8 @@ const (
 type ImportInstanceLaunchSpecification struct {
 	AdditionalInfo                    aws.StringValue  `ec2:"AdditionalInfo" xml:"additionalInfo"`
 	Architecture                      aws.StringValue  `ec2:"Architecture" xml:"architecture"`
-	GroupIDs                          []string         `ec2:"GroupId" xml:"GroupId>SecurityGroupId"`
-	GroupNames                        []string         `ec2:"GroupName" xml:"GroupName>SecurityGroup"`
+	GroupIDs                          []string         `ec2:"GroupId,list" xml:"GroupId>SecurityGroupId"`
+	GroupNames                        []string         `ec2:"GroupName,list" xml:"GroupName>SecurityGroup"`
 	InstanceInitiatedShutdownBehavior aws.StringValue  `ec2:"InstanceInitiatedShutdownBehavior" xml:"instanceInitiatedShutdownBehavior"`
 	InstanceType                      aws.StringValue  `ec2:"InstanceType" xml:"instanceType"`
 	Monitoring                        aws.BooleanValue `ec2:"Monitoring" xml:"monitoring"`
@@ -3754,15 +7628,94 @@ type ImportInstanceLaunchSpecification struct {
 	UserData                          aws.StringValue  `ec2:"UserData" xml:"userData"`
 }
 
+// MarshalEC2 adds the parameters of the ImportInstanceLaunchSpecification to the form, prefixing their names.
+func (v *ImportInstanceLaunchSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AdditionalInfo != nil {
+		form.Set(prefix+"AdditionalInfo", *v.AdditionalInfo)
+	}
+	if v.Architecture != nil {
+		form.Set(prefix+"Architecture", *v.Architecture)
+	}
+	if v.GroupIDs != nil {
+		for i1 := range v.GroupIDs {
+			name1 := prefix + "GroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.GroupIDs[i1])
+		}
+	}
+	if v.GroupNames != nil {
+		for i1 := range v.GroupNames {
+			name1 := prefix + "GroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.GroupNames[i1])
+		}
+	}
+	if v.InstanceInitiatedShutdownBehavior != nil {
+		form.Set(prefix+"InstanceInitiatedShutdownBehavior", *v.InstanceInitiatedShutdownBehavior)
+	}
+	if v.InstanceType != nil {
+		form.Set(prefix+"InstanceType", *v.InstanceType)
+	}
+	if v.Monitoring != nil {
+		form.Set(prefix+"Monitoring", strconv.FormatBool(*v.Monitoring))
+	}
+	if v.Placement != nil {
+		if err := v.Placement.MarshalEC2(form, prefix+"Placement."); err != nil {
+			return err
+		}
+	}
+	if v.PrivateIPAddress != nil {
+		form.Set(prefix+"PrivateIpAddress", *v.PrivateIPAddress)
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	if v.UserData != nil {
+		form.Set(prefix+"UserData", *v.UserData)
+	}
+	return nil
+}
+
 // ImportInstanceRequest is undocumented.
 type ImportInstanceRequest struct {
 	Description         aws.StringValue                    `ec2:"Description" xml:"description"`
-	DiskImages          []DiskImage                        `ec2:"DiskImages" xml:"diskImage>member"`
+	DiskImages          []DiskImage                        `ec2:"DiskImage,list" xml:"diskImage>member"`
 	DryRun              aws.BooleanValue                   `ec2:"DryRun" xml:"dryRun"`
 	LaunchSpecification *ImportInstanceLaunchSpecification `ec2:"LaunchSpecification" xml:"launchSpecification"`
 	Platform            aws.StringValue                    `ec2:"Platform" xml:"platform"`
 }
 
+// MarshalEC2 adds the parameters of the ImportInstanceRequest to the form, prefixing their names.
+func (v *ImportInstanceRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DiskImages != nil {
+		for i1 := range v.DiskImages {
+			name1 := prefix + "DiskImage." + strconv.Itoa(i1+1)
+			if err := v.DiskImages[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.LaunchSpecification != nil {
+		if err := v.LaunchSpecification.MarshalEC2(form, prefix+"LaunchSpecification."); err != nil {
+			return err
+		}
+	}
+	if v.Platform != nil {
+		form.Set(prefix+"Platform", *v.Platform)
+	}
+	return nil
+}
+
 // ImportInstanceResult is undocumented.
 type ImportInstanceResult struct {
 	ConversionTask *ConversionTask `ec2:"ConversionTask" xml:"conversionTask"`
@@ -3773,7 +7726,7 @@ type ImportInstanceTaskDetails struct {
 	Description aws.StringValue                  `ec2:"Description" xml:"description"`
 	InstanceID  aws.StringValue                  `ec2:"InstanceId" xml:"instanceId"`
 	Platform    aws.StringValue                  `ec2:"Platform" xml:"platform"`
-	Volumes     []ImportInstanceVolumeDetailItem `ec2:"Volumes" xml:"volumes>item"`
+	Volumes     []ImportInstanceVolumeDetailItem `ec2:"Volumes,list" xml:"volumes>item"`
 }
 
 // ImportInstanceVolumeDetailItem is undocumented.
@@ -3794,6 +7747,23 @@ type ImportKeyPairRequest struct {
 	PublicKeyMaterial []byte           `ec2:"PublicKeyMaterial" xml:"publicKeyMaterial"`
 }
 
+// MarshalEC2 adds the parameters of the ImportKeyPairRequest to the form, prefixing their names.
+func (v *ImportKeyPairRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.KeyName != nil {
+		form.Set(prefix+"KeyName", *v.KeyName)
+	}
+	if v.PublicKeyMaterial != nil {
+		form.Set(prefix+"PublicKeyMaterial", base64.StdEncoding.EncodeToString(v.PublicKeyMaterial))
+	}
+	return nil
+}
+
 // ImportKeyPairResult is undocumented.
 type ImportKeyPairResult struct {
 	KeyFingerprint aws.StringValue `ec2:"KeyFingerprint" xml:"keyFingerprint"`
@@ -3809,6 +7779,33 @@ type ImportVolumeRequest struct {
 	Volume           *VolumeDetail    `ec2:"Volume" xml:"volume"`
 }
 
+// MarshalEC2 adds the parameters of the ImportVolumeRequest to the form, prefixing their names.
+func (v *ImportVolumeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Image != nil {
+		if err := v.Image.MarshalEC2(form, prefix+"Image."); err != nil {
+			return err
+		}
+	}
+	if v.Volume != nil {
+		if err := v.Volume.MarshalEC2(form, prefix+"Volume."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ImportVolumeResult is undocumented.
 type ImportVolumeResult struct {
 	ConversionTask *ConversionTask `ec2:"ConversionTask" xml:"conversionTask"`
@@ -3827,7 +7824,7 @@ type ImportVolumeTaskDetails struct {
 type Instance struct {
 	AMILaunchIndex        aws.IntegerValue             `ec2:"AmiLaunchIndex" xml:"amiLaunchIndex"`
 	Architecture          aws.StringValue              `ec2:"Architecture" xml:"architecture"`
-	BlockDeviceMappings   []InstanceBlockDeviceMapping `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>item"`
+	BlockDeviceMappings   []InstanceBlockDeviceMapping `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>item"`
 	ClientToken           aws.StringValue              `ec2:"ClientToken" xml:"clientToken"`
 	EBSOptimized          aws.BooleanValue             `ec2:"EbsOptimized" xml:"ebsOptimized"`
 	Hypervisor            aws.StringValue              `ec2:"Hypervisor" xml:"hypervisor"`
@@ -3840,42 +7837,42 @@ type Instance struct {
 	KeyName               aws.StringValue              `ec2:"KeyName" xml:"keyName"`
 	LaunchTime            time.Time                    `ec2:"LaunchTime" xml:"launchTime"`
 	Monitoring            *Monitoring                  `ec2:"Monitoring" xml:"monitoring"`
-	NetworkInterfaces     []InstanceNetworkInterface   `ec2:"NetworkInterfaces" xml:"networkInterfaceSet>item"`
+	NetworkInterfaces     []InstanceNetworkInterface   `ec2:"NetworkInterfaceSet,list" xml:"networkInterfaceSet>item"`
 	Placement             *Placement                   `ec2:"Placement" xml:"placement"`
 	Platform              aws.StringValue              `ec2:"Platform" xml:"platform"`
 	PrivateDNSName        aws.StringValue              `ec2:"PrivateDnsName" xml:"privateDnsName"`
 	PrivateIPAddress      aws.StringValue              `ec2:"PrivateIpAddress" xml:"privateIpAddress"`
-	ProductCodes          []ProductCode                `ec2:"ProductCodes" xml:"productCodes>item"`
-	PublicDNSName         aws.StringValue              `ec2:"PublicDnsName" xml:"dnsName"`
-	PublicIPAddress       aws.StringValue              `ec2:"PublicIpAddress" xml:"ipAddress"`
+	ProductCodes          []ProductCode                `ec2:"ProductCodes,list" xml:"productCodes>item"`
+	PublicDNSName         aws.StringValue              `ec2:"DnsName" xml:"dnsName"`
+	PublicIPAddress       aws.StringValue              `ec2:"IpAddress" xml:"ipAddress"`
 	RAMDiskID             aws.StringValue              `ec2:"RamdiskId" xml:"ramdiskId"`
 	RootDeviceName        aws.StringValue              `ec2:"RootDeviceName" xml:"rootDeviceName"`
 	RootDeviceType        aws.StringValue              `ec2:"RootDeviceType" xml:"rootDeviceType"`
-	SecurityGroups        []GroupIdentifier            `ec2:"SecurityGroups" xml:"groupSet>item"`
+	SecurityGroups        []GroupIdentifier            `ec2:"GroupSet,list" xml:"groupSet>item"`
 	SourceDestCheck       aws.BooleanValue             `ec2:"SourceDestCheck" xml:"sourceDestCheck"`
 	SpotInstanceRequestID aws.StringValue              `ec2:"SpotInstanceRequestId" xml:"spotInstanceRequestId"`
 	SRIOVNetSupport       aws.StringValue              `ec2:"SriovNetSupport" xml:"sriovNetSupport"`
-	State                 *InstanceState               `ec2:"State" xml:"instanceState"`
+	State                 *InstanceState               `ec2:"InstanceState" xml:"instanceState"`
 	StateReason           *StateReason                 `ec2:"StateReason" xml:"stateReason"`
-	StateTransitionReason aws.StringValue              `ec2:"StateTransitionReason" xml:"reason"`
+	StateTransitionReason aws.StringValue              `ec2:"Reason" xml:"reason"`
 	SubnetID              aws.StringValue              `ec2:"SubnetId" xml:"subnetId"`
-	Tags                  []Tag                        `ec2:"Tags" xml:"tagSet>item"`
+	Tags                  []Tag                        `ec2:"TagSet,list" xml:"tagSet>item"`
 	VirtualizationType    aws.StringValue              `ec2:"VirtualizationType" xml:"virtualizationType"`
 	VPCID                 aws.StringValue              `ec2:"VpcId" xml:"vpcId"`
 }
 
 // InstanceAttribute is undocumented.
 type InstanceAttribute struct {
-	BlockDeviceMappings               []InstanceBlockDeviceMapping `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>item"`
+	BlockDeviceMappings               []InstanceBlockDeviceMapping `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>item"`
 	DisableAPITermination             *AttributeBooleanValue       `ec2:"DisableApiTermination" xml:"disableApiTermination"`
 	EBSOptimized                      *AttributeBooleanValue       `ec2:"EbsOptimized" xml:"ebsOptimized"`
-	Groups                            []GroupIdentifier            `ec2:"Groups" xml:"groupSet>item"`
+	Groups                            []GroupIdentifier            `ec2:"GroupSet,list" xml:"groupSet>item"`
 	InstanceID                        aws.StringValue              `ec2:"InstanceId" xml:"instanceId"`
 	InstanceInitiatedShutdownBehavior *AttributeValue              `ec2:"InstanceInitiatedShutdownBehavior" xml:"instanceInitiatedShutdownBehavior"`
 	InstanceType                      *AttributeValue              `ec2:"InstanceType" xml:"instanceType"`
-	KernelID                          *AttributeValue              `ec2:"KernelId" xml:"kernel"`
-	ProductCodes                      []ProductCode                `ec2:"ProductCodes" xml:"productCodes>item"`
-	RAMDiskID                         *AttributeValue              `ec2:"RamdiskId" xml:"ramdisk"`
+	KernelID                          *AttributeValue              `ec2:"Kernel" xml:"kernel"`
+	ProductCodes                      []ProductCode                `ec2:"ProductCodes,list" xml:"productCodes>item"`
+	RAMDiskID                         *AttributeValue              `ec2:"Ramdisk" xml:"ramdisk"`
 	RootDeviceName                    *AttributeValue              `ec2:"RootDeviceName" xml:"rootDeviceName"`
 	SourceDestCheck                   *AttributeBooleanValue       `ec2:"SourceDestCheck" xml:"sourceDestCheck"`
 	SRIOVNetSupport                   *AttributeValue              `ec2:"SriovNetSupport" xml:"sriovNetSupport"`
@@ -3913,6 +7910,28 @@ type InstanceBlockDeviceMappingSpecification struct {
 	VirtualName aws.StringValue                      `ec2:"VirtualName" xml:"virtualName"`
 }
 
+// MarshalEC2 adds the parameters of the InstanceBlockDeviceMappingSpecification to the form, prefixing their names.
+func (v *InstanceBlockDeviceMappingSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DeviceName != nil {
+		form.Set(prefix+"DeviceName", *v.DeviceName)
+	}
+	if v.EBS != nil {
+		if err := v.EBS.MarshalEC2(form, prefix+"Ebs."); err != nil {
+			return err
+		}
+	}
+	if v.NoDevice != nil {
+		form.Set(prefix+"NoDevice", *v.NoDevice)
+	}
+	if v.VirtualName != nil {
+		form.Set(prefix+"VirtualName", *v.VirtualName)
+	}
+	return nil
+}
+
 // InstanceCount is undocumented.
 type InstanceCount struct {
 	InstanceCount aws.IntegerValue `ec2:"InstanceCount" xml:"instanceCount"`
@@ -3941,13 +7960,13 @@ type InstanceNetworkInterface struct {
 	Association        *InstanceNetworkInterfaceAssociation `ec2:"Association" xml:"association"`
 	Attachment         *InstanceNetworkInterfaceAttachment  `ec2:"Attachment" xml:"attachment"`
 	Description        aws.StringValue                      `ec2:"Description" xml:"description"`
-	Groups             []GroupIdentifier                    `ec2:"Groups" xml:"groupSet>item"`
+	Groups             []GroupIdentifier                    `ec2:"GroupSet,list" xml:"groupSet>item"`
 	MACAddress         aws.StringValue                      `ec2:"MacAddress" xml:"macAddress"`
 	NetworkInterfaceID aws.StringValue                      `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 	OwnerID            aws.StringValue                      `ec2:"OwnerId" xml:"ownerId"`
 	PrivateDNSName     aws.StringValue                      `ec2:"PrivateDnsName" xml:"privateDnsName"`
 	PrivateIPAddress   aws.StringValue                      `ec2:"PrivateIpAddress" xml:"privateIpAddress"`
-	PrivateIPAddresses []InstancePrivateIPAddress           `ec2:"PrivateIpAddresses" xml:"privateIpAddressesSet>item"`
+	PrivateIPAddresses []InstancePrivateIPAddress           `ec2:"PrivateIpAddressesSet,list" xml:"privateIpAddressesSet>item"`
 	SourceDestCheck    aws.BooleanValue                     `ec2:"SourceDestCheck" xml:"sourceDestCheck"`
 	Status             aws.StringValue                      `ec2:"Status" xml:"status"`
 	SubnetID           aws.StringValue                      `ec2:"SubnetId" xml:"subnetId"`
@@ -3976,14 +7995,60 @@ type InstanceNetworkInterfaceSpecification struct {
 	DeleteOnTermination            aws.BooleanValue                `ec2:"DeleteOnTermination" xml:"deleteOnTermination"`
 	Description                    aws.StringValue                 `ec2:"Description" xml:"description"`
 	DeviceIndex                    aws.IntegerValue                `ec2:"DeviceIndex" xml:"deviceIndex"`
-	Groups                         []string                        `ec2:"SecurityGroupId" xml:"SecurityGroupId>SecurityGroupId"`
+	Groups                         []string                        `ec2:"SecurityGroupId,list" xml:"SecurityGroupId>SecurityGroupId"`
 	NetworkInterfaceID             aws.StringValue                 `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 	PrivateIPAddress               aws.StringValue                 `ec2:"PrivateIpAddress" xml:"privateIpAddress"`
-	PrivateIPAddresses             []PrivateIPAddressSpecification `ec2:"PrivateIpAddresses" xml:"privateIpAddressesSet>item"`
+	PrivateIPAddresses             []PrivateIPAddressSpecification `ec2:"PrivateIpAddresses,list" xml:"privateIpAddressesSet>item"`
 	SecondaryPrivateIPAddressCount aws.IntegerValue                `ec2:"SecondaryPrivateIpAddressCount" xml:"secondaryPrivateIpAddressCount"`
 	SubnetID                       aws.StringValue                 `ec2:"SubnetId" xml:"subnetId"`
 }
 
+// MarshalEC2 adds the parameters of the InstanceNetworkInterfaceSpecification to the form, prefixing their names.
+func (v *InstanceNetworkInterfaceSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssociatePublicIPAddress != nil {
+		form.Set(prefix+"AssociatePublicIpAddress", strconv.FormatBool(*v.AssociatePublicIPAddress))
+	}
+	if v.DeleteOnTermination != nil {
+		form.Set(prefix+"DeleteOnTermination", strconv.FormatBool(*v.DeleteOnTermination))
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DeviceIndex != nil {
+		form.Set(prefix+"DeviceIndex", strconv.FormatInt(int64(*v.DeviceIndex), 10))
+	}
+	if v.Groups != nil {
+		for i1 := range v.Groups {
+			name1 := prefix + "SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Groups[i1])
+		}
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.PrivateIPAddress != nil {
+		form.Set(prefix+"PrivateIpAddress", *v.PrivateIPAddress)
+	}
+	if v.PrivateIPAddresses != nil {
+		for i1 := range v.PrivateIPAddresses {
+			name1 := prefix + "PrivateIpAddresses." + strconv.Itoa(i1+1)
+			if err := v.PrivateIPAddresses[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SecondaryPrivateIPAddressCount != nil {
+		form.Set(prefix+"SecondaryPrivateIpAddressCount", strconv.FormatInt(int64(*v.SecondaryPrivateIPAddressCount), 10))
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	return nil
+}
+
 // InstancePrivateIPAddress is undocumented.
 type InstancePrivateIPAddress struct {
 	Association      *InstanceNetworkInterfaceAssociation `ec2:"Association" xml:"association"`
@@ -4018,7 +8083,7 @@ const (
 // InstanceStatus is undocumented.
 type InstanceStatus struct {
 	AvailabilityZone aws.StringValue        `ec2:"AvailabilityZone" xml:"availabilityZone"`
-	Events           []InstanceStatusEvent  `ec2:"Events" xml:"eventsSet>item"`
+	Events           []InstanceStatusEvent  `ec2:"EventsSet,list" xml:"eventsSet>item"`
 	InstanceID       aws.StringValue        `ec2:"InstanceId" xml:"instanceId"`
 	InstanceState    *InstanceState         `ec2:"InstanceState" xml:"instanceState"`
 	InstanceStatus   *InstanceStatusSummary `ec2:"InstanceStatus" xml:"instanceStatus"`
@@ -4042,7 +8107,7 @@ type InstanceStatusEvent struct {
 
 // InstanceStatusSummary is undocumented.
 type InstanceStatusSummary struct {
-	Details []InstanceStatusDetails `ec2:"Details" xml:"details>item"`
+	Details []InstanceStatusDetails `ec2:"Details,list" xml:"details>item"`
 	Status  aws.StringValue         `ec2:"Status" xml:"status"`
 }
 
@@ -4090,9 +8155,9 @@ const (
 
 // InternetGateway is undocumented.
 type InternetGateway struct {
-	Attachments       []InternetGatewayAttachment `ec2:"Attachments" xml:"attachmentSet>item"`
+	Attachments       []InternetGatewayAttachment `ec2:"AttachmentSet,list" xml:"attachmentSet>item"`
 	InternetGatewayID aws.StringValue             `ec2:"InternetGatewayId" xml:"internetGatewayId"`
-	Tags              []Tag                       `ec2:"Tags" xml:"tagSet>item"`
+	Tags              []Tag                       `ec2:"TagSet,list" xml:"tagSet>item"`
 }
 
 // InternetGatewayAttachment is undocumented.
@@ -4105,9 +8170,42 @@ type InternetGatewayAttachment struct {
 type IPPermission struct {
 	FromPort         aws.IntegerValue  `ec2:"FromPort" xml:"fromPort"`
 	IPProtocol       aws.StringValue   `ec2:"IpProtocol" xml:"ipProtocol"`
-	IPRanges         []IPRange         `ec2:"IpRanges" xml:"ipRanges>item"`
+	IPRanges         []IPRange         `ec2:"IpRanges,list" xml:"ipRanges>item"`
 	ToPort           aws.IntegerValue  `ec2:"ToPort" xml:"toPort"`
-	UserIDGroupPairs []UserIDGroupPair `ec2:"UserIdGroupPairs" xml:"groups>item"`
+	UserIDGroupPairs []UserIDGroupPair `ec2:"Groups,list" xml:"groups>item"`
+}
+
+// MarshalEC2 adds the parameters of the IPPermission to the form, prefixing their names.
+func (v *IPPermission) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.FromPort != nil {
+		form.Set(prefix+"FromPort", strconv.FormatInt(int64(*v.FromPort), 10))
+	}
+	if v.IPProtocol != nil {
+		form.Set(prefix+"IpProtocol", *v.IPProtocol)
+	}
+	if v.IPRanges != nil {
+		for i1 := range v.IPRanges {
+			name1 := prefix + "IpRanges." + strconv.Itoa(i1+1)
+			if err := v.IPRanges[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ToPort != nil {
+		form.Set(prefix+"ToPort", strconv.FormatInt(int64(*v.ToPort), 10))
+	}
+	if v.UserIDGroupPairs != nil {
+		for i1 := range v.UserIDGroupPairs {
+			name1 := prefix + "Groups." + strconv.Itoa(i1+1)
+			if err := v.UserIDGroupPairs[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // IPRange is undocumented.
@@ -4115,6 +8213,17 @@ type IPRange struct {
 	CIDRIP aws.StringValue `ec2:"CidrIp" xml:"cidrIp"`
 }
 
+// MarshalEC2 adds the parameters of the IPRange to the form, prefixing their names.
+func (v *IPRange) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CidrIp", *v.CIDRIP)
+	}
+	return nil
+}
+
 // KeyPair is undocumented.
 type KeyPair struct {
 	KeyFingerprint aws.StringValue `ec2:"KeyFingerprint" xml:"keyFingerprint"`
@@ -4134,16 +8243,54 @@ type LaunchPermission struct {
 	UserID aws.StringValue `ec2:"UserId" xml:"userId"`
 }
 
+// MarshalEC2 adds the parameters of the LaunchPermission to the form, prefixing their names.
+func (v *LaunchPermission) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Group != nil {
+		form.Set(prefix+"Group", *v.Group)
+	}
+	if v.UserID != nil {
+		form.Set(prefix+"UserId", *v.UserID)
+	}
+	return nil
+}
+
 // LaunchPermissionModifications is undocumented.
 type LaunchPermissionModifications struct {
-	Add    []LaunchPermission `ec2:"Add" xml:"Add>item"`
-	Remove []LaunchPermission `ec2:"Remove" xml:"Remove>item"`
+	Add    []LaunchPermission `ec2:"Add,list" xml:"Add>item"`
+	Remove []LaunchPermission `ec2:"Remove,list" xml:"Remove>item"`
+}
+
+// MarshalEC2 adds the parameters of the LaunchPermissionModifications to the form, prefixing their names.
+func (v *LaunchPermissionModifications) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Add != nil {
+		for i1 := range v.Add {
+			name1 := prefix + "Add." + strconv.Itoa(i1+1)
+			if err := v.Add[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Remove != nil {
+		for i1 := range v.Remove {
+			name1 := prefix + "Remove." + strconv.Itoa(i1+1)
+			if err := v.Remove[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // LaunchSpecification is undocumented.
 type LaunchSpecification struct {
 	AddressingType      aws.StringValue                         `ec2:"AddressingType" xml:"addressingType"`
-	BlockDeviceMappings []BlockDeviceMapping                    `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>item"`
+	BlockDeviceMappings []BlockDeviceMapping                    `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>item"`
 	EBSOptimized        aws.BooleanValue                        `ec2:"EbsOptimized" xml:"ebsOptimized"`
 	IAMInstanceProfile  *IAMInstanceProfileSpecification        `ec2:"IamInstanceProfile" xml:"iamInstanceProfile"`
 	ImageID             aws.StringValue                         `ec2:"ImageId" xml:"imageId"`
@@ -4151,10 +8298,10 @@ type LaunchSpecification struct {
 	KernelID            aws.StringValue                         `ec2:"KernelId" xml:"kernelId"`
 	KeyName             aws.StringValue                         `ec2:"KeyName" xml:"keyName"`
 	Monitoring          *RunInstancesMonitoringEnabled          `ec2:"Monitoring" xml:"monitoring"`
-	NetworkInterfaces   []InstanceNetworkInterfaceSpecification `ec2:"NetworkInterfaces" xml:"networkInterfaceSet>item"`
+	NetworkInterfaces   []InstanceNetworkInterfaceSpecification `ec2:"NetworkInterfaceSet,list" xml:"networkInterfaceSet>item"`
 	Placement           *SpotPlacement                          `ec2:"Placement" xml:"placement"`
 	RAMDiskID           aws.StringValue                         `ec2:"RamdiskId" xml:"ramdiskId"`
-	SecurityGroups      []GroupIdentifier                       `ec2:"SecurityGroups" xml:"groupSet>item"`
+	SecurityGroups      []GroupIdentifier                       `ec2:"GroupSet,list" xml:"groupSet>item"`
 	SubnetID            aws.StringValue                         `ec2:"SubnetId" xml:"subnetId"`
 	UserData            aws.StringValue                         `ec2:"UserData" xml:"userData"`
 }
@@ -4183,20 +8330,71 @@ type ModifyImageAttributeRequest struct {
 	ImageID          aws.StringValue                `ec2:"ImageId" xml:"ImageId"`
 	LaunchPermission *LaunchPermissionModifications `ec2:"LaunchPermission" xml:"LaunchPermission"`
 	OperationType    aws.StringValue                `ec2:"OperationType" xml:"OperationType"`
-	ProductCodes     []string                       `ec2:"ProductCode" xml:"ProductCode>ProductCode"`
-	UserGroups       []string                       `ec2:"UserGroup" xml:"UserGroup>UserGroup"`
-	UserIDs          []string                       `ec2:"UserId" xml:"UserId>UserId"`
+	ProductCodes     []string                       `ec2:"ProductCode,list" xml:"ProductCode>ProductCode"`
+	UserGroups       []string                       `ec2:"UserGroup,list" xml:"UserGroup>UserGroup"`
+	UserIDs          []string                       `ec2:"UserId,list" xml:"UserId>UserId"`
 	Value            aws.StringValue                `ec2:"Value" xml:"Value"`
 }
 
+// MarshalEC2 adds the parameters of the ModifyImageAttributeRequest to the form, prefixing their names.
+func (v *ModifyImageAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.Description != nil {
+		if err := v.Description.MarshalEC2(form, prefix+"Description."); err != nil {
+			return err
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.ImageID != nil {
+		form.Set(prefix+"ImageId", *v.ImageID)
+	}
+	if v.LaunchPermission != nil {
+		if err := v.LaunchPermission.MarshalEC2(form, prefix+"LaunchPermission."); err != nil {
+			return err
+		}
+	}
+	if v.OperationType != nil {
+		form.Set(prefix+"OperationType", *v.OperationType)
+	}
+	if v.ProductCodes != nil {
+		for i1 := range v.ProductCodes {
+			name1 := prefix + "ProductCode." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ProductCodes[i1])
+		}
+	}
+	if v.UserGroups != nil {
+		for i1 := range v.UserGroups {
+			name1 := prefix + "UserGroup." + strconv.Itoa(i1+1)
+			form.Set(name1, v.UserGroups[i1])
+		}
+	}
+	if v.UserIDs != nil {
+		for i1 := range v.UserIDs {
+			name1 := prefix + "UserId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.UserIDs[i1])
+		}
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // ModifyInstanceAttributeRequest is undocumented.
 type ModifyInstanceAttributeRequest struct {
 	Attribute                         aws.StringValue                           `ec2:"Attribute" xml:"attribute"`
-	BlockDeviceMappings               []InstanceBlockDeviceMappingSpecification `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>item"`
+	BlockDeviceMappings               []InstanceBlockDeviceMappingSpecification `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>item"`
 	DisableAPITermination             *AttributeBooleanValue                    `ec2:"DisableApiTermination" xml:"disableApiTermination"`
 	DryRun                            aws.BooleanValue                          `ec2:"DryRun" xml:"dryRun"`
 	EBSOptimized                      *AttributeBooleanValue                    `ec2:"EbsOptimized" xml:"ebsOptimized"`
-	Groups                            []string                                  `ec2:"GroupId" xml:"GroupId>groupId"`
+	Groups                            []string                                  `ec2:"GroupId,list" xml:"GroupId>groupId"`
 	InstanceID                        aws.StringValue                           `ec2:"InstanceId" xml:"instanceId"`
 	InstanceInitiatedShutdownBehavior *AttributeValue                           `ec2:"InstanceInitiatedShutdownBehavior" xml:"instanceInitiatedShutdownBehavior"`
 	InstanceType                      *AttributeValue                           `ec2:"InstanceType" xml:"instanceType"`
@@ -4208,21 +8406,160 @@ type ModifyInstanceAttributeRequest struct {
 	Value                             aws.StringValue                           `ec2:"Value" xml:"value"`
 }
 
+// MarshalEC2 adds the parameters of the ModifyInstanceAttributeRequest to the form, prefixing their names.
+func (v *ModifyInstanceAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.BlockDeviceMappings != nil {
+		for i1 := range v.BlockDeviceMappings {
+			name1 := prefix + "BlockDeviceMapping." + strconv.Itoa(i1+1)
+			if err := v.BlockDeviceMappings[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.DisableAPITermination != nil {
+		if err := v.DisableAPITermination.MarshalEC2(form, prefix+"DisableApiTermination."); err != nil {
+			return err
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.EBSOptimized != nil {
+		if err := v.EBSOptimized.MarshalEC2(form, prefix+"EbsOptimized."); err != nil {
+			return err
+		}
+	}
+	if v.Groups != nil {
+		for i1 := range v.Groups {
+			name1 := prefix + "GroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Groups[i1])
+		}
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.InstanceInitiatedShutdownBehavior != nil {
+		if err := v.InstanceInitiatedShutdownBehavior.MarshalEC2(form, prefix+"InstanceInitiatedShutdownBehavior."); err != nil {
+			return err
+		}
+	}
+	if v.InstanceType != nil {
+		if err := v.InstanceType.MarshalEC2(form, prefix+"InstanceType."); err != nil {
+			return err
+		}
+	}
+	if v.Kernel != nil {
+		if err := v.Kernel.MarshalEC2(form, prefix+"Kernel."); err != nil {
+			return err
+		}
+	}
+	if v.RAMDisk != nil {
+		if err := v.RAMDisk.MarshalEC2(form, prefix+"Ramdisk."); err != nil {
+			return err
+		}
+	}
+	if v.SourceDestCheck != nil {
+		if err := v.SourceDestCheck.MarshalEC2(form, prefix+"SourceDestCheck."); err != nil {
+			return err
+		}
+	}
+	if v.SRIOVNetSupport != nil {
+		if err := v.SRIOVNetSupport.MarshalEC2(form, prefix+"SriovNetSupport."); err != nil {
+			return err
+		}
+	}
+	if v.UserData != nil {
+		if err := v.UserData.MarshalEC2(form, prefix+"UserData."); err != nil {
+			return err
+		}
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // ModifyNetworkInterfaceAttributeRequest is undocumented.
 type ModifyNetworkInterfaceAttributeRequest struct {
 	Attachment         *NetworkInterfaceAttachmentChanges `ec2:"Attachment" xml:"attachment"`
 	Description        *AttributeValue                    `ec2:"Description" xml:"description"`
 	DryRun             aws.BooleanValue                   `ec2:"DryRun" xml:"dryRun"`
-	Groups             []string                           `ec2:"SecurityGroupId" xml:"SecurityGroupId>SecurityGroupId"`
+	Groups             []string                           `ec2:"SecurityGroupId,list" xml:"SecurityGroupId>SecurityGroupId"`
 	NetworkInterfaceID aws.StringValue                    `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 	SourceDestCheck    *AttributeBooleanValue             `ec2:"SourceDestCheck" xml:"sourceDestCheck"`
 }
 
+// MarshalEC2 adds the parameters of the ModifyNetworkInterfaceAttributeRequest to the form, prefixing their names.
+func (v *ModifyNetworkInterfaceAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attachment != nil {
+		if err := v.Attachment.MarshalEC2(form, prefix+"Attachment."); err != nil {
+			return err
+		}
+	}
+	if v.Description != nil {
+		if err := v.Description.MarshalEC2(form, prefix+"Description."); err != nil {
+			return err
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Groups != nil {
+		for i1 := range v.Groups {
+			name1 := prefix + "SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Groups[i1])
+		}
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.SourceDestCheck != nil {
+		if err := v.SourceDestCheck.MarshalEC2(form, prefix+"SourceDestCheck."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ModifyReservedInstancesRequest is undocumented.
 type ModifyReservedInstancesRequest struct {
 	ClientToken          aws.StringValue                  `ec2:"ClientToken" xml:"clientToken"`
-	ReservedInstancesIDs []string                         `ec2:"ReservedInstancesId" xml:"ReservedInstancesId>ReservedInstancesId"`
-	TargetConfigurations []ReservedInstancesConfiguration `ec2:"ReservedInstancesConfigurationSetItemType" xml:"ReservedInstancesConfigurationSetItemType>item"`
+	ReservedInstancesIDs []string                         `ec2:"ReservedInstancesId,list" xml:"ReservedInstancesId>ReservedInstancesId"`
+	TargetConfigurations []ReservedInstancesConfiguration `ec2:"ReservedInstancesConfigurationSetItemType,list" xml:"ReservedInstancesConfigurationSetItemType>item"`
+}
+
+// MarshalEC2 adds the parameters of the ModifyReservedInstancesRequest to the form, prefixing their names.
+func (v *ModifyReservedInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClientToken != nil {
+		form.Set(prefix+"ClientToken", *v.ClientToken)
+	}
+	if v.ReservedInstancesIDs != nil {
+		for i1 := range v.ReservedInstancesIDs {
+			name1 := prefix + "ReservedInstancesId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ReservedInstancesIDs[i1])
+		}
+	}
+	if v.TargetConfigurations != nil {
+		for i1 := range v.TargetConfigurations {
+			name1 := prefix + "ReservedInstancesConfigurationSetItemType." + strconv.Itoa(i1+1)
+			if err := v.TargetConfigurations[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // ModifyReservedInstancesResult is undocumented.
@@ -4235,10 +8572,47 @@ type ModifySnapshotAttributeRequest struct {
 	Attribute              aws.StringValue                      `ec2:"Attribute" xml:"Attribute"`
 	CreateVolumePermission *CreateVolumePermissionModifications `ec2:"CreateVolumePermission" xml:"CreateVolumePermission"`
 	DryRun                 aws.BooleanValue                     `ec2:"DryRun" xml:"dryRun"`
-	GroupNames             []string                             `ec2:"UserGroup" xml:"UserGroup>GroupName"`
+	GroupNames             []string                             `ec2:"UserGroup,list" xml:"UserGroup>GroupName"`
 	OperationType          aws.StringValue                      `ec2:"OperationType" xml:"OperationType"`
 	SnapshotID             aws.StringValue                      `ec2:"SnapshotId" xml:"SnapshotId"`
-	UserIDs                []string                             `ec2:"UserId" xml:"UserId>UserId"`
+	UserIDs                []string                             `ec2:"UserId,list" xml:"UserId>UserId"`
+}
+
+// MarshalEC2 adds the parameters of the ModifySnapshotAttributeRequest to the form, prefixing their names.
+func (v *ModifySnapshotAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.CreateVolumePermission != nil {
+		if err := v.CreateVolumePermission.MarshalEC2(form, prefix+"CreateVolumePermission."); err != nil {
+			return err
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.GroupNames != nil {
+		for i1 := range v.GroupNames {
+			name1 := prefix + "UserGroup." + strconv.Itoa(i1+1)
+			form.Set(name1, v.GroupNames[i1])
+		}
+	}
+	if v.OperationType != nil {
+		form.Set(prefix+"OperationType", *v.OperationType)
+	}
+	if v.SnapshotID != nil {
+		form.Set(prefix+"SnapshotId", *v.SnapshotID)
+	}
+	if v.UserIDs != nil {
+		for i1 := range v.UserIDs {
+			name1 := prefix + "UserId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.UserIDs[i1])
+		}
+	}
+	return nil
 }
 
 // ModifySubnetAttributeRequest is undocumented.
@@ -4247,6 +8621,22 @@ type ModifySubnetAttributeRequest struct {
 	SubnetID            aws.StringValue        `ec2:"SubnetId" xml:"subnetId"`
 }
 
+// MarshalEC2 adds the parameters of the ModifySubnetAttributeRequest to the form, prefixing their names.
+func (v *ModifySubnetAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.MapPublicIPOnLaunch != nil {
+		if err := v.MapPublicIPOnLaunch.MarshalEC2(form, prefix+"MapPublicIpOnLaunch."); err != nil {
+			return err
+		}
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	return nil
+}
+
 // ModifyVolumeAttributeRequest is undocumented.
 type ModifyVolumeAttributeRequest struct {
 	AutoEnableIO *AttributeBooleanValue `ec2:"AutoEnableIO" xml:"AutoEnableIO"`
@@ -4254,6 +8644,25 @@ type ModifyVolumeAttributeRequest struct {
 	VolumeID     aws.StringValue        `ec2:"VolumeId" xml:"VolumeId"`
 }
 
+// MarshalEC2 adds the parameters of the ModifyVolumeAttributeRequest to the form, prefixing their names.
+func (v *ModifyVolumeAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoEnableIO != nil {
+		if err := v.AutoEnableIO.MarshalEC2(form, prefix+"AutoEnableIO."); err != nil {
+			return err
+		}
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VolumeID != nil {
+		form.Set(prefix+"VolumeId", *v.VolumeID)
+	}
+	return nil
+}
+
 // ModifyVPCAttributeRequest is undocumented.
 type ModifyVPCAttributeRequest struct {
 	EnableDNSHostnames *AttributeBooleanValue `ec2:"EnableDnsHostnames" xml:"EnableDnsHostnames"`
@@ -4261,15 +8670,53 @@ type ModifyVPCAttributeRequest struct {
 	VPCID              aws.StringValue        `ec2:"VpcId" xml:"vpcId"`
 }
 
+// MarshalEC2 adds the parameters of the ModifyVPCAttributeRequest to the form, prefixing their names.
+func (v *ModifyVPCAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EnableDNSHostnames != nil {
+		if err := v.EnableDNSHostnames.MarshalEC2(form, prefix+"EnableDnsHostnames."); err != nil {
+			return err
+		}
+	}
+	if v.EnableDNSSupport != nil {
+		if err := v.EnableDNSSupport.MarshalEC2(form, prefix+"EnableDnsSupport."); err != nil {
+			return err
+		}
+	}
+	if v.VPCID != nil {
+		form.Set(prefix+"VpcId", *v.VPCID)
+	}
+	return nil
+}
+
 // MonitorInstancesRequest is undocumented.
 type MonitorInstancesRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	InstanceIDs []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	InstanceIDs []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
+}
+
+// MarshalEC2 adds the parameters of the MonitorInstancesRequest to the form, prefixing their names.
+func (v *MonitorInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // MonitorInstancesResult is undocumented.
 type MonitorInstancesResult struct {
-	InstanceMonitorings []InstanceMonitoring `ec2:"InstanceMonitorings" xml:"instancesSet>item"`
+	InstanceMonitorings []InstanceMonitoring `ec2:"InstancesSet,list" xml:"instancesSet>item"`
 }
 
 // Monitoring is undocumented.
@@ -4286,11 +8733,11 @@ const (
 
 // NetworkACL is undocumented.
 type NetworkACL struct {
-	Associations []NetworkACLAssociation `ec2:"Associations" xml:"associationSet>item"`
-	Entries      []NetworkACLEntry       `ec2:"Entries" xml:"entrySet>item"`
-	IsDefault    aws.BooleanValue        `ec2:"IsDefault" xml:"default"`
+	Associations []NetworkACLAssociation `ec2:"AssociationSet,list" xml:"associationSet>item"`
+	Entries      []NetworkACLEntry       `ec2:"EntrySet,list" xml:"entrySet>item"`
+	IsDefault    aws.BooleanValue        `ec2:"Default" xml:"default"`
 	NetworkACLID aws.StringValue         `ec2:"NetworkAclId" xml:"networkAclId"`
-	Tags         []Tag                   `ec2:"Tags" xml:"tagSet>item"`
+	Tags         []Tag                   `ec2:"TagSet,list" xml:"tagSet>item"`
 	VPCID        aws.StringValue         `ec2:"VpcId" xml:"vpcId"`
 }
 
@@ -4318,19 +8765,19 @@ type NetworkInterface struct {
 	Attachment         *NetworkInterfaceAttachment        `ec2:"Attachment" xml:"attachment"`
 	AvailabilityZone   aws.StringValue                    `ec2:"AvailabilityZone" xml:"availabilityZone"`
 	Description        aws.StringValue                    `ec2:"Description" xml:"description"`
-	Groups             []GroupIdentifier                  `ec2:"Groups" xml:"groupSet>item"`
+	Groups             []GroupIdentifier                  `ec2:"GroupSet,list" xml:"groupSet>item"`
 	MACAddress         aws.StringValue                    `ec2:"MacAddress" xml:"macAddress"`
 	NetworkInterfaceID aws.StringValue                    `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
 	OwnerID            aws.StringValue                    `ec2:"OwnerId" xml:"ownerId"`
 	PrivateDNSName     aws.StringValue                    `ec2:"PrivateDnsName" xml:"privateDnsName"`
 	PrivateIPAddress   aws.StringValue                    `ec2:"PrivateIpAddress" xml:"privateIpAddress"`
-	PrivateIPAddresses []NetworkInterfacePrivateIPAddress `ec2:"PrivateIpAddresses" xml:"privateIpAddressesSet>item"`
+	PrivateIPAddresses []NetworkInterfacePrivateIPAddress `ec2:"PrivateIpAddressesSet,list" xml:"privateIpAddressesSet>item"`
 	RequesterID        aws.StringValue                    `ec2:"RequesterId" xml:"requesterId"`
 	RequesterManaged   aws.BooleanValue                   `ec2:"RequesterManaged" xml:"requesterManaged"`
 	SourceDestCheck    aws.BooleanValue                   `ec2:"SourceDestCheck" xml:"sourceDestCheck"`
 	Status             aws.StringValue                    `ec2:"Status" xml:"status"`
 	SubnetID           aws.StringValue                    `ec2:"SubnetId" xml:"subnetId"`
-	TagSet             []Tag                              `ec2:"TagSet" xml:"tagSet>item"`
+	TagSet             []Tag                              `ec2:"TagSet,list" xml:"tagSet>item"`
 	VPCID              aws.StringValue                    `ec2:"VpcId" xml:"vpcId"`
 }
 
@@ -4360,6 +8807,20 @@ type NetworkInterfaceAttachmentChanges struct {
 	DeleteOnTermination aws.BooleanValue `ec2:"DeleteOnTermination" xml:"deleteOnTermination"`
 }
 
+// MarshalEC2 adds the parameters of the NetworkInterfaceAttachmentChanges to the form, prefixing their names.
+func (v *NetworkInterfaceAttachmentChanges) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttachmentID != nil {
+		form.Set(prefix+"AttachmentId", *v.AttachmentID)
+	}
+	if v.DeleteOnTermination != nil {
+		form.Set(prefix+"DeleteOnTermination", strconv.FormatBool(*v.DeleteOnTermination))
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	NetworkInterfaceAttributeAttachment      = "attachment"
@@ -4387,7 +8848,24 @@ const (
 // NewDHCPConfiguration is undocumented.
 type NewDHCPConfiguration struct {
 	Key    aws.StringValue `ec2:"Key" xml:"key"`
-	Values []string        `ec2:"Value" xml:"Value>item"`
+	Values []string        `ec2:"Value,list" xml:"Value>item"`
+}
+
+// MarshalEC2 adds the parameters of the NewDHCPConfiguration to the form, prefixing their names.
+func (v *NewDHCPConfiguration) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Values != nil {
+		for i1 := range v.Values {
+			name1 := prefix + "Value." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Values[i1])
+		}
+	}
+	return nil
 }
 
 // Possible values for EC2.
@@ -4409,6 +8887,23 @@ type Placement struct {
 	Tenancy          aws.StringValue `ec2:"Tenancy" xml:"tenancy"`
 }
 
+// MarshalEC2 adds the parameters of the Placement to the form, prefixing their names.
+func (v *Placement) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.Tenancy != nil {
+		form.Set(prefix+"Tenancy", *v.Tenancy)
+	}
+	return nil
+}
+
 // PlacementGroup is undocumented.
 type PlacementGroup struct {
 	GroupName aws.StringValue `ec2:"GroupName" xml:"groupName"`
@@ -4440,6 +8935,20 @@ type PortRange struct {
 	To   aws.IntegerValue `ec2:"To" xml:"to"`
 }
 
+// MarshalEC2 adds the parameters of the PortRange to the form, prefixing their names.
+func (v *PortRange) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.From != nil {
+		form.Set(prefix+"From", strconv.FormatInt(int64(*v.From), 10))
+	}
+	if v.To != nil {
+		form.Set(prefix+"To", strconv.FormatInt(int64(*v.To), 10))
+	}
+	return nil
+}
+
 // PriceSchedule is undocumented.
 type PriceSchedule struct {
 	Active       aws.BooleanValue `ec2:"Active" xml:"active"`
@@ -4455,6 +8964,23 @@ type PriceScheduleSpecification struct {
 	Term         aws.LongValue   `ec2:"Term" xml:"term"`
 }
 
+// MarshalEC2 adds the parameters of the PriceScheduleSpecification to the form, prefixing their names.
+func (v *PriceScheduleSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CurrencyCode != nil {
+		form.Set(prefix+"CurrencyCode", *v.CurrencyCode)
+	}
+	if v.Price != nil {
+		form.Set(prefix+"Price", strconv.FormatFloat(float64(*v.Price), 'f', -1, 64))
+	}
+	if v.Term != nil {
+		form.Set(prefix+"Term", strconv.FormatInt(int64(*v.Term), 10))
+	}
+	return nil
+}
+
 // PricingDetail is undocumented.
 type PricingDetail struct {
 	Count aws.IntegerValue `ec2:"Count" xml:"count"`
@@ -4467,10 +8993,24 @@ type PrivateIPAddressSpecification struct {
 	PrivateIPAddress aws.StringValue  `ec2:"PrivateIpAddress" xml:"privateIpAddress"`
 }
 
+// MarshalEC2 adds the parameters of the PrivateIPAddressSpecification to the form, prefixing their names.
+func (v *PrivateIPAddressSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Primary != nil {
+		form.Set(prefix+"Primary", strconv.FormatBool(*v.Primary))
+	}
+	if v.PrivateIPAddress != nil {
+		form.Set(prefix+"PrivateIpAddress", *v.PrivateIPAddress)
+	}
+	return nil
+}
+
 // ProductCode is undocumented.
 type ProductCode struct {
-	ProductCodeID   aws.StringValue `ec2:"ProductCodeId" xml:"productCode"`
-	ProductCodeType aws.StringValue `ec2:"ProductCodeType" xml:"type"`
+	ProductCodeID   aws.StringValue `ec2:"ProductCode" xml:"productCode"`
+	ProductCodeType aws.StringValue `ec2:"Type" xml:"type"`
 }
 
 // Possible values for EC2.
@@ -4492,6 +9032,28 @@ type PurchaseReservedInstancesOfferingRequest struct {
 	ReservedInstancesOfferingID aws.StringValue             `ec2:"ReservedInstancesOfferingId" xml:"ReservedInstancesOfferingId"`
 }
 
+// MarshalEC2 adds the parameters of the PurchaseReservedInstancesOfferingRequest to the form, prefixing their names.
+func (v *PurchaseReservedInstancesOfferingRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceCount != nil {
+		form.Set(prefix+"InstanceCount", strconv.FormatInt(int64(*v.InstanceCount), 10))
+	}
+	if v.LimitPrice != nil {
+		if err := v.LimitPrice.MarshalEC2(form, prefix+"LimitPrice."); err != nil {
+			return err
+		}
+	}
+	if v.ReservedInstancesOfferingID != nil {
+		form.Set(prefix+"ReservedInstancesOfferingId", *v.ReservedInstancesOfferingID)
+	}
+	return nil
+}
+
 // PurchaseReservedInstancesOfferingResult is undocumented.
 type PurchaseReservedInstancesOfferingResult struct {
 	ReservedInstancesID aws.StringValue `ec2:"ReservedInstancesId" xml:"reservedInstancesId"`
@@ -4508,7 +9070,24 @@ const (
 // RebootInstancesRequest is undocumented.
 type RebootInstancesRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	InstanceIDs []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	InstanceIDs []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
+}
+
+// MarshalEC2 adds the parameters of the RebootInstancesRequest to the form, prefixing their names.
+func (v *RebootInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // RecurringCharge is undocumented.
@@ -4524,14 +9103,14 @@ const (
 
 // Region is undocumented.
 type Region struct {
-	Endpoint   aws.StringValue `ec2:"Endpoint" xml:"regionEndpoint"`
+	Endpoint   aws.StringValue `ec2:"RegionEndpoint" xml:"regionEndpoint"`
 	RegionName aws.StringValue `ec2:"RegionName" xml:"regionName"`
 }
 
 // RegisterImageRequest is undocumented.
 type RegisterImageRequest struct {
 	Architecture        aws.StringValue      `ec2:"Architecture" xml:"architecture"`
-	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMapping" xml:"BlockDeviceMapping>BlockDeviceMapping"`
+	BlockDeviceMappings []BlockDeviceMapping `ec2:"BlockDeviceMapping,list" xml:"BlockDeviceMapping>BlockDeviceMapping"`
 	Description         aws.StringValue      `ec2:"Description" xml:"description"`
 	DryRun              aws.BooleanValue     `ec2:"DryRun" xml:"dryRun"`
 	ImageLocation       aws.StringValue      `ec2:"ImageLocation" xml:"ImageLocation"`
@@ -4543,6 +9122,52 @@ type RegisterImageRequest struct {
 	VirtualizationType  aws.StringValue      `ec2:"VirtualizationType" xml:"virtualizationType"`
 }
 
+// MarshalEC2 adds the parameters of the RegisterImageRequest to the form, prefixing their names.
+func (v *RegisterImageRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Architecture != nil {
+		form.Set(prefix+"Architecture", *v.Architecture)
+	}
+	if v.BlockDeviceMappings != nil {
+		for i1 := range v.BlockDeviceMappings {
+			name1 := prefix + "BlockDeviceMapping." + strconv.Itoa(i1+1)
+			if err := v.BlockDeviceMappings[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.ImageLocation != nil {
+		form.Set(prefix+"ImageLocation", *v.ImageLocation)
+	}
+	if v.KernelID != nil {
+		form.Set(prefix+"KernelId", *v.KernelID)
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.RAMDiskID != nil {
+		form.Set(prefix+"RamdiskId", *v.RAMDiskID)
+	}
+	if v.RootDeviceName != nil {
+		form.Set(prefix+"RootDeviceName", *v.RootDeviceName)
+	}
+	if v.SRIOVNetSupport != nil {
+		form.Set(prefix+"SriovNetSupport", *v.SRIOVNetSupport)
+	}
+	if v.VirtualizationType != nil {
+		form.Set(prefix+"VirtualizationType", *v.VirtualizationType)
+	}
+	return nil
+}
+
 // RegisterImageResult is undocumented.
 type RegisterImageResult struct {
 	ImageID aws.StringValue `ec2:"ImageId" xml:"imageId"`
@@ -4554,6 +9179,20 @@ type RejectVPCPeeringConnectionRequest struct {
 	VPCPeeringConnectionID aws.StringValue  `ec2:"VpcPeeringConnectionId" xml:"vpcPeeringConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the RejectVPCPeeringConnectionRequest to the form, prefixing their names.
+func (v *RejectVPCPeeringConnectionRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.VPCPeeringConnectionID != nil {
+		form.Set(prefix+"VpcPeeringConnectionId", *v.VPCPeeringConnectionID)
+	}
+	return nil
+}
+
 // RejectVPCPeeringConnectionResult is undocumented.
 type RejectVPCPeeringConnectionResult struct {
 	Return aws.BooleanValue `ec2:"Return" xml:"return"`
@@ -4566,6 +9205,23 @@ type ReleaseAddressRequest struct {
 	PublicIP     aws.StringValue  `ec2:"PublicIp" xml:"PublicIp"`
 }
 
+// MarshalEC2 adds the parameters of the ReleaseAddressRequest to the form, prefixing their names.
+func (v *ReleaseAddressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllocationID != nil {
+		form.Set(prefix+"AllocationId", *v.AllocationID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.PublicIP != nil {
+		form.Set(prefix+"PublicIp", *v.PublicIP)
+	}
+	return nil
+}
+
 // ReplaceNetworkACLAssociationRequest is undocumented.
 type ReplaceNetworkACLAssociationRequest struct {
 	AssociationID aws.StringValue  `ec2:"AssociationId" xml:"associationId"`
@@ -4573,6 +9229,23 @@ type ReplaceNetworkACLAssociationRequest struct {
 	NetworkACLID  aws.StringValue  `ec2:"NetworkAclId" xml:"networkAclId"`
 }
 
+// MarshalEC2 adds the parameters of the ReplaceNetworkACLAssociationRequest to the form, prefixing their names.
+func (v *ReplaceNetworkACLAssociationRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssociationID != nil {
+		form.Set(prefix+"AssociationId", *v.AssociationID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.NetworkACLID != nil {
+		form.Set(prefix+"NetworkAclId", *v.NetworkACLID)
+	}
+	return nil
+}
+
 // ReplaceNetworkACLAssociationResult is undocumented.
 type ReplaceNetworkACLAssociationResult struct {
 	NewAssociationID aws.StringValue `ec2:"NewAssociationId" xml:"newAssociationId"`
@@ -4591,6 +9264,45 @@ type ReplaceNetworkACLEntryRequest struct {
 	RuleNumber   aws.IntegerValue `ec2:"RuleNumber" xml:"ruleNumber"`
 }
 
+// MarshalEC2 adds the parameters of the ReplaceNetworkACLEntryRequest to the form, prefixing their names.
+func (v *ReplaceNetworkACLEntryRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRBlock != nil {
+		form.Set(prefix+"CidrBlock", *v.CIDRBlock)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Egress != nil {
+		form.Set(prefix+"Egress", strconv.FormatBool(*v.Egress))
+	}
+	if v.ICMPTypeCode != nil {
+		if err := v.ICMPTypeCode.MarshalEC2(form, prefix+"Icmp."); err != nil {
+			return err
+		}
+	}
+	if v.NetworkACLID != nil {
+		form.Set(prefix+"NetworkAclId", *v.NetworkACLID)
+	}
+	if v.PortRange != nil {
+		if err := v.PortRange.MarshalEC2(form, prefix+"PortRange."); err != nil {
+			return err
+		}
+	}
+	if v.Protocol != nil {
+		form.Set(prefix+"Protocol", *v.Protocol)
+	}
+	if v.RuleAction != nil {
+		form.Set(prefix+"RuleAction", *v.RuleAction)
+	}
+	if v.RuleNumber != nil {
+		form.Set(prefix+"RuleNumber", strconv.FormatInt(int64(*v.RuleNumber), 10))
+	}
+	return nil
+}
+
 // ReplaceRouteRequest is undocumented.
 type ReplaceRouteRequest struct {
 	DestinationCIDRBlock   aws.StringValue  `ec2:"DestinationCidrBlock" xml:"destinationCidrBlock"`
@@ -4602,6 +9314,35 @@ type ReplaceRouteRequest struct {
 	VPCPeeringConnectionID aws.StringValue  `ec2:"VpcPeeringConnectionId" xml:"vpcPeeringConnectionId"`
 }
 
+// MarshalEC2 adds the parameters of the ReplaceRouteRequest to the form, prefixing their names.
+func (v *ReplaceRouteRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DestinationCIDRBlock != nil {
+		form.Set(prefix+"DestinationCidrBlock", *v.DestinationCIDRBlock)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.GatewayID != nil {
+		form.Set(prefix+"GatewayId", *v.GatewayID)
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	if v.VPCPeeringConnectionID != nil {
+		form.Set(prefix+"VpcPeeringConnectionId", *v.VPCPeeringConnectionID)
+	}
+	return nil
+}
+
 // ReplaceRouteTableAssociationRequest is undocumented.
 type ReplaceRouteTableAssociationRequest struct {
 	AssociationID aws.StringValue  `ec2:"AssociationId" xml:"associationId"`
@@ -4609,6 +9350,23 @@ type ReplaceRouteTableAssociationRequest struct {
 	RouteTableID  aws.StringValue  `ec2:"RouteTableId" xml:"routeTableId"`
 }
 
+// MarshalEC2 adds the parameters of the ReplaceRouteTableAssociationRequest to the form, prefixing their names.
+func (v *ReplaceRouteTableAssociationRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssociationID != nil {
+		form.Set(prefix+"AssociationId", *v.AssociationID)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.RouteTableID != nil {
+		form.Set(prefix+"RouteTableId", *v.RouteTableID)
+	}
+	return nil
+}
+
 // ReplaceRouteTableAssociationResult is undocumented.
 type ReplaceRouteTableAssociationResult struct {
 	NewAssociationID aws.StringValue `ec2:"NewAssociationId" xml:"newAssociationId"`
@@ -4632,12 +9390,47 @@ type ReportInstanceStatusRequest struct {
 	Description aws.StringValue  `ec2:"Description" xml:"description"`
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	EndTime     time.Time        `ec2:"EndTime" xml:"endTime"`
-	Instances   []string         `ec2:"Instances" xml:"instanceId>InstanceId"`
-	ReasonCodes []string         `ec2:"ReasonCodes" xml:"reasonCode>item"`
+	Instances   []string         `ec2:"InstanceId,list" xml:"instanceId>InstanceId"`
+	ReasonCodes []string         `ec2:"ReasonCode,list" xml:"reasonCode>item"`
 	StartTime   time.Time        `ec2:"StartTime" xml:"startTime"`
 	Status      aws.StringValue  `ec2:"Status" xml:"status"`
 }
 
+// MarshalEC2 adds the parameters of the ReportInstanceStatusRequest to the form, prefixing their names.
+func (v *ReportInstanceStatusRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Instances != nil {
+		for i1 := range v.Instances {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Instances[i1])
+		}
+	}
+	if v.ReasonCodes != nil {
+		for i1 := range v.ReasonCodes {
+			name1 := prefix + "ReasonCode." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ReasonCodes[i1])
+		}
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Status != nil {
+		form.Set(prefix+"Status", *v.Status)
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	ReportStatusTypeImpaired = "impaired"
@@ -4657,15 +9450,52 @@ type RequestSpotInstancesRequest struct {
 	ValidUntil            time.Time                       `ec2:"ValidUntil" xml:"validUntil"`
 }
 
+// MarshalEC2 adds the parameters of the RequestSpotInstancesRequest to the form, prefixing their names.
+func (v *RequestSpotInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZoneGroup != nil {
+		form.Set(prefix+"AvailabilityZoneGroup", *v.AvailabilityZoneGroup)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceCount != nil {
+		form.Set(prefix+"InstanceCount", strconv.FormatInt(int64(*v.InstanceCount), 10))
+	}
+	if v.LaunchGroup != nil {
+		form.Set(prefix+"LaunchGroup", *v.LaunchGroup)
+	}
+	if v.LaunchSpecification != nil {
+		if err := v.LaunchSpecification.MarshalEC2(form, prefix+"LaunchSpecification."); err != nil {
+			return err
+		}
+	}
+	if v.SpotPrice != nil {
+		form.Set(prefix+"SpotPrice", *v.SpotPrice)
+	}
+	if v.Type != nil {
+		form.Set(prefix+"Type", *v.Type)
+	}
+	if !v.ValidFrom.IsZero() {
+		form.Set(prefix+"ValidFrom", v.ValidFrom.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if !v.ValidUntil.IsZero() {
+		form.Set(prefix+"ValidUntil", v.ValidUntil.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // RequestSpotInstancesResult is undocumented.
 type RequestSpotInstancesResult struct {
-	SpotInstanceRequests []SpotInstanceRequest `ec2:"SpotInstanceRequests" xml:"spotInstanceRequestSet>item"`
+	SpotInstanceRequests []SpotInstanceRequest `ec2:"SpotInstanceRequestSet,list" xml:"spotInstanceRequestSet>item"`
 }
 
 // RequestSpotLaunchSpecification is undocumented.
 type RequestSpotLaunchSpecification struct {
 	AddressingType      aws.StringValue                         `ec2:"AddressingType" xml:"addressingType"`
-	BlockDeviceMappings []BlockDeviceMapping                    `ec2:"BlockDeviceMappings" xml:"blockDeviceMapping>item"`
+	BlockDeviceMappings []BlockDeviceMapping                    `ec2:"BlockDeviceMapping,list" xml:"blockDeviceMapping>item"`
 	EBSOptimized        aws.BooleanValue                        `ec2:"EbsOptimized" xml:"ebsOptimized"`
 	IAMInstanceProfile  *IAMInstanceProfileSpecification        `ec2:"IamInstanceProfile" xml:"iamInstanceProfile"`
 	ImageID             aws.StringValue                         `ec2:"ImageId" xml:"imageId"`
@@ -4673,19 +9503,97 @@ type RequestSpotLaunchSpecification struct {
 	KernelID            aws.StringValue                         `ec2:"KernelId" xml:"kernelId"`
 	KeyName             aws.StringValue                         `ec2:"KeyName" xml:"keyName"`
 	Monitoring          *RunInstancesMonitoringEnabled          `ec2:"Monitoring" xml:"monitoring"`
-	NetworkInterfaces   []InstanceNetworkInterfaceSpecification `ec2:"NetworkInterface" xml:"NetworkInterface>item"`
+	NetworkInterfaces   []InstanceNetworkInterfaceSpecification `ec2:"NetworkInterface,list" xml:"NetworkInterface>item"`
 	Placement           *SpotPlacement                          `ec2:"Placement" xml:"placement"`
 	RAMDiskID           aws.StringValue                         `ec2:"RamdiskId" xml:"ramdiskId"`
-	SecurityGroupIDs    []string                                `ec2:"SecurityGroupId" xml:"SecurityGroupId>item"`
-	SecurityGroups      []string                                `ec2:"SecurityGroup" xml:"SecurityGroup>item"`
+	SecurityGroupIDs    []string                                `ec2:"SecurityGroupId,list" xml:"SecurityGroupId>item"`
+	SecurityGroups      []string                                `ec2:"SecurityGroup,list" xml:"SecurityGroup>item"`
 	SubnetID            aws.StringValue                         `ec2:"SubnetId" xml:"subnetId"`
 	UserData            aws.StringValue                         `ec2:"UserData" xml:"userData"`
 }
 
+// MarshalEC2 adds the parameters of the RequestSpotLaunchSpecification to the form, prefixing their names.
+func (v *RequestSpotLaunchSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AddressingType != nil {
+		form.Set(prefix+"AddressingType", *v.AddressingType)
+	}
+	if v.BlockDeviceMappings != nil {
+		for i1 := range v.BlockDeviceMappings {
+			name1 := prefix + "BlockDeviceMapping." + strconv.Itoa(i1+1)
+			if err := v.BlockDeviceMappings[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.EBSOptimized != nil {
+		form.Set(prefix+"EbsOptimized", strconv.FormatBool(*v.EBSOptimized))
+	}
+	if v.IAMInstanceProfile != nil {
+		if err := v.IAMInstanceProfile.MarshalEC2(form, prefix+"IamInstanceProfile."); err != nil {
+			return err
+		}
+	}
+	if v.ImageID != nil {
+		form.Set(prefix+"ImageId", *v.ImageID)
+	}
+	if v.InstanceType != nil {
+		form.Set(prefix+"InstanceType", *v.InstanceType)
+	}
+	if v.KernelID != nil {
+		form.Set(prefix+"KernelId", *v.KernelID)
+	}
+	if v.KeyName != nil {
+		form.Set(prefix+"KeyName", *v.KeyName)
+	}
+	if v.Monitoring != nil {
+		if err := v.Monitoring.MarshalEC2(form, prefix+"Monitoring."); err != nil {
+			return err
+		}
+	}
+	if v.NetworkInterfaces != nil {
+		for i1 := range v.NetworkInterfaces {
+			name1 := prefix + "NetworkInterface." + strconv.Itoa(i1+1)
+			if err := v.NetworkInterfaces[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Placement != nil {
+		if err := v.Placement.MarshalEC2(form, prefix+"Placement."); err != nil {
+			return err
+		}
+	}
+	if v.RAMDiskID != nil {
+		form.Set(prefix+"RamdiskId", *v.RAMDiskID)
+	}
+	if v.SecurityGroupIDs != nil {
+		for i1 := range v.SecurityGroupIDs {
+			name1 := prefix + "SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroupIDs[i1])
+		}
+	}
+	if v.SecurityGroups != nil {
+		for i1 := range v.SecurityGroups {
+			name1 := prefix + "SecurityGroup." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroups[i1])
+		}
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	if v.UserData != nil {
+		form.Set(prefix+"UserData", *v.UserData)
+	}
+	return nil
+}
+
 // Reservation is undocumented.
 type Reservation struct {
-	Groups        []GroupIdentifier `ec2:"Groups" xml:"groupSet>item"`
-	Instances     []Instance        `ec2:"Instances" xml:"instancesSet>item"`
+	Groups        []GroupIdentifier `ec2:"GroupSet,list" xml:"groupSet>item"`
+	Instances     []Instance        `ec2:"InstancesSet,list" xml:"instancesSet>item"`
 	OwnerID       aws.StringValue   `ec2:"OwnerId" xml:"ownerId"`
 	RequesterID   aws.StringValue   `ec2:"RequesterId" xml:"requesterId"`
 	ReservationID aws.StringValue   `ec2:"ReservationId" xml:"reservationId"`
@@ -4697,6 +9605,20 @@ type ReservedInstanceLimitPrice struct {
 	CurrencyCode aws.StringValue `ec2:"CurrencyCode" xml:"currencyCode"`
 }
 
+// MarshalEC2 adds the parameters of the ReservedInstanceLimitPrice to the form, prefixing their names.
+func (v *ReservedInstanceLimitPrice) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Amount != nil {
+		form.Set(prefix+"Amount", strconv.FormatFloat(float64(*v.Amount), 'f', -1, 64))
+	}
+	if v.CurrencyCode != nil {
+		form.Set(prefix+"CurrencyCode", *v.CurrencyCode)
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	ReservedInstanceStateActive         = "active"
@@ -4717,11 +9639,11 @@ type ReservedInstances struct {
 	InstanceType        aws.StringValue   `ec2:"InstanceType" xml:"instanceType"`
 	OfferingType        aws.StringValue   `ec2:"OfferingType" xml:"offeringType"`
 	ProductDescription  aws.StringValue   `ec2:"ProductDescription" xml:"productDescription"`
-	RecurringCharges    []RecurringCharge `ec2:"RecurringCharges" xml:"recurringCharges>item"`
+	RecurringCharges    []RecurringCharge `ec2:"RecurringCharges,list" xml:"recurringCharges>item"`
 	ReservedInstancesID aws.StringValue   `ec2:"ReservedInstancesId" xml:"reservedInstancesId"`
 	Start               time.Time         `ec2:"Start" xml:"start"`
 	State               aws.StringValue   `ec2:"State" xml:"state"`
-	Tags                []Tag             `ec2:"Tags" xml:"tagSet>item"`
+	Tags                []Tag             `ec2:"TagSet,list" xml:"tagSet>item"`
 	UsagePrice          aws.FloatValue    `ec2:"UsagePrice" xml:"usagePrice"`
 }
 
@@ -4733,6 +9655,26 @@ type ReservedInstancesConfiguration struct {
 	Platform         aws.StringValue  `ec2:"Platform" xml:"platform"`
 }
 
+// MarshalEC2 adds the parameters of the ReservedInstancesConfiguration to the form, prefixing their names.
+func (v *ReservedInstancesConfiguration) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.InstanceCount != nil {
+		form.Set(prefix+"InstanceCount", strconv.FormatInt(int64(*v.InstanceCount), 10))
+	}
+	if v.InstanceType != nil {
+		form.Set(prefix+"InstanceType", *v.InstanceType)
+	}
+	if v.Platform != nil {
+		form.Set(prefix+"Platform", *v.Platform)
+	}
+	return nil
+}
+
 // ReservedInstancesID is undocumented.
 type ReservedInstancesID struct {
 	ReservedInstancesID aws.StringValue `ec2:"ReservedInstancesId" xml:"reservedInstancesId"`
@@ -4742,13 +9684,13 @@ type ReservedInstancesID struct {
 type ReservedInstancesListing struct {
 	ClientToken                aws.StringValue `ec2:"ClientToken" xml:"clientToken"`
 	CreateDate                 time.Time       `ec2:"CreateDate" xml:"createDate"`
-	InstanceCounts             []InstanceCount `ec2:"InstanceCounts" xml:"instanceCounts>item"`
-	PriceSchedules             []PriceSchedule `ec2:"PriceSchedules" xml:"priceSchedules>item"`
+	InstanceCounts             []InstanceCount `ec2:"InstanceCounts,list" xml:"instanceCounts>item"`
+	PriceSchedules             []PriceSchedule `ec2:"PriceSchedules,list" xml:"priceSchedules>item"`
 	ReservedInstancesID        aws.StringValue `ec2:"ReservedInstancesId" xml:"reservedInstancesId"`
 	ReservedInstancesListingID aws.StringValue `ec2:"ReservedInstancesListingId" xml:"reservedInstancesListingId"`
 	Status                     aws.StringValue `ec2:"Status" xml:"status"`
 	StatusMessage              aws.StringValue `ec2:"StatusMessage" xml:"statusMessage"`
-	Tags                       []Tag           `ec2:"Tags" xml:"tagSet>item"`
+	Tags                       []Tag           `ec2:"TagSet,list" xml:"tagSet>item"`
 	UpdateDate                 time.Time       `ec2:"UpdateDate" xml:"updateDate"`
 }
 
@@ -4757,8 +9699,8 @@ type ReservedInstancesModification struct {
 	ClientToken                     aws.StringValue                       `ec2:"ClientToken" xml:"clientToken"`
 	CreateDate                      time.Time                             `ec2:"CreateDate" xml:"createDate"`
 	EffectiveDate                   time.Time                             `ec2:"EffectiveDate" xml:"effectiveDate"`
-	ModificationResults             []ReservedInstancesModificationResult `ec2:"ModificationResults" xml:"modificationResultSet>item"`
-	ReservedInstancesIDs            []ReservedInstancesID                 `ec2:"ReservedInstancesIds" xml:"reservedInstancesSet>item"`
+	ModificationResults             []ReservedInstancesModificationResult `ec2:"ModificationResultSet,list" xml:"modificationResultSet>item"`
+	ReservedInstancesIDs            []ReservedInstancesID                 `ec2:"ReservedInstancesSet,list" xml:"reservedInstancesSet>item"`
 	ReservedInstancesModificationID aws.StringValue                       `ec2:"ReservedInstancesModificationId" xml:"reservedInstancesModificationId"`
 	Status                          aws.StringValue                       `ec2:"Status" xml:"status"`
 	StatusMessage                   aws.StringValue                       `ec2:"StatusMessage" xml:"statusMessage"`
@@ -4781,9 +9723,9 @@ type ReservedInstancesOffering struct {
 	InstanceType                aws.StringValue   `ec2:"InstanceType" xml:"instanceType"`
 	Marketplace                 aws.BooleanValue  `ec2:"Marketplace" xml:"marketplace"`
 	OfferingType                aws.StringValue   `ec2:"OfferingType" xml:"offeringType"`
-	PricingDetails              []PricingDetail   `ec2:"PricingDetails" xml:"pricingDetailsSet>item"`
+	PricingDetails              []PricingDetail   `ec2:"PricingDetailsSet,list" xml:"pricingDetailsSet>item"`
 	ProductDescription          aws.StringValue   `ec2:"ProductDescription" xml:"productDescription"`
-	RecurringCharges            []RecurringCharge `ec2:"RecurringCharges" xml:"recurringCharges>item"`
+	RecurringCharges            []RecurringCharge `ec2:"RecurringCharges,list" xml:"recurringCharges>item"`
 	ReservedInstancesOfferingID aws.StringValue   `ec2:"ReservedInstancesOfferingId" xml:"reservedInstancesOfferingId"`
 	UsagePrice                  aws.FloatValue    `ec2:"UsagePrice" xml:"usagePrice"`
 }
@@ -4800,6 +9742,23 @@ type ResetImageAttributeRequest struct {
 	ImageID   aws.StringValue  `ec2:"ImageId" xml:"ImageId"`
 }
 
+// MarshalEC2 adds the parameters of the ResetImageAttributeRequest to the form, prefixing their names.
+func (v *ResetImageAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.ImageID != nil {
+		form.Set(prefix+"ImageId", *v.ImageID)
+	}
+	return nil
+}
+
 // ResetInstanceAttributeRequest is undocumented.
 type ResetInstanceAttributeRequest struct {
 	Attribute  aws.StringValue  `ec2:"Attribute" xml:"attribute"`
@@ -4807,6 +9766,23 @@ type ResetInstanceAttributeRequest struct {
 	InstanceID aws.StringValue  `ec2:"InstanceId" xml:"instanceId"`
 }
 
+// MarshalEC2 adds the parameters of the ResetInstanceAttributeRequest to the form, prefixing their names.
+func (v *ResetInstanceAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	return nil
+}
+
 // ResetNetworkInterfaceAttributeRequest is undocumented.
 type ResetNetworkInterfaceAttributeRequest struct {
 	DryRun             aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
@@ -4814,6 +9790,23 @@ type ResetNetworkInterfaceAttributeRequest struct {
 	SourceDestCheck    aws.StringValue  `ec2:"SourceDestCheck" xml:"sourceDestCheck"`
 }
 
+// MarshalEC2 adds the parameters of the ResetNetworkInterfaceAttributeRequest to the form, prefixing their names.
+func (v *ResetNetworkInterfaceAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.SourceDestCheck != nil {
+		form.Set(prefix+"SourceDestCheck", *v.SourceDestCheck)
+	}
+	return nil
+}
+
 // ResetSnapshotAttributeRequest is undocumented.
 type ResetSnapshotAttributeRequest struct {
 	Attribute  aws.StringValue  `ec2:"Attribute" xml:"Attribute"`
@@ -4821,6 +9814,23 @@ type ResetSnapshotAttributeRequest struct {
 	SnapshotID aws.StringValue  `ec2:"SnapshotId" xml:"SnapshotId"`
 }
 
+// MarshalEC2 adds the parameters of the ResetSnapshotAttributeRequest to the form, prefixing their names.
+func (v *ResetSnapshotAttributeRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attribute != nil {
+		form.Set(prefix+"Attribute", *v.Attribute)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.SnapshotID != nil {
+		form.Set(prefix+"SnapshotId", *v.SnapshotID)
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	ResourceTypeCustomerGateway      = "customer-gateway"
@@ -4848,13 +9858,53 @@ type RevokeSecurityGroupEgressRequest struct {
 	DryRun                     aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	FromPort                   aws.IntegerValue `ec2:"FromPort" xml:"fromPort"`
 	GroupID                    aws.StringValue  `ec2:"GroupId" xml:"groupId"`
-	IPPermissions              []IPPermission   `ec2:"IpPermissions" xml:"ipPermissions>item"`
+	IPPermissions              []IPPermission   `ec2:"IpPermissions,list" xml:"ipPermissions>item"`
 	IPProtocol                 aws.StringValue  `ec2:"IpProtocol" xml:"ipProtocol"`
 	SourceSecurityGroupName    aws.StringValue  `ec2:"SourceSecurityGroupName" xml:"sourceSecurityGroupName"`
 	SourceSecurityGroupOwnerID aws.StringValue  `ec2:"SourceSecurityGroupOwnerId" xml:"sourceSecurityGroupOwnerId"`
 	ToPort                     aws.IntegerValue `ec2:"ToPort" xml:"toPort"`
 }
 
+// MarshalEC2 adds the parameters of the RevokeSecurityGroupEgressRequest to the form, prefixing their names.
+func (v *RevokeSecurityGroupEgressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CidrIp", *v.CIDRIP)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.FromPort != nil {
+		form.Set(prefix+"FromPort", strconv.FormatInt(int64(*v.FromPort), 10))
+	}
+	if v.GroupID != nil {
+		form.Set(prefix+"GroupId", *v.GroupID)
+	}
+	if v.IPPermissions != nil {
+		for i1 := range v.IPPermissions {
+			name1 := prefix + "IpPermissions." + strconv.Itoa(i1+1)
+			if err := v.IPPermissions[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.IPProtocol != nil {
+		form.Set(prefix+"IpProtocol", *v.IPProtocol)
+	}
+	if v.SourceSecurityGroupName != nil {
+		form.Set(prefix+"SourceSecurityGroupName", *v.SourceSecurityGroupName)
+	}
+	if v.SourceSecurityGroupOwnerID != nil {
+		form.Set(prefix+"SourceSecurityGroupOwnerId", *v.SourceSecurityGroupOwnerID)
+	}
+	if v.ToPort != nil {
+		form.Set(prefix+"ToPort", strconv.FormatInt(int64(*v.ToPort), 10))
+	}
+	return nil
+}
+
 // RevokeSecurityGroupIngressRequest is undocumented.
 type RevokeSecurityGroupIngressRequest struct {
 	CIDRIP                     aws.StringValue  `ec2:"CidrIp" xml:"CidrIp"`
@@ -4862,13 +9912,56 @@ type RevokeSecurityGroupIngressRequest struct {
 	FromPort                   aws.IntegerValue `ec2:"FromPort" xml:"FromPort"`
 	GroupID                    aws.StringValue  `ec2:"GroupId" xml:"GroupId"`
 	GroupName                  aws.StringValue  `ec2:"GroupName" xml:"GroupName"`
-	IPPermissions              []IPPermission   `ec2:"IpPermissions" xml:"IpPermissions>item"`
+	IPPermissions              []IPPermission   `ec2:"IpPermissions,list" xml:"IpPermissions>item"`
 	IPProtocol                 aws.StringValue  `ec2:"IpProtocol" xml:"IpProtocol"`
 	SourceSecurityGroupName    aws.StringValue  `ec2:"SourceSecurityGroupName" xml:"SourceSecurityGroupName"`
 	SourceSecurityGroupOwnerID aws.StringValue  `ec2:"SourceSecurityGroupOwnerId" xml:"SourceSecurityGroupOwnerId"`
 	ToPort                     aws.IntegerValue `ec2:"ToPort" xml:"ToPort"`
 }
 
+// MarshalEC2 adds the parameters of the RevokeSecurityGroupIngressRequest to the form, prefixing their names.
+func (v *RevokeSecurityGroupIngressRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CidrIp", *v.CIDRIP)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.FromPort != nil {
+		form.Set(prefix+"FromPort", strconv.FormatInt(int64(*v.FromPort), 10))
+	}
+	if v.GroupID != nil {
+		form.Set(prefix+"GroupId", *v.GroupID)
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.IPPermissions != nil {
+		for i1 := range v.IPPermissions {
+			name1 := prefix + "IpPermissions." + strconv.Itoa(i1+1)
+			if err := v.IPPermissions[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.IPProtocol != nil {
+		form.Set(prefix+"IpProtocol", *v.IPProtocol)
+	}
+	if v.SourceSecurityGroupName != nil {
+		form.Set(prefix+"SourceSecurityGroupName", *v.SourceSecurityGroupName)
+	}
+	if v.SourceSecurityGroupOwnerID != nil {
+		form.Set(prefix+"SourceSecurityGroupOwnerId", *v.SourceSecurityGroupOwnerID)
+	}
+	if v.ToPort != nil {
+		form.Set(prefix+"ToPort", strconv.FormatInt(int64(*v.ToPort), 10))
+	}
+	return nil
+}
+
 // Route is undocumented.
 type Route struct {
 	DestinationCIDRBlock   aws.StringValue `ec2:"DestinationCidrBlock" xml:"destinationCidrBlock"`
@@ -4896,11 +9989,11 @@ const (
 
 // RouteTable is undocumented.
 type RouteTable struct {
-	Associations    []RouteTableAssociation `ec2:"Associations" xml:"associationSet>item"`
-	PropagatingVGWs []PropagatingVGW        `ec2:"PropagatingVgws" xml:"propagatingVgwSet>item"`
+	Associations    []RouteTableAssociation `ec2:"AssociationSet,list" xml:"associationSet>item"`
+	PropagatingVGWs []PropagatingVGW        `ec2:"PropagatingVgwSet,list" xml:"propagatingVgwSet>item"`
 	RouteTableID    aws.StringValue         `ec2:"RouteTableId" xml:"routeTableId"`
-	Routes          []Route                 `ec2:"Routes" xml:"routeSet>item"`
-	Tags            []Tag                   `ec2:"Tags" xml:"tagSet>item"`
+	Routes          []Route                 `ec2:"RouteSet,list" xml:"routeSet>item"`
+	Tags            []Tag                   `ec2:"TagSet,list" xml:"tagSet>item"`
 	VPCID           aws.StringValue         `ec2:"VpcId" xml:"vpcId"`
 }
 
@@ -4923,10 +10016,21 @@ type RunInstancesMonitoringEnabled struct {
 	Enabled aws.BooleanValue `ec2:"Enabled" xml:"enabled"`
 }
 
+// MarshalEC2 adds the parameters of the RunInstancesMonitoringEnabled to the form, prefixing their names.
+func (v *RunInstancesMonitoringEnabled) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	return nil
+}
+
 // RunInstancesRequest is undocumented.
 type RunInstancesRequest struct {
 	AdditionalInfo                    aws.StringValue                         `ec2:"AdditionalInfo" xml:"additionalInfo"`
-	BlockDeviceMappings               []BlockDeviceMapping                    `ec2:"BlockDeviceMapping" xml:"BlockDeviceMapping>BlockDeviceMapping"`
+	BlockDeviceMappings               []BlockDeviceMapping                    `ec2:"BlockDeviceMapping,list" xml:"BlockDeviceMapping>BlockDeviceMapping"`
 	ClientToken                       aws.StringValue                         `ec2:"ClientToken" xml:"clientToken"`
 	DisableAPITermination             aws.BooleanValue                        `ec2:"DisableApiTermination" xml:"disableApiTermination"`
 	DryRun                            aws.BooleanValue                        `ec2:"DryRun" xml:"dryRun"`
@@ -4940,16 +10044,115 @@ type RunInstancesRequest struct {
 	MaxCount                          aws.IntegerValue                        `ec2:"MaxCount" xml:"MaxCount"`
 	MinCount                          aws.IntegerValue                        `ec2:"MinCount" xml:"MinCount"`
 	Monitoring                        *RunInstancesMonitoringEnabled          `ec2:"Monitoring" xml:"Monitoring"`
-	NetworkInterfaces                 []InstanceNetworkInterfaceSpecification `ec2:"NetworkInterfaces" xml:"networkInterface>item"`
+	NetworkInterfaces                 []InstanceNetworkInterfaceSpecification `ec2:"NetworkInterface,list" xml:"networkInterface>item"`
 	Placement                         *Placement                              `ec2:"Placement" xml:"Placement"`
 	PrivateIPAddress                  aws.StringValue                         `ec2:"PrivateIpAddress" xml:"privateIpAddress"`
 	RAMDiskID                         aws.StringValue                         `ec2:"RamdiskId" xml:"RamdiskId"`
-	SecurityGroupIDs                  []string                                `ec2:"SecurityGroupId" xml:"SecurityGroupId>SecurityGroupId"`
-	SecurityGroups                    []string                                `ec2:"SecurityGroup" xml:"SecurityGroup>SecurityGroup"`
+	SecurityGroupIDs                  []string                                `ec2:"SecurityGroupId,list" xml:"SecurityGroupId>SecurityGroupId"`
+	SecurityGroups                    []string                                `ec2:"SecurityGroup,list" xml:"SecurityGroup>SecurityGroup"`
 	SubnetID                          aws.StringValue                         `ec2:"SubnetId" xml:"SubnetId"`
 	UserData                          aws.StringValue                         `ec2:"UserData" xml:"UserData"`
 }
 
+// MarshalEC2 adds the parameters of the RunInstancesRequest to the form, prefixing their names.
+func (v *RunInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AdditionalInfo != nil {
+		form.Set(prefix+"AdditionalInfo", *v.AdditionalInfo)
+	}
+	if v.BlockDeviceMappings != nil {
+		for i1 := range v.BlockDeviceMappings {
+			name1 := prefix + "BlockDeviceMapping." + strconv.Itoa(i1+1)
+			if err := v.BlockDeviceMappings[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ClientToken != nil {
+		form.Set(prefix+"ClientToken", *v.ClientToken)
+	}
+	if v.DisableAPITermination != nil {
+		form.Set(prefix+"DisableApiTermination", strconv.FormatBool(*v.DisableAPITermination))
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.EBSOptimized != nil {
+		form.Set(prefix+"EbsOptimized", strconv.FormatBool(*v.EBSOptimized))
+	}
+	if v.IAMInstanceProfile != nil {
+		if err := v.IAMInstanceProfile.MarshalEC2(form, prefix+"IamInstanceProfile."); err != nil {
+			return err
+		}
+	}
+	if v.ImageID != nil {
+		form.Set(prefix+"ImageId", *v.ImageID)
+	}
+	if v.InstanceInitiatedShutdownBehavior != nil {
+		form.Set(prefix+"InstanceInitiatedShutdownBehavior", *v.InstanceInitiatedShutdownBehavior)
+	}
+	if v.InstanceType != nil {
+		form.Set(prefix+"InstanceType", *v.InstanceType)
+	}
+	if v.KernelID != nil {
+		form.Set(prefix+"KernelId", *v.KernelID)
+	}
+	if v.KeyName != nil {
+		form.Set(prefix+"KeyName", *v.KeyName)
+	}
+	if v.MaxCount != nil {
+		form.Set(prefix+"MaxCount", strconv.FormatInt(int64(*v.MaxCount), 10))
+	}
+	if v.MinCount != nil {
+		form.Set(prefix+"MinCount", strconv.FormatInt(int64(*v.MinCount), 10))
+	}
+	if v.Monitoring != nil {
+		if err := v.Monitoring.MarshalEC2(form, prefix+"Monitoring."); err != nil {
+			return err
+		}
+	}
+	if v.NetworkInterfaces != nil {
+		for i1 := range v.NetworkInterfaces {
+			name1 := prefix + "NetworkInterface." + strconv.Itoa(i1+1)
+			if err := v.NetworkInterfaces[i1].MarshalEC2(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Placement != nil {
+		if err := v.Placement.MarshalEC2(form, prefix+"Placement."); err != nil {
+			return err
+		}
+	}
+	if v.PrivateIPAddress != nil {
+		form.Set(prefix+"PrivateIpAddress", *v.PrivateIPAddress)
+	}
+	if v.RAMDiskID != nil {
+		form.Set(prefix+"RamdiskId", *v.RAMDiskID)
+	}
+	if v.SecurityGroupIDs != nil {
+		for i1 := range v.SecurityGroupIDs {
+			name1 := prefix + "SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroupIDs[i1])
+		}
+	}
+	if v.SecurityGroups != nil {
+		for i1 := range v.SecurityGroups {
+			name1 := prefix + "SecurityGroup." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroups[i1])
+		}
+	}
+	if v.SubnetID != nil {
+		form.Set(prefix+"SubnetId", *v.SubnetID)
+	}
+	if v.UserData != nil {
+		form.Set(prefix+"UserData", *v.UserData)
+	}
+	return nil
+}
+
 // S3Storage is undocumented.
 type S3Storage struct {
 	AWSAccessKeyID        aws.StringValue `ec2:"AWSAccessKeyId" xml:"AWSAccessKeyId"`
@@ -4959,15 +10162,38 @@ type S3Storage struct {
 	UploadPolicySignature aws.StringValue `ec2:"UploadPolicySignature" xml:"uploadPolicySignature"`
 }
 
+// MarshalEC2 adds the parameters of the S3Storage to the form, prefixing their names.
+func (v *S3Storage) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AWSAccessKeyID != nil {
+		form.Set(prefix+"AWSAccessKeyId", *v.AWSAccessKeyID)
+	}
+	if v.Bucket != nil {
+		form.Set(prefix+"Bucket", *v.Bucket)
+	}
+	if v.Prefix != nil {
+		form.Set(prefix+"Prefix", *v.Prefix)
+	}
+	if v.UploadPolicy != nil {
+		form.Set(prefix+"UploadPolicy", base64.StdEncoding.EncodeToString(v.UploadPolicy))
+	}
+	if v.UploadPolicySignature != nil {
+		form.Set(prefix+"UploadPolicySignature", *v.UploadPolicySignature)
+	}
+	return nil
+}
+
 // SecurityGroup is undocumented.
 type SecurityGroup struct {
-	Description         aws.StringValue `ec2:"Description" xml:"groupDescription"`
+	Description         aws.StringValue `ec2:"GroupDescription" xml:"groupDescription"`
 	GroupID             aws.StringValue `ec2:"GroupId" xml:"groupId"`
 	GroupName           aws.StringValue `ec2:"GroupName" xml:"groupName"`
-	IPPermissions       []IPPermission  `ec2:"IpPermissions" xml:"ipPermissions>item"`
-	IPPermissionsEgress []IPPermission  `ec2:"IpPermissionsEgress" xml:"ipPermissionsEgress>item"`
+	IPPermissions       []IPPermission  `ec2:"IpPermissions,list" xml:"ipPermissions>item"`
+	IPPermissionsEgress []IPPermission  `ec2:"IpPermissionsEgress,list" xml:"ipPermissionsEgress>item"`
 	OwnerID             aws.StringValue `ec2:"OwnerId" xml:"ownerId"`
-	Tags                []Tag           `ec2:"Tags" xml:"tagSet>item"`
+	Tags                []Tag           `ec2:"TagSet,list" xml:"tagSet>item"`
 	VPCID               aws.StringValue `ec2:"VpcId" xml:"vpcId"`
 }
 
@@ -4987,8 +10213,8 @@ type Snapshot struct {
 	Progress    aws.StringValue  `ec2:"Progress" xml:"progress"`
 	SnapshotID  aws.StringValue  `ec2:"SnapshotId" xml:"snapshotId"`
 	StartTime   time.Time        `ec2:"StartTime" xml:"startTime"`
-	State       aws.StringValue  `ec2:"State" xml:"status"`
-	Tags        []Tag            `ec2:"Tags" xml:"tagSet>item"`
+	State       aws.StringValue  `ec2:"Status" xml:"status"`
+	Tags        []Tag            `ec2:"TagSet,list" xml:"tagSet>item"`
 	VolumeID    aws.StringValue  `ec2:"VolumeId" xml:"volumeId"`
 	VolumeSize  aws.IntegerValue `ec2:"VolumeSize" xml:"volumeSize"`
 }
@@ -5029,7 +10255,7 @@ type SpotInstanceRequest struct {
 	SpotPrice                aws.StringValue         `ec2:"SpotPrice" xml:"spotPrice"`
 	State                    aws.StringValue         `ec2:"State" xml:"state"`
 	Status                   *SpotInstanceStatus     `ec2:"Status" xml:"status"`
-	Tags                     []Tag                   `ec2:"Tags" xml:"tagSet>item"`
+	Tags                     []Tag                   `ec2:"TagSet,list" xml:"tagSet>item"`
 	Type                     aws.StringValue         `ec2:"Type" xml:"type"`
 	ValidFrom                time.Time               `ec2:"ValidFrom" xml:"validFrom"`
 	ValidUntil               time.Time               `ec2:"ValidUntil" xml:"validUntil"`
@@ -5069,6 +10295,20 @@ type SpotPlacement struct {
 	GroupName        aws.StringValue `ec2:"GroupName" xml:"groupName"`
 }
 
+// MarshalEC2 adds the parameters of the SpotPlacement to the form, prefixing their names.
+func (v *SpotPlacement) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	return nil
+}
+
 // SpotPrice is undocumented.
 type SpotPrice struct {
 	AvailabilityZone   aws.StringValue `ec2:"AvailabilityZone" xml:"availabilityZone"`
@@ -5082,12 +10322,32 @@ type SpotPrice struct {
 type StartInstancesRequest struct {
 	AdditionalInfo aws.StringValue  `ec2:"AdditionalInfo" xml:"additionalInfo"`
 	DryRun         aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	InstanceIDs    []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	InstanceIDs    []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
+}
+
+// MarshalEC2 adds the parameters of the StartInstancesRequest to the form, prefixing their names.
+func (v *StartInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AdditionalInfo != nil {
+		form.Set(prefix+"AdditionalInfo", *v.AdditionalInfo)
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // StartInstancesResult is undocumented.
 type StartInstancesResult struct {
-	StartingInstances []InstanceStateChange `ec2:"StartingInstances" xml:"instancesSet>item"`
+	StartingInstances []InstanceStateChange `ec2:"InstancesSet,list" xml:"instancesSet>item"`
 }
 
 // StateReason is undocumented.
@@ -5112,12 +10372,32 @@ const (
 type StopInstancesRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
 	Force       aws.BooleanValue `ec2:"Force" xml:"force"`
-	InstanceIDs []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	InstanceIDs []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
+}
+
+// MarshalEC2 adds the parameters of the StopInstancesRequest to the form, prefixing their names.
+func (v *StopInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.Force != nil {
+		form.Set(prefix+"Force", strconv.FormatBool(*v.Force))
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // StopInstancesResult is undocumented.
 type StopInstancesResult struct {
-	StoppingInstances []InstanceStateChange `ec2:"StoppingInstances" xml:"instancesSet>item"`
+	StoppingInstances []InstanceStateChange `ec2:"InstancesSet,list" xml:"instancesSet>item"`
 }
 
 // Storage is undocumented.
@@ -5125,6 +10405,19 @@ type Storage struct {
 	S3 *S3Storage `ec2:"S3" xml:"S3"`
 }
 
+// MarshalEC2 adds the parameters of the Storage to the form, prefixing their names.
+func (v *Storage) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.S3 != nil {
+		if err := v.S3.MarshalEC2(form, prefix+"S3."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Subnet is undocumented.
 type Subnet struct {
 	AvailabilityZone        aws.StringValue  `ec2:"AvailabilityZone" xml:"availabilityZone"`
@@ -5134,7 +10427,7 @@ type Subnet struct {
 	MapPublicIPOnLaunch     aws.BooleanValue `ec2:"MapPublicIpOnLaunch" xml:"mapPublicIpOnLaunch"`
 	State                   aws.StringValue  `ec2:"State" xml:"state"`
 	SubnetID                aws.StringValue  `ec2:"SubnetId" xml:"subnetId"`
-	Tags                    []Tag            `ec2:"Tags" xml:"tagSet>item"`
+	Tags                    []Tag            `ec2:"TagSet,list" xml:"tagSet>item"`
 	VPCID                   aws.StringValue  `ec2:"VpcId" xml:"vpcId"`
 }
 
@@ -5158,6 +10451,20 @@ type Tag struct {
 	Value aws.StringValue `ec2:"Value" xml:"value"`
 }
 
+// MarshalEC2 adds the parameters of the Tag to the form, prefixing their names.
+func (v *Tag) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // TagDescription is undocumented.
 type TagDescription struct {
 	Key          aws.StringValue `ec2:"Key" xml:"key"`
@@ -5181,29 +10488,80 @@ const (
 // TerminateInstancesRequest is undocumented.
 type TerminateInstancesRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	InstanceIDs []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	InstanceIDs []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
+}
+
+// MarshalEC2 adds the parameters of the TerminateInstancesRequest to the form, prefixing their names.
+func (v *TerminateInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // TerminateInstancesResult is undocumented.
 type TerminateInstancesResult struct {
-	TerminatingInstances []InstanceStateChange `ec2:"TerminatingInstances" xml:"instancesSet>item"`
+	TerminatingInstances []InstanceStateChange `ec2:"InstancesSet,list" xml:"instancesSet>item"`
 }
 
 // UnassignPrivateIPAddressesRequest is undocumented.
 type UnassignPrivateIPAddressesRequest struct {
 	NetworkInterfaceID aws.StringValue `ec2:"NetworkInterfaceId" xml:"networkInterfaceId"`
-	PrivateIPAddresses []string        `ec2:"PrivateIpAddresses" xml:"privateIpAddress>PrivateIpAddress"`
+	PrivateIPAddresses []string        `ec2:"PrivateIpAddress,list" xml:"privateIpAddress>PrivateIpAddress"`
+}
+
+// MarshalEC2 adds the parameters of the UnassignPrivateIPAddressesRequest to the form, prefixing their names.
+func (v *UnassignPrivateIPAddressesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NetworkInterfaceID != nil {
+		form.Set(prefix+"NetworkInterfaceId", *v.NetworkInterfaceID)
+	}
+	if v.PrivateIPAddresses != nil {
+		for i1 := range v.PrivateIPAddresses {
+			name1 := prefix + "PrivateIpAddress." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PrivateIPAddresses[i1])
+		}
+	}
+	return nil
 }
 
 // UnmonitorInstancesRequest is undocumented.
 type UnmonitorInstancesRequest struct {
 	DryRun      aws.BooleanValue `ec2:"DryRun" xml:"dryRun"`
-	InstanceIDs []string         `ec2:"InstanceId" xml:"InstanceId>InstanceId"`
+	InstanceIDs []string         `ec2:"InstanceId,list" xml:"InstanceId>InstanceId"`
+}
+
+// MarshalEC2 adds the parameters of the UnmonitorInstancesRequest to the form, prefixing their names.
+func (v *UnmonitorInstancesRequest) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DryRun != nil {
+		form.Set(prefix+"DryRun", strconv.FormatBool(*v.DryRun))
+	}
+	if v.InstanceIDs != nil {
+		for i1 := range v.InstanceIDs {
+			name1 := prefix + "InstanceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.InstanceIDs[i1])
+		}
+	}
+	return nil
 }
 
 // UnmonitorInstancesResult is undocumented.
 type UnmonitorInstancesResult struct {
-	InstanceMonitorings []InstanceMonitoring `ec2:"InstanceMonitorings" xml:"instancesSet>item"`
+	InstanceMonitorings []InstanceMonitoring `ec2:"InstancesSet,list" xml:"instancesSet>item"`
 }
 
 // UserIDGroupPair is undocumented.
@@ -5213,6 +10571,23 @@ type UserIDGroupPair struct {
 	UserID    aws.StringValue `ec2:"UserId" xml:"userId"`
 }
 
+// MarshalEC2 adds the parameters of the UserIDGroupPair to the form, prefixing their names.
+func (v *UserIDGroupPair) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupID != nil {
+		form.Set(prefix+"GroupId", *v.GroupID)
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.UserID != nil {
+		form.Set(prefix+"UserId", *v.UserID)
+	}
+	return nil
+}
+
 // VGWTelemetry is undocumented.
 type VGWTelemetry struct {
 	AcceptedRouteCount aws.IntegerValue `ec2:"AcceptedRouteCount" xml:"acceptedRouteCount"`
@@ -5230,7 +10605,7 @@ const (
 
 // Volume is undocumented.
 type Volume struct {
-	Attachments      []VolumeAttachment `ec2:"Attachments" xml:"attachmentSet>item"`
+	Attachments      []VolumeAttachment `ec2:"AttachmentSet,list" xml:"attachmentSet>item"`
 	AvailabilityZone aws.StringValue    `ec2:"AvailabilityZone" xml:"availabilityZone"`
 	CreateTime       time.Time          `ec2:"CreateTime" xml:"createTime"`
 	Encrypted        aws.BooleanValue   `ec2:"Encrypted" xml:"encrypted"`
@@ -5238,8 +10613,8 @@ type Volume struct {
 	KMSKeyID         aws.StringValue    `ec2:"KmsKeyId" xml:"kmsKeyId"`
 	Size             aws.IntegerValue   `ec2:"Size" xml:"size"`
 	SnapshotID       aws.StringValue    `ec2:"SnapshotId" xml:"snapshotId"`
-	State            aws.StringValue    `ec2:"State" xml:"status"`
-	Tags             []Tag              `ec2:"Tags" xml:"tagSet>item"`
+	State            aws.StringValue    `ec2:"Status" xml:"status"`
+	Tags             []Tag              `ec2:"TagSet,list" xml:"tagSet>item"`
 	VolumeID         aws.StringValue    `ec2:"VolumeId" xml:"volumeId"`
 	VolumeType       aws.StringValue    `ec2:"VolumeType" xml:"volumeType"`
 }
@@ -5250,7 +10625,7 @@ type VolumeAttachment struct {
 	DeleteOnTermination aws.BooleanValue `ec2:"DeleteOnTermination" xml:"deleteOnTermination"`
 	Device              aws.StringValue  `ec2:"Device" xml:"device"`
 	InstanceID          aws.StringValue  `ec2:"InstanceId" xml:"instanceId"`
-	State               aws.StringValue  `ec2:"State" xml:"status"`
+	State               aws.StringValue  `ec2:"Status" xml:"status"`
 	VolumeID            aws.StringValue  `ec2:"VolumeId" xml:"volumeId"`
 }
 
@@ -5273,6 +10648,17 @@ type VolumeDetail struct {
 	Size aws.LongValue `ec2:"Size" xml:"size"`
 }
 
+// MarshalEC2 adds the parameters of the VolumeDetail to the form, prefixing their names.
+func (v *VolumeDetail) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Size != nil {
+		form.Set(prefix+"Size", strconv.FormatInt(int64(*v.Size), 10))
+	}
+	return nil
+}
+
 // Possible values for EC2.
 const (
 	VolumeStateAvailable = "available"
@@ -5308,7 +10694,7 @@ type VolumeStatusEvent struct {
 
 // VolumeStatusInfo is undocumented.
 type VolumeStatusInfo struct {
-	Details []VolumeStatusDetails `ec2:"Details" xml:"details>item"`
+	Details []VolumeStatusDetails `ec2:"Details,list" xml:"details>item"`
 	Status  aws.StringValue       `ec2:"Status" xml:"status"`
 }
 
@@ -5321,9 +10707,9 @@ const (
 
 // VolumeStatusItem is undocumented.
 type VolumeStatusItem struct {
-	Actions          []VolumeStatusAction `ec2:"Actions" xml:"actionsSet>item"`
+	Actions          []VolumeStatusAction `ec2:"ActionsSet,list" xml:"actionsSet>item"`
 	AvailabilityZone aws.StringValue      `ec2:"AvailabilityZone" xml:"availabilityZone"`
-	Events           []VolumeStatusEvent  `ec2:"Events" xml:"eventsSet>item"`
+	Events           []VolumeStatusEvent  `ec2:"EventsSet,list" xml:"eventsSet>item"`
 	VolumeID         aws.StringValue      `ec2:"VolumeId" xml:"volumeId"`
 	VolumeStatus     *VolumeStatusInfo    `ec2:"VolumeStatus" xml:"volumeStatus"`
 }
@@ -5348,7 +10734,7 @@ type VPC struct {
 	InstanceTenancy aws.StringValue  `ec2:"InstanceTenancy" xml:"instanceTenancy"`
 	IsDefault       aws.BooleanValue `ec2:"IsDefault" xml:"isDefault"`
 	State           aws.StringValue  `ec2:"State" xml:"state"`
-	Tags            []Tag            `ec2:"Tags" xml:"tagSet>item"`
+	Tags            []Tag            `ec2:"TagSet,list" xml:"tagSet>item"`
 	VPCID           aws.StringValue  `ec2:"VpcId" xml:"vpcId"`
 }
 
@@ -5370,7 +10756,7 @@ type VPCPeeringConnection struct {
 	ExpirationTime         time.Time                        `ec2:"ExpirationTime" xml:"expirationTime"`
 	RequesterVPCInfo       *VPCPeeringConnectionVPCInfo     `ec2:"RequesterVpcInfo" xml:"requesterVpcInfo"`
 	Status                 *VPCPeeringConnectionStateReason `ec2:"Status" xml:"status"`
-	Tags                   []Tag                            `ec2:"Tags" xml:"tagSet>item"`
+	Tags                   []Tag                            `ec2:"TagSet,list" xml:"tagSet>item"`
 	VPCPeeringConnectionID aws.StringValue                  `ec2:"VpcPeeringConnectionId" xml:"vpcPeeringConnectionId"`
 }
 
@@ -5398,11 +10784,11 @@ type VPNConnection struct {
 	CustomerGatewayConfiguration aws.StringValue       `ec2:"CustomerGatewayConfiguration" xml:"customerGatewayConfiguration"`
 	CustomerGatewayID            aws.StringValue       `ec2:"CustomerGatewayId" xml:"customerGatewayId"`
 	Options                      *VPNConnectionOptions `ec2:"Options" xml:"options"`
-	Routes                       []VPNStaticRoute      `ec2:"Routes" xml:"routes>item"`
+	Routes                       []VPNStaticRoute      `ec2:"Routes,list" xml:"routes>item"`
 	State                        aws.StringValue       `ec2:"State" xml:"state"`
-	Tags                         []Tag                 `ec2:"Tags" xml:"tagSet>item"`
+	Tags                         []Tag                 `ec2:"TagSet,list" xml:"tagSet>item"`
 	Type                         aws.StringValue       `ec2:"Type" xml:"type"`
-	VGWTelemetry                 []VGWTelemetry        `ec2:"VgwTelemetry" xml:"vgwTelemetry>item"`
+	VGWTelemetry                 []VGWTelemetry        `ec2:"VgwTelemetry,list" xml:"vgwTelemetry>item"`
 	VPNConnectionID              aws.StringValue       `ec2:"VpnConnectionId" xml:"vpnConnectionId"`
 	VPNGatewayID                 aws.StringValue       `ec2:"VpnGatewayId" xml:"vpnGatewayId"`
 }
@@ -5417,13 +10803,24 @@ type VPNConnectionOptionsSpecification struct {
 	StaticRoutesOnly aws.BooleanValue `ec2:"StaticRoutesOnly" xml:"staticRoutesOnly"`
 }
 
+// MarshalEC2 adds the parameters of the VPNConnectionOptionsSpecification to the form, prefixing their names.
+func (v *VPNConnectionOptionsSpecification) MarshalEC2(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.StaticRoutesOnly != nil {
+		form.Set(prefix+"StaticRoutesOnly", strconv.FormatBool(*v.StaticRoutesOnly))
+	}
+	return nil
+}
+
 // VPNGateway is undocumented.
 type VPNGateway struct {
 	AvailabilityZone aws.StringValue `ec2:"AvailabilityZone" xml:"availabilityZone"`
 	State            aws.StringValue `ec2:"State" xml:"state"`
-	Tags             []Tag           `ec2:"Tags" xml:"tagSet>item"`
+	Tags             []Tag           `ec2:"TagSet,list" xml:"tagSet>item"`
 	Type             aws.StringValue `ec2:"Type" xml:"type"`
-	VPCAttachments   []VPCAttachment `ec2:"VpcAttachments" xml:"attachments>item"`
+	VPCAttachments   []VPCAttachment `ec2:"Attachments,list" xml:"attachments>item"`
 	VPNGatewayID     aws.StringValue `ec2:"VpnGatewayId" xml:"vpnGatewayId"`
 }
 
@@ -5447,5 +10844,14 @@ const (
 	VPNStaticRouteSourceStatic = "Static"
 )
 
+// exceptions maps EC2's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
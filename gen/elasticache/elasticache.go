@@ -4,11 +4,21 @@
 package elasticcache
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // ElasticCache is a client for Amazon ElastiCache.
@@ -16,45 +26,100 @@ type ElasticCache struct {
 	client *aws.QueryClient
 }
 
-// New returns a new ElasticCache client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *ElasticCache {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "elasticache"
-	endpoint, service, region := endpoints.Lookup("elasticache", region)
+// New returns a new ElasticCache client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *ElasticCache {
+	cfg := newConfig(region, client, opts)
 
 	return &ElasticCache{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2014-09-30",
 		},
 	}
 }
 
+// NewFromSession returns a new ElasticCache client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *ElasticCache {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("elasticache", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("elasticache", "elasticcache"),
+	}, "AWS_ENDPOINT_URL_ELASTICACHE", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *ElasticCache) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *ElasticCache) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *ElasticCache) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AuthorizeCacheSecurityGroupIngress the
 // AuthorizeCacheSecurityGroupIngress operation allows network ingress to a
 // cache security group. Applications using ElastiCache must be running on
 // Amazon EC2, and Amazon EC2 security groups are used as the authorization
 // mechanism.
 func (c *ElasticCache) AuthorizeCacheSecurityGroupIngress(req *AuthorizeCacheSecurityGroupIngressMessage) (resp *AuthorizeCacheSecurityGroupIngressResult, err error) {
+	return c.AuthorizeCacheSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// AuthorizeCacheSecurityGroupIngressWithContext is like AuthorizeCacheSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) AuthorizeCacheSecurityGroupIngressWithContext(ctx context.Context, req *AuthorizeCacheSecurityGroupIngressMessage) (resp *AuthorizeCacheSecurityGroupIngressResult, err error) {
 	resp = &AuthorizeCacheSecurityGroupIngressResult{}
-	err = c.client.Do("AuthorizeCacheSecurityGroupIngress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AuthorizeCacheSecurityGroupIngress", "POST", "/", req, resp)
 	return
 }
 
 // CopySnapshot the CopySnapshot operation makes a copy of an existing
 // snapshot.
 func (c *ElasticCache) CopySnapshot(req *CopySnapshotMessage) (resp *CopySnapshotResult, err error) {
+	return c.CopySnapshotWithContext(context.Background(), req)
+}
+
+// CopySnapshotWithContext is like CopySnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) CopySnapshotWithContext(ctx context.Context, req *CopySnapshotMessage) (resp *CopySnapshotResult, err error) {
 	resp = &CopySnapshotResult{}
-	err = c.client.Do("CopySnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CopySnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -62,8 +127,15 @@ func (c *ElasticCache) CopySnapshot(req *CopySnapshotMessage) (resp *CopySnapsho
 // cluster. All nodes in the cache cluster run the same protocol-compliant
 // cache engine software, either Memcached or Redis.
 func (c *ElasticCache) CreateCacheCluster(req *CreateCacheClusterMessage) (resp *CreateCacheClusterResult, err error) {
+	return c.CreateCacheClusterWithContext(context.Background(), req)
+}
+
+// CreateCacheClusterWithContext is like CreateCacheCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) CreateCacheClusterWithContext(ctx context.Context, req *CreateCacheClusterMessage) (resp *CreateCacheClusterResult, err error) {
 	resp = &CreateCacheClusterResult{}
-	err = c.client.Do("CreateCacheCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCacheCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -72,8 +144,15 @@ func (c *ElasticCache) CreateCacheCluster(req *CreateCacheClusterMessage) (resp
 // collection of parameters that you apply to all of the nodes in a cache
 // cluster.
 func (c *ElasticCache) CreateCacheParameterGroup(req *CreateCacheParameterGroupMessage) (resp *CreateCacheParameterGroupResult, err error) {
+	return c.CreateCacheParameterGroupWithContext(context.Background(), req)
+}
+
+// CreateCacheParameterGroupWithContext is like CreateCacheParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) CreateCacheParameterGroupWithContext(ctx context.Context, req *CreateCacheParameterGroupMessage) (resp *CreateCacheParameterGroupResult, err error) {
 	resp = &CreateCacheParameterGroupResult{}
-	err = c.client.Do("CreateCacheParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCacheParameterGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -84,8 +163,15 @@ func (c *ElasticCache) CreateCacheParameterGroup(req *CreateCacheParameterGroupM
 // Cloud If you are creating a cache cluster inside of a use a cache subnet
 // group instead. For more information, see CreateCacheSubnetGroup
 func (c *ElasticCache) CreateCacheSecurityGroup(req *CreateCacheSecurityGroupMessage) (resp *CreateCacheSecurityGroupResult, err error) {
+	return c.CreateCacheSecurityGroupWithContext(context.Background(), req)
+}
+
+// CreateCacheSecurityGroupWithContext is like CreateCacheSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) CreateCacheSecurityGroupWithContext(ctx context.Context, req *CreateCacheSecurityGroupMessage) (resp *CreateCacheSecurityGroupResult, err error) {
 	resp = &CreateCacheSecurityGroupResult{}
-	err = c.client.Do("CreateCacheSecurityGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCacheSecurityGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -93,8 +179,15 @@ func (c *ElasticCache) CreateCacheSecurityGroup(req *CreateCacheSecurityGroupMes
 // new cache subnet group. Use this parameter only when you are creating a
 // cluster in an Amazon Virtual Private Cloud
 func (c *ElasticCache) CreateCacheSubnetGroup(req *CreateCacheSubnetGroupMessage) (resp *CreateCacheSubnetGroupResult, err error) {
+	return c.CreateCacheSubnetGroupWithContext(context.Background(), req)
+}
+
+// CreateCacheSubnetGroupWithContext is like CreateCacheSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) CreateCacheSubnetGroupWithContext(ctx context.Context, req *CreateCacheSubnetGroupMessage) (resp *CreateCacheSubnetGroupResult, err error) {
 	resp = &CreateCacheSubnetGroupResult{}
-	err = c.client.Do("CreateCacheSubnetGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCacheSubnetGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -108,16 +201,30 @@ func (c *ElasticCache) CreateCacheSubnetGroup(req *CreateCacheSubnetGroupMessage
 // add one or more read replica replicas to it, up to a total of five read
 // replicas. Note: This action is valid only for Redis.
 func (c *ElasticCache) CreateReplicationGroup(req *CreateReplicationGroupMessage) (resp *CreateReplicationGroupResult, err error) {
+	return c.CreateReplicationGroupWithContext(context.Background(), req)
+}
+
+// CreateReplicationGroupWithContext is like CreateReplicationGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) CreateReplicationGroupWithContext(ctx context.Context, req *CreateReplicationGroupMessage) (resp *CreateReplicationGroupResult, err error) {
 	resp = &CreateReplicationGroupResult{}
-	err = c.client.Do("CreateReplicationGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateReplicationGroup", "POST", "/", req, resp)
 	return
 }
 
 // CreateSnapshot the CreateSnapshot operation creates a copy of an entire
 // cache cluster at a specific moment in time.
 func (c *ElasticCache) CreateSnapshot(req *CreateSnapshotMessage) (resp *CreateSnapshotResult, err error) {
+	return c.CreateSnapshotWithContext(context.Background(), req)
+}
+
+// CreateSnapshotWithContext is like CreateSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) CreateSnapshotWithContext(ctx context.Context, req *CreateSnapshotMessage) (resp *CreateSnapshotResult, err error) {
 	resp = &CreateSnapshotResult{}
-	err = c.client.Do("CreateSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -130,8 +237,15 @@ func (c *ElasticCache) CreateSnapshot(req *CreateSnapshotMessage) (resp *CreateS
 // that is the last read replica of a replication group that has automatic
 // failover mode enabled.
 func (c *ElasticCache) DeleteCacheCluster(req *DeleteCacheClusterMessage) (resp *DeleteCacheClusterResult, err error) {
+	return c.DeleteCacheClusterWithContext(context.Background(), req)
+}
+
+// DeleteCacheClusterWithContext is like DeleteCacheCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DeleteCacheClusterWithContext(ctx context.Context, req *DeleteCacheClusterMessage) (resp *DeleteCacheClusterResult, err error) {
 	resp = &DeleteCacheClusterResult{}
-	err = c.client.Do("DeleteCacheCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteCacheCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -139,24 +253,45 @@ func (c *ElasticCache) DeleteCacheCluster(req *DeleteCacheClusterMessage) (resp
 // deletes the specified cache parameter group. You cannot delete a cache
 // parameter group if it is associated with any cache clusters.
 func (c *ElasticCache) DeleteCacheParameterGroup(req *DeleteCacheParameterGroupMessage) (err error) {
+	return c.DeleteCacheParameterGroupWithContext(context.Background(), req)
+}
+
+// DeleteCacheParameterGroupWithContext is like DeleteCacheParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DeleteCacheParameterGroupWithContext(ctx context.Context, req *DeleteCacheParameterGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteCacheParameterGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteCacheParameterGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteCacheSecurityGroup the DeleteCacheSecurityGroup operation deletes
 // a cache security group.
 func (c *ElasticCache) DeleteCacheSecurityGroup(req *DeleteCacheSecurityGroupMessage) (err error) {
+	return c.DeleteCacheSecurityGroupWithContext(context.Background(), req)
+}
+
+// DeleteCacheSecurityGroupWithContext is like DeleteCacheSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DeleteCacheSecurityGroupWithContext(ctx context.Context, req *DeleteCacheSecurityGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteCacheSecurityGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteCacheSecurityGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteCacheSubnetGroup the DeleteCacheSubnetGroup operation deletes a
 // cache subnet group.
 func (c *ElasticCache) DeleteCacheSubnetGroup(req *DeleteCacheSubnetGroupMessage) (err error) {
+	return c.DeleteCacheSubnetGroupWithContext(context.Background(), req)
+}
+
+// DeleteCacheSubnetGroupWithContext is like DeleteCacheSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DeleteCacheSubnetGroupWithContext(ctx context.Context, req *DeleteCacheSubnetGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteCacheSubnetGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteCacheSubnetGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -168,8 +303,15 @@ func (c *ElasticCache) DeleteCacheSubnetGroup(req *DeleteCacheSubnetGroupMessage
 // Amazon ElastiCache immediately begins deleting the selected resources;
 // you cannot cancel or revert this operation.
 func (c *ElasticCache) DeleteReplicationGroup(req *DeleteReplicationGroupMessage) (resp *DeleteReplicationGroupResult, err error) {
+	return c.DeleteReplicationGroupWithContext(context.Background(), req)
+}
+
+// DeleteReplicationGroupWithContext is like DeleteReplicationGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DeleteReplicationGroupWithContext(ctx context.Context, req *DeleteReplicationGroupMessage) (resp *DeleteReplicationGroupResult, err error) {
 	resp = &DeleteReplicationGroupResult{}
-	err = c.client.Do("DeleteReplicationGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteReplicationGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -178,8 +320,15 @@ func (c *ElasticCache) DeleteReplicationGroup(req *DeleteReplicationGroupMessage
 // ElastiCache immediately begins deleting the snapshot; you cannot cancel
 // or revert this operation.
 func (c *ElasticCache) DeleteSnapshot(req *DeleteSnapshotMessage) (resp *DeleteSnapshotResult, err error) {
+	return c.DeleteSnapshotWithContext(context.Background(), req)
+}
+
+// DeleteSnapshotWithContext is like DeleteSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DeleteSnapshotWithContext(ctx context.Context, req *DeleteSnapshotMessage) (resp *DeleteSnapshotResult, err error) {
 	resp = &DeleteSnapshotResult{}
-	err = c.client.Do("DeleteSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -201,16 +350,30 @@ func (c *ElasticCache) DeleteSnapshot(req *DeleteSnapshotMessage) (resp *DeleteS
 // being removed from the cache cluster, no endpoint information for the
 // removed nodes is displayed.
 func (c *ElasticCache) DescribeCacheClusters(req *DescribeCacheClustersMessage) (resp *DescribeCacheClustersResult, err error) {
+	return c.DescribeCacheClustersWithContext(context.Background(), req)
+}
+
+// DescribeCacheClustersWithContext is like DescribeCacheClusters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeCacheClustersWithContext(ctx context.Context, req *DescribeCacheClustersMessage) (resp *DescribeCacheClustersResult, err error) {
 	resp = &DescribeCacheClustersResult{}
-	err = c.client.Do("DescribeCacheClusters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCacheClusters", "POST", "/", req, resp)
 	return
 }
 
 // DescribeCacheEngineVersions the DescribeCacheEngineVersions operation
 // returns a list of the available cache engines and their versions.
 func (c *ElasticCache) DescribeCacheEngineVersions(req *DescribeCacheEngineVersionsMessage) (resp *DescribeCacheEngineVersionsResult, err error) {
+	return c.DescribeCacheEngineVersionsWithContext(context.Background(), req)
+}
+
+// DescribeCacheEngineVersionsWithContext is like DescribeCacheEngineVersions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeCacheEngineVersionsWithContext(ctx context.Context, req *DescribeCacheEngineVersionsMessage) (resp *DescribeCacheEngineVersionsResult, err error) {
 	resp = &DescribeCacheEngineVersionsResult{}
-	err = c.client.Do("DescribeCacheEngineVersions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCacheEngineVersions", "POST", "/", req, resp)
 	return
 }
 
@@ -219,16 +382,30 @@ func (c *ElasticCache) DescribeCacheEngineVersions(req *DescribeCacheEngineVersi
 // parameter group name is specified, the list will contain only the
 // descriptions for that group.
 func (c *ElasticCache) DescribeCacheParameterGroups(req *DescribeCacheParameterGroupsMessage) (resp *DescribeCacheParameterGroupsResult, err error) {
+	return c.DescribeCacheParameterGroupsWithContext(context.Background(), req)
+}
+
+// DescribeCacheParameterGroupsWithContext is like DescribeCacheParameterGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeCacheParameterGroupsWithContext(ctx context.Context, req *DescribeCacheParameterGroupsMessage) (resp *DescribeCacheParameterGroupsResult, err error) {
 	resp = &DescribeCacheParameterGroupsResult{}
-	err = c.client.Do("DescribeCacheParameterGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCacheParameterGroups", "POST", "/", req, resp)
 	return
 }
 
 // DescribeCacheParameters the DescribeCacheParameters operation returns
 // the detailed parameter list for a particular cache parameter group.
 func (c *ElasticCache) DescribeCacheParameters(req *DescribeCacheParametersMessage) (resp *DescribeCacheParametersResult, err error) {
+	return c.DescribeCacheParametersWithContext(context.Background(), req)
+}
+
+// DescribeCacheParametersWithContext is like DescribeCacheParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeCacheParametersWithContext(ctx context.Context, req *DescribeCacheParametersMessage) (resp *DescribeCacheParametersResult, err error) {
 	resp = &DescribeCacheParametersResult{}
-	err = c.client.Do("DescribeCacheParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCacheParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -237,8 +414,15 @@ func (c *ElasticCache) DescribeCacheParameters(req *DescribeCacheParametersMessa
 // group name is specified, the list will contain only the description of
 // that group.
 func (c *ElasticCache) DescribeCacheSecurityGroups(req *DescribeCacheSecurityGroupsMessage) (resp *DescribeCacheSecurityGroupsResult, err error) {
+	return c.DescribeCacheSecurityGroupsWithContext(context.Background(), req)
+}
+
+// DescribeCacheSecurityGroupsWithContext is like DescribeCacheSecurityGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeCacheSecurityGroupsWithContext(ctx context.Context, req *DescribeCacheSecurityGroupsMessage) (resp *DescribeCacheSecurityGroupsResult, err error) {
 	resp = &DescribeCacheSecurityGroupsResult{}
-	err = c.client.Do("DescribeCacheSecurityGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCacheSecurityGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -247,8 +431,15 @@ func (c *ElasticCache) DescribeCacheSecurityGroups(req *DescribeCacheSecurityGro
 // name is specified, the list will contain only the description of that
 // group.
 func (c *ElasticCache) DescribeCacheSubnetGroups(req *DescribeCacheSubnetGroupsMessage) (resp *DescribeCacheSubnetGroupsResult, err error) {
+	return c.DescribeCacheSubnetGroupsWithContext(context.Background(), req)
+}
+
+// DescribeCacheSubnetGroupsWithContext is like DescribeCacheSubnetGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeCacheSubnetGroupsWithContext(ctx context.Context, req *DescribeCacheSubnetGroupsMessage) (resp *DescribeCacheSubnetGroupsResult, err error) {
 	resp = &DescribeCacheSubnetGroupsResult{}
-	err = c.client.Do("DescribeCacheSubnetGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCacheSubnetGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -256,8 +447,15 @@ func (c *ElasticCache) DescribeCacheSubnetGroups(req *DescribeCacheSubnetGroupsM
 // operation returns the default engine and system parameter information
 // for the specified cache engine.
 func (c *ElasticCache) DescribeEngineDefaultParameters(req *DescribeEngineDefaultParametersMessage) (resp *DescribeEngineDefaultParametersResult, err error) {
+	return c.DescribeEngineDefaultParametersWithContext(context.Background(), req)
+}
+
+// DescribeEngineDefaultParametersWithContext is like DescribeEngineDefaultParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeEngineDefaultParametersWithContext(ctx context.Context, req *DescribeEngineDefaultParametersMessage) (resp *DescribeEngineDefaultParametersResult, err error) {
 	resp = &DescribeEngineDefaultParametersResult{}
-	err = c.client.Do("DescribeEngineDefaultParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEngineDefaultParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -268,8 +466,15 @@ func (c *ElasticCache) DescribeEngineDefaultParameters(req *DescribeEngineDefaul
 // default, only the events occurring within the last hour are returned;
 // however, you can retrieve up to 14 days' worth of events if necessary.
 func (c *ElasticCache) DescribeEvents(req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
+	return c.DescribeEventsWithContext(context.Background(), req)
+}
+
+// DescribeEventsWithContext is like DescribeEvents, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeEventsWithContext(ctx context.Context, req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
 	resp = &DescribeEventsResult{}
-	err = c.client.Do("DescribeEvents", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEvents", "POST", "/", req, resp)
 	return
 }
 
@@ -278,8 +483,15 @@ func (c *ElasticCache) DescribeEvents(req *DescribeEventsMessage) (resp *Describ
 // identifier is specified, DescribeReplicationGroups returns information
 // about all replication groups.
 func (c *ElasticCache) DescribeReplicationGroups(req *DescribeReplicationGroupsMessage) (resp *DescribeReplicationGroupsResult, err error) {
+	return c.DescribeReplicationGroupsWithContext(context.Background(), req)
+}
+
+// DescribeReplicationGroupsWithContext is like DescribeReplicationGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeReplicationGroupsWithContext(ctx context.Context, req *DescribeReplicationGroupsMessage) (resp *DescribeReplicationGroupsResult, err error) {
 	resp = &DescribeReplicationGroupsResult{}
-	err = c.client.Do("DescribeReplicationGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReplicationGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -287,8 +499,15 @@ func (c *ElasticCache) DescribeReplicationGroups(req *DescribeReplicationGroupsM
 // returns information about reserved cache nodes for this account, or
 // about a specified reserved cache node.
 func (c *ElasticCache) DescribeReservedCacheNodes(req *DescribeReservedCacheNodesMessage) (resp *DescribeReservedCacheNodesResult, err error) {
+	return c.DescribeReservedCacheNodesWithContext(context.Background(), req)
+}
+
+// DescribeReservedCacheNodesWithContext is like DescribeReservedCacheNodes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeReservedCacheNodesWithContext(ctx context.Context, req *DescribeReservedCacheNodesMessage) (resp *DescribeReservedCacheNodesResult, err error) {
 	resp = &DescribeReservedCacheNodesResult{}
-	err = c.client.Do("DescribeReservedCacheNodes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedCacheNodes", "POST", "/", req, resp)
 	return
 }
 
@@ -296,8 +515,15 @@ func (c *ElasticCache) DescribeReservedCacheNodes(req *DescribeReservedCacheNode
 // DescribeReservedCacheNodesOfferings operation lists available reserved
 // cache node offerings.
 func (c *ElasticCache) DescribeReservedCacheNodesOfferings(req *DescribeReservedCacheNodesOfferingsMessage) (resp *DescribeReservedCacheNodesOfferingsResult, err error) {
+	return c.DescribeReservedCacheNodesOfferingsWithContext(context.Background(), req)
+}
+
+// DescribeReservedCacheNodesOfferingsWithContext is like DescribeReservedCacheNodesOfferings, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeReservedCacheNodesOfferingsWithContext(ctx context.Context, req *DescribeReservedCacheNodesOfferingsMessage) (resp *DescribeReservedCacheNodesOfferingsResult, err error) {
 	resp = &DescribeReservedCacheNodesOfferingsResult{}
-	err = c.client.Do("DescribeReservedCacheNodesOfferings", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedCacheNodesOfferings", "POST", "/", req, resp)
 	return
 }
 
@@ -306,8 +532,15 @@ func (c *ElasticCache) DescribeReservedCacheNodesOfferings(req *DescribeReserved
 // of your snapshots; it can optionally describe a single snapshot, or just
 // the snapshots associated with a particular cache cluster.
 func (c *ElasticCache) DescribeSnapshots(req *DescribeSnapshotsMessage) (resp *DescribeSnapshotsResult, err error) {
+	return c.DescribeSnapshotsWithContext(context.Background(), req)
+}
+
+// DescribeSnapshotsWithContext is like DescribeSnapshots, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) DescribeSnapshotsWithContext(ctx context.Context, req *DescribeSnapshotsMessage) (resp *DescribeSnapshotsResult, err error) {
 	resp = &DescribeSnapshotsResult{}
-	err = c.client.Do("DescribeSnapshots", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSnapshots", "POST", "/", req, resp)
 	return
 }
 
@@ -316,8 +549,15 @@ func (c *ElasticCache) DescribeSnapshots(req *DescribeSnapshotsMessage) (resp *D
 // or more cluster configuration parameters by specifying the parameters
 // and the new values.
 func (c *ElasticCache) ModifyCacheCluster(req *ModifyCacheClusterMessage) (resp *ModifyCacheClusterResult, err error) {
+	return c.ModifyCacheClusterWithContext(context.Background(), req)
+}
+
+// ModifyCacheClusterWithContext is like ModifyCacheCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) ModifyCacheClusterWithContext(ctx context.Context, req *ModifyCacheClusterMessage) (resp *ModifyCacheClusterResult, err error) {
 	resp = &ModifyCacheClusterResult{}
-	err = c.client.Do("ModifyCacheCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyCacheCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -326,24 +566,45 @@ func (c *ElasticCache) ModifyCacheCluster(req *ModifyCacheClusterMessage) (resp
 // 20 parameters in a single request by submitting a list parameter name
 // and value pairs.
 func (c *ElasticCache) ModifyCacheParameterGroup(req *ModifyCacheParameterGroupMessage) (resp *ModifyCacheParameterGroupResult, err error) {
+	return c.ModifyCacheParameterGroupWithContext(context.Background(), req)
+}
+
+// ModifyCacheParameterGroupWithContext is like ModifyCacheParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) ModifyCacheParameterGroupWithContext(ctx context.Context, req *ModifyCacheParameterGroupMessage) (resp *ModifyCacheParameterGroupResult, err error) {
 	resp = &ModifyCacheParameterGroupResult{}
-	err = c.client.Do("ModifyCacheParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyCacheParameterGroup", "POST", "/", req, resp)
 	return
 }
 
 // ModifyCacheSubnetGroup the ModifyCacheSubnetGroup operation modifies an
 // existing cache subnet group.
 func (c *ElasticCache) ModifyCacheSubnetGroup(req *ModifyCacheSubnetGroupMessage) (resp *ModifyCacheSubnetGroupResult, err error) {
+	return c.ModifyCacheSubnetGroupWithContext(context.Background(), req)
+}
+
+// ModifyCacheSubnetGroupWithContext is like ModifyCacheSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) ModifyCacheSubnetGroupWithContext(ctx context.Context, req *ModifyCacheSubnetGroupMessage) (resp *ModifyCacheSubnetGroupResult, err error) {
 	resp = &ModifyCacheSubnetGroupResult{}
-	err = c.client.Do("ModifyCacheSubnetGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyCacheSubnetGroup", "POST", "/", req, resp)
 	return
 }
 
 // ModifyReplicationGroup the ModifyReplicationGroup operation modifies the
 // settings for a replication group.
 func (c *ElasticCache) ModifyReplicationGroup(req *ModifyReplicationGroupMessage) (resp *ModifyReplicationGroupResult, err error) {
+	return c.ModifyReplicationGroupWithContext(context.Background(), req)
+}
+
+// ModifyReplicationGroupWithContext is like ModifyReplicationGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) ModifyReplicationGroupWithContext(ctx context.Context, req *ModifyReplicationGroupMessage) (resp *ModifyReplicationGroupResult, err error) {
 	resp = &ModifyReplicationGroupResult{}
-	err = c.client.Do("ModifyReplicationGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyReplicationGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -351,8 +612,15 @@ func (c *ElasticCache) ModifyReplicationGroup(req *ModifyReplicationGroupMessage
 // PurchaseReservedCacheNodesOffering operation allows you to purchase a
 // reserved cache node offering.
 func (c *ElasticCache) PurchaseReservedCacheNodesOffering(req *PurchaseReservedCacheNodesOfferingMessage) (resp *PurchaseReservedCacheNodesOfferingResult, err error) {
+	return c.PurchaseReservedCacheNodesOfferingWithContext(context.Background(), req)
+}
+
+// PurchaseReservedCacheNodesOfferingWithContext is like PurchaseReservedCacheNodesOffering, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) PurchaseReservedCacheNodesOfferingWithContext(ctx context.Context, req *PurchaseReservedCacheNodesOfferingMessage) (resp *PurchaseReservedCacheNodesOfferingResult, err error) {
 	resp = &PurchaseReservedCacheNodesOfferingResult{}
-	err = c.client.Do("PurchaseReservedCacheNodesOffering", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PurchaseReservedCacheNodesOffering", "POST", "/", req, resp)
 	return
 }
 
@@ -365,8 +633,15 @@ func (c *ElasticCache) PurchaseReservedCacheNodesOffering(req *PurchaseReservedC
 // (for each cache node being rebooted) to be lost. When the reboot is
 // complete, a cache cluster event is created.
 func (c *ElasticCache) RebootCacheCluster(req *RebootCacheClusterMessage) (resp *RebootCacheClusterResult, err error) {
+	return c.RebootCacheClusterWithContext(context.Background(), req)
+}
+
+// RebootCacheClusterWithContext is like RebootCacheCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) RebootCacheClusterWithContext(ctx context.Context, req *RebootCacheClusterMessage) (resp *RebootCacheClusterResult, err error) {
 	resp = &RebootCacheClusterResult{}
-	err = c.client.Do("RebootCacheCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RebootCacheCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -376,8 +651,15 @@ func (c *ElasticCache) RebootCacheCluster(req *RebootCacheClusterMessage) (resp
 // parameter names. To reset the entire cache parameter group, specify the
 // ResetAllParameters and CacheParameterGroupName parameters.
 func (c *ElasticCache) ResetCacheParameterGroup(req *ResetCacheParameterGroupMessage) (resp *ResetCacheParameterGroupResult, err error) {
+	return c.ResetCacheParameterGroupWithContext(context.Background(), req)
+}
+
+// ResetCacheParameterGroupWithContext is like ResetCacheParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) ResetCacheParameterGroupWithContext(ctx context.Context, req *ResetCacheParameterGroupMessage) (resp *ResetCacheParameterGroupResult, err error) {
 	resp = &ResetCacheParameterGroupResult{}
-	err = c.client.Do("ResetCacheParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ResetCacheParameterGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -386,8 +668,15 @@ func (c *ElasticCache) ResetCacheParameterGroup(req *ResetCacheParameterGroupMes
 // operation to disallow access from an Amazon EC2 security group that had
 // been previously authorized.
 func (c *ElasticCache) RevokeCacheSecurityGroupIngress(req *RevokeCacheSecurityGroupIngressMessage) (resp *RevokeCacheSecurityGroupIngressResult, err error) {
+	return c.RevokeCacheSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// RevokeCacheSecurityGroupIngressWithContext is like RevokeCacheSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticCache) RevokeCacheSecurityGroupIngressWithContext(ctx context.Context, req *RevokeCacheSecurityGroupIngressMessage) (resp *RevokeCacheSecurityGroupIngressResult, err error) {
 	resp = &RevokeCacheSecurityGroupIngressResult{}
-	err = c.client.Do("RevokeCacheSecurityGroupIngress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RevokeCacheSecurityGroupIngress", "POST", "/", req, resp)
 	return
 }
 
@@ -404,6 +693,23 @@ type AuthorizeCacheSecurityGroupIngressMessage struct {
 	EC2SecurityGroupOwnerID aws.StringValue `query:"EC2SecurityGroupOwnerId" xml:"EC2SecurityGroupOwnerId"`
 }
 
+// MarshalQuery adds the parameters of the AuthorizeCacheSecurityGroupIngressMessage to the form, prefixing their names.
+func (v *AuthorizeCacheSecurityGroupIngressMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSecurityGroupName != nil {
+		form.Set(prefix+"CacheSecurityGroupName", *v.CacheSecurityGroupName)
+	}
+	if v.EC2SecurityGroupName != nil {
+		form.Set(prefix+"EC2SecurityGroupName", *v.EC2SecurityGroupName)
+	}
+	if v.EC2SecurityGroupOwnerID != nil {
+		form.Set(prefix+"EC2SecurityGroupOwnerId", *v.EC2SecurityGroupOwnerID)
+	}
+	return nil
+}
+
 // AuthorizeCacheSecurityGroupIngressResult is undocumented.
 type AuthorizeCacheSecurityGroupIngressResult struct {
 	CacheSecurityGroup *CacheSecurityGroup `query:"CacheSecurityGroup" xml:"AuthorizeCacheSecurityGroupIngressResult>CacheSecurityGroup"`
@@ -429,9 +735,9 @@ type CacheCluster struct {
 	CacheClusterID             aws.StringValue                `query:"CacheClusterId" xml:"CacheClusterId"`
 	CacheClusterStatus         aws.StringValue                `query:"CacheClusterStatus" xml:"CacheClusterStatus"`
 	CacheNodeType              aws.StringValue                `query:"CacheNodeType" xml:"CacheNodeType"`
-	CacheNodes                 []CacheNode                    `query:"CacheNodes.member" xml:"CacheNodes>CacheNode"`
+	CacheNodes                 []CacheNode                    `query:"CacheNodes,list:CacheNode" xml:"CacheNodes>CacheNode"`
 	CacheParameterGroup        *CacheParameterGroupStatus     `query:"CacheParameterGroup" xml:"CacheParameterGroup"`
-	CacheSecurityGroups        []CacheSecurityGroupMembership `query:"CacheSecurityGroups.member" xml:"CacheSecurityGroups>CacheSecurityGroup"`
+	CacheSecurityGroups        []CacheSecurityGroupMembership `query:"CacheSecurityGroups,list:CacheSecurityGroup" xml:"CacheSecurityGroups>CacheSecurityGroup"`
 	CacheSubnetGroupName       aws.StringValue                `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
 	ClientDownloadLandingPage  aws.StringValue                `query:"ClientDownloadLandingPage" xml:"ClientDownloadLandingPage"`
 	ConfigurationEndpoint      *Endpoint                      `query:"ConfigurationEndpoint" xml:"ConfigurationEndpoint"`
@@ -443,14 +749,14 @@ type CacheCluster struct {
 	PreferredAvailabilityZone  aws.StringValue                `query:"PreferredAvailabilityZone" xml:"PreferredAvailabilityZone"`
 	PreferredMaintenanceWindow aws.StringValue                `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
 	ReplicationGroupID         aws.StringValue                `query:"ReplicationGroupId" xml:"ReplicationGroupId"`
-	SecurityGroups             []SecurityGroupMembership      `query:"SecurityGroups.member" xml:"SecurityGroups>member"`
+	SecurityGroups             []SecurityGroupMembership      `query:"SecurityGroups,list:member" xml:"SecurityGroups>member"`
 	SnapshotRetentionLimit     aws.IntegerValue               `query:"SnapshotRetentionLimit" xml:"SnapshotRetentionLimit"`
 	SnapshotWindow             aws.StringValue                `query:"SnapshotWindow" xml:"SnapshotWindow"`
 }
 
 // CacheClusterMessage is undocumented.
 type CacheClusterMessage struct {
-	CacheClusters []CacheCluster  `query:"CacheClusters.member" xml:"DescribeCacheClustersResult>CacheClusters>CacheCluster"`
+	CacheClusters []CacheCluster  `query:"CacheClusters,list:CacheCluster" xml:"DescribeCacheClustersResult>CacheClusters>CacheCluster"`
 	Marker        aws.StringValue `query:"Marker" xml:"DescribeCacheClustersResult>Marker"`
 }
 
@@ -465,7 +771,7 @@ type CacheEngineVersion struct {
 
 // CacheEngineVersionMessage is undocumented.
 type CacheEngineVersionMessage struct {
-	CacheEngineVersions []CacheEngineVersion `query:"CacheEngineVersions.member" xml:"DescribeCacheEngineVersionsResult>CacheEngineVersions>CacheEngineVersion"`
+	CacheEngineVersions []CacheEngineVersion `query:"CacheEngineVersions,list:CacheEngineVersion" xml:"DescribeCacheEngineVersionsResult>CacheEngineVersions>CacheEngineVersion"`
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeCacheEngineVersionsResult>Marker"`
 }
 
@@ -483,7 +789,7 @@ type CacheNode struct {
 // CacheNodeTypeSpecificParameter is undocumented.
 type CacheNodeTypeSpecificParameter struct {
 	AllowedValues               aws.StringValue              `query:"AllowedValues" xml:"AllowedValues"`
-	CacheNodeTypeSpecificValues []CacheNodeTypeSpecificValue `query:"CacheNodeTypeSpecificValues.member" xml:"CacheNodeTypeSpecificValues>CacheNodeTypeSpecificValue"`
+	CacheNodeTypeSpecificValues []CacheNodeTypeSpecificValue `query:"CacheNodeTypeSpecificValues,list:CacheNodeTypeSpecificValue" xml:"CacheNodeTypeSpecificValues>CacheNodeTypeSpecificValue"`
 	DataType                    aws.StringValue              `query:"DataType" xml:"DataType"`
 	Description                 aws.StringValue              `query:"Description" xml:"Description"`
 	IsModifiable                aws.BooleanValue             `query:"IsModifiable" xml:"IsModifiable"`
@@ -507,9 +813,9 @@ type CacheParameterGroup struct {
 
 // CacheParameterGroupDetails is undocumented.
 type CacheParameterGroupDetails struct {
-	CacheNodeTypeSpecificParameters []CacheNodeTypeSpecificParameter `query:"CacheNodeTypeSpecificParameters.member" xml:"DescribeCacheParametersResult>CacheNodeTypeSpecificParameters>CacheNodeTypeSpecificParameter"`
+	CacheNodeTypeSpecificParameters []CacheNodeTypeSpecificParameter `query:"CacheNodeTypeSpecificParameters,list:CacheNodeTypeSpecificParameter" xml:"DescribeCacheParametersResult>CacheNodeTypeSpecificParameters>CacheNodeTypeSpecificParameter"`
 	Marker                          aws.StringValue                  `query:"Marker" xml:"DescribeCacheParametersResult>Marker"`
-	Parameters                      []Parameter                      `query:"Parameters.member" xml:"DescribeCacheParametersResult>Parameters>Parameter"`
+	Parameters                      []Parameter                      `query:"Parameters,list:Parameter" xml:"DescribeCacheParametersResult>Parameters>Parameter"`
 }
 
 // CacheParameterGroupNameMessage is undocumented.
@@ -519,14 +825,14 @@ type CacheParameterGroupNameMessage struct {
 
 // CacheParameterGroupStatus is undocumented.
 type CacheParameterGroupStatus struct {
-	CacheNodeIDsToReboot    []string        `query:"CacheNodeIdsToReboot.member" xml:"CacheNodeIdsToReboot>CacheNodeId"`
+	CacheNodeIDsToReboot    []string        `query:"CacheNodeIdsToReboot,list:CacheNodeId" xml:"CacheNodeIdsToReboot>CacheNodeId"`
 	CacheParameterGroupName aws.StringValue `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
 	ParameterApplyStatus    aws.StringValue `query:"ParameterApplyStatus" xml:"ParameterApplyStatus"`
 }
 
 // CacheParameterGroupsMessage is undocumented.
 type CacheParameterGroupsMessage struct {
-	CacheParameterGroups []CacheParameterGroup `query:"CacheParameterGroups.member" xml:"DescribeCacheParameterGroupsResult>CacheParameterGroups>CacheParameterGroup"`
+	CacheParameterGroups []CacheParameterGroup `query:"CacheParameterGroups,list:CacheParameterGroup" xml:"DescribeCacheParameterGroupsResult>CacheParameterGroups>CacheParameterGroup"`
 	Marker               aws.StringValue       `query:"Marker" xml:"DescribeCacheParameterGroupsResult>Marker"`
 }
 
@@ -534,7 +840,7 @@ type CacheParameterGroupsMessage struct {
 type CacheSecurityGroup struct {
 	CacheSecurityGroupName aws.StringValue    `query:"CacheSecurityGroupName" xml:"CacheSecurityGroupName"`
 	Description            aws.StringValue    `query:"Description" xml:"Description"`
-	EC2SecurityGroups      []EC2SecurityGroup `query:"EC2SecurityGroups.member" xml:"EC2SecurityGroups>EC2SecurityGroup"`
+	EC2SecurityGroups      []EC2SecurityGroup `query:"EC2SecurityGroups,list:EC2SecurityGroup" xml:"EC2SecurityGroups>EC2SecurityGroup"`
 	OwnerID                aws.StringValue    `query:"OwnerId" xml:"OwnerId"`
 }
 
@@ -546,7 +852,7 @@ type CacheSecurityGroupMembership struct {
 
 // CacheSecurityGroupMessage is undocumented.
 type CacheSecurityGroupMessage struct {
-	CacheSecurityGroups []CacheSecurityGroup `query:"CacheSecurityGroups.member" xml:"DescribeCacheSecurityGroupsResult>CacheSecurityGroups>CacheSecurityGroup"`
+	CacheSecurityGroups []CacheSecurityGroup `query:"CacheSecurityGroups,list:CacheSecurityGroup" xml:"DescribeCacheSecurityGroupsResult>CacheSecurityGroups>CacheSecurityGroup"`
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeCacheSecurityGroupsResult>Marker"`
 }
 
@@ -554,13 +860,13 @@ type CacheSecurityGroupMessage struct {
 type CacheSubnetGroup struct {
 	CacheSubnetGroupDescription aws.StringValue `query:"CacheSubnetGroupDescription" xml:"CacheSubnetGroupDescription"`
 	CacheSubnetGroupName        aws.StringValue `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
-	Subnets                     []Subnet        `query:"Subnets.member" xml:"Subnets>Subnet"`
+	Subnets                     []Subnet        `query:"Subnets,list:Subnet" xml:"Subnets>Subnet"`
 	VPCID                       aws.StringValue `query:"VpcId" xml:"VpcId"`
 }
 
 // CacheSubnetGroupMessage is undocumented.
 type CacheSubnetGroupMessage struct {
-	CacheSubnetGroups []CacheSubnetGroup `query:"CacheSubnetGroups.member" xml:"DescribeCacheSubnetGroupsResult>CacheSubnetGroups>CacheSubnetGroup"`
+	CacheSubnetGroups []CacheSubnetGroup `query:"CacheSubnetGroups,list:CacheSubnetGroup" xml:"DescribeCacheSubnetGroupsResult>CacheSubnetGroups>CacheSubnetGroup"`
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeCacheSubnetGroupsResult>Marker"`
 }
 
@@ -570,6 +876,20 @@ type CopySnapshotMessage struct {
 	TargetSnapshotName aws.StringValue `query:"TargetSnapshotName" xml:"TargetSnapshotName"`
 }
 
+// MarshalQuery adds the parameters of the CopySnapshotMessage to the form, prefixing their names.
+func (v *CopySnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceSnapshotName != nil {
+		form.Set(prefix+"SourceSnapshotName", *v.SourceSnapshotName)
+	}
+	if v.TargetSnapshotName != nil {
+		form.Set(prefix+"TargetSnapshotName", *v.TargetSnapshotName)
+	}
+	return nil
+}
+
 // CopySnapshotResult is undocumented.
 type CopySnapshotResult struct {
 	Snapshot *Snapshot `query:"Snapshot" xml:"CopySnapshotResult>Snapshot"`
@@ -582,7 +902,7 @@ type CreateCacheClusterMessage struct {
 	CacheClusterID             aws.StringValue  `query:"CacheClusterId" xml:"CacheClusterId"`
 	CacheNodeType              aws.StringValue  `query:"CacheNodeType" xml:"CacheNodeType"`
 	CacheParameterGroupName    aws.StringValue  `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
-	CacheSecurityGroupNames    []string         `query:"CacheSecurityGroupNames.member" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
+	CacheSecurityGroupNames    []string         `query:"CacheSecurityGroupNames,list:CacheSecurityGroupName" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
 	CacheSubnetGroupName       aws.StringValue  `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
 	Engine                     aws.StringValue  `query:"Engine" xml:"Engine"`
 	EngineVersion              aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
@@ -590,16 +910,111 @@ type CreateCacheClusterMessage struct {
 	NumCacheNodes              aws.IntegerValue `query:"NumCacheNodes" xml:"NumCacheNodes"`
 	Port                       aws.IntegerValue `query:"Port" xml:"Port"`
 	PreferredAvailabilityZone  aws.StringValue  `query:"PreferredAvailabilityZone" xml:"PreferredAvailabilityZone"`
-	PreferredAvailabilityZones []string         `query:"PreferredAvailabilityZones.member" xml:"PreferredAvailabilityZones>PreferredAvailabilityZone"`
+	PreferredAvailabilityZones []string         `query:"PreferredAvailabilityZones,list:PreferredAvailabilityZone" xml:"PreferredAvailabilityZones>PreferredAvailabilityZone"`
 	PreferredMaintenanceWindow aws.StringValue  `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
 	ReplicationGroupID         aws.StringValue  `query:"ReplicationGroupId" xml:"ReplicationGroupId"`
-	SecurityGroupIDs           []string         `query:"SecurityGroupIds.member" xml:"SecurityGroupIds>SecurityGroupId"`
-	SnapshotARNs               []string         `query:"SnapshotArns.member" xml:"SnapshotArns>SnapshotArn"`
+	SecurityGroupIDs           []string         `query:"SecurityGroupIds,list:SecurityGroupId" xml:"SecurityGroupIds>SecurityGroupId"`
+	SnapshotARNs               []string         `query:"SnapshotArns,list:SnapshotArn" xml:"SnapshotArns>SnapshotArn"`
 	SnapshotName               aws.StringValue  `query:"SnapshotName" xml:"SnapshotName"`
 	SnapshotRetentionLimit     aws.IntegerValue `query:"SnapshotRetentionLimit" xml:"SnapshotRetentionLimit"`
 	SnapshotWindow             aws.StringValue  `query:"SnapshotWindow" xml:"SnapshotWindow"`
 }
 
+// MarshalQuery adds the parameters of the CreateCacheClusterMessage to the form, prefixing their names.
+func (v *CreateCacheClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AZMode != nil {
+		form.Set(prefix+"AZMode", *v.AZMode)
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.CacheClusterID != nil {
+		form.Set(prefix+"CacheClusterId", *v.CacheClusterID)
+	}
+	if v.CacheNodeType != nil {
+		form.Set(prefix+"CacheNodeType", *v.CacheNodeType)
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.CacheSecurityGroupNames != nil {
+		if len(v.CacheSecurityGroupNames) == 0 {
+			form.Set(prefix+"CacheSecurityGroupNames", "")
+		}
+		for i1 := range v.CacheSecurityGroupNames {
+			name1 := prefix + "CacheSecurityGroupNames.CacheSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CacheSecurityGroupNames[i1])
+		}
+	}
+	if v.CacheSubnetGroupName != nil {
+		form.Set(prefix+"CacheSubnetGroupName", *v.CacheSubnetGroupName)
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.NotificationTopicARN != nil {
+		form.Set(prefix+"NotificationTopicArn", *v.NotificationTopicARN)
+	}
+	if v.NumCacheNodes != nil {
+		form.Set(prefix+"NumCacheNodes", strconv.FormatInt(int64(*v.NumCacheNodes), 10))
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PreferredAvailabilityZone != nil {
+		form.Set(prefix+"PreferredAvailabilityZone", *v.PreferredAvailabilityZone)
+	}
+	if v.PreferredAvailabilityZones != nil {
+		if len(v.PreferredAvailabilityZones) == 0 {
+			form.Set(prefix+"PreferredAvailabilityZones", "")
+		}
+		for i1 := range v.PreferredAvailabilityZones {
+			name1 := prefix + "PreferredAvailabilityZones.PreferredAvailabilityZone." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PreferredAvailabilityZones[i1])
+		}
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.ReplicationGroupID != nil {
+		form.Set(prefix+"ReplicationGroupId", *v.ReplicationGroupID)
+	}
+	if v.SecurityGroupIDs != nil {
+		if len(v.SecurityGroupIDs) == 0 {
+			form.Set(prefix+"SecurityGroupIds", "")
+		}
+		for i1 := range v.SecurityGroupIDs {
+			name1 := prefix + "SecurityGroupIds.SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroupIDs[i1])
+		}
+	}
+	if v.SnapshotARNs != nil {
+		if len(v.SnapshotARNs) == 0 {
+			form.Set(prefix+"SnapshotArns", "")
+		}
+		for i1 := range v.SnapshotARNs {
+			name1 := prefix + "SnapshotArns.SnapshotArn." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SnapshotARNs[i1])
+		}
+	}
+	if v.SnapshotName != nil {
+		form.Set(prefix+"SnapshotName", *v.SnapshotName)
+	}
+	if v.SnapshotRetentionLimit != nil {
+		form.Set(prefix+"SnapshotRetentionLimit", strconv.FormatInt(int64(*v.SnapshotRetentionLimit), 10))
+	}
+	if v.SnapshotWindow != nil {
+		form.Set(prefix+"SnapshotWindow", *v.SnapshotWindow)
+	}
+	return nil
+}
+
 // CreateCacheClusterResult is undocumented.
 type CreateCacheClusterResult struct {
 	CacheCluster *CacheCluster `query:"CacheCluster" xml:"CreateCacheClusterResult>CacheCluster"`
@@ -612,6 +1027,23 @@ type CreateCacheParameterGroupMessage struct {
 	Description               aws.StringValue `query:"Description" xml:"Description"`
 }
 
+// MarshalQuery adds the parameters of the CreateCacheParameterGroupMessage to the form, prefixing their names.
+func (v *CreateCacheParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupFamily != nil {
+		form.Set(prefix+"CacheParameterGroupFamily", *v.CacheParameterGroupFamily)
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	return nil
+}
+
 // CreateCacheParameterGroupResult is undocumented.
 type CreateCacheParameterGroupResult struct {
 	CacheParameterGroup *CacheParameterGroup `query:"CacheParameterGroup" xml:"CreateCacheParameterGroupResult>CacheParameterGroup"`
@@ -623,6 +1055,20 @@ type CreateCacheSecurityGroupMessage struct {
 	Description            aws.StringValue `query:"Description" xml:"Description"`
 }
 
+// MarshalQuery adds the parameters of the CreateCacheSecurityGroupMessage to the form, prefixing their names.
+func (v *CreateCacheSecurityGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSecurityGroupName != nil {
+		form.Set(prefix+"CacheSecurityGroupName", *v.CacheSecurityGroupName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	return nil
+}
+
 // CreateCacheSecurityGroupResult is undocumented.
 type CreateCacheSecurityGroupResult struct {
 	CacheSecurityGroup *CacheSecurityGroup `query:"CacheSecurityGroup" xml:"CreateCacheSecurityGroupResult>CacheSecurityGroup"`
@@ -632,7 +1078,30 @@ type CreateCacheSecurityGroupResult struct {
 type CreateCacheSubnetGroupMessage struct {
 	CacheSubnetGroupDescription aws.StringValue `query:"CacheSubnetGroupDescription" xml:"CacheSubnetGroupDescription"`
 	CacheSubnetGroupName        aws.StringValue `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
-	SubnetIDs                   []string        `query:"SubnetIds.member" xml:"SubnetIds>SubnetIdentifier"`
+	SubnetIDs                   []string        `query:"SubnetIds,list:SubnetIdentifier" xml:"SubnetIds>SubnetIdentifier"`
+}
+
+// MarshalQuery adds the parameters of the CreateCacheSubnetGroupMessage to the form, prefixing their names.
+func (v *CreateCacheSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSubnetGroupDescription != nil {
+		form.Set(prefix+"CacheSubnetGroupDescription", *v.CacheSubnetGroupDescription)
+	}
+	if v.CacheSubnetGroupName != nil {
+		form.Set(prefix+"CacheSubnetGroupName", *v.CacheSubnetGroupName)
+	}
+	if v.SubnetIDs != nil {
+		if len(v.SubnetIDs) == 0 {
+			form.Set(prefix+"SubnetIds", "")
+		}
+		for i1 := range v.SubnetIDs {
+			name1 := prefix + "SubnetIds.SubnetIdentifier." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SubnetIDs[i1])
+		}
+	}
+	return nil
 }
 
 // CreateCacheSubnetGroupResult is undocumented.
@@ -646,25 +1115,120 @@ type CreateReplicationGroupMessage struct {
 	AutomaticFailoverEnabled    aws.BooleanValue `query:"AutomaticFailoverEnabled" xml:"AutomaticFailoverEnabled"`
 	CacheNodeType               aws.StringValue  `query:"CacheNodeType" xml:"CacheNodeType"`
 	CacheParameterGroupName     aws.StringValue  `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
-	CacheSecurityGroupNames     []string         `query:"CacheSecurityGroupNames.member" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
+	CacheSecurityGroupNames     []string         `query:"CacheSecurityGroupNames,list:CacheSecurityGroupName" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
 	CacheSubnetGroupName        aws.StringValue  `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
 	Engine                      aws.StringValue  `query:"Engine" xml:"Engine"`
 	EngineVersion               aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
 	NotificationTopicARN        aws.StringValue  `query:"NotificationTopicArn" xml:"NotificationTopicArn"`
 	NumCacheClusters            aws.IntegerValue `query:"NumCacheClusters" xml:"NumCacheClusters"`
 	Port                        aws.IntegerValue `query:"Port" xml:"Port"`
-	PreferredCacheClusterAZs    []string         `query:"PreferredCacheClusterAZs.member" xml:"PreferredCacheClusterAZs>AvailabilityZone"`
+	PreferredCacheClusterAZs    []string         `query:"PreferredCacheClusterAZs,list:AvailabilityZone" xml:"PreferredCacheClusterAZs>AvailabilityZone"`
 	PreferredMaintenanceWindow  aws.StringValue  `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
 	PrimaryClusterID            aws.StringValue  `query:"PrimaryClusterId" xml:"PrimaryClusterId"`
 	ReplicationGroupDescription aws.StringValue  `query:"ReplicationGroupDescription" xml:"ReplicationGroupDescription"`
 	ReplicationGroupID          aws.StringValue  `query:"ReplicationGroupId" xml:"ReplicationGroupId"`
-	SecurityGroupIDs            []string         `query:"SecurityGroupIds.member" xml:"SecurityGroupIds>SecurityGroupId"`
-	SnapshotARNs                []string         `query:"SnapshotArns.member" xml:"SnapshotArns>SnapshotArn"`
+	SecurityGroupIDs            []string         `query:"SecurityGroupIds,list:SecurityGroupId" xml:"SecurityGroupIds>SecurityGroupId"`
+	SnapshotARNs                []string         `query:"SnapshotArns,list:SnapshotArn" xml:"SnapshotArns>SnapshotArn"`
 	SnapshotName                aws.StringValue  `query:"SnapshotName" xml:"SnapshotName"`
 	SnapshotRetentionLimit      aws.IntegerValue `query:"SnapshotRetentionLimit" xml:"SnapshotRetentionLimit"`
 	SnapshotWindow              aws.StringValue  `query:"SnapshotWindow" xml:"SnapshotWindow"`
 }
 
+// MarshalQuery adds the parameters of the CreateReplicationGroupMessage to the form, prefixing their names.
+func (v *CreateReplicationGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.AutomaticFailoverEnabled != nil {
+		form.Set(prefix+"AutomaticFailoverEnabled", strconv.FormatBool(*v.AutomaticFailoverEnabled))
+	}
+	if v.CacheNodeType != nil {
+		form.Set(prefix+"CacheNodeType", *v.CacheNodeType)
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.CacheSecurityGroupNames != nil {
+		if len(v.CacheSecurityGroupNames) == 0 {
+			form.Set(prefix+"CacheSecurityGroupNames", "")
+		}
+		for i1 := range v.CacheSecurityGroupNames {
+			name1 := prefix + "CacheSecurityGroupNames.CacheSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CacheSecurityGroupNames[i1])
+		}
+	}
+	if v.CacheSubnetGroupName != nil {
+		form.Set(prefix+"CacheSubnetGroupName", *v.CacheSubnetGroupName)
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.NotificationTopicARN != nil {
+		form.Set(prefix+"NotificationTopicArn", *v.NotificationTopicARN)
+	}
+	if v.NumCacheClusters != nil {
+		form.Set(prefix+"NumCacheClusters", strconv.FormatInt(int64(*v.NumCacheClusters), 10))
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PreferredCacheClusterAZs != nil {
+		if len(v.PreferredCacheClusterAZs) == 0 {
+			form.Set(prefix+"PreferredCacheClusterAZs", "")
+		}
+		for i1 := range v.PreferredCacheClusterAZs {
+			name1 := prefix + "PreferredCacheClusterAZs.AvailabilityZone." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PreferredCacheClusterAZs[i1])
+		}
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.PrimaryClusterID != nil {
+		form.Set(prefix+"PrimaryClusterId", *v.PrimaryClusterID)
+	}
+	if v.ReplicationGroupDescription != nil {
+		form.Set(prefix+"ReplicationGroupDescription", *v.ReplicationGroupDescription)
+	}
+	if v.ReplicationGroupID != nil {
+		form.Set(prefix+"ReplicationGroupId", *v.ReplicationGroupID)
+	}
+	if v.SecurityGroupIDs != nil {
+		if len(v.SecurityGroupIDs) == 0 {
+			form.Set(prefix+"SecurityGroupIds", "")
+		}
+		for i1 := range v.SecurityGroupIDs {
+			name1 := prefix + "SecurityGroupIds.SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroupIDs[i1])
+		}
+	}
+	if v.SnapshotARNs != nil {
+		if len(v.SnapshotARNs) == 0 {
+			form.Set(prefix+"SnapshotArns", "")
+		}
+		for i1 := range v.SnapshotARNs {
+			name1 := prefix + "SnapshotArns.SnapshotArn." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SnapshotARNs[i1])
+		}
+	}
+	if v.SnapshotName != nil {
+		form.Set(prefix+"SnapshotName", *v.SnapshotName)
+	}
+	if v.SnapshotRetentionLimit != nil {
+		form.Set(prefix+"SnapshotRetentionLimit", strconv.FormatInt(int64(*v.SnapshotRetentionLimit), 10))
+	}
+	if v.SnapshotWindow != nil {
+		form.Set(prefix+"SnapshotWindow", *v.SnapshotWindow)
+	}
+	return nil
+}
+
 // CreateReplicationGroupResult is undocumented.
 type CreateReplicationGroupResult struct {
 	ReplicationGroup *ReplicationGroup `query:"ReplicationGroup" xml:"CreateReplicationGroupResult>ReplicationGroup"`
@@ -676,6 +1240,20 @@ type CreateSnapshotMessage struct {
 	SnapshotName   aws.StringValue `query:"SnapshotName" xml:"SnapshotName"`
 }
 
+// MarshalQuery adds the parameters of the CreateSnapshotMessage to the form, prefixing their names.
+func (v *CreateSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheClusterID != nil {
+		form.Set(prefix+"CacheClusterId", *v.CacheClusterID)
+	}
+	if v.SnapshotName != nil {
+		form.Set(prefix+"SnapshotName", *v.SnapshotName)
+	}
+	return nil
+}
+
 // CreateSnapshotResult is undocumented.
 type CreateSnapshotResult struct {
 	Snapshot *Snapshot `query:"Snapshot" xml:"CreateSnapshotResult>Snapshot"`
@@ -687,6 +1265,20 @@ type DeleteCacheClusterMessage struct {
 	FinalSnapshotIdentifier aws.StringValue `query:"FinalSnapshotIdentifier" xml:"FinalSnapshotIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DeleteCacheClusterMessage to the form, prefixing their names.
+func (v *DeleteCacheClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheClusterID != nil {
+		form.Set(prefix+"CacheClusterId", *v.CacheClusterID)
+	}
+	if v.FinalSnapshotIdentifier != nil {
+		form.Set(prefix+"FinalSnapshotIdentifier", *v.FinalSnapshotIdentifier)
+	}
+	return nil
+}
+
 // DeleteCacheClusterResult is undocumented.
 type DeleteCacheClusterResult struct {
 	CacheCluster *CacheCluster `query:"CacheCluster" xml:"DeleteCacheClusterResult>CacheCluster"`
@@ -697,16 +1289,49 @@ type DeleteCacheParameterGroupMessage struct {
 	CacheParameterGroupName aws.StringValue `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteCacheParameterGroupMessage to the form, prefixing their names.
+func (v *DeleteCacheParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	return nil
+}
+
 // DeleteCacheSecurityGroupMessage is undocumented.
 type DeleteCacheSecurityGroupMessage struct {
 	CacheSecurityGroupName aws.StringValue `query:"CacheSecurityGroupName" xml:"CacheSecurityGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteCacheSecurityGroupMessage to the form, prefixing their names.
+func (v *DeleteCacheSecurityGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSecurityGroupName != nil {
+		form.Set(prefix+"CacheSecurityGroupName", *v.CacheSecurityGroupName)
+	}
+	return nil
+}
+
 // DeleteCacheSubnetGroupMessage is undocumented.
 type DeleteCacheSubnetGroupMessage struct {
 	CacheSubnetGroupName aws.StringValue `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteCacheSubnetGroupMessage to the form, prefixing their names.
+func (v *DeleteCacheSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSubnetGroupName != nil {
+		form.Set(prefix+"CacheSubnetGroupName", *v.CacheSubnetGroupName)
+	}
+	return nil
+}
+
 // DeleteReplicationGroupMessage is undocumented.
 type DeleteReplicationGroupMessage struct {
 	FinalSnapshotIdentifier aws.StringValue  `query:"FinalSnapshotIdentifier" xml:"FinalSnapshotIdentifier"`
@@ -714,6 +1339,23 @@ type DeleteReplicationGroupMessage struct {
 	RetainPrimaryCluster    aws.BooleanValue `query:"RetainPrimaryCluster" xml:"RetainPrimaryCluster"`
 }
 
+// MarshalQuery adds the parameters of the DeleteReplicationGroupMessage to the form, prefixing their names.
+func (v *DeleteReplicationGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.FinalSnapshotIdentifier != nil {
+		form.Set(prefix+"FinalSnapshotIdentifier", *v.FinalSnapshotIdentifier)
+	}
+	if v.ReplicationGroupID != nil {
+		form.Set(prefix+"ReplicationGroupId", *v.ReplicationGroupID)
+	}
+	if v.RetainPrimaryCluster != nil {
+		form.Set(prefix+"RetainPrimaryCluster", strconv.FormatBool(*v.RetainPrimaryCluster))
+	}
+	return nil
+}
+
 // DeleteReplicationGroupResult is undocumented.
 type DeleteReplicationGroupResult struct {
 	ReplicationGroup *ReplicationGroup `query:"ReplicationGroup" xml:"DeleteReplicationGroupResult>ReplicationGroup"`
@@ -724,6 +1366,17 @@ type DeleteSnapshotMessage struct {
 	SnapshotName aws.StringValue `query:"SnapshotName" xml:"SnapshotName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteSnapshotMessage to the form, prefixing their names.
+func (v *DeleteSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SnapshotName != nil {
+		form.Set(prefix+"SnapshotName", *v.SnapshotName)
+	}
+	return nil
+}
+
 // DeleteSnapshotResult is undocumented.
 type DeleteSnapshotResult struct {
 	Snapshot *Snapshot `query:"Snapshot" xml:"DeleteSnapshotResult>Snapshot"`
@@ -737,6 +1390,26 @@ type DescribeCacheClustersMessage struct {
 	ShowCacheNodeInfo aws.BooleanValue `query:"ShowCacheNodeInfo" xml:"ShowCacheNodeInfo"`
 }
 
+// MarshalQuery adds the parameters of the DescribeCacheClustersMessage to the form, prefixing their names.
+func (v *DescribeCacheClustersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheClusterID != nil {
+		form.Set(prefix+"CacheClusterId", *v.CacheClusterID)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ShowCacheNodeInfo != nil {
+		form.Set(prefix+"ShowCacheNodeInfo", strconv.FormatBool(*v.ShowCacheNodeInfo))
+	}
+	return nil
+}
+
 // DescribeCacheEngineVersionsMessage is undocumented.
 type DescribeCacheEngineVersionsMessage struct {
 	CacheParameterGroupFamily aws.StringValue  `query:"CacheParameterGroupFamily" xml:"CacheParameterGroupFamily"`
@@ -747,6 +1420,32 @@ type DescribeCacheEngineVersionsMessage struct {
 	MaxRecords                aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeCacheEngineVersionsMessage to the form, prefixing their names.
+func (v *DescribeCacheEngineVersionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupFamily != nil {
+		form.Set(prefix+"CacheParameterGroupFamily", *v.CacheParameterGroupFamily)
+	}
+	if v.DefaultOnly != nil {
+		form.Set(prefix+"DefaultOnly", strconv.FormatBool(*v.DefaultOnly))
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeCacheParameterGroupsMessage is undocumented.
 type DescribeCacheParameterGroupsMessage struct {
 	CacheParameterGroupName aws.StringValue  `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
@@ -754,6 +1453,23 @@ type DescribeCacheParameterGroupsMessage struct {
 	MaxRecords              aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeCacheParameterGroupsMessage to the form, prefixing their names.
+func (v *DescribeCacheParameterGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeCacheParametersMessage is undocumented.
 type DescribeCacheParametersMessage struct {
 	CacheParameterGroupName aws.StringValue  `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
@@ -762,6 +1478,26 @@ type DescribeCacheParametersMessage struct {
 	Source                  aws.StringValue  `query:"Source" xml:"Source"`
 }
 
+// MarshalQuery adds the parameters of the DescribeCacheParametersMessage to the form, prefixing their names.
+func (v *DescribeCacheParametersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.Source != nil {
+		form.Set(prefix+"Source", *v.Source)
+	}
+	return nil
+}
+
 // DescribeCacheSecurityGroupsMessage is undocumented.
 type DescribeCacheSecurityGroupsMessage struct {
 	CacheSecurityGroupName aws.StringValue  `query:"CacheSecurityGroupName" xml:"CacheSecurityGroupName"`
@@ -769,6 +1505,23 @@ type DescribeCacheSecurityGroupsMessage struct {
 	MaxRecords             aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeCacheSecurityGroupsMessage to the form, prefixing their names.
+func (v *DescribeCacheSecurityGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSecurityGroupName != nil {
+		form.Set(prefix+"CacheSecurityGroupName", *v.CacheSecurityGroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeCacheSubnetGroupsMessage is undocumented.
 type DescribeCacheSubnetGroupsMessage struct {
 	CacheSubnetGroupName aws.StringValue  `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
@@ -776,6 +1529,23 @@ type DescribeCacheSubnetGroupsMessage struct {
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeCacheSubnetGroupsMessage to the form, prefixing their names.
+func (v *DescribeCacheSubnetGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSubnetGroupName != nil {
+		form.Set(prefix+"CacheSubnetGroupName", *v.CacheSubnetGroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeEngineDefaultParametersMessage is undocumented.
 type DescribeEngineDefaultParametersMessage struct {
 	CacheParameterGroupFamily aws.StringValue  `query:"CacheParameterGroupFamily" xml:"CacheParameterGroupFamily"`
@@ -783,6 +1553,23 @@ type DescribeEngineDefaultParametersMessage struct {
 	MaxRecords                aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEngineDefaultParametersMessage to the form, prefixing their names.
+func (v *DescribeEngineDefaultParametersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupFamily != nil {
+		form.Set(prefix+"CacheParameterGroupFamily", *v.CacheParameterGroupFamily)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeEngineDefaultParametersResult is undocumented.
 type DescribeEngineDefaultParametersResult struct {
 	EngineDefaults *EngineDefaults `query:"EngineDefaults" xml:"DescribeEngineDefaultParametersResult>EngineDefaults"`
@@ -799,6 +1586,35 @@ type DescribeEventsMessage struct {
 	StartTime        time.Time        `query:"StartTime" xml:"StartTime"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventsMessage to the form, prefixing their names.
+func (v *DescribeEventsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Duration != nil {
+		form.Set(prefix+"Duration", strconv.FormatInt(int64(*v.Duration), 10))
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.SourceIdentifier != nil {
+		form.Set(prefix+"SourceIdentifier", *v.SourceIdentifier)
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // DescribeReplicationGroupsMessage is undocumented.
 type DescribeReplicationGroupsMessage struct {
 	Marker             aws.StringValue  `query:"Marker" xml:"Marker"`
@@ -806,6 +1622,23 @@ type DescribeReplicationGroupsMessage struct {
 	ReplicationGroupID aws.StringValue  `query:"ReplicationGroupId" xml:"ReplicationGroupId"`
 }
 
+// MarshalQuery adds the parameters of the DescribeReplicationGroupsMessage to the form, prefixing their names.
+func (v *DescribeReplicationGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ReplicationGroupID != nil {
+		form.Set(prefix+"ReplicationGroupId", *v.ReplicationGroupID)
+	}
+	return nil
+}
+
 // DescribeReservedCacheNodesMessage is undocumented.
 type DescribeReservedCacheNodesMessage struct {
 	CacheNodeType                aws.StringValue  `query:"CacheNodeType" xml:"CacheNodeType"`
@@ -818,6 +1651,38 @@ type DescribeReservedCacheNodesMessage struct {
 	ReservedCacheNodesOfferingID aws.StringValue  `query:"ReservedCacheNodesOfferingId" xml:"ReservedCacheNodesOfferingId"`
 }
 
+// MarshalQuery adds the parameters of the DescribeReservedCacheNodesMessage to the form, prefixing their names.
+func (v *DescribeReservedCacheNodesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheNodeType != nil {
+		form.Set(prefix+"CacheNodeType", *v.CacheNodeType)
+	}
+	if v.Duration != nil {
+		form.Set(prefix+"Duration", *v.Duration)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.OfferingType != nil {
+		form.Set(prefix+"OfferingType", *v.OfferingType)
+	}
+	if v.ProductDescription != nil {
+		form.Set(prefix+"ProductDescription", *v.ProductDescription)
+	}
+	if v.ReservedCacheNodeID != nil {
+		form.Set(prefix+"ReservedCacheNodeId", *v.ReservedCacheNodeID)
+	}
+	if v.ReservedCacheNodesOfferingID != nil {
+		form.Set(prefix+"ReservedCacheNodesOfferingId", *v.ReservedCacheNodesOfferingID)
+	}
+	return nil
+}
+
 // DescribeReservedCacheNodesOfferingsMessage is undocumented.
 type DescribeReservedCacheNodesOfferingsMessage struct {
 	CacheNodeType                aws.StringValue  `query:"CacheNodeType" xml:"CacheNodeType"`
@@ -829,10 +1694,39 @@ type DescribeReservedCacheNodesOfferingsMessage struct {
 	ReservedCacheNodesOfferingID aws.StringValue  `query:"ReservedCacheNodesOfferingId" xml:"ReservedCacheNodesOfferingId"`
 }
 
+// MarshalQuery adds the parameters of the DescribeReservedCacheNodesOfferingsMessage to the form, prefixing their names.
+func (v *DescribeReservedCacheNodesOfferingsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheNodeType != nil {
+		form.Set(prefix+"CacheNodeType", *v.CacheNodeType)
+	}
+	if v.Duration != nil {
+		form.Set(prefix+"Duration", *v.Duration)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.OfferingType != nil {
+		form.Set(prefix+"OfferingType", *v.OfferingType)
+	}
+	if v.ProductDescription != nil {
+		form.Set(prefix+"ProductDescription", *v.ProductDescription)
+	}
+	if v.ReservedCacheNodesOfferingID != nil {
+		form.Set(prefix+"ReservedCacheNodesOfferingId", *v.ReservedCacheNodesOfferingID)
+	}
+	return nil
+}
+
 // DescribeSnapshotsListMessage is undocumented.
 type DescribeSnapshotsListMessage struct {
 	Marker    aws.StringValue `query:"Marker" xml:"DescribeSnapshotsResult>Marker"`
-	Snapshots []Snapshot      `query:"Snapshots.member" xml:"DescribeSnapshotsResult>Snapshots>Snapshot"`
+	Snapshots []Snapshot      `query:"Snapshots,list:Snapshot" xml:"DescribeSnapshotsResult>Snapshots>Snapshot"`
 }
 
 // DescribeSnapshotsMessage is undocumented.
@@ -844,6 +1738,29 @@ type DescribeSnapshotsMessage struct {
 	SnapshotSource aws.StringValue  `query:"SnapshotSource" xml:"SnapshotSource"`
 }
 
+// MarshalQuery adds the parameters of the DescribeSnapshotsMessage to the form, prefixing their names.
+func (v *DescribeSnapshotsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheClusterID != nil {
+		form.Set(prefix+"CacheClusterId", *v.CacheClusterID)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.SnapshotName != nil {
+		form.Set(prefix+"SnapshotName", *v.SnapshotName)
+	}
+	if v.SnapshotSource != nil {
+		form.Set(prefix+"SnapshotSource", *v.SnapshotSource)
+	}
+	return nil
+}
+
 // EC2SecurityGroup is undocumented.
 type EC2SecurityGroup struct {
 	EC2SecurityGroupName    aws.StringValue `query:"EC2SecurityGroupName" xml:"EC2SecurityGroupName"`
@@ -859,10 +1776,10 @@ type Endpoint struct {
 
 // EngineDefaults is undocumented.
 type EngineDefaults struct {
-	CacheNodeTypeSpecificParameters []CacheNodeTypeSpecificParameter `query:"CacheNodeTypeSpecificParameters.member" xml:"CacheNodeTypeSpecificParameters>CacheNodeTypeSpecificParameter"`
+	CacheNodeTypeSpecificParameters []CacheNodeTypeSpecificParameter `query:"CacheNodeTypeSpecificParameters,list:CacheNodeTypeSpecificParameter" xml:"CacheNodeTypeSpecificParameters>CacheNodeTypeSpecificParameter"`
 	CacheParameterGroupFamily       aws.StringValue                  `query:"CacheParameterGroupFamily" xml:"CacheParameterGroupFamily"`
 	Marker                          aws.StringValue                  `query:"Marker" xml:"Marker"`
-	Parameters                      []Parameter                      `query:"Parameters.member" xml:"Parameters>Parameter"`
+	Parameters                      []Parameter                      `query:"Parameters,list:Parameter" xml:"Parameters>Parameter"`
 }
 
 // Event is undocumented.
@@ -875,7 +1792,7 @@ type Event struct {
 
 // EventsMessage is undocumented.
 type EventsMessage struct {
-	Events []Event         `query:"Events.member" xml:"DescribeEventsResult>Events>Event"`
+	Events []Event         `query:"Events,list:Event" xml:"DescribeEventsResult>Events>Event"`
 	Marker aws.StringValue `query:"Marker" xml:"DescribeEventsResult>Marker"`
 }
 
@@ -885,20 +1802,100 @@ type ModifyCacheClusterMessage struct {
 	ApplyImmediately           aws.BooleanValue `query:"ApplyImmediately" xml:"ApplyImmediately"`
 	AutoMinorVersionUpgrade    aws.BooleanValue `query:"AutoMinorVersionUpgrade" xml:"AutoMinorVersionUpgrade"`
 	CacheClusterID             aws.StringValue  `query:"CacheClusterId" xml:"CacheClusterId"`
-	CacheNodeIDsToRemove       []string         `query:"CacheNodeIdsToRemove.member" xml:"CacheNodeIdsToRemove>CacheNodeId"`
+	CacheNodeIDsToRemove       []string         `query:"CacheNodeIdsToRemove,list:CacheNodeId" xml:"CacheNodeIdsToRemove>CacheNodeId"`
 	CacheParameterGroupName    aws.StringValue  `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
-	CacheSecurityGroupNames    []string         `query:"CacheSecurityGroupNames.member" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
+	CacheSecurityGroupNames    []string         `query:"CacheSecurityGroupNames,list:CacheSecurityGroupName" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
 	EngineVersion              aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
-	NewAvailabilityZones       []string         `query:"NewAvailabilityZones.member" xml:"NewAvailabilityZones>PreferredAvailabilityZone"`
+	NewAvailabilityZones       []string         `query:"NewAvailabilityZones,list:PreferredAvailabilityZone" xml:"NewAvailabilityZones>PreferredAvailabilityZone"`
 	NotificationTopicARN       aws.StringValue  `query:"NotificationTopicArn" xml:"NotificationTopicArn"`
 	NotificationTopicStatus    aws.StringValue  `query:"NotificationTopicStatus" xml:"NotificationTopicStatus"`
 	NumCacheNodes              aws.IntegerValue `query:"NumCacheNodes" xml:"NumCacheNodes"`
 	PreferredMaintenanceWindow aws.StringValue  `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
-	SecurityGroupIDs           []string         `query:"SecurityGroupIds.member" xml:"SecurityGroupIds>SecurityGroupId"`
+	SecurityGroupIDs           []string         `query:"SecurityGroupIds,list:SecurityGroupId" xml:"SecurityGroupIds>SecurityGroupId"`
 	SnapshotRetentionLimit     aws.IntegerValue `query:"SnapshotRetentionLimit" xml:"SnapshotRetentionLimit"`
 	SnapshotWindow             aws.StringValue  `query:"SnapshotWindow" xml:"SnapshotWindow"`
 }
 
+// MarshalQuery adds the parameters of the ModifyCacheClusterMessage to the form, prefixing their names.
+func (v *ModifyCacheClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AZMode != nil {
+		form.Set(prefix+"AZMode", *v.AZMode)
+	}
+	if v.ApplyImmediately != nil {
+		form.Set(prefix+"ApplyImmediately", strconv.FormatBool(*v.ApplyImmediately))
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.CacheClusterID != nil {
+		form.Set(prefix+"CacheClusterId", *v.CacheClusterID)
+	}
+	if v.CacheNodeIDsToRemove != nil {
+		if len(v.CacheNodeIDsToRemove) == 0 {
+			form.Set(prefix+"CacheNodeIdsToRemove", "")
+		}
+		for i1 := range v.CacheNodeIDsToRemove {
+			name1 := prefix + "CacheNodeIdsToRemove.CacheNodeId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CacheNodeIDsToRemove[i1])
+		}
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.CacheSecurityGroupNames != nil {
+		if len(v.CacheSecurityGroupNames) == 0 {
+			form.Set(prefix+"CacheSecurityGroupNames", "")
+		}
+		for i1 := range v.CacheSecurityGroupNames {
+			name1 := prefix + "CacheSecurityGroupNames.CacheSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CacheSecurityGroupNames[i1])
+		}
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.NewAvailabilityZones != nil {
+		if len(v.NewAvailabilityZones) == 0 {
+			form.Set(prefix+"NewAvailabilityZones", "")
+		}
+		for i1 := range v.NewAvailabilityZones {
+			name1 := prefix + "NewAvailabilityZones.PreferredAvailabilityZone." + strconv.Itoa(i1+1)
+			form.Set(name1, v.NewAvailabilityZones[i1])
+		}
+	}
+	if v.NotificationTopicARN != nil {
+		form.Set(prefix+"NotificationTopicArn", *v.NotificationTopicARN)
+	}
+	if v.NotificationTopicStatus != nil {
+		form.Set(prefix+"NotificationTopicStatus", *v.NotificationTopicStatus)
+	}
+	if v.NumCacheNodes != nil {
+		form.Set(prefix+"NumCacheNodes", strconv.FormatInt(int64(*v.NumCacheNodes), 10))
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.SecurityGroupIDs != nil {
+		if len(v.SecurityGroupIDs) == 0 {
+			form.Set(prefix+"SecurityGroupIds", "")
+		}
+		for i1 := range v.SecurityGroupIDs {
+			name1 := prefix + "SecurityGroupIds.SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroupIDs[i1])
+		}
+	}
+	if v.SnapshotRetentionLimit != nil {
+		form.Set(prefix+"SnapshotRetentionLimit", strconv.FormatInt(int64(*v.SnapshotRetentionLimit), 10))
+	}
+	if v.SnapshotWindow != nil {
+		form.Set(prefix+"SnapshotWindow", *v.SnapshotWindow)
+	}
+	return nil
+}
+
 // ModifyCacheClusterResult is undocumented.
 type ModifyCacheClusterResult struct {
 	CacheCluster *CacheCluster `query:"CacheCluster" xml:"ModifyCacheClusterResult>CacheCluster"`
@@ -907,14 +1904,59 @@ type ModifyCacheClusterResult struct {
 // ModifyCacheParameterGroupMessage is undocumented.
 type ModifyCacheParameterGroupMessage struct {
 	CacheParameterGroupName aws.StringValue      `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
-	ParameterNameValues     []ParameterNameValue `query:"ParameterNameValues.member" xml:"ParameterNameValues>ParameterNameValue"`
+	ParameterNameValues     []ParameterNameValue `query:"ParameterNameValues,list:ParameterNameValue" xml:"ParameterNameValues>ParameterNameValue"`
+}
+
+// MarshalQuery adds the parameters of the ModifyCacheParameterGroupMessage to the form, prefixing their names.
+func (v *ModifyCacheParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.ParameterNameValues != nil {
+		if len(v.ParameterNameValues) == 0 {
+			form.Set(prefix+"ParameterNameValues", "")
+		}
+		for i1 := range v.ParameterNameValues {
+			name1 := prefix + "ParameterNameValues.ParameterNameValue." + strconv.Itoa(i1+1)
+			if err := v.ParameterNameValues[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // ModifyCacheSubnetGroupMessage is undocumented.
 type ModifyCacheSubnetGroupMessage struct {
 	CacheSubnetGroupDescription aws.StringValue `query:"CacheSubnetGroupDescription" xml:"CacheSubnetGroupDescription"`
 	CacheSubnetGroupName        aws.StringValue `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
-	SubnetIDs                   []string        `query:"SubnetIds.member" xml:"SubnetIds>SubnetIdentifier"`
+	SubnetIDs                   []string        `query:"SubnetIds,list:SubnetIdentifier" xml:"SubnetIds>SubnetIdentifier"`
+}
+
+// MarshalQuery adds the parameters of the ModifyCacheSubnetGroupMessage to the form, prefixing their names.
+func (v *ModifyCacheSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSubnetGroupDescription != nil {
+		form.Set(prefix+"CacheSubnetGroupDescription", *v.CacheSubnetGroupDescription)
+	}
+	if v.CacheSubnetGroupName != nil {
+		form.Set(prefix+"CacheSubnetGroupName", *v.CacheSubnetGroupName)
+	}
+	if v.SubnetIDs != nil {
+		if len(v.SubnetIDs) == 0 {
+			form.Set(prefix+"SubnetIds", "")
+		}
+		for i1 := range v.SubnetIDs {
+			name1 := prefix + "SubnetIds.SubnetIdentifier." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SubnetIDs[i1])
+		}
+	}
+	return nil
 }
 
 // ModifyCacheSubnetGroupResult is undocumented.
@@ -928,7 +1970,7 @@ type ModifyReplicationGroupMessage struct {
 	AutoMinorVersionUpgrade     aws.BooleanValue `query:"AutoMinorVersionUpgrade" xml:"AutoMinorVersionUpgrade"`
 	AutomaticFailoverEnabled    aws.BooleanValue `query:"AutomaticFailoverEnabled" xml:"AutomaticFailoverEnabled"`
 	CacheParameterGroupName     aws.StringValue  `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
-	CacheSecurityGroupNames     []string         `query:"CacheSecurityGroupNames.member" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
+	CacheSecurityGroupNames     []string         `query:"CacheSecurityGroupNames,list:CacheSecurityGroupName" xml:"CacheSecurityGroupNames>CacheSecurityGroupName"`
 	EngineVersion               aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
 	NotificationTopicARN        aws.StringValue  `query:"NotificationTopicArn" xml:"NotificationTopicArn"`
 	NotificationTopicStatus     aws.StringValue  `query:"NotificationTopicStatus" xml:"NotificationTopicStatus"`
@@ -936,12 +1978,80 @@ type ModifyReplicationGroupMessage struct {
 	PrimaryClusterID            aws.StringValue  `query:"PrimaryClusterId" xml:"PrimaryClusterId"`
 	ReplicationGroupDescription aws.StringValue  `query:"ReplicationGroupDescription" xml:"ReplicationGroupDescription"`
 	ReplicationGroupID          aws.StringValue  `query:"ReplicationGroupId" xml:"ReplicationGroupId"`
-	SecurityGroupIDs            []string         `query:"SecurityGroupIds.member" xml:"SecurityGroupIds>SecurityGroupId"`
+	SecurityGroupIDs            []string         `query:"SecurityGroupIds,list:SecurityGroupId" xml:"SecurityGroupIds>SecurityGroupId"`
 	SnapshotRetentionLimit      aws.IntegerValue `query:"SnapshotRetentionLimit" xml:"SnapshotRetentionLimit"`
 	SnapshotWindow              aws.StringValue  `query:"SnapshotWindow" xml:"SnapshotWindow"`
 	SnapshottingClusterID       aws.StringValue  `query:"SnapshottingClusterId" xml:"SnapshottingClusterId"`
 }
 
+// MarshalQuery adds the parameters of the ModifyReplicationGroupMessage to the form, prefixing their names.
+func (v *ModifyReplicationGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplyImmediately != nil {
+		form.Set(prefix+"ApplyImmediately", strconv.FormatBool(*v.ApplyImmediately))
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.AutomaticFailoverEnabled != nil {
+		form.Set(prefix+"AutomaticFailoverEnabled", strconv.FormatBool(*v.AutomaticFailoverEnabled))
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.CacheSecurityGroupNames != nil {
+		if len(v.CacheSecurityGroupNames) == 0 {
+			form.Set(prefix+"CacheSecurityGroupNames", "")
+		}
+		for i1 := range v.CacheSecurityGroupNames {
+			name1 := prefix + "CacheSecurityGroupNames.CacheSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CacheSecurityGroupNames[i1])
+		}
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.NotificationTopicARN != nil {
+		form.Set(prefix+"NotificationTopicArn", *v.NotificationTopicARN)
+	}
+	if v.NotificationTopicStatus != nil {
+		form.Set(prefix+"NotificationTopicStatus", *v.NotificationTopicStatus)
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.PrimaryClusterID != nil {
+		form.Set(prefix+"PrimaryClusterId", *v.PrimaryClusterID)
+	}
+	if v.ReplicationGroupDescription != nil {
+		form.Set(prefix+"ReplicationGroupDescription", *v.ReplicationGroupDescription)
+	}
+	if v.ReplicationGroupID != nil {
+		form.Set(prefix+"ReplicationGroupId", *v.ReplicationGroupID)
+	}
+	if v.SecurityGroupIDs != nil {
+		if len(v.SecurityGroupIDs) == 0 {
+			form.Set(prefix+"SecurityGroupIds", "")
+		}
+		for i1 := range v.SecurityGroupIDs {
+			name1 := prefix + "SecurityGroupIds.SecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroupIDs[i1])
+		}
+	}
+	if v.SnapshotRetentionLimit != nil {
+		form.Set(prefix+"SnapshotRetentionLimit", strconv.FormatInt(int64(*v.SnapshotRetentionLimit), 10))
+	}
+	if v.SnapshotWindow != nil {
+		form.Set(prefix+"SnapshotWindow", *v.SnapshotWindow)
+	}
+	if v.SnapshottingClusterID != nil {
+		form.Set(prefix+"SnapshottingClusterId", *v.SnapshottingClusterID)
+	}
+	return nil
+}
+
 // ModifyReplicationGroupResult is undocumented.
 type ModifyReplicationGroupResult struct {
 	ReplicationGroup *ReplicationGroup `query:"ReplicationGroup" xml:"ModifyReplicationGroupResult>ReplicationGroup"`
@@ -950,7 +2060,7 @@ type ModifyReplicationGroupResult struct {
 // NodeGroup is undocumented.
 type NodeGroup struct {
 	NodeGroupID      aws.StringValue   `query:"NodeGroupId" xml:"NodeGroupId"`
-	NodeGroupMembers []NodeGroupMember `query:"NodeGroupMembers.member" xml:"NodeGroupMembers>NodeGroupMember"`
+	NodeGroupMembers []NodeGroupMember `query:"NodeGroupMembers,list:NodeGroupMember" xml:"NodeGroupMembers>NodeGroupMember"`
 	PrimaryEndpoint  *Endpoint         `query:"PrimaryEndpoint" xml:"PrimaryEndpoint"`
 	Status           aws.StringValue   `query:"Status" xml:"Status"`
 }
@@ -996,6 +2106,20 @@ type ParameterNameValue struct {
 	ParameterValue aws.StringValue `query:"ParameterValue" xml:"ParameterValue"`
 }
 
+// MarshalQuery adds the parameters of the ParameterNameValue to the form, prefixing their names.
+func (v *ParameterNameValue) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ParameterName != nil {
+		form.Set(prefix+"ParameterName", *v.ParameterName)
+	}
+	if v.ParameterValue != nil {
+		form.Set(prefix+"ParameterValue", *v.ParameterValue)
+	}
+	return nil
+}
+
 // Possible values for ElasticCache.
 const (
 	PendingAutomaticFailoverStatusDisabled = "disabled"
@@ -1004,7 +2128,7 @@ const (
 
 // PendingModifiedValues is undocumented.
 type PendingModifiedValues struct {
-	CacheNodeIDsToRemove []string         `query:"CacheNodeIdsToRemove.member" xml:"CacheNodeIdsToRemove>CacheNodeId"`
+	CacheNodeIDsToRemove []string         `query:"CacheNodeIdsToRemove,list:CacheNodeId" xml:"CacheNodeIdsToRemove>CacheNodeId"`
 	EngineVersion        aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
 	NumCacheNodes        aws.IntegerValue `query:"NumCacheNodes" xml:"NumCacheNodes"`
 }
@@ -1016,6 +2140,23 @@ type PurchaseReservedCacheNodesOfferingMessage struct {
 	ReservedCacheNodesOfferingID aws.StringValue  `query:"ReservedCacheNodesOfferingId" xml:"ReservedCacheNodesOfferingId"`
 }
 
+// MarshalQuery adds the parameters of the PurchaseReservedCacheNodesOfferingMessage to the form, prefixing their names.
+func (v *PurchaseReservedCacheNodesOfferingMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheNodeCount != nil {
+		form.Set(prefix+"CacheNodeCount", strconv.FormatInt(int64(*v.CacheNodeCount), 10))
+	}
+	if v.ReservedCacheNodeID != nil {
+		form.Set(prefix+"ReservedCacheNodeId", *v.ReservedCacheNodeID)
+	}
+	if v.ReservedCacheNodesOfferingID != nil {
+		form.Set(prefix+"ReservedCacheNodesOfferingId", *v.ReservedCacheNodesOfferingID)
+	}
+	return nil
+}
+
 // PurchaseReservedCacheNodesOfferingResult is undocumented.
 type PurchaseReservedCacheNodesOfferingResult struct {
 	ReservedCacheNode *ReservedCacheNode `query:"ReservedCacheNode" xml:"PurchaseReservedCacheNodesOfferingResult>ReservedCacheNode"`
@@ -1024,7 +2165,27 @@ type PurchaseReservedCacheNodesOfferingResult struct {
 // RebootCacheClusterMessage is undocumented.
 type RebootCacheClusterMessage struct {
 	CacheClusterID       aws.StringValue `query:"CacheClusterId" xml:"CacheClusterId"`
-	CacheNodeIDsToReboot []string        `query:"CacheNodeIdsToReboot.member" xml:"CacheNodeIdsToReboot>CacheNodeId"`
+	CacheNodeIDsToReboot []string        `query:"CacheNodeIdsToReboot,list:CacheNodeId" xml:"CacheNodeIdsToReboot>CacheNodeId"`
+}
+
+// MarshalQuery adds the parameters of the RebootCacheClusterMessage to the form, prefixing their names.
+func (v *RebootCacheClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheClusterID != nil {
+		form.Set(prefix+"CacheClusterId", *v.CacheClusterID)
+	}
+	if v.CacheNodeIDsToReboot != nil {
+		if len(v.CacheNodeIDsToReboot) == 0 {
+			form.Set(prefix+"CacheNodeIdsToReboot", "")
+		}
+		for i1 := range v.CacheNodeIDsToReboot {
+			name1 := prefix + "CacheNodeIdsToReboot.CacheNodeId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CacheNodeIDsToReboot[i1])
+		}
+	}
+	return nil
 }
 
 // RebootCacheClusterResult is undocumented.
@@ -1042,8 +2203,8 @@ type RecurringCharge struct {
 type ReplicationGroup struct {
 	AutomaticFailover     aws.StringValue                        `query:"AutomaticFailover" xml:"AutomaticFailover"`
 	Description           aws.StringValue                        `query:"Description" xml:"Description"`
-	MemberClusters        []string                               `query:"MemberClusters.member" xml:"MemberClusters>ClusterId"`
-	NodeGroups            []NodeGroup                            `query:"NodeGroups.member" xml:"NodeGroups>NodeGroup"`
+	MemberClusters        []string                               `query:"MemberClusters,list:ClusterId" xml:"MemberClusters>ClusterId"`
+	NodeGroups            []NodeGroup                            `query:"NodeGroups,list:NodeGroup" xml:"NodeGroups>NodeGroup"`
 	PendingModifiedValues *ReplicationGroupPendingModifiedValues `query:"PendingModifiedValues" xml:"PendingModifiedValues"`
 	ReplicationGroupID    aws.StringValue                        `query:"ReplicationGroupId" xml:"ReplicationGroupId"`
 	SnapshottingClusterID aws.StringValue                        `query:"SnapshottingClusterId" xml:"SnapshottingClusterId"`
@@ -1053,7 +2214,7 @@ type ReplicationGroup struct {
 // ReplicationGroupMessage is undocumented.
 type ReplicationGroupMessage struct {
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeReplicationGroupsResult>Marker"`
-	ReplicationGroups []ReplicationGroup `query:"ReplicationGroups.member" xml:"DescribeReplicationGroupsResult>ReplicationGroups>ReplicationGroup"`
+	ReplicationGroups []ReplicationGroup `query:"ReplicationGroups,list:ReplicationGroup" xml:"DescribeReplicationGroupsResult>ReplicationGroups>ReplicationGroup"`
 }
 
 // ReplicationGroupPendingModifiedValues is undocumented.
@@ -1070,7 +2231,7 @@ type ReservedCacheNode struct {
 	FixedPrice                   aws.DoubleValue   `query:"FixedPrice" xml:"FixedPrice"`
 	OfferingType                 aws.StringValue   `query:"OfferingType" xml:"OfferingType"`
 	ProductDescription           aws.StringValue   `query:"ProductDescription" xml:"ProductDescription"`
-	RecurringCharges             []RecurringCharge `query:"RecurringCharges.member" xml:"RecurringCharges>RecurringCharge"`
+	RecurringCharges             []RecurringCharge `query:"RecurringCharges,list:RecurringCharge" xml:"RecurringCharges>RecurringCharge"`
 	ReservedCacheNodeID          aws.StringValue   `query:"ReservedCacheNodeId" xml:"ReservedCacheNodeId"`
 	ReservedCacheNodesOfferingID aws.StringValue   `query:"ReservedCacheNodesOfferingId" xml:"ReservedCacheNodesOfferingId"`
 	StartTime                    time.Time         `query:"StartTime" xml:"StartTime"`
@@ -1081,7 +2242,7 @@ type ReservedCacheNode struct {
 // ReservedCacheNodeMessage is undocumented.
 type ReservedCacheNodeMessage struct {
 	Marker             aws.StringValue     `query:"Marker" xml:"DescribeReservedCacheNodesResult>Marker"`
-	ReservedCacheNodes []ReservedCacheNode `query:"ReservedCacheNodes.member" xml:"DescribeReservedCacheNodesResult>ReservedCacheNodes>ReservedCacheNode"`
+	ReservedCacheNodes []ReservedCacheNode `query:"ReservedCacheNodes,list:ReservedCacheNode" xml:"DescribeReservedCacheNodesResult>ReservedCacheNodes>ReservedCacheNode"`
 }
 
 // ReservedCacheNodesOffering is undocumented.
@@ -1091,7 +2252,7 @@ type ReservedCacheNodesOffering struct {
 	FixedPrice                   aws.DoubleValue   `query:"FixedPrice" xml:"FixedPrice"`
 	OfferingType                 aws.StringValue   `query:"OfferingType" xml:"OfferingType"`
 	ProductDescription           aws.StringValue   `query:"ProductDescription" xml:"ProductDescription"`
-	RecurringCharges             []RecurringCharge `query:"RecurringCharges.member" xml:"RecurringCharges>RecurringCharge"`
+	RecurringCharges             []RecurringCharge `query:"RecurringCharges,list:RecurringCharge" xml:"RecurringCharges>RecurringCharge"`
 	ReservedCacheNodesOfferingID aws.StringValue   `query:"ReservedCacheNodesOfferingId" xml:"ReservedCacheNodesOfferingId"`
 	UsagePrice                   aws.DoubleValue   `query:"UsagePrice" xml:"UsagePrice"`
 }
@@ -1099,16 +2260,41 @@ type ReservedCacheNodesOffering struct {
 // ReservedCacheNodesOfferingMessage is undocumented.
 type ReservedCacheNodesOfferingMessage struct {
 	Marker                      aws.StringValue              `query:"Marker" xml:"DescribeReservedCacheNodesOfferingsResult>Marker"`
-	ReservedCacheNodesOfferings []ReservedCacheNodesOffering `query:"ReservedCacheNodesOfferings.member" xml:"DescribeReservedCacheNodesOfferingsResult>ReservedCacheNodesOfferings>ReservedCacheNodesOffering"`
+	ReservedCacheNodesOfferings []ReservedCacheNodesOffering `query:"ReservedCacheNodesOfferings,list:ReservedCacheNodesOffering" xml:"DescribeReservedCacheNodesOfferingsResult>ReservedCacheNodesOfferings>ReservedCacheNodesOffering"`
 }
 
 // ResetCacheParameterGroupMessage is undocumented.
 type ResetCacheParameterGroupMessage struct {
 	CacheParameterGroupName aws.StringValue      `query:"CacheParameterGroupName" xml:"CacheParameterGroupName"`
-	ParameterNameValues     []ParameterNameValue `query:"ParameterNameValues.member" xml:"ParameterNameValues>ParameterNameValue"`
+	ParameterNameValues     []ParameterNameValue `query:"ParameterNameValues,list:ParameterNameValue" xml:"ParameterNameValues>ParameterNameValue"`
 	ResetAllParameters      aws.BooleanValue     `query:"ResetAllParameters" xml:"ResetAllParameters"`
 }
 
+// MarshalQuery adds the parameters of the ResetCacheParameterGroupMessage to the form, prefixing their names.
+func (v *ResetCacheParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheParameterGroupName != nil {
+		form.Set(prefix+"CacheParameterGroupName", *v.CacheParameterGroupName)
+	}
+	if v.ParameterNameValues != nil {
+		if len(v.ParameterNameValues) == 0 {
+			form.Set(prefix+"ParameterNameValues", "")
+		}
+		for i1 := range v.ParameterNameValues {
+			name1 := prefix + "ParameterNameValues.ParameterNameValue." + strconv.Itoa(i1+1)
+			if err := v.ParameterNameValues[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ResetAllParameters != nil {
+		form.Set(prefix+"ResetAllParameters", strconv.FormatBool(*v.ResetAllParameters))
+	}
+	return nil
+}
+
 // RevokeCacheSecurityGroupIngressMessage is undocumented.
 type RevokeCacheSecurityGroupIngressMessage struct {
 	CacheSecurityGroupName  aws.StringValue `query:"CacheSecurityGroupName" xml:"CacheSecurityGroupName"`
@@ -1116,6 +2302,23 @@ type RevokeCacheSecurityGroupIngressMessage struct {
 	EC2SecurityGroupOwnerID aws.StringValue `query:"EC2SecurityGroupOwnerId" xml:"EC2SecurityGroupOwnerId"`
 }
 
+// MarshalQuery adds the parameters of the RevokeCacheSecurityGroupIngressMessage to the form, prefixing their names.
+func (v *RevokeCacheSecurityGroupIngressMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CacheSecurityGroupName != nil {
+		form.Set(prefix+"CacheSecurityGroupName", *v.CacheSecurityGroupName)
+	}
+	if v.EC2SecurityGroupName != nil {
+		form.Set(prefix+"EC2SecurityGroupName", *v.EC2SecurityGroupName)
+	}
+	if v.EC2SecurityGroupOwnerID != nil {
+		form.Set(prefix+"EC2SecurityGroupOwnerId", *v.EC2SecurityGroupOwnerID)
+	}
+	return nil
+}
+
 // RevokeCacheSecurityGroupIngressResult is undocumented.
 type RevokeCacheSecurityGroupIngressResult struct {
 	CacheSecurityGroup *CacheSecurityGroup `query:"CacheSecurityGroup" xml:"RevokeCacheSecurityGroupIngressResult>CacheSecurityGroup"`
@@ -1137,7 +2340,7 @@ type Snapshot struct {
 	CacheSubnetGroupName       aws.StringValue  `query:"CacheSubnetGroupName" xml:"CacheSubnetGroupName"`
 	Engine                     aws.StringValue  `query:"Engine" xml:"Engine"`
 	EngineVersion              aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
-	NodeSnapshots              []NodeSnapshot   `query:"NodeSnapshots.member" xml:"NodeSnapshots>NodeSnapshot"`
+	NodeSnapshots              []NodeSnapshot   `query:"NodeSnapshots,list:NodeSnapshot" xml:"NodeSnapshots>NodeSnapshot"`
 	NumCacheNodes              aws.IntegerValue `query:"NumCacheNodes" xml:"NumCacheNodes"`
 	Port                       aws.IntegerValue `query:"Port" xml:"Port"`
 	PreferredAvailabilityZone  aws.StringValue  `query:"PreferredAvailabilityZone" xml:"PreferredAvailabilityZone"`
@@ -1167,69 +2370,69 @@ type Subnet struct {
 
 // DescribeCacheClustersResult is a wrapper for CacheClusterMessage.
 type DescribeCacheClustersResult struct {
-	CacheClusters []CacheCluster  `query:"CacheClusters.member" xml:"DescribeCacheClustersResult>CacheClusters>CacheCluster"`
+	CacheClusters []CacheCluster  `query:"CacheClusters,list:CacheCluster" xml:"DescribeCacheClustersResult>CacheClusters>CacheCluster"`
 	Marker        aws.StringValue `query:"Marker" xml:"DescribeCacheClustersResult>Marker"`
 }
 
 // DescribeCacheEngineVersionsResult is a wrapper for CacheEngineVersionMessage.
 type DescribeCacheEngineVersionsResult struct {
-	CacheEngineVersions []CacheEngineVersion `query:"CacheEngineVersions.member" xml:"DescribeCacheEngineVersionsResult>CacheEngineVersions>CacheEngineVersion"`
+	CacheEngineVersions []CacheEngineVersion `query:"CacheEngineVersions,list:CacheEngineVersion" xml:"DescribeCacheEngineVersionsResult>CacheEngineVersions>CacheEngineVersion"`
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeCacheEngineVersionsResult>Marker"`
 }
 
 // DescribeCacheParameterGroupsResult is a wrapper for CacheParameterGroupsMessage.
 type DescribeCacheParameterGroupsResult struct {
-	CacheParameterGroups []CacheParameterGroup `query:"CacheParameterGroups.member" xml:"DescribeCacheParameterGroupsResult>CacheParameterGroups>CacheParameterGroup"`
+	CacheParameterGroups []CacheParameterGroup `query:"CacheParameterGroups,list:CacheParameterGroup" xml:"DescribeCacheParameterGroupsResult>CacheParameterGroups>CacheParameterGroup"`
 	Marker               aws.StringValue       `query:"Marker" xml:"DescribeCacheParameterGroupsResult>Marker"`
 }
 
 // DescribeCacheParametersResult is a wrapper for CacheParameterGroupDetails.
 type DescribeCacheParametersResult struct {
-	CacheNodeTypeSpecificParameters []CacheNodeTypeSpecificParameter `query:"CacheNodeTypeSpecificParameters.member" xml:"DescribeCacheParametersResult>CacheNodeTypeSpecificParameters>CacheNodeTypeSpecificParameter"`
+	CacheNodeTypeSpecificParameters []CacheNodeTypeSpecificParameter `query:"CacheNodeTypeSpecificParameters,list:CacheNodeTypeSpecificParameter" xml:"DescribeCacheParametersResult>CacheNodeTypeSpecificParameters>CacheNodeTypeSpecificParameter"`
 	Marker                          aws.StringValue                  `query:"Marker" xml:"DescribeCacheParametersResult>Marker"`
-	Parameters                      []Parameter                      `query:"Parameters.member" xml:"DescribeCacheParametersResult>Parameters>Parameter"`
+	Parameters                      []Parameter                      `query:"Parameters,list:Parameter" xml:"DescribeCacheParametersResult>Parameters>Parameter"`
 }
 
 // DescribeCacheSecurityGroupsResult is a wrapper for CacheSecurityGroupMessage.
 type DescribeCacheSecurityGroupsResult struct {
-	CacheSecurityGroups []CacheSecurityGroup `query:"CacheSecurityGroups.member" xml:"DescribeCacheSecurityGroupsResult>CacheSecurityGroups>CacheSecurityGroup"`
+	CacheSecurityGroups []CacheSecurityGroup `query:"CacheSecurityGroups,list:CacheSecurityGroup" xml:"DescribeCacheSecurityGroupsResult>CacheSecurityGroups>CacheSecurityGroup"`
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeCacheSecurityGroupsResult>Marker"`
 }
 
 // DescribeCacheSubnetGroupsResult is a wrapper for CacheSubnetGroupMessage.
 type DescribeCacheSubnetGroupsResult struct {
-	CacheSubnetGroups []CacheSubnetGroup `query:"CacheSubnetGroups.member" xml:"DescribeCacheSubnetGroupsResult>CacheSubnetGroups>CacheSubnetGroup"`
+	CacheSubnetGroups []CacheSubnetGroup `query:"CacheSubnetGroups,list:CacheSubnetGroup" xml:"DescribeCacheSubnetGroupsResult>CacheSubnetGroups>CacheSubnetGroup"`
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeCacheSubnetGroupsResult>Marker"`
 }
 
 // DescribeEventsResult is a wrapper for EventsMessage.
 type DescribeEventsResult struct {
-	Events []Event         `query:"Events.member" xml:"DescribeEventsResult>Events>Event"`
+	Events []Event         `query:"Events,list:Event" xml:"DescribeEventsResult>Events>Event"`
 	Marker aws.StringValue `query:"Marker" xml:"DescribeEventsResult>Marker"`
 }
 
 // DescribeReplicationGroupsResult is a wrapper for ReplicationGroupMessage.
 type DescribeReplicationGroupsResult struct {
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeReplicationGroupsResult>Marker"`
-	ReplicationGroups []ReplicationGroup `query:"ReplicationGroups.member" xml:"DescribeReplicationGroupsResult>ReplicationGroups>ReplicationGroup"`
+	ReplicationGroups []ReplicationGroup `query:"ReplicationGroups,list:ReplicationGroup" xml:"DescribeReplicationGroupsResult>ReplicationGroups>ReplicationGroup"`
 }
 
 // DescribeReservedCacheNodesOfferingsResult is a wrapper for ReservedCacheNodesOfferingMessage.
 type DescribeReservedCacheNodesOfferingsResult struct {
 	Marker                      aws.StringValue              `query:"Marker" xml:"DescribeReservedCacheNodesOfferingsResult>Marker"`
-	ReservedCacheNodesOfferings []ReservedCacheNodesOffering `query:"ReservedCacheNodesOfferings.member" xml:"DescribeReservedCacheNodesOfferingsResult>ReservedCacheNodesOfferings>ReservedCacheNodesOffering"`
+	ReservedCacheNodesOfferings []ReservedCacheNodesOffering `query:"ReservedCacheNodesOfferings,list:ReservedCacheNodesOffering" xml:"DescribeReservedCacheNodesOfferingsResult>ReservedCacheNodesOfferings>ReservedCacheNodesOffering"`
 }
 
 // DescribeReservedCacheNodesResult is a wrapper for ReservedCacheNodeMessage.
 type DescribeReservedCacheNodesResult struct {
 	Marker             aws.StringValue     `query:"Marker" xml:"DescribeReservedCacheNodesResult>Marker"`
-	ReservedCacheNodes []ReservedCacheNode `query:"ReservedCacheNodes.member" xml:"DescribeReservedCacheNodesResult>ReservedCacheNodes>ReservedCacheNode"`
+	ReservedCacheNodes []ReservedCacheNode `query:"ReservedCacheNodes,list:ReservedCacheNode" xml:"DescribeReservedCacheNodesResult>ReservedCacheNodes>ReservedCacheNode"`
 }
 
 // DescribeSnapshotsResult is a wrapper for DescribeSnapshotsListMessage.
 type DescribeSnapshotsResult struct {
 	Marker    aws.StringValue `query:"Marker" xml:"DescribeSnapshotsResult>Marker"`
-	Snapshots []Snapshot      `query:"Snapshots.member" xml:"DescribeSnapshotsResult>Snapshots>Snapshot"`
+	Snapshots []Snapshot      `query:"Snapshots,list:Snapshot" xml:"DescribeSnapshotsResult>Snapshots>Snapshot"`
 }
 
 // ModifyCacheParameterGroupResult is a wrapper for CacheParameterGroupNameMessage.
@@ -1242,5 +2445,249 @@ type ResetCacheParameterGroupResult struct {
 	CacheParameterGroupName aws.StringValue `query:"CacheParameterGroupName" xml:"ResetCacheParameterGroupResult>CacheParameterGroupName"`
 }
 
+// AuthorizationAlreadyExistsFault is the error returned for the AuthorizationAlreadyExists error code.
+type AuthorizationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// AuthorizationNotFoundFault is the error returned for the AuthorizationNotFound error code.
+type AuthorizationNotFoundFault struct {
+	aws.APIError
+}
+
+// CacheClusterAlreadyExistsFault is the error returned for the CacheClusterAlreadyExists error code.
+type CacheClusterAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// CacheClusterNotFoundFault is the error returned for the CacheClusterNotFound error code.
+type CacheClusterNotFoundFault struct {
+	aws.APIError
+}
+
+// CacheParameterGroupAlreadyExistsFault is the error returned for the CacheParameterGroupAlreadyExists error code.
+type CacheParameterGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// CacheParameterGroupNotFoundFault is the error returned for the CacheParameterGroupNotFound error code.
+type CacheParameterGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// CacheParameterGroupQuotaExceededFault is the error returned for the CacheParameterGroupQuotaExceeded error code.
+type CacheParameterGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// CacheSecurityGroupAlreadyExistsFault is the error returned for the CacheSecurityGroupAlreadyExists error code.
+type CacheSecurityGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// CacheSecurityGroupNotFoundFault is the error returned for the CacheSecurityGroupNotFound error code.
+type CacheSecurityGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// CacheSecurityGroupQuotaExceededFault is the error returned for the QuotaExceeded.CacheSecurityGroup error code.
+type CacheSecurityGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// CacheSubnetGroupAlreadyExistsFault is the error returned for the CacheSubnetGroupAlreadyExists error code.
+type CacheSubnetGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// CacheSubnetGroupInUse is the error returned for the CacheSubnetGroupInUse error code.
+type CacheSubnetGroupInUse struct {
+	aws.APIError
+}
+
+// CacheSubnetGroupNotFoundFault is the error returned for the CacheSubnetGroupNotFoundFault error code.
+type CacheSubnetGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// CacheSubnetGroupQuotaExceededFault is the error returned for the CacheSubnetGroupQuotaExceeded error code.
+type CacheSubnetGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// CacheSubnetQuotaExceededFault is the error returned for the CacheSubnetQuotaExceededFault error code.
+type CacheSubnetQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ClusterQuotaForCustomerExceededFault is the error returned for the ClusterQuotaForCustomerExceeded error code.
+type ClusterQuotaForCustomerExceededFault struct {
+	aws.APIError
+}
+
+// InsufficientCacheClusterCapacityFault is the error returned for the InsufficientCacheClusterCapacity error code.
+type InsufficientCacheClusterCapacityFault struct {
+	aws.APIError
+}
+
+// InvalidCacheClusterStateFault is the error returned for the InvalidCacheClusterState error code.
+type InvalidCacheClusterStateFault struct {
+	aws.APIError
+}
+
+// InvalidCacheParameterGroupStateFault is the error returned for the InvalidCacheParameterGroupState error code.
+type InvalidCacheParameterGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidCacheSecurityGroupStateFault is the error returned for the InvalidCacheSecurityGroupState error code.
+type InvalidCacheSecurityGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidParameterCombinationException is the error returned for the InvalidParameterCombination error code.
+type InvalidParameterCombinationException struct {
+	aws.APIError
+}
+
+// InvalidParameterValueException is the error returned for the InvalidParameterValue error code.
+type InvalidParameterValueException struct {
+	aws.APIError
+}
+
+// InvalidReplicationGroupStateFault is the error returned for the InvalidReplicationGroupState error code.
+type InvalidReplicationGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidSnapshotStateFault is the error returned for the InvalidSnapshotState error code.
+type InvalidSnapshotStateFault struct {
+	aws.APIError
+}
+
+// InvalidSubnet is the error returned for the InvalidSubnet error code.
+type InvalidSubnet struct {
+	aws.APIError
+}
+
+// InvalidVPCNetworkStateFault is the error returned for the InvalidVPCNetworkStateFault error code.
+type InvalidVPCNetworkStateFault struct {
+	aws.APIError
+}
+
+// NodeQuotaForClusterExceededFault is the error returned for the NodeQuotaForClusterExceeded error code.
+type NodeQuotaForClusterExceededFault struct {
+	aws.APIError
+}
+
+// NodeQuotaForCustomerExceededFault is the error returned for the NodeQuotaForCustomerExceeded error code.
+type NodeQuotaForCustomerExceededFault struct {
+	aws.APIError
+}
+
+// ReplicationGroupAlreadyExistsFault is the error returned for the ReplicationGroupAlreadyExists error code.
+type ReplicationGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ReplicationGroupNotFoundFault is the error returned for the ReplicationGroupNotFoundFault error code.
+type ReplicationGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// ReservedCacheNodeAlreadyExistsFault is the error returned for the ReservedCacheNodeAlreadyExists error code.
+type ReservedCacheNodeAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ReservedCacheNodeNotFoundFault is the error returned for the ReservedCacheNodeNotFound error code.
+type ReservedCacheNodeNotFoundFault struct {
+	aws.APIError
+}
+
+// ReservedCacheNodeQuotaExceededFault is the error returned for the ReservedCacheNodeQuotaExceeded error code.
+type ReservedCacheNodeQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ReservedCacheNodesOfferingNotFoundFault is the error returned for the ReservedCacheNodesOfferingNotFound error code.
+type ReservedCacheNodesOfferingNotFoundFault struct {
+	aws.APIError
+}
+
+// SnapshotAlreadyExistsFault is the error returned for the SnapshotAlreadyExistsFault error code.
+type SnapshotAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// SnapshotFeatureNotSupportedFault is the error returned for the SnapshotFeatureNotSupportedFault error code.
+type SnapshotFeatureNotSupportedFault struct {
+	aws.APIError
+}
+
+// SnapshotNotFoundFault is the error returned for the SnapshotNotFoundFault error code.
+type SnapshotNotFoundFault struct {
+	aws.APIError
+}
+
+// SnapshotQuotaExceededFault is the error returned for the SnapshotQuotaExceededFault error code.
+type SnapshotQuotaExceededFault struct {
+	aws.APIError
+}
+
+// SubnetInUse is the error returned for the SubnetInUse error code.
+type SubnetInUse struct {
+	aws.APIError
+}
+
+// exceptions maps ElasticCache's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AuthorizationAlreadyExists":         AuthorizationAlreadyExistsFault{},
+	"AuthorizationNotFound":              AuthorizationNotFoundFault{},
+	"CacheClusterAlreadyExists":          CacheClusterAlreadyExistsFault{},
+	"CacheClusterNotFound":               CacheClusterNotFoundFault{},
+	"CacheParameterGroupAlreadyExists":   CacheParameterGroupAlreadyExistsFault{},
+	"CacheParameterGroupNotFound":        CacheParameterGroupNotFoundFault{},
+	"CacheParameterGroupQuotaExceeded":   CacheParameterGroupQuotaExceededFault{},
+	"CacheSecurityGroupAlreadyExists":    CacheSecurityGroupAlreadyExistsFault{},
+	"CacheSecurityGroupNotFound":         CacheSecurityGroupNotFoundFault{},
+	"QuotaExceeded.CacheSecurityGroup":   CacheSecurityGroupQuotaExceededFault{},
+	"CacheSubnetGroupAlreadyExists":      CacheSubnetGroupAlreadyExistsFault{},
+	"CacheSubnetGroupInUse":              CacheSubnetGroupInUse{},
+	"CacheSubnetGroupNotFoundFault":      CacheSubnetGroupNotFoundFault{},
+	"CacheSubnetGroupQuotaExceeded":      CacheSubnetGroupQuotaExceededFault{},
+	"CacheSubnetQuotaExceededFault":      CacheSubnetQuotaExceededFault{},
+	"ClusterQuotaForCustomerExceeded":    ClusterQuotaForCustomerExceededFault{},
+	"InsufficientCacheClusterCapacity":   InsufficientCacheClusterCapacityFault{},
+	"InvalidCacheClusterState":           InvalidCacheClusterStateFault{},
+	"InvalidCacheParameterGroupState":    InvalidCacheParameterGroupStateFault{},
+	"InvalidCacheSecurityGroupState":     InvalidCacheSecurityGroupStateFault{},
+	"InvalidParameterCombination":        InvalidParameterCombinationException{},
+	"InvalidParameterValue":              InvalidParameterValueException{},
+	"InvalidReplicationGroupState":       InvalidReplicationGroupStateFault{},
+	"InvalidSnapshotState":               InvalidSnapshotStateFault{},
+	"InvalidSubnet":                      InvalidSubnet{},
+	"InvalidVPCNetworkStateFault":        InvalidVPCNetworkStateFault{},
+	"NodeQuotaForClusterExceeded":        NodeQuotaForClusterExceededFault{},
+	"NodeQuotaForCustomerExceeded":       NodeQuotaForCustomerExceededFault{},
+	"ReplicationGroupAlreadyExists":      ReplicationGroupAlreadyExistsFault{},
+	"ReplicationGroupNotFoundFault":      ReplicationGroupNotFoundFault{},
+	"ReservedCacheNodeAlreadyExists":     ReservedCacheNodeAlreadyExistsFault{},
+	"ReservedCacheNodeNotFound":          ReservedCacheNodeNotFoundFault{},
+	"ReservedCacheNodeQuotaExceeded":     ReservedCacheNodeQuotaExceededFault{},
+	"ReservedCacheNodesOfferingNotFound": ReservedCacheNodesOfferingNotFoundFault{},
+	"SnapshotAlreadyExistsFault":         SnapshotAlreadyExistsFault{},
+	"SnapshotFeatureNotSupportedFault":   SnapshotFeatureNotSupportedFault{},
+	"SnapshotNotFoundFault":              SnapshotNotFoundFault{},
+	"SnapshotQuotaExceededFault":         SnapshotQuotaExceededFault{},
+	"SubnetInUse":                        SubnetInUse{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,11 +4,21 @@
 package elasticbeanstalk
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // ElasticBeanstalk is a client for AWS Elastic Beanstalk.
@@ -16,49 +26,111 @@ type ElasticBeanstalk struct {
 	client *aws.QueryClient
 }
 
-// New returns a new ElasticBeanstalk client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *ElasticBeanstalk {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "elasticbeanstalk"
-	endpoint, service, region := endpoints.Lookup("elasticbeanstalk", region)
+// New returns a new ElasticBeanstalk client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *ElasticBeanstalk {
+	cfg := newConfig(region, client, opts)
 
 	return &ElasticBeanstalk{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2010-12-01",
 		},
 	}
 }
 
+// NewFromSession returns a new ElasticBeanstalk client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *ElasticBeanstalk {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("elasticbeanstalk", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("elasticbeanstalk", "elasticbeanstalk"),
+	}, "AWS_ENDPOINT_URL_ELASTIC_BEANSTALK", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *ElasticBeanstalk) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *ElasticBeanstalk) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *ElasticBeanstalk) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // CheckDNSAvailability is undocumented.
 func (c *ElasticBeanstalk) CheckDNSAvailability(req *CheckDNSAvailabilityMessage) (resp *CheckDNSAvailabilityResult, err error) {
+	return c.CheckDNSAvailabilityWithContext(context.Background(), req)
+}
+
+// CheckDNSAvailabilityWithContext is like CheckDNSAvailability, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) CheckDNSAvailabilityWithContext(ctx context.Context, req *CheckDNSAvailabilityMessage) (resp *CheckDNSAvailabilityResult, err error) {
 	resp = &CheckDNSAvailabilityResult{}
-	err = c.client.Do("CheckDNSAvailability", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CheckDNSAvailability", "POST", "/", req, resp)
 	return
 }
 
 // CreateApplication creates an application that has one configuration
 // template named default and no application versions.
 func (c *ElasticBeanstalk) CreateApplication(req *CreateApplicationMessage) (resp *CreateApplicationResult, err error) {
+	return c.CreateApplicationWithContext(context.Background(), req)
+}
+
+// CreateApplicationWithContext is like CreateApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) CreateApplicationWithContext(ctx context.Context, req *CreateApplicationMessage) (resp *CreateApplicationResult, err error) {
 	resp = &CreateApplicationResult{}
-	err = c.client.Do("CreateApplication", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateApplication", "POST", "/", req, resp)
 	return
 }
 
 // CreateApplicationVersion creates an application version for the
 // specified application.
 func (c *ElasticBeanstalk) CreateApplicationVersion(req *CreateApplicationVersionMessage) (resp *CreateApplicationVersionResult, err error) {
+	return c.CreateApplicationVersionWithContext(context.Background(), req)
+}
+
+// CreateApplicationVersionWithContext is like CreateApplicationVersion, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) CreateApplicationVersionWithContext(ctx context.Context, req *CreateApplicationVersionMessage) (resp *CreateApplicationVersionResult, err error) {
 	resp = &CreateApplicationVersionResult{}
-	err = c.client.Do("CreateApplicationVersion", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateApplicationVersion", "POST", "/", req, resp)
 	return
 }
 
@@ -67,24 +139,45 @@ func (c *ElasticBeanstalk) CreateApplicationVersion(req *CreateApplicationVersio
 // different versions of the application with the same configuration
 // settings.
 func (c *ElasticBeanstalk) CreateConfigurationTemplate(req *CreateConfigurationTemplateMessage) (resp *CreateConfigurationTemplateResult, err error) {
+	return c.CreateConfigurationTemplateWithContext(context.Background(), req)
+}
+
+// CreateConfigurationTemplateWithContext is like CreateConfigurationTemplate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) CreateConfigurationTemplateWithContext(ctx context.Context, req *CreateConfigurationTemplateMessage) (resp *CreateConfigurationTemplateResult, err error) {
 	resp = &CreateConfigurationTemplateResult{}
-	err = c.client.Do("CreateConfigurationTemplate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateConfigurationTemplate", "POST", "/", req, resp)
 	return
 }
 
 // CreateEnvironment launches an environment for the specified application
 // using the specified configuration.
 func (c *ElasticBeanstalk) CreateEnvironment(req *CreateEnvironmentMessage) (resp *CreateEnvironmentResult, err error) {
+	return c.CreateEnvironmentWithContext(context.Background(), req)
+}
+
+// CreateEnvironmentWithContext is like CreateEnvironment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) CreateEnvironmentWithContext(ctx context.Context, req *CreateEnvironmentMessage) (resp *CreateEnvironmentResult, err error) {
 	resp = &CreateEnvironmentResult{}
-	err = c.client.Do("CreateEnvironment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateEnvironment", "POST", "/", req, resp)
 	return
 }
 
 // CreateStorageLocation creates the Amazon S3 storage location for the
 // account. This location is used to store user log files.
 func (c *ElasticBeanstalk) CreateStorageLocation() (resp *CreateStorageLocationResult, err error) {
+	return c.CreateStorageLocationWithContext(context.Background())
+}
+
+// CreateStorageLocationWithContext is like CreateStorageLocation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) CreateStorageLocationWithContext(ctx context.Context) (resp *CreateStorageLocationResult, err error) {
 	resp = &CreateStorageLocationResult{}
-	err = c.client.Do("CreateStorageLocation", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "CreateStorageLocation", "POST", "/", nil, resp)
 	return
 }
 
@@ -92,23 +185,44 @@ func (c *ElasticBeanstalk) CreateStorageLocation() (resp *CreateStorageLocationR
 // associated versions and configurations. The application versions will
 // not be deleted from your Amazon S3 bucket.
 func (c *ElasticBeanstalk) DeleteApplication(req *DeleteApplicationMessage) (err error) {
+	return c.DeleteApplicationWithContext(context.Background(), req)
+}
+
+// DeleteApplicationWithContext is like DeleteApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DeleteApplicationWithContext(ctx context.Context, req *DeleteApplicationMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteApplication", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteApplication", "POST", "/", req, nil)
 	return
 }
 
 // DeleteApplicationVersion deletes the specified version from the
 // specified application.
 func (c *ElasticBeanstalk) DeleteApplicationVersion(req *DeleteApplicationVersionMessage) (err error) {
+	return c.DeleteApplicationVersionWithContext(context.Background(), req)
+}
+
+// DeleteApplicationVersionWithContext is like DeleteApplicationVersion, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DeleteApplicationVersionWithContext(ctx context.Context, req *DeleteApplicationVersionMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteApplicationVersion", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteApplicationVersion", "POST", "/", req, nil)
 	return
 }
 
 // DeleteConfigurationTemplate is undocumented.
 func (c *ElasticBeanstalk) DeleteConfigurationTemplate(req *DeleteConfigurationTemplateMessage) (err error) {
+	return c.DeleteConfigurationTemplateWithContext(context.Background(), req)
+}
+
+// DeleteConfigurationTemplateWithContext is like DeleteConfigurationTemplate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DeleteConfigurationTemplateWithContext(ctx context.Context, req *DeleteConfigurationTemplateMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteConfigurationTemplate", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteConfigurationTemplate", "POST", "/", req, nil)
 	return
 }
 
@@ -121,23 +235,44 @@ func (c *ElasticBeanstalk) DeleteConfigurationTemplate(req *DeleteConfigurationT
 // deployment is in process or has failed. The draft configuration remains
 // in existence until it is deleted with this action.
 func (c *ElasticBeanstalk) DeleteEnvironmentConfiguration(req *DeleteEnvironmentConfigurationMessage) (err error) {
+	return c.DeleteEnvironmentConfigurationWithContext(context.Background(), req)
+}
+
+// DeleteEnvironmentConfigurationWithContext is like DeleteEnvironmentConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DeleteEnvironmentConfigurationWithContext(ctx context.Context, req *DeleteEnvironmentConfigurationMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteEnvironmentConfiguration", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteEnvironmentConfiguration", "POST", "/", req, nil)
 	return
 }
 
 // DescribeApplicationVersions returns descriptions for existing
 // application versions.
 func (c *ElasticBeanstalk) DescribeApplicationVersions(req *DescribeApplicationVersionsMessage) (resp *DescribeApplicationVersionsResult, err error) {
+	return c.DescribeApplicationVersionsWithContext(context.Background(), req)
+}
+
+// DescribeApplicationVersionsWithContext is like DescribeApplicationVersions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DescribeApplicationVersionsWithContext(ctx context.Context, req *DescribeApplicationVersionsMessage) (resp *DescribeApplicationVersionsResult, err error) {
 	resp = &DescribeApplicationVersionsResult{}
-	err = c.client.Do("DescribeApplicationVersions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeApplicationVersions", "POST", "/", req, resp)
 	return
 }
 
 // DescribeApplications is undocumented.
 func (c *ElasticBeanstalk) DescribeApplications(req *DescribeApplicationsMessage) (resp *DescribeApplicationsResult, err error) {
+	return c.DescribeApplicationsWithContext(context.Background(), req)
+}
+
+// DescribeApplicationsWithContext is like DescribeApplications, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DescribeApplicationsWithContext(ctx context.Context, req *DescribeApplicationsMessage) (resp *DescribeApplicationsResult, err error) {
 	resp = &DescribeApplicationsResult{}
-	err = c.client.Do("DescribeApplications", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeApplications", "POST", "/", req, resp)
 	return
 }
 
@@ -147,8 +282,15 @@ func (c *ElasticBeanstalk) DescribeApplications(req *DescribeApplicationsMessage
 // the options, their default values, and an indication of the required
 // action on a running environment if an option value is changed.
 func (c *ElasticBeanstalk) DescribeConfigurationOptions(req *DescribeConfigurationOptionsMessage) (resp *DescribeConfigurationOptionsResult, err error) {
+	return c.DescribeConfigurationOptionsWithContext(context.Background(), req)
+}
+
+// DescribeConfigurationOptionsWithContext is like DescribeConfigurationOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DescribeConfigurationOptionsWithContext(ctx context.Context, req *DescribeConfigurationOptionsMessage) (resp *DescribeConfigurationOptionsResult, err error) {
 	resp = &DescribeConfigurationOptionsResult{}
-	err = c.client.Do("DescribeConfigurationOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeConfigurationOptions", "POST", "/", req, resp)
 	return
 }
 
@@ -161,38 +303,73 @@ func (c *ElasticBeanstalk) DescribeConfigurationOptions(req *DescribeConfigurati
 // draft configuration of an environment that is either in the process of
 // deployment or that failed to deploy.
 func (c *ElasticBeanstalk) DescribeConfigurationSettings(req *DescribeConfigurationSettingsMessage) (resp *DescribeConfigurationSettingsResult, err error) {
+	return c.DescribeConfigurationSettingsWithContext(context.Background(), req)
+}
+
+// DescribeConfigurationSettingsWithContext is like DescribeConfigurationSettings, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DescribeConfigurationSettingsWithContext(ctx context.Context, req *DescribeConfigurationSettingsMessage) (resp *DescribeConfigurationSettingsResult, err error) {
 	resp = &DescribeConfigurationSettingsResult{}
-	err = c.client.Do("DescribeConfigurationSettings", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeConfigurationSettings", "POST", "/", req, resp)
 	return
 }
 
 // DescribeEnvironmentResources is undocumented.
 func (c *ElasticBeanstalk) DescribeEnvironmentResources(req *DescribeEnvironmentResourcesMessage) (resp *DescribeEnvironmentResourcesResult, err error) {
+	return c.DescribeEnvironmentResourcesWithContext(context.Background(), req)
+}
+
+// DescribeEnvironmentResourcesWithContext is like DescribeEnvironmentResources, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DescribeEnvironmentResourcesWithContext(ctx context.Context, req *DescribeEnvironmentResourcesMessage) (resp *DescribeEnvironmentResourcesResult, err error) {
 	resp = &DescribeEnvironmentResourcesResult{}
-	err = c.client.Do("DescribeEnvironmentResources", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEnvironmentResources", "POST", "/", req, resp)
 	return
 }
 
 // DescribeEnvironments is undocumented.
 func (c *ElasticBeanstalk) DescribeEnvironments(req *DescribeEnvironmentsMessage) (resp *DescribeEnvironmentsResult, err error) {
+	return c.DescribeEnvironmentsWithContext(context.Background(), req)
+}
+
+// DescribeEnvironmentsWithContext is like DescribeEnvironments, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DescribeEnvironmentsWithContext(ctx context.Context, req *DescribeEnvironmentsMessage) (resp *DescribeEnvironmentsResult, err error) {
 	resp = &DescribeEnvironmentsResult{}
-	err = c.client.Do("DescribeEnvironments", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEnvironments", "POST", "/", req, resp)
 	return
 }
 
 // DescribeEvents returns list of event descriptions matching criteria up
 // to the last 6 weeks.
 func (c *ElasticBeanstalk) DescribeEvents(req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
+	return c.DescribeEventsWithContext(context.Background(), req)
+}
+
+// DescribeEventsWithContext is like DescribeEvents, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) DescribeEventsWithContext(ctx context.Context, req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
 	resp = &DescribeEventsResult{}
-	err = c.client.Do("DescribeEvents", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEvents", "POST", "/", req, resp)
 	return
 }
 
 // ListAvailableSolutionStacks returns a list of the available solution
 // stack names.
 func (c *ElasticBeanstalk) ListAvailableSolutionStacks() (resp *ListAvailableSolutionStacksResult, err error) {
+	return c.ListAvailableSolutionStacksWithContext(context.Background())
+}
+
+// ListAvailableSolutionStacksWithContext is like ListAvailableSolutionStacks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) ListAvailableSolutionStacksWithContext(ctx context.Context) (resp *ListAvailableSolutionStacksResult, err error) {
 	resp = &ListAvailableSolutionStacksResult{}
-	err = c.client.Do("ListAvailableSolutionStacks", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "ListAvailableSolutionStacks", "POST", "/", nil, resp)
 	return
 }
 
@@ -200,8 +377,15 @@ func (c *ElasticBeanstalk) ListAvailableSolutionStacks() (resp *ListAvailableSol
 // example: the Auto Scaling group, load balancer, etc.) for a specified
 // environment and forces a restart.
 func (c *ElasticBeanstalk) RebuildEnvironment(req *RebuildEnvironmentMessage) (err error) {
+	return c.RebuildEnvironmentWithContext(context.Background(), req)
+}
+
+// RebuildEnvironmentWithContext is like RebuildEnvironment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) RebuildEnvironmentWithContext(ctx context.Context, req *RebuildEnvironmentMessage) (err error) {
 	// NRE
-	err = c.client.Do("RebuildEnvironment", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RebuildEnvironment", "POST", "/", req, nil)
 	return
 }
 
@@ -211,62 +395,118 @@ func (c *ElasticBeanstalk) RebuildEnvironment(req *RebuildEnvironmentMessage) (e
 // Amazon EC2 instance in your environment. Use RetrieveEnvironmentInfo to
 // access the compiled information.
 func (c *ElasticBeanstalk) RequestEnvironmentInfo(req *RequestEnvironmentInfoMessage) (err error) {
+	return c.RequestEnvironmentInfoWithContext(context.Background(), req)
+}
+
+// RequestEnvironmentInfoWithContext is like RequestEnvironmentInfo, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) RequestEnvironmentInfoWithContext(ctx context.Context, req *RequestEnvironmentInfoMessage) (err error) {
 	// NRE
-	err = c.client.Do("RequestEnvironmentInfo", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RequestEnvironmentInfo", "POST", "/", req, nil)
 	return
 }
 
 // RestartAppServer causes the environment to restart the application
 // container server running on each Amazon EC2 instance.
 func (c *ElasticBeanstalk) RestartAppServer(req *RestartAppServerMessage) (err error) {
+	return c.RestartAppServerWithContext(context.Background(), req)
+}
+
+// RestartAppServerWithContext is like RestartAppServer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) RestartAppServerWithContext(ctx context.Context, req *RestartAppServerMessage) (err error) {
 	// NRE
-	err = c.client.Do("RestartAppServer", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RestartAppServer", "POST", "/", req, nil)
 	return
 }
 
 // RetrieveEnvironmentInfo retrieves the compiled information from a
 // RequestEnvironmentInfo request.
 func (c *ElasticBeanstalk) RetrieveEnvironmentInfo(req *RetrieveEnvironmentInfoMessage) (resp *RetrieveEnvironmentInfoResult, err error) {
+	return c.RetrieveEnvironmentInfoWithContext(context.Background(), req)
+}
+
+// RetrieveEnvironmentInfoWithContext is like RetrieveEnvironmentInfo, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) RetrieveEnvironmentInfoWithContext(ctx context.Context, req *RetrieveEnvironmentInfoMessage) (resp *RetrieveEnvironmentInfoResult, err error) {
 	resp = &RetrieveEnvironmentInfoResult{}
-	err = c.client.Do("RetrieveEnvironmentInfo", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RetrieveEnvironmentInfo", "POST", "/", req, resp)
 	return
 }
 
 // SwapEnvironmentCNAMEs is undocumented.
 func (c *ElasticBeanstalk) SwapEnvironmentCNAMEs(req *SwapEnvironmentCNAMEsMessage) (err error) {
+	return c.SwapEnvironmentCNAMEsWithContext(context.Background(), req)
+}
+
+// SwapEnvironmentCNAMEsWithContext is like SwapEnvironmentCNAMEs, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) SwapEnvironmentCNAMEsWithContext(ctx context.Context, req *SwapEnvironmentCNAMEsMessage) (err error) {
 	// NRE
-	err = c.client.Do("SwapEnvironmentCNAMEs", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SwapEnvironmentCNAMEs", "POST", "/", req, nil)
 	return
 }
 
 // TerminateEnvironment is undocumented.
 func (c *ElasticBeanstalk) TerminateEnvironment(req *TerminateEnvironmentMessage) (resp *TerminateEnvironmentResult, err error) {
+	return c.TerminateEnvironmentWithContext(context.Background(), req)
+}
+
+// TerminateEnvironmentWithContext is like TerminateEnvironment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) TerminateEnvironmentWithContext(ctx context.Context, req *TerminateEnvironmentMessage) (resp *TerminateEnvironmentResult, err error) {
 	resp = &TerminateEnvironmentResult{}
-	err = c.client.Do("TerminateEnvironment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "TerminateEnvironment", "POST", "/", req, resp)
 	return
 }
 
 // UpdateApplication updates the specified application to have the
 // specified properties.
 func (c *ElasticBeanstalk) UpdateApplication(req *UpdateApplicationMessage) (resp *UpdateApplicationResult, err error) {
+	return c.UpdateApplicationWithContext(context.Background(), req)
+}
+
+// UpdateApplicationWithContext is like UpdateApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) UpdateApplicationWithContext(ctx context.Context, req *UpdateApplicationMessage) (resp *UpdateApplicationResult, err error) {
 	resp = &UpdateApplicationResult{}
-	err = c.client.Do("UpdateApplication", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateApplication", "POST", "/", req, resp)
 	return
 }
 
 // UpdateApplicationVersion updates the specified application version to
 // have the specified properties.
 func (c *ElasticBeanstalk) UpdateApplicationVersion(req *UpdateApplicationVersionMessage) (resp *UpdateApplicationVersionResult, err error) {
+	return c.UpdateApplicationVersionWithContext(context.Background(), req)
+}
+
+// UpdateApplicationVersionWithContext is like UpdateApplicationVersion, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) UpdateApplicationVersionWithContext(ctx context.Context, req *UpdateApplicationVersionMessage) (resp *UpdateApplicationVersionResult, err error) {
 	resp = &UpdateApplicationVersionResult{}
-	err = c.client.Do("UpdateApplicationVersion", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateApplicationVersion", "POST", "/", req, resp)
 	return
 }
 
 // UpdateConfigurationTemplate updates the specified configuration template
 // to have the specified properties or configuration option values.
 func (c *ElasticBeanstalk) UpdateConfigurationTemplate(req *UpdateConfigurationTemplateMessage) (resp *UpdateConfigurationTemplateResult, err error) {
+	return c.UpdateConfigurationTemplateWithContext(context.Background(), req)
+}
+
+// UpdateConfigurationTemplateWithContext is like UpdateConfigurationTemplate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) UpdateConfigurationTemplateWithContext(ctx context.Context, req *UpdateConfigurationTemplateMessage) (resp *UpdateConfigurationTemplateResult, err error) {
 	resp = &UpdateConfigurationTemplateResult{}
-	err = c.client.Do("UpdateConfigurationTemplate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateConfigurationTemplate", "POST", "/", req, resp)
 	return
 }
 
@@ -280,8 +520,15 @@ func (c *ElasticBeanstalk) UpdateConfigurationTemplate(req *UpdateConfigurationT
 // is created and DescribeConfigurationSettings for this environment
 // returns two setting descriptions with different DeploymentStatus values.
 func (c *ElasticBeanstalk) UpdateEnvironment(req *UpdateEnvironmentMessage) (resp *UpdateEnvironmentResult, err error) {
+	return c.UpdateEnvironmentWithContext(context.Background(), req)
+}
+
+// UpdateEnvironmentWithContext is like UpdateEnvironment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) UpdateEnvironmentWithContext(ctx context.Context, req *UpdateEnvironmentMessage) (resp *UpdateEnvironmentResult, err error) {
 	resp = &UpdateEnvironmentResult{}
-	err = c.client.Do("UpdateEnvironment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateEnvironment", "POST", "/", req, resp)
 	return
 }
 
@@ -291,19 +538,26 @@ func (c *ElasticBeanstalk) UpdateEnvironment(req *UpdateEnvironmentMessage) (res
 // indicating any errors or warnings associated with the selection of
 // option values.
 func (c *ElasticBeanstalk) ValidateConfigurationSettings(req *ValidateConfigurationSettingsMessage) (resp *ValidateConfigurationSettingsResult, err error) {
+	return c.ValidateConfigurationSettingsWithContext(context.Background(), req)
+}
+
+// ValidateConfigurationSettingsWithContext is like ValidateConfigurationSettings, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticBeanstalk) ValidateConfigurationSettingsWithContext(ctx context.Context, req *ValidateConfigurationSettingsMessage) (resp *ValidateConfigurationSettingsResult, err error) {
 	resp = &ValidateConfigurationSettingsResult{}
-	err = c.client.Do("ValidateConfigurationSettings", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ValidateConfigurationSettings", "POST", "/", req, resp)
 	return
 }
 
 // ApplicationDescription is undocumented.
 type ApplicationDescription struct {
 	ApplicationName        aws.StringValue `query:"ApplicationName" xml:"ApplicationName"`
-	ConfigurationTemplates []string        `query:"ConfigurationTemplates.member" xml:"ConfigurationTemplates>member"`
+	ConfigurationTemplates []string        `query:"ConfigurationTemplates,list:member" xml:"ConfigurationTemplates>member"`
 	DateCreated            time.Time       `query:"DateCreated" xml:"DateCreated"`
 	DateUpdated            time.Time       `query:"DateUpdated" xml:"DateUpdated"`
 	Description            aws.StringValue `query:"Description" xml:"Description"`
-	Versions               []string        `query:"Versions.member" xml:"Versions>member"`
+	Versions               []string        `query:"Versions,list:member" xml:"Versions>member"`
 }
 
 // ApplicationDescriptionMessage is undocumented.
@@ -313,7 +567,7 @@ type ApplicationDescriptionMessage struct {
 
 // ApplicationDescriptionsMessage is undocumented.
 type ApplicationDescriptionsMessage struct {
-	Applications []ApplicationDescription `query:"Applications.member" xml:"DescribeApplicationsResult>Applications>member"`
+	Applications []ApplicationDescription `query:"Applications,list:member" xml:"DescribeApplicationsResult>Applications>member"`
 }
 
 // ApplicationVersionDescription is undocumented.
@@ -333,7 +587,7 @@ type ApplicationVersionDescriptionMessage struct {
 
 // ApplicationVersionDescriptionsMessage is undocumented.
 type ApplicationVersionDescriptionsMessage struct {
-	ApplicationVersions []ApplicationVersionDescription `query:"ApplicationVersions.member" xml:"DescribeApplicationVersionsResult>ApplicationVersions>member"`
+	ApplicationVersions []ApplicationVersionDescription `query:"ApplicationVersions,list:member" xml:"DescribeApplicationVersionsResult>ApplicationVersions>member"`
 }
 
 // AutoScalingGroup is undocumented.
@@ -346,6 +600,17 @@ type CheckDNSAvailabilityMessage struct {
 	CNAMEPrefix aws.StringValue `query:"CNAMEPrefix" xml:"CNAMEPrefix"`
 }
 
+// MarshalQuery adds the parameters of the CheckDNSAvailabilityMessage to the form, prefixing their names.
+func (v *CheckDNSAvailabilityMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CNAMEPrefix != nil {
+		form.Set(prefix+"CNAMEPrefix", *v.CNAMEPrefix)
+	}
+	return nil
+}
+
 // CheckDNSAvailabilityResultMessage is undocumented.
 type CheckDNSAvailabilityResultMessage struct {
 	Available           aws.BooleanValue `query:"Available" xml:"CheckDNSAvailabilityResult>Available"`
@@ -370,7 +635,7 @@ type ConfigurationOptionDescription struct {
 	Namespace      aws.StringValue         `query:"Namespace" xml:"Namespace"`
 	Regex          *OptionRestrictionRegex `query:"Regex" xml:"Regex"`
 	UserDefined    aws.BooleanValue        `query:"UserDefined" xml:"UserDefined"`
-	ValueOptions   []string                `query:"ValueOptions.member" xml:"ValueOptions>member"`
+	ValueOptions   []string                `query:"ValueOptions,list:member" xml:"ValueOptions>member"`
 	ValueType      aws.StringValue         `query:"ValueType" xml:"ValueType"`
 }
 
@@ -381,6 +646,23 @@ type ConfigurationOptionSetting struct {
 	Value      aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the ConfigurationOptionSetting to the form, prefixing their names.
+func (v *ConfigurationOptionSetting) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Namespace != nil {
+		form.Set(prefix+"Namespace", *v.Namespace)
+	}
+	if v.OptionName != nil {
+		form.Set(prefix+"OptionName", *v.OptionName)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // Possible values for ElasticBeanstalk.
 const (
 	ConfigurationOptionValueTypeList   = "List"
@@ -389,7 +671,7 @@ const (
 
 // ConfigurationOptionsDescription is undocumented.
 type ConfigurationOptionsDescription struct {
-	Options           []ConfigurationOptionDescription `query:"Options.member" xml:"DescribeConfigurationOptionsResult>Options>member"`
+	Options           []ConfigurationOptionDescription `query:"Options,list:member" xml:"DescribeConfigurationOptionsResult>Options>member"`
 	SolutionStackName aws.StringValue                  `query:"SolutionStackName" xml:"DescribeConfigurationOptionsResult>SolutionStackName"`
 }
 
@@ -401,19 +683,19 @@ type ConfigurationSettingsDescription struct {
 	DeploymentStatus  aws.StringValue              `query:"DeploymentStatus" xml:"DeploymentStatus"`
 	Description       aws.StringValue              `query:"Description" xml:"Description"`
 	EnvironmentName   aws.StringValue              `query:"EnvironmentName" xml:"EnvironmentName"`
-	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"OptionSettings>member"`
+	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"OptionSettings>member"`
 	SolutionStackName aws.StringValue              `query:"SolutionStackName" xml:"SolutionStackName"`
 	TemplateName      aws.StringValue              `query:"TemplateName" xml:"TemplateName"`
 }
 
 // ConfigurationSettingsDescriptions is undocumented.
 type ConfigurationSettingsDescriptions struct {
-	ConfigurationSettings []ConfigurationSettingsDescription `query:"ConfigurationSettings.member" xml:"DescribeConfigurationSettingsResult>ConfigurationSettings>member"`
+	ConfigurationSettings []ConfigurationSettingsDescription `query:"ConfigurationSettings,list:member" xml:"DescribeConfigurationSettingsResult>ConfigurationSettings>member"`
 }
 
 // ConfigurationSettingsValidationMessages is undocumented.
 type ConfigurationSettingsValidationMessages struct {
-	Messages []ValidationMessage `query:"Messages.member" xml:"ValidateConfigurationSettingsResult>Messages>member"`
+	Messages []ValidationMessage `query:"Messages,list:member" xml:"ValidateConfigurationSettingsResult>Messages>member"`
 }
 
 // CreateApplicationMessage is undocumented.
@@ -422,6 +704,20 @@ type CreateApplicationMessage struct {
 	Description     aws.StringValue `query:"Description" xml:"Description"`
 }
 
+// MarshalQuery adds the parameters of the CreateApplicationMessage to the form, prefixing their names.
+func (v *CreateApplicationMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	return nil
+}
+
 // CreateApplicationVersionMessage is undocumented.
 type CreateApplicationVersionMessage struct {
 	ApplicationName       aws.StringValue  `query:"ApplicationName" xml:"ApplicationName"`
@@ -431,32 +727,163 @@ type CreateApplicationVersionMessage struct {
 	VersionLabel          aws.StringValue  `query:"VersionLabel" xml:"VersionLabel"`
 }
 
+// MarshalQuery adds the parameters of the CreateApplicationVersionMessage to the form, prefixing their names.
+func (v *CreateApplicationVersionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.AutoCreateApplication != nil {
+		form.Set(prefix+"AutoCreateApplication", strconv.FormatBool(*v.AutoCreateApplication))
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.SourceBundle != nil {
+		if err := v.SourceBundle.MarshalQuery(form, prefix+"SourceBundle."); err != nil {
+			return err
+		}
+	}
+	if v.VersionLabel != nil {
+		form.Set(prefix+"VersionLabel", *v.VersionLabel)
+	}
+	return nil
+}
+
 // CreateConfigurationTemplateMessage is undocumented.
 type CreateConfigurationTemplateMessage struct {
 	ApplicationName     aws.StringValue              `query:"ApplicationName" xml:"ApplicationName"`
 	Description         aws.StringValue              `query:"Description" xml:"Description"`
 	EnvironmentID       aws.StringValue              `query:"EnvironmentId" xml:"EnvironmentId"`
-	OptionSettings      []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"OptionSettings>member"`
+	OptionSettings      []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"OptionSettings>member"`
 	SolutionStackName   aws.StringValue              `query:"SolutionStackName" xml:"SolutionStackName"`
 	SourceConfiguration *SourceConfiguration         `query:"SourceConfiguration" xml:"SourceConfiguration"`
 	TemplateName        aws.StringValue              `query:"TemplateName" xml:"TemplateName"`
 }
 
+// MarshalQuery adds the parameters of the CreateConfigurationTemplateMessage to the form, prefixing their names.
+func (v *CreateConfigurationTemplateMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.OptionSettings != nil {
+		if len(v.OptionSettings) == 0 {
+			form.Set(prefix+"OptionSettings", "")
+		}
+		for i1 := range v.OptionSettings {
+			name1 := prefix + "OptionSettings.member." + strconv.Itoa(i1+1)
+			if err := v.OptionSettings[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SolutionStackName != nil {
+		form.Set(prefix+"SolutionStackName", *v.SolutionStackName)
+	}
+	if v.SourceConfiguration != nil {
+		if err := v.SourceConfiguration.MarshalQuery(form, prefix+"SourceConfiguration."); err != nil {
+			return err
+		}
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	return nil
+}
+
 // CreateEnvironmentMessage is undocumented.
 type CreateEnvironmentMessage struct {
 	ApplicationName   aws.StringValue              `query:"ApplicationName" xml:"ApplicationName"`
 	CNAMEPrefix       aws.StringValue              `query:"CNAMEPrefix" xml:"CNAMEPrefix"`
 	Description       aws.StringValue              `query:"Description" xml:"Description"`
 	EnvironmentName   aws.StringValue              `query:"EnvironmentName" xml:"EnvironmentName"`
-	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"OptionSettings>member"`
-	OptionsToRemove   []OptionSpecification        `query:"OptionsToRemove.member" xml:"OptionsToRemove>member"`
+	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"OptionSettings>member"`
+	OptionsToRemove   []OptionSpecification        `query:"OptionsToRemove,list:member" xml:"OptionsToRemove>member"`
 	SolutionStackName aws.StringValue              `query:"SolutionStackName" xml:"SolutionStackName"`
-	Tags              []Tag                        `query:"Tags.member" xml:"Tags>member"`
+	Tags              []Tag                        `query:"Tags,list:member" xml:"Tags>member"`
 	TemplateName      aws.StringValue              `query:"TemplateName" xml:"TemplateName"`
 	Tier              *EnvironmentTier             `query:"Tier" xml:"Tier"`
 	VersionLabel      aws.StringValue              `query:"VersionLabel" xml:"VersionLabel"`
 }
 
+// MarshalQuery adds the parameters of the CreateEnvironmentMessage to the form, prefixing their names.
+func (v *CreateEnvironmentMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.CNAMEPrefix != nil {
+		form.Set(prefix+"CNAMEPrefix", *v.CNAMEPrefix)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.OptionSettings != nil {
+		if len(v.OptionSettings) == 0 {
+			form.Set(prefix+"OptionSettings", "")
+		}
+		for i1 := range v.OptionSettings {
+			name1 := prefix + "OptionSettings.member." + strconv.Itoa(i1+1)
+			if err := v.OptionSettings[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.OptionsToRemove != nil {
+		if len(v.OptionsToRemove) == 0 {
+			form.Set(prefix+"OptionsToRemove", "")
+		}
+		for i1 := range v.OptionsToRemove {
+			name1 := prefix + "OptionsToRemove.member." + strconv.Itoa(i1+1)
+			if err := v.OptionsToRemove[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SolutionStackName != nil {
+		form.Set(prefix+"SolutionStackName", *v.SolutionStackName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	if v.Tier != nil {
+		if err := v.Tier.MarshalQuery(form, prefix+"Tier."); err != nil {
+			return err
+		}
+	}
+	if v.VersionLabel != nil {
+		form.Set(prefix+"VersionLabel", *v.VersionLabel)
+	}
+	return nil
+}
+
 // CreateStorageLocationResultMessage is undocumented.
 type CreateStorageLocationResultMessage struct {
 	S3Bucket aws.StringValue `query:"S3Bucket" xml:"CreateStorageLocationResult>S3Bucket"`
@@ -468,6 +895,20 @@ type DeleteApplicationMessage struct {
 	TerminateEnvByForce aws.BooleanValue `query:"TerminateEnvByForce" xml:"TerminateEnvByForce"`
 }
 
+// MarshalQuery adds the parameters of the DeleteApplicationMessage to the form, prefixing their names.
+func (v *DeleteApplicationMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.TerminateEnvByForce != nil {
+		form.Set(prefix+"TerminateEnvByForce", strconv.FormatBool(*v.TerminateEnvByForce))
+	}
+	return nil
+}
+
 // DeleteApplicationVersionMessage is undocumented.
 type DeleteApplicationVersionMessage struct {
 	ApplicationName    aws.StringValue  `query:"ApplicationName" xml:"ApplicationName"`
@@ -475,38 +916,151 @@ type DeleteApplicationVersionMessage struct {
 	VersionLabel       aws.StringValue  `query:"VersionLabel" xml:"VersionLabel"`
 }
 
+// MarshalQuery adds the parameters of the DeleteApplicationVersionMessage to the form, prefixing their names.
+func (v *DeleteApplicationVersionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.DeleteSourceBundle != nil {
+		form.Set(prefix+"DeleteSourceBundle", strconv.FormatBool(*v.DeleteSourceBundle))
+	}
+	if v.VersionLabel != nil {
+		form.Set(prefix+"VersionLabel", *v.VersionLabel)
+	}
+	return nil
+}
+
 // DeleteConfigurationTemplateMessage is undocumented.
 type DeleteConfigurationTemplateMessage struct {
 	ApplicationName aws.StringValue `query:"ApplicationName" xml:"ApplicationName"`
 	TemplateName    aws.StringValue `query:"TemplateName" xml:"TemplateName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteConfigurationTemplateMessage to the form, prefixing their names.
+func (v *DeleteConfigurationTemplateMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	return nil
+}
+
 // DeleteEnvironmentConfigurationMessage is undocumented.
 type DeleteEnvironmentConfigurationMessage struct {
 	ApplicationName aws.StringValue `query:"ApplicationName" xml:"ApplicationName"`
 	EnvironmentName aws.StringValue `query:"EnvironmentName" xml:"EnvironmentName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteEnvironmentConfigurationMessage to the form, prefixing their names.
+func (v *DeleteEnvironmentConfigurationMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	return nil
+}
+
 // DescribeApplicationVersionsMessage is undocumented.
 type DescribeApplicationVersionsMessage struct {
 	ApplicationName aws.StringValue `query:"ApplicationName" xml:"ApplicationName"`
-	VersionLabels   []string        `query:"VersionLabels.member" xml:"VersionLabels>member"`
+	VersionLabels   []string        `query:"VersionLabels,list:member" xml:"VersionLabels>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeApplicationVersionsMessage to the form, prefixing their names.
+func (v *DescribeApplicationVersionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.VersionLabels != nil {
+		if len(v.VersionLabels) == 0 {
+			form.Set(prefix+"VersionLabels", "")
+		}
+		for i1 := range v.VersionLabels {
+			name1 := prefix + "VersionLabels.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VersionLabels[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeApplicationsMessage is undocumented.
 type DescribeApplicationsMessage struct {
-	ApplicationNames []string `query:"ApplicationNames.member" xml:"ApplicationNames>member"`
+	ApplicationNames []string `query:"ApplicationNames,list:member" xml:"ApplicationNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeApplicationsMessage to the form, prefixing their names.
+func (v *DescribeApplicationsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationNames != nil {
+		if len(v.ApplicationNames) == 0 {
+			form.Set(prefix+"ApplicationNames", "")
+		}
+		for i1 := range v.ApplicationNames {
+			name1 := prefix + "ApplicationNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ApplicationNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeConfigurationOptionsMessage is undocumented.
 type DescribeConfigurationOptionsMessage struct {
 	ApplicationName   aws.StringValue       `query:"ApplicationName" xml:"ApplicationName"`
 	EnvironmentName   aws.StringValue       `query:"EnvironmentName" xml:"EnvironmentName"`
-	Options           []OptionSpecification `query:"Options.member" xml:"Options>member"`
+	Options           []OptionSpecification `query:"Options,list:member" xml:"Options>member"`
 	SolutionStackName aws.StringValue       `query:"SolutionStackName" xml:"SolutionStackName"`
 	TemplateName      aws.StringValue       `query:"TemplateName" xml:"TemplateName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeConfigurationOptionsMessage to the form, prefixing their names.
+func (v *DescribeConfigurationOptionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.Options != nil {
+		if len(v.Options) == 0 {
+			form.Set(prefix+"Options", "")
+		}
+		for i1 := range v.Options {
+			name1 := prefix + "Options.member." + strconv.Itoa(i1+1)
+			if err := v.Options[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SolutionStackName != nil {
+		form.Set(prefix+"SolutionStackName", *v.SolutionStackName)
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	return nil
+}
+
 // DescribeConfigurationSettingsMessage is undocumented.
 type DescribeConfigurationSettingsMessage struct {
 	ApplicationName aws.StringValue `query:"ApplicationName" xml:"ApplicationName"`
@@ -514,22 +1068,91 @@ type DescribeConfigurationSettingsMessage struct {
 	TemplateName    aws.StringValue `query:"TemplateName" xml:"TemplateName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeConfigurationSettingsMessage to the form, prefixing their names.
+func (v *DescribeConfigurationSettingsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	return nil
+}
+
 // DescribeEnvironmentResourcesMessage is undocumented.
 type DescribeEnvironmentResourcesMessage struct {
 	EnvironmentID   aws.StringValue `query:"EnvironmentId" xml:"EnvironmentId"`
 	EnvironmentName aws.StringValue `query:"EnvironmentName" xml:"EnvironmentName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEnvironmentResourcesMessage to the form, prefixing their names.
+func (v *DescribeEnvironmentResourcesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	return nil
+}
+
 // DescribeEnvironmentsMessage is undocumented.
 type DescribeEnvironmentsMessage struct {
 	ApplicationName       aws.StringValue  `query:"ApplicationName" xml:"ApplicationName"`
-	EnvironmentIDs        []string         `query:"EnvironmentIds.member" xml:"EnvironmentIds>member"`
-	EnvironmentNames      []string         `query:"EnvironmentNames.member" xml:"EnvironmentNames>member"`
+	EnvironmentIDs        []string         `query:"EnvironmentIds,list:member" xml:"EnvironmentIds>member"`
+	EnvironmentNames      []string         `query:"EnvironmentNames,list:member" xml:"EnvironmentNames>member"`
 	IncludeDeleted        aws.BooleanValue `query:"IncludeDeleted" xml:"IncludeDeleted"`
 	IncludedDeletedBackTo time.Time        `query:"IncludedDeletedBackTo" xml:"IncludedDeletedBackTo"`
 	VersionLabel          aws.StringValue  `query:"VersionLabel" xml:"VersionLabel"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEnvironmentsMessage to the form, prefixing their names.
+func (v *DescribeEnvironmentsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.EnvironmentIDs != nil {
+		if len(v.EnvironmentIDs) == 0 {
+			form.Set(prefix+"EnvironmentIds", "")
+		}
+		for i1 := range v.EnvironmentIDs {
+			name1 := prefix + "EnvironmentIds.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.EnvironmentIDs[i1])
+		}
+	}
+	if v.EnvironmentNames != nil {
+		if len(v.EnvironmentNames) == 0 {
+			form.Set(prefix+"EnvironmentNames", "")
+		}
+		for i1 := range v.EnvironmentNames {
+			name1 := prefix + "EnvironmentNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.EnvironmentNames[i1])
+		}
+	}
+	if v.IncludeDeleted != nil {
+		form.Set(prefix+"IncludeDeleted", strconv.FormatBool(*v.IncludeDeleted))
+	}
+	if !v.IncludedDeletedBackTo.IsZero() {
+		form.Set(prefix+"IncludedDeletedBackTo", v.IncludedDeletedBackTo.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.VersionLabel != nil {
+		form.Set(prefix+"VersionLabel", *v.VersionLabel)
+	}
+	return nil
+}
+
 // DescribeEventsMessage is undocumented.
 type DescribeEventsMessage struct {
 	ApplicationName aws.StringValue  `query:"ApplicationName" xml:"ApplicationName"`
@@ -545,6 +1168,47 @@ type DescribeEventsMessage struct {
 	VersionLabel    aws.StringValue  `query:"VersionLabel" xml:"VersionLabel"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventsMessage to the form, prefixing their names.
+func (v *DescribeEventsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.RequestID != nil {
+		form.Set(prefix+"RequestId", *v.RequestID)
+	}
+	if v.Severity != nil {
+		form.Set(prefix+"Severity", *v.Severity)
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	if v.VersionLabel != nil {
+		form.Set(prefix+"VersionLabel", *v.VersionLabel)
+	}
+	return nil
+}
+
 // EnvironmentDescription is undocumented.
 type EnvironmentDescription struct {
 	ApplicationName   aws.StringValue                  `query:"ApplicationName" xml:"ApplicationName"`
@@ -566,7 +1230,7 @@ type EnvironmentDescription struct {
 
 // EnvironmentDescriptionsMessage is undocumented.
 type EnvironmentDescriptionsMessage struct {
-	Environments []EnvironmentDescription `query:"Environments.member" xml:"DescribeEnvironmentsResult>Environments>member"`
+	Environments []EnvironmentDescription `query:"Environments,list:member" xml:"DescribeEnvironmentsResult>Environments>member"`
 }
 
 // Possible values for ElasticBeanstalk.
@@ -592,13 +1256,13 @@ const (
 
 // EnvironmentResourceDescription is undocumented.
 type EnvironmentResourceDescription struct {
-	AutoScalingGroups    []AutoScalingGroup    `query:"AutoScalingGroups.member" xml:"AutoScalingGroups>member"`
+	AutoScalingGroups    []AutoScalingGroup    `query:"AutoScalingGroups,list:member" xml:"AutoScalingGroups>member"`
 	EnvironmentName      aws.StringValue       `query:"EnvironmentName" xml:"EnvironmentName"`
-	Instances            []Instance            `query:"Instances.member" xml:"Instances>member"`
-	LaunchConfigurations []LaunchConfiguration `query:"LaunchConfigurations.member" xml:"LaunchConfigurations>member"`
-	LoadBalancers        []LoadBalancer        `query:"LoadBalancers.member" xml:"LoadBalancers>member"`
-	Queues               []Queue               `query:"Queues.member" xml:"Queues>member"`
-	Triggers             []Trigger             `query:"Triggers.member" xml:"Triggers>member"`
+	Instances            []Instance            `query:"Instances,list:member" xml:"Instances>member"`
+	LaunchConfigurations []LaunchConfiguration `query:"LaunchConfigurations,list:member" xml:"LaunchConfigurations>member"`
+	LoadBalancers        []LoadBalancer        `query:"LoadBalancers,list:member" xml:"LoadBalancers>member"`
+	Queues               []Queue               `query:"Queues,list:member" xml:"Queues>member"`
+	Triggers             []Trigger             `query:"Triggers,list:member" xml:"Triggers>member"`
 }
 
 // EnvironmentResourceDescriptionsMessage is undocumented.
@@ -627,6 +1291,23 @@ type EnvironmentTier struct {
 	Version aws.StringValue `query:"Version" xml:"Version"`
 }
 
+// MarshalQuery adds the parameters of the EnvironmentTier to the form, prefixing their names.
+func (v *EnvironmentTier) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Type != nil {
+		form.Set(prefix+"Type", *v.Type)
+	}
+	if v.Version != nil {
+		form.Set(prefix+"Version", *v.Version)
+	}
+	return nil
+}
+
 // EventDescription is undocumented.
 type EventDescription struct {
 	ApplicationName aws.StringValue `query:"ApplicationName" xml:"ApplicationName"`
@@ -641,7 +1322,7 @@ type EventDescription struct {
 
 // EventDescriptionsMessage is undocumented.
 type EventDescriptionsMessage struct {
-	Events    []EventDescription `query:"Events.member" xml:"DescribeEventsResult>Events>member"`
+	Events    []EventDescription `query:"Events,list:member" xml:"DescribeEventsResult>Events>member"`
 	NextToken aws.StringValue    `query:"NextToken" xml:"DescribeEventsResult>NextToken"`
 }
 
@@ -667,8 +1348,8 @@ type LaunchConfiguration struct {
 
 // ListAvailableSolutionStacksResultMessage is undocumented.
 type ListAvailableSolutionStacksResultMessage struct {
-	SolutionStackDetails []SolutionStackDescription `query:"SolutionStackDetails.member" xml:"ListAvailableSolutionStacksResult>SolutionStackDetails>member"`
-	SolutionStacks       []string                   `query:"SolutionStacks.member" xml:"ListAvailableSolutionStacksResult>SolutionStacks>member"`
+	SolutionStackDetails []SolutionStackDescription `query:"SolutionStackDetails,list:member" xml:"ListAvailableSolutionStacksResult>SolutionStackDetails>member"`
+	SolutionStacks       []string                   `query:"SolutionStacks,list:member" xml:"ListAvailableSolutionStacksResult>SolutionStacks>member"`
 }
 
 // Listener is undocumented.
@@ -685,7 +1366,7 @@ type LoadBalancer struct {
 // LoadBalancerDescription is undocumented.
 type LoadBalancerDescription struct {
 	Domain           aws.StringValue `query:"Domain" xml:"Domain"`
-	Listeners        []Listener      `query:"Listeners.member" xml:"Listeners>member"`
+	Listeners        []Listener      `query:"Listeners,list:member" xml:"Listeners>member"`
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
@@ -701,6 +1382,20 @@ type OptionSpecification struct {
 	OptionName aws.StringValue `query:"OptionName" xml:"OptionName"`
 }
 
+// MarshalQuery adds the parameters of the OptionSpecification to the form, prefixing their names.
+func (v *OptionSpecification) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Namespace != nil {
+		form.Set(prefix+"Namespace", *v.Namespace)
+	}
+	if v.OptionName != nil {
+		form.Set(prefix+"OptionName", *v.OptionName)
+	}
+	return nil
+}
+
 // Queue is undocumented.
 type Queue struct {
 	Name aws.StringValue `query:"Name" xml:"Name"`
@@ -713,6 +1408,20 @@ type RebuildEnvironmentMessage struct {
 	EnvironmentName aws.StringValue `query:"EnvironmentName" xml:"EnvironmentName"`
 }
 
+// MarshalQuery adds the parameters of the RebuildEnvironmentMessage to the form, prefixing their names.
+func (v *RebuildEnvironmentMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	return nil
+}
+
 // RequestEnvironmentInfoMessage is undocumented.
 type RequestEnvironmentInfoMessage struct {
 	EnvironmentID   aws.StringValue `query:"EnvironmentId" xml:"EnvironmentId"`
@@ -720,12 +1429,43 @@ type RequestEnvironmentInfoMessage struct {
 	InfoType        aws.StringValue `query:"InfoType" xml:"InfoType"`
 }
 
+// MarshalQuery adds the parameters of the RequestEnvironmentInfoMessage to the form, prefixing their names.
+func (v *RequestEnvironmentInfoMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.InfoType != nil {
+		form.Set(prefix+"InfoType", *v.InfoType)
+	}
+	return nil
+}
+
 // RestartAppServerMessage is undocumented.
 type RestartAppServerMessage struct {
 	EnvironmentID   aws.StringValue `query:"EnvironmentId" xml:"EnvironmentId"`
 	EnvironmentName aws.StringValue `query:"EnvironmentName" xml:"EnvironmentName"`
 }
 
+// MarshalQuery adds the parameters of the RestartAppServerMessage to the form, prefixing their names.
+func (v *RestartAppServerMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	return nil
+}
+
 // RetrieveEnvironmentInfoMessage is undocumented.
 type RetrieveEnvironmentInfoMessage struct {
 	EnvironmentID   aws.StringValue `query:"EnvironmentId" xml:"EnvironmentId"`
@@ -733,9 +1473,26 @@ type RetrieveEnvironmentInfoMessage struct {
 	InfoType        aws.StringValue `query:"InfoType" xml:"InfoType"`
 }
 
+// MarshalQuery adds the parameters of the RetrieveEnvironmentInfoMessage to the form, prefixing their names.
+func (v *RetrieveEnvironmentInfoMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.InfoType != nil {
+		form.Set(prefix+"InfoType", *v.InfoType)
+	}
+	return nil
+}
+
 // RetrieveEnvironmentInfoResultMessage is undocumented.
 type RetrieveEnvironmentInfoResultMessage struct {
-	EnvironmentInfo []EnvironmentInfoDescription `query:"EnvironmentInfo.member" xml:"RetrieveEnvironmentInfoResult>EnvironmentInfo>member"`
+	EnvironmentInfo []EnvironmentInfoDescription `query:"EnvironmentInfo,list:member" xml:"RetrieveEnvironmentInfoResult>EnvironmentInfo>member"`
 }
 
 // S3Location is undocumented.
@@ -744,9 +1501,23 @@ type S3Location struct {
 	S3Key    aws.StringValue `query:"S3Key" xml:"S3Key"`
 }
 
+// MarshalQuery adds the parameters of the S3Location to the form, prefixing their names.
+func (v *S3Location) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.S3Bucket != nil {
+		form.Set(prefix+"S3Bucket", *v.S3Bucket)
+	}
+	if v.S3Key != nil {
+		form.Set(prefix+"S3Key", *v.S3Key)
+	}
+	return nil
+}
+
 // SolutionStackDescription is undocumented.
 type SolutionStackDescription struct {
-	PermittedFileTypes []string        `query:"PermittedFileTypes.member" xml:"PermittedFileTypes>member"`
+	PermittedFileTypes []string        `query:"PermittedFileTypes,list:member" xml:"PermittedFileTypes>member"`
 	SolutionStackName  aws.StringValue `query:"SolutionStackName" xml:"SolutionStackName"`
 }
 
@@ -756,6 +1527,20 @@ type SourceConfiguration struct {
 	TemplateName    aws.StringValue `query:"TemplateName" xml:"TemplateName"`
 }
 
+// MarshalQuery adds the parameters of the SourceConfiguration to the form, prefixing their names.
+func (v *SourceConfiguration) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	return nil
+}
+
 // SwapEnvironmentCNAMEsMessage is undocumented.
 type SwapEnvironmentCNAMEsMessage struct {
 	DestinationEnvironmentID   aws.StringValue `query:"DestinationEnvironmentId" xml:"DestinationEnvironmentId"`
@@ -764,12 +1549,46 @@ type SwapEnvironmentCNAMEsMessage struct {
 	SourceEnvironmentName      aws.StringValue `query:"SourceEnvironmentName" xml:"SourceEnvironmentName"`
 }
 
+// MarshalQuery adds the parameters of the SwapEnvironmentCNAMEsMessage to the form, prefixing their names.
+func (v *SwapEnvironmentCNAMEsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DestinationEnvironmentID != nil {
+		form.Set(prefix+"DestinationEnvironmentId", *v.DestinationEnvironmentID)
+	}
+	if v.DestinationEnvironmentName != nil {
+		form.Set(prefix+"DestinationEnvironmentName", *v.DestinationEnvironmentName)
+	}
+	if v.SourceEnvironmentID != nil {
+		form.Set(prefix+"SourceEnvironmentId", *v.SourceEnvironmentID)
+	}
+	if v.SourceEnvironmentName != nil {
+		form.Set(prefix+"SourceEnvironmentName", *v.SourceEnvironmentName)
+	}
+	return nil
+}
+
 // Tag is undocumented.
 type Tag struct {
 	Key   aws.StringValue `query:"Key" xml:"Key"`
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Tag to the form, prefixing their names.
+func (v *Tag) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // TerminateEnvironmentMessage is undocumented.
 type TerminateEnvironmentMessage struct {
 	EnvironmentID      aws.StringValue  `query:"EnvironmentId" xml:"EnvironmentId"`
@@ -777,6 +1596,23 @@ type TerminateEnvironmentMessage struct {
 	TerminateResources aws.BooleanValue `query:"TerminateResources" xml:"TerminateResources"`
 }
 
+// MarshalQuery adds the parameters of the TerminateEnvironmentMessage to the form, prefixing their names.
+func (v *TerminateEnvironmentMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.TerminateResources != nil {
+		form.Set(prefix+"TerminateResources", strconv.FormatBool(*v.TerminateResources))
+	}
+	return nil
+}
+
 // Trigger is undocumented.
 type Trigger struct {
 	Name aws.StringValue `query:"Name" xml:"Name"`
@@ -788,6 +1624,20 @@ type UpdateApplicationMessage struct {
 	Description     aws.StringValue `query:"Description" xml:"Description"`
 }
 
+// MarshalQuery adds the parameters of the UpdateApplicationMessage to the form, prefixing their names.
+func (v *UpdateApplicationMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	return nil
+}
+
 // UpdateApplicationVersionMessage is undocumented.
 type UpdateApplicationVersionMessage struct {
 	ApplicationName aws.StringValue `query:"ApplicationName" xml:"ApplicationName"`
@@ -795,35 +1645,169 @@ type UpdateApplicationVersionMessage struct {
 	VersionLabel    aws.StringValue `query:"VersionLabel" xml:"VersionLabel"`
 }
 
+// MarshalQuery adds the parameters of the UpdateApplicationVersionMessage to the form, prefixing their names.
+func (v *UpdateApplicationVersionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.VersionLabel != nil {
+		form.Set(prefix+"VersionLabel", *v.VersionLabel)
+	}
+	return nil
+}
+
 // UpdateConfigurationTemplateMessage is undocumented.
 type UpdateConfigurationTemplateMessage struct {
 	ApplicationName aws.StringValue              `query:"ApplicationName" xml:"ApplicationName"`
 	Description     aws.StringValue              `query:"Description" xml:"Description"`
-	OptionSettings  []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"OptionSettings>member"`
-	OptionsToRemove []OptionSpecification        `query:"OptionsToRemove.member" xml:"OptionsToRemove>member"`
+	OptionSettings  []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"OptionSettings>member"`
+	OptionsToRemove []OptionSpecification        `query:"OptionsToRemove,list:member" xml:"OptionsToRemove>member"`
 	TemplateName    aws.StringValue              `query:"TemplateName" xml:"TemplateName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateConfigurationTemplateMessage to the form, prefixing their names.
+func (v *UpdateConfigurationTemplateMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.OptionSettings != nil {
+		if len(v.OptionSettings) == 0 {
+			form.Set(prefix+"OptionSettings", "")
+		}
+		for i1 := range v.OptionSettings {
+			name1 := prefix + "OptionSettings.member." + strconv.Itoa(i1+1)
+			if err := v.OptionSettings[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.OptionsToRemove != nil {
+		if len(v.OptionsToRemove) == 0 {
+			form.Set(prefix+"OptionsToRemove", "")
+		}
+		for i1 := range v.OptionsToRemove {
+			name1 := prefix + "OptionsToRemove.member." + strconv.Itoa(i1+1)
+			if err := v.OptionsToRemove[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	return nil
+}
+
 // UpdateEnvironmentMessage is undocumented.
 type UpdateEnvironmentMessage struct {
 	Description     aws.StringValue              `query:"Description" xml:"Description"`
 	EnvironmentID   aws.StringValue              `query:"EnvironmentId" xml:"EnvironmentId"`
 	EnvironmentName aws.StringValue              `query:"EnvironmentName" xml:"EnvironmentName"`
-	OptionSettings  []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"OptionSettings>member"`
-	OptionsToRemove []OptionSpecification        `query:"OptionsToRemove.member" xml:"OptionsToRemove>member"`
+	OptionSettings  []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"OptionSettings>member"`
+	OptionsToRemove []OptionSpecification        `query:"OptionsToRemove,list:member" xml:"OptionsToRemove>member"`
 	TemplateName    aws.StringValue              `query:"TemplateName" xml:"TemplateName"`
 	Tier            *EnvironmentTier             `query:"Tier" xml:"Tier"`
 	VersionLabel    aws.StringValue              `query:"VersionLabel" xml:"VersionLabel"`
 }
 
+// MarshalQuery adds the parameters of the UpdateEnvironmentMessage to the form, prefixing their names.
+func (v *UpdateEnvironmentMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.EnvironmentID != nil {
+		form.Set(prefix+"EnvironmentId", *v.EnvironmentID)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.OptionSettings != nil {
+		if len(v.OptionSettings) == 0 {
+			form.Set(prefix+"OptionSettings", "")
+		}
+		for i1 := range v.OptionSettings {
+			name1 := prefix + "OptionSettings.member." + strconv.Itoa(i1+1)
+			if err := v.OptionSettings[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.OptionsToRemove != nil {
+		if len(v.OptionsToRemove) == 0 {
+			form.Set(prefix+"OptionsToRemove", "")
+		}
+		for i1 := range v.OptionsToRemove {
+			name1 := prefix + "OptionsToRemove.member." + strconv.Itoa(i1+1)
+			if err := v.OptionsToRemove[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	if v.Tier != nil {
+		if err := v.Tier.MarshalQuery(form, prefix+"Tier."); err != nil {
+			return err
+		}
+	}
+	if v.VersionLabel != nil {
+		form.Set(prefix+"VersionLabel", *v.VersionLabel)
+	}
+	return nil
+}
+
 // ValidateConfigurationSettingsMessage is undocumented.
 type ValidateConfigurationSettingsMessage struct {
 	ApplicationName aws.StringValue              `query:"ApplicationName" xml:"ApplicationName"`
 	EnvironmentName aws.StringValue              `query:"EnvironmentName" xml:"EnvironmentName"`
-	OptionSettings  []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"OptionSettings>member"`
+	OptionSettings  []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"OptionSettings>member"`
 	TemplateName    aws.StringValue              `query:"TemplateName" xml:"TemplateName"`
 }
 
+// MarshalQuery adds the parameters of the ValidateConfigurationSettingsMessage to the form, prefixing their names.
+func (v *ValidateConfigurationSettingsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplicationName != nil {
+		form.Set(prefix+"ApplicationName", *v.ApplicationName)
+	}
+	if v.EnvironmentName != nil {
+		form.Set(prefix+"EnvironmentName", *v.EnvironmentName)
+	}
+	if v.OptionSettings != nil {
+		if len(v.OptionSettings) == 0 {
+			form.Set(prefix+"OptionSettings", "")
+		}
+		for i1 := range v.OptionSettings {
+			name1 := prefix + "OptionSettings.member." + strconv.Itoa(i1+1)
+			if err := v.OptionSettings[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TemplateName != nil {
+		form.Set(prefix+"TemplateName", *v.TemplateName)
+	}
+	return nil
+}
+
 // ValidationMessage is undocumented.
 type ValidationMessage struct {
 	Message    aws.StringValue `query:"Message" xml:"Message"`
@@ -862,7 +1846,7 @@ type CreateConfigurationTemplateResult struct {
 	DeploymentStatus  aws.StringValue              `query:"DeploymentStatus" xml:"CreateConfigurationTemplateResult>DeploymentStatus"`
 	Description       aws.StringValue              `query:"Description" xml:"CreateConfigurationTemplateResult>Description"`
 	EnvironmentName   aws.StringValue              `query:"EnvironmentName" xml:"CreateConfigurationTemplateResult>EnvironmentName"`
-	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"CreateConfigurationTemplateResult>OptionSettings>member"`
+	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"CreateConfigurationTemplateResult>OptionSettings>member"`
 	SolutionStackName aws.StringValue              `query:"SolutionStackName" xml:"CreateConfigurationTemplateResult>SolutionStackName"`
 	TemplateName      aws.StringValue              `query:"TemplateName" xml:"CreateConfigurationTemplateResult>TemplateName"`
 }
@@ -893,23 +1877,23 @@ type CreateStorageLocationResult struct {
 
 // DescribeApplicationVersionsResult is a wrapper for ApplicationVersionDescriptionsMessage.
 type DescribeApplicationVersionsResult struct {
-	ApplicationVersions []ApplicationVersionDescription `query:"ApplicationVersions.member" xml:"DescribeApplicationVersionsResult>ApplicationVersions>member"`
+	ApplicationVersions []ApplicationVersionDescription `query:"ApplicationVersions,list:member" xml:"DescribeApplicationVersionsResult>ApplicationVersions>member"`
 }
 
 // DescribeApplicationsResult is a wrapper for ApplicationDescriptionsMessage.
 type DescribeApplicationsResult struct {
-	Applications []ApplicationDescription `query:"Applications.member" xml:"DescribeApplicationsResult>Applications>member"`
+	Applications []ApplicationDescription `query:"Applications,list:member" xml:"DescribeApplicationsResult>Applications>member"`
 }
 
 // DescribeConfigurationOptionsResult is a wrapper for ConfigurationOptionsDescription.
 type DescribeConfigurationOptionsResult struct {
-	Options           []ConfigurationOptionDescription `query:"Options.member" xml:"DescribeConfigurationOptionsResult>Options>member"`
+	Options           []ConfigurationOptionDescription `query:"Options,list:member" xml:"DescribeConfigurationOptionsResult>Options>member"`
 	SolutionStackName aws.StringValue                  `query:"SolutionStackName" xml:"DescribeConfigurationOptionsResult>SolutionStackName"`
 }
 
 // DescribeConfigurationSettingsResult is a wrapper for ConfigurationSettingsDescriptions.
 type DescribeConfigurationSettingsResult struct {
-	ConfigurationSettings []ConfigurationSettingsDescription `query:"ConfigurationSettings.member" xml:"DescribeConfigurationSettingsResult>ConfigurationSettings>member"`
+	ConfigurationSettings []ConfigurationSettingsDescription `query:"ConfigurationSettings,list:member" xml:"DescribeConfigurationSettingsResult>ConfigurationSettings>member"`
 }
 
 // DescribeEnvironmentResourcesResult is a wrapper for EnvironmentResourceDescriptionsMessage.
@@ -919,24 +1903,24 @@ type DescribeEnvironmentResourcesResult struct {
 
 // DescribeEnvironmentsResult is a wrapper for EnvironmentDescriptionsMessage.
 type DescribeEnvironmentsResult struct {
-	Environments []EnvironmentDescription `query:"Environments.member" xml:"DescribeEnvironmentsResult>Environments>member"`
+	Environments []EnvironmentDescription `query:"Environments,list:member" xml:"DescribeEnvironmentsResult>Environments>member"`
 }
 
 // DescribeEventsResult is a wrapper for EventDescriptionsMessage.
 type DescribeEventsResult struct {
-	Events    []EventDescription `query:"Events.member" xml:"DescribeEventsResult>Events>member"`
+	Events    []EventDescription `query:"Events,list:member" xml:"DescribeEventsResult>Events>member"`
 	NextToken aws.StringValue    `query:"NextToken" xml:"DescribeEventsResult>NextToken"`
 }
 
 // ListAvailableSolutionStacksResult is a wrapper for ListAvailableSolutionStacksResultMessage.
 type ListAvailableSolutionStacksResult struct {
-	SolutionStackDetails []SolutionStackDescription `query:"SolutionStackDetails.member" xml:"ListAvailableSolutionStacksResult>SolutionStackDetails>member"`
-	SolutionStacks       []string                   `query:"SolutionStacks.member" xml:"ListAvailableSolutionStacksResult>SolutionStacks>member"`
+	SolutionStackDetails []SolutionStackDescription `query:"SolutionStackDetails,list:member" xml:"ListAvailableSolutionStacksResult>SolutionStackDetails>member"`
+	SolutionStacks       []string                   `query:"SolutionStacks,list:member" xml:"ListAvailableSolutionStacksResult>SolutionStacks>member"`
 }
 
 // RetrieveEnvironmentInfoResult is a wrapper for RetrieveEnvironmentInfoResultMessage.
 type RetrieveEnvironmentInfoResult struct {
-	EnvironmentInfo []EnvironmentInfoDescription `query:"EnvironmentInfo.member" xml:"RetrieveEnvironmentInfoResult>EnvironmentInfo>member"`
+	EnvironmentInfo []EnvironmentInfoDescription `query:"EnvironmentInfo,list:member" xml:"RetrieveEnvironmentInfoResult>EnvironmentInfo>member"`
 }
 
 // TerminateEnvironmentResult is a wrapper for EnvironmentDescription.
@@ -976,7 +1960,7 @@ type UpdateConfigurationTemplateResult struct {
 	DeploymentStatus  aws.StringValue              `query:"DeploymentStatus" xml:"UpdateConfigurationTemplateResult>DeploymentStatus"`
 	Description       aws.StringValue              `query:"Description" xml:"UpdateConfigurationTemplateResult>Description"`
 	EnvironmentName   aws.StringValue              `query:"EnvironmentName" xml:"UpdateConfigurationTemplateResult>EnvironmentName"`
-	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings.member" xml:"UpdateConfigurationTemplateResult>OptionSettings>member"`
+	OptionSettings    []ConfigurationOptionSetting `query:"OptionSettings,list:member" xml:"UpdateConfigurationTemplateResult>OptionSettings>member"`
 	SolutionStackName aws.StringValue              `query:"SolutionStackName" xml:"UpdateConfigurationTemplateResult>SolutionStackName"`
 	TemplateName      aws.StringValue              `query:"TemplateName" xml:"UpdateConfigurationTemplateResult>TemplateName"`
 }
@@ -1002,8 +1986,78 @@ type UpdateEnvironmentResult struct {
 
 // ValidateConfigurationSettingsResult is a wrapper for ConfigurationSettingsValidationMessages.
 type ValidateConfigurationSettingsResult struct {
-	Messages []ValidationMessage `query:"Messages.member" xml:"ValidateConfigurationSettingsResult>Messages>member"`
+	Messages []ValidationMessage `query:"Messages,list:member" xml:"ValidateConfigurationSettingsResult>Messages>member"`
+}
+
+// InsufficientPrivilegesException is the error returned for the InsufficientPrivilegesException error code.
+type InsufficientPrivilegesException struct {
+	aws.APIError
+}
+
+// OperationInProgressException is the error returned for the OperationInProgressFailure error code.
+type OperationInProgressException struct {
+	aws.APIError
+}
+
+// S3LocationNotInServiceRegionException is the error returned for the S3LocationNotInServiceRegionException error code.
+type S3LocationNotInServiceRegionException struct {
+	aws.APIError
+}
+
+// S3SubscriptionRequiredException is the error returned for the S3SubscriptionRequiredException error code.
+type S3SubscriptionRequiredException struct {
+	aws.APIError
+}
+
+// SourceBundleDeletionException is the error returned for the SourceBundleDeletionFailure error code.
+type SourceBundleDeletionException struct {
+	aws.APIError
+}
+
+// TooManyApplicationVersionsException is the error returned for the TooManyApplicationVersionsException error code.
+type TooManyApplicationVersionsException struct {
+	aws.APIError
+}
+
+// TooManyApplicationsException is the error returned for the TooManyApplicationsException error code.
+type TooManyApplicationsException struct {
+	aws.APIError
+}
+
+// TooManyBucketsException is the error returned for the TooManyBucketsException error code.
+type TooManyBucketsException struct {
+	aws.APIError
+}
+
+// TooManyConfigurationTemplatesException is the error returned for the TooManyConfigurationTemplatesException error code.
+type TooManyConfigurationTemplatesException struct {
+	aws.APIError
+}
+
+// TooManyEnvironmentsException is the error returned for the TooManyEnvironmentsException error code.
+type TooManyEnvironmentsException struct {
+	aws.APIError
+}
+
+// exceptions maps ElasticBeanstalk's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InsufficientPrivilegesException":        InsufficientPrivilegesException{},
+	"OperationInProgressFailure":             OperationInProgressException{},
+	"S3LocationNotInServiceRegionException":  S3LocationNotInServiceRegionException{},
+	"S3SubscriptionRequiredException":        S3SubscriptionRequiredException{},
+	"SourceBundleDeletionFailure":            SourceBundleDeletionException{},
+	"TooManyApplicationVersionsException":    TooManyApplicationVersionsException{},
+	"TooManyApplicationsException":           TooManyApplicationsException{},
+	"TooManyBucketsException":                TooManyBucketsException{},
+	"TooManyConfigurationTemplatesException": TooManyConfigurationTemplatesException{},
+	"TooManyEnvironmentsException":           TooManyEnvironmentsException{},
 }
 
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,15 +4,18 @@
 package elastictranscoder
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
 )
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,53 +30,280 @@ type ElasticTranscoder struct {
 	client *aws.RestClient
 }
 
-// New returns a new ElasticTranscoder client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *ElasticTranscoder {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "elastictranscoder"
-	endpoint, service, region := endpoints.Lookup("elastictranscoder", region)
+// New returns a new ElasticTranscoder client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *ElasticTranscoder {
+	cfg := newConfig(region, client, opts)
 
 	return &ElasticTranscoder{
 		client: &aws.RestClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2012-09-25",
 		},
 	}
 }
 
+// NewFromSession returns a new ElasticTranscoder client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *ElasticTranscoder {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("elastictranscoder", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("elastictranscoder", "elastictranscoder"),
+	}, "AWS_ENDPOINT_URL_ELASTIC_TRANSCODER", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *ElasticTranscoder) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *ElasticTranscoder) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *ElasticTranscoder) SetPayloadSigning(p aws.PayloadSigning) {
+	c.client.Context.PayloadSigning = p
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *ElasticTranscoder) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch op {
+
+	case "CancelJob":
+
+		r, ok := req.(*CancelJobRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: CancelJob takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCancelJobRequest(context.Background(), r)
+
+	case "CreateJob":
+
+		r, ok := req.(*CreateJobRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: CreateJob takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateJobRequest(context.Background(), r)
+
+	case "CreatePipeline":
+
+		r, ok := req.(*CreatePipelineRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: CreatePipeline takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreatePipelineRequest(context.Background(), r)
+
+	case "CreatePreset":
+
+		r, ok := req.(*CreatePresetRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: CreatePreset takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreatePresetRequest(context.Background(), r)
+
+	case "DeletePipeline":
+
+		r, ok := req.(*DeletePipelineRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: DeletePipeline takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeletePipelineRequest(context.Background(), r)
+
+	case "DeletePreset":
+
+		r, ok := req.(*DeletePresetRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: DeletePreset takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeletePresetRequest(context.Background(), r)
+
+	case "ListJobsByPipeline":
+
+		r, ok := req.(*ListJobsByPipelineRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: ListJobsByPipeline takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListJobsByPipelineRequest(context.Background(), r)
+
+	case "ListJobsByStatus":
+
+		r, ok := req.(*ListJobsByStatusRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: ListJobsByStatus takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListJobsByStatusRequest(context.Background(), r)
+
+	case "ListPipelines":
+
+		r, ok := req.(*ListPipelinesRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: ListPipelines takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListPipelinesRequest(context.Background(), r)
+
+	case "ListPresets":
+
+		r, ok := req.(*ListPresetsRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: ListPresets takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListPresetsRequest(context.Background(), r)
+
+	case "ReadJob":
+
+		r, ok := req.(*ReadJobRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: ReadJob takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newReadJobRequest(context.Background(), r)
+
+	case "ReadPipeline":
+
+		r, ok := req.(*ReadPipelineRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: ReadPipeline takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newReadPipelineRequest(context.Background(), r)
+
+	case "ReadPreset":
+
+		r, ok := req.(*ReadPresetRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: ReadPreset takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newReadPresetRequest(context.Background(), r)
+
+	case "TestRole":
+
+		r, ok := req.(*TestRoleRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: TestRole takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newTestRoleRequest(context.Background(), r)
+
+	case "UpdatePipeline":
+
+		r, ok := req.(*UpdatePipelineRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: UpdatePipeline takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdatePipelineRequest(context.Background(), r)
+
+	case "UpdatePipelineNotifications":
+
+		r, ok := req.(*UpdatePipelineNotificationsRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: UpdatePipelineNotifications takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdatePipelineNotificationsRequest(context.Background(), r)
+
+	case "UpdatePipelineStatus":
+
+		r, ok := req.(*UpdatePipelineStatusRequest)
+		if !ok {
+			return "", fmt.Errorf("elastictranscoder: UpdatePipelineStatus takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdatePipelineStatusRequest(context.Background(), r)
+
+	default:
+		return "", fmt.Errorf("elastictranscoder: unknown operation %q", op)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
 // CancelJob the CancelJob operation cancels an unfinished job. You can
 // only cancel a job that has a status of Submitted . To prevent a pipeline
 // from starting to process a job while you're getting the job identifier,
 // use UpdatePipelineStatus to temporarily pause the pipeline.
 func (c *ElasticTranscoder) CancelJob(req *CancelJobRequest) (resp *CancelJobResponse, err error) {
+	return c.CancelJobWithContext(context.Background(), req)
+}
+
+// CancelJobWithContext is like CancelJob, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) CancelJobWithContext(ctx context.Context, req *CancelJobRequest) (resp *CancelJobResponse, err error) {
 	resp = &CancelJobResponse{}
 
+	httpReq, err := c.newCancelJobRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CancelJob", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newCancelJobRequest builds the HTTP request for CancelJob.
+func (c *ElasticTranscoder) newCancelJobRequest(ctx context.Context, req *CancelJobRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/jobs/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -82,7 +312,31 @@ func (c *ElasticTranscoder) CancelJob(req *CancelJobRequest) (resp *CancelJobRes
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreateJob when you create a job, Elastic Transcoder returns data that
+// includes the values that you specified plus information about the job
+// that is created. If you have specified more than one output for your
+// jobs (for example, one output for the Kindle Fire and another output for
+// the Apple iPhone 4s), you currently must use the Elastic Transcoder API
+// to list the jobs (as opposed to the AWS Console).
+func (c *ElasticTranscoder) CreateJob(req *CreateJobRequest) (resp *CreateJobResponse, err error) {
+	return c.CreateJobWithContext(context.Background(), req)
+}
+
+// CreateJobWithContext is like CreateJob, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) CreateJobWithContext(ctx context.Context, req *CreateJobRequest) (resp *CreateJobResponse, err error) {
+	resp = &CreateJobResponse{}
+
+	httpReq, err := c.newCreateJobRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateJob", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -97,15 +351,8 @@ func (c *ElasticTranscoder) CancelJob(req *CancelJobRequest) (resp *CancelJobRes
 	return
 }
 
-// CreateJob when you create a job, Elastic Transcoder returns data that
-// includes the values that you specified plus information about the job
-// that is created. If you have specified more than one output for your
-// jobs (for example, one output for the Kindle Fire and another output for
-// the Apple iPhone 4s), you currently must use the Elastic Transcoder API
-// to list the jobs (as opposed to the AWS Console).
-func (c *ElasticTranscoder) CreateJob(req *CreateJobRequest) (resp *CreateJobResponse, err error) {
-	resp = &CreateJobResponse{}
-
+// newCreateJobRequest builds the HTTP request for CreateJob.
+func (c *ElasticTranscoder) newCreateJobRequest(ctx context.Context, req *CreateJobRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -114,10 +361,14 @@ func (c *ElasticTranscoder) CreateJob(req *CreateJobRequest) (resp *CreateJobRes
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -126,7 +377,27 @@ func (c *ElasticTranscoder) CreateJob(req *CreateJobRequest) (resp *CreateJobRes
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreatePipeline the CreatePipeline operation creates a pipeline with
+// settings that you specify.
+func (c *ElasticTranscoder) CreatePipeline(req *CreatePipelineRequest) (resp *CreatePipelineResponse, err error) {
+	return c.CreatePipelineWithContext(context.Background(), req)
+}
+
+// CreatePipelineWithContext is like CreatePipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) CreatePipelineWithContext(ctx context.Context, req *CreatePipelineRequest) (resp *CreatePipelineResponse, err error) {
+	resp = &CreatePipelineResponse{}
+
+	httpReq, err := c.newCreatePipelineRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreatePipeline", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -141,11 +412,8 @@ func (c *ElasticTranscoder) CreateJob(req *CreateJobRequest) (resp *CreateJobRes
 	return
 }
 
-// CreatePipeline the CreatePipeline operation creates a pipeline with
-// settings that you specify.
-func (c *ElasticTranscoder) CreatePipeline(req *CreatePipelineRequest) (resp *CreatePipelineResponse, err error) {
-	resp = &CreatePipelineResponse{}
-
+// newCreatePipelineRequest builds the HTTP request for CreatePipeline.
+func (c *ElasticTranscoder) newCreatePipelineRequest(ctx context.Context, req *CreatePipelineRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -154,10 +422,14 @@ func (c *ElasticTranscoder) CreatePipeline(req *CreatePipelineRequest) (resp *Cr
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -166,18 +438,6 @@ func (c *ElasticTranscoder) CreatePipeline(req *CreatePipelineRequest) (resp *Cr
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -197,8 +457,37 @@ func (c *ElasticTranscoder) CreatePipeline(req *CreatePipelineRequest) (resp *Cr
 // Recommendation H.264: Advanced video coding for generic audiovisual
 // services
 func (c *ElasticTranscoder) CreatePreset(req *CreatePresetRequest) (resp *CreatePresetResponse, err error) {
+	return c.CreatePresetWithContext(context.Background(), req)
+}
+
+// CreatePresetWithContext is like CreatePreset, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) CreatePresetWithContext(ctx context.Context, req *CreatePresetRequest) (resp *CreatePresetResponse, err error) {
 	resp = &CreatePresetResponse{}
 
+	httpReq, err := c.newCreatePresetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreatePreset", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newCreatePresetRequest builds the HTTP request for CreatePreset.
+func (c *ElasticTranscoder) newCreatePresetRequest(ctx context.Context, req *CreatePresetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -207,10 +496,14 @@ func (c *ElasticTranscoder) CreatePreset(req *CreatePresetRequest) (resp *Create
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -219,7 +512,29 @@ func (c *ElasticTranscoder) CreatePreset(req *CreatePresetRequest) (resp *Create
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeletePipeline the DeletePipeline operation removes a pipeline. You can
+// only delete a pipeline that has never been used or that is not currently
+// in use (doesn't contain any active jobs). If the pipeline is currently
+// in use, DeletePipeline returns an error.
+func (c *ElasticTranscoder) DeletePipeline(req *DeletePipelineRequest) (resp *DeletePipelineResponse, err error) {
+	return c.DeletePipelineWithContext(context.Background(), req)
+}
+
+// DeletePipelineWithContext is like DeletePipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) DeletePipelineWithContext(ctx context.Context, req *DeletePipelineRequest) (resp *DeletePipelineResponse, err error) {
+	resp = &DeletePipelineResponse{}
+
+	httpReq, err := c.newDeletePipelineRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeletePipeline", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -234,30 +549,29 @@ func (c *ElasticTranscoder) CreatePreset(req *CreatePresetRequest) (resp *Create
 	return
 }
 
-// DeletePipeline the DeletePipeline operation removes a pipeline. You can
-// only delete a pipeline that has never been used or that is not currently
-// in use (doesn't contain any active jobs). If the pipeline is currently
-// in use, DeletePipeline returns an error.
-func (c *ElasticTranscoder) DeletePipeline(req *DeletePipelineRequest) (resp *DeletePipelineResponse, err error) {
-	resp = &DeletePipelineResponse{}
-
+// newDeletePipelineRequest builds the HTTP request for DeletePipeline.
+func (c *ElasticTranscoder) newDeletePipelineRequest(ctx context.Context, req *DeletePipelineRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/pipelines/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -266,7 +580,28 @@ func (c *ElasticTranscoder) DeletePipeline(req *DeletePipelineRequest) (resp *De
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeletePreset the DeletePreset operation removes a preset that you've
+// added in an AWS region. You can't delete the default presets that are
+// included with Elastic Transcoder.
+func (c *ElasticTranscoder) DeletePreset(req *DeletePresetRequest) (resp *DeletePresetResponse, err error) {
+	return c.DeletePresetWithContext(context.Background(), req)
+}
+
+// DeletePresetWithContext is like DeletePreset, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) DeletePresetWithContext(ctx context.Context, req *DeletePresetRequest) (resp *DeletePresetResponse, err error) {
+	resp = &DeletePresetResponse{}
+
+	httpReq, err := c.newDeletePresetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeletePreset", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -281,29 +616,29 @@ func (c *ElasticTranscoder) DeletePipeline(req *DeletePipelineRequest) (resp *De
 	return
 }
 
-// DeletePreset the DeletePreset operation removes a preset that you've
-// added in an AWS region. You can't delete the default presets that are
-// included with Elastic Transcoder.
-func (c *ElasticTranscoder) DeletePreset(req *DeletePresetRequest) (resp *DeletePresetResponse, err error) {
-	resp = &DeletePresetResponse{}
-
+// newDeletePresetRequest builds the HTTP request for DeletePreset.
+func (c *ElasticTranscoder) newDeletePresetRequest(ctx context.Context, req *DeletePresetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/presets/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -312,7 +647,29 @@ func (c *ElasticTranscoder) DeletePreset(req *DeletePresetRequest) (resp *Delete
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListJobsByPipeline the ListJobsByPipeline operation gets a list of the
+// jobs currently in a pipeline. Elastic Transcoder returns all of the jobs
+// currently in the specified pipeline. The response body contains one
+// element for each job that satisfies the search criteria.
+func (c *ElasticTranscoder) ListJobsByPipeline(req *ListJobsByPipelineRequest) (resp *ListJobsByPipelineResponse, err error) {
+	return c.ListJobsByPipelineWithContext(context.Background(), req)
+}
+
+// ListJobsByPipelineWithContext is like ListJobsByPipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) ListJobsByPipelineWithContext(ctx context.Context, req *ListJobsByPipelineRequest) (resp *ListJobsByPipelineResponse, err error) {
+	resp = &ListJobsByPipelineResponse{}
+
+	httpReq, err := c.newListJobsByPipelineRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListJobsByPipeline", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -327,21 +684,16 @@ func (c *ElasticTranscoder) DeletePreset(req *DeletePresetRequest) (resp *Delete
 	return
 }
 
-// ListJobsByPipeline the ListJobsByPipeline operation gets a list of the
-// jobs currently in a pipeline. Elastic Transcoder returns all of the jobs
-// currently in the specified pipeline. The response body contains one
-// element for each job that satisfies the search criteria.
-func (c *ElasticTranscoder) ListJobsByPipeline(req *ListJobsByPipelineRequest) (resp *ListJobsByPipelineResponse, err error) {
-	resp = &ListJobsByPipelineResponse{}
-
+// newListJobsByPipelineRequest builds the HTTP request for ListJobsByPipeline.
+func (c *ElasticTranscoder) newListJobsByPipelineRequest(ctx context.Context, req *ListJobsByPipelineRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/jobsByPipeline/{PipelineId}"
 
 	if req.PipelineID != nil {
-		uri = strings.Replace(uri, "{"+"PipelineId"+"}", *req.PipelineID, -1)
-		uri = strings.Replace(uri, "{"+"PipelineId+"+"}", *req.PipelineID, -1)
+		uri = strings.Replace(uri, "{PipelineId}", aws.EscapePath(*req.PipelineID, false), -1)
+		uri = strings.Replace(uri, "{PipelineId+}", aws.EscapePath(*req.PipelineID, true), -1)
 	}
 
 	q := url.Values{}
@@ -355,10 +707,14 @@ func (c *ElasticTranscoder) ListJobsByPipeline(req *ListJobsByPipelineRequest) (
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -367,7 +723,28 @@ func (c *ElasticTranscoder) ListJobsByPipeline(req *ListJobsByPipelineRequest) (
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListJobsByStatus the ListJobsByStatus operation gets a list of jobs that
+// have a specified status. The response body contains one element for each
+// job that satisfies the search criteria.
+func (c *ElasticTranscoder) ListJobsByStatus(req *ListJobsByStatusRequest) (resp *ListJobsByStatusResponse, err error) {
+	return c.ListJobsByStatusWithContext(context.Background(), req)
+}
+
+// ListJobsByStatusWithContext is like ListJobsByStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) ListJobsByStatusWithContext(ctx context.Context, req *ListJobsByStatusRequest) (resp *ListJobsByStatusResponse, err error) {
+	resp = &ListJobsByStatusResponse{}
+
+	httpReq, err := c.newListJobsByStatusRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListJobsByStatus", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -382,20 +759,16 @@ func (c *ElasticTranscoder) ListJobsByPipeline(req *ListJobsByPipelineRequest) (
 	return
 }
 
-// ListJobsByStatus the ListJobsByStatus operation gets a list of jobs that
-// have a specified status. The response body contains one element for each
-// job that satisfies the search criteria.
-func (c *ElasticTranscoder) ListJobsByStatus(req *ListJobsByStatusRequest) (resp *ListJobsByStatusResponse, err error) {
-	resp = &ListJobsByStatusResponse{}
-
+// newListJobsByStatusRequest builds the HTTP request for ListJobsByStatus.
+func (c *ElasticTranscoder) newListJobsByStatusRequest(ctx context.Context, req *ListJobsByStatusRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/jobsByStatus/{Status}"
 
 	if req.Status != nil {
-		uri = strings.Replace(uri, "{"+"Status"+"}", *req.Status, -1)
-		uri = strings.Replace(uri, "{"+"Status+"+"}", *req.Status, -1)
+		uri = strings.Replace(uri, "{Status}", aws.EscapePath(*req.Status, false), -1)
+		uri = strings.Replace(uri, "{Status+}", aws.EscapePath(*req.Status, true), -1)
 	}
 
 	q := url.Values{}
@@ -409,10 +782,14 @@ func (c *ElasticTranscoder) ListJobsByStatus(req *ListJobsByStatusRequest) (resp
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -421,7 +798,27 @@ func (c *ElasticTranscoder) ListJobsByStatus(req *ListJobsByStatusRequest) (resp
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListPipelines the ListPipelines operation gets a list of the pipelines
+// associated with the current AWS account.
+func (c *ElasticTranscoder) ListPipelines(req *ListPipelinesRequest) (resp *ListPipelinesResponse, err error) {
+	return c.ListPipelinesWithContext(context.Background(), req)
+}
+
+// ListPipelinesWithContext is like ListPipelines, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) ListPipelinesWithContext(ctx context.Context, req *ListPipelinesRequest) (resp *ListPipelinesResponse, err error) {
+	resp = &ListPipelinesResponse{}
+
+	httpReq, err := c.newListPipelinesRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListPipelines", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -436,11 +833,8 @@ func (c *ElasticTranscoder) ListJobsByStatus(req *ListJobsByStatusRequest) (resp
 	return
 }
 
-// ListPipelines the ListPipelines operation gets a list of the pipelines
-// associated with the current AWS account.
-func (c *ElasticTranscoder) ListPipelines(req *ListPipelinesRequest) (resp *ListPipelinesResponse, err error) {
-	resp = &ListPipelinesResponse{}
-
+// newListPipelinesRequest builds the HTTP request for ListPipelines.
+func (c *ElasticTranscoder) newListPipelinesRequest(ctx context.Context, req *ListPipelinesRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -457,10 +851,14 @@ func (c *ElasticTranscoder) ListPipelines(req *ListPipelinesRequest) (resp *List
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -469,7 +867,28 @@ func (c *ElasticTranscoder) ListPipelines(req *ListPipelinesRequest) (resp *List
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListPresets the ListPresets operation gets a list of the default presets
+// included with Elastic Transcoder and the presets that you've added in an
+// AWS region.
+func (c *ElasticTranscoder) ListPresets(req *ListPresetsRequest) (resp *ListPresetsResponse, err error) {
+	return c.ListPresetsWithContext(context.Background(), req)
+}
+
+// ListPresetsWithContext is like ListPresets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) ListPresetsWithContext(ctx context.Context, req *ListPresetsRequest) (resp *ListPresetsResponse, err error) {
+	resp = &ListPresetsResponse{}
+
+	httpReq, err := c.newListPresetsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListPresets", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -484,12 +903,8 @@ func (c *ElasticTranscoder) ListPipelines(req *ListPipelinesRequest) (resp *List
 	return
 }
 
-// ListPresets the ListPresets operation gets a list of the default presets
-// included with Elastic Transcoder and the presets that you've added in an
-// AWS region.
-func (c *ElasticTranscoder) ListPresets(req *ListPresetsRequest) (resp *ListPresetsResponse, err error) {
-	resp = &ListPresetsResponse{}
-
+// newListPresetsRequest builds the HTTP request for ListPresets.
+func (c *ElasticTranscoder) newListPresetsRequest(ctx context.Context, req *ListPresetsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -506,10 +921,14 @@ func (c *ElasticTranscoder) ListPresets(req *ListPresetsRequest) (resp *ListPres
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -518,7 +937,26 @@ func (c *ElasticTranscoder) ListPresets(req *ListPresetsRequest) (resp *ListPres
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ReadJob the ReadJob operation returns detailed information about a job.
+func (c *ElasticTranscoder) ReadJob(req *ReadJobRequest) (resp *ReadJobResponse, err error) {
+	return c.ReadJobWithContext(context.Background(), req)
+}
+
+// ReadJobWithContext is like ReadJob, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) ReadJobWithContext(ctx context.Context, req *ReadJobRequest) (resp *ReadJobResponse, err error) {
+	resp = &ReadJobResponse{}
+
+	httpReq, err := c.newReadJobRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ReadJob", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -533,27 +971,29 @@ func (c *ElasticTranscoder) ListPresets(req *ListPresetsRequest) (resp *ListPres
 	return
 }
 
-// ReadJob the ReadJob operation returns detailed information about a job.
-func (c *ElasticTranscoder) ReadJob(req *ReadJobRequest) (resp *ReadJobResponse, err error) {
-	resp = &ReadJobResponse{}
-
+// newReadJobRequest builds the HTTP request for ReadJob.
+func (c *ElasticTranscoder) newReadJobRequest(ctx context.Context, req *ReadJobRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/jobs/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -562,7 +1002,27 @@ func (c *ElasticTranscoder) ReadJob(req *ReadJobRequest) (resp *ReadJobResponse,
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ReadPipeline the ReadPipeline operation gets detailed information about
+// a pipeline.
+func (c *ElasticTranscoder) ReadPipeline(req *ReadPipelineRequest) (resp *ReadPipelineResponse, err error) {
+	return c.ReadPipelineWithContext(context.Background(), req)
+}
+
+// ReadPipelineWithContext is like ReadPipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) ReadPipelineWithContext(ctx context.Context, req *ReadPipelineRequest) (resp *ReadPipelineResponse, err error) {
+	resp = &ReadPipelineResponse{}
+
+	httpReq, err := c.newReadPipelineRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ReadPipeline", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -577,28 +1037,29 @@ func (c *ElasticTranscoder) ReadJob(req *ReadJobRequest) (resp *ReadJobResponse,
 	return
 }
 
-// ReadPipeline the ReadPipeline operation gets detailed information about
-// a pipeline.
-func (c *ElasticTranscoder) ReadPipeline(req *ReadPipelineRequest) (resp *ReadPipelineResponse, err error) {
-	resp = &ReadPipelineResponse{}
-
+// newReadPipelineRequest builds the HTTP request for ReadPipeline.
+func (c *ElasticTranscoder) newReadPipelineRequest(ctx context.Context, req *ReadPipelineRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/pipelines/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -607,7 +1068,27 @@ func (c *ElasticTranscoder) ReadPipeline(req *ReadPipelineRequest) (resp *ReadPi
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ReadPreset the ReadPreset operation gets detailed information about a
+// preset.
+func (c *ElasticTranscoder) ReadPreset(req *ReadPresetRequest) (resp *ReadPresetResponse, err error) {
+	return c.ReadPresetWithContext(context.Background(), req)
+}
+
+// ReadPresetWithContext is like ReadPreset, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) ReadPresetWithContext(ctx context.Context, req *ReadPresetRequest) (resp *ReadPresetResponse, err error) {
+	resp = &ReadPresetResponse{}
+
+	httpReq, err := c.newReadPresetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ReadPreset", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -622,28 +1103,29 @@ func (c *ElasticTranscoder) ReadPipeline(req *ReadPipelineRequest) (resp *ReadPi
 	return
 }
 
-// ReadPreset the ReadPreset operation gets detailed information about a
-// preset.
-func (c *ElasticTranscoder) ReadPreset(req *ReadPresetRequest) (resp *ReadPresetResponse, err error) {
-	resp = &ReadPresetResponse{}
-
+// newReadPresetRequest builds the HTTP request for ReadPreset.
+func (c *ElasticTranscoder) newReadPresetRequest(ctx context.Context, req *ReadPresetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/presets/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -652,7 +1134,32 @@ func (c *ElasticTranscoder) ReadPreset(req *ReadPresetRequest) (resp *ReadPreset
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// TestRole the TestRole operation tests the IAM role used to create the
+// pipeline. The TestRole action lets you determine whether the IAM role
+// you are using has sufficient permissions to let Elastic Transcoder
+// perform tasks associated with the transcoding process. The action
+// attempts to assume the specified IAM role, checks read access to the
+// input and output buckets, and tries to send a test notification to
+// Amazon SNS topics that you specify.
+func (c *ElasticTranscoder) TestRole(req *TestRoleRequest) (resp *TestRoleResponse, err error) {
+	return c.TestRoleWithContext(context.Background(), req)
+}
+
+// TestRoleWithContext is like TestRole, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) TestRoleWithContext(ctx context.Context, req *TestRoleRequest) (resp *TestRoleResponse, err error) {
+	resp = &TestRoleResponse{}
+
+	httpReq, err := c.newTestRoleRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("TestRole", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -667,16 +1174,8 @@ func (c *ElasticTranscoder) ReadPreset(req *ReadPresetRequest) (resp *ReadPreset
 	return
 }
 
-// TestRole the TestRole operation tests the IAM role used to create the
-// pipeline. The TestRole action lets you determine whether the IAM role
-// you are using has sufficient permissions to let Elastic Transcoder
-// perform tasks associated with the transcoding process. The action
-// attempts to assume the specified IAM role, checks read access to the
-// input and output buckets, and tries to send a test notification to
-// Amazon SNS topics that you specify.
-func (c *ElasticTranscoder) TestRole(req *TestRoleRequest) (resp *TestRoleResponse, err error) {
-	resp = &TestRoleResponse{}
-
+// newTestRoleRequest builds the HTTP request for TestRole.
+func (c *ElasticTranscoder) newTestRoleRequest(ctx context.Context, req *TestRoleRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -685,10 +1184,14 @@ func (c *ElasticTranscoder) TestRole(req *TestRoleRequest) (resp *TestRoleRespon
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -697,7 +1200,30 @@ func (c *ElasticTranscoder) TestRole(req *TestRoleRequest) (resp *TestRoleRespon
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdatePipeline use the UpdatePipeline operation to update settings for a
+// pipeline. When you change pipeline settings, your changes take effect
+// immediately. Jobs that you have already submitted and that Elastic
+// Transcoder has not started to process are affected in addition to jobs
+// that you submit after you change settings.
+func (c *ElasticTranscoder) UpdatePipeline(req *UpdatePipelineRequest) (resp *UpdatePipelineResponse, err error) {
+	return c.UpdatePipelineWithContext(context.Background(), req)
+}
+
+// UpdatePipelineWithContext is like UpdatePipeline, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) UpdatePipelineWithContext(ctx context.Context, req *UpdatePipelineRequest) (resp *UpdatePipelineResponse, err error) {
+	resp = &UpdatePipelineResponse{}
+
+	httpReq, err := c.newUpdatePipelineRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdatePipeline", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -712,31 +1238,29 @@ func (c *ElasticTranscoder) TestRole(req *TestRoleRequest) (resp *TestRoleRespon
 	return
 }
 
-// UpdatePipeline use the UpdatePipeline operation to update settings for a
-// pipeline. When you change pipeline settings, your changes take effect
-// immediately. Jobs that you have already submitted and that Elastic
-// Transcoder has not started to process are affected in addition to jobs
-// that you submit after you change settings.
-func (c *ElasticTranscoder) UpdatePipeline(req *UpdatePipelineRequest) (resp *UpdatePipelineResponse, err error) {
-	resp = &UpdatePipelineResponse{}
-
+// newUpdatePipelineRequest builds the HTTP request for UpdatePipeline.
+func (c *ElasticTranscoder) newUpdatePipelineRequest(ctx context.Context, req *UpdatePipelineRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/pipelines/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -745,7 +1269,30 @@ func (c *ElasticTranscoder) UpdatePipeline(req *UpdatePipelineRequest) (resp *Up
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdatePipelineNotifications with the UpdatePipelineNotifications
+// operation, you can update Amazon Simple Notification Service (Amazon
+// notifications for a pipeline. When you update notifications for a
+// pipeline, Elastic Transcoder returns the values that you specified in
+// the request.
+func (c *ElasticTranscoder) UpdatePipelineNotifications(req *UpdatePipelineNotificationsRequest) (resp *UpdatePipelineNotificationsResponse, err error) {
+	return c.UpdatePipelineNotificationsWithContext(context.Background(), req)
+}
+
+// UpdatePipelineNotificationsWithContext is like UpdatePipelineNotifications, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) UpdatePipelineNotificationsWithContext(ctx context.Context, req *UpdatePipelineNotificationsRequest) (resp *UpdatePipelineNotificationsResponse, err error) {
+	resp = &UpdatePipelineNotificationsResponse{}
+
+	httpReq, err := c.newUpdatePipelineNotificationsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdatePipelineNotifications", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -760,31 +1307,29 @@ func (c *ElasticTranscoder) UpdatePipeline(req *UpdatePipelineRequest) (resp *Up
 	return
 }
 
-// UpdatePipelineNotifications with the UpdatePipelineNotifications
-// operation, you can update Amazon Simple Notification Service (Amazon
-// notifications for a pipeline. When you update notifications for a
-// pipeline, Elastic Transcoder returns the values that you specified in
-// the request.
-func (c *ElasticTranscoder) UpdatePipelineNotifications(req *UpdatePipelineNotificationsRequest) (resp *UpdatePipelineNotificationsResponse, err error) {
-	resp = &UpdatePipelineNotificationsResponse{}
-
+// newUpdatePipelineNotificationsRequest builds the HTTP request for UpdatePipelineNotifications.
+func (c *ElasticTranscoder) newUpdatePipelineNotificationsRequest(ctx context.Context, req *UpdatePipelineNotificationsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/pipelines/{Id}/notifications"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -793,7 +1338,32 @@ func (c *ElasticTranscoder) UpdatePipelineNotifications(req *UpdatePipelineNotif
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdatePipelineStatus the UpdatePipelineStatus operation pauses or
+// reactivates a pipeline, so that the pipeline stops or restarts the
+// processing of jobs. Changing the pipeline status is useful if you want
+// to cancel one or more jobs. You can't cancel jobs after Elastic
+// Transcoder has started processing them; if you pause the pipeline to
+// which you submitted the jobs, you have more time to get the job IDs for
+// the jobs that you want to cancel, and to send a CancelJob request.
+func (c *ElasticTranscoder) UpdatePipelineStatus(req *UpdatePipelineStatusRequest) (resp *UpdatePipelineStatusResponse, err error) {
+	return c.UpdatePipelineStatusWithContext(context.Background(), req)
+}
+
+// UpdatePipelineStatusWithContext is like UpdatePipelineStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ElasticTranscoder) UpdatePipelineStatusWithContext(ctx context.Context, req *UpdatePipelineStatusRequest) (resp *UpdatePipelineStatusResponse, err error) {
+	resp = &UpdatePipelineStatusResponse{}
+
+	httpReq, err := c.newUpdatePipelineStatusRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdatePipelineStatus", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -808,33 +1378,29 @@ func (c *ElasticTranscoder) UpdatePipelineNotifications(req *UpdatePipelineNotif
 	return
 }
 
-// UpdatePipelineStatus the UpdatePipelineStatus operation pauses or
-// reactivates a pipeline, so that the pipeline stops or restarts the
-// processing of jobs. Changing the pipeline status is useful if you want
-// to cancel one or more jobs. You can't cancel jobs after Elastic
-// Transcoder has started processing them; if you pause the pipeline to
-// which you submitted the jobs, you have more time to get the job IDs for
-// the jobs that you want to cancel, and to send a CancelJob request.
-func (c *ElasticTranscoder) UpdatePipelineStatus(req *UpdatePipelineStatusRequest) (resp *UpdatePipelineStatusResponse, err error) {
-	resp = &UpdatePipelineStatusResponse{}
-
+// newUpdatePipelineStatusRequest builds the HTTP request for UpdatePipelineStatus.
+func (c *ElasticTranscoder) newUpdatePipelineStatusRequest(ctx context.Context, req *UpdatePipelineStatusRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2012-09-25/pipelines/{Id}/status"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -843,18 +1409,6 @@ func (c *ElasticTranscoder) UpdatePipelineStatus(req *UpdatePipelineStatusReques
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -1319,6 +1873,52 @@ type VideoParameters struct {
 	Watermarks         []PresetWatermark `json:"Watermarks,omitempty"`
 }
 
+// AccessDeniedException is the error returned for the AccessDeniedException error code.
+type AccessDeniedException struct {
+	aws.APIError
+}
+
+// IncompatibleVersionException is the error returned for the IncompatibleVersionException error code.
+type IncompatibleVersionException struct {
+	aws.APIError
+}
+
+// InternalServiceException is the error returned for the InternalServiceException error code.
+type InternalServiceException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// ResourceInUseException is the error returned for the ResourceInUseException error code.
+type ResourceInUseException struct {
+	aws.APIError
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// ValidationException is the error returned for the ValidationException error code.
+type ValidationException struct {
+	aws.APIError
+}
+
+// exceptions maps ElasticTranscoder's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AccessDeniedException":        AccessDeniedException{},
+	"IncompatibleVersionException": IncompatibleVersionException{},
+	"InternalServiceException":     InternalServiceException{},
+	"LimitExceededException":       LimitExceededException{},
+	"ResourceInUseException":       ResourceInUseException{},
+	"ResourceNotFoundException":    ResourceNotFoundException{},
+	"ValidationException":          ValidationException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
 
@@ -1328,4 +1928,5 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
 var _ json.RawMessage
@@ -4,11 +4,21 @@
 package elb
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // ELB is a client for Elastic Load Balancing.
@@ -16,29 +26,70 @@ type ELB struct {
 	client *aws.QueryClient
 }
 
-// New returns a new ELB client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *ELB {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "elasticloadbalancing"
-	endpoint, service, region := endpoints.Lookup("elasticloadbalancing", region)
+// New returns a new ELB client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *ELB {
+	cfg := newConfig(region, client, opts)
 
 	return &ELB{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2012-06-01",
 		},
 	}
 }
 
+// NewFromSession returns a new ELB client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *ELB {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("elasticloadbalancing", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("elasticloadbalancing", "elb"),
+	}, "AWS_ENDPOINT_URL_ELASTIC_LOAD_BALANCING", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *ELB) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *ELB) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *ELB) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AddTags adds one or more tags for the specified load balancer. Each load
 // balancer can have a maximum of 10 tags. Each tag consists of a key and
 // an optional value. Tag keys must be unique for each load balancer. If a
@@ -46,8 +97,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *ELB
 // action will update the value of the key. For more information, see
 // Tagging in the Elastic Load Balancing Developer Guide
 func (c *ELB) AddTags(req *AddTagsInput) (resp *AddTagsResult, err error) {
+	return c.AddTagsWithContext(context.Background(), req)
+}
+
+// AddTagsWithContext is like AddTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) AddTagsWithContext(ctx context.Context, req *AddTagsInput) (resp *AddTagsResult, err error) {
 	resp = &AddTagsResult{}
-	err = c.client.Do("AddTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddTags", "POST", "/", req, resp)
 	return
 }
 
@@ -57,8 +115,15 @@ func (c *ELB) AddTags(req *AddTagsInput) (resp *AddTagsResult, err error) {
 // groups. For more information, see Manage Security Groups in Amazon in
 // the Elastic Load Balancing Developer Guide
 func (c *ELB) ApplySecurityGroupsToLoadBalancer(req *ApplySecurityGroupsToLoadBalancerInput) (resp *ApplySecurityGroupsToLoadBalancerResult, err error) {
+	return c.ApplySecurityGroupsToLoadBalancerWithContext(context.Background(), req)
+}
+
+// ApplySecurityGroupsToLoadBalancerWithContext is like ApplySecurityGroupsToLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) ApplySecurityGroupsToLoadBalancerWithContext(ctx context.Context, req *ApplySecurityGroupsToLoadBalancerInput) (resp *ApplySecurityGroupsToLoadBalancerResult, err error) {
 	resp = &ApplySecurityGroupsToLoadBalancerResult{}
-	err = c.client.Do("ApplySecurityGroupsToLoadBalancer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ApplySecurityGroupsToLoadBalancer", "POST", "/", req, resp)
 	return
 }
 
@@ -68,8 +133,15 @@ func (c *ELB) ApplySecurityGroupsToLoadBalancer(req *ApplySecurityGroupsToLoadBa
 // of the registered subnets. For more information, see Deploy Elastic Load
 // Balancing in Amazon in the Elastic Load Balancing Developer Guide .
 func (c *ELB) AttachLoadBalancerToSubnets(req *AttachLoadBalancerToSubnetsInput) (resp *AttachLoadBalancerToSubnetsResult, err error) {
+	return c.AttachLoadBalancerToSubnetsWithContext(context.Background(), req)
+}
+
+// AttachLoadBalancerToSubnetsWithContext is like AttachLoadBalancerToSubnets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) AttachLoadBalancerToSubnetsWithContext(ctx context.Context, req *AttachLoadBalancerToSubnetsInput) (resp *AttachLoadBalancerToSubnetsResult, err error) {
 	resp = &AttachLoadBalancerToSubnetsResult{}
-	err = c.client.Do("AttachLoadBalancerToSubnets", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AttachLoadBalancerToSubnets", "POST", "/", req, resp)
 	return
 }
 
@@ -78,8 +150,15 @@ func (c *ELB) AttachLoadBalancerToSubnets(req *AttachLoadBalancerToSubnetsInput)
 // information, see Health Check in the Elastic Load Balancing Developer
 // Guide
 func (c *ELB) ConfigureHealthCheck(req *ConfigureHealthCheckInput) (resp *ConfigureHealthCheckResult, err error) {
+	return c.ConfigureHealthCheckWithContext(context.Background(), req)
+}
+
+// ConfigureHealthCheckWithContext is like ConfigureHealthCheck, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) ConfigureHealthCheckWithContext(ctx context.Context, req *ConfigureHealthCheckInput) (resp *ConfigureHealthCheckResult, err error) {
 	resp = &ConfigureHealthCheckResult{}
-	err = c.client.Do("ConfigureHealthCheck", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ConfigureHealthCheck", "POST", "/", req, resp)
 	return
 }
 
@@ -99,8 +178,15 @@ func (c *ELB) ConfigureHealthCheck(req *ConfigureHealthCheckInput) (resp *Config
 // browsers. For more information, see Enabling Application-Controlled
 // Session Stickiness in the Elastic Load Balancing Developer Guide
 func (c *ELB) CreateAppCookieStickinessPolicy(req *CreateAppCookieStickinessPolicyInput) (resp *CreateAppCookieStickinessPolicyResult, err error) {
+	return c.CreateAppCookieStickinessPolicyWithContext(context.Background(), req)
+}
+
+// CreateAppCookieStickinessPolicyWithContext is like CreateAppCookieStickinessPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) CreateAppCookieStickinessPolicyWithContext(ctx context.Context, req *CreateAppCookieStickinessPolicyInput) (resp *CreateAppCookieStickinessPolicyResult, err error) {
 	resp = &CreateAppCookieStickinessPolicyResult{}
-	err = c.client.Do("CreateAppCookieStickinessPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateAppCookieStickinessPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -120,8 +206,15 @@ func (c *ELB) CreateAppCookieStickinessPolicy(req *CreateAppCookieStickinessPoli
 // more information, see Enabling Duration-Based Session Stickiness in the
 // Elastic Load Balancing Developer Guide
 func (c *ELB) CreateLBCookieStickinessPolicy(req *CreateLBCookieStickinessPolicyInput) (resp *CreateLBCookieStickinessPolicyResult, err error) {
+	return c.CreateLBCookieStickinessPolicyWithContext(context.Background(), req)
+}
+
+// CreateLBCookieStickinessPolicyWithContext is like CreateLBCookieStickinessPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) CreateLBCookieStickinessPolicyWithContext(ctx context.Context, req *CreateLBCookieStickinessPolicyInput) (resp *CreateLBCookieStickinessPolicyResult, err error) {
 	resp = &CreateLBCookieStickinessPolicyResult{}
-	err = c.client.Do("CreateLBCookieStickinessPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateLBCookieStickinessPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -141,8 +234,15 @@ func (c *ELB) CreateLBCookieStickinessPolicy(req *CreateLBCookieStickinessPolicy
 // Amazon EC2-Classic For information on creating and managing your load
 // balancers in EC2-VPC, see Deploy Elastic Load Balancing in Amazon
 func (c *ELB) CreateLoadBalancer(req *CreateAccessPointInput) (resp *CreateLoadBalancerResult, err error) {
+	return c.CreateLoadBalancerWithContext(context.Background(), req)
+}
+
+// CreateLoadBalancerWithContext is like CreateLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) CreateLoadBalancerWithContext(ctx context.Context, req *CreateAccessPointInput) (resp *CreateLoadBalancerResult, err error) {
 	resp = &CreateLoadBalancerResult{}
-	err = c.client.Do("CreateLoadBalancer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateLoadBalancer", "POST", "/", req, resp)
 	return
 }
 
@@ -153,8 +253,15 @@ func (c *ELB) CreateLoadBalancer(req *CreateAccessPointInput) (resp *CreateLoadB
 // more information, see Add a Listener to Your Load Balancer in the
 // Elastic Load Balancing Developer Guide
 func (c *ELB) CreateLoadBalancerListeners(req *CreateLoadBalancerListenerInput) (resp *CreateLoadBalancerListenersResult, err error) {
+	return c.CreateLoadBalancerListenersWithContext(context.Background(), req)
+}
+
+// CreateLoadBalancerListenersWithContext is like CreateLoadBalancerListeners, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) CreateLoadBalancerListenersWithContext(ctx context.Context, req *CreateLoadBalancerListenerInput) (resp *CreateLoadBalancerListenersResult, err error) {
 	resp = &CreateLoadBalancerListenersResult{}
-	err = c.client.Do("CreateLoadBalancerListeners", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateLoadBalancerListeners", "POST", "/", req, resp)
 	return
 }
 
@@ -164,8 +271,15 @@ func (c *ELB) CreateLoadBalancerListeners(req *CreateLoadBalancerListenerInput)
 // front-end listener, or the back-end application server, depending on
 // your policy type.
 func (c *ELB) CreateLoadBalancerPolicy(req *CreateLoadBalancerPolicyInput) (resp *CreateLoadBalancerPolicyResult, err error) {
+	return c.CreateLoadBalancerPolicyWithContext(context.Background(), req)
+}
+
+// CreateLoadBalancerPolicyWithContext is like CreateLoadBalancerPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) CreateLoadBalancerPolicyWithContext(ctx context.Context, req *CreateLoadBalancerPolicyInput) (resp *CreateLoadBalancerPolicyResult, err error) {
 	resp = &CreateLoadBalancerPolicyResult{}
-	err = c.client.Do("CreateLoadBalancerPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateLoadBalancerPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -180,24 +294,45 @@ func (c *ELB) CreateLoadBalancerPolicy(req *CreateLoadBalancerPolicyInput) (resp
 // or has already been deleted, a call to DeleteLoadBalancer action still
 // succeeds.
 func (c *ELB) DeleteLoadBalancer(req *DeleteAccessPointInput) (resp *DeleteLoadBalancerResult, err error) {
+	return c.DeleteLoadBalancerWithContext(context.Background(), req)
+}
+
+// DeleteLoadBalancerWithContext is like DeleteLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DeleteLoadBalancerWithContext(ctx context.Context, req *DeleteAccessPointInput) (resp *DeleteLoadBalancerResult, err error) {
 	resp = &DeleteLoadBalancerResult{}
-	err = c.client.Do("DeleteLoadBalancer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteLoadBalancer", "POST", "/", req, resp)
 	return
 }
 
 // DeleteLoadBalancerListeners deletes listeners from the load balancer for
 // the specified port.
 func (c *ELB) DeleteLoadBalancerListeners(req *DeleteLoadBalancerListenerInput) (resp *DeleteLoadBalancerListenersResult, err error) {
+	return c.DeleteLoadBalancerListenersWithContext(context.Background(), req)
+}
+
+// DeleteLoadBalancerListenersWithContext is like DeleteLoadBalancerListeners, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DeleteLoadBalancerListenersWithContext(ctx context.Context, req *DeleteLoadBalancerListenerInput) (resp *DeleteLoadBalancerListenersResult, err error) {
 	resp = &DeleteLoadBalancerListenersResult{}
-	err = c.client.Do("DeleteLoadBalancerListeners", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteLoadBalancerListeners", "POST", "/", req, resp)
 	return
 }
 
 // DeleteLoadBalancerPolicy deletes a policy from the load balancer. The
 // specified policy must not be enabled for any listeners.
 func (c *ELB) DeleteLoadBalancerPolicy(req *DeleteLoadBalancerPolicyInput) (resp *DeleteLoadBalancerPolicyResult, err error) {
+	return c.DeleteLoadBalancerPolicyWithContext(context.Background(), req)
+}
+
+// DeleteLoadBalancerPolicyWithContext is like DeleteLoadBalancerPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DeleteLoadBalancerPolicyWithContext(ctx context.Context, req *DeleteLoadBalancerPolicyInput) (resp *DeleteLoadBalancerPolicyResult, err error) {
 	resp = &DeleteLoadBalancerPolicyResult{}
-	err = c.client.Do("DeleteLoadBalancerPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteLoadBalancerPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -210,8 +345,15 @@ func (c *ELB) DeleteLoadBalancerPolicy(req *DeleteLoadBalancerPolicyInput) (resp
 // DescribeLoadBalancers to verify if the instance is deregistered from the
 // load balancer.
 func (c *ELB) DeregisterInstancesFromLoadBalancer(req *DeregisterEndPointsInput) (resp *DeregisterInstancesFromLoadBalancerResult, err error) {
+	return c.DeregisterInstancesFromLoadBalancerWithContext(context.Background(), req)
+}
+
+// DeregisterInstancesFromLoadBalancerWithContext is like DeregisterInstancesFromLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DeregisterInstancesFromLoadBalancerWithContext(ctx context.Context, req *DeregisterEndPointsInput) (resp *DeregisterInstancesFromLoadBalancerResult, err error) {
 	resp = &DeregisterInstancesFromLoadBalancerResult{}
-	err = c.client.Do("DeregisterInstancesFromLoadBalancer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeregisterInstancesFromLoadBalancer", "POST", "/", req, resp)
 	return
 }
 
@@ -221,16 +363,30 @@ func (c *ELB) DeregisterInstancesFromLoadBalancer(req *DeregisterEndPointsInput)
 // balancer is returned. You must provide the same account credentials as
 // those that were used to create the load balancer.
 func (c *ELB) DescribeInstanceHealth(req *DescribeEndPointStateInput) (resp *DescribeInstanceHealthResult, err error) {
+	return c.DescribeInstanceHealthWithContext(context.Background(), req)
+}
+
+// DescribeInstanceHealthWithContext is like DescribeInstanceHealth, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DescribeInstanceHealthWithContext(ctx context.Context, req *DescribeEndPointStateInput) (resp *DescribeInstanceHealthResult, err error) {
 	resp = &DescribeInstanceHealthResult{}
-	err = c.client.Do("DescribeInstanceHealth", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeInstanceHealth", "POST", "/", req, resp)
 	return
 }
 
 // DescribeLoadBalancerAttributes returns detailed information about all of
 // the attributes associated with the specified load balancer.
 func (c *ELB) DescribeLoadBalancerAttributes(req *DescribeLoadBalancerAttributesInput) (resp *DescribeLoadBalancerAttributesResult, err error) {
+	return c.DescribeLoadBalancerAttributesWithContext(context.Background(), req)
+}
+
+// DescribeLoadBalancerAttributesWithContext is like DescribeLoadBalancerAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DescribeLoadBalancerAttributesWithContext(ctx context.Context, req *DescribeLoadBalancerAttributesInput) (resp *DescribeLoadBalancerAttributesResult, err error) {
 	resp = &DescribeLoadBalancerAttributesResult{}
-	err = c.client.Do("DescribeLoadBalancerAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLoadBalancerAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -243,8 +399,15 @@ func (c *ELB) DescribeLoadBalancerAttributes(req *DescribeLoadBalancerAttributes
 // policies, or descriptions of all the sample policies. The names of the
 // sample policies have the ELBSample- prefix.
 func (c *ELB) DescribeLoadBalancerPolicies(req *DescribeLoadBalancerPoliciesInput) (resp *DescribeLoadBalancerPoliciesResult, err error) {
+	return c.DescribeLoadBalancerPoliciesWithContext(context.Background(), req)
+}
+
+// DescribeLoadBalancerPoliciesWithContext is like DescribeLoadBalancerPolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DescribeLoadBalancerPoliciesWithContext(ctx context.Context, req *DescribeLoadBalancerPoliciesInput) (resp *DescribeLoadBalancerPoliciesResult, err error) {
 	resp = &DescribeLoadBalancerPoliciesResult{}
-	err = c.client.Do("DescribeLoadBalancerPolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLoadBalancerPolicies", "POST", "/", req, resp)
 	return
 }
 
@@ -254,8 +417,15 @@ func (c *ELB) DescribeLoadBalancerPolicies(req *DescribeLoadBalancerPoliciesInpu
 // in a CreateLoadBalancerPolicy action to instantiate specific policy
 // configurations that will be applied to a load balancer.
 func (c *ELB) DescribeLoadBalancerPolicyTypes(req *DescribeLoadBalancerPolicyTypesInput) (resp *DescribeLoadBalancerPolicyTypesResult, err error) {
+	return c.DescribeLoadBalancerPolicyTypesWithContext(context.Background(), req)
+}
+
+// DescribeLoadBalancerPolicyTypesWithContext is like DescribeLoadBalancerPolicyTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DescribeLoadBalancerPolicyTypesWithContext(ctx context.Context, req *DescribeLoadBalancerPolicyTypesInput) (resp *DescribeLoadBalancerPolicyTypesResult, err error) {
 	resp = &DescribeLoadBalancerPolicyTypesResult{}
-	err = c.client.Do("DescribeLoadBalancerPolicyTypes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLoadBalancerPolicyTypes", "POST", "/", req, resp)
 	return
 }
 
@@ -265,16 +435,30 @@ func (c *ELB) DescribeLoadBalancerPolicyTypes(req *DescribeLoadBalancerPolicyTyp
 // load balancers. In order to retrieve this information, you must provide
 // the same account credentials that was used to create the load balancer.
 func (c *ELB) DescribeLoadBalancers(req *DescribeAccessPointsInput) (resp *DescribeLoadBalancersResult, err error) {
+	return c.DescribeLoadBalancersWithContext(context.Background(), req)
+}
+
+// DescribeLoadBalancersWithContext is like DescribeLoadBalancers, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DescribeLoadBalancersWithContext(ctx context.Context, req *DescribeAccessPointsInput) (resp *DescribeLoadBalancersResult, err error) {
 	resp = &DescribeLoadBalancersResult{}
-	err = c.client.Do("DescribeLoadBalancers", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLoadBalancers", "POST", "/", req, resp)
 	return
 }
 
 // DescribeTags describes the tags associated with one or more load
 // balancers.
 func (c *ELB) DescribeTags(req *DescribeTagsInput) (resp *DescribeTagsResult, err error) {
+	return c.DescribeTagsWithContext(context.Background(), req)
+}
+
+// DescribeTagsWithContext is like DescribeTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DescribeTagsWithContext(ctx context.Context, req *DescribeTagsInput) (resp *DescribeTagsResult, err error) {
 	resp = &DescribeTagsResult{}
-	err = c.client.Do("DescribeTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTags", "POST", "/", req, resp)
 	return
 }
 
@@ -286,8 +470,15 @@ func (c *ELB) DescribeTags(req *DescribeTagsInput) (resp *DescribeTagsResult, er
 // balance the traffic among the remaining routable subnets for the load
 // balancer.
 func (c *ELB) DetachLoadBalancerFromSubnets(req *DetachLoadBalancerFromSubnetsInput) (resp *DetachLoadBalancerFromSubnetsResult, err error) {
+	return c.DetachLoadBalancerFromSubnetsWithContext(context.Background(), req)
+}
+
+// DetachLoadBalancerFromSubnetsWithContext is like DetachLoadBalancerFromSubnets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DetachLoadBalancerFromSubnetsWithContext(ctx context.Context, req *DetachLoadBalancerFromSubnetsInput) (resp *DetachLoadBalancerFromSubnetsResult, err error) {
 	resp = &DetachLoadBalancerFromSubnetsResult{}
-	err = c.client.Do("DetachLoadBalancerFromSubnets", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DetachLoadBalancerFromSubnets", "POST", "/", req, resp)
 	return
 }
 
@@ -304,8 +495,15 @@ func (c *ELB) DetachLoadBalancerFromSubnets(req *DetachLoadBalancerFromSubnetsIn
 // Zone from a Load-Balanced Application in the Elastic Load Balancing
 // Developer Guide
 func (c *ELB) DisableAvailabilityZonesForLoadBalancer(req *RemoveAvailabilityZonesInput) (resp *DisableAvailabilityZonesForLoadBalancerResult, err error) {
+	return c.DisableAvailabilityZonesForLoadBalancerWithContext(context.Background(), req)
+}
+
+// DisableAvailabilityZonesForLoadBalancerWithContext is like DisableAvailabilityZonesForLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) DisableAvailabilityZonesForLoadBalancerWithContext(ctx context.Context, req *RemoveAvailabilityZonesInput) (resp *DisableAvailabilityZonesForLoadBalancerResult, err error) {
 	resp = &DisableAvailabilityZonesForLoadBalancerResult{}
-	err = c.client.Do("DisableAvailabilityZonesForLoadBalancer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DisableAvailabilityZonesForLoadBalancer", "POST", "/", req, resp)
 	return
 }
 
@@ -318,8 +516,15 @@ func (c *ELB) DisableAvailabilityZonesForLoadBalancer(req *RemoveAvailabilityZon
 // Application to an Additional Availability Zone in the Elastic Load
 // Balancing Developer Guide
 func (c *ELB) EnableAvailabilityZonesForLoadBalancer(req *AddAvailabilityZonesInput) (resp *EnableAvailabilityZonesForLoadBalancerResult, err error) {
+	return c.EnableAvailabilityZonesForLoadBalancerWithContext(context.Background(), req)
+}
+
+// EnableAvailabilityZonesForLoadBalancerWithContext is like EnableAvailabilityZonesForLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) EnableAvailabilityZonesForLoadBalancerWithContext(ctx context.Context, req *AddAvailabilityZonesInput) (resp *EnableAvailabilityZonesForLoadBalancerResult, err error) {
 	resp = &EnableAvailabilityZonesForLoadBalancerResult{}
-	err = c.client.Do("EnableAvailabilityZonesForLoadBalancer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EnableAvailabilityZonesForLoadBalancer", "POST", "/", req, resp)
 	return
 }
 
@@ -330,8 +535,15 @@ func (c *ELB) EnableAvailabilityZonesForLoadBalancer(req *AddAvailabilityZonesIn
 // attribute ConnectionSettings by specifying an idle connection timeout
 // value for your load balancer. For more information, see the following:
 func (c *ELB) ModifyLoadBalancerAttributes(req *ModifyLoadBalancerAttributesInput) (resp *ModifyLoadBalancerAttributesResult, err error) {
+	return c.ModifyLoadBalancerAttributesWithContext(context.Background(), req)
+}
+
+// ModifyLoadBalancerAttributesWithContext is like ModifyLoadBalancerAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) ModifyLoadBalancerAttributesWithContext(ctx context.Context, req *ModifyLoadBalancerAttributesInput) (resp *ModifyLoadBalancerAttributesResult, err error) {
 	resp = &ModifyLoadBalancerAttributesResult{}
-	err = c.client.Do("ModifyLoadBalancerAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyLoadBalancerAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -358,15 +570,29 @@ func (c *ELB) ModifyLoadBalancerAttributes(req *ModifyLoadBalancerAttributesInpu
 // DescribeLoadBalancers or DescribeInstanceHealth action to check the
 // state of the newly registered instances.
 func (c *ELB) RegisterInstancesWithLoadBalancer(req *RegisterEndPointsInput) (resp *RegisterInstancesWithLoadBalancerResult, err error) {
+	return c.RegisterInstancesWithLoadBalancerWithContext(context.Background(), req)
+}
+
+// RegisterInstancesWithLoadBalancerWithContext is like RegisterInstancesWithLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) RegisterInstancesWithLoadBalancerWithContext(ctx context.Context, req *RegisterEndPointsInput) (resp *RegisterInstancesWithLoadBalancerResult, err error) {
 	resp = &RegisterInstancesWithLoadBalancerResult{}
-	err = c.client.Do("RegisterInstancesWithLoadBalancer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RegisterInstancesWithLoadBalancer", "POST", "/", req, resp)
 	return
 }
 
 // RemoveTags removes one or more tags from the specified load balancer.
 func (c *ELB) RemoveTags(req *RemoveTagsInput) (resp *RemoveTagsResult, err error) {
+	return c.RemoveTagsWithContext(context.Background(), req)
+}
+
+// RemoveTagsWithContext is like RemoveTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) RemoveTagsWithContext(ctx context.Context, req *RemoveTagsInput) (resp *RemoveTagsResult, err error) {
 	resp = &RemoveTagsResult{}
-	err = c.client.Do("RemoveTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RemoveTags", "POST", "/", req, resp)
 	return
 }
 
@@ -377,8 +603,15 @@ func (c *ELB) RemoveTags(req *RemoveTagsInput) (resp *RemoveTagsResult, err erro
 // certificate, see Updating an SSL Certificate for a Load Balancer in the
 // Elastic Load Balancing Developer Guide
 func (c *ELB) SetLoadBalancerListenerSSLCertificate(req *SetLoadBalancerListenerSSLCertificateInput) (resp *SetLoadBalancerListenerSSLCertificateResult, err error) {
+	return c.SetLoadBalancerListenerSSLCertificateWithContext(context.Background(), req)
+}
+
+// SetLoadBalancerListenerSSLCertificateWithContext is like SetLoadBalancerListenerSSLCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) SetLoadBalancerListenerSSLCertificateWithContext(ctx context.Context, req *SetLoadBalancerListenerSSLCertificateInput) (resp *SetLoadBalancerListenerSSLCertificateResult, err error) {
 	resp = &SetLoadBalancerListenerSSLCertificateResult{}
-	err = c.client.Do("SetLoadBalancerListenerSSLCertificate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SetLoadBalancerListenerSSLCertificate", "POST", "/", req, resp)
 	return
 }
 
@@ -396,8 +629,15 @@ func (c *ELB) SetLoadBalancerListenerSSLCertificate(req *SetLoadBalancerListener
 // DescribeLoadBalancers or DescribeLoadBalancerPolicies action to verify
 // that the policy has been associated with the back-end server.
 func (c *ELB) SetLoadBalancerPoliciesForBackendServer(req *SetLoadBalancerPoliciesForBackendServerInput) (resp *SetLoadBalancerPoliciesForBackendServerResult, err error) {
+	return c.SetLoadBalancerPoliciesForBackendServerWithContext(context.Background(), req)
+}
+
+// SetLoadBalancerPoliciesForBackendServerWithContext is like SetLoadBalancerPoliciesForBackendServer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) SetLoadBalancerPoliciesForBackendServerWithContext(ctx context.Context, req *SetLoadBalancerPoliciesForBackendServerInput) (resp *SetLoadBalancerPoliciesForBackendServerResult, err error) {
 	resp = &SetLoadBalancerPoliciesForBackendServerResult{}
-	err = c.client.Do("SetLoadBalancerPoliciesForBackendServer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SetLoadBalancerPoliciesForBackendServer", "POST", "/", req, resp)
 	return
 }
 
@@ -405,8 +645,15 @@ func (c *ELB) SetLoadBalancerPoliciesForBackendServer(req *SetLoadBalancerPolici
 // policy with a listener on the load balancer. You can associate multiple
 // policies with a listener.
 func (c *ELB) SetLoadBalancerPoliciesOfListener(req *SetLoadBalancerPoliciesOfListenerInput) (resp *SetLoadBalancerPoliciesOfListenerResult, err error) {
+	return c.SetLoadBalancerPoliciesOfListenerWithContext(context.Background(), req)
+}
+
+// SetLoadBalancerPoliciesOfListenerWithContext is like SetLoadBalancerPoliciesOfListener, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ELB) SetLoadBalancerPoliciesOfListenerWithContext(ctx context.Context, req *SetLoadBalancerPoliciesOfListenerInput) (resp *SetLoadBalancerPoliciesOfListenerResult, err error) {
 	resp = &SetLoadBalancerPoliciesOfListenerResult{}
-	err = c.client.Do("SetLoadBalancerPoliciesOfListener", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SetLoadBalancerPoliciesOfListener", "POST", "/", req, resp)
 	return
 }
 
@@ -418,21 +665,89 @@ type AccessLog struct {
 	S3BucketPrefix aws.StringValue  `query:"S3BucketPrefix" xml:"S3BucketPrefix"`
 }
 
+// MarshalQuery adds the parameters of the AccessLog to the form, prefixing their names.
+func (v *AccessLog) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EmitInterval != nil {
+		form.Set(prefix+"EmitInterval", strconv.FormatInt(int64(*v.EmitInterval), 10))
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	if v.S3BucketName != nil {
+		form.Set(prefix+"S3BucketName", *v.S3BucketName)
+	}
+	if v.S3BucketPrefix != nil {
+		form.Set(prefix+"S3BucketPrefix", *v.S3BucketPrefix)
+	}
+	return nil
+}
+
 // AddAvailabilityZonesInput is undocumented.
 type AddAvailabilityZonesInput struct {
-	AvailabilityZones []string        `query:"AvailabilityZones.member" xml:"AvailabilityZones>member"`
+	AvailabilityZones []string        `query:"AvailabilityZones,list:member" xml:"AvailabilityZones>member"`
 	LoadBalancerName  aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the AddAvailabilityZonesInput to the form, prefixing their names.
+func (v *AddAvailabilityZonesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZones != nil {
+		if len(v.AvailabilityZones) == 0 {
+			form.Set(prefix+"AvailabilityZones", "")
+		}
+		for i1 := range v.AvailabilityZones {
+			name1 := prefix + "AvailabilityZones.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AvailabilityZones[i1])
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // AddAvailabilityZonesOutput is undocumented.
 type AddAvailabilityZonesOutput struct {
-	AvailabilityZones []string `query:"AvailabilityZones.member" xml:"EnableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
+	AvailabilityZones []string `query:"AvailabilityZones,list:member" xml:"EnableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
 }
 
 // AddTagsInput is undocumented.
 type AddTagsInput struct {
-	LoadBalancerNames []string `query:"LoadBalancerNames.member" xml:"LoadBalancerNames>member"`
-	Tags              []Tag    `query:"Tags.member" xml:"Tags>member"`
+	LoadBalancerNames []string `query:"LoadBalancerNames,list:member" xml:"LoadBalancerNames>member"`
+	Tags              []Tag    `query:"Tags,list:member" xml:"Tags>member"`
+}
+
+// MarshalQuery adds the parameters of the AddTagsInput to the form, prefixing their names.
+func (v *AddTagsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerNames != nil {
+		if len(v.LoadBalancerNames) == 0 {
+			form.Set(prefix+"LoadBalancerNames", "")
+		}
+		for i1 := range v.LoadBalancerNames {
+			name1 := prefix + "LoadBalancerNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.LoadBalancerNames[i1])
+		}
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // AddTagsOutput is undocumented.
@@ -445,6 +760,20 @@ type AdditionalAttribute struct {
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the AdditionalAttribute to the form, prefixing their names.
+func (v *AdditionalAttribute) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // AppCookieStickinessPolicy is undocumented.
 type AppCookieStickinessPolicy struct {
 	CookieName aws.StringValue `query:"CookieName" xml:"CookieName"`
@@ -454,29 +783,69 @@ type AppCookieStickinessPolicy struct {
 // ApplySecurityGroupsToLoadBalancerInput is undocumented.
 type ApplySecurityGroupsToLoadBalancerInput struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	SecurityGroups   []string        `query:"SecurityGroups.member" xml:"SecurityGroups>member"`
+	SecurityGroups   []string        `query:"SecurityGroups,list:member" xml:"SecurityGroups>member"`
+}
+
+// MarshalQuery adds the parameters of the ApplySecurityGroupsToLoadBalancerInput to the form, prefixing their names.
+func (v *ApplySecurityGroupsToLoadBalancerInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.SecurityGroups != nil {
+		if len(v.SecurityGroups) == 0 {
+			form.Set(prefix+"SecurityGroups", "")
+		}
+		for i1 := range v.SecurityGroups {
+			name1 := prefix + "SecurityGroups.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroups[i1])
+		}
+	}
+	return nil
 }
 
 // ApplySecurityGroupsToLoadBalancerOutput is undocumented.
 type ApplySecurityGroupsToLoadBalancerOutput struct {
-	SecurityGroups []string `query:"SecurityGroups.member" xml:"ApplySecurityGroupsToLoadBalancerResult>SecurityGroups>member"`
+	SecurityGroups []string `query:"SecurityGroups,list:member" xml:"ApplySecurityGroupsToLoadBalancerResult>SecurityGroups>member"`
 }
 
 // AttachLoadBalancerToSubnetsInput is undocumented.
 type AttachLoadBalancerToSubnetsInput struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	Subnets          []string        `query:"Subnets.member" xml:"Subnets>member"`
+	Subnets          []string        `query:"Subnets,list:member" xml:"Subnets>member"`
+}
+
+// MarshalQuery adds the parameters of the AttachLoadBalancerToSubnetsInput to the form, prefixing their names.
+func (v *AttachLoadBalancerToSubnetsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.Subnets != nil {
+		if len(v.Subnets) == 0 {
+			form.Set(prefix+"Subnets", "")
+		}
+		for i1 := range v.Subnets {
+			name1 := prefix + "Subnets.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Subnets[i1])
+		}
+	}
+	return nil
 }
 
 // AttachLoadBalancerToSubnetsOutput is undocumented.
 type AttachLoadBalancerToSubnetsOutput struct {
-	Subnets []string `query:"Subnets.member" xml:"AttachLoadBalancerToSubnetsResult>Subnets>member"`
+	Subnets []string `query:"Subnets,list:member" xml:"AttachLoadBalancerToSubnetsResult>Subnets>member"`
 }
 
 // BackendServerDescription is undocumented.
 type BackendServerDescription struct {
 	InstancePort aws.IntegerValue `query:"InstancePort" xml:"InstancePort"`
-	PolicyNames  []string         `query:"PolicyNames.member" xml:"PolicyNames>member"`
+	PolicyNames  []string         `query:"PolicyNames,list:member" xml:"PolicyNames>member"`
 }
 
 // ConfigureHealthCheckInput is undocumented.
@@ -485,6 +854,22 @@ type ConfigureHealthCheckInput struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the ConfigureHealthCheckInput to the form, prefixing their names.
+func (v *ConfigureHealthCheckInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HealthCheck != nil {
+		if err := v.HealthCheck.MarshalQuery(form, prefix+"HealthCheck."); err != nil {
+			return err
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // ConfigureHealthCheckOutput is undocumented.
 type ConfigureHealthCheckOutput struct {
 	HealthCheck *HealthCheck `query:"HealthCheck" xml:"ConfigureHealthCheckResult>HealthCheck"`
@@ -496,20 +881,108 @@ type ConnectionDraining struct {
 	Timeout aws.IntegerValue `query:"Timeout" xml:"Timeout"`
 }
 
+// MarshalQuery adds the parameters of the ConnectionDraining to the form, prefixing their names.
+func (v *ConnectionDraining) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	if v.Timeout != nil {
+		form.Set(prefix+"Timeout", strconv.FormatInt(int64(*v.Timeout), 10))
+	}
+	return nil
+}
+
 // ConnectionSettings is undocumented.
 type ConnectionSettings struct {
 	IdleTimeout aws.IntegerValue `query:"IdleTimeout" xml:"IdleTimeout"`
 }
 
+// MarshalQuery adds the parameters of the ConnectionSettings to the form, prefixing their names.
+func (v *ConnectionSettings) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.IdleTimeout != nil {
+		form.Set(prefix+"IdleTimeout", strconv.FormatInt(int64(*v.IdleTimeout), 10))
+	}
+	return nil
+}
+
 // CreateAccessPointInput is undocumented.
 type CreateAccessPointInput struct {
-	AvailabilityZones []string        `query:"AvailabilityZones.member" xml:"AvailabilityZones>member"`
-	Listeners         []Listener      `query:"Listeners.member" xml:"Listeners>member"`
+	AvailabilityZones []string        `query:"AvailabilityZones,list:member" xml:"AvailabilityZones>member"`
+	Listeners         []Listener      `query:"Listeners,list:member" xml:"Listeners>member"`
 	LoadBalancerName  aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 	Scheme            aws.StringValue `query:"Scheme" xml:"Scheme"`
-	SecurityGroups    []string        `query:"SecurityGroups.member" xml:"SecurityGroups>member"`
-	Subnets           []string        `query:"Subnets.member" xml:"Subnets>member"`
-	Tags              []Tag           `query:"Tags.member" xml:"Tags>member"`
+	SecurityGroups    []string        `query:"SecurityGroups,list:member" xml:"SecurityGroups>member"`
+	Subnets           []string        `query:"Subnets,list:member" xml:"Subnets>member"`
+	Tags              []Tag           `query:"Tags,list:member" xml:"Tags>member"`
+}
+
+// MarshalQuery adds the parameters of the CreateAccessPointInput to the form, prefixing their names.
+func (v *CreateAccessPointInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZones != nil {
+		if len(v.AvailabilityZones) == 0 {
+			form.Set(prefix+"AvailabilityZones", "")
+		}
+		for i1 := range v.AvailabilityZones {
+			name1 := prefix + "AvailabilityZones.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AvailabilityZones[i1])
+		}
+	}
+	if v.Listeners != nil {
+		if len(v.Listeners) == 0 {
+			form.Set(prefix+"Listeners", "")
+		}
+		for i1 := range v.Listeners {
+			name1 := prefix + "Listeners.member." + strconv.Itoa(i1+1)
+			if err := v.Listeners[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.Scheme != nil {
+		form.Set(prefix+"Scheme", *v.Scheme)
+	}
+	if v.SecurityGroups != nil {
+		if len(v.SecurityGroups) == 0 {
+			form.Set(prefix+"SecurityGroups", "")
+		}
+		for i1 := range v.SecurityGroups {
+			name1 := prefix + "SecurityGroups.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SecurityGroups[i1])
+		}
+	}
+	if v.Subnets != nil {
+		if len(v.Subnets) == 0 {
+			form.Set(prefix+"Subnets", "")
+		}
+		for i1 := range v.Subnets {
+			name1 := prefix + "Subnets.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Subnets[i1])
+		}
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateAccessPointOutput is undocumented.
@@ -524,6 +997,23 @@ type CreateAppCookieStickinessPolicyInput struct {
 	PolicyName       aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the CreateAppCookieStickinessPolicyInput to the form, prefixing their names.
+func (v *CreateAppCookieStickinessPolicyInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CookieName != nil {
+		form.Set(prefix+"CookieName", *v.CookieName)
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // CreateAppCookieStickinessPolicyOutput is undocumented.
 type CreateAppCookieStickinessPolicyOutput struct {
 }
@@ -535,16 +1025,55 @@ type CreateLBCookieStickinessPolicyInput struct {
 	PolicyName             aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the CreateLBCookieStickinessPolicyInput to the form, prefixing their names.
+func (v *CreateLBCookieStickinessPolicyInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CookieExpirationPeriod != nil {
+		form.Set(prefix+"CookieExpirationPeriod", strconv.FormatInt(int64(*v.CookieExpirationPeriod), 10))
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // CreateLBCookieStickinessPolicyOutput is undocumented.
 type CreateLBCookieStickinessPolicyOutput struct {
 }
 
 // CreateLoadBalancerListenerInput is undocumented.
 type CreateLoadBalancerListenerInput struct {
-	Listeners        []Listener      `query:"Listeners.member" xml:"Listeners>member"`
+	Listeners        []Listener      `query:"Listeners,list:member" xml:"Listeners>member"`
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the CreateLoadBalancerListenerInput to the form, prefixing their names.
+func (v *CreateLoadBalancerListenerInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Listeners != nil {
+		if len(v.Listeners) == 0 {
+			form.Set(prefix+"Listeners", "")
+		}
+		for i1 := range v.Listeners {
+			name1 := prefix + "Listeners.member." + strconv.Itoa(i1+1)
+			if err := v.Listeners[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // CreateLoadBalancerListenerOutput is undocumented.
 type CreateLoadBalancerListenerOutput struct {
 }
@@ -552,11 +1081,39 @@ type CreateLoadBalancerListenerOutput struct {
 // CreateLoadBalancerPolicyInput is undocumented.
 type CreateLoadBalancerPolicyInput struct {
 	LoadBalancerName aws.StringValue   `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	PolicyAttributes []PolicyAttribute `query:"PolicyAttributes.member" xml:"PolicyAttributes>member"`
+	PolicyAttributes []PolicyAttribute `query:"PolicyAttributes,list:member" xml:"PolicyAttributes>member"`
 	PolicyName       aws.StringValue   `query:"PolicyName" xml:"PolicyName"`
 	PolicyTypeName   aws.StringValue   `query:"PolicyTypeName" xml:"PolicyTypeName"`
 }
 
+// MarshalQuery adds the parameters of the CreateLoadBalancerPolicyInput to the form, prefixing their names.
+func (v *CreateLoadBalancerPolicyInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.PolicyAttributes != nil {
+		if len(v.PolicyAttributes) == 0 {
+			form.Set(prefix+"PolicyAttributes", "")
+		}
+		for i1 := range v.PolicyAttributes {
+			name1 := prefix + "PolicyAttributes.member." + strconv.Itoa(i1+1)
+			if err := v.PolicyAttributes[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.PolicyTypeName != nil {
+		form.Set(prefix+"PolicyTypeName", *v.PolicyTypeName)
+	}
+	return nil
+}
+
 // CreateLoadBalancerPolicyOutput is undocumented.
 type CreateLoadBalancerPolicyOutput struct {
 }
@@ -566,11 +1123,33 @@ type CrossZoneLoadBalancing struct {
 	Enabled aws.BooleanValue `query:"Enabled" xml:"Enabled"`
 }
 
+// MarshalQuery adds the parameters of the CrossZoneLoadBalancing to the form, prefixing their names.
+func (v *CrossZoneLoadBalancing) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	return nil
+}
+
 // DeleteAccessPointInput is undocumented.
 type DeleteAccessPointInput struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteAccessPointInput to the form, prefixing their names.
+func (v *DeleteAccessPointInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // DeleteAccessPointOutput is undocumented.
 type DeleteAccessPointOutput struct {
 }
@@ -578,7 +1157,27 @@ type DeleteAccessPointOutput struct {
 // DeleteLoadBalancerListenerInput is undocumented.
 type DeleteLoadBalancerListenerInput struct {
 	LoadBalancerName  aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	LoadBalancerPorts []int           `query:"LoadBalancerPorts.member" xml:"LoadBalancerPorts>member"`
+	LoadBalancerPorts []int           `query:"LoadBalancerPorts,list:member" xml:"LoadBalancerPorts>member"`
+}
+
+// MarshalQuery adds the parameters of the DeleteLoadBalancerListenerInput to the form, prefixing their names.
+func (v *DeleteLoadBalancerListenerInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.LoadBalancerPorts != nil {
+		if len(v.LoadBalancerPorts) == 0 {
+			form.Set(prefix+"LoadBalancerPorts", "")
+		}
+		for i1 := range v.LoadBalancerPorts {
+			name1 := prefix + "LoadBalancerPorts.member." + strconv.Itoa(i1+1)
+			form.Set(name1, strconv.FormatInt(int64(v.LoadBalancerPorts[i1]), 10))
+		}
+	}
+	return nil
 }
 
 // DeleteLoadBalancerListenerOutput is undocumented.
@@ -591,43 +1190,124 @@ type DeleteLoadBalancerPolicyInput struct {
 	PolicyName       aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteLoadBalancerPolicyInput to the form, prefixing their names.
+func (v *DeleteLoadBalancerPolicyInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // DeleteLoadBalancerPolicyOutput is undocumented.
 type DeleteLoadBalancerPolicyOutput struct {
 }
 
 // DeregisterEndPointsInput is undocumented.
 type DeregisterEndPointsInput struct {
-	Instances        []Instance      `query:"Instances.member" xml:"Instances>member"`
+	Instances        []Instance      `query:"Instances,list:member" xml:"Instances>member"`
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the DeregisterEndPointsInput to the form, prefixing their names.
+func (v *DeregisterEndPointsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Instances != nil {
+		if len(v.Instances) == 0 {
+			form.Set(prefix+"Instances", "")
+		}
+		for i1 := range v.Instances {
+			name1 := prefix + "Instances.member." + strconv.Itoa(i1+1)
+			if err := v.Instances[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // DeregisterEndPointsOutput is undocumented.
 type DeregisterEndPointsOutput struct {
-	Instances []Instance `query:"Instances.member" xml:"DeregisterInstancesFromLoadBalancerResult>Instances>member"`
+	Instances []Instance `query:"Instances,list:member" xml:"DeregisterInstancesFromLoadBalancerResult>Instances>member"`
 }
 
 // DescribeAccessPointsInput is undocumented.
 type DescribeAccessPointsInput struct {
-	LoadBalancerNames []string         `query:"LoadBalancerNames.member" xml:"LoadBalancerNames>member"`
+	LoadBalancerNames []string         `query:"LoadBalancerNames,list:member" xml:"LoadBalancerNames>member"`
 	Marker            aws.StringValue  `query:"Marker" xml:"Marker"`
 	PageSize          aws.IntegerValue `query:"PageSize" xml:"PageSize"`
 }
 
+// MarshalQuery adds the parameters of the DescribeAccessPointsInput to the form, prefixing their names.
+func (v *DescribeAccessPointsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerNames != nil {
+		if len(v.LoadBalancerNames) == 0 {
+			form.Set(prefix+"LoadBalancerNames", "")
+		}
+		for i1 := range v.LoadBalancerNames {
+			name1 := prefix + "LoadBalancerNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.LoadBalancerNames[i1])
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.PageSize != nil {
+		form.Set(prefix+"PageSize", strconv.FormatInt(int64(*v.PageSize), 10))
+	}
+	return nil
+}
+
 // DescribeAccessPointsOutput is undocumented.
 type DescribeAccessPointsOutput struct {
-	LoadBalancerDescriptions []LoadBalancerDescription `query:"LoadBalancerDescriptions.member" xml:"DescribeLoadBalancersResult>LoadBalancerDescriptions>member"`
+	LoadBalancerDescriptions []LoadBalancerDescription `query:"LoadBalancerDescriptions,list:member" xml:"DescribeLoadBalancersResult>LoadBalancerDescriptions>member"`
 	NextMarker               aws.StringValue           `query:"NextMarker" xml:"DescribeLoadBalancersResult>NextMarker"`
 }
 
 // DescribeEndPointStateInput is undocumented.
 type DescribeEndPointStateInput struct {
-	Instances        []Instance      `query:"Instances.member" xml:"Instances>member"`
+	Instances        []Instance      `query:"Instances,list:member" xml:"Instances>member"`
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEndPointStateInput to the form, prefixing their names.
+func (v *DescribeEndPointStateInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Instances != nil {
+		if len(v.Instances) == 0 {
+			form.Set(prefix+"Instances", "")
+		}
+		for i1 := range v.Instances {
+			name1 := prefix + "Instances.member." + strconv.Itoa(i1+1)
+			if err := v.Instances[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // DescribeEndPointStateOutput is undocumented.
 type DescribeEndPointStateOutput struct {
-	InstanceStates []InstanceState `query:"InstanceStates.member" xml:"DescribeInstanceHealthResult>InstanceStates>member"`
+	InstanceStates []InstanceState `query:"InstanceStates,list:member" xml:"DescribeInstanceHealthResult>InstanceStates>member"`
 }
 
 // DescribeLoadBalancerAttributesInput is undocumented.
@@ -635,6 +1315,17 @@ type DescribeLoadBalancerAttributesInput struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeLoadBalancerAttributesInput to the form, prefixing their names.
+func (v *DescribeLoadBalancerAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // DescribeLoadBalancerAttributesOutput is undocumented.
 type DescribeLoadBalancerAttributesOutput struct {
 	LoadBalancerAttributes *LoadBalancerAttributes `query:"LoadBalancerAttributes" xml:"DescribeLoadBalancerAttributesResult>LoadBalancerAttributes"`
@@ -643,43 +1334,117 @@ type DescribeLoadBalancerAttributesOutput struct {
 // DescribeLoadBalancerPoliciesInput is undocumented.
 type DescribeLoadBalancerPoliciesInput struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	PolicyNames      []string        `query:"PolicyNames.member" xml:"PolicyNames>member"`
+	PolicyNames      []string        `query:"PolicyNames,list:member" xml:"PolicyNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeLoadBalancerPoliciesInput to the form, prefixing their names.
+func (v *DescribeLoadBalancerPoliciesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.PolicyNames != nil {
+		if len(v.PolicyNames) == 0 {
+			form.Set(prefix+"PolicyNames", "")
+		}
+		for i1 := range v.PolicyNames {
+			name1 := prefix + "PolicyNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PolicyNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeLoadBalancerPoliciesOutput is undocumented.
 type DescribeLoadBalancerPoliciesOutput struct {
-	PolicyDescriptions []PolicyDescription `query:"PolicyDescriptions.member" xml:"DescribeLoadBalancerPoliciesResult>PolicyDescriptions>member"`
+	PolicyDescriptions []PolicyDescription `query:"PolicyDescriptions,list:member" xml:"DescribeLoadBalancerPoliciesResult>PolicyDescriptions>member"`
 }
 
 // DescribeLoadBalancerPolicyTypesInput is undocumented.
 type DescribeLoadBalancerPolicyTypesInput struct {
-	PolicyTypeNames []string `query:"PolicyTypeNames.member" xml:"PolicyTypeNames>member"`
+	PolicyTypeNames []string `query:"PolicyTypeNames,list:member" xml:"PolicyTypeNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeLoadBalancerPolicyTypesInput to the form, prefixing their names.
+func (v *DescribeLoadBalancerPolicyTypesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyTypeNames != nil {
+		if len(v.PolicyTypeNames) == 0 {
+			form.Set(prefix+"PolicyTypeNames", "")
+		}
+		for i1 := range v.PolicyTypeNames {
+			name1 := prefix + "PolicyTypeNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PolicyTypeNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeLoadBalancerPolicyTypesOutput is undocumented.
 type DescribeLoadBalancerPolicyTypesOutput struct {
-	PolicyTypeDescriptions []PolicyTypeDescription `query:"PolicyTypeDescriptions.member" xml:"DescribeLoadBalancerPolicyTypesResult>PolicyTypeDescriptions>member"`
+	PolicyTypeDescriptions []PolicyTypeDescription `query:"PolicyTypeDescriptions,list:member" xml:"DescribeLoadBalancerPolicyTypesResult>PolicyTypeDescriptions>member"`
 }
 
 // DescribeTagsInput is undocumented.
 type DescribeTagsInput struct {
-	LoadBalancerNames []string `query:"LoadBalancerNames.member" xml:"LoadBalancerNames>member"`
+	LoadBalancerNames []string `query:"LoadBalancerNames,list:member" xml:"LoadBalancerNames>member"`
+}
+
+// MarshalQuery adds the parameters of the DescribeTagsInput to the form, prefixing their names.
+func (v *DescribeTagsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerNames != nil {
+		if len(v.LoadBalancerNames) == 0 {
+			form.Set(prefix+"LoadBalancerNames", "")
+		}
+		for i1 := range v.LoadBalancerNames {
+			name1 := prefix + "LoadBalancerNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.LoadBalancerNames[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeTagsOutput is undocumented.
 type DescribeTagsOutput struct {
-	TagDescriptions []TagDescription `query:"TagDescriptions.member" xml:"DescribeTagsResult>TagDescriptions>member"`
+	TagDescriptions []TagDescription `query:"TagDescriptions,list:member" xml:"DescribeTagsResult>TagDescriptions>member"`
 }
 
 // DetachLoadBalancerFromSubnetsInput is undocumented.
 type DetachLoadBalancerFromSubnetsInput struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	Subnets          []string        `query:"Subnets.member" xml:"Subnets>member"`
+	Subnets          []string        `query:"Subnets,list:member" xml:"Subnets>member"`
+}
+
+// MarshalQuery adds the parameters of the DetachLoadBalancerFromSubnetsInput to the form, prefixing their names.
+func (v *DetachLoadBalancerFromSubnetsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.Subnets != nil {
+		if len(v.Subnets) == 0 {
+			form.Set(prefix+"Subnets", "")
+		}
+		for i1 := range v.Subnets {
+			name1 := prefix + "Subnets.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Subnets[i1])
+		}
+	}
+	return nil
 }
 
 // DetachLoadBalancerFromSubnetsOutput is undocumented.
 type DetachLoadBalancerFromSubnetsOutput struct {
-	Subnets []string `query:"Subnets.member" xml:"DetachLoadBalancerFromSubnetsResult>Subnets>member"`
+	Subnets []string `query:"Subnets,list:member" xml:"DetachLoadBalancerFromSubnetsResult>Subnets>member"`
 }
 
 // HealthCheck is undocumented.
@@ -691,11 +1456,45 @@ type HealthCheck struct {
 	UnhealthyThreshold aws.IntegerValue `query:"UnhealthyThreshold" xml:"UnhealthyThreshold"`
 }
 
+// MarshalQuery adds the parameters of the HealthCheck to the form, prefixing their names.
+func (v *HealthCheck) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HealthyThreshold != nil {
+		form.Set(prefix+"HealthyThreshold", strconv.FormatInt(int64(*v.HealthyThreshold), 10))
+	}
+	if v.Interval != nil {
+		form.Set(prefix+"Interval", strconv.FormatInt(int64(*v.Interval), 10))
+	}
+	if v.Target != nil {
+		form.Set(prefix+"Target", *v.Target)
+	}
+	if v.Timeout != nil {
+		form.Set(prefix+"Timeout", strconv.FormatInt(int64(*v.Timeout), 10))
+	}
+	if v.UnhealthyThreshold != nil {
+		form.Set(prefix+"UnhealthyThreshold", strconv.FormatInt(int64(*v.UnhealthyThreshold), 10))
+	}
+	return nil
+}
+
 // Instance is undocumented.
 type Instance struct {
 	InstanceID aws.StringValue `query:"InstanceId" xml:"InstanceId"`
 }
 
+// MarshalQuery adds the parameters of the Instance to the form, prefixing their names.
+func (v *Instance) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceID != nil {
+		form.Set(prefix+"InstanceId", *v.InstanceID)
+	}
+	return nil
+}
+
 // InstanceState is undocumented.
 type InstanceState struct {
 	Description aws.StringValue `query:"Description" xml:"Description"`
@@ -719,38 +1518,100 @@ type Listener struct {
 	SSLCertificateID aws.StringValue  `query:"SSLCertificateId" xml:"SSLCertificateId"`
 }
 
+// MarshalQuery adds the parameters of the Listener to the form, prefixing their names.
+func (v *Listener) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstancePort != nil {
+		form.Set(prefix+"InstancePort", strconv.FormatInt(int64(*v.InstancePort), 10))
+	}
+	if v.InstanceProtocol != nil {
+		form.Set(prefix+"InstanceProtocol", *v.InstanceProtocol)
+	}
+	if v.LoadBalancerPort != nil {
+		form.Set(prefix+"LoadBalancerPort", strconv.FormatInt(int64(*v.LoadBalancerPort), 10))
+	}
+	if v.Protocol != nil {
+		form.Set(prefix+"Protocol", *v.Protocol)
+	}
+	if v.SSLCertificateID != nil {
+		form.Set(prefix+"SSLCertificateId", *v.SSLCertificateID)
+	}
+	return nil
+}
+
 // ListenerDescription is undocumented.
 type ListenerDescription struct {
 	Listener    *Listener `query:"Listener" xml:"Listener"`
-	PolicyNames []string  `query:"PolicyNames.member" xml:"PolicyNames>member"`
+	PolicyNames []string  `query:"PolicyNames,list:member" xml:"PolicyNames>member"`
 }
 
 // LoadBalancerAttributes is undocumented.
 type LoadBalancerAttributes struct {
 	AccessLog              *AccessLog              `query:"AccessLog" xml:"AccessLog"`
-	AdditionalAttributes   []AdditionalAttribute   `query:"AdditionalAttributes.member" xml:"AdditionalAttributes>member"`
+	AdditionalAttributes   []AdditionalAttribute   `query:"AdditionalAttributes,list:member" xml:"AdditionalAttributes>member"`
 	ConnectionDraining     *ConnectionDraining     `query:"ConnectionDraining" xml:"ConnectionDraining"`
 	ConnectionSettings     *ConnectionSettings     `query:"ConnectionSettings" xml:"ConnectionSettings"`
 	CrossZoneLoadBalancing *CrossZoneLoadBalancing `query:"CrossZoneLoadBalancing" xml:"CrossZoneLoadBalancing"`
 }
 
+// MarshalQuery adds the parameters of the LoadBalancerAttributes to the form, prefixing their names.
+func (v *LoadBalancerAttributes) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccessLog != nil {
+		if err := v.AccessLog.MarshalQuery(form, prefix+"AccessLog."); err != nil {
+			return err
+		}
+	}
+	if v.AdditionalAttributes != nil {
+		if len(v.AdditionalAttributes) == 0 {
+			form.Set(prefix+"AdditionalAttributes", "")
+		}
+		for i1 := range v.AdditionalAttributes {
+			name1 := prefix + "AdditionalAttributes.member." + strconv.Itoa(i1+1)
+			if err := v.AdditionalAttributes[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ConnectionDraining != nil {
+		if err := v.ConnectionDraining.MarshalQuery(form, prefix+"ConnectionDraining."); err != nil {
+			return err
+		}
+	}
+	if v.ConnectionSettings != nil {
+		if err := v.ConnectionSettings.MarshalQuery(form, prefix+"ConnectionSettings."); err != nil {
+			return err
+		}
+	}
+	if v.CrossZoneLoadBalancing != nil {
+		if err := v.CrossZoneLoadBalancing.MarshalQuery(form, prefix+"CrossZoneLoadBalancing."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // LoadBalancerDescription is undocumented.
 type LoadBalancerDescription struct {
-	AvailabilityZones         []string                   `query:"AvailabilityZones.member" xml:"AvailabilityZones>member"`
-	BackendServerDescriptions []BackendServerDescription `query:"BackendServerDescriptions.member" xml:"BackendServerDescriptions>member"`
+	AvailabilityZones         []string                   `query:"AvailabilityZones,list:member" xml:"AvailabilityZones>member"`
+	BackendServerDescriptions []BackendServerDescription `query:"BackendServerDescriptions,list:member" xml:"BackendServerDescriptions>member"`
 	CanonicalHostedZoneName   aws.StringValue            `query:"CanonicalHostedZoneName" xml:"CanonicalHostedZoneName"`
 	CanonicalHostedZoneNameID aws.StringValue            `query:"CanonicalHostedZoneNameID" xml:"CanonicalHostedZoneNameID"`
 	CreatedTime               time.Time                  `query:"CreatedTime" xml:"CreatedTime"`
 	DNSName                   aws.StringValue            `query:"DNSName" xml:"DNSName"`
 	HealthCheck               *HealthCheck               `query:"HealthCheck" xml:"HealthCheck"`
-	Instances                 []Instance                 `query:"Instances.member" xml:"Instances>member"`
-	ListenerDescriptions      []ListenerDescription      `query:"ListenerDescriptions.member" xml:"ListenerDescriptions>member"`
+	Instances                 []Instance                 `query:"Instances,list:member" xml:"Instances>member"`
+	ListenerDescriptions      []ListenerDescription      `query:"ListenerDescriptions,list:member" xml:"ListenerDescriptions>member"`
 	LoadBalancerName          aws.StringValue            `query:"LoadBalancerName" xml:"LoadBalancerName"`
 	Policies                  *Policies                  `query:"Policies" xml:"Policies"`
 	Scheme                    aws.StringValue            `query:"Scheme" xml:"Scheme"`
-	SecurityGroups            []string                   `query:"SecurityGroups.member" xml:"SecurityGroups>member"`
+	SecurityGroups            []string                   `query:"SecurityGroups,list:member" xml:"SecurityGroups>member"`
 	SourceSecurityGroup       *SourceSecurityGroup       `query:"SourceSecurityGroup" xml:"SourceSecurityGroup"`
-	Subnets                   []string                   `query:"Subnets.member" xml:"Subnets>member"`
+	Subnets                   []string                   `query:"Subnets,list:member" xml:"Subnets>member"`
 	VPCID                     aws.StringValue            `query:"VPCId" xml:"VPCId"`
 }
 
@@ -760,6 +1621,22 @@ type ModifyLoadBalancerAttributesInput struct {
 	LoadBalancerName       aws.StringValue         `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the ModifyLoadBalancerAttributesInput to the form, prefixing their names.
+func (v *ModifyLoadBalancerAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerAttributes != nil {
+		if err := v.LoadBalancerAttributes.MarshalQuery(form, prefix+"LoadBalancerAttributes."); err != nil {
+			return err
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // ModifyLoadBalancerAttributesOutput is undocumented.
 type ModifyLoadBalancerAttributesOutput struct {
 	LoadBalancerAttributes *LoadBalancerAttributes `query:"LoadBalancerAttributes" xml:"ModifyLoadBalancerAttributesResult>LoadBalancerAttributes"`
@@ -768,9 +1645,9 @@ type ModifyLoadBalancerAttributesOutput struct {
 
 // Policies is undocumented.
 type Policies struct {
-	AppCookieStickinessPolicies []AppCookieStickinessPolicy `query:"AppCookieStickinessPolicies.member" xml:"AppCookieStickinessPolicies>member"`
-	LBCookieStickinessPolicies  []LBCookieStickinessPolicy  `query:"LBCookieStickinessPolicies.member" xml:"LBCookieStickinessPolicies>member"`
-	OtherPolicies               []string                    `query:"OtherPolicies.member" xml:"OtherPolicies>member"`
+	AppCookieStickinessPolicies []AppCookieStickinessPolicy `query:"AppCookieStickinessPolicies,list:member" xml:"AppCookieStickinessPolicies>member"`
+	LBCookieStickinessPolicies  []LBCookieStickinessPolicy  `query:"LBCookieStickinessPolicies,list:member" xml:"LBCookieStickinessPolicies>member"`
+	OtherPolicies               []string                    `query:"OtherPolicies,list:member" xml:"OtherPolicies>member"`
 }
 
 // PolicyAttribute is undocumented.
@@ -779,6 +1656,20 @@ type PolicyAttribute struct {
 	AttributeValue aws.StringValue `query:"AttributeValue" xml:"AttributeValue"`
 }
 
+// MarshalQuery adds the parameters of the PolicyAttribute to the form, prefixing their names.
+func (v *PolicyAttribute) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttributeName != nil {
+		form.Set(prefix+"AttributeName", *v.AttributeName)
+	}
+	if v.AttributeValue != nil {
+		form.Set(prefix+"AttributeValue", *v.AttributeValue)
+	}
+	return nil
+}
+
 // PolicyAttributeDescription is undocumented.
 type PolicyAttributeDescription struct {
 	AttributeName  aws.StringValue `query:"AttributeName" xml:"AttributeName"`
@@ -796,7 +1687,7 @@ type PolicyAttributeTypeDescription struct {
 
 // PolicyDescription is undocumented.
 type PolicyDescription struct {
-	PolicyAttributeDescriptions []PolicyAttributeDescription `query:"PolicyAttributeDescriptions.member" xml:"PolicyAttributeDescriptions>member"`
+	PolicyAttributeDescriptions []PolicyAttributeDescription `query:"PolicyAttributeDescriptions,list:member" xml:"PolicyAttributeDescriptions>member"`
 	PolicyName                  aws.StringValue              `query:"PolicyName" xml:"PolicyName"`
 	PolicyTypeName              aws.StringValue              `query:"PolicyTypeName" xml:"PolicyTypeName"`
 }
@@ -804,36 +1695,106 @@ type PolicyDescription struct {
 // PolicyTypeDescription is undocumented.
 type PolicyTypeDescription struct {
 	Description                     aws.StringValue                  `query:"Description" xml:"Description"`
-	PolicyAttributeTypeDescriptions []PolicyAttributeTypeDescription `query:"PolicyAttributeTypeDescriptions.member" xml:"PolicyAttributeTypeDescriptions>member"`
+	PolicyAttributeTypeDescriptions []PolicyAttributeTypeDescription `query:"PolicyAttributeTypeDescriptions,list:member" xml:"PolicyAttributeTypeDescriptions>member"`
 	PolicyTypeName                  aws.StringValue                  `query:"PolicyTypeName" xml:"PolicyTypeName"`
 }
 
 // RegisterEndPointsInput is undocumented.
 type RegisterEndPointsInput struct {
-	Instances        []Instance      `query:"Instances.member" xml:"Instances>member"`
+	Instances        []Instance      `query:"Instances,list:member" xml:"Instances>member"`
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the RegisterEndPointsInput to the form, prefixing their names.
+func (v *RegisterEndPointsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Instances != nil {
+		if len(v.Instances) == 0 {
+			form.Set(prefix+"Instances", "")
+		}
+		for i1 := range v.Instances {
+			name1 := prefix + "Instances.member." + strconv.Itoa(i1+1)
+			if err := v.Instances[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // RegisterEndPointsOutput is undocumented.
 type RegisterEndPointsOutput struct {
-	Instances []Instance `query:"Instances.member" xml:"RegisterInstancesWithLoadBalancerResult>Instances>member"`
+	Instances []Instance `query:"Instances,list:member" xml:"RegisterInstancesWithLoadBalancerResult>Instances>member"`
 }
 
 // RemoveAvailabilityZonesInput is undocumented.
 type RemoveAvailabilityZonesInput struct {
-	AvailabilityZones []string        `query:"AvailabilityZones.member" xml:"AvailabilityZones>member"`
+	AvailabilityZones []string        `query:"AvailabilityZones,list:member" xml:"AvailabilityZones>member"`
 	LoadBalancerName  aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
 }
 
+// MarshalQuery adds the parameters of the RemoveAvailabilityZonesInput to the form, prefixing their names.
+func (v *RemoveAvailabilityZonesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AvailabilityZones != nil {
+		if len(v.AvailabilityZones) == 0 {
+			form.Set(prefix+"AvailabilityZones", "")
+		}
+		for i1 := range v.AvailabilityZones {
+			name1 := prefix + "AvailabilityZones.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AvailabilityZones[i1])
+		}
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	return nil
+}
+
 // RemoveAvailabilityZonesOutput is undocumented.
 type RemoveAvailabilityZonesOutput struct {
-	AvailabilityZones []string `query:"AvailabilityZones.member" xml:"DisableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
+	AvailabilityZones []string `query:"AvailabilityZones,list:member" xml:"DisableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
 }
 
 // RemoveTagsInput is undocumented.
 type RemoveTagsInput struct {
-	LoadBalancerNames []string     `query:"LoadBalancerNames.member" xml:"LoadBalancerNames>member"`
-	Tags              []TagKeyOnly `query:"Tags.member" xml:"Tags>member"`
+	LoadBalancerNames []string     `query:"LoadBalancerNames,list:member" xml:"LoadBalancerNames>member"`
+	Tags              []TagKeyOnly `query:"Tags,list:member" xml:"Tags>member"`
+}
+
+// MarshalQuery adds the parameters of the RemoveTagsInput to the form, prefixing their names.
+func (v *RemoveTagsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerNames != nil {
+		if len(v.LoadBalancerNames) == 0 {
+			form.Set(prefix+"LoadBalancerNames", "")
+		}
+		for i1 := range v.LoadBalancerNames {
+			name1 := prefix + "LoadBalancerNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.LoadBalancerNames[i1])
+		}
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.member." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // RemoveTagsOutput is undocumented.
@@ -847,6 +1808,23 @@ type SetLoadBalancerListenerSSLCertificateInput struct {
 	SSLCertificateID aws.StringValue  `query:"SSLCertificateId" xml:"SSLCertificateId"`
 }
 
+// MarshalQuery adds the parameters of the SetLoadBalancerListenerSSLCertificateInput to the form, prefixing their names.
+func (v *SetLoadBalancerListenerSSLCertificateInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.LoadBalancerPort != nil {
+		form.Set(prefix+"LoadBalancerPort", strconv.FormatInt(int64(*v.LoadBalancerPort), 10))
+	}
+	if v.SSLCertificateID != nil {
+		form.Set(prefix+"SSLCertificateId", *v.SSLCertificateID)
+	}
+	return nil
+}
+
 // SetLoadBalancerListenerSSLCertificateOutput is undocumented.
 type SetLoadBalancerListenerSSLCertificateOutput struct {
 }
@@ -855,7 +1833,30 @@ type SetLoadBalancerListenerSSLCertificateOutput struct {
 type SetLoadBalancerPoliciesForBackendServerInput struct {
 	InstancePort     aws.IntegerValue `query:"InstancePort" xml:"InstancePort"`
 	LoadBalancerName aws.StringValue  `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	PolicyNames      []string         `query:"PolicyNames.member" xml:"PolicyNames>member"`
+	PolicyNames      []string         `query:"PolicyNames,list:member" xml:"PolicyNames>member"`
+}
+
+// MarshalQuery adds the parameters of the SetLoadBalancerPoliciesForBackendServerInput to the form, prefixing their names.
+func (v *SetLoadBalancerPoliciesForBackendServerInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstancePort != nil {
+		form.Set(prefix+"InstancePort", strconv.FormatInt(int64(*v.InstancePort), 10))
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.PolicyNames != nil {
+		if len(v.PolicyNames) == 0 {
+			form.Set(prefix+"PolicyNames", "")
+		}
+		for i1 := range v.PolicyNames {
+			name1 := prefix + "PolicyNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PolicyNames[i1])
+		}
+	}
+	return nil
 }
 
 // SetLoadBalancerPoliciesForBackendServerOutput is undocumented.
@@ -866,7 +1867,30 @@ type SetLoadBalancerPoliciesForBackendServerOutput struct {
 type SetLoadBalancerPoliciesOfListenerInput struct {
 	LoadBalancerName aws.StringValue  `query:"LoadBalancerName" xml:"LoadBalancerName"`
 	LoadBalancerPort aws.IntegerValue `query:"LoadBalancerPort" xml:"LoadBalancerPort"`
-	PolicyNames      []string         `query:"PolicyNames.member" xml:"PolicyNames>member"`
+	PolicyNames      []string         `query:"PolicyNames,list:member" xml:"PolicyNames>member"`
+}
+
+// MarshalQuery adds the parameters of the SetLoadBalancerPoliciesOfListenerInput to the form, prefixing their names.
+func (v *SetLoadBalancerPoliciesOfListenerInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.LoadBalancerName != nil {
+		form.Set(prefix+"LoadBalancerName", *v.LoadBalancerName)
+	}
+	if v.LoadBalancerPort != nil {
+		form.Set(prefix+"LoadBalancerPort", strconv.FormatInt(int64(*v.LoadBalancerPort), 10))
+	}
+	if v.PolicyNames != nil {
+		if len(v.PolicyNames) == 0 {
+			form.Set(prefix+"PolicyNames", "")
+		}
+		for i1 := range v.PolicyNames {
+			name1 := prefix + "PolicyNames.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.PolicyNames[i1])
+		}
+	}
+	return nil
 }
 
 // SetLoadBalancerPoliciesOfListenerOutput is undocumented.
@@ -885,10 +1909,24 @@ type Tag struct {
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Tag to the form, prefixing their names.
+func (v *Tag) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // TagDescription is undocumented.
 type TagDescription struct {
 	LoadBalancerName aws.StringValue `query:"LoadBalancerName" xml:"LoadBalancerName"`
-	Tags             []Tag           `query:"Tags.member" xml:"Tags>member"`
+	Tags             []Tag           `query:"Tags,list:member" xml:"Tags>member"`
 }
 
 // TagKeyOnly is undocumented.
@@ -896,18 +1934,29 @@ type TagKeyOnly struct {
 	Key aws.StringValue `query:"Key" xml:"Key"`
 }
 
+// MarshalQuery adds the parameters of the TagKeyOnly to the form, prefixing their names.
+func (v *TagKeyOnly) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	return nil
+}
+
 // AddTagsResult is a wrapper for AddTagsOutput.
 type AddTagsResult struct {
 }
 
 // ApplySecurityGroupsToLoadBalancerResult is a wrapper for ApplySecurityGroupsToLoadBalancerOutput.
 type ApplySecurityGroupsToLoadBalancerResult struct {
-	SecurityGroups []string `query:"SecurityGroups.member" xml:"ApplySecurityGroupsToLoadBalancerResult>SecurityGroups>member"`
+	SecurityGroups []string `query:"SecurityGroups,list:member" xml:"ApplySecurityGroupsToLoadBalancerResult>SecurityGroups>member"`
 }
 
 // AttachLoadBalancerToSubnetsResult is a wrapper for AttachLoadBalancerToSubnetsOutput.
 type AttachLoadBalancerToSubnetsResult struct {
-	Subnets []string `query:"Subnets.member" xml:"AttachLoadBalancerToSubnetsResult>Subnets>member"`
+	Subnets []string `query:"Subnets,list:member" xml:"AttachLoadBalancerToSubnetsResult>Subnets>member"`
 }
 
 // ConfigureHealthCheckResult is a wrapper for ConfigureHealthCheckOutput.
@@ -950,12 +1999,12 @@ type DeleteLoadBalancerResult struct {
 
 // DeregisterInstancesFromLoadBalancerResult is a wrapper for DeregisterEndPointsOutput.
 type DeregisterInstancesFromLoadBalancerResult struct {
-	Instances []Instance `query:"Instances.member" xml:"DeregisterInstancesFromLoadBalancerResult>Instances>member"`
+	Instances []Instance `query:"Instances,list:member" xml:"DeregisterInstancesFromLoadBalancerResult>Instances>member"`
 }
 
 // DescribeInstanceHealthResult is a wrapper for DescribeEndPointStateOutput.
 type DescribeInstanceHealthResult struct {
-	InstanceStates []InstanceState `query:"InstanceStates.member" xml:"DescribeInstanceHealthResult>InstanceStates>member"`
+	InstanceStates []InstanceState `query:"InstanceStates,list:member" xml:"DescribeInstanceHealthResult>InstanceStates>member"`
 }
 
 // DescribeLoadBalancerAttributesResult is a wrapper for DescribeLoadBalancerAttributesOutput.
@@ -965,38 +2014,38 @@ type DescribeLoadBalancerAttributesResult struct {
 
 // DescribeLoadBalancerPoliciesResult is a wrapper for DescribeLoadBalancerPoliciesOutput.
 type DescribeLoadBalancerPoliciesResult struct {
-	PolicyDescriptions []PolicyDescription `query:"PolicyDescriptions.member" xml:"DescribeLoadBalancerPoliciesResult>PolicyDescriptions>member"`
+	PolicyDescriptions []PolicyDescription `query:"PolicyDescriptions,list:member" xml:"DescribeLoadBalancerPoliciesResult>PolicyDescriptions>member"`
 }
 
 // DescribeLoadBalancerPolicyTypesResult is a wrapper for DescribeLoadBalancerPolicyTypesOutput.
 type DescribeLoadBalancerPolicyTypesResult struct {
-	PolicyTypeDescriptions []PolicyTypeDescription `query:"PolicyTypeDescriptions.member" xml:"DescribeLoadBalancerPolicyTypesResult>PolicyTypeDescriptions>member"`
+	PolicyTypeDescriptions []PolicyTypeDescription `query:"PolicyTypeDescriptions,list:member" xml:"DescribeLoadBalancerPolicyTypesResult>PolicyTypeDescriptions>member"`
 }
 
 // DescribeLoadBalancersResult is a wrapper for DescribeAccessPointsOutput.
 type DescribeLoadBalancersResult struct {
-	LoadBalancerDescriptions []LoadBalancerDescription `query:"LoadBalancerDescriptions.member" xml:"DescribeLoadBalancersResult>LoadBalancerDescriptions>member"`
+	LoadBalancerDescriptions []LoadBalancerDescription `query:"LoadBalancerDescriptions,list:member" xml:"DescribeLoadBalancersResult>LoadBalancerDescriptions>member"`
 	NextMarker               aws.StringValue           `query:"NextMarker" xml:"DescribeLoadBalancersResult>NextMarker"`
 }
 
 // DescribeTagsResult is a wrapper for DescribeTagsOutput.
 type DescribeTagsResult struct {
-	TagDescriptions []TagDescription `query:"TagDescriptions.member" xml:"DescribeTagsResult>TagDescriptions>member"`
+	TagDescriptions []TagDescription `query:"TagDescriptions,list:member" xml:"DescribeTagsResult>TagDescriptions>member"`
 }
 
 // DetachLoadBalancerFromSubnetsResult is a wrapper for DetachLoadBalancerFromSubnetsOutput.
 type DetachLoadBalancerFromSubnetsResult struct {
-	Subnets []string `query:"Subnets.member" xml:"DetachLoadBalancerFromSubnetsResult>Subnets>member"`
+	Subnets []string `query:"Subnets,list:member" xml:"DetachLoadBalancerFromSubnetsResult>Subnets>member"`
 }
 
 // DisableAvailabilityZonesForLoadBalancerResult is a wrapper for RemoveAvailabilityZonesOutput.
 type DisableAvailabilityZonesForLoadBalancerResult struct {
-	AvailabilityZones []string `query:"AvailabilityZones.member" xml:"DisableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
+	AvailabilityZones []string `query:"AvailabilityZones,list:member" xml:"DisableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
 }
 
 // EnableAvailabilityZonesForLoadBalancerResult is a wrapper for AddAvailabilityZonesOutput.
 type EnableAvailabilityZonesForLoadBalancerResult struct {
-	AvailabilityZones []string `query:"AvailabilityZones.member" xml:"EnableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
+	AvailabilityZones []string `query:"AvailabilityZones,list:member" xml:"EnableAvailabilityZonesForLoadBalancerResult>AvailabilityZones>member"`
 }
 
 // ModifyLoadBalancerAttributesResult is a wrapper for ModifyLoadBalancerAttributesOutput.
@@ -1007,7 +2056,7 @@ type ModifyLoadBalancerAttributesResult struct {
 
 // RegisterInstancesWithLoadBalancerResult is a wrapper for RegisterEndPointsOutput.
 type RegisterInstancesWithLoadBalancerResult struct {
-	Instances []Instance `query:"Instances.member" xml:"RegisterInstancesWithLoadBalancerResult>Instances>member"`
+	Instances []Instance `query:"Instances,list:member" xml:"RegisterInstancesWithLoadBalancerResult>Instances>member"`
 }
 
 // RemoveTagsResult is a wrapper for RemoveTagsOutput.
@@ -1026,5 +2075,129 @@ type SetLoadBalancerPoliciesForBackendServerResult struct {
 type SetLoadBalancerPoliciesOfListenerResult struct {
 }
 
+// AccessPointNotFoundException is the error returned for the LoadBalancerNotFound error code.
+type AccessPointNotFoundException struct {
+	aws.APIError
+}
+
+// CertificateNotFoundException is the error returned for the CertificateNotFound error code.
+type CertificateNotFoundException struct {
+	aws.APIError
+}
+
+// DuplicateAccessPointNameException is the error returned for the DuplicateLoadBalancerName error code.
+type DuplicateAccessPointNameException struct {
+	aws.APIError
+}
+
+// DuplicateListenerException is the error returned for the DuplicateListener error code.
+type DuplicateListenerException struct {
+	aws.APIError
+}
+
+// DuplicatePolicyNameException is the error returned for the DuplicatePolicyName error code.
+type DuplicatePolicyNameException struct {
+	aws.APIError
+}
+
+// DuplicateTagKeysException is the error returned for the DuplicateTagKeys error code.
+type DuplicateTagKeysException struct {
+	aws.APIError
+}
+
+// InvalidConfigurationRequestException is the error returned for the InvalidConfigurationRequest error code.
+type InvalidConfigurationRequestException struct {
+	aws.APIError
+}
+
+// InvalidEndPointException is the error returned for the InvalidInstance error code.
+type InvalidEndPointException struct {
+	aws.APIError
+}
+
+// InvalidSchemeException is the error returned for the InvalidScheme error code.
+type InvalidSchemeException struct {
+	aws.APIError
+}
+
+// InvalidSecurityGroupException is the error returned for the InvalidSecurityGroup error code.
+type InvalidSecurityGroupException struct {
+	aws.APIError
+}
+
+// InvalidSubnetException is the error returned for the InvalidSubnet error code.
+type InvalidSubnetException struct {
+	aws.APIError
+}
+
+// ListenerNotFoundException is the error returned for the ListenerNotFound error code.
+type ListenerNotFoundException struct {
+	aws.APIError
+}
+
+// LoadBalancerAttributeNotFoundException is the error returned for the LoadBalancerAttributeNotFound error code.
+type LoadBalancerAttributeNotFoundException struct {
+	aws.APIError
+}
+
+// PolicyNotFoundException is the error returned for the PolicyNotFound error code.
+type PolicyNotFoundException struct {
+	aws.APIError
+}
+
+// PolicyTypeNotFoundException is the error returned for the PolicyTypeNotFound error code.
+type PolicyTypeNotFoundException struct {
+	aws.APIError
+}
+
+// SubnetNotFoundException is the error returned for the SubnetNotFound error code.
+type SubnetNotFoundException struct {
+	aws.APIError
+}
+
+// TooManyAccessPointsException is the error returned for the TooManyLoadBalancers error code.
+type TooManyAccessPointsException struct {
+	aws.APIError
+}
+
+// TooManyPoliciesException is the error returned for the TooManyPolicies error code.
+type TooManyPoliciesException struct {
+	aws.APIError
+}
+
+// TooManyTagsException is the error returned for the TooManyTags error code.
+type TooManyTagsException struct {
+	aws.APIError
+}
+
+// exceptions maps ELB's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"LoadBalancerNotFound":          AccessPointNotFoundException{},
+	"CertificateNotFound":           CertificateNotFoundException{},
+	"DuplicateLoadBalancerName":     DuplicateAccessPointNameException{},
+	"DuplicateListener":             DuplicateListenerException{},
+	"DuplicatePolicyName":           DuplicatePolicyNameException{},
+	"DuplicateTagKeys":              DuplicateTagKeysException{},
+	"InvalidConfigurationRequest":   InvalidConfigurationRequestException{},
+	"InvalidInstance":               InvalidEndPointException{},
+	"InvalidScheme":                 InvalidSchemeException{},
+	"InvalidSecurityGroup":          InvalidSecurityGroupException{},
+	"InvalidSubnet":                 InvalidSubnetException{},
+	"ListenerNotFound":              ListenerNotFoundException{},
+	"LoadBalancerAttributeNotFound": LoadBalancerAttributeNotFoundException{},
+	"PolicyNotFound":                PolicyNotFoundException{},
+	"PolicyTypeNotFound":            PolicyTypeNotFoundException{},
+	"SubnetNotFound":                SubnetNotFoundException{},
+	"TooManyLoadBalancers":          TooManyAccessPointsException{},
+	"TooManyPolicies":               TooManyPoliciesException{},
+	"TooManyTags":                   TooManyTagsException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
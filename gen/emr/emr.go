@@ -4,11 +4,18 @@
 package emr
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // EMR is a client for Amazon Elastic MapReduce.
@@ -16,34 +23,76 @@ type EMR struct {
 	client *aws.JSONClient
 }
 
-// New returns a new EMR client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *EMR {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "elasticmapreduce"
-	endpoint, service, region := endpoints.Lookup("elasticmapreduce", region)
+// New returns a new EMR client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *EMR {
+	cfg := newConfig(region, client, opts)
 
 	return &EMR{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "ElasticMapReduce",
 		},
 	}
 }
 
+// NewFromSession returns a new EMR client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *EMR {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("elasticmapreduce", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("elasticmapreduce", "emr"),
+	}, "AWS_ENDPOINT_URL_EMR", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *EMR) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *EMR) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // AddInstanceGroups addInstanceGroups adds an instance group to a running
 // cluster.
 func (c *EMR) AddInstanceGroups(req *AddInstanceGroupsInput) (resp *AddInstanceGroupsOutput, err error) {
+	return c.AddInstanceGroupsWithContext(context.Background(), req)
+}
+
+// AddInstanceGroupsWithContext is like AddInstanceGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) AddInstanceGroupsWithContext(ctx context.Context, req *AddInstanceGroupsInput) (resp *AddInstanceGroupsOutput, err error) {
 	resp = &AddInstanceGroupsOutput{}
-	err = c.client.Do("AddInstanceGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddInstanceGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -66,8 +115,15 @@ func (c *EMR) AddInstanceGroups(req *AddInstanceGroupsInput) (resp *AddInstanceG
 // completed and run successfully. You can only add steps to a job flow
 // that is in one of the following states: or
 func (c *EMR) AddJobFlowSteps(req *AddJobFlowStepsInput) (resp *AddJobFlowStepsOutput, err error) {
+	return c.AddJobFlowStepsWithContext(context.Background(), req)
+}
+
+// AddJobFlowStepsWithContext is like AddJobFlowSteps, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) AddJobFlowStepsWithContext(ctx context.Context, req *AddJobFlowStepsInput) (resp *AddJobFlowStepsOutput, err error) {
 	resp = &AddJobFlowStepsOutput{}
-	err = c.client.Do("AddJobFlowSteps", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddJobFlowSteps", "POST", "/", req, resp)
 	return
 }
 
@@ -76,8 +132,15 @@ func (c *EMR) AddJobFlowSteps(req *AddJobFlowStepsInput) (resp *AddJobFlowStepsO
 // your Amazon EMR resource allocation costs. For more information, see
 // Tagging Amazon EMR Resources .
 func (c *EMR) AddTags(req *AddTagsInput) (resp *AddTagsOutput, err error) {
+	return c.AddTagsWithContext(context.Background(), req)
+}
+
+// AddTagsWithContext is like AddTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) AddTagsWithContext(ctx context.Context, req *AddTagsInput) (resp *AddTagsOutput, err error) {
 	resp = &AddTagsOutput{}
-	err = c.client.Do("AddTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddTags", "POST", "/", req, resp)
 	return
 }
 
@@ -85,8 +148,15 @@ func (c *EMR) AddTags(req *AddTagsInput) (resp *AddTagsOutput, err error) {
 // hardware and software configuration, VPC settings, and so on. For
 // information about the cluster steps, see ListSteps
 func (c *EMR) DescribeCluster(req *DescribeClusterInput) (resp *DescribeClusterOutput, err error) {
+	return c.DescribeClusterWithContext(context.Background(), req)
+}
+
+// DescribeClusterWithContext is like DescribeCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) DescribeClusterWithContext(ctx context.Context, req *DescribeClusterInput) (resp *DescribeClusterOutput, err error) {
 	resp = &DescribeClusterOutput{}
-	err = c.client.Do("DescribeCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -103,23 +173,44 @@ func (c *EMR) DescribeCluster(req *DescribeClusterInput) (resp *DescribeClusterO
 // are in one of the following states: , , , Amazon Elastic MapReduce can
 // return a maximum of 512 job flow descriptions.
 func (c *EMR) DescribeJobFlows(req *DescribeJobFlowsInput) (resp *DescribeJobFlowsOutput, err error) {
+	return c.DescribeJobFlowsWithContext(context.Background(), req)
+}
+
+// DescribeJobFlowsWithContext is like DescribeJobFlows, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) DescribeJobFlowsWithContext(ctx context.Context, req *DescribeJobFlowsInput) (resp *DescribeJobFlowsOutput, err error) {
 	resp = &DescribeJobFlowsOutput{}
-	err = c.client.Do("DescribeJobFlows", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeJobFlows", "POST", "/", req, resp)
 	return
 }
 
 // DescribeStep is undocumented.
 func (c *EMR) DescribeStep(req *DescribeStepInput) (resp *DescribeStepOutput, err error) {
+	return c.DescribeStepWithContext(context.Background(), req)
+}
+
+// DescribeStepWithContext is like DescribeStep, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) DescribeStepWithContext(ctx context.Context, req *DescribeStepInput) (resp *DescribeStepOutput, err error) {
 	resp = &DescribeStepOutput{}
-	err = c.client.Do("DescribeStep", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStep", "POST", "/", req, resp)
 	return
 }
 
 // ListBootstrapActions provides information about the bootstrap actions
 // associated with a cluster.
 func (c *EMR) ListBootstrapActions(req *ListBootstrapActionsInput) (resp *ListBootstrapActionsOutput, err error) {
+	return c.ListBootstrapActionsWithContext(context.Background(), req)
+}
+
+// ListBootstrapActionsWithContext is like ListBootstrapActions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) ListBootstrapActionsWithContext(ctx context.Context, req *ListBootstrapActionsInput) (resp *ListBootstrapActionsOutput, err error) {
 	resp = &ListBootstrapActionsOutput{}
-	err = c.client.Do("ListBootstrapActions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListBootstrapActions", "POST", "/", req, resp)
 	return
 }
 
@@ -130,16 +221,30 @@ func (c *EMR) ListBootstrapActions(req *ListBootstrapActionsInput) (resp *ListBo
 // a marker to track the paging of the cluster list across multiple
 // ListClusters calls.
 func (c *EMR) ListClusters(req *ListClustersInput) (resp *ListClustersOutput, err error) {
+	return c.ListClustersWithContext(context.Background(), req)
+}
+
+// ListClustersWithContext is like ListClusters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) ListClustersWithContext(ctx context.Context, req *ListClustersInput) (resp *ListClustersOutput, err error) {
 	resp = &ListClustersOutput{}
-	err = c.client.Do("ListClusters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListClusters", "POST", "/", req, resp)
 	return
 }
 
 // ListInstanceGroups provides all available details about the instance
 // groups in a cluster.
 func (c *EMR) ListInstanceGroups(req *ListInstanceGroupsInput) (resp *ListInstanceGroupsOutput, err error) {
+	return c.ListInstanceGroupsWithContext(context.Background(), req)
+}
+
+// ListInstanceGroupsWithContext is like ListInstanceGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) ListInstanceGroupsWithContext(ctx context.Context, req *ListInstanceGroupsInput) (resp *ListInstanceGroupsOutput, err error) {
 	resp = &ListInstanceGroupsOutput{}
-	err = c.client.Do("ListInstanceGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListInstanceGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -149,15 +254,29 @@ func (c *EMR) ListInstanceGroups(req *ListInstanceGroupsInput) (resp *ListInstan
 // Ready state, when instances become available to Amazon EMR to use for
 // jobs, and the IP addresses for cluster instances, etc.
 func (c *EMR) ListInstances(req *ListInstancesInput) (resp *ListInstancesOutput, err error) {
+	return c.ListInstancesWithContext(context.Background(), req)
+}
+
+// ListInstancesWithContext is like ListInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) ListInstancesWithContext(ctx context.Context, req *ListInstancesInput) (resp *ListInstancesOutput, err error) {
 	resp = &ListInstancesOutput{}
-	err = c.client.Do("ListInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListInstances", "POST", "/", req, resp)
 	return
 }
 
 // ListSteps is undocumented.
 func (c *EMR) ListSteps(req *ListStepsInput) (resp *ListStepsOutput, err error) {
+	return c.ListStepsWithContext(context.Background(), req)
+}
+
+// ListStepsWithContext is like ListSteps, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) ListStepsWithContext(ctx context.Context, req *ListStepsInput) (resp *ListStepsOutput, err error) {
 	resp = &ListStepsOutput{}
-	err = c.client.Do("ListSteps", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListSteps", "POST", "/", req, resp)
 	return
 }
 
@@ -166,8 +285,15 @@ func (c *EMR) ListSteps(req *ListStepsInput) (resp *ListStepsOutput, err error)
 // include the new target instance count for the group and the instance
 // group ID. The call will either succeed or fail atomically.
 func (c *EMR) ModifyInstanceGroups(req *ModifyInstanceGroupsInput) (err error) {
+	return c.ModifyInstanceGroupsWithContext(context.Background(), req)
+}
+
+// ModifyInstanceGroupsWithContext is like ModifyInstanceGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) ModifyInstanceGroupsWithContext(ctx context.Context, req *ModifyInstanceGroupsInput) (err error) {
 	// NRE
-	err = c.client.Do("ModifyInstanceGroups", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ModifyInstanceGroups", "POST", "/", req, nil)
 	return
 }
 
@@ -177,8 +303,15 @@ func (c *EMR) ModifyInstanceGroups(req *ModifyInstanceGroupsInput) (err error) {
 // see Tagging Amazon EMR Resources . The following example removes the
 // stack tag with value Prod from a cluster:
 func (c *EMR) RemoveTags(req *RemoveTagsInput) (resp *RemoveTagsOutput, err error) {
+	return c.RemoveTagsWithContext(context.Background(), req)
+}
+
+// RemoveTagsWithContext is like RemoveTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) RemoveTagsWithContext(ctx context.Context, req *RemoveTagsInput) (resp *RemoveTagsOutput, err error) {
 	resp = &RemoveTagsOutput{}
-	err = c.client.Do("RemoveTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RemoveTags", "POST", "/", req, resp)
 	return
 }
 
@@ -202,8 +335,15 @@ func (c *EMR) RemoveTags(req *RemoveTagsInput) (resp *RemoveTagsOutput, err erro
 // MapReduce Developer's Guide For long running job flows, we recommend
 // that you periodically store your results.
 func (c *EMR) RunJobFlow(req *RunJobFlowInput) (resp *RunJobFlowOutput, err error) {
+	return c.RunJobFlowWithContext(context.Background(), req)
+}
+
+// RunJobFlowWithContext is like RunJobFlow, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) RunJobFlowWithContext(ctx context.Context, req *RunJobFlowInput) (resp *RunJobFlowOutput, err error) {
 	resp = &RunJobFlowOutput{}
-	err = c.client.Do("RunJobFlow", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RunJobFlow", "POST", "/", req, resp)
 	return
 }
 
@@ -222,8 +362,15 @@ func (c *EMR) RunJobFlow(req *RunJobFlowInput) (resp *RunJobFlowOutput, err erro
 // set the value to false . For more information, go to Protecting a Job
 // Flow from Termination in the Amazon Elastic MapReduce Developer's Guide.
 func (c *EMR) SetTerminationProtection(req *SetTerminationProtectionInput) (err error) {
+	return c.SetTerminationProtectionWithContext(context.Background(), req)
+}
+
+// SetTerminationProtectionWithContext is like SetTerminationProtection, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) SetTerminationProtectionWithContext(ctx context.Context, req *SetTerminationProtectionInput) (err error) {
 	// NRE
-	err = c.client.Do("SetTerminationProtection", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetTerminationProtection", "POST", "/", req, nil)
 	return
 }
 
@@ -235,8 +382,15 @@ func (c *EMR) SetTerminationProtection(req *SetTerminationProtectionInput) (err
 // IAM user who created the job flow or the AWS account that owns the job
 // flow.
 func (c *EMR) SetVisibleToAllUsers(req *SetVisibleToAllUsersInput) (err error) {
+	return c.SetVisibleToAllUsersWithContext(context.Background(), req)
+}
+
+// SetVisibleToAllUsersWithContext is like SetVisibleToAllUsers, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) SetVisibleToAllUsersWithContext(ctx context.Context, req *SetVisibleToAllUsersInput) (err error) {
 	// NRE
-	err = c.client.Do("SetVisibleToAllUsers", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetVisibleToAllUsers", "POST", "/", req, nil)
 	return
 }
 
@@ -249,8 +403,15 @@ func (c *EMR) SetVisibleToAllUsers(req *SetVisibleToAllUsersInput) (err error) {
 // take up to 5-20 minutes for the job flow to completely terminate and
 // release allocated resources, such as Amazon EC2 instances.
 func (c *EMR) TerminateJobFlows(req *TerminateJobFlowsInput) (err error) {
+	return c.TerminateJobFlowsWithContext(context.Background(), req)
+}
+
+// TerminateJobFlowsWithContext is like TerminateJobFlows, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *EMR) TerminateJobFlowsWithContext(ctx context.Context, req *TerminateJobFlowsInput) (err error) {
 	// NRE
-	err = c.client.Do("TerminateJobFlows", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "TerminateJobFlows", "POST", "/", req, nil)
 	return
 }
 
@@ -268,6 +429,52 @@ type AddInstanceGroupsInput struct {
 	JobFlowID      aws.StringValue       `json:"JobFlowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddInstanceGroupsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddInstanceGroupsInput to b.
+func (v *AddInstanceGroupsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceGroups\":"...)
+	if v.InstanceGroups == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.InstanceGroups {
+			b, err = v.InstanceGroups[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"JobFlowId\":"...)
+	if v.JobFlowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.JobFlowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddInstanceGroupsOutput is undocumented.
 type AddInstanceGroupsOutput struct {
 	InstanceGroupIDs []string        `json:"InstanceGroupIds,omitempty"`
@@ -280,6 +487,52 @@ type AddJobFlowStepsInput struct {
 	Steps     []StepConfig    `json:"Steps"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddJobFlowStepsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddJobFlowStepsInput to b.
+func (v *AddJobFlowStepsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"JobFlowId\":"...)
+	if v.JobFlowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.JobFlowID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Steps\":"...)
+	if v.Steps == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Steps {
+			b, err = v.Steps[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddJobFlowStepsOutput is undocumented.
 type AddJobFlowStepsOutput struct {
 	StepIDs []string `json:"StepIds,omitempty"`
@@ -291,6 +544,52 @@ type AddTagsInput struct {
 	Tags       []Tag           `json:"Tags"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddTagsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddTagsInput to b.
+func (v *AddTagsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ResourceId\":"...)
+	if v.ResourceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ResourceID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Tags\":"...)
+	if v.Tags == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Tags {
+			b, err = v.Tags[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddTagsOutput is undocumented.
 type AddTagsOutput struct {
 }
@@ -309,6 +608,43 @@ type BootstrapActionConfig struct {
 	ScriptBootstrapAction *ScriptBootstrapActionConfig `json:"ScriptBootstrapAction"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *BootstrapActionConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the BootstrapActionConfig to b.
+func (v *BootstrapActionConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"ScriptBootstrapAction\":"...)
+	if v.ScriptBootstrapAction == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ScriptBootstrapAction.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // BootstrapActionDetail is undocumented.
 type BootstrapActionDetail struct {
 	BootstrapActionConfig *BootstrapActionConfig `json:"BootstrapActionConfig,omitempty"`
@@ -375,9 +711,9 @@ type ClusterSummary struct {
 
 // ClusterTimeline is undocumented.
 type ClusterTimeline struct {
-	CreationDateTime *aws.LongTimestamp `json:"CreationDateTime,omitempty"`
-	EndDateTime      *aws.LongTimestamp `json:"EndDateTime,omitempty"`
-	ReadyDateTime    *aws.LongTimestamp `json:"ReadyDateTime,omitempty"`
+	CreationDateTime *aws.FloatTimestamp `json:"CreationDateTime,omitempty"`
+	EndDateTime      *aws.FloatTimestamp `json:"EndDateTime,omitempty"`
+	ReadyDateTime    *aws.FloatTimestamp `json:"ReadyDateTime,omitempty"`
 }
 
 // Command is undocumented.
@@ -392,6 +728,33 @@ type DescribeClusterInput struct {
 	ClusterID aws.StringValue `json:"ClusterId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeClusterInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeClusterInput to b.
+func (v *DescribeClusterInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClusterId\":"...)
+	if v.ClusterID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClusterID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeClusterOutput is undocumented.
 type DescribeClusterOutput struct {
 	Cluster *Cluster `json:"Cluster,omitempty"`
@@ -399,10 +762,74 @@ type DescribeClusterOutput struct {
 
 // DescribeJobFlowsInput is undocumented.
 type DescribeJobFlowsInput struct {
-	CreatedAfter  *aws.LongTimestamp `json:"CreatedAfter,omitempty"`
-	CreatedBefore *aws.LongTimestamp `json:"CreatedBefore,omitempty"`
-	JobFlowIDs    []string           `json:"JobFlowIds,omitempty"`
-	JobFlowStates []string           `json:"JobFlowStates,omitempty"`
+	CreatedAfter  *aws.FloatTimestamp `json:"CreatedAfter,omitempty"`
+	CreatedBefore *aws.FloatTimestamp `json:"CreatedBefore,omitempty"`
+	JobFlowIDs    []string            `json:"JobFlowIds,omitempty"`
+	JobFlowStates []string            `json:"JobFlowStates,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeJobFlowsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeJobFlowsInput to b.
+func (v *DescribeJobFlowsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CreatedAfter != nil {
+		b = append(b, "\"CreatedAfter\":"...)
+		b, err = aws.AppendJSON(b, v.CreatedAfter)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CreatedBefore != nil {
+		b = append(b, "\"CreatedBefore\":"...)
+		b, err = aws.AppendJSON(b, v.CreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if len(v.JobFlowIDs) != 0 {
+		b = append(b, "\"JobFlowIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.JobFlowIDs {
+			b = aws.AppendJSONString(b, v.JobFlowIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.JobFlowStates) != 0 {
+		b = append(b, "\"JobFlowStates\":"...)
+		b = append(b, '[')
+		for i1 := range v.JobFlowStates {
+			b = aws.AppendJSONString(b, v.JobFlowStates[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // DescribeJobFlowsOutput is undocumented.
@@ -416,6 +843,40 @@ type DescribeStepInput struct {
 	StepID    aws.StringValue `json:"StepId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeStepInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeStepInput to b.
+func (v *DescribeStepInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClusterId\":"...)
+	if v.ClusterID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClusterID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StepId\":"...)
+	if v.StepID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StepID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeStepOutput is undocumented.
 type DescribeStepOutput struct {
 	Step *Step `json:"Step,omitempty"`
@@ -437,6 +898,69 @@ type HadoopJARStepConfig struct {
 	Properties []KeyValue      `json:"Properties,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *HadoopJARStepConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the HadoopJARStepConfig to b.
+func (v *HadoopJARStepConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Args) != 0 {
+		b = append(b, "\"Args\":"...)
+		b = append(b, '[')
+		for i1 := range v.Args {
+			b = aws.AppendJSONString(b, v.Args[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Jar\":"...)
+	if v.JAR == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.JAR)
+	}
+	b = append(b, ',')
+	if v.MainClass != nil {
+		b = append(b, "\"MainClass\":"...)
+		b = aws.AppendJSONString(b, *v.MainClass)
+		b = append(b, ',')
+	}
+	if len(v.Properties) != 0 {
+		b = append(b, "\"Properties\":"...)
+		b = append(b, '[')
+		for i1 := range v.Properties {
+			b, err = v.Properties[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // HadoopStepConfig is undocumented.
 type HadoopStepConfig struct {
 	Args       []string          `json:"Args,omitempty"`
@@ -479,22 +1003,78 @@ type InstanceGroupConfig struct {
 	Name          aws.StringValue  `json:"Name,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *InstanceGroupConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the InstanceGroupConfig to b.
+func (v *InstanceGroupConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.BidPrice != nil {
+		b = append(b, "\"BidPrice\":"...)
+		b = aws.AppendJSONString(b, *v.BidPrice)
+		b = append(b, ',')
+	}
+	b = append(b, "\"InstanceCount\":"...)
+	if v.InstanceCount == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.InstanceCount), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"InstanceRole\":"...)
+	if v.InstanceRole == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceRole)
+	}
+	b = append(b, ',')
+	b = append(b, "\"InstanceType\":"...)
+	if v.InstanceType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceType)
+	}
+	b = append(b, ',')
+	if v.Market != nil {
+		b = append(b, "\"Market\":"...)
+		b = aws.AppendJSONString(b, *v.Market)
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // InstanceGroupDetail is undocumented.
 type InstanceGroupDetail struct {
-	BidPrice              aws.StringValue    `json:"BidPrice,omitempty"`
-	CreationDateTime      *aws.LongTimestamp `json:"CreationDateTime"`
-	EndDateTime           *aws.LongTimestamp `json:"EndDateTime,omitempty"`
-	InstanceGroupID       aws.StringValue    `json:"InstanceGroupId,omitempty"`
-	InstanceRequestCount  aws.IntegerValue   `json:"InstanceRequestCount"`
-	InstanceRole          aws.StringValue    `json:"InstanceRole"`
-	InstanceRunningCount  aws.IntegerValue   `json:"InstanceRunningCount"`
-	InstanceType          aws.StringValue    `json:"InstanceType"`
-	LastStateChangeReason aws.StringValue    `json:"LastStateChangeReason,omitempty"`
-	Market                aws.StringValue    `json:"Market"`
-	Name                  aws.StringValue    `json:"Name,omitempty"`
-	ReadyDateTime         *aws.LongTimestamp `json:"ReadyDateTime,omitempty"`
-	StartDateTime         *aws.LongTimestamp `json:"StartDateTime,omitempty"`
-	State                 aws.StringValue    `json:"State"`
+	BidPrice              aws.StringValue     `json:"BidPrice,omitempty"`
+	CreationDateTime      *aws.FloatTimestamp `json:"CreationDateTime"`
+	EndDateTime           *aws.FloatTimestamp `json:"EndDateTime,omitempty"`
+	InstanceGroupID       aws.StringValue     `json:"InstanceGroupId,omitempty"`
+	InstanceRequestCount  aws.IntegerValue    `json:"InstanceRequestCount"`
+	InstanceRole          aws.StringValue     `json:"InstanceRole"`
+	InstanceRunningCount  aws.IntegerValue    `json:"InstanceRunningCount"`
+	InstanceType          aws.StringValue     `json:"InstanceType"`
+	LastStateChangeReason aws.StringValue     `json:"LastStateChangeReason,omitempty"`
+	Market                aws.StringValue     `json:"Market"`
+	Name                  aws.StringValue     `json:"Name,omitempty"`
+	ReadyDateTime         *aws.FloatTimestamp `json:"ReadyDateTime,omitempty"`
+	StartDateTime         *aws.FloatTimestamp `json:"StartDateTime,omitempty"`
+	State                 aws.StringValue     `json:"State"`
 }
 
 // InstanceGroupModifyConfig is undocumented.
@@ -504,6 +1084,52 @@ type InstanceGroupModifyConfig struct {
 	InstanceGroupID           aws.StringValue  `json:"InstanceGroupId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *InstanceGroupModifyConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the InstanceGroupModifyConfig to b.
+func (v *InstanceGroupModifyConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.EC2InstanceIDsToTerminate) != 0 {
+		b = append(b, "\"EC2InstanceIdsToTerminate\":"...)
+		b = append(b, '[')
+		for i1 := range v.EC2InstanceIDsToTerminate {
+			b = aws.AppendJSONString(b, v.EC2InstanceIDsToTerminate[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.InstanceCount != nil {
+		b = append(b, "\"InstanceCount\":"...)
+		b = strconv.AppendInt(b, int64(*v.InstanceCount), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"InstanceGroupId\":"...)
+	if v.InstanceGroupID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceGroupID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for EMR.
 const (
 	InstanceGroupStateArrested      = "ARRESTED"
@@ -541,9 +1167,9 @@ type InstanceGroupStatus struct {
 
 // InstanceGroupTimeline is undocumented.
 type InstanceGroupTimeline struct {
-	CreationDateTime *aws.LongTimestamp `json:"CreationDateTime,omitempty"`
-	EndDateTime      *aws.LongTimestamp `json:"EndDateTime,omitempty"`
-	ReadyDateTime    *aws.LongTimestamp `json:"ReadyDateTime,omitempty"`
+	CreationDateTime *aws.FloatTimestamp `json:"CreationDateTime,omitempty"`
+	EndDateTime      *aws.FloatTimestamp `json:"EndDateTime,omitempty"`
+	ReadyDateTime    *aws.FloatTimestamp `json:"ReadyDateTime,omitempty"`
 }
 
 // Possible values for EMR.
@@ -593,9 +1219,9 @@ type InstanceStatus struct {
 
 // InstanceTimeline is undocumented.
 type InstanceTimeline struct {
-	CreationDateTime *aws.LongTimestamp `json:"CreationDateTime,omitempty"`
-	EndDateTime      *aws.LongTimestamp `json:"EndDateTime,omitempty"`
-	ReadyDateTime    *aws.LongTimestamp `json:"ReadyDateTime,omitempty"`
+	CreationDateTime *aws.FloatTimestamp `json:"CreationDateTime,omitempty"`
+	EndDateTime      *aws.FloatTimestamp `json:"EndDateTime,omitempty"`
+	ReadyDateTime    *aws.FloatTimestamp `json:"ReadyDateTime,omitempty"`
 }
 
 // JobFlowDetail is undocumented.
@@ -628,12 +1254,12 @@ const (
 
 // JobFlowExecutionStatusDetail is undocumented.
 type JobFlowExecutionStatusDetail struct {
-	CreationDateTime      *aws.LongTimestamp `json:"CreationDateTime"`
-	EndDateTime           *aws.LongTimestamp `json:"EndDateTime,omitempty"`
-	LastStateChangeReason aws.StringValue    `json:"LastStateChangeReason,omitempty"`
-	ReadyDateTime         *aws.LongTimestamp `json:"ReadyDateTime,omitempty"`
-	StartDateTime         *aws.LongTimestamp `json:"StartDateTime,omitempty"`
-	State                 aws.StringValue    `json:"State"`
+	CreationDateTime      *aws.FloatTimestamp `json:"CreationDateTime"`
+	EndDateTime           *aws.FloatTimestamp `json:"EndDateTime,omitempty"`
+	LastStateChangeReason aws.StringValue     `json:"LastStateChangeReason,omitempty"`
+	ReadyDateTime         *aws.FloatTimestamp `json:"ReadyDateTime,omitempty"`
+	StartDateTime         *aws.FloatTimestamp `json:"StartDateTime,omitempty"`
+	State                 aws.StringValue     `json:"State"`
 }
 
 // JobFlowInstancesConfig is undocumented.
@@ -650,6 +1276,91 @@ type JobFlowInstancesConfig struct {
 	TerminationProtected        aws.BooleanValue      `json:"TerminationProtected,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *JobFlowInstancesConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the JobFlowInstancesConfig to b.
+func (v *JobFlowInstancesConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.EC2KeyName != nil {
+		b = append(b, "\"Ec2KeyName\":"...)
+		b = aws.AppendJSONString(b, *v.EC2KeyName)
+		b = append(b, ',')
+	}
+	if v.EC2SubnetID != nil {
+		b = append(b, "\"Ec2SubnetId\":"...)
+		b = aws.AppendJSONString(b, *v.EC2SubnetID)
+		b = append(b, ',')
+	}
+	if v.HadoopVersion != nil {
+		b = append(b, "\"HadoopVersion\":"...)
+		b = aws.AppendJSONString(b, *v.HadoopVersion)
+		b = append(b, ',')
+	}
+	if v.InstanceCount != nil {
+		b = append(b, "\"InstanceCount\":"...)
+		b = strconv.AppendInt(b, int64(*v.InstanceCount), 10)
+		b = append(b, ',')
+	}
+	if len(v.InstanceGroups) != 0 {
+		b = append(b, "\"InstanceGroups\":"...)
+		b = append(b, '[')
+		for i1 := range v.InstanceGroups {
+			b, err = v.InstanceGroups[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.KeepJobFlowAliveWhenNoSteps != nil {
+		b = append(b, "\"KeepJobFlowAliveWhenNoSteps\":"...)
+		b = strconv.AppendBool(b, *v.KeepJobFlowAliveWhenNoSteps)
+		b = append(b, ',')
+	}
+	if v.MasterInstanceType != nil {
+		b = append(b, "\"MasterInstanceType\":"...)
+		b = aws.AppendJSONString(b, *v.MasterInstanceType)
+		b = append(b, ',')
+	}
+	if v.Placement != nil {
+		b = append(b, "\"Placement\":"...)
+		b, err = v.Placement.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.SlaveInstanceType != nil {
+		b = append(b, "\"SlaveInstanceType\":"...)
+		b = aws.AppendJSONString(b, *v.SlaveInstanceType)
+		b = append(b, ',')
+	}
+	if v.TerminationProtected != nil {
+		b = append(b, "\"TerminationProtected\":"...)
+		b = strconv.AppendBool(b, *v.TerminationProtected)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // JobFlowInstancesDetail is undocumented.
 type JobFlowInstancesDetail struct {
 	EC2KeyName                  aws.StringValue       `json:"Ec2KeyName,omitempty"`
@@ -673,12 +1384,74 @@ type KeyValue struct {
 	Value aws.StringValue `json:"Value,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *KeyValue) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the KeyValue to b.
+func (v *KeyValue) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Key != nil {
+		b = append(b, "\"Key\":"...)
+		b = aws.AppendJSONString(b, *v.Key)
+		b = append(b, ',')
+	}
+	if v.Value != nil {
+		b = append(b, "\"Value\":"...)
+		b = aws.AppendJSONString(b, *v.Value)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListBootstrapActionsInput is undocumented.
 type ListBootstrapActionsInput struct {
 	ClusterID aws.StringValue `json:"ClusterId"`
 	Marker    aws.StringValue `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListBootstrapActionsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListBootstrapActionsInput to b.
+func (v *ListBootstrapActionsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClusterId\":"...)
+	if v.ClusterID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClusterID)
+	}
+	b = append(b, ',')
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListBootstrapActionsOutput is undocumented.
 type ListBootstrapActionsOutput struct {
 	BootstrapActions []Command       `json:"BootstrapActions,omitempty"`
@@ -687,10 +1460,65 @@ type ListBootstrapActionsOutput struct {
 
 // ListClustersInput is undocumented.
 type ListClustersInput struct {
-	ClusterStates []string           `json:"ClusterStates,omitempty"`
-	CreatedAfter  *aws.LongTimestamp `json:"CreatedAfter,omitempty"`
-	CreatedBefore *aws.LongTimestamp `json:"CreatedBefore,omitempty"`
-	Marker        aws.StringValue    `json:"Marker,omitempty"`
+	ClusterStates []string            `json:"ClusterStates,omitempty"`
+	CreatedAfter  *aws.FloatTimestamp `json:"CreatedAfter,omitempty"`
+	CreatedBefore *aws.FloatTimestamp `json:"CreatedBefore,omitempty"`
+	Marker        aws.StringValue     `json:"Marker,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListClustersInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListClustersInput to b.
+func (v *ListClustersInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.ClusterStates) != 0 {
+		b = append(b, "\"ClusterStates\":"...)
+		b = append(b, '[')
+		for i1 := range v.ClusterStates {
+			b = aws.AppendJSONString(b, v.ClusterStates[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.CreatedAfter != nil {
+		b = append(b, "\"CreatedAfter\":"...)
+		b, err = aws.AppendJSON(b, v.CreatedAfter)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CreatedBefore != nil {
+		b = append(b, "\"CreatedBefore\":"...)
+		b, err = aws.AppendJSON(b, v.CreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // ListClustersOutput is undocumented.
@@ -705,6 +1533,38 @@ type ListInstanceGroupsInput struct {
 	Marker    aws.StringValue `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListInstanceGroupsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListInstanceGroupsInput to b.
+func (v *ListInstanceGroupsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClusterId\":"...)
+	if v.ClusterID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClusterID)
+	}
+	b = append(b, ',')
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListInstanceGroupsOutput is undocumented.
 type ListInstanceGroupsOutput struct {
 	InstanceGroups []InstanceGroup `json:"InstanceGroups,omitempty"`
@@ -719,6 +1579,57 @@ type ListInstancesInput struct {
 	Marker             aws.StringValue `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListInstancesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListInstancesInput to b.
+func (v *ListInstancesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClusterId\":"...)
+	if v.ClusterID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClusterID)
+	}
+	b = append(b, ',')
+	if v.InstanceGroupID != nil {
+		b = append(b, "\"InstanceGroupId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceGroupID)
+		b = append(b, ',')
+	}
+	if len(v.InstanceGroupTypes) != 0 {
+		b = append(b, "\"InstanceGroupTypes\":"...)
+		b = append(b, '[')
+		for i1 := range v.InstanceGroupTypes {
+			b = aws.AppendJSONString(b, v.InstanceGroupTypes[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListInstancesOutput is undocumented.
 type ListInstancesOutput struct {
 	Instances []Instance      `json:"Instances,omitempty"`
@@ -732,6 +1643,52 @@ type ListStepsInput struct {
 	StepStates []string        `json:"StepStates,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListStepsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListStepsInput to b.
+func (v *ListStepsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClusterId\":"...)
+	if v.ClusterID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClusterID)
+	}
+	b = append(b, ',')
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if len(v.StepStates) != 0 {
+		b = append(b, "\"StepStates\":"...)
+		b = append(b, '[')
+		for i1 := range v.StepStates {
+			b = aws.AppendJSONString(b, v.StepStates[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListStepsOutput is undocumented.
 type ListStepsOutput struct {
 	Marker aws.StringValue `json:"Marker,omitempty"`
@@ -749,17 +1706,124 @@ type ModifyInstanceGroupsInput struct {
 	InstanceGroups []InstanceGroupModifyConfig `json:"InstanceGroups,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ModifyInstanceGroupsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ModifyInstanceGroupsInput to b.
+func (v *ModifyInstanceGroupsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.InstanceGroups) != 0 {
+		b = append(b, "\"InstanceGroups\":"...)
+		b = append(b, '[')
+		for i1 := range v.InstanceGroups {
+			b, err = v.InstanceGroups[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PlacementType is undocumented.
 type PlacementType struct {
 	AvailabilityZone aws.StringValue `json:"AvailabilityZone"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PlacementType) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PlacementType to b.
+func (v *PlacementType) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AvailabilityZone\":"...)
+	if v.AvailabilityZone == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AvailabilityZone)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RemoveTagsInput is undocumented.
 type RemoveTagsInput struct {
 	ResourceID aws.StringValue `json:"ResourceId"`
 	TagKeys    []string        `json:"TagKeys"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RemoveTagsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RemoveTagsInput to b.
+func (v *RemoveTagsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ResourceId\":"...)
+	if v.ResourceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ResourceID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TagKeys\":"...)
+	if v.TagKeys == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.TagKeys {
+			b = aws.AppendJSONString(b, v.TagKeys[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RemoveTagsOutput is undocumented.
 type RemoveTagsOutput struct {
 }
@@ -781,6 +1845,155 @@ type RunJobFlowInput struct {
 	VisibleToAllUsers    aws.BooleanValue         `json:"VisibleToAllUsers,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RunJobFlowInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RunJobFlowInput to b.
+func (v *RunJobFlowInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AdditionalInfo != nil {
+		b = append(b, "\"AdditionalInfo\":"...)
+		b = aws.AppendJSONString(b, *v.AdditionalInfo)
+		b = append(b, ',')
+	}
+	if v.AMIVersion != nil {
+		b = append(b, "\"AmiVersion\":"...)
+		b = aws.AppendJSONString(b, *v.AMIVersion)
+		b = append(b, ',')
+	}
+	if len(v.BootstrapActions) != 0 {
+		b = append(b, "\"BootstrapActions\":"...)
+		b = append(b, '[')
+		for i1 := range v.BootstrapActions {
+			b, err = v.BootstrapActions[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Instances\":"...)
+	if v.Instances == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Instances.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.JobFlowRole != nil {
+		b = append(b, "\"JobFlowRole\":"...)
+		b = aws.AppendJSONString(b, *v.JobFlowRole)
+		b = append(b, ',')
+	}
+	if v.LogURI != nil {
+		b = append(b, "\"LogUri\":"...)
+		b = aws.AppendJSONString(b, *v.LogURI)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if len(v.NewSupportedProducts) != 0 {
+		b = append(b, "\"NewSupportedProducts\":"...)
+		b = append(b, '[')
+		for i1 := range v.NewSupportedProducts {
+			b, err = v.NewSupportedProducts[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ServiceRole != nil {
+		b = append(b, "\"ServiceRole\":"...)
+		b = aws.AppendJSONString(b, *v.ServiceRole)
+		b = append(b, ',')
+	}
+	if len(v.Steps) != 0 {
+		b = append(b, "\"Steps\":"...)
+		b = append(b, '[')
+		for i1 := range v.Steps {
+			b, err = v.Steps[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.SupportedProducts) != 0 {
+		b = append(b, "\"SupportedProducts\":"...)
+		b = append(b, '[')
+		for i1 := range v.SupportedProducts {
+			b = aws.AppendJSONString(b, v.SupportedProducts[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Tags) != 0 {
+		b = append(b, "\"Tags\":"...)
+		b = append(b, '[')
+		for i1 := range v.Tags {
+			b, err = v.Tags[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.VisibleToAllUsers != nil {
+		b = append(b, "\"VisibleToAllUsers\":"...)
+		b = strconv.AppendBool(b, *v.VisibleToAllUsers)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RunJobFlowOutput is undocumented.
 type RunJobFlowOutput struct {
 	JobFlowID aws.StringValue `json:"JobFlowId,omitempty"`
@@ -792,18 +2005,145 @@ type ScriptBootstrapActionConfig struct {
 	Path aws.StringValue `json:"Path"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ScriptBootstrapActionConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ScriptBootstrapActionConfig to b.
+func (v *ScriptBootstrapActionConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Args) != 0 {
+		b = append(b, "\"Args\":"...)
+		b = append(b, '[')
+		for i1 := range v.Args {
+			b = aws.AppendJSONString(b, v.Args[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Path\":"...)
+	if v.Path == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Path)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SetTerminationProtectionInput is undocumented.
 type SetTerminationProtectionInput struct {
 	JobFlowIDs           []string         `json:"JobFlowIds"`
 	TerminationProtected aws.BooleanValue `json:"TerminationProtected"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SetTerminationProtectionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SetTerminationProtectionInput to b.
+func (v *SetTerminationProtectionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"JobFlowIds\":"...)
+	if v.JobFlowIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.JobFlowIDs {
+			b = aws.AppendJSONString(b, v.JobFlowIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"TerminationProtected\":"...)
+	if v.TerminationProtected == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendBool(b, *v.TerminationProtected)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SetVisibleToAllUsersInput is undocumented.
 type SetVisibleToAllUsersInput struct {
 	JobFlowIDs        []string         `json:"JobFlowIds"`
 	VisibleToAllUsers aws.BooleanValue `json:"VisibleToAllUsers"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SetVisibleToAllUsersInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SetVisibleToAllUsersInput to b.
+func (v *SetVisibleToAllUsersInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"JobFlowIds\":"...)
+	if v.JobFlowIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.JobFlowIDs {
+			b = aws.AppendJSONString(b, v.JobFlowIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"VisibleToAllUsers\":"...)
+	if v.VisibleToAllUsers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendBool(b, *v.VisibleToAllUsers)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Step is undocumented.
 type Step struct {
 	ActionOnFailure aws.StringValue   `json:"ActionOnFailure,omitempty"`
@@ -820,6 +2160,48 @@ type StepConfig struct {
 	Name            aws.StringValue      `json:"Name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StepConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StepConfig to b.
+func (v *StepConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ActionOnFailure != nil {
+		b = append(b, "\"ActionOnFailure\":"...)
+		b = aws.AppendJSONString(b, *v.ActionOnFailure)
+		b = append(b, ',')
+	}
+	b = append(b, "\"HadoopJarStep\":"...)
+	if v.HadoopJARStep == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.HadoopJARStep.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StepDetail is undocumented.
 type StepDetail struct {
 	ExecutionStatusDetail *StepExecutionStatusDetail `json:"ExecutionStatusDetail"`
@@ -839,11 +2221,11 @@ const (
 
 // StepExecutionStatusDetail is undocumented.
 type StepExecutionStatusDetail struct {
-	CreationDateTime      *aws.LongTimestamp `json:"CreationDateTime"`
-	EndDateTime           *aws.LongTimestamp `json:"EndDateTime,omitempty"`
-	LastStateChangeReason aws.StringValue    `json:"LastStateChangeReason,omitempty"`
-	StartDateTime         *aws.LongTimestamp `json:"StartDateTime,omitempty"`
-	State                 aws.StringValue    `json:"State"`
+	CreationDateTime      *aws.FloatTimestamp `json:"CreationDateTime"`
+	EndDateTime           *aws.FloatTimestamp `json:"EndDateTime,omitempty"`
+	LastStateChangeReason aws.StringValue     `json:"LastStateChangeReason,omitempty"`
+	StartDateTime         *aws.FloatTimestamp `json:"StartDateTime,omitempty"`
+	State                 aws.StringValue     `json:"State"`
 }
 
 // Possible values for EMR.
@@ -883,9 +2265,9 @@ type StepSummary struct {
 
 // StepTimeline is undocumented.
 type StepTimeline struct {
-	CreationDateTime *aws.LongTimestamp `json:"CreationDateTime,omitempty"`
-	EndDateTime      *aws.LongTimestamp `json:"EndDateTime,omitempty"`
-	StartDateTime    *aws.LongTimestamp `json:"StartDateTime,omitempty"`
+	CreationDateTime *aws.FloatTimestamp `json:"CreationDateTime,omitempty"`
+	EndDateTime      *aws.FloatTimestamp `json:"EndDateTime,omitempty"`
+	StartDateTime    *aws.FloatTimestamp `json:"StartDateTime,omitempty"`
 }
 
 // SupportedProductConfig is undocumented.
@@ -894,16 +2276,148 @@ type SupportedProductConfig struct {
 	Name aws.StringValue `json:"Name,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SupportedProductConfig) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SupportedProductConfig to b.
+func (v *SupportedProductConfig) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Args) != 0 {
+		b = append(b, "\"Args\":"...)
+		b = append(b, '[')
+		for i1 := range v.Args {
+			b = aws.AppendJSONString(b, v.Args[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Tag is undocumented.
 type Tag struct {
 	Key   aws.StringValue `json:"Key,omitempty"`
 	Value aws.StringValue `json:"Value,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Tag) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Tag to b.
+func (v *Tag) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Key != nil {
+		b = append(b, "\"Key\":"...)
+		b = aws.AppendJSONString(b, *v.Key)
+		b = append(b, ',')
+	}
+	if v.Value != nil {
+		b = append(b, "\"Value\":"...)
+		b = aws.AppendJSONString(b, *v.Value)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TerminateJobFlowsInput is undocumented.
 type TerminateJobFlowsInput struct {
 	JobFlowIDs []string `json:"JobFlowIds"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *TerminateJobFlowsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the TerminateJobFlowsInput to b.
+func (v *TerminateJobFlowsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"JobFlowIds\":"...)
+	if v.JobFlowIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.JobFlowIDs {
+			b = aws.AppendJSONString(b, v.JobFlowIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
+// InternalServerError is the error returned for the InternalServerError error code.
+type InternalServerError struct {
+	aws.APIError
+}
+
+// InternalServerException is the error returned for the InternalServerException error code.
+type InternalServerException struct {
+	aws.APIError
+}
+
+// InvalidRequestException is the error returned for the InvalidRequestException error code.
+type InvalidRequestException struct {
+	aws.APIError
+
+	ErrorCode aws.StringValue `json:"ErrorCode,omitempty"`
+}
+
+// exceptions maps EMR's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InternalServerError":     InternalServerError{},
+	"InternalServerException": InternalServerException{},
+	"InvalidRequestException": InvalidRequestException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -2,6 +2,7 @@
 package gen
 
 //go:generate aws-gen-goendpoints ../apis/_endpoints.json endpoints/endpoints.go
+//go:generate aws-gen-goretry ../apis/_retry.json retry/retry.go
 //go:generate aws-gen-gocli AutoScaling ../apis/autoscaling/2011-01-01.api.json autoscaling/autoscaling.go
 //go:generate aws-gen-gocli CloudFormation ../apis/cloudformation/2010-05-15.api.json cloudformation/cloudformation.go
 //go:generate aws-gen-gocli CloudFront ../apis/cloudfront/2014-10-21.api.json cloudfront/cloudfront.go
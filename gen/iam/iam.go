@@ -4,11 +4,21 @@
 package iam
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // IAM is a client for AWS Identity and Access Management.
@@ -16,36 +26,84 @@ type IAM struct {
 	client *aws.QueryClient
 }
 
-// New returns a new IAM client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *IAM {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "iam"
-	endpoint, service, region := endpoints.Lookup("iam", region)
+// New returns a new IAM client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *IAM {
+	cfg := newConfig(region, client, opts)
 
 	return &IAM{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2010-05-08",
 		},
 	}
 }
 
+// NewFromSession returns a new IAM client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *IAM {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("iam", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("iam", "iam"),
+	}, "AWS_ENDPOINT_URL_IAM", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *IAM) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *IAM) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *IAM) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AddClientIDToOpenIDConnectProvider adds a new client ID (also known as
 // audience) to the list of client IDs already registered for the specified
 // IAM OpenID Connect provider. This action is idempotent; it does not fail
 // or return an error if you add an existing client ID to the provider.
 func (c *IAM) AddClientIDToOpenIDConnectProvider(req *AddClientIDToOpenIDConnectProviderRequest) (err error) {
+	return c.AddClientIDToOpenIDConnectProviderWithContext(context.Background(), req)
+}
+
+// AddClientIDToOpenIDConnectProviderWithContext is like AddClientIDToOpenIDConnectProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) AddClientIDToOpenIDConnectProviderWithContext(ctx context.Context, req *AddClientIDToOpenIDConnectProviderRequest) (err error) {
 	// NRE
-	err = c.client.Do("AddClientIDToOpenIDConnectProvider", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AddClientIDToOpenIDConnectProvider", "POST", "/", req, nil)
 	return
 }
 
@@ -54,15 +112,29 @@ func (c *IAM) AddClientIDToOpenIDConnectProvider(req *AddClientIDToOpenIDConnect
 // Roles . For more information about instance profiles, go to About
 // Instance Profiles .
 func (c *IAM) AddRoleToInstanceProfile(req *AddRoleToInstanceProfileRequest) (err error) {
+	return c.AddRoleToInstanceProfileWithContext(context.Background(), req)
+}
+
+// AddRoleToInstanceProfileWithContext is like AddRoleToInstanceProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) AddRoleToInstanceProfileWithContext(ctx context.Context, req *AddRoleToInstanceProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("AddRoleToInstanceProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AddRoleToInstanceProfile", "POST", "/", req, nil)
 	return
 }
 
 // AddUserToGroup is undocumented.
 func (c *IAM) AddUserToGroup(req *AddUserToGroupRequest) (err error) {
+	return c.AddUserToGroupWithContext(context.Background(), req)
+}
+
+// AddUserToGroupWithContext is like AddUserToGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) AddUserToGroupWithContext(ctx context.Context, req *AddUserToGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("AddUserToGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AddUserToGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -72,8 +144,15 @@ func (c *IAM) AddUserToGroup(req *AddUserToGroupRequest) (err error) {
 // more information about modifying passwords, see Managing Passwords in
 // the Using guide.
 func (c *IAM) ChangePassword(req *ChangePasswordRequest) (err error) {
+	return c.ChangePasswordWithContext(context.Background(), req)
+}
+
+// ChangePasswordWithContext is like ChangePassword, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ChangePasswordWithContext(ctx context.Context, req *ChangePasswordRequest) (err error) {
 	// NRE
-	err = c.client.Do("ChangePassword", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ChangePassword", "POST", "/", req, nil)
 	return
 }
 
@@ -91,8 +170,15 @@ func (c *IAM) ChangePassword(req *ChangePasswordRequest) (err error) {
 // secret key is lost, you can delete the access keys for the associated
 // user and then create new keys.
 func (c *IAM) CreateAccessKey(req *CreateAccessKeyRequest) (resp *CreateAccessKeyResult, err error) {
+	return c.CreateAccessKeyWithContext(context.Background(), req)
+}
+
+// CreateAccessKeyWithContext is like CreateAccessKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateAccessKeyWithContext(ctx context.Context, req *CreateAccessKeyRequest) (resp *CreateAccessKeyResult, err error) {
 	resp = &CreateAccessKeyResult{}
-	err = c.client.Do("CreateAccessKey", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateAccessKey", "POST", "/", req, resp)
 	return
 }
 
@@ -100,8 +186,15 @@ func (c *IAM) CreateAccessKey(req *CreateAccessKeyRequest) (resp *CreateAccessKe
 // information about using an AWS account alias, see Using an Alias for
 // Your AWS Account in the Using guide.
 func (c *IAM) CreateAccountAlias(req *CreateAccountAliasRequest) (err error) {
+	return c.CreateAccountAliasWithContext(context.Background(), req)
+}
+
+// CreateAccountAliasWithContext is like CreateAccountAlias, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateAccountAliasWithContext(ctx context.Context, req *CreateAccountAliasRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateAccountAlias", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateAccountAlias", "POST", "/", req, nil)
 	return
 }
 
@@ -109,8 +202,15 @@ func (c *IAM) CreateAccountAlias(req *CreateAccountAliasRequest) (err error) {
 // groups you can create, see Limitations on IAM Entities in the Using
 // guide.
 func (c *IAM) CreateGroup(req *CreateGroupRequest) (resp *CreateGroupResult, err error) {
+	return c.CreateGroupWithContext(context.Background(), req)
+}
+
+// CreateGroupWithContext is like CreateGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateGroupWithContext(ctx context.Context, req *CreateGroupRequest) (resp *CreateGroupResult, err error) {
 	resp = &CreateGroupResult{}
-	err = c.client.Do("CreateGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -119,8 +219,15 @@ func (c *IAM) CreateGroup(req *CreateGroupRequest) (resp *CreateGroupResult, err
 // about the number of instance profiles you can create, see Limitations on
 // IAM Entities in the Using guide.
 func (c *IAM) CreateInstanceProfile(req *CreateInstanceProfileRequest) (resp *CreateInstanceProfileResult, err error) {
+	return c.CreateInstanceProfileWithContext(context.Background(), req)
+}
+
+// CreateInstanceProfileWithContext is like CreateInstanceProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateInstanceProfileWithContext(ctx context.Context, req *CreateInstanceProfileRequest) (resp *CreateInstanceProfileResult, err error) {
 	resp = &CreateInstanceProfileResult{}
-	err = c.client.Do("CreateInstanceProfile", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateInstanceProfile", "POST", "/", req, resp)
 	return
 }
 
@@ -129,8 +236,15 @@ func (c *IAM) CreateInstanceProfile(req *CreateInstanceProfileRequest) (resp *Cr
 // Console. For more information about managing passwords, see Managing
 // Passwords in the Using guide.
 func (c *IAM) CreateLoginProfile(req *CreateLoginProfileRequest) (resp *CreateLoginProfileResult, err error) {
+	return c.CreateLoginProfileWithContext(context.Background(), req)
+}
+
+// CreateLoginProfileWithContext is like CreateLoginProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateLoginProfileWithContext(ctx context.Context, req *CreateLoginProfileRequest) (resp *CreateLoginProfileResult, err error) {
 	resp = &CreateLoginProfileResult{}
-	err = c.client.Do("CreateLoginProfile", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateLoginProfile", "POST", "/", req, resp)
 	return
 }
 
@@ -148,8 +262,15 @@ func (c *IAM) CreateLoginProfile(req *CreateLoginProfileRequest) (resp *CreateLo
 // this action creates, it is a best practice to limit access to the
 // CreateOpenIDConnectProvider action to highly-privileged users.
 func (c *IAM) CreateOpenIDConnectProvider(req *CreateOpenIDConnectProviderRequest) (resp *CreateOpenIDConnectProviderResult, err error) {
+	return c.CreateOpenIDConnectProviderWithContext(context.Background(), req)
+}
+
+// CreateOpenIDConnectProviderWithContext is like CreateOpenIDConnectProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateOpenIDConnectProviderWithContext(ctx context.Context, req *CreateOpenIDConnectProviderRequest) (resp *CreateOpenIDConnectProviderResult, err error) {
 	resp = &CreateOpenIDConnectProviderResult{}
-	err = c.client.Do("CreateOpenIDConnectProvider", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateOpenIDConnectProvider", "POST", "/", req, resp)
 	return
 }
 
@@ -161,8 +282,15 @@ func (c *IAM) CreateOpenIDConnectProvider(req *CreateOpenIDConnectProviderReques
 // URL-encoded according to RFC 3986. For more information about RFC 3986,
 // go to http://www.faqs.org/rfcs/rfc3986.html .
 func (c *IAM) CreateRole(req *CreateRoleRequest) (resp *CreateRoleResult, err error) {
+	return c.CreateRoleWithContext(context.Background(), req)
+}
+
+// CreateRoleWithContext is like CreateRole, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateRoleWithContext(ctx context.Context, req *CreateRoleRequest) (resp *CreateRoleResult, err error) {
 	resp = &CreateRoleResult{}
-	err = c.client.Do("CreateRole", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateRole", "POST", "/", req, resp)
 	return
 }
 
@@ -182,8 +310,15 @@ func (c *IAM) CreateRole(req *CreateRoleRequest) (resp *CreateRoleResult, err er
 // Temporary Security Credentials for Federation in the Using Temporary
 // Credentials guide.
 func (c *IAM) CreateSAMLProvider(req *CreateSAMLProviderRequest) (resp *CreateSAMLProviderResult, err error) {
+	return c.CreateSAMLProviderWithContext(context.Background(), req)
+}
+
+// CreateSAMLProviderWithContext is like CreateSAMLProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateSAMLProviderWithContext(ctx context.Context, req *CreateSAMLProviderRequest) (resp *CreateSAMLProviderResult, err error) {
 	resp = &CreateSAMLProviderResult{}
-	err = c.client.Do("CreateSAMLProvider", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSAMLProvider", "POST", "/", req, resp)
 	return
 }
 
@@ -191,8 +326,15 @@ func (c *IAM) CreateSAMLProvider(req *CreateSAMLProviderRequest) (resp *CreateSA
 // about limitations on the number of users you can create, see Limitations
 // on IAM Entities in the Using guide.
 func (c *IAM) CreateUser(req *CreateUserRequest) (resp *CreateUserResult, err error) {
+	return c.CreateUserWithContext(context.Background(), req)
+}
+
+// CreateUserWithContext is like CreateUser, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateUserWithContext(ctx context.Context, req *CreateUserRequest) (resp *CreateUserResult, err error) {
 	resp = &CreateUserResult{}
-	err = c.client.Do("CreateUser", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateUser", "POST", "/", req, resp)
 	return
 }
 
@@ -208,8 +350,15 @@ func (c *IAM) CreateUser(req *CreateUserRequest) (resp *CreateUserResult, err er
 // device, you should ensure that the information is destroyed following
 // secure procedures.
 func (c *IAM) CreateVirtualMFADevice(req *CreateVirtualMFADeviceRequest) (resp *CreateVirtualMFADeviceResult, err error) {
+	return c.CreateVirtualMFADeviceWithContext(context.Background(), req)
+}
+
+// CreateVirtualMFADeviceWithContext is like CreateVirtualMFADevice, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) CreateVirtualMFADeviceWithContext(ctx context.Context, req *CreateVirtualMFADeviceRequest) (resp *CreateVirtualMFADeviceResult, err error) {
 	resp = &CreateVirtualMFADeviceResult{}
-	err = c.client.Do("CreateVirtualMFADevice", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateVirtualMFADevice", "POST", "/", req, resp)
 	return
 }
 
@@ -218,8 +367,15 @@ func (c *IAM) CreateVirtualMFADevice(req *CreateVirtualMFADeviceRequest) (resp *
 // For more information about creating and working with virtual MFA
 // devices, go to Using a Virtual MFA Device in the Using guide.
 func (c *IAM) DeactivateMFADevice(req *DeactivateMFADeviceRequest) (err error) {
+	return c.DeactivateMFADeviceWithContext(context.Background(), req)
+}
+
+// DeactivateMFADeviceWithContext is like DeactivateMFADevice, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeactivateMFADeviceWithContext(ctx context.Context, req *DeactivateMFADeviceRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeactivateMFADevice", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeactivateMFADevice", "POST", "/", req, nil)
 	return
 }
 
@@ -230,8 +386,15 @@ func (c *IAM) DeactivateMFADevice(req *DeactivateMFADeviceRequest) (err error) {
 // this action to manage root credentials even if the AWS account has no
 // associated users.
 func (c *IAM) DeleteAccessKey(req *DeleteAccessKeyRequest) (err error) {
+	return c.DeleteAccessKeyWithContext(context.Background(), req)
+}
+
+// DeleteAccessKeyWithContext is like DeleteAccessKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteAccessKeyWithContext(ctx context.Context, req *DeleteAccessKeyRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteAccessKey", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteAccessKey", "POST", "/", req, nil)
 	return
 }
 
@@ -239,31 +402,59 @@ func (c *IAM) DeleteAccessKey(req *DeleteAccessKeyRequest) (err error) {
 // information about using an AWS account alias, see Using an Alias for
 // Your AWS Account in the Using guide.
 func (c *IAM) DeleteAccountAlias(req *DeleteAccountAliasRequest) (err error) {
+	return c.DeleteAccountAliasWithContext(context.Background(), req)
+}
+
+// DeleteAccountAliasWithContext is like DeleteAccountAlias, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteAccountAliasWithContext(ctx context.Context, req *DeleteAccountAliasRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteAccountAlias", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteAccountAlias", "POST", "/", req, nil)
 	return
 }
 
 // DeleteAccountPasswordPolicy is undocumented.
 func (c *IAM) DeleteAccountPasswordPolicy() (err error) {
+	return c.DeleteAccountPasswordPolicyWithContext(context.Background())
+}
+
+// DeleteAccountPasswordPolicyWithContext is like DeleteAccountPasswordPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteAccountPasswordPolicyWithContext(ctx context.Context) (err error) {
 	// NRE
-	err = c.client.Do("DeleteAccountPasswordPolicy", "POST", "/", nil, nil)
+	err = c.client.DoWithContext(ctx, "DeleteAccountPasswordPolicy", "POST", "/", nil, nil)
 	return
 }
 
 // DeleteGroup deletes the specified group. The group must not contain any
 // users or have any attached policies.
 func (c *IAM) DeleteGroup(req *DeleteGroupRequest) (err error) {
+	return c.DeleteGroupWithContext(context.Background(), req)
+}
+
+// DeleteGroupWithContext is like DeleteGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteGroupWithContext(ctx context.Context, req *DeleteGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteGroupPolicy deletes the specified policy that is associated with
 // the specified group.
 func (c *IAM) DeleteGroupPolicy(req *DeleteGroupPolicyRequest) (err error) {
+	return c.DeleteGroupPolicyWithContext(context.Background(), req)
+}
+
+// DeleteGroupPolicyWithContext is like DeleteGroupPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteGroupPolicyWithContext(ctx context.Context, req *DeleteGroupPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteGroupPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteGroupPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -275,8 +466,15 @@ func (c *IAM) DeleteGroupPolicy(req *DeleteGroupPolicyRequest) (err error) {
 // instance. For more information about instance profiles, go to About
 // Instance Profiles .
 func (c *IAM) DeleteInstanceProfile(req *DeleteInstanceProfileRequest) (err error) {
+	return c.DeleteInstanceProfileWithContext(context.Background(), req)
+}
+
+// DeleteInstanceProfileWithContext is like DeleteInstanceProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteInstanceProfileWithContext(ctx context.Context, req *DeleteInstanceProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteInstanceProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteInstanceProfile", "POST", "/", req, nil)
 	return
 }
 
@@ -288,8 +486,15 @@ func (c *IAM) DeleteInstanceProfile(req *DeleteInstanceProfileRequest) (err erro
 // delete it. For more information about making keys inactive or deleting
 // them, see UpdateAccessKey and DeleteAccessKey .
 func (c *IAM) DeleteLoginProfile(req *DeleteLoginProfileRequest) (err error) {
+	return c.DeleteLoginProfileWithContext(context.Background(), req)
+}
+
+// DeleteLoginProfileWithContext is like DeleteLoginProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteLoginProfileWithContext(ctx context.Context, req *DeleteLoginProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteLoginProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteLoginProfile", "POST", "/", req, nil)
 	return
 }
 
@@ -300,8 +505,15 @@ func (c *IAM) DeleteLoginProfile(req *DeleteLoginProfileRequest) (err error) {
 // fail. This action is idempotent; it does not fail or return an error if
 // you call the action for a provider that was already deleted.
 func (c *IAM) DeleteOpenIDConnectProvider(req *DeleteOpenIDConnectProviderRequest) (err error) {
+	return c.DeleteOpenIDConnectProviderWithContext(context.Background(), req)
+}
+
+// DeleteOpenIDConnectProviderWithContext is like DeleteOpenIDConnectProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteOpenIDConnectProviderWithContext(ctx context.Context, req *DeleteOpenIDConnectProviderRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteOpenIDConnectProvider", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteOpenIDConnectProvider", "POST", "/", req, nil)
 	return
 }
 
@@ -312,16 +524,30 @@ func (c *IAM) DeleteOpenIDConnectProvider(req *DeleteOpenIDConnectProviderReques
 // that is associated with a running instance will break any applications
 // running on the instance.
 func (c *IAM) DeleteRole(req *DeleteRoleRequest) (err error) {
+	return c.DeleteRoleWithContext(context.Background(), req)
+}
+
+// DeleteRoleWithContext is like DeleteRole, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteRoleWithContext(ctx context.Context, req *DeleteRoleRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteRole", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteRole", "POST", "/", req, nil)
 	return
 }
 
 // DeleteRolePolicy deletes the specified policy associated with the
 // specified role.
 func (c *IAM) DeleteRolePolicy(req *DeleteRolePolicyRequest) (err error) {
+	return c.DeleteRolePolicyWithContext(context.Background(), req)
+}
+
+// DeleteRolePolicyWithContext is like DeleteRolePolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteRolePolicyWithContext(ctx context.Context, req *DeleteRolePolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteRolePolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteRolePolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -331,8 +557,15 @@ func (c *IAM) DeleteRolePolicy(req *DeleteRolePolicyRequest) (err error) {
 // that has been deleted will fail. This operation requires Signature
 // Version 4 .
 func (c *IAM) DeleteSAMLProvider(req *DeleteSAMLProviderRequest) (err error) {
+	return c.DeleteSAMLProviderWithContext(context.Background(), req)
+}
+
+// DeleteSAMLProviderWithContext is like DeleteSAMLProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteSAMLProviderWithContext(ctx context.Context, req *DeleteSAMLProviderRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteSAMLProvider", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteSAMLProvider", "POST", "/", req, nil)
 	return
 }
 
@@ -347,8 +580,15 @@ func (c *IAM) DeleteSAMLProvider(req *DeleteSAMLProviderRequest) (err error) {
 // DeleteLoadBalancerListeners in the Elastic Load Balancing API Reference
 // .
 func (c *IAM) DeleteServerCertificate(req *DeleteServerCertificateRequest) (err error) {
+	return c.DeleteServerCertificateWithContext(context.Background(), req)
+}
+
+// DeleteServerCertificateWithContext is like DeleteServerCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteServerCertificateWithContext(ctx context.Context, req *DeleteServerCertificateRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteServerCertificate", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteServerCertificate", "POST", "/", req, nil)
 	return
 }
 
@@ -359,8 +599,15 @@ func (c *IAM) DeleteServerCertificate(req *DeleteServerCertificateRequest) (err
 // AWS account, you can use this action to manage root credentials even if
 // the AWS account has no associated users.
 func (c *IAM) DeleteSigningCertificate(req *DeleteSigningCertificateRequest) (err error) {
+	return c.DeleteSigningCertificateWithContext(context.Background(), req)
+}
+
+// DeleteSigningCertificateWithContext is like DeleteSigningCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteSigningCertificateWithContext(ctx context.Context, req *DeleteSigningCertificateRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteSigningCertificate", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteSigningCertificate", "POST", "/", req, nil)
 	return
 }
 
@@ -368,16 +615,30 @@ func (c *IAM) DeleteSigningCertificate(req *DeleteSigningCertificateRequest) (er
 // groups, have any keys or signing certificates, or have any attached
 // policies.
 func (c *IAM) DeleteUser(req *DeleteUserRequest) (err error) {
+	return c.DeleteUserWithContext(context.Background(), req)
+}
+
+// DeleteUserWithContext is like DeleteUser, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteUserWithContext(ctx context.Context, req *DeleteUserRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteUser", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteUser", "POST", "/", req, nil)
 	return
 }
 
 // DeleteUserPolicy deletes the specified policy associated with the
 // specified user.
 func (c *IAM) DeleteUserPolicy(req *DeleteUserPolicyRequest) (err error) {
+	return c.DeleteUserPolicyWithContext(context.Background(), req)
+}
+
+// DeleteUserPolicyWithContext is like DeleteUserPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteUserPolicyWithContext(ctx context.Context, req *DeleteUserPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteUserPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteUserPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -385,8 +646,15 @@ func (c *IAM) DeleteUserPolicy(req *DeleteUserPolicyRequest) (err error) {
 // a user's virtual MFA device before you can delete it. For information
 // about deactivating MFA devices, see DeactivateMFADevice .
 func (c *IAM) DeleteVirtualMFADevice(req *DeleteVirtualMFADeviceRequest) (err error) {
+	return c.DeleteVirtualMFADeviceWithContext(context.Background(), req)
+}
+
+// DeleteVirtualMFADeviceWithContext is like DeleteVirtualMFADevice, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) DeleteVirtualMFADeviceWithContext(ctx context.Context, req *DeleteVirtualMFADeviceRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteVirtualMFADevice", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteVirtualMFADevice", "POST", "/", req, nil)
 	return
 }
 
@@ -394,8 +662,15 @@ func (c *IAM) DeleteVirtualMFADevice(req *DeleteVirtualMFADeviceRequest) (err er
 // the specified user name. When enabled, the MFA device is required for
 // every subsequent login by the user name associated with the device.
 func (c *IAM) EnableMFADevice(req *EnableMFADeviceRequest) (err error) {
+	return c.EnableMFADeviceWithContext(context.Background(), req)
+}
+
+// EnableMFADeviceWithContext is like EnableMFADevice, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) EnableMFADeviceWithContext(ctx context.Context, req *EnableMFADeviceRequest) (err error) {
 	// NRE
-	err = c.client.Do("EnableMFADevice", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "EnableMFADevice", "POST", "/", req, nil)
 	return
 }
 
@@ -403,8 +678,15 @@ func (c *IAM) EnableMFADevice(req *EnableMFADeviceRequest) (err error) {
 // account. For more information about the credential report, see Getting
 // Credential Reports in the Using guide.
 func (c *IAM) GenerateCredentialReport() (resp *GenerateCredentialReportResult, err error) {
+	return c.GenerateCredentialReportWithContext(context.Background())
+}
+
+// GenerateCredentialReportWithContext is like GenerateCredentialReport, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GenerateCredentialReportWithContext(ctx context.Context) (resp *GenerateCredentialReportResult, err error) {
 	resp = &GenerateCredentialReportResult{}
-	err = c.client.Do("GenerateCredentialReport", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "GenerateCredentialReport", "POST", "/", nil, resp)
 	return
 }
 
@@ -416,8 +698,15 @@ func (c *IAM) GenerateCredentialReport() (resp *GenerateCredentialReportResult,
 // using the Filter parameter. You can paginate the results using the
 // MaxItems and Marker parameters.
 func (c *IAM) GetAccountAuthorizationDetails(req *GetAccountAuthorizationDetailsRequest) (resp *GetAccountAuthorizationDetailsResult, err error) {
+	return c.GetAccountAuthorizationDetailsWithContext(context.Background(), req)
+}
+
+// GetAccountAuthorizationDetailsWithContext is like GetAccountAuthorizationDetails, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetAccountAuthorizationDetailsWithContext(ctx context.Context, req *GetAccountAuthorizationDetailsRequest) (resp *GetAccountAuthorizationDetailsResult, err error) {
 	resp = &GetAccountAuthorizationDetailsResult{}
-	err = c.client.Do("GetAccountAuthorizationDetails", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetAccountAuthorizationDetails", "POST", "/", req, resp)
 	return
 }
 
@@ -425,8 +714,15 @@ func (c *IAM) GetAccountAuthorizationDetails(req *GetAccountAuthorizationDetails
 // account. For more information about using a password policy, go to
 // Managing an IAM Password Policy .
 func (c *IAM) GetAccountPasswordPolicy() (resp *GetAccountPasswordPolicyResult, err error) {
+	return c.GetAccountPasswordPolicyWithContext(context.Background())
+}
+
+// GetAccountPasswordPolicyWithContext is like GetAccountPasswordPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetAccountPasswordPolicyWithContext(ctx context.Context) (resp *GetAccountPasswordPolicyResult, err error) {
 	resp = &GetAccountPasswordPolicyResult{}
-	err = c.client.Do("GetAccountPasswordPolicy", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "GetAccountPasswordPolicy", "POST", "/", nil, resp)
 	return
 }
 
@@ -434,8 +730,15 @@ func (c *IAM) GetAccountPasswordPolicy() (resp *GetAccountPasswordPolicyResult,
 // entity usage and IAM quotas. For information about limitations on IAM
 // entities, see Limitations on IAM Entities in the Using guide.
 func (c *IAM) GetAccountSummary() (resp *GetAccountSummaryResult, err error) {
+	return c.GetAccountSummaryWithContext(context.Background())
+}
+
+// GetAccountSummaryWithContext is like GetAccountSummary, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetAccountSummaryWithContext(ctx context.Context) (resp *GetAccountSummaryResult, err error) {
 	resp = &GetAccountSummaryResult{}
-	err = c.client.Do("GetAccountSummary", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "GetAccountSummary", "POST", "/", nil, resp)
 	return
 }
 
@@ -443,16 +746,30 @@ func (c *IAM) GetAccountSummary() (resp *GetAccountSummaryResult, err error) {
 // For more information about the credential report, see Getting Credential
 // Reports in the Using guide.
 func (c *IAM) GetCredentialReport() (resp *GetCredentialReportResult, err error) {
+	return c.GetCredentialReportWithContext(context.Background())
+}
+
+// GetCredentialReportWithContext is like GetCredentialReport, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetCredentialReportWithContext(ctx context.Context) (resp *GetCredentialReportResult, err error) {
 	resp = &GetCredentialReportResult{}
-	err = c.client.Do("GetCredentialReport", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "GetCredentialReport", "POST", "/", nil, resp)
 	return
 }
 
 // GetGroup returns a list of users that are in the specified group. You
 // can paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) GetGroup(req *GetGroupRequest) (resp *GetGroupResult, err error) {
+	return c.GetGroupWithContext(context.Background(), req)
+}
+
+// GetGroupWithContext is like GetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetGroupWithContext(ctx context.Context, req *GetGroupRequest) (resp *GetGroupResult, err error) {
 	resp = &GetGroupResult{}
-	err = c.client.Do("GetGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -461,8 +778,15 @@ func (c *IAM) GetGroup(req *GetGroupRequest) (resp *GetGroupResult, err error) {
 // more information about RFC 3986, go to
 // http://www.faqs.org/rfcs/rfc3986.html .
 func (c *IAM) GetGroupPolicy(req *GetGroupPolicyRequest) (resp *GetGroupPolicyResult, err error) {
+	return c.GetGroupPolicyWithContext(context.Background(), req)
+}
+
+// GetGroupPolicyWithContext is like GetGroupPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetGroupPolicyWithContext(ctx context.Context, req *GetGroupPolicyRequest) (resp *GetGroupPolicyResult, err error) {
 	resp = &GetGroupPolicyResult{}
-	err = c.client.Do("GetGroupPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetGroupPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -471,8 +795,15 @@ func (c *IAM) GetGroupPolicy(req *GetGroupPolicyRequest) (resp *GetGroupPolicyRe
 // information about instance profiles, go to About Instance Profiles . For
 // more information about ARNs, go to ARNs .
 func (c *IAM) GetInstanceProfile(req *GetInstanceProfileRequest) (resp *GetInstanceProfileResult, err error) {
+	return c.GetInstanceProfileWithContext(context.Background(), req)
+}
+
+// GetInstanceProfileWithContext is like GetInstanceProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetInstanceProfileWithContext(ctx context.Context, req *GetInstanceProfileRequest) (resp *GetInstanceProfileResult, err error) {
 	resp = &GetInstanceProfileResult{}
-	err = c.client.Do("GetInstanceProfile", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetInstanceProfile", "POST", "/", req, resp)
 	return
 }
 
@@ -480,16 +811,30 @@ func (c *IAM) GetInstanceProfile(req *GetInstanceProfileRequest) (resp *GetInsta
 // the specified user. If the user has not been assigned a password, the
 // action returns a 404 NoSuchEntity ) error.
 func (c *IAM) GetLoginProfile(req *GetLoginProfileRequest) (resp *GetLoginProfileResult, err error) {
+	return c.GetLoginProfileWithContext(context.Background(), req)
+}
+
+// GetLoginProfileWithContext is like GetLoginProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetLoginProfileWithContext(ctx context.Context, req *GetLoginProfileRequest) (resp *GetLoginProfileResult, err error) {
 	resp = &GetLoginProfileResult{}
-	err = c.client.Do("GetLoginProfile", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetLoginProfile", "POST", "/", req, resp)
 	return
 }
 
 // GetOpenIDConnectProvider returns information about the specified OpenID
 // Connect provider.
 func (c *IAM) GetOpenIDConnectProvider(req *GetOpenIDConnectProviderRequest) (resp *GetOpenIDConnectProviderResult, err error) {
+	return c.GetOpenIDConnectProviderWithContext(context.Background(), req)
+}
+
+// GetOpenIDConnectProviderWithContext is like GetOpenIDConnectProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetOpenIDConnectProviderWithContext(ctx context.Context, req *GetOpenIDConnectProviderRequest) (resp *GetOpenIDConnectProviderResult, err error) {
 	resp = &GetOpenIDConnectProviderResult{}
-	err = c.client.Do("GetOpenIDConnectProvider", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetOpenIDConnectProvider", "POST", "/", req, resp)
 	return
 }
 
@@ -500,8 +845,15 @@ func (c *IAM) GetOpenIDConnectProvider(req *GetOpenIDConnectProviderRequest) (re
 // according to RFC 3986. For more information about RFC 3986, go to
 // http://www.faqs.org/rfcs/rfc3986.html .
 func (c *IAM) GetRole(req *GetRoleRequest) (resp *GetRoleResult, err error) {
+	return c.GetRoleWithContext(context.Background(), req)
+}
+
+// GetRoleWithContext is like GetRole, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetRoleWithContext(ctx context.Context, req *GetRoleRequest) (resp *GetRoleResult, err error) {
 	resp = &GetRoleResult{}
-	err = c.client.Do("GetRole", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetRole", "POST", "/", req, resp)
 	return
 }
 
@@ -511,8 +863,15 @@ func (c *IAM) GetRole(req *GetRoleRequest) (resp *GetRoleResult, err error) {
 // information about RFC 3986, go to http://www.faqs.org/rfcs/rfc3986.html
 // .
 func (c *IAM) GetRolePolicy(req *GetRolePolicyRequest) (resp *GetRolePolicyResult, err error) {
+	return c.GetRolePolicyWithContext(context.Background(), req)
+}
+
+// GetRolePolicyWithContext is like GetRolePolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetRolePolicyWithContext(ctx context.Context, req *GetRolePolicyRequest) (resp *GetRolePolicyResult, err error) {
 	resp = &GetRolePolicyResult{}
-	err = c.client.Do("GetRolePolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetRolePolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -520,16 +879,30 @@ func (c *IAM) GetRolePolicy(req *GetRolePolicyRequest) (resp *GetRolePolicyResul
 // the provider was created or updated. This operation requires Signature
 // Version 4 .
 func (c *IAM) GetSAMLProvider(req *GetSAMLProviderRequest) (resp *GetSAMLProviderResult, err error) {
+	return c.GetSAMLProviderWithContext(context.Background(), req)
+}
+
+// GetSAMLProviderWithContext is like GetSAMLProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetSAMLProviderWithContext(ctx context.Context, req *GetSAMLProviderRequest) (resp *GetSAMLProviderResult, err error) {
 	resp = &GetSAMLProviderResult{}
-	err = c.client.Do("GetSAMLProvider", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetSAMLProvider", "POST", "/", req, resp)
 	return
 }
 
 // GetServerCertificate retrieves information about the specified server
 // certificate.
 func (c *IAM) GetServerCertificate(req *GetServerCertificateRequest) (resp *GetServerCertificateResult, err error) {
+	return c.GetServerCertificateWithContext(context.Background(), req)
+}
+
+// GetServerCertificateWithContext is like GetServerCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetServerCertificateWithContext(ctx context.Context, req *GetServerCertificateRequest) (resp *GetServerCertificateResult, err error) {
 	resp = &GetServerCertificateResult{}
-	err = c.client.Do("GetServerCertificate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetServerCertificate", "POST", "/", req, resp)
 	return
 }
 
@@ -538,8 +911,15 @@ func (c *IAM) GetServerCertificate(req *GetServerCertificateRequest) (resp *GetS
 // name, IAM determines the user name implicitly based on the AWS access
 // key ID used to sign the request.
 func (c *IAM) GetUser(req *GetUserRequest) (resp *GetUserResult, err error) {
+	return c.GetUserWithContext(context.Background(), req)
+}
+
+// GetUserWithContext is like GetUser, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetUserWithContext(ctx context.Context, req *GetUserRequest) (resp *GetUserResult, err error) {
 	resp = &GetUserResult{}
-	err = c.client.Do("GetUser", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetUser", "POST", "/", req, resp)
 	return
 }
 
@@ -548,8 +928,15 @@ func (c *IAM) GetUser(req *GetUserRequest) (resp *GetUserResult, err error) {
 // information about RFC 3986, go to http://www.faqs.org/rfcs/rfc3986.html
 // .
 func (c *IAM) GetUserPolicy(req *GetUserPolicyRequest) (resp *GetUserPolicyResult, err error) {
+	return c.GetUserPolicyWithContext(context.Background(), req)
+}
+
+// GetUserPolicyWithContext is like GetUserPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) GetUserPolicyWithContext(ctx context.Context, req *GetUserPolicyRequest) (resp *GetUserPolicyResult, err error) {
 	resp = &GetUserPolicyResult{}
-	err = c.client.Do("GetUserPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetUserPolicy", "POST", "/", req, resp)
 	return
 }
 
@@ -564,8 +951,15 @@ func (c *IAM) GetUserPolicy(req *GetUserPolicyRequest) (resp *GetUserPolicyResul
 // no associated users. To ensure the security of your AWS account, the
 // secret access key is accessible only during key and user creation.
 func (c *IAM) ListAccessKeys(req *ListAccessKeysRequest) (resp *ListAccessKeysResult, err error) {
+	return c.ListAccessKeysWithContext(context.Background(), req)
+}
+
+// ListAccessKeysWithContext is like ListAccessKeys, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListAccessKeysWithContext(ctx context.Context, req *ListAccessKeysRequest) (resp *ListAccessKeysResult, err error) {
 	resp = &ListAccessKeysResult{}
-	err = c.client.Do("ListAccessKeys", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListAccessKeys", "POST", "/", req, resp)
 	return
 }
 
@@ -574,8 +968,15 @@ func (c *IAM) ListAccessKeys(req *ListAccessKeysRequest) (resp *ListAccessKeysRe
 // Alias for Your AWS Account in the Using guide. You can paginate the
 // results using the MaxItems and Marker parameters.
 func (c *IAM) ListAccountAliases(req *ListAccountAliasesRequest) (resp *ListAccountAliasesResult, err error) {
+	return c.ListAccountAliasesWithContext(context.Background(), req)
+}
+
+// ListAccountAliasesWithContext is like ListAccountAliases, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListAccountAliasesWithContext(ctx context.Context, req *ListAccountAliasesRequest) (resp *ListAccountAliasesResult, err error) {
 	resp = &ListAccountAliasesResult{}
-	err = c.client.Do("ListAccountAliases", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListAccountAliases", "POST", "/", req, resp)
 	return
 }
 
@@ -583,24 +984,45 @@ func (c *IAM) ListAccountAliases(req *ListAccountAliasesRequest) (resp *ListAcco
 // specified group. If there are none, the action returns an empty list.
 // You can paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListGroupPolicies(req *ListGroupPoliciesRequest) (resp *ListGroupPoliciesResult, err error) {
+	return c.ListGroupPoliciesWithContext(context.Background(), req)
+}
+
+// ListGroupPoliciesWithContext is like ListGroupPolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListGroupPoliciesWithContext(ctx context.Context, req *ListGroupPoliciesRequest) (resp *ListGroupPoliciesResult, err error) {
 	resp = &ListGroupPoliciesResult{}
-	err = c.client.Do("ListGroupPolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListGroupPolicies", "POST", "/", req, resp)
 	return
 }
 
 // ListGroups lists the groups that have the specified path prefix. You can
 // paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListGroups(req *ListGroupsRequest) (resp *ListGroupsResult, err error) {
+	return c.ListGroupsWithContext(context.Background(), req)
+}
+
+// ListGroupsWithContext is like ListGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListGroupsWithContext(ctx context.Context, req *ListGroupsRequest) (resp *ListGroupsResult, err error) {
 	resp = &ListGroupsResult{}
-	err = c.client.Do("ListGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListGroups", "POST", "/", req, resp)
 	return
 }
 
 // ListGroupsForUser lists the groups the specified user belongs to. You
 // can paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListGroupsForUser(req *ListGroupsForUserRequest) (resp *ListGroupsForUserResult, err error) {
+	return c.ListGroupsForUserWithContext(context.Background(), req)
+}
+
+// ListGroupsForUserWithContext is like ListGroupsForUser, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListGroupsForUserWithContext(ctx context.Context, req *ListGroupsForUserRequest) (resp *ListGroupsForUserResult, err error) {
 	resp = &ListGroupsForUserResult{}
-	err = c.client.Do("ListGroupsForUser", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListGroupsForUser", "POST", "/", req, resp)
 	return
 }
 
@@ -609,8 +1031,15 @@ func (c *IAM) ListGroupsForUser(req *ListGroupsForUserRequest) (resp *ListGroups
 // more information about instance profiles, go to About Instance Profiles
 // . You can paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListInstanceProfiles(req *ListInstanceProfilesRequest) (resp *ListInstanceProfilesResult, err error) {
+	return c.ListInstanceProfilesWithContext(context.Background(), req)
+}
+
+// ListInstanceProfilesWithContext is like ListInstanceProfiles, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListInstanceProfilesWithContext(ctx context.Context, req *ListInstanceProfilesRequest) (resp *ListInstanceProfilesResult, err error) {
 	resp = &ListInstanceProfilesResult{}
-	err = c.client.Do("ListInstanceProfiles", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListInstanceProfiles", "POST", "/", req, resp)
 	return
 }
 
@@ -620,8 +1049,15 @@ func (c *IAM) ListInstanceProfiles(req *ListInstanceProfilesRequest) (resp *List
 // Instance Profiles . You can paginate the results using the MaxItems and
 // Marker parameters.
 func (c *IAM) ListInstanceProfilesForRole(req *ListInstanceProfilesForRoleRequest) (resp *ListInstanceProfilesForRoleResult, err error) {
+	return c.ListInstanceProfilesForRoleWithContext(context.Background(), req)
+}
+
+// ListInstanceProfilesForRoleWithContext is like ListInstanceProfilesForRole, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListInstanceProfilesForRoleWithContext(ctx context.Context, req *ListInstanceProfilesForRoleRequest) (resp *ListInstanceProfilesForRoleResult, err error) {
 	resp = &ListInstanceProfilesForRoleResult{}
-	err = c.client.Do("ListInstanceProfilesForRole", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListInstanceProfilesForRole", "POST", "/", req, resp)
 	return
 }
 
@@ -632,16 +1068,30 @@ func (c *IAM) ListInstanceProfilesForRole(req *ListInstanceProfilesForRoleReques
 // request. You can paginate the results using the MaxItems and Marker
 // parameters.
 func (c *IAM) ListMFADevices(req *ListMFADevicesRequest) (resp *ListMFADevicesResult, err error) {
+	return c.ListMFADevicesWithContext(context.Background(), req)
+}
+
+// ListMFADevicesWithContext is like ListMFADevices, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListMFADevicesWithContext(ctx context.Context, req *ListMFADevicesRequest) (resp *ListMFADevicesResult, err error) {
 	resp = &ListMFADevicesResult{}
-	err = c.client.Do("ListMFADevices", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListMFADevices", "POST", "/", req, resp)
 	return
 }
 
 // ListOpenIDConnectProviders lists information about the OpenID Connect
 // providers in the AWS account.
 func (c *IAM) ListOpenIDConnectProviders(req *ListOpenIDConnectProvidersRequest) (resp *ListOpenIDConnectProvidersResult, err error) {
+	return c.ListOpenIDConnectProvidersWithContext(context.Background(), req)
+}
+
+// ListOpenIDConnectProvidersWithContext is like ListOpenIDConnectProviders, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListOpenIDConnectProvidersWithContext(ctx context.Context, req *ListOpenIDConnectProvidersRequest) (resp *ListOpenIDConnectProvidersResult, err error) {
 	resp = &ListOpenIDConnectProvidersResult{}
-	err = c.client.Do("ListOpenIDConnectProviders", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListOpenIDConnectProviders", "POST", "/", req, resp)
 	return
 }
 
@@ -649,8 +1099,15 @@ func (c *IAM) ListOpenIDConnectProviders(req *ListOpenIDConnectProvidersRequest)
 // specified role. If there are none, the action returns an empty list. You
 // can paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListRolePolicies(req *ListRolePoliciesRequest) (resp *ListRolePoliciesResult, err error) {
+	return c.ListRolePoliciesWithContext(context.Background(), req)
+}
+
+// ListRolePoliciesWithContext is like ListRolePolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListRolePoliciesWithContext(ctx context.Context, req *ListRolePoliciesRequest) (resp *ListRolePoliciesResult, err error) {
 	resp = &ListRolePoliciesResult{}
-	err = c.client.Do("ListRolePolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListRolePolicies", "POST", "/", req, resp)
 	return
 }
 
@@ -661,15 +1118,29 @@ func (c *IAM) ListRolePolicies(req *ListRolePoliciesRequest) (resp *ListRolePoli
 // according to RFC 3986. For more information about RFC 3986, go to
 // http://www.faqs.org/rfcs/rfc3986.html .
 func (c *IAM) ListRoles(req *ListRolesRequest) (resp *ListRolesResult, err error) {
+	return c.ListRolesWithContext(context.Background(), req)
+}
+
+// ListRolesWithContext is like ListRoles, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListRolesWithContext(ctx context.Context, req *ListRolesRequest) (resp *ListRolesResult, err error) {
 	resp = &ListRolesResult{}
-	err = c.client.Do("ListRoles", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListRoles", "POST", "/", req, resp)
 	return
 }
 
 // ListSAMLProviders is undocumented.
 func (c *IAM) ListSAMLProviders(req *ListSAMLProvidersRequest) (resp *ListSAMLProvidersResult, err error) {
+	return c.ListSAMLProvidersWithContext(context.Background(), req)
+}
+
+// ListSAMLProvidersWithContext is like ListSAMLProviders, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListSAMLProvidersWithContext(ctx context.Context, req *ListSAMLProvidersRequest) (resp *ListSAMLProvidersResult, err error) {
 	resp = &ListSAMLProvidersResult{}
-	err = c.client.Do("ListSAMLProviders", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListSAMLProviders", "POST", "/", req, resp)
 	return
 }
 
@@ -677,8 +1148,15 @@ func (c *IAM) ListSAMLProviders(req *ListSAMLProvidersRequest) (resp *ListSAMLPr
 // specified path prefix. If none exist, the action returns an empty list.
 // You can paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListServerCertificates(req *ListServerCertificatesRequest) (resp *ListServerCertificatesResult, err error) {
+	return c.ListServerCertificatesWithContext(context.Background(), req)
+}
+
+// ListServerCertificatesWithContext is like ListServerCertificates, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListServerCertificatesWithContext(ctx context.Context, req *ListServerCertificatesRequest) (resp *ListServerCertificatesResult, err error) {
 	resp = &ListServerCertificatesResult{}
-	err = c.client.Do("ListServerCertificates", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListServerCertificates", "POST", "/", req, resp)
 	return
 }
 
@@ -692,8 +1170,15 @@ func (c *IAM) ListServerCertificates(req *ListServerCertificatesRequest) (resp *
 // access keys under the AWS account, you can use this action to manage
 // root credentials even if the AWS account has no associated users.
 func (c *IAM) ListSigningCertificates(req *ListSigningCertificatesRequest) (resp *ListSigningCertificatesResult, err error) {
+	return c.ListSigningCertificatesWithContext(context.Background(), req)
+}
+
+// ListSigningCertificatesWithContext is like ListSigningCertificates, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListSigningCertificatesWithContext(ctx context.Context, req *ListSigningCertificatesRequest) (resp *ListSigningCertificatesResult, err error) {
 	resp = &ListSigningCertificatesResult{}
-	err = c.client.Do("ListSigningCertificates", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListSigningCertificates", "POST", "/", req, resp)
 	return
 }
 
@@ -701,8 +1186,15 @@ func (c *IAM) ListSigningCertificates(req *ListSigningCertificatesRequest) (resp
 // specified user. If there are none, the action returns an empty list. You
 // can paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListUserPolicies(req *ListUserPoliciesRequest) (resp *ListUserPoliciesResult, err error) {
+	return c.ListUserPoliciesWithContext(context.Background(), req)
+}
+
+// ListUserPoliciesWithContext is like ListUserPolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListUserPoliciesWithContext(ctx context.Context, req *ListUserPoliciesRequest) (resp *ListUserPoliciesResult, err error) {
 	resp = &ListUserPoliciesResult{}
-	err = c.client.Do("ListUserPolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListUserPolicies", "POST", "/", req, resp)
 	return
 }
 
@@ -711,8 +1203,15 @@ func (c *IAM) ListUserPolicies(req *ListUserPoliciesRequest) (resp *ListUserPoli
 // account. If there are none, the action returns an empty list. You can
 // paginate the results using the MaxItems and Marker parameters.
 func (c *IAM) ListUsers(req *ListUsersRequest) (resp *ListUsersResult, err error) {
+	return c.ListUsersWithContext(context.Background(), req)
+}
+
+// ListUsersWithContext is like ListUsers, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListUsersWithContext(ctx context.Context, req *ListUsersRequest) (resp *ListUsersResult, err error) {
 	resp = &ListUsersResult{}
-	err = c.client.Do("ListUsers", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListUsers", "POST", "/", req, resp)
 	return
 }
 
@@ -722,8 +1221,15 @@ func (c *IAM) ListUsers(req *ListUsersRequest) (resp *ListUsersResult, err error
 // status can be Assigned , Unassigned , or Any . You can paginate the
 // results using the MaxItems and Marker parameters.
 func (c *IAM) ListVirtualMFADevices(req *ListVirtualMFADevicesRequest) (resp *ListVirtualMFADevicesResult, err error) {
+	return c.ListVirtualMFADevicesWithContext(context.Background(), req)
+}
+
+// ListVirtualMFADevicesWithContext is like ListVirtualMFADevices, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ListVirtualMFADevicesWithContext(ctx context.Context, req *ListVirtualMFADevicesRequest) (resp *ListVirtualMFADevicesResult, err error) {
 	resp = &ListVirtualMFADevicesResult{}
-	err = c.client.Do("ListVirtualMFADevices", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListVirtualMFADevices", "POST", "/", req, resp)
 	return
 }
 
@@ -738,8 +1244,15 @@ func (c *IAM) ListVirtualMFADevices(req *ListVirtualMFADevicesRequest) (resp *Li
 // about using the Query API with go to Making Query Requests in the Using
 // guide.
 func (c *IAM) PutGroupPolicy(req *PutGroupPolicyRequest) (err error) {
+	return c.PutGroupPolicyWithContext(context.Background(), req)
+}
+
+// PutGroupPolicyWithContext is like PutGroupPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) PutGroupPolicyWithContext(ctx context.Context, req *PutGroupPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutGroupPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutGroupPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -754,8 +1267,15 @@ func (c *IAM) PutGroupPolicy(req *PutGroupPolicyRequest) (err error) {
 // about using the Query API with go to Making Query Requests in the Using
 // guide.
 func (c *IAM) PutRolePolicy(req *PutRolePolicyRequest) (err error) {
+	return c.PutRolePolicyWithContext(context.Background(), req)
+}
+
+// PutRolePolicyWithContext is like PutRolePolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) PutRolePolicyWithContext(ctx context.Context, req *PutRolePolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutRolePolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutRolePolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -770,8 +1290,15 @@ func (c *IAM) PutRolePolicy(req *PutRolePolicyRequest) (err error) {
 // about using the Query API with go to Making Query Requests in the Using
 // guide.
 func (c *IAM) PutUserPolicy(req *PutUserPolicyRequest) (err error) {
+	return c.PutUserPolicyWithContext(context.Background(), req)
+}
+
+// PutUserPolicyWithContext is like PutUserPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) PutUserPolicyWithContext(ctx context.Context, req *PutUserPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutUserPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutUserPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -781,8 +1308,15 @@ func (c *IAM) PutUserPolicy(req *PutUserPolicyRequest) (err error) {
 // does not fail or return an error if you try to remove a client ID that
 // was removed previously.
 func (c *IAM) RemoveClientIDFromOpenIDConnectProvider(req *RemoveClientIDFromOpenIDConnectProviderRequest) (err error) {
+	return c.RemoveClientIDFromOpenIDConnectProviderWithContext(context.Background(), req)
+}
+
+// RemoveClientIDFromOpenIDConnectProviderWithContext is like RemoveClientIDFromOpenIDConnectProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) RemoveClientIDFromOpenIDConnectProviderWithContext(ctx context.Context, req *RemoveClientIDFromOpenIDConnectProviderRequest) (err error) {
 	// NRE
-	err = c.client.Do("RemoveClientIDFromOpenIDConnectProvider", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RemoveClientIDFromOpenIDConnectProvider", "POST", "/", req, nil)
 	return
 }
 
@@ -795,15 +1329,29 @@ func (c *IAM) RemoveClientIDFromOpenIDConnectProvider(req *RemoveClientIDFromOpe
 // Roles . For more information about instance profiles, go to About
 // Instance Profiles .
 func (c *IAM) RemoveRoleFromInstanceProfile(req *RemoveRoleFromInstanceProfileRequest) (err error) {
+	return c.RemoveRoleFromInstanceProfileWithContext(context.Background(), req)
+}
+
+// RemoveRoleFromInstanceProfileWithContext is like RemoveRoleFromInstanceProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) RemoveRoleFromInstanceProfileWithContext(ctx context.Context, req *RemoveRoleFromInstanceProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("RemoveRoleFromInstanceProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RemoveRoleFromInstanceProfile", "POST", "/", req, nil)
 	return
 }
 
 // RemoveUserFromGroup removes the specified user from the specified group.
 func (c *IAM) RemoveUserFromGroup(req *RemoveUserFromGroupRequest) (err error) {
+	return c.RemoveUserFromGroupWithContext(context.Background(), req)
+}
+
+// RemoveUserFromGroupWithContext is like RemoveUserFromGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) RemoveUserFromGroupWithContext(ctx context.Context, req *RemoveUserFromGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("RemoveUserFromGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RemoveUserFromGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -811,8 +1359,15 @@ func (c *IAM) RemoveUserFromGroup(req *RemoveUserFromGroupRequest) (err error) {
 // For more information about creating and working with virtual MFA
 // devices, go to Using a Virtual MFA Device in the Using guide.
 func (c *IAM) ResyncMFADevice(req *ResyncMFADeviceRequest) (err error) {
+	return c.ResyncMFADeviceWithContext(context.Background(), req)
+}
+
+// ResyncMFADeviceWithContext is like ResyncMFADevice, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) ResyncMFADeviceWithContext(ctx context.Context, req *ResyncMFADeviceRequest) (err error) {
 	// NRE
-	err = c.client.Do("ResyncMFADevice", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ResyncMFADevice", "POST", "/", req, nil)
 	return
 }
 
@@ -826,8 +1381,15 @@ func (c *IAM) ResyncMFADevice(req *ResyncMFADeviceRequest) (err error) {
 // information about rotating keys, see Managing Keys and Certificates in
 // the Using guide.
 func (c *IAM) UpdateAccessKey(req *UpdateAccessKeyRequest) (err error) {
+	return c.UpdateAccessKeyWithContext(context.Background(), req)
+}
+
+// UpdateAccessKeyWithContext is like UpdateAccessKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateAccessKeyWithContext(ctx context.Context, req *UpdateAccessKeyRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateAccessKey", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateAccessKey", "POST", "/", req, nil)
 	return
 }
 
@@ -838,8 +1400,15 @@ func (c *IAM) UpdateAccessKey(req *UpdateAccessKeyRequest) (err error) {
 // for each parameter's default value. For more information about using a
 // password policy, see Managing an IAM Password Policy in the Using guide.
 func (c *IAM) UpdateAccountPasswordPolicy(req *UpdateAccountPasswordPolicyRequest) (err error) {
+	return c.UpdateAccountPasswordPolicyWithContext(context.Background(), req)
+}
+
+// UpdateAccountPasswordPolicyWithContext is like UpdateAccountPasswordPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateAccountPasswordPolicyWithContext(ctx context.Context, req *UpdateAccountPasswordPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateAccountPasswordPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateAccountPasswordPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -847,8 +1416,15 @@ func (c *IAM) UpdateAccountPasswordPolicy(req *UpdateAccountPasswordPolicyReques
 // permission to assume a role. For more information about roles, go to
 // Working with Roles .
 func (c *IAM) UpdateAssumeRolePolicy(req *UpdateAssumeRolePolicyRequest) (err error) {
+	return c.UpdateAssumeRolePolicyWithContext(context.Background(), req)
+}
+
+// UpdateAssumeRolePolicyWithContext is like UpdateAssumeRolePolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateAssumeRolePolicyWithContext(ctx context.Context, req *UpdateAssumeRolePolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateAssumeRolePolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateAssumeRolePolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -861,8 +1437,15 @@ func (c *IAM) UpdateAssumeRolePolicy(req *UpdateAssumeRolePolicyRequest) (err er
 // Managers and MGRs, or must have permission on all For more information
 // about permissions, see Permissions and Policies .
 func (c *IAM) UpdateGroup(req *UpdateGroupRequest) (err error) {
+	return c.UpdateGroupWithContext(context.Background(), req)
+}
+
+// UpdateGroupWithContext is like UpdateGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateGroupWithContext(ctx context.Context, req *UpdateGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -871,8 +1454,15 @@ func (c *IAM) UpdateGroup(req *UpdateGroupRequest) (err error) {
 // information about modifying passwords, see Managing Passwords in the
 // Using guide.
 func (c *IAM) UpdateLoginProfile(req *UpdateLoginProfileRequest) (err error) {
+	return c.UpdateLoginProfileWithContext(context.Background(), req)
+}
+
+// UpdateLoginProfileWithContext is like UpdateLoginProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateLoginProfileWithContext(ctx context.Context, req *UpdateLoginProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateLoginProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateLoginProfile", "POST", "/", req, nil)
 	return
 }
 
@@ -889,16 +1479,30 @@ func (c *IAM) UpdateLoginProfile(req *UpdateLoginProfileRequest) (err error) {
 // limit access to the UpdateOpenIDConnectProviderThumbprint action to
 // highly-privileged users.
 func (c *IAM) UpdateOpenIDConnectProviderThumbprint(req *UpdateOpenIDConnectProviderThumbprintRequest) (err error) {
+	return c.UpdateOpenIDConnectProviderThumbprintWithContext(context.Background(), req)
+}
+
+// UpdateOpenIDConnectProviderThumbprintWithContext is like UpdateOpenIDConnectProviderThumbprint, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateOpenIDConnectProviderThumbprintWithContext(ctx context.Context, req *UpdateOpenIDConnectProviderThumbprintRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateOpenIDConnectProviderThumbprint", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateOpenIDConnectProviderThumbprint", "POST", "/", req, nil)
 	return
 }
 
 // UpdateSAMLProvider updates the metadata document for an existing
 // provider. This operation requires Signature Version 4 .
 func (c *IAM) UpdateSAMLProvider(req *UpdateSAMLProviderRequest) (resp *UpdateSAMLProviderResult, err error) {
+	return c.UpdateSAMLProviderWithContext(context.Background(), req)
+}
+
+// UpdateSAMLProviderWithContext is like UpdateSAMLProvider, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateSAMLProviderWithContext(ctx context.Context, req *UpdateSAMLProviderRequest) (resp *UpdateSAMLProviderResult, err error) {
 	resp = &UpdateSAMLProviderResult{}
-	err = c.client.Do("UpdateSAMLProvider", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateSAMLProvider", "POST", "/", req, resp)
 	return
 }
 
@@ -913,8 +1517,15 @@ func (c *IAM) UpdateSAMLProvider(req *UpdateSAMLProviderRequest) (resp *UpdateSA
 // all For more information about permissions, see Permissions and Policies
 // .
 func (c *IAM) UpdateServerCertificate(req *UpdateServerCertificateRequest) (err error) {
+	return c.UpdateServerCertificateWithContext(context.Background(), req)
+}
+
+// UpdateServerCertificateWithContext is like UpdateServerCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateServerCertificateWithContext(ctx context.Context, req *UpdateServerCertificateRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateServerCertificate", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateServerCertificate", "POST", "/", req, nil)
 	return
 }
 
@@ -928,8 +1539,15 @@ func (c *IAM) UpdateServerCertificate(req *UpdateServerCertificateRequest) (err
 // AWS account has no associated users. For information about rotating
 // certificates, see Managing Keys and Certificates in the Using guide.
 func (c *IAM) UpdateSigningCertificate(req *UpdateSigningCertificateRequest) (err error) {
+	return c.UpdateSigningCertificateWithContext(context.Background(), req)
+}
+
+// UpdateSigningCertificateWithContext is like UpdateSigningCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateSigningCertificateWithContext(ctx context.Context, req *UpdateSigningCertificateRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateSigningCertificate", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateSigningCertificate", "POST", "/", req, nil)
 	return
 }
 
@@ -942,8 +1560,15 @@ func (c *IAM) UpdateSigningCertificate(req *UpdateSigningCertificateRequest) (er
 // Robert, or must have permission on all For more information about
 // permissions, see Permissions and Policies .
 func (c *IAM) UpdateUser(req *UpdateUserRequest) (err error) {
+	return c.UpdateUserWithContext(context.Background(), req)
+}
+
+// UpdateUserWithContext is like UpdateUser, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UpdateUserWithContext(ctx context.Context, req *UpdateUserRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateUser", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateUser", "POST", "/", req, nil)
 	return
 }
 
@@ -959,8 +1584,15 @@ func (c *IAM) UpdateUser(req *UpdateUserRequest) (err error) {
 // Requests in the AWS General Reference . For general information about
 // using the Query API with go to Making Query Requests in the Using guide.
 func (c *IAM) UploadServerCertificate(req *UploadServerCertificateRequest) (resp *UploadServerCertificateResult, err error) {
+	return c.UploadServerCertificateWithContext(context.Background(), req)
+}
+
+// UploadServerCertificateWithContext is like UploadServerCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UploadServerCertificateWithContext(ctx context.Context, req *UploadServerCertificateRequest) (resp *UploadServerCertificateResult, err error) {
 	resp = &UploadServerCertificateResult{}
-	err = c.client.Do("UploadServerCertificate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UploadServerCertificate", "POST", "/", req, resp)
 	return
 }
 
@@ -979,8 +1611,15 @@ func (c *IAM) UploadServerCertificate(req *UploadServerCertificateRequest) (resp
 // General Reference . For general information about using the Query API
 // with go to Making Query Requests in the Using guide.
 func (c *IAM) UploadSigningCertificate(req *UploadSigningCertificateRequest) (resp *UploadSigningCertificateResult, err error) {
+	return c.UploadSigningCertificateWithContext(context.Background(), req)
+}
+
+// UploadSigningCertificateWithContext is like UploadSigningCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *IAM) UploadSigningCertificateWithContext(ctx context.Context, req *UploadSigningCertificateRequest) (resp *UploadSigningCertificateResult, err error) {
 	resp = &UploadSigningCertificateResult{}
-	err = c.client.Do("UploadSigningCertificate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UploadSigningCertificate", "POST", "/", req, resp)
 	return
 }
 
@@ -988,7 +1627,7 @@ func (c *IAM) UploadSigningCertificate(req *UploadSigningCertificateRequest) (re
 type AccessKey struct {
 	AccessKeyID     aws.StringValue `query:"AccessKeyId" xml:"AccessKeyId"`
 	CreateDate      time.Time       `query:"CreateDate" xml:"CreateDate"`
-	SecretAccessKey aws.StringValue `query:"SecretAccessKey" xml:"SecretAccessKey"`
+	SecretAccessKey aws.StringValue `query:"SecretAccessKey" xml:"SecretAccessKey" sensitive:"SecretAccessKey"`
 	Status          aws.StringValue `query:"Status" xml:"Status"`
 	UserName        aws.StringValue `query:"UserName" xml:"UserName"`
 }
@@ -1007,22 +1646,78 @@ type AddClientIDToOpenIDConnectProviderRequest struct {
 	OpenIDConnectProviderARN aws.StringValue `query:"OpenIDConnectProviderArn" xml:"OpenIDConnectProviderArn"`
 }
 
+// MarshalQuery adds the parameters of the AddClientIDToOpenIDConnectProviderRequest to the form, prefixing their names.
+func (v *AddClientIDToOpenIDConnectProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClientID != nil {
+		form.Set(prefix+"ClientID", *v.ClientID)
+	}
+	if v.OpenIDConnectProviderARN != nil {
+		form.Set(prefix+"OpenIDConnectProviderArn", *v.OpenIDConnectProviderARN)
+	}
+	return nil
+}
+
 // AddRoleToInstanceProfileRequest is undocumented.
 type AddRoleToInstanceProfileRequest struct {
 	InstanceProfileName aws.StringValue `query:"InstanceProfileName" xml:"InstanceProfileName"`
 	RoleName            aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the AddRoleToInstanceProfileRequest to the form, prefixing their names.
+func (v *AddRoleToInstanceProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceProfileName != nil {
+		form.Set(prefix+"InstanceProfileName", *v.InstanceProfileName)
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // AddUserToGroupRequest is undocumented.
 type AddUserToGroupRequest struct {
 	GroupName aws.StringValue `query:"GroupName" xml:"GroupName"`
 	UserName  aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the AddUserToGroupRequest to the form, prefixing their names.
+func (v *AddUserToGroupRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // ChangePasswordRequest is undocumented.
 type ChangePasswordRequest struct {
-	NewPassword aws.StringValue `query:"NewPassword" xml:"NewPassword"`
-	OldPassword aws.StringValue `query:"OldPassword" xml:"OldPassword"`
+	NewPassword aws.StringValue `query:"NewPassword" xml:"NewPassword" sensitive:"NewPassword"`
+	OldPassword aws.StringValue `query:"OldPassword" xml:"OldPassword" sensitive:"OldPassword"`
+}
+
+// MarshalQuery adds the parameters of the ChangePasswordRequest to the form, prefixing their names.
+func (v *ChangePasswordRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NewPassword != nil {
+		form.Set(prefix+"NewPassword", *v.NewPassword)
+	}
+	if v.OldPassword != nil {
+		form.Set(prefix+"OldPassword", *v.OldPassword)
+	}
+	return nil
 }
 
 // CreateAccessKeyRequest is undocumented.
@@ -1030,6 +1725,17 @@ type CreateAccessKeyRequest struct {
 	UserName aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the CreateAccessKeyRequest to the form, prefixing their names.
+func (v *CreateAccessKeyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // CreateAccessKeyResponse is undocumented.
 type CreateAccessKeyResponse struct {
 	AccessKey *AccessKey `query:"AccessKey" xml:"CreateAccessKeyResult>AccessKey"`
@@ -1040,12 +1746,37 @@ type CreateAccountAliasRequest struct {
 	AccountAlias aws.StringValue `query:"AccountAlias" xml:"AccountAlias"`
 }
 
+// MarshalQuery adds the parameters of the CreateAccountAliasRequest to the form, prefixing their names.
+func (v *CreateAccountAliasRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccountAlias != nil {
+		form.Set(prefix+"AccountAlias", *v.AccountAlias)
+	}
+	return nil
+}
+
 // CreateGroupRequest is undocumented.
 type CreateGroupRequest struct {
 	GroupName aws.StringValue `query:"GroupName" xml:"GroupName"`
 	Path      aws.StringValue `query:"Path" xml:"Path"`
 }
 
+// MarshalQuery adds the parameters of the CreateGroupRequest to the form, prefixing their names.
+func (v *CreateGroupRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.Path != nil {
+		form.Set(prefix+"Path", *v.Path)
+	}
+	return nil
+}
+
 // CreateGroupResponse is undocumented.
 type CreateGroupResponse struct {
 	Group *Group `query:"Group" xml:"CreateGroupResult>Group"`
@@ -1057,6 +1788,20 @@ type CreateInstanceProfileRequest struct {
 	Path                aws.StringValue `query:"Path" xml:"Path"`
 }
 
+// MarshalQuery adds the parameters of the CreateInstanceProfileRequest to the form, prefixing their names.
+func (v *CreateInstanceProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceProfileName != nil {
+		form.Set(prefix+"InstanceProfileName", *v.InstanceProfileName)
+	}
+	if v.Path != nil {
+		form.Set(prefix+"Path", *v.Path)
+	}
+	return nil
+}
+
 // CreateInstanceProfileResponse is undocumented.
 type CreateInstanceProfileResponse struct {
 	InstanceProfile *InstanceProfile `query:"InstanceProfile" xml:"CreateInstanceProfileResult>InstanceProfile"`
@@ -1064,11 +1809,28 @@ type CreateInstanceProfileResponse struct {
 
 // CreateLoginProfileRequest is undocumented.
 type CreateLoginProfileRequest struct {
-	Password              aws.StringValue  `query:"Password" xml:"Password"`
+	Password              aws.StringValue  `query:"Password" xml:"Password" sensitive:"Password"`
 	PasswordResetRequired aws.BooleanValue `query:"PasswordResetRequired" xml:"PasswordResetRequired"`
 	UserName              aws.StringValue  `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the CreateLoginProfileRequest to the form, prefixing their names.
+func (v *CreateLoginProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Password != nil {
+		form.Set(prefix+"Password", *v.Password)
+	}
+	if v.PasswordResetRequired != nil {
+		form.Set(prefix+"PasswordResetRequired", strconv.FormatBool(*v.PasswordResetRequired))
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // CreateLoginProfileResponse is undocumented.
 type CreateLoginProfileResponse struct {
 	LoginProfile *LoginProfile `query:"LoginProfile" xml:"CreateLoginProfileResult>LoginProfile"`
@@ -1076,11 +1838,40 @@ type CreateLoginProfileResponse struct {
 
 // CreateOpenIDConnectProviderRequest is undocumented.
 type CreateOpenIDConnectProviderRequest struct {
-	ClientIDList   []string        `query:"ClientIDList.member" xml:"ClientIDList>member"`
-	ThumbprintList []string        `query:"ThumbprintList.member" xml:"ThumbprintList>member"`
+	ClientIDList   []string        `query:"ClientIDList,list:member" xml:"ClientIDList>member"`
+	ThumbprintList []string        `query:"ThumbprintList,list:member" xml:"ThumbprintList>member"`
 	URL            aws.StringValue `query:"Url" xml:"Url"`
 }
 
+// MarshalQuery adds the parameters of the CreateOpenIDConnectProviderRequest to the form, prefixing their names.
+func (v *CreateOpenIDConnectProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClientIDList != nil {
+		if len(v.ClientIDList) == 0 {
+			form.Set(prefix+"ClientIDList", "")
+		}
+		for i1 := range v.ClientIDList {
+			name1 := prefix + "ClientIDList.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ClientIDList[i1])
+		}
+	}
+	if v.ThumbprintList != nil {
+		if len(v.ThumbprintList) == 0 {
+			form.Set(prefix+"ThumbprintList", "")
+		}
+		for i1 := range v.ThumbprintList {
+			name1 := prefix + "ThumbprintList.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ThumbprintList[i1])
+		}
+	}
+	if v.URL != nil {
+		form.Set(prefix+"Url", *v.URL)
+	}
+	return nil
+}
+
 // CreateOpenIDConnectProviderResponse is undocumented.
 type CreateOpenIDConnectProviderResponse struct {
 	OpenIDConnectProviderARN aws.StringValue `query:"OpenIDConnectProviderArn" xml:"CreateOpenIDConnectProviderResult>OpenIDConnectProviderArn"`
@@ -1093,6 +1884,23 @@ type CreateRoleRequest struct {
 	RoleName                 aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the CreateRoleRequest to the form, prefixing their names.
+func (v *CreateRoleRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssumeRolePolicyDocument != nil {
+		form.Set(prefix+"AssumeRolePolicyDocument", *v.AssumeRolePolicyDocument)
+	}
+	if v.Path != nil {
+		form.Set(prefix+"Path", *v.Path)
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // CreateRoleResponse is undocumented.
 type CreateRoleResponse struct {
 	Role *Role `query:"Role" xml:"CreateRoleResult>Role"`
@@ -1104,6 +1912,20 @@ type CreateSAMLProviderRequest struct {
 	SAMLMetadataDocument aws.StringValue `query:"SAMLMetadataDocument" xml:"SAMLMetadataDocument"`
 }
 
+// MarshalQuery adds the parameters of the CreateSAMLProviderRequest to the form, prefixing their names.
+func (v *CreateSAMLProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.SAMLMetadataDocument != nil {
+		form.Set(prefix+"SAMLMetadataDocument", *v.SAMLMetadataDocument)
+	}
+	return nil
+}
+
 // CreateSAMLProviderResponse is undocumented.
 type CreateSAMLProviderResponse struct {
 	SAMLProviderARN aws.StringValue `query:"SAMLProviderArn" xml:"CreateSAMLProviderResult>SAMLProviderArn"`
@@ -1115,6 +1937,20 @@ type CreateUserRequest struct {
 	UserName aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the CreateUserRequest to the form, prefixing their names.
+func (v *CreateUserRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Path != nil {
+		form.Set(prefix+"Path", *v.Path)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // CreateUserResponse is undocumented.
 type CreateUserResponse struct {
 	User *User `query:"User" xml:"CreateUserResult>User"`
@@ -1126,6 +1962,20 @@ type CreateVirtualMFADeviceRequest struct {
 	VirtualMFADeviceName aws.StringValue `query:"VirtualMFADeviceName" xml:"VirtualMFADeviceName"`
 }
 
+// MarshalQuery adds the parameters of the CreateVirtualMFADeviceRequest to the form, prefixing their names.
+func (v *CreateVirtualMFADeviceRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Path != nil {
+		form.Set(prefix+"Path", *v.Path)
+	}
+	if v.VirtualMFADeviceName != nil {
+		form.Set(prefix+"VirtualMFADeviceName", *v.VirtualMFADeviceName)
+	}
+	return nil
+}
+
 // CreateVirtualMFADeviceResponse is undocumented.
 type CreateVirtualMFADeviceResponse struct {
 	VirtualMFADevice *VirtualMFADevice `query:"VirtualMFADevice" xml:"CreateVirtualMFADeviceResult>VirtualMFADevice"`
@@ -1137,86 +1987,280 @@ type DeactivateMFADeviceRequest struct {
 	UserName     aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the DeactivateMFADeviceRequest to the form, prefixing their names.
+func (v *DeactivateMFADeviceRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SerialNumber != nil {
+		form.Set(prefix+"SerialNumber", *v.SerialNumber)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // DeleteAccessKeyRequest is undocumented.
 type DeleteAccessKeyRequest struct {
 	AccessKeyID aws.StringValue `query:"AccessKeyId" xml:"AccessKeyId"`
 	UserName    aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteAccessKeyRequest to the form, prefixing their names.
+func (v *DeleteAccessKeyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccessKeyID != nil {
+		form.Set(prefix+"AccessKeyId", *v.AccessKeyID)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // DeleteAccountAliasRequest is undocumented.
 type DeleteAccountAliasRequest struct {
 	AccountAlias aws.StringValue `query:"AccountAlias" xml:"AccountAlias"`
 }
 
+// MarshalQuery adds the parameters of the DeleteAccountAliasRequest to the form, prefixing their names.
+func (v *DeleteAccountAliasRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccountAlias != nil {
+		form.Set(prefix+"AccountAlias", *v.AccountAlias)
+	}
+	return nil
+}
+
 // DeleteGroupPolicyRequest is undocumented.
 type DeleteGroupPolicyRequest struct {
 	GroupName  aws.StringValue `query:"GroupName" xml:"GroupName"`
 	PolicyName aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteGroupPolicyRequest to the form, prefixing their names.
+func (v *DeleteGroupPolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // DeleteGroupRequest is undocumented.
 type DeleteGroupRequest struct {
 	GroupName aws.StringValue `query:"GroupName" xml:"GroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteGroupRequest to the form, prefixing their names.
+func (v *DeleteGroupRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	return nil
+}
+
 // DeleteInstanceProfileRequest is undocumented.
 type DeleteInstanceProfileRequest struct {
 	InstanceProfileName aws.StringValue `query:"InstanceProfileName" xml:"InstanceProfileName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteInstanceProfileRequest to the form, prefixing their names.
+func (v *DeleteInstanceProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceProfileName != nil {
+		form.Set(prefix+"InstanceProfileName", *v.InstanceProfileName)
+	}
+	return nil
+}
+
 // DeleteLoginProfileRequest is undocumented.
 type DeleteLoginProfileRequest struct {
 	UserName aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteLoginProfileRequest to the form, prefixing their names.
+func (v *DeleteLoginProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // DeleteOpenIDConnectProviderRequest is undocumented.
 type DeleteOpenIDConnectProviderRequest struct {
 	OpenIDConnectProviderARN aws.StringValue `query:"OpenIDConnectProviderArn" xml:"OpenIDConnectProviderArn"`
 }
 
+// MarshalQuery adds the parameters of the DeleteOpenIDConnectProviderRequest to the form, prefixing their names.
+func (v *DeleteOpenIDConnectProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.OpenIDConnectProviderARN != nil {
+		form.Set(prefix+"OpenIDConnectProviderArn", *v.OpenIDConnectProviderARN)
+	}
+	return nil
+}
+
 // DeleteRolePolicyRequest is undocumented.
 type DeleteRolePolicyRequest struct {
 	PolicyName aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 	RoleName   aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteRolePolicyRequest to the form, prefixing their names.
+func (v *DeleteRolePolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // DeleteRoleRequest is undocumented.
 type DeleteRoleRequest struct {
 	RoleName aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteRoleRequest to the form, prefixing their names.
+func (v *DeleteRoleRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // DeleteSAMLProviderRequest is undocumented.
 type DeleteSAMLProviderRequest struct {
 	SAMLProviderARN aws.StringValue `query:"SAMLProviderArn" xml:"SAMLProviderArn"`
 }
 
+// MarshalQuery adds the parameters of the DeleteSAMLProviderRequest to the form, prefixing their names.
+func (v *DeleteSAMLProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SAMLProviderARN != nil {
+		form.Set(prefix+"SAMLProviderArn", *v.SAMLProviderARN)
+	}
+	return nil
+}
+
 // DeleteServerCertificateRequest is undocumented.
 type DeleteServerCertificateRequest struct {
 	ServerCertificateName aws.StringValue `query:"ServerCertificateName" xml:"ServerCertificateName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteServerCertificateRequest to the form, prefixing their names.
+func (v *DeleteServerCertificateRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ServerCertificateName != nil {
+		form.Set(prefix+"ServerCertificateName", *v.ServerCertificateName)
+	}
+	return nil
+}
+
 // DeleteSigningCertificateRequest is undocumented.
 type DeleteSigningCertificateRequest struct {
 	CertificateID aws.StringValue `query:"CertificateId" xml:"CertificateId"`
 	UserName      aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteSigningCertificateRequest to the form, prefixing their names.
+func (v *DeleteSigningCertificateRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CertificateID != nil {
+		form.Set(prefix+"CertificateId", *v.CertificateID)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // DeleteUserPolicyRequest is undocumented.
 type DeleteUserPolicyRequest struct {
 	PolicyName aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 	UserName   aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteUserPolicyRequest to the form, prefixing their names.
+func (v *DeleteUserPolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // DeleteUserRequest is undocumented.
 type DeleteUserRequest struct {
 	UserName aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteUserRequest to the form, prefixing their names.
+func (v *DeleteUserRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // DeleteVirtualMFADeviceRequest is undocumented.
 type DeleteVirtualMFADeviceRequest struct {
 	SerialNumber aws.StringValue `query:"SerialNumber" xml:"SerialNumber"`
 }
 
+// MarshalQuery adds the parameters of the DeleteVirtualMFADeviceRequest to the form, prefixing their names.
+func (v *DeleteVirtualMFADeviceRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SerialNumber != nil {
+		form.Set(prefix+"SerialNumber", *v.SerialNumber)
+	}
+	return nil
+}
+
 // EnableMFADeviceRequest is undocumented.
 type EnableMFADeviceRequest struct {
 	AuthenticationCode1 aws.StringValue `query:"AuthenticationCode1" xml:"AuthenticationCode1"`
@@ -1225,6 +2269,26 @@ type EnableMFADeviceRequest struct {
 	UserName            aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the EnableMFADeviceRequest to the form, prefixing their names.
+func (v *EnableMFADeviceRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AuthenticationCode1 != nil {
+		form.Set(prefix+"AuthenticationCode1", *v.AuthenticationCode1)
+	}
+	if v.AuthenticationCode2 != nil {
+		form.Set(prefix+"AuthenticationCode2", *v.AuthenticationCode2)
+	}
+	if v.SerialNumber != nil {
+		form.Set(prefix+"SerialNumber", *v.SerialNumber)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // Possible values for IAM.
 const (
 	EntityTypeGroup = "Group"
@@ -1240,18 +2304,41 @@ type GenerateCredentialReportResponse struct {
 
 // GetAccountAuthorizationDetailsRequest is undocumented.
 type GetAccountAuthorizationDetailsRequest struct {
-	Filter   []string         `query:"Filter.member" xml:"Filter>member"`
+	Filter   []string         `query:"Filter,list:member" xml:"Filter>member"`
 	Marker   aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxItems aws.IntegerValue `query:"MaxItems" xml:"MaxItems"`
 }
 
+// MarshalQuery adds the parameters of the GetAccountAuthorizationDetailsRequest to the form, prefixing their names.
+func (v *GetAccountAuthorizationDetailsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Filter != nil {
+		if len(v.Filter) == 0 {
+			form.Set(prefix+"Filter", "")
+		}
+		for i1 := range v.Filter {
+			name1 := prefix + "Filter.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Filter[i1])
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	return nil
+}
+
 // GetAccountAuthorizationDetailsResponse is undocumented.
 type GetAccountAuthorizationDetailsResponse struct {
-	GroupDetailList []GroupDetail    `query:"GroupDetailList.member" xml:"GetAccountAuthorizationDetailsResult>GroupDetailList>member"`
+	GroupDetailList []GroupDetail    `query:"GroupDetailList,list:member" xml:"GetAccountAuthorizationDetailsResult>GroupDetailList>member"`
 	IsTruncated     aws.BooleanValue `query:"IsTruncated" xml:"GetAccountAuthorizationDetailsResult>IsTruncated"`
 	Marker          aws.StringValue  `query:"Marker" xml:"GetAccountAuthorizationDetailsResult>Marker"`
-	RoleDetailList  []RoleDetail     `query:"RoleDetailList.member" xml:"GetAccountAuthorizationDetailsResult>RoleDetailList>member"`
-	UserDetailList  []UserDetail     `query:"UserDetailList.member" xml:"GetAccountAuthorizationDetailsResult>UserDetailList>member"`
+	RoleDetailList  []RoleDetail     `query:"RoleDetailList,list:member" xml:"GetAccountAuthorizationDetailsResult>RoleDetailList>member"`
+	UserDetailList  []UserDetail     `query:"UserDetailList,list:member" xml:"GetAccountAuthorizationDetailsResult>UserDetailList>member"`
 }
 
 // GetAccountPasswordPolicyResponse is undocumented.
@@ -1261,7 +2348,7 @@ type GetAccountPasswordPolicyResponse struct {
 
 // GetAccountSummaryResponse is undocumented.
 type GetAccountSummaryResponse struct {
-	SummaryMap map[string]int `query:"SummaryMap" xml:"GetAccountSummaryResult>SummaryMap"`
+	SummaryMap SummaryMapType `query:"SummaryMap,map:entry:key:value" xml:"GetAccountSummaryResult>SummaryMap"`
 }
 
 // GetCredentialReportResponse is undocumented.
@@ -1277,6 +2364,20 @@ type GetGroupPolicyRequest struct {
 	PolicyName aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the GetGroupPolicyRequest to the form, prefixing their names.
+func (v *GetGroupPolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // GetGroupPolicyResponse is undocumented.
 type GetGroupPolicyResponse struct {
 	GroupName      aws.StringValue `query:"GroupName" xml:"GetGroupPolicyResult>GroupName"`
@@ -1291,12 +2392,29 @@ type GetGroupRequest struct {
 	MaxItems  aws.IntegerValue `query:"MaxItems" xml:"MaxItems"`
 }
 
+// MarshalQuery adds the parameters of the GetGroupRequest to the form, prefixing their names.
+func (v *GetGroupRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	return nil
+}
+
 // GetGroupResponse is undocumented.
 type GetGroupResponse struct {
 	Group       *Group           `query:"Group" xml:"GetGroupResult>Group"`
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"GetGroupResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"GetGroupResult>Marker"`
-	Users       []User           `query:"Users.member" xml:"GetGroupResult>Users>member"`
+	Users       []User           `query:"Users,list:member" xml:"GetGroupResult>Users>member"`
 }
 
 // GetInstanceProfileRequest is undocumented.
@@ -1304,6 +2422,17 @@ type GetInstanceProfileRequest struct {
 	InstanceProfileName aws.StringValue `query:"InstanceProfileName" xml:"InstanceProfileName"`
 }
 
+// MarshalQuery adds the parameters of the GetInstanceProfileRequest to the form, prefixing their names.
+func (v *GetInstanceProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceProfileName != nil {
+		form.Set(prefix+"InstanceProfileName", *v.InstanceProfileName)
+	}
+	return nil
+}
+
 // GetInstanceProfileResponse is undocumented.
 type GetInstanceProfileResponse struct {
 	InstanceProfile *InstanceProfile `query:"InstanceProfile" xml:"GetInstanceProfileResult>InstanceProfile"`
@@ -1314,6 +2443,17 @@ type GetLoginProfileRequest struct {
 	UserName aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the GetLoginProfileRequest to the form, prefixing their names.
+func (v *GetLoginProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // GetLoginProfileResponse is undocumented.
 type GetLoginProfileResponse struct {
 	LoginProfile *LoginProfile `query:"LoginProfile" xml:"GetLoginProfileResult>LoginProfile"`
@@ -1324,11 +2464,22 @@ type GetOpenIDConnectProviderRequest struct {
 	OpenIDConnectProviderARN aws.StringValue `query:"OpenIDConnectProviderArn" xml:"OpenIDConnectProviderArn"`
 }
 
+// MarshalQuery adds the parameters of the GetOpenIDConnectProviderRequest to the form, prefixing their names.
+func (v *GetOpenIDConnectProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.OpenIDConnectProviderARN != nil {
+		form.Set(prefix+"OpenIDConnectProviderArn", *v.OpenIDConnectProviderARN)
+	}
+	return nil
+}
+
 // GetOpenIDConnectProviderResponse is undocumented.
 type GetOpenIDConnectProviderResponse struct {
-	ClientIDList   []string        `query:"ClientIDList.member" xml:"GetOpenIDConnectProviderResult>ClientIDList>member"`
+	ClientIDList   []string        `query:"ClientIDList,list:member" xml:"GetOpenIDConnectProviderResult>ClientIDList>member"`
 	CreateDate     time.Time       `query:"CreateDate" xml:"GetOpenIDConnectProviderResult>CreateDate"`
-	ThumbprintList []string        `query:"ThumbprintList.member" xml:"GetOpenIDConnectProviderResult>ThumbprintList>member"`
+	ThumbprintList []string        `query:"ThumbprintList,list:member" xml:"GetOpenIDConnectProviderResult>ThumbprintList>member"`
 	URL            aws.StringValue `query:"Url" xml:"GetOpenIDConnectProviderResult>Url"`
 }
 
@@ -1338,6 +2489,20 @@ type GetRolePolicyRequest struct {
 	RoleName   aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the GetRolePolicyRequest to the form, prefixing their names.
+func (v *GetRolePolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // GetRolePolicyResponse is undocumented.
 type GetRolePolicyResponse struct {
 	PolicyDocument aws.StringValue `query:"PolicyDocument" xml:"GetRolePolicyResult>PolicyDocument"`
@@ -1350,6 +2515,17 @@ type GetRoleRequest struct {
 	RoleName aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the GetRoleRequest to the form, prefixing their names.
+func (v *GetRoleRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // GetRoleResponse is undocumented.
 type GetRoleResponse struct {
 	Role *Role `query:"Role" xml:"GetRoleResult>Role"`
@@ -1360,6 +2536,17 @@ type GetSAMLProviderRequest struct {
 	SAMLProviderARN aws.StringValue `query:"SAMLProviderArn" xml:"SAMLProviderArn"`
 }
 
+// MarshalQuery adds the parameters of the GetSAMLProviderRequest to the form, prefixing their names.
+func (v *GetSAMLProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SAMLProviderARN != nil {
+		form.Set(prefix+"SAMLProviderArn", *v.SAMLProviderARN)
+	}
+	return nil
+}
+
 // GetSAMLProviderResponse is undocumented.
 type GetSAMLProviderResponse struct {
 	CreateDate           time.Time       `query:"CreateDate" xml:"GetSAMLProviderResult>CreateDate"`
@@ -1372,6 +2559,17 @@ type GetServerCertificateRequest struct {
 	ServerCertificateName aws.StringValue `query:"ServerCertificateName" xml:"ServerCertificateName"`
 }
 
+// MarshalQuery adds the parameters of the GetServerCertificateRequest to the form, prefixing their names.
+func (v *GetServerCertificateRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ServerCertificateName != nil {
+		form.Set(prefix+"ServerCertificateName", *v.ServerCertificateName)
+	}
+	return nil
+}
+
 // GetServerCertificateResponse is undocumented.
 type GetServerCertificateResponse struct {
 	ServerCertificate *ServerCertificate `query:"ServerCertificate" xml:"GetServerCertificateResult>ServerCertificate"`
@@ -1383,6 +2581,20 @@ type GetUserPolicyRequest struct {
 	UserName   aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the GetUserPolicyRequest to the form, prefixing their names.
+func (v *GetUserPolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // GetUserPolicyResponse is undocumented.
 type GetUserPolicyResponse struct {
 	PolicyDocument aws.StringValue `query:"PolicyDocument" xml:"GetUserPolicyResult>PolicyDocument"`
@@ -1395,6 +2607,17 @@ type GetUserRequest struct {
 	UserName aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the GetUserRequest to the form, prefixing their names.
+func (v *GetUserRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // GetUserResponse is undocumented.
 type GetUserResponse struct {
 	User *User `query:"User" xml:"GetUserResult>User"`
@@ -1415,7 +2638,7 @@ type GroupDetail struct {
 	CreateDate      time.Time       `query:"CreateDate" xml:"CreateDate"`
 	GroupID         aws.StringValue `query:"GroupId" xml:"GroupId"`
 	GroupName       aws.StringValue `query:"GroupName" xml:"GroupName"`
-	GroupPolicyList []PolicyDetail  `query:"GroupPolicyList.member" xml:"GroupPolicyList>member"`
+	GroupPolicyList []PolicyDetail  `query:"GroupPolicyList,list:member" xml:"GroupPolicyList>member"`
 	Path            aws.StringValue `query:"Path" xml:"Path"`
 }
 
@@ -1426,7 +2649,7 @@ type InstanceProfile struct {
 	InstanceProfileID   aws.StringValue `query:"InstanceProfileId" xml:"InstanceProfileId"`
 	InstanceProfileName aws.StringValue `query:"InstanceProfileName" xml:"InstanceProfileName"`
 	Path                aws.StringValue `query:"Path" xml:"Path"`
-	Roles               []Role          `query:"Roles.member" xml:"Roles>member"`
+	Roles               []Role          `query:"Roles,list:member" xml:"Roles>member"`
 }
 
 // ListAccessKeysRequest is undocumented.
@@ -1436,9 +2659,26 @@ type ListAccessKeysRequest struct {
 	UserName aws.StringValue  `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the ListAccessKeysRequest to the form, prefixing their names.
+func (v *ListAccessKeysRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // ListAccessKeysResponse is undocumented.
 type ListAccessKeysResponse struct {
-	AccessKeyMetadata []AccessKeyMetadata `query:"AccessKeyMetadata.member" xml:"ListAccessKeysResult>AccessKeyMetadata>member"`
+	AccessKeyMetadata []AccessKeyMetadata `query:"AccessKeyMetadata,list:member" xml:"ListAccessKeysResult>AccessKeyMetadata>member"`
 	IsTruncated       aws.BooleanValue    `query:"IsTruncated" xml:"ListAccessKeysResult>IsTruncated"`
 	Marker            aws.StringValue     `query:"Marker" xml:"ListAccessKeysResult>Marker"`
 }
@@ -1449,9 +2689,23 @@ type ListAccountAliasesRequest struct {
 	MaxItems aws.IntegerValue `query:"MaxItems" xml:"MaxItems"`
 }
 
+// MarshalQuery adds the parameters of the ListAccountAliasesRequest to the form, prefixing their names.
+func (v *ListAccountAliasesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	return nil
+}
+
 // ListAccountAliasesResponse is undocumented.
 type ListAccountAliasesResponse struct {
-	AccountAliases []string         `query:"AccountAliases.member" xml:"ListAccountAliasesResult>AccountAliases>member"`
+	AccountAliases []string         `query:"AccountAliases,list:member" xml:"ListAccountAliasesResult>AccountAliases>member"`
 	IsTruncated    aws.BooleanValue `query:"IsTruncated" xml:"ListAccountAliasesResult>IsTruncated"`
 	Marker         aws.StringValue  `query:"Marker" xml:"ListAccountAliasesResult>Marker"`
 }
@@ -1463,11 +2717,28 @@ type ListGroupPoliciesRequest struct {
 	MaxItems  aws.IntegerValue `query:"MaxItems" xml:"MaxItems"`
 }
 
+// MarshalQuery adds the parameters of the ListGroupPoliciesRequest to the form, prefixing their names.
+func (v *ListGroupPoliciesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	return nil
+}
+
 // ListGroupPoliciesResponse is undocumented.
 type ListGroupPoliciesResponse struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListGroupPoliciesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListGroupPoliciesResult>Marker"`
-	PolicyNames []string         `query:"PolicyNames.member" xml:"ListGroupPoliciesResult>PolicyNames>member"`
+	PolicyNames []string         `query:"PolicyNames,list:member" xml:"ListGroupPoliciesResult>PolicyNames>member"`
 }
 
 // ListGroupsForUserRequest is undocumented.
@@ -1477,9 +2748,26 @@ type ListGroupsForUserRequest struct {
 	UserName aws.StringValue  `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the ListGroupsForUserRequest to the form, prefixing their names.
+func (v *ListGroupsForUserRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // ListGroupsForUserResponse is undocumented.
 type ListGroupsForUserResponse struct {
-	Groups      []Group          `query:"Groups.member" xml:"ListGroupsForUserResult>Groups>member"`
+	Groups      []Group          `query:"Groups,list:member" xml:"ListGroupsForUserResult>Groups>member"`
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListGroupsForUserResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListGroupsForUserResult>Marker"`
 }
@@ -1491,9 +2779,26 @@ type ListGroupsRequest struct {
 	PathPrefix aws.StringValue  `query:"PathPrefix" xml:"PathPrefix"`
 }
 
+// MarshalQuery adds the parameters of the ListGroupsRequest to the form, prefixing their names.
+func (v *ListGroupsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.PathPrefix != nil {
+		form.Set(prefix+"PathPrefix", *v.PathPrefix)
+	}
+	return nil
+}
+
 // ListGroupsResponse is undocumented.
 type ListGroupsResponse struct {
-	Groups      []Group          `query:"Groups.member" xml:"ListGroupsResult>Groups>member"`
+	Groups      []Group          `query:"Groups,list:member" xml:"ListGroupsResult>Groups>member"`
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListGroupsResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListGroupsResult>Marker"`
 }
@@ -1505,9 +2810,26 @@ type ListInstanceProfilesForRoleRequest struct {
 	RoleName aws.StringValue  `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the ListInstanceProfilesForRoleRequest to the form, prefixing their names.
+func (v *ListInstanceProfilesForRoleRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // ListInstanceProfilesForRoleResponse is undocumented.
 type ListInstanceProfilesForRoleResponse struct {
-	InstanceProfiles []InstanceProfile `query:"InstanceProfiles.member" xml:"ListInstanceProfilesForRoleResult>InstanceProfiles>member"`
+	InstanceProfiles []InstanceProfile `query:"InstanceProfiles,list:member" xml:"ListInstanceProfilesForRoleResult>InstanceProfiles>member"`
 	IsTruncated      aws.BooleanValue  `query:"IsTruncated" xml:"ListInstanceProfilesForRoleResult>IsTruncated"`
 	Marker           aws.StringValue   `query:"Marker" xml:"ListInstanceProfilesForRoleResult>Marker"`
 }
@@ -1519,9 +2841,26 @@ type ListInstanceProfilesRequest struct {
 	PathPrefix aws.StringValue  `query:"PathPrefix" xml:"PathPrefix"`
 }
 
+// MarshalQuery adds the parameters of the ListInstanceProfilesRequest to the form, prefixing their names.
+func (v *ListInstanceProfilesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.PathPrefix != nil {
+		form.Set(prefix+"PathPrefix", *v.PathPrefix)
+	}
+	return nil
+}
+
 // ListInstanceProfilesResponse is undocumented.
 type ListInstanceProfilesResponse struct {
-	InstanceProfiles []InstanceProfile `query:"InstanceProfiles.member" xml:"ListInstanceProfilesResult>InstanceProfiles>member"`
+	InstanceProfiles []InstanceProfile `query:"InstanceProfiles,list:member" xml:"ListInstanceProfilesResult>InstanceProfiles>member"`
 	IsTruncated      aws.BooleanValue  `query:"IsTruncated" xml:"ListInstanceProfilesResult>IsTruncated"`
 	Marker           aws.StringValue   `query:"Marker" xml:"ListInstanceProfilesResult>Marker"`
 }
@@ -1533,10 +2872,27 @@ type ListMFADevicesRequest struct {
 	UserName aws.StringValue  `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the ListMFADevicesRequest to the form, prefixing their names.
+func (v *ListMFADevicesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // ListMFADevicesResponse is undocumented.
 type ListMFADevicesResponse struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListMFADevicesResult>IsTruncated"`
-	MFADevices  []MFADevice      `query:"MFADevices.member" xml:"ListMFADevicesResult>MFADevices>member"`
+	MFADevices  []MFADevice      `query:"MFADevices,list:member" xml:"ListMFADevicesResult>MFADevices>member"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListMFADevicesResult>Marker"`
 }
 
@@ -1544,9 +2900,17 @@ type ListMFADevicesResponse struct {
 type ListOpenIDConnectProvidersRequest struct {
 }
 
+// MarshalQuery adds the parameters of the ListOpenIDConnectProvidersRequest to the form, prefixing their names.
+func (v *ListOpenIDConnectProvidersRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	return nil
+}
+
 // ListOpenIDConnectProvidersResponse is undocumented.
 type ListOpenIDConnectProvidersResponse struct {
-	OpenIDConnectProviderList []OpenIDConnectProviderListEntry `query:"OpenIDConnectProviderList.member" xml:"ListOpenIDConnectProvidersResult>OpenIDConnectProviderList>member"`
+	OpenIDConnectProviderList []OpenIDConnectProviderListEntry `query:"OpenIDConnectProviderList,list:member" xml:"ListOpenIDConnectProvidersResult>OpenIDConnectProviderList>member"`
 }
 
 // ListRolePoliciesRequest is undocumented.
@@ -1556,11 +2920,28 @@ type ListRolePoliciesRequest struct {
 	RoleName aws.StringValue  `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the ListRolePoliciesRequest to the form, prefixing their names.
+func (v *ListRolePoliciesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // ListRolePoliciesResponse is undocumented.
 type ListRolePoliciesResponse struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListRolePoliciesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListRolePoliciesResult>Marker"`
-	PolicyNames []string         `query:"PolicyNames.member" xml:"ListRolePoliciesResult>PolicyNames>member"`
+	PolicyNames []string         `query:"PolicyNames,list:member" xml:"ListRolePoliciesResult>PolicyNames>member"`
 }
 
 // ListRolesRequest is undocumented.
@@ -1570,20 +2951,45 @@ type ListRolesRequest struct {
 	PathPrefix aws.StringValue  `query:"PathPrefix" xml:"PathPrefix"`
 }
 
+// MarshalQuery adds the parameters of the ListRolesRequest to the form, prefixing their names.
+func (v *ListRolesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.PathPrefix != nil {
+		form.Set(prefix+"PathPrefix", *v.PathPrefix)
+	}
+	return nil
+}
+
 // ListRolesResponse is undocumented.
 type ListRolesResponse struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListRolesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListRolesResult>Marker"`
-	Roles       []Role           `query:"Roles.member" xml:"ListRolesResult>Roles>member"`
+	Roles       []Role           `query:"Roles,list:member" xml:"ListRolesResult>Roles>member"`
 }
 
 // ListSAMLProvidersRequest is undocumented.
 type ListSAMLProvidersRequest struct {
 }
 
+// MarshalQuery adds the parameters of the ListSAMLProvidersRequest to the form, prefixing their names.
+func (v *ListSAMLProvidersRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	return nil
+}
+
 // ListSAMLProvidersResponse is undocumented.
 type ListSAMLProvidersResponse struct {
-	SAMLProviderList []SAMLProviderListEntry `query:"SAMLProviderList.member" xml:"ListSAMLProvidersResult>SAMLProviderList>member"`
+	SAMLProviderList []SAMLProviderListEntry `query:"SAMLProviderList,list:member" xml:"ListSAMLProvidersResult>SAMLProviderList>member"`
 }
 
 // ListServerCertificatesRequest is undocumented.
@@ -1593,11 +2999,28 @@ type ListServerCertificatesRequest struct {
 	PathPrefix aws.StringValue  `query:"PathPrefix" xml:"PathPrefix"`
 }
 
+// MarshalQuery adds the parameters of the ListServerCertificatesRequest to the form, prefixing their names.
+func (v *ListServerCertificatesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.PathPrefix != nil {
+		form.Set(prefix+"PathPrefix", *v.PathPrefix)
+	}
+	return nil
+}
+
 // ListServerCertificatesResponse is undocumented.
 type ListServerCertificatesResponse struct {
 	IsTruncated                   aws.BooleanValue            `query:"IsTruncated" xml:"ListServerCertificatesResult>IsTruncated"`
 	Marker                        aws.StringValue             `query:"Marker" xml:"ListServerCertificatesResult>Marker"`
-	ServerCertificateMetadataList []ServerCertificateMetadata `query:"ServerCertificateMetadataList.member" xml:"ListServerCertificatesResult>ServerCertificateMetadataList>member"`
+	ServerCertificateMetadataList []ServerCertificateMetadata `query:"ServerCertificateMetadataList,list:member" xml:"ListServerCertificatesResult>ServerCertificateMetadataList>member"`
 }
 
 // ListSigningCertificatesRequest is undocumented.
@@ -1607,9 +3030,26 @@ type ListSigningCertificatesRequest struct {
 	UserName aws.StringValue  `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the ListSigningCertificatesRequest to the form, prefixing their names.
+func (v *ListSigningCertificatesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // ListSigningCertificatesResponse is undocumented.
 type ListSigningCertificatesResponse struct {
-	Certificates []SigningCertificate `query:"Certificates.member" xml:"ListSigningCertificatesResult>Certificates>member"`
+	Certificates []SigningCertificate `query:"Certificates,list:member" xml:"ListSigningCertificatesResult>Certificates>member"`
 	IsTruncated  aws.BooleanValue     `query:"IsTruncated" xml:"ListSigningCertificatesResult>IsTruncated"`
 	Marker       aws.StringValue      `query:"Marker" xml:"ListSigningCertificatesResult>Marker"`
 }
@@ -1621,11 +3061,28 @@ type ListUserPoliciesRequest struct {
 	UserName aws.StringValue  `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the ListUserPoliciesRequest to the form, prefixing their names.
+func (v *ListUserPoliciesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // ListUserPoliciesResponse is undocumented.
 type ListUserPoliciesResponse struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListUserPoliciesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListUserPoliciesResult>Marker"`
-	PolicyNames []string         `query:"PolicyNames.member" xml:"ListUserPoliciesResult>PolicyNames>member"`
+	PolicyNames []string         `query:"PolicyNames,list:member" xml:"ListUserPoliciesResult>PolicyNames>member"`
 }
 
 // ListUsersRequest is undocumented.
@@ -1635,11 +3092,28 @@ type ListUsersRequest struct {
 	PathPrefix aws.StringValue  `query:"PathPrefix" xml:"PathPrefix"`
 }
 
+// MarshalQuery adds the parameters of the ListUsersRequest to the form, prefixing their names.
+func (v *ListUsersRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.PathPrefix != nil {
+		form.Set(prefix+"PathPrefix", *v.PathPrefix)
+	}
+	return nil
+}
+
 // ListUsersResponse is undocumented.
 type ListUsersResponse struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListUsersResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListUsersResult>Marker"`
-	Users       []User           `query:"Users.member" xml:"ListUsersResult>Users>member"`
+	Users       []User           `query:"Users,list:member" xml:"ListUsersResult>Users>member"`
 }
 
 // ListVirtualMFADevicesRequest is undocumented.
@@ -1649,11 +3123,28 @@ type ListVirtualMFADevicesRequest struct {
 	MaxItems         aws.IntegerValue `query:"MaxItems" xml:"MaxItems"`
 }
 
+// MarshalQuery adds the parameters of the ListVirtualMFADevicesRequest to the form, prefixing their names.
+func (v *ListVirtualMFADevicesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AssignmentStatus != nil {
+		form.Set(prefix+"AssignmentStatus", *v.AssignmentStatus)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	return nil
+}
+
 // ListVirtualMFADevicesResponse is undocumented.
 type ListVirtualMFADevicesResponse struct {
 	IsTruncated       aws.BooleanValue   `query:"IsTruncated" xml:"ListVirtualMFADevicesResult>IsTruncated"`
 	Marker            aws.StringValue    `query:"Marker" xml:"ListVirtualMFADevicesResult>Marker"`
-	VirtualMFADevices []VirtualMFADevice `query:"VirtualMFADevices.member" xml:"ListVirtualMFADevicesResult>VirtualMFADevices>member"`
+	VirtualMFADevices []VirtualMFADevice `query:"VirtualMFADevices,list:member" xml:"ListVirtualMFADevicesResult>VirtualMFADevices>member"`
 }
 
 // LoginProfile is undocumented.
@@ -1702,6 +3193,23 @@ type PutGroupPolicyRequest struct {
 	PolicyName     aws.StringValue `query:"PolicyName" xml:"PolicyName"`
 }
 
+// MarshalQuery adds the parameters of the PutGroupPolicyRequest to the form, prefixing their names.
+func (v *PutGroupPolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.PolicyDocument != nil {
+		form.Set(prefix+"PolicyDocument", *v.PolicyDocument)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	return nil
+}
+
 // PutRolePolicyRequest is undocumented.
 type PutRolePolicyRequest struct {
 	PolicyDocument aws.StringValue `query:"PolicyDocument" xml:"PolicyDocument"`
@@ -1709,6 +3217,23 @@ type PutRolePolicyRequest struct {
 	RoleName       aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the PutRolePolicyRequest to the form, prefixing their names.
+func (v *PutRolePolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyDocument != nil {
+		form.Set(prefix+"PolicyDocument", *v.PolicyDocument)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // PutUserPolicyRequest is undocumented.
 type PutUserPolicyRequest struct {
 	PolicyDocument aws.StringValue `query:"PolicyDocument" xml:"PolicyDocument"`
@@ -1716,24 +3241,83 @@ type PutUserPolicyRequest struct {
 	UserName       aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the PutUserPolicyRequest to the form, prefixing their names.
+func (v *PutUserPolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyDocument != nil {
+		form.Set(prefix+"PolicyDocument", *v.PolicyDocument)
+	}
+	if v.PolicyName != nil {
+		form.Set(prefix+"PolicyName", *v.PolicyName)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // RemoveClientIDFromOpenIDConnectProviderRequest is undocumented.
 type RemoveClientIDFromOpenIDConnectProviderRequest struct {
 	ClientID                 aws.StringValue `query:"ClientID" xml:"ClientID"`
 	OpenIDConnectProviderARN aws.StringValue `query:"OpenIDConnectProviderArn" xml:"OpenIDConnectProviderArn"`
 }
 
+// MarshalQuery adds the parameters of the RemoveClientIDFromOpenIDConnectProviderRequest to the form, prefixing their names.
+func (v *RemoveClientIDFromOpenIDConnectProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClientID != nil {
+		form.Set(prefix+"ClientID", *v.ClientID)
+	}
+	if v.OpenIDConnectProviderARN != nil {
+		form.Set(prefix+"OpenIDConnectProviderArn", *v.OpenIDConnectProviderARN)
+	}
+	return nil
+}
+
 // RemoveRoleFromInstanceProfileRequest is undocumented.
 type RemoveRoleFromInstanceProfileRequest struct {
 	InstanceProfileName aws.StringValue `query:"InstanceProfileName" xml:"InstanceProfileName"`
 	RoleName            aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the RemoveRoleFromInstanceProfileRequest to the form, prefixing their names.
+func (v *RemoveRoleFromInstanceProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.InstanceProfileName != nil {
+		form.Set(prefix+"InstanceProfileName", *v.InstanceProfileName)
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // RemoveUserFromGroupRequest is undocumented.
 type RemoveUserFromGroupRequest struct {
 	GroupName aws.StringValue `query:"GroupName" xml:"GroupName"`
 	UserName  aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the RemoveUserFromGroupRequest to the form, prefixing their names.
+func (v *RemoveUserFromGroupRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // Possible values for IAM.
 const (
 	ReportFormatTypeTextCSV = "text/csv"
@@ -1754,6 +3338,26 @@ type ResyncMFADeviceRequest struct {
 	UserName            aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the ResyncMFADeviceRequest to the form, prefixing their names.
+func (v *ResyncMFADeviceRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AuthenticationCode1 != nil {
+		form.Set(prefix+"AuthenticationCode1", *v.AuthenticationCode1)
+	}
+	if v.AuthenticationCode2 != nil {
+		form.Set(prefix+"AuthenticationCode2", *v.AuthenticationCode2)
+	}
+	if v.SerialNumber != nil {
+		form.Set(prefix+"SerialNumber", *v.SerialNumber)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // Role is undocumented.
 type Role struct {
 	ARN                      aws.StringValue `query:"Arn" xml:"Arn"`
@@ -1769,11 +3373,11 @@ type RoleDetail struct {
 	ARN                      aws.StringValue   `query:"Arn" xml:"Arn"`
 	AssumeRolePolicyDocument aws.StringValue   `query:"AssumeRolePolicyDocument" xml:"AssumeRolePolicyDocument"`
 	CreateDate               time.Time         `query:"CreateDate" xml:"CreateDate"`
-	InstanceProfileList      []InstanceProfile `query:"InstanceProfileList.member" xml:"InstanceProfileList>member"`
+	InstanceProfileList      []InstanceProfile `query:"InstanceProfileList,list:member" xml:"InstanceProfileList>member"`
 	Path                     aws.StringValue   `query:"Path" xml:"Path"`
 	RoleID                   aws.StringValue   `query:"RoleId" xml:"RoleId"`
 	RoleName                 aws.StringValue   `query:"RoleName" xml:"RoleName"`
-	RolePolicyList           []PolicyDetail    `query:"RolePolicyList.member" xml:"RolePolicyList>member"`
+	RolePolicyList           []PolicyDetail    `query:"RolePolicyList,list:member" xml:"RolePolicyList>member"`
 }
 
 // SAMLProviderListEntry is undocumented.
@@ -1816,6 +3420,23 @@ type UpdateAccessKeyRequest struct {
 	UserName    aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateAccessKeyRequest to the form, prefixing their names.
+func (v *UpdateAccessKeyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccessKeyID != nil {
+		form.Set(prefix+"AccessKeyId", *v.AccessKeyID)
+	}
+	if v.Status != nil {
+		form.Set(prefix+"Status", *v.Status)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // UpdateAccountPasswordPolicyRequest is undocumented.
 type UpdateAccountPasswordPolicyRequest struct {
 	AllowUsersToChangePassword aws.BooleanValue `query:"AllowUsersToChangePassword" xml:"AllowUsersToChangePassword"`
@@ -1829,12 +3450,61 @@ type UpdateAccountPasswordPolicyRequest struct {
 	RequireUppercaseCharacters aws.BooleanValue `query:"RequireUppercaseCharacters" xml:"RequireUppercaseCharacters"`
 }
 
+// MarshalQuery adds the parameters of the UpdateAccountPasswordPolicyRequest to the form, prefixing their names.
+func (v *UpdateAccountPasswordPolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowUsersToChangePassword != nil {
+		form.Set(prefix+"AllowUsersToChangePassword", strconv.FormatBool(*v.AllowUsersToChangePassword))
+	}
+	if v.HardExpiry != nil {
+		form.Set(prefix+"HardExpiry", strconv.FormatBool(*v.HardExpiry))
+	}
+	if v.MaxPasswordAge != nil {
+		form.Set(prefix+"MaxPasswordAge", strconv.FormatInt(int64(*v.MaxPasswordAge), 10))
+	}
+	if v.MinimumPasswordLength != nil {
+		form.Set(prefix+"MinimumPasswordLength", strconv.FormatInt(int64(*v.MinimumPasswordLength), 10))
+	}
+	if v.PasswordReusePrevention != nil {
+		form.Set(prefix+"PasswordReusePrevention", strconv.FormatInt(int64(*v.PasswordReusePrevention), 10))
+	}
+	if v.RequireLowercaseCharacters != nil {
+		form.Set(prefix+"RequireLowercaseCharacters", strconv.FormatBool(*v.RequireLowercaseCharacters))
+	}
+	if v.RequireNumbers != nil {
+		form.Set(prefix+"RequireNumbers", strconv.FormatBool(*v.RequireNumbers))
+	}
+	if v.RequireSymbols != nil {
+		form.Set(prefix+"RequireSymbols", strconv.FormatBool(*v.RequireSymbols))
+	}
+	if v.RequireUppercaseCharacters != nil {
+		form.Set(prefix+"RequireUppercaseCharacters", strconv.FormatBool(*v.RequireUppercaseCharacters))
+	}
+	return nil
+}
+
 // UpdateAssumeRolePolicyRequest is undocumented.
 type UpdateAssumeRolePolicyRequest struct {
 	PolicyDocument aws.StringValue `query:"PolicyDocument" xml:"PolicyDocument"`
 	RoleName       aws.StringValue `query:"RoleName" xml:"RoleName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateAssumeRolePolicyRequest to the form, prefixing their names.
+func (v *UpdateAssumeRolePolicyRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PolicyDocument != nil {
+		form.Set(prefix+"PolicyDocument", *v.PolicyDocument)
+	}
+	if v.RoleName != nil {
+		form.Set(prefix+"RoleName", *v.RoleName)
+	}
+	return nil
+}
+
 // UpdateGroupRequest is undocumented.
 type UpdateGroupRequest struct {
 	GroupName    aws.StringValue `query:"GroupName" xml:"GroupName"`
@@ -1842,17 +3512,71 @@ type UpdateGroupRequest struct {
 	NewPath      aws.StringValue `query:"NewPath" xml:"NewPath"`
 }
 
+// MarshalQuery adds the parameters of the UpdateGroupRequest to the form, prefixing their names.
+func (v *UpdateGroupRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.GroupName != nil {
+		form.Set(prefix+"GroupName", *v.GroupName)
+	}
+	if v.NewGroupName != nil {
+		form.Set(prefix+"NewGroupName", *v.NewGroupName)
+	}
+	if v.NewPath != nil {
+		form.Set(prefix+"NewPath", *v.NewPath)
+	}
+	return nil
+}
+
 // UpdateLoginProfileRequest is undocumented.
 type UpdateLoginProfileRequest struct {
-	Password              aws.StringValue  `query:"Password" xml:"Password"`
+	Password              aws.StringValue  `query:"Password" xml:"Password" sensitive:"Password"`
 	PasswordResetRequired aws.BooleanValue `query:"PasswordResetRequired" xml:"PasswordResetRequired"`
 	UserName              aws.StringValue  `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateLoginProfileRequest to the form, prefixing their names.
+func (v *UpdateLoginProfileRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Password != nil {
+		form.Set(prefix+"Password", *v.Password)
+	}
+	if v.PasswordResetRequired != nil {
+		form.Set(prefix+"PasswordResetRequired", strconv.FormatBool(*v.PasswordResetRequired))
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // UpdateOpenIDConnectProviderThumbprintRequest is undocumented.
 type UpdateOpenIDConnectProviderThumbprintRequest struct {
 	OpenIDConnectProviderARN aws.StringValue `query:"OpenIDConnectProviderArn" xml:"OpenIDConnectProviderArn"`
-	ThumbprintList           []string        `query:"ThumbprintList.member" xml:"ThumbprintList>member"`
+	ThumbprintList           []string        `query:"ThumbprintList,list:member" xml:"ThumbprintList>member"`
+}
+
+// MarshalQuery adds the parameters of the UpdateOpenIDConnectProviderThumbprintRequest to the form, prefixing their names.
+func (v *UpdateOpenIDConnectProviderThumbprintRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.OpenIDConnectProviderARN != nil {
+		form.Set(prefix+"OpenIDConnectProviderArn", *v.OpenIDConnectProviderARN)
+	}
+	if v.ThumbprintList != nil {
+		if len(v.ThumbprintList) == 0 {
+			form.Set(prefix+"ThumbprintList", "")
+		}
+		for i1 := range v.ThumbprintList {
+			name1 := prefix + "ThumbprintList.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ThumbprintList[i1])
+		}
+	}
+	return nil
 }
 
 // UpdateSAMLProviderRequest is undocumented.
@@ -1861,6 +3585,20 @@ type UpdateSAMLProviderRequest struct {
 	SAMLProviderARN      aws.StringValue `query:"SAMLProviderArn" xml:"SAMLProviderArn"`
 }
 
+// MarshalQuery adds the parameters of the UpdateSAMLProviderRequest to the form, prefixing their names.
+func (v *UpdateSAMLProviderRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SAMLMetadataDocument != nil {
+		form.Set(prefix+"SAMLMetadataDocument", *v.SAMLMetadataDocument)
+	}
+	if v.SAMLProviderARN != nil {
+		form.Set(prefix+"SAMLProviderArn", *v.SAMLProviderARN)
+	}
+	return nil
+}
+
 // UpdateSAMLProviderResponse is undocumented.
 type UpdateSAMLProviderResponse struct {
 	SAMLProviderARN aws.StringValue `query:"SAMLProviderArn" xml:"UpdateSAMLProviderResult>SAMLProviderArn"`
@@ -1873,6 +3611,23 @@ type UpdateServerCertificateRequest struct {
 	ServerCertificateName    aws.StringValue `query:"ServerCertificateName" xml:"ServerCertificateName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateServerCertificateRequest to the form, prefixing their names.
+func (v *UpdateServerCertificateRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NewPath != nil {
+		form.Set(prefix+"NewPath", *v.NewPath)
+	}
+	if v.NewServerCertificateName != nil {
+		form.Set(prefix+"NewServerCertificateName", *v.NewServerCertificateName)
+	}
+	if v.ServerCertificateName != nil {
+		form.Set(prefix+"ServerCertificateName", *v.ServerCertificateName)
+	}
+	return nil
+}
+
 // UpdateSigningCertificateRequest is undocumented.
 type UpdateSigningCertificateRequest struct {
 	CertificateID aws.StringValue `query:"CertificateId" xml:"CertificateId"`
@@ -1880,6 +3635,23 @@ type UpdateSigningCertificateRequest struct {
 	UserName      aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateSigningCertificateRequest to the form, prefixing their names.
+func (v *UpdateSigningCertificateRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CertificateID != nil {
+		form.Set(prefix+"CertificateId", *v.CertificateID)
+	}
+	if v.Status != nil {
+		form.Set(prefix+"Status", *v.Status)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // UpdateUserRequest is undocumented.
 type UpdateUserRequest struct {
 	NewPath     aws.StringValue `query:"NewPath" xml:"NewPath"`
@@ -1887,15 +3659,55 @@ type UpdateUserRequest struct {
 	UserName    aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the UpdateUserRequest to the form, prefixing their names.
+func (v *UpdateUserRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NewPath != nil {
+		form.Set(prefix+"NewPath", *v.NewPath)
+	}
+	if v.NewUserName != nil {
+		form.Set(prefix+"NewUserName", *v.NewUserName)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // UploadServerCertificateRequest is undocumented.
 type UploadServerCertificateRequest struct {
 	CertificateBody       aws.StringValue `query:"CertificateBody" xml:"CertificateBody"`
 	CertificateChain      aws.StringValue `query:"CertificateChain" xml:"CertificateChain"`
 	Path                  aws.StringValue `query:"Path" xml:"Path"`
-	PrivateKey            aws.StringValue `query:"PrivateKey" xml:"PrivateKey"`
+	PrivateKey            aws.StringValue `query:"PrivateKey" xml:"PrivateKey" sensitive:"PrivateKey"`
 	ServerCertificateName aws.StringValue `query:"ServerCertificateName" xml:"ServerCertificateName"`
 }
 
+// MarshalQuery adds the parameters of the UploadServerCertificateRequest to the form, prefixing their names.
+func (v *UploadServerCertificateRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CertificateBody != nil {
+		form.Set(prefix+"CertificateBody", *v.CertificateBody)
+	}
+	if v.CertificateChain != nil {
+		form.Set(prefix+"CertificateChain", *v.CertificateChain)
+	}
+	if v.Path != nil {
+		form.Set(prefix+"Path", *v.Path)
+	}
+	if v.PrivateKey != nil {
+		form.Set(prefix+"PrivateKey", *v.PrivateKey)
+	}
+	if v.ServerCertificateName != nil {
+		form.Set(prefix+"ServerCertificateName", *v.ServerCertificateName)
+	}
+	return nil
+}
+
 // UploadServerCertificateResponse is undocumented.
 type UploadServerCertificateResponse struct {
 	ServerCertificateMetadata *ServerCertificateMetadata `query:"ServerCertificateMetadata" xml:"UploadServerCertificateResult>ServerCertificateMetadata"`
@@ -1907,6 +3719,20 @@ type UploadSigningCertificateRequest struct {
 	UserName        aws.StringValue `query:"UserName" xml:"UserName"`
 }
 
+// MarshalQuery adds the parameters of the UploadSigningCertificateRequest to the form, prefixing their names.
+func (v *UploadSigningCertificateRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CertificateBody != nil {
+		form.Set(prefix+"CertificateBody", *v.CertificateBody)
+	}
+	if v.UserName != nil {
+		form.Set(prefix+"UserName", *v.UserName)
+	}
+	return nil
+}
+
 // UploadSigningCertificateResponse is undocumented.
 type UploadSigningCertificateResponse struct {
 	Certificate *SigningCertificate `query:"Certificate" xml:"UploadSigningCertificateResult>Certificate"`
@@ -1926,18 +3752,18 @@ type User struct {
 type UserDetail struct {
 	ARN            aws.StringValue `query:"Arn" xml:"Arn"`
 	CreateDate     time.Time       `query:"CreateDate" xml:"CreateDate"`
-	GroupList      []string        `query:"GroupList.member" xml:"GroupList>member"`
+	GroupList      []string        `query:"GroupList,list:member" xml:"GroupList>member"`
 	Path           aws.StringValue `query:"Path" xml:"Path"`
 	UserID         aws.StringValue `query:"UserId" xml:"UserId"`
 	UserName       aws.StringValue `query:"UserName" xml:"UserName"`
-	UserPolicyList []PolicyDetail  `query:"UserPolicyList.member" xml:"UserPolicyList>member"`
+	UserPolicyList []PolicyDetail  `query:"UserPolicyList,list:member" xml:"UserPolicyList>member"`
 }
 
 // VirtualMFADevice is undocumented.
 type VirtualMFADevice struct {
-	Base32StringSeed []byte          `query:"Base32StringSeed" xml:"Base32StringSeed"`
+	Base32StringSeed []byte          `query:"Base32StringSeed" xml:"Base32StringSeed" sensitive:"Base32StringSeed"`
 	EnableDate       time.Time       `query:"EnableDate" xml:"EnableDate"`
-	QRCodePNG        []byte          `query:"QRCodePNG" xml:"QRCodePNG"`
+	QRCodePNG        []byte          `query:"QRCodePNG" xml:"QRCodePNG" sensitive:"QRCodePNG"`
 	SerialNumber     aws.StringValue `query:"SerialNumber" xml:"SerialNumber"`
 	User             *User           `query:"User" xml:"User"`
 }
@@ -1973,6 +3799,14 @@ const (
 	SummaryKeyTypeUsersQuota                      = "UsersQuota"
 )
 
+// SummaryMapType is undocumented.
+type SummaryMapType map[string]int
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *SummaryMapType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
 // CreateAccessKeyResult is a wrapper for CreateAccessKeyResponse.
 type CreateAccessKeyResult struct {
 	AccessKey *AccessKey `query:"AccessKey" xml:"CreateAccessKeyResult>AccessKey"`
@@ -2026,11 +3860,11 @@ type GenerateCredentialReportResult struct {
 
 // GetAccountAuthorizationDetailsResult is a wrapper for GetAccountAuthorizationDetailsResponse.
 type GetAccountAuthorizationDetailsResult struct {
-	GroupDetailList []GroupDetail    `query:"GroupDetailList.member" xml:"GetAccountAuthorizationDetailsResult>GroupDetailList>member"`
+	GroupDetailList []GroupDetail    `query:"GroupDetailList,list:member" xml:"GetAccountAuthorizationDetailsResult>GroupDetailList>member"`
 	IsTruncated     aws.BooleanValue `query:"IsTruncated" xml:"GetAccountAuthorizationDetailsResult>IsTruncated"`
 	Marker          aws.StringValue  `query:"Marker" xml:"GetAccountAuthorizationDetailsResult>Marker"`
-	RoleDetailList  []RoleDetail     `query:"RoleDetailList.member" xml:"GetAccountAuthorizationDetailsResult>RoleDetailList>member"`
-	UserDetailList  []UserDetail     `query:"UserDetailList.member" xml:"GetAccountAuthorizationDetailsResult>UserDetailList>member"`
+	RoleDetailList  []RoleDetail     `query:"RoleDetailList,list:member" xml:"GetAccountAuthorizationDetailsResult>RoleDetailList>member"`
+	UserDetailList  []UserDetail     `query:"UserDetailList,list:member" xml:"GetAccountAuthorizationDetailsResult>UserDetailList>member"`
 }
 
 // GetAccountPasswordPolicyResult is a wrapper for GetAccountPasswordPolicyResponse.
@@ -2040,7 +3874,7 @@ type GetAccountPasswordPolicyResult struct {
 
 // GetAccountSummaryResult is a wrapper for GetAccountSummaryResponse.
 type GetAccountSummaryResult struct {
-	SummaryMap map[string]int `query:"SummaryMap" xml:"GetAccountSummaryResult>SummaryMap"`
+	SummaryMap SummaryMapType `query:"SummaryMap,map:entry:key:value" xml:"GetAccountSummaryResult>SummaryMap"`
 }
 
 // GetCredentialReportResult is a wrapper for GetCredentialReportResponse.
@@ -2062,7 +3896,7 @@ type GetGroupResult struct {
 	Group       *Group           `query:"Group" xml:"GetGroupResult>Group"`
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"GetGroupResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"GetGroupResult>Marker"`
-	Users       []User           `query:"Users.member" xml:"GetGroupResult>Users>member"`
+	Users       []User           `query:"Users,list:member" xml:"GetGroupResult>Users>member"`
 }
 
 // GetInstanceProfileResult is a wrapper for GetInstanceProfileResponse.
@@ -2077,9 +3911,9 @@ type GetLoginProfileResult struct {
 
 // GetOpenIDConnectProviderResult is a wrapper for GetOpenIDConnectProviderResponse.
 type GetOpenIDConnectProviderResult struct {
-	ClientIDList   []string        `query:"ClientIDList.member" xml:"GetOpenIDConnectProviderResult>ClientIDList>member"`
+	ClientIDList   []string        `query:"ClientIDList,list:member" xml:"GetOpenIDConnectProviderResult>ClientIDList>member"`
 	CreateDate     time.Time       `query:"CreateDate" xml:"GetOpenIDConnectProviderResult>CreateDate"`
-	ThumbprintList []string        `query:"ThumbprintList.member" xml:"GetOpenIDConnectProviderResult>ThumbprintList>member"`
+	ThumbprintList []string        `query:"ThumbprintList,list:member" xml:"GetOpenIDConnectProviderResult>ThumbprintList>member"`
 	URL            aws.StringValue `query:"Url" xml:"GetOpenIDConnectProviderResult>Url"`
 }
 
@@ -2121,14 +3955,14 @@ type GetUserResult struct {
 
 // ListAccessKeysResult is a wrapper for ListAccessKeysResponse.
 type ListAccessKeysResult struct {
-	AccessKeyMetadata []AccessKeyMetadata `query:"AccessKeyMetadata.member" xml:"ListAccessKeysResult>AccessKeyMetadata>member"`
+	AccessKeyMetadata []AccessKeyMetadata `query:"AccessKeyMetadata,list:member" xml:"ListAccessKeysResult>AccessKeyMetadata>member"`
 	IsTruncated       aws.BooleanValue    `query:"IsTruncated" xml:"ListAccessKeysResult>IsTruncated"`
 	Marker            aws.StringValue     `query:"Marker" xml:"ListAccessKeysResult>Marker"`
 }
 
 // ListAccountAliasesResult is a wrapper for ListAccountAliasesResponse.
 type ListAccountAliasesResult struct {
-	AccountAliases []string         `query:"AccountAliases.member" xml:"ListAccountAliasesResult>AccountAliases>member"`
+	AccountAliases []string         `query:"AccountAliases,list:member" xml:"ListAccountAliasesResult>AccountAliases>member"`
 	IsTruncated    aws.BooleanValue `query:"IsTruncated" xml:"ListAccountAliasesResult>IsTruncated"`
 	Marker         aws.StringValue  `query:"Marker" xml:"ListAccountAliasesResult>Marker"`
 }
@@ -2137,33 +3971,33 @@ type ListAccountAliasesResult struct {
 type ListGroupPoliciesResult struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListGroupPoliciesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListGroupPoliciesResult>Marker"`
-	PolicyNames []string         `query:"PolicyNames.member" xml:"ListGroupPoliciesResult>PolicyNames>member"`
+	PolicyNames []string         `query:"PolicyNames,list:member" xml:"ListGroupPoliciesResult>PolicyNames>member"`
 }
 
 // ListGroupsForUserResult is a wrapper for ListGroupsForUserResponse.
 type ListGroupsForUserResult struct {
-	Groups      []Group          `query:"Groups.member" xml:"ListGroupsForUserResult>Groups>member"`
+	Groups      []Group          `query:"Groups,list:member" xml:"ListGroupsForUserResult>Groups>member"`
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListGroupsForUserResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListGroupsForUserResult>Marker"`
 }
 
 // ListGroupsResult is a wrapper for ListGroupsResponse.
 type ListGroupsResult struct {
-	Groups      []Group          `query:"Groups.member" xml:"ListGroupsResult>Groups>member"`
+	Groups      []Group          `query:"Groups,list:member" xml:"ListGroupsResult>Groups>member"`
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListGroupsResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListGroupsResult>Marker"`
 }
 
 // ListInstanceProfilesForRoleResult is a wrapper for ListInstanceProfilesForRoleResponse.
 type ListInstanceProfilesForRoleResult struct {
-	InstanceProfiles []InstanceProfile `query:"InstanceProfiles.member" xml:"ListInstanceProfilesForRoleResult>InstanceProfiles>member"`
+	InstanceProfiles []InstanceProfile `query:"InstanceProfiles,list:member" xml:"ListInstanceProfilesForRoleResult>InstanceProfiles>member"`
 	IsTruncated      aws.BooleanValue  `query:"IsTruncated" xml:"ListInstanceProfilesForRoleResult>IsTruncated"`
 	Marker           aws.StringValue   `query:"Marker" xml:"ListInstanceProfilesForRoleResult>Marker"`
 }
 
 // ListInstanceProfilesResult is a wrapper for ListInstanceProfilesResponse.
 type ListInstanceProfilesResult struct {
-	InstanceProfiles []InstanceProfile `query:"InstanceProfiles.member" xml:"ListInstanceProfilesResult>InstanceProfiles>member"`
+	InstanceProfiles []InstanceProfile `query:"InstanceProfiles,list:member" xml:"ListInstanceProfilesResult>InstanceProfiles>member"`
 	IsTruncated      aws.BooleanValue  `query:"IsTruncated" xml:"ListInstanceProfilesResult>IsTruncated"`
 	Marker           aws.StringValue   `query:"Marker" xml:"ListInstanceProfilesResult>Marker"`
 }
@@ -2171,44 +4005,44 @@ type ListInstanceProfilesResult struct {
 // ListMFADevicesResult is a wrapper for ListMFADevicesResponse.
 type ListMFADevicesResult struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListMFADevicesResult>IsTruncated"`
-	MFADevices  []MFADevice      `query:"MFADevices.member" xml:"ListMFADevicesResult>MFADevices>member"`
+	MFADevices  []MFADevice      `query:"MFADevices,list:member" xml:"ListMFADevicesResult>MFADevices>member"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListMFADevicesResult>Marker"`
 }
 
 // ListOpenIDConnectProvidersResult is a wrapper for ListOpenIDConnectProvidersResponse.
 type ListOpenIDConnectProvidersResult struct {
-	OpenIDConnectProviderList []OpenIDConnectProviderListEntry `query:"OpenIDConnectProviderList.member" xml:"ListOpenIDConnectProvidersResult>OpenIDConnectProviderList>member"`
+	OpenIDConnectProviderList []OpenIDConnectProviderListEntry `query:"OpenIDConnectProviderList,list:member" xml:"ListOpenIDConnectProvidersResult>OpenIDConnectProviderList>member"`
 }
 
 // ListRolePoliciesResult is a wrapper for ListRolePoliciesResponse.
 type ListRolePoliciesResult struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListRolePoliciesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListRolePoliciesResult>Marker"`
-	PolicyNames []string         `query:"PolicyNames.member" xml:"ListRolePoliciesResult>PolicyNames>member"`
+	PolicyNames []string         `query:"PolicyNames,list:member" xml:"ListRolePoliciesResult>PolicyNames>member"`
 }
 
 // ListRolesResult is a wrapper for ListRolesResponse.
 type ListRolesResult struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListRolesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListRolesResult>Marker"`
-	Roles       []Role           `query:"Roles.member" xml:"ListRolesResult>Roles>member"`
+	Roles       []Role           `query:"Roles,list:member" xml:"ListRolesResult>Roles>member"`
 }
 
 // ListSAMLProvidersResult is a wrapper for ListSAMLProvidersResponse.
 type ListSAMLProvidersResult struct {
-	SAMLProviderList []SAMLProviderListEntry `query:"SAMLProviderList.member" xml:"ListSAMLProvidersResult>SAMLProviderList>member"`
+	SAMLProviderList []SAMLProviderListEntry `query:"SAMLProviderList,list:member" xml:"ListSAMLProvidersResult>SAMLProviderList>member"`
 }
 
 // ListServerCertificatesResult is a wrapper for ListServerCertificatesResponse.
 type ListServerCertificatesResult struct {
 	IsTruncated                   aws.BooleanValue            `query:"IsTruncated" xml:"ListServerCertificatesResult>IsTruncated"`
 	Marker                        aws.StringValue             `query:"Marker" xml:"ListServerCertificatesResult>Marker"`
-	ServerCertificateMetadataList []ServerCertificateMetadata `query:"ServerCertificateMetadataList.member" xml:"ListServerCertificatesResult>ServerCertificateMetadataList>member"`
+	ServerCertificateMetadataList []ServerCertificateMetadata `query:"ServerCertificateMetadataList,list:member" xml:"ListServerCertificatesResult>ServerCertificateMetadataList>member"`
 }
 
 // ListSigningCertificatesResult is a wrapper for ListSigningCertificatesResponse.
 type ListSigningCertificatesResult struct {
-	Certificates []SigningCertificate `query:"Certificates.member" xml:"ListSigningCertificatesResult>Certificates>member"`
+	Certificates []SigningCertificate `query:"Certificates,list:member" xml:"ListSigningCertificatesResult>Certificates>member"`
 	IsTruncated  aws.BooleanValue     `query:"IsTruncated" xml:"ListSigningCertificatesResult>IsTruncated"`
 	Marker       aws.StringValue      `query:"Marker" xml:"ListSigningCertificatesResult>Marker"`
 }
@@ -2217,21 +4051,21 @@ type ListSigningCertificatesResult struct {
 type ListUserPoliciesResult struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListUserPoliciesResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListUserPoliciesResult>Marker"`
-	PolicyNames []string         `query:"PolicyNames.member" xml:"ListUserPoliciesResult>PolicyNames>member"`
+	PolicyNames []string         `query:"PolicyNames,list:member" xml:"ListUserPoliciesResult>PolicyNames>member"`
 }
 
 // ListUsersResult is a wrapper for ListUsersResponse.
 type ListUsersResult struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListUsersResult>IsTruncated"`
 	Marker      aws.StringValue  `query:"Marker" xml:"ListUsersResult>Marker"`
-	Users       []User           `query:"Users.member" xml:"ListUsersResult>Users>member"`
+	Users       []User           `query:"Users,list:member" xml:"ListUsersResult>Users>member"`
 }
 
 // ListVirtualMFADevicesResult is a wrapper for ListVirtualMFADevicesResponse.
 type ListVirtualMFADevicesResult struct {
 	IsTruncated       aws.BooleanValue   `query:"IsTruncated" xml:"ListVirtualMFADevicesResult>IsTruncated"`
 	Marker            aws.StringValue    `query:"Marker" xml:"ListVirtualMFADevicesResult>Marker"`
-	VirtualMFADevices []VirtualMFADevice `query:"VirtualMFADevices.member" xml:"ListVirtualMFADevicesResult>VirtualMFADevices>member"`
+	VirtualMFADevices []VirtualMFADevice `query:"VirtualMFADevices,list:member" xml:"ListVirtualMFADevicesResult>VirtualMFADevices>member"`
 }
 
 // UpdateSAMLProviderResult is a wrapper for UpdateSAMLProviderResponse.
@@ -2249,5 +4083,117 @@ type UploadSigningCertificateResult struct {
 	Certificate *SigningCertificate `query:"Certificate" xml:"UploadSigningCertificateResult>Certificate"`
 }
 
+// CredentialReportExpiredException is the error returned for the ReportExpired error code.
+type CredentialReportExpiredException struct {
+	aws.APIError
+}
+
+// CredentialReportNotPresentException is the error returned for the ReportNotPresent error code.
+type CredentialReportNotPresentException struct {
+	aws.APIError
+}
+
+// CredentialReportNotReadyException is the error returned for the ReportInProgress error code.
+type CredentialReportNotReadyException struct {
+	aws.APIError
+}
+
+// DeleteConflictException is the error returned for the DeleteConflict error code.
+type DeleteConflictException struct {
+	aws.APIError
+}
+
+// DuplicateCertificateException is the error returned for the DuplicateCertificate error code.
+type DuplicateCertificateException struct {
+	aws.APIError
+}
+
+// EntityAlreadyExistsException is the error returned for the EntityAlreadyExists error code.
+type EntityAlreadyExistsException struct {
+	aws.APIError
+}
+
+// EntityTemporarilyUnmodifiableException is the error returned for the EntityTemporarilyUnmodifiable error code.
+type EntityTemporarilyUnmodifiableException struct {
+	aws.APIError
+}
+
+// InvalidAuthenticationCodeException is the error returned for the InvalidAuthenticationCode error code.
+type InvalidAuthenticationCodeException struct {
+	aws.APIError
+}
+
+// InvalidCertificateException is the error returned for the InvalidCertificate error code.
+type InvalidCertificateException struct {
+	aws.APIError
+}
+
+// InvalidInputException is the error returned for the InvalidInput error code.
+type InvalidInputException struct {
+	aws.APIError
+}
+
+// InvalidUserTypeException is the error returned for the InvalidUserType error code.
+type InvalidUserTypeException struct {
+	aws.APIError
+}
+
+// KeyPairMismatchException is the error returned for the KeyPairMismatch error code.
+type KeyPairMismatchException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceeded error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// MalformedCertificateException is the error returned for the MalformedCertificate error code.
+type MalformedCertificateException struct {
+	aws.APIError
+}
+
+// MalformedPolicyDocumentException is the error returned for the MalformedPolicyDocument error code.
+type MalformedPolicyDocumentException struct {
+	aws.APIError
+}
+
+// NoSuchEntityException is the error returned for the NoSuchEntity error code.
+type NoSuchEntityException struct {
+	aws.APIError
+}
+
+// PasswordPolicyViolationException is the error returned for the PasswordPolicyViolation error code.
+type PasswordPolicyViolationException struct {
+	aws.APIError
+}
+
+// exceptions maps IAM's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"ReportExpired":                 CredentialReportExpiredException{},
+	"ReportNotPresent":              CredentialReportNotPresentException{},
+	"ReportInProgress":              CredentialReportNotReadyException{},
+	"DeleteConflict":                DeleteConflictException{},
+	"DuplicateCertificate":          DuplicateCertificateException{},
+	"EntityAlreadyExists":           EntityAlreadyExistsException{},
+	"EntityTemporarilyUnmodifiable": EntityTemporarilyUnmodifiableException{},
+	"InvalidAuthenticationCode":     InvalidAuthenticationCodeException{},
+	"InvalidCertificate":            InvalidCertificateException{},
+	"InvalidInput":                  InvalidInputException{},
+	"InvalidUserType":               InvalidUserTypeException{},
+	"KeyPairMismatch":               KeyPairMismatchException{},
+	"LimitExceeded":                 LimitExceededException{},
+	"MalformedCertificate":          MalformedCertificateException{},
+	"MalformedPolicyDocument":       MalformedPolicyDocumentException{},
+	"NoSuchEntity":                  NoSuchEntityException{},
+	"PasswordPolicyViolation":       PasswordPolicyViolationException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
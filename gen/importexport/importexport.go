@@ -4,11 +4,21 @@
 package importexport
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // ImportExport is a client for AWS Import/Export.
@@ -16,35 +26,84 @@ type ImportExport struct {
 	client *aws.QueryClient
 }
 
-// New returns a new ImportExport client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *ImportExport {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "importexport"
-	endpoint, service, region := endpoints.Lookup("importexport", region)
+// New returns a new ImportExport client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *ImportExport {
+	cfg := newConfig(region, client, opts)
 
 	return &ImportExport{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+				Signer:      aws.V2Signer{},
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2010-06-01",
 		},
 	}
 }
 
+// NewFromSession returns a new ImportExport client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *ImportExport {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("importexport", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("importexport", "importexport"),
+	}, "AWS_ENDPOINT_URL_IMPORTEXPORT", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *ImportExport) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *ImportExport) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *ImportExport) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // CancelJob this operation cancels a specified job. Only the job owner can
 // cancel it. The operation fails if the job has already started or is
 // complete.
 func (c *ImportExport) CancelJob(req *CancelJobInput) (resp *CancelJobResult, err error) {
+	return c.CancelJobWithContext(context.Background(), req)
+}
+
+// CancelJobWithContext is like CancelJob, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ImportExport) CancelJobWithContext(ctx context.Context, req *CancelJobInput) (resp *CancelJobResult, err error) {
 	resp = &CancelJobResult{}
-	err = c.client.Do("CancelJob", "POST", "/?Operation=CancelJob", req, resp)
+	err = c.client.DoWithContext(ctx, "CancelJob", "POST", "/?Operation=CancelJob", req, resp)
 	return
 }
 
@@ -55,8 +114,15 @@ func (c *ImportExport) CancelJob(req *CancelJobInput) (resp *CancelJobResult, er
 // that you use to identify your storage device, and the address where you
 // should ship your storage device.
 func (c *ImportExport) CreateJob(req *CreateJobInput) (resp *CreateJobResult, err error) {
+	return c.CreateJobWithContext(context.Background(), req)
+}
+
+// CreateJobWithContext is like CreateJob, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ImportExport) CreateJobWithContext(ctx context.Context, req *CreateJobInput) (resp *CreateJobResult, err error) {
 	resp = &CreateJobResult{}
-	err = c.client.Do("CreateJob", "POST", "/?Operation=CreateJob", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateJob", "POST", "/?Operation=CreateJob", req, resp)
 	return
 }
 
@@ -65,8 +131,15 @@ func (c *ImportExport) CreateJob(req *CreateJobInput) (resp *CreateJobResult, er
 // and the signature value associated with the job. You can only return
 // information about jobs you own.
 func (c *ImportExport) GetStatus(req *GetStatusInput) (resp *GetStatusResult, err error) {
+	return c.GetStatusWithContext(context.Background(), req)
+}
+
+// GetStatusWithContext is like GetStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ImportExport) GetStatusWithContext(ctx context.Context, req *GetStatusInput) (resp *GetStatusResult, err error) {
 	resp = &GetStatusResult{}
-	err = c.client.Do("GetStatus", "POST", "/?Operation=GetStatus", req, resp)
+	err = c.client.DoWithContext(ctx, "GetStatus", "POST", "/?Operation=GetStatus", req, resp)
 	return
 }
 
@@ -76,8 +149,15 @@ func (c *ImportExport) GetStatus(req *GetStatusInput) (resp *GetStatusResult, er
 // Test2 was created 2010Feb05, the ListJobs operation would return Test2
 // followed by Test1.
 func (c *ImportExport) ListJobs(req *ListJobsInput) (resp *ListJobsResult, err error) {
+	return c.ListJobsWithContext(context.Background(), req)
+}
+
+// ListJobsWithContext is like ListJobs, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ImportExport) ListJobsWithContext(ctx context.Context, req *ListJobsInput) (resp *ListJobsResult, err error) {
 	resp = &ListJobsResult{}
-	err = c.client.Do("ListJobs", "POST", "/?Operation=ListJobs", req, resp)
+	err = c.client.DoWithContext(ctx, "ListJobs", "POST", "/?Operation=ListJobs", req, resp)
 	return
 }
 
@@ -87,8 +167,15 @@ func (c *ImportExport) ListJobs(req *ListJobsInput) (resp *ListJobsResult, err e
 // file. You can only use the operation after a CreateJob request but
 // before the data transfer starts and you can only use it on jobs you own.
 func (c *ImportExport) UpdateJob(req *UpdateJobInput) (resp *UpdateJobResult, err error) {
+	return c.UpdateJobWithContext(context.Background(), req)
+}
+
+// UpdateJobWithContext is like UpdateJob, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *ImportExport) UpdateJobWithContext(ctx context.Context, req *UpdateJobInput) (resp *UpdateJobResult, err error) {
 	resp = &UpdateJobResult{}
-	err = c.client.Do("UpdateJob", "POST", "/?Operation=UpdateJob", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateJob", "POST", "/?Operation=UpdateJob", req, resp)
 	return
 }
 
@@ -97,6 +184,17 @@ type CancelJobInput struct {
 	JobID aws.StringValue `query:"JobId" xml:"JobId"`
 }
 
+// MarshalQuery adds the parameters of the CancelJobInput to the form, prefixing their names.
+func (v *CancelJobInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.JobID != nil {
+		form.Set(prefix+"JobId", *v.JobID)
+	}
+	return nil
+}
+
 // CancelJobOutput is undocumented.
 type CancelJobOutput struct {
 	Success aws.BooleanValue `query:"Success" xml:"CancelJobResult>Success"`
@@ -110,6 +208,26 @@ type CreateJobInput struct {
 	ValidateOnly     aws.BooleanValue `query:"ValidateOnly" xml:"ValidateOnly"`
 }
 
+// MarshalQuery adds the parameters of the CreateJobInput to the form, prefixing their names.
+func (v *CreateJobInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.JobType != nil {
+		form.Set(prefix+"JobType", *v.JobType)
+	}
+	if v.Manifest != nil {
+		form.Set(prefix+"Manifest", *v.Manifest)
+	}
+	if v.ManifestAddendum != nil {
+		form.Set(prefix+"ManifestAddendum", *v.ManifestAddendum)
+	}
+	if v.ValidateOnly != nil {
+		form.Set(prefix+"ValidateOnly", strconv.FormatBool(*v.ValidateOnly))
+	}
+	return nil
+}
+
 // CreateJobOutput is undocumented.
 type CreateJobOutput struct {
 	AWSShippingAddress    aws.StringValue `query:"AwsShippingAddress" xml:"CreateJobResult>AwsShippingAddress"`
@@ -125,6 +243,17 @@ type GetStatusInput struct {
 	JobID aws.StringValue `query:"JobId" xml:"JobId"`
 }
 
+// MarshalQuery adds the parameters of the GetStatusInput to the form, prefixing their names.
+func (v *GetStatusInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.JobID != nil {
+		form.Set(prefix+"JobId", *v.JobID)
+	}
+	return nil
+}
+
 // GetStatusOutput is undocumented.
 type GetStatusOutput struct {
 	AWSShippingAddress    aws.StringValue  `query:"AwsShippingAddress" xml:"GetStatusResult>AwsShippingAddress"`
@@ -165,10 +294,24 @@ type ListJobsInput struct {
 	MaxJobs aws.IntegerValue `query:"MaxJobs" xml:"MaxJobs"`
 }
 
+// MarshalQuery adds the parameters of the ListJobsInput to the form, prefixing their names.
+func (v *ListJobsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxJobs != nil {
+		form.Set(prefix+"MaxJobs", strconv.FormatInt(int64(*v.MaxJobs), 10))
+	}
+	return nil
+}
+
 // ListJobsOutput is undocumented.
 type ListJobsOutput struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListJobsResult>IsTruncated"`
-	Jobs        []Job            `query:"Jobs.member" xml:"ListJobsResult>Jobs>member"`
+	Jobs        []Job            `query:"Jobs,list:member" xml:"ListJobsResult>Jobs>member"`
 }
 
 // UpdateJobInput is undocumented.
@@ -179,6 +322,26 @@ type UpdateJobInput struct {
 	ValidateOnly aws.BooleanValue `query:"ValidateOnly" xml:"ValidateOnly"`
 }
 
+// MarshalQuery adds the parameters of the UpdateJobInput to the form, prefixing their names.
+func (v *UpdateJobInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.JobID != nil {
+		form.Set(prefix+"JobId", *v.JobID)
+	}
+	if v.JobType != nil {
+		form.Set(prefix+"JobType", *v.JobType)
+	}
+	if v.Manifest != nil {
+		form.Set(prefix+"Manifest", *v.Manifest)
+	}
+	if v.ValidateOnly != nil {
+		form.Set(prefix+"ValidateOnly", strconv.FormatBool(*v.ValidateOnly))
+	}
+	return nil
+}
+
 // UpdateJobOutput is undocumented.
 type UpdateJobOutput struct {
 	Success        aws.BooleanValue `query:"Success" xml:"UpdateJobResult>Success"`
@@ -223,7 +386,7 @@ type GetStatusResult struct {
 // ListJobsResult is a wrapper for ListJobsOutput.
 type ListJobsResult struct {
 	IsTruncated aws.BooleanValue `query:"IsTruncated" xml:"ListJobsResult>IsTruncated"`
-	Jobs        []Job            `query:"Jobs.member" xml:"ListJobsResult>Jobs>member"`
+	Jobs        []Job            `query:"Jobs,list:member" xml:"ListJobsResult>Jobs>member"`
 }
 
 // UpdateJobResult is a wrapper for UpdateJobOutput.
@@ -232,5 +395,117 @@ type UpdateJobResult struct {
 	WarningMessage aws.StringValue  `query:"WarningMessage" xml:"UpdateJobResult>WarningMessage"`
 }
 
+// BucketPermissionException is the error returned for the BucketPermissionException error code.
+type BucketPermissionException struct {
+	aws.APIError
+}
+
+// CanceledJobIDException is the error returned for the CanceledJobIdException error code.
+type CanceledJobIDException struct {
+	aws.APIError
+}
+
+// ExpiredJobIDException is the error returned for the ExpiredJobIdException error code.
+type ExpiredJobIDException struct {
+	aws.APIError
+}
+
+// InvalidAccessKeyIDException is the error returned for the InvalidAccessKeyIdException error code.
+type InvalidAccessKeyIDException struct {
+	aws.APIError
+}
+
+// InvalidAddressException is the error returned for the InvalidAddressException error code.
+type InvalidAddressException struct {
+	aws.APIError
+}
+
+// InvalidCustomsException is the error returned for the InvalidCustomsException error code.
+type InvalidCustomsException struct {
+	aws.APIError
+}
+
+// InvalidFileSystemException is the error returned for the InvalidFileSystemException error code.
+type InvalidFileSystemException struct {
+	aws.APIError
+}
+
+// InvalidJobIDException is the error returned for the InvalidJobIdException error code.
+type InvalidJobIDException struct {
+	aws.APIError
+}
+
+// InvalidManifestFieldException is the error returned for the InvalidManifestFieldException error code.
+type InvalidManifestFieldException struct {
+	aws.APIError
+}
+
+// InvalidParameterException is the error returned for the InvalidParameterException error code.
+type InvalidParameterException struct {
+	aws.APIError
+}
+
+// MalformedManifestException is the error returned for the MalformedManifestException error code.
+type MalformedManifestException struct {
+	aws.APIError
+}
+
+// MissingCustomsException is the error returned for the MissingCustomsException error code.
+type MissingCustomsException struct {
+	aws.APIError
+}
+
+// MissingManifestFieldException is the error returned for the MissingManifestFieldException error code.
+type MissingManifestFieldException struct {
+	aws.APIError
+}
+
+// MissingParameterException is the error returned for the MissingParameterException error code.
+type MissingParameterException struct {
+	aws.APIError
+}
+
+// MultipleRegionsException is the error returned for the MultipleRegionsException error code.
+type MultipleRegionsException struct {
+	aws.APIError
+}
+
+// NoSuchBucketException is the error returned for the NoSuchBucketException error code.
+type NoSuchBucketException struct {
+	aws.APIError
+}
+
+// UnableToCancelJobIDException is the error returned for the UnableToCancelJobIdException error code.
+type UnableToCancelJobIDException struct {
+	aws.APIError
+}
+
+// exceptions maps ImportExport's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"BucketPermissionException":     BucketPermissionException{},
+	"CanceledJobIdException":        CanceledJobIDException{},
+	"ExpiredJobIdException":         ExpiredJobIDException{},
+	"InvalidAccessKeyIdException":   InvalidAccessKeyIDException{},
+	"InvalidAddressException":       InvalidAddressException{},
+	"InvalidCustomsException":       InvalidCustomsException{},
+	"InvalidFileSystemException":    InvalidFileSystemException{},
+	"InvalidJobIdException":         InvalidJobIDException{},
+	"InvalidManifestFieldException": InvalidManifestFieldException{},
+	"InvalidParameterException":     InvalidParameterException{},
+	"MalformedManifestException":    MalformedManifestException{},
+	"MissingCustomsException":       MissingCustomsException{},
+	"MissingManifestFieldException": MissingManifestFieldException{},
+	"MissingParameterException":     MissingParameterException{},
+	"MultipleRegionsException":      MultipleRegionsException{},
+	"NoSuchBucketException":         NoSuchBucketException{},
+	"UnableToCancelJobIdException":  UnableToCancelJobIDException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,11 +4,18 @@
 package kinesis
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // Kinesis is a client for Amazon Kinesis.
@@ -16,36 +23,78 @@ type Kinesis struct {
 	client *aws.JSONClient
 }
 
-// New returns a new Kinesis client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *Kinesis {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "kinesis"
-	endpoint, service, region := endpoints.Lookup("kinesis", region)
+// New returns a new Kinesis client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *Kinesis {
+	cfg := newConfig(region, client, opts)
 
 	return &Kinesis{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "Kinesis_20131202",
 		},
 	}
 }
 
+// NewFromSession returns a new Kinesis client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *Kinesis {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("kinesis", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("kinesis", "kinesis"),
+	}, "AWS_ENDPOINT_URL_KINESIS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *Kinesis) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *Kinesis) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // AddTagsToStream adds or updates tags for the specified Amazon Kinesis
 // stream. Each stream can have up to 10 tags. If tags have already been
 // assigned to the stream, AddTagsToStream overwrites any existing tags
 // that correspond to the specified tag keys.
 func (c *Kinesis) AddTagsToStream(req *AddTagsToStreamInput) (err error) {
+	return c.AddTagsToStreamWithContext(context.Background(), req)
+}
+
+// AddTagsToStreamWithContext is like AddTagsToStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) AddTagsToStreamWithContext(ctx context.Context, req *AddTagsToStreamInput) (err error) {
 	// NRE
-	err = c.client.Do("AddTagsToStream", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AddTagsToStream", "POST", "/", req, nil)
 	return
 }
 
@@ -78,8 +127,15 @@ func (c *Kinesis) AddTagsToStream(req *AddTagsToStreamInput) (err error) {
 // returned in StreamStatus CreateStream has a limit of 5 transactions per
 // second per account.
 func (c *Kinesis) CreateStream(req *CreateStreamInput) (err error) {
+	return c.CreateStreamWithContext(context.Background(), req)
+}
+
+// CreateStreamWithContext is like CreateStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) CreateStreamWithContext(ctx context.Context, req *CreateStreamInput) (err error) {
 	// NRE
-	err = c.client.Do("CreateStream", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateStream", "POST", "/", req, nil)
 	return
 }
 
@@ -98,8 +154,15 @@ func (c *Kinesis) CreateStream(req *CreateStreamInput) (err error) {
 // StreamStatus DeleteStream has a limit of 5 transactions per second per
 // account.
 func (c *Kinesis) DeleteStream(req *DeleteStreamInput) (err error) {
+	return c.DeleteStreamWithContext(context.Background(), req)
+}
+
+// DeleteStreamWithContext is like DeleteStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) DeleteStreamWithContext(ctx context.Context, req *DeleteStreamInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteStream", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteStream", "POST", "/", req, nil)
 	return
 }
 
@@ -121,8 +184,15 @@ func (c *Kinesis) DeleteStream(req *DeleteStreamInput) (err error) {
 // subsequent request to DescribeStream . DescribeStream has a limit of 10
 // transactions per second per account.
 func (c *Kinesis) DescribeStream(req *DescribeStreamInput) (resp *DescribeStreamOutput, err error) {
+	return c.DescribeStreamWithContext(context.Background(), req)
+}
+
+// DescribeStreamWithContext is like DescribeStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) DescribeStreamWithContext(ctx context.Context, req *DescribeStreamInput) (resp *DescribeStreamOutput, err error) {
 	resp = &DescribeStreamOutput{}
-	err = c.client.Do("DescribeStream", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStream", "POST", "/", req, resp)
 	return
 }
 
@@ -167,8 +237,15 @@ func (c *Kinesis) DescribeStream(req *DescribeStreamInput) (resp *DescribeStream
 // PutRecords ). For more information, see Monitoring Amazon Kinesis with
 // Amazon CloudWatch in the Amazon Kinesis Developer Guide
 func (c *Kinesis) GetRecords(req *GetRecordsInput) (resp *GetRecordsOutput, err error) {
+	return c.GetRecordsWithContext(context.Background(), req)
+}
+
+// GetRecordsWithContext is like GetRecords, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) GetRecordsWithContext(ctx context.Context, req *GetRecordsInput) (resp *GetRecordsOutput, err error) {
 	resp = &GetRecordsOutput{}
-	err = c.client.Do("GetRecords", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetRecords", "POST", "/", req, resp)
 	return
 }
 
@@ -204,8 +281,15 @@ func (c *Kinesis) GetRecords(req *GetRecordsInput) (resp *GetRecordsOutput, err
 // GetShardIterator has a limit of 5 transactions per second per account
 // per open shard.
 func (c *Kinesis) GetShardIterator(req *GetShardIteratorInput) (resp *GetShardIteratorOutput, err error) {
+	return c.GetShardIteratorWithContext(context.Background(), req)
+}
+
+// GetShardIteratorWithContext is like GetShardIterator, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) GetShardIteratorWithContext(ctx context.Context, req *GetShardIteratorInput) (resp *GetShardIteratorOutput, err error) {
 	resp = &GetShardIteratorOutput{}
-	err = c.client.Do("GetShardIterator", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetShardIterator", "POST", "/", req, resp)
 	return
 }
 
@@ -223,16 +307,30 @@ func (c *Kinesis) GetShardIterator(req *GetShardIteratorInput) (resp *GetShardIt
 // process until all the stream names have been collected in the list.
 // ListStreams has a limit of 5 transactions per second per account.
 func (c *Kinesis) ListStreams(req *ListStreamsInput) (resp *ListStreamsOutput, err error) {
+	return c.ListStreamsWithContext(context.Background(), req)
+}
+
+// ListStreamsWithContext is like ListStreams, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) ListStreamsWithContext(ctx context.Context, req *ListStreamsInput) (resp *ListStreamsOutput, err error) {
 	resp = &ListStreamsOutput{}
-	err = c.client.Do("ListStreams", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListStreams", "POST", "/", req, resp)
 	return
 }
 
 // ListTagsForStream lists the tags for the specified Amazon Kinesis
 // stream.
 func (c *Kinesis) ListTagsForStream(req *ListTagsForStreamInput) (resp *ListTagsForStreamOutput, err error) {
+	return c.ListTagsForStreamWithContext(context.Background(), req)
+}
+
+// ListTagsForStreamWithContext is like ListTagsForStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) ListTagsForStreamWithContext(ctx context.Context, req *ListTagsForStreamInput) (resp *ListTagsForStreamOutput, err error) {
 	resp = &ListTagsForStreamOutput{}
-	err = c.client.Do("ListTagsForStream", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListTagsForStream", "POST", "/", req, resp)
 	return
 }
 
@@ -265,8 +363,15 @@ func (c *Kinesis) ListTagsForStream(req *ListTagsForStreamInput) (resp *ListTags
 // LimitExceededException . MergeShards has limit of 5 transactions per
 // second per account.
 func (c *Kinesis) MergeShards(req *MergeShardsInput) (err error) {
+	return c.MergeShardsWithContext(context.Background(), req)
+}
+
+// MergeShardsWithContext is like MergeShards, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) MergeShardsWithContext(ctx context.Context, req *MergeShardsInput) (err error) {
 	// NRE
-	err = c.client.Do("MergeShards", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "MergeShards", "POST", "/", req, nil)
 	return
 }
 
@@ -301,8 +406,15 @@ func (c *Kinesis) MergeShards(req *MergeShardsInput) (err error) {
 // only 24 hours from the time that they are added to an Amazon Kinesis
 // stream.
 func (c *Kinesis) PutRecord(req *PutRecordInput) (resp *PutRecordOutput, err error) {
+	return c.PutRecordWithContext(context.Background(), req)
+}
+
+// PutRecordWithContext is like PutRecord, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) PutRecordWithContext(ctx context.Context, req *PutRecordInput) (resp *PutRecordOutput, err error) {
 	resp = &PutRecordOutput{}
-	err = c.client.Do("PutRecord", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PutRecord", "POST", "/", req, resp)
 	return
 }
 
@@ -349,16 +461,30 @@ func (c *Kinesis) PutRecord(req *PutRecordInput) (resp *PutRecordOutput, err err
 // Data records are accessible for only 24 hours from the time that they
 // are added to an Amazon Kinesis stream.
 func (c *Kinesis) PutRecords(req *PutRecordsInput) (resp *PutRecordsOutput, err error) {
+	return c.PutRecordsWithContext(context.Background(), req)
+}
+
+// PutRecordsWithContext is like PutRecords, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) PutRecordsWithContext(ctx context.Context, req *PutRecordsInput) (resp *PutRecordsOutput, err error) {
 	resp = &PutRecordsOutput{}
-	err = c.client.Do("PutRecords", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PutRecords", "POST", "/", req, resp)
 	return
 }
 
 // RemoveTagsFromStream deletes tags from the specified Amazon Kinesis
 // stream. If you specify a tag that does not exist, it is ignored.
 func (c *Kinesis) RemoveTagsFromStream(req *RemoveTagsFromStreamInput) (err error) {
+	return c.RemoveTagsFromStreamWithContext(context.Background(), req)
+}
+
+// RemoveTagsFromStreamWithContext is like RemoveTagsFromStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) RemoveTagsFromStreamWithContext(ctx context.Context, req *RemoveTagsFromStreamInput) (err error) {
 	// NRE
-	err = c.client.Do("RemoveTagsFromStream", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RemoveTagsFromStream", "POST", "/", req, nil)
 	return
 }
 
@@ -398,8 +524,15 @@ func (c *Kinesis) RemoveTagsFromStream(req *RemoveTagsFromStreamInput) (err erro
 // LimitExceededException . SplitShard has limit of 5 transactions per
 // second per account.
 func (c *Kinesis) SplitShard(req *SplitShardInput) (err error) {
+	return c.SplitShardWithContext(context.Background(), req)
+}
+
+// SplitShardWithContext is like SplitShard, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Kinesis) SplitShardWithContext(ctx context.Context, req *SplitShardInput) (err error) {
 	// NRE
-	err = c.client.Do("SplitShard", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SplitShard", "POST", "/", req, nil)
 	return
 }
 
@@ -409,17 +542,129 @@ type AddTagsToStreamInput struct {
 	Tags       map[string]string `json:"Tags"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddTagsToStreamInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddTagsToStreamInput to b.
+func (v *AddTagsToStreamInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Tags\":"...)
+	if v.Tags == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Tags))
+		for k := range v.Tags {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Tags[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateStreamInput is undocumented.
 type CreateStreamInput struct {
 	ShardCount aws.IntegerValue `json:"ShardCount"`
 	StreamName aws.StringValue  `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateStreamInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateStreamInput to b.
+func (v *CreateStreamInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ShardCount\":"...)
+	if v.ShardCount == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.ShardCount), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteStreamInput is undocumented.
 type DeleteStreamInput struct {
 	StreamName aws.StringValue `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteStreamInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteStreamInput to b.
+func (v *DeleteStreamInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeStreamInput is undocumented.
 type DescribeStreamInput struct {
 	ExclusiveStartShardID aws.StringValue  `json:"ExclusiveStartShardId,omitempty"`
@@ -427,6 +672,43 @@ type DescribeStreamInput struct {
 	StreamName            aws.StringValue  `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeStreamInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeStreamInput to b.
+func (v *DescribeStreamInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ExclusiveStartShardID != nil {
+		b = append(b, "\"ExclusiveStartShardId\":"...)
+		b = aws.AppendJSONString(b, *v.ExclusiveStartShardID)
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeStreamOutput is undocumented.
 type DescribeStreamOutput struct {
 	StreamDescription *StreamDescription `json:"StreamDescription"`
@@ -438,6 +720,38 @@ type GetRecordsInput struct {
 	ShardIterator aws.StringValue  `json:"ShardIterator"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetRecordsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetRecordsInput to b.
+func (v *GetRecordsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"ShardIterator\":"...)
+	if v.ShardIterator == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ShardIterator)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetRecordsOutput is undocumented.
 type GetRecordsOutput struct {
 	NextShardIterator aws.StringValue `json:"NextShardIterator,omitempty"`
@@ -452,6 +766,52 @@ type GetShardIteratorInput struct {
 	StreamName             aws.StringValue `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetShardIteratorInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetShardIteratorInput to b.
+func (v *GetShardIteratorInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ShardId\":"...)
+	if v.ShardID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ShardID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"ShardIteratorType\":"...)
+	if v.ShardIteratorType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ShardIteratorType)
+	}
+	b = append(b, ',')
+	if v.StartingSequenceNumber != nil {
+		b = append(b, "\"StartingSequenceNumber\":"...)
+		b = aws.AppendJSONString(b, *v.StartingSequenceNumber)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetShardIteratorOutput is undocumented.
 type GetShardIteratorOutput struct {
 	ShardIterator aws.StringValue `json:"ShardIterator,omitempty"`
@@ -469,6 +829,36 @@ type ListStreamsInput struct {
 	Limit                    aws.IntegerValue `json:"Limit,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListStreamsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListStreamsInput to b.
+func (v *ListStreamsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ExclusiveStartStreamName != nil {
+		b = append(b, "\"ExclusiveStartStreamName\":"...)
+		b = aws.AppendJSONString(b, *v.ExclusiveStartStreamName)
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListStreamsOutput is undocumented.
 type ListStreamsOutput struct {
 	HasMoreStreams aws.BooleanValue `json:"HasMoreStreams"`
@@ -482,6 +872,43 @@ type ListTagsForStreamInput struct {
 	StreamName           aws.StringValue  `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListTagsForStreamInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListTagsForStreamInput to b.
+func (v *ListTagsForStreamInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ExclusiveStartTagKey != nil {
+		b = append(b, "\"ExclusiveStartTagKey\":"...)
+		b = aws.AppendJSONString(b, *v.ExclusiveStartTagKey)
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListTagsForStreamOutput is undocumented.
 type ListTagsForStreamOutput struct {
 	HasMoreTags aws.BooleanValue `json:"HasMoreTags"`
@@ -495,6 +922,47 @@ type MergeShardsInput struct {
 	StreamName           aws.StringValue `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *MergeShardsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the MergeShardsInput to b.
+func (v *MergeShardsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AdjacentShardToMerge\":"...)
+	if v.AdjacentShardToMerge == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AdjacentShardToMerge)
+	}
+	b = append(b, ',')
+	b = append(b, "\"ShardToMerge\":"...)
+	if v.ShardToMerge == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ShardToMerge)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutRecordInput is undocumented.
 type PutRecordInput struct {
 	Data                      []byte          `json:"Data"`
@@ -504,6 +972,53 @@ type PutRecordInput struct {
 	StreamName                aws.StringValue `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutRecordInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutRecordInput to b.
+func (v *PutRecordInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Data\":"...)
+	b = aws.AppendJSONBytes(b, v.Data)
+	b = append(b, ',')
+	if v.ExplicitHashKey != nil {
+		b = append(b, "\"ExplicitHashKey\":"...)
+		b = aws.AppendJSONString(b, *v.ExplicitHashKey)
+		b = append(b, ',')
+	}
+	b = append(b, "\"PartitionKey\":"...)
+	if v.PartitionKey == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PartitionKey)
+	}
+	b = append(b, ',')
+	if v.SequenceNumberForOrdering != nil {
+		b = append(b, "\"SequenceNumberForOrdering\":"...)
+		b = aws.AppendJSONString(b, *v.SequenceNumberForOrdering)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutRecordOutput is undocumented.
 type PutRecordOutput struct {
 	SequenceNumber aws.StringValue `json:"SequenceNumber"`
@@ -516,6 +1031,52 @@ type PutRecordsInput struct {
 	StreamName aws.StringValue          `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutRecordsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutRecordsInput to b.
+func (v *PutRecordsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Records\":"...)
+	if v.Records == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Records {
+			b, err = v.Records[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutRecordsOutput is undocumented.
 type PutRecordsOutput struct {
 	FailedRecordCount aws.IntegerValue        `json:"FailedRecordCount,omitempty"`
@@ -529,6 +1090,41 @@ type PutRecordsRequestEntry struct {
 	PartitionKey    aws.StringValue `json:"PartitionKey"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutRecordsRequestEntry) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutRecordsRequestEntry to b.
+func (v *PutRecordsRequestEntry) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Data\":"...)
+	b = aws.AppendJSONBytes(b, v.Data)
+	b = append(b, ',')
+	if v.ExplicitHashKey != nil {
+		b = append(b, "\"ExplicitHashKey\":"...)
+		b = aws.AppendJSONString(b, *v.ExplicitHashKey)
+		b = append(b, ',')
+	}
+	b = append(b, "\"PartitionKey\":"...)
+	if v.PartitionKey == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PartitionKey)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutRecordsResultEntry is undocumented.
 type PutRecordsResultEntry struct {
 	ErrorCode      aws.StringValue `json:"ErrorCode,omitempty"`
@@ -550,6 +1146,49 @@ type RemoveTagsFromStreamInput struct {
 	TagKeys    []string        `json:"TagKeys"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RemoveTagsFromStreamInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RemoveTagsFromStreamInput to b.
+func (v *RemoveTagsFromStreamInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TagKeys\":"...)
+	if v.TagKeys == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.TagKeys {
+			b = aws.AppendJSONString(b, v.TagKeys[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SequenceNumberRange is undocumented.
 type SequenceNumberRange struct {
 	EndingSequenceNumber   aws.StringValue `json:"EndingSequenceNumber,omitempty"`
@@ -580,6 +1219,47 @@ type SplitShardInput struct {
 	StreamName         aws.StringValue `json:"StreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SplitShardInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SplitShardInput to b.
+func (v *SplitShardInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"NewStartingHashKey\":"...)
+	if v.NewStartingHashKey == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.NewStartingHashKey)
+	}
+	b = append(b, ',')
+	b = append(b, "\"ShardToSplit\":"...)
+	if v.ShardToSplit == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ShardToSplit)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StreamName\":"...)
+	if v.StreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StreamDescription is undocumented.
 type StreamDescription struct {
 	HasMoreShards aws.BooleanValue `json:"HasMoreShards"`
@@ -603,5 +1283,48 @@ type Tag struct {
 	Value aws.StringValue `json:"Value,omitempty"`
 }
 
+// ExpiredIteratorException is the error returned for the ExpiredIteratorException error code.
+type ExpiredIteratorException struct {
+	aws.APIError
+}
+
+// InvalidArgumentException is the error returned for the InvalidArgumentException error code.
+type InvalidArgumentException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// ProvisionedThroughputExceededException is the error returned for the ProvisionedThroughputExceededException error code.
+type ProvisionedThroughputExceededException struct {
+	aws.APIError
+}
+
+// ResourceInUseException is the error returned for the ResourceInUseException error code.
+type ResourceInUseException struct {
+	aws.APIError
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// exceptions maps Kinesis's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"ExpiredIteratorException":               ExpiredIteratorException{},
+	"InvalidArgumentException":               InvalidArgumentException{},
+	"LimitExceededException":                 LimitExceededException{},
+	"ProvisionedThroughputExceededException": ProvisionedThroughputExceededException{},
+	"ResourceInUseException":                 ResourceInUseException{},
+	"ResourceNotFoundException":              ResourceNotFoundException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -4,11 +4,18 @@
 package kms
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // KMS is a client for AWS Key Management Service.
@@ -16,29 +23,64 @@ type KMS struct {
 	client *aws.JSONClient
 }
 
-// New returns a new KMS client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *KMS {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "kms"
-	endpoint, service, region := endpoints.Lookup("kms", region)
+// New returns a new KMS client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *KMS {
+	cfg := newConfig(region, client, opts)
 
 	return &KMS{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "TrentService",
 		},
 	}
 }
 
+// NewFromSession returns a new KMS client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *KMS {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("kms", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("kms", "kms"),
+	}, "AWS_ENDPOINT_URL_KMS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *KMS) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *KMS) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // CreateAlias creates a display name for a customer master key. An alias
 // can be used to identify a key and should be unique. The console enforces
 // a one-to-one mapping between the alias and a key. An alias name can
@@ -47,8 +89,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *KMS
 // slash (alias/). An alias that begins with "aws" after the forward slash
 // (alias/aws...) is reserved by Amazon Web Services
 func (c *KMS) CreateAlias(req *CreateAliasRequest) (err error) {
+	return c.CreateAliasWithContext(context.Background(), req)
+}
+
+// CreateAliasWithContext is like CreateAlias, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) CreateAliasWithContext(ctx context.Context, req *CreateAliasRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateAlias", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateAlias", "POST", "/", req, nil)
 	return
 }
 
@@ -61,8 +110,15 @@ func (c *KMS) CreateAlias(req *CreateAliasRequest) (err error) {
 // want to end a grant immediately, revoke it. For more information about
 // grants, see Grants .
 func (c *KMS) CreateGrant(req *CreateGrantRequest) (resp *CreateGrantResponse, err error) {
+	return c.CreateGrantWithContext(context.Background(), req)
+}
+
+// CreateGrantWithContext is like CreateGrant, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) CreateGrantWithContext(ctx context.Context, req *CreateGrantRequest) (resp *CreateGrantResponse, err error) {
 	resp = &CreateGrantResponse{}
-	err = c.client.Do("CreateGrant", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateGrant", "POST", "/", req, resp)
 	return
 }
 
@@ -72,76 +128,146 @@ func (c *KMS) CreateGrant(req *CreateGrantRequest) (resp *CreateGrantResponse, e
 // used to encrypt customer data. For more information about data keys, see
 // GenerateDataKey and GenerateDataKeyWithoutPlaintext
 func (c *KMS) CreateKey(req *CreateKeyRequest) (resp *CreateKeyResponse, err error) {
+	return c.CreateKeyWithContext(context.Background(), req)
+}
+
+// CreateKeyWithContext is like CreateKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) CreateKeyWithContext(ctx context.Context, req *CreateKeyRequest) (resp *CreateKeyResponse, err error) {
 	resp = &CreateKeyResponse{}
-	err = c.client.Do("CreateKey", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateKey", "POST", "/", req, resp)
 	return
 }
 
 // Decrypt decrypts ciphertext. Ciphertext is plaintext that has been
 // previously encrypted by using the Encrypt function.
 func (c *KMS) Decrypt(req *DecryptRequest) (resp *DecryptResponse, err error) {
+	return c.DecryptWithContext(context.Background(), req)
+}
+
+// DecryptWithContext is like Decrypt, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) DecryptWithContext(ctx context.Context, req *DecryptRequest) (resp *DecryptResponse, err error) {
 	resp = &DecryptResponse{}
-	err = c.client.Do("Decrypt", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "Decrypt", "POST", "/", req, resp)
 	return
 }
 
 // DeleteAlias is undocumented.
 func (c *KMS) DeleteAlias(req *DeleteAliasRequest) (err error) {
+	return c.DeleteAliasWithContext(context.Background(), req)
+}
+
+// DeleteAliasWithContext is like DeleteAlias, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) DeleteAliasWithContext(ctx context.Context, req *DeleteAliasRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteAlias", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteAlias", "POST", "/", req, nil)
 	return
 }
 
 // DescribeKey provides detailed information about the specified customer
 // master key.
 func (c *KMS) DescribeKey(req *DescribeKeyRequest) (resp *DescribeKeyResponse, err error) {
+	return c.DescribeKeyWithContext(context.Background(), req)
+}
+
+// DescribeKeyWithContext is like DescribeKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) DescribeKeyWithContext(ctx context.Context, req *DescribeKeyRequest) (resp *DescribeKeyResponse, err error) {
 	resp = &DescribeKeyResponse{}
-	err = c.client.Do("DescribeKey", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeKey", "POST", "/", req, resp)
 	return
 }
 
 // DisableKey marks a key as disabled, thereby preventing its use.
 func (c *KMS) DisableKey(req *DisableKeyRequest) (err error) {
+	return c.DisableKeyWithContext(context.Background(), req)
+}
+
+// DisableKeyWithContext is like DisableKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) DisableKeyWithContext(ctx context.Context, req *DisableKeyRequest) (err error) {
 	// NRE
-	err = c.client.Do("DisableKey", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisableKey", "POST", "/", req, nil)
 	return
 }
 
 // DisableKeyRotation is undocumented.
 func (c *KMS) DisableKeyRotation(req *DisableKeyRotationRequest) (err error) {
+	return c.DisableKeyRotationWithContext(context.Background(), req)
+}
+
+// DisableKeyRotationWithContext is like DisableKeyRotation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) DisableKeyRotationWithContext(ctx context.Context, req *DisableKeyRotationRequest) (err error) {
 	// NRE
-	err = c.client.Do("DisableKeyRotation", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisableKeyRotation", "POST", "/", req, nil)
 	return
 }
 
 // EnableKey marks a key as enabled, thereby permitting its use. You can
 // have up to 25 enabled keys at one time.
 func (c *KMS) EnableKey(req *EnableKeyRequest) (err error) {
+	return c.EnableKeyWithContext(context.Background(), req)
+}
+
+// EnableKeyWithContext is like EnableKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) EnableKeyWithContext(ctx context.Context, req *EnableKeyRequest) (err error) {
 	// NRE
-	err = c.client.Do("EnableKey", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "EnableKey", "POST", "/", req, nil)
 	return
 }
 
 // EnableKeyRotation enables rotation of the specified customer master key.
 func (c *KMS) EnableKeyRotation(req *EnableKeyRotationRequest) (err error) {
+	return c.EnableKeyRotationWithContext(context.Background(), req)
+}
+
+// EnableKeyRotationWithContext is like EnableKeyRotation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) EnableKeyRotationWithContext(ctx context.Context, req *EnableKeyRotationRequest) (err error) {
 	// NRE
-	err = c.client.Do("EnableKeyRotation", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "EnableKeyRotation", "POST", "/", req, nil)
 	return
 }
 
 // Encrypt encrypts plaintext into ciphertext by using a customer master
 // key.
 func (c *KMS) Encrypt(req *EncryptRequest) (resp *EncryptResponse, err error) {
+	return c.EncryptWithContext(context.Background(), req)
+}
+
+// EncryptWithContext is like Encrypt, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) EncryptWithContext(ctx context.Context, req *EncryptRequest) (resp *EncryptResponse, err error) {
 	resp = &EncryptResponse{}
-	err = c.client.Do("Encrypt", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "Encrypt", "POST", "/", req, resp)
 	return
 }
 
 // GenerateDataKey generates a secure data key. Data keys are used to
 // encrypt and decrypt data. They are wrapped by customer master keys.
 func (c *KMS) GenerateDataKey(req *GenerateDataKeyRequest) (resp *GenerateDataKeyResponse, err error) {
+	return c.GenerateDataKeyWithContext(context.Background(), req)
+}
+
+// GenerateDataKeyWithContext is like GenerateDataKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) GenerateDataKeyWithContext(ctx context.Context, req *GenerateDataKeyRequest) (resp *GenerateDataKeyResponse, err error) {
 	resp = &GenerateDataKeyResponse{}
-	err = c.client.Do("GenerateDataKey", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GenerateDataKey", "POST", "/", req, resp)
 	return
 }
 
@@ -149,65 +275,128 @@ func (c *KMS) GenerateDataKey(req *GenerateDataKeyRequest) (resp *GenerateDataKe
 // master key without the plaintext copy of that key. To retrieve the
 // plaintext, see GenerateDataKey .
 func (c *KMS) GenerateDataKeyWithoutPlaintext(req *GenerateDataKeyWithoutPlaintextRequest) (resp *GenerateDataKeyWithoutPlaintextResponse, err error) {
+	return c.GenerateDataKeyWithoutPlaintextWithContext(context.Background(), req)
+}
+
+// GenerateDataKeyWithoutPlaintextWithContext is like GenerateDataKeyWithoutPlaintext, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) GenerateDataKeyWithoutPlaintextWithContext(ctx context.Context, req *GenerateDataKeyWithoutPlaintextRequest) (resp *GenerateDataKeyWithoutPlaintextResponse, err error) {
 	resp = &GenerateDataKeyWithoutPlaintextResponse{}
-	err = c.client.Do("GenerateDataKeyWithoutPlaintext", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GenerateDataKeyWithoutPlaintext", "POST", "/", req, resp)
 	return
 }
 
 // GenerateRandom is undocumented.
 func (c *KMS) GenerateRandom(req *GenerateRandomRequest) (resp *GenerateRandomResponse, err error) {
+	return c.GenerateRandomWithContext(context.Background(), req)
+}
+
+// GenerateRandomWithContext is like GenerateRandom, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) GenerateRandomWithContext(ctx context.Context, req *GenerateRandomRequest) (resp *GenerateRandomResponse, err error) {
 	resp = &GenerateRandomResponse{}
-	err = c.client.Do("GenerateRandom", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GenerateRandom", "POST", "/", req, resp)
 	return
 }
 
 // GetKeyPolicy is undocumented.
 func (c *KMS) GetKeyPolicy(req *GetKeyPolicyRequest) (resp *GetKeyPolicyResponse, err error) {
+	return c.GetKeyPolicyWithContext(context.Background(), req)
+}
+
+// GetKeyPolicyWithContext is like GetKeyPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) GetKeyPolicyWithContext(ctx context.Context, req *GetKeyPolicyRequest) (resp *GetKeyPolicyResponse, err error) {
 	resp = &GetKeyPolicyResponse{}
-	err = c.client.Do("GetKeyPolicy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetKeyPolicy", "POST", "/", req, resp)
 	return
 }
 
 // GetKeyRotationStatus retrieves a Boolean value that indicates whether
 // key rotation is enabled for the specified key.
 func (c *KMS) GetKeyRotationStatus(req *GetKeyRotationStatusRequest) (resp *GetKeyRotationStatusResponse, err error) {
+	return c.GetKeyRotationStatusWithContext(context.Background(), req)
+}
+
+// GetKeyRotationStatusWithContext is like GetKeyRotationStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) GetKeyRotationStatusWithContext(ctx context.Context, req *GetKeyRotationStatusRequest) (resp *GetKeyRotationStatusResponse, err error) {
 	resp = &GetKeyRotationStatusResponse{}
-	err = c.client.Do("GetKeyRotationStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetKeyRotationStatus", "POST", "/", req, resp)
 	return
 }
 
 // ListAliases is undocumented.
 func (c *KMS) ListAliases(req *ListAliasesRequest) (resp *ListAliasesResponse, err error) {
+	return c.ListAliasesWithContext(context.Background(), req)
+}
+
+// ListAliasesWithContext is like ListAliases, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) ListAliasesWithContext(ctx context.Context, req *ListAliasesRequest) (resp *ListAliasesResponse, err error) {
 	resp = &ListAliasesResponse{}
-	err = c.client.Do("ListAliases", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListAliases", "POST", "/", req, resp)
 	return
 }
 
 // ListGrants is undocumented.
 func (c *KMS) ListGrants(req *ListGrantsRequest) (resp *ListGrantsResponse, err error) {
+	return c.ListGrantsWithContext(context.Background(), req)
+}
+
+// ListGrantsWithContext is like ListGrants, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) ListGrantsWithContext(ctx context.Context, req *ListGrantsRequest) (resp *ListGrantsResponse, err error) {
 	resp = &ListGrantsResponse{}
-	err = c.client.Do("ListGrants", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListGrants", "POST", "/", req, resp)
 	return
 }
 
 // ListKeyPolicies is undocumented.
 func (c *KMS) ListKeyPolicies(req *ListKeyPoliciesRequest) (resp *ListKeyPoliciesResponse, err error) {
+	return c.ListKeyPoliciesWithContext(context.Background(), req)
+}
+
+// ListKeyPoliciesWithContext is like ListKeyPolicies, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) ListKeyPoliciesWithContext(ctx context.Context, req *ListKeyPoliciesRequest) (resp *ListKeyPoliciesResponse, err error) {
 	resp = &ListKeyPoliciesResponse{}
-	err = c.client.Do("ListKeyPolicies", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListKeyPolicies", "POST", "/", req, resp)
 	return
 }
 
 // ListKeys is undocumented.
 func (c *KMS) ListKeys(req *ListKeysRequest) (resp *ListKeysResponse, err error) {
+	return c.ListKeysWithContext(context.Background(), req)
+}
+
+// ListKeysWithContext is like ListKeys, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) ListKeysWithContext(ctx context.Context, req *ListKeysRequest) (resp *ListKeysResponse, err error) {
 	resp = &ListKeysResponse{}
-	err = c.client.Do("ListKeys", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListKeys", "POST", "/", req, resp)
 	return
 }
 
 // PutKeyPolicy is undocumented.
 func (c *KMS) PutKeyPolicy(req *PutKeyPolicyRequest) (err error) {
+	return c.PutKeyPolicyWithContext(context.Background(), req)
+}
+
+// PutKeyPolicyWithContext is like PutKeyPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) PutKeyPolicyWithContext(ctx context.Context, req *PutKeyPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutKeyPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutKeyPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -216,8 +405,15 @@ func (c *KMS) PutKeyPolicy(req *PutKeyPolicyRequest) (err error) {
 // data is first decrypted and then encrypted. This operation can also be
 // used to change the encryption context of a ciphertext.
 func (c *KMS) ReEncrypt(req *ReEncryptRequest) (resp *ReEncryptResponse, err error) {
+	return c.ReEncryptWithContext(context.Background(), req)
+}
+
+// ReEncryptWithContext is like ReEncrypt, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) ReEncryptWithContext(ctx context.Context, req *ReEncryptRequest) (resp *ReEncryptResponse, err error) {
 	resp = &ReEncryptResponse{}
-	err = c.client.Do("ReEncrypt", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ReEncrypt", "POST", "/", req, resp)
 	return
 }
 
@@ -225,23 +421,44 @@ func (c *KMS) ReEncrypt(req *ReEncryptRequest) (resp *ReEncryptResponse, err err
 // using it to clean up. You should revoke a grant when you intend to
 // actively deny operations that depend on it.
 func (c *KMS) RetireGrant(req *RetireGrantRequest) (err error) {
+	return c.RetireGrantWithContext(context.Background(), req)
+}
+
+// RetireGrantWithContext is like RetireGrant, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) RetireGrantWithContext(ctx context.Context, req *RetireGrantRequest) (err error) {
 	// NRE
-	err = c.client.Do("RetireGrant", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RetireGrant", "POST", "/", req, nil)
 	return
 }
 
 // RevokeGrant revokes a grant. You can revoke a grant to actively deny
 // operations that depend on it.
 func (c *KMS) RevokeGrant(req *RevokeGrantRequest) (err error) {
+	return c.RevokeGrantWithContext(context.Background(), req)
+}
+
+// RevokeGrantWithContext is like RevokeGrant, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) RevokeGrantWithContext(ctx context.Context, req *RevokeGrantRequest) (err error) {
 	// NRE
-	err = c.client.Do("RevokeGrant", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RevokeGrant", "POST", "/", req, nil)
 	return
 }
 
 // UpdateKeyDescription <nil>
 func (c *KMS) UpdateKeyDescription(req *UpdateKeyDescriptionRequest) (err error) {
+	return c.UpdateKeyDescriptionWithContext(context.Background(), req)
+}
+
+// UpdateKeyDescriptionWithContext is like UpdateKeyDescription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *KMS) UpdateKeyDescriptionWithContext(ctx context.Context, req *UpdateKeyDescriptionRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateKeyDescription", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateKeyDescription", "POST", "/", req, nil)
 	return
 }
 
@@ -258,6 +475,40 @@ type CreateAliasRequest struct {
 	TargetKeyID aws.StringValue `json:"TargetKeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateAliasRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateAliasRequest to b.
+func (v *CreateAliasRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AliasName\":"...)
+	if v.AliasName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AliasName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TargetKeyId\":"...)
+	if v.TargetKeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TargetKeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateGrantRequest is undocumented.
 type CreateGrantRequest struct {
 	Constraints       *GrantConstraints `json:"Constraints,omitempty"`
@@ -268,6 +519,81 @@ type CreateGrantRequest struct {
 	RetiringPrincipal aws.StringValue   `json:"RetiringPrincipal,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateGrantRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateGrantRequest to b.
+func (v *CreateGrantRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Constraints != nil {
+		b = append(b, "\"Constraints\":"...)
+		b, err = v.Constraints.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if len(v.GrantTokens) != 0 {
+		b = append(b, "\"GrantTokens\":"...)
+		b = append(b, '[')
+		for i1 := range v.GrantTokens {
+			b = aws.AppendJSONString(b, v.GrantTokens[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"GranteePrincipal\":"...)
+	if v.GranteePrincipal == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GranteePrincipal)
+	}
+	b = append(b, ',')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if len(v.Operations) != 0 {
+		b = append(b, "\"Operations\":"...)
+		b = append(b, '[')
+		for i1 := range v.Operations {
+			b = aws.AppendJSONString(b, v.Operations[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.RetiringPrincipal != nil {
+		b = append(b, "\"RetiringPrincipal\":"...)
+		b = aws.AppendJSONString(b, *v.RetiringPrincipal)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateGrantResponse is undocumented.
 type CreateGrantResponse struct {
 	GrantID    aws.StringValue `json:"GrantId,omitempty"`
@@ -281,6 +607,41 @@ type CreateKeyRequest struct {
 	Policy      aws.StringValue `json:"Policy,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateKeyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateKeyRequest to b.
+func (v *CreateKeyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Description != nil {
+		b = append(b, "\"Description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	if v.KeyUsage != nil {
+		b = append(b, "\"KeyUsage\":"...)
+		b = aws.AppendJSONString(b, *v.KeyUsage)
+		b = append(b, ',')
+	}
+	if v.Policy != nil {
+		b = append(b, "\"Policy\":"...)
+		b = aws.AppendJSONString(b, *v.Policy)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateKeyResponse is undocumented.
 type CreateKeyResponse struct {
 	KeyMetadata *KeyMetadata `json:"KeyMetadata,omitempty"`
@@ -299,10 +660,69 @@ type DecryptRequest struct {
 	GrantTokens       []string          `json:"GrantTokens,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DecryptRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DecryptRequest to b.
+func (v *DecryptRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"CiphertextBlob\":"...)
+	b = aws.AppendJSONBytes(b, v.CiphertextBlob)
+	b = append(b, ',')
+	if len(v.EncryptionContext) != 0 {
+		b = append(b, "\"EncryptionContext\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.EncryptionContext))
+		for k := range v.EncryptionContext {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.EncryptionContext[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.GrantTokens) != 0 {
+		b = append(b, "\"GrantTokens\":"...)
+		b = append(b, '[')
+		for i1 := range v.GrantTokens {
+			b = aws.AppendJSONString(b, v.GrantTokens[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DecryptResponse is undocumented.
 type DecryptResponse struct {
 	KeyID     aws.StringValue `json:"KeyId,omitempty"`
-	Plaintext []byte          `json:"Plaintext,omitempty"`
+	Plaintext []byte          `json:"Plaintext,omitempty" sensitive:"Plaintext"`
 }
 
 // DeleteAliasRequest is undocumented.
@@ -310,11 +730,65 @@ type DeleteAliasRequest struct {
 	AliasName aws.StringValue `json:"AliasName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteAliasRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteAliasRequest to b.
+func (v *DeleteAliasRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AliasName\":"...)
+	if v.AliasName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AliasName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeKeyRequest is undocumented.
 type DescribeKeyRequest struct {
 	KeyID aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeKeyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeKeyRequest to b.
+func (v *DescribeKeyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeKeyResponse is undocumented.
 type DescribeKeyResponse struct {
 	KeyMetadata *KeyMetadata `json:"KeyMetadata,omitempty"`
@@ -325,27 +799,201 @@ type DisableKeyRequest struct {
 	KeyID aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DisableKeyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DisableKeyRequest to b.
+func (v *DisableKeyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DisableKeyRotationRequest is undocumented.
 type DisableKeyRotationRequest struct {
 	KeyID aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DisableKeyRotationRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DisableKeyRotationRequest to b.
+func (v *DisableKeyRotationRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // EnableKeyRequest is undocumented.
 type EnableKeyRequest struct {
 	KeyID aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EnableKeyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EnableKeyRequest to b.
+func (v *EnableKeyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // EnableKeyRotationRequest is undocumented.
 type EnableKeyRotationRequest struct {
 	KeyID aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EnableKeyRotationRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EnableKeyRotationRequest to b.
+func (v *EnableKeyRotationRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // EncryptRequest is undocumented.
 type EncryptRequest struct {
 	EncryptionContext map[string]string `json:"EncryptionContext,omitempty"`
 	GrantTokens       []string          `json:"GrantTokens,omitempty"`
 	KeyID             aws.StringValue   `json:"KeyId"`
-	Plaintext         []byte            `json:"Plaintext"`
+	Plaintext         []byte            `json:"Plaintext" sensitive:"Plaintext"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EncryptRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EncryptRequest to b.
+func (v *EncryptRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.EncryptionContext) != 0 {
+		b = append(b, "\"EncryptionContext\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.EncryptionContext))
+		for k := range v.EncryptionContext {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.EncryptionContext[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.GrantTokens) != 0 {
+		b = append(b, "\"GrantTokens\":"...)
+		b = append(b, '[')
+		for i1 := range v.GrantTokens {
+			b = aws.AppendJSONString(b, v.GrantTokens[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Plaintext\":"...)
+	b = aws.AppendJSONBytes(b, v.Plaintext)
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // EncryptResponse is undocumented.
@@ -363,11 +1011,84 @@ type GenerateDataKeyRequest struct {
 	NumberOfBytes     aws.IntegerValue  `json:"NumberOfBytes,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GenerateDataKeyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GenerateDataKeyRequest to b.
+func (v *GenerateDataKeyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.EncryptionContext) != 0 {
+		b = append(b, "\"EncryptionContext\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.EncryptionContext))
+		for k := range v.EncryptionContext {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.EncryptionContext[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.GrantTokens) != 0 {
+		b = append(b, "\"GrantTokens\":"...)
+		b = append(b, '[')
+		for i1 := range v.GrantTokens {
+			b = aws.AppendJSONString(b, v.GrantTokens[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if v.KeySpec != nil {
+		b = append(b, "\"KeySpec\":"...)
+		b = aws.AppendJSONString(b, *v.KeySpec)
+		b = append(b, ',')
+	}
+	if v.NumberOfBytes != nil {
+		b = append(b, "\"NumberOfBytes\":"...)
+		b = strconv.AppendInt(b, int64(*v.NumberOfBytes), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GenerateDataKeyResponse is undocumented.
 type GenerateDataKeyResponse struct {
 	CiphertextBlob []byte          `json:"CiphertextBlob,omitempty"`
 	KeyID          aws.StringValue `json:"KeyId,omitempty"`
-	Plaintext      []byte          `json:"Plaintext,omitempty"`
+	Plaintext      []byte          `json:"Plaintext,omitempty" sensitive:"Plaintext"`
 }
 
 // GenerateDataKeyWithoutPlaintextRequest is undocumented.
@@ -379,6 +1100,79 @@ type GenerateDataKeyWithoutPlaintextRequest struct {
 	NumberOfBytes     aws.IntegerValue  `json:"NumberOfBytes,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GenerateDataKeyWithoutPlaintextRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GenerateDataKeyWithoutPlaintextRequest to b.
+func (v *GenerateDataKeyWithoutPlaintextRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.EncryptionContext) != 0 {
+		b = append(b, "\"EncryptionContext\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.EncryptionContext))
+		for k := range v.EncryptionContext {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.EncryptionContext[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.GrantTokens) != 0 {
+		b = append(b, "\"GrantTokens\":"...)
+		b = append(b, '[')
+		for i1 := range v.GrantTokens {
+			b = aws.AppendJSONString(b, v.GrantTokens[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if v.KeySpec != nil {
+		b = append(b, "\"KeySpec\":"...)
+		b = aws.AppendJSONString(b, *v.KeySpec)
+		b = append(b, ',')
+	}
+	if v.NumberOfBytes != nil {
+		b = append(b, "\"NumberOfBytes\":"...)
+		b = strconv.AppendInt(b, int64(*v.NumberOfBytes), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GenerateDataKeyWithoutPlaintextResponse is undocumented.
 type GenerateDataKeyWithoutPlaintextResponse struct {
 	CiphertextBlob []byte          `json:"CiphertextBlob,omitempty"`
@@ -390,9 +1184,34 @@ type GenerateRandomRequest struct {
 	NumberOfBytes aws.IntegerValue `json:"NumberOfBytes,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GenerateRandomRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GenerateRandomRequest to b.
+func (v *GenerateRandomRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.NumberOfBytes != nil {
+		b = append(b, "\"NumberOfBytes\":"...)
+		b = strconv.AppendInt(b, int64(*v.NumberOfBytes), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GenerateRandomResponse is undocumented.
 type GenerateRandomResponse struct {
-	Plaintext []byte `json:"Plaintext,omitempty"`
+	Plaintext []byte `json:"Plaintext,omitempty" sensitive:"Plaintext"`
 }
 
 // GetKeyPolicyRequest is undocumented.
@@ -401,6 +1220,40 @@ type GetKeyPolicyRequest struct {
 	PolicyName aws.StringValue `json:"PolicyName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetKeyPolicyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetKeyPolicyRequest to b.
+func (v *GetKeyPolicyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"PolicyName\":"...)
+	if v.PolicyName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PolicyName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetKeyPolicyResponse is undocumented.
 type GetKeyPolicyResponse struct {
 	Policy aws.StringValue `json:"Policy,omitempty"`
@@ -411,6 +1264,33 @@ type GetKeyRotationStatusRequest struct {
 	KeyID aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetKeyRotationStatusRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetKeyRotationStatusRequest to b.
+func (v *GetKeyRotationStatusRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetKeyRotationStatusResponse is undocumented.
 type GetKeyRotationStatusResponse struct {
 	KeyRotationEnabled aws.BooleanValue `json:"KeyRotationEnabled,omitempty"`
@@ -422,6 +1302,70 @@ type GrantConstraints struct {
 	EncryptionContextSubset map[string]string `json:"EncryptionContextSubset,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GrantConstraints) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GrantConstraints to b.
+func (v *GrantConstraints) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.EncryptionContextEquals) != 0 {
+		b = append(b, "\"EncryptionContextEquals\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.EncryptionContextEquals))
+		for k := range v.EncryptionContextEquals {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.EncryptionContextEquals[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.EncryptionContextSubset) != 0 {
+		b = append(b, "\"EncryptionContextSubset\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.EncryptionContextSubset))
+		for k := range v.EncryptionContextSubset {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.EncryptionContextSubset[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GrantListEntry is undocumented.
 type GrantListEntry struct {
 	Constraints       *GrantConstraints `json:"Constraints,omitempty"`
@@ -452,13 +1396,13 @@ type KeyListEntry struct {
 
 // KeyMetadata is undocumented.
 type KeyMetadata struct {
-	AWSAccountID aws.StringValue  `json:"AWSAccountId,omitempty"`
-	ARN          aws.StringValue  `json:"Arn,omitempty"`
-	CreationDate time.Time        `json:"CreationDate,omitempty"`
-	Description  aws.StringValue  `json:"Description,omitempty"`
-	Enabled      aws.BooleanValue `json:"Enabled,omitempty"`
-	KeyID        aws.StringValue  `json:"KeyId"`
-	KeyUsage     aws.StringValue  `json:"KeyUsage,omitempty"`
+	AWSAccountID aws.StringValue     `json:"AWSAccountId,omitempty"`
+	ARN          aws.StringValue     `json:"Arn,omitempty"`
+	CreationDate *aws.FloatTimestamp `json:"CreationDate,omitempty"`
+	Description  aws.StringValue     `json:"Description,omitempty"`
+	Enabled      aws.BooleanValue    `json:"Enabled,omitempty"`
+	KeyID        aws.StringValue     `json:"KeyId"`
+	KeyUsage     aws.StringValue     `json:"KeyUsage,omitempty"`
 }
 
 // Possible values for KMS.
@@ -472,6 +1416,36 @@ type ListAliasesRequest struct {
 	Marker aws.StringValue  `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListAliasesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListAliasesRequest to b.
+func (v *ListAliasesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListAliasesResponse is undocumented.
 type ListAliasesResponse struct {
 	Aliases    []AliasListEntry `json:"Aliases,omitempty"`
@@ -486,6 +1460,43 @@ type ListGrantsRequest struct {
 	Marker aws.StringValue  `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListGrantsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListGrantsRequest to b.
+func (v *ListGrantsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListGrantsResponse is undocumented.
 type ListGrantsResponse struct {
 	Grants     []GrantListEntry `json:"Grants,omitempty"`
@@ -500,6 +1511,43 @@ type ListKeyPoliciesRequest struct {
 	Marker aws.StringValue  `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListKeyPoliciesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListKeyPoliciesRequest to b.
+func (v *ListKeyPoliciesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListKeyPoliciesResponse is undocumented.
 type ListKeyPoliciesResponse struct {
 	NextMarker  aws.StringValue  `json:"NextMarker,omitempty"`
@@ -513,6 +1561,36 @@ type ListKeysRequest struct {
 	Marker aws.StringValue  `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListKeysRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListKeysRequest to b.
+func (v *ListKeysRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListKeysResponse is undocumented.
 type ListKeysResponse struct {
 	Keys       []KeyListEntry   `json:"Keys,omitempty"`
@@ -527,6 +1605,47 @@ type PutKeyPolicyRequest struct {
 	PolicyName aws.StringValue `json:"PolicyName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutKeyPolicyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutKeyPolicyRequest to b.
+func (v *PutKeyPolicyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Policy\":"...)
+	if v.Policy == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Policy)
+	}
+	b = append(b, ',')
+	b = append(b, "\"PolicyName\":"...)
+	if v.PolicyName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PolicyName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ReEncryptRequest is undocumented.
 type ReEncryptRequest struct {
 	CiphertextBlob               []byte            `json:"CiphertextBlob"`
@@ -536,6 +1655,94 @@ type ReEncryptRequest struct {
 	SourceEncryptionContext      map[string]string `json:"SourceEncryptionContext,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ReEncryptRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ReEncryptRequest to b.
+func (v *ReEncryptRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"CiphertextBlob\":"...)
+	b = aws.AppendJSONBytes(b, v.CiphertextBlob)
+	b = append(b, ',')
+	if len(v.DestinationEncryptionContext) != 0 {
+		b = append(b, "\"DestinationEncryptionContext\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.DestinationEncryptionContext))
+		for k := range v.DestinationEncryptionContext {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.DestinationEncryptionContext[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"DestinationKeyId\":"...)
+	if v.DestinationKeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DestinationKeyID)
+	}
+	b = append(b, ',')
+	if len(v.GrantTokens) != 0 {
+		b = append(b, "\"GrantTokens\":"...)
+		b = append(b, '[')
+		for i1 := range v.GrantTokens {
+			b = aws.AppendJSONString(b, v.GrantTokens[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.SourceEncryptionContext) != 0 {
+		b = append(b, "\"SourceEncryptionContext\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.SourceEncryptionContext))
+		for k := range v.SourceEncryptionContext {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.SourceEncryptionContext[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ReEncryptResponse is undocumented.
 type ReEncryptResponse struct {
 	CiphertextBlob []byte          `json:"CiphertextBlob,omitempty"`
@@ -548,17 +1755,209 @@ type RetireGrantRequest struct {
 	GrantToken aws.StringValue `json:"GrantToken"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RetireGrantRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RetireGrantRequest to b.
+func (v *RetireGrantRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GrantToken\":"...)
+	if v.GrantToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GrantToken)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RevokeGrantRequest is undocumented.
 type RevokeGrantRequest struct {
 	GrantID aws.StringValue `json:"GrantId"`
 	KeyID   aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RevokeGrantRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RevokeGrantRequest to b.
+func (v *RevokeGrantRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GrantId\":"...)
+	if v.GrantID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GrantID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateKeyDescriptionRequest is undocumented.
 type UpdateKeyDescriptionRequest struct {
 	Description aws.StringValue `json:"Description"`
 	KeyID       aws.StringValue `json:"KeyId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateKeyDescriptionRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateKeyDescriptionRequest to b.
+func (v *UpdateKeyDescriptionRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Description\":"...)
+	if v.Description == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Description)
+	}
+	b = append(b, ',')
+	b = append(b, "\"KeyId\":"...)
+	if v.KeyID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.KeyID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
+// AlreadyExistsException is the error returned for the AlreadyExistsException error code.
+type AlreadyExistsException struct {
+	aws.APIError
+}
+
+// DependencyTimeoutException is the error returned for the DependencyTimeoutException error code.
+type DependencyTimeoutException struct {
+	aws.APIError
+}
+
+// DisabledException is the error returned for the DisabledException error code.
+type DisabledException struct {
+	aws.APIError
+}
+
+// InvalidAliasNameException is the error returned for the InvalidAliasNameException error code.
+type InvalidAliasNameException struct {
+	aws.APIError
+}
+
+// InvalidARNException is the error returned for the InvalidArnException error code.
+type InvalidARNException struct {
+	aws.APIError
+}
+
+// InvalidCiphertextException is the error returned for the InvalidCiphertextException error code.
+type InvalidCiphertextException struct {
+	aws.APIError
+}
+
+// InvalidGrantTokenException is the error returned for the InvalidGrantTokenException error code.
+type InvalidGrantTokenException struct {
+	aws.APIError
+}
+
+// InvalidKeyUsageException is the error returned for the InvalidKeyUsageException error code.
+type InvalidKeyUsageException struct {
+	aws.APIError
+}
+
+// InvalidMarkerException is the error returned for the InvalidMarkerException error code.
+type InvalidMarkerException struct {
+	aws.APIError
+}
+
+// KMSInternalException is the error returned for the KMSInternalException error code.
+type KMSInternalException struct {
+	aws.APIError
+}
+
+// KeyUnavailableException is the error returned for the KeyUnavailableException error code.
+type KeyUnavailableException struct {
+	aws.APIError
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// MalformedPolicyDocumentException is the error returned for the MalformedPolicyDocumentException error code.
+type MalformedPolicyDocumentException struct {
+	aws.APIError
+}
+
+// NotFoundException is the error returned for the NotFoundException error code.
+type NotFoundException struct {
+	aws.APIError
+}
+
+// UnsupportedOperationException is the error returned for the UnsupportedOperationException error code.
+type UnsupportedOperationException struct {
+	aws.APIError
+}
+
+// exceptions maps KMS's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AlreadyExistsException":           AlreadyExistsException{},
+	"DependencyTimeoutException":       DependencyTimeoutException{},
+	"DisabledException":                DisabledException{},
+	"InvalidAliasNameException":        InvalidAliasNameException{},
+	"InvalidArnException":              InvalidARNException{},
+	"InvalidCiphertextException":       InvalidCiphertextException{},
+	"InvalidGrantTokenException":       InvalidGrantTokenException{},
+	"InvalidKeyUsageException":         InvalidKeyUsageException{},
+	"InvalidMarkerException":           InvalidMarkerException{},
+	"KMSInternalException":             KMSInternalException{},
+	"KeyUnavailableException":          KeyUnavailableException{},
+	"LimitExceededException":           LimitExceededException{},
+	"MalformedPolicyDocumentException": MalformedPolicyDocumentException{},
+	"NotFoundException":                NotFoundException{},
+	"UnsupportedOperationException":    UnsupportedOperationException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
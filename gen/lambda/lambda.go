@@ -4,15 +4,18 @@
 package lambda
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
 )
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,29 +30,175 @@ type Lambda struct {
 	client *aws.RestClient
 }
 
-// New returns a new Lambda client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *Lambda {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "lambda"
-	endpoint, service, region := endpoints.Lookup("lambda", region)
+// New returns a new Lambda client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *Lambda {
+	cfg := newConfig(region, client, opts)
 
 	return &Lambda{
 		client: &aws.RestClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2014-11-11",
 		},
 	}
 }
 
+// NewFromSession returns a new Lambda client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *Lambda {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("lambda", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("lambda", "lambda"),
+	}, "AWS_ENDPOINT_URL_LAMBDA", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *Lambda) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *Lambda) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *Lambda) SetPayloadSigning(p aws.PayloadSigning) {
+	c.client.Context.PayloadSigning = p
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *Lambda) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch op {
+
+	case "AddEventSource":
+
+		r, ok := req.(*AddEventSourceRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: AddEventSource takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newAddEventSourceRequest(context.Background(), r)
+
+	case "DeleteFunction":
+
+		r, ok := req.(*DeleteFunctionRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: DeleteFunction takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteFunctionRequest(context.Background(), r)
+
+	case "GetEventSource":
+
+		r, ok := req.(*GetEventSourceRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: GetEventSource takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetEventSourceRequest(context.Background(), r)
+
+	case "GetFunction":
+
+		r, ok := req.(*GetFunctionRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: GetFunction takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetFunctionRequest(context.Background(), r)
+
+	case "GetFunctionConfiguration":
+
+		r, ok := req.(*GetFunctionConfigurationRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: GetFunctionConfiguration takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetFunctionConfigurationRequest(context.Background(), r)
+
+	case "InvokeAsync":
+
+		r, ok := req.(*InvokeAsyncRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: InvokeAsync takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newInvokeAsyncRequest(context.Background(), r)
+
+	case "ListEventSources":
+
+		r, ok := req.(*ListEventSourcesRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: ListEventSources takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListEventSourcesRequest(context.Background(), r)
+
+	case "ListFunctions":
+
+		r, ok := req.(*ListFunctionsRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: ListFunctions takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListFunctionsRequest(context.Background(), r)
+
+	case "RemoveEventSource":
+
+		r, ok := req.(*RemoveEventSourceRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: RemoveEventSource takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newRemoveEventSourceRequest(context.Background(), r)
+
+	case "UpdateFunctionConfiguration":
+
+		r, ok := req.(*UpdateFunctionConfigurationRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: UpdateFunctionConfiguration takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdateFunctionConfigurationRequest(context.Background(), r)
+
+	case "UploadFunction":
+
+		r, ok := req.(*UploadFunctionRequest)
+		if !ok {
+			return "", fmt.Errorf("lambda: UploadFunction takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUploadFunctionRequest(context.Background(), r)
+
+	default:
+		return "", fmt.Errorf("lambda: unknown operation %q", op)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
 // AddEventSource identifies an Amazon Kinesis stream as the event source
 // for an AWS Lambda function. AWS Lambda invokes the specified function
 // when records are posted to the stream. This is the pull model, where AWS
@@ -62,8 +211,37 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Lam
 // permission for the iam:PassRole action for the IAM role. It also
 // requires permission for the lambda:AddEventSource action.
 func (c *Lambda) AddEventSource(req *AddEventSourceRequest) (resp *EventSourceConfiguration, err error) {
+	return c.AddEventSourceWithContext(context.Background(), req)
+}
+
+// AddEventSourceWithContext is like AddEventSource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) AddEventSourceWithContext(ctx context.Context, req *AddEventSourceRequest) (resp *EventSourceConfiguration, err error) {
 	resp = &EventSourceConfiguration{}
 
+	httpReq, err := c.newAddEventSourceRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("AddEventSource", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newAddEventSourceRequest builds the HTTP request for AddEventSource.
+func (c *Lambda) newAddEventSourceRequest(ctx context.Context, req *AddEventSourceRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -72,10 +250,14 @@ func (c *Lambda) AddEventSource(req *AddEventSourceRequest) (resp *EventSourceCo
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -84,44 +266,60 @@ func (c *Lambda) AddEventSource(req *AddEventSourceRequest) (resp *EventSourceCo
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteFunction deletes the specified Lambda function code and
+// configuration. This operation requires permission for the
+// lambda:DeleteFunction action.
+func (c *Lambda) DeleteFunction(req *DeleteFunctionRequest) (err error) {
+	return c.DeleteFunctionWithContext(context.Background(), req)
+}
+
+// DeleteFunctionWithContext is like DeleteFunction, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) DeleteFunctionWithContext(ctx context.Context, req *DeleteFunctionRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteFunctionRequest(ctx, req)
 	if err != nil {
 		return
 	}
 
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
+	httpResp, err := c.client.DoOperation("DeleteFunction", httpReq, req, nil)
+	if err != nil {
 		return
 	}
 
+	defer httpResp.Body.Close()
+
 	return
 }
 
-// DeleteFunction deletes the specified Lambda function code and
-// configuration. This operation requires permission for the
-// lambda:DeleteFunction action.
-func (c *Lambda) DeleteFunction(req *DeleteFunctionRequest) (err error) {
-	// NRE
-
+// newDeleteFunctionRequest builds the HTTP request for DeleteFunction.
+func (c *Lambda) newDeleteFunctionRequest(ctx context.Context, req *DeleteFunctionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-11-13/functions/{FunctionName}"
 
 	if req.FunctionName != nil {
-		uri = strings.Replace(uri, "{"+"FunctionName"+"}", *req.FunctionName, -1)
-		uri = strings.Replace(uri, "{"+"FunctionName+"+"}", *req.FunctionName, -1)
+		uri = strings.Replace(uri, "{FunctionName}", aws.EscapePath(*req.FunctionName, false), -1)
+		uri = strings.Replace(uri, "{FunctionName+}", aws.EscapePath(*req.FunctionName, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -130,13 +328,6 @@ func (c *Lambda) DeleteFunction(req *DeleteFunctionRequest) (err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
 	return
 }
 
@@ -144,25 +335,58 @@ func (c *Lambda) DeleteFunction(req *DeleteFunctionRequest) (err error) {
 // source mapping (see AddEventSource This operation requires permission
 // for the lambda:GetEventSource action.
 func (c *Lambda) GetEventSource(req *GetEventSourceRequest) (resp *EventSourceConfiguration, err error) {
+	return c.GetEventSourceWithContext(context.Background(), req)
+}
+
+// GetEventSourceWithContext is like GetEventSource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) GetEventSourceWithContext(ctx context.Context, req *GetEventSourceRequest) (resp *EventSourceConfiguration, err error) {
 	resp = &EventSourceConfiguration{}
 
+	httpReq, err := c.newGetEventSourceRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetEventSource", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newGetEventSourceRequest builds the HTTP request for GetEventSource.
+func (c *Lambda) newGetEventSourceRequest(ctx context.Context, req *GetEventSourceRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-11-13/event-source-mappings/{UUID}"
 
 	if req.UUID != nil {
-		uri = strings.Replace(uri, "{"+"UUID"+"}", *req.UUID, -1)
-		uri = strings.Replace(uri, "{"+"UUID+"+"}", *req.UUID, -1)
+		uri = strings.Replace(uri, "{UUID}", aws.EscapePath(*req.UUID, false), -1)
+		uri = strings.Replace(uri, "{UUID+}", aws.EscapePath(*req.UUID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -171,7 +395,31 @@ func (c *Lambda) GetEventSource(req *GetEventSourceRequest) (resp *EventSourceCo
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetFunction returns the configuration information of the Lambda function
+// and a presigned URL link to the .zip file you uploaded with
+// UploadFunction so you can download the .zip file. Note that the URL is
+// valid for up to 10 minutes. The configuration information is the same
+// information you provided as parameters when uploading the function. This
+// operation requires permission for the lambda:GetFunction action.
+func (c *Lambda) GetFunction(req *GetFunctionRequest) (resp *GetFunctionResponse, err error) {
+	return c.GetFunctionWithContext(context.Background(), req)
+}
+
+// GetFunctionWithContext is like GetFunction, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) GetFunctionWithContext(ctx context.Context, req *GetFunctionRequest) (resp *GetFunctionResponse, err error) {
+	resp = &GetFunctionResponse{}
+
+	httpReq, err := c.newGetFunctionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetFunction", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -186,32 +434,29 @@ func (c *Lambda) GetEventSource(req *GetEventSourceRequest) (resp *EventSourceCo
 	return
 }
 
-// GetFunction returns the configuration information of the Lambda function
-// and a presigned URL link to the .zip file you uploaded with
-// UploadFunction so you can download the .zip file. Note that the URL is
-// valid for up to 10 minutes. The configuration information is the same
-// information you provided as parameters when uploading the function. This
-// operation requires permission for the lambda:GetFunction action.
-func (c *Lambda) GetFunction(req *GetFunctionRequest) (resp *GetFunctionResponse, err error) {
-	resp = &GetFunctionResponse{}
-
+// newGetFunctionRequest builds the HTTP request for GetFunction.
+func (c *Lambda) newGetFunctionRequest(ctx context.Context, req *GetFunctionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-11-13/functions/{FunctionName}"
 
 	if req.FunctionName != nil {
-		uri = strings.Replace(uri, "{"+"FunctionName"+"}", *req.FunctionName, -1)
-		uri = strings.Replace(uri, "{"+"FunctionName+"+"}", *req.FunctionName, -1)
+		uri = strings.Replace(uri, "{FunctionName}", aws.EscapePath(*req.FunctionName, false), -1)
+		uri = strings.Replace(uri, "{FunctionName+}", aws.EscapePath(*req.FunctionName, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -220,7 +465,29 @@ func (c *Lambda) GetFunction(req *GetFunctionRequest) (resp *GetFunctionResponse
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetFunctionConfiguration returns the configuration information of the
+// Lambda function. This the same information you provided as parameters
+// when uploading the function by using UploadFunction This operation
+// requires permission for the lambda:GetFunctionConfiguration operation.
+func (c *Lambda) GetFunctionConfiguration(req *GetFunctionConfigurationRequest) (resp *FunctionConfiguration, err error) {
+	return c.GetFunctionConfigurationWithContext(context.Background(), req)
+}
+
+// GetFunctionConfigurationWithContext is like GetFunctionConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) GetFunctionConfigurationWithContext(ctx context.Context, req *GetFunctionConfigurationRequest) (resp *FunctionConfiguration, err error) {
+	resp = &FunctionConfiguration{}
+
+	httpReq, err := c.newGetFunctionConfigurationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetFunctionConfiguration", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -235,30 +502,29 @@ func (c *Lambda) GetFunction(req *GetFunctionRequest) (resp *GetFunctionResponse
 	return
 }
 
-// GetFunctionConfiguration returns the configuration information of the
-// Lambda function. This the same information you provided as parameters
-// when uploading the function by using UploadFunction This operation
-// requires permission for the lambda:GetFunctionConfiguration operation.
-func (c *Lambda) GetFunctionConfiguration(req *GetFunctionConfigurationRequest) (resp *FunctionConfiguration, err error) {
-	resp = &FunctionConfiguration{}
-
+// newGetFunctionConfigurationRequest builds the HTTP request for GetFunctionConfiguration.
+func (c *Lambda) newGetFunctionConfigurationRequest(ctx context.Context, req *GetFunctionConfigurationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-11-13/functions/{FunctionName}/configuration"
 
 	if req.FunctionName != nil {
-		uri = strings.Replace(uri, "{"+"FunctionName"+"}", *req.FunctionName, -1)
-		uri = strings.Replace(uri, "{"+"FunctionName+"+"}", *req.FunctionName, -1)
+		uri = strings.Replace(uri, "{FunctionName}", aws.EscapePath(*req.FunctionName, false), -1)
+		uri = strings.Replace(uri, "{FunctionName+}", aws.EscapePath(*req.FunctionName, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -267,7 +533,30 @@ func (c *Lambda) GetFunctionConfiguration(req *GetFunctionConfigurationRequest)
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// InvokeAsync submits an invocation request to AWS Lambda. Upon receiving
+// the request, Lambda executes the specified function asynchronously. To
+// see the logs generated by the Lambda function execution, see the
+// CloudWatch logs console. This operation requires permission for the
+// lambda:InvokeAsync action.
+func (c *Lambda) InvokeAsync(req *InvokeAsyncRequest) (resp *InvokeAsyncResponse, err error) {
+	return c.InvokeAsyncWithContext(context.Background(), req)
+}
+
+// InvokeAsyncWithContext is like InvokeAsync, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) InvokeAsyncWithContext(ctx context.Context, req *InvokeAsyncRequest) (resp *InvokeAsyncResponse, err error) {
+	resp = &InvokeAsyncResponse{}
+
+	httpReq, err := c.newInvokeAsyncRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("InvokeAsync", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -279,17 +568,13 @@ func (c *Lambda) GetFunctionConfiguration(req *GetFunctionConfigurationRequest)
 		return
 	}
 
+	resp.Status = aws.Integer(httpResp.StatusCode)
+
 	return
 }
 
-// InvokeAsync submits an invocation request to AWS Lambda. Upon receiving
-// the request, Lambda executes the specified function asynchronously. To
-// see the logs generated by the Lambda function execution, see the
-// CloudWatch logs console. This operation requires permission for the
-// lambda:InvokeAsync action.
-func (c *Lambda) InvokeAsync(req *InvokeAsyncRequest) (resp *InvokeAsyncResponse, err error) {
-	resp = &InvokeAsyncResponse{}
-
+// newInvokeAsyncRequest builds the HTTP request for InvokeAsync.
+func (c *Lambda) newInvokeAsyncRequest(ctx context.Context, req *InvokeAsyncRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -303,17 +588,21 @@ func (c *Lambda) InvokeAsync(req *InvokeAsyncRequest) (resp *InvokeAsyncResponse
 	uri := c.client.Endpoint + "/2014-11-13/functions/{FunctionName}/invoke-async/"
 
 	if req.FunctionName != nil {
-		uri = strings.Replace(uri, "{"+"FunctionName"+"}", *req.FunctionName, -1)
-		uri = strings.Replace(uri, "{"+"FunctionName+"+"}", *req.FunctionName, -1)
+		uri = strings.Replace(uri, "{FunctionName}", aws.EscapePath(*req.FunctionName, false), -1)
+		uri = strings.Replace(uri, "{FunctionName+}", aws.EscapePath(*req.FunctionName, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -322,7 +611,30 @@ func (c *Lambda) InvokeAsync(req *InvokeAsyncRequest) (resp *InvokeAsyncResponse
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListEventSources returns a list of event source mappings. For each
+// mapping, the API returns configuration information (see AddEventSource
+// ). You can optionally specify filters to retrieve specific event source
+// mappings. This operation requires permission for the
+// lambda:ListEventSources action.
+func (c *Lambda) ListEventSources(req *ListEventSourcesRequest) (resp *ListEventSourcesResponse, err error) {
+	return c.ListEventSourcesWithContext(context.Background(), req)
+}
+
+// ListEventSourcesWithContext is like ListEventSources, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) ListEventSourcesWithContext(ctx context.Context, req *ListEventSourcesRequest) (resp *ListEventSourcesResponse, err error) {
+	resp = &ListEventSourcesResponse{}
+
+	httpReq, err := c.newListEventSourcesRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListEventSources", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -334,19 +646,11 @@ func (c *Lambda) InvokeAsync(req *InvokeAsyncRequest) (resp *InvokeAsyncResponse
 		return
 	}
 
-	resp.Status = aws.Integer(httpResp.StatusCode)
-
 	return
 }
 
-// ListEventSources returns a list of event source mappings. For each
-// mapping, the API returns configuration information (see AddEventSource
-// ). You can optionally specify filters to retrieve specific event source
-// mappings. This operation requires permission for the
-// lambda:ListEventSources action.
-func (c *Lambda) ListEventSources(req *ListEventSourcesRequest) (resp *ListEventSourcesResponse, err error) {
-	resp = &ListEventSourcesResponse{}
-
+// newListEventSourcesRequest builds the HTTP request for ListEventSources.
+func (c *Lambda) newListEventSourcesRequest(ctx context.Context, req *ListEventSourcesRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -371,10 +675,14 @@ func (c *Lambda) ListEventSources(req *ListEventSourcesRequest) (resp *ListEvent
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -383,7 +691,29 @@ func (c *Lambda) ListEventSources(req *ListEventSourcesRequest) (resp *ListEvent
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListFunctions returns a list of your Lambda functions. For each
+// function, the response includes the function configuration information.
+// You must use GetFunction to retrieve the code for your function. This
+// operation requires permission for the lambda:ListFunctions action.
+func (c *Lambda) ListFunctions(req *ListFunctionsRequest) (resp *ListFunctionsResponse, err error) {
+	return c.ListFunctionsWithContext(context.Background(), req)
+}
+
+// ListFunctionsWithContext is like ListFunctions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) ListFunctionsWithContext(ctx context.Context, req *ListFunctionsRequest) (resp *ListFunctionsResponse, err error) {
+	resp = &ListFunctionsResponse{}
+
+	httpReq, err := c.newListFunctionsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListFunctions", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -398,13 +728,8 @@ func (c *Lambda) ListEventSources(req *ListEventSourcesRequest) (resp *ListEvent
 	return
 }
 
-// ListFunctions returns a list of your Lambda functions. For each
-// function, the response includes the function configuration information.
-// You must use GetFunction to retrieve the code for your function. This
-// operation requires permission for the lambda:ListFunctions action.
-func (c *Lambda) ListFunctions(req *ListFunctionsRequest) (resp *ListFunctionsResponse, err error) {
-	resp = &ListFunctionsResponse{}
-
+// newListFunctionsRequest builds the HTTP request for ListFunctions.
+func (c *Lambda) newListFunctionsRequest(ctx context.Context, req *ListFunctionsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -421,10 +746,14 @@ func (c *Lambda) ListFunctions(req *ListFunctionsRequest) (resp *ListFunctionsRe
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -433,18 +762,6 @@ func (c *Lambda) ListFunctions(req *ListFunctionsRequest) (resp *ListFunctionsRe
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -453,25 +770,53 @@ func (c *Lambda) ListFunctions(req *ListFunctionsRequest) (resp *ListFunctionsRe
 // This operation requires permission for the lambda:RemoveEventSource
 // action.
 func (c *Lambda) RemoveEventSource(req *RemoveEventSourceRequest) (err error) {
+	return c.RemoveEventSourceWithContext(context.Background(), req)
+}
+
+// RemoveEventSourceWithContext is like RemoveEventSource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) RemoveEventSourceWithContext(ctx context.Context, req *RemoveEventSourceRequest) (err error) {
 	// NRE
 
+	httpReq, err := c.newRemoveEventSourceRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("RemoveEventSource", httpReq, req, nil)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	return
+}
+
+// newRemoveEventSourceRequest builds the HTTP request for RemoveEventSource.
+func (c *Lambda) newRemoveEventSourceRequest(ctx context.Context, req *RemoveEventSourceRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-11-13/event-source-mappings/{UUID}"
 
 	if req.UUID != nil {
-		uri = strings.Replace(uri, "{"+"UUID"+"}", *req.UUID, -1)
-		uri = strings.Replace(uri, "{"+"UUID+"+"}", *req.UUID, -1)
+		uri = strings.Replace(uri, "{UUID}", aws.EscapePath(*req.UUID, false), -1)
+		uri = strings.Replace(uri, "{UUID+}", aws.EscapePath(*req.UUID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -480,13 +825,6 @@ func (c *Lambda) RemoveEventSource(req *RemoveEventSourceRequest) (err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
 	return
 }
 
@@ -497,16 +835,45 @@ func (c *Lambda) RemoveEventSource(req *RemoveEventSourceRequest) (err error) {
 // the function's code. This operation requires permission for the
 // lambda:UpdateFunctionConfiguration action.
 func (c *Lambda) UpdateFunctionConfiguration(req *UpdateFunctionConfigurationRequest) (resp *FunctionConfiguration, err error) {
+	return c.UpdateFunctionConfigurationWithContext(context.Background(), req)
+}
+
+// UpdateFunctionConfigurationWithContext is like UpdateFunctionConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) UpdateFunctionConfigurationWithContext(ctx context.Context, req *UpdateFunctionConfigurationRequest) (resp *FunctionConfiguration, err error) {
 	resp = &FunctionConfiguration{}
 
+	httpReq, err := c.newUpdateFunctionConfigurationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdateFunctionConfiguration", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newUpdateFunctionConfigurationRequest builds the HTTP request for UpdateFunctionConfiguration.
+func (c *Lambda) newUpdateFunctionConfigurationRequest(ctx context.Context, req *UpdateFunctionConfigurationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2014-11-13/functions/{FunctionName}/configuration"
 
 	if req.FunctionName != nil {
-		uri = strings.Replace(uri, "{"+"FunctionName"+"}", *req.FunctionName, -1)
-		uri = strings.Replace(uri, "{"+"FunctionName+"+"}", *req.FunctionName, -1)
+		uri = strings.Replace(uri, "{FunctionName}", aws.EscapePath(*req.FunctionName, false), -1)
+		uri = strings.Replace(uri, "{FunctionName+}", aws.EscapePath(*req.FunctionName, true), -1)
 	}
 
 	q := url.Values{}
@@ -532,10 +899,14 @@ func (c *Lambda) UpdateFunctionConfiguration(req *UpdateFunctionConfigurationReq
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -544,7 +915,31 @@ func (c *Lambda) UpdateFunctionConfiguration(req *UpdateFunctionConfigurationReq
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UploadFunction creates a new Lambda function or updates an existing
+// function. The function metadata is created from the request parameters,
+// and the code for the function is provided by a .zip file in the request
+// body. If the function name already exists, the existing Lambda function
+// is updated with the new code and metadata. This operation requires
+// permission for the lambda:UploadFunction action.
+func (c *Lambda) UploadFunction(req *UploadFunctionRequest) (resp *FunctionConfiguration, err error) {
+	return c.UploadFunctionWithContext(context.Background(), req)
+}
+
+// UploadFunctionWithContext is like UploadFunction, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Lambda) UploadFunctionWithContext(ctx context.Context, req *UploadFunctionRequest) (resp *FunctionConfiguration, err error) {
+	resp = &FunctionConfiguration{}
+
+	httpReq, err := c.newUploadFunctionRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UploadFunction", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -559,15 +954,8 @@ func (c *Lambda) UpdateFunctionConfiguration(req *UpdateFunctionConfigurationReq
 	return
 }
 
-// UploadFunction creates a new Lambda function or updates an existing
-// function. The function metadata is created from the request parameters,
-// and the code for the function is provided by a .zip file in the request
-// body. If the function name already exists, the existing Lambda function
-// is updated with the new code and metadata. This operation requires
-// permission for the lambda:UploadFunction action.
-func (c *Lambda) UploadFunction(req *UploadFunctionRequest) (resp *FunctionConfiguration, err error) {
-	resp = &FunctionConfiguration{}
-
+// newUploadFunctionRequest builds the HTTP request for UploadFunction.
+func (c *Lambda) newUploadFunctionRequest(ctx context.Context, req *UploadFunctionRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -581,8 +969,8 @@ func (c *Lambda) UploadFunction(req *UploadFunctionRequest) (resp *FunctionConfi
 	uri := c.client.Endpoint + "/2014-11-13/functions/{FunctionName}"
 
 	if req.FunctionName != nil {
-		uri = strings.Replace(uri, "{"+"FunctionName"+"}", *req.FunctionName, -1)
-		uri = strings.Replace(uri, "{"+"FunctionName+"+"}", *req.FunctionName, -1)
+		uri = strings.Replace(uri, "{FunctionName}", aws.EscapePath(*req.FunctionName, false), -1)
+		uri = strings.Replace(uri, "{FunctionName+}", aws.EscapePath(*req.FunctionName, true), -1)
 	}
 
 	q := url.Values{}
@@ -616,10 +1004,14 @@ func (c *Lambda) UploadFunction(req *UploadFunctionRequest) (resp *FunctionConfi
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -628,18 +1020,6 @@ func (c *Lambda) UploadFunction(req *UploadFunctionRequest) (resp *FunctionConfi
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
-	if e := json.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -659,15 +1039,15 @@ type DeleteFunctionRequest struct {
 
 // EventSourceConfiguration is undocumented.
 type EventSourceConfiguration struct {
-	BatchSize    aws.IntegerValue  `json:"BatchSize,omitempty"`
-	EventSource  aws.StringValue   `json:"EventSource,omitempty"`
-	FunctionName aws.StringValue   `json:"FunctionName,omitempty"`
-	IsActive     aws.BooleanValue  `json:"IsActive,omitempty"`
-	LastModified time.Time         `json:"LastModified,omitempty"`
-	Parameters   map[string]string `json:"Parameters,omitempty"`
-	Role         aws.StringValue   `json:"Role,omitempty"`
-	Status       aws.StringValue   `json:"Status,omitempty"`
-	UUID         aws.StringValue   `json:"UUID,omitempty"`
+	BatchSize    aws.IntegerValue    `json:"BatchSize,omitempty"`
+	EventSource  aws.StringValue     `json:"EventSource,omitempty"`
+	FunctionName aws.StringValue     `json:"FunctionName,omitempty"`
+	IsActive     aws.BooleanValue    `json:"IsActive,omitempty"`
+	LastModified *aws.FloatTimestamp `json:"LastModified,omitempty"`
+	Parameters   map[string]string   `json:"Parameters,omitempty"`
+	Role         aws.StringValue     `json:"Role,omitempty"`
+	Status       aws.StringValue     `json:"Status,omitempty"`
+	UUID         aws.StringValue     `json:"UUID,omitempty"`
 }
 
 // FunctionCodeLocation is undocumented.
@@ -678,18 +1058,18 @@ type FunctionCodeLocation struct {
 
 // FunctionConfiguration is undocumented.
 type FunctionConfiguration struct {
-	CodeSize        aws.LongValue    `json:"CodeSize,omitempty"`
-	ConfigurationID aws.StringValue  `json:"ConfigurationId,omitempty"`
-	Description     aws.StringValue  `json:"Description,omitempty"`
-	FunctionARN     aws.StringValue  `json:"FunctionARN,omitempty"`
-	FunctionName    aws.StringValue  `json:"FunctionName,omitempty"`
-	Handler         aws.StringValue  `json:"Handler,omitempty"`
-	LastModified    time.Time        `json:"LastModified,omitempty"`
-	MemorySize      aws.IntegerValue `json:"MemorySize,omitempty"`
-	Mode            aws.StringValue  `json:"Mode,omitempty"`
-	Role            aws.StringValue  `json:"Role,omitempty"`
-	Runtime         aws.StringValue  `json:"Runtime,omitempty"`
-	Timeout         aws.IntegerValue `json:"Timeout,omitempty"`
+	CodeSize        aws.LongValue       `json:"CodeSize,omitempty"`
+	ConfigurationID aws.StringValue     `json:"ConfigurationId,omitempty"`
+	Description     aws.StringValue     `json:"Description,omitempty"`
+	FunctionARN     aws.StringValue     `json:"FunctionARN,omitempty"`
+	FunctionName    aws.StringValue     `json:"FunctionName,omitempty"`
+	Handler         aws.StringValue     `json:"Handler,omitempty"`
+	LastModified    *aws.FloatTimestamp `json:"LastModified,omitempty"`
+	MemorySize      aws.IntegerValue    `json:"MemorySize,omitempty"`
+	Mode            aws.StringValue     `json:"Mode,omitempty"`
+	Role            aws.StringValue     `json:"Role,omitempty"`
+	Runtime         aws.StringValue     `json:"Runtime,omitempty"`
+	Timeout         aws.IntegerValue    `json:"Timeout,omitempty"`
 }
 
 // GetEventSourceRequest is undocumented.
@@ -788,6 +1168,42 @@ type UploadFunctionRequest struct {
 	Timeout      aws.IntegerValue `json:"-"`
 }
 
+// InvalidParameterValueException is the error returned for the InvalidParameterValueException error code.
+type InvalidParameterValueException struct {
+	aws.APIError
+
+	Type aws.StringValue `json:"Type,omitempty"`
+}
+
+// InvalidRequestContentException is the error returned for the InvalidRequestContentException error code.
+type InvalidRequestContentException struct {
+	aws.APIError
+
+	Type aws.StringValue `json:"Type,omitempty"`
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+
+	Type aws.StringValue `json:"Type,omitempty"`
+}
+
+// ServiceException is the error returned for the ServiceException error code.
+type ServiceException struct {
+	aws.APIError
+
+	Type aws.StringValue `json:"Type,omitempty"`
+}
+
+// exceptions maps Lambda's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InvalidParameterValueException": InvalidParameterValueException{},
+	"InvalidRequestContentException": InvalidRequestContentException{},
+	"ResourceNotFoundException":      ResourceNotFoundException{},
+	"ServiceException":               ServiceException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
 
@@ -797,4 +1213,5 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
 var _ json.RawMessage
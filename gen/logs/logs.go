@@ -4,11 +4,18 @@
 package logs
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // Logs is a client for Amazon CloudWatch Logs.
@@ -16,29 +23,64 @@ type Logs struct {
 	client *aws.JSONClient
 }
 
-// New returns a new Logs client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *Logs {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "logs"
-	endpoint, service, region := endpoints.Lookup("logs", region)
+// New returns a new Logs client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *Logs {
+	cfg := newConfig(region, client, opts)
 
 	return &Logs{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "Logs_20140328",
 		},
 	}
 }
 
+// NewFromSession returns a new Logs client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *Logs {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("logs", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("logs", "logs"),
+	}, "AWS_ENDPOINT_URL_CLOUDWATCH_LOGS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *Logs) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *Logs) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // CreateLogGroup creates a new log group with the specified name. The name
 // of the log group must be unique within a region for an AWS account. You
 // can create up to 500 log groups per account. You must use the following
@@ -46,8 +88,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Log
 // 512 characters long. Allowed characters are a-z, 0-9, '_' (underscore),
 // '-' (hyphen), '/' (forward slash), and '.' (period).
 func (c *Logs) CreateLogGroup(req *CreateLogGroupRequest) (err error) {
+	return c.CreateLogGroupWithContext(context.Background(), req)
+}
+
+// CreateLogGroupWithContext is like CreateLogGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) CreateLogGroupWithContext(ctx context.Context, req *CreateLogGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateLogGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateLogGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -58,32 +107,60 @@ func (c *Logs) CreateLogGroup(req *CreateLogGroupRequest) (err error) {
 // names can be between 1 and 512 characters long. The ':' colon character
 // is not allowed.
 func (c *Logs) CreateLogStream(req *CreateLogStreamRequest) (err error) {
+	return c.CreateLogStreamWithContext(context.Background(), req)
+}
+
+// CreateLogStreamWithContext is like CreateLogStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) CreateLogStreamWithContext(ctx context.Context, req *CreateLogStreamRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateLogStream", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateLogStream", "POST", "/", req, nil)
 	return
 }
 
 // DeleteLogGroup deletes the log group with the specified name and
 // permanently deletes all the archived log events associated with it.
 func (c *Logs) DeleteLogGroup(req *DeleteLogGroupRequest) (err error) {
+	return c.DeleteLogGroupWithContext(context.Background(), req)
+}
+
+// DeleteLogGroupWithContext is like DeleteLogGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) DeleteLogGroupWithContext(ctx context.Context, req *DeleteLogGroupRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteLogGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteLogGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteLogStream deletes a log stream and permanently deletes all the
 // archived log events associated with it.
 func (c *Logs) DeleteLogStream(req *DeleteLogStreamRequest) (err error) {
+	return c.DeleteLogStreamWithContext(context.Background(), req)
+}
+
+// DeleteLogStreamWithContext is like DeleteLogStream, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) DeleteLogStreamWithContext(ctx context.Context, req *DeleteLogStreamRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteLogStream", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteLogStream", "POST", "/", req, nil)
 	return
 }
 
 // DeleteMetricFilter deletes a metric filter associated with the specified
 // log group.
 func (c *Logs) DeleteMetricFilter(req *DeleteMetricFilterRequest) (err error) {
+	return c.DeleteMetricFilterWithContext(context.Background(), req)
+}
+
+// DeleteMetricFilterWithContext is like DeleteMetricFilter, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) DeleteMetricFilterWithContext(ctx context.Context, req *DeleteMetricFilterRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteMetricFilter", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteMetricFilter", "POST", "/", req, nil)
 	return
 }
 
@@ -91,8 +168,15 @@ func (c *Logs) DeleteMetricFilter(req *DeleteMetricFilterRequest) (err error) {
 // group. Log events would not expire if they belong to log groups without
 // a retention policy.
 func (c *Logs) DeleteRetentionPolicy(req *DeleteRetentionPolicyRequest) (err error) {
+	return c.DeleteRetentionPolicyWithContext(context.Background(), req)
+}
+
+// DeleteRetentionPolicyWithContext is like DeleteRetentionPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) DeleteRetentionPolicyWithContext(ctx context.Context, req *DeleteRetentionPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteRetentionPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteRetentionPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -104,8 +188,15 @@ func (c *Logs) DeleteRetentionPolicy(req *DeleteRetentionPolicyRequest) (err err
 // number of log groups returned in the response by specifying the limit
 // parameter in the request.
 func (c *Logs) DescribeLogGroups(req *DescribeLogGroupsRequest) (resp *DescribeLogGroupsResponse, err error) {
+	return c.DescribeLogGroupsWithContext(context.Background(), req)
+}
+
+// DescribeLogGroupsWithContext is like DescribeLogGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) DescribeLogGroupsWithContext(ctx context.Context, req *DescribeLogGroupsRequest) (resp *DescribeLogGroupsResponse, err error) {
 	resp = &DescribeLogGroupsResponse{}
-	err = c.client.Do("DescribeLogGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLogGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -117,8 +208,15 @@ func (c *Logs) DescribeLogGroups(req *DescribeLogGroupsRequest) (resp *DescribeL
 // the number of log streams returned in the response by specifying the
 // limit parameter in the request.
 func (c *Logs) DescribeLogStreams(req *DescribeLogStreamsRequest) (resp *DescribeLogStreamsResponse, err error) {
+	return c.DescribeLogStreamsWithContext(context.Background(), req)
+}
+
+// DescribeLogStreamsWithContext is like DescribeLogStreams, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) DescribeLogStreamsWithContext(ctx context.Context, req *DescribeLogStreamsRequest) (resp *DescribeLogStreamsResponse, err error) {
 	resp = &DescribeLogStreamsResponse{}
-	err = c.client.Do("DescribeLogStreams", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLogStreams", "POST", "/", req, resp)
 	return
 }
 
@@ -130,8 +228,15 @@ func (c *Logs) DescribeLogStreams(req *DescribeLogStreamsRequest) (resp *Describ
 // the number of metric filters returned in the response by specifying the
 // limit parameter in the request.
 func (c *Logs) DescribeMetricFilters(req *DescribeMetricFiltersRequest) (resp *DescribeMetricFiltersResponse, err error) {
+	return c.DescribeMetricFiltersWithContext(context.Background(), req)
+}
+
+// DescribeMetricFiltersWithContext is like DescribeMetricFilters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) DescribeMetricFiltersWithContext(ctx context.Context, req *DescribeMetricFiltersRequest) (resp *DescribeMetricFiltersResponse, err error) {
 	resp = &DescribeMetricFiltersResponse{}
-	err = c.client.Do("DescribeMetricFilters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeMetricFilters", "POST", "/", req, resp)
 	return
 }
 
@@ -145,8 +250,15 @@ func (c *Logs) DescribeMetricFilters(req *DescribeMetricFiltersRequest) (resp *D
 // backward direction. You can also limit the number of log events returned
 // in the response by specifying the limit parameter in the request.
 func (c *Logs) GetLogEvents(req *GetLogEventsRequest) (resp *GetLogEventsResponse, err error) {
+	return c.GetLogEventsWithContext(context.Background(), req)
+}
+
+// GetLogEventsWithContext is like GetLogEvents, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) GetLogEventsWithContext(ctx context.Context, req *GetLogEventsRequest) (resp *GetLogEventsResponse, err error) {
 	resp = &GetLogEventsResponse{}
-	err = c.client.Do("GetLogEvents", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetLogEvents", "POST", "/", req, resp)
 	return
 }
 
@@ -162,8 +274,15 @@ func (c *Logs) GetLogEvents(req *GetLogEventsRequest) (resp *GetLogEventsRespons
 // group. The log events in the batch must be in chronological ordered by
 // their timestamp The maximum number of log events in a batch is 1,000.
 func (c *Logs) PutLogEvents(req *PutLogEventsRequest) (resp *PutLogEventsResponse, err error) {
+	return c.PutLogEventsWithContext(context.Background(), req)
+}
+
+// PutLogEventsWithContext is like PutLogEvents, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) PutLogEventsWithContext(ctx context.Context, req *PutLogEventsRequest) (resp *PutLogEventsResponse, err error) {
 	resp = &PutLogEventsResponse{}
-	err = c.client.Do("PutLogEvents", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PutLogEvents", "POST", "/", req, resp)
 	return
 }
 
@@ -172,8 +291,15 @@ func (c *Logs) PutLogEvents(req *PutLogEventsRequest) (resp *PutLogEventsRespons
 // rules to extract metric data from log events ingested through
 // PutLogEvents requests.
 func (c *Logs) PutMetricFilter(req *PutMetricFilterRequest) (err error) {
+	return c.PutMetricFilterWithContext(context.Background(), req)
+}
+
+// PutMetricFilterWithContext is like PutMetricFilter, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) PutMetricFilterWithContext(ctx context.Context, req *PutMetricFilterRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutMetricFilter", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutMetricFilter", "POST", "/", req, nil)
 	return
 }
 
@@ -181,8 +307,15 @@ func (c *Logs) PutMetricFilter(req *PutMetricFilterRequest) (err error) {
 // retention policy allows you to configure the number of days you want to
 // retain log events in the specified log group.
 func (c *Logs) PutRetentionPolicy(req *PutRetentionPolicyRequest) (err error) {
+	return c.PutRetentionPolicyWithContext(context.Background(), req)
+}
+
+// PutRetentionPolicyWithContext is like PutRetentionPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) PutRetentionPolicyWithContext(ctx context.Context, req *PutRetentionPolicyRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutRetentionPolicy", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutRetentionPolicy", "POST", "/", req, nil)
 	return
 }
 
@@ -190,8 +323,15 @@ func (c *Logs) PutRetentionPolicy(req *PutRetentionPolicyRequest) (err error) {
 // sample of log event messages. You can use this operation to validate the
 // correctness of a metric filter pattern.
 func (c *Logs) TestMetricFilter(req *TestMetricFilterRequest) (resp *TestMetricFilterResponse, err error) {
+	return c.TestMetricFilterWithContext(context.Background(), req)
+}
+
+// TestMetricFilterWithContext is like TestMetricFilter, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Logs) TestMetricFilterWithContext(ctx context.Context, req *TestMetricFilterRequest) (resp *TestMetricFilterResponse, err error) {
 	resp = &TestMetricFilterResponse{}
-	err = c.client.Do("TestMetricFilter", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "TestMetricFilter", "POST", "/", req, resp)
 	return
 }
 
@@ -200,34 +340,217 @@ type CreateLogGroupRequest struct {
 	LogGroupName aws.StringValue `json:"logGroupName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateLogGroupRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateLogGroupRequest to b.
+func (v *CreateLogGroupRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateLogStreamRequest is undocumented.
 type CreateLogStreamRequest struct {
 	LogGroupName  aws.StringValue `json:"logGroupName"`
 	LogStreamName aws.StringValue `json:"logStreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateLogStreamRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateLogStreamRequest to b.
+func (v *CreateLogStreamRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"logStreamName\":"...)
+	if v.LogStreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogStreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteLogGroupRequest is undocumented.
 type DeleteLogGroupRequest struct {
 	LogGroupName aws.StringValue `json:"logGroupName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteLogGroupRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteLogGroupRequest to b.
+func (v *DeleteLogGroupRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteLogStreamRequest is undocumented.
 type DeleteLogStreamRequest struct {
 	LogGroupName  aws.StringValue `json:"logGroupName"`
 	LogStreamName aws.StringValue `json:"logStreamName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteLogStreamRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteLogStreamRequest to b.
+func (v *DeleteLogStreamRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"logStreamName\":"...)
+	if v.LogStreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogStreamName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteMetricFilterRequest is undocumented.
 type DeleteMetricFilterRequest struct {
 	FilterName   aws.StringValue `json:"filterName"`
 	LogGroupName aws.StringValue `json:"logGroupName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteMetricFilterRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteMetricFilterRequest to b.
+func (v *DeleteMetricFilterRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"filterName\":"...)
+	if v.FilterName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.FilterName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteRetentionPolicyRequest is undocumented.
 type DeleteRetentionPolicyRequest struct {
 	LogGroupName aws.StringValue `json:"logGroupName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteRetentionPolicyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteRetentionPolicyRequest to b.
+func (v *DeleteRetentionPolicyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeLogGroupsRequest is undocumented.
 type DescribeLogGroupsRequest struct {
 	Limit              aws.IntegerValue `json:"limit,omitempty"`
@@ -235,6 +558,41 @@ type DescribeLogGroupsRequest struct {
 	NextToken          aws.StringValue  `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeLogGroupsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeLogGroupsRequest to b.
+func (v *DescribeLogGroupsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.LogGroupNamePrefix != nil {
+		b = append(b, "\"logGroupNamePrefix\":"...)
+		b = aws.AppendJSONString(b, *v.LogGroupNamePrefix)
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeLogGroupsResponse is undocumented.
 type DescribeLogGroupsResponse struct {
 	LogGroups []LogGroup      `json:"logGroups,omitempty"`
@@ -249,6 +607,48 @@ type DescribeLogStreamsRequest struct {
 	NextToken           aws.StringValue  `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeLogStreamsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeLogStreamsRequest to b.
+func (v *DescribeLogStreamsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	if v.LogStreamNamePrefix != nil {
+		b = append(b, "\"logStreamNamePrefix\":"...)
+		b = aws.AppendJSONString(b, *v.LogStreamNamePrefix)
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeLogStreamsResponse is undocumented.
 type DescribeLogStreamsResponse struct {
 	LogStreams []LogStream     `json:"logStreams,omitempty"`
@@ -263,6 +663,48 @@ type DescribeMetricFiltersRequest struct {
 	NextToken        aws.StringValue  `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeMetricFiltersRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeMetricFiltersRequest to b.
+func (v *DescribeMetricFiltersRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.FilterNamePrefix != nil {
+		b = append(b, "\"filterNamePrefix\":"...)
+		b = aws.AppendJSONString(b, *v.FilterNamePrefix)
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeMetricFiltersResponse is undocumented.
 type DescribeMetricFiltersResponse struct {
 	MetricFilters []MetricFilter  `json:"metricFilters,omitempty"`
@@ -280,6 +722,65 @@ type GetLogEventsRequest struct {
 	StartTime     aws.LongValue    `json:"startTime,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetLogEventsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetLogEventsRequest to b.
+func (v *GetLogEventsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.EndTime != nil {
+		b = append(b, "\"endTime\":"...)
+		b = strconv.AppendInt(b, int64(*v.EndTime), 10)
+		b = append(b, ',')
+	}
+	if v.Limit != nil {
+		b = append(b, "\"limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"logStreamName\":"...)
+	if v.LogStreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogStreamName)
+	}
+	b = append(b, ',')
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if v.StartFromHead != nil {
+		b = append(b, "\"startFromHead\":"...)
+		b = strconv.AppendBool(b, *v.StartFromHead)
+		b = append(b, ',')
+	}
+	if v.StartTime != nil {
+		b = append(b, "\"startTime\":"...)
+		b = strconv.AppendInt(b, int64(*v.StartTime), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetLogEventsResponse is undocumented.
 type GetLogEventsResponse struct {
 	Events            []OutputLogEvent `json:"events,omitempty"`
@@ -293,6 +794,40 @@ type InputLogEvent struct {
 	Timestamp aws.LongValue   `json:"timestamp"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *InputLogEvent) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the InputLogEvent to b.
+func (v *InputLogEvent) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"message\":"...)
+	if v.Message == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Message)
+	}
+	b = append(b, ',')
+	b = append(b, "\"timestamp\":"...)
+	if v.Timestamp == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.Timestamp), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // LogGroup is undocumented.
 type LogGroup struct {
 	ARN               aws.StringValue  `json:"arn,omitempty"`
@@ -337,6 +872,47 @@ type MetricTransformation struct {
 	MetricValue     aws.StringValue `json:"metricValue"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *MetricTransformation) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the MetricTransformation to b.
+func (v *MetricTransformation) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"metricName\":"...)
+	if v.MetricName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.MetricName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"metricNamespace\":"...)
+	if v.MetricNamespace == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.MetricNamespace)
+	}
+	b = append(b, ',')
+	b = append(b, "\"metricValue\":"...)
+	if v.MetricValue == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.MetricValue)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // OutputLogEvent is undocumented.
 type OutputLogEvent struct {
 	IngestionTime aws.LongValue   `json:"ingestionTime,omitempty"`
@@ -352,6 +928,64 @@ type PutLogEventsRequest struct {
 	SequenceToken aws.StringValue `json:"sequenceToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutLogEventsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutLogEventsRequest to b.
+func (v *PutLogEventsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"logEvents\":"...)
+	if v.LogEvents == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.LogEvents {
+			b, err = v.LogEvents[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"logStreamName\":"...)
+	if v.LogStreamName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogStreamName)
+	}
+	b = append(b, ',')
+	if v.SequenceToken != nil {
+		b = append(b, "\"sequenceToken\":"...)
+		b = aws.AppendJSONString(b, *v.SequenceToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutLogEventsResponse is undocumented.
 type PutLogEventsResponse struct {
 	NextSequenceToken aws.StringValue `json:"nextSequenceToken,omitempty"`
@@ -365,22 +999,218 @@ type PutMetricFilterRequest struct {
 	MetricTransformations []MetricTransformation `json:"metricTransformations"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutMetricFilterRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutMetricFilterRequest to b.
+func (v *PutMetricFilterRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"filterName\":"...)
+	if v.FilterName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.FilterName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"filterPattern\":"...)
+	if v.FilterPattern == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.FilterPattern)
+	}
+	b = append(b, ',')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"metricTransformations\":"...)
+	if v.MetricTransformations == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.MetricTransformations {
+			b, err = v.MetricTransformations[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PutRetentionPolicyRequest is undocumented.
 type PutRetentionPolicyRequest struct {
 	LogGroupName    aws.StringValue  `json:"logGroupName"`
 	RetentionInDays aws.IntegerValue `json:"retentionInDays"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PutRetentionPolicyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PutRetentionPolicyRequest to b.
+func (v *PutRetentionPolicyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"logGroupName\":"...)
+	if v.LogGroupName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LogGroupName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"retentionInDays\":"...)
+	if v.RetentionInDays == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.RetentionInDays), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TestMetricFilterRequest is undocumented.
 type TestMetricFilterRequest struct {
 	FilterPattern    aws.StringValue `json:"filterPattern"`
 	LogEventMessages []string        `json:"logEventMessages"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *TestMetricFilterRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the TestMetricFilterRequest to b.
+func (v *TestMetricFilterRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"filterPattern\":"...)
+	if v.FilterPattern == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.FilterPattern)
+	}
+	b = append(b, ',')
+	b = append(b, "\"logEventMessages\":"...)
+	if v.LogEventMessages == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.LogEventMessages {
+			b = aws.AppendJSONString(b, v.LogEventMessages[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TestMetricFilterResponse is undocumented.
 type TestMetricFilterResponse struct {
 	Matches []MetricFilterMatchRecord `json:"matches,omitempty"`
 }
 
+// DataAlreadyAcceptedException is the error returned for the DataAlreadyAcceptedException error code.
+type DataAlreadyAcceptedException struct {
+	aws.APIError
+
+	ExpectedSequenceToken aws.StringValue `json:"expectedSequenceToken,omitempty"`
+}
+
+// InvalidParameterException is the error returned for the InvalidParameterException error code.
+type InvalidParameterException struct {
+	aws.APIError
+}
+
+// InvalidSequenceTokenException is the error returned for the InvalidSequenceTokenException error code.
+type InvalidSequenceTokenException struct {
+	aws.APIError
+
+	ExpectedSequenceToken aws.StringValue `json:"expectedSequenceToken,omitempty"`
+}
+
+// LimitExceededException is the error returned for the LimitExceededException error code.
+type LimitExceededException struct {
+	aws.APIError
+}
+
+// OperationAbortedException is the error returned for the OperationAbortedException error code.
+type OperationAbortedException struct {
+	aws.APIError
+}
+
+// ResourceAlreadyExistsException is the error returned for the ResourceAlreadyExistsException error code.
+type ResourceAlreadyExistsException struct {
+	aws.APIError
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// ServiceUnavailableException is the error returned for the ServiceUnavailableException error code.
+type ServiceUnavailableException struct {
+	aws.APIError
+}
+
+// exceptions maps Logs's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"DataAlreadyAcceptedException":   DataAlreadyAcceptedException{},
+	"InvalidParameterException":      InvalidParameterException{},
+	"InvalidSequenceTokenException":  InvalidSequenceTokenException{},
+	"LimitExceededException":         LimitExceededException{},
+	"OperationAbortedException":      OperationAbortedException{},
+	"ResourceAlreadyExistsException": ResourceAlreadyExistsException{},
+	"ResourceNotFoundException":      ResourceNotFoundException{},
+	"ServiceUnavailableException":    ServiceUnavailableException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
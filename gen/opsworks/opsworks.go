@@ -4,11 +4,18 @@
 package opsworks
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // OpsWorks is a client for AWS OpsWorks.
@@ -16,29 +23,64 @@ type OpsWorks struct {
 	client *aws.JSONClient
 }
 
-// New returns a new OpsWorks client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *OpsWorks {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "opsworks"
-	endpoint, service, region := endpoints.Lookup("opsworks", region)
+// New returns a new OpsWorks client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *OpsWorks {
+	cfg := newConfig(region, client, opts)
 
 	return &OpsWorks{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "OpsWorks_20130218",
 		},
 	}
 }
 
+// NewFromSession returns a new OpsWorks client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *OpsWorks {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("opsworks", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("opsworks", "opsworks"),
+	}, "AWS_ENDPOINT_URL_OPSWORKS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *OpsWorks) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *OpsWorks) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // AssignInstance assign a registered instance to a custom layer. You
 // cannot use this action with instances that were created with AWS
 // OpsWorks. Required Permissions : To use this action, an IAM user must
@@ -46,8 +88,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Ops
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) AssignInstance(req *AssignInstanceRequest) (err error) {
+	return c.AssignInstanceWithContext(context.Background(), req)
+}
+
+// AssignInstanceWithContext is like AssignInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) AssignInstanceWithContext(ctx context.Context, req *AssignInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("AssignInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AssignInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -59,8 +108,15 @@ func (c *OpsWorks) AssignInstance(req *AssignInstanceRequest) (err error) {
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) AssignVolume(req *AssignVolumeRequest) (err error) {
+	return c.AssignVolumeWithContext(context.Background(), req)
+}
+
+// AssignVolumeWithContext is like AssignVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) AssignVolumeWithContext(ctx context.Context, req *AssignVolumeRequest) (err error) {
 	// NRE
-	err = c.client.Do("AssignVolume", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AssignVolume", "POST", "/", req, nil)
 	return
 }
 
@@ -72,8 +128,15 @@ func (c *OpsWorks) AssignVolume(req *AssignVolumeRequest) (err error) {
 // or an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) AssociateElasticIP(req *AssociateElasticIPRequest) (err error) {
+	return c.AssociateElasticIPWithContext(context.Background(), req)
+}
+
+// AssociateElasticIPWithContext is like AssociateElasticIP, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) AssociateElasticIPWithContext(ctx context.Context, req *AssociateElasticIPRequest) (err error) {
 	// NRE
-	err = c.client.Do("AssociateElasticIp", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AssociateElasticIp", "POST", "/", req, nil)
 	return
 }
 
@@ -87,8 +150,15 @@ func (c *OpsWorks) AssociateElasticIP(req *AssociateElasticIPRequest) (err error
 // grants permissions. For more information on user permissions, see
 // Managing User Permissions
 func (c *OpsWorks) AttachElasticLoadBalancer(req *AttachElasticLoadBalancerRequest) (err error) {
+	return c.AttachElasticLoadBalancerWithContext(context.Background(), req)
+}
+
+// AttachElasticLoadBalancerWithContext is like AttachElasticLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) AttachElasticLoadBalancerWithContext(ctx context.Context, req *AttachElasticLoadBalancerRequest) (err error) {
 	// NRE
-	err = c.client.Do("AttachElasticLoadBalancer", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AttachElasticLoadBalancer", "POST", "/", req, nil)
 	return
 }
 
@@ -97,8 +167,15 @@ func (c *OpsWorks) AttachElasticLoadBalancer(req *AttachElasticLoadBalancerReque
 // must have an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) CloneStack(req *CloneStackRequest) (resp *CloneStackResult, err error) {
+	return c.CloneStackWithContext(context.Background(), req)
+}
+
+// CloneStackWithContext is like CloneStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) CloneStackWithContext(ctx context.Context, req *CloneStackRequest) (resp *CloneStackResult, err error) {
 	resp = &CloneStackResult{}
-	err = c.client.Do("CloneStack", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CloneStack", "POST", "/", req, resp)
 	return
 }
 
@@ -108,8 +185,15 @@ func (c *OpsWorks) CloneStack(req *CloneStackRequest) (resp *CloneStackResult, e
 // policy that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) CreateApp(req *CreateAppRequest) (resp *CreateAppResult, err error) {
+	return c.CreateAppWithContext(context.Background(), req)
+}
+
+// CreateAppWithContext is like CreateApp, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) CreateAppWithContext(ctx context.Context, req *CreateAppRequest) (resp *CreateAppResult, err error) {
 	resp = &CreateAppResult{}
-	err = c.client.Do("CreateApp", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateApp", "POST", "/", req, resp)
 	return
 }
 
@@ -120,8 +204,15 @@ func (c *OpsWorks) CreateApp(req *CreateAppRequest) (resp *CreateAppResult, err
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) CreateDeployment(req *CreateDeploymentRequest) (resp *CreateDeploymentResult, err error) {
+	return c.CreateDeploymentWithContext(context.Background(), req)
+}
+
+// CreateDeploymentWithContext is like CreateDeployment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) CreateDeploymentWithContext(ctx context.Context, req *CreateDeploymentRequest) (resp *CreateDeploymentResult, err error) {
 	resp = &CreateDeploymentResult{}
-	err = c.client.Do("CreateDeployment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDeployment", "POST", "/", req, resp)
 	return
 }
 
@@ -131,8 +222,15 @@ func (c *OpsWorks) CreateDeployment(req *CreateDeploymentRequest) (resp *CreateD
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) CreateInstance(req *CreateInstanceRequest) (resp *CreateInstanceResult, err error) {
+	return c.CreateInstanceWithContext(context.Background(), req)
+}
+
+// CreateInstanceWithContext is like CreateInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) CreateInstanceWithContext(ctx context.Context, req *CreateInstanceRequest) (resp *CreateInstanceResult, err error) {
 	resp = &CreateInstanceResult{}
-	err = c.client.Do("CreateInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateInstance", "POST", "/", req, resp)
 	return
 }
 
@@ -147,8 +245,15 @@ func (c *OpsWorks) CreateInstance(req *CreateInstanceRequest) (resp *CreateInsta
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) CreateLayer(req *CreateLayerRequest) (resp *CreateLayerResult, err error) {
+	return c.CreateLayerWithContext(context.Background(), req)
+}
+
+// CreateLayerWithContext is like CreateLayer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) CreateLayerWithContext(ctx context.Context, req *CreateLayerRequest) (resp *CreateLayerResult, err error) {
 	resp = &CreateLayerResult{}
-	err = c.client.Do("CreateLayer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateLayer", "POST", "/", req, resp)
 	return
 }
 
@@ -157,8 +262,15 @@ func (c *OpsWorks) CreateLayer(req *CreateLayerRequest) (resp *CreateLayerResult
 // an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) CreateStack(req *CreateStackRequest) (resp *CreateStackResult, err error) {
+	return c.CreateStackWithContext(context.Background(), req)
+}
+
+// CreateStackWithContext is like CreateStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) CreateStackWithContext(ctx context.Context, req *CreateStackRequest) (resp *CreateStackResult, err error) {
 	resp = &CreateStackResult{}
-	err = c.client.Do("CreateStack", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateStack", "POST", "/", req, resp)
 	return
 }
 
@@ -167,8 +279,15 @@ func (c *OpsWorks) CreateStack(req *CreateStackRequest) (resp *CreateStackResult
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) CreateUserProfile(req *CreateUserProfileRequest) (resp *CreateUserProfileResult, err error) {
+	return c.CreateUserProfileWithContext(context.Background(), req)
+}
+
+// CreateUserProfileWithContext is like CreateUserProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) CreateUserProfileWithContext(ctx context.Context, req *CreateUserProfileRequest) (resp *CreateUserProfileResult, err error) {
 	resp = &CreateUserProfileResult{}
-	err = c.client.Do("CreateUserProfile", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateUserProfile", "POST", "/", req, resp)
 	return
 }
 
@@ -177,8 +296,15 @@ func (c *OpsWorks) CreateUserProfile(req *CreateUserProfileRequest) (resp *Creat
 // or an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DeleteApp(req *DeleteAppRequest) (err error) {
+	return c.DeleteAppWithContext(context.Background(), req)
+}
+
+// DeleteAppWithContext is like DeleteApp, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeleteAppWithContext(ctx context.Context, req *DeleteAppRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteApp", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteApp", "POST", "/", req, nil)
 	return
 }
 
@@ -190,8 +316,15 @@ func (c *OpsWorks) DeleteApp(req *DeleteAppRequest) (err error) {
 // grants permissions. For more information on user permissions, see
 // Managing User Permissions
 func (c *OpsWorks) DeleteInstance(req *DeleteInstanceRequest) (err error) {
+	return c.DeleteInstanceWithContext(context.Background(), req)
+}
+
+// DeleteInstanceWithContext is like DeleteInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeleteInstanceWithContext(ctx context.Context, req *DeleteInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -202,8 +335,15 @@ func (c *OpsWorks) DeleteInstance(req *DeleteInstanceRequest) (err error) {
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DeleteLayer(req *DeleteLayerRequest) (err error) {
+	return c.DeleteLayerWithContext(context.Background(), req)
+}
+
+// DeleteLayerWithContext is like DeleteLayer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeleteLayerWithContext(ctx context.Context, req *DeleteLayerRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteLayer", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteLayer", "POST", "/", req, nil)
 	return
 }
 
@@ -214,8 +354,15 @@ func (c *OpsWorks) DeleteLayer(req *DeleteLayerRequest) (err error) {
 // or an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DeleteStack(req *DeleteStackRequest) (err error) {
+	return c.DeleteStackWithContext(context.Background(), req)
+}
+
+// DeleteStackWithContext is like DeleteStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeleteStackWithContext(ctx context.Context, req *DeleteStackRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteStack", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteStack", "POST", "/", req, nil)
 	return
 }
 
@@ -224,8 +371,15 @@ func (c *OpsWorks) DeleteStack(req *DeleteStackRequest) (err error) {
 // grants permissions. For more information on user permissions, see
 // Managing User Permissions
 func (c *OpsWorks) DeleteUserProfile(req *DeleteUserProfileRequest) (err error) {
+	return c.DeleteUserProfileWithContext(context.Background(), req)
+}
+
+// DeleteUserProfileWithContext is like DeleteUserProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeleteUserProfileWithContext(ctx context.Context, req *DeleteUserProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteUserProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteUserProfile", "POST", "/", req, nil)
 	return
 }
 
@@ -236,8 +390,15 @@ func (c *OpsWorks) DeleteUserProfile(req *DeleteUserProfileRequest) (err error)
 // attached policy that explicitly grants permissions. For more information
 // on user permissions, see Managing User Permissions
 func (c *OpsWorks) DeregisterElasticIP(req *DeregisterElasticIPRequest) (err error) {
+	return c.DeregisterElasticIPWithContext(context.Background(), req)
+}
+
+// DeregisterElasticIPWithContext is like DeregisterElasticIP, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeregisterElasticIPWithContext(ctx context.Context, req *DeregisterElasticIPRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeregisterElasticIp", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeregisterElasticIp", "POST", "/", req, nil)
 	return
 }
 
@@ -249,8 +410,15 @@ func (c *OpsWorks) DeregisterElasticIP(req *DeregisterElasticIPRequest) (err err
 // attached policy that explicitly grants permissions. For more information
 // on user permissions, see Managing User Permissions
 func (c *OpsWorks) DeregisterInstance(req *DeregisterInstanceRequest) (err error) {
+	return c.DeregisterInstanceWithContext(context.Background(), req)
+}
+
+// DeregisterInstanceWithContext is like DeregisterInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeregisterInstanceWithContext(ctx context.Context, req *DeregisterInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeregisterInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeregisterInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -260,8 +428,15 @@ func (c *OpsWorks) DeregisterInstance(req *DeregisterInstanceRequest) (err error
 // grants permissions. For more information on user permissions, see
 // Managing User Permissions
 func (c *OpsWorks) DeregisterRDSDBInstance(req *DeregisterRDSDBInstanceRequest) (err error) {
+	return c.DeregisterRDSDBInstanceWithContext(context.Background(), req)
+}
+
+// DeregisterRDSDBInstanceWithContext is like DeregisterRDSDBInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeregisterRDSDBInstanceWithContext(ctx context.Context, req *DeregisterRDSDBInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeregisterRdsDbInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeregisterRdsDbInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -272,8 +447,15 @@ func (c *OpsWorks) DeregisterRDSDBInstance(req *DeregisterRDSDBInstanceRequest)
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) DeregisterVolume(req *DeregisterVolumeRequest) (err error) {
+	return c.DeregisterVolumeWithContext(context.Background(), req)
+}
+
+// DeregisterVolumeWithContext is like DeregisterVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DeregisterVolumeWithContext(ctx context.Context, req *DeregisterVolumeRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeregisterVolume", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeregisterVolume", "POST", "/", req, nil)
 	return
 }
 
@@ -283,8 +465,15 @@ func (c *OpsWorks) DeregisterVolume(req *DeregisterVolumeRequest) (err error) {
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribeApps(req *DescribeAppsRequest) (resp *DescribeAppsResult, err error) {
+	return c.DescribeAppsWithContext(context.Background(), req)
+}
+
+// DescribeAppsWithContext is like DescribeApps, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeAppsWithContext(ctx context.Context, req *DescribeAppsRequest) (resp *DescribeAppsResult, err error) {
 	resp = &DescribeAppsResult{}
-	err = c.client.Do("DescribeApps", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeApps", "POST", "/", req, resp)
 	return
 }
 
@@ -294,8 +483,15 @@ func (c *OpsWorks) DescribeApps(req *DescribeAppsRequest) (resp *DescribeAppsRes
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeCommands(req *DescribeCommandsRequest) (resp *DescribeCommandsResult, err error) {
+	return c.DescribeCommandsWithContext(context.Background(), req)
+}
+
+// DescribeCommandsWithContext is like DescribeCommands, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeCommandsWithContext(ctx context.Context, req *DescribeCommandsRequest) (resp *DescribeCommandsResult, err error) {
 	resp = &DescribeCommandsResult{}
-	err = c.client.Do("DescribeCommands", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCommands", "POST", "/", req, resp)
 	return
 }
 
@@ -306,8 +502,15 @@ func (c *OpsWorks) DescribeCommands(req *DescribeCommandsRequest) (resp *Describ
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribeDeployments(req *DescribeDeploymentsRequest) (resp *DescribeDeploymentsResult, err error) {
+	return c.DescribeDeploymentsWithContext(context.Background(), req)
+}
+
+// DescribeDeploymentsWithContext is like DescribeDeployments, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeDeploymentsWithContext(ctx context.Context, req *DescribeDeploymentsRequest) (resp *DescribeDeploymentsResult, err error) {
 	resp = &DescribeDeploymentsResult{}
-	err = c.client.Do("DescribeDeployments", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDeployments", "POST", "/", req, resp)
 	return
 }
 
@@ -317,8 +520,15 @@ func (c *OpsWorks) DescribeDeployments(req *DescribeDeploymentsRequest) (resp *D
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeElasticIPs(req *DescribeElasticIPsRequest) (resp *DescribeElasticIPsResult, err error) {
+	return c.DescribeElasticIPsWithContext(context.Background(), req)
+}
+
+// DescribeElasticIPsWithContext is like DescribeElasticIPs, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeElasticIPsWithContext(ctx context.Context, req *DescribeElasticIPsRequest) (resp *DescribeElasticIPsResult, err error) {
 	resp = &DescribeElasticIPsResult{}
-	err = c.client.Do("DescribeElasticIps", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeElasticIps", "POST", "/", req, resp)
 	return
 }
 
@@ -329,8 +539,15 @@ func (c *OpsWorks) DescribeElasticIPs(req *DescribeElasticIPsRequest) (resp *Des
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribeElasticLoadBalancers(req *DescribeElasticLoadBalancersRequest) (resp *DescribeElasticLoadBalancersResult, err error) {
+	return c.DescribeElasticLoadBalancersWithContext(context.Background(), req)
+}
+
+// DescribeElasticLoadBalancersWithContext is like DescribeElasticLoadBalancers, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeElasticLoadBalancersWithContext(ctx context.Context, req *DescribeElasticLoadBalancersRequest) (resp *DescribeElasticLoadBalancersResult, err error) {
 	resp = &DescribeElasticLoadBalancersResult{}
-	err = c.client.Do("DescribeElasticLoadBalancers", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeElasticLoadBalancers", "POST", "/", req, resp)
 	return
 }
 
@@ -340,8 +557,15 @@ func (c *OpsWorks) DescribeElasticLoadBalancers(req *DescribeElasticLoadBalancer
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeInstances(req *DescribeInstancesRequest) (resp *DescribeInstancesResult, err error) {
+	return c.DescribeInstancesWithContext(context.Background(), req)
+}
+
+// DescribeInstancesWithContext is like DescribeInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeInstancesWithContext(ctx context.Context, req *DescribeInstancesRequest) (resp *DescribeInstancesResult, err error) {
 	resp = &DescribeInstancesResult{}
-	err = c.client.Do("DescribeInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -352,8 +576,15 @@ func (c *OpsWorks) DescribeInstances(req *DescribeInstancesRequest) (resp *Descr
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeLayers(req *DescribeLayersRequest) (resp *DescribeLayersResult, err error) {
+	return c.DescribeLayersWithContext(context.Background(), req)
+}
+
+// DescribeLayersWithContext is like DescribeLayers, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeLayersWithContext(ctx context.Context, req *DescribeLayersRequest) (resp *DescribeLayersResult, err error) {
 	resp = &DescribeLayersResult{}
-	err = c.client.Do("DescribeLayers", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLayers", "POST", "/", req, resp)
 	return
 }
 
@@ -364,8 +595,15 @@ func (c *OpsWorks) DescribeLayers(req *DescribeLayersRequest) (resp *DescribeLay
 // an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeLoadBasedAutoScaling(req *DescribeLoadBasedAutoScalingRequest) (resp *DescribeLoadBasedAutoScalingResult, err error) {
+	return c.DescribeLoadBasedAutoScalingWithContext(context.Background(), req)
+}
+
+// DescribeLoadBasedAutoScalingWithContext is like DescribeLoadBasedAutoScaling, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeLoadBasedAutoScalingWithContext(ctx context.Context, req *DescribeLoadBasedAutoScalingRequest) (resp *DescribeLoadBasedAutoScalingResult, err error) {
 	resp = &DescribeLoadBasedAutoScalingResult{}
-	err = c.client.Do("DescribeLoadBasedAutoScaling", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLoadBasedAutoScaling", "POST", "/", req, resp)
 	return
 }
 
@@ -374,8 +612,15 @@ func (c *OpsWorks) DescribeLoadBasedAutoScaling(req *DescribeLoadBasedAutoScalin
 // enabled or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeMyUserProfile() (resp *DescribeMyUserProfileResult, err error) {
+	return c.DescribeMyUserProfileWithContext(context.Background())
+}
+
+// DescribeMyUserProfileWithContext is like DescribeMyUserProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeMyUserProfileWithContext(ctx context.Context) (resp *DescribeMyUserProfileResult, err error) {
 	resp = &DescribeMyUserProfileResult{}
-	err = c.client.Do("DescribeMyUserProfile", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "DescribeMyUserProfile", "POST", "/", nil, resp)
 	return
 }
 
@@ -385,8 +630,15 @@ func (c *OpsWorks) DescribeMyUserProfile() (resp *DescribeMyUserProfileResult, e
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribePermissions(req *DescribePermissionsRequest) (resp *DescribePermissionsResult, err error) {
+	return c.DescribePermissionsWithContext(context.Background(), req)
+}
+
+// DescribePermissionsWithContext is like DescribePermissions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribePermissionsWithContext(ctx context.Context, req *DescribePermissionsRequest) (resp *DescribePermissionsResult, err error) {
 	resp = &DescribePermissionsResult{}
-	err = c.client.Do("DescribePermissions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribePermissions", "POST", "/", req, resp)
 	return
 }
 
@@ -396,8 +648,15 @@ func (c *OpsWorks) DescribePermissions(req *DescribePermissionsRequest) (resp *D
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeRAIDArrays(req *DescribeRAIDArraysRequest) (resp *DescribeRAIDArraysResult, err error) {
+	return c.DescribeRAIDArraysWithContext(context.Background(), req)
+}
+
+// DescribeRAIDArraysWithContext is like DescribeRAIDArrays, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeRAIDArraysWithContext(ctx context.Context, req *DescribeRAIDArraysRequest) (resp *DescribeRAIDArraysResult, err error) {
 	resp = &DescribeRAIDArraysResult{}
-	err = c.client.Do("DescribeRaidArrays", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeRaidArrays", "POST", "/", req, resp)
 	return
 }
 
@@ -407,8 +666,15 @@ func (c *OpsWorks) DescribeRAIDArrays(req *DescribeRAIDArraysRequest) (resp *Des
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribeRDSDBInstances(req *DescribeRDSDBInstancesRequest) (resp *DescribeRDSDBInstancesResult, err error) {
+	return c.DescribeRDSDBInstancesWithContext(context.Background(), req)
+}
+
+// DescribeRDSDBInstancesWithContext is like DescribeRDSDBInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeRDSDBInstancesWithContext(ctx context.Context, req *DescribeRDSDBInstancesRequest) (resp *DescribeRDSDBInstancesResult, err error) {
 	resp = &DescribeRDSDBInstancesResult{}
-	err = c.client.Do("DescribeRdsDbInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeRdsDbInstances", "POST", "/", req, resp)
 	return
 }
 
@@ -418,8 +684,15 @@ func (c *OpsWorks) DescribeRDSDBInstances(req *DescribeRDSDBInstancesRequest) (r
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribeServiceErrors(req *DescribeServiceErrorsRequest) (resp *DescribeServiceErrorsResult, err error) {
+	return c.DescribeServiceErrorsWithContext(context.Background(), req)
+}
+
+// DescribeServiceErrorsWithContext is like DescribeServiceErrors, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeServiceErrorsWithContext(ctx context.Context, req *DescribeServiceErrorsRequest) (resp *DescribeServiceErrorsResult, err error) {
 	resp = &DescribeServiceErrorsResult{}
-	err = c.client.Do("DescribeServiceErrors", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeServiceErrors", "POST", "/", req, resp)
 	return
 }
 
@@ -429,8 +702,15 @@ func (c *OpsWorks) DescribeServiceErrors(req *DescribeServiceErrorsRequest) (res
 // stack or an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeStackProvisioningParameters(req *DescribeStackProvisioningParametersRequest) (resp *DescribeStackProvisioningParametersResult, err error) {
+	return c.DescribeStackProvisioningParametersWithContext(context.Background(), req)
+}
+
+// DescribeStackProvisioningParametersWithContext is like DescribeStackProvisioningParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeStackProvisioningParametersWithContext(ctx context.Context, req *DescribeStackProvisioningParametersRequest) (resp *DescribeStackProvisioningParametersResult, err error) {
 	resp = &DescribeStackProvisioningParametersResult{}
-	err = c.client.Do("DescribeStackProvisioningParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStackProvisioningParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -441,8 +721,15 @@ func (c *OpsWorks) DescribeStackProvisioningParameters(req *DescribeStackProvisi
 // stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeStackSummary(req *DescribeStackSummaryRequest) (resp *DescribeStackSummaryResult, err error) {
+	return c.DescribeStackSummaryWithContext(context.Background(), req)
+}
+
+// DescribeStackSummaryWithContext is like DescribeStackSummary, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeStackSummaryWithContext(ctx context.Context, req *DescribeStackSummaryRequest) (resp *DescribeStackSummaryResult, err error) {
 	resp = &DescribeStackSummaryResult{}
-	err = c.client.Do("DescribeStackSummary", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStackSummary", "POST", "/", req, resp)
 	return
 }
 
@@ -452,8 +739,15 @@ func (c *OpsWorks) DescribeStackSummary(req *DescribeStackSummaryRequest) (resp
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribeStacks(req *DescribeStacksRequest) (resp *DescribeStacksResult, err error) {
+	return c.DescribeStacksWithContext(context.Background(), req)
+}
+
+// DescribeStacksWithContext is like DescribeStacks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeStacksWithContext(ctx context.Context, req *DescribeStacksRequest) (resp *DescribeStacksResult, err error) {
 	resp = &DescribeStacksResult{}
-	err = c.client.Do("DescribeStacks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStacks", "POST", "/", req, resp)
 	return
 }
 
@@ -464,8 +758,15 @@ func (c *OpsWorks) DescribeStacks(req *DescribeStacksRequest) (resp *DescribeSta
 // an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeTimeBasedAutoScaling(req *DescribeTimeBasedAutoScalingRequest) (resp *DescribeTimeBasedAutoScalingResult, err error) {
+	return c.DescribeTimeBasedAutoScalingWithContext(context.Background(), req)
+}
+
+// DescribeTimeBasedAutoScalingWithContext is like DescribeTimeBasedAutoScaling, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeTimeBasedAutoScalingWithContext(ctx context.Context, req *DescribeTimeBasedAutoScalingRequest) (resp *DescribeTimeBasedAutoScalingResult, err error) {
 	resp = &DescribeTimeBasedAutoScalingResult{}
-	err = c.client.Do("DescribeTimeBasedAutoScaling", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTimeBasedAutoScaling", "POST", "/", req, resp)
 	return
 }
 
@@ -474,8 +775,15 @@ func (c *OpsWorks) DescribeTimeBasedAutoScaling(req *DescribeTimeBasedAutoScalin
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) DescribeUserProfiles(req *DescribeUserProfilesRequest) (resp *DescribeUserProfilesResult, err error) {
+	return c.DescribeUserProfilesWithContext(context.Background(), req)
+}
+
+// DescribeUserProfilesWithContext is like DescribeUserProfiles, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeUserProfilesWithContext(ctx context.Context, req *DescribeUserProfilesRequest) (resp *DescribeUserProfilesResult, err error) {
 	resp = &DescribeUserProfilesResult{}
-	err = c.client.Do("DescribeUserProfiles", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeUserProfiles", "POST", "/", req, resp)
 	return
 }
 
@@ -485,8 +793,15 @@ func (c *OpsWorks) DescribeUserProfiles(req *DescribeUserProfilesRequest) (resp
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) DescribeVolumes(req *DescribeVolumesRequest) (resp *DescribeVolumesResult, err error) {
+	return c.DescribeVolumesWithContext(context.Background(), req)
+}
+
+// DescribeVolumesWithContext is like DescribeVolumes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DescribeVolumesWithContext(ctx context.Context, req *DescribeVolumesRequest) (resp *DescribeVolumesResult, err error) {
 	resp = &DescribeVolumesResult{}
-	err = c.client.Do("DescribeVolumes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVolumes", "POST", "/", req, resp)
 	return
 }
 
@@ -496,8 +811,15 @@ func (c *OpsWorks) DescribeVolumes(req *DescribeVolumesRequest) (resp *DescribeV
 // attached policy that explicitly grants permissions. For more information
 // on user permissions, see Managing User Permissions
 func (c *OpsWorks) DetachElasticLoadBalancer(req *DetachElasticLoadBalancerRequest) (err error) {
+	return c.DetachElasticLoadBalancerWithContext(context.Background(), req)
+}
+
+// DetachElasticLoadBalancerWithContext is like DetachElasticLoadBalancer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DetachElasticLoadBalancerWithContext(ctx context.Context, req *DetachElasticLoadBalancerRequest) (err error) {
 	// NRE
-	err = c.client.Do("DetachElasticLoadBalancer", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DetachElasticLoadBalancer", "POST", "/", req, nil)
 	return
 }
 
@@ -508,8 +830,15 @@ func (c *OpsWorks) DetachElasticLoadBalancer(req *DetachElasticLoadBalancerReque
 // or an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) DisassociateElasticIP(req *DisassociateElasticIPRequest) (err error) {
+	return c.DisassociateElasticIPWithContext(context.Background(), req)
+}
+
+// DisassociateElasticIPWithContext is like DisassociateElasticIP, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) DisassociateElasticIPWithContext(ctx context.Context, req *DisassociateElasticIPRequest) (err error) {
 	// NRE
-	err = c.client.Do("DisassociateElasticIp", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DisassociateElasticIp", "POST", "/", req, nil)
 	return
 }
 
@@ -519,8 +848,15 @@ func (c *OpsWorks) DisassociateElasticIP(req *DisassociateElasticIPRequest) (err
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) GetHostnameSuggestion(req *GetHostnameSuggestionRequest) (resp *GetHostnameSuggestionResult, err error) {
+	return c.GetHostnameSuggestionWithContext(context.Background(), req)
+}
+
+// GetHostnameSuggestionWithContext is like GetHostnameSuggestion, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) GetHostnameSuggestionWithContext(ctx context.Context, req *GetHostnameSuggestionRequest) (resp *GetHostnameSuggestionResult, err error) {
 	resp = &GetHostnameSuggestionResult{}
-	err = c.client.Do("GetHostnameSuggestion", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetHostnameSuggestion", "POST", "/", req, resp)
 	return
 }
 
@@ -530,8 +866,15 @@ func (c *OpsWorks) GetHostnameSuggestion(req *GetHostnameSuggestionRequest) (res
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) RebootInstance(req *RebootInstanceRequest) (err error) {
+	return c.RebootInstanceWithContext(context.Background(), req)
+}
+
+// RebootInstanceWithContext is like RebootInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) RebootInstanceWithContext(ctx context.Context, req *RebootInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("RebootInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RebootInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -544,8 +887,15 @@ func (c *OpsWorks) RebootInstance(req *RebootInstanceRequest) (err error) {
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) RegisterElasticIP(req *RegisterElasticIPRequest) (resp *RegisterElasticIPResult, err error) {
+	return c.RegisterElasticIPWithContext(context.Background(), req)
+}
+
+// RegisterElasticIPWithContext is like RegisterElasticIP, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) RegisterElasticIPWithContext(ctx context.Context, req *RegisterElasticIPRequest) (resp *RegisterElasticIPResult, err error) {
 	resp = &RegisterElasticIPResult{}
-	err = c.client.Do("RegisterElasticIp", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RegisterElasticIp", "POST", "/", req, resp)
 	return
 }
 
@@ -560,8 +910,15 @@ func (c *OpsWorks) RegisterElasticIP(req *RegisterElasticIPRequest) (resp *Regis
 // the stack or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) RegisterInstance(req *RegisterInstanceRequest) (resp *RegisterInstanceResult, err error) {
+	return c.RegisterInstanceWithContext(context.Background(), req)
+}
+
+// RegisterInstanceWithContext is like RegisterInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) RegisterInstanceWithContext(ctx context.Context, req *RegisterInstanceRequest) (resp *RegisterInstanceResult, err error) {
 	resp = &RegisterInstanceResult{}
-	err = c.client.Do("RegisterInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RegisterInstance", "POST", "/", req, resp)
 	return
 }
 
@@ -571,8 +928,15 @@ func (c *OpsWorks) RegisterInstance(req *RegisterInstanceRequest) (resp *Registe
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) RegisterRDSDBInstance(req *RegisterRDSDBInstanceRequest) (err error) {
+	return c.RegisterRDSDBInstanceWithContext(context.Background(), req)
+}
+
+// RegisterRDSDBInstanceWithContext is like RegisterRDSDBInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) RegisterRDSDBInstanceWithContext(ctx context.Context, req *RegisterRDSDBInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("RegisterRdsDbInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RegisterRdsDbInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -585,8 +949,15 @@ func (c *OpsWorks) RegisterRDSDBInstance(req *RegisterRDSDBInstanceRequest) (err
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) RegisterVolume(req *RegisterVolumeRequest) (resp *RegisterVolumeResult, err error) {
+	return c.RegisterVolumeWithContext(context.Background(), req)
+}
+
+// RegisterVolumeWithContext is like RegisterVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) RegisterVolumeWithContext(ctx context.Context, req *RegisterVolumeRequest) (resp *RegisterVolumeResult, err error) {
 	resp = &RegisterVolumeResult{}
-	err = c.client.Do("RegisterVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RegisterVolume", "POST", "/", req, resp)
 	return
 }
 
@@ -601,8 +972,15 @@ func (c *OpsWorks) RegisterVolume(req *RegisterVolumeRequest) (resp *RegisterVol
 // attached policy that explicitly grants permissions. For more information
 // on user permissions, see Managing User Permissions
 func (c *OpsWorks) SetLoadBasedAutoScaling(req *SetLoadBasedAutoScalingRequest) (err error) {
+	return c.SetLoadBasedAutoScalingWithContext(context.Background(), req)
+}
+
+// SetLoadBasedAutoScalingWithContext is like SetLoadBasedAutoScaling, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) SetLoadBasedAutoScalingWithContext(ctx context.Context, req *SetLoadBasedAutoScalingRequest) (err error) {
 	// NRE
-	err = c.client.Do("SetLoadBasedAutoScaling", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetLoadBasedAutoScaling", "POST", "/", req, nil)
 	return
 }
 
@@ -612,8 +990,15 @@ func (c *OpsWorks) SetLoadBasedAutoScaling(req *SetLoadBasedAutoScalingRequest)
 // attached policy that explicitly grants permissions. For more information
 // on user permissions, see Managing User Permissions
 func (c *OpsWorks) SetPermission(req *SetPermissionRequest) (err error) {
+	return c.SetPermissionWithContext(context.Background(), req)
+}
+
+// SetPermissionWithContext is like SetPermission, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) SetPermissionWithContext(ctx context.Context, req *SetPermissionRequest) (err error) {
 	// NRE
-	err = c.client.Do("SetPermission", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetPermission", "POST", "/", req, nil)
 	return
 }
 
@@ -625,8 +1010,15 @@ func (c *OpsWorks) SetPermission(req *SetPermissionRequest) (err error) {
 // grants permissions. For more information on user permissions, see
 // Managing User Permissions
 func (c *OpsWorks) SetTimeBasedAutoScaling(req *SetTimeBasedAutoScalingRequest) (err error) {
+	return c.SetTimeBasedAutoScalingWithContext(context.Background(), req)
+}
+
+// SetTimeBasedAutoScalingWithContext is like SetTimeBasedAutoScaling, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) SetTimeBasedAutoScalingWithContext(ctx context.Context, req *SetTimeBasedAutoScalingRequest) (err error) {
 	// NRE
-	err = c.client.Do("SetTimeBasedAutoScaling", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetTimeBasedAutoScaling", "POST", "/", req, nil)
 	return
 }
 
@@ -636,8 +1028,15 @@ func (c *OpsWorks) SetTimeBasedAutoScaling(req *SetTimeBasedAutoScalingRequest)
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) StartInstance(req *StartInstanceRequest) (err error) {
+	return c.StartInstanceWithContext(context.Background(), req)
+}
+
+// StartInstanceWithContext is like StartInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) StartInstanceWithContext(ctx context.Context, req *StartInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("StartInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "StartInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -646,8 +1045,15 @@ func (c *OpsWorks) StartInstance(req *StartInstanceRequest) (err error) {
 // stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) StartStack(req *StartStackRequest) (err error) {
+	return c.StartStackWithContext(context.Background(), req)
+}
+
+// StartStackWithContext is like StartStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) StartStackWithContext(ctx context.Context, req *StartStackRequest) (err error) {
 	// NRE
-	err = c.client.Do("StartStack", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "StartStack", "POST", "/", req, nil)
 	return
 }
 
@@ -660,8 +1066,15 @@ func (c *OpsWorks) StartStack(req *StartStackRequest) (err error) {
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) StopInstance(req *StopInstanceRequest) (err error) {
+	return c.StopInstanceWithContext(context.Background(), req)
+}
+
+// StopInstanceWithContext is like StopInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) StopInstanceWithContext(ctx context.Context, req *StopInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("StopInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "StopInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -670,8 +1083,15 @@ func (c *OpsWorks) StopInstance(req *StopInstanceRequest) (err error) {
 // or an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) StopStack(req *StopStackRequest) (err error) {
+	return c.StopStackWithContext(context.Background(), req)
+}
+
+// StopStackWithContext is like StopStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) StopStackWithContext(ctx context.Context, req *StopStackRequest) (err error) {
 	// NRE
-	err = c.client.Do("StopStack", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "StopStack", "POST", "/", req, nil)
 	return
 }
 
@@ -683,8 +1103,15 @@ func (c *OpsWorks) StopStack(req *StopStackRequest) (err error) {
 // for the stack or an attached policy that explicitly grants permissions.
 // For more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UnassignInstance(req *UnassignInstanceRequest) (err error) {
+	return c.UnassignInstanceWithContext(context.Background(), req)
+}
+
+// UnassignInstanceWithContext is like UnassignInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UnassignInstanceWithContext(ctx context.Context, req *UnassignInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("UnassignInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UnassignInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -695,8 +1122,15 @@ func (c *OpsWorks) UnassignInstance(req *UnassignInstanceRequest) (err error) {
 // that explicitly grants permissions. For more information on user
 // permissions, see Managing User Permissions
 func (c *OpsWorks) UnassignVolume(req *UnassignVolumeRequest) (err error) {
+	return c.UnassignVolumeWithContext(context.Background(), req)
+}
+
+// UnassignVolumeWithContext is like UnassignVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UnassignVolumeWithContext(ctx context.Context, req *UnassignVolumeRequest) (err error) {
 	// NRE
-	err = c.client.Do("UnassignVolume", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UnassignVolume", "POST", "/", req, nil)
 	return
 }
 
@@ -705,8 +1139,15 @@ func (c *OpsWorks) UnassignVolume(req *UnassignVolumeRequest) (err error) {
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateApp(req *UpdateAppRequest) (err error) {
+	return c.UpdateAppWithContext(context.Background(), req)
+}
+
+// UpdateAppWithContext is like UpdateApp, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateAppWithContext(ctx context.Context, req *UpdateAppRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateApp", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateApp", "POST", "/", req, nil)
 	return
 }
 
@@ -716,8 +1157,15 @@ func (c *OpsWorks) UpdateApp(req *UpdateAppRequest) (err error) {
 // or an attached policy that explicitly grants permissions. For more
 // information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateElasticIP(req *UpdateElasticIPRequest) (err error) {
+	return c.UpdateElasticIPWithContext(context.Background(), req)
+}
+
+// UpdateElasticIPWithContext is like UpdateElasticIP, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateElasticIPWithContext(ctx context.Context, req *UpdateElasticIPRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateElasticIp", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateElasticIp", "POST", "/", req, nil)
 	return
 }
 
@@ -726,8 +1174,15 @@ func (c *OpsWorks) UpdateElasticIP(req *UpdateElasticIPRequest) (err error) {
 // the stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateInstance(req *UpdateInstanceRequest) (err error) {
+	return c.UpdateInstanceWithContext(context.Background(), req)
+}
+
+// UpdateInstanceWithContext is like UpdateInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateInstanceWithContext(ctx context.Context, req *UpdateInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -736,8 +1191,15 @@ func (c *OpsWorks) UpdateInstance(req *UpdateInstanceRequest) (err error) {
 // stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateLayer(req *UpdateLayerRequest) (err error) {
+	return c.UpdateLayerWithContext(context.Background(), req)
+}
+
+// UpdateLayerWithContext is like UpdateLayer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateLayerWithContext(ctx context.Context, req *UpdateLayerRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateLayer", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateLayer", "POST", "/", req, nil)
 	return
 }
 
@@ -746,8 +1208,15 @@ func (c *OpsWorks) UpdateLayer(req *UpdateLayerRequest) (err error) {
 // enabled or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateMyUserProfile(req *UpdateMyUserProfileRequest) (err error) {
+	return c.UpdateMyUserProfileWithContext(context.Background(), req)
+}
+
+// UpdateMyUserProfileWithContext is like UpdateMyUserProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateMyUserProfileWithContext(ctx context.Context, req *UpdateMyUserProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateMyUserProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateMyUserProfile", "POST", "/", req, nil)
 	return
 }
 
@@ -756,8 +1225,15 @@ func (c *OpsWorks) UpdateMyUserProfile(req *UpdateMyUserProfileRequest) (err err
 // for the stack, or an attached policy that explicitly grants permissions.
 // For more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateRDSDBInstance(req *UpdateRDSDBInstanceRequest) (err error) {
+	return c.UpdateRDSDBInstanceWithContext(context.Background(), req)
+}
+
+// UpdateRDSDBInstanceWithContext is like UpdateRDSDBInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateRDSDBInstanceWithContext(ctx context.Context, req *UpdateRDSDBInstanceRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateRdsDbInstance", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateRdsDbInstance", "POST", "/", req, nil)
 	return
 }
 
@@ -766,8 +1242,15 @@ func (c *OpsWorks) UpdateRDSDBInstance(req *UpdateRDSDBInstanceRequest) (err err
 // stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateStack(req *UpdateStackRequest) (err error) {
+	return c.UpdateStackWithContext(context.Background(), req)
+}
+
+// UpdateStackWithContext is like UpdateStack, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateStackWithContext(ctx context.Context, req *UpdateStackRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateStack", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateStack", "POST", "/", req, nil)
 	return
 }
 
@@ -776,8 +1259,15 @@ func (c *OpsWorks) UpdateStack(req *UpdateStackRequest) (err error) {
 // explicitly grants permissions. For more information on user permissions,
 // see Managing User Permissions
 func (c *OpsWorks) UpdateUserProfile(req *UpdateUserProfileRequest) (err error) {
+	return c.UpdateUserProfileWithContext(context.Background(), req)
+}
+
+// UpdateUserProfileWithContext is like UpdateUserProfile, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateUserProfileWithContext(ctx context.Context, req *UpdateUserProfileRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateUserProfile", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateUserProfile", "POST", "/", req, nil)
 	return
 }
 
@@ -787,8 +1277,15 @@ func (c *OpsWorks) UpdateUserProfile(req *UpdateUserProfileRequest) (err error)
 // stack, or an attached policy that explicitly grants permissions. For
 // more information on user permissions, see Managing User Permissions
 func (c *OpsWorks) UpdateVolume(req *UpdateVolumeRequest) (err error) {
+	return c.UpdateVolumeWithContext(context.Background(), req)
+}
+
+// UpdateVolumeWithContext is like UpdateVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *OpsWorks) UpdateVolumeWithContext(ctx context.Context, req *UpdateVolumeRequest) (err error) {
 	// NRE
-	err = c.client.Do("UpdateVolume", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "UpdateVolume", "POST", "/", req, nil)
 	return
 }
 
@@ -839,24 +1336,165 @@ type AssignInstanceRequest struct {
 	LayerIDs   []string        `json:"LayerIds"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AssignInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AssignInstanceRequest to b.
+func (v *AssignInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"LayerIds\":"...)
+	if v.LayerIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.LayerIDs {
+			b = aws.AppendJSONString(b, v.LayerIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AssignVolumeRequest is undocumented.
 type AssignVolumeRequest struct {
 	InstanceID aws.StringValue `json:"InstanceId,omitempty"`
 	VolumeID   aws.StringValue `json:"VolumeId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AssignVolumeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AssignVolumeRequest to b.
+func (v *AssignVolumeRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.InstanceID != nil {
+		b = append(b, "\"InstanceId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"VolumeId\":"...)
+	if v.VolumeID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AssociateElasticIPRequest is undocumented.
 type AssociateElasticIPRequest struct {
 	ElasticIP  aws.StringValue `json:"ElasticIp"`
 	InstanceID aws.StringValue `json:"InstanceId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AssociateElasticIPRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AssociateElasticIPRequest to b.
+func (v *AssociateElasticIPRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ElasticIp\":"...)
+	if v.ElasticIP == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ElasticIP)
+	}
+	b = append(b, ',')
+	if v.InstanceID != nil {
+		b = append(b, "\"InstanceId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AttachElasticLoadBalancerRequest is undocumented.
 type AttachElasticLoadBalancerRequest struct {
 	ElasticLoadBalancerName aws.StringValue `json:"ElasticLoadBalancerName"`
 	LayerID                 aws.StringValue `json:"LayerId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AttachElasticLoadBalancerRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AttachElasticLoadBalancerRequest to b.
+func (v *AttachElasticLoadBalancerRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ElasticLoadBalancerName\":"...)
+	if v.ElasticLoadBalancerName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ElasticLoadBalancerName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"LayerId\":"...)
+	if v.LayerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LayerID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AutoScalingThresholds is undocumented.
 type AutoScalingThresholds struct {
 	CPUThreshold       aws.DoubleValue  `json:"CpuThreshold,omitempty"`
@@ -867,6 +1505,65 @@ type AutoScalingThresholds struct {
 	ThresholdsWaitTime aws.IntegerValue `json:"ThresholdsWaitTime,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AutoScalingThresholds) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AutoScalingThresholds to b.
+func (v *AutoScalingThresholds) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CPUThreshold != nil {
+		b = append(b, "\"CpuThreshold\":"...)
+		b, err = aws.AppendJSONFloat(b, float64(*v.CPUThreshold), 64)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.IgnoreMetricsTime != nil {
+		b = append(b, "\"IgnoreMetricsTime\":"...)
+		b = strconv.AppendInt(b, int64(*v.IgnoreMetricsTime), 10)
+		b = append(b, ',')
+	}
+	if v.InstanceCount != nil {
+		b = append(b, "\"InstanceCount\":"...)
+		b = strconv.AppendInt(b, int64(*v.InstanceCount), 10)
+		b = append(b, ',')
+	}
+	if v.LoadThreshold != nil {
+		b = append(b, "\"LoadThreshold\":"...)
+		b, err = aws.AppendJSONFloat(b, float64(*v.LoadThreshold), 64)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.MemoryThreshold != nil {
+		b = append(b, "\"MemoryThreshold\":"...)
+		b, err = aws.AppendJSONFloat(b, float64(*v.MemoryThreshold), 64)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.ThresholdsWaitTime != nil {
+		b = append(b, "\"ThresholdsWaitTime\":"...)
+		b = strconv.AppendInt(b, int64(*v.ThresholdsWaitTime), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for OpsWorks.
 const (
 	AutoScalingTypeLoad  = "load"
@@ -879,6 +1576,36 @@ type ChefConfiguration struct {
 	ManageBerkshelf  aws.BooleanValue `json:"ManageBerkshelf,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ChefConfiguration) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ChefConfiguration to b.
+func (v *ChefConfiguration) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.BerkshelfVersion != nil {
+		b = append(b, "\"BerkshelfVersion\":"...)
+		b = aws.AppendJSONString(b, *v.BerkshelfVersion)
+		b = append(b, ',')
+	}
+	if v.ManageBerkshelf != nil {
+		b = append(b, "\"ManageBerkshelf\":"...)
+		b = strconv.AppendBool(b, *v.ManageBerkshelf)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CloneStackRequest is undocumented.
 type CloneStackRequest struct {
 	Attributes                map[string]string          `json:"Attributes,omitempty"`
@@ -904,6 +1631,170 @@ type CloneStackRequest struct {
 	VPCID                     aws.StringValue            `json:"VpcId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CloneStackRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CloneStackRequest to b.
+func (v *CloneStackRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Attributes) != 0 {
+		b = append(b, "\"Attributes\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Attributes))
+		for k := range v.Attributes {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Attributes[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.ChefConfiguration != nil {
+		b = append(b, "\"ChefConfiguration\":"...)
+		b, err = v.ChefConfiguration.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if len(v.CloneAppIDs) != 0 {
+		b = append(b, "\"CloneAppIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.CloneAppIDs {
+			b = aws.AppendJSONString(b, v.CloneAppIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ClonePermissions != nil {
+		b = append(b, "\"ClonePermissions\":"...)
+		b = strconv.AppendBool(b, *v.ClonePermissions)
+		b = append(b, ',')
+	}
+	if v.ConfigurationManager != nil {
+		b = append(b, "\"ConfigurationManager\":"...)
+		b, err = v.ConfigurationManager.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CustomCookbooksSource != nil {
+		b = append(b, "\"CustomCookbooksSource\":"...)
+		b, err = v.CustomCookbooksSource.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CustomJSON != nil {
+		b = append(b, "\"CustomJson\":"...)
+		b = aws.AppendJSONString(b, *v.CustomJSON)
+		b = append(b, ',')
+	}
+	if v.DefaultAvailabilityZone != nil {
+		b = append(b, "\"DefaultAvailabilityZone\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultAvailabilityZone)
+		b = append(b, ',')
+	}
+	if v.DefaultInstanceProfileARN != nil {
+		b = append(b, "\"DefaultInstanceProfileArn\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultInstanceProfileARN)
+		b = append(b, ',')
+	}
+	if v.DefaultOS != nil {
+		b = append(b, "\"DefaultOs\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultOS)
+		b = append(b, ',')
+	}
+	if v.DefaultRootDeviceType != nil {
+		b = append(b, "\"DefaultRootDeviceType\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultRootDeviceType)
+		b = append(b, ',')
+	}
+	if v.DefaultSSHKeyName != nil {
+		b = append(b, "\"DefaultSshKeyName\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultSSHKeyName)
+		b = append(b, ',')
+	}
+	if v.DefaultSubnetID != nil {
+		b = append(b, "\"DefaultSubnetId\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultSubnetID)
+		b = append(b, ',')
+	}
+	if v.HostnameTheme != nil {
+		b = append(b, "\"HostnameTheme\":"...)
+		b = aws.AppendJSONString(b, *v.HostnameTheme)
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.Region != nil {
+		b = append(b, "\"Region\":"...)
+		b = aws.AppendJSONString(b, *v.Region)
+		b = append(b, ',')
+	}
+	b = append(b, "\"ServiceRoleArn\":"...)
+	if v.ServiceRoleARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ServiceRoleARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"SourceStackId\":"...)
+	if v.SourceStackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.SourceStackID)
+	}
+	b = append(b, ',')
+	if v.UseCustomCookbooks != nil {
+		b = append(b, "\"UseCustomCookbooks\":"...)
+		b = strconv.AppendBool(b, *v.UseCustomCookbooks)
+		b = append(b, ',')
+	}
+	if v.UseOpsworksSecurityGroups != nil {
+		b = append(b, "\"UseOpsworksSecurityGroups\":"...)
+		b = strconv.AppendBool(b, *v.UseOpsworksSecurityGroups)
+		b = append(b, ',')
+	}
+	if v.VPCID != nil {
+		b = append(b, "\"VpcId\":"...)
+		b = aws.AppendJSONString(b, *v.VPCID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CloneStackResult is undocumented.
 type CloneStackResult struct {
 	StackID aws.StringValue `json:"StackId,omitempty"`
@@ -939,6 +1830,148 @@ type CreateAppRequest struct {
 	Type             aws.StringValue       `json:"Type"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateAppRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateAppRequest to b.
+func (v *CreateAppRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AppSource != nil {
+		b = append(b, "\"AppSource\":"...)
+		b, err = v.AppSource.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if len(v.Attributes) != 0 {
+		b = append(b, "\"Attributes\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Attributes))
+		for k := range v.Attributes {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Attributes[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.DataSources) != 0 {
+		b = append(b, "\"DataSources\":"...)
+		b = append(b, '[')
+		for i1 := range v.DataSources {
+			b, err = v.DataSources[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.Description != nil {
+		b = append(b, "\"Description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	if len(v.Domains) != 0 {
+		b = append(b, "\"Domains\":"...)
+		b = append(b, '[')
+		for i1 := range v.Domains {
+			b = aws.AppendJSONString(b, v.Domains[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.EnableSSL != nil {
+		b = append(b, "\"EnableSsl\":"...)
+		b = strconv.AppendBool(b, *v.EnableSSL)
+		b = append(b, ',')
+	}
+	if len(v.Environment) != 0 {
+		b = append(b, "\"Environment\":"...)
+		b = append(b, '[')
+		for i1 := range v.Environment {
+			b, err = v.Environment[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if v.Shortname != nil {
+		b = append(b, "\"Shortname\":"...)
+		b = aws.AppendJSONString(b, *v.Shortname)
+		b = append(b, ',')
+	}
+	if v.SSLConfiguration != nil {
+		b = append(b, "\"SslConfiguration\":"...)
+		b, err = v.SSLConfiguration.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Type\":"...)
+	if v.Type == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Type)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateAppResult is undocumented.
 type CreateAppResult struct {
 	AppID aws.StringValue `json:"AppId,omitempty"`
@@ -954,6 +1987,72 @@ type CreateDeploymentRequest struct {
 	StackID     aws.StringValue    `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateDeploymentRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateDeploymentRequest to b.
+func (v *CreateDeploymentRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AppID != nil {
+		b = append(b, "\"AppId\":"...)
+		b = aws.AppendJSONString(b, *v.AppID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Command\":"...)
+	if v.Command == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Command.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.Comment != nil {
+		b = append(b, "\"Comment\":"...)
+		b = aws.AppendJSONString(b, *v.Comment)
+		b = append(b, ',')
+	}
+	if v.CustomJSON != nil {
+		b = append(b, "\"CustomJson\":"...)
+		b = aws.AppendJSONString(b, *v.CustomJSON)
+		b = append(b, ',')
+	}
+	if len(v.InstanceIDs) != 0 {
+		b = append(b, "\"InstanceIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.InstanceIDs {
+			b = aws.AppendJSONString(b, v.InstanceIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateDeploymentResult is undocumented.
 type CreateDeploymentResult struct {
 	DeploymentID aws.StringValue `json:"DeploymentId,omitempty"`
@@ -978,21 +2077,131 @@ type CreateInstanceRequest struct {
 	VirtualizationType   aws.StringValue  `json:"VirtualizationType,omitempty"`
 }
 
-// CreateInstanceResult is undocumented.
-type CreateInstanceResult struct {
-	InstanceID aws.StringValue `json:"InstanceId,omitempty"`
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
 }
 
-// CreateLayerRequest is undocumented.
-type CreateLayerRequest struct {
-	Attributes                  map[string]string            `json:"Attributes,omitempty"`
-	AutoAssignElasticIPs        aws.BooleanValue             `json:"AutoAssignElasticIps,omitempty"`
-	AutoAssignPublicIPs         aws.BooleanValue             `json:"AutoAssignPublicIps,omitempty"`
-	CustomInstanceProfileARN    aws.StringValue              `json:"CustomInstanceProfileArn,omitempty"`
-	CustomRecipes               *Recipes                     `json:"CustomRecipes,omitempty"`
-	CustomSecurityGroupIDs      []string                     `json:"CustomSecurityGroupIds,omitempty"`
-	EnableAutoHealing           aws.BooleanValue             `json:"EnableAutoHealing,omitempty"`
-	InstallUpdatesOnBoot        aws.BooleanValue             `json:"InstallUpdatesOnBoot,omitempty"`
+// appendJSON appends the JSON encoding of the CreateInstanceRequest to b.
+func (v *CreateInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AMIID != nil {
+		b = append(b, "\"AmiId\":"...)
+		b = aws.AppendJSONString(b, *v.AMIID)
+		b = append(b, ',')
+	}
+	if v.Architecture != nil {
+		b = append(b, "\"Architecture\":"...)
+		b = aws.AppendJSONString(b, *v.Architecture)
+		b = append(b, ',')
+	}
+	if v.AutoScalingType != nil {
+		b = append(b, "\"AutoScalingType\":"...)
+		b = aws.AppendJSONString(b, *v.AutoScalingType)
+		b = append(b, ',')
+	}
+	if v.AvailabilityZone != nil {
+		b = append(b, "\"AvailabilityZone\":"...)
+		b = aws.AppendJSONString(b, *v.AvailabilityZone)
+		b = append(b, ',')
+	}
+	if v.EBSOptimized != nil {
+		b = append(b, "\"EbsOptimized\":"...)
+		b = strconv.AppendBool(b, *v.EBSOptimized)
+		b = append(b, ',')
+	}
+	if v.Hostname != nil {
+		b = append(b, "\"Hostname\":"...)
+		b = aws.AppendJSONString(b, *v.Hostname)
+		b = append(b, ',')
+	}
+	if v.InstallUpdatesOnBoot != nil {
+		b = append(b, "\"InstallUpdatesOnBoot\":"...)
+		b = strconv.AppendBool(b, *v.InstallUpdatesOnBoot)
+		b = append(b, ',')
+	}
+	b = append(b, "\"InstanceType\":"...)
+	if v.InstanceType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceType)
+	}
+	b = append(b, ',')
+	b = append(b, "\"LayerIds\":"...)
+	if v.LayerIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.LayerIDs {
+			b = aws.AppendJSONString(b, v.LayerIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if v.OS != nil {
+		b = append(b, "\"Os\":"...)
+		b = aws.AppendJSONString(b, *v.OS)
+		b = append(b, ',')
+	}
+	if v.RootDeviceType != nil {
+		b = append(b, "\"RootDeviceType\":"...)
+		b = aws.AppendJSONString(b, *v.RootDeviceType)
+		b = append(b, ',')
+	}
+	if v.SSHKeyName != nil {
+		b = append(b, "\"SshKeyName\":"...)
+		b = aws.AppendJSONString(b, *v.SSHKeyName)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if v.SubnetID != nil {
+		b = append(b, "\"SubnetId\":"...)
+		b = aws.AppendJSONString(b, *v.SubnetID)
+		b = append(b, ',')
+	}
+	if v.VirtualizationType != nil {
+		b = append(b, "\"VirtualizationType\":"...)
+		b = aws.AppendJSONString(b, *v.VirtualizationType)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
+// CreateInstanceResult is undocumented.
+type CreateInstanceResult struct {
+	InstanceID aws.StringValue `json:"InstanceId,omitempty"`
+}
+
+// CreateLayerRequest is undocumented.
+type CreateLayerRequest struct {
+	Attributes                  map[string]string            `json:"Attributes,omitempty"`
+	AutoAssignElasticIPs        aws.BooleanValue             `json:"AutoAssignElasticIps,omitempty"`
+	AutoAssignPublicIPs         aws.BooleanValue             `json:"AutoAssignPublicIps,omitempty"`
+	CustomInstanceProfileARN    aws.StringValue              `json:"CustomInstanceProfileArn,omitempty"`
+	CustomRecipes               *Recipes                     `json:"CustomRecipes,omitempty"`
+	CustomSecurityGroupIDs      []string                     `json:"CustomSecurityGroupIds,omitempty"`
+	EnableAutoHealing           aws.BooleanValue             `json:"EnableAutoHealing,omitempty"`
+	InstallUpdatesOnBoot        aws.BooleanValue             `json:"InstallUpdatesOnBoot,omitempty"`
 	LifecycleEventConfiguration *LifecycleEventConfiguration `json:"LifecycleEventConfiguration,omitempty"`
 	Name                        aws.StringValue              `json:"Name"`
 	Packages                    []string                     `json:"Packages,omitempty"`
@@ -1003,6 +2212,167 @@ type CreateLayerRequest struct {
 	VolumeConfigurations        []VolumeConfiguration        `json:"VolumeConfigurations,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateLayerRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateLayerRequest to b.
+func (v *CreateLayerRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Attributes) != 0 {
+		b = append(b, "\"Attributes\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Attributes))
+		for k := range v.Attributes {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Attributes[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.AutoAssignElasticIPs != nil {
+		b = append(b, "\"AutoAssignElasticIps\":"...)
+		b = strconv.AppendBool(b, *v.AutoAssignElasticIPs)
+		b = append(b, ',')
+	}
+	if v.AutoAssignPublicIPs != nil {
+		b = append(b, "\"AutoAssignPublicIps\":"...)
+		b = strconv.AppendBool(b, *v.AutoAssignPublicIPs)
+		b = append(b, ',')
+	}
+	if v.CustomInstanceProfileARN != nil {
+		b = append(b, "\"CustomInstanceProfileArn\":"...)
+		b = aws.AppendJSONString(b, *v.CustomInstanceProfileARN)
+		b = append(b, ',')
+	}
+	if v.CustomRecipes != nil {
+		b = append(b, "\"CustomRecipes\":"...)
+		b, err = v.CustomRecipes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if len(v.CustomSecurityGroupIDs) != 0 {
+		b = append(b, "\"CustomSecurityGroupIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.CustomSecurityGroupIDs {
+			b = aws.AppendJSONString(b, v.CustomSecurityGroupIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.EnableAutoHealing != nil {
+		b = append(b, "\"EnableAutoHealing\":"...)
+		b = strconv.AppendBool(b, *v.EnableAutoHealing)
+		b = append(b, ',')
+	}
+	if v.InstallUpdatesOnBoot != nil {
+		b = append(b, "\"InstallUpdatesOnBoot\":"...)
+		b = strconv.AppendBool(b, *v.InstallUpdatesOnBoot)
+		b = append(b, ',')
+	}
+	if v.LifecycleEventConfiguration != nil {
+		b = append(b, "\"LifecycleEventConfiguration\":"...)
+		b, err = v.LifecycleEventConfiguration.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if len(v.Packages) != 0 {
+		b = append(b, "\"Packages\":"...)
+		b = append(b, '[')
+		for i1 := range v.Packages {
+			b = aws.AppendJSONString(b, v.Packages[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Shortname\":"...)
+	if v.Shortname == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Shortname)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Type\":"...)
+	if v.Type == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Type)
+	}
+	b = append(b, ',')
+	if v.UseEBSOptimizedInstances != nil {
+		b = append(b, "\"UseEbsOptimizedInstances\":"...)
+		b = strconv.AppendBool(b, *v.UseEBSOptimizedInstances)
+		b = append(b, ',')
+	}
+	if len(v.VolumeConfigurations) != 0 {
+		b = append(b, "\"VolumeConfigurations\":"...)
+		b = append(b, '[')
+		for i1 := range v.VolumeConfigurations {
+			b, err = v.VolumeConfigurations[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateLayerResult is undocumented.
 type CreateLayerResult struct {
 	LayerID aws.StringValue `json:"LayerId,omitempty"`
@@ -1030,6 +2400,150 @@ type CreateStackRequest struct {
 	VPCID                     aws.StringValue            `json:"VpcId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateStackRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateStackRequest to b.
+func (v *CreateStackRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Attributes) != 0 {
+		b = append(b, "\"Attributes\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Attributes))
+		for k := range v.Attributes {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Attributes[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.ChefConfiguration != nil {
+		b = append(b, "\"ChefConfiguration\":"...)
+		b, err = v.ChefConfiguration.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.ConfigurationManager != nil {
+		b = append(b, "\"ConfigurationManager\":"...)
+		b, err = v.ConfigurationManager.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CustomCookbooksSource != nil {
+		b = append(b, "\"CustomCookbooksSource\":"...)
+		b, err = v.CustomCookbooksSource.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CustomJSON != nil {
+		b = append(b, "\"CustomJson\":"...)
+		b = aws.AppendJSONString(b, *v.CustomJSON)
+		b = append(b, ',')
+	}
+	if v.DefaultAvailabilityZone != nil {
+		b = append(b, "\"DefaultAvailabilityZone\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultAvailabilityZone)
+		b = append(b, ',')
+	}
+	b = append(b, "\"DefaultInstanceProfileArn\":"...)
+	if v.DefaultInstanceProfileARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DefaultInstanceProfileARN)
+	}
+	b = append(b, ',')
+	if v.DefaultOS != nil {
+		b = append(b, "\"DefaultOs\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultOS)
+		b = append(b, ',')
+	}
+	if v.DefaultRootDeviceType != nil {
+		b = append(b, "\"DefaultRootDeviceType\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultRootDeviceType)
+		b = append(b, ',')
+	}
+	if v.DefaultSSHKeyName != nil {
+		b = append(b, "\"DefaultSshKeyName\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultSSHKeyName)
+		b = append(b, ',')
+	}
+	if v.DefaultSubnetID != nil {
+		b = append(b, "\"DefaultSubnetId\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultSubnetID)
+		b = append(b, ',')
+	}
+	if v.HostnameTheme != nil {
+		b = append(b, "\"HostnameTheme\":"...)
+		b = aws.AppendJSONString(b, *v.HostnameTheme)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Region\":"...)
+	if v.Region == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Region)
+	}
+	b = append(b, ',')
+	b = append(b, "\"ServiceRoleArn\":"...)
+	if v.ServiceRoleARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ServiceRoleARN)
+	}
+	b = append(b, ',')
+	if v.UseCustomCookbooks != nil {
+		b = append(b, "\"UseCustomCookbooks\":"...)
+		b = strconv.AppendBool(b, *v.UseCustomCookbooks)
+		b = append(b, ',')
+	}
+	if v.UseOpsworksSecurityGroups != nil {
+		b = append(b, "\"UseOpsworksSecurityGroups\":"...)
+		b = strconv.AppendBool(b, *v.UseOpsworksSecurityGroups)
+		b = append(b, ',')
+	}
+	if v.VPCID != nil {
+		b = append(b, "\"VpcId\":"...)
+		b = aws.AppendJSONString(b, *v.VPCID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateStackResult is undocumented.
 type CreateStackResult struct {
 	StackID aws.StringValue `json:"StackId,omitempty"`
@@ -1043,6 +2557,48 @@ type CreateUserProfileRequest struct {
 	SSHUsername         aws.StringValue  `json:"SshUsername,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateUserProfileRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateUserProfileRequest to b.
+func (v *CreateUserProfileRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AllowSelfManagement != nil {
+		b = append(b, "\"AllowSelfManagement\":"...)
+		b = strconv.AppendBool(b, *v.AllowSelfManagement)
+		b = append(b, ',')
+	}
+	b = append(b, "\"IamUserArn\":"...)
+	if v.IAMUserARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IAMUserARN)
+	}
+	b = append(b, ',')
+	if v.SSHPublicKey != nil {
+		b = append(b, "\"SshPublicKey\":"...)
+		b = aws.AppendJSONString(b, *v.SSHPublicKey)
+		b = append(b, ',')
+	}
+	if v.SSHUsername != nil {
+		b = append(b, "\"SshUsername\":"...)
+		b = aws.AppendJSONString(b, *v.SSHUsername)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateUserProfileResult is undocumented.
 type CreateUserProfileResult struct {
 	IAMUserARN aws.StringValue `json:"IamUserArn,omitempty"`
@@ -1055,11 +2611,73 @@ type DataSource struct {
 	Type         aws.StringValue `json:"Type,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DataSource) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DataSource to b.
+func (v *DataSource) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ARN != nil {
+		b = append(b, "\"Arn\":"...)
+		b = aws.AppendJSONString(b, *v.ARN)
+		b = append(b, ',')
+	}
+	if v.DatabaseName != nil {
+		b = append(b, "\"DatabaseName\":"...)
+		b = aws.AppendJSONString(b, *v.DatabaseName)
+		b = append(b, ',')
+	}
+	if v.Type != nil {
+		b = append(b, "\"Type\":"...)
+		b = aws.AppendJSONString(b, *v.Type)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteAppRequest is undocumented.
 type DeleteAppRequest struct {
 	AppID aws.StringValue `json:"AppId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteAppRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteAppRequest to b.
+func (v *DeleteAppRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AppId\":"...)
+	if v.AppID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AppID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteInstanceRequest is undocumented.
 type DeleteInstanceRequest struct {
 	DeleteElasticIP aws.BooleanValue `json:"DeleteElasticIp,omitempty"`
@@ -1067,21 +2685,139 @@ type DeleteInstanceRequest struct {
 	InstanceID      aws.StringValue  `json:"InstanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteInstanceRequest to b.
+func (v *DeleteInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DeleteElasticIP != nil {
+		b = append(b, "\"DeleteElasticIp\":"...)
+		b = strconv.AppendBool(b, *v.DeleteElasticIP)
+		b = append(b, ',')
+	}
+	if v.DeleteVolumes != nil {
+		b = append(b, "\"DeleteVolumes\":"...)
+		b = strconv.AppendBool(b, *v.DeleteVolumes)
+		b = append(b, ',')
+	}
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteLayerRequest is undocumented.
 type DeleteLayerRequest struct {
 	LayerID aws.StringValue `json:"LayerId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteLayerRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteLayerRequest to b.
+func (v *DeleteLayerRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"LayerId\":"...)
+	if v.LayerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LayerID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteStackRequest is undocumented.
 type DeleteStackRequest struct {
 	StackID aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteStackRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteStackRequest to b.
+func (v *DeleteStackRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteUserProfileRequest is undocumented.
 type DeleteUserProfileRequest struct {
 	IAMUserARN aws.StringValue `json:"IamUserArn"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteUserProfileRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteUserProfileRequest to b.
+func (v *DeleteUserProfileRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"IamUserArn\":"...)
+	if v.IAMUserARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IAMUserARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Deployment is undocumented.
 type Deployment struct {
 	AppID        aws.StringValue    `json:"AppId,omitempty"`
@@ -1104,6 +2840,68 @@ type DeploymentCommand struct {
 	Name aws.StringValue     `json:"Name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeploymentCommand) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeploymentCommand to b.
+func (v *DeploymentCommand) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Args) != 0 {
+		b = append(b, "\"Args\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Args))
+		for k := range v.Args {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Args[k1]
+			if v1 == nil {
+				b = append(b, "null"...)
+			} else {
+				b = append(b, '[')
+				for i2 := range v1 {
+					b = aws.AppendJSONString(b, v1[i2])
+					b = append(b, ',')
+				}
+				if b[len(b)-1] == ',' {
+					b[len(b)-1] = ']'
+				} else {
+					b = append(b, ']')
+				}
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for OpsWorks.
 const (
 	DeploymentCommandNameDeploy                = "deploy"
@@ -1123,27 +2921,174 @@ type DeregisterElasticIPRequest struct {
 	ElasticIP aws.StringValue `json:"ElasticIp"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeregisterElasticIPRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeregisterElasticIPRequest to b.
+func (v *DeregisterElasticIPRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ElasticIp\":"...)
+	if v.ElasticIP == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ElasticIP)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeregisterInstanceRequest is undocumented.
 type DeregisterInstanceRequest struct {
 	InstanceID aws.StringValue `json:"InstanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeregisterInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeregisterInstanceRequest to b.
+func (v *DeregisterInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeregisterRDSDBInstanceRequest is undocumented.
 type DeregisterRDSDBInstanceRequest struct {
 	RDSDBInstanceARN aws.StringValue `json:"RdsDbInstanceArn"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeregisterRDSDBInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeregisterRDSDBInstanceRequest to b.
+func (v *DeregisterRDSDBInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"RdsDbInstanceArn\":"...)
+	if v.RDSDBInstanceARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.RDSDBInstanceARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeregisterVolumeRequest is undocumented.
 type DeregisterVolumeRequest struct {
 	VolumeID aws.StringValue `json:"VolumeId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeregisterVolumeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeregisterVolumeRequest to b.
+func (v *DeregisterVolumeRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"VolumeId\":"...)
+	if v.VolumeID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeAppsRequest is undocumented.
 type DescribeAppsRequest struct {
 	AppIDs  []string        `json:"AppIds,omitempty"`
 	StackID aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeAppsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeAppsRequest to b.
+func (v *DescribeAppsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.AppIDs) != 0 {
+		b = append(b, "\"AppIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.AppIDs {
+			b = aws.AppendJSONString(b, v.AppIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeAppsResult is undocumented.
 type DescribeAppsResult struct {
 	Apps []App `json:"Apps,omitempty"`
@@ -1156,6 +3101,50 @@ type DescribeCommandsRequest struct {
 	InstanceID   aws.StringValue `json:"InstanceId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeCommandsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeCommandsRequest to b.
+func (v *DescribeCommandsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.CommandIDs) != 0 {
+		b = append(b, "\"CommandIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.CommandIDs {
+			b = aws.AppendJSONString(b, v.CommandIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.DeploymentID != nil {
+		b = append(b, "\"DeploymentId\":"...)
+		b = aws.AppendJSONString(b, *v.DeploymentID)
+		b = append(b, ',')
+	}
+	if v.InstanceID != nil {
+		b = append(b, "\"InstanceId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeCommandsResult is undocumented.
 type DescribeCommandsResult struct {
 	Commands []Command `json:"Commands,omitempty"`
@@ -1168,6 +3157,50 @@ type DescribeDeploymentsRequest struct {
 	StackID       aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeDeploymentsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeDeploymentsRequest to b.
+func (v *DescribeDeploymentsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AppID != nil {
+		b = append(b, "\"AppId\":"...)
+		b = aws.AppendJSONString(b, *v.AppID)
+		b = append(b, ',')
+	}
+	if len(v.DeploymentIDs) != 0 {
+		b = append(b, "\"DeploymentIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.DeploymentIDs {
+			b = aws.AppendJSONString(b, v.DeploymentIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeDeploymentsResult is undocumented.
 type DescribeDeploymentsResult struct {
 	Deployments []Deployment `json:"Deployments,omitempty"`
@@ -1180,6 +3213,50 @@ type DescribeElasticIPsRequest struct {
 	StackID    aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeElasticIPsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeElasticIPsRequest to b.
+func (v *DescribeElasticIPsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.InstanceID != nil {
+		b = append(b, "\"InstanceId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceID)
+		b = append(b, ',')
+	}
+	if len(v.IPs) != 0 {
+		b = append(b, "\"Ips\":"...)
+		b = append(b, '[')
+		for i1 := range v.IPs {
+			b = aws.AppendJSONString(b, v.IPs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeElasticIPsResult is undocumented.
 type DescribeElasticIPsResult struct {
 	ElasticIPs []ElasticIP `json:"ElasticIps,omitempty"`
@@ -1191,6 +3268,45 @@ type DescribeElasticLoadBalancersRequest struct {
 	StackID  aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeElasticLoadBalancersRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeElasticLoadBalancersRequest to b.
+func (v *DescribeElasticLoadBalancersRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.LayerIDs) != 0 {
+		b = append(b, "\"LayerIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.LayerIDs {
+			b = aws.AppendJSONString(b, v.LayerIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeElasticLoadBalancersResult is undocumented.
 type DescribeElasticLoadBalancersResult struct {
 	ElasticLoadBalancers []ElasticLoadBalancer `json:"ElasticLoadBalancers,omitempty"`
@@ -1203,6 +3319,50 @@ type DescribeInstancesRequest struct {
 	StackID     aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeInstancesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeInstancesRequest to b.
+func (v *DescribeInstancesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.InstanceIDs) != 0 {
+		b = append(b, "\"InstanceIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.InstanceIDs {
+			b = aws.AppendJSONString(b, v.InstanceIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.LayerID != nil {
+		b = append(b, "\"LayerId\":"...)
+		b = aws.AppendJSONString(b, *v.LayerID)
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeInstancesResult is undocumented.
 type DescribeInstancesResult struct {
 	Instances []Instance `json:"Instances,omitempty"`
@@ -1214,6 +3374,45 @@ type DescribeLayersRequest struct {
 	StackID  aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeLayersRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeLayersRequest to b.
+func (v *DescribeLayersRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.LayerIDs) != 0 {
+		b = append(b, "\"LayerIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.LayerIDs {
+			b = aws.AppendJSONString(b, v.LayerIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeLayersResult is undocumented.
 type DescribeLayersResult struct {
 	Layers []Layer `json:"Layers,omitempty"`
@@ -1224,6 +3423,42 @@ type DescribeLoadBasedAutoScalingRequest struct {
 	LayerIDs []string `json:"LayerIds"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeLoadBasedAutoScalingRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeLoadBasedAutoScalingRequest to b.
+func (v *DescribeLoadBasedAutoScalingRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"LayerIds\":"...)
+	if v.LayerIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.LayerIDs {
+			b = aws.AppendJSONString(b, v.LayerIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeLoadBasedAutoScalingResult is undocumented.
 type DescribeLoadBasedAutoScalingResult struct {
 	LoadBasedAutoScalingConfigurations []LoadBasedAutoScalingConfiguration `json:"LoadBasedAutoScalingConfigurations,omitempty"`
@@ -1240,6 +3475,36 @@ type DescribePermissionsRequest struct {
 	StackID    aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribePermissionsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribePermissionsRequest to b.
+func (v *DescribePermissionsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.IAMUserARN != nil {
+		b = append(b, "\"IamUserArn\":"...)
+		b = aws.AppendJSONString(b, *v.IAMUserARN)
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribePermissionsResult is undocumented.
 type DescribePermissionsResult struct {
 	Permissions []Permission `json:"Permissions,omitempty"`
@@ -1252,6 +3517,50 @@ type DescribeRAIDArraysRequest struct {
 	StackID      aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeRAIDArraysRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeRAIDArraysRequest to b.
+func (v *DescribeRAIDArraysRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.InstanceID != nil {
+		b = append(b, "\"InstanceId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceID)
+		b = append(b, ',')
+	}
+	if len(v.RAIDArrayIDs) != 0 {
+		b = append(b, "\"RaidArrayIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.RAIDArrayIDs {
+			b = aws.AppendJSONString(b, v.RAIDArrayIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeRAIDArraysResult is undocumented.
 type DescribeRAIDArraysResult struct {
 	RAIDArrays []RAIDArray `json:"RaidArrays,omitempty"`
@@ -1263,6 +3572,47 @@ type DescribeRDSDBInstancesRequest struct {
 	StackID           aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeRDSDBInstancesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeRDSDBInstancesRequest to b.
+func (v *DescribeRDSDBInstancesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.RDSDBInstanceARNs) != 0 {
+		b = append(b, "\"RdsDbInstanceArns\":"...)
+		b = append(b, '[')
+		for i1 := range v.RDSDBInstanceARNs {
+			b = aws.AppendJSONString(b, v.RDSDBInstanceARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeRDSDBInstancesResult is undocumented.
 type DescribeRDSDBInstancesResult struct {
 	RDSDBInstances []RDSDBInstance `json:"RdsDbInstances,omitempty"`
@@ -1275,6 +3625,50 @@ type DescribeServiceErrorsRequest struct {
 	StackID         aws.StringValue `json:"StackId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeServiceErrorsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeServiceErrorsRequest to b.
+func (v *DescribeServiceErrorsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.InstanceID != nil {
+		b = append(b, "\"InstanceId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceID)
+		b = append(b, ',')
+	}
+	if len(v.ServiceErrorIDs) != 0 {
+		b = append(b, "\"ServiceErrorIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.ServiceErrorIDs {
+			b = aws.AppendJSONString(b, v.ServiceErrorIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeServiceErrorsResult is undocumented.
 type DescribeServiceErrorsResult struct {
 	ServiceErrors []ServiceError `json:"ServiceErrors,omitempty"`
@@ -1285,6 +3679,33 @@ type DescribeStackProvisioningParametersRequest struct {
 	StackID aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeStackProvisioningParametersRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeStackProvisioningParametersRequest to b.
+func (v *DescribeStackProvisioningParametersRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeStackProvisioningParametersResult is undocumented.
 type DescribeStackProvisioningParametersResult struct {
 	AgentInstallerURL aws.StringValue   `json:"AgentInstallerUrl,omitempty"`
@@ -1296,6 +3717,33 @@ type DescribeStackSummaryRequest struct {
 	StackID aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeStackSummaryRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeStackSummaryRequest to b.
+func (v *DescribeStackSummaryRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeStackSummaryResult is undocumented.
 type DescribeStackSummaryResult struct {
 	StackSummary *StackSummary `json:"StackSummary,omitempty"`
@@ -1306,6 +3754,40 @@ type DescribeStacksRequest struct {
 	StackIDs []string `json:"StackIds,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeStacksRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeStacksRequest to b.
+func (v *DescribeStacksRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.StackIDs) != 0 {
+		b = append(b, "\"StackIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.StackIDs {
+			b = aws.AppendJSONString(b, v.StackIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeStacksResult is undocumented.
 type DescribeStacksResult struct {
 	Stacks []Stack `json:"Stacks,omitempty"`
@@ -1316,6 +3798,42 @@ type DescribeTimeBasedAutoScalingRequest struct {
 	InstanceIDs []string `json:"InstanceIds"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTimeBasedAutoScalingRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTimeBasedAutoScalingRequest to b.
+func (v *DescribeTimeBasedAutoScalingRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceIds\":"...)
+	if v.InstanceIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.InstanceIDs {
+			b = aws.AppendJSONString(b, v.InstanceIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTimeBasedAutoScalingResult is undocumented.
 type DescribeTimeBasedAutoScalingResult struct {
 	TimeBasedAutoScalingConfigurations []TimeBasedAutoScalingConfiguration `json:"TimeBasedAutoScalingConfigurations,omitempty"`
@@ -1326,6 +3844,40 @@ type DescribeUserProfilesRequest struct {
 	IAMUserARNs []string `json:"IamUserArns,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeUserProfilesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeUserProfilesRequest to b.
+func (v *DescribeUserProfilesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.IAMUserARNs) != 0 {
+		b = append(b, "\"IamUserArns\":"...)
+		b = append(b, '[')
+		for i1 := range v.IAMUserARNs {
+			b = aws.AppendJSONString(b, v.IAMUserARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeUserProfilesResult is undocumented.
 type DescribeUserProfilesResult struct {
 	UserProfiles []UserProfile `json:"UserProfiles,omitempty"`
@@ -1339,6 +3891,55 @@ type DescribeVolumesRequest struct {
 	VolumeIDs   []string        `json:"VolumeIds,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeVolumesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeVolumesRequest to b.
+func (v *DescribeVolumesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.InstanceID != nil {
+		b = append(b, "\"InstanceId\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceID)
+		b = append(b, ',')
+	}
+	if v.RAIDArrayID != nil {
+		b = append(b, "\"RaidArrayId\":"...)
+		b = aws.AppendJSONString(b, *v.RAIDArrayID)
+		b = append(b, ',')
+	}
+	if v.StackID != nil {
+		b = append(b, "\"StackId\":"...)
+		b = aws.AppendJSONString(b, *v.StackID)
+		b = append(b, ',')
+	}
+	if len(v.VolumeIDs) != 0 {
+		b = append(b, "\"VolumeIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.VolumeIDs {
+			b = aws.AppendJSONString(b, v.VolumeIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeVolumesResult is undocumented.
 type DescribeVolumesResult struct {
 	Volumes []Volume `json:"Volumes,omitempty"`
@@ -1350,11 +3951,72 @@ type DetachElasticLoadBalancerRequest struct {
 	LayerID                 aws.StringValue `json:"LayerId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DetachElasticLoadBalancerRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DetachElasticLoadBalancerRequest to b.
+func (v *DetachElasticLoadBalancerRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ElasticLoadBalancerName\":"...)
+	if v.ElasticLoadBalancerName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ElasticLoadBalancerName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"LayerId\":"...)
+	if v.LayerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LayerID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DisassociateElasticIPRequest is undocumented.
 type DisassociateElasticIPRequest struct {
 	ElasticIP aws.StringValue `json:"ElasticIp"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DisassociateElasticIPRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DisassociateElasticIPRequest to b.
+func (v *DisassociateElasticIPRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ElasticIp\":"...)
+	if v.ElasticIP == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ElasticIP)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ElasticIP is undocumented.
 type ElasticIP struct {
 	Domain     aws.StringValue `json:"Domain,omitempty"`
@@ -1384,11 +4046,77 @@ type EnvironmentVariable struct {
 	Value  aws.StringValue  `json:"Value"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EnvironmentVariable) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EnvironmentVariable to b.
+func (v *EnvironmentVariable) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Key\":"...)
+	if v.Key == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Key)
+	}
+	b = append(b, ',')
+	if v.Secure != nil {
+		b = append(b, "\"Secure\":"...)
+		b = strconv.AppendBool(b, *v.Secure)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Value\":"...)
+	if v.Value == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Value)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetHostnameSuggestionRequest is undocumented.
 type GetHostnameSuggestionRequest struct {
 	LayerID aws.StringValue `json:"LayerId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetHostnameSuggestionRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetHostnameSuggestionRequest to b.
+func (v *GetHostnameSuggestionRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"LayerId\":"...)
+	if v.LayerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LayerID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetHostnameSuggestionResult is undocumented.
 type GetHostnameSuggestionResult struct {
 	Hostname aws.StringValue `json:"Hostname,omitempty"`
@@ -1438,6 +4166,36 @@ type InstanceIdentity struct {
 	Signature aws.StringValue `json:"Signature,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *InstanceIdentity) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the InstanceIdentity to b.
+func (v *InstanceIdentity) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Document != nil {
+		b = append(b, "\"Document\":"...)
+		b = aws.AppendJSONString(b, *v.Document)
+		b = append(b, ',')
+	}
+	if v.Signature != nil {
+		b = append(b, "\"Signature\":"...)
+		b = aws.AppendJSONString(b, *v.Signature)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // InstancesCount is undocumented.
 type InstancesCount struct {
 	Assigning      aws.IntegerValue `json:"Assigning,omitempty"`
@@ -1532,6 +4290,34 @@ type LifecycleEventConfiguration struct {
 	Shutdown *ShutdownEventConfiguration `json:"Shutdown,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *LifecycleEventConfiguration) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the LifecycleEventConfiguration to b.
+func (v *LifecycleEventConfiguration) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Shutdown != nil {
+		b = append(b, "\"Shutdown\":"...)
+		b, err = v.Shutdown.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // LoadBasedAutoScalingConfiguration is undocumented.
 type LoadBasedAutoScalingConfiguration struct {
 	DownScaling *AutoScalingThresholds `json:"DownScaling,omitempty"`
@@ -1584,6 +4370,33 @@ type RebootInstanceRequest struct {
 	InstanceID aws.StringValue `json:"InstanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RebootInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RebootInstanceRequest to b.
+func (v *RebootInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Recipes is undocumented.
 type Recipes struct {
 	Configure []string `json:"Configure,omitempty"`
@@ -1593,12 +4406,136 @@ type Recipes struct {
 	Undeploy  []string `json:"Undeploy,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Recipes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Recipes to b.
+func (v *Recipes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Configure) != 0 {
+		b = append(b, "\"Configure\":"...)
+		b = append(b, '[')
+		for i1 := range v.Configure {
+			b = aws.AppendJSONString(b, v.Configure[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Deploy) != 0 {
+		b = append(b, "\"Deploy\":"...)
+		b = append(b, '[')
+		for i1 := range v.Deploy {
+			b = aws.AppendJSONString(b, v.Deploy[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Setup) != 0 {
+		b = append(b, "\"Setup\":"...)
+		b = append(b, '[')
+		for i1 := range v.Setup {
+			b = aws.AppendJSONString(b, v.Setup[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Shutdown) != 0 {
+		b = append(b, "\"Shutdown\":"...)
+		b = append(b, '[')
+		for i1 := range v.Shutdown {
+			b = aws.AppendJSONString(b, v.Shutdown[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Undeploy) != 0 {
+		b = append(b, "\"Undeploy\":"...)
+		b = append(b, '[')
+		for i1 := range v.Undeploy {
+			b = aws.AppendJSONString(b, v.Undeploy[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RegisterElasticIPRequest is undocumented.
 type RegisterElasticIPRequest struct {
 	ElasticIP aws.StringValue `json:"ElasticIp"`
 	StackID   aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterElasticIPRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterElasticIPRequest to b.
+func (v *RegisterElasticIPRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ElasticIp\":"...)
+	if v.ElasticIP == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ElasticIP)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RegisterElasticIPResult is undocumented.
 type RegisterElasticIPResult struct {
 	ElasticIP aws.StringValue `json:"ElasticIp,omitempty"`
@@ -1615,6 +4552,66 @@ type RegisterInstanceRequest struct {
 	StackID                 aws.StringValue   `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterInstanceRequest to b.
+func (v *RegisterInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Hostname != nil {
+		b = append(b, "\"Hostname\":"...)
+		b = aws.AppendJSONString(b, *v.Hostname)
+		b = append(b, ',')
+	}
+	if v.InstanceIdentity != nil {
+		b = append(b, "\"InstanceIdentity\":"...)
+		b, err = v.InstanceIdentity.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.PrivateIP != nil {
+		b = append(b, "\"PrivateIp\":"...)
+		b = aws.AppendJSONString(b, *v.PrivateIP)
+		b = append(b, ',')
+	}
+	if v.PublicIP != nil {
+		b = append(b, "\"PublicIp\":"...)
+		b = aws.AppendJSONString(b, *v.PublicIP)
+		b = append(b, ',')
+	}
+	if v.RsaPublicKey != nil {
+		b = append(b, "\"RsaPublicKey\":"...)
+		b = aws.AppendJSONString(b, *v.RsaPublicKey)
+		b = append(b, ',')
+	}
+	if v.RsaPublicKeyFingerprint != nil {
+		b = append(b, "\"RsaPublicKeyFingerprint\":"...)
+		b = aws.AppendJSONString(b, *v.RsaPublicKeyFingerprint)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RegisterInstanceResult is undocumented.
 type RegisterInstanceResult struct {
 	InstanceID aws.StringValue `json:"InstanceId,omitempty"`
@@ -1628,12 +4625,92 @@ type RegisterRDSDBInstanceRequest struct {
 	StackID          aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterRDSDBInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterRDSDBInstanceRequest to b.
+func (v *RegisterRDSDBInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DbPassword\":"...)
+	if v.DBPassword == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DBPassword)
+	}
+	b = append(b, ',')
+	b = append(b, "\"DbUser\":"...)
+	if v.DBUser == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DBUser)
+	}
+	b = append(b, ',')
+	b = append(b, "\"RdsDbInstanceArn\":"...)
+	if v.RDSDBInstanceARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.RDSDBInstanceARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RegisterVolumeRequest is undocumented.
 type RegisterVolumeRequest struct {
 	EC2VolumeID aws.StringValue `json:"Ec2VolumeId,omitempty"`
 	StackID     aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterVolumeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterVolumeRequest to b.
+func (v *RegisterVolumeRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.EC2VolumeID != nil {
+		b = append(b, "\"Ec2VolumeId\":"...)
+		b = aws.AppendJSONString(b, *v.EC2VolumeID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RegisterVolumeResult is undocumented.
 type RegisterVolumeResult struct {
 	VolumeID aws.StringValue `json:"VolumeId,omitempty"`
@@ -1678,6 +4755,54 @@ type SetLoadBasedAutoScalingRequest struct {
 	UpScaling   *AutoScalingThresholds `json:"UpScaling,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SetLoadBasedAutoScalingRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SetLoadBasedAutoScalingRequest to b.
+func (v *SetLoadBasedAutoScalingRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DownScaling != nil {
+		b = append(b, "\"DownScaling\":"...)
+		b, err = v.DownScaling.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.Enable != nil {
+		b = append(b, "\"Enable\":"...)
+		b = strconv.AppendBool(b, *v.Enable)
+		b = append(b, ',')
+	}
+	b = append(b, "\"LayerId\":"...)
+	if v.LayerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LayerID)
+	}
+	b = append(b, ',')
+	if v.UpScaling != nil {
+		b = append(b, "\"UpScaling\":"...)
+		b, err = v.UpScaling.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SetPermissionRequest is undocumented.
 type SetPermissionRequest struct {
 	AllowSSH   aws.BooleanValue `json:"AllowSsh,omitempty"`
@@ -1687,18 +4812,132 @@ type SetPermissionRequest struct {
 	StackID    aws.StringValue  `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SetPermissionRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SetPermissionRequest to b.
+func (v *SetPermissionRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AllowSSH != nil {
+		b = append(b, "\"AllowSsh\":"...)
+		b = strconv.AppendBool(b, *v.AllowSSH)
+		b = append(b, ',')
+	}
+	if v.AllowSudo != nil {
+		b = append(b, "\"AllowSudo\":"...)
+		b = strconv.AppendBool(b, *v.AllowSudo)
+		b = append(b, ',')
+	}
+	b = append(b, "\"IamUserArn\":"...)
+	if v.IAMUserARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IAMUserARN)
+	}
+	b = append(b, ',')
+	if v.Level != nil {
+		b = append(b, "\"Level\":"...)
+		b = aws.AppendJSONString(b, *v.Level)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // SetTimeBasedAutoScalingRequest is undocumented.
 type SetTimeBasedAutoScalingRequest struct {
 	AutoScalingSchedule *WeeklyAutoScalingSchedule `json:"AutoScalingSchedule,omitempty"`
 	InstanceID          aws.StringValue            `json:"InstanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SetTimeBasedAutoScalingRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SetTimeBasedAutoScalingRequest to b.
+func (v *SetTimeBasedAutoScalingRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AutoScalingSchedule != nil {
+		b = append(b, "\"AutoScalingSchedule\":"...)
+		b, err = v.AutoScalingSchedule.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ShutdownEventConfiguration is undocumented.
 type ShutdownEventConfiguration struct {
 	DelayUntilElbConnectionsDrained aws.BooleanValue `json:"DelayUntilElbConnectionsDrained,omitempty"`
 	ExecutionTimeout                aws.IntegerValue `json:"ExecutionTimeout,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ShutdownEventConfiguration) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ShutdownEventConfiguration to b.
+func (v *ShutdownEventConfiguration) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DelayUntilElbConnectionsDrained != nil {
+		b = append(b, "\"DelayUntilElbConnectionsDrained\":"...)
+		b = strconv.AppendBool(b, *v.DelayUntilElbConnectionsDrained)
+		b = append(b, ',')
+	}
+	if v.ExecutionTimeout != nil {
+		b = append(b, "\"ExecutionTimeout\":"...)
+		b = strconv.AppendInt(b, int64(*v.ExecutionTimeout), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Source is undocumented.
 type Source struct {
 	Password aws.StringValue `json:"Password,omitempty"`
@@ -1709,6 +4948,56 @@ type Source struct {
 	Username aws.StringValue `json:"Username,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Source) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Source to b.
+func (v *Source) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Password != nil {
+		b = append(b, "\"Password\":"...)
+		b = aws.AppendJSONString(b, *v.Password)
+		b = append(b, ',')
+	}
+	if v.Revision != nil {
+		b = append(b, "\"Revision\":"...)
+		b = aws.AppendJSONString(b, *v.Revision)
+		b = append(b, ',')
+	}
+	if v.SSHKey != nil {
+		b = append(b, "\"SshKey\":"...)
+		b = aws.AppendJSONString(b, *v.SSHKey)
+		b = append(b, ',')
+	}
+	if v.Type != nil {
+		b = append(b, "\"Type\":"...)
+		b = aws.AppendJSONString(b, *v.Type)
+		b = append(b, ',')
+	}
+	if v.URL != nil {
+		b = append(b, "\"Url\":"...)
+		b = aws.AppendJSONString(b, *v.URL)
+		b = append(b, ',')
+	}
+	if v.Username != nil {
+		b = append(b, "\"Username\":"...)
+		b = aws.AppendJSONString(b, *v.Username)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for OpsWorks.
 const (
 	SourceTypeArchive = "archive"
@@ -1724,6 +5013,45 @@ type SSLConfiguration struct {
 	PrivateKey  aws.StringValue `json:"PrivateKey"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SSLConfiguration) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SSLConfiguration to b.
+func (v *SSLConfiguration) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Certificate\":"...)
+	if v.Certificate == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Certificate)
+	}
+	b = append(b, ',')
+	if v.Chain != nil {
+		b = append(b, "\"Chain\":"...)
+		b = aws.AppendJSONString(b, *v.Chain)
+		b = append(b, ',')
+	}
+	b = append(b, "\"PrivateKey\":"...)
+	if v.PrivateKey == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.PrivateKey)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Stack is undocumented.
 type Stack struct {
 	ARN                       aws.StringValue            `json:"Arn,omitempty"`
@@ -1760,6 +5088,36 @@ type StackConfigurationManager struct {
 	Version aws.StringValue `json:"Version,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StackConfigurationManager) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StackConfigurationManager to b.
+func (v *StackConfigurationManager) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.Version != nil {
+		b = append(b, "\"Version\":"...)
+		b = aws.AppendJSONString(b, *v.Version)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StackSummary is undocumented.
 type StackSummary struct {
 	AppsCount      aws.IntegerValue `json:"AppsCount,omitempty"`
@@ -1775,21 +5133,129 @@ type StartInstanceRequest struct {
 	InstanceID aws.StringValue `json:"InstanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartInstanceRequest to b.
+func (v *StartInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StartStackRequest is undocumented.
 type StartStackRequest struct {
 	StackID aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartStackRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartStackRequest to b.
+func (v *StartStackRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StopInstanceRequest is undocumented.
 type StopInstanceRequest struct {
 	InstanceID aws.StringValue `json:"InstanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StopInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StopInstanceRequest to b.
+func (v *StopInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StopStackRequest is undocumented.
 type StopStackRequest struct {
 	StackID aws.StringValue `json:"StackId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StopStackRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StopStackRequest to b.
+func (v *StopStackRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TimeBasedAutoScalingConfiguration is undocumented.
 type TimeBasedAutoScalingConfiguration struct {
 	AutoScalingSchedule *WeeklyAutoScalingSchedule `json:"AutoScalingSchedule,omitempty"`
@@ -1801,11 +5267,65 @@ type UnassignInstanceRequest struct {
 	InstanceID aws.StringValue `json:"InstanceId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UnassignInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UnassignInstanceRequest to b.
+func (v *UnassignInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UnassignVolumeRequest is undocumented.
 type UnassignVolumeRequest struct {
 	VolumeID aws.StringValue `json:"VolumeId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UnassignVolumeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UnassignVolumeRequest to b.
+func (v *UnassignVolumeRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"VolumeId\":"...)
+	if v.VolumeID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateAppRequest is undocumented.
 type UpdateAppRequest struct {
 	AppID            aws.StringValue       `json:"AppId"`
@@ -1821,12 +5341,177 @@ type UpdateAppRequest struct {
 	Type             aws.StringValue       `json:"Type,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateAppRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateAppRequest to b.
+func (v *UpdateAppRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AppId\":"...)
+	if v.AppID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AppID)
+	}
+	b = append(b, ',')
+	if v.AppSource != nil {
+		b = append(b, "\"AppSource\":"...)
+		b, err = v.AppSource.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if len(v.Attributes) != 0 {
+		b = append(b, "\"Attributes\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Attributes))
+		for k := range v.Attributes {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Attributes[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.DataSources) != 0 {
+		b = append(b, "\"DataSources\":"...)
+		b = append(b, '[')
+		for i1 := range v.DataSources {
+			b, err = v.DataSources[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.Description != nil {
+		b = append(b, "\"Description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	if len(v.Domains) != 0 {
+		b = append(b, "\"Domains\":"...)
+		b = append(b, '[')
+		for i1 := range v.Domains {
+			b = aws.AppendJSONString(b, v.Domains[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.EnableSSL != nil {
+		b = append(b, "\"EnableSsl\":"...)
+		b = strconv.AppendBool(b, *v.EnableSSL)
+		b = append(b, ',')
+	}
+	if len(v.Environment) != 0 {
+		b = append(b, "\"Environment\":"...)
+		b = append(b, '[')
+		for i1 := range v.Environment {
+			b, err = v.Environment[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.SSLConfiguration != nil {
+		b = append(b, "\"SslConfiguration\":"...)
+		b, err = v.SSLConfiguration.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.Type != nil {
+		b = append(b, "\"Type\":"...)
+		b = aws.AppendJSONString(b, *v.Type)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateElasticIPRequest is undocumented.
 type UpdateElasticIPRequest struct {
 	ElasticIP aws.StringValue `json:"ElasticIp"`
 	Name      aws.StringValue `json:"Name,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateElasticIPRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateElasticIPRequest to b.
+func (v *UpdateElasticIPRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ElasticIp\":"...)
+	if v.ElasticIP == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ElasticIP)
+	}
+	b = append(b, ',')
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateInstanceRequest is undocumented.
 type UpdateInstanceRequest struct {
 	AMIID                aws.StringValue  `json:"AmiId,omitempty"`
@@ -1842,6 +5527,92 @@ type UpdateInstanceRequest struct {
 	SSHKeyName           aws.StringValue  `json:"SshKeyName,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateInstanceRequest to b.
+func (v *UpdateInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AMIID != nil {
+		b = append(b, "\"AmiId\":"...)
+		b = aws.AppendJSONString(b, *v.AMIID)
+		b = append(b, ',')
+	}
+	if v.Architecture != nil {
+		b = append(b, "\"Architecture\":"...)
+		b = aws.AppendJSONString(b, *v.Architecture)
+		b = append(b, ',')
+	}
+	if v.AutoScalingType != nil {
+		b = append(b, "\"AutoScalingType\":"...)
+		b = aws.AppendJSONString(b, *v.AutoScalingType)
+		b = append(b, ',')
+	}
+	if v.EBSOptimized != nil {
+		b = append(b, "\"EbsOptimized\":"...)
+		b = strconv.AppendBool(b, *v.EBSOptimized)
+		b = append(b, ',')
+	}
+	if v.Hostname != nil {
+		b = append(b, "\"Hostname\":"...)
+		b = aws.AppendJSONString(b, *v.Hostname)
+		b = append(b, ',')
+	}
+	if v.InstallUpdatesOnBoot != nil {
+		b = append(b, "\"InstallUpdatesOnBoot\":"...)
+		b = strconv.AppendBool(b, *v.InstallUpdatesOnBoot)
+		b = append(b, ',')
+	}
+	b = append(b, "\"InstanceId\":"...)
+	if v.InstanceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InstanceID)
+	}
+	b = append(b, ',')
+	if v.InstanceType != nil {
+		b = append(b, "\"InstanceType\":"...)
+		b = aws.AppendJSONString(b, *v.InstanceType)
+		b = append(b, ',')
+	}
+	if len(v.LayerIDs) != 0 {
+		b = append(b, "\"LayerIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.LayerIDs {
+			b = aws.AppendJSONString(b, v.LayerIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.OS != nil {
+		b = append(b, "\"Os\":"...)
+		b = aws.AppendJSONString(b, *v.OS)
+		b = append(b, ',')
+	}
+	if v.SSHKeyName != nil {
+		b = append(b, "\"SshKeyName\":"...)
+		b = aws.AppendJSONString(b, *v.SSHKeyName)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateLayerRequest is undocumented.
 type UpdateLayerRequest struct {
 	Attributes                  map[string]string            `json:"Attributes,omitempty"`
@@ -1861,11 +5632,186 @@ type UpdateLayerRequest struct {
 	VolumeConfigurations        []VolumeConfiguration        `json:"VolumeConfigurations,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateLayerRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateLayerRequest to b.
+func (v *UpdateLayerRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Attributes) != 0 {
+		b = append(b, "\"Attributes\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Attributes))
+		for k := range v.Attributes {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Attributes[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.AutoAssignElasticIPs != nil {
+		b = append(b, "\"AutoAssignElasticIps\":"...)
+		b = strconv.AppendBool(b, *v.AutoAssignElasticIPs)
+		b = append(b, ',')
+	}
+	if v.AutoAssignPublicIPs != nil {
+		b = append(b, "\"AutoAssignPublicIps\":"...)
+		b = strconv.AppendBool(b, *v.AutoAssignPublicIPs)
+		b = append(b, ',')
+	}
+	if v.CustomInstanceProfileARN != nil {
+		b = append(b, "\"CustomInstanceProfileArn\":"...)
+		b = aws.AppendJSONString(b, *v.CustomInstanceProfileARN)
+		b = append(b, ',')
+	}
+	if v.CustomRecipes != nil {
+		b = append(b, "\"CustomRecipes\":"...)
+		b, err = v.CustomRecipes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if len(v.CustomSecurityGroupIDs) != 0 {
+		b = append(b, "\"CustomSecurityGroupIds\":"...)
+		b = append(b, '[')
+		for i1 := range v.CustomSecurityGroupIDs {
+			b = aws.AppendJSONString(b, v.CustomSecurityGroupIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.EnableAutoHealing != nil {
+		b = append(b, "\"EnableAutoHealing\":"...)
+		b = strconv.AppendBool(b, *v.EnableAutoHealing)
+		b = append(b, ',')
+	}
+	if v.InstallUpdatesOnBoot != nil {
+		b = append(b, "\"InstallUpdatesOnBoot\":"...)
+		b = strconv.AppendBool(b, *v.InstallUpdatesOnBoot)
+		b = append(b, ',')
+	}
+	b = append(b, "\"LayerId\":"...)
+	if v.LayerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.LayerID)
+	}
+	b = append(b, ',')
+	if v.LifecycleEventConfiguration != nil {
+		b = append(b, "\"LifecycleEventConfiguration\":"...)
+		b, err = v.LifecycleEventConfiguration.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if len(v.Packages) != 0 {
+		b = append(b, "\"Packages\":"...)
+		b = append(b, '[')
+		for i1 := range v.Packages {
+			b = aws.AppendJSONString(b, v.Packages[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.Shortname != nil {
+		b = append(b, "\"Shortname\":"...)
+		b = aws.AppendJSONString(b, *v.Shortname)
+		b = append(b, ',')
+	}
+	if v.UseEBSOptimizedInstances != nil {
+		b = append(b, "\"UseEbsOptimizedInstances\":"...)
+		b = strconv.AppendBool(b, *v.UseEBSOptimizedInstances)
+		b = append(b, ',')
+	}
+	if len(v.VolumeConfigurations) != 0 {
+		b = append(b, "\"VolumeConfigurations\":"...)
+		b = append(b, '[')
+		for i1 := range v.VolumeConfigurations {
+			b, err = v.VolumeConfigurations[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateMyUserProfileRequest is undocumented.
 type UpdateMyUserProfileRequest struct {
 	SSHPublicKey aws.StringValue `json:"SshPublicKey,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateMyUserProfileRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateMyUserProfileRequest to b.
+func (v *UpdateMyUserProfileRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.SSHPublicKey != nil {
+		b = append(b, "\"SshPublicKey\":"...)
+		b = aws.AppendJSONString(b, *v.SSHPublicKey)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateRDSDBInstanceRequest is undocumented.
 type UpdateRDSDBInstanceRequest struct {
 	DBPassword       aws.StringValue `json:"DbPassword,omitempty"`
@@ -1873,6 +5819,43 @@ type UpdateRDSDBInstanceRequest struct {
 	RDSDBInstanceARN aws.StringValue `json:"RdsDbInstanceArn"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateRDSDBInstanceRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateRDSDBInstanceRequest to b.
+func (v *UpdateRDSDBInstanceRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DBPassword != nil {
+		b = append(b, "\"DbPassword\":"...)
+		b = aws.AppendJSONString(b, *v.DBPassword)
+		b = append(b, ',')
+	}
+	if v.DBUser != nil {
+		b = append(b, "\"DbUser\":"...)
+		b = aws.AppendJSONString(b, *v.DBUser)
+		b = append(b, ',')
+	}
+	b = append(b, "\"RdsDbInstanceArn\":"...)
+	if v.RDSDBInstanceARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.RDSDBInstanceARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateStackRequest is undocumented.
 type UpdateStackRequest struct {
 	Attributes                map[string]string          `json:"Attributes,omitempty"`
@@ -1894,6 +5877,139 @@ type UpdateStackRequest struct {
 	UseOpsworksSecurityGroups aws.BooleanValue           `json:"UseOpsworksSecurityGroups,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateStackRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateStackRequest to b.
+func (v *UpdateStackRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Attributes) != 0 {
+		b = append(b, "\"Attributes\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Attributes))
+		for k := range v.Attributes {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Attributes[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if v.ChefConfiguration != nil {
+		b = append(b, "\"ChefConfiguration\":"...)
+		b, err = v.ChefConfiguration.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.ConfigurationManager != nil {
+		b = append(b, "\"ConfigurationManager\":"...)
+		b, err = v.ConfigurationManager.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CustomCookbooksSource != nil {
+		b = append(b, "\"CustomCookbooksSource\":"...)
+		b, err = v.CustomCookbooksSource.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CustomJSON != nil {
+		b = append(b, "\"CustomJson\":"...)
+		b = aws.AppendJSONString(b, *v.CustomJSON)
+		b = append(b, ',')
+	}
+	if v.DefaultAvailabilityZone != nil {
+		b = append(b, "\"DefaultAvailabilityZone\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultAvailabilityZone)
+		b = append(b, ',')
+	}
+	if v.DefaultInstanceProfileARN != nil {
+		b = append(b, "\"DefaultInstanceProfileArn\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultInstanceProfileARN)
+		b = append(b, ',')
+	}
+	if v.DefaultOS != nil {
+		b = append(b, "\"DefaultOs\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultOS)
+		b = append(b, ',')
+	}
+	if v.DefaultRootDeviceType != nil {
+		b = append(b, "\"DefaultRootDeviceType\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultRootDeviceType)
+		b = append(b, ',')
+	}
+	if v.DefaultSSHKeyName != nil {
+		b = append(b, "\"DefaultSshKeyName\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultSSHKeyName)
+		b = append(b, ',')
+	}
+	if v.DefaultSubnetID != nil {
+		b = append(b, "\"DefaultSubnetId\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultSubnetID)
+		b = append(b, ',')
+	}
+	if v.HostnameTheme != nil {
+		b = append(b, "\"HostnameTheme\":"...)
+		b = aws.AppendJSONString(b, *v.HostnameTheme)
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.ServiceRoleARN != nil {
+		b = append(b, "\"ServiceRoleArn\":"...)
+		b = aws.AppendJSONString(b, *v.ServiceRoleARN)
+		b = append(b, ',')
+	}
+	b = append(b, "\"StackId\":"...)
+	if v.StackID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StackID)
+	}
+	b = append(b, ',')
+	if v.UseCustomCookbooks != nil {
+		b = append(b, "\"UseCustomCookbooks\":"...)
+		b = strconv.AppendBool(b, *v.UseCustomCookbooks)
+		b = append(b, ',')
+	}
+	if v.UseOpsworksSecurityGroups != nil {
+		b = append(b, "\"UseOpsworksSecurityGroups\":"...)
+		b = strconv.AppendBool(b, *v.UseOpsworksSecurityGroups)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateUserProfileRequest is undocumented.
 type UpdateUserProfileRequest struct {
 	AllowSelfManagement aws.BooleanValue `json:"AllowSelfManagement,omitempty"`
@@ -1902,6 +6018,48 @@ type UpdateUserProfileRequest struct {
 	SSHUsername         aws.StringValue  `json:"SshUsername,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateUserProfileRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateUserProfileRequest to b.
+func (v *UpdateUserProfileRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AllowSelfManagement != nil {
+		b = append(b, "\"AllowSelfManagement\":"...)
+		b = strconv.AppendBool(b, *v.AllowSelfManagement)
+		b = append(b, ',')
+	}
+	b = append(b, "\"IamUserArn\":"...)
+	if v.IAMUserARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.IAMUserARN)
+	}
+	b = append(b, ',')
+	if v.SSHPublicKey != nil {
+		b = append(b, "\"SshPublicKey\":"...)
+		b = aws.AppendJSONString(b, *v.SSHPublicKey)
+		b = append(b, ',')
+	}
+	if v.SSHUsername != nil {
+		b = append(b, "\"SshUsername\":"...)
+		b = aws.AppendJSONString(b, *v.SSHUsername)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateVolumeRequest is undocumented.
 type UpdateVolumeRequest struct {
 	MountPoint aws.StringValue `json:"MountPoint,omitempty"`
@@ -1909,6 +6067,43 @@ type UpdateVolumeRequest struct {
 	VolumeID   aws.StringValue `json:"VolumeId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateVolumeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateVolumeRequest to b.
+func (v *UpdateVolumeRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.MountPoint != nil {
+		b = append(b, "\"MountPoint\":"...)
+		b = aws.AppendJSONString(b, *v.MountPoint)
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"Name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	b = append(b, "\"VolumeId\":"...)
+	if v.VolumeID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UserProfile is undocumented.
 type UserProfile struct {
 	AllowSelfManagement aws.BooleanValue `json:"AllowSelfManagement,omitempty"`
@@ -1951,6 +6146,62 @@ type VolumeConfiguration struct {
 	VolumeType    aws.StringValue  `json:"VolumeType,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *VolumeConfiguration) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the VolumeConfiguration to b.
+func (v *VolumeConfiguration) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.IOPS != nil {
+		b = append(b, "\"Iops\":"...)
+		b = strconv.AppendInt(b, int64(*v.IOPS), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"MountPoint\":"...)
+	if v.MountPoint == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.MountPoint)
+	}
+	b = append(b, ',')
+	b = append(b, "\"NumberOfDisks\":"...)
+	if v.NumberOfDisks == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.NumberOfDisks), 10)
+	}
+	b = append(b, ',')
+	if v.RAIDLevel != nil {
+		b = append(b, "\"RaidLevel\":"...)
+		b = strconv.AppendInt(b, int64(*v.RAIDLevel), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Size\":"...)
+	if v.Size == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.Size), 10)
+	}
+	b = append(b, ',')
+	if v.VolumeType != nil {
+		b = append(b, "\"VolumeType\":"...)
+		b = aws.AppendJSONString(b, *v.VolumeType)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // WeeklyAutoScalingSchedule is undocumented.
 type WeeklyAutoScalingSchedule struct {
 	Friday    map[string]string `json:"Friday,omitempty"`
@@ -1962,5 +6213,198 @@ type WeeklyAutoScalingSchedule struct {
 	Wednesday map[string]string `json:"Wednesday,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *WeeklyAutoScalingSchedule) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the WeeklyAutoScalingSchedule to b.
+func (v *WeeklyAutoScalingSchedule) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Friday) != 0 {
+		b = append(b, "\"Friday\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Friday))
+		for k := range v.Friday {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Friday[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Monday) != 0 {
+		b = append(b, "\"Monday\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Monday))
+		for k := range v.Monday {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Monday[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Saturday) != 0 {
+		b = append(b, "\"Saturday\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Saturday))
+		for k := range v.Saturday {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Saturday[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Sunday) != 0 {
+		b = append(b, "\"Sunday\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Sunday))
+		for k := range v.Sunday {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Sunday[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Thursday) != 0 {
+		b = append(b, "\"Thursday\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Thursday))
+		for k := range v.Thursday {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Thursday[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Tuesday) != 0 {
+		b = append(b, "\"Tuesday\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Tuesday))
+		for k := range v.Tuesday {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Tuesday[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if len(v.Wednesday) != 0 {
+		b = append(b, "\"Wednesday\":"...)
+		b = append(b, '{')
+		keys1 := make([]string, 0, len(v.Wednesday))
+		for k := range v.Wednesday {
+			keys1 = append(keys1, k)
+		}
+		sort.Strings(keys1)
+		for _, k1 := range keys1 {
+			b = aws.AppendJSONString(b, k1)
+			b = append(b, ':')
+			v1 := v.Wednesday[k1]
+			b = aws.AppendJSONString(b, v1)
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = '}'
+		} else {
+			b = append(b, '}')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
+// ResourceNotFoundException is the error returned for the ResourceNotFoundException error code.
+type ResourceNotFoundException struct {
+	aws.APIError
+}
+
+// ValidationException is the error returned for the ValidationException error code.
+type ValidationException struct {
+	aws.APIError
+}
+
+// exceptions maps OpsWorks's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"ResourceNotFoundException": ResourceNotFoundException{},
+	"ValidationException":       ValidationException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -4,11 +4,21 @@
 package rds
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // RDS is a client for Amazon Relational Database Service.
@@ -16,34 +26,82 @@ type RDS struct {
 	client *aws.QueryClient
 }
 
-// New returns a new RDS client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *RDS {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "rds"
-	endpoint, service, region := endpoints.Lookup("rds", region)
+// New returns a new RDS client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *RDS {
+	cfg := newConfig(region, client, opts)
 
 	return &RDS{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2014-09-01",
 		},
 	}
 }
 
+// NewFromSession returns a new RDS client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *RDS {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("rds", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("rds", "rds"),
+	}, "AWS_ENDPOINT_URL_RDS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *RDS) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *RDS) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *RDS) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AddSourceIdentifierToSubscription adds a source identifier to an
 // existing RDS event notification subscription.
 func (c *RDS) AddSourceIdentifierToSubscription(req *AddSourceIdentifierToSubscriptionMessage) (resp *AddSourceIdentifierToSubscriptionResult, err error) {
+	return c.AddSourceIdentifierToSubscriptionWithContext(context.Background(), req)
+}
+
+// AddSourceIdentifierToSubscriptionWithContext is like AddSourceIdentifierToSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) AddSourceIdentifierToSubscriptionWithContext(ctx context.Context, req *AddSourceIdentifierToSubscriptionMessage) (resp *AddSourceIdentifierToSubscriptionResult, err error) {
 	resp = &AddSourceIdentifierToSubscriptionResult{}
-	err = c.client.Do("AddSourceIdentifierToSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddSourceIdentifierToSubscription", "POST", "/", req, resp)
 	return
 }
 
@@ -53,8 +111,15 @@ func (c *RDS) AddSourceIdentifierToSubscription(req *AddSourceIdentifierToSubscr
 // IAM policy for Amazon For an overview on tagging Amazon RDS resources,
 // see Tagging Amazon RDS Resources
 func (c *RDS) AddTagsToResource(req *AddTagsToResourceMessage) (err error) {
+	return c.AddTagsToResourceWithContext(context.Background(), req)
+}
+
+// AddTagsToResourceWithContext is like AddTagsToResource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) AddTagsToResourceWithContext(ctx context.Context, req *AddTagsToResourceMessage) (err error) {
 	// NRE
-	err = c.client.Do("AddTagsToResource", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AddTagsToResource", "POST", "/", req, nil)
 	return
 }
 
@@ -68,37 +133,72 @@ func (c *RDS) AddTagsToResource(req *AddTagsToResourceMessage) (err error) {
 // EC2SecurityGroupName or EC2SecurityGroupId for non-VPC). For an overview
 // of ranges, go to the Wikipedia Tutorial .
 func (c *RDS) AuthorizeDBSecurityGroupIngress(req *AuthorizeDBSecurityGroupIngressMessage) (resp *AuthorizeDBSecurityGroupIngressResult, err error) {
+	return c.AuthorizeDBSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// AuthorizeDBSecurityGroupIngressWithContext is like AuthorizeDBSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) AuthorizeDBSecurityGroupIngressWithContext(ctx context.Context, req *AuthorizeDBSecurityGroupIngressMessage) (resp *AuthorizeDBSecurityGroupIngressResult, err error) {
 	resp = &AuthorizeDBSecurityGroupIngressResult{}
-	err = c.client.Do("AuthorizeDBSecurityGroupIngress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AuthorizeDBSecurityGroupIngress", "POST", "/", req, resp)
 	return
 }
 
 // CopyDBParameterGroup is undocumented.
 func (c *RDS) CopyDBParameterGroup(req *CopyDBParameterGroupMessage) (resp *CopyDBParameterGroupResult, err error) {
+	return c.CopyDBParameterGroupWithContext(context.Background(), req)
+}
+
+// CopyDBParameterGroupWithContext is like CopyDBParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CopyDBParameterGroupWithContext(ctx context.Context, req *CopyDBParameterGroupMessage) (resp *CopyDBParameterGroupResult, err error) {
 	resp = &CopyDBParameterGroupResult{}
-	err = c.client.Do("CopyDBParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CopyDBParameterGroup", "POST", "/", req, resp)
 	return
 }
 
 // CopyDBSnapshot copies the specified DBSnapshot. The source DBSnapshot
 // must be in the "available" state.
 func (c *RDS) CopyDBSnapshot(req *CopyDBSnapshotMessage) (resp *CopyDBSnapshotResult, err error) {
+	return c.CopyDBSnapshotWithContext(context.Background(), req)
+}
+
+// CopyDBSnapshotWithContext is like CopyDBSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CopyDBSnapshotWithContext(ctx context.Context, req *CopyDBSnapshotMessage) (resp *CopyDBSnapshotResult, err error) {
 	resp = &CopyDBSnapshotResult{}
-	err = c.client.Do("CopyDBSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CopyDBSnapshot", "POST", "/", req, resp)
 	return
 }
 
 // CopyOptionGroup is undocumented.
 func (c *RDS) CopyOptionGroup(req *CopyOptionGroupMessage) (resp *CopyOptionGroupResult, err error) {
+	return c.CopyOptionGroupWithContext(context.Background(), req)
+}
+
+// CopyOptionGroupWithContext is like CopyOptionGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CopyOptionGroupWithContext(ctx context.Context, req *CopyOptionGroupMessage) (resp *CopyOptionGroupResult, err error) {
 	resp = &CopyOptionGroupResult{}
-	err = c.client.Do("CopyOptionGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CopyOptionGroup", "POST", "/", req, resp)
 	return
 }
 
 // CreateDBInstance is undocumented.
 func (c *RDS) CreateDBInstance(req *CreateDBInstanceMessage) (resp *CreateDBInstanceResult, err error) {
+	return c.CreateDBInstanceWithContext(context.Background(), req)
+}
+
+// CreateDBInstanceWithContext is like CreateDBInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateDBInstanceWithContext(ctx context.Context, req *CreateDBInstanceMessage) (resp *CreateDBInstanceResult, err error) {
 	resp = &CreateDBInstanceResult{}
-	err = c.client.Do("CreateDBInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDBInstance", "POST", "/", req, resp)
 	return
 }
 
@@ -109,8 +209,15 @@ func (c *RDS) CreateDBInstance(req *CreateDBInstanceMessage) (resp *CreateDBInst
 // groups) are inherited from the source DB instance, except as specified
 // below. The source DB instance must have backup retention enabled.
 func (c *RDS) CreateDBInstanceReadReplica(req *CreateDBInstanceReadReplicaMessage) (resp *CreateDBInstanceReadReplicaResult, err error) {
+	return c.CreateDBInstanceReadReplicaWithContext(context.Background(), req)
+}
+
+// CreateDBInstanceReadReplicaWithContext is like CreateDBInstanceReadReplica, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateDBInstanceReadReplicaWithContext(ctx context.Context, req *CreateDBInstanceReadReplicaMessage) (resp *CreateDBInstanceReadReplicaResult, err error) {
 	resp = &CreateDBInstanceReadReplicaResult{}
-	err = c.client.Do("CreateDBInstanceReadReplica", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDBInstanceReadReplica", "POST", "/", req, resp)
 	return
 }
 
@@ -134,32 +241,60 @@ func (c *RDS) CreateDBInstanceReadReplica(req *CreateDBInstanceReadReplicaMessag
 // option of the Amazon RDS console or the DescribeDBParameters command to
 // verify that your DB parameter group has been created or modified.
 func (c *RDS) CreateDBParameterGroup(req *CreateDBParameterGroupMessage) (resp *CreateDBParameterGroupResult, err error) {
+	return c.CreateDBParameterGroupWithContext(context.Background(), req)
+}
+
+// CreateDBParameterGroupWithContext is like CreateDBParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateDBParameterGroupWithContext(ctx context.Context, req *CreateDBParameterGroupMessage) (resp *CreateDBParameterGroupResult, err error) {
 	resp = &CreateDBParameterGroupResult{}
-	err = c.client.Do("CreateDBParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDBParameterGroup", "POST", "/", req, resp)
 	return
 }
 
 // CreateDBSecurityGroup creates a new DB security group. DB security
 // groups control access to a DB instance.
 func (c *RDS) CreateDBSecurityGroup(req *CreateDBSecurityGroupMessage) (resp *CreateDBSecurityGroupResult, err error) {
+	return c.CreateDBSecurityGroupWithContext(context.Background(), req)
+}
+
+// CreateDBSecurityGroupWithContext is like CreateDBSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateDBSecurityGroupWithContext(ctx context.Context, req *CreateDBSecurityGroupMessage) (resp *CreateDBSecurityGroupResult, err error) {
 	resp = &CreateDBSecurityGroupResult{}
-	err = c.client.Do("CreateDBSecurityGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDBSecurityGroup", "POST", "/", req, resp)
 	return
 }
 
 // CreateDBSnapshot creates a DBSnapshot. The source DBInstance must be in
 // "available" state.
 func (c *RDS) CreateDBSnapshot(req *CreateDBSnapshotMessage) (resp *CreateDBSnapshotResult, err error) {
+	return c.CreateDBSnapshotWithContext(context.Background(), req)
+}
+
+// CreateDBSnapshotWithContext is like CreateDBSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateDBSnapshotWithContext(ctx context.Context, req *CreateDBSnapshotMessage) (resp *CreateDBSnapshotResult, err error) {
 	resp = &CreateDBSnapshotResult{}
-	err = c.client.Do("CreateDBSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDBSnapshot", "POST", "/", req, resp)
 	return
 }
 
 // CreateDBSubnetGroup creates a new DB subnet group. DB subnet groups must
 // contain at least one subnet in at least two AZs in the region.
 func (c *RDS) CreateDBSubnetGroup(req *CreateDBSubnetGroupMessage) (resp *CreateDBSubnetGroupResult, err error) {
+	return c.CreateDBSubnetGroupWithContext(context.Background(), req)
+}
+
+// CreateDBSubnetGroupWithContext is like CreateDBSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateDBSubnetGroupWithContext(ctx context.Context, req *CreateDBSubnetGroupMessage) (resp *CreateDBSubnetGroupResult, err error) {
 	resp = &CreateDBSubnetGroupResult{}
-	err = c.client.Do("CreateDBSubnetGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateDBSubnetGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -182,16 +317,30 @@ func (c *RDS) CreateDBSubnetGroup(req *CreateDBSubnetGroupMessage) (resp *Create
 // will be notified of events generated from all RDS sources belonging to
 // your customer account.
 func (c *RDS) CreateEventSubscription(req *CreateEventSubscriptionMessage) (resp *CreateEventSubscriptionResult, err error) {
+	return c.CreateEventSubscriptionWithContext(context.Background(), req)
+}
+
+// CreateEventSubscriptionWithContext is like CreateEventSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateEventSubscriptionWithContext(ctx context.Context, req *CreateEventSubscriptionMessage) (resp *CreateEventSubscriptionResult, err error) {
 	resp = &CreateEventSubscriptionResult{}
-	err = c.client.Do("CreateEventSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateEventSubscription", "POST", "/", req, resp)
 	return
 }
 
 // CreateOptionGroup creates a new option group. You can create up to 20
 // option groups.
 func (c *RDS) CreateOptionGroup(req *CreateOptionGroupMessage) (resp *CreateOptionGroupResult, err error) {
+	return c.CreateOptionGroupWithContext(context.Background(), req)
+}
+
+// CreateOptionGroupWithContext is like CreateOptionGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) CreateOptionGroupWithContext(ctx context.Context, req *CreateOptionGroupMessage) (resp *CreateOptionGroupResult, err error) {
 	resp = &CreateOptionGroupResult{}
-	err = c.client.Do("CreateOptionGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateOptionGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -205,8 +354,15 @@ func (c *RDS) CreateOptionGroup(req *CreateOptionGroupMessage) (resp *CreateOpti
 // action DescribeDBInstance is used to monitor the status of this
 // operation. The action cannot be canceled or reverted once submitted.
 func (c *RDS) DeleteDBInstance(req *DeleteDBInstanceMessage) (resp *DeleteDBInstanceResult, err error) {
+	return c.DeleteDBInstanceWithContext(context.Background(), req)
+}
+
+// DeleteDBInstanceWithContext is like DeleteDBInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DeleteDBInstanceWithContext(ctx context.Context, req *DeleteDBInstanceMessage) (resp *DeleteDBInstanceResult, err error) {
 	resp = &DeleteDBInstanceResult{}
-	err = c.client.Do("DeleteDBInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteDBInstance", "POST", "/", req, resp)
 	return
 }
 
@@ -214,66 +370,129 @@ func (c *RDS) DeleteDBInstance(req *DeleteDBInstanceMessage) (resp *DeleteDBInst
 // DBParameterGroup to be deleted cannot be associated with any DB
 // instances.
 func (c *RDS) DeleteDBParameterGroup(req *DeleteDBParameterGroupMessage) (err error) {
+	return c.DeleteDBParameterGroupWithContext(context.Background(), req)
+}
+
+// DeleteDBParameterGroupWithContext is like DeleteDBParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DeleteDBParameterGroupWithContext(ctx context.Context, req *DeleteDBParameterGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteDBParameterGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteDBParameterGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteDBSecurityGroup is undocumented.
 func (c *RDS) DeleteDBSecurityGroup(req *DeleteDBSecurityGroupMessage) (err error) {
+	return c.DeleteDBSecurityGroupWithContext(context.Background(), req)
+}
+
+// DeleteDBSecurityGroupWithContext is like DeleteDBSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DeleteDBSecurityGroupWithContext(ctx context.Context, req *DeleteDBSecurityGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteDBSecurityGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteDBSecurityGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteDBSnapshot deletes a DBSnapshot. If the snapshot is being copied,
 // the copy operation is terminated.
 func (c *RDS) DeleteDBSnapshot(req *DeleteDBSnapshotMessage) (resp *DeleteDBSnapshotResult, err error) {
+	return c.DeleteDBSnapshotWithContext(context.Background(), req)
+}
+
+// DeleteDBSnapshotWithContext is like DeleteDBSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DeleteDBSnapshotWithContext(ctx context.Context, req *DeleteDBSnapshotMessage) (resp *DeleteDBSnapshotResult, err error) {
 	resp = &DeleteDBSnapshotResult{}
-	err = c.client.Do("DeleteDBSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteDBSnapshot", "POST", "/", req, resp)
 	return
 }
 
 // DeleteDBSubnetGroup is undocumented.
 func (c *RDS) DeleteDBSubnetGroup(req *DeleteDBSubnetGroupMessage) (err error) {
+	return c.DeleteDBSubnetGroupWithContext(context.Background(), req)
+}
+
+// DeleteDBSubnetGroupWithContext is like DeleteDBSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DeleteDBSubnetGroupWithContext(ctx context.Context, req *DeleteDBSubnetGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteDBSubnetGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteDBSubnetGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteEventSubscription is undocumented.
 func (c *RDS) DeleteEventSubscription(req *DeleteEventSubscriptionMessage) (resp *DeleteEventSubscriptionResult, err error) {
+	return c.DeleteEventSubscriptionWithContext(context.Background(), req)
+}
+
+// DeleteEventSubscriptionWithContext is like DeleteEventSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DeleteEventSubscriptionWithContext(ctx context.Context, req *DeleteEventSubscriptionMessage) (resp *DeleteEventSubscriptionResult, err error) {
 	resp = &DeleteEventSubscriptionResult{}
-	err = c.client.Do("DeleteEventSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteEventSubscription", "POST", "/", req, resp)
 	return
 }
 
 // DeleteOptionGroup is undocumented.
 func (c *RDS) DeleteOptionGroup(req *DeleteOptionGroupMessage) (err error) {
+	return c.DeleteOptionGroupWithContext(context.Background(), req)
+}
+
+// DeleteOptionGroupWithContext is like DeleteOptionGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DeleteOptionGroupWithContext(ctx context.Context, req *DeleteOptionGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteOptionGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteOptionGroup", "POST", "/", req, nil)
 	return
 }
 
 // DescribeDBEngineVersions is undocumented.
 func (c *RDS) DescribeDBEngineVersions(req *DescribeDBEngineVersionsMessage) (resp *DescribeDBEngineVersionsResult, err error) {
+	return c.DescribeDBEngineVersionsWithContext(context.Background(), req)
+}
+
+// DescribeDBEngineVersionsWithContext is like DescribeDBEngineVersions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBEngineVersionsWithContext(ctx context.Context, req *DescribeDBEngineVersionsMessage) (resp *DescribeDBEngineVersionsResult, err error) {
 	resp = &DescribeDBEngineVersionsResult{}
-	err = c.client.Do("DescribeDBEngineVersions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBEngineVersions", "POST", "/", req, resp)
 	return
 }
 
 // DescribeDBInstances returns information about provisioned RDS instances.
 // This API supports pagination.
 func (c *RDS) DescribeDBInstances(req *DescribeDBInstancesMessage) (resp *DescribeDBInstancesResult, err error) {
+	return c.DescribeDBInstancesWithContext(context.Background(), req)
+}
+
+// DescribeDBInstancesWithContext is like DescribeDBInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBInstancesWithContext(ctx context.Context, req *DescribeDBInstancesMessage) (resp *DescribeDBInstancesResult, err error) {
 	resp = &DescribeDBInstancesResult{}
-	err = c.client.Do("DescribeDBInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBInstances", "POST", "/", req, resp)
 	return
 }
 
 // DescribeDBLogFiles returns a list of DB log files for the DB instance.
 func (c *RDS) DescribeDBLogFiles(req *DescribeDBLogFilesMessage) (resp *DescribeDBLogFilesResult, err error) {
+	return c.DescribeDBLogFilesWithContext(context.Background(), req)
+}
+
+// DescribeDBLogFilesWithContext is like DescribeDBLogFiles, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBLogFilesWithContext(ctx context.Context, req *DescribeDBLogFilesMessage) (resp *DescribeDBLogFilesResult, err error) {
 	resp = &DescribeDBLogFilesResult{}
-	err = c.client.Do("DescribeDBLogFiles", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBLogFiles", "POST", "/", req, resp)
 	return
 }
 
@@ -281,16 +500,30 @@ func (c *RDS) DescribeDBLogFiles(req *DescribeDBLogFilesMessage) (resp *Describe
 // descriptions. If a DBParameterGroupName is specified, the list will
 // contain only the description of the specified DB parameter group.
 func (c *RDS) DescribeDBParameterGroups(req *DescribeDBParameterGroupsMessage) (resp *DescribeDBParameterGroupsResult, err error) {
+	return c.DescribeDBParameterGroupsWithContext(context.Background(), req)
+}
+
+// DescribeDBParameterGroupsWithContext is like DescribeDBParameterGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBParameterGroupsWithContext(ctx context.Context, req *DescribeDBParameterGroupsMessage) (resp *DescribeDBParameterGroupsResult, err error) {
 	resp = &DescribeDBParameterGroupsResult{}
-	err = c.client.Do("DescribeDBParameterGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBParameterGroups", "POST", "/", req, resp)
 	return
 }
 
 // DescribeDBParameters returns the detailed parameter list for a
 // particular DB parameter group.
 func (c *RDS) DescribeDBParameters(req *DescribeDBParametersMessage) (resp *DescribeDBParametersResult, err error) {
+	return c.DescribeDBParametersWithContext(context.Background(), req)
+}
+
+// DescribeDBParametersWithContext is like DescribeDBParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBParametersWithContext(ctx context.Context, req *DescribeDBParametersMessage) (resp *DescribeDBParametersResult, err error) {
 	resp = &DescribeDBParametersResult{}
-	err = c.client.Do("DescribeDBParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -298,16 +531,30 @@ func (c *RDS) DescribeDBParameters(req *DescribeDBParametersMessage) (resp *Desc
 // If a DBSecurityGroupName is specified, the list will contain only the
 // descriptions of the specified DB security group.
 func (c *RDS) DescribeDBSecurityGroups(req *DescribeDBSecurityGroupsMessage) (resp *DescribeDBSecurityGroupsResult, err error) {
+	return c.DescribeDBSecurityGroupsWithContext(context.Background(), req)
+}
+
+// DescribeDBSecurityGroupsWithContext is like DescribeDBSecurityGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBSecurityGroupsWithContext(ctx context.Context, req *DescribeDBSecurityGroupsMessage) (resp *DescribeDBSecurityGroupsResult, err error) {
 	resp = &DescribeDBSecurityGroupsResult{}
-	err = c.client.Do("DescribeDBSecurityGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBSecurityGroups", "POST", "/", req, resp)
 	return
 }
 
 // DescribeDBSnapshots returns information about DB snapshots. This API
 // supports pagination.
 func (c *RDS) DescribeDBSnapshots(req *DescribeDBSnapshotsMessage) (resp *DescribeDBSnapshotsResult, err error) {
+	return c.DescribeDBSnapshotsWithContext(context.Background(), req)
+}
+
+// DescribeDBSnapshotsWithContext is like DescribeDBSnapshots, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBSnapshotsWithContext(ctx context.Context, req *DescribeDBSnapshotsMessage) (resp *DescribeDBSnapshotsResult, err error) {
 	resp = &DescribeDBSnapshotsResult{}
-	err = c.client.Do("DescribeDBSnapshots", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBSnapshots", "POST", "/", req, resp)
 	return
 }
 
@@ -316,16 +563,30 @@ func (c *RDS) DescribeDBSnapshots(req *DescribeDBSnapshotsMessage) (resp *Descri
 // descriptions of the specified DBSubnetGroup. For an overview of ranges,
 // go to the Wikipedia Tutorial .
 func (c *RDS) DescribeDBSubnetGroups(req *DescribeDBSubnetGroupsMessage) (resp *DescribeDBSubnetGroupsResult, err error) {
+	return c.DescribeDBSubnetGroupsWithContext(context.Background(), req)
+}
+
+// DescribeDBSubnetGroupsWithContext is like DescribeDBSubnetGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeDBSubnetGroupsWithContext(ctx context.Context, req *DescribeDBSubnetGroupsMessage) (resp *DescribeDBSubnetGroupsResult, err error) {
 	resp = &DescribeDBSubnetGroupsResult{}
-	err = c.client.Do("DescribeDBSubnetGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDBSubnetGroups", "POST", "/", req, resp)
 	return
 }
 
 // DescribeEngineDefaultParameters returns the default engine and system
 // parameter information for the specified database engine.
 func (c *RDS) DescribeEngineDefaultParameters(req *DescribeEngineDefaultParametersMessage) (resp *DescribeEngineDefaultParametersResult, err error) {
+	return c.DescribeEngineDefaultParametersWithContext(context.Background(), req)
+}
+
+// DescribeEngineDefaultParametersWithContext is like DescribeEngineDefaultParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeEngineDefaultParametersWithContext(ctx context.Context, req *DescribeEngineDefaultParametersMessage) (resp *DescribeEngineDefaultParametersResult, err error) {
 	resp = &DescribeEngineDefaultParametersResult{}
-	err = c.client.Do("DescribeEngineDefaultParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEngineDefaultParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -334,8 +595,15 @@ func (c *RDS) DescribeEngineDefaultParameters(req *DescribeEngineDefaultParamete
 // a list of the event categories and source types in the Events topic in
 // the Amazon RDS User Guide.
 func (c *RDS) DescribeEventCategories(req *DescribeEventCategoriesMessage) (resp *DescribeEventCategoriesResult, err error) {
+	return c.DescribeEventCategoriesWithContext(context.Background(), req)
+}
+
+// DescribeEventCategoriesWithContext is like DescribeEventCategories, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeEventCategoriesWithContext(ctx context.Context, req *DescribeEventCategoriesMessage) (resp *DescribeEventCategoriesResult, err error) {
 	resp = &DescribeEventCategoriesResult{}
-	err = c.client.Do("DescribeEventCategories", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEventCategories", "POST", "/", req, resp)
 	return
 }
 
@@ -345,8 +613,15 @@ func (c *RDS) DescribeEventCategories(req *DescribeEventCategoriesMessage) (resp
 // CreationTime, and Status. If you specify a SubscriptionName, lists the
 // description for that subscription.
 func (c *RDS) DescribeEventSubscriptions(req *DescribeEventSubscriptionsMessage) (resp *DescribeEventSubscriptionsResult, err error) {
+	return c.DescribeEventSubscriptionsWithContext(context.Background(), req)
+}
+
+// DescribeEventSubscriptionsWithContext is like DescribeEventSubscriptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeEventSubscriptionsWithContext(ctx context.Context, req *DescribeEventSubscriptionsMessage) (resp *DescribeEventSubscriptionsResult, err error) {
 	resp = &DescribeEventSubscriptionsResult{}
-	err = c.client.Do("DescribeEventSubscriptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEventSubscriptions", "POST", "/", req, resp)
 	return
 }
 
@@ -356,53 +631,102 @@ func (c *RDS) DescribeEventSubscriptions(req *DescribeEventSubscriptionsMessage)
 // snapshot, or DB parameter group can be obtained by providing the name as
 // a parameter. By default, the past hour of events are returned.
 func (c *RDS) DescribeEvents(req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
+	return c.DescribeEventsWithContext(context.Background(), req)
+}
+
+// DescribeEventsWithContext is like DescribeEvents, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeEventsWithContext(ctx context.Context, req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
 	resp = &DescribeEventsResult{}
-	err = c.client.Do("DescribeEvents", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEvents", "POST", "/", req, resp)
 	return
 }
 
 // DescribeOptionGroupOptions is undocumented.
 func (c *RDS) DescribeOptionGroupOptions(req *DescribeOptionGroupOptionsMessage) (resp *DescribeOptionGroupOptionsResult, err error) {
+	return c.DescribeOptionGroupOptionsWithContext(context.Background(), req)
+}
+
+// DescribeOptionGroupOptionsWithContext is like DescribeOptionGroupOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeOptionGroupOptionsWithContext(ctx context.Context, req *DescribeOptionGroupOptionsMessage) (resp *DescribeOptionGroupOptionsResult, err error) {
 	resp = &DescribeOptionGroupOptionsResult{}
-	err = c.client.Do("DescribeOptionGroupOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeOptionGroupOptions", "POST", "/", req, resp)
 	return
 }
 
 // DescribeOptionGroups is undocumented.
 func (c *RDS) DescribeOptionGroups(req *DescribeOptionGroupsMessage) (resp *DescribeOptionGroupsResult, err error) {
+	return c.DescribeOptionGroupsWithContext(context.Background(), req)
+}
+
+// DescribeOptionGroupsWithContext is like DescribeOptionGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeOptionGroupsWithContext(ctx context.Context, req *DescribeOptionGroupsMessage) (resp *DescribeOptionGroupsResult, err error) {
 	resp = &DescribeOptionGroupsResult{}
-	err = c.client.Do("DescribeOptionGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeOptionGroups", "POST", "/", req, resp)
 	return
 }
 
 // DescribeOrderableDBInstanceOptions returns a list of orderable DB
 // instance options for the specified engine.
 func (c *RDS) DescribeOrderableDBInstanceOptions(req *DescribeOrderableDBInstanceOptionsMessage) (resp *DescribeOrderableDBInstanceOptionsResult, err error) {
+	return c.DescribeOrderableDBInstanceOptionsWithContext(context.Background(), req)
+}
+
+// DescribeOrderableDBInstanceOptionsWithContext is like DescribeOrderableDBInstanceOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeOrderableDBInstanceOptionsWithContext(ctx context.Context, req *DescribeOrderableDBInstanceOptionsMessage) (resp *DescribeOrderableDBInstanceOptionsResult, err error) {
 	resp = &DescribeOrderableDBInstanceOptionsResult{}
-	err = c.client.Do("DescribeOrderableDBInstanceOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeOrderableDBInstanceOptions", "POST", "/", req, resp)
 	return
 }
 
 // DescribeReservedDBInstances returns information about reserved DB
 // instances for this account, or about a specified reserved DB instance.
 func (c *RDS) DescribeReservedDBInstances(req *DescribeReservedDBInstancesMessage) (resp *DescribeReservedDBInstancesResult, err error) {
+	return c.DescribeReservedDBInstancesWithContext(context.Background(), req)
+}
+
+// DescribeReservedDBInstancesWithContext is like DescribeReservedDBInstances, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeReservedDBInstancesWithContext(ctx context.Context, req *DescribeReservedDBInstancesMessage) (resp *DescribeReservedDBInstancesResult, err error) {
 	resp = &DescribeReservedDBInstancesResult{}
-	err = c.client.Do("DescribeReservedDBInstances", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedDBInstances", "POST", "/", req, resp)
 	return
 }
 
 // DescribeReservedDBInstancesOfferings is undocumented.
 func (c *RDS) DescribeReservedDBInstancesOfferings(req *DescribeReservedDBInstancesOfferingsMessage) (resp *DescribeReservedDBInstancesOfferingsResult, err error) {
+	return c.DescribeReservedDBInstancesOfferingsWithContext(context.Background(), req)
+}
+
+// DescribeReservedDBInstancesOfferingsWithContext is like DescribeReservedDBInstancesOfferings, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DescribeReservedDBInstancesOfferingsWithContext(ctx context.Context, req *DescribeReservedDBInstancesOfferingsMessage) (resp *DescribeReservedDBInstancesOfferingsResult, err error) {
 	resp = &DescribeReservedDBInstancesOfferingsResult{}
-	err = c.client.Do("DescribeReservedDBInstancesOfferings", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedDBInstancesOfferings", "POST", "/", req, resp)
 	return
 }
 
 // DownloadDBLogFilePortion downloads all or a portion of the specified log
 // file.
 func (c *RDS) DownloadDBLogFilePortion(req *DownloadDBLogFilePortionMessage) (resp *DownloadDBLogFilePortionResult, err error) {
+	return c.DownloadDBLogFilePortionWithContext(context.Background(), req)
+}
+
+// DownloadDBLogFilePortionWithContext is like DownloadDBLogFilePortion, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) DownloadDBLogFilePortionWithContext(ctx context.Context, req *DownloadDBLogFilePortionMessage) (resp *DownloadDBLogFilePortionResult, err error) {
 	resp = &DownloadDBLogFilePortionResult{}
-	err = c.client.Do("DownloadDBLogFilePortion", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DownloadDBLogFilePortion", "POST", "/", req, resp)
 	return
 }
 
@@ -410,8 +734,15 @@ func (c *RDS) DownloadDBLogFilePortion(req *DownloadDBLogFilePortionMessage) (re
 // overview on tagging an Amazon RDS resource, see Tagging Amazon RDS
 // Resources
 func (c *RDS) ListTagsForResource(req *ListTagsForResourceMessage) (resp *ListTagsForResourceResult, err error) {
+	return c.ListTagsForResourceWithContext(context.Background(), req)
+}
+
+// ListTagsForResourceWithContext is like ListTagsForResource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) ListTagsForResourceWithContext(ctx context.Context, req *ListTagsForResourceMessage) (resp *ListTagsForResourceResult, err error) {
 	resp = &ListTagsForResourceResult{}
-	err = c.client.Do("ListTagsForResource", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListTagsForResource", "POST", "/", req, resp)
 	return
 }
 
@@ -419,8 +750,15 @@ func (c *RDS) ListTagsForResource(req *ListTagsForResourceMessage) (resp *ListTa
 // or more database configuration parameters by specifying these parameters
 // and the new values in the request.
 func (c *RDS) ModifyDBInstance(req *ModifyDBInstanceMessage) (resp *ModifyDBInstanceResult, err error) {
+	return c.ModifyDBInstanceWithContext(context.Background(), req)
+}
+
+// ModifyDBInstanceWithContext is like ModifyDBInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) ModifyDBInstanceWithContext(ctx context.Context, req *ModifyDBInstanceMessage) (resp *ModifyDBInstanceResult, err error) {
 	resp = &ModifyDBInstanceResult{}
-	err = c.client.Do("ModifyDBInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyDBInstance", "POST", "/", req, resp)
 	return
 }
 
@@ -439,8 +777,15 @@ func (c *RDS) ModifyDBInstance(req *ModifyDBInstanceMessage) (resp *ModifyDBInst
 // option of the Amazon RDS console or the DescribeDBParameters command to
 // verify that your DB parameter group has been created or modified.
 func (c *RDS) ModifyDBParameterGroup(req *ModifyDBParameterGroupMessage) (resp *ModifyDBParameterGroupResult, err error) {
+	return c.ModifyDBParameterGroupWithContext(context.Background(), req)
+}
+
+// ModifyDBParameterGroupWithContext is like ModifyDBParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) ModifyDBParameterGroupWithContext(ctx context.Context, req *ModifyDBParameterGroupMessage) (resp *ModifyDBParameterGroupResult, err error) {
 	resp = &ModifyDBParameterGroupResult{}
-	err = c.client.Do("ModifyDBParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyDBParameterGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -448,8 +793,15 @@ func (c *RDS) ModifyDBParameterGroup(req *ModifyDBParameterGroupMessage) (resp *
 // groups must contain at least one subnet in at least two AZs in the
 // region.
 func (c *RDS) ModifyDBSubnetGroup(req *ModifyDBSubnetGroupMessage) (resp *ModifyDBSubnetGroupResult, err error) {
+	return c.ModifyDBSubnetGroupWithContext(context.Background(), req)
+}
+
+// ModifyDBSubnetGroupWithContext is like ModifyDBSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) ModifyDBSubnetGroupWithContext(ctx context.Context, req *ModifyDBSubnetGroupMessage) (resp *ModifyDBSubnetGroupResult, err error) {
 	resp = &ModifyDBSubnetGroupResult{}
-	err = c.client.Do("ModifyDBSubnetGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyDBSubnetGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -461,30 +813,58 @@ func (c *RDS) ModifyDBSubnetGroup(req *ModifyDBSubnetGroupMessage) (resp *Modify
 // event categories for a given SourceType in the Events topic in the
 // Amazon RDS User Guide or by using the DescribeEventCategories action.
 func (c *RDS) ModifyEventSubscription(req *ModifyEventSubscriptionMessage) (resp *ModifyEventSubscriptionResult, err error) {
+	return c.ModifyEventSubscriptionWithContext(context.Background(), req)
+}
+
+// ModifyEventSubscriptionWithContext is like ModifyEventSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) ModifyEventSubscriptionWithContext(ctx context.Context, req *ModifyEventSubscriptionMessage) (resp *ModifyEventSubscriptionResult, err error) {
 	resp = &ModifyEventSubscriptionResult{}
-	err = c.client.Do("ModifyEventSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyEventSubscription", "POST", "/", req, resp)
 	return
 }
 
 // ModifyOptionGroup is undocumented.
 func (c *RDS) ModifyOptionGroup(req *ModifyOptionGroupMessage) (resp *ModifyOptionGroupResult, err error) {
+	return c.ModifyOptionGroupWithContext(context.Background(), req)
+}
+
+// ModifyOptionGroupWithContext is like ModifyOptionGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) ModifyOptionGroupWithContext(ctx context.Context, req *ModifyOptionGroupMessage) (resp *ModifyOptionGroupResult, err error) {
 	resp = &ModifyOptionGroupResult{}
-	err = c.client.Do("ModifyOptionGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyOptionGroup", "POST", "/", req, resp)
 	return
 }
 
 // PromoteReadReplica promotes a read replica DB instance to a standalone
 // DB instance.
 func (c *RDS) PromoteReadReplica(req *PromoteReadReplicaMessage) (resp *PromoteReadReplicaResult, err error) {
+	return c.PromoteReadReplicaWithContext(context.Background(), req)
+}
+
+// PromoteReadReplicaWithContext is like PromoteReadReplica, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) PromoteReadReplicaWithContext(ctx context.Context, req *PromoteReadReplicaMessage) (resp *PromoteReadReplicaResult, err error) {
 	resp = &PromoteReadReplicaResult{}
-	err = c.client.Do("PromoteReadReplica", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PromoteReadReplica", "POST", "/", req, resp)
 	return
 }
 
 // PurchaseReservedDBInstancesOffering is undocumented.
 func (c *RDS) PurchaseReservedDBInstancesOffering(req *PurchaseReservedDBInstancesOfferingMessage) (resp *PurchaseReservedDBInstancesOfferingResult, err error) {
+	return c.PurchaseReservedDBInstancesOfferingWithContext(context.Background(), req)
+}
+
+// PurchaseReservedDBInstancesOfferingWithContext is like PurchaseReservedDBInstancesOffering, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) PurchaseReservedDBInstancesOfferingWithContext(ctx context.Context, req *PurchaseReservedDBInstancesOfferingMessage) (resp *PurchaseReservedDBInstancesOfferingResult, err error) {
 	resp = &PurchaseReservedDBInstancesOfferingResult{}
-	err = c.client.Do("PurchaseReservedDBInstancesOffering", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PurchaseReservedDBInstancesOffering", "POST", "/", req, resp)
 	return
 }
 
@@ -505,16 +885,30 @@ func (c *RDS) PurchaseReservedDBInstancesOffering(req *PurchaseReservedDBInstanc
 // possible during the reboot process to reduce rollback activity for
 // in-transit transactions.
 func (c *RDS) RebootDBInstance(req *RebootDBInstanceMessage) (resp *RebootDBInstanceResult, err error) {
+	return c.RebootDBInstanceWithContext(context.Background(), req)
+}
+
+// RebootDBInstanceWithContext is like RebootDBInstance, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) RebootDBInstanceWithContext(ctx context.Context, req *RebootDBInstanceMessage) (resp *RebootDBInstanceResult, err error) {
 	resp = &RebootDBInstanceResult{}
-	err = c.client.Do("RebootDBInstance", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RebootDBInstance", "POST", "/", req, resp)
 	return
 }
 
 // RemoveSourceIdentifierFromSubscription removes a source identifier from
 // an existing RDS event notification subscription.
 func (c *RDS) RemoveSourceIdentifierFromSubscription(req *RemoveSourceIdentifierFromSubscriptionMessage) (resp *RemoveSourceIdentifierFromSubscriptionResult, err error) {
+	return c.RemoveSourceIdentifierFromSubscriptionWithContext(context.Background(), req)
+}
+
+// RemoveSourceIdentifierFromSubscriptionWithContext is like RemoveSourceIdentifierFromSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) RemoveSourceIdentifierFromSubscriptionWithContext(ctx context.Context, req *RemoveSourceIdentifierFromSubscriptionMessage) (resp *RemoveSourceIdentifierFromSubscriptionResult, err error) {
 	resp = &RemoveSourceIdentifierFromSubscriptionResult{}
-	err = c.client.Do("RemoveSourceIdentifierFromSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RemoveSourceIdentifierFromSubscription", "POST", "/", req, resp)
 	return
 }
 
@@ -522,8 +916,15 @@ func (c *RDS) RemoveSourceIdentifierFromSubscription(req *RemoveSourceIdentifier
 // resource. For an overview on tagging an Amazon RDS resource, see Tagging
 // Amazon RDS Resources
 func (c *RDS) RemoveTagsFromResource(req *RemoveTagsFromResourceMessage) (err error) {
+	return c.RemoveTagsFromResourceWithContext(context.Background(), req)
+}
+
+// RemoveTagsFromResourceWithContext is like RemoveTagsFromResource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) RemoveTagsFromResourceWithContext(ctx context.Context, req *RemoveTagsFromResourceMessage) (err error) {
 	// NRE
-	err = c.client.Do("RemoveTagsFromResource", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RemoveTagsFromResource", "POST", "/", req, nil)
 	return
 }
 
@@ -536,8 +937,15 @@ func (c *RDS) RemoveTagsFromResource(req *RemoveTagsFromResourceMessage) (err er
 // pending-reboot to take effect on the next DB instance restart or
 // RebootDBInstance request.
 func (c *RDS) ResetDBParameterGroup(req *ResetDBParameterGroupMessage) (resp *ResetDBParameterGroupResult, err error) {
+	return c.ResetDBParameterGroupWithContext(context.Background(), req)
+}
+
+// ResetDBParameterGroupWithContext is like ResetDBParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) ResetDBParameterGroupWithContext(ctx context.Context, req *ResetDBParameterGroupMessage) (resp *ResetDBParameterGroupResult, err error) {
 	resp = &ResetDBParameterGroupResult{}
-	err = c.client.Do("ResetDBParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ResetDBParameterGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -547,8 +955,15 @@ func (c *RDS) ResetDBParameterGroup(req *ResetDBParameterGroupMessage) (resp *Re
 // database, except that the new RDS instance is created with the default
 // security group.
 func (c *RDS) RestoreDBInstanceFromDBSnapshot(req *RestoreDBInstanceFromDBSnapshotMessage) (resp *RestoreDBInstanceFromDBSnapshotResult, err error) {
+	return c.RestoreDBInstanceFromDBSnapshotWithContext(context.Background(), req)
+}
+
+// RestoreDBInstanceFromDBSnapshotWithContext is like RestoreDBInstanceFromDBSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) RestoreDBInstanceFromDBSnapshotWithContext(ctx context.Context, req *RestoreDBInstanceFromDBSnapshotMessage) (resp *RestoreDBInstanceFromDBSnapshotResult, err error) {
 	resp = &RestoreDBInstanceFromDBSnapshotResult{}
-	err = c.client.Do("RestoreDBInstanceFromDBSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RestoreDBInstanceFromDBSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -559,8 +974,15 @@ func (c *RDS) RestoreDBInstanceFromDBSnapshot(req *RestoreDBInstanceFromDBSnapsh
 // as the original database except that the DB instance is created with the
 // default DB security group.
 func (c *RDS) RestoreDBInstanceToPointInTime(req *RestoreDBInstanceToPointInTimeMessage) (resp *RestoreDBInstanceToPointInTimeResult, err error) {
+	return c.RestoreDBInstanceToPointInTimeWithContext(context.Background(), req)
+}
+
+// RestoreDBInstanceToPointInTimeWithContext is like RestoreDBInstanceToPointInTime, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) RestoreDBInstanceToPointInTimeWithContext(ctx context.Context, req *RestoreDBInstanceToPointInTimeMessage) (resp *RestoreDBInstanceToPointInTimeResult, err error) {
 	resp = &RestoreDBInstanceToPointInTimeResult{}
-	err = c.client.Do("RestoreDBInstanceToPointInTime", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RestoreDBInstanceToPointInTime", "POST", "/", req, resp)
 	return
 }
 
@@ -570,8 +992,15 @@ func (c *RDS) RestoreDBInstanceToPointInTime(req *RestoreDBInstanceToPointInTime
 // (EC2SecurityGroupOwnerId and either EC2SecurityGroupName or
 // EC2SecurityGroupId).
 func (c *RDS) RevokeDBSecurityGroupIngress(req *RevokeDBSecurityGroupIngressMessage) (resp *RevokeDBSecurityGroupIngressResult, err error) {
+	return c.RevokeDBSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// RevokeDBSecurityGroupIngressWithContext is like RevokeDBSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RDS) RevokeDBSecurityGroupIngressWithContext(ctx context.Context, req *RevokeDBSecurityGroupIngressMessage) (resp *RevokeDBSecurityGroupIngressResult, err error) {
 	resp = &RevokeDBSecurityGroupIngressResult{}
-	err = c.client.Do("RevokeDBSecurityGroupIngress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RevokeDBSecurityGroupIngress", "POST", "/", req, resp)
 	return
 }
 
@@ -581,6 +1010,20 @@ type AddSourceIdentifierToSubscriptionMessage struct {
 	SubscriptionName aws.StringValue `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the AddSourceIdentifierToSubscriptionMessage to the form, prefixing their names.
+func (v *AddSourceIdentifierToSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceIdentifier != nil {
+		form.Set(prefix+"SourceIdentifier", *v.SourceIdentifier)
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // AddSourceIdentifierToSubscriptionResult is undocumented.
 type AddSourceIdentifierToSubscriptionResult struct {
 	EventSubscription *EventSubscription `query:"EventSubscription" xml:"AddSourceIdentifierToSubscriptionResult>EventSubscription"`
@@ -589,7 +1032,29 @@ type AddSourceIdentifierToSubscriptionResult struct {
 // AddTagsToResourceMessage is undocumented.
 type AddTagsToResourceMessage struct {
 	ResourceName aws.StringValue `query:"ResourceName" xml:"ResourceName"`
-	Tags         []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags         []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the AddTagsToResourceMessage to the form, prefixing their names.
+func (v *AddTagsToResourceMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ResourceName != nil {
+		form.Set(prefix+"ResourceName", *v.ResourceName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // Possible values for RDS.
@@ -607,6 +1072,29 @@ type AuthorizeDBSecurityGroupIngressMessage struct {
 	EC2SecurityGroupOwnerID aws.StringValue `query:"EC2SecurityGroupOwnerId" xml:"EC2SecurityGroupOwnerId"`
 }
 
+// MarshalQuery adds the parameters of the AuthorizeDBSecurityGroupIngressMessage to the form, prefixing their names.
+func (v *AuthorizeDBSecurityGroupIngressMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CIDRIP", *v.CIDRIP)
+	}
+	if v.DBSecurityGroupName != nil {
+		form.Set(prefix+"DBSecurityGroupName", *v.DBSecurityGroupName)
+	}
+	if v.EC2SecurityGroupID != nil {
+		form.Set(prefix+"EC2SecurityGroupId", *v.EC2SecurityGroupID)
+	}
+	if v.EC2SecurityGroupName != nil {
+		form.Set(prefix+"EC2SecurityGroupName", *v.EC2SecurityGroupName)
+	}
+	if v.EC2SecurityGroupOwnerID != nil {
+		form.Set(prefix+"EC2SecurityGroupOwnerId", *v.EC2SecurityGroupOwnerID)
+	}
+	return nil
+}
+
 // AuthorizeDBSecurityGroupIngressResult is undocumented.
 type AuthorizeDBSecurityGroupIngressResult struct {
 	DBSecurityGroup *DBSecurityGroup `query:"DBSecurityGroup" xml:"AuthorizeDBSecurityGroupIngressResult>DBSecurityGroup"`
@@ -626,11 +1114,39 @@ type CharacterSet struct {
 // CopyDBParameterGroupMessage is undocumented.
 type CopyDBParameterGroupMessage struct {
 	SourceDBParameterGroupIdentifier  aws.StringValue `query:"SourceDBParameterGroupIdentifier" xml:"SourceDBParameterGroupIdentifier"`
-	Tags                              []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                              []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	TargetDBParameterGroupDescription aws.StringValue `query:"TargetDBParameterGroupDescription" xml:"TargetDBParameterGroupDescription"`
 	TargetDBParameterGroupIdentifier  aws.StringValue `query:"TargetDBParameterGroupIdentifier" xml:"TargetDBParameterGroupIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the CopyDBParameterGroupMessage to the form, prefixing their names.
+func (v *CopyDBParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceDBParameterGroupIdentifier != nil {
+		form.Set(prefix+"SourceDBParameterGroupIdentifier", *v.SourceDBParameterGroupIdentifier)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TargetDBParameterGroupDescription != nil {
+		form.Set(prefix+"TargetDBParameterGroupDescription", *v.TargetDBParameterGroupDescription)
+	}
+	if v.TargetDBParameterGroupIdentifier != nil {
+		form.Set(prefix+"TargetDBParameterGroupIdentifier", *v.TargetDBParameterGroupIdentifier)
+	}
+	return nil
+}
+
 // CopyDBParameterGroupResult is undocumented.
 type CopyDBParameterGroupResult struct {
 	DBParameterGroup *DBParameterGroup `query:"DBParameterGroup" xml:"CopyDBParameterGroupResult>DBParameterGroup"`
@@ -639,10 +1155,35 @@ type CopyDBParameterGroupResult struct {
 // CopyDBSnapshotMessage is undocumented.
 type CopyDBSnapshotMessage struct {
 	SourceDBSnapshotIdentifier aws.StringValue `query:"SourceDBSnapshotIdentifier" xml:"SourceDBSnapshotIdentifier"`
-	Tags                       []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                       []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	TargetDBSnapshotIdentifier aws.StringValue `query:"TargetDBSnapshotIdentifier" xml:"TargetDBSnapshotIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the CopyDBSnapshotMessage to the form, prefixing their names.
+func (v *CopyDBSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceDBSnapshotIdentifier != nil {
+		form.Set(prefix+"SourceDBSnapshotIdentifier", *v.SourceDBSnapshotIdentifier)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TargetDBSnapshotIdentifier != nil {
+		form.Set(prefix+"TargetDBSnapshotIdentifier", *v.TargetDBSnapshotIdentifier)
+	}
+	return nil
+}
+
 // CopyDBSnapshotResult is undocumented.
 type CopyDBSnapshotResult struct {
 	DBSnapshot *DBSnapshot `query:"DBSnapshot" xml:"CopyDBSnapshotResult>DBSnapshot"`
@@ -651,11 +1192,39 @@ type CopyDBSnapshotResult struct {
 // CopyOptionGroupMessage is undocumented.
 type CopyOptionGroupMessage struct {
 	SourceOptionGroupIdentifier  aws.StringValue `query:"SourceOptionGroupIdentifier" xml:"SourceOptionGroupIdentifier"`
-	Tags                         []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                         []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	TargetOptionGroupDescription aws.StringValue `query:"TargetOptionGroupDescription" xml:"TargetOptionGroupDescription"`
 	TargetOptionGroupIdentifier  aws.StringValue `query:"TargetOptionGroupIdentifier" xml:"TargetOptionGroupIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the CopyOptionGroupMessage to the form, prefixing their names.
+func (v *CopyOptionGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceOptionGroupIdentifier != nil {
+		form.Set(prefix+"SourceOptionGroupIdentifier", *v.SourceOptionGroupIdentifier)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TargetOptionGroupDescription != nil {
+		form.Set(prefix+"TargetOptionGroupDescription", *v.TargetOptionGroupDescription)
+	}
+	if v.TargetOptionGroupIdentifier != nil {
+		form.Set(prefix+"TargetOptionGroupIdentifier", *v.TargetOptionGroupIdentifier)
+	}
+	return nil
+}
+
 // CopyOptionGroupResult is undocumented.
 type CopyOptionGroupResult struct {
 	OptionGroup *OptionGroup `query:"OptionGroup" xml:"CopyOptionGroupResult>OptionGroup"`
@@ -672,7 +1241,7 @@ type CreateDBInstanceMessage struct {
 	DBInstanceIdentifier       aws.StringValue  `query:"DBInstanceIdentifier" xml:"DBInstanceIdentifier"`
 	DBName                     aws.StringValue  `query:"DBName" xml:"DBName"`
 	DBParameterGroupName       aws.StringValue  `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
-	DBSecurityGroups           []string         `query:"DBSecurityGroups.member" xml:"DBSecurityGroups>DBSecurityGroupName"`
+	DBSecurityGroups           []string         `query:"DBSecurityGroups,list:DBSecurityGroupName" xml:"DBSecurityGroups>DBSecurityGroupName"`
 	DBSubnetGroupName          aws.StringValue  `query:"DBSubnetGroupName" xml:"DBSubnetGroupName"`
 	Engine                     aws.StringValue  `query:"Engine" xml:"Engine"`
 	EngineVersion              aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
@@ -687,10 +1256,122 @@ type CreateDBInstanceMessage struct {
 	PreferredMaintenanceWindow aws.StringValue  `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
 	PubliclyAccessible         aws.BooleanValue `query:"PubliclyAccessible" xml:"PubliclyAccessible"`
 	StorageType                aws.StringValue  `query:"StorageType" xml:"StorageType"`
-	Tags                       []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                       []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	TDECredentialARN           aws.StringValue  `query:"TdeCredentialArn" xml:"TdeCredentialArn"`
 	TDECredentialPassword      aws.StringValue  `query:"TdeCredentialPassword" xml:"TdeCredentialPassword"`
-	VPCSecurityGroupIDs        []string         `query:"VpcSecurityGroupIds.member" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+	VPCSecurityGroupIDs        []string         `query:"VpcSecurityGroupIds,list:VpcSecurityGroupId" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+}
+
+// MarshalQuery adds the parameters of the CreateDBInstanceMessage to the form, prefixing their names.
+func (v *CreateDBInstanceMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllocatedStorage != nil {
+		form.Set(prefix+"AllocatedStorage", strconv.FormatInt(int64(*v.AllocatedStorage), 10))
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.BackupRetentionPeriod != nil {
+		form.Set(prefix+"BackupRetentionPeriod", strconv.FormatInt(int64(*v.BackupRetentionPeriod), 10))
+	}
+	if v.CharacterSetName != nil {
+		form.Set(prefix+"CharacterSetName", *v.CharacterSetName)
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.DBName != nil {
+		form.Set(prefix+"DBName", *v.DBName)
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	if v.DBSecurityGroups != nil {
+		if len(v.DBSecurityGroups) == 0 {
+			form.Set(prefix+"DBSecurityGroups", "")
+		}
+		for i1 := range v.DBSecurityGroups {
+			name1 := prefix + "DBSecurityGroups.DBSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.DBSecurityGroups[i1])
+		}
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.LicenseModel != nil {
+		form.Set(prefix+"LicenseModel", *v.LicenseModel)
+	}
+	if v.MasterUserPassword != nil {
+		form.Set(prefix+"MasterUserPassword", *v.MasterUserPassword)
+	}
+	if v.MasterUsername != nil {
+		form.Set(prefix+"MasterUsername", *v.MasterUsername)
+	}
+	if v.MultiAZ != nil {
+		form.Set(prefix+"MultiAZ", strconv.FormatBool(*v.MultiAZ))
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PreferredBackupWindow != nil {
+		form.Set(prefix+"PreferredBackupWindow", *v.PreferredBackupWindow)
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.PubliclyAccessible != nil {
+		form.Set(prefix+"PubliclyAccessible", strconv.FormatBool(*v.PubliclyAccessible))
+	}
+	if v.StorageType != nil {
+		form.Set(prefix+"StorageType", *v.StorageType)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TDECredentialARN != nil {
+		form.Set(prefix+"TdeCredentialArn", *v.TDECredentialARN)
+	}
+	if v.TDECredentialPassword != nil {
+		form.Set(prefix+"TdeCredentialPassword", *v.TDECredentialPassword)
+	}
+	if v.VPCSecurityGroupIDs != nil {
+		if len(v.VPCSecurityGroupIDs) == 0 {
+			form.Set(prefix+"VpcSecurityGroupIds", "")
+		}
+		for i1 := range v.VPCSecurityGroupIDs {
+			name1 := prefix + "VpcSecurityGroupIds.VpcSecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCSecurityGroupIDs[i1])
+		}
+	}
+	return nil
 }
 
 // CreateDBInstanceReadReplicaMessage is undocumented.
@@ -706,7 +1387,59 @@ type CreateDBInstanceReadReplicaMessage struct {
 	PubliclyAccessible         aws.BooleanValue `query:"PubliclyAccessible" xml:"PubliclyAccessible"`
 	SourceDBInstanceIdentifier aws.StringValue  `query:"SourceDBInstanceIdentifier" xml:"SourceDBInstanceIdentifier"`
 	StorageType                aws.StringValue  `query:"StorageType" xml:"StorageType"`
-	Tags                       []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                       []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateDBInstanceReadReplicaMessage to the form, prefixing their names.
+func (v *CreateDBInstanceReadReplicaMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PubliclyAccessible != nil {
+		form.Set(prefix+"PubliclyAccessible", strconv.FormatBool(*v.PubliclyAccessible))
+	}
+	if v.SourceDBInstanceIdentifier != nil {
+		form.Set(prefix+"SourceDBInstanceIdentifier", *v.SourceDBInstanceIdentifier)
+	}
+	if v.StorageType != nil {
+		form.Set(prefix+"StorageType", *v.StorageType)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateDBInstanceReadReplicaResult is undocumented.
@@ -724,7 +1457,35 @@ type CreateDBParameterGroupMessage struct {
 	DBParameterGroupFamily aws.StringValue `query:"DBParameterGroupFamily" xml:"DBParameterGroupFamily"`
 	DBParameterGroupName   aws.StringValue `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
 	Description            aws.StringValue `query:"Description" xml:"Description"`
-	Tags                   []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                   []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateDBParameterGroupMessage to the form, prefixing their names.
+func (v *CreateDBParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupFamily != nil {
+		form.Set(prefix+"DBParameterGroupFamily", *v.DBParameterGroupFamily)
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateDBParameterGroupResult is undocumented.
@@ -736,7 +1497,32 @@ type CreateDBParameterGroupResult struct {
 type CreateDBSecurityGroupMessage struct {
 	DBSecurityGroupDescription aws.StringValue `query:"DBSecurityGroupDescription" xml:"DBSecurityGroupDescription"`
 	DBSecurityGroupName        aws.StringValue `query:"DBSecurityGroupName" xml:"DBSecurityGroupName"`
-	Tags                       []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                       []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateDBSecurityGroupMessage to the form, prefixing their names.
+func (v *CreateDBSecurityGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSecurityGroupDescription != nil {
+		form.Set(prefix+"DBSecurityGroupDescription", *v.DBSecurityGroupDescription)
+	}
+	if v.DBSecurityGroupName != nil {
+		form.Set(prefix+"DBSecurityGroupName", *v.DBSecurityGroupName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateDBSecurityGroupResult is undocumented.
@@ -748,7 +1534,32 @@ type CreateDBSecurityGroupResult struct {
 type CreateDBSnapshotMessage struct {
 	DBInstanceIdentifier aws.StringValue `query:"DBInstanceIdentifier" xml:"DBInstanceIdentifier"`
 	DBSnapshotIdentifier aws.StringValue `query:"DBSnapshotIdentifier" xml:"DBSnapshotIdentifier"`
-	Tags                 []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                 []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateDBSnapshotMessage to the form, prefixing their names.
+func (v *CreateDBSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.DBSnapshotIdentifier != nil {
+		form.Set(prefix+"DBSnapshotIdentifier", *v.DBSnapshotIdentifier)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateDBSnapshotResult is undocumented.
@@ -760,8 +1571,42 @@ type CreateDBSnapshotResult struct {
 type CreateDBSubnetGroupMessage struct {
 	DBSubnetGroupDescription aws.StringValue `query:"DBSubnetGroupDescription" xml:"DBSubnetGroupDescription"`
 	DBSubnetGroupName        aws.StringValue `query:"DBSubnetGroupName" xml:"DBSubnetGroupName"`
-	SubnetIDs                []string        `query:"SubnetIds.member" xml:"SubnetIds>SubnetIdentifier"`
-	Tags                     []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	SubnetIDs                []string        `query:"SubnetIds,list:SubnetIdentifier" xml:"SubnetIds>SubnetIdentifier"`
+	Tags                     []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateDBSubnetGroupMessage to the form, prefixing their names.
+func (v *CreateDBSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSubnetGroupDescription != nil {
+		form.Set(prefix+"DBSubnetGroupDescription", *v.DBSubnetGroupDescription)
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	if v.SubnetIDs != nil {
+		if len(v.SubnetIDs) == 0 {
+			form.Set(prefix+"SubnetIds", "")
+		}
+		for i1 := range v.SubnetIDs {
+			name1 := prefix + "SubnetIds.SubnetIdentifier." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SubnetIDs[i1])
+		}
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateDBSubnetGroupResult is undocumented.
@@ -772,12 +1617,61 @@ type CreateDBSubnetGroupResult struct {
 // CreateEventSubscriptionMessage is undocumented.
 type CreateEventSubscriptionMessage struct {
 	Enabled          aws.BooleanValue `query:"Enabled" xml:"Enabled"`
-	EventCategories  []string         `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories  []string         `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	SNSTopicARN      aws.StringValue  `query:"SnsTopicArn" xml:"SnsTopicArn"`
-	SourceIDs        []string         `query:"SourceIds.member" xml:"SourceIds>SourceId"`
+	SourceIDs        []string         `query:"SourceIds,list:SourceId" xml:"SourceIds>SourceId"`
 	SourceType       aws.StringValue  `query:"SourceType" xml:"SourceType"`
 	SubscriptionName aws.StringValue  `query:"SubscriptionName" xml:"SubscriptionName"`
-	Tags             []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags             []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateEventSubscriptionMessage to the form, prefixing their names.
+func (v *CreateEventSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	if v.EventCategories != nil {
+		if len(v.EventCategories) == 0 {
+			form.Set(prefix+"EventCategories", "")
+		}
+		for i1 := range v.EventCategories {
+			name1 := prefix + "EventCategories.EventCategory." + strconv.Itoa(i1+1)
+			form.Set(name1, v.EventCategories[i1])
+		}
+	}
+	if v.SNSTopicARN != nil {
+		form.Set(prefix+"SnsTopicArn", *v.SNSTopicARN)
+	}
+	if v.SourceIDs != nil {
+		if len(v.SourceIDs) == 0 {
+			form.Set(prefix+"SourceIds", "")
+		}
+		for i1 := range v.SourceIDs {
+			name1 := prefix + "SourceIds.SourceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SourceIDs[i1])
+		}
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateEventSubscriptionResult is undocumented.
@@ -791,7 +1685,38 @@ type CreateOptionGroupMessage struct {
 	MajorEngineVersion     aws.StringValue `query:"MajorEngineVersion" xml:"MajorEngineVersion"`
 	OptionGroupDescription aws.StringValue `query:"OptionGroupDescription" xml:"OptionGroupDescription"`
 	OptionGroupName        aws.StringValue `query:"OptionGroupName" xml:"OptionGroupName"`
-	Tags                   []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                   []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateOptionGroupMessage to the form, prefixing their names.
+func (v *CreateOptionGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EngineName != nil {
+		form.Set(prefix+"EngineName", *v.EngineName)
+	}
+	if v.MajorEngineVersion != nil {
+		form.Set(prefix+"MajorEngineVersion", *v.MajorEngineVersion)
+	}
+	if v.OptionGroupDescription != nil {
+		form.Set(prefix+"OptionGroupDescription", *v.OptionGroupDescription)
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateOptionGroupResult is undocumented.
@@ -807,12 +1732,12 @@ type DBEngineVersion struct {
 	DefaultCharacterSet        *CharacterSet   `query:"DefaultCharacterSet" xml:"DefaultCharacterSet"`
 	Engine                     aws.StringValue `query:"Engine" xml:"Engine"`
 	EngineVersion              aws.StringValue `query:"EngineVersion" xml:"EngineVersion"`
-	SupportedCharacterSets     []CharacterSet  `query:"SupportedCharacterSets.member" xml:"SupportedCharacterSets>CharacterSet"`
+	SupportedCharacterSets     []CharacterSet  `query:"SupportedCharacterSets,list:CharacterSet" xml:"SupportedCharacterSets>CharacterSet"`
 }
 
 // DBEngineVersionMessage is undocumented.
 type DBEngineVersionMessage struct {
-	DBEngineVersions []DBEngineVersion `query:"DBEngineVersions.member" xml:"DescribeDBEngineVersionsResult>DBEngineVersions>DBEngineVersion"`
+	DBEngineVersions []DBEngineVersion `query:"DBEngineVersions,list:DBEngineVersion" xml:"DescribeDBEngineVersionsResult>DBEngineVersions>DBEngineVersion"`
 	Marker           aws.StringValue   `query:"Marker" xml:"DescribeDBEngineVersionsResult>Marker"`
 }
 
@@ -827,8 +1752,8 @@ type DBInstance struct {
 	DBInstanceIdentifier                  aws.StringValue              `query:"DBInstanceIdentifier" xml:"DBInstanceIdentifier"`
 	DBInstanceStatus                      aws.StringValue              `query:"DBInstanceStatus" xml:"DBInstanceStatus"`
 	DBName                                aws.StringValue              `query:"DBName" xml:"DBName"`
-	DBParameterGroups                     []DBParameterGroupStatus     `query:"DBParameterGroups.member" xml:"DBParameterGroups>DBParameterGroup"`
-	DBSecurityGroups                      []DBSecurityGroupMembership  `query:"DBSecurityGroups.member" xml:"DBSecurityGroups>DBSecurityGroup"`
+	DBParameterGroups                     []DBParameterGroupStatus     `query:"DBParameterGroups,list:DBParameterGroup" xml:"DBParameterGroups>DBParameterGroup"`
+	DBSecurityGroups                      []DBSecurityGroupMembership  `query:"DBSecurityGroups,list:DBSecurityGroup" xml:"DBSecurityGroups>DBSecurityGroup"`
 	DBSubnetGroup                         *DBSubnetGroup               `query:"DBSubnetGroup" xml:"DBSubnetGroup"`
 	Endpoint                              *Endpoint                    `query:"Endpoint" xml:"Endpoint"`
 	Engine                                aws.StringValue              `query:"Engine" xml:"Engine"`
@@ -839,23 +1764,23 @@ type DBInstance struct {
 	LicenseModel                          aws.StringValue              `query:"LicenseModel" xml:"LicenseModel"`
 	MasterUsername                        aws.StringValue              `query:"MasterUsername" xml:"MasterUsername"`
 	MultiAZ                               aws.BooleanValue             `query:"MultiAZ" xml:"MultiAZ"`
-	OptionGroupMemberships                []OptionGroupMembership      `query:"OptionGroupMemberships.member" xml:"OptionGroupMemberships>OptionGroupMembership"`
+	OptionGroupMemberships                []OptionGroupMembership      `query:"OptionGroupMemberships,list:OptionGroupMembership" xml:"OptionGroupMemberships>OptionGroupMembership"`
 	PendingModifiedValues                 *PendingModifiedValues       `query:"PendingModifiedValues" xml:"PendingModifiedValues"`
 	PreferredBackupWindow                 aws.StringValue              `query:"PreferredBackupWindow" xml:"PreferredBackupWindow"`
 	PreferredMaintenanceWindow            aws.StringValue              `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
 	PubliclyAccessible                    aws.BooleanValue             `query:"PubliclyAccessible" xml:"PubliclyAccessible"`
-	ReadReplicaDBInstanceIdentifiers      []string                     `query:"ReadReplicaDBInstanceIdentifiers.member" xml:"ReadReplicaDBInstanceIdentifiers>ReadReplicaDBInstanceIdentifier"`
+	ReadReplicaDBInstanceIdentifiers      []string                     `query:"ReadReplicaDBInstanceIdentifiers,list:ReadReplicaDBInstanceIdentifier" xml:"ReadReplicaDBInstanceIdentifiers>ReadReplicaDBInstanceIdentifier"`
 	ReadReplicaSourceDBInstanceIdentifier aws.StringValue              `query:"ReadReplicaSourceDBInstanceIdentifier" xml:"ReadReplicaSourceDBInstanceIdentifier"`
 	SecondaryAvailabilityZone             aws.StringValue              `query:"SecondaryAvailabilityZone" xml:"SecondaryAvailabilityZone"`
-	StatusInfos                           []DBInstanceStatusInfo       `query:"StatusInfos.member" xml:"StatusInfos>DBInstanceStatusInfo"`
+	StatusInfos                           []DBInstanceStatusInfo       `query:"StatusInfos,list:DBInstanceStatusInfo" xml:"StatusInfos>DBInstanceStatusInfo"`
 	StorageType                           aws.StringValue              `query:"StorageType" xml:"StorageType"`
 	TDECredentialARN                      aws.StringValue              `query:"TdeCredentialArn" xml:"TdeCredentialArn"`
-	VPCSecurityGroups                     []VPCSecurityGroupMembership `query:"VpcSecurityGroups.member" xml:"VpcSecurityGroups>VpcSecurityGroupMembership"`
+	VPCSecurityGroups                     []VPCSecurityGroupMembership `query:"VpcSecurityGroups,list:VpcSecurityGroupMembership" xml:"VpcSecurityGroups>VpcSecurityGroupMembership"`
 }
 
 // DBInstanceMessage is undocumented.
 type DBInstanceMessage struct {
-	DBInstances []DBInstance    `query:"DBInstances.member" xml:"DescribeDBInstancesResult>DBInstances>DBInstance"`
+	DBInstances []DBInstance    `query:"DBInstances,list:DBInstance" xml:"DescribeDBInstancesResult>DBInstances>DBInstance"`
 	Marker      aws.StringValue `query:"Marker" xml:"DescribeDBInstancesResult>Marker"`
 }
 
@@ -877,7 +1802,7 @@ type DBParameterGroup struct {
 // DBParameterGroupDetails is undocumented.
 type DBParameterGroupDetails struct {
 	Marker     aws.StringValue `query:"Marker" xml:"DescribeDBParametersResult>Marker"`
-	Parameters []Parameter     `query:"Parameters.member" xml:"DescribeDBParametersResult>Parameters>Parameter"`
+	Parameters []Parameter     `query:"Parameters,list:Parameter" xml:"DescribeDBParametersResult>Parameters>Parameter"`
 }
 
 // DBParameterGroupNameMessage is undocumented.
@@ -893,7 +1818,7 @@ type DBParameterGroupStatus struct {
 
 // DBParameterGroupsMessage is undocumented.
 type DBParameterGroupsMessage struct {
-	DBParameterGroups []DBParameterGroup `query:"DBParameterGroups.member" xml:"DescribeDBParameterGroupsResult>DBParameterGroups>DBParameterGroup"`
+	DBParameterGroups []DBParameterGroup `query:"DBParameterGroups,list:DBParameterGroup" xml:"DescribeDBParameterGroupsResult>DBParameterGroups>DBParameterGroup"`
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeDBParameterGroupsResult>Marker"`
 }
 
@@ -901,8 +1826,8 @@ type DBParameterGroupsMessage struct {
 type DBSecurityGroup struct {
 	DBSecurityGroupDescription aws.StringValue    `query:"DBSecurityGroupDescription" xml:"DBSecurityGroupDescription"`
 	DBSecurityGroupName        aws.StringValue    `query:"DBSecurityGroupName" xml:"DBSecurityGroupName"`
-	EC2SecurityGroups          []EC2SecurityGroup `query:"EC2SecurityGroups.member" xml:"EC2SecurityGroups>EC2SecurityGroup"`
-	IPRanges                   []IPRange          `query:"IPRanges.member" xml:"IPRanges>IPRange"`
+	EC2SecurityGroups          []EC2SecurityGroup `query:"EC2SecurityGroups,list:EC2SecurityGroup" xml:"EC2SecurityGroups>EC2SecurityGroup"`
+	IPRanges                   []IPRange          `query:"IPRanges,list:IPRange" xml:"IPRanges>IPRange"`
 	OwnerID                    aws.StringValue    `query:"OwnerId" xml:"OwnerId"`
 	VPCID                      aws.StringValue    `query:"VpcId" xml:"VpcId"`
 }
@@ -915,7 +1840,7 @@ type DBSecurityGroupMembership struct {
 
 // DBSecurityGroupMessage is undocumented.
 type DBSecurityGroupMessage struct {
-	DBSecurityGroups []DBSecurityGroup `query:"DBSecurityGroups.member" xml:"DescribeDBSecurityGroupsResult>DBSecurityGroups>DBSecurityGroup"`
+	DBSecurityGroups []DBSecurityGroup `query:"DBSecurityGroups,list:DBSecurityGroup" xml:"DescribeDBSecurityGroupsResult>DBSecurityGroups>DBSecurityGroup"`
 	Marker           aws.StringValue   `query:"Marker" xml:"DescribeDBSecurityGroupsResult>Marker"`
 }
 
@@ -945,7 +1870,7 @@ type DBSnapshot struct {
 
 // DBSnapshotMessage is undocumented.
 type DBSnapshotMessage struct {
-	DBSnapshots []DBSnapshot    `query:"DBSnapshots.member" xml:"DescribeDBSnapshotsResult>DBSnapshots>DBSnapshot"`
+	DBSnapshots []DBSnapshot    `query:"DBSnapshots,list:DBSnapshot" xml:"DescribeDBSnapshotsResult>DBSnapshots>DBSnapshot"`
 	Marker      aws.StringValue `query:"Marker" xml:"DescribeDBSnapshotsResult>Marker"`
 }
 
@@ -954,13 +1879,13 @@ type DBSubnetGroup struct {
 	DBSubnetGroupDescription aws.StringValue `query:"DBSubnetGroupDescription" xml:"DBSubnetGroupDescription"`
 	DBSubnetGroupName        aws.StringValue `query:"DBSubnetGroupName" xml:"DBSubnetGroupName"`
 	SubnetGroupStatus        aws.StringValue `query:"SubnetGroupStatus" xml:"SubnetGroupStatus"`
-	Subnets                  []Subnet        `query:"Subnets.member" xml:"Subnets>Subnet"`
+	Subnets                  []Subnet        `query:"Subnets,list:Subnet" xml:"Subnets>Subnet"`
 	VPCID                    aws.StringValue `query:"VpcId" xml:"VpcId"`
 }
 
 // DBSubnetGroupMessage is undocumented.
 type DBSubnetGroupMessage struct {
-	DBSubnetGroups []DBSubnetGroup `query:"DBSubnetGroups.member" xml:"DescribeDBSubnetGroupsResult>DBSubnetGroups>DBSubnetGroup"`
+	DBSubnetGroups []DBSubnetGroup `query:"DBSubnetGroups,list:DBSubnetGroup" xml:"DescribeDBSubnetGroupsResult>DBSubnetGroups>DBSubnetGroup"`
 	Marker         aws.StringValue `query:"Marker" xml:"DescribeDBSubnetGroupsResult>Marker"`
 }
 
@@ -971,9 +1896,26 @@ type DeleteDBInstanceMessage struct {
 	SkipFinalSnapshot         aws.BooleanValue `query:"SkipFinalSnapshot" xml:"SkipFinalSnapshot"`
 }
 
-// DeleteDBInstanceResult is undocumented.
-type DeleteDBInstanceResult struct {
-	DBInstance *DBInstance `query:"DBInstance" xml:"DeleteDBInstanceResult>DBInstance"`
+// MarshalQuery adds the parameters of the DeleteDBInstanceMessage to the form, prefixing their names.
+func (v *DeleteDBInstanceMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.FinalDBSnapshotIdentifier != nil {
+		form.Set(prefix+"FinalDBSnapshotIdentifier", *v.FinalDBSnapshotIdentifier)
+	}
+	if v.SkipFinalSnapshot != nil {
+		form.Set(prefix+"SkipFinalSnapshot", strconv.FormatBool(*v.SkipFinalSnapshot))
+	}
+	return nil
+}
+
+// DeleteDBInstanceResult is undocumented.
+type DeleteDBInstanceResult struct {
+	DBInstance *DBInstance `query:"DBInstance" xml:"DeleteDBInstanceResult>DBInstance"`
 }
 
 // DeleteDBParameterGroupMessage is undocumented.
@@ -981,16 +1923,49 @@ type DeleteDBParameterGroupMessage struct {
 	DBParameterGroupName aws.StringValue `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteDBParameterGroupMessage to the form, prefixing their names.
+func (v *DeleteDBParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	return nil
+}
+
 // DeleteDBSecurityGroupMessage is undocumented.
 type DeleteDBSecurityGroupMessage struct {
 	DBSecurityGroupName aws.StringValue `query:"DBSecurityGroupName" xml:"DBSecurityGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteDBSecurityGroupMessage to the form, prefixing their names.
+func (v *DeleteDBSecurityGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSecurityGroupName != nil {
+		form.Set(prefix+"DBSecurityGroupName", *v.DBSecurityGroupName)
+	}
+	return nil
+}
+
 // DeleteDBSnapshotMessage is undocumented.
 type DeleteDBSnapshotMessage struct {
 	DBSnapshotIdentifier aws.StringValue `query:"DBSnapshotIdentifier" xml:"DBSnapshotIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DeleteDBSnapshotMessage to the form, prefixing their names.
+func (v *DeleteDBSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSnapshotIdentifier != nil {
+		form.Set(prefix+"DBSnapshotIdentifier", *v.DBSnapshotIdentifier)
+	}
+	return nil
+}
+
 // DeleteDBSnapshotResult is undocumented.
 type DeleteDBSnapshotResult struct {
 	DBSnapshot *DBSnapshot `query:"DBSnapshot" xml:"DeleteDBSnapshotResult>DBSnapshot"`
@@ -1001,11 +1976,33 @@ type DeleteDBSubnetGroupMessage struct {
 	DBSubnetGroupName aws.StringValue `query:"DBSubnetGroupName" xml:"DBSubnetGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteDBSubnetGroupMessage to the form, prefixing their names.
+func (v *DeleteDBSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	return nil
+}
+
 // DeleteEventSubscriptionMessage is undocumented.
 type DeleteEventSubscriptionMessage struct {
 	SubscriptionName aws.StringValue `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteEventSubscriptionMessage to the form, prefixing their names.
+func (v *DeleteEventSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // DeleteEventSubscriptionResult is undocumented.
 type DeleteEventSubscriptionResult struct {
 	EventSubscription *EventSubscription `query:"EventSubscription" xml:"DeleteEventSubscriptionResult>EventSubscription"`
@@ -1016,26 +2013,105 @@ type DeleteOptionGroupMessage struct {
 	OptionGroupName aws.StringValue `query:"OptionGroupName" xml:"OptionGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteOptionGroupMessage to the form, prefixing their names.
+func (v *DeleteOptionGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	return nil
+}
+
 // DescribeDBEngineVersionsMessage is undocumented.
 type DescribeDBEngineVersionsMessage struct {
 	DBParameterGroupFamily     aws.StringValue  `query:"DBParameterGroupFamily" xml:"DBParameterGroupFamily"`
 	DefaultOnly                aws.BooleanValue `query:"DefaultOnly" xml:"DefaultOnly"`
 	Engine                     aws.StringValue  `query:"Engine" xml:"Engine"`
 	EngineVersion              aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
-	Filters                    []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters                    []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	ListSupportedCharacterSets aws.BooleanValue `query:"ListSupportedCharacterSets" xml:"ListSupportedCharacterSets"`
 	Marker                     aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords                 aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBEngineVersionsMessage to the form, prefixing their names.
+func (v *DescribeDBEngineVersionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupFamily != nil {
+		form.Set(prefix+"DBParameterGroupFamily", *v.DBParameterGroupFamily)
+	}
+	if v.DefaultOnly != nil {
+		form.Set(prefix+"DefaultOnly", strconv.FormatBool(*v.DefaultOnly))
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ListSupportedCharacterSets != nil {
+		form.Set(prefix+"ListSupportedCharacterSets", strconv.FormatBool(*v.ListSupportedCharacterSets))
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeDBInstancesMessage is undocumented.
 type DescribeDBInstancesMessage struct {
 	DBInstanceIdentifier aws.StringValue  `query:"DBInstanceIdentifier" xml:"DBInstanceIdentifier"`
-	Filters              []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters              []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker               aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBInstancesMessage to the form, prefixing their names.
+func (v *DescribeDBInstancesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeDBLogFilesDetails is undocumented.
 type DescribeDBLogFilesDetails struct {
 	LastWritten aws.LongValue   `query:"LastWritten" xml:"LastWritten"`
@@ -1049,68 +2125,282 @@ type DescribeDBLogFilesMessage struct {
 	FileLastWritten      aws.LongValue    `query:"FileLastWritten" xml:"FileLastWritten"`
 	FileSize             aws.LongValue    `query:"FileSize" xml:"FileSize"`
 	FilenameContains     aws.StringValue  `query:"FilenameContains" xml:"FilenameContains"`
-	Filters              []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters              []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker               aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBLogFilesMessage to the form, prefixing their names.
+func (v *DescribeDBLogFilesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.FileLastWritten != nil {
+		form.Set(prefix+"FileLastWritten", strconv.FormatInt(int64(*v.FileLastWritten), 10))
+	}
+	if v.FileSize != nil {
+		form.Set(prefix+"FileSize", strconv.FormatInt(int64(*v.FileSize), 10))
+	}
+	if v.FilenameContains != nil {
+		form.Set(prefix+"FilenameContains", *v.FilenameContains)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeDBLogFilesResponse is undocumented.
 type DescribeDBLogFilesResponse struct {
-	DescribeDBLogFiles []DescribeDBLogFilesDetails `query:"DescribeDBLogFiles.member" xml:"DescribeDBLogFilesResult>DescribeDBLogFiles>DescribeDBLogFilesDetails"`
+	DescribeDBLogFiles []DescribeDBLogFilesDetails `query:"DescribeDBLogFiles,list:DescribeDBLogFilesDetails" xml:"DescribeDBLogFilesResult>DescribeDBLogFiles>DescribeDBLogFilesDetails"`
 	Marker             aws.StringValue             `query:"Marker" xml:"DescribeDBLogFilesResult>Marker"`
 }
 
 // DescribeDBParameterGroupsMessage is undocumented.
 type DescribeDBParameterGroupsMessage struct {
 	DBParameterGroupName aws.StringValue  `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
-	Filters              []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters              []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker               aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBParameterGroupsMessage to the form, prefixing their names.
+func (v *DescribeDBParameterGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeDBParametersMessage is undocumented.
 type DescribeDBParametersMessage struct {
 	DBParameterGroupName aws.StringValue  `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
-	Filters              []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters              []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker               aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	Source               aws.StringValue  `query:"Source" xml:"Source"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBParametersMessage to the form, prefixing their names.
+func (v *DescribeDBParametersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.Source != nil {
+		form.Set(prefix+"Source", *v.Source)
+	}
+	return nil
+}
+
 // DescribeDBSecurityGroupsMessage is undocumented.
 type DescribeDBSecurityGroupsMessage struct {
 	DBSecurityGroupName aws.StringValue  `query:"DBSecurityGroupName" xml:"DBSecurityGroupName"`
-	Filters             []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters             []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker              aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords          aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBSecurityGroupsMessage to the form, prefixing their names.
+func (v *DescribeDBSecurityGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSecurityGroupName != nil {
+		form.Set(prefix+"DBSecurityGroupName", *v.DBSecurityGroupName)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeDBSnapshotsMessage is undocumented.
 type DescribeDBSnapshotsMessage struct {
 	DBInstanceIdentifier aws.StringValue  `query:"DBInstanceIdentifier" xml:"DBInstanceIdentifier"`
 	DBSnapshotIdentifier aws.StringValue  `query:"DBSnapshotIdentifier" xml:"DBSnapshotIdentifier"`
-	Filters              []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters              []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker               aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords           aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	SnapshotType         aws.StringValue  `query:"SnapshotType" xml:"SnapshotType"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBSnapshotsMessage to the form, prefixing their names.
+func (v *DescribeDBSnapshotsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.DBSnapshotIdentifier != nil {
+		form.Set(prefix+"DBSnapshotIdentifier", *v.DBSnapshotIdentifier)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.SnapshotType != nil {
+		form.Set(prefix+"SnapshotType", *v.SnapshotType)
+	}
+	return nil
+}
+
 // DescribeDBSubnetGroupsMessage is undocumented.
 type DescribeDBSubnetGroupsMessage struct {
 	DBSubnetGroupName aws.StringValue  `query:"DBSubnetGroupName" xml:"DBSubnetGroupName"`
-	Filters           []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters           []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker            aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords        aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDBSubnetGroupsMessage to the form, prefixing their names.
+func (v *DescribeDBSubnetGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeEngineDefaultParametersMessage is undocumented.
 type DescribeEngineDefaultParametersMessage struct {
 	DBParameterGroupFamily aws.StringValue  `query:"DBParameterGroupFamily" xml:"DBParameterGroupFamily"`
-	Filters                []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters                []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker                 aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords             aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEngineDefaultParametersMessage to the form, prefixing their names.
+func (v *DescribeEngineDefaultParametersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupFamily != nil {
+		form.Set(prefix+"DBParameterGroupFamily", *v.DBParameterGroupFamily)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeEngineDefaultParametersResult is undocumented.
 type DescribeEngineDefaultParametersResult struct {
 	EngineDefaults *EngineDefaults `query:"EngineDefaults" xml:"DescribeEngineDefaultParametersResult>EngineDefaults"`
@@ -1118,24 +2408,74 @@ type DescribeEngineDefaultParametersResult struct {
 
 // DescribeEventCategoriesMessage is undocumented.
 type DescribeEventCategoriesMessage struct {
-	Filters    []Filter        `query:"Filters.member" xml:"Filters>Filter"`
+	Filters    []Filter        `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	SourceType aws.StringValue `query:"SourceType" xml:"SourceType"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventCategoriesMessage to the form, prefixing their names.
+func (v *DescribeEventCategoriesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	return nil
+}
+
 // DescribeEventSubscriptionsMessage is undocumented.
 type DescribeEventSubscriptionsMessage struct {
-	Filters          []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters          []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker           aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords       aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	SubscriptionName aws.StringValue  `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventSubscriptionsMessage to the form, prefixing their names.
+func (v *DescribeEventSubscriptionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // DescribeEventsMessage is undocumented.
 type DescribeEventsMessage struct {
 	Duration         aws.IntegerValue `query:"Duration" xml:"Duration"`
 	EndTime          time.Time        `query:"EndTime" xml:"EndTime"`
-	EventCategories  []string         `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
-	Filters          []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	EventCategories  []string         `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
+	Filters          []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker           aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords       aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	SourceIdentifier aws.StringValue  `query:"SourceIdentifier" xml:"SourceIdentifier"`
@@ -1143,42 +2483,196 @@ type DescribeEventsMessage struct {
 	StartTime        time.Time        `query:"StartTime" xml:"StartTime"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventsMessage to the form, prefixing their names.
+func (v *DescribeEventsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Duration != nil {
+		form.Set(prefix+"Duration", strconv.FormatInt(int64(*v.Duration), 10))
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.EventCategories != nil {
+		if len(v.EventCategories) == 0 {
+			form.Set(prefix+"EventCategories", "")
+		}
+		for i1 := range v.EventCategories {
+			name1 := prefix + "EventCategories.EventCategory." + strconv.Itoa(i1+1)
+			form.Set(name1, v.EventCategories[i1])
+		}
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.SourceIdentifier != nil {
+		form.Set(prefix+"SourceIdentifier", *v.SourceIdentifier)
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // DescribeOptionGroupOptionsMessage is undocumented.
 type DescribeOptionGroupOptionsMessage struct {
 	EngineName         aws.StringValue  `query:"EngineName" xml:"EngineName"`
-	Filters            []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters            []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	MajorEngineVersion aws.StringValue  `query:"MajorEngineVersion" xml:"MajorEngineVersion"`
 	Marker             aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords         aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeOptionGroupOptionsMessage to the form, prefixing their names.
+func (v *DescribeOptionGroupOptionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EngineName != nil {
+		form.Set(prefix+"EngineName", *v.EngineName)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MajorEngineVersion != nil {
+		form.Set(prefix+"MajorEngineVersion", *v.MajorEngineVersion)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeOptionGroupsMessage is undocumented.
 type DescribeOptionGroupsMessage struct {
 	EngineName         aws.StringValue  `query:"EngineName" xml:"EngineName"`
-	Filters            []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters            []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	MajorEngineVersion aws.StringValue  `query:"MajorEngineVersion" xml:"MajorEngineVersion"`
 	Marker             aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords         aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	OptionGroupName    aws.StringValue  `query:"OptionGroupName" xml:"OptionGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeOptionGroupsMessage to the form, prefixing their names.
+func (v *DescribeOptionGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EngineName != nil {
+		form.Set(prefix+"EngineName", *v.EngineName)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.MajorEngineVersion != nil {
+		form.Set(prefix+"MajorEngineVersion", *v.MajorEngineVersion)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	return nil
+}
+
 // DescribeOrderableDBInstanceOptionsMessage is undocumented.
 type DescribeOrderableDBInstanceOptionsMessage struct {
 	DBInstanceClass aws.StringValue  `query:"DBInstanceClass" xml:"DBInstanceClass"`
 	Engine          aws.StringValue  `query:"Engine" xml:"Engine"`
 	EngineVersion   aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
-	Filters         []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters         []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	LicenseModel    aws.StringValue  `query:"LicenseModel" xml:"LicenseModel"`
 	Marker          aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords      aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	VPC             aws.BooleanValue `query:"Vpc" xml:"Vpc"`
 }
 
+// MarshalQuery adds the parameters of the DescribeOrderableDBInstanceOptionsMessage to the form, prefixing their names.
+func (v *DescribeOrderableDBInstanceOptionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.LicenseModel != nil {
+		form.Set(prefix+"LicenseModel", *v.LicenseModel)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.VPC != nil {
+		form.Set(prefix+"Vpc", strconv.FormatBool(*v.VPC))
+	}
+	return nil
+}
+
 // DescribeReservedDBInstancesMessage is undocumented.
 type DescribeReservedDBInstancesMessage struct {
 	DBInstanceClass               aws.StringValue  `query:"DBInstanceClass" xml:"DBInstanceClass"`
 	Duration                      aws.StringValue  `query:"Duration" xml:"Duration"`
-	Filters                       []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters                       []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker                        aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords                    aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	MultiAZ                       aws.BooleanValue `query:"MultiAZ" xml:"MultiAZ"`
@@ -1188,11 +2682,57 @@ type DescribeReservedDBInstancesMessage struct {
 	ReservedDBInstancesOfferingID aws.StringValue  `query:"ReservedDBInstancesOfferingId" xml:"ReservedDBInstancesOfferingId"`
 }
 
+// MarshalQuery adds the parameters of the DescribeReservedDBInstancesMessage to the form, prefixing their names.
+func (v *DescribeReservedDBInstancesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.Duration != nil {
+		form.Set(prefix+"Duration", *v.Duration)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.MultiAZ != nil {
+		form.Set(prefix+"MultiAZ", strconv.FormatBool(*v.MultiAZ))
+	}
+	if v.OfferingType != nil {
+		form.Set(prefix+"OfferingType", *v.OfferingType)
+	}
+	if v.ProductDescription != nil {
+		form.Set(prefix+"ProductDescription", *v.ProductDescription)
+	}
+	if v.ReservedDBInstanceID != nil {
+		form.Set(prefix+"ReservedDBInstanceId", *v.ReservedDBInstanceID)
+	}
+	if v.ReservedDBInstancesOfferingID != nil {
+		form.Set(prefix+"ReservedDBInstancesOfferingId", *v.ReservedDBInstancesOfferingID)
+	}
+	return nil
+}
+
 // DescribeReservedDBInstancesOfferingsMessage is undocumented.
 type DescribeReservedDBInstancesOfferingsMessage struct {
 	DBInstanceClass               aws.StringValue  `query:"DBInstanceClass" xml:"DBInstanceClass"`
 	Duration                      aws.StringValue  `query:"Duration" xml:"Duration"`
-	Filters                       []Filter         `query:"Filters.member" xml:"Filters>Filter"`
+	Filters                       []Filter         `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	Marker                        aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords                    aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	MultiAZ                       aws.BooleanValue `query:"MultiAZ" xml:"MultiAZ"`
@@ -1201,6 +2741,49 @@ type DescribeReservedDBInstancesOfferingsMessage struct {
 	ReservedDBInstancesOfferingID aws.StringValue  `query:"ReservedDBInstancesOfferingId" xml:"ReservedDBInstancesOfferingId"`
 }
 
+// MarshalQuery adds the parameters of the DescribeReservedDBInstancesOfferingsMessage to the form, prefixing their names.
+func (v *DescribeReservedDBInstancesOfferingsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.Duration != nil {
+		form.Set(prefix+"Duration", *v.Duration)
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.MultiAZ != nil {
+		form.Set(prefix+"MultiAZ", strconv.FormatBool(*v.MultiAZ))
+	}
+	if v.OfferingType != nil {
+		form.Set(prefix+"OfferingType", *v.OfferingType)
+	}
+	if v.ProductDescription != nil {
+		form.Set(prefix+"ProductDescription", *v.ProductDescription)
+	}
+	if v.ReservedDBInstancesOfferingID != nil {
+		form.Set(prefix+"ReservedDBInstancesOfferingId", *v.ReservedDBInstancesOfferingID)
+	}
+	return nil
+}
+
 // DownloadDBLogFilePortionDetails is undocumented.
 type DownloadDBLogFilePortionDetails struct {
 	AdditionalDataPending aws.BooleanValue `query:"AdditionalDataPending" xml:"DownloadDBLogFilePortionResult>AdditionalDataPending"`
@@ -1216,6 +2799,26 @@ type DownloadDBLogFilePortionMessage struct {
 	NumberOfLines        aws.IntegerValue `query:"NumberOfLines" xml:"NumberOfLines"`
 }
 
+// MarshalQuery adds the parameters of the DownloadDBLogFilePortionMessage to the form, prefixing their names.
+func (v *DownloadDBLogFilePortionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.LogFileName != nil {
+		form.Set(prefix+"LogFileName", *v.LogFileName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.NumberOfLines != nil {
+		form.Set(prefix+"NumberOfLines", strconv.FormatInt(int64(*v.NumberOfLines), 10))
+	}
+	return nil
+}
+
 // EC2SecurityGroup is undocumented.
 type EC2SecurityGroup struct {
 	EC2SecurityGroupID      aws.StringValue `query:"EC2SecurityGroupId" xml:"EC2SecurityGroupId"`
@@ -1234,13 +2837,13 @@ type Endpoint struct {
 type EngineDefaults struct {
 	DBParameterGroupFamily aws.StringValue `query:"DBParameterGroupFamily" xml:"DBParameterGroupFamily"`
 	Marker                 aws.StringValue `query:"Marker" xml:"Marker"`
-	Parameters             []Parameter     `query:"Parameters.member" xml:"Parameters>Parameter"`
+	Parameters             []Parameter     `query:"Parameters,list:Parameter" xml:"Parameters>Parameter"`
 }
 
 // Event is undocumented.
 type Event struct {
 	Date             time.Time       `query:"Date" xml:"Date"`
-	EventCategories  []string        `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories  []string        `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	Message          aws.StringValue `query:"Message" xml:"Message"`
 	SourceIdentifier aws.StringValue `query:"SourceIdentifier" xml:"SourceIdentifier"`
 	SourceType       aws.StringValue `query:"SourceType" xml:"SourceType"`
@@ -1248,13 +2851,13 @@ type Event struct {
 
 // EventCategoriesMap is undocumented.
 type EventCategoriesMap struct {
-	EventCategories []string        `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories []string        `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	SourceType      aws.StringValue `query:"SourceType" xml:"SourceType"`
 }
 
 // EventCategoriesMessage is undocumented.
 type EventCategoriesMessage struct {
-	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList.member" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
+	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList,list:EventCategoriesMap" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
 }
 
 // EventSubscription is undocumented.
@@ -1262,9 +2865,9 @@ type EventSubscription struct {
 	CustSubscriptionID       aws.StringValue  `query:"CustSubscriptionId" xml:"CustSubscriptionId"`
 	CustomerAWSID            aws.StringValue  `query:"CustomerAwsId" xml:"CustomerAwsId"`
 	Enabled                  aws.BooleanValue `query:"Enabled" xml:"Enabled"`
-	EventCategoriesList      []string         `query:"EventCategoriesList.member" xml:"EventCategoriesList>EventCategory"`
+	EventCategoriesList      []string         `query:"EventCategoriesList,list:EventCategory" xml:"EventCategoriesList>EventCategory"`
 	SNSTopicARN              aws.StringValue  `query:"SnsTopicArn" xml:"SnsTopicArn"`
-	SourceIDsList            []string         `query:"SourceIdsList.member" xml:"SourceIdsList>SourceId"`
+	SourceIDsList            []string         `query:"SourceIdsList,list:SourceId" xml:"SourceIdsList>SourceId"`
 	SourceType               aws.StringValue  `query:"SourceType" xml:"SourceType"`
 	Status                   aws.StringValue  `query:"Status" xml:"Status"`
 	SubscriptionCreationTime aws.StringValue  `query:"SubscriptionCreationTime" xml:"SubscriptionCreationTime"`
@@ -1272,20 +2875,40 @@ type EventSubscription struct {
 
 // EventSubscriptionsMessage is undocumented.
 type EventSubscriptionsMessage struct {
-	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList.member" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
+	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList,list:EventSubscription" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
 	Marker                 aws.StringValue     `query:"Marker" xml:"DescribeEventSubscriptionsResult>Marker"`
 }
 
 // EventsMessage is undocumented.
 type EventsMessage struct {
-	Events []Event         `query:"Events.member" xml:"DescribeEventsResult>Events>Event"`
+	Events []Event         `query:"Events,list:Event" xml:"DescribeEventsResult>Events>Event"`
 	Marker aws.StringValue `query:"Marker" xml:"DescribeEventsResult>Marker"`
 }
 
 // Filter is undocumented.
 type Filter struct {
 	Name   aws.StringValue `query:"Name" xml:"Name"`
-	Values []string        `query:"Values.member" xml:"Values>Value"`
+	Values []string        `query:"Values,list:Value" xml:"Values>Value"`
+}
+
+// MarshalQuery adds the parameters of the Filter to the form, prefixing their names.
+func (v *Filter) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Values != nil {
+		if len(v.Values) == 0 {
+			form.Set(prefix+"Values", "")
+		}
+		for i1 := range v.Values {
+			name1 := prefix + "Values.Value." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Values[i1])
+		}
+	}
+	return nil
 }
 
 // IPRange is undocumented.
@@ -1296,10 +2919,32 @@ type IPRange struct {
 
 // ListTagsForResourceMessage is undocumented.
 type ListTagsForResourceMessage struct {
-	Filters      []Filter        `query:"Filters.member" xml:"Filters>Filter"`
+	Filters      []Filter        `query:"Filters,list:Filter" xml:"Filters>Filter"`
 	ResourceName aws.StringValue `query:"ResourceName" xml:"ResourceName"`
 }
 
+// MarshalQuery adds the parameters of the ListTagsForResourceMessage to the form, prefixing their names.
+func (v *ListTagsForResourceMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Filters != nil {
+		if len(v.Filters) == 0 {
+			form.Set(prefix+"Filters", "")
+		}
+		for i1 := range v.Filters {
+			name1 := prefix + "Filters.Filter." + strconv.Itoa(i1+1)
+			if err := v.Filters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ResourceName != nil {
+		form.Set(prefix+"ResourceName", *v.ResourceName)
+	}
+	return nil
+}
+
 // ModifyDBInstanceMessage is undocumented.
 type ModifyDBInstanceMessage struct {
 	AllocatedStorage           aws.IntegerValue `query:"AllocatedStorage" xml:"AllocatedStorage"`
@@ -1310,7 +2955,7 @@ type ModifyDBInstanceMessage struct {
 	DBInstanceClass            aws.StringValue  `query:"DBInstanceClass" xml:"DBInstanceClass"`
 	DBInstanceIdentifier       aws.StringValue  `query:"DBInstanceIdentifier" xml:"DBInstanceIdentifier"`
 	DBParameterGroupName       aws.StringValue  `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
-	DBSecurityGroups           []string         `query:"DBSecurityGroups.member" xml:"DBSecurityGroups>DBSecurityGroupName"`
+	DBSecurityGroups           []string         `query:"DBSecurityGroups,list:DBSecurityGroupName" xml:"DBSecurityGroups>DBSecurityGroupName"`
 	EngineVersion              aws.StringValue  `query:"EngineVersion" xml:"EngineVersion"`
 	IOPS                       aws.IntegerValue `query:"Iops" xml:"Iops"`
 	MasterUserPassword         aws.StringValue  `query:"MasterUserPassword" xml:"MasterUserPassword"`
@@ -1322,7 +2967,90 @@ type ModifyDBInstanceMessage struct {
 	StorageType                aws.StringValue  `query:"StorageType" xml:"StorageType"`
 	TDECredentialARN           aws.StringValue  `query:"TdeCredentialArn" xml:"TdeCredentialArn"`
 	TDECredentialPassword      aws.StringValue  `query:"TdeCredentialPassword" xml:"TdeCredentialPassword"`
-	VPCSecurityGroupIDs        []string         `query:"VpcSecurityGroupIds.member" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+	VPCSecurityGroupIDs        []string         `query:"VpcSecurityGroupIds,list:VpcSecurityGroupId" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+}
+
+// MarshalQuery adds the parameters of the ModifyDBInstanceMessage to the form, prefixing their names.
+func (v *ModifyDBInstanceMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllocatedStorage != nil {
+		form.Set(prefix+"AllocatedStorage", strconv.FormatInt(int64(*v.AllocatedStorage), 10))
+	}
+	if v.AllowMajorVersionUpgrade != nil {
+		form.Set(prefix+"AllowMajorVersionUpgrade", strconv.FormatBool(*v.AllowMajorVersionUpgrade))
+	}
+	if v.ApplyImmediately != nil {
+		form.Set(prefix+"ApplyImmediately", strconv.FormatBool(*v.ApplyImmediately))
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.BackupRetentionPeriod != nil {
+		form.Set(prefix+"BackupRetentionPeriod", strconv.FormatInt(int64(*v.BackupRetentionPeriod), 10))
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	if v.DBSecurityGroups != nil {
+		if len(v.DBSecurityGroups) == 0 {
+			form.Set(prefix+"DBSecurityGroups", "")
+		}
+		for i1 := range v.DBSecurityGroups {
+			name1 := prefix + "DBSecurityGroups.DBSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.DBSecurityGroups[i1])
+		}
+	}
+	if v.EngineVersion != nil {
+		form.Set(prefix+"EngineVersion", *v.EngineVersion)
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.MasterUserPassword != nil {
+		form.Set(prefix+"MasterUserPassword", *v.MasterUserPassword)
+	}
+	if v.MultiAZ != nil {
+		form.Set(prefix+"MultiAZ", strconv.FormatBool(*v.MultiAZ))
+	}
+	if v.NewDBInstanceIdentifier != nil {
+		form.Set(prefix+"NewDBInstanceIdentifier", *v.NewDBInstanceIdentifier)
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	if v.PreferredBackupWindow != nil {
+		form.Set(prefix+"PreferredBackupWindow", *v.PreferredBackupWindow)
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.StorageType != nil {
+		form.Set(prefix+"StorageType", *v.StorageType)
+	}
+	if v.TDECredentialARN != nil {
+		form.Set(prefix+"TdeCredentialArn", *v.TDECredentialARN)
+	}
+	if v.TDECredentialPassword != nil {
+		form.Set(prefix+"TdeCredentialPassword", *v.TDECredentialPassword)
+	}
+	if v.VPCSecurityGroupIDs != nil {
+		if len(v.VPCSecurityGroupIDs) == 0 {
+			form.Set(prefix+"VpcSecurityGroupIds", "")
+		}
+		for i1 := range v.VPCSecurityGroupIDs {
+			name1 := prefix + "VpcSecurityGroupIds.VpcSecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCSecurityGroupIDs[i1])
+		}
+	}
+	return nil
 }
 
 // ModifyDBInstanceResult is undocumented.
@@ -1333,14 +3061,59 @@ type ModifyDBInstanceResult struct {
 // ModifyDBParameterGroupMessage is undocumented.
 type ModifyDBParameterGroupMessage struct {
 	DBParameterGroupName aws.StringValue `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
-	Parameters           []Parameter     `query:"Parameters.member" xml:"Parameters>Parameter"`
+	Parameters           []Parameter     `query:"Parameters,list:Parameter" xml:"Parameters>Parameter"`
+}
+
+// MarshalQuery adds the parameters of the ModifyDBParameterGroupMessage to the form, prefixing their names.
+func (v *ModifyDBParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	if v.Parameters != nil {
+		if len(v.Parameters) == 0 {
+			form.Set(prefix+"Parameters", "")
+		}
+		for i1 := range v.Parameters {
+			name1 := prefix + "Parameters.Parameter." + strconv.Itoa(i1+1)
+			if err := v.Parameters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // ModifyDBSubnetGroupMessage is undocumented.
 type ModifyDBSubnetGroupMessage struct {
 	DBSubnetGroupDescription aws.StringValue `query:"DBSubnetGroupDescription" xml:"DBSubnetGroupDescription"`
 	DBSubnetGroupName        aws.StringValue `query:"DBSubnetGroupName" xml:"DBSubnetGroupName"`
-	SubnetIDs                []string        `query:"SubnetIds.member" xml:"SubnetIds>SubnetIdentifier"`
+	SubnetIDs                []string        `query:"SubnetIds,list:SubnetIdentifier" xml:"SubnetIds>SubnetIdentifier"`
+}
+
+// MarshalQuery adds the parameters of the ModifyDBSubnetGroupMessage to the form, prefixing their names.
+func (v *ModifyDBSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSubnetGroupDescription != nil {
+		form.Set(prefix+"DBSubnetGroupDescription", *v.DBSubnetGroupDescription)
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	if v.SubnetIDs != nil {
+		if len(v.SubnetIDs) == 0 {
+			form.Set(prefix+"SubnetIds", "")
+		}
+		for i1 := range v.SubnetIDs {
+			name1 := prefix + "SubnetIds.SubnetIdentifier." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SubnetIDs[i1])
+		}
+	}
+	return nil
 }
 
 // ModifyDBSubnetGroupResult is undocumented.
@@ -1351,12 +3124,41 @@ type ModifyDBSubnetGroupResult struct {
 // ModifyEventSubscriptionMessage is undocumented.
 type ModifyEventSubscriptionMessage struct {
 	Enabled          aws.BooleanValue `query:"Enabled" xml:"Enabled"`
-	EventCategories  []string         `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories  []string         `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	SNSTopicARN      aws.StringValue  `query:"SnsTopicArn" xml:"SnsTopicArn"`
 	SourceType       aws.StringValue  `query:"SourceType" xml:"SourceType"`
 	SubscriptionName aws.StringValue  `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the ModifyEventSubscriptionMessage to the form, prefixing their names.
+func (v *ModifyEventSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	if v.EventCategories != nil {
+		if len(v.EventCategories) == 0 {
+			form.Set(prefix+"EventCategories", "")
+		}
+		for i1 := range v.EventCategories {
+			name1 := prefix + "EventCategories.EventCategory." + strconv.Itoa(i1+1)
+			form.Set(name1, v.EventCategories[i1])
+		}
+	}
+	if v.SNSTopicARN != nil {
+		form.Set(prefix+"SnsTopicArn", *v.SNSTopicARN)
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // ModifyEventSubscriptionResult is undocumented.
 type ModifyEventSubscriptionResult struct {
 	EventSubscription *EventSubscription `query:"EventSubscription" xml:"ModifyEventSubscriptionResult>EventSubscription"`
@@ -1366,8 +3168,42 @@ type ModifyEventSubscriptionResult struct {
 type ModifyOptionGroupMessage struct {
 	ApplyImmediately aws.BooleanValue      `query:"ApplyImmediately" xml:"ApplyImmediately"`
 	OptionGroupName  aws.StringValue       `query:"OptionGroupName" xml:"OptionGroupName"`
-	OptionsToInclude []OptionConfiguration `query:"OptionsToInclude.member" xml:"OptionsToInclude>OptionConfiguration"`
-	OptionsToRemove  []string              `query:"OptionsToRemove.member" xml:"OptionsToRemove>member"`
+	OptionsToInclude []OptionConfiguration `query:"OptionsToInclude,list:OptionConfiguration" xml:"OptionsToInclude>OptionConfiguration"`
+	OptionsToRemove  []string              `query:"OptionsToRemove,list:member" xml:"OptionsToRemove>member"`
+}
+
+// MarshalQuery adds the parameters of the ModifyOptionGroupMessage to the form, prefixing their names.
+func (v *ModifyOptionGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ApplyImmediately != nil {
+		form.Set(prefix+"ApplyImmediately", strconv.FormatBool(*v.ApplyImmediately))
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	if v.OptionsToInclude != nil {
+		if len(v.OptionsToInclude) == 0 {
+			form.Set(prefix+"OptionsToInclude", "")
+		}
+		for i1 := range v.OptionsToInclude {
+			name1 := prefix + "OptionsToInclude.OptionConfiguration." + strconv.Itoa(i1+1)
+			if err := v.OptionsToInclude[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.OptionsToRemove != nil {
+		if len(v.OptionsToRemove) == 0 {
+			form.Set(prefix+"OptionsToRemove", "")
+		}
+		for i1 := range v.OptionsToRemove {
+			name1 := prefix + "OptionsToRemove.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.OptionsToRemove[i1])
+		}
+	}
+	return nil
 }
 
 // ModifyOptionGroupResult is undocumented.
@@ -1377,23 +3213,66 @@ type ModifyOptionGroupResult struct {
 
 // Option is undocumented.
 type Option struct {
-	DBSecurityGroupMemberships  []DBSecurityGroupMembership  `query:"DBSecurityGroupMemberships.member" xml:"DBSecurityGroupMemberships>DBSecurityGroup"`
+	DBSecurityGroupMemberships  []DBSecurityGroupMembership  `query:"DBSecurityGroupMemberships,list:DBSecurityGroup" xml:"DBSecurityGroupMemberships>DBSecurityGroup"`
 	OptionDescription           aws.StringValue              `query:"OptionDescription" xml:"OptionDescription"`
 	OptionName                  aws.StringValue              `query:"OptionName" xml:"OptionName"`
-	OptionSettings              []OptionSetting              `query:"OptionSettings.member" xml:"OptionSettings>OptionSetting"`
+	OptionSettings              []OptionSetting              `query:"OptionSettings,list:OptionSetting" xml:"OptionSettings>OptionSetting"`
 	Permanent                   aws.BooleanValue             `query:"Permanent" xml:"Permanent"`
 	Persistent                  aws.BooleanValue             `query:"Persistent" xml:"Persistent"`
 	Port                        aws.IntegerValue             `query:"Port" xml:"Port"`
-	VPCSecurityGroupMemberships []VPCSecurityGroupMembership `query:"VpcSecurityGroupMemberships.member" xml:"VpcSecurityGroupMemberships>VpcSecurityGroupMembership"`
+	VPCSecurityGroupMemberships []VPCSecurityGroupMembership `query:"VpcSecurityGroupMemberships,list:VpcSecurityGroupMembership" xml:"VpcSecurityGroupMemberships>VpcSecurityGroupMembership"`
 }
 
 // OptionConfiguration is undocumented.
 type OptionConfiguration struct {
-	DBSecurityGroupMemberships  []string         `query:"DBSecurityGroupMemberships.member" xml:"DBSecurityGroupMemberships>DBSecurityGroupName"`
+	DBSecurityGroupMemberships  []string         `query:"DBSecurityGroupMemberships,list:DBSecurityGroupName" xml:"DBSecurityGroupMemberships>DBSecurityGroupName"`
 	OptionName                  aws.StringValue  `query:"OptionName" xml:"OptionName"`
-	OptionSettings              []OptionSetting  `query:"OptionSettings.member" xml:"OptionSettings>OptionSetting"`
+	OptionSettings              []OptionSetting  `query:"OptionSettings,list:OptionSetting" xml:"OptionSettings>OptionSetting"`
 	Port                        aws.IntegerValue `query:"Port" xml:"Port"`
-	VPCSecurityGroupMemberships []string         `query:"VpcSecurityGroupMemberships.member" xml:"VpcSecurityGroupMemberships>VpcSecurityGroupId"`
+	VPCSecurityGroupMemberships []string         `query:"VpcSecurityGroupMemberships,list:VpcSecurityGroupId" xml:"VpcSecurityGroupMemberships>VpcSecurityGroupId"`
+}
+
+// MarshalQuery adds the parameters of the OptionConfiguration to the form, prefixing their names.
+func (v *OptionConfiguration) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBSecurityGroupMemberships != nil {
+		if len(v.DBSecurityGroupMemberships) == 0 {
+			form.Set(prefix+"DBSecurityGroupMemberships", "")
+		}
+		for i1 := range v.DBSecurityGroupMemberships {
+			name1 := prefix + "DBSecurityGroupMemberships.DBSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.DBSecurityGroupMemberships[i1])
+		}
+	}
+	if v.OptionName != nil {
+		form.Set(prefix+"OptionName", *v.OptionName)
+	}
+	if v.OptionSettings != nil {
+		if len(v.OptionSettings) == 0 {
+			form.Set(prefix+"OptionSettings", "")
+		}
+		for i1 := range v.OptionSettings {
+			name1 := prefix + "OptionSettings.OptionSetting." + strconv.Itoa(i1+1)
+			if err := v.OptionSettings[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.VPCSecurityGroupMemberships != nil {
+		if len(v.VPCSecurityGroupMemberships) == 0 {
+			form.Set(prefix+"VpcSecurityGroupMemberships", "")
+		}
+		for i1 := range v.VPCSecurityGroupMemberships {
+			name1 := prefix + "VpcSecurityGroupMemberships.VpcSecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCSecurityGroupMemberships[i1])
+		}
+	}
+	return nil
 }
 
 // OptionGroup is undocumented.
@@ -1403,7 +3282,7 @@ type OptionGroup struct {
 	MajorEngineVersion                    aws.StringValue  `query:"MajorEngineVersion" xml:"MajorEngineVersion"`
 	OptionGroupDescription                aws.StringValue  `query:"OptionGroupDescription" xml:"OptionGroupDescription"`
 	OptionGroupName                       aws.StringValue  `query:"OptionGroupName" xml:"OptionGroupName"`
-	Options                               []Option         `query:"Options.member" xml:"Options>Option"`
+	Options                               []Option         `query:"Options,list:Option" xml:"Options>Option"`
 	VPCID                                 aws.StringValue  `query:"VpcId" xml:"VpcId"`
 }
 
@@ -1421,8 +3300,8 @@ type OptionGroupOption struct {
 	MajorEngineVersion                aws.StringValue            `query:"MajorEngineVersion" xml:"MajorEngineVersion"`
 	MinimumRequiredMinorEngineVersion aws.StringValue            `query:"MinimumRequiredMinorEngineVersion" xml:"MinimumRequiredMinorEngineVersion"`
 	Name                              aws.StringValue            `query:"Name" xml:"Name"`
-	OptionGroupOptionSettings         []OptionGroupOptionSetting `query:"OptionGroupOptionSettings.member" xml:"OptionGroupOptionSettings>OptionGroupOptionSetting"`
-	OptionsDependedOn                 []string                   `query:"OptionsDependedOn.member" xml:"OptionsDependedOn>OptionName"`
+	OptionGroupOptionSettings         []OptionGroupOptionSetting `query:"OptionGroupOptionSettings,list:OptionGroupOptionSetting" xml:"OptionGroupOptionSettings>OptionGroupOptionSetting"`
+	OptionsDependedOn                 []string                   `query:"OptionsDependedOn,list:OptionName" xml:"OptionsDependedOn>OptionName"`
 	Permanent                         aws.BooleanValue           `query:"Permanent" xml:"Permanent"`
 	Persistent                        aws.BooleanValue           `query:"Persistent" xml:"Persistent"`
 	PortRequired                      aws.BooleanValue           `query:"PortRequired" xml:"PortRequired"`
@@ -1441,13 +3320,13 @@ type OptionGroupOptionSetting struct {
 // OptionGroupOptionsMessage is undocumented.
 type OptionGroupOptionsMessage struct {
 	Marker             aws.StringValue     `query:"Marker" xml:"DescribeOptionGroupOptionsResult>Marker"`
-	OptionGroupOptions []OptionGroupOption `query:"OptionGroupOptions.member" xml:"DescribeOptionGroupOptionsResult>OptionGroupOptions>OptionGroupOption"`
+	OptionGroupOptions []OptionGroupOption `query:"OptionGroupOptions,list:OptionGroupOption" xml:"DescribeOptionGroupOptionsResult>OptionGroupOptions>OptionGroupOption"`
 }
 
 // OptionGroups is undocumented.
 type OptionGroups struct {
 	Marker           aws.StringValue `query:"Marker" xml:"DescribeOptionGroupsResult>Marker"`
-	OptionGroupsList []OptionGroup   `query:"OptionGroupsList.member" xml:"DescribeOptionGroupsResult>OptionGroupsList>OptionGroup"`
+	OptionGroupsList []OptionGroup   `query:"OptionGroupsList,list:OptionGroup" xml:"DescribeOptionGroupsResult>OptionGroupsList>OptionGroup"`
 }
 
 // OptionSetting is undocumented.
@@ -1463,9 +3342,44 @@ type OptionSetting struct {
 	Value         aws.StringValue  `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the OptionSetting to the form, prefixing their names.
+func (v *OptionSetting) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowedValues != nil {
+		form.Set(prefix+"AllowedValues", *v.AllowedValues)
+	}
+	if v.ApplyType != nil {
+		form.Set(prefix+"ApplyType", *v.ApplyType)
+	}
+	if v.DataType != nil {
+		form.Set(prefix+"DataType", *v.DataType)
+	}
+	if v.DefaultValue != nil {
+		form.Set(prefix+"DefaultValue", *v.DefaultValue)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.IsCollection != nil {
+		form.Set(prefix+"IsCollection", strconv.FormatBool(*v.IsCollection))
+	}
+	if v.IsModifiable != nil {
+		form.Set(prefix+"IsModifiable", strconv.FormatBool(*v.IsModifiable))
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // OrderableDBInstanceOption is undocumented.
 type OrderableDBInstanceOption struct {
-	AvailabilityZones  []AvailabilityZone `query:"AvailabilityZones.member" xml:"AvailabilityZones>AvailabilityZone"`
+	AvailabilityZones  []AvailabilityZone `query:"AvailabilityZones,list:AvailabilityZone" xml:"AvailabilityZones>AvailabilityZone"`
 	DBInstanceClass    aws.StringValue    `query:"DBInstanceClass" xml:"DBInstanceClass"`
 	Engine             aws.StringValue    `query:"Engine" xml:"Engine"`
 	EngineVersion      aws.StringValue    `query:"EngineVersion" xml:"EngineVersion"`
@@ -1480,7 +3394,7 @@ type OrderableDBInstanceOption struct {
 // OrderableDBInstanceOptionsMessage is undocumented.
 type OrderableDBInstanceOptionsMessage struct {
 	Marker                     aws.StringValue             `query:"Marker" xml:"DescribeOrderableDBInstanceOptionsResult>Marker"`
-	OrderableDBInstanceOptions []OrderableDBInstanceOption `query:"OrderableDBInstanceOptions.member" xml:"DescribeOrderableDBInstanceOptionsResult>OrderableDBInstanceOptions>OrderableDBInstanceOption"`
+	OrderableDBInstanceOptions []OrderableDBInstanceOption `query:"OrderableDBInstanceOptions,list:OrderableDBInstanceOption" xml:"DescribeOrderableDBInstanceOptionsResult>OrderableDBInstanceOptions>OrderableDBInstanceOption"`
 }
 
 // Parameter is undocumented.
@@ -1497,6 +3411,44 @@ type Parameter struct {
 	Source               aws.StringValue  `query:"Source" xml:"Source"`
 }
 
+// MarshalQuery adds the parameters of the Parameter to the form, prefixing their names.
+func (v *Parameter) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowedValues != nil {
+		form.Set(prefix+"AllowedValues", *v.AllowedValues)
+	}
+	if v.ApplyMethod != nil {
+		form.Set(prefix+"ApplyMethod", *v.ApplyMethod)
+	}
+	if v.ApplyType != nil {
+		form.Set(prefix+"ApplyType", *v.ApplyType)
+	}
+	if v.DataType != nil {
+		form.Set(prefix+"DataType", *v.DataType)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.IsModifiable != nil {
+		form.Set(prefix+"IsModifiable", strconv.FormatBool(*v.IsModifiable))
+	}
+	if v.MinimumEngineVersion != nil {
+		form.Set(prefix+"MinimumEngineVersion", *v.MinimumEngineVersion)
+	}
+	if v.ParameterName != nil {
+		form.Set(prefix+"ParameterName", *v.ParameterName)
+	}
+	if v.ParameterValue != nil {
+		form.Set(prefix+"ParameterValue", *v.ParameterValue)
+	}
+	if v.Source != nil {
+		form.Set(prefix+"Source", *v.Source)
+	}
+	return nil
+}
+
 // PendingModifiedValues is undocumented.
 type PendingModifiedValues struct {
 	AllocatedStorage      aws.IntegerValue `query:"AllocatedStorage" xml:"AllocatedStorage"`
@@ -1518,6 +3470,23 @@ type PromoteReadReplicaMessage struct {
 	PreferredBackupWindow aws.StringValue  `query:"PreferredBackupWindow" xml:"PreferredBackupWindow"`
 }
 
+// MarshalQuery adds the parameters of the PromoteReadReplicaMessage to the form, prefixing their names.
+func (v *PromoteReadReplicaMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BackupRetentionPeriod != nil {
+		form.Set(prefix+"BackupRetentionPeriod", strconv.FormatInt(int64(*v.BackupRetentionPeriod), 10))
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.PreferredBackupWindow != nil {
+		form.Set(prefix+"PreferredBackupWindow", *v.PreferredBackupWindow)
+	}
+	return nil
+}
+
 // PromoteReadReplicaResult is undocumented.
 type PromoteReadReplicaResult struct {
 	DBInstance *DBInstance `query:"DBInstance" xml:"PromoteReadReplicaResult>DBInstance"`
@@ -1528,7 +3497,35 @@ type PurchaseReservedDBInstancesOfferingMessage struct {
 	DBInstanceCount               aws.IntegerValue `query:"DBInstanceCount" xml:"DBInstanceCount"`
 	ReservedDBInstanceID          aws.StringValue  `query:"ReservedDBInstanceId" xml:"ReservedDBInstanceId"`
 	ReservedDBInstancesOfferingID aws.StringValue  `query:"ReservedDBInstancesOfferingId" xml:"ReservedDBInstancesOfferingId"`
-	Tags                          []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                          []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the PurchaseReservedDBInstancesOfferingMessage to the form, prefixing their names.
+func (v *PurchaseReservedDBInstancesOfferingMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceCount != nil {
+		form.Set(prefix+"DBInstanceCount", strconv.FormatInt(int64(*v.DBInstanceCount), 10))
+	}
+	if v.ReservedDBInstanceID != nil {
+		form.Set(prefix+"ReservedDBInstanceId", *v.ReservedDBInstanceID)
+	}
+	if v.ReservedDBInstancesOfferingID != nil {
+		form.Set(prefix+"ReservedDBInstancesOfferingId", *v.ReservedDBInstancesOfferingID)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // PurchaseReservedDBInstancesOfferingResult is undocumented.
@@ -1542,6 +3539,20 @@ type RebootDBInstanceMessage struct {
 	ForceFailover        aws.BooleanValue `query:"ForceFailover" xml:"ForceFailover"`
 }
 
+// MarshalQuery adds the parameters of the RebootDBInstanceMessage to the form, prefixing their names.
+func (v *RebootDBInstanceMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.ForceFailover != nil {
+		form.Set(prefix+"ForceFailover", strconv.FormatBool(*v.ForceFailover))
+	}
+	return nil
+}
+
 // RebootDBInstanceResult is undocumented.
 type RebootDBInstanceResult struct {
 	DBInstance *DBInstance `query:"DBInstance" xml:"RebootDBInstanceResult>DBInstance"`
@@ -1559,6 +3570,20 @@ type RemoveSourceIdentifierFromSubscriptionMessage struct {
 	SubscriptionName aws.StringValue `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the RemoveSourceIdentifierFromSubscriptionMessage to the form, prefixing their names.
+func (v *RemoveSourceIdentifierFromSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceIdentifier != nil {
+		form.Set(prefix+"SourceIdentifier", *v.SourceIdentifier)
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // RemoveSourceIdentifierFromSubscriptionResult is undocumented.
 type RemoveSourceIdentifierFromSubscriptionResult struct {
 	EventSubscription *EventSubscription `query:"EventSubscription" xml:"RemoveSourceIdentifierFromSubscriptionResult>EventSubscription"`
@@ -1567,7 +3592,27 @@ type RemoveSourceIdentifierFromSubscriptionResult struct {
 // RemoveTagsFromResourceMessage is undocumented.
 type RemoveTagsFromResourceMessage struct {
 	ResourceName aws.StringValue `query:"ResourceName" xml:"ResourceName"`
-	TagKeys      []string        `query:"TagKeys.member" xml:"TagKeys>member"`
+	TagKeys      []string        `query:"TagKeys,list:member" xml:"TagKeys>member"`
+}
+
+// MarshalQuery adds the parameters of the RemoveTagsFromResourceMessage to the form, prefixing their names.
+func (v *RemoveTagsFromResourceMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ResourceName != nil {
+		form.Set(prefix+"ResourceName", *v.ResourceName)
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	return nil
 }
 
 // ReservedDBInstance is undocumented.
@@ -1580,7 +3625,7 @@ type ReservedDBInstance struct {
 	MultiAZ                       aws.BooleanValue  `query:"MultiAZ" xml:"MultiAZ"`
 	OfferingType                  aws.StringValue   `query:"OfferingType" xml:"OfferingType"`
 	ProductDescription            aws.StringValue   `query:"ProductDescription" xml:"ProductDescription"`
-	RecurringCharges              []RecurringCharge `query:"RecurringCharges.member" xml:"RecurringCharges>RecurringCharge"`
+	RecurringCharges              []RecurringCharge `query:"RecurringCharges,list:RecurringCharge" xml:"RecurringCharges>RecurringCharge"`
 	ReservedDBInstanceID          aws.StringValue   `query:"ReservedDBInstanceId" xml:"ReservedDBInstanceId"`
 	ReservedDBInstancesOfferingID aws.StringValue   `query:"ReservedDBInstancesOfferingId" xml:"ReservedDBInstancesOfferingId"`
 	StartTime                     time.Time         `query:"StartTime" xml:"StartTime"`
@@ -1591,7 +3636,7 @@ type ReservedDBInstance struct {
 // ReservedDBInstanceMessage is undocumented.
 type ReservedDBInstanceMessage struct {
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeReservedDBInstancesResult>Marker"`
-	ReservedDBInstances []ReservedDBInstance `query:"ReservedDBInstances.member" xml:"DescribeReservedDBInstancesResult>ReservedDBInstances>ReservedDBInstance"`
+	ReservedDBInstances []ReservedDBInstance `query:"ReservedDBInstances,list:ReservedDBInstance" xml:"DescribeReservedDBInstancesResult>ReservedDBInstances>ReservedDBInstance"`
 }
 
 // ReservedDBInstancesOffering is undocumented.
@@ -1603,7 +3648,7 @@ type ReservedDBInstancesOffering struct {
 	MultiAZ                       aws.BooleanValue  `query:"MultiAZ" xml:"MultiAZ"`
 	OfferingType                  aws.StringValue   `query:"OfferingType" xml:"OfferingType"`
 	ProductDescription            aws.StringValue   `query:"ProductDescription" xml:"ProductDescription"`
-	RecurringCharges              []RecurringCharge `query:"RecurringCharges.member" xml:"RecurringCharges>RecurringCharge"`
+	RecurringCharges              []RecurringCharge `query:"RecurringCharges,list:RecurringCharge" xml:"RecurringCharges>RecurringCharge"`
 	ReservedDBInstancesOfferingID aws.StringValue   `query:"ReservedDBInstancesOfferingId" xml:"ReservedDBInstancesOfferingId"`
 	UsagePrice                    aws.DoubleValue   `query:"UsagePrice" xml:"UsagePrice"`
 }
@@ -1611,16 +3656,41 @@ type ReservedDBInstancesOffering struct {
 // ReservedDBInstancesOfferingMessage is undocumented.
 type ReservedDBInstancesOfferingMessage struct {
 	Marker                       aws.StringValue               `query:"Marker" xml:"DescribeReservedDBInstancesOfferingsResult>Marker"`
-	ReservedDBInstancesOfferings []ReservedDBInstancesOffering `query:"ReservedDBInstancesOfferings.member" xml:"DescribeReservedDBInstancesOfferingsResult>ReservedDBInstancesOfferings>ReservedDBInstancesOffering"`
+	ReservedDBInstancesOfferings []ReservedDBInstancesOffering `query:"ReservedDBInstancesOfferings,list:ReservedDBInstancesOffering" xml:"DescribeReservedDBInstancesOfferingsResult>ReservedDBInstancesOfferings>ReservedDBInstancesOffering"`
 }
 
 // ResetDBParameterGroupMessage is undocumented.
 type ResetDBParameterGroupMessage struct {
 	DBParameterGroupName aws.StringValue  `query:"DBParameterGroupName" xml:"DBParameterGroupName"`
-	Parameters           []Parameter      `query:"Parameters.member" xml:"Parameters>Parameter"`
+	Parameters           []Parameter      `query:"Parameters,list:Parameter" xml:"Parameters>Parameter"`
 	ResetAllParameters   aws.BooleanValue `query:"ResetAllParameters" xml:"ResetAllParameters"`
 }
 
+// MarshalQuery adds the parameters of the ResetDBParameterGroupMessage to the form, prefixing their names.
+func (v *ResetDBParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DBParameterGroupName != nil {
+		form.Set(prefix+"DBParameterGroupName", *v.DBParameterGroupName)
+	}
+	if v.Parameters != nil {
+		if len(v.Parameters) == 0 {
+			form.Set(prefix+"Parameters", "")
+		}
+		for i1 := range v.Parameters {
+			name1 := prefix + "Parameters.Parameter." + strconv.Itoa(i1+1)
+			if err := v.Parameters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ResetAllParameters != nil {
+		form.Set(prefix+"ResetAllParameters", strconv.FormatBool(*v.ResetAllParameters))
+	}
+	return nil
+}
+
 // RestoreDBInstanceFromDBSnapshotMessage is undocumented.
 type RestoreDBInstanceFromDBSnapshotMessage struct {
 	AutoMinorVersionUpgrade aws.BooleanValue `query:"AutoMinorVersionUpgrade" xml:"AutoMinorVersionUpgrade"`
@@ -1638,11 +3708,81 @@ type RestoreDBInstanceFromDBSnapshotMessage struct {
 	Port                    aws.IntegerValue `query:"Port" xml:"Port"`
 	PubliclyAccessible      aws.BooleanValue `query:"PubliclyAccessible" xml:"PubliclyAccessible"`
 	StorageType             aws.StringValue  `query:"StorageType" xml:"StorageType"`
-	Tags                    []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                    []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	TDECredentialARN        aws.StringValue  `query:"TdeCredentialArn" xml:"TdeCredentialArn"`
 	TDECredentialPassword   aws.StringValue  `query:"TdeCredentialPassword" xml:"TdeCredentialPassword"`
 }
 
+// MarshalQuery adds the parameters of the RestoreDBInstanceFromDBSnapshotMessage to the form, prefixing their names.
+func (v *RestoreDBInstanceFromDBSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.DBInstanceIdentifier != nil {
+		form.Set(prefix+"DBInstanceIdentifier", *v.DBInstanceIdentifier)
+	}
+	if v.DBName != nil {
+		form.Set(prefix+"DBName", *v.DBName)
+	}
+	if v.DBSnapshotIdentifier != nil {
+		form.Set(prefix+"DBSnapshotIdentifier", *v.DBSnapshotIdentifier)
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.LicenseModel != nil {
+		form.Set(prefix+"LicenseModel", *v.LicenseModel)
+	}
+	if v.MultiAZ != nil {
+		form.Set(prefix+"MultiAZ", strconv.FormatBool(*v.MultiAZ))
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PubliclyAccessible != nil {
+		form.Set(prefix+"PubliclyAccessible", strconv.FormatBool(*v.PubliclyAccessible))
+	}
+	if v.StorageType != nil {
+		form.Set(prefix+"StorageType", *v.StorageType)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TDECredentialARN != nil {
+		form.Set(prefix+"TdeCredentialArn", *v.TDECredentialARN)
+	}
+	if v.TDECredentialPassword != nil {
+		form.Set(prefix+"TdeCredentialPassword", *v.TDECredentialPassword)
+	}
+	return nil
+}
+
 // RestoreDBInstanceFromDBSnapshotResult is undocumented.
 type RestoreDBInstanceFromDBSnapshotResult struct {
 	DBInstance *DBInstance `query:"DBInstance" xml:"RestoreDBInstanceFromDBSnapshotResult>DBInstance"`
@@ -1665,13 +3805,89 @@ type RestoreDBInstanceToPointInTimeMessage struct {
 	RestoreTime                time.Time        `query:"RestoreTime" xml:"RestoreTime"`
 	SourceDBInstanceIdentifier aws.StringValue  `query:"SourceDBInstanceIdentifier" xml:"SourceDBInstanceIdentifier"`
 	StorageType                aws.StringValue  `query:"StorageType" xml:"StorageType"`
-	Tags                       []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                       []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	TargetDBInstanceIdentifier aws.StringValue  `query:"TargetDBInstanceIdentifier" xml:"TargetDBInstanceIdentifier"`
 	TDECredentialARN           aws.StringValue  `query:"TdeCredentialArn" xml:"TdeCredentialArn"`
 	TDECredentialPassword      aws.StringValue  `query:"TdeCredentialPassword" xml:"TdeCredentialPassword"`
 	UseLatestRestorableTime    aws.BooleanValue `query:"UseLatestRestorableTime" xml:"UseLatestRestorableTime"`
 }
 
+// MarshalQuery adds the parameters of the RestoreDBInstanceToPointInTimeMessage to the form, prefixing their names.
+func (v *RestoreDBInstanceToPointInTimeMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AutoMinorVersionUpgrade != nil {
+		form.Set(prefix+"AutoMinorVersionUpgrade", strconv.FormatBool(*v.AutoMinorVersionUpgrade))
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.DBInstanceClass != nil {
+		form.Set(prefix+"DBInstanceClass", *v.DBInstanceClass)
+	}
+	if v.DBName != nil {
+		form.Set(prefix+"DBName", *v.DBName)
+	}
+	if v.DBSubnetGroupName != nil {
+		form.Set(prefix+"DBSubnetGroupName", *v.DBSubnetGroupName)
+	}
+	if v.Engine != nil {
+		form.Set(prefix+"Engine", *v.Engine)
+	}
+	if v.IOPS != nil {
+		form.Set(prefix+"Iops", strconv.FormatInt(int64(*v.IOPS), 10))
+	}
+	if v.LicenseModel != nil {
+		form.Set(prefix+"LicenseModel", *v.LicenseModel)
+	}
+	if v.MultiAZ != nil {
+		form.Set(prefix+"MultiAZ", strconv.FormatBool(*v.MultiAZ))
+	}
+	if v.OptionGroupName != nil {
+		form.Set(prefix+"OptionGroupName", *v.OptionGroupName)
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PubliclyAccessible != nil {
+		form.Set(prefix+"PubliclyAccessible", strconv.FormatBool(*v.PubliclyAccessible))
+	}
+	if !v.RestoreTime.IsZero() {
+		form.Set(prefix+"RestoreTime", v.RestoreTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.SourceDBInstanceIdentifier != nil {
+		form.Set(prefix+"SourceDBInstanceIdentifier", *v.SourceDBInstanceIdentifier)
+	}
+	if v.StorageType != nil {
+		form.Set(prefix+"StorageType", *v.StorageType)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.TargetDBInstanceIdentifier != nil {
+		form.Set(prefix+"TargetDBInstanceIdentifier", *v.TargetDBInstanceIdentifier)
+	}
+	if v.TDECredentialARN != nil {
+		form.Set(prefix+"TdeCredentialArn", *v.TDECredentialARN)
+	}
+	if v.TDECredentialPassword != nil {
+		form.Set(prefix+"TdeCredentialPassword", *v.TDECredentialPassword)
+	}
+	if v.UseLatestRestorableTime != nil {
+		form.Set(prefix+"UseLatestRestorableTime", strconv.FormatBool(*v.UseLatestRestorableTime))
+	}
+	return nil
+}
+
 // RestoreDBInstanceToPointInTimeResult is undocumented.
 type RestoreDBInstanceToPointInTimeResult struct {
 	DBInstance *DBInstance `query:"DBInstance" xml:"RestoreDBInstanceToPointInTimeResult>DBInstance"`
@@ -1686,6 +3902,29 @@ type RevokeDBSecurityGroupIngressMessage struct {
 	EC2SecurityGroupOwnerID aws.StringValue `query:"EC2SecurityGroupOwnerId" xml:"EC2SecurityGroupOwnerId"`
 }
 
+// MarshalQuery adds the parameters of the RevokeDBSecurityGroupIngressMessage to the form, prefixing their names.
+func (v *RevokeDBSecurityGroupIngressMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CIDRIP", *v.CIDRIP)
+	}
+	if v.DBSecurityGroupName != nil {
+		form.Set(prefix+"DBSecurityGroupName", *v.DBSecurityGroupName)
+	}
+	if v.EC2SecurityGroupID != nil {
+		form.Set(prefix+"EC2SecurityGroupId", *v.EC2SecurityGroupID)
+	}
+	if v.EC2SecurityGroupName != nil {
+		form.Set(prefix+"EC2SecurityGroupName", *v.EC2SecurityGroupName)
+	}
+	if v.EC2SecurityGroupOwnerID != nil {
+		form.Set(prefix+"EC2SecurityGroupOwnerId", *v.EC2SecurityGroupOwnerID)
+	}
+	return nil
+}
+
 // RevokeDBSecurityGroupIngressResult is undocumented.
 type RevokeDBSecurityGroupIngressResult struct {
 	DBSecurityGroup *DBSecurityGroup `query:"DBSecurityGroup" xml:"RevokeDBSecurityGroupIngressResult>DBSecurityGroup"`
@@ -1712,9 +3951,23 @@ type Tag struct {
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Tag to the form, prefixing their names.
+func (v *Tag) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // TagListMessage is undocumented.
 type TagListMessage struct {
-	TagList []Tag `query:"TagList.member" xml:"ListTagsForResourceResult>TagList>Tag"`
+	TagList []Tag `query:"TagList,list:Tag" xml:"ListTagsForResourceResult>TagList>Tag"`
 }
 
 // VPCSecurityGroupMembership is undocumented.
@@ -1725,97 +3978,97 @@ type VPCSecurityGroupMembership struct {
 
 // DescribeDBEngineVersionsResult is a wrapper for DBEngineVersionMessage.
 type DescribeDBEngineVersionsResult struct {
-	DBEngineVersions []DBEngineVersion `query:"DBEngineVersions.member" xml:"DescribeDBEngineVersionsResult>DBEngineVersions>DBEngineVersion"`
+	DBEngineVersions []DBEngineVersion `query:"DBEngineVersions,list:DBEngineVersion" xml:"DescribeDBEngineVersionsResult>DBEngineVersions>DBEngineVersion"`
 	Marker           aws.StringValue   `query:"Marker" xml:"DescribeDBEngineVersionsResult>Marker"`
 }
 
 // DescribeDBInstancesResult is a wrapper for DBInstanceMessage.
 type DescribeDBInstancesResult struct {
-	DBInstances []DBInstance    `query:"DBInstances.member" xml:"DescribeDBInstancesResult>DBInstances>DBInstance"`
+	DBInstances []DBInstance    `query:"DBInstances,list:DBInstance" xml:"DescribeDBInstancesResult>DBInstances>DBInstance"`
 	Marker      aws.StringValue `query:"Marker" xml:"DescribeDBInstancesResult>Marker"`
 }
 
 // DescribeDBLogFilesResult is a wrapper for DescribeDBLogFilesResponse.
 type DescribeDBLogFilesResult struct {
-	DescribeDBLogFiles []DescribeDBLogFilesDetails `query:"DescribeDBLogFiles.member" xml:"DescribeDBLogFilesResult>DescribeDBLogFiles>DescribeDBLogFilesDetails"`
+	DescribeDBLogFiles []DescribeDBLogFilesDetails `query:"DescribeDBLogFiles,list:DescribeDBLogFilesDetails" xml:"DescribeDBLogFilesResult>DescribeDBLogFiles>DescribeDBLogFilesDetails"`
 	Marker             aws.StringValue             `query:"Marker" xml:"DescribeDBLogFilesResult>Marker"`
 }
 
 // DescribeDBParameterGroupsResult is a wrapper for DBParameterGroupsMessage.
 type DescribeDBParameterGroupsResult struct {
-	DBParameterGroups []DBParameterGroup `query:"DBParameterGroups.member" xml:"DescribeDBParameterGroupsResult>DBParameterGroups>DBParameterGroup"`
+	DBParameterGroups []DBParameterGroup `query:"DBParameterGroups,list:DBParameterGroup" xml:"DescribeDBParameterGroupsResult>DBParameterGroups>DBParameterGroup"`
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeDBParameterGroupsResult>Marker"`
 }
 
 // DescribeDBParametersResult is a wrapper for DBParameterGroupDetails.
 type DescribeDBParametersResult struct {
 	Marker     aws.StringValue `query:"Marker" xml:"DescribeDBParametersResult>Marker"`
-	Parameters []Parameter     `query:"Parameters.member" xml:"DescribeDBParametersResult>Parameters>Parameter"`
+	Parameters []Parameter     `query:"Parameters,list:Parameter" xml:"DescribeDBParametersResult>Parameters>Parameter"`
 }
 
 // DescribeDBSecurityGroupsResult is a wrapper for DBSecurityGroupMessage.
 type DescribeDBSecurityGroupsResult struct {
-	DBSecurityGroups []DBSecurityGroup `query:"DBSecurityGroups.member" xml:"DescribeDBSecurityGroupsResult>DBSecurityGroups>DBSecurityGroup"`
+	DBSecurityGroups []DBSecurityGroup `query:"DBSecurityGroups,list:DBSecurityGroup" xml:"DescribeDBSecurityGroupsResult>DBSecurityGroups>DBSecurityGroup"`
 	Marker           aws.StringValue   `query:"Marker" xml:"DescribeDBSecurityGroupsResult>Marker"`
 }
 
 // DescribeDBSnapshotsResult is a wrapper for DBSnapshotMessage.
 type DescribeDBSnapshotsResult struct {
-	DBSnapshots []DBSnapshot    `query:"DBSnapshots.member" xml:"DescribeDBSnapshotsResult>DBSnapshots>DBSnapshot"`
+	DBSnapshots []DBSnapshot    `query:"DBSnapshots,list:DBSnapshot" xml:"DescribeDBSnapshotsResult>DBSnapshots>DBSnapshot"`
 	Marker      aws.StringValue `query:"Marker" xml:"DescribeDBSnapshotsResult>Marker"`
 }
 
 // DescribeDBSubnetGroupsResult is a wrapper for DBSubnetGroupMessage.
 type DescribeDBSubnetGroupsResult struct {
-	DBSubnetGroups []DBSubnetGroup `query:"DBSubnetGroups.member" xml:"DescribeDBSubnetGroupsResult>DBSubnetGroups>DBSubnetGroup"`
+	DBSubnetGroups []DBSubnetGroup `query:"DBSubnetGroups,list:DBSubnetGroup" xml:"DescribeDBSubnetGroupsResult>DBSubnetGroups>DBSubnetGroup"`
 	Marker         aws.StringValue `query:"Marker" xml:"DescribeDBSubnetGroupsResult>Marker"`
 }
 
 // DescribeEventCategoriesResult is a wrapper for EventCategoriesMessage.
 type DescribeEventCategoriesResult struct {
-	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList.member" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
+	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList,list:EventCategoriesMap" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
 }
 
 // DescribeEventSubscriptionsResult is a wrapper for EventSubscriptionsMessage.
 type DescribeEventSubscriptionsResult struct {
-	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList.member" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
+	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList,list:EventSubscription" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
 	Marker                 aws.StringValue     `query:"Marker" xml:"DescribeEventSubscriptionsResult>Marker"`
 }
 
 // DescribeEventsResult is a wrapper for EventsMessage.
 type DescribeEventsResult struct {
-	Events []Event         `query:"Events.member" xml:"DescribeEventsResult>Events>Event"`
+	Events []Event         `query:"Events,list:Event" xml:"DescribeEventsResult>Events>Event"`
 	Marker aws.StringValue `query:"Marker" xml:"DescribeEventsResult>Marker"`
 }
 
 // DescribeOptionGroupOptionsResult is a wrapper for OptionGroupOptionsMessage.
 type DescribeOptionGroupOptionsResult struct {
 	Marker             aws.StringValue     `query:"Marker" xml:"DescribeOptionGroupOptionsResult>Marker"`
-	OptionGroupOptions []OptionGroupOption `query:"OptionGroupOptions.member" xml:"DescribeOptionGroupOptionsResult>OptionGroupOptions>OptionGroupOption"`
+	OptionGroupOptions []OptionGroupOption `query:"OptionGroupOptions,list:OptionGroupOption" xml:"DescribeOptionGroupOptionsResult>OptionGroupOptions>OptionGroupOption"`
 }
 
 // DescribeOptionGroupsResult is a wrapper for OptionGroups.
 type DescribeOptionGroupsResult struct {
 	Marker           aws.StringValue `query:"Marker" xml:"DescribeOptionGroupsResult>Marker"`
-	OptionGroupsList []OptionGroup   `query:"OptionGroupsList.member" xml:"DescribeOptionGroupsResult>OptionGroupsList>OptionGroup"`
+	OptionGroupsList []OptionGroup   `query:"OptionGroupsList,list:OptionGroup" xml:"DescribeOptionGroupsResult>OptionGroupsList>OptionGroup"`
 }
 
 // DescribeOrderableDBInstanceOptionsResult is a wrapper for OrderableDBInstanceOptionsMessage.
 type DescribeOrderableDBInstanceOptionsResult struct {
 	Marker                     aws.StringValue             `query:"Marker" xml:"DescribeOrderableDBInstanceOptionsResult>Marker"`
-	OrderableDBInstanceOptions []OrderableDBInstanceOption `query:"OrderableDBInstanceOptions.member" xml:"DescribeOrderableDBInstanceOptionsResult>OrderableDBInstanceOptions>OrderableDBInstanceOption"`
+	OrderableDBInstanceOptions []OrderableDBInstanceOption `query:"OrderableDBInstanceOptions,list:OrderableDBInstanceOption" xml:"DescribeOrderableDBInstanceOptionsResult>OrderableDBInstanceOptions>OrderableDBInstanceOption"`
 }
 
 // DescribeReservedDBInstancesOfferingsResult is a wrapper for ReservedDBInstancesOfferingMessage.
 type DescribeReservedDBInstancesOfferingsResult struct {
 	Marker                       aws.StringValue               `query:"Marker" xml:"DescribeReservedDBInstancesOfferingsResult>Marker"`
-	ReservedDBInstancesOfferings []ReservedDBInstancesOffering `query:"ReservedDBInstancesOfferings.member" xml:"DescribeReservedDBInstancesOfferingsResult>ReservedDBInstancesOfferings>ReservedDBInstancesOffering"`
+	ReservedDBInstancesOfferings []ReservedDBInstancesOffering `query:"ReservedDBInstancesOfferings,list:ReservedDBInstancesOffering" xml:"DescribeReservedDBInstancesOfferingsResult>ReservedDBInstancesOfferings>ReservedDBInstancesOffering"`
 }
 
 // DescribeReservedDBInstancesResult is a wrapper for ReservedDBInstanceMessage.
 type DescribeReservedDBInstancesResult struct {
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeReservedDBInstancesResult>Marker"`
-	ReservedDBInstances []ReservedDBInstance `query:"ReservedDBInstances.member" xml:"DescribeReservedDBInstancesResult>ReservedDBInstances>ReservedDBInstance"`
+	ReservedDBInstances []ReservedDBInstance `query:"ReservedDBInstances,list:ReservedDBInstance" xml:"DescribeReservedDBInstancesResult>ReservedDBInstances>ReservedDBInstance"`
 }
 
 // DownloadDBLogFilePortionResult is a wrapper for DownloadDBLogFilePortionDetails.
@@ -1827,7 +4080,7 @@ type DownloadDBLogFilePortionResult struct {
 
 // ListTagsForResourceResult is a wrapper for TagListMessage.
 type ListTagsForResourceResult struct {
-	TagList []Tag `query:"TagList.member" xml:"ListTagsForResourceResult>TagList>Tag"`
+	TagList []Tag `query:"TagList,list:Tag" xml:"ListTagsForResourceResult>TagList>Tag"`
 }
 
 // ModifyDBParameterGroupResult is a wrapper for DBParameterGroupNameMessage.
@@ -1840,5 +4093,351 @@ type ResetDBParameterGroupResult struct {
 	DBParameterGroupName aws.StringValue `query:"DBParameterGroupName" xml:"ResetDBParameterGroupResult>DBParameterGroupName"`
 }
 
+// AuthorizationAlreadyExistsFault is the error returned for the AuthorizationAlreadyExists error code.
+type AuthorizationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// AuthorizationNotFoundFault is the error returned for the AuthorizationNotFound error code.
+type AuthorizationNotFoundFault struct {
+	aws.APIError
+}
+
+// AuthorizationQuotaExceededFault is the error returned for the AuthorizationQuotaExceeded error code.
+type AuthorizationQuotaExceededFault struct {
+	aws.APIError
+}
+
+// DBInstanceAlreadyExistsFault is the error returned for the DBInstanceAlreadyExists error code.
+type DBInstanceAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBInstanceNotFoundFault is the error returned for the DBInstanceNotFound error code.
+type DBInstanceNotFoundFault struct {
+	aws.APIError
+}
+
+// DBParameterGroupAlreadyExistsFault is the error returned for the DBParameterGroupAlreadyExists error code.
+type DBParameterGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBParameterGroupNotFoundFault is the error returned for the DBParameterGroupNotFound error code.
+type DBParameterGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// DBParameterGroupQuotaExceededFault is the error returned for the DBParameterGroupQuotaExceeded error code.
+type DBParameterGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// DBSecurityGroupAlreadyExistsFault is the error returned for the DBSecurityGroupAlreadyExists error code.
+type DBSecurityGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBSecurityGroupNotFoundFault is the error returned for the DBSecurityGroupNotFound error code.
+type DBSecurityGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// DBSecurityGroupNotSupportedFault is the error returned for the DBSecurityGroupNotSupported error code.
+type DBSecurityGroupNotSupportedFault struct {
+	aws.APIError
+}
+
+// DBSecurityGroupQuotaExceededFault is the error returned for the QuotaExceeded.DBSecurityGroup error code.
+type DBSecurityGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// DBSnapshotAlreadyExistsFault is the error returned for the DBSnapshotAlreadyExists error code.
+type DBSnapshotAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBSnapshotNotFoundFault is the error returned for the DBSnapshotNotFound error code.
+type DBSnapshotNotFoundFault struct {
+	aws.APIError
+}
+
+// DBSubnetGroupAlreadyExistsFault is the error returned for the DBSubnetGroupAlreadyExists error code.
+type DBSubnetGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DBSubnetGroupDoesNotCoverEnoughAZs is the error returned for the DBSubnetGroupDoesNotCoverEnoughAZs error code.
+type DBSubnetGroupDoesNotCoverEnoughAZs struct {
+	aws.APIError
+}
+
+// DBSubnetGroupNotAllowedFault is the error returned for the DBSubnetGroupNotAllowedFault error code.
+type DBSubnetGroupNotAllowedFault struct {
+	aws.APIError
+}
+
+// DBSubnetGroupNotFoundFault is the error returned for the DBSubnetGroupNotFoundFault error code.
+type DBSubnetGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// DBSubnetGroupQuotaExceededFault is the error returned for the DBSubnetGroupQuotaExceeded error code.
+type DBSubnetGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// DBSubnetQuotaExceededFault is the error returned for the DBSubnetQuotaExceededFault error code.
+type DBSubnetQuotaExceededFault struct {
+	aws.APIError
+}
+
+// DBUpgradeDependencyFailureFault is the error returned for the DBUpgradeDependencyFailure error code.
+type DBUpgradeDependencyFailureFault struct {
+	aws.APIError
+}
+
+// EventSubscriptionQuotaExceededFault is the error returned for the EventSubscriptionQuotaExceeded error code.
+type EventSubscriptionQuotaExceededFault struct {
+	aws.APIError
+}
+
+// InstanceQuotaExceededFault is the error returned for the InstanceQuotaExceeded error code.
+type InstanceQuotaExceededFault struct {
+	aws.APIError
+}
+
+// InsufficientDBInstanceCapacityFault is the error returned for the InsufficientDBInstanceCapacity error code.
+type InsufficientDBInstanceCapacityFault struct {
+	aws.APIError
+}
+
+// InvalidDBInstanceStateFault is the error returned for the InvalidDBInstanceState error code.
+type InvalidDBInstanceStateFault struct {
+	aws.APIError
+}
+
+// InvalidDBParameterGroupStateFault is the error returned for the InvalidDBParameterGroupState error code.
+type InvalidDBParameterGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidDBSecurityGroupStateFault is the error returned for the InvalidDBSecurityGroupState error code.
+type InvalidDBSecurityGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidDBSnapshotStateFault is the error returned for the InvalidDBSnapshotState error code.
+type InvalidDBSnapshotStateFault struct {
+	aws.APIError
+}
+
+// InvalidDBSubnetGroupFault is the error returned for the InvalidDBSubnetGroupFault error code.
+type InvalidDBSubnetGroupFault struct {
+	aws.APIError
+}
+
+// InvalidDBSubnetGroupStateFault is the error returned for the InvalidDBSubnetGroupStateFault error code.
+type InvalidDBSubnetGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidDBSubnetStateFault is the error returned for the InvalidDBSubnetStateFault error code.
+type InvalidDBSubnetStateFault struct {
+	aws.APIError
+}
+
+// InvalidEventSubscriptionStateFault is the error returned for the InvalidEventSubscriptionState error code.
+type InvalidEventSubscriptionStateFault struct {
+	aws.APIError
+}
+
+// InvalidOptionGroupStateFault is the error returned for the InvalidOptionGroupStateFault error code.
+type InvalidOptionGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidRestoreFault is the error returned for the InvalidRestoreFault error code.
+type InvalidRestoreFault struct {
+	aws.APIError
+}
+
+// InvalidSubnet is the error returned for the InvalidSubnet error code.
+type InvalidSubnet struct {
+	aws.APIError
+}
+
+// InvalidVPCNetworkStateFault is the error returned for the InvalidVPCNetworkStateFault error code.
+type InvalidVPCNetworkStateFault struct {
+	aws.APIError
+}
+
+// OptionGroupAlreadyExistsFault is the error returned for the OptionGroupAlreadyExistsFault error code.
+type OptionGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// OptionGroupNotFoundFault is the error returned for the OptionGroupNotFoundFault error code.
+type OptionGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// OptionGroupQuotaExceededFault is the error returned for the OptionGroupQuotaExceededFault error code.
+type OptionGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// PointInTimeRestoreNotEnabledFault is the error returned for the PointInTimeRestoreNotEnabled error code.
+type PointInTimeRestoreNotEnabledFault struct {
+	aws.APIError
+}
+
+// ProvisionedIOPSNotAvailableInAZFault is the error returned for the ProvisionedIopsNotAvailableInAZFault error code.
+type ProvisionedIOPSNotAvailableInAZFault struct {
+	aws.APIError
+}
+
+// ReservedDBInstanceAlreadyExistsFault is the error returned for the ReservedDBInstanceAlreadyExists error code.
+type ReservedDBInstanceAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ReservedDBInstanceNotFoundFault is the error returned for the ReservedDBInstanceNotFound error code.
+type ReservedDBInstanceNotFoundFault struct {
+	aws.APIError
+}
+
+// ReservedDBInstanceQuotaExceededFault is the error returned for the ReservedDBInstanceQuotaExceeded error code.
+type ReservedDBInstanceQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ReservedDBInstancesOfferingNotFoundFault is the error returned for the ReservedDBInstancesOfferingNotFound error code.
+type ReservedDBInstancesOfferingNotFoundFault struct {
+	aws.APIError
+}
+
+// SNSInvalidTopicFault is the error returned for the SNSInvalidTopic error code.
+type SNSInvalidTopicFault struct {
+	aws.APIError
+}
+
+// SNSNoAuthorizationFault is the error returned for the SNSNoAuthorization error code.
+type SNSNoAuthorizationFault struct {
+	aws.APIError
+}
+
+// SNSTopicARNNotFoundFault is the error returned for the SNSTopicArnNotFound error code.
+type SNSTopicARNNotFoundFault struct {
+	aws.APIError
+}
+
+// SnapshotQuotaExceededFault is the error returned for the SnapshotQuotaExceeded error code.
+type SnapshotQuotaExceededFault struct {
+	aws.APIError
+}
+
+// SourceNotFoundFault is the error returned for the SourceNotFound error code.
+type SourceNotFoundFault struct {
+	aws.APIError
+}
+
+// StorageQuotaExceededFault is the error returned for the StorageQuotaExceeded error code.
+type StorageQuotaExceededFault struct {
+	aws.APIError
+}
+
+// StorageTypeNotSupportedFault is the error returned for the StorageTypeNotSupported error code.
+type StorageTypeNotSupportedFault struct {
+	aws.APIError
+}
+
+// SubnetAlreadyInUse is the error returned for the SubnetAlreadyInUse error code.
+type SubnetAlreadyInUse struct {
+	aws.APIError
+}
+
+// SubscriptionAlreadyExistFault is the error returned for the SubscriptionAlreadyExist error code.
+type SubscriptionAlreadyExistFault struct {
+	aws.APIError
+}
+
+// SubscriptionCategoryNotFoundFault is the error returned for the SubscriptionCategoryNotFound error code.
+type SubscriptionCategoryNotFoundFault struct {
+	aws.APIError
+}
+
+// SubscriptionNotFoundFault is the error returned for the SubscriptionNotFound error code.
+type SubscriptionNotFoundFault struct {
+	aws.APIError
+}
+
+// exceptions maps RDS's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AuthorizationAlreadyExists":           AuthorizationAlreadyExistsFault{},
+	"AuthorizationNotFound":                AuthorizationNotFoundFault{},
+	"AuthorizationQuotaExceeded":           AuthorizationQuotaExceededFault{},
+	"DBInstanceAlreadyExists":              DBInstanceAlreadyExistsFault{},
+	"DBInstanceNotFound":                   DBInstanceNotFoundFault{},
+	"DBParameterGroupAlreadyExists":        DBParameterGroupAlreadyExistsFault{},
+	"DBParameterGroupNotFound":             DBParameterGroupNotFoundFault{},
+	"DBParameterGroupQuotaExceeded":        DBParameterGroupQuotaExceededFault{},
+	"DBSecurityGroupAlreadyExists":         DBSecurityGroupAlreadyExistsFault{},
+	"DBSecurityGroupNotFound":              DBSecurityGroupNotFoundFault{},
+	"DBSecurityGroupNotSupported":          DBSecurityGroupNotSupportedFault{},
+	"QuotaExceeded.DBSecurityGroup":        DBSecurityGroupQuotaExceededFault{},
+	"DBSnapshotAlreadyExists":              DBSnapshotAlreadyExistsFault{},
+	"DBSnapshotNotFound":                   DBSnapshotNotFoundFault{},
+	"DBSubnetGroupAlreadyExists":           DBSubnetGroupAlreadyExistsFault{},
+	"DBSubnetGroupDoesNotCoverEnoughAZs":   DBSubnetGroupDoesNotCoverEnoughAZs{},
+	"DBSubnetGroupNotAllowedFault":         DBSubnetGroupNotAllowedFault{},
+	"DBSubnetGroupNotFoundFault":           DBSubnetGroupNotFoundFault{},
+	"DBSubnetGroupQuotaExceeded":           DBSubnetGroupQuotaExceededFault{},
+	"DBSubnetQuotaExceededFault":           DBSubnetQuotaExceededFault{},
+	"DBUpgradeDependencyFailure":           DBUpgradeDependencyFailureFault{},
+	"EventSubscriptionQuotaExceeded":       EventSubscriptionQuotaExceededFault{},
+	"InstanceQuotaExceeded":                InstanceQuotaExceededFault{},
+	"InsufficientDBInstanceCapacity":       InsufficientDBInstanceCapacityFault{},
+	"InvalidDBInstanceState":               InvalidDBInstanceStateFault{},
+	"InvalidDBParameterGroupState":         InvalidDBParameterGroupStateFault{},
+	"InvalidDBSecurityGroupState":          InvalidDBSecurityGroupStateFault{},
+	"InvalidDBSnapshotState":               InvalidDBSnapshotStateFault{},
+	"InvalidDBSubnetGroupFault":            InvalidDBSubnetGroupFault{},
+	"InvalidDBSubnetGroupStateFault":       InvalidDBSubnetGroupStateFault{},
+	"InvalidDBSubnetStateFault":            InvalidDBSubnetStateFault{},
+	"InvalidEventSubscriptionState":        InvalidEventSubscriptionStateFault{},
+	"InvalidOptionGroupStateFault":         InvalidOptionGroupStateFault{},
+	"InvalidRestoreFault":                  InvalidRestoreFault{},
+	"InvalidSubnet":                        InvalidSubnet{},
+	"InvalidVPCNetworkStateFault":          InvalidVPCNetworkStateFault{},
+	"OptionGroupAlreadyExistsFault":        OptionGroupAlreadyExistsFault{},
+	"OptionGroupNotFoundFault":             OptionGroupNotFoundFault{},
+	"OptionGroupQuotaExceededFault":        OptionGroupQuotaExceededFault{},
+	"PointInTimeRestoreNotEnabled":         PointInTimeRestoreNotEnabledFault{},
+	"ProvisionedIopsNotAvailableInAZFault": ProvisionedIOPSNotAvailableInAZFault{},
+	"ReservedDBInstanceAlreadyExists":      ReservedDBInstanceAlreadyExistsFault{},
+	"ReservedDBInstanceNotFound":           ReservedDBInstanceNotFoundFault{},
+	"ReservedDBInstanceQuotaExceeded":      ReservedDBInstanceQuotaExceededFault{},
+	"ReservedDBInstancesOfferingNotFound":  ReservedDBInstancesOfferingNotFoundFault{},
+	"SNSInvalidTopic":                      SNSInvalidTopicFault{},
+	"SNSNoAuthorization":                   SNSNoAuthorizationFault{},
+	"SNSTopicArnNotFound":                  SNSTopicARNNotFoundFault{},
+	"SnapshotQuotaExceeded":                SnapshotQuotaExceededFault{},
+	"SourceNotFound":                       SourceNotFoundFault{},
+	"StorageQuotaExceeded":                 StorageQuotaExceededFault{},
+	"StorageTypeNotSupported":              StorageTypeNotSupportedFault{},
+	"SubnetAlreadyInUse":                   SubnetAlreadyInUse{},
+	"SubscriptionAlreadyExist":             SubscriptionAlreadyExistFault{},
+	"SubscriptionCategoryNotFound":         SubscriptionCategoryNotFoundFault{},
+	"SubscriptionNotFound":                 SubscriptionNotFoundFault{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
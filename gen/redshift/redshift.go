@@ -4,11 +4,21 @@
 package redshift
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // RedShift is a client for Amazon Redshift.
@@ -16,29 +26,70 @@ type RedShift struct {
 	client *aws.QueryClient
 }
 
-// New returns a new RedShift client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *RedShift {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "redshift"
-	endpoint, service, region := endpoints.Lookup("redshift", region)
+// New returns a new RedShift client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *RedShift {
+	cfg := newConfig(region, client, opts)
 
 	return &RedShift{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2012-12-01",
 		},
 	}
 }
 
+// NewFromSession returns a new RedShift client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *RedShift {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("redshift", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("redshift", "redshift"),
+	}, "AWS_ENDPOINT_URL_REDSHIFT", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *RedShift) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *RedShift) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *RedShift) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AuthorizeClusterSecurityGroupIngress adds an inbound (ingress) rule to
 // an Amazon Redshift security group. Depending on whether the application
 // accessing your cluster is running on the Internet or an EC2 instance,
@@ -53,8 +104,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Red
 // information about managing security groups, go to Working with Security
 // Groups in the Amazon Redshift Cluster Management Guide
 func (c *RedShift) AuthorizeClusterSecurityGroupIngress(req *AuthorizeClusterSecurityGroupIngressMessage) (resp *AuthorizeClusterSecurityGroupIngressResult, err error) {
+	return c.AuthorizeClusterSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// AuthorizeClusterSecurityGroupIngressWithContext is like AuthorizeClusterSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) AuthorizeClusterSecurityGroupIngressWithContext(ctx context.Context, req *AuthorizeClusterSecurityGroupIngressMessage) (resp *AuthorizeClusterSecurityGroupIngressResult, err error) {
 	resp = &AuthorizeClusterSecurityGroupIngressResult{}
-	err = c.client.Do("AuthorizeClusterSecurityGroupIngress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AuthorizeClusterSecurityGroupIngress", "POST", "/", req, resp)
 	return
 }
 
@@ -63,8 +121,15 @@ func (c *RedShift) AuthorizeClusterSecurityGroupIngress(req *AuthorizeClusterSec
 // snapshots, go to Amazon Redshift Snapshots in the Amazon Redshift
 // Cluster Management Guide .
 func (c *RedShift) AuthorizeSnapshotAccess(req *AuthorizeSnapshotAccessMessage) (resp *AuthorizeSnapshotAccessResult, err error) {
+	return c.AuthorizeSnapshotAccessWithContext(context.Background(), req)
+}
+
+// AuthorizeSnapshotAccessWithContext is like AuthorizeSnapshotAccess, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) AuthorizeSnapshotAccessWithContext(ctx context.Context, req *AuthorizeSnapshotAccessMessage) (resp *AuthorizeSnapshotAccessResult, err error) {
 	resp = &AuthorizeSnapshotAccessResult{}
-	err = c.client.Do("AuthorizeSnapshotAccess", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AuthorizeSnapshotAccess", "POST", "/", req, resp)
 	return
 }
 
@@ -79,8 +144,15 @@ func (c *RedShift) AuthorizeSnapshotAccess(req *AuthorizeSnapshotAccessMessage)
 // snapshots, go to Amazon Redshift Snapshots in the Amazon Redshift
 // Cluster Management Guide .
 func (c *RedShift) CopyClusterSnapshot(req *CopyClusterSnapshotMessage) (resp *CopyClusterSnapshotResult, err error) {
+	return c.CopyClusterSnapshotWithContext(context.Background(), req)
+}
+
+// CopyClusterSnapshotWithContext is like CopyClusterSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CopyClusterSnapshotWithContext(ctx context.Context, req *CopyClusterSnapshotMessage) (resp *CopyClusterSnapshotResult, err error) {
 	resp = &CopyClusterSnapshotResult{}
-	err = c.client.Do("CopyClusterSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CopyClusterSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -92,8 +164,15 @@ func (c *RedShift) CopyClusterSnapshot(req *CopyClusterSnapshotMessage) (resp *C
 // about managing clusters, go to Amazon Redshift Clusters in the Amazon
 // Redshift Cluster Management Guide .
 func (c *RedShift) CreateCluster(req *CreateClusterMessage) (resp *CreateClusterResult, err error) {
+	return c.CreateClusterWithContext(context.Background(), req)
+}
+
+// CreateClusterWithContext is like CreateCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateClusterWithContext(ctx context.Context, req *CreateClusterMessage) (resp *CreateClusterResult, err error) {
 	resp = &CreateClusterResult{}
-	err = c.client.Do("CreateCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -107,8 +186,15 @@ func (c *RedShift) CreateCluster(req *CreateClusterMessage) (resp *CreateCluster
 // groups, go to Amazon Redshift Parameter Groups in the Amazon Redshift
 // Cluster Management Guide .
 func (c *RedShift) CreateClusterParameterGroup(req *CreateClusterParameterGroupMessage) (resp *CreateClusterParameterGroupResult, err error) {
+	return c.CreateClusterParameterGroupWithContext(context.Background(), req)
+}
+
+// CreateClusterParameterGroupWithContext is like CreateClusterParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateClusterParameterGroupWithContext(ctx context.Context, req *CreateClusterParameterGroupMessage) (resp *CreateClusterParameterGroupResult, err error) {
 	resp = &CreateClusterParameterGroupResult{}
-	err = c.client.Do("CreateClusterParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateClusterParameterGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -118,8 +204,15 @@ func (c *RedShift) CreateClusterParameterGroup(req *CreateClusterParameterGroupM
 // Cluster Security Groups in the Amazon Redshift Cluster Management Guide
 // .
 func (c *RedShift) CreateClusterSecurityGroup(req *CreateClusterSecurityGroupMessage) (resp *CreateClusterSecurityGroupResult, err error) {
+	return c.CreateClusterSecurityGroupWithContext(context.Background(), req)
+}
+
+// CreateClusterSecurityGroupWithContext is like CreateClusterSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateClusterSecurityGroupWithContext(ctx context.Context, req *CreateClusterSecurityGroupMessage) (resp *CreateClusterSecurityGroupResult, err error) {
 	resp = &CreateClusterSecurityGroupResult{}
-	err = c.client.Do("CreateClusterSecurityGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateClusterSecurityGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -128,8 +221,15 @@ func (c *RedShift) CreateClusterSecurityGroup(req *CreateClusterSecurityGroupMes
 // information about working with snapshots, go to Amazon Redshift
 // Snapshots in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) CreateClusterSnapshot(req *CreateClusterSnapshotMessage) (resp *CreateClusterSnapshotResult, err error) {
+	return c.CreateClusterSnapshotWithContext(context.Background(), req)
+}
+
+// CreateClusterSnapshotWithContext is like CreateClusterSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateClusterSnapshotWithContext(ctx context.Context, req *CreateClusterSnapshotMessage) (resp *CreateClusterSnapshotResult, err error) {
 	resp = &CreateClusterSnapshotResult{}
-	err = c.client.Do("CreateClusterSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateClusterSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -139,8 +239,15 @@ func (c *RedShift) CreateClusterSnapshot(req *CreateClusterSnapshotMessage) (res
 // group. For information about subnet groups, go to Amazon Redshift
 // Cluster Subnet Groups in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) CreateClusterSubnetGroup(req *CreateClusterSubnetGroupMessage) (resp *CreateClusterSubnetGroupResult, err error) {
+	return c.CreateClusterSubnetGroupWithContext(context.Background(), req)
+}
+
+// CreateClusterSubnetGroupWithContext is like CreateClusterSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateClusterSubnetGroupWithContext(ctx context.Context, req *CreateClusterSubnetGroupMessage) (resp *CreateClusterSubnetGroupResult, err error) {
 	resp = &CreateClusterSubnetGroupResult{}
-	err = c.client.Do("CreateClusterSubnetGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateClusterSubnetGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -166,8 +273,15 @@ func (c *RedShift) CreateClusterSubnetGroup(req *CreateClusterSubnetGroupMessage
 // Amazon Redshift sources belonging to your AWS account. You must specify
 // a source type if you specify a source ID.
 func (c *RedShift) CreateEventSubscription(req *CreateEventSubscriptionMessage) (resp *CreateEventSubscriptionResult, err error) {
+	return c.CreateEventSubscriptionWithContext(context.Background(), req)
+}
+
+// CreateEventSubscriptionWithContext is like CreateEventSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateEventSubscriptionWithContext(ctx context.Context, req *CreateEventSubscriptionMessage) (resp *CreateEventSubscriptionResult, err error) {
 	resp = &CreateEventSubscriptionResult{}
-	err = c.client.Do("CreateEventSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateEventSubscription", "POST", "/", req, resp)
 	return
 }
 
@@ -181,8 +295,15 @@ func (c *RedShift) CreateEventSubscription(req *CreateEventSubscriptionMessage)
 // to Hardware Security Modules in the Amazon Redshift Cluster Management
 // Guide.
 func (c *RedShift) CreateHSMClientCertificate(req *CreateHSMClientCertificateMessage) (resp *CreateHSMClientCertificateResult, err error) {
+	return c.CreateHSMClientCertificateWithContext(context.Background(), req)
+}
+
+// CreateHSMClientCertificateWithContext is like CreateHSMClientCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateHSMClientCertificateWithContext(ctx context.Context, req *CreateHSMClientCertificateMessage) (resp *CreateHSMClientCertificateResult, err error) {
 	resp = &CreateHSMClientCertificateResult{}
-	err = c.client.Do("CreateHsmClientCertificate", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateHsmClientCertificate", "POST", "/", req, resp)
 	return
 }
 
@@ -195,8 +316,15 @@ func (c *RedShift) CreateHSMClientCertificate(req *CreateHSMClientCertificateMes
 // client certificate. For more information, go to Hardware Security
 // Modules in the Amazon Redshift Cluster Management Guide.
 func (c *RedShift) CreateHSMConfiguration(req *CreateHSMConfigurationMessage) (resp *CreateHSMConfigurationResult, err error) {
+	return c.CreateHSMConfigurationWithContext(context.Background(), req)
+}
+
+// CreateHSMConfigurationWithContext is like CreateHSMConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateHSMConfigurationWithContext(ctx context.Context, req *CreateHSMConfigurationMessage) (resp *CreateHSMConfigurationResult, err error) {
 	resp = &CreateHSMConfigurationResult{}
-	err = c.client.Do("CreateHsmConfiguration", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateHsmConfiguration", "POST", "/", req, resp)
 	return
 }
 
@@ -206,8 +334,15 @@ func (c *RedShift) CreateHSMConfiguration(req *CreateHSMConfigurationMessage) (r
 // specify a key that already exists for the resource, the value for that
 // key will be updated with the new value.
 func (c *RedShift) CreateTags(req *CreateTagsMessage) (err error) {
+	return c.CreateTagsWithContext(context.Background(), req)
+}
+
+// CreateTagsWithContext is like CreateTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) CreateTagsWithContext(ctx context.Context, req *CreateTagsMessage) (err error) {
 	// NRE
-	err = c.client.Do("CreateTags", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateTags", "POST", "/", req, nil)
 	return
 }
 
@@ -226,8 +361,15 @@ func (c *RedShift) CreateTags(req *CreateTagsMessage) (err error) {
 // the cluster. For more information about managing clusters, go to Amazon
 // Redshift Clusters in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) DeleteCluster(req *DeleteClusterMessage) (resp *DeleteClusterResult, err error) {
+	return c.DeleteClusterWithContext(context.Background(), req)
+}
+
+// DeleteClusterWithContext is like DeleteCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteClusterWithContext(ctx context.Context, req *DeleteClusterMessage) (resp *DeleteClusterResult, err error) {
 	resp = &DeleteClusterResult{}
-	err = c.client.Do("DeleteCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -235,8 +377,15 @@ func (c *RedShift) DeleteCluster(req *DeleteClusterMessage) (resp *DeleteCluster
 // parameter group. You cannot delete a parameter group if it is associated
 // with a cluster.
 func (c *RedShift) DeleteClusterParameterGroup(req *DeleteClusterParameterGroupMessage) (err error) {
+	return c.DeleteClusterParameterGroupWithContext(context.Background(), req)
+}
+
+// DeleteClusterParameterGroupWithContext is like DeleteClusterParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteClusterParameterGroupWithContext(ctx context.Context, req *DeleteClusterParameterGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteClusterParameterGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteClusterParameterGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -246,8 +395,15 @@ func (c *RedShift) DeleteClusterParameterGroup(req *DeleteClusterParameterGroupM
 // managing security groups, go to Amazon Redshift Cluster Security Groups
 // in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) DeleteClusterSecurityGroup(req *DeleteClusterSecurityGroupMessage) (err error) {
+	return c.DeleteClusterSecurityGroupWithContext(context.Background(), req)
+}
+
+// DeleteClusterSecurityGroupWithContext is like DeleteClusterSecurityGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteClusterSecurityGroupWithContext(ctx context.Context, req *DeleteClusterSecurityGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteClusterSecurityGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteClusterSecurityGroup", "POST", "/", req, nil)
 	return
 }
 
@@ -260,46 +416,88 @@ func (c *RedShift) DeleteClusterSecurityGroup(req *DeleteClusterSecurityGroupMes
 // snapshot, you must revoke all of the authorizations before you can
 // delete the snapshot.
 func (c *RedShift) DeleteClusterSnapshot(req *DeleteClusterSnapshotMessage) (resp *DeleteClusterSnapshotResult, err error) {
+	return c.DeleteClusterSnapshotWithContext(context.Background(), req)
+}
+
+// DeleteClusterSnapshotWithContext is like DeleteClusterSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteClusterSnapshotWithContext(ctx context.Context, req *DeleteClusterSnapshotMessage) (resp *DeleteClusterSnapshotResult, err error) {
 	resp = &DeleteClusterSnapshotResult{}
-	err = c.client.Do("DeleteClusterSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteClusterSnapshot", "POST", "/", req, resp)
 	return
 }
 
 // DeleteClusterSubnetGroup is undocumented.
 func (c *RedShift) DeleteClusterSubnetGroup(req *DeleteClusterSubnetGroupMessage) (err error) {
+	return c.DeleteClusterSubnetGroupWithContext(context.Background(), req)
+}
+
+// DeleteClusterSubnetGroupWithContext is like DeleteClusterSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteClusterSubnetGroupWithContext(ctx context.Context, req *DeleteClusterSubnetGroupMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteClusterSubnetGroup", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteClusterSubnetGroup", "POST", "/", req, nil)
 	return
 }
 
 // DeleteEventSubscription deletes an Amazon Redshift event notification
 // subscription.
 func (c *RedShift) DeleteEventSubscription(req *DeleteEventSubscriptionMessage) (err error) {
+	return c.DeleteEventSubscriptionWithContext(context.Background(), req)
+}
+
+// DeleteEventSubscriptionWithContext is like DeleteEventSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteEventSubscriptionWithContext(ctx context.Context, req *DeleteEventSubscriptionMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteEventSubscription", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteEventSubscription", "POST", "/", req, nil)
 	return
 }
 
 // DeleteHSMClientCertificate is undocumented.
 func (c *RedShift) DeleteHSMClientCertificate(req *DeleteHSMClientCertificateMessage) (err error) {
+	return c.DeleteHSMClientCertificateWithContext(context.Background(), req)
+}
+
+// DeleteHSMClientCertificateWithContext is like DeleteHSMClientCertificate, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteHSMClientCertificateWithContext(ctx context.Context, req *DeleteHSMClientCertificateMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteHsmClientCertificate", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteHsmClientCertificate", "POST", "/", req, nil)
 	return
 }
 
 // DeleteHSMConfiguration deletes the specified Amazon Redshift HSM
 // configuration.
 func (c *RedShift) DeleteHSMConfiguration(req *DeleteHSMConfigurationMessage) (err error) {
+	return c.DeleteHSMConfigurationWithContext(context.Background(), req)
+}
+
+// DeleteHSMConfigurationWithContext is like DeleteHSMConfiguration, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteHSMConfigurationWithContext(ctx context.Context, req *DeleteHSMConfigurationMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteHsmConfiguration", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteHsmConfiguration", "POST", "/", req, nil)
 	return
 }
 
 // DeleteTags deletes a tag or tags from a resource. You must provide the
 // ARN of the resource from which you want to delete the tag or tags.
 func (c *RedShift) DeleteTags(req *DeleteTagsMessage) (err error) {
+	return c.DeleteTagsWithContext(context.Background(), req)
+}
+
+// DeleteTagsWithContext is like DeleteTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DeleteTagsWithContext(ctx context.Context, req *DeleteTagsMessage) (err error) {
 	// NRE
-	err = c.client.Do("DeleteTags", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteTags", "POST", "/", req, nil)
 	return
 }
 
@@ -319,8 +517,15 @@ func (c *RedShift) DeleteTags(req *DeleteTagsMessage) (err error) {
 // parameter groups are returned regardless of whether they have tag keys
 // or values associated with them.
 func (c *RedShift) DescribeClusterParameterGroups(req *DescribeClusterParameterGroupsMessage) (resp *DescribeClusterParameterGroupsResult, err error) {
+	return c.DescribeClusterParameterGroupsWithContext(context.Background(), req)
+}
+
+// DescribeClusterParameterGroupsWithContext is like DescribeClusterParameterGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeClusterParameterGroupsWithContext(ctx context.Context, req *DescribeClusterParameterGroupsMessage) (resp *DescribeClusterParameterGroupsResult, err error) {
 	resp = &DescribeClusterParameterGroupsResult{}
-	err = c.client.Do("DescribeClusterParameterGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeClusterParameterGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -335,8 +540,15 @@ func (c *RedShift) DescribeClusterParameterGroups(req *DescribeClusterParameterG
 // more information about managing parameter groups, go to Amazon Redshift
 // Parameter Groups in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) DescribeClusterParameters(req *DescribeClusterParametersMessage) (resp *DescribeClusterParametersResult, err error) {
+	return c.DescribeClusterParametersWithContext(context.Background(), req)
+}
+
+// DescribeClusterParametersWithContext is like DescribeClusterParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeClusterParametersWithContext(ctx context.Context, req *DescribeClusterParametersMessage) (resp *DescribeClusterParametersResult, err error) {
 	resp = &DescribeClusterParametersResult{}
-	err = c.client.Do("DescribeClusterParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeClusterParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -354,8 +566,15 @@ func (c *RedShift) DescribeClusterParameters(req *DescribeClusterParametersMessa
 // groups are returned regardless of whether they have tag keys or values
 // associated with them.
 func (c *RedShift) DescribeClusterSecurityGroups(req *DescribeClusterSecurityGroupsMessage) (resp *DescribeClusterSecurityGroupsResult, err error) {
+	return c.DescribeClusterSecurityGroupsWithContext(context.Background(), req)
+}
+
+// DescribeClusterSecurityGroupsWithContext is like DescribeClusterSecurityGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeClusterSecurityGroupsWithContext(ctx context.Context, req *DescribeClusterSecurityGroupsMessage) (resp *DescribeClusterSecurityGroupsResult, err error) {
 	resp = &DescribeClusterSecurityGroupsResult{}
-	err = c.client.Do("DescribeClusterSecurityGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeClusterSecurityGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -374,8 +593,15 @@ func (c *RedShift) DescribeClusterSecurityGroups(req *DescribeClusterSecurityGro
 // the request, snapshots are returned regardless of whether they have tag
 // keys or values associated with them.
 func (c *RedShift) DescribeClusterSnapshots(req *DescribeClusterSnapshotsMessage) (resp *DescribeClusterSnapshotsResult, err error) {
+	return c.DescribeClusterSnapshotsWithContext(context.Background(), req)
+}
+
+// DescribeClusterSnapshotsWithContext is like DescribeClusterSnapshots, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeClusterSnapshotsWithContext(ctx context.Context, req *DescribeClusterSnapshotsMessage) (resp *DescribeClusterSnapshotsResult, err error) {
 	resp = &DescribeClusterSnapshotsResult{}
-	err = c.client.Do("DescribeClusterSnapshots", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeClusterSnapshots", "POST", "/", req, resp)
 	return
 }
 
@@ -391,8 +617,15 @@ func (c *RedShift) DescribeClusterSnapshots(req *DescribeClusterSnapshotsMessage
 // the request, subnet groups are returned regardless of whether they have
 // tag keys or values associated with them.
 func (c *RedShift) DescribeClusterSubnetGroups(req *DescribeClusterSubnetGroupsMessage) (resp *DescribeClusterSubnetGroupsResult, err error) {
+	return c.DescribeClusterSubnetGroupsWithContext(context.Background(), req)
+}
+
+// DescribeClusterSubnetGroupsWithContext is like DescribeClusterSubnetGroups, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeClusterSubnetGroupsWithContext(ctx context.Context, req *DescribeClusterSubnetGroupsMessage) (resp *DescribeClusterSubnetGroupsResult, err error) {
 	resp = &DescribeClusterSubnetGroupsResult{}
-	err = c.client.Do("DescribeClusterSubnetGroups", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeClusterSubnetGroups", "POST", "/", req, resp)
 	return
 }
 
@@ -402,8 +635,15 @@ func (c *RedShift) DescribeClusterSubnetGroups(req *DescribeClusterSubnetGroupsM
 // For more information about managing clusters, go to Amazon Redshift
 // Clusters in the Amazon Redshift Cluster Management Guide
 func (c *RedShift) DescribeClusterVersions(req *DescribeClusterVersionsMessage) (resp *DescribeClusterVersionsResult, err error) {
+	return c.DescribeClusterVersionsWithContext(context.Background(), req)
+}
+
+// DescribeClusterVersionsWithContext is like DescribeClusterVersions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeClusterVersionsWithContext(ctx context.Context, req *DescribeClusterVersionsMessage) (resp *DescribeClusterVersionsResult, err error) {
 	resp = &DescribeClusterVersionsResult{}
-	err = c.client.Do("DescribeClusterVersions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeClusterVersions", "POST", "/", req, resp)
 	return
 }
 
@@ -420,8 +660,15 @@ func (c *RedShift) DescribeClusterVersions(req *DescribeClusterVersionsMessage)
 // tag keys and values are omitted from the request, clusters are returned
 // regardless of whether they have tag keys or values associated with them.
 func (c *RedShift) DescribeClusters(req *DescribeClustersMessage) (resp *DescribeClustersResult, err error) {
+	return c.DescribeClustersWithContext(context.Background(), req)
+}
+
+// DescribeClustersWithContext is like DescribeClusters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeClustersWithContext(ctx context.Context, req *DescribeClustersMessage) (resp *DescribeClustersResult, err error) {
 	resp = &DescribeClustersResult{}
-	err = c.client.Do("DescribeClusters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeClusters", "POST", "/", req, resp)
 	return
 }
 
@@ -430,8 +677,15 @@ func (c *RedShift) DescribeClusters(req *DescribeClustersMessage) (resp *Describ
 // managing parameter groups, go to Amazon Redshift Parameter Groups in the
 // Amazon Redshift Cluster Management Guide .
 func (c *RedShift) DescribeDefaultClusterParameters(req *DescribeDefaultClusterParametersMessage) (resp *DescribeDefaultClusterParametersResult, err error) {
+	return c.DescribeDefaultClusterParametersWithContext(context.Background(), req)
+}
+
+// DescribeDefaultClusterParametersWithContext is like DescribeDefaultClusterParameters, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeDefaultClusterParametersWithContext(ctx context.Context, req *DescribeDefaultClusterParametersMessage) (resp *DescribeDefaultClusterParametersResult, err error) {
 	resp = &DescribeDefaultClusterParametersResult{}
-	err = c.client.Do("DescribeDefaultClusterParameters", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDefaultClusterParameters", "POST", "/", req, resp)
 	return
 }
 
@@ -440,8 +694,15 @@ func (c *RedShift) DescribeDefaultClusterParameters(req *DescribeDefaultClusterP
 // event categories and source types, go to Amazon Redshift Event
 // Notifications
 func (c *RedShift) DescribeEventCategories(req *DescribeEventCategoriesMessage) (resp *DescribeEventCategoriesResult, err error) {
+	return c.DescribeEventCategoriesWithContext(context.Background(), req)
+}
+
+// DescribeEventCategoriesWithContext is like DescribeEventCategories, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeEventCategoriesWithContext(ctx context.Context, req *DescribeEventCategoriesMessage) (resp *DescribeEventCategoriesResult, err error) {
 	resp = &DescribeEventCategoriesResult{}
-	err = c.client.Do("DescribeEventCategories", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEventCategories", "POST", "/", req, resp)
 	return
 }
 
@@ -449,8 +710,15 @@ func (c *RedShift) DescribeEventCategories(req *DescribeEventCategoriesMessage)
 // event notifications subscription for a customer account. If you specify
 // a subscription name, lists the description for that subscription.
 func (c *RedShift) DescribeEventSubscriptions(req *DescribeEventSubscriptionsMessage) (resp *DescribeEventSubscriptionsResult, err error) {
+	return c.DescribeEventSubscriptionsWithContext(context.Background(), req)
+}
+
+// DescribeEventSubscriptionsWithContext is like DescribeEventSubscriptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeEventSubscriptionsWithContext(ctx context.Context, req *DescribeEventSubscriptionsMessage) (resp *DescribeEventSubscriptionsResult, err error) {
 	resp = &DescribeEventSubscriptionsResult{}
-	err = c.client.Do("DescribeEventSubscriptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEventSubscriptions", "POST", "/", req, resp)
 	return
 }
 
@@ -460,8 +728,15 @@ func (c *RedShift) DescribeEventSubscriptions(req *DescribeEventSubscriptionsMes
 // obtained by providing the name as a parameter. By default, the past hour
 // of events are returned.
 func (c *RedShift) DescribeEvents(req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
+	return c.DescribeEventsWithContext(context.Background(), req)
+}
+
+// DescribeEventsWithContext is like DescribeEvents, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeEventsWithContext(ctx context.Context, req *DescribeEventsMessage) (resp *DescribeEventsResult, err error) {
 	resp = &DescribeEventsResult{}
-	err = c.client.Do("DescribeEvents", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeEvents", "POST", "/", req, resp)
 	return
 }
 
@@ -477,8 +752,15 @@ func (c *RedShift) DescribeEvents(req *DescribeEventsMessage) (resp *DescribeEve
 // request, HSM client certificates are returned regardless of whether they
 // have tag keys or values associated with them.
 func (c *RedShift) DescribeHSMClientCertificates(req *DescribeHSMClientCertificatesMessage) (resp *DescribeHSMClientCertificatesResult, err error) {
+	return c.DescribeHSMClientCertificatesWithContext(context.Background(), req)
+}
+
+// DescribeHSMClientCertificatesWithContext is like DescribeHSMClientCertificates, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeHSMClientCertificatesWithContext(ctx context.Context, req *DescribeHSMClientCertificatesMessage) (resp *DescribeHSMClientCertificatesResult, err error) {
 	resp = &DescribeHSMClientCertificatesResult{}
-	err = c.client.Do("DescribeHsmClientCertificates", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeHsmClientCertificates", "POST", "/", req, resp)
 	return
 }
 
@@ -494,8 +776,15 @@ func (c *RedShift) DescribeHSMClientCertificates(req *DescribeHSMClientCertifica
 // connections are returned regardless of whether they have tag keys or
 // values associated with them.
 func (c *RedShift) DescribeHSMConfigurations(req *DescribeHSMConfigurationsMessage) (resp *DescribeHSMConfigurationsResult, err error) {
+	return c.DescribeHSMConfigurationsWithContext(context.Background(), req)
+}
+
+// DescribeHSMConfigurationsWithContext is like DescribeHSMConfigurations, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeHSMConfigurationsWithContext(ctx context.Context, req *DescribeHSMConfigurationsMessage) (resp *DescribeHSMConfigurationsResult, err error) {
 	resp = &DescribeHSMConfigurationsResult{}
-	err = c.client.Do("DescribeHsmConfigurations", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeHsmConfigurations", "POST", "/", req, resp)
 	return
 }
 
@@ -503,8 +792,15 @@ func (c *RedShift) DescribeHSMConfigurations(req *DescribeHSMConfigurationsMessa
 // connection attempts, is being logged for the specified Amazon Redshift
 // cluster.
 func (c *RedShift) DescribeLoggingStatus(req *DescribeLoggingStatusMessage) (resp *DescribeLoggingStatusResult, err error) {
+	return c.DescribeLoggingStatusWithContext(context.Background(), req)
+}
+
+// DescribeLoggingStatusWithContext is like DescribeLoggingStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeLoggingStatusWithContext(ctx context.Context, req *DescribeLoggingStatusMessage) (resp *DescribeLoggingStatusResult, err error) {
 	resp = &DescribeLoggingStatusResult{}
-	err = c.client.Do("DescribeLoggingStatus", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeLoggingStatus", "POST", "/", req, resp)
 	return
 }
 
@@ -518,8 +814,15 @@ func (c *RedShift) DescribeLoggingStatus(req *DescribeLoggingStatusMessage) (res
 // cluster. For more information about managing clusters, go to Amazon
 // Redshift Clusters in the Amazon Redshift Cluster Management Guide
 func (c *RedShift) DescribeOrderableClusterOptions(req *DescribeOrderableClusterOptionsMessage) (resp *DescribeOrderableClusterOptionsResult, err error) {
+	return c.DescribeOrderableClusterOptionsWithContext(context.Background(), req)
+}
+
+// DescribeOrderableClusterOptionsWithContext is like DescribeOrderableClusterOptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeOrderableClusterOptionsWithContext(ctx context.Context, req *DescribeOrderableClusterOptionsMessage) (resp *DescribeOrderableClusterOptionsResult, err error) {
 	resp = &DescribeOrderableClusterOptionsResult{}
-	err = c.client.Do("DescribeOrderableClusterOptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeOrderableClusterOptions", "POST", "/", req, resp)
 	return
 }
 
@@ -533,15 +836,29 @@ func (c *RedShift) DescribeOrderableClusterOptions(req *DescribeOrderableCluster
 // information about managing parameter groups, go to Purchasing Reserved
 // Nodes in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) DescribeReservedNodeOfferings(req *DescribeReservedNodeOfferingsMessage) (resp *DescribeReservedNodeOfferingsResult, err error) {
+	return c.DescribeReservedNodeOfferingsWithContext(context.Background(), req)
+}
+
+// DescribeReservedNodeOfferingsWithContext is like DescribeReservedNodeOfferings, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeReservedNodeOfferingsWithContext(ctx context.Context, req *DescribeReservedNodeOfferingsMessage) (resp *DescribeReservedNodeOfferingsResult, err error) {
 	resp = &DescribeReservedNodeOfferingsResult{}
-	err = c.client.Do("DescribeReservedNodeOfferings", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedNodeOfferings", "POST", "/", req, resp)
 	return
 }
 
 // DescribeReservedNodes is undocumented.
 func (c *RedShift) DescribeReservedNodes(req *DescribeReservedNodesMessage) (resp *DescribeReservedNodesResult, err error) {
+	return c.DescribeReservedNodesWithContext(context.Background(), req)
+}
+
+// DescribeReservedNodesWithContext is like DescribeReservedNodes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeReservedNodesWithContext(ctx context.Context, req *DescribeReservedNodesMessage) (resp *DescribeReservedNodesResult, err error) {
 	resp = &DescribeReservedNodesResult{}
-	err = c.client.Do("DescribeReservedNodes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeReservedNodes", "POST", "/", req, resp)
 	return
 }
 
@@ -553,8 +870,15 @@ func (c *RedShift) DescribeReservedNodes(req *DescribeReservedNodesMessage) (res
 // ModifyCluster and specifying a different number or type of nodes for the
 // cluster.
 func (c *RedShift) DescribeResize(req *DescribeResizeMessage) (resp *DescribeResizeResult, err error) {
+	return c.DescribeResizeWithContext(context.Background(), req)
+}
+
+// DescribeResizeWithContext is like DescribeResize, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeResizeWithContext(ctx context.Context, req *DescribeResizeMessage) (resp *DescribeResizeResult, err error) {
 	resp = &DescribeResizeResult{}
-	err = c.client.Do("DescribeResize", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeResize", "POST", "/", req, resp)
 	return
 }
 
@@ -574,40 +898,75 @@ func (c *RedShift) DescribeResize(req *DescribeResizeMessage) (resp *DescribeRes
 // returned regardless of whether they have tag keys or values associated
 // with them.
 func (c *RedShift) DescribeTags(req *DescribeTagsMessage) (resp *DescribeTagsResult, err error) {
+	return c.DescribeTagsWithContext(context.Background(), req)
+}
+
+// DescribeTagsWithContext is like DescribeTags, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DescribeTagsWithContext(ctx context.Context, req *DescribeTagsMessage) (resp *DescribeTagsResult, err error) {
 	resp = &DescribeTagsResult{}
-	err = c.client.Do("DescribeTags", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTags", "POST", "/", req, resp)
 	return
 }
 
 // DisableLogging stops logging information, such as queries and connection
 // attempts, for the specified Amazon Redshift cluster.
 func (c *RedShift) DisableLogging(req *DisableLoggingMessage) (resp *DisableLoggingResult, err error) {
+	return c.DisableLoggingWithContext(context.Background(), req)
+}
+
+// DisableLoggingWithContext is like DisableLogging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DisableLoggingWithContext(ctx context.Context, req *DisableLoggingMessage) (resp *DisableLoggingResult, err error) {
 	resp = &DisableLoggingResult{}
-	err = c.client.Do("DisableLogging", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DisableLogging", "POST", "/", req, resp)
 	return
 }
 
 // DisableSnapshotCopy disables the automatic copying of snapshots from one
 // region to another region for a specified cluster.
 func (c *RedShift) DisableSnapshotCopy(req *DisableSnapshotCopyMessage) (resp *DisableSnapshotCopyResult, err error) {
+	return c.DisableSnapshotCopyWithContext(context.Background(), req)
+}
+
+// DisableSnapshotCopyWithContext is like DisableSnapshotCopy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) DisableSnapshotCopyWithContext(ctx context.Context, req *DisableSnapshotCopyMessage) (resp *DisableSnapshotCopyResult, err error) {
 	resp = &DisableSnapshotCopyResult{}
-	err = c.client.Do("DisableSnapshotCopy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DisableSnapshotCopy", "POST", "/", req, resp)
 	return
 }
 
 // EnableLogging starts logging information, such as queries and connection
 // attempts, for the specified Amazon Redshift cluster.
 func (c *RedShift) EnableLogging(req *EnableLoggingMessage) (resp *EnableLoggingResult, err error) {
+	return c.EnableLoggingWithContext(context.Background(), req)
+}
+
+// EnableLoggingWithContext is like EnableLogging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) EnableLoggingWithContext(ctx context.Context, req *EnableLoggingMessage) (resp *EnableLoggingResult, err error) {
 	resp = &EnableLoggingResult{}
-	err = c.client.Do("EnableLogging", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EnableLogging", "POST", "/", req, resp)
 	return
 }
 
 // EnableSnapshotCopy enables the automatic copy of snapshots from one
 // region to another region for a specified cluster.
 func (c *RedShift) EnableSnapshotCopy(req *EnableSnapshotCopyMessage) (resp *EnableSnapshotCopyResult, err error) {
+	return c.EnableSnapshotCopyWithContext(context.Background(), req)
+}
+
+// EnableSnapshotCopyWithContext is like EnableSnapshotCopy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) EnableSnapshotCopyWithContext(ctx context.Context, req *EnableSnapshotCopyMessage) (resp *EnableSnapshotCopyResult, err error) {
 	resp = &EnableSnapshotCopyResult{}
-	err = c.client.Do("EnableSnapshotCopy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EnableSnapshotCopy", "POST", "/", req, resp)
 	return
 }
 
@@ -623,8 +982,15 @@ func (c *RedShift) EnableSnapshotCopy(req *EnableSnapshotCopyMessage) (resp *Ena
 // cluster, you must specify both the number of nodes and the node type
 // even if one of the parameters does not change.
 func (c *RedShift) ModifyCluster(req *ModifyClusterMessage) (resp *ModifyClusterResult, err error) {
+	return c.ModifyClusterWithContext(context.Background(), req)
+}
+
+// ModifyClusterWithContext is like ModifyCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) ModifyClusterWithContext(ctx context.Context, req *ModifyClusterMessage) (resp *ModifyClusterResult, err error) {
 	resp = &ModifyClusterResult{}
-	err = c.client.Do("ModifyCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -633,8 +999,15 @@ func (c *RedShift) ModifyCluster(req *ModifyClusterMessage) (resp *ModifyCluster
 // Amazon Redshift Parameter Groups in the Amazon Redshift Cluster
 // Management Guide .
 func (c *RedShift) ModifyClusterParameterGroup(req *ModifyClusterParameterGroupMessage) (resp *ModifyClusterParameterGroupResult, err error) {
+	return c.ModifyClusterParameterGroupWithContext(context.Background(), req)
+}
+
+// ModifyClusterParameterGroupWithContext is like ModifyClusterParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) ModifyClusterParameterGroupWithContext(ctx context.Context, req *ModifyClusterParameterGroupMessage) (resp *ModifyClusterParameterGroupResult, err error) {
 	resp = &ModifyClusterParameterGroupResult{}
-	err = c.client.Do("ModifyClusterParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyClusterParameterGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -642,16 +1015,30 @@ func (c *RedShift) ModifyClusterParameterGroup(req *ModifyClusterParameterGroupM
 // specified list of VPC subnets. The operation replaces the existing list
 // of subnets with the new list of subnets.
 func (c *RedShift) ModifyClusterSubnetGroup(req *ModifyClusterSubnetGroupMessage) (resp *ModifyClusterSubnetGroupResult, err error) {
+	return c.ModifyClusterSubnetGroupWithContext(context.Background(), req)
+}
+
+// ModifyClusterSubnetGroupWithContext is like ModifyClusterSubnetGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) ModifyClusterSubnetGroupWithContext(ctx context.Context, req *ModifyClusterSubnetGroupMessage) (resp *ModifyClusterSubnetGroupResult, err error) {
 	resp = &ModifyClusterSubnetGroupResult{}
-	err = c.client.Do("ModifyClusterSubnetGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyClusterSubnetGroup", "POST", "/", req, resp)
 	return
 }
 
 // ModifyEventSubscription modifies an existing Amazon Redshift event
 // notification subscription.
 func (c *RedShift) ModifyEventSubscription(req *ModifyEventSubscriptionMessage) (resp *ModifyEventSubscriptionResult, err error) {
+	return c.ModifyEventSubscriptionWithContext(context.Background(), req)
+}
+
+// ModifyEventSubscriptionWithContext is like ModifyEventSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) ModifyEventSubscriptionWithContext(ctx context.Context, req *ModifyEventSubscriptionMessage) (resp *ModifyEventSubscriptionResult, err error) {
 	resp = &ModifyEventSubscriptionResult{}
-	err = c.client.Do("ModifyEventSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifyEventSubscription", "POST", "/", req, resp)
 	return
 }
 
@@ -659,8 +1046,15 @@ func (c *RedShift) ModifyEventSubscription(req *ModifyEventSubscriptionMessage)
 // automated snapshots in the destination region after they are copied from
 // the source region.
 func (c *RedShift) ModifySnapshotCopyRetentionPeriod(req *ModifySnapshotCopyRetentionPeriodMessage) (resp *ModifySnapshotCopyRetentionPeriodResult, err error) {
+	return c.ModifySnapshotCopyRetentionPeriodWithContext(context.Background(), req)
+}
+
+// ModifySnapshotCopyRetentionPeriodWithContext is like ModifySnapshotCopyRetentionPeriod, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) ModifySnapshotCopyRetentionPeriodWithContext(ctx context.Context, req *ModifySnapshotCopyRetentionPeriodMessage) (resp *ModifySnapshotCopyRetentionPeriodResult, err error) {
 	resp = &ModifySnapshotCopyRetentionPeriodResult{}
-	err = c.client.Do("ModifySnapshotCopyRetentionPeriod", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ModifySnapshotCopyRetentionPeriod", "POST", "/", req, resp)
 	return
 }
 
@@ -673,8 +1067,15 @@ func (c *RedShift) ModifySnapshotCopyRetentionPeriod(req *ModifySnapshotCopyRete
 // information about managing parameter groups, go to Purchasing Reserved
 // Nodes in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) PurchaseReservedNodeOffering(req *PurchaseReservedNodeOfferingMessage) (resp *PurchaseReservedNodeOfferingResult, err error) {
+	return c.PurchaseReservedNodeOfferingWithContext(context.Background(), req)
+}
+
+// PurchaseReservedNodeOfferingWithContext is like PurchaseReservedNodeOffering, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) PurchaseReservedNodeOfferingWithContext(ctx context.Context, req *PurchaseReservedNodeOfferingMessage) (resp *PurchaseReservedNodeOfferingResult, err error) {
 	resp = &PurchaseReservedNodeOfferingResult{}
-	err = c.client.Do("PurchaseReservedNodeOffering", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PurchaseReservedNodeOffering", "POST", "/", req, resp)
 	return
 }
 
@@ -686,8 +1087,15 @@ func (c *RedShift) PurchaseReservedNodeOffering(req *PurchaseReservedNodeOfferin
 // managing clusters, go to Amazon Redshift Clusters in the Amazon Redshift
 // Cluster Management Guide
 func (c *RedShift) RebootCluster(req *RebootClusterMessage) (resp *RebootClusterResult, err error) {
+	return c.RebootClusterWithContext(context.Background(), req)
+}
+
+// RebootClusterWithContext is like RebootCluster, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) RebootClusterWithContext(ctx context.Context, req *RebootClusterMessage) (resp *RebootClusterResult, err error) {
 	resp = &RebootClusterResult{}
-	err = c.client.Do("RebootCluster", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RebootCluster", "POST", "/", req, resp)
 	return
 }
 
@@ -697,8 +1105,15 @@ func (c *RedShift) RebootCluster(req *RebootClusterMessage) (resp *RebootCluster
 // specify the ResetAllParameters parameter. For parameter changes to take
 // effect you must reboot any associated clusters.
 func (c *RedShift) ResetClusterParameterGroup(req *ResetClusterParameterGroupMessage) (resp *ResetClusterParameterGroupResult, err error) {
+	return c.ResetClusterParameterGroupWithContext(context.Background(), req)
+}
+
+// ResetClusterParameterGroupWithContext is like ResetClusterParameterGroup, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) ResetClusterParameterGroupWithContext(ctx context.Context, req *ResetClusterParameterGroupMessage) (resp *ResetClusterParameterGroupResult, err error) {
 	resp = &ResetClusterParameterGroupResult{}
-	err = c.client.Do("ResetClusterParameterGroup", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ResetClusterParameterGroup", "POST", "/", req, resp)
 	return
 }
 
@@ -714,8 +1129,15 @@ func (c *RedShift) ResetClusterParameterGroup(req *ResetClusterParameterGroupMes
 // go to Amazon Redshift Snapshots in the Amazon Redshift Cluster
 // Management Guide .
 func (c *RedShift) RestoreFromClusterSnapshot(req *RestoreFromClusterSnapshotMessage) (resp *RestoreFromClusterSnapshotResult, err error) {
+	return c.RestoreFromClusterSnapshotWithContext(context.Background(), req)
+}
+
+// RestoreFromClusterSnapshotWithContext is like RestoreFromClusterSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) RestoreFromClusterSnapshotWithContext(ctx context.Context, req *RestoreFromClusterSnapshotMessage) (resp *RestoreFromClusterSnapshotResult, err error) {
 	resp = &RestoreFromClusterSnapshotResult{}
-	err = c.client.Do("RestoreFromClusterSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RestoreFromClusterSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -726,8 +1148,15 @@ func (c *RedShift) RestoreFromClusterSnapshot(req *RestoreFromClusterSnapshotMes
 // security groups, go to Amazon Redshift Cluster Security Groups in the
 // Amazon Redshift Cluster Management Guide .
 func (c *RedShift) RevokeClusterSecurityGroupIngress(req *RevokeClusterSecurityGroupIngressMessage) (resp *RevokeClusterSecurityGroupIngressResult, err error) {
+	return c.RevokeClusterSecurityGroupIngressWithContext(context.Background(), req)
+}
+
+// RevokeClusterSecurityGroupIngressWithContext is like RevokeClusterSecurityGroupIngress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) RevokeClusterSecurityGroupIngressWithContext(ctx context.Context, req *RevokeClusterSecurityGroupIngressMessage) (resp *RevokeClusterSecurityGroupIngressResult, err error) {
 	resp = &RevokeClusterSecurityGroupIngressResult{}
-	err = c.client.Do("RevokeClusterSecurityGroupIngress", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RevokeClusterSecurityGroupIngress", "POST", "/", req, resp)
 	return
 }
 
@@ -737,15 +1166,29 @@ func (c *RedShift) RevokeClusterSecurityGroupIngress(req *RevokeClusterSecurityG
 // information about working with snapshots, go to Amazon Redshift
 // Snapshots in the Amazon Redshift Cluster Management Guide .
 func (c *RedShift) RevokeSnapshotAccess(req *RevokeSnapshotAccessMessage) (resp *RevokeSnapshotAccessResult, err error) {
+	return c.RevokeSnapshotAccessWithContext(context.Background(), req)
+}
+
+// RevokeSnapshotAccessWithContext is like RevokeSnapshotAccess, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) RevokeSnapshotAccessWithContext(ctx context.Context, req *RevokeSnapshotAccessMessage) (resp *RevokeSnapshotAccessResult, err error) {
 	resp = &RevokeSnapshotAccessResult{}
-	err = c.client.Do("RevokeSnapshotAccess", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RevokeSnapshotAccess", "POST", "/", req, resp)
 	return
 }
 
 // RotateEncryptionKey is undocumented.
 func (c *RedShift) RotateEncryptionKey(req *RotateEncryptionKeyMessage) (resp *RotateEncryptionKeyResult, err error) {
+	return c.RotateEncryptionKeyWithContext(context.Background(), req)
+}
+
+// RotateEncryptionKeyWithContext is like RotateEncryptionKey, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *RedShift) RotateEncryptionKeyWithContext(ctx context.Context, req *RotateEncryptionKeyMessage) (resp *RotateEncryptionKeyResult, err error) {
 	resp = &RotateEncryptionKeyResult{}
-	err = c.client.Do("RotateEncryptionKey", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RotateEncryptionKey", "POST", "/", req, resp)
 	return
 }
 
@@ -762,6 +1205,26 @@ type AuthorizeClusterSecurityGroupIngressMessage struct {
 	EC2SecurityGroupOwnerID  aws.StringValue `query:"EC2SecurityGroupOwnerId" xml:"EC2SecurityGroupOwnerId"`
 }
 
+// MarshalQuery adds the parameters of the AuthorizeClusterSecurityGroupIngressMessage to the form, prefixing their names.
+func (v *AuthorizeClusterSecurityGroupIngressMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CIDRIP", *v.CIDRIP)
+	}
+	if v.ClusterSecurityGroupName != nil {
+		form.Set(prefix+"ClusterSecurityGroupName", *v.ClusterSecurityGroupName)
+	}
+	if v.EC2SecurityGroupName != nil {
+		form.Set(prefix+"EC2SecurityGroupName", *v.EC2SecurityGroupName)
+	}
+	if v.EC2SecurityGroupOwnerID != nil {
+		form.Set(prefix+"EC2SecurityGroupOwnerId", *v.EC2SecurityGroupOwnerID)
+	}
+	return nil
+}
+
 // AuthorizeClusterSecurityGroupIngressResult is undocumented.
 type AuthorizeClusterSecurityGroupIngressResult struct {
 	ClusterSecurityGroup *ClusterSecurityGroup `query:"ClusterSecurityGroup" xml:"AuthorizeClusterSecurityGroupIngressResult>ClusterSecurityGroup"`
@@ -774,6 +1237,23 @@ type AuthorizeSnapshotAccessMessage struct {
 	SnapshotIdentifier        aws.StringValue `query:"SnapshotIdentifier" xml:"SnapshotIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the AuthorizeSnapshotAccessMessage to the form, prefixing their names.
+func (v *AuthorizeSnapshotAccessMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccountWithRestoreAccess != nil {
+		form.Set(prefix+"AccountWithRestoreAccess", *v.AccountWithRestoreAccess)
+	}
+	if v.SnapshotClusterIdentifier != nil {
+		form.Set(prefix+"SnapshotClusterIdentifier", *v.SnapshotClusterIdentifier)
+	}
+	if v.SnapshotIdentifier != nil {
+		form.Set(prefix+"SnapshotIdentifier", *v.SnapshotIdentifier)
+	}
+	return nil
+}
+
 // AuthorizeSnapshotAccessResult is undocumented.
 type AuthorizeSnapshotAccessResult struct {
 	Snapshot *Snapshot `query:"Snapshot" xml:"AuthorizeSnapshotAccessResult>Snapshot"`
@@ -791,11 +1271,11 @@ type Cluster struct {
 	AvailabilityZone                 aws.StringValue                  `query:"AvailabilityZone" xml:"AvailabilityZone"`
 	ClusterCreateTime                time.Time                        `query:"ClusterCreateTime" xml:"ClusterCreateTime"`
 	ClusterIdentifier                aws.StringValue                  `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
-	ClusterNodes                     []ClusterNode                    `query:"ClusterNodes.member" xml:"ClusterNodes>member"`
-	ClusterParameterGroups           []ClusterParameterGroupStatus    `query:"ClusterParameterGroups.member" xml:"ClusterParameterGroups>ClusterParameterGroup"`
+	ClusterNodes                     []ClusterNode                    `query:"ClusterNodes,list:member" xml:"ClusterNodes>member"`
+	ClusterParameterGroups           []ClusterParameterGroupStatus    `query:"ClusterParameterGroups,list:ClusterParameterGroup" xml:"ClusterParameterGroups>ClusterParameterGroup"`
 	ClusterPublicKey                 aws.StringValue                  `query:"ClusterPublicKey" xml:"ClusterPublicKey"`
 	ClusterRevisionNumber            aws.StringValue                  `query:"ClusterRevisionNumber" xml:"ClusterRevisionNumber"`
-	ClusterSecurityGroups            []ClusterSecurityGroupMembership `query:"ClusterSecurityGroups.member" xml:"ClusterSecurityGroups>ClusterSecurityGroup"`
+	ClusterSecurityGroups            []ClusterSecurityGroupMembership `query:"ClusterSecurityGroups,list:ClusterSecurityGroup" xml:"ClusterSecurityGroups>ClusterSecurityGroup"`
 	ClusterSnapshotCopyStatus        *ClusterSnapshotCopyStatus       `query:"ClusterSnapshotCopyStatus" xml:"ClusterSnapshotCopyStatus"`
 	ClusterStatus                    aws.StringValue                  `query:"ClusterStatus" xml:"ClusterStatus"`
 	ClusterSubnetGroupName           aws.StringValue                  `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
@@ -814,9 +1294,9 @@ type Cluster struct {
 	PreferredMaintenanceWindow       aws.StringValue                  `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
 	PubliclyAccessible               aws.BooleanValue                 `query:"PubliclyAccessible" xml:"PubliclyAccessible"`
 	RestoreStatus                    *RestoreStatus                   `query:"RestoreStatus" xml:"RestoreStatus"`
-	Tags                             []Tag                            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                             []Tag                            `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	VPCID                            aws.StringValue                  `query:"VpcId" xml:"VpcId"`
-	VPCSecurityGroups                []VPCSecurityGroupMembership     `query:"VpcSecurityGroups.member" xml:"VpcSecurityGroups>VpcSecurityGroup"`
+	VPCSecurityGroups                []VPCSecurityGroupMembership     `query:"VpcSecurityGroups,list:VpcSecurityGroup" xml:"VpcSecurityGroups>VpcSecurityGroup"`
 }
 
 // ClusterNode is undocumented.
@@ -831,13 +1311,13 @@ type ClusterParameterGroup struct {
 	Description          aws.StringValue `query:"Description" xml:"Description"`
 	ParameterGroupFamily aws.StringValue `query:"ParameterGroupFamily" xml:"ParameterGroupFamily"`
 	ParameterGroupName   aws.StringValue `query:"ParameterGroupName" xml:"ParameterGroupName"`
-	Tags                 []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                 []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 }
 
 // ClusterParameterGroupDetails is undocumented.
 type ClusterParameterGroupDetails struct {
 	Marker     aws.StringValue `query:"Marker" xml:"DescribeClusterParametersResult>Marker"`
-	Parameters []Parameter     `query:"Parameters.member" xml:"DescribeClusterParametersResult>Parameters>Parameter"`
+	Parameters []Parameter     `query:"Parameters,list:Parameter" xml:"DescribeClusterParametersResult>Parameters>Parameter"`
 }
 
 // ClusterParameterGroupNameMessage is undocumented.
@@ -855,16 +1335,16 @@ type ClusterParameterGroupStatus struct {
 // ClusterParameterGroupsMessage is undocumented.
 type ClusterParameterGroupsMessage struct {
 	Marker          aws.StringValue         `query:"Marker" xml:"DescribeClusterParameterGroupsResult>Marker"`
-	ParameterGroups []ClusterParameterGroup `query:"ParameterGroups.member" xml:"DescribeClusterParameterGroupsResult>ParameterGroups>ClusterParameterGroup"`
+	ParameterGroups []ClusterParameterGroup `query:"ParameterGroups,list:ClusterParameterGroup" xml:"DescribeClusterParameterGroupsResult>ParameterGroups>ClusterParameterGroup"`
 }
 
 // ClusterSecurityGroup is undocumented.
 type ClusterSecurityGroup struct {
 	ClusterSecurityGroupName aws.StringValue    `query:"ClusterSecurityGroupName" xml:"ClusterSecurityGroupName"`
 	Description              aws.StringValue    `query:"Description" xml:"Description"`
-	EC2SecurityGroups        []EC2SecurityGroup `query:"EC2SecurityGroups.member" xml:"EC2SecurityGroups>EC2SecurityGroup"`
-	IPRanges                 []IPRange          `query:"IPRanges.member" xml:"IPRanges>IPRange"`
-	Tags                     []Tag              `query:"Tags.member" xml:"Tags>Tag"`
+	EC2SecurityGroups        []EC2SecurityGroup `query:"EC2SecurityGroups,list:EC2SecurityGroup" xml:"EC2SecurityGroups>EC2SecurityGroup"`
+	IPRanges                 []IPRange          `query:"IPRanges,list:IPRange" xml:"IPRanges>IPRange"`
+	Tags                     []Tag              `query:"Tags,list:Tag" xml:"Tags>Tag"`
 }
 
 // ClusterSecurityGroupMembership is undocumented.
@@ -875,7 +1355,7 @@ type ClusterSecurityGroupMembership struct {
 
 // ClusterSecurityGroupMessage is undocumented.
 type ClusterSecurityGroupMessage struct {
-	ClusterSecurityGroups []ClusterSecurityGroup `query:"ClusterSecurityGroups.member" xml:"DescribeClusterSecurityGroupsResult>ClusterSecurityGroups>ClusterSecurityGroup"`
+	ClusterSecurityGroups []ClusterSecurityGroup `query:"ClusterSecurityGroups,list:ClusterSecurityGroup" xml:"DescribeClusterSecurityGroupsResult>ClusterSecurityGroups>ClusterSecurityGroup"`
 	Marker                aws.StringValue        `query:"Marker" xml:"DescribeClusterSecurityGroupsResult>Marker"`
 }
 
@@ -890,14 +1370,14 @@ type ClusterSubnetGroup struct {
 	ClusterSubnetGroupName aws.StringValue `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
 	Description            aws.StringValue `query:"Description" xml:"Description"`
 	SubnetGroupStatus      aws.StringValue `query:"SubnetGroupStatus" xml:"SubnetGroupStatus"`
-	Subnets                []Subnet        `query:"Subnets.member" xml:"Subnets>Subnet"`
-	Tags                   []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Subnets                []Subnet        `query:"Subnets,list:Subnet" xml:"Subnets>Subnet"`
+	Tags                   []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	VPCID                  aws.StringValue `query:"VpcId" xml:"VpcId"`
 }
 
 // ClusterSubnetGroupMessage is undocumented.
 type ClusterSubnetGroupMessage struct {
-	ClusterSubnetGroups []ClusterSubnetGroup `query:"ClusterSubnetGroups.member" xml:"DescribeClusterSubnetGroupsResult>ClusterSubnetGroups>ClusterSubnetGroup"`
+	ClusterSubnetGroups []ClusterSubnetGroup `query:"ClusterSubnetGroups,list:ClusterSubnetGroup" xml:"DescribeClusterSubnetGroupsResult>ClusterSubnetGroups>ClusterSubnetGroup"`
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeClusterSubnetGroupsResult>Marker"`
 }
 
@@ -910,13 +1390,13 @@ type ClusterVersion struct {
 
 // ClusterVersionsMessage is undocumented.
 type ClusterVersionsMessage struct {
-	ClusterVersions []ClusterVersion `query:"ClusterVersions.member" xml:"DescribeClusterVersionsResult>ClusterVersions>ClusterVersion"`
+	ClusterVersions []ClusterVersion `query:"ClusterVersions,list:ClusterVersion" xml:"DescribeClusterVersionsResult>ClusterVersions>ClusterVersion"`
 	Marker          aws.StringValue  `query:"Marker" xml:"DescribeClusterVersionsResult>Marker"`
 }
 
 // ClustersMessage is undocumented.
 type ClustersMessage struct {
-	Clusters []Cluster       `query:"Clusters.member" xml:"DescribeClustersResult>Clusters>Cluster"`
+	Clusters []Cluster       `query:"Clusters,list:Cluster" xml:"DescribeClustersResult>Clusters>Cluster"`
 	Marker   aws.StringValue `query:"Marker" xml:"DescribeClustersResult>Marker"`
 }
 
@@ -927,6 +1407,23 @@ type CopyClusterSnapshotMessage struct {
 	TargetSnapshotIdentifier        aws.StringValue `query:"TargetSnapshotIdentifier" xml:"TargetSnapshotIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the CopyClusterSnapshotMessage to the form, prefixing their names.
+func (v *CopyClusterSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceSnapshotClusterIdentifier != nil {
+		form.Set(prefix+"SourceSnapshotClusterIdentifier", *v.SourceSnapshotClusterIdentifier)
+	}
+	if v.SourceSnapshotIdentifier != nil {
+		form.Set(prefix+"SourceSnapshotIdentifier", *v.SourceSnapshotIdentifier)
+	}
+	if v.TargetSnapshotIdentifier != nil {
+		form.Set(prefix+"TargetSnapshotIdentifier", *v.TargetSnapshotIdentifier)
+	}
+	return nil
+}
+
 // CopyClusterSnapshotResult is undocumented.
 type CopyClusterSnapshotResult struct {
 	Snapshot *Snapshot `query:"Snapshot" xml:"CopyClusterSnapshotResult>Snapshot"`
@@ -939,7 +1436,7 @@ type CreateClusterMessage struct {
 	AvailabilityZone                 aws.StringValue  `query:"AvailabilityZone" xml:"AvailabilityZone"`
 	ClusterIdentifier                aws.StringValue  `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 	ClusterParameterGroupName        aws.StringValue  `query:"ClusterParameterGroupName" xml:"ClusterParameterGroupName"`
-	ClusterSecurityGroups            []string         `query:"ClusterSecurityGroups.member" xml:"ClusterSecurityGroups>ClusterSecurityGroupName"`
+	ClusterSecurityGroups            []string         `query:"ClusterSecurityGroups,list:ClusterSecurityGroupName" xml:"ClusterSecurityGroups>ClusterSecurityGroupName"`
 	ClusterSubnetGroupName           aws.StringValue  `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
 	ClusterType                      aws.StringValue  `query:"ClusterType" xml:"ClusterType"`
 	ClusterVersion                   aws.StringValue  `query:"ClusterVersion" xml:"ClusterVersion"`
@@ -956,8 +1453,108 @@ type CreateClusterMessage struct {
 	Port                             aws.IntegerValue `query:"Port" xml:"Port"`
 	PreferredMaintenanceWindow       aws.StringValue  `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
 	PubliclyAccessible               aws.BooleanValue `query:"PubliclyAccessible" xml:"PubliclyAccessible"`
-	Tags                             []Tag            `query:"Tags.member" xml:"Tags>Tag"`
-	VPCSecurityGroupIDs              []string         `query:"VpcSecurityGroupIds.member" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+	Tags                             []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
+	VPCSecurityGroupIDs              []string         `query:"VpcSecurityGroupIds,list:VpcSecurityGroupId" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+}
+
+// MarshalQuery adds the parameters of the CreateClusterMessage to the form, prefixing their names.
+func (v *CreateClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowVersionUpgrade != nil {
+		form.Set(prefix+"AllowVersionUpgrade", strconv.FormatBool(*v.AllowVersionUpgrade))
+	}
+	if v.AutomatedSnapshotRetentionPeriod != nil {
+		form.Set(prefix+"AutomatedSnapshotRetentionPeriod", strconv.FormatInt(int64(*v.AutomatedSnapshotRetentionPeriod), 10))
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.ClusterParameterGroupName != nil {
+		form.Set(prefix+"ClusterParameterGroupName", *v.ClusterParameterGroupName)
+	}
+	if v.ClusterSecurityGroups != nil {
+		if len(v.ClusterSecurityGroups) == 0 {
+			form.Set(prefix+"ClusterSecurityGroups", "")
+		}
+		for i1 := range v.ClusterSecurityGroups {
+			name1 := prefix + "ClusterSecurityGroups.ClusterSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ClusterSecurityGroups[i1])
+		}
+	}
+	if v.ClusterSubnetGroupName != nil {
+		form.Set(prefix+"ClusterSubnetGroupName", *v.ClusterSubnetGroupName)
+	}
+	if v.ClusterType != nil {
+		form.Set(prefix+"ClusterType", *v.ClusterType)
+	}
+	if v.ClusterVersion != nil {
+		form.Set(prefix+"ClusterVersion", *v.ClusterVersion)
+	}
+	if v.DBName != nil {
+		form.Set(prefix+"DBName", *v.DBName)
+	}
+	if v.ElasticIP != nil {
+		form.Set(prefix+"ElasticIp", *v.ElasticIP)
+	}
+	if v.Encrypted != nil {
+		form.Set(prefix+"Encrypted", strconv.FormatBool(*v.Encrypted))
+	}
+	if v.HSMClientCertificateIdentifier != nil {
+		form.Set(prefix+"HsmClientCertificateIdentifier", *v.HSMClientCertificateIdentifier)
+	}
+	if v.HSMConfigurationIdentifier != nil {
+		form.Set(prefix+"HsmConfigurationIdentifier", *v.HSMConfigurationIdentifier)
+	}
+	if v.KMSKeyID != nil {
+		form.Set(prefix+"KmsKeyId", *v.KMSKeyID)
+	}
+	if v.MasterUserPassword != nil {
+		form.Set(prefix+"MasterUserPassword", *v.MasterUserPassword)
+	}
+	if v.MasterUsername != nil {
+		form.Set(prefix+"MasterUsername", *v.MasterUsername)
+	}
+	if v.NodeType != nil {
+		form.Set(prefix+"NodeType", *v.NodeType)
+	}
+	if v.NumberOfNodes != nil {
+		form.Set(prefix+"NumberOfNodes", strconv.FormatInt(int64(*v.NumberOfNodes), 10))
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.PubliclyAccessible != nil {
+		form.Set(prefix+"PubliclyAccessible", strconv.FormatBool(*v.PubliclyAccessible))
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.VPCSecurityGroupIDs != nil {
+		if len(v.VPCSecurityGroupIDs) == 0 {
+			form.Set(prefix+"VpcSecurityGroupIds", "")
+		}
+		for i1 := range v.VPCSecurityGroupIDs {
+			name1 := prefix + "VpcSecurityGroupIds.VpcSecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCSecurityGroupIDs[i1])
+		}
+	}
+	return nil
 }
 
 // CreateClusterParameterGroupMessage is undocumented.
@@ -965,7 +1562,35 @@ type CreateClusterParameterGroupMessage struct {
 	Description          aws.StringValue `query:"Description" xml:"Description"`
 	ParameterGroupFamily aws.StringValue `query:"ParameterGroupFamily" xml:"ParameterGroupFamily"`
 	ParameterGroupName   aws.StringValue `query:"ParameterGroupName" xml:"ParameterGroupName"`
-	Tags                 []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                 []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateClusterParameterGroupMessage to the form, prefixing their names.
+func (v *CreateClusterParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.ParameterGroupFamily != nil {
+		form.Set(prefix+"ParameterGroupFamily", *v.ParameterGroupFamily)
+	}
+	if v.ParameterGroupName != nil {
+		form.Set(prefix+"ParameterGroupName", *v.ParameterGroupName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateClusterParameterGroupResult is undocumented.
@@ -982,7 +1607,32 @@ type CreateClusterResult struct {
 type CreateClusterSecurityGroupMessage struct {
 	ClusterSecurityGroupName aws.StringValue `query:"ClusterSecurityGroupName" xml:"ClusterSecurityGroupName"`
 	Description              aws.StringValue `query:"Description" xml:"Description"`
-	Tags                     []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                     []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateClusterSecurityGroupMessage to the form, prefixing their names.
+func (v *CreateClusterSecurityGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterSecurityGroupName != nil {
+		form.Set(prefix+"ClusterSecurityGroupName", *v.ClusterSecurityGroupName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateClusterSecurityGroupResult is undocumented.
@@ -994,7 +1644,32 @@ type CreateClusterSecurityGroupResult struct {
 type CreateClusterSnapshotMessage struct {
 	ClusterIdentifier  aws.StringValue `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 	SnapshotIdentifier aws.StringValue `query:"SnapshotIdentifier" xml:"SnapshotIdentifier"`
-	Tags               []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags               []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateClusterSnapshotMessage to the form, prefixing their names.
+func (v *CreateClusterSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.SnapshotIdentifier != nil {
+		form.Set(prefix+"SnapshotIdentifier", *v.SnapshotIdentifier)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateClusterSnapshotResult is undocumented.
@@ -1006,8 +1681,42 @@ type CreateClusterSnapshotResult struct {
 type CreateClusterSubnetGroupMessage struct {
 	ClusterSubnetGroupName aws.StringValue `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
 	Description            aws.StringValue `query:"Description" xml:"Description"`
-	SubnetIDs              []string        `query:"SubnetIds.member" xml:"SubnetIds>SubnetIdentifier"`
-	Tags                   []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	SubnetIDs              []string        `query:"SubnetIds,list:SubnetIdentifier" xml:"SubnetIds>SubnetIdentifier"`
+	Tags                   []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateClusterSubnetGroupMessage to the form, prefixing their names.
+func (v *CreateClusterSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterSubnetGroupName != nil {
+		form.Set(prefix+"ClusterSubnetGroupName", *v.ClusterSubnetGroupName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.SubnetIDs != nil {
+		if len(v.SubnetIDs) == 0 {
+			form.Set(prefix+"SubnetIds", "")
+		}
+		for i1 := range v.SubnetIDs {
+			name1 := prefix + "SubnetIds.SubnetIdentifier." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SubnetIDs[i1])
+		}
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateClusterSubnetGroupResult is undocumented.
@@ -1018,13 +1727,65 @@ type CreateClusterSubnetGroupResult struct {
 // CreateEventSubscriptionMessage is undocumented.
 type CreateEventSubscriptionMessage struct {
 	Enabled          aws.BooleanValue `query:"Enabled" xml:"Enabled"`
-	EventCategories  []string         `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories  []string         `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	Severity         aws.StringValue  `query:"Severity" xml:"Severity"`
 	SNSTopicARN      aws.StringValue  `query:"SnsTopicArn" xml:"SnsTopicArn"`
-	SourceIDs        []string         `query:"SourceIds.member" xml:"SourceIds>SourceId"`
+	SourceIDs        []string         `query:"SourceIds,list:SourceId" xml:"SourceIds>SourceId"`
 	SourceType       aws.StringValue  `query:"SourceType" xml:"SourceType"`
 	SubscriptionName aws.StringValue  `query:"SubscriptionName" xml:"SubscriptionName"`
-	Tags             []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags             []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateEventSubscriptionMessage to the form, prefixing their names.
+func (v *CreateEventSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	if v.EventCategories != nil {
+		if len(v.EventCategories) == 0 {
+			form.Set(prefix+"EventCategories", "")
+		}
+		for i1 := range v.EventCategories {
+			name1 := prefix + "EventCategories.EventCategory." + strconv.Itoa(i1+1)
+			form.Set(name1, v.EventCategories[i1])
+		}
+	}
+	if v.Severity != nil {
+		form.Set(prefix+"Severity", *v.Severity)
+	}
+	if v.SNSTopicARN != nil {
+		form.Set(prefix+"SnsTopicArn", *v.SNSTopicARN)
+	}
+	if v.SourceIDs != nil {
+		if len(v.SourceIDs) == 0 {
+			form.Set(prefix+"SourceIds", "")
+		}
+		for i1 := range v.SourceIDs {
+			name1 := prefix + "SourceIds.SourceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SourceIDs[i1])
+		}
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateEventSubscriptionResult is undocumented.
@@ -1035,7 +1796,29 @@ type CreateEventSubscriptionResult struct {
 // CreateHSMClientCertificateMessage is undocumented.
 type CreateHSMClientCertificateMessage struct {
 	HSMClientCertificateIdentifier aws.StringValue `query:"HsmClientCertificateIdentifier" xml:"HsmClientCertificateIdentifier"`
-	Tags                           []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                           []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateHSMClientCertificateMessage to the form, prefixing their names.
+func (v *CreateHSMClientCertificateMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HSMClientCertificateIdentifier != nil {
+		form.Set(prefix+"HsmClientCertificateIdentifier", *v.HSMClientCertificateIdentifier)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateHSMClientCertificateResult is undocumented.
@@ -1051,7 +1834,44 @@ type CreateHSMConfigurationMessage struct {
 	HSMPartitionName           aws.StringValue `query:"HsmPartitionName" xml:"HsmPartitionName"`
 	HSMPartitionPassword       aws.StringValue `query:"HsmPartitionPassword" xml:"HsmPartitionPassword"`
 	HSMServerPublicCertificate aws.StringValue `query:"HsmServerPublicCertificate" xml:"HsmServerPublicCertificate"`
-	Tags                       []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                       []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateHSMConfigurationMessage to the form, prefixing their names.
+func (v *CreateHSMConfigurationMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.HSMConfigurationIdentifier != nil {
+		form.Set(prefix+"HsmConfigurationIdentifier", *v.HSMConfigurationIdentifier)
+	}
+	if v.HSMIPAddress != nil {
+		form.Set(prefix+"HsmIpAddress", *v.HSMIPAddress)
+	}
+	if v.HSMPartitionName != nil {
+		form.Set(prefix+"HsmPartitionName", *v.HSMPartitionName)
+	}
+	if v.HSMPartitionPassword != nil {
+		form.Set(prefix+"HsmPartitionPassword", *v.HSMPartitionPassword)
+	}
+	if v.HSMServerPublicCertificate != nil {
+		form.Set(prefix+"HsmServerPublicCertificate", *v.HSMServerPublicCertificate)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateHSMConfigurationResult is undocumented.
@@ -1062,14 +1882,36 @@ type CreateHSMConfigurationResult struct {
 // CreateTagsMessage is undocumented.
 type CreateTagsMessage struct {
 	ResourceName aws.StringValue `query:"ResourceName" xml:"ResourceName"`
-	Tags         []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags         []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
+}
+
+// MarshalQuery adds the parameters of the CreateTagsMessage to the form, prefixing their names.
+func (v *CreateTagsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ResourceName != nil {
+		form.Set(prefix+"ResourceName", *v.ResourceName)
+	}
+	if v.Tags != nil {
+		if len(v.Tags) == 0 {
+			form.Set(prefix+"Tags", "")
+		}
+		for i1 := range v.Tags {
+			name1 := prefix + "Tags.Tag." + strconv.Itoa(i1+1)
+			if err := v.Tags[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DefaultClusterParameters is undocumented.
 type DefaultClusterParameters struct {
 	Marker               aws.StringValue `query:"Marker" xml:"Marker"`
 	ParameterGroupFamily aws.StringValue `query:"ParameterGroupFamily" xml:"ParameterGroupFamily"`
-	Parameters           []Parameter     `query:"Parameters.member" xml:"Parameters>Parameter"`
+	Parameters           []Parameter     `query:"Parameters,list:Parameter" xml:"Parameters>Parameter"`
 }
 
 // DeleteClusterMessage is undocumented.
@@ -1079,11 +1921,39 @@ type DeleteClusterMessage struct {
 	SkipFinalClusterSnapshot       aws.BooleanValue `query:"SkipFinalClusterSnapshot" xml:"SkipFinalClusterSnapshot"`
 }
 
+// MarshalQuery adds the parameters of the DeleteClusterMessage to the form, prefixing their names.
+func (v *DeleteClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.FinalClusterSnapshotIdentifier != nil {
+		form.Set(prefix+"FinalClusterSnapshotIdentifier", *v.FinalClusterSnapshotIdentifier)
+	}
+	if v.SkipFinalClusterSnapshot != nil {
+		form.Set(prefix+"SkipFinalClusterSnapshot", strconv.FormatBool(*v.SkipFinalClusterSnapshot))
+	}
+	return nil
+}
+
 // DeleteClusterParameterGroupMessage is undocumented.
 type DeleteClusterParameterGroupMessage struct {
 	ParameterGroupName aws.StringValue `query:"ParameterGroupName" xml:"ParameterGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteClusterParameterGroupMessage to the form, prefixing their names.
+func (v *DeleteClusterParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ParameterGroupName != nil {
+		form.Set(prefix+"ParameterGroupName", *v.ParameterGroupName)
+	}
+	return nil
+}
+
 // DeleteClusterResult is undocumented.
 type DeleteClusterResult struct {
 	Cluster *Cluster `query:"Cluster" xml:"DeleteClusterResult>Cluster"`
@@ -1094,12 +1964,37 @@ type DeleteClusterSecurityGroupMessage struct {
 	ClusterSecurityGroupName aws.StringValue `query:"ClusterSecurityGroupName" xml:"ClusterSecurityGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteClusterSecurityGroupMessage to the form, prefixing their names.
+func (v *DeleteClusterSecurityGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterSecurityGroupName != nil {
+		form.Set(prefix+"ClusterSecurityGroupName", *v.ClusterSecurityGroupName)
+	}
+	return nil
+}
+
 // DeleteClusterSnapshotMessage is undocumented.
 type DeleteClusterSnapshotMessage struct {
 	SnapshotClusterIdentifier aws.StringValue `query:"SnapshotClusterIdentifier" xml:"SnapshotClusterIdentifier"`
 	SnapshotIdentifier        aws.StringValue `query:"SnapshotIdentifier" xml:"SnapshotIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DeleteClusterSnapshotMessage to the form, prefixing their names.
+func (v *DeleteClusterSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SnapshotClusterIdentifier != nil {
+		form.Set(prefix+"SnapshotClusterIdentifier", *v.SnapshotClusterIdentifier)
+	}
+	if v.SnapshotIdentifier != nil {
+		form.Set(prefix+"SnapshotIdentifier", *v.SnapshotIdentifier)
+	}
+	return nil
+}
+
 // DeleteClusterSnapshotResult is undocumented.
 type DeleteClusterSnapshotResult struct {
 	Snapshot *Snapshot `query:"Snapshot" xml:"DeleteClusterSnapshotResult>Snapshot"`
@@ -1110,25 +2005,89 @@ type DeleteClusterSubnetGroupMessage struct {
 	ClusterSubnetGroupName aws.StringValue `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteClusterSubnetGroupMessage to the form, prefixing their names.
+func (v *DeleteClusterSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterSubnetGroupName != nil {
+		form.Set(prefix+"ClusterSubnetGroupName", *v.ClusterSubnetGroupName)
+	}
+	return nil
+}
+
 // DeleteEventSubscriptionMessage is undocumented.
 type DeleteEventSubscriptionMessage struct {
 	SubscriptionName aws.StringValue `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteEventSubscriptionMessage to the form, prefixing their names.
+func (v *DeleteEventSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // DeleteHSMClientCertificateMessage is undocumented.
 type DeleteHSMClientCertificateMessage struct {
 	HSMClientCertificateIdentifier aws.StringValue `query:"HsmClientCertificateIdentifier" xml:"HsmClientCertificateIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DeleteHSMClientCertificateMessage to the form, prefixing their names.
+func (v *DeleteHSMClientCertificateMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HSMClientCertificateIdentifier != nil {
+		form.Set(prefix+"HsmClientCertificateIdentifier", *v.HSMClientCertificateIdentifier)
+	}
+	return nil
+}
+
 // DeleteHSMConfigurationMessage is undocumented.
 type DeleteHSMConfigurationMessage struct {
 	HSMConfigurationIdentifier aws.StringValue `query:"HsmConfigurationIdentifier" xml:"HsmConfigurationIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DeleteHSMConfigurationMessage to the form, prefixing their names.
+func (v *DeleteHSMConfigurationMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HSMConfigurationIdentifier != nil {
+		form.Set(prefix+"HsmConfigurationIdentifier", *v.HSMConfigurationIdentifier)
+	}
+	return nil
+}
+
 // DeleteTagsMessage is undocumented.
 type DeleteTagsMessage struct {
 	ResourceName aws.StringValue `query:"ResourceName" xml:"ResourceName"`
-	TagKeys      []string        `query:"TagKeys.member" xml:"TagKeys>TagKey"`
+	TagKeys      []string        `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+}
+
+// MarshalQuery adds the parameters of the DeleteTagsMessage to the form, prefixing their names.
+func (v *DeleteTagsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ResourceName != nil {
+		form.Set(prefix+"ResourceName", *v.ResourceName)
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeClusterParameterGroupsMessage is undocumented.
@@ -1136,8 +2095,43 @@ type DescribeClusterParameterGroupsMessage struct {
 	Marker             aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords         aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	ParameterGroupName aws.StringValue  `query:"ParameterGroupName" xml:"ParameterGroupName"`
-	TagKeys            []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues          []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys            []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues          []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeClusterParameterGroupsMessage to the form, prefixing their names.
+func (v *DescribeClusterParameterGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ParameterGroupName != nil {
+		form.Set(prefix+"ParameterGroupName", *v.ParameterGroupName)
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeClusterParametersMessage is undocumented.
@@ -1148,13 +2142,68 @@ type DescribeClusterParametersMessage struct {
 	Source             aws.StringValue  `query:"Source" xml:"Source"`
 }
 
+// MarshalQuery adds the parameters of the DescribeClusterParametersMessage to the form, prefixing their names.
+func (v *DescribeClusterParametersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ParameterGroupName != nil {
+		form.Set(prefix+"ParameterGroupName", *v.ParameterGroupName)
+	}
+	if v.Source != nil {
+		form.Set(prefix+"Source", *v.Source)
+	}
+	return nil
+}
+
 // DescribeClusterSecurityGroupsMessage is undocumented.
 type DescribeClusterSecurityGroupsMessage struct {
 	ClusterSecurityGroupName aws.StringValue  `query:"ClusterSecurityGroupName" xml:"ClusterSecurityGroupName"`
 	Marker                   aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords               aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
-	TagKeys                  []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues                []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys                  []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues                []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeClusterSecurityGroupsMessage to the form, prefixing their names.
+func (v *DescribeClusterSecurityGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterSecurityGroupName != nil {
+		form.Set(prefix+"ClusterSecurityGroupName", *v.ClusterSecurityGroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeClusterSnapshotsMessage is undocumented.
@@ -1167,8 +2216,58 @@ type DescribeClusterSnapshotsMessage struct {
 	SnapshotIdentifier aws.StringValue  `query:"SnapshotIdentifier" xml:"SnapshotIdentifier"`
 	SnapshotType       aws.StringValue  `query:"SnapshotType" xml:"SnapshotType"`
 	StartTime          time.Time        `query:"StartTime" xml:"StartTime"`
-	TagKeys            []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues          []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys            []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues          []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeClusterSnapshotsMessage to the form, prefixing their names.
+func (v *DescribeClusterSnapshotsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.OwnerAccount != nil {
+		form.Set(prefix+"OwnerAccount", *v.OwnerAccount)
+	}
+	if v.SnapshotIdentifier != nil {
+		form.Set(prefix+"SnapshotIdentifier", *v.SnapshotIdentifier)
+	}
+	if v.SnapshotType != nil {
+		form.Set(prefix+"SnapshotType", *v.SnapshotType)
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeClusterSubnetGroupsMessage is undocumented.
@@ -1176,8 +2275,43 @@ type DescribeClusterSubnetGroupsMessage struct {
 	ClusterSubnetGroupName aws.StringValue  `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
 	Marker                 aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords             aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
-	TagKeys                []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues              []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys                []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues              []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeClusterSubnetGroupsMessage to the form, prefixing their names.
+func (v *DescribeClusterSubnetGroupsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterSubnetGroupName != nil {
+		form.Set(prefix+"ClusterSubnetGroupName", *v.ClusterSubnetGroupName)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeClusterVersionsMessage is undocumented.
@@ -1188,13 +2322,68 @@ type DescribeClusterVersionsMessage struct {
 	MaxRecords                  aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 }
 
+// MarshalQuery adds the parameters of the DescribeClusterVersionsMessage to the form, prefixing their names.
+func (v *DescribeClusterVersionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterParameterGroupFamily != nil {
+		form.Set(prefix+"ClusterParameterGroupFamily", *v.ClusterParameterGroupFamily)
+	}
+	if v.ClusterVersion != nil {
+		form.Set(prefix+"ClusterVersion", *v.ClusterVersion)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	return nil
+}
+
 // DescribeClustersMessage is undocumented.
 type DescribeClustersMessage struct {
 	ClusterIdentifier aws.StringValue  `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 	Marker            aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords        aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
-	TagKeys           []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues         []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys           []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues         []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeClustersMessage to the form, prefixing their names.
+func (v *DescribeClustersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeDefaultClusterParametersMessage is undocumented.
@@ -1204,6 +2393,23 @@ type DescribeDefaultClusterParametersMessage struct {
 	ParameterGroupFamily aws.StringValue  `query:"ParameterGroupFamily" xml:"ParameterGroupFamily"`
 }
 
+// MarshalQuery adds the parameters of the DescribeDefaultClusterParametersMessage to the form, prefixing their names.
+func (v *DescribeDefaultClusterParametersMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ParameterGroupFamily != nil {
+		form.Set(prefix+"ParameterGroupFamily", *v.ParameterGroupFamily)
+	}
+	return nil
+}
+
 // DescribeDefaultClusterParametersResult is undocumented.
 type DescribeDefaultClusterParametersResult struct {
 	DefaultClusterParameters *DefaultClusterParameters `query:"DefaultClusterParameters" xml:"DescribeDefaultClusterParametersResult>DefaultClusterParameters"`
@@ -1214,6 +2420,17 @@ type DescribeEventCategoriesMessage struct {
 	SourceType aws.StringValue `query:"SourceType" xml:"SourceType"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventCategoriesMessage to the form, prefixing their names.
+func (v *DescribeEventCategoriesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	return nil
+}
+
 // DescribeEventSubscriptionsMessage is undocumented.
 type DescribeEventSubscriptionsMessage struct {
 	Marker           aws.StringValue  `query:"Marker" xml:"Marker"`
@@ -1221,6 +2438,23 @@ type DescribeEventSubscriptionsMessage struct {
 	SubscriptionName aws.StringValue  `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventSubscriptionsMessage to the form, prefixing their names.
+func (v *DescribeEventSubscriptionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // DescribeEventsMessage is undocumented.
 type DescribeEventsMessage struct {
 	Duration         aws.IntegerValue `query:"Duration" xml:"Duration"`
@@ -1232,13 +2466,77 @@ type DescribeEventsMessage struct {
 	StartTime        time.Time        `query:"StartTime" xml:"StartTime"`
 }
 
+// MarshalQuery adds the parameters of the DescribeEventsMessage to the form, prefixing their names.
+func (v *DescribeEventsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Duration != nil {
+		form.Set(prefix+"Duration", strconv.FormatInt(int64(*v.Duration), 10))
+	}
+	if !v.EndTime.IsZero() {
+		form.Set(prefix+"EndTime", v.EndTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.SourceIdentifier != nil {
+		form.Set(prefix+"SourceIdentifier", *v.SourceIdentifier)
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	if !v.StartTime.IsZero() {
+		form.Set(prefix+"StartTime", v.StartTime.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	return nil
+}
+
 // DescribeHSMClientCertificatesMessage is undocumented.
 type DescribeHSMClientCertificatesMessage struct {
 	HSMClientCertificateIdentifier aws.StringValue  `query:"HsmClientCertificateIdentifier" xml:"HsmClientCertificateIdentifier"`
 	Marker                         aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords                     aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
-	TagKeys                        []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues                      []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys                        []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues                      []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeHSMClientCertificatesMessage to the form, prefixing their names.
+func (v *DescribeHSMClientCertificatesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HSMClientCertificateIdentifier != nil {
+		form.Set(prefix+"HsmClientCertificateIdentifier", *v.HSMClientCertificateIdentifier)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeHSMConfigurationsMessage is undocumented.
@@ -1246,8 +2544,43 @@ type DescribeHSMConfigurationsMessage struct {
 	HSMConfigurationIdentifier aws.StringValue  `query:"HsmConfigurationIdentifier" xml:"HsmConfigurationIdentifier"`
 	Marker                     aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords                 aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
-	TagKeys                    []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues                  []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys                    []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues                  []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeHSMConfigurationsMessage to the form, prefixing their names.
+func (v *DescribeHSMConfigurationsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HSMConfigurationIdentifier != nil {
+		form.Set(prefix+"HsmConfigurationIdentifier", *v.HSMConfigurationIdentifier)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DescribeLoggingStatusMessage is undocumented.
@@ -1255,6 +2588,17 @@ type DescribeLoggingStatusMessage struct {
 	ClusterIdentifier aws.StringValue `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DescribeLoggingStatusMessage to the form, prefixing their names.
+func (v *DescribeLoggingStatusMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	return nil
+}
+
 // DescribeOrderableClusterOptionsMessage is undocumented.
 type DescribeOrderableClusterOptionsMessage struct {
 	ClusterVersion aws.StringValue  `query:"ClusterVersion" xml:"ClusterVersion"`
@@ -1263,6 +2607,26 @@ type DescribeOrderableClusterOptionsMessage struct {
 	NodeType       aws.StringValue  `query:"NodeType" xml:"NodeType"`
 }
 
+// MarshalQuery adds the parameters of the DescribeOrderableClusterOptionsMessage to the form, prefixing their names.
+func (v *DescribeOrderableClusterOptionsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterVersion != nil {
+		form.Set(prefix+"ClusterVersion", *v.ClusterVersion)
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.NodeType != nil {
+		form.Set(prefix+"NodeType", *v.NodeType)
+	}
+	return nil
+}
+
 // DescribeReservedNodeOfferingsMessage is undocumented.
 type DescribeReservedNodeOfferingsMessage struct {
 	Marker                 aws.StringValue  `query:"Marker" xml:"Marker"`
@@ -1270,6 +2634,23 @@ type DescribeReservedNodeOfferingsMessage struct {
 	ReservedNodeOfferingID aws.StringValue  `query:"ReservedNodeOfferingId" xml:"ReservedNodeOfferingId"`
 }
 
+// MarshalQuery adds the parameters of the DescribeReservedNodeOfferingsMessage to the form, prefixing their names.
+func (v *DescribeReservedNodeOfferingsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ReservedNodeOfferingID != nil {
+		form.Set(prefix+"ReservedNodeOfferingId", *v.ReservedNodeOfferingID)
+	}
+	return nil
+}
+
 // DescribeReservedNodesMessage is undocumented.
 type DescribeReservedNodesMessage struct {
 	Marker         aws.StringValue  `query:"Marker" xml:"Marker"`
@@ -1277,19 +2658,85 @@ type DescribeReservedNodesMessage struct {
 	ReservedNodeID aws.StringValue  `query:"ReservedNodeId" xml:"ReservedNodeId"`
 }
 
+// MarshalQuery adds the parameters of the DescribeReservedNodesMessage to the form, prefixing their names.
+func (v *DescribeReservedNodesMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ReservedNodeID != nil {
+		form.Set(prefix+"ReservedNodeId", *v.ReservedNodeID)
+	}
+	return nil
+}
+
 // DescribeResizeMessage is undocumented.
 type DescribeResizeMessage struct {
 	ClusterIdentifier aws.StringValue `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DescribeResizeMessage to the form, prefixing their names.
+func (v *DescribeResizeMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	return nil
+}
+
 // DescribeTagsMessage is undocumented.
 type DescribeTagsMessage struct {
 	Marker       aws.StringValue  `query:"Marker" xml:"Marker"`
 	MaxRecords   aws.IntegerValue `query:"MaxRecords" xml:"MaxRecords"`
 	ResourceName aws.StringValue  `query:"ResourceName" xml:"ResourceName"`
 	ResourceType aws.StringValue  `query:"ResourceType" xml:"ResourceType"`
-	TagKeys      []string         `query:"TagKeys.member" xml:"TagKeys>TagKey"`
-	TagValues    []string         `query:"TagValues.member" xml:"TagValues>TagValue"`
+	TagKeys      []string         `query:"TagKeys,list:TagKey" xml:"TagKeys>TagKey"`
+	TagValues    []string         `query:"TagValues,list:TagValue" xml:"TagValues>TagValue"`
+}
+
+// MarshalQuery adds the parameters of the DescribeTagsMessage to the form, prefixing their names.
+func (v *DescribeTagsMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Marker != nil {
+		form.Set(prefix+"Marker", *v.Marker)
+	}
+	if v.MaxRecords != nil {
+		form.Set(prefix+"MaxRecords", strconv.FormatInt(int64(*v.MaxRecords), 10))
+	}
+	if v.ResourceName != nil {
+		form.Set(prefix+"ResourceName", *v.ResourceName)
+	}
+	if v.ResourceType != nil {
+		form.Set(prefix+"ResourceType", *v.ResourceType)
+	}
+	if v.TagKeys != nil {
+		if len(v.TagKeys) == 0 {
+			form.Set(prefix+"TagKeys", "")
+		}
+		for i1 := range v.TagKeys {
+			name1 := prefix + "TagKeys.TagKey." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagKeys[i1])
+		}
+	}
+	if v.TagValues != nil {
+		if len(v.TagValues) == 0 {
+			form.Set(prefix+"TagValues", "")
+		}
+		for i1 := range v.TagValues {
+			name1 := prefix + "TagValues.TagValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.TagValues[i1])
+		}
+	}
+	return nil
 }
 
 // DisableLoggingMessage is undocumented.
@@ -1297,11 +2744,33 @@ type DisableLoggingMessage struct {
 	ClusterIdentifier aws.StringValue `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DisableLoggingMessage to the form, prefixing their names.
+func (v *DisableLoggingMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	return nil
+}
+
 // DisableSnapshotCopyMessage is undocumented.
 type DisableSnapshotCopyMessage struct {
 	ClusterIdentifier aws.StringValue `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the DisableSnapshotCopyMessage to the form, prefixing their names.
+func (v *DisableSnapshotCopyMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	return nil
+}
+
 // DisableSnapshotCopyResult is undocumented.
 type DisableSnapshotCopyResult struct {
 	Cluster *Cluster `query:"Cluster" xml:"DisableSnapshotCopyResult>Cluster"`
@@ -1312,7 +2781,7 @@ type EC2SecurityGroup struct {
 	EC2SecurityGroupName    aws.StringValue `query:"EC2SecurityGroupName" xml:"EC2SecurityGroupName"`
 	EC2SecurityGroupOwnerID aws.StringValue `query:"EC2SecurityGroupOwnerId" xml:"EC2SecurityGroupOwnerId"`
 	Status                  aws.StringValue `query:"Status" xml:"Status"`
-	Tags                    []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                    []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 }
 
 // ElasticIPStatus is undocumented.
@@ -1328,6 +2797,23 @@ type EnableLoggingMessage struct {
 	S3KeyPrefix       aws.StringValue `query:"S3KeyPrefix" xml:"S3KeyPrefix"`
 }
 
+// MarshalQuery adds the parameters of the EnableLoggingMessage to the form, prefixing their names.
+func (v *EnableLoggingMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BucketName != nil {
+		form.Set(prefix+"BucketName", *v.BucketName)
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.S3KeyPrefix != nil {
+		form.Set(prefix+"S3KeyPrefix", *v.S3KeyPrefix)
+	}
+	return nil
+}
+
 // EnableSnapshotCopyMessage is undocumented.
 type EnableSnapshotCopyMessage struct {
 	ClusterIdentifier aws.StringValue  `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
@@ -1335,6 +2821,23 @@ type EnableSnapshotCopyMessage struct {
 	RetentionPeriod   aws.IntegerValue `query:"RetentionPeriod" xml:"RetentionPeriod"`
 }
 
+// MarshalQuery adds the parameters of the EnableSnapshotCopyMessage to the form, prefixing their names.
+func (v *EnableSnapshotCopyMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.DestinationRegion != nil {
+		form.Set(prefix+"DestinationRegion", *v.DestinationRegion)
+	}
+	if v.RetentionPeriod != nil {
+		form.Set(prefix+"RetentionPeriod", strconv.FormatInt(int64(*v.RetentionPeriod), 10))
+	}
+	return nil
+}
+
 // EnableSnapshotCopyResult is undocumented.
 type EnableSnapshotCopyResult struct {
 	Cluster *Cluster `query:"Cluster" xml:"EnableSnapshotCopyResult>Cluster"`
@@ -1349,7 +2852,7 @@ type Endpoint struct {
 // Event is undocumented.
 type Event struct {
 	Date             time.Time       `query:"Date" xml:"Date"`
-	EventCategories  []string        `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories  []string        `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	EventID          aws.StringValue `query:"EventId" xml:"EventId"`
 	Message          aws.StringValue `query:"Message" xml:"Message"`
 	Severity         aws.StringValue `query:"Severity" xml:"Severity"`
@@ -1359,18 +2862,18 @@ type Event struct {
 
 // EventCategoriesMap is undocumented.
 type EventCategoriesMap struct {
-	Events     []EventInfoMap  `query:"Events.member" xml:"Events>EventInfoMap"`
+	Events     []EventInfoMap  `query:"Events,list:EventInfoMap" xml:"Events>EventInfoMap"`
 	SourceType aws.StringValue `query:"SourceType" xml:"SourceType"`
 }
 
 // EventCategoriesMessage is undocumented.
 type EventCategoriesMessage struct {
-	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList.member" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
+	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList,list:EventCategoriesMap" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
 }
 
 // EventInfoMap is undocumented.
 type EventInfoMap struct {
-	EventCategories  []string        `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories  []string        `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	EventDescription aws.StringValue `query:"EventDescription" xml:"EventDescription"`
 	EventID          aws.StringValue `query:"EventId" xml:"EventId"`
 	Severity         aws.StringValue `query:"Severity" xml:"Severity"`
@@ -1381,25 +2884,25 @@ type EventSubscription struct {
 	CustSubscriptionID       aws.StringValue  `query:"CustSubscriptionId" xml:"CustSubscriptionId"`
 	CustomerAWSID            aws.StringValue  `query:"CustomerAwsId" xml:"CustomerAwsId"`
 	Enabled                  aws.BooleanValue `query:"Enabled" xml:"Enabled"`
-	EventCategoriesList      []string         `query:"EventCategoriesList.member" xml:"EventCategoriesList>EventCategory"`
+	EventCategoriesList      []string         `query:"EventCategoriesList,list:EventCategory" xml:"EventCategoriesList>EventCategory"`
 	Severity                 aws.StringValue  `query:"Severity" xml:"Severity"`
 	SNSTopicARN              aws.StringValue  `query:"SnsTopicArn" xml:"SnsTopicArn"`
-	SourceIDsList            []string         `query:"SourceIdsList.member" xml:"SourceIdsList>SourceId"`
+	SourceIDsList            []string         `query:"SourceIdsList,list:SourceId" xml:"SourceIdsList>SourceId"`
 	SourceType               aws.StringValue  `query:"SourceType" xml:"SourceType"`
 	Status                   aws.StringValue  `query:"Status" xml:"Status"`
 	SubscriptionCreationTime time.Time        `query:"SubscriptionCreationTime" xml:"SubscriptionCreationTime"`
-	Tags                     []Tag            `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                     []Tag            `query:"Tags,list:Tag" xml:"Tags>Tag"`
 }
 
 // EventSubscriptionsMessage is undocumented.
 type EventSubscriptionsMessage struct {
-	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList.member" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
+	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList,list:EventSubscription" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
 	Marker                 aws.StringValue     `query:"Marker" xml:"DescribeEventSubscriptionsResult>Marker"`
 }
 
 // EventsMessage is undocumented.
 type EventsMessage struct {
-	Events []Event         `query:"Events.member" xml:"DescribeEventsResult>Events>Event"`
+	Events []Event         `query:"Events,list:Event" xml:"DescribeEventsResult>Events>Event"`
 	Marker aws.StringValue `query:"Marker" xml:"DescribeEventsResult>Marker"`
 }
 
@@ -1407,12 +2910,12 @@ type EventsMessage struct {
 type HSMClientCertificate struct {
 	HSMClientCertificateIdentifier aws.StringValue `query:"HsmClientCertificateIdentifier" xml:"HsmClientCertificateIdentifier"`
 	HSMClientCertificatePublicKey  aws.StringValue `query:"HsmClientCertificatePublicKey" xml:"HsmClientCertificatePublicKey"`
-	Tags                           []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                           []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 }
 
 // HSMClientCertificateMessage is undocumented.
 type HSMClientCertificateMessage struct {
-	HSMClientCertificates []HSMClientCertificate `query:"HsmClientCertificates.member" xml:"DescribeHsmClientCertificatesResult>HsmClientCertificates>HsmClientCertificate"`
+	HSMClientCertificates []HSMClientCertificate `query:"HsmClientCertificates,list:HsmClientCertificate" xml:"DescribeHsmClientCertificatesResult>HsmClientCertificates>HsmClientCertificate"`
 	Marker                aws.StringValue        `query:"Marker" xml:"DescribeHsmClientCertificatesResult>Marker"`
 }
 
@@ -1422,12 +2925,12 @@ type HSMConfiguration struct {
 	HSMConfigurationIdentifier aws.StringValue `query:"HsmConfigurationIdentifier" xml:"HsmConfigurationIdentifier"`
 	HSMIPAddress               aws.StringValue `query:"HsmIpAddress" xml:"HsmIpAddress"`
 	HSMPartitionName           aws.StringValue `query:"HsmPartitionName" xml:"HsmPartitionName"`
-	Tags                       []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                       []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 }
 
 // HSMConfigurationMessage is undocumented.
 type HSMConfigurationMessage struct {
-	HSMConfigurations []HSMConfiguration `query:"HsmConfigurations.member" xml:"DescribeHsmConfigurationsResult>HsmConfigurations>HsmConfiguration"`
+	HSMConfigurations []HSMConfiguration `query:"HsmConfigurations,list:HsmConfiguration" xml:"DescribeHsmConfigurationsResult>HsmConfigurations>HsmConfiguration"`
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeHsmConfigurationsResult>Marker"`
 }
 
@@ -1442,7 +2945,7 @@ type HSMStatus struct {
 type IPRange struct {
 	CIDRIP aws.StringValue `query:"CIDRIP" xml:"CIDRIP"`
 	Status aws.StringValue `query:"Status" xml:"Status"`
-	Tags   []Tag           `query:"Tags.member" xml:"Tags>Tag"`
+	Tags   []Tag           `query:"Tags,list:Tag" xml:"Tags>Tag"`
 }
 
 // LoggingStatus is undocumented.
@@ -1461,7 +2964,7 @@ type ModifyClusterMessage struct {
 	AutomatedSnapshotRetentionPeriod aws.IntegerValue `query:"AutomatedSnapshotRetentionPeriod" xml:"AutomatedSnapshotRetentionPeriod"`
 	ClusterIdentifier                aws.StringValue  `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 	ClusterParameterGroupName        aws.StringValue  `query:"ClusterParameterGroupName" xml:"ClusterParameterGroupName"`
-	ClusterSecurityGroups            []string         `query:"ClusterSecurityGroups.member" xml:"ClusterSecurityGroups>ClusterSecurityGroupName"`
+	ClusterSecurityGroups            []string         `query:"ClusterSecurityGroups,list:ClusterSecurityGroupName" xml:"ClusterSecurityGroups>ClusterSecurityGroupName"`
 	ClusterType                      aws.StringValue  `query:"ClusterType" xml:"ClusterType"`
 	ClusterVersion                   aws.StringValue  `query:"ClusterVersion" xml:"ClusterVersion"`
 	HSMClientCertificateIdentifier   aws.StringValue  `query:"HsmClientCertificateIdentifier" xml:"HsmClientCertificateIdentifier"`
@@ -1471,13 +2974,100 @@ type ModifyClusterMessage struct {
 	NodeType                         aws.StringValue  `query:"NodeType" xml:"NodeType"`
 	NumberOfNodes                    aws.IntegerValue `query:"NumberOfNodes" xml:"NumberOfNodes"`
 	PreferredMaintenanceWindow       aws.StringValue  `query:"PreferredMaintenanceWindow" xml:"PreferredMaintenanceWindow"`
-	VPCSecurityGroupIDs              []string         `query:"VpcSecurityGroupIds.member" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+	VPCSecurityGroupIDs              []string         `query:"VpcSecurityGroupIds,list:VpcSecurityGroupId" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+}
+
+// MarshalQuery adds the parameters of the ModifyClusterMessage to the form, prefixing their names.
+func (v *ModifyClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowVersionUpgrade != nil {
+		form.Set(prefix+"AllowVersionUpgrade", strconv.FormatBool(*v.AllowVersionUpgrade))
+	}
+	if v.AutomatedSnapshotRetentionPeriod != nil {
+		form.Set(prefix+"AutomatedSnapshotRetentionPeriod", strconv.FormatInt(int64(*v.AutomatedSnapshotRetentionPeriod), 10))
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.ClusterParameterGroupName != nil {
+		form.Set(prefix+"ClusterParameterGroupName", *v.ClusterParameterGroupName)
+	}
+	if v.ClusterSecurityGroups != nil {
+		if len(v.ClusterSecurityGroups) == 0 {
+			form.Set(prefix+"ClusterSecurityGroups", "")
+		}
+		for i1 := range v.ClusterSecurityGroups {
+			name1 := prefix + "ClusterSecurityGroups.ClusterSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ClusterSecurityGroups[i1])
+		}
+	}
+	if v.ClusterType != nil {
+		form.Set(prefix+"ClusterType", *v.ClusterType)
+	}
+	if v.ClusterVersion != nil {
+		form.Set(prefix+"ClusterVersion", *v.ClusterVersion)
+	}
+	if v.HSMClientCertificateIdentifier != nil {
+		form.Set(prefix+"HsmClientCertificateIdentifier", *v.HSMClientCertificateIdentifier)
+	}
+	if v.HSMConfigurationIdentifier != nil {
+		form.Set(prefix+"HsmConfigurationIdentifier", *v.HSMConfigurationIdentifier)
+	}
+	if v.MasterUserPassword != nil {
+		form.Set(prefix+"MasterUserPassword", *v.MasterUserPassword)
+	}
+	if v.NewClusterIdentifier != nil {
+		form.Set(prefix+"NewClusterIdentifier", *v.NewClusterIdentifier)
+	}
+	if v.NodeType != nil {
+		form.Set(prefix+"NodeType", *v.NodeType)
+	}
+	if v.NumberOfNodes != nil {
+		form.Set(prefix+"NumberOfNodes", strconv.FormatInt(int64(*v.NumberOfNodes), 10))
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.VPCSecurityGroupIDs != nil {
+		if len(v.VPCSecurityGroupIDs) == 0 {
+			form.Set(prefix+"VpcSecurityGroupIds", "")
+		}
+		for i1 := range v.VPCSecurityGroupIDs {
+			name1 := prefix + "VpcSecurityGroupIds.VpcSecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCSecurityGroupIDs[i1])
+		}
+	}
+	return nil
 }
 
 // ModifyClusterParameterGroupMessage is undocumented.
 type ModifyClusterParameterGroupMessage struct {
 	ParameterGroupName aws.StringValue `query:"ParameterGroupName" xml:"ParameterGroupName"`
-	Parameters         []Parameter     `query:"Parameters.member" xml:"Parameters>Parameter"`
+	Parameters         []Parameter     `query:"Parameters,list:Parameter" xml:"Parameters>Parameter"`
+}
+
+// MarshalQuery adds the parameters of the ModifyClusterParameterGroupMessage to the form, prefixing their names.
+func (v *ModifyClusterParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ParameterGroupName != nil {
+		form.Set(prefix+"ParameterGroupName", *v.ParameterGroupName)
+	}
+	if v.Parameters != nil {
+		if len(v.Parameters) == 0 {
+			form.Set(prefix+"Parameters", "")
+		}
+		for i1 := range v.Parameters {
+			name1 := prefix + "Parameters.Parameter." + strconv.Itoa(i1+1)
+			if err := v.Parameters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // ModifyClusterResult is undocumented.
@@ -1489,7 +3079,30 @@ type ModifyClusterResult struct {
 type ModifyClusterSubnetGroupMessage struct {
 	ClusterSubnetGroupName aws.StringValue `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
 	Description            aws.StringValue `query:"Description" xml:"Description"`
-	SubnetIDs              []string        `query:"SubnetIds.member" xml:"SubnetIds>SubnetIdentifier"`
+	SubnetIDs              []string        `query:"SubnetIds,list:SubnetIdentifier" xml:"SubnetIds>SubnetIdentifier"`
+}
+
+// MarshalQuery adds the parameters of the ModifyClusterSubnetGroupMessage to the form, prefixing their names.
+func (v *ModifyClusterSubnetGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterSubnetGroupName != nil {
+		form.Set(prefix+"ClusterSubnetGroupName", *v.ClusterSubnetGroupName)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.SubnetIDs != nil {
+		if len(v.SubnetIDs) == 0 {
+			form.Set(prefix+"SubnetIds", "")
+		}
+		for i1 := range v.SubnetIDs {
+			name1 := prefix + "SubnetIds.SubnetIdentifier." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SubnetIDs[i1])
+		}
+	}
+	return nil
 }
 
 // ModifyClusterSubnetGroupResult is undocumented.
@@ -1500,14 +3113,55 @@ type ModifyClusterSubnetGroupResult struct {
 // ModifyEventSubscriptionMessage is undocumented.
 type ModifyEventSubscriptionMessage struct {
 	Enabled          aws.BooleanValue `query:"Enabled" xml:"Enabled"`
-	EventCategories  []string         `query:"EventCategories.member" xml:"EventCategories>EventCategory"`
+	EventCategories  []string         `query:"EventCategories,list:EventCategory" xml:"EventCategories>EventCategory"`
 	Severity         aws.StringValue  `query:"Severity" xml:"Severity"`
 	SNSTopicARN      aws.StringValue  `query:"SnsTopicArn" xml:"SnsTopicArn"`
-	SourceIDs        []string         `query:"SourceIds.member" xml:"SourceIds>SourceId"`
+	SourceIDs        []string         `query:"SourceIds,list:SourceId" xml:"SourceIds>SourceId"`
 	SourceType       aws.StringValue  `query:"SourceType" xml:"SourceType"`
 	SubscriptionName aws.StringValue  `query:"SubscriptionName" xml:"SubscriptionName"`
 }
 
+// MarshalQuery adds the parameters of the ModifyEventSubscriptionMessage to the form, prefixing their names.
+func (v *ModifyEventSubscriptionMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		form.Set(prefix+"Enabled", strconv.FormatBool(*v.Enabled))
+	}
+	if v.EventCategories != nil {
+		if len(v.EventCategories) == 0 {
+			form.Set(prefix+"EventCategories", "")
+		}
+		for i1 := range v.EventCategories {
+			name1 := prefix + "EventCategories.EventCategory." + strconv.Itoa(i1+1)
+			form.Set(name1, v.EventCategories[i1])
+		}
+	}
+	if v.Severity != nil {
+		form.Set(prefix+"Severity", *v.Severity)
+	}
+	if v.SNSTopicARN != nil {
+		form.Set(prefix+"SnsTopicArn", *v.SNSTopicARN)
+	}
+	if v.SourceIDs != nil {
+		if len(v.SourceIDs) == 0 {
+			form.Set(prefix+"SourceIds", "")
+		}
+		for i1 := range v.SourceIDs {
+			name1 := prefix + "SourceIds.SourceId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.SourceIDs[i1])
+		}
+	}
+	if v.SourceType != nil {
+		form.Set(prefix+"SourceType", *v.SourceType)
+	}
+	if v.SubscriptionName != nil {
+		form.Set(prefix+"SubscriptionName", *v.SubscriptionName)
+	}
+	return nil
+}
+
 // ModifyEventSubscriptionResult is undocumented.
 type ModifyEventSubscriptionResult struct {
 	EventSubscription *EventSubscription `query:"EventSubscription" xml:"ModifyEventSubscriptionResult>EventSubscription"`
@@ -1519,6 +3173,20 @@ type ModifySnapshotCopyRetentionPeriodMessage struct {
 	RetentionPeriod   aws.IntegerValue `query:"RetentionPeriod" xml:"RetentionPeriod"`
 }
 
+// MarshalQuery adds the parameters of the ModifySnapshotCopyRetentionPeriodMessage to the form, prefixing their names.
+func (v *ModifySnapshotCopyRetentionPeriodMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.RetentionPeriod != nil {
+		form.Set(prefix+"RetentionPeriod", strconv.FormatInt(int64(*v.RetentionPeriod), 10))
+	}
+	return nil
+}
+
 // ModifySnapshotCopyRetentionPeriodResult is undocumented.
 type ModifySnapshotCopyRetentionPeriodResult struct {
 	Cluster *Cluster `query:"Cluster" xml:"ModifySnapshotCopyRetentionPeriodResult>Cluster"`
@@ -1526,7 +3194,7 @@ type ModifySnapshotCopyRetentionPeriodResult struct {
 
 // OrderableClusterOption is undocumented.
 type OrderableClusterOption struct {
-	AvailabilityZones []AvailabilityZone `query:"AvailabilityZones.member" xml:"AvailabilityZones>AvailabilityZone"`
+	AvailabilityZones []AvailabilityZone `query:"AvailabilityZones,list:AvailabilityZone" xml:"AvailabilityZones>AvailabilityZone"`
 	ClusterType       aws.StringValue    `query:"ClusterType" xml:"ClusterType"`
 	ClusterVersion    aws.StringValue    `query:"ClusterVersion" xml:"ClusterVersion"`
 	NodeType          aws.StringValue    `query:"NodeType" xml:"NodeType"`
@@ -1535,7 +3203,7 @@ type OrderableClusterOption struct {
 // OrderableClusterOptionsMessage is undocumented.
 type OrderableClusterOptionsMessage struct {
 	Marker                  aws.StringValue          `query:"Marker" xml:"DescribeOrderableClusterOptionsResult>Marker"`
-	OrderableClusterOptions []OrderableClusterOption `query:"OrderableClusterOptions.member" xml:"DescribeOrderableClusterOptionsResult>OrderableClusterOptions>OrderableClusterOption"`
+	OrderableClusterOptions []OrderableClusterOption `query:"OrderableClusterOptions,list:OrderableClusterOption" xml:"DescribeOrderableClusterOptionsResult>OrderableClusterOptions>OrderableClusterOption"`
 }
 
 // Parameter is undocumented.
@@ -1550,6 +3218,38 @@ type Parameter struct {
 	Source               aws.StringValue  `query:"Source" xml:"Source"`
 }
 
+// MarshalQuery adds the parameters of the Parameter to the form, prefixing their names.
+func (v *Parameter) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowedValues != nil {
+		form.Set(prefix+"AllowedValues", *v.AllowedValues)
+	}
+	if v.DataType != nil {
+		form.Set(prefix+"DataType", *v.DataType)
+	}
+	if v.Description != nil {
+		form.Set(prefix+"Description", *v.Description)
+	}
+	if v.IsModifiable != nil {
+		form.Set(prefix+"IsModifiable", strconv.FormatBool(*v.IsModifiable))
+	}
+	if v.MinimumEngineVersion != nil {
+		form.Set(prefix+"MinimumEngineVersion", *v.MinimumEngineVersion)
+	}
+	if v.ParameterName != nil {
+		form.Set(prefix+"ParameterName", *v.ParameterName)
+	}
+	if v.ParameterValue != nil {
+		form.Set(prefix+"ParameterValue", *v.ParameterValue)
+	}
+	if v.Source != nil {
+		form.Set(prefix+"Source", *v.Source)
+	}
+	return nil
+}
+
 // PendingModifiedValues is undocumented.
 type PendingModifiedValues struct {
 	AutomatedSnapshotRetentionPeriod aws.IntegerValue `query:"AutomatedSnapshotRetentionPeriod" xml:"AutomatedSnapshotRetentionPeriod"`
@@ -1567,6 +3267,20 @@ type PurchaseReservedNodeOfferingMessage struct {
 	ReservedNodeOfferingID aws.StringValue  `query:"ReservedNodeOfferingId" xml:"ReservedNodeOfferingId"`
 }
 
+// MarshalQuery adds the parameters of the PurchaseReservedNodeOfferingMessage to the form, prefixing their names.
+func (v *PurchaseReservedNodeOfferingMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NodeCount != nil {
+		form.Set(prefix+"NodeCount", strconv.FormatInt(int64(*v.NodeCount), 10))
+	}
+	if v.ReservedNodeOfferingID != nil {
+		form.Set(prefix+"ReservedNodeOfferingId", *v.ReservedNodeOfferingID)
+	}
+	return nil
+}
+
 // PurchaseReservedNodeOfferingResult is undocumented.
 type PurchaseReservedNodeOfferingResult struct {
 	ReservedNode *ReservedNode `query:"ReservedNode" xml:"PurchaseReservedNodeOfferingResult>ReservedNode"`
@@ -1577,6 +3291,17 @@ type RebootClusterMessage struct {
 	ClusterIdentifier aws.StringValue `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the RebootClusterMessage to the form, prefixing their names.
+func (v *RebootClusterMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	return nil
+}
+
 // RebootClusterResult is undocumented.
 type RebootClusterResult struct {
 	Cluster *Cluster `query:"Cluster" xml:"RebootClusterResult>Cluster"`
@@ -1596,7 +3321,7 @@ type ReservedNode struct {
 	NodeCount              aws.IntegerValue  `query:"NodeCount" xml:"NodeCount"`
 	NodeType               aws.StringValue   `query:"NodeType" xml:"NodeType"`
 	OfferingType           aws.StringValue   `query:"OfferingType" xml:"OfferingType"`
-	RecurringCharges       []RecurringCharge `query:"RecurringCharges.member" xml:"RecurringCharges>RecurringCharge"`
+	RecurringCharges       []RecurringCharge `query:"RecurringCharges,list:RecurringCharge" xml:"RecurringCharges>RecurringCharge"`
 	ReservedNodeID         aws.StringValue   `query:"ReservedNodeId" xml:"ReservedNodeId"`
 	ReservedNodeOfferingID aws.StringValue   `query:"ReservedNodeOfferingId" xml:"ReservedNodeOfferingId"`
 	StartTime              time.Time         `query:"StartTime" xml:"StartTime"`
@@ -1611,7 +3336,7 @@ type ReservedNodeOffering struct {
 	FixedPrice             aws.DoubleValue   `query:"FixedPrice" xml:"FixedPrice"`
 	NodeType               aws.StringValue   `query:"NodeType" xml:"NodeType"`
 	OfferingType           aws.StringValue   `query:"OfferingType" xml:"OfferingType"`
-	RecurringCharges       []RecurringCharge `query:"RecurringCharges.member" xml:"RecurringCharges>RecurringCharge"`
+	RecurringCharges       []RecurringCharge `query:"RecurringCharges,list:RecurringCharge" xml:"RecurringCharges>RecurringCharge"`
 	ReservedNodeOfferingID aws.StringValue   `query:"ReservedNodeOfferingId" xml:"ReservedNodeOfferingId"`
 	UsagePrice             aws.DoubleValue   `query:"UsagePrice" xml:"UsagePrice"`
 }
@@ -1619,30 +3344,55 @@ type ReservedNodeOffering struct {
 // ReservedNodeOfferingsMessage is undocumented.
 type ReservedNodeOfferingsMessage struct {
 	Marker                aws.StringValue        `query:"Marker" xml:"DescribeReservedNodeOfferingsResult>Marker"`
-	ReservedNodeOfferings []ReservedNodeOffering `query:"ReservedNodeOfferings.member" xml:"DescribeReservedNodeOfferingsResult>ReservedNodeOfferings>ReservedNodeOffering"`
+	ReservedNodeOfferings []ReservedNodeOffering `query:"ReservedNodeOfferings,list:ReservedNodeOffering" xml:"DescribeReservedNodeOfferingsResult>ReservedNodeOfferings>ReservedNodeOffering"`
 }
 
 // ReservedNodesMessage is undocumented.
 type ReservedNodesMessage struct {
 	Marker        aws.StringValue `query:"Marker" xml:"DescribeReservedNodesResult>Marker"`
-	ReservedNodes []ReservedNode  `query:"ReservedNodes.member" xml:"DescribeReservedNodesResult>ReservedNodes>ReservedNode"`
+	ReservedNodes []ReservedNode  `query:"ReservedNodes,list:ReservedNode" xml:"DescribeReservedNodesResult>ReservedNodes>ReservedNode"`
 }
 
 // ResetClusterParameterGroupMessage is undocumented.
 type ResetClusterParameterGroupMessage struct {
 	ParameterGroupName aws.StringValue  `query:"ParameterGroupName" xml:"ParameterGroupName"`
-	Parameters         []Parameter      `query:"Parameters.member" xml:"Parameters>Parameter"`
+	Parameters         []Parameter      `query:"Parameters,list:Parameter" xml:"Parameters>Parameter"`
 	ResetAllParameters aws.BooleanValue `query:"ResetAllParameters" xml:"ResetAllParameters"`
 }
 
+// MarshalQuery adds the parameters of the ResetClusterParameterGroupMessage to the form, prefixing their names.
+func (v *ResetClusterParameterGroupMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ParameterGroupName != nil {
+		form.Set(prefix+"ParameterGroupName", *v.ParameterGroupName)
+	}
+	if v.Parameters != nil {
+		if len(v.Parameters) == 0 {
+			form.Set(prefix+"Parameters", "")
+		}
+		for i1 := range v.Parameters {
+			name1 := prefix + "Parameters.Parameter." + strconv.Itoa(i1+1)
+			if err := v.Parameters[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.ResetAllParameters != nil {
+		form.Set(prefix+"ResetAllParameters", strconv.FormatBool(*v.ResetAllParameters))
+	}
+	return nil
+}
+
 // ResizeProgressMessage is undocumented.
 type ResizeProgressMessage struct {
 	AvgResizeRateInMegaBytesPerSecond  aws.DoubleValue  `query:"AvgResizeRateInMegaBytesPerSecond" xml:"DescribeResizeResult>AvgResizeRateInMegaBytesPerSecond"`
 	ElapsedTimeInSeconds               aws.LongValue    `query:"ElapsedTimeInSeconds" xml:"DescribeResizeResult>ElapsedTimeInSeconds"`
 	EstimatedTimeToCompletionInSeconds aws.LongValue    `query:"EstimatedTimeToCompletionInSeconds" xml:"DescribeResizeResult>EstimatedTimeToCompletionInSeconds"`
-	ImportTablesCompleted              []string         `query:"ImportTablesCompleted.member" xml:"DescribeResizeResult>ImportTablesCompleted>member"`
-	ImportTablesInProgress             []string         `query:"ImportTablesInProgress.member" xml:"DescribeResizeResult>ImportTablesInProgress>member"`
-	ImportTablesNotStarted             []string         `query:"ImportTablesNotStarted.member" xml:"DescribeResizeResult>ImportTablesNotStarted>member"`
+	ImportTablesCompleted              []string         `query:"ImportTablesCompleted,list:member" xml:"DescribeResizeResult>ImportTablesCompleted>member"`
+	ImportTablesInProgress             []string         `query:"ImportTablesInProgress,list:member" xml:"DescribeResizeResult>ImportTablesInProgress>member"`
+	ImportTablesNotStarted             []string         `query:"ImportTablesNotStarted,list:member" xml:"DescribeResizeResult>ImportTablesNotStarted>member"`
 	ProgressInMegaBytes                aws.LongValue    `query:"ProgressInMegaBytes" xml:"DescribeResizeResult>ProgressInMegaBytes"`
 	Status                             aws.StringValue  `query:"Status" xml:"DescribeResizeResult>Status"`
 	TargetClusterType                  aws.StringValue  `query:"TargetClusterType" xml:"DescribeResizeResult>TargetClusterType"`
@@ -1658,7 +3408,7 @@ type RestoreFromClusterSnapshotMessage struct {
 	AvailabilityZone                 aws.StringValue  `query:"AvailabilityZone" xml:"AvailabilityZone"`
 	ClusterIdentifier                aws.StringValue  `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 	ClusterParameterGroupName        aws.StringValue  `query:"ClusterParameterGroupName" xml:"ClusterParameterGroupName"`
-	ClusterSecurityGroups            []string         `query:"ClusterSecurityGroups.member" xml:"ClusterSecurityGroups>ClusterSecurityGroupName"`
+	ClusterSecurityGroups            []string         `query:"ClusterSecurityGroups,list:ClusterSecurityGroupName" xml:"ClusterSecurityGroups>ClusterSecurityGroupName"`
 	ClusterSubnetGroupName           aws.StringValue  `query:"ClusterSubnetGroupName" xml:"ClusterSubnetGroupName"`
 	ElasticIP                        aws.StringValue  `query:"ElasticIp" xml:"ElasticIp"`
 	HSMClientCertificateIdentifier   aws.StringValue  `query:"HsmClientCertificateIdentifier" xml:"HsmClientCertificateIdentifier"`
@@ -1670,7 +3420,81 @@ type RestoreFromClusterSnapshotMessage struct {
 	PubliclyAccessible               aws.BooleanValue `query:"PubliclyAccessible" xml:"PubliclyAccessible"`
 	SnapshotClusterIdentifier        aws.StringValue  `query:"SnapshotClusterIdentifier" xml:"SnapshotClusterIdentifier"`
 	SnapshotIdentifier               aws.StringValue  `query:"SnapshotIdentifier" xml:"SnapshotIdentifier"`
-	VPCSecurityGroupIDs              []string         `query:"VpcSecurityGroupIds.member" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+	VPCSecurityGroupIDs              []string         `query:"VpcSecurityGroupIds,list:VpcSecurityGroupId" xml:"VpcSecurityGroupIds>VpcSecurityGroupId"`
+}
+
+// MarshalQuery adds the parameters of the RestoreFromClusterSnapshotMessage to the form, prefixing their names.
+func (v *RestoreFromClusterSnapshotMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AllowVersionUpgrade != nil {
+		form.Set(prefix+"AllowVersionUpgrade", strconv.FormatBool(*v.AllowVersionUpgrade))
+	}
+	if v.AutomatedSnapshotRetentionPeriod != nil {
+		form.Set(prefix+"AutomatedSnapshotRetentionPeriod", strconv.FormatInt(int64(*v.AutomatedSnapshotRetentionPeriod), 10))
+	}
+	if v.AvailabilityZone != nil {
+		form.Set(prefix+"AvailabilityZone", *v.AvailabilityZone)
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	if v.ClusterParameterGroupName != nil {
+		form.Set(prefix+"ClusterParameterGroupName", *v.ClusterParameterGroupName)
+	}
+	if v.ClusterSecurityGroups != nil {
+		if len(v.ClusterSecurityGroups) == 0 {
+			form.Set(prefix+"ClusterSecurityGroups", "")
+		}
+		for i1 := range v.ClusterSecurityGroups {
+			name1 := prefix + "ClusterSecurityGroups.ClusterSecurityGroupName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ClusterSecurityGroups[i1])
+		}
+	}
+	if v.ClusterSubnetGroupName != nil {
+		form.Set(prefix+"ClusterSubnetGroupName", *v.ClusterSubnetGroupName)
+	}
+	if v.ElasticIP != nil {
+		form.Set(prefix+"ElasticIp", *v.ElasticIP)
+	}
+	if v.HSMClientCertificateIdentifier != nil {
+		form.Set(prefix+"HsmClientCertificateIdentifier", *v.HSMClientCertificateIdentifier)
+	}
+	if v.HSMConfigurationIdentifier != nil {
+		form.Set(prefix+"HsmConfigurationIdentifier", *v.HSMConfigurationIdentifier)
+	}
+	if v.KMSKeyID != nil {
+		form.Set(prefix+"KmsKeyId", *v.KMSKeyID)
+	}
+	if v.OwnerAccount != nil {
+		form.Set(prefix+"OwnerAccount", *v.OwnerAccount)
+	}
+	if v.Port != nil {
+		form.Set(prefix+"Port", strconv.FormatInt(int64(*v.Port), 10))
+	}
+	if v.PreferredMaintenanceWindow != nil {
+		form.Set(prefix+"PreferredMaintenanceWindow", *v.PreferredMaintenanceWindow)
+	}
+	if v.PubliclyAccessible != nil {
+		form.Set(prefix+"PubliclyAccessible", strconv.FormatBool(*v.PubliclyAccessible))
+	}
+	if v.SnapshotClusterIdentifier != nil {
+		form.Set(prefix+"SnapshotClusterIdentifier", *v.SnapshotClusterIdentifier)
+	}
+	if v.SnapshotIdentifier != nil {
+		form.Set(prefix+"SnapshotIdentifier", *v.SnapshotIdentifier)
+	}
+	if v.VPCSecurityGroupIDs != nil {
+		if len(v.VPCSecurityGroupIDs) == 0 {
+			form.Set(prefix+"VpcSecurityGroupIds", "")
+		}
+		for i1 := range v.VPCSecurityGroupIDs {
+			name1 := prefix + "VpcSecurityGroupIds.VpcSecurityGroupId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.VPCSecurityGroupIDs[i1])
+		}
+	}
+	return nil
 }
 
 // RestoreFromClusterSnapshotResult is undocumented.
@@ -1696,6 +3520,26 @@ type RevokeClusterSecurityGroupIngressMessage struct {
 	EC2SecurityGroupOwnerID  aws.StringValue `query:"EC2SecurityGroupOwnerId" xml:"EC2SecurityGroupOwnerId"`
 }
 
+// MarshalQuery adds the parameters of the RevokeClusterSecurityGroupIngressMessage to the form, prefixing their names.
+func (v *RevokeClusterSecurityGroupIngressMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.CIDRIP != nil {
+		form.Set(prefix+"CIDRIP", *v.CIDRIP)
+	}
+	if v.ClusterSecurityGroupName != nil {
+		form.Set(prefix+"ClusterSecurityGroupName", *v.ClusterSecurityGroupName)
+	}
+	if v.EC2SecurityGroupName != nil {
+		form.Set(prefix+"EC2SecurityGroupName", *v.EC2SecurityGroupName)
+	}
+	if v.EC2SecurityGroupOwnerID != nil {
+		form.Set(prefix+"EC2SecurityGroupOwnerId", *v.EC2SecurityGroupOwnerID)
+	}
+	return nil
+}
+
 // RevokeClusterSecurityGroupIngressResult is undocumented.
 type RevokeClusterSecurityGroupIngressResult struct {
 	ClusterSecurityGroup *ClusterSecurityGroup `query:"ClusterSecurityGroup" xml:"RevokeClusterSecurityGroupIngressResult>ClusterSecurityGroup"`
@@ -1708,6 +3552,23 @@ type RevokeSnapshotAccessMessage struct {
 	SnapshotIdentifier        aws.StringValue `query:"SnapshotIdentifier" xml:"SnapshotIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the RevokeSnapshotAccessMessage to the form, prefixing their names.
+func (v *RevokeSnapshotAccessMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AccountWithRestoreAccess != nil {
+		form.Set(prefix+"AccountWithRestoreAccess", *v.AccountWithRestoreAccess)
+	}
+	if v.SnapshotClusterIdentifier != nil {
+		form.Set(prefix+"SnapshotClusterIdentifier", *v.SnapshotClusterIdentifier)
+	}
+	if v.SnapshotIdentifier != nil {
+		form.Set(prefix+"SnapshotIdentifier", *v.SnapshotIdentifier)
+	}
+	return nil
+}
+
 // RevokeSnapshotAccessResult is undocumented.
 type RevokeSnapshotAccessResult struct {
 	Snapshot *Snapshot `query:"Snapshot" xml:"RevokeSnapshotAccessResult>Snapshot"`
@@ -1718,6 +3579,17 @@ type RotateEncryptionKeyMessage struct {
 	ClusterIdentifier aws.StringValue `query:"ClusterIdentifier" xml:"ClusterIdentifier"`
 }
 
+// MarshalQuery adds the parameters of the RotateEncryptionKeyMessage to the form, prefixing their names.
+func (v *RotateEncryptionKeyMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterIdentifier != nil {
+		form.Set(prefix+"ClusterIdentifier", *v.ClusterIdentifier)
+	}
+	return nil
+}
+
 // RotateEncryptionKeyResult is undocumented.
 type RotateEncryptionKeyResult struct {
 	Cluster *Cluster `query:"Cluster" xml:"RotateEncryptionKeyResult>Cluster"`
@@ -1725,7 +3597,7 @@ type RotateEncryptionKeyResult struct {
 
 // Snapshot is undocumented.
 type Snapshot struct {
-	AccountsWithRestoreAccess              []AccountWithRestoreAccess `query:"AccountsWithRestoreAccess.member" xml:"AccountsWithRestoreAccess>AccountWithRestoreAccess"`
+	AccountsWithRestoreAccess              []AccountWithRestoreAccess `query:"AccountsWithRestoreAccess,list:AccountWithRestoreAccess" xml:"AccountsWithRestoreAccess>AccountWithRestoreAccess"`
 	ActualIncrementalBackupSizeInMegaBytes aws.DoubleValue            `query:"ActualIncrementalBackupSizeInMegaBytes" xml:"ActualIncrementalBackupSizeInMegaBytes"`
 	AvailabilityZone                       aws.StringValue            `query:"AvailabilityZone" xml:"AvailabilityZone"`
 	BackupProgressInMegaBytes              aws.DoubleValue            `query:"BackupProgressInMegaBytes" xml:"BackupProgressInMegaBytes"`
@@ -1749,7 +3621,7 @@ type Snapshot struct {
 	SnapshotType                           aws.StringValue            `query:"SnapshotType" xml:"SnapshotType"`
 	SourceRegion                           aws.StringValue            `query:"SourceRegion" xml:"SourceRegion"`
 	Status                                 aws.StringValue            `query:"Status" xml:"Status"`
-	Tags                                   []Tag                      `query:"Tags.member" xml:"Tags>Tag"`
+	Tags                                   []Tag                      `query:"Tags,list:Tag" xml:"Tags>Tag"`
 	TotalBackupSizeInMegaBytes             aws.DoubleValue            `query:"TotalBackupSizeInMegaBytes" xml:"TotalBackupSizeInMegaBytes"`
 	VPCID                                  aws.StringValue            `query:"VpcId" xml:"VpcId"`
 }
@@ -1757,7 +3629,7 @@ type Snapshot struct {
 // SnapshotMessage is undocumented.
 type SnapshotMessage struct {
 	Marker    aws.StringValue `query:"Marker" xml:"DescribeClusterSnapshotsResult>Marker"`
-	Snapshots []Snapshot      `query:"Snapshots.member" xml:"DescribeClusterSnapshotsResult>Snapshots>Snapshot"`
+	Snapshots []Snapshot      `query:"Snapshots,list:Snapshot" xml:"DescribeClusterSnapshotsResult>Snapshots>Snapshot"`
 }
 
 // Possible values for RedShift.
@@ -1781,6 +3653,20 @@ type Tag struct {
 	Value aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Tag to the form, prefixing their names.
+func (v *Tag) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		form.Set(prefix+"Key", *v.Key)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // TaggedResource is undocumented.
 type TaggedResource struct {
 	ResourceName aws.StringValue `query:"ResourceName" xml:"ResourceName"`
@@ -1791,7 +3677,7 @@ type TaggedResource struct {
 // TaggedResourceListMessage is undocumented.
 type TaggedResourceListMessage struct {
 	Marker          aws.StringValue  `query:"Marker" xml:"DescribeTagsResult>Marker"`
-	TaggedResources []TaggedResource `query:"TaggedResources.member" xml:"DescribeTagsResult>TaggedResources>TaggedResource"`
+	TaggedResources []TaggedResource `query:"TaggedResources,list:TaggedResource" xml:"DescribeTagsResult>TaggedResources>TaggedResource"`
 }
 
 // VPCSecurityGroupMembership is undocumented.
@@ -1803,71 +3689,71 @@ type VPCSecurityGroupMembership struct {
 // DescribeClusterParameterGroupsResult is a wrapper for ClusterParameterGroupsMessage.
 type DescribeClusterParameterGroupsResult struct {
 	Marker          aws.StringValue         `query:"Marker" xml:"DescribeClusterParameterGroupsResult>Marker"`
-	ParameterGroups []ClusterParameterGroup `query:"ParameterGroups.member" xml:"DescribeClusterParameterGroupsResult>ParameterGroups>ClusterParameterGroup"`
+	ParameterGroups []ClusterParameterGroup `query:"ParameterGroups,list:ClusterParameterGroup" xml:"DescribeClusterParameterGroupsResult>ParameterGroups>ClusterParameterGroup"`
 }
 
 // DescribeClusterParametersResult is a wrapper for ClusterParameterGroupDetails.
 type DescribeClusterParametersResult struct {
 	Marker     aws.StringValue `query:"Marker" xml:"DescribeClusterParametersResult>Marker"`
-	Parameters []Parameter     `query:"Parameters.member" xml:"DescribeClusterParametersResult>Parameters>Parameter"`
+	Parameters []Parameter     `query:"Parameters,list:Parameter" xml:"DescribeClusterParametersResult>Parameters>Parameter"`
 }
 
 // DescribeClusterSecurityGroupsResult is a wrapper for ClusterSecurityGroupMessage.
 type DescribeClusterSecurityGroupsResult struct {
-	ClusterSecurityGroups []ClusterSecurityGroup `query:"ClusterSecurityGroups.member" xml:"DescribeClusterSecurityGroupsResult>ClusterSecurityGroups>ClusterSecurityGroup"`
+	ClusterSecurityGroups []ClusterSecurityGroup `query:"ClusterSecurityGroups,list:ClusterSecurityGroup" xml:"DescribeClusterSecurityGroupsResult>ClusterSecurityGroups>ClusterSecurityGroup"`
 	Marker                aws.StringValue        `query:"Marker" xml:"DescribeClusterSecurityGroupsResult>Marker"`
 }
 
 // DescribeClusterSnapshotsResult is a wrapper for SnapshotMessage.
 type DescribeClusterSnapshotsResult struct {
 	Marker    aws.StringValue `query:"Marker" xml:"DescribeClusterSnapshotsResult>Marker"`
-	Snapshots []Snapshot      `query:"Snapshots.member" xml:"DescribeClusterSnapshotsResult>Snapshots>Snapshot"`
+	Snapshots []Snapshot      `query:"Snapshots,list:Snapshot" xml:"DescribeClusterSnapshotsResult>Snapshots>Snapshot"`
 }
 
 // DescribeClusterSubnetGroupsResult is a wrapper for ClusterSubnetGroupMessage.
 type DescribeClusterSubnetGroupsResult struct {
-	ClusterSubnetGroups []ClusterSubnetGroup `query:"ClusterSubnetGroups.member" xml:"DescribeClusterSubnetGroupsResult>ClusterSubnetGroups>ClusterSubnetGroup"`
+	ClusterSubnetGroups []ClusterSubnetGroup `query:"ClusterSubnetGroups,list:ClusterSubnetGroup" xml:"DescribeClusterSubnetGroupsResult>ClusterSubnetGroups>ClusterSubnetGroup"`
 	Marker              aws.StringValue      `query:"Marker" xml:"DescribeClusterSubnetGroupsResult>Marker"`
 }
 
 // DescribeClusterVersionsResult is a wrapper for ClusterVersionsMessage.
 type DescribeClusterVersionsResult struct {
-	ClusterVersions []ClusterVersion `query:"ClusterVersions.member" xml:"DescribeClusterVersionsResult>ClusterVersions>ClusterVersion"`
+	ClusterVersions []ClusterVersion `query:"ClusterVersions,list:ClusterVersion" xml:"DescribeClusterVersionsResult>ClusterVersions>ClusterVersion"`
 	Marker          aws.StringValue  `query:"Marker" xml:"DescribeClusterVersionsResult>Marker"`
 }
 
 // DescribeClustersResult is a wrapper for ClustersMessage.
 type DescribeClustersResult struct {
-	Clusters []Cluster       `query:"Clusters.member" xml:"DescribeClustersResult>Clusters>Cluster"`
+	Clusters []Cluster       `query:"Clusters,list:Cluster" xml:"DescribeClustersResult>Clusters>Cluster"`
 	Marker   aws.StringValue `query:"Marker" xml:"DescribeClustersResult>Marker"`
 }
 
 // DescribeEventCategoriesResult is a wrapper for EventCategoriesMessage.
 type DescribeEventCategoriesResult struct {
-	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList.member" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
+	EventCategoriesMapList []EventCategoriesMap `query:"EventCategoriesMapList,list:EventCategoriesMap" xml:"DescribeEventCategoriesResult>EventCategoriesMapList>EventCategoriesMap"`
 }
 
 // DescribeEventSubscriptionsResult is a wrapper for EventSubscriptionsMessage.
 type DescribeEventSubscriptionsResult struct {
-	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList.member" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
+	EventSubscriptionsList []EventSubscription `query:"EventSubscriptionsList,list:EventSubscription" xml:"DescribeEventSubscriptionsResult>EventSubscriptionsList>EventSubscription"`
 	Marker                 aws.StringValue     `query:"Marker" xml:"DescribeEventSubscriptionsResult>Marker"`
 }
 
 // DescribeEventsResult is a wrapper for EventsMessage.
 type DescribeEventsResult struct {
-	Events []Event         `query:"Events.member" xml:"DescribeEventsResult>Events>Event"`
+	Events []Event         `query:"Events,list:Event" xml:"DescribeEventsResult>Events>Event"`
 	Marker aws.StringValue `query:"Marker" xml:"DescribeEventsResult>Marker"`
 }
 
 // DescribeHSMClientCertificatesResult is a wrapper for HsmClientCertificateMessage.
 type DescribeHSMClientCertificatesResult struct {
-	HSMClientCertificates []HSMClientCertificate `query:"HsmClientCertificates.member" xml:"DescribeHsmClientCertificatesResult>HsmClientCertificates>HsmClientCertificate"`
+	HSMClientCertificates []HSMClientCertificate `query:"HsmClientCertificates,list:HsmClientCertificate" xml:"DescribeHsmClientCertificatesResult>HsmClientCertificates>HsmClientCertificate"`
 	Marker                aws.StringValue        `query:"Marker" xml:"DescribeHsmClientCertificatesResult>Marker"`
 }
 
 // DescribeHSMConfigurationsResult is a wrapper for HsmConfigurationMessage.
 type DescribeHSMConfigurationsResult struct {
-	HSMConfigurations []HSMConfiguration `query:"HsmConfigurations.member" xml:"DescribeHsmConfigurationsResult>HsmConfigurations>HsmConfiguration"`
+	HSMConfigurations []HSMConfiguration `query:"HsmConfigurations,list:HsmConfiguration" xml:"DescribeHsmConfigurationsResult>HsmConfigurations>HsmConfiguration"`
 	Marker            aws.StringValue    `query:"Marker" xml:"DescribeHsmConfigurationsResult>Marker"`
 }
 
@@ -1884,19 +3770,19 @@ type DescribeLoggingStatusResult struct {
 // DescribeOrderableClusterOptionsResult is a wrapper for OrderableClusterOptionsMessage.
 type DescribeOrderableClusterOptionsResult struct {
 	Marker                  aws.StringValue          `query:"Marker" xml:"DescribeOrderableClusterOptionsResult>Marker"`
-	OrderableClusterOptions []OrderableClusterOption `query:"OrderableClusterOptions.member" xml:"DescribeOrderableClusterOptionsResult>OrderableClusterOptions>OrderableClusterOption"`
+	OrderableClusterOptions []OrderableClusterOption `query:"OrderableClusterOptions,list:OrderableClusterOption" xml:"DescribeOrderableClusterOptionsResult>OrderableClusterOptions>OrderableClusterOption"`
 }
 
 // DescribeReservedNodeOfferingsResult is a wrapper for ReservedNodeOfferingsMessage.
 type DescribeReservedNodeOfferingsResult struct {
 	Marker                aws.StringValue        `query:"Marker" xml:"DescribeReservedNodeOfferingsResult>Marker"`
-	ReservedNodeOfferings []ReservedNodeOffering `query:"ReservedNodeOfferings.member" xml:"DescribeReservedNodeOfferingsResult>ReservedNodeOfferings>ReservedNodeOffering"`
+	ReservedNodeOfferings []ReservedNodeOffering `query:"ReservedNodeOfferings,list:ReservedNodeOffering" xml:"DescribeReservedNodeOfferingsResult>ReservedNodeOfferings>ReservedNodeOffering"`
 }
 
 // DescribeReservedNodesResult is a wrapper for ReservedNodesMessage.
 type DescribeReservedNodesResult struct {
 	Marker        aws.StringValue `query:"Marker" xml:"DescribeReservedNodesResult>Marker"`
-	ReservedNodes []ReservedNode  `query:"ReservedNodes.member" xml:"DescribeReservedNodesResult>ReservedNodes>ReservedNode"`
+	ReservedNodes []ReservedNode  `query:"ReservedNodes,list:ReservedNode" xml:"DescribeReservedNodesResult>ReservedNodes>ReservedNode"`
 }
 
 // DescribeResizeResult is a wrapper for ResizeProgressMessage.
@@ -1904,9 +3790,9 @@ type DescribeResizeResult struct {
 	AvgResizeRateInMegaBytesPerSecond  aws.DoubleValue  `query:"AvgResizeRateInMegaBytesPerSecond" xml:"DescribeResizeResult>AvgResizeRateInMegaBytesPerSecond"`
 	ElapsedTimeInSeconds               aws.LongValue    `query:"ElapsedTimeInSeconds" xml:"DescribeResizeResult>ElapsedTimeInSeconds"`
 	EstimatedTimeToCompletionInSeconds aws.LongValue    `query:"EstimatedTimeToCompletionInSeconds" xml:"DescribeResizeResult>EstimatedTimeToCompletionInSeconds"`
-	ImportTablesCompleted              []string         `query:"ImportTablesCompleted.member" xml:"DescribeResizeResult>ImportTablesCompleted>member"`
-	ImportTablesInProgress             []string         `query:"ImportTablesInProgress.member" xml:"DescribeResizeResult>ImportTablesInProgress>member"`
-	ImportTablesNotStarted             []string         `query:"ImportTablesNotStarted.member" xml:"DescribeResizeResult>ImportTablesNotStarted>member"`
+	ImportTablesCompleted              []string         `query:"ImportTablesCompleted,list:member" xml:"DescribeResizeResult>ImportTablesCompleted>member"`
+	ImportTablesInProgress             []string         `query:"ImportTablesInProgress,list:member" xml:"DescribeResizeResult>ImportTablesInProgress>member"`
+	ImportTablesNotStarted             []string         `query:"ImportTablesNotStarted,list:member" xml:"DescribeResizeResult>ImportTablesNotStarted>member"`
 	ProgressInMegaBytes                aws.LongValue    `query:"ProgressInMegaBytes" xml:"DescribeResizeResult>ProgressInMegaBytes"`
 	Status                             aws.StringValue  `query:"Status" xml:"DescribeResizeResult>Status"`
 	TargetClusterType                  aws.StringValue  `query:"TargetClusterType" xml:"DescribeResizeResult>TargetClusterType"`
@@ -1918,7 +3804,7 @@ type DescribeResizeResult struct {
 // DescribeTagsResult is a wrapper for TaggedResourceListMessage.
 type DescribeTagsResult struct {
 	Marker          aws.StringValue  `query:"Marker" xml:"DescribeTagsResult>Marker"`
-	TaggedResources []TaggedResource `query:"TaggedResources.member" xml:"DescribeTagsResult>TaggedResources>TaggedResource"`
+	TaggedResources []TaggedResource `query:"TaggedResources,list:TaggedResource" xml:"DescribeTagsResult>TaggedResources>TaggedResource"`
 }
 
 // DisableLoggingResult is a wrapper for LoggingStatus.
@@ -1953,5 +3839,453 @@ type ResetClusterParameterGroupResult struct {
 	ParameterGroupStatus aws.StringValue `query:"ParameterGroupStatus" xml:"ResetClusterParameterGroupResult>ParameterGroupStatus"`
 }
 
+// AccessToSnapshotDeniedFault is the error returned for the AccessToSnapshotDenied error code.
+type AccessToSnapshotDeniedFault struct {
+	aws.APIError
+}
+
+// AuthorizationAlreadyExistsFault is the error returned for the AuthorizationAlreadyExists error code.
+type AuthorizationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// AuthorizationNotFoundFault is the error returned for the AuthorizationNotFound error code.
+type AuthorizationNotFoundFault struct {
+	aws.APIError
+}
+
+// AuthorizationQuotaExceededFault is the error returned for the AuthorizationQuotaExceeded error code.
+type AuthorizationQuotaExceededFault struct {
+	aws.APIError
+}
+
+// BucketNotFoundFault is the error returned for the BucketNotFoundFault error code.
+type BucketNotFoundFault struct {
+	aws.APIError
+}
+
+// ClusterAlreadyExistsFault is the error returned for the ClusterAlreadyExists error code.
+type ClusterAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ClusterNotFoundFault is the error returned for the ClusterNotFound error code.
+type ClusterNotFoundFault struct {
+	aws.APIError
+}
+
+// ClusterParameterGroupAlreadyExistsFault is the error returned for the ClusterParameterGroupAlreadyExists error code.
+type ClusterParameterGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ClusterParameterGroupNotFoundFault is the error returned for the ClusterParameterGroupNotFound error code.
+type ClusterParameterGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// ClusterParameterGroupQuotaExceededFault is the error returned for the ClusterParameterGroupQuotaExceeded error code.
+type ClusterParameterGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ClusterQuotaExceededFault is the error returned for the ClusterQuotaExceeded error code.
+type ClusterQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ClusterSecurityGroupAlreadyExistsFault is the error returned for the ClusterSecurityGroupAlreadyExists error code.
+type ClusterSecurityGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ClusterSecurityGroupNotFoundFault is the error returned for the ClusterSecurityGroupNotFound error code.
+type ClusterSecurityGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// ClusterSecurityGroupQuotaExceededFault is the error returned for the QuotaExceeded.ClusterSecurityGroup error code.
+type ClusterSecurityGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ClusterSnapshotAlreadyExistsFault is the error returned for the ClusterSnapshotAlreadyExists error code.
+type ClusterSnapshotAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ClusterSnapshotNotFoundFault is the error returned for the ClusterSnapshotNotFound error code.
+type ClusterSnapshotNotFoundFault struct {
+	aws.APIError
+}
+
+// ClusterSnapshotQuotaExceededFault is the error returned for the ClusterSnapshotQuotaExceeded error code.
+type ClusterSnapshotQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ClusterSubnetGroupAlreadyExistsFault is the error returned for the ClusterSubnetGroupAlreadyExists error code.
+type ClusterSubnetGroupAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ClusterSubnetGroupNotFoundFault is the error returned for the ClusterSubnetGroupNotFoundFault error code.
+type ClusterSubnetGroupNotFoundFault struct {
+	aws.APIError
+}
+
+// ClusterSubnetGroupQuotaExceededFault is the error returned for the ClusterSubnetGroupQuotaExceeded error code.
+type ClusterSubnetGroupQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ClusterSubnetQuotaExceededFault is the error returned for the ClusterSubnetQuotaExceededFault error code.
+type ClusterSubnetQuotaExceededFault struct {
+	aws.APIError
+}
+
+// CopyToRegionDisabledFault is the error returned for the CopyToRegionDisabledFault error code.
+type CopyToRegionDisabledFault struct {
+	aws.APIError
+}
+
+// EventSubscriptionQuotaExceededFault is the error returned for the EventSubscriptionQuotaExceeded error code.
+type EventSubscriptionQuotaExceededFault struct {
+	aws.APIError
+}
+
+// HSMClientCertificateAlreadyExistsFault is the error returned for the HsmClientCertificateAlreadyExistsFault error code.
+type HSMClientCertificateAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// HSMClientCertificateNotFoundFault is the error returned for the HsmClientCertificateNotFoundFault error code.
+type HSMClientCertificateNotFoundFault struct {
+	aws.APIError
+}
+
+// HSMClientCertificateQuotaExceededFault is the error returned for the HsmClientCertificateQuotaExceededFault error code.
+type HSMClientCertificateQuotaExceededFault struct {
+	aws.APIError
+}
+
+// HSMConfigurationAlreadyExistsFault is the error returned for the HsmConfigurationAlreadyExistsFault error code.
+type HSMConfigurationAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// HSMConfigurationNotFoundFault is the error returned for the HsmConfigurationNotFoundFault error code.
+type HSMConfigurationNotFoundFault struct {
+	aws.APIError
+}
+
+// HSMConfigurationQuotaExceededFault is the error returned for the HsmConfigurationQuotaExceededFault error code.
+type HSMConfigurationQuotaExceededFault struct {
+	aws.APIError
+}
+
+// IncompatibleOrderableOptions is the error returned for the IncompatibleOrderableOptions error code.
+type IncompatibleOrderableOptions struct {
+	aws.APIError
+}
+
+// InsufficientClusterCapacityFault is the error returned for the InsufficientClusterCapacity error code.
+type InsufficientClusterCapacityFault struct {
+	aws.APIError
+}
+
+// InsufficientS3BucketPolicyFault is the error returned for the InsufficientS3BucketPolicyFault error code.
+type InsufficientS3BucketPolicyFault struct {
+	aws.APIError
+}
+
+// InvalidClusterParameterGroupStateFault is the error returned for the InvalidClusterParameterGroupState error code.
+type InvalidClusterParameterGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidClusterSecurityGroupStateFault is the error returned for the InvalidClusterSecurityGroupState error code.
+type InvalidClusterSecurityGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidClusterSnapshotStateFault is the error returned for the InvalidClusterSnapshotState error code.
+type InvalidClusterSnapshotStateFault struct {
+	aws.APIError
+}
+
+// InvalidClusterStateFault is the error returned for the InvalidClusterState error code.
+type InvalidClusterStateFault struct {
+	aws.APIError
+}
+
+// InvalidClusterSubnetGroupStateFault is the error returned for the InvalidClusterSubnetGroupStateFault error code.
+type InvalidClusterSubnetGroupStateFault struct {
+	aws.APIError
+}
+
+// InvalidClusterSubnetStateFault is the error returned for the InvalidClusterSubnetStateFault error code.
+type InvalidClusterSubnetStateFault struct {
+	aws.APIError
+}
+
+// InvalidElasticIPFault is the error returned for the InvalidElasticIpFault error code.
+type InvalidElasticIPFault struct {
+	aws.APIError
+}
+
+// InvalidHSMClientCertificateStateFault is the error returned for the InvalidHsmClientCertificateStateFault error code.
+type InvalidHSMClientCertificateStateFault struct {
+	aws.APIError
+}
+
+// InvalidHSMConfigurationStateFault is the error returned for the InvalidHsmConfigurationStateFault error code.
+type InvalidHSMConfigurationStateFault struct {
+	aws.APIError
+}
+
+// InvalidRestoreFault is the error returned for the InvalidRestore error code.
+type InvalidRestoreFault struct {
+	aws.APIError
+}
+
+// InvalidS3BucketNameFault is the error returned for the InvalidS3BucketNameFault error code.
+type InvalidS3BucketNameFault struct {
+	aws.APIError
+}
+
+// InvalidS3KeyPrefixFault is the error returned for the InvalidS3KeyPrefixFault error code.
+type InvalidS3KeyPrefixFault struct {
+	aws.APIError
+}
+
+// InvalidSubnet is the error returned for the InvalidSubnet error code.
+type InvalidSubnet struct {
+	aws.APIError
+}
+
+// InvalidSubscriptionStateFault is the error returned for the InvalidSubscriptionStateFault error code.
+type InvalidSubscriptionStateFault struct {
+	aws.APIError
+}
+
+// InvalidTagFault is the error returned for the InvalidTagFault error code.
+type InvalidTagFault struct {
+	aws.APIError
+}
+
+// InvalidVPCNetworkStateFault is the error returned for the InvalidVPCNetworkStateFault error code.
+type InvalidVPCNetworkStateFault struct {
+	aws.APIError
+}
+
+// NumberOfNodesPerClusterLimitExceededFault is the error returned for the NumberOfNodesPerClusterLimitExceeded error code.
+type NumberOfNodesPerClusterLimitExceededFault struct {
+	aws.APIError
+}
+
+// NumberOfNodesQuotaExceededFault is the error returned for the NumberOfNodesQuotaExceeded error code.
+type NumberOfNodesQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ReservedNodeAlreadyExistsFault is the error returned for the ReservedNodeAlreadyExists error code.
+type ReservedNodeAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// ReservedNodeNotFoundFault is the error returned for the ReservedNodeNotFound error code.
+type ReservedNodeNotFoundFault struct {
+	aws.APIError
+}
+
+// ReservedNodeOfferingNotFoundFault is the error returned for the ReservedNodeOfferingNotFound error code.
+type ReservedNodeOfferingNotFoundFault struct {
+	aws.APIError
+}
+
+// ReservedNodeQuotaExceededFault is the error returned for the ReservedNodeQuotaExceeded error code.
+type ReservedNodeQuotaExceededFault struct {
+	aws.APIError
+}
+
+// ResizeNotFoundFault is the error returned for the ResizeNotFound error code.
+type ResizeNotFoundFault struct {
+	aws.APIError
+}
+
+// ResourceNotFoundFault is the error returned for the ResourceNotFoundFault error code.
+type ResourceNotFoundFault struct {
+	aws.APIError
+}
+
+// SNSInvalidTopicFault is the error returned for the SNSInvalidTopic error code.
+type SNSInvalidTopicFault struct {
+	aws.APIError
+}
+
+// SNSNoAuthorizationFault is the error returned for the SNSNoAuthorization error code.
+type SNSNoAuthorizationFault struct {
+	aws.APIError
+}
+
+// SNSTopicARNNotFoundFault is the error returned for the SNSTopicArnNotFound error code.
+type SNSTopicARNNotFoundFault struct {
+	aws.APIError
+}
+
+// SnapshotCopyAlreadyDisabledFault is the error returned for the SnapshotCopyAlreadyDisabledFault error code.
+type SnapshotCopyAlreadyDisabledFault struct {
+	aws.APIError
+}
+
+// SnapshotCopyAlreadyEnabledFault is the error returned for the SnapshotCopyAlreadyEnabledFault error code.
+type SnapshotCopyAlreadyEnabledFault struct {
+	aws.APIError
+}
+
+// SnapshotCopyDisabledFault is the error returned for the SnapshotCopyDisabledFault error code.
+type SnapshotCopyDisabledFault struct {
+	aws.APIError
+}
+
+// SourceNotFoundFault is the error returned for the SourceNotFound error code.
+type SourceNotFoundFault struct {
+	aws.APIError
+}
+
+// SubnetAlreadyInUse is the error returned for the SubnetAlreadyInUse error code.
+type SubnetAlreadyInUse struct {
+	aws.APIError
+}
+
+// SubscriptionAlreadyExistFault is the error returned for the SubscriptionAlreadyExist error code.
+type SubscriptionAlreadyExistFault struct {
+	aws.APIError
+}
+
+// SubscriptionCategoryNotFoundFault is the error returned for the SubscriptionCategoryNotFound error code.
+type SubscriptionCategoryNotFoundFault struct {
+	aws.APIError
+}
+
+// SubscriptionEventIDNotFoundFault is the error returned for the SubscriptionEventIdNotFound error code.
+type SubscriptionEventIDNotFoundFault struct {
+	aws.APIError
+}
+
+// SubscriptionNotFoundFault is the error returned for the SubscriptionNotFound error code.
+type SubscriptionNotFoundFault struct {
+	aws.APIError
+}
+
+// SubscriptionSeverityNotFoundFault is the error returned for the SubscriptionSeverityNotFound error code.
+type SubscriptionSeverityNotFoundFault struct {
+	aws.APIError
+}
+
+// TagLimitExceededFault is the error returned for the TagLimitExceededFault error code.
+type TagLimitExceededFault struct {
+	aws.APIError
+}
+
+// UnauthorizedOperation is the error returned for the UnauthorizedOperation error code.
+type UnauthorizedOperation struct {
+	aws.APIError
+}
+
+// UnknownSnapshotCopyRegionFault is the error returned for the UnknownSnapshotCopyRegionFault error code.
+type UnknownSnapshotCopyRegionFault struct {
+	aws.APIError
+}
+
+// UnsupportedOptionFault is the error returned for the UnsupportedOptionFault error code.
+type UnsupportedOptionFault struct {
+	aws.APIError
+}
+
+// exceptions maps RedShift's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AccessToSnapshotDenied":                 AccessToSnapshotDeniedFault{},
+	"AuthorizationAlreadyExists":             AuthorizationAlreadyExistsFault{},
+	"AuthorizationNotFound":                  AuthorizationNotFoundFault{},
+	"AuthorizationQuotaExceeded":             AuthorizationQuotaExceededFault{},
+	"BucketNotFoundFault":                    BucketNotFoundFault{},
+	"ClusterAlreadyExists":                   ClusterAlreadyExistsFault{},
+	"ClusterNotFound":                        ClusterNotFoundFault{},
+	"ClusterParameterGroupAlreadyExists":     ClusterParameterGroupAlreadyExistsFault{},
+	"ClusterParameterGroupNotFound":          ClusterParameterGroupNotFoundFault{},
+	"ClusterParameterGroupQuotaExceeded":     ClusterParameterGroupQuotaExceededFault{},
+	"ClusterQuotaExceeded":                   ClusterQuotaExceededFault{},
+	"ClusterSecurityGroupAlreadyExists":      ClusterSecurityGroupAlreadyExistsFault{},
+	"ClusterSecurityGroupNotFound":           ClusterSecurityGroupNotFoundFault{},
+	"QuotaExceeded.ClusterSecurityGroup":     ClusterSecurityGroupQuotaExceededFault{},
+	"ClusterSnapshotAlreadyExists":           ClusterSnapshotAlreadyExistsFault{},
+	"ClusterSnapshotNotFound":                ClusterSnapshotNotFoundFault{},
+	"ClusterSnapshotQuotaExceeded":           ClusterSnapshotQuotaExceededFault{},
+	"ClusterSubnetGroupAlreadyExists":        ClusterSubnetGroupAlreadyExistsFault{},
+	"ClusterSubnetGroupNotFoundFault":        ClusterSubnetGroupNotFoundFault{},
+	"ClusterSubnetGroupQuotaExceeded":        ClusterSubnetGroupQuotaExceededFault{},
+	"ClusterSubnetQuotaExceededFault":        ClusterSubnetQuotaExceededFault{},
+	"CopyToRegionDisabledFault":              CopyToRegionDisabledFault{},
+	"EventSubscriptionQuotaExceeded":         EventSubscriptionQuotaExceededFault{},
+	"HsmClientCertificateAlreadyExistsFault": HSMClientCertificateAlreadyExistsFault{},
+	"HsmClientCertificateNotFoundFault":      HSMClientCertificateNotFoundFault{},
+	"HsmClientCertificateQuotaExceededFault": HSMClientCertificateQuotaExceededFault{},
+	"HsmConfigurationAlreadyExistsFault":     HSMConfigurationAlreadyExistsFault{},
+	"HsmConfigurationNotFoundFault":          HSMConfigurationNotFoundFault{},
+	"HsmConfigurationQuotaExceededFault":     HSMConfigurationQuotaExceededFault{},
+	"IncompatibleOrderableOptions":           IncompatibleOrderableOptions{},
+	"InsufficientClusterCapacity":            InsufficientClusterCapacityFault{},
+	"InsufficientS3BucketPolicyFault":        InsufficientS3BucketPolicyFault{},
+	"InvalidClusterParameterGroupState":      InvalidClusterParameterGroupStateFault{},
+	"InvalidClusterSecurityGroupState":       InvalidClusterSecurityGroupStateFault{},
+	"InvalidClusterSnapshotState":            InvalidClusterSnapshotStateFault{},
+	"InvalidClusterState":                    InvalidClusterStateFault{},
+	"InvalidClusterSubnetGroupStateFault":    InvalidClusterSubnetGroupStateFault{},
+	"InvalidClusterSubnetStateFault":         InvalidClusterSubnetStateFault{},
+	"InvalidElasticIpFault":                  InvalidElasticIPFault{},
+	"InvalidHsmClientCertificateStateFault":  InvalidHSMClientCertificateStateFault{},
+	"InvalidHsmConfigurationStateFault":      InvalidHSMConfigurationStateFault{},
+	"InvalidRestore":                         InvalidRestoreFault{},
+	"InvalidS3BucketNameFault":               InvalidS3BucketNameFault{},
+	"InvalidS3KeyPrefixFault":                InvalidS3KeyPrefixFault{},
+	"InvalidSubnet":                          InvalidSubnet{},
+	"InvalidSubscriptionStateFault":          InvalidSubscriptionStateFault{},
+	"InvalidTagFault":                        InvalidTagFault{},
+	"InvalidVPCNetworkStateFault":            InvalidVPCNetworkStateFault{},
+	"NumberOfNodesPerClusterLimitExceeded":   NumberOfNodesPerClusterLimitExceededFault{},
+	"NumberOfNodesQuotaExceeded":             NumberOfNodesQuotaExceededFault{},
+	"ReservedNodeAlreadyExists":              ReservedNodeAlreadyExistsFault{},
+	"ReservedNodeNotFound":                   ReservedNodeNotFoundFault{},
+	"ReservedNodeOfferingNotFound":           ReservedNodeOfferingNotFoundFault{},
+	"ReservedNodeQuotaExceeded":              ReservedNodeQuotaExceededFault{},
+	"ResizeNotFound":                         ResizeNotFoundFault{},
+	"ResourceNotFoundFault":                  ResourceNotFoundFault{},
+	"SNSInvalidTopic":                        SNSInvalidTopicFault{},
+	"SNSNoAuthorization":                     SNSNoAuthorizationFault{},
+	"SNSTopicArnNotFound":                    SNSTopicARNNotFoundFault{},
+	"SnapshotCopyAlreadyDisabledFault":       SnapshotCopyAlreadyDisabledFault{},
+	"SnapshotCopyAlreadyEnabledFault":        SnapshotCopyAlreadyEnabledFault{},
+	"SnapshotCopyDisabledFault":              SnapshotCopyDisabledFault{},
+	"SourceNotFound":                         SourceNotFoundFault{},
+	"SubnetAlreadyInUse":                     SubnetAlreadyInUse{},
+	"SubscriptionAlreadyExist":               SubscriptionAlreadyExistFault{},
+	"SubscriptionCategoryNotFound":           SubscriptionCategoryNotFoundFault{},
+	"SubscriptionEventIdNotFound":            SubscriptionEventIDNotFoundFault{},
+	"SubscriptionNotFound":                   SubscriptionNotFoundFault{},
+	"SubscriptionSeverityNotFound":           SubscriptionSeverityNotFoundFault{},
+	"TagLimitExceededFault":                  TagLimitExceededFault{},
+	"UnauthorizedOperation":                  UnauthorizedOperation{},
+	"UnknownSnapshotCopyRegionFault":         UnknownSnapshotCopyRegionFault{},
+	"UnsupportedOptionFault":                 UnsupportedOptionFault{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
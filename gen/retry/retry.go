@@ -0,0 +1,370 @@
+// Package retry provides the retry policies for all AWS services.
+package retry
+
+import (
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+)
+
+// Lookup returns a new copy of the retry policy for the first of the given
+// service names which has one, or the default policy if none do.
+func Lookup(names ...string) *aws.RetryPolicy {
+	for _, name := range names {
+		switch name {
+
+		case "autoscaling":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "cloudformation":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "cloudsearch":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{Code: "BandwidthLimitExceeded", StatusCode: 509},
+					{StatusCode: 503},
+				},
+			}
+
+		case "cloudwatch":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "datapipeline":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "directconnect":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "dynamodb":
+			return &aws.RetryPolicy{
+				MaxAttempts: 10,
+				Delay:       aws.Backoff{Base: 50 * time.Millisecond, GrowthFactor: 2, Jitter: false},
+				Conditions: []aws.RetryCondition{
+					{Checksum: true},
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "ThrottlingException", StatusCode: 400},
+					{Code: "ProvisionedThroughputExceededException", StatusCode: 400},
+				},
+			}
+
+		case "ec2":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{Code: "RequestLimitExceeded", StatusCode: 503},
+					{StatusCode: 503},
+				},
+			}
+
+		case "elasticache":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "elasticbeanstalk":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "elasticmapreduce":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "ThrottlingException", StatusCode: 400},
+				},
+			}
+
+		case "elastictranscoder":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "ThrottlingException", StatusCode: 400},
+				},
+			}
+
+		case "elb":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "glacier":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "ThrottlingException", StatusCode: 400},
+				},
+			}
+
+		case "iam":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "opsworks":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "rds":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "redshift":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "route53":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "s3":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "RequestTimeout", StatusCode: 400},
+				},
+			}
+
+		case "ses":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "sns":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "sqs":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{Code: "RequestThrottled", StatusCode: 403},
+					{StatusCode: 503},
+				},
+			}
+
+		case "storagegateway":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "ThrottlingException", StatusCode: 400},
+				},
+			}
+
+		case "sts":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		case "swf":
+			return &aws.RetryPolicy{
+				MaxAttempts: 5,
+				Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+				Conditions: []aws.RetryCondition{
+					{StatusCode: 500},
+					{ConnectionError: true},
+					{StatusCode: 509},
+					{StatusCode: 503},
+					{Code: "Throttling", StatusCode: 400},
+				},
+			}
+
+		}
+	}
+
+	return &aws.RetryPolicy{
+		MaxAttempts: 5,
+		Delay:       aws.Backoff{Base: 1 * time.Second, GrowthFactor: 2, Jitter: true},
+		Conditions: []aws.RetryCondition{
+			{StatusCode: 500},
+			{ConnectionError: true},
+			{StatusCode: 509},
+			{StatusCode: 503},
+		},
+	}
+
+}
@@ -4,15 +4,18 @@
 package route53
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
 )
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -27,29 +30,311 @@ type Route53 struct {
 	client *aws.RestClient
 }
 
-// New returns a new Route53 client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *Route53 {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "route53"
-	endpoint, service, region := endpoints.Lookup("route53", region)
+// New returns a new Route53 client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *Route53 {
+	cfg := newConfig(region, client, opts)
 
 	return &Route53{
 		client: &aws.RestClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2013-04-01",
 		},
 	}
 }
 
+// NewFromSession returns a new Route53 client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *Route53 {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("route53", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("route53", "route53"),
+	}, "AWS_ENDPOINT_URL_ROUTE_53", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *Route53) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *Route53) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *Route53) SetPayloadSigning(p aws.PayloadSigning) {
+	c.client.Context.PayloadSigning = p
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *Route53) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch op {
+
+	case "AssociateVPCWithHostedZone":
+
+		r, ok := req.(*AssociateVPCWithHostedZoneRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: AssociateVPCWithHostedZone takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newAssociateVPCWithHostedZoneRequest(context.Background(), r)
+
+	case "ChangeResourceRecordSets":
+
+		r, ok := req.(*ChangeResourceRecordSetsRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ChangeResourceRecordSets takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newChangeResourceRecordSetsRequest(context.Background(), r)
+
+	case "ChangeTagsForResource":
+
+		r, ok := req.(*ChangeTagsForResourceRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ChangeTagsForResource takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newChangeTagsForResourceRequest(context.Background(), r)
+
+	case "CreateHealthCheck":
+
+		r, ok := req.(*CreateHealthCheckRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: CreateHealthCheck takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateHealthCheckRequest(context.Background(), r)
+
+	case "CreateHostedZone":
+
+		r, ok := req.(*CreateHostedZoneRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: CreateHostedZone takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateHostedZoneRequest(context.Background(), r)
+
+	case "CreateReusableDelegationSet":
+
+		r, ok := req.(*CreateReusableDelegationSetRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: CreateReusableDelegationSet takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateReusableDelegationSetRequest(context.Background(), r)
+
+	case "DeleteHealthCheck":
+
+		r, ok := req.(*DeleteHealthCheckRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: DeleteHealthCheck takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteHealthCheckRequest(context.Background(), r)
+
+	case "DeleteHostedZone":
+
+		r, ok := req.(*DeleteHostedZoneRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: DeleteHostedZone takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteHostedZoneRequest(context.Background(), r)
+
+	case "DeleteReusableDelegationSet":
+
+		r, ok := req.(*DeleteReusableDelegationSetRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: DeleteReusableDelegationSet takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteReusableDelegationSetRequest(context.Background(), r)
+
+	case "DisassociateVPCFromHostedZone":
+
+		r, ok := req.(*DisassociateVPCFromHostedZoneRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: DisassociateVPCFromHostedZone takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDisassociateVPCFromHostedZoneRequest(context.Background(), r)
+
+	case "GetChange":
+
+		r, ok := req.(*GetChangeRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetChange takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetChangeRequest(context.Background(), r)
+
+	case "GetCheckerIpRanges":
+
+		r, ok := req.(*GetCheckerIPRangesRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetCheckerIpRanges takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetCheckerIPRangesRequest(context.Background(), r)
+
+	case "GetGeoLocation":
+
+		r, ok := req.(*GetGeoLocationRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetGeoLocation takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetGeoLocationRequest(context.Background(), r)
+
+	case "GetHealthCheck":
+
+		r, ok := req.(*GetHealthCheckRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetHealthCheck takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetHealthCheckRequest(context.Background(), r)
+
+	case "GetHealthCheckCount":
+
+		r, ok := req.(*GetHealthCheckCountRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetHealthCheckCount takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetHealthCheckCountRequest(context.Background(), r)
+
+	case "GetHealthCheckLastFailureReason":
+
+		r, ok := req.(*GetHealthCheckLastFailureReasonRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetHealthCheckLastFailureReason takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetHealthCheckLastFailureReasonRequest(context.Background(), r)
+
+	case "GetHealthCheckStatus":
+
+		r, ok := req.(*GetHealthCheckStatusRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetHealthCheckStatus takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetHealthCheckStatusRequest(context.Background(), r)
+
+	case "GetHostedZone":
+
+		r, ok := req.(*GetHostedZoneRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetHostedZone takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetHostedZoneRequest(context.Background(), r)
+
+	case "GetReusableDelegationSet":
+
+		r, ok := req.(*GetReusableDelegationSetRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: GetReusableDelegationSet takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetReusableDelegationSetRequest(context.Background(), r)
+
+	case "ListGeoLocations":
+
+		r, ok := req.(*ListGeoLocationsRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ListGeoLocations takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListGeoLocationsRequest(context.Background(), r)
+
+	case "ListHealthChecks":
+
+		r, ok := req.(*ListHealthChecksRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ListHealthChecks takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListHealthChecksRequest(context.Background(), r)
+
+	case "ListHostedZones":
+
+		r, ok := req.(*ListHostedZonesRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ListHostedZones takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListHostedZonesRequest(context.Background(), r)
+
+	case "ListResourceRecordSets":
+
+		r, ok := req.(*ListResourceRecordSetsRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ListResourceRecordSets takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListResourceRecordSetsRequest(context.Background(), r)
+
+	case "ListReusableDelegationSets":
+
+		r, ok := req.(*ListReusableDelegationSetsRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ListReusableDelegationSets takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListReusableDelegationSetsRequest(context.Background(), r)
+
+	case "ListTagsForResource":
+
+		r, ok := req.(*ListTagsForResourceRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ListTagsForResource takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListTagsForResourceRequest(context.Background(), r)
+
+	case "ListTagsForResources":
+
+		r, ok := req.(*ListTagsForResourcesRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: ListTagsForResources takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListTagsForResourcesRequest(context.Background(), r)
+
+	case "UpdateHealthCheck":
+
+		r, ok := req.(*UpdateHealthCheckRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: UpdateHealthCheck takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdateHealthCheckRequest(context.Background(), r)
+
+	case "UpdateHostedZoneComment":
+
+		r, ok := req.(*UpdateHostedZoneCommentRequest)
+		if !ok {
+			return "", fmt.Errorf("route53: UpdateHostedZoneComment takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUpdateHostedZoneCommentRequest(context.Background(), r)
+
+	default:
+		return "", fmt.Errorf("route53: unknown operation %q", op)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
 // AssociateVPCWithHostedZone this action associates a VPC with an hosted
 // zone. To associate a VPC with an hosted zone, send a request to the
 // 2013-04-01/hostedzone/ hosted zone /associatevpc resource. The request
@@ -60,8 +345,36 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Rou
 // made. See GetChange operation for how to track the progress of your
 // change.
 func (c *Route53) AssociateVPCWithHostedZone(req *AssociateVPCWithHostedZoneRequest) (resp *AssociateVPCWithHostedZoneResponse, err error) {
+	return c.AssociateVPCWithHostedZoneWithContext(context.Background(), req)
+}
+
+// AssociateVPCWithHostedZoneWithContext is like AssociateVPCWithHostedZone, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) AssociateVPCWithHostedZoneWithContext(ctx context.Context, req *AssociateVPCWithHostedZoneRequest) (resp *AssociateVPCWithHostedZoneResponse, err error) {
 	resp = &AssociateVPCWithHostedZoneResponse{}
 
+	httpReq, err := c.newAssociateVPCWithHostedZoneRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("AssociateVPCWithHostedZone", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newAssociateVPCWithHostedZoneRequest builds the HTTP request for AssociateVPCWithHostedZone.
+func (c *Route53) newAssociateVPCWithHostedZoneRequest(ctx context.Context, req *AssociateVPCWithHostedZoneRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -79,17 +392,21 @@ func (c *Route53) AssociateVPCWithHostedZone(req *AssociateVPCWithHostedZoneRequ
 	uri := c.client.Endpoint + "/2013-04-01/hostedzone/{Id}/associatevpc"
 
 	if req.HostedZoneID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.HostedZoneID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.HostedZoneID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.HostedZoneID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.HostedZoneID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -98,17 +415,6 @@ func (c *Route53) AssociateVPCWithHostedZone(req *AssociateVPCWithHostedZoneRequ
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -134,8 +440,36 @@ func (c *Route53) AssociateVPCWithHostedZone(req *AssociateVPCWithHostedZoneRequ
 // characters (including spaces) in all Value elements in a request cannot
 // exceed 32,000 characters.
 func (c *Route53) ChangeResourceRecordSets(req *ChangeResourceRecordSetsRequest) (resp *ChangeResourceRecordSetsResponse, err error) {
+	return c.ChangeResourceRecordSetsWithContext(context.Background(), req)
+}
+
+// ChangeResourceRecordSetsWithContext is like ChangeResourceRecordSets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ChangeResourceRecordSetsWithContext(ctx context.Context, req *ChangeResourceRecordSetsRequest) (resp *ChangeResourceRecordSetsResponse, err error) {
 	resp = &ChangeResourceRecordSetsResponse{}
 
+	httpReq, err := c.newChangeResourceRecordSetsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ChangeResourceRecordSets", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newChangeResourceRecordSetsRequest builds the HTTP request for ChangeResourceRecordSets.
+func (c *Route53) newChangeResourceRecordSetsRequest(ctx context.Context, req *ChangeResourceRecordSetsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -153,17 +487,21 @@ func (c *Route53) ChangeResourceRecordSets(req *ChangeResourceRecordSetsRequest)
 	uri := c.client.Endpoint + "/2013-04-01/hostedzone/{Id}/rrset/"
 
 	if req.HostedZoneID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.HostedZoneID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.HostedZoneID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.HostedZoneID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.HostedZoneID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -172,7 +510,26 @@ func (c *Route53) ChangeResourceRecordSets(req *ChangeResourceRecordSetsRequest)
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ChangeTagsForResource <nil>
+func (c *Route53) ChangeTagsForResource(req *ChangeTagsForResourceRequest) (resp *ChangeTagsForResourceResponse, err error) {
+	return c.ChangeTagsForResourceWithContext(context.Background(), req)
+}
+
+// ChangeTagsForResourceWithContext is like ChangeTagsForResource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ChangeTagsForResourceWithContext(ctx context.Context, req *ChangeTagsForResourceRequest) (resp *ChangeTagsForResourceResponse, err error) {
+	resp = &ChangeTagsForResourceResponse{}
+
+	httpReq, err := c.newChangeTagsForResourceRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ChangeTagsForResource", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -186,10 +543,8 @@ func (c *Route53) ChangeResourceRecordSets(req *ChangeResourceRecordSetsRequest)
 	return
 }
 
-// ChangeTagsForResource <nil>
-func (c *Route53) ChangeTagsForResource(req *ChangeTagsForResourceRequest) (resp *ChangeTagsForResourceResponse, err error) {
-	resp = &ChangeTagsForResourceResponse{}
-
+// newChangeTagsForResourceRequest builds the HTTP request for ChangeTagsForResource.
+func (c *Route53) newChangeTagsForResourceRequest(ctx context.Context, req *ChangeTagsForResourceRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -207,22 +562,26 @@ func (c *Route53) ChangeTagsForResource(req *ChangeTagsForResourceRequest) (resp
 	uri := c.client.Endpoint + "/2013-04-01/tags/{ResourceType}/{ResourceId}"
 
 	if req.ResourceID != nil {
-		uri = strings.Replace(uri, "{"+"ResourceId"+"}", *req.ResourceID, -1)
-		uri = strings.Replace(uri, "{"+"ResourceId+"+"}", *req.ResourceID, -1)
+		uri = strings.Replace(uri, "{ResourceId}", aws.EscapePath(*req.ResourceID, false), -1)
+		uri = strings.Replace(uri, "{ResourceId+}", aws.EscapePath(*req.ResourceID, true), -1)
 	}
 
 	if req.ResourceType != nil {
-		uri = strings.Replace(uri, "{"+"ResourceType"+"}", *req.ResourceType, -1)
-		uri = strings.Replace(uri, "{"+"ResourceType+"+"}", *req.ResourceType, -1)
+		uri = strings.Replace(uri, "{ResourceType}", aws.EscapePath(*req.ResourceType, false), -1)
+		uri = strings.Replace(uri, "{ResourceType+}", aws.EscapePath(*req.ResourceType, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -231,7 +590,31 @@ func (c *Route53) ChangeTagsForResource(req *ChangeTagsForResourceRequest) (resp
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreateHealthCheck this action creates a new health check. To create a
+// new health check, send a request to the 2013-04-01/healthcheck resource.
+// The request body must include an XML document with a
+// CreateHealthCheckRequest element. The response returns the
+// CreateHealthCheckResponse element that contains metadata about the
+// health check.
+func (c *Route53) CreateHealthCheck(req *CreateHealthCheckRequest) (resp *CreateHealthCheckResponse, err error) {
+	return c.CreateHealthCheckWithContext(context.Background(), req)
+}
+
+// CreateHealthCheckWithContext is like CreateHealthCheck, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) CreateHealthCheckWithContext(ctx context.Context, req *CreateHealthCheckRequest) (resp *CreateHealthCheckResponse, err error) {
+	resp = &CreateHealthCheckResponse{}
+
+	httpReq, err := c.newCreateHealthCheckRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateHealthCheck", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -242,18 +625,15 @@ func (c *Route53) ChangeTagsForResource(req *ChangeTagsForResourceRequest) (resp
 		return
 	}
 
+	if s := httpResp.Header.Get("Location"); s != "" {
+		resp.Location = &s
+	}
+
 	return
 }
 
-// CreateHealthCheck this action creates a new health check. To create a
-// new health check, send a request to the 2013-04-01/healthcheck resource.
-// The request body must include an XML document with a
-// CreateHealthCheckRequest element. The response returns the
-// CreateHealthCheckResponse element that contains metadata about the
-// health check.
-func (c *Route53) CreateHealthCheck(req *CreateHealthCheckRequest) (resp *CreateHealthCheckResponse, err error) {
-	resp = &CreateHealthCheckResponse{}
-
+// newCreateHealthCheckRequest builds the HTTP request for CreateHealthCheck.
+func (c *Route53) newCreateHealthCheckRequest(ctx context.Context, req *CreateHealthCheckRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -273,10 +653,14 @@ func (c *Route53) CreateHealthCheck(req *CreateHealthCheckRequest) (resp *Create
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -285,23 +669,6 @@ func (c *Route53) CreateHealthCheck(req *CreateHealthCheckRequest) (resp *Create
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
-	if s := httpResp.Header.Get("Location"); s != "" {
-
-		resp.Location = &s
-
-	}
-
 	return
 }
 
@@ -322,8 +689,40 @@ func (c *Route53) CreateHealthCheck(req *CreateHealthCheckRequest) (resp *Create
 // and Route53 would assign those 4 NS records for the zone, instead of
 // alloting a new one.
 func (c *Route53) CreateHostedZone(req *CreateHostedZoneRequest) (resp *CreateHostedZoneResponse, err error) {
+	return c.CreateHostedZoneWithContext(context.Background(), req)
+}
+
+// CreateHostedZoneWithContext is like CreateHostedZone, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) CreateHostedZoneWithContext(ctx context.Context, req *CreateHostedZoneRequest) (resp *CreateHostedZoneResponse, err error) {
 	resp = &CreateHostedZoneResponse{}
 
+	httpReq, err := c.newCreateHostedZoneRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateHostedZone", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	if s := httpResp.Header.Get("Location"); s != "" {
+		resp.Location = &s
+	}
+
+	return
+}
+
+// newCreateHostedZoneRequest builds the HTTP request for CreateHostedZone.
+func (c *Route53) newCreateHostedZoneRequest(ctx context.Context, req *CreateHostedZoneRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -343,10 +742,14 @@ func (c *Route53) CreateHostedZone(req *CreateHostedZoneRequest) (resp *CreateHo
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -355,7 +758,33 @@ func (c *Route53) CreateHostedZone(req *CreateHostedZoneRequest) (resp *CreateHo
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreateReusableDelegationSet this action creates a reusable
+// delegationSet. To create a new reusable delegationSet, send a request to
+// the 2013-04-01/delegationset resource. The request body must include an
+// XML document with a CreateReusableDelegationSetRequest element. The
+// response returns the CreateReusableDelegationSetResponse element that
+// contains metadata about the delegationSet. If the optional parameter
+// HostedZoneId is specified, it marks the delegationSet associated with
+// that particular hosted zone as reusable.
+func (c *Route53) CreateReusableDelegationSet(req *CreateReusableDelegationSetRequest) (resp *CreateReusableDelegationSetResponse, err error) {
+	return c.CreateReusableDelegationSetWithContext(context.Background(), req)
+}
+
+// CreateReusableDelegationSetWithContext is like CreateReusableDelegationSet, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) CreateReusableDelegationSetWithContext(ctx context.Context, req *CreateReusableDelegationSetRequest) (resp *CreateReusableDelegationSetResponse, err error) {
+	resp = &CreateReusableDelegationSetResponse{}
+
+	httpReq, err := c.newCreateReusableDelegationSetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateReusableDelegationSet", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -367,25 +796,14 @@ func (c *Route53) CreateHostedZone(req *CreateHostedZoneRequest) (resp *CreateHo
 	}
 
 	if s := httpResp.Header.Get("Location"); s != "" {
-
 		resp.Location = &s
-
 	}
 
 	return
 }
 
-// CreateReusableDelegationSet this action creates a reusable
-// delegationSet. To create a new reusable delegationSet, send a request to
-// the 2013-04-01/delegationset resource. The request body must include an
-// XML document with a CreateReusableDelegationSetRequest element. The
-// response returns the CreateReusableDelegationSetResponse element that
-// contains metadata about the delegationSet. If the optional parameter
-// HostedZoneId is specified, it marks the delegationSet associated with
-// that particular hosted zone as reusable.
-func (c *Route53) CreateReusableDelegationSet(req *CreateReusableDelegationSetRequest) (resp *CreateReusableDelegationSetResponse, err error) {
-	resp = &CreateReusableDelegationSetResponse{}
-
+// newCreateReusableDelegationSetRequest builds the HTTP request for CreateReusableDelegationSet.
+func (c *Route53) newCreateReusableDelegationSetRequest(ctx context.Context, req *CreateReusableDelegationSetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -405,10 +823,14 @@ func (c *Route53) CreateReusableDelegationSet(req *CreateReusableDelegationSetRe
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -417,23 +839,6 @@ func (c *Route53) CreateReusableDelegationSet(req *CreateReusableDelegationSetRe
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
-	if s := httpResp.Header.Get("Location"); s != "" {
-
-		resp.Location = &s
-
-	}
-
 	return
 }
 
@@ -448,25 +853,57 @@ func (c *Route53) CreateReusableDelegationSet(req *CreateReusableDelegationSetRe
 // disassociating the records from your health check, see
 // ChangeResourceRecordSets
 func (c *Route53) DeleteHealthCheck(req *DeleteHealthCheckRequest) (resp *DeleteHealthCheckResponse, err error) {
+	return c.DeleteHealthCheckWithContext(context.Background(), req)
+}
+
+// DeleteHealthCheckWithContext is like DeleteHealthCheck, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) DeleteHealthCheckWithContext(ctx context.Context, req *DeleteHealthCheckRequest) (resp *DeleteHealthCheckResponse, err error) {
 	resp = &DeleteHealthCheckResponse{}
 
+	httpReq, err := c.newDeleteHealthCheckRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteHealthCheck", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newDeleteHealthCheckRequest builds the HTTP request for DeleteHealthCheck.
+func (c *Route53) newDeleteHealthCheckRequest(ctx context.Context, req *DeleteHealthCheckRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/healthcheck/{HealthCheckId}"
 
 	if req.HealthCheckID != nil {
-		uri = strings.Replace(uri, "{"+"HealthCheckId"+"}", *req.HealthCheckID, -1)
-		uri = strings.Replace(uri, "{"+"HealthCheckId+"+"}", *req.HealthCheckID, -1)
+		uri = strings.Replace(uri, "{HealthCheckId}", aws.EscapePath(*req.HealthCheckID, false), -1)
+		uri = strings.Replace(uri, "{HealthCheckId+}", aws.EscapePath(*req.HealthCheckID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -475,17 +912,6 @@ func (c *Route53) DeleteHealthCheck(req *DeleteHealthCheckRequest) (resp *Delete
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -501,25 +927,57 @@ func (c *Route53) DeleteHealthCheck(req *DeleteHealthCheckRequest) (resp *Delete
 // HostedZoneNotEmpty error. For information about deleting records from
 // your hosted zone, see ChangeResourceRecordSets
 func (c *Route53) DeleteHostedZone(req *DeleteHostedZoneRequest) (resp *DeleteHostedZoneResponse, err error) {
+	return c.DeleteHostedZoneWithContext(context.Background(), req)
+}
+
+// DeleteHostedZoneWithContext is like DeleteHostedZone, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) DeleteHostedZoneWithContext(ctx context.Context, req *DeleteHostedZoneRequest) (resp *DeleteHostedZoneResponse, err error) {
 	resp = &DeleteHostedZoneResponse{}
 
+	httpReq, err := c.newDeleteHostedZoneRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteHostedZone", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newDeleteHostedZoneRequest builds the HTTP request for DeleteHostedZone.
+func (c *Route53) newDeleteHostedZoneRequest(ctx context.Context, req *DeleteHostedZoneRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/hostedzone/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -528,17 +986,6 @@ func (c *Route53) DeleteHostedZone(req *DeleteHostedZoneRequest) (resp *DeleteHo
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -551,25 +998,57 @@ func (c *Route53) DeleteHostedZone(req *DeleteHostedZoneRequest) (resp *DeleteHo
 // try to delete a reusable delegation set that contains associated hosted
 // zones, Route 53 will deny your request with a DelegationSetInUse error.
 func (c *Route53) DeleteReusableDelegationSet(req *DeleteReusableDelegationSetRequest) (resp *DeleteReusableDelegationSetResponse, err error) {
+	return c.DeleteReusableDelegationSetWithContext(context.Background(), req)
+}
+
+// DeleteReusableDelegationSetWithContext is like DeleteReusableDelegationSet, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) DeleteReusableDelegationSetWithContext(ctx context.Context, req *DeleteReusableDelegationSetRequest) (resp *DeleteReusableDelegationSetResponse, err error) {
 	resp = &DeleteReusableDelegationSetResponse{}
 
+	httpReq, err := c.newDeleteReusableDelegationSetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteReusableDelegationSet", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newDeleteReusableDelegationSetRequest builds the HTTP request for DeleteReusableDelegationSet.
+func (c *Route53) newDeleteReusableDelegationSetRequest(ctx context.Context, req *DeleteReusableDelegationSetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/delegationset/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -578,17 +1057,6 @@ func (c *Route53) DeleteReusableDelegationSet(req *DeleteReusableDelegationSetRe
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -602,8 +1070,36 @@ func (c *Route53) DeleteReusableDelegationSet(req *DeleteReusableDelegationSetRe
 // DisassociateVPCFromHostedZoneRequest you made. See GetChange operation
 // for how to track the progress of your change.
 func (c *Route53) DisassociateVPCFromHostedZone(req *DisassociateVPCFromHostedZoneRequest) (resp *DisassociateVPCFromHostedZoneResponse, err error) {
+	return c.DisassociateVPCFromHostedZoneWithContext(context.Background(), req)
+}
+
+// DisassociateVPCFromHostedZoneWithContext is like DisassociateVPCFromHostedZone, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) DisassociateVPCFromHostedZoneWithContext(ctx context.Context, req *DisassociateVPCFromHostedZoneRequest) (resp *DisassociateVPCFromHostedZoneResponse, err error) {
 	resp = &DisassociateVPCFromHostedZoneResponse{}
 
+	httpReq, err := c.newDisassociateVPCFromHostedZoneRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DisassociateVPCFromHostedZone", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newDisassociateVPCFromHostedZoneRequest builds the HTTP request for DisassociateVPCFromHostedZone.
+func (c *Route53) newDisassociateVPCFromHostedZoneRequest(ctx context.Context, req *DisassociateVPCFromHostedZoneRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -621,17 +1117,21 @@ func (c *Route53) DisassociateVPCFromHostedZone(req *DisassociateVPCFromHostedZo
 	uri := c.client.Endpoint + "/2013-04-01/hostedzone/{Id}/disassociatevpc"
 
 	if req.HostedZoneID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.HostedZoneID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.HostedZoneID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.HostedZoneID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.HostedZoneID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -640,7 +1140,30 @@ func (c *Route53) DisassociateVPCFromHostedZone(req *DisassociateVPCFromHostedZo
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetChange this action returns the current status of a change batch
+// request. The status is one of the following values: - indicates that the
+// changes in this request have not replicated to all Route 53 DNS servers.
+// This is the initial status of all change batch requests. - indicates
+// that the changes have replicated to all Amazon Route 53 DNS servers.
+func (c *Route53) GetChange(req *GetChangeRequest) (resp *GetChangeResponse, err error) {
+	return c.GetChangeWithContext(context.Background(), req)
+}
+
+// GetChangeWithContext is like GetChange, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetChangeWithContext(ctx context.Context, req *GetChangeRequest) (resp *GetChangeResponse, err error) {
+	resp = &GetChangeResponse{}
+
+	httpReq, err := c.newGetChangeRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetChange", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -654,31 +1177,29 @@ func (c *Route53) DisassociateVPCFromHostedZone(req *DisassociateVPCFromHostedZo
 	return
 }
 
-// GetChange this action returns the current status of a change batch
-// request. The status is one of the following values: - indicates that the
-// changes in this request have not replicated to all Route 53 DNS servers.
-// This is the initial status of all change batch requests. - indicates
-// that the changes have replicated to all Amazon Route 53 DNS servers.
-func (c *Route53) GetChange(req *GetChangeRequest) (resp *GetChangeResponse, err error) {
-	resp = &GetChangeResponse{}
-
+// newGetChangeRequest builds the HTTP request for GetChange.
+func (c *Route53) newGetChangeRequest(ctx context.Context, req *GetChangeRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/change/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -687,7 +1208,30 @@ func (c *Route53) GetChange(req *GetChangeRequest) (resp *GetChangeResponse, err
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetCheckerIPRanges to retrieve a list of the IP ranges used by Amazon
+// Route 53 health checkers to check the health of your resources, send a
+// request to the 2013-04-01/checkeripranges resource. You can use these IP
+// addresses to configure router and firewall rules to allow health
+// checkers to check the health of your resources.
+func (c *Route53) GetCheckerIPRanges(req *GetCheckerIPRangesRequest) (resp *GetCheckerIPRangesResponse, err error) {
+	return c.GetCheckerIPRangesWithContext(context.Background(), req)
+}
+
+// GetCheckerIPRangesWithContext is like GetCheckerIPRanges, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetCheckerIPRangesWithContext(ctx context.Context, req *GetCheckerIPRangesRequest) (resp *GetCheckerIPRangesResponse, err error) {
+	resp = &GetCheckerIPRangesResponse{}
+
+	httpReq, err := c.newGetCheckerIPRangesRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetCheckerIpRanges", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -701,14 +1245,8 @@ func (c *Route53) GetChange(req *GetChangeRequest) (resp *GetChangeResponse, err
 	return
 }
 
-// GetCheckerIPRanges to retrieve a list of the IP ranges used by Amazon
-// Route 53 health checkers to check the health of your resources, send a
-// request to the 2013-04-01/checkeripranges resource. You can use these IP
-// addresses to configure router and firewall rules to allow health
-// checkers to check the health of your resources.
-func (c *Route53) GetCheckerIPRanges(req *GetCheckerIPRangesRequest) (resp *GetCheckerIPRangesResponse, err error) {
-	resp = &GetCheckerIPRangesResponse{}
-
+// newGetCheckerIPRangesRequest builds the HTTP request for GetCheckerIPRanges.
+func (c *Route53) newGetCheckerIPRangesRequest(ctx context.Context, req *GetCheckerIPRangesRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -717,10 +1255,14 @@ func (c *Route53) GetCheckerIPRanges(req *GetCheckerIPRangesRequest) (resp *GetC
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -729,7 +1271,28 @@ func (c *Route53) GetCheckerIPRanges(req *GetCheckerIPRangesRequest) (resp *GetC
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetGeoLocation to retrieve a single geo location, send a request to the
+// 2013-04-01/geolocation resource with one of these options: continentcode
+// | countrycode | countrycode and subdivisioncode.
+func (c *Route53) GetGeoLocation(req *GetGeoLocationRequest) (resp *GetGeoLocationResponse, err error) {
+	return c.GetGeoLocationWithContext(context.Background(), req)
+}
+
+// GetGeoLocationWithContext is like GetGeoLocation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetGeoLocationWithContext(ctx context.Context, req *GetGeoLocationRequest) (resp *GetGeoLocationResponse, err error) {
+	resp = &GetGeoLocationResponse{}
+
+	httpReq, err := c.newGetGeoLocationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetGeoLocation", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -743,12 +1306,8 @@ func (c *Route53) GetCheckerIPRanges(req *GetCheckerIPRangesRequest) (resp *GetC
 	return
 }
 
-// GetGeoLocation to retrieve a single geo location, send a request to the
-// 2013-04-01/geolocation resource with one of these options: continentcode
-// | countrycode | countrycode and subdivisioncode.
-func (c *Route53) GetGeoLocation(req *GetGeoLocationRequest) (resp *GetGeoLocationResponse, err error) {
-	resp = &GetGeoLocationResponse{}
-
+// newGetGeoLocationRequest builds the HTTP request for GetGeoLocation.
+func (c *Route53) newGetGeoLocationRequest(ctx context.Context, req *GetGeoLocationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -769,10 +1328,14 @@ func (c *Route53) GetGeoLocation(req *GetGeoLocationRequest) (resp *GetGeoLocati
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -781,7 +1344,27 @@ func (c *Route53) GetGeoLocation(req *GetGeoLocationRequest) (resp *GetGeoLocati
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetHealthCheck to retrieve the health check, send a request to the
+// 2013-04-01/healthcheck/ health check resource.
+func (c *Route53) GetHealthCheck(req *GetHealthCheckRequest) (resp *GetHealthCheckResponse, err error) {
+	return c.GetHealthCheckWithContext(context.Background(), req)
+}
+
+// GetHealthCheckWithContext is like GetHealthCheck, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetHealthCheckWithContext(ctx context.Context, req *GetHealthCheckRequest) (resp *GetHealthCheckResponse, err error) {
+	resp = &GetHealthCheckResponse{}
+
+	httpReq, err := c.newGetHealthCheckRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetHealthCheck", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -795,28 +1378,29 @@ func (c *Route53) GetGeoLocation(req *GetGeoLocationRequest) (resp *GetGeoLocati
 	return
 }
 
-// GetHealthCheck to retrieve the health check, send a request to the
-// 2013-04-01/healthcheck/ health check resource.
-func (c *Route53) GetHealthCheck(req *GetHealthCheckRequest) (resp *GetHealthCheckResponse, err error) {
-	resp = &GetHealthCheckResponse{}
-
+// newGetHealthCheckRequest builds the HTTP request for GetHealthCheck.
+func (c *Route53) newGetHealthCheckRequest(ctx context.Context, req *GetHealthCheckRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/healthcheck/{HealthCheckId}"
 
 	if req.HealthCheckID != nil {
-		uri = strings.Replace(uri, "{"+"HealthCheckId"+"}", *req.HealthCheckID, -1)
-		uri = strings.Replace(uri, "{"+"HealthCheckId+"+"}", *req.HealthCheckID, -1)
+		uri = strings.Replace(uri, "{HealthCheckId}", aws.EscapePath(*req.HealthCheckID, false), -1)
+		uri = strings.Replace(uri, "{HealthCheckId+}", aws.EscapePath(*req.HealthCheckID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -825,7 +1409,27 @@ func (c *Route53) GetHealthCheck(req *GetHealthCheckRequest) (resp *GetHealthChe
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetHealthCheckCount to retrieve a count of all your health checks, send
+// a request to the 2013-04-01/healthcheckcount resource.
+func (c *Route53) GetHealthCheckCount(req *GetHealthCheckCountRequest) (resp *GetHealthCheckCountResponse, err error) {
+	return c.GetHealthCheckCountWithContext(context.Background(), req)
+}
+
+// GetHealthCheckCountWithContext is like GetHealthCheckCount, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetHealthCheckCountWithContext(ctx context.Context, req *GetHealthCheckCountRequest) (resp *GetHealthCheckCountResponse, err error) {
+	resp = &GetHealthCheckCountResponse{}
+
+	httpReq, err := c.newGetHealthCheckCountRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetHealthCheckCount", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -839,11 +1443,8 @@ func (c *Route53) GetHealthCheck(req *GetHealthCheckRequest) (resp *GetHealthChe
 	return
 }
 
-// GetHealthCheckCount to retrieve a count of all your health checks, send
-// a request to the 2013-04-01/healthcheckcount resource.
-func (c *Route53) GetHealthCheckCount(req *GetHealthCheckCountRequest) (resp *GetHealthCheckCountResponse, err error) {
-	resp = &GetHealthCheckCountResponse{}
-
+// newGetHealthCheckCountRequest builds the HTTP request for GetHealthCheckCount.
+func (c *Route53) newGetHealthCheckCountRequest(ctx context.Context, req *GetHealthCheckCountRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -852,10 +1453,14 @@ func (c *Route53) GetHealthCheckCount(req *GetHealthCheckCountRequest) (resp *Ge
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -864,7 +1469,29 @@ func (c *Route53) GetHealthCheckCount(req *GetHealthCheckCountRequest) (resp *Ge
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetHealthCheckLastFailureReason if you want to learn why a health check
+// is currently failing or why it failed most recently (if at all), you can
+// get the failure reason for the most recent failure. Send a request to
+// the 2013-04-01/healthcheck/ health check /lastfailurereason resource.
+func (c *Route53) GetHealthCheckLastFailureReason(req *GetHealthCheckLastFailureReasonRequest) (resp *GetHealthCheckLastFailureReasonResponse, err error) {
+	return c.GetHealthCheckLastFailureReasonWithContext(context.Background(), req)
+}
+
+// GetHealthCheckLastFailureReasonWithContext is like GetHealthCheckLastFailureReason, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetHealthCheckLastFailureReasonWithContext(ctx context.Context, req *GetHealthCheckLastFailureReasonRequest) (resp *GetHealthCheckLastFailureReasonResponse, err error) {
+	resp = &GetHealthCheckLastFailureReasonResponse{}
+
+	httpReq, err := c.newGetHealthCheckLastFailureReasonRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetHealthCheckLastFailureReason", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -878,30 +1505,29 @@ func (c *Route53) GetHealthCheckCount(req *GetHealthCheckCountRequest) (resp *Ge
 	return
 }
 
-// GetHealthCheckLastFailureReason if you want to learn why a health check
-// is currently failing or why it failed most recently (if at all), you can
-// get the failure reason for the most recent failure. Send a request to
-// the 2013-04-01/healthcheck/ health check /lastfailurereason resource.
-func (c *Route53) GetHealthCheckLastFailureReason(req *GetHealthCheckLastFailureReasonRequest) (resp *GetHealthCheckLastFailureReasonResponse, err error) {
-	resp = &GetHealthCheckLastFailureReasonResponse{}
-
+// newGetHealthCheckLastFailureReasonRequest builds the HTTP request for GetHealthCheckLastFailureReason.
+func (c *Route53) newGetHealthCheckLastFailureReasonRequest(ctx context.Context, req *GetHealthCheckLastFailureReasonRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/healthcheck/{HealthCheckId}/lastfailurereason"
 
 	if req.HealthCheckID != nil {
-		uri = strings.Replace(uri, "{"+"HealthCheckId"+"}", *req.HealthCheckID, -1)
-		uri = strings.Replace(uri, "{"+"HealthCheckId+"+"}", *req.HealthCheckID, -1)
+		uri = strings.Replace(uri, "{HealthCheckId}", aws.EscapePath(*req.HealthCheckID, false), -1)
+		uri = strings.Replace(uri, "{HealthCheckId+}", aws.EscapePath(*req.HealthCheckID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -910,7 +1536,28 @@ func (c *Route53) GetHealthCheckLastFailureReason(req *GetHealthCheckLastFailure
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetHealthCheckStatus to retrieve the health check status, send a request
+// to the 2013-04-01/healthcheck/ health check /status resource. You can
+// use this call to get a health check's current status.
+func (c *Route53) GetHealthCheckStatus(req *GetHealthCheckStatusRequest) (resp *GetHealthCheckStatusResponse, err error) {
+	return c.GetHealthCheckStatusWithContext(context.Background(), req)
+}
+
+// GetHealthCheckStatusWithContext is like GetHealthCheckStatus, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetHealthCheckStatusWithContext(ctx context.Context, req *GetHealthCheckStatusRequest) (resp *GetHealthCheckStatusResponse, err error) {
+	resp = &GetHealthCheckStatusResponse{}
+
+	httpReq, err := c.newGetHealthCheckStatusRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetHealthCheckStatus", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -924,29 +1571,29 @@ func (c *Route53) GetHealthCheckLastFailureReason(req *GetHealthCheckLastFailure
 	return
 }
 
-// GetHealthCheckStatus to retrieve the health check status, send a request
-// to the 2013-04-01/healthcheck/ health check /status resource. You can
-// use this call to get a health check's current status.
-func (c *Route53) GetHealthCheckStatus(req *GetHealthCheckStatusRequest) (resp *GetHealthCheckStatusResponse, err error) {
-	resp = &GetHealthCheckStatusResponse{}
-
+// newGetHealthCheckStatusRequest builds the HTTP request for GetHealthCheckStatus.
+func (c *Route53) newGetHealthCheckStatusRequest(ctx context.Context, req *GetHealthCheckStatusRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/healthcheck/{HealthCheckId}/status"
 
 	if req.HealthCheckID != nil {
-		uri = strings.Replace(uri, "{"+"HealthCheckId"+"}", *req.HealthCheckID, -1)
-		uri = strings.Replace(uri, "{"+"HealthCheckId+"+"}", *req.HealthCheckID, -1)
+		uri = strings.Replace(uri, "{HealthCheckId}", aws.EscapePath(*req.HealthCheckID, false), -1)
+		uri = strings.Replace(uri, "{HealthCheckId+}", aws.EscapePath(*req.HealthCheckID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -955,7 +1602,29 @@ func (c *Route53) GetHealthCheckStatus(req *GetHealthCheckStatusRequest) (resp *
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetHostedZone to retrieve the delegation set for a hosted zone, send a
+// request to the 2013-04-01/hostedzone/ hosted zone resource. The
+// delegation set is the four Route 53 name servers that were assigned to
+// the hosted zone when you created it.
+func (c *Route53) GetHostedZone(req *GetHostedZoneRequest) (resp *GetHostedZoneResponse, err error) {
+	return c.GetHostedZoneWithContext(context.Background(), req)
+}
+
+// GetHostedZoneWithContext is like GetHostedZone, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetHostedZoneWithContext(ctx context.Context, req *GetHostedZoneRequest) (resp *GetHostedZoneResponse, err error) {
+	resp = &GetHostedZoneResponse{}
+
+	httpReq, err := c.newGetHostedZoneRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetHostedZone", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -969,30 +1638,29 @@ func (c *Route53) GetHealthCheckStatus(req *GetHealthCheckStatusRequest) (resp *
 	return
 }
 
-// GetHostedZone to retrieve the delegation set for a hosted zone, send a
-// request to the 2013-04-01/hostedzone/ hosted zone resource. The
-// delegation set is the four Route 53 name servers that were assigned to
-// the hosted zone when you created it.
-func (c *Route53) GetHostedZone(req *GetHostedZoneRequest) (resp *GetHostedZoneResponse, err error) {
-	resp = &GetHostedZoneResponse{}
-
+// newGetHostedZoneRequest builds the HTTP request for GetHostedZone.
+func (c *Route53) newGetHostedZoneRequest(ctx context.Context, req *GetHostedZoneRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/hostedzone/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1001,7 +1669,27 @@ func (c *Route53) GetHostedZone(req *GetHostedZoneRequest) (resp *GetHostedZoneR
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetReusableDelegationSet to retrieve the reusable delegation set, send a
+// request to the 2013-04-01/delegationset/ delegation set resource.
+func (c *Route53) GetReusableDelegationSet(req *GetReusableDelegationSetRequest) (resp *GetReusableDelegationSetResponse, err error) {
+	return c.GetReusableDelegationSetWithContext(context.Background(), req)
+}
+
+// GetReusableDelegationSetWithContext is like GetReusableDelegationSet, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) GetReusableDelegationSetWithContext(ctx context.Context, req *GetReusableDelegationSetRequest) (resp *GetReusableDelegationSetResponse, err error) {
+	resp = &GetReusableDelegationSetResponse{}
+
+	httpReq, err := c.newGetReusableDelegationSetRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetReusableDelegationSet", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1015,28 +1703,29 @@ func (c *Route53) GetHostedZone(req *GetHostedZoneRequest) (resp *GetHostedZoneR
 	return
 }
 
-// GetReusableDelegationSet to retrieve the reusable delegation set, send a
-// request to the 2013-04-01/delegationset/ delegation set resource.
-func (c *Route53) GetReusableDelegationSet(req *GetReusableDelegationSetRequest) (resp *GetReusableDelegationSetResponse, err error) {
-	resp = &GetReusableDelegationSetResponse{}
-
+// newGetReusableDelegationSetRequest builds the HTTP request for GetReusableDelegationSet.
+func (c *Route53) newGetReusableDelegationSetRequest(ctx context.Context, req *GetReusableDelegationSetRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/delegationset/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1045,17 +1734,6 @@ func (c *Route53) GetReusableDelegationSet(req *GetReusableDelegationSetRequest)
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -1072,8 +1750,36 @@ func (c *Route53) GetReusableDelegationSet(req *GetReusableDelegationSetRequest)
 // to StartContinentCode, StartCountryCode, StartSubdivisionCode to control
 // the geo location that the list begins with.
 func (c *Route53) ListGeoLocations(req *ListGeoLocationsRequest) (resp *ListGeoLocationsResponse, err error) {
+	return c.ListGeoLocationsWithContext(context.Background(), req)
+}
+
+// ListGeoLocationsWithContext is like ListGeoLocations, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ListGeoLocationsWithContext(ctx context.Context, req *ListGeoLocationsRequest) (resp *ListGeoLocationsResponse, err error) {
 	resp = &ListGeoLocationsResponse{}
 
+	httpReq, err := c.newListGeoLocationsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListGeoLocations", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newListGeoLocationsRequest builds the HTTP request for ListGeoLocations.
+func (c *Route53) newListGeoLocationsRequest(ctx context.Context, req *ListGeoLocationsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1098,10 +1804,14 @@ func (c *Route53) ListGeoLocations(req *ListGeoLocationsRequest) (resp *ListGeoL
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1110,17 +1820,6 @@ func (c *Route53) ListGeoLocations(req *ListGeoLocationsRequest) (resp *ListGeoL
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -1134,8 +1833,36 @@ func (c *Route53) ListGeoLocations(req *ListGeoLocationsRequest) (resp *ListGeoL
 // Route 53 returns a maximum of 100 items. If you set MaxItems to a value
 // greater than 100, Amazon Route 53 returns only the first 100.
 func (c *Route53) ListHealthChecks(req *ListHealthChecksRequest) (resp *ListHealthChecksResponse, err error) {
+	return c.ListHealthChecksWithContext(context.Background(), req)
+}
+
+// ListHealthChecksWithContext is like ListHealthChecks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ListHealthChecksWithContext(ctx context.Context, req *ListHealthChecksRequest) (resp *ListHealthChecksResponse, err error) {
 	resp = &ListHealthChecksResponse{}
 
+	httpReq, err := c.newListHealthChecksRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListHealthChecks", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newListHealthChecksRequest builds the HTTP request for ListHealthChecks.
+func (c *Route53) newListHealthChecksRequest(ctx context.Context, req *ListHealthChecksRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1152,10 +1879,14 @@ func (c *Route53) ListHealthChecks(req *ListHealthChecksRequest) (resp *ListHeal
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1164,17 +1895,6 @@ func (c *Route53) ListHealthChecks(req *ListHealthChecksRequest) (resp *ListHeal
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -1188,8 +1908,36 @@ func (c *Route53) ListHealthChecks(req *ListHealthChecksRequest) (resp *ListHeal
 // returns a maximum of 100 items. If you set MaxItems to a value greater
 // than 100, Amazon Route 53 returns only the first 100.
 func (c *Route53) ListHostedZones(req *ListHostedZonesRequest) (resp *ListHostedZonesResponse, err error) {
+	return c.ListHostedZonesWithContext(context.Background(), req)
+}
+
+// ListHostedZonesWithContext is like ListHostedZones, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ListHostedZonesWithContext(ctx context.Context, req *ListHostedZonesRequest) (resp *ListHostedZonesResponse, err error) {
 	resp = &ListHostedZonesResponse{}
 
+	httpReq, err := c.newListHostedZonesRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListHostedZones", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newListHostedZonesRequest builds the HTTP request for ListHostedZones.
+func (c *Route53) newListHostedZonesRequest(ctx context.Context, req *ListHostedZonesRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1210,10 +1958,14 @@ func (c *Route53) ListHostedZones(req *ListHostedZonesRequest) (resp *ListHosted
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1222,17 +1974,6 @@ func (c *Route53) ListHostedZones(req *ListHostedZonesRequest) (resp *ListHosted
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -1271,16 +2012,44 @@ func (c *Route53) ListHostedZones(req *ListHostedZonesRequest) (resp *ListHosted
 // effects of that change will be visible in a subsequent call to
 // ListResourceRecordSets by that process.
 func (c *Route53) ListResourceRecordSets(req *ListResourceRecordSetsRequest) (resp *ListResourceRecordSetsResponse, err error) {
+	return c.ListResourceRecordSetsWithContext(context.Background(), req)
+}
+
+// ListResourceRecordSetsWithContext is like ListResourceRecordSets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ListResourceRecordSetsWithContext(ctx context.Context, req *ListResourceRecordSetsRequest) (resp *ListResourceRecordSetsResponse, err error) {
 	resp = &ListResourceRecordSetsResponse{}
 
+	httpReq, err := c.newListResourceRecordSetsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListResourceRecordSets", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newListResourceRecordSetsRequest builds the HTTP request for ListResourceRecordSets.
+func (c *Route53) newListResourceRecordSetsRequest(ctx context.Context, req *ListResourceRecordSetsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/hostedzone/{Id}/rrset"
 
 	if req.HostedZoneID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.HostedZoneID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.HostedZoneID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.HostedZoneID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.HostedZoneID, true), -1)
 	}
 
 	q := url.Values{}
@@ -1302,10 +2071,14 @@ func (c *Route53) ListResourceRecordSets(req *ListResourceRecordSetsRequest) (re
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1314,17 +2087,6 @@ func (c *Route53) ListResourceRecordSets(req *ListResourceRecordSetsRequest) (re
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -1339,8 +2101,36 @@ func (c *Route53) ListResourceRecordSets(req *ListResourceRecordSetsRequest) (re
 // items. If you set MaxItems to a value greater than 100, Amazon Route 53
 // returns only the first 100.
 func (c *Route53) ListReusableDelegationSets(req *ListReusableDelegationSetsRequest) (resp *ListReusableDelegationSetsResponse, err error) {
+	return c.ListReusableDelegationSetsWithContext(context.Background(), req)
+}
+
+// ListReusableDelegationSetsWithContext is like ListReusableDelegationSets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ListReusableDelegationSetsWithContext(ctx context.Context, req *ListReusableDelegationSetsRequest) (resp *ListReusableDelegationSetsResponse, err error) {
 	resp = &ListReusableDelegationSetsResponse{}
 
+	httpReq, err := c.newListReusableDelegationSetsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListReusableDelegationSets", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newListReusableDelegationSetsRequest builds the HTTP request for ListReusableDelegationSets.
+func (c *Route53) newListReusableDelegationSetsRequest(ctx context.Context, req *ListReusableDelegationSetsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1357,10 +2147,14 @@ func (c *Route53) ListReusableDelegationSets(req *ListReusableDelegationSetsRequ
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1369,7 +2163,26 @@ func (c *Route53) ListReusableDelegationSets(req *ListReusableDelegationSetsRequ
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListTagsForResource <nil>
+func (c *Route53) ListTagsForResource(req *ListTagsForResourceRequest) (resp *ListTagsForResourceResponse, err error) {
+	return c.ListTagsForResourceWithContext(context.Background(), req)
+}
+
+// ListTagsForResourceWithContext is like ListTagsForResource, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ListTagsForResourceWithContext(ctx context.Context, req *ListTagsForResourceRequest) (resp *ListTagsForResourceResponse, err error) {
+	resp = &ListTagsForResourceResponse{}
+
+	httpReq, err := c.newListTagsForResourceRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListTagsForResource", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1383,32 +2196,34 @@ func (c *Route53) ListReusableDelegationSets(req *ListReusableDelegationSetsRequ
 	return
 }
 
-// ListTagsForResource <nil>
-func (c *Route53) ListTagsForResource(req *ListTagsForResourceRequest) (resp *ListTagsForResourceResponse, err error) {
-	resp = &ListTagsForResourceResponse{}
-
+// newListTagsForResourceRequest builds the HTTP request for ListTagsForResource.
+func (c *Route53) newListTagsForResourceRequest(ctx context.Context, req *ListTagsForResourceRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/2013-04-01/tags/{ResourceType}/{ResourceId}"
 
 	if req.ResourceID != nil {
-		uri = strings.Replace(uri, "{"+"ResourceId"+"}", *req.ResourceID, -1)
-		uri = strings.Replace(uri, "{"+"ResourceId+"+"}", *req.ResourceID, -1)
+		uri = strings.Replace(uri, "{ResourceId}", aws.EscapePath(*req.ResourceID, false), -1)
+		uri = strings.Replace(uri, "{ResourceId+}", aws.EscapePath(*req.ResourceID, true), -1)
 	}
 
 	if req.ResourceType != nil {
-		uri = strings.Replace(uri, "{"+"ResourceType"+"}", *req.ResourceType, -1)
-		uri = strings.Replace(uri, "{"+"ResourceType+"+"}", *req.ResourceType, -1)
+		uri = strings.Replace(uri, "{ResourceType}", aws.EscapePath(*req.ResourceType, false), -1)
+		uri = strings.Replace(uri, "{ResourceType+}", aws.EscapePath(*req.ResourceType, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1417,7 +2232,26 @@ func (c *Route53) ListTagsForResource(req *ListTagsForResourceRequest) (resp *Li
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListTagsForResources <nil>
+func (c *Route53) ListTagsForResources(req *ListTagsForResourcesRequest) (resp *ListTagsForResourcesResponse, err error) {
+	return c.ListTagsForResourcesWithContext(context.Background(), req)
+}
+
+// ListTagsForResourcesWithContext is like ListTagsForResources, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) ListTagsForResourcesWithContext(ctx context.Context, req *ListTagsForResourcesRequest) (resp *ListTagsForResourcesResponse, err error) {
+	resp = &ListTagsForResourcesResponse{}
+
+	httpReq, err := c.newListTagsForResourcesRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListTagsForResources", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1431,10 +2265,8 @@ func (c *Route53) ListTagsForResource(req *ListTagsForResourceRequest) (resp *Li
 	return
 }
 
-// ListTagsForResources <nil>
-func (c *Route53) ListTagsForResources(req *ListTagsForResourcesRequest) (resp *ListTagsForResourcesResponse, err error) {
-	resp = &ListTagsForResourcesResponse{}
-
+// newListTagsForResourcesRequest builds the HTTP request for ListTagsForResources.
+func (c *Route53) newListTagsForResourcesRequest(ctx context.Context, req *ListTagsForResourcesRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1452,17 +2284,21 @@ func (c *Route53) ListTagsForResources(req *ListTagsForResourcesRequest) (resp *
 	uri := c.client.Endpoint + "/2013-04-01/tags/{ResourceType}"
 
 	if req.ResourceType != nil {
-		uri = strings.Replace(uri, "{"+"ResourceType"+"}", *req.ResourceType, -1)
-		uri = strings.Replace(uri, "{"+"ResourceType+"+"}", *req.ResourceType, -1)
+		uri = strings.Replace(uri, "{ResourceType}", aws.EscapePath(*req.ResourceType, false), -1)
+		uri = strings.Replace(uri, "{ResourceType+}", aws.EscapePath(*req.ResourceType, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -1471,7 +2307,31 @@ func (c *Route53) ListTagsForResources(req *ListTagsForResourcesRequest) (resp *
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdateHealthCheck this action updates an existing health check. To
+// update a health check, send a request to the 2013-04-01/healthcheck/
+// health check resource. The request body must include an XML document
+// with an UpdateHealthCheckRequest element. The response returns an
+// UpdateHealthCheckResponse element, which contains metadata about the
+// health check.
+func (c *Route53) UpdateHealthCheck(req *UpdateHealthCheckRequest) (resp *UpdateHealthCheckResponse, err error) {
+	return c.UpdateHealthCheckWithContext(context.Background(), req)
+}
+
+// UpdateHealthCheckWithContext is like UpdateHealthCheck, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) UpdateHealthCheckWithContext(ctx context.Context, req *UpdateHealthCheckRequest) (resp *UpdateHealthCheckResponse, err error) {
+	resp = &UpdateHealthCheckResponse{}
+
+	httpReq, err := c.newUpdateHealthCheckRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdateHealthCheck", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1485,15 +2345,8 @@ func (c *Route53) ListTagsForResources(req *ListTagsForResourcesRequest) (resp *
 	return
 }
 
-// UpdateHealthCheck this action updates an existing health check. To
-// update a health check, send a request to the 2013-04-01/healthcheck/
-// health check resource. The request body must include an XML document
-// with an UpdateHealthCheckRequest element. The response returns an
-// UpdateHealthCheckResponse element, which contains metadata about the
-// health check.
-func (c *Route53) UpdateHealthCheck(req *UpdateHealthCheckRequest) (resp *UpdateHealthCheckResponse, err error) {
-	resp = &UpdateHealthCheckResponse{}
-
+// newUpdateHealthCheckRequest builds the HTTP request for UpdateHealthCheck.
+func (c *Route53) newUpdateHealthCheckRequest(ctx context.Context, req *UpdateHealthCheckRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1511,17 +2364,21 @@ func (c *Route53) UpdateHealthCheck(req *UpdateHealthCheckRequest) (resp *Update
 	uri := c.client.Endpoint + "/2013-04-01/healthcheck/{HealthCheckId}"
 
 	if req.HealthCheckID != nil {
-		uri = strings.Replace(uri, "{"+"HealthCheckId"+"}", *req.HealthCheckID, -1)
-		uri = strings.Replace(uri, "{"+"HealthCheckId+"+"}", *req.HealthCheckID, -1)
+		uri = strings.Replace(uri, "{HealthCheckId}", aws.EscapePath(*req.HealthCheckID, false), -1)
+		uri = strings.Replace(uri, "{HealthCheckId+}", aws.EscapePath(*req.HealthCheckID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -1530,7 +2387,30 @@ func (c *Route53) UpdateHealthCheck(req *UpdateHealthCheckRequest) (resp *Update
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UpdateHostedZoneComment to update the hosted zone comment, send a
+// request to the 2013-04-01/hostedzone/ hosted zone resource. The request
+// body must include an XML document with a UpdateHostedZoneCommentRequest
+// element. The response to this request includes the modified HostedZone
+// element. The comment can have a maximum length of 256 characters.
+func (c *Route53) UpdateHostedZoneComment(req *UpdateHostedZoneCommentRequest) (resp *UpdateHostedZoneCommentResponse, err error) {
+	return c.UpdateHostedZoneCommentWithContext(context.Background(), req)
+}
+
+// UpdateHostedZoneCommentWithContext is like UpdateHostedZoneComment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53) UpdateHostedZoneCommentWithContext(ctx context.Context, req *UpdateHostedZoneCommentRequest) (resp *UpdateHostedZoneCommentResponse, err error) {
+	resp = &UpdateHostedZoneCommentResponse{}
+
+	httpReq, err := c.newUpdateHostedZoneCommentRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UpdateHostedZoneComment", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1544,14 +2424,8 @@ func (c *Route53) UpdateHealthCheck(req *UpdateHealthCheckRequest) (resp *Update
 	return
 }
 
-// UpdateHostedZoneComment to update the hosted zone comment, send a
-// request to the 2013-04-01/hostedzone/ hosted zone resource. The request
-// body must include an XML document with a UpdateHostedZoneCommentRequest
-// element. The response to this request includes the modified HostedZone
-// element. The comment can have a maximum length of 256 characters.
-func (c *Route53) UpdateHostedZoneComment(req *UpdateHostedZoneCommentRequest) (resp *UpdateHostedZoneCommentResponse, err error) {
-	resp = &UpdateHostedZoneCommentResponse{}
-
+// newUpdateHostedZoneCommentRequest builds the HTTP request for UpdateHostedZoneComment.
+func (c *Route53) newUpdateHostedZoneCommentRequest(ctx context.Context, req *UpdateHostedZoneCommentRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -1569,17 +2443,21 @@ func (c *Route53) UpdateHostedZoneComment(req *UpdateHostedZoneCommentRequest) (
 	uri := c.client.Endpoint + "/2013-04-01/hostedzone/{Id}"
 
 	if req.ID != nil {
-		uri = strings.Replace(uri, "{"+"Id"+"}", *req.ID, -1)
-		uri = strings.Replace(uri, "{"+"Id+"+"}", *req.ID, -1)
+		uri = strings.Replace(uri, "{Id}", aws.EscapePath(*req.ID, false), -1)
+		uri = strings.Replace(uri, "{Id+}", aws.EscapePath(*req.ID, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -1588,17 +2466,6 @@ func (c *Route53) UpdateHostedZoneComment(req *UpdateHostedZoneCommentRequest) (
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
@@ -2596,6 +3463,198 @@ const (
 	VPCRegionUsWest2      = "us-west-2"
 )
 
+// ConflictingDomainExists is the error returned for the ConflictingDomainExists error code.
+type ConflictingDomainExists struct {
+	aws.APIError
+}
+
+// DelegationSetAlreadyCreated is the error returned for the DelegationSetAlreadyCreated error code.
+type DelegationSetAlreadyCreated struct {
+	aws.APIError
+}
+
+// DelegationSetAlreadyReusable is the error returned for the DelegationSetAlreadyReusable error code.
+type DelegationSetAlreadyReusable struct {
+	aws.APIError
+}
+
+// DelegationSetInUse is the error returned for the DelegationSetInUse error code.
+type DelegationSetInUse struct {
+	aws.APIError
+}
+
+// DelegationSetNotAvailable is the error returned for the DelegationSetNotAvailable error code.
+type DelegationSetNotAvailable struct {
+	aws.APIError
+}
+
+// DelegationSetNotReusable is the error returned for the DelegationSetNotReusable error code.
+type DelegationSetNotReusable struct {
+	aws.APIError
+}
+
+// HealthCheckAlreadyExists is the error returned for the HealthCheckAlreadyExists error code.
+type HealthCheckAlreadyExists struct {
+	aws.APIError
+}
+
+// HealthCheckInUse is the error returned for the HealthCheckInUse error code.
+type HealthCheckInUse struct {
+	aws.APIError
+}
+
+// HealthCheckVersionMismatch is the error returned for the HealthCheckVersionMismatch error code.
+type HealthCheckVersionMismatch struct {
+	aws.APIError
+}
+
+// HostedZoneAlreadyExists is the error returned for the HostedZoneAlreadyExists error code.
+type HostedZoneAlreadyExists struct {
+	aws.APIError
+}
+
+// HostedZoneNotEmpty is the error returned for the HostedZoneNotEmpty error code.
+type HostedZoneNotEmpty struct {
+	aws.APIError
+}
+
+// HostedZoneNotFound is the error returned for the HostedZoneNotFound error code.
+type HostedZoneNotFound struct {
+	aws.APIError
+}
+
+// IncompatibleVersion is the error returned for the IncompatibleVersion error code.
+type IncompatibleVersion struct {
+	aws.APIError
+}
+
+// InvalidArgument is the error returned for the InvalidArgument error code.
+type InvalidArgument struct {
+	aws.APIError
+}
+
+// InvalidChangeBatch is the error returned for the InvalidChangeBatch error code.
+type InvalidChangeBatch struct {
+	aws.APIError
+
+	Messages []string `xml:"messages>Message,omitempty"`
+}
+
+// InvalidDomainName is the error returned for the InvalidDomainName error code.
+type InvalidDomainName struct {
+	aws.APIError
+}
+
+// InvalidInput is the error returned for the InvalidInput error code.
+type InvalidInput struct {
+	aws.APIError
+}
+
+// InvalidVPCID is the error returned for the InvalidVPCId error code.
+type InvalidVPCID struct {
+	aws.APIError
+}
+
+// LastVPCAssociation is the error returned for the LastVPCAssociation error code.
+type LastVPCAssociation struct {
+	aws.APIError
+}
+
+// LimitsExceeded is the error returned for the LimitsExceeded error code.
+type LimitsExceeded struct {
+	aws.APIError
+}
+
+// NoSuchChange is the error returned for the NoSuchChange error code.
+type NoSuchChange struct {
+	aws.APIError
+}
+
+// NoSuchDelegationSet is the error returned for the NoSuchDelegationSet error code.
+type NoSuchDelegationSet struct {
+	aws.APIError
+}
+
+// NoSuchGeoLocation is the error returned for the NoSuchGeoLocation error code.
+type NoSuchGeoLocation struct {
+	aws.APIError
+}
+
+// NoSuchHealthCheck is the error returned for the NoSuchHealthCheck error code.
+type NoSuchHealthCheck struct {
+	aws.APIError
+}
+
+// NoSuchHostedZone is the error returned for the NoSuchHostedZone error code.
+type NoSuchHostedZone struct {
+	aws.APIError
+}
+
+// PriorRequestNotComplete is the error returned for the PriorRequestNotComplete error code.
+type PriorRequestNotComplete struct {
+	aws.APIError
+}
+
+// PublicZoneVPCAssociation is the error returned for the PublicZoneVPCAssociation error code.
+type PublicZoneVPCAssociation struct {
+	aws.APIError
+}
+
+// ThrottlingException is the error returned for the ThrottlingException error code.
+type ThrottlingException struct {
+	aws.APIError
+}
+
+// TooManyHealthChecks is the error returned for the TooManyHealthChecks error code.
+type TooManyHealthChecks struct {
+	aws.APIError
+}
+
+// TooManyHostedZones is the error returned for the TooManyHostedZones error code.
+type TooManyHostedZones struct {
+	aws.APIError
+}
+
+// VPCAssociationNotFound is the error returned for the VPCAssociationNotFound error code.
+type VPCAssociationNotFound struct {
+	aws.APIError
+}
+
+// exceptions maps Route53's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"ConflictingDomainExists":      ConflictingDomainExists{},
+	"DelegationSetAlreadyCreated":  DelegationSetAlreadyCreated{},
+	"DelegationSetAlreadyReusable": DelegationSetAlreadyReusable{},
+	"DelegationSetInUse":           DelegationSetInUse{},
+	"DelegationSetNotAvailable":    DelegationSetNotAvailable{},
+	"DelegationSetNotReusable":     DelegationSetNotReusable{},
+	"HealthCheckAlreadyExists":     HealthCheckAlreadyExists{},
+	"HealthCheckInUse":             HealthCheckInUse{},
+	"HealthCheckVersionMismatch":   HealthCheckVersionMismatch{},
+	"HostedZoneAlreadyExists":      HostedZoneAlreadyExists{},
+	"HostedZoneNotEmpty":           HostedZoneNotEmpty{},
+	"HostedZoneNotFound":           HostedZoneNotFound{},
+	"IncompatibleVersion":          IncompatibleVersion{},
+	"InvalidArgument":              InvalidArgument{},
+	"InvalidChangeBatch":           InvalidChangeBatch{},
+	"InvalidDomainName":            InvalidDomainName{},
+	"InvalidInput":                 InvalidInput{},
+	"InvalidVPCId":                 InvalidVPCID{},
+	"LastVPCAssociation":           LastVPCAssociation{},
+	"LimitsExceeded":               LimitsExceeded{},
+	"NoSuchChange":                 NoSuchChange{},
+	"NoSuchDelegationSet":          NoSuchDelegationSet{},
+	"NoSuchGeoLocation":            NoSuchGeoLocation{},
+	"NoSuchHealthCheck":            NoSuchHealthCheck{},
+	"NoSuchHostedZone":             NoSuchHostedZone{},
+	"PriorRequestNotComplete":      PriorRequestNotComplete{},
+	"PublicZoneVPCAssociation":     PublicZoneVPCAssociation{},
+	"ThrottlingException":          ThrottlingException{},
+	"TooManyHealthChecks":          TooManyHealthChecks{},
+	"TooManyHostedZones":           TooManyHostedZones{},
+	"VPCAssociationNotFound":       VPCAssociationNotFound{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
 
@@ -2605,3 +3664,4 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
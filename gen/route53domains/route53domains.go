@@ -4,11 +4,18 @@
 package route53domains
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // Route53Domains is a client for Amazon Route 53 Domains.
@@ -16,44 +23,93 @@ type Route53Domains struct {
 	client *aws.JSONClient
 }
 
-// New returns a new Route53Domains client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *Route53Domains {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "route53domains"
-	endpoint, service, region := endpoints.Lookup("route53domains", region)
+// New returns a new Route53Domains client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *Route53Domains {
+	cfg := newConfig(region, client, opts)
 
 	return &Route53Domains{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "Route53Domains_v20140515",
 		},
 	}
 }
 
+// NewFromSession returns a new Route53Domains client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *Route53Domains {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("route53domains", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("route53domains", "route53domains"),
+	}, "AWS_ENDPOINT_URL_ROUTE_53_DOMAINS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *Route53Domains) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *Route53Domains) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // CheckDomainAvailability this operation checks the availability of one
 // domain name. You can access this API without authenticating. Note that
 // if the availability status of a domain is pending, you must submit
 // another request to determine the availability of the domain name.
 func (c *Route53Domains) CheckDomainAvailability(req *CheckDomainAvailabilityRequest) (resp *CheckDomainAvailabilityResponse, err error) {
+	return c.CheckDomainAvailabilityWithContext(context.Background(), req)
+}
+
+// CheckDomainAvailabilityWithContext is like CheckDomainAvailability, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) CheckDomainAvailabilityWithContext(ctx context.Context, req *CheckDomainAvailabilityRequest) (resp *CheckDomainAvailabilityResponse, err error) {
 	resp = &CheckDomainAvailabilityResponse{}
-	err = c.client.Do("CheckDomainAvailability", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CheckDomainAvailability", "POST", "/", req, resp)
 	return
 }
 
 // DisableDomainAutoRenew this operation disables automatic renewal of
 // domain registration for the specified domain.
 func (c *Route53Domains) DisableDomainAutoRenew(req *DisableDomainAutoRenewRequest) (resp *DisableDomainAutoRenewResponse, err error) {
+	return c.DisableDomainAutoRenewWithContext(context.Background(), req)
+}
+
+// DisableDomainAutoRenewWithContext is like DisableDomainAutoRenew, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) DisableDomainAutoRenewWithContext(ctx context.Context, req *DisableDomainAutoRenewRequest) (resp *DisableDomainAutoRenewResponse, err error) {
 	resp = &DisableDomainAutoRenewResponse{}
-	err = c.client.Do("DisableDomainAutoRenew", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DisableDomainAutoRenew", "POST", "/", req, resp)
 	return
 }
 
@@ -65,8 +121,15 @@ func (c *Route53Domains) DisableDomainAutoRenew(req *DisableDomainAutoRenewReque
 // the progress and completion of the action. If the request is not
 // completed successfully, the domain registrant will be notified by email.
 func (c *Route53Domains) DisableDomainTransferLock(req *DisableDomainTransferLockRequest) (resp *DisableDomainTransferLockResponse, err error) {
+	return c.DisableDomainTransferLockWithContext(context.Background(), req)
+}
+
+// DisableDomainTransferLockWithContext is like DisableDomainTransferLock, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) DisableDomainTransferLockWithContext(ctx context.Context, req *DisableDomainTransferLockRequest) (resp *DisableDomainTransferLockResponse, err error) {
 	resp = &DisableDomainTransferLockResponse{}
-	err = c.client.Do("DisableDomainTransferLock", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DisableDomainTransferLock", "POST", "/", req, resp)
 	return
 }
 
@@ -81,8 +144,15 @@ func (c *Route53Domains) DisableDomainTransferLock(req *DisableDomainTransferLoc
 // you renew before the end of the renewal period that is listed on the
 // Gandi website so we can complete processing before the deadline.
 func (c *Route53Domains) EnableDomainAutoRenew(req *EnableDomainAutoRenewRequest) (resp *EnableDomainAutoRenewResponse, err error) {
+	return c.EnableDomainAutoRenewWithContext(context.Background(), req)
+}
+
+// EnableDomainAutoRenewWithContext is like EnableDomainAutoRenew, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) EnableDomainAutoRenewWithContext(ctx context.Context, req *EnableDomainAutoRenewRequest) (resp *EnableDomainAutoRenewResponse, err error) {
 	resp = &EnableDomainAutoRenewResponse{}
-	err = c.client.Do("EnableDomainAutoRenew", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EnableDomainAutoRenew", "POST", "/", req, resp)
 	return
 }
 
@@ -93,8 +163,15 @@ func (c *Route53Domains) EnableDomainAutoRenew(req *EnableDomainAutoRenewRequest
 // request is not completed successfully, the domain registrant will be
 // notified by email.
 func (c *Route53Domains) EnableDomainTransferLock(req *EnableDomainTransferLockRequest) (resp *EnableDomainTransferLockResponse, err error) {
+	return c.EnableDomainTransferLockWithContext(context.Background(), req)
+}
+
+// EnableDomainTransferLockWithContext is like EnableDomainTransferLock, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) EnableDomainTransferLockWithContext(ctx context.Context, req *EnableDomainTransferLockRequest) (resp *EnableDomainTransferLockResponse, err error) {
 	resp = &EnableDomainTransferLockResponse{}
-	err = c.client.Do("EnableDomainTransferLock", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "EnableDomainTransferLock", "POST", "/", req, resp)
 	return
 }
 
@@ -102,32 +179,60 @@ func (c *Route53Domains) EnableDomainTransferLock(req *EnableDomainTransferLockR
 // domain. The domain's contact information is also returned as part of the
 // output.
 func (c *Route53Domains) GetDomainDetail(req *GetDomainDetailRequest) (resp *GetDomainDetailResponse, err error) {
+	return c.GetDomainDetailWithContext(context.Background(), req)
+}
+
+// GetDomainDetailWithContext is like GetDomainDetail, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) GetDomainDetailWithContext(ctx context.Context, req *GetDomainDetailRequest) (resp *GetDomainDetailResponse, err error) {
 	resp = &GetDomainDetailResponse{}
-	err = c.client.Do("GetDomainDetail", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetDomainDetail", "POST", "/", req, resp)
 	return
 }
 
 // GetOperationDetail this operation returns the current status of an
 // operation that is not completed.
 func (c *Route53Domains) GetOperationDetail(req *GetOperationDetailRequest) (resp *GetOperationDetailResponse, err error) {
+	return c.GetOperationDetailWithContext(context.Background(), req)
+}
+
+// GetOperationDetailWithContext is like GetOperationDetail, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) GetOperationDetailWithContext(ctx context.Context, req *GetOperationDetailRequest) (resp *GetOperationDetailResponse, err error) {
 	resp = &GetOperationDetailResponse{}
-	err = c.client.Do("GetOperationDetail", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetOperationDetail", "POST", "/", req, resp)
 	return
 }
 
 // ListDomains this operation returns all the domain names registered with
 // Amazon Route 53 for the current AWS account.
 func (c *Route53Domains) ListDomains(req *ListDomainsRequest) (resp *ListDomainsResponse, err error) {
+	return c.ListDomainsWithContext(context.Background(), req)
+}
+
+// ListDomainsWithContext is like ListDomains, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) ListDomainsWithContext(ctx context.Context, req *ListDomainsRequest) (resp *ListDomainsResponse, err error) {
 	resp = &ListDomainsResponse{}
-	err = c.client.Do("ListDomains", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDomains", "POST", "/", req, resp)
 	return
 }
 
 // ListOperations this operation returns the operation IDs of operations
 // that are not yet complete.
 func (c *Route53Domains) ListOperations(req *ListOperationsRequest) (resp *ListOperationsResponse, err error) {
+	return c.ListOperationsWithContext(context.Background(), req)
+}
+
+// ListOperationsWithContext is like ListOperations, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) ListOperationsWithContext(ctx context.Context, req *ListOperationsRequest) (resp *ListOperationsResponse, err error) {
 	resp = &ListOperationsResponse{}
-	err = c.client.Do("ListOperations", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListOperations", "POST", "/", req, resp)
 	return
 }
 
@@ -149,8 +254,15 @@ func (c *Route53Domains) ListOperations(req *ListOperationsRequest) (resp *ListO
 // by email. Charges your AWS account an amount based on the top-level
 // domain. For more information, see Amazon Route 53 Pricing
 func (c *Route53Domains) RegisterDomain(req *RegisterDomainRequest) (resp *RegisterDomainResponse, err error) {
+	return c.RegisterDomainWithContext(context.Background(), req)
+}
+
+// RegisterDomainWithContext is like RegisterDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) RegisterDomainWithContext(ctx context.Context, req *RegisterDomainRequest) (resp *RegisterDomainResponse, err error) {
 	resp = &RegisterDomainResponse{}
-	err = c.client.Do("RegisterDomain", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RegisterDomain", "POST", "/", req, resp)
 	return
 }
 
@@ -158,8 +270,15 @@ func (c *Route53Domains) RegisterDomain(req *RegisterDomainRequest) (resp *Regis
 // domain. To transfer a domain to another registrar, you provide this
 // value to the new registrar.
 func (c *Route53Domains) RetrieveDomainAuthCode(req *RetrieveDomainAuthCodeRequest) (resp *RetrieveDomainAuthCodeResponse, err error) {
+	return c.RetrieveDomainAuthCodeWithContext(context.Background(), req)
+}
+
+// RetrieveDomainAuthCodeWithContext is like RetrieveDomainAuthCode, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) RetrieveDomainAuthCodeWithContext(ctx context.Context, req *RetrieveDomainAuthCodeRequest) (resp *RetrieveDomainAuthCodeResponse, err error) {
 	resp = &RetrieveDomainAuthCodeResponse{}
-	err = c.client.Do("RetrieveDomainAuthCode", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RetrieveDomainAuthCode", "POST", "/", req, resp)
 	return
 }
 
@@ -181,8 +300,15 @@ func (c *Route53Domains) RetrieveDomainAuthCode(req *RetrieveDomainAuthCodeReque
 // AWS account an amount based on the top-level domain. For more
 // information, see Amazon Route 53 Pricing .
 func (c *Route53Domains) TransferDomain(req *TransferDomainRequest) (resp *TransferDomainResponse, err error) {
+	return c.TransferDomainWithContext(context.Background(), req)
+}
+
+// TransferDomainWithContext is like TransferDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) TransferDomainWithContext(ctx context.Context, req *TransferDomainRequest) (resp *TransferDomainResponse, err error) {
 	resp = &TransferDomainResponse{}
-	err = c.client.Do("TransferDomain", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "TransferDomain", "POST", "/", req, resp)
 	return
 }
 
@@ -193,8 +319,15 @@ func (c *Route53Domains) TransferDomain(req *TransferDomainRequest) (resp *Trans
 // track the progress and completion of the action. If the request is not
 // completed successfully, the domain registrant will be notified by email.
 func (c *Route53Domains) UpdateDomainContact(req *UpdateDomainContactRequest) (resp *UpdateDomainContactResponse, err error) {
+	return c.UpdateDomainContactWithContext(context.Background(), req)
+}
+
+// UpdateDomainContactWithContext is like UpdateDomainContact, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) UpdateDomainContactWithContext(ctx context.Context, req *UpdateDomainContactRequest) (resp *UpdateDomainContactResponse, err error) {
 	resp = &UpdateDomainContactResponse{}
-	err = c.client.Do("UpdateDomainContact", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateDomainContact", "POST", "/", req, resp)
 	return
 }
 
@@ -209,8 +342,15 @@ func (c *Route53Domains) UpdateDomainContact(req *UpdateDomainContactRequest) (r
 // progress and completion of the action. If the request is not completed
 // successfully, the domain registrant will be notified by email.
 func (c *Route53Domains) UpdateDomainContactPrivacy(req *UpdateDomainContactPrivacyRequest) (resp *UpdateDomainContactPrivacyResponse, err error) {
+	return c.UpdateDomainContactPrivacyWithContext(context.Background(), req)
+}
+
+// UpdateDomainContactPrivacyWithContext is like UpdateDomainContactPrivacy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) UpdateDomainContactPrivacyWithContext(ctx context.Context, req *UpdateDomainContactPrivacyRequest) (resp *UpdateDomainContactPrivacyResponse, err error) {
 	resp = &UpdateDomainContactPrivacyResponse{}
-	err = c.client.Do("UpdateDomainContactPrivacy", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateDomainContactPrivacy", "POST", "/", req, resp)
 	return
 }
 
@@ -222,8 +362,15 @@ func (c *Route53Domains) UpdateDomainContactPrivacy(req *UpdateDomainContactPriv
 // progress and completion of the action. If the request is not completed
 // successfully, the domain registrant will be notified by email.
 func (c *Route53Domains) UpdateDomainNameservers(req *UpdateDomainNameserversRequest) (resp *UpdateDomainNameserversResponse, err error) {
+	return c.UpdateDomainNameserversWithContext(context.Background(), req)
+}
+
+// UpdateDomainNameserversWithContext is like UpdateDomainNameservers, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Route53Domains) UpdateDomainNameserversWithContext(ctx context.Context, req *UpdateDomainNameserversRequest) (resp *UpdateDomainNameserversResponse, err error) {
 	resp = &UpdateDomainNameserversResponse{}
-	err = c.client.Do("UpdateDomainNameservers", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateDomainNameservers", "POST", "/", req, resp)
 	return
 }
 
@@ -233,6 +380,38 @@ type CheckDomainAvailabilityRequest struct {
 	IDNLangCode aws.StringValue `json:"IdnLangCode,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CheckDomainAvailabilityRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CheckDomainAvailabilityRequest to b.
+func (v *CheckDomainAvailabilityRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if v.IDNLangCode != nil {
+		b = append(b, "\"IdnLangCode\":"...)
+		b = aws.AppendJSONString(b, *v.IDNLangCode)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CheckDomainAvailabilityResponse is undocumented.
 type CheckDomainAvailabilityResponse struct {
 	Availability aws.StringValue `json:"Availability"`
@@ -256,6 +435,108 @@ type ContactDetail struct {
 	ZipCode          aws.StringValue `json:"ZipCode,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ContactDetail) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ContactDetail to b.
+func (v *ContactDetail) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AddressLine1 != nil {
+		b = append(b, "\"AddressLine1\":"...)
+		b = aws.AppendJSONString(b, *v.AddressLine1)
+		b = append(b, ',')
+	}
+	if v.AddressLine2 != nil {
+		b = append(b, "\"AddressLine2\":"...)
+		b = aws.AppendJSONString(b, *v.AddressLine2)
+		b = append(b, ',')
+	}
+	if v.City != nil {
+		b = append(b, "\"City\":"...)
+		b = aws.AppendJSONString(b, *v.City)
+		b = append(b, ',')
+	}
+	if v.ContactType != nil {
+		b = append(b, "\"ContactType\":"...)
+		b = aws.AppendJSONString(b, *v.ContactType)
+		b = append(b, ',')
+	}
+	if v.CountryCode != nil {
+		b = append(b, "\"CountryCode\":"...)
+		b = aws.AppendJSONString(b, *v.CountryCode)
+		b = append(b, ',')
+	}
+	if v.Email != nil {
+		b = append(b, "\"Email\":"...)
+		b = aws.AppendJSONString(b, *v.Email)
+		b = append(b, ',')
+	}
+	if len(v.ExtraParams) != 0 {
+		b = append(b, "\"ExtraParams\":"...)
+		b = append(b, '[')
+		for i1 := range v.ExtraParams {
+			b, err = v.ExtraParams[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.Fax != nil {
+		b = append(b, "\"Fax\":"...)
+		b = aws.AppendJSONString(b, *v.Fax)
+		b = append(b, ',')
+	}
+	if v.FirstName != nil {
+		b = append(b, "\"FirstName\":"...)
+		b = aws.AppendJSONString(b, *v.FirstName)
+		b = append(b, ',')
+	}
+	if v.LastName != nil {
+		b = append(b, "\"LastName\":"...)
+		b = aws.AppendJSONString(b, *v.LastName)
+		b = append(b, ',')
+	}
+	if v.OrganizationName != nil {
+		b = append(b, "\"OrganizationName\":"...)
+		b = aws.AppendJSONString(b, *v.OrganizationName)
+		b = append(b, ',')
+	}
+	if v.PhoneNumber != nil {
+		b = append(b, "\"PhoneNumber\":"...)
+		b = aws.AppendJSONString(b, *v.PhoneNumber)
+		b = append(b, ',')
+	}
+	if v.State != nil {
+		b = append(b, "\"State\":"...)
+		b = aws.AppendJSONString(b, *v.State)
+		b = append(b, ',')
+	}
+	if v.ZipCode != nil {
+		b = append(b, "\"ZipCode\":"...)
+		b = aws.AppendJSONString(b, *v.ZipCode)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for Route53Domains.
 const (
 	ContactTypeAssociation = "ASSOCIATION"
@@ -503,6 +784,33 @@ type DisableDomainAutoRenewRequest struct {
 	DomainName aws.StringValue `json:"DomainName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DisableDomainAutoRenewRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DisableDomainAutoRenewRequest to b.
+func (v *DisableDomainAutoRenewRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DisableDomainAutoRenewResponse is undocumented.
 type DisableDomainAutoRenewResponse struct {
 }
@@ -512,6 +820,33 @@ type DisableDomainTransferLockRequest struct {
 	DomainName aws.StringValue `json:"DomainName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DisableDomainTransferLockRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DisableDomainTransferLockRequest to b.
+func (v *DisableDomainTransferLockRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DisableDomainTransferLockResponse is undocumented.
 type DisableDomainTransferLockResponse struct {
 	OperationID aws.StringValue `json:"OperationId"`
@@ -530,10 +865,10 @@ const (
 
 // DomainSummary is undocumented.
 type DomainSummary struct {
-	AutoRenew    aws.BooleanValue `json:"AutoRenew,omitempty"`
-	DomainName   aws.StringValue  `json:"DomainName"`
-	Expiry       time.Time        `json:"Expiry,omitempty"`
-	TransferLock aws.BooleanValue `json:"TransferLock,omitempty"`
+	AutoRenew    aws.BooleanValue    `json:"AutoRenew,omitempty"`
+	DomainName   aws.StringValue     `json:"DomainName"`
+	Expiry       *aws.FloatTimestamp `json:"Expiry,omitempty"`
+	TransferLock aws.BooleanValue    `json:"TransferLock,omitempty"`
 }
 
 // EnableDomainAutoRenewRequest is undocumented.
@@ -541,6 +876,33 @@ type EnableDomainAutoRenewRequest struct {
 	DomainName aws.StringValue `json:"DomainName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EnableDomainAutoRenewRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EnableDomainAutoRenewRequest to b.
+func (v *EnableDomainAutoRenewRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // EnableDomainAutoRenewResponse is undocumented.
 type EnableDomainAutoRenewResponse struct {
 }
@@ -550,6 +912,33 @@ type EnableDomainTransferLockRequest struct {
 	DomainName aws.StringValue `json:"DomainName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *EnableDomainTransferLockRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the EnableDomainTransferLockRequest to b.
+func (v *EnableDomainTransferLockRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // EnableDomainTransferLockResponse is undocumented.
 type EnableDomainTransferLockResponse struct {
 	OperationID aws.StringValue `json:"OperationId"`
@@ -561,6 +950,40 @@ type ExtraParam struct {
 	Value aws.StringValue `json:"Value"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ExtraParam) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ExtraParam to b.
+func (v *ExtraParam) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Value\":"...)
+	if v.Value == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Value)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for Route53Domains.
 const (
 	ExtraParamNameAuIDNumber          = "AU_ID_NUMBER"
@@ -587,29 +1010,56 @@ type GetDomainDetailRequest struct {
 	DomainName aws.StringValue `json:"DomainName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetDomainDetailRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetDomainDetailRequest to b.
+func (v *GetDomainDetailRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetDomainDetailResponse is undocumented.
 type GetDomainDetailResponse struct {
-	AbuseContactEmail aws.StringValue  `json:"AbuseContactEmail,omitempty"`
-	AbuseContactPhone aws.StringValue  `json:"AbuseContactPhone,omitempty"`
-	AdminContact      *ContactDetail   `json:"AdminContact"`
-	AdminPrivacy      aws.BooleanValue `json:"AdminPrivacy,omitempty"`
-	AutoRenew         aws.BooleanValue `json:"AutoRenew,omitempty"`
-	CreationDate      time.Time        `json:"CreationDate,omitempty"`
-	DNSSec            aws.StringValue  `json:"DnsSec,omitempty"`
-	DomainName        aws.StringValue  `json:"DomainName"`
-	ExpirationDate    time.Time        `json:"ExpirationDate,omitempty"`
-	Nameservers       []Nameserver     `json:"Nameservers"`
-	RegistrantContact *ContactDetail   `json:"RegistrantContact"`
-	RegistrantPrivacy aws.BooleanValue `json:"RegistrantPrivacy,omitempty"`
-	RegistrarName     aws.StringValue  `json:"RegistrarName,omitempty"`
-	RegistrarURL      aws.StringValue  `json:"RegistrarUrl,omitempty"`
-	RegistryDomainID  aws.StringValue  `json:"RegistryDomainId,omitempty"`
-	Reseller          aws.StringValue  `json:"Reseller,omitempty"`
-	StatusList        []string         `json:"StatusList,omitempty"`
-	TechContact       *ContactDetail   `json:"TechContact"`
-	TechPrivacy       aws.BooleanValue `json:"TechPrivacy,omitempty"`
-	UpdatedDate       time.Time        `json:"UpdatedDate,omitempty"`
-	WhoIsServer       aws.StringValue  `json:"WhoIsServer,omitempty"`
+	AbuseContactEmail aws.StringValue     `json:"AbuseContactEmail,omitempty"`
+	AbuseContactPhone aws.StringValue     `json:"AbuseContactPhone,omitempty"`
+	AdminContact      *ContactDetail      `json:"AdminContact" sensitive:"AdminContact"`
+	AdminPrivacy      aws.BooleanValue    `json:"AdminPrivacy,omitempty"`
+	AutoRenew         aws.BooleanValue    `json:"AutoRenew,omitempty"`
+	CreationDate      *aws.FloatTimestamp `json:"CreationDate,omitempty"`
+	DNSSec            aws.StringValue     `json:"DnsSec,omitempty"`
+	DomainName        aws.StringValue     `json:"DomainName"`
+	ExpirationDate    *aws.FloatTimestamp `json:"ExpirationDate,omitempty"`
+	Nameservers       []Nameserver        `json:"Nameservers"`
+	RegistrantContact *ContactDetail      `json:"RegistrantContact" sensitive:"RegistrantContact"`
+	RegistrantPrivacy aws.BooleanValue    `json:"RegistrantPrivacy,omitempty"`
+	RegistrarName     aws.StringValue     `json:"RegistrarName,omitempty"`
+	RegistrarURL      aws.StringValue     `json:"RegistrarUrl,omitempty"`
+	RegistryDomainID  aws.StringValue     `json:"RegistryDomainId,omitempty"`
+	Reseller          aws.StringValue     `json:"Reseller,omitempty"`
+	StatusList        []string            `json:"StatusList,omitempty"`
+	TechContact       *ContactDetail      `json:"TechContact" sensitive:"TechContact"`
+	TechPrivacy       aws.BooleanValue    `json:"TechPrivacy,omitempty"`
+	UpdatedDate       *aws.FloatTimestamp `json:"UpdatedDate,omitempty"`
+	WhoIsServer       aws.StringValue     `json:"WhoIsServer,omitempty"`
 }
 
 // GetOperationDetailRequest is undocumented.
@@ -617,14 +1067,41 @@ type GetOperationDetailRequest struct {
 	OperationID aws.StringValue `json:"OperationId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetOperationDetailRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetOperationDetailRequest to b.
+func (v *GetOperationDetailRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"OperationId\":"...)
+	if v.OperationID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.OperationID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // GetOperationDetailResponse is undocumented.
 type GetOperationDetailResponse struct {
-	DomainName    aws.StringValue `json:"DomainName,omitempty"`
-	Message       aws.StringValue `json:"Message,omitempty"`
-	OperationID   aws.StringValue `json:"OperationId,omitempty"`
-	Status        aws.StringValue `json:"Status,omitempty"`
-	SubmittedDate time.Time       `json:"SubmittedDate,omitempty"`
-	Type          aws.StringValue `json:"Type,omitempty"`
+	DomainName    aws.StringValue     `json:"DomainName,omitempty"`
+	Message       aws.StringValue     `json:"Message,omitempty"`
+	OperationID   aws.StringValue     `json:"OperationId,omitempty"`
+	Status        aws.StringValue     `json:"Status,omitempty"`
+	SubmittedDate *aws.FloatTimestamp `json:"SubmittedDate,omitempty"`
+	Type          aws.StringValue     `json:"Type,omitempty"`
 }
 
 // ListDomainsRequest is undocumented.
@@ -633,6 +1110,36 @@ type ListDomainsRequest struct {
 	MaxItems aws.IntegerValue `json:"MaxItems,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListDomainsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListDomainsRequest to b.
+func (v *ListDomainsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if v.MaxItems != nil {
+		b = append(b, "\"MaxItems\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaxItems), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListDomainsResponse is undocumented.
 type ListDomainsResponse struct {
 	Domains        []DomainSummary `json:"Domains"`
@@ -645,6 +1152,36 @@ type ListOperationsRequest struct {
 	MaxItems aws.IntegerValue `json:"MaxItems,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListOperationsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListOperationsRequest to b.
+func (v *ListOperationsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if v.MaxItems != nil {
+		b = append(b, "\"MaxItems\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaxItems), 10)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListOperationsResponse is undocumented.
 type ListOperationsResponse struct {
 	NextPageMarker aws.StringValue    `json:"NextPageMarker,omitempty"`
@@ -657,6 +1194,47 @@ type Nameserver struct {
 	Name    aws.StringValue `json:"Name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Nameserver) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Nameserver to b.
+func (v *Nameserver) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.GlueIPs) != 0 {
+		b = append(b, "\"GlueIps\":"...)
+		b = append(b, '[')
+		for i1 := range v.GlueIPs {
+			b = aws.AppendJSONString(b, v.GlueIPs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"Name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for Route53Domains.
 const (
 	OperationStatusError      = "ERROR"
@@ -668,10 +1246,10 @@ const (
 
 // OperationSummary is undocumented.
 type OperationSummary struct {
-	OperationID   aws.StringValue `json:"OperationId"`
-	Status        aws.StringValue `json:"Status"`
-	SubmittedDate time.Time       `json:"SubmittedDate"`
-	Type          aws.StringValue `json:"Type"`
+	OperationID   aws.StringValue     `json:"OperationId"`
+	Status        aws.StringValue     `json:"Status"`
+	SubmittedDate *aws.FloatTimestamp `json:"SubmittedDate"`
+	Type          aws.StringValue     `json:"Type"`
 }
 
 // Possible values for Route53Domains.
@@ -687,7 +1265,7 @@ const (
 
 // RegisterDomainRequest is undocumented.
 type RegisterDomainRequest struct {
-	AdminContact                    *ContactDetail   `json:"AdminContact"`
+	AdminContact                    *ContactDetail   `json:"AdminContact" sensitive:"AdminContact"`
 	AutoRenew                       aws.BooleanValue `json:"AutoRenew,omitempty"`
 	DomainName                      aws.StringValue  `json:"DomainName"`
 	DurationInYears                 aws.IntegerValue `json:"DurationInYears"`
@@ -695,8 +1273,97 @@ type RegisterDomainRequest struct {
 	PrivacyProtectAdminContact      aws.BooleanValue `json:"PrivacyProtectAdminContact,omitempty"`
 	PrivacyProtectRegistrantContact aws.BooleanValue `json:"PrivacyProtectRegistrantContact,omitempty"`
 	PrivacyProtectTechContact       aws.BooleanValue `json:"PrivacyProtectTechContact,omitempty"`
-	RegistrantContact               *ContactDetail   `json:"RegistrantContact"`
-	TechContact                     *ContactDetail   `json:"TechContact"`
+	RegistrantContact               *ContactDetail   `json:"RegistrantContact" sensitive:"RegistrantContact"`
+	TechContact                     *ContactDetail   `json:"TechContact" sensitive:"TechContact"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterDomainRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterDomainRequest to b.
+func (v *RegisterDomainRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AdminContact\":"...)
+	if v.AdminContact == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.AdminContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.AutoRenew != nil {
+		b = append(b, "\"AutoRenew\":"...)
+		b = strconv.AppendBool(b, *v.AutoRenew)
+		b = append(b, ',')
+	}
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"DurationInYears\":"...)
+	if v.DurationInYears == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.DurationInYears), 10)
+	}
+	b = append(b, ',')
+	if v.IDNLangCode != nil {
+		b = append(b, "\"IdnLangCode\":"...)
+		b = aws.AppendJSONString(b, *v.IDNLangCode)
+		b = append(b, ',')
+	}
+	if v.PrivacyProtectAdminContact != nil {
+		b = append(b, "\"PrivacyProtectAdminContact\":"...)
+		b = strconv.AppendBool(b, *v.PrivacyProtectAdminContact)
+		b = append(b, ',')
+	}
+	if v.PrivacyProtectRegistrantContact != nil {
+		b = append(b, "\"PrivacyProtectRegistrantContact\":"...)
+		b = strconv.AppendBool(b, *v.PrivacyProtectRegistrantContact)
+		b = append(b, ',')
+	}
+	if v.PrivacyProtectTechContact != nil {
+		b = append(b, "\"PrivacyProtectTechContact\":"...)
+		b = strconv.AppendBool(b, *v.PrivacyProtectTechContact)
+		b = append(b, ',')
+	}
+	b = append(b, "\"RegistrantContact\":"...)
+	if v.RegistrantContact == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.RegistrantContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"TechContact\":"...)
+	if v.TechContact == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.TechContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // RegisterDomainResponse is undocumented.
@@ -709,15 +1376,42 @@ type RetrieveDomainAuthCodeRequest struct {
 	DomainName aws.StringValue `json:"DomainName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RetrieveDomainAuthCodeRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RetrieveDomainAuthCodeRequest to b.
+func (v *RetrieveDomainAuthCodeRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RetrieveDomainAuthCodeResponse is undocumented.
 type RetrieveDomainAuthCodeResponse struct {
-	AuthCode aws.StringValue `json:"AuthCode"`
+	AuthCode aws.StringValue `json:"AuthCode" sensitive:"AuthCode"`
 }
 
 // TransferDomainRequest is undocumented.
 type TransferDomainRequest struct {
-	AdminContact                    *ContactDetail   `json:"AdminContact"`
-	AuthCode                        aws.StringValue  `json:"AuthCode,omitempty"`
+	AdminContact                    *ContactDetail   `json:"AdminContact" sensitive:"AdminContact"`
+	AuthCode                        aws.StringValue  `json:"AuthCode,omitempty" sensitive:"AuthCode"`
 	AutoRenew                       aws.BooleanValue `json:"AutoRenew,omitempty"`
 	DomainName                      aws.StringValue  `json:"DomainName"`
 	DurationInYears                 aws.IntegerValue `json:"DurationInYears"`
@@ -726,8 +1420,121 @@ type TransferDomainRequest struct {
 	PrivacyProtectAdminContact      aws.BooleanValue `json:"PrivacyProtectAdminContact,omitempty"`
 	PrivacyProtectRegistrantContact aws.BooleanValue `json:"PrivacyProtectRegistrantContact,omitempty"`
 	PrivacyProtectTechContact       aws.BooleanValue `json:"PrivacyProtectTechContact,omitempty"`
-	RegistrantContact               *ContactDetail   `json:"RegistrantContact"`
-	TechContact                     *ContactDetail   `json:"TechContact"`
+	RegistrantContact               *ContactDetail   `json:"RegistrantContact" sensitive:"RegistrantContact"`
+	TechContact                     *ContactDetail   `json:"TechContact" sensitive:"TechContact"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *TransferDomainRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the TransferDomainRequest to b.
+func (v *TransferDomainRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"AdminContact\":"...)
+	if v.AdminContact == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.AdminContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.AuthCode != nil {
+		b = append(b, "\"AuthCode\":"...)
+		b = aws.AppendJSONString(b, *v.AuthCode)
+		b = append(b, ',')
+	}
+	if v.AutoRenew != nil {
+		b = append(b, "\"AutoRenew\":"...)
+		b = strconv.AppendBool(b, *v.AutoRenew)
+		b = append(b, ',')
+	}
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"DurationInYears\":"...)
+	if v.DurationInYears == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.DurationInYears), 10)
+	}
+	b = append(b, ',')
+	if v.IDNLangCode != nil {
+		b = append(b, "\"IdnLangCode\":"...)
+		b = aws.AppendJSONString(b, *v.IDNLangCode)
+		b = append(b, ',')
+	}
+	b = append(b, "\"Nameservers\":"...)
+	if v.Nameservers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Nameservers {
+			b, err = v.Nameservers[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if v.PrivacyProtectAdminContact != nil {
+		b = append(b, "\"PrivacyProtectAdminContact\":"...)
+		b = strconv.AppendBool(b, *v.PrivacyProtectAdminContact)
+		b = append(b, ',')
+	}
+	if v.PrivacyProtectRegistrantContact != nil {
+		b = append(b, "\"PrivacyProtectRegistrantContact\":"...)
+		b = strconv.AppendBool(b, *v.PrivacyProtectRegistrantContact)
+		b = append(b, ',')
+	}
+	if v.PrivacyProtectTechContact != nil {
+		b = append(b, "\"PrivacyProtectTechContact\":"...)
+		b = strconv.AppendBool(b, *v.PrivacyProtectTechContact)
+		b = append(b, ',')
+	}
+	b = append(b, "\"RegistrantContact\":"...)
+	if v.RegistrantContact == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.RegistrantContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"TechContact\":"...)
+	if v.TechContact == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.TechContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // TransferDomainResponse is undocumented.
@@ -743,6 +1550,48 @@ type UpdateDomainContactPrivacyRequest struct {
 	TechPrivacy       aws.BooleanValue `json:"TechPrivacy,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateDomainContactPrivacyRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateDomainContactPrivacyRequest to b.
+func (v *UpdateDomainContactPrivacyRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AdminPrivacy != nil {
+		b = append(b, "\"AdminPrivacy\":"...)
+		b = strconv.AppendBool(b, *v.AdminPrivacy)
+		b = append(b, ',')
+	}
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if v.RegistrantPrivacy != nil {
+		b = append(b, "\"RegistrantPrivacy\":"...)
+		b = strconv.AppendBool(b, *v.RegistrantPrivacy)
+		b = append(b, ',')
+	}
+	if v.TechPrivacy != nil {
+		b = append(b, "\"TechPrivacy\":"...)
+		b = strconv.AppendBool(b, *v.TechPrivacy)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateDomainContactPrivacyResponse is undocumented.
 type UpdateDomainContactPrivacyResponse struct {
 	OperationID aws.StringValue `json:"OperationId"`
@@ -750,10 +1599,61 @@ type UpdateDomainContactPrivacyResponse struct {
 
 // UpdateDomainContactRequest is undocumented.
 type UpdateDomainContactRequest struct {
-	AdminContact      *ContactDetail  `json:"AdminContact,omitempty"`
+	AdminContact      *ContactDetail  `json:"AdminContact,omitempty" sensitive:"AdminContact"`
 	DomainName        aws.StringValue `json:"DomainName"`
-	RegistrantContact *ContactDetail  `json:"RegistrantContact,omitempty"`
-	TechContact       *ContactDetail  `json:"TechContact,omitempty"`
+	RegistrantContact *ContactDetail  `json:"RegistrantContact,omitempty" sensitive:"RegistrantContact"`
+	TechContact       *ContactDetail  `json:"TechContact,omitempty" sensitive:"TechContact"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateDomainContactRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateDomainContactRequest to b.
+func (v *UpdateDomainContactRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AdminContact != nil {
+		b = append(b, "\"AdminContact\":"...)
+		b, err = v.AdminContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	if v.RegistrantContact != nil {
+		b = append(b, "\"RegistrantContact\":"...)
+		b, err = v.RegistrantContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TechContact != nil {
+		b = append(b, "\"TechContact\":"...)
+		b, err = v.TechContact.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // UpdateDomainContactResponse is undocumented.
@@ -767,10 +1667,99 @@ type UpdateDomainNameserversRequest struct {
 	Nameservers []Nameserver    `json:"Nameservers"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateDomainNameserversRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateDomainNameserversRequest to b.
+func (v *UpdateDomainNameserversRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DomainName\":"...)
+	if v.DomainName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DomainName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"Nameservers\":"...)
+	if v.Nameservers == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Nameservers {
+			b, err = v.Nameservers[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateDomainNameserversResponse is undocumented.
 type UpdateDomainNameserversResponse struct {
 	OperationID aws.StringValue `json:"OperationId"`
 }
 
+// DomainLimitExceeded is the error returned for the DomainLimitExceeded error code.
+type DomainLimitExceeded struct {
+	aws.APIError
+}
+
+// DuplicateRequest is the error returned for the DuplicateRequest error code.
+type DuplicateRequest struct {
+	aws.APIError
+}
+
+// InvalidInput is the error returned for the InvalidInput error code.
+type InvalidInput struct {
+	aws.APIError
+}
+
+// OperationLimitExceeded is the error returned for the OperationLimitExceeded error code.
+type OperationLimitExceeded struct {
+	aws.APIError
+}
+
+// TLDRulesViolation is the error returned for the TLDRulesViolation error code.
+type TLDRulesViolation struct {
+	aws.APIError
+}
+
+// UnsupportedTLD is the error returned for the UnsupportedTLD error code.
+type UnsupportedTLD struct {
+	aws.APIError
+}
+
+// exceptions maps Route53Domains's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"DomainLimitExceeded":    DomainLimitExceeded{},
+	"DuplicateRequest":       DuplicateRequest{},
+	"InvalidInput":           InvalidInput{},
+	"OperationLimitExceeded": OperationLimitExceeded{},
+	"TLDRulesViolation":      TLDRulesViolation{},
+	"UnsupportedTLD":         UnsupportedTLD{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
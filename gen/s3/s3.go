@@ -4,15 +4,18 @@
 package s3
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
 )
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -27,48 +30,1009 @@ type S3 struct {
 	client *aws.RestClient
 }
 
-// New returns a new S3 client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *S3 {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "s3"
-	endpoint, service, region := endpoints.Lookup("s3", region)
+// New returns a new S3 client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *S3 {
+	cfg := newConfig(region, client, opts)
 
 	return &S3{
 		client: &aws.RestClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2006-03-01",
+			ContentMD5: true,
 		},
 	}
 }
 
+// NewFromSession returns a new S3 client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *S3 {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("s3", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("s3", "s3"),
+	}, "AWS_ENDPOINT_URL_S3", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *S3) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *S3) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *S3) SetPayloadSigning(p aws.PayloadSigning) {
+	c.client.Context.PayloadSigning = p
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *S3) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	var httpReq *http.Request
+	var err error
+
+	switch op {
+
+	case "AbortMultipartUpload":
+
+		r, ok := req.(*AbortMultipartUploadRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: AbortMultipartUpload takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newAbortMultipartUploadRequest(context.Background(), r)
+
+	case "CompleteMultipartUpload":
+
+		r, ok := req.(*CompleteMultipartUploadRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: CompleteMultipartUpload takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCompleteMultipartUploadRequest(context.Background(), r)
+
+	case "CopyObject":
+
+		r, ok := req.(*CopyObjectRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: CopyObject takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCopyObjectRequest(context.Background(), r)
+
+	case "CreateBucket":
+
+		r, ok := req.(*CreateBucketRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: CreateBucket takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateBucketRequest(context.Background(), r)
+
+	case "CreateMultipartUpload":
+
+		r, ok := req.(*CreateMultipartUploadRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: CreateMultipartUpload takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newCreateMultipartUploadRequest(context.Background(), r)
+
+	case "DeleteBucket":
+
+		r, ok := req.(*DeleteBucketRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteBucket takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteBucketRequest(context.Background(), r)
+
+	case "DeleteBucketCors":
+
+		r, ok := req.(*DeleteBucketCORSRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteBucketCors takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteBucketCORSRequest(context.Background(), r)
+
+	case "DeleteBucketLifecycle":
+
+		r, ok := req.(*DeleteBucketLifecycleRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteBucketLifecycle takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteBucketLifecycleRequest(context.Background(), r)
+
+	case "DeleteBucketPolicy":
+
+		r, ok := req.(*DeleteBucketPolicyRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteBucketPolicy takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteBucketPolicyRequest(context.Background(), r)
+
+	case "DeleteBucketTagging":
+
+		r, ok := req.(*DeleteBucketTaggingRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteBucketTagging takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteBucketTaggingRequest(context.Background(), r)
+
+	case "DeleteBucketWebsite":
+
+		r, ok := req.(*DeleteBucketWebsiteRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteBucketWebsite takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteBucketWebsiteRequest(context.Background(), r)
+
+	case "DeleteObject":
+
+		r, ok := req.(*DeleteObjectRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteObject takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteObjectRequest(context.Background(), r)
+
+	case "DeleteObjects":
+
+		r, ok := req.(*DeleteObjectsRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: DeleteObjects takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newDeleteObjectsRequest(context.Background(), r)
+
+	case "GetBucketAcl":
+
+		r, ok := req.(*GetBucketACLRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketAcl takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketACLRequest(context.Background(), r)
+
+	case "GetBucketCors":
+
+		r, ok := req.(*GetBucketCORSRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketCors takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketCORSRequest(context.Background(), r)
+
+	case "GetBucketLifecycle":
+
+		r, ok := req.(*GetBucketLifecycleRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketLifecycle takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketLifecycleRequest(context.Background(), r)
+
+	case "GetBucketLocation":
+
+		r, ok := req.(*GetBucketLocationRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketLocation takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketLocationRequest(context.Background(), r)
+
+	case "GetBucketLogging":
+
+		r, ok := req.(*GetBucketLoggingRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketLogging takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketLoggingRequest(context.Background(), r)
+
+	case "GetBucketNotification":
+
+		r, ok := req.(*GetBucketNotificationRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketNotification takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketNotificationRequest(context.Background(), r)
+
+	case "GetBucketPolicy":
+
+		r, ok := req.(*GetBucketPolicyRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketPolicy takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketPolicyRequest(context.Background(), r)
+
+	case "GetBucketRequestPayment":
+
+		r, ok := req.(*GetBucketRequestPaymentRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketRequestPayment takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketRequestPaymentRequest(context.Background(), r)
+
+	case "GetBucketTagging":
+
+		r, ok := req.(*GetBucketTaggingRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketTagging takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketTaggingRequest(context.Background(), r)
+
+	case "GetBucketVersioning":
+
+		r, ok := req.(*GetBucketVersioningRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketVersioning takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketVersioningRequest(context.Background(), r)
+
+	case "GetBucketWebsite":
+
+		r, ok := req.(*GetBucketWebsiteRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetBucketWebsite takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetBucketWebsiteRequest(context.Background(), r)
+
+	case "GetObject":
+
+		r, ok := req.(*GetObjectRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetObject takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetObjectRequest(context.Background(), r)
+
+	case "GetObjectAcl":
+
+		r, ok := req.(*GetObjectACLRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetObjectAcl takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetObjectACLRequest(context.Background(), r)
+
+	case "GetObjectTorrent":
+
+		r, ok := req.(*GetObjectTorrentRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: GetObjectTorrent takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newGetObjectTorrentRequest(context.Background(), r)
+
+	case "HeadBucket":
+
+		r, ok := req.(*HeadBucketRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: HeadBucket takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newHeadBucketRequest(context.Background(), r)
+
+	case "HeadObject":
+
+		r, ok := req.(*HeadObjectRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: HeadObject takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newHeadObjectRequest(context.Background(), r)
+
+	case "ListBuckets":
+
+		httpReq, err = c.newListBucketsRequest(context.Background())
+
+	case "ListMultipartUploads":
+
+		r, ok := req.(*ListMultipartUploadsRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: ListMultipartUploads takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListMultipartUploadsRequest(context.Background(), r)
+
+	case "ListObjectVersions":
+
+		r, ok := req.(*ListObjectVersionsRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: ListObjectVersions takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListObjectVersionsRequest(context.Background(), r)
+
+	case "ListObjects":
+
+		r, ok := req.(*ListObjectsRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: ListObjects takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListObjectsRequest(context.Background(), r)
+
+	case "ListParts":
+
+		r, ok := req.(*ListPartsRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: ListParts takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newListPartsRequest(context.Background(), r)
+
+	case "PutBucketAcl":
+
+		r, ok := req.(*PutBucketACLRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketAcl takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketACLRequest(context.Background(), r)
+
+	case "PutBucketCors":
+
+		r, ok := req.(*PutBucketCORSRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketCors takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketCORSRequest(context.Background(), r)
+
+	case "PutBucketLifecycle":
+
+		r, ok := req.(*PutBucketLifecycleRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketLifecycle takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketLifecycleRequest(context.Background(), r)
+
+	case "PutBucketLogging":
+
+		r, ok := req.(*PutBucketLoggingRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketLogging takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketLoggingRequest(context.Background(), r)
+
+	case "PutBucketNotification":
+
+		r, ok := req.(*PutBucketNotificationRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketNotification takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketNotificationRequest(context.Background(), r)
+
+	case "PutBucketPolicy":
+
+		r, ok := req.(*PutBucketPolicyRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketPolicy takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketPolicyRequest(context.Background(), r)
+
+	case "PutBucketRequestPayment":
+
+		r, ok := req.(*PutBucketRequestPaymentRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketRequestPayment takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketRequestPaymentRequest(context.Background(), r)
+
+	case "PutBucketTagging":
+
+		r, ok := req.(*PutBucketTaggingRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketTagging takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketTaggingRequest(context.Background(), r)
+
+	case "PutBucketVersioning":
+
+		r, ok := req.(*PutBucketVersioningRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketVersioning takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketVersioningRequest(context.Background(), r)
+
+	case "PutBucketWebsite":
+
+		r, ok := req.(*PutBucketWebsiteRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutBucketWebsite takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutBucketWebsiteRequest(context.Background(), r)
+
+	case "PutObject":
+
+		r, ok := req.(*PutObjectRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutObject takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutObjectRequest(context.Background(), r)
+
+	case "PutObjectAcl":
+
+		r, ok := req.(*PutObjectACLRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: PutObjectAcl takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newPutObjectACLRequest(context.Background(), r)
+
+	case "RestoreObject":
+
+		r, ok := req.(*RestoreObjectRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: RestoreObject takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newRestoreObjectRequest(context.Background(), r)
+
+	case "UploadPart":
+
+		r, ok := req.(*UploadPartRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: UploadPart takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUploadPartRequest(context.Background(), r)
+
+	case "UploadPartCopy":
+
+		r, ok := req.(*UploadPartCopyRequest)
+		if !ok {
+			return "", fmt.Errorf("s3: UploadPartCopy takes a %T, not a %T", r, req)
+		}
+		httpReq, err = c.newUploadPartCopyRequest(context.Background(), r)
+
+	default:
+		return "", fmt.Errorf("s3: unknown operation %q", op)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignAbortMultipartUpload returns a URL for AbortMultipartUpload, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignAbortMultipartUpload(req *AbortMultipartUploadRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newAbortMultipartUploadRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignCompleteMultipartUpload returns a URL for CompleteMultipartUpload, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignCompleteMultipartUpload(req *CompleteMultipartUploadRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newCompleteMultipartUploadRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignCopyObject returns a URL for CopyObject, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignCopyObject(req *CopyObjectRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newCopyObjectRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignCreateBucket returns a URL for CreateBucket, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignCreateBucket(req *CreateBucketRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newCreateBucketRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignCreateMultipartUpload returns a URL for CreateMultipartUpload, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignCreateMultipartUpload(req *CreateMultipartUploadRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newCreateMultipartUploadRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteBucket returns a URL for DeleteBucket, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteBucket(req *DeleteBucketRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteBucketRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteBucketCORS returns a URL for DeleteBucketCORS, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteBucketCORS(req *DeleteBucketCORSRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteBucketCORSRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteBucketLifecycle returns a URL for DeleteBucketLifecycle, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteBucketLifecycle(req *DeleteBucketLifecycleRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteBucketLifecycleRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteBucketPolicy returns a URL for DeleteBucketPolicy, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteBucketPolicy(req *DeleteBucketPolicyRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteBucketPolicyRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteBucketTagging returns a URL for DeleteBucketTagging, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteBucketTagging(req *DeleteBucketTaggingRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteBucketTaggingRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteBucketWebsite returns a URL for DeleteBucketWebsite, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteBucketWebsite(req *DeleteBucketWebsiteRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteBucketWebsiteRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteObject returns a URL for DeleteObject, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteObject(req *DeleteObjectRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteObjectRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignDeleteObjects returns a URL for DeleteObjects, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignDeleteObjects(req *DeleteObjectsRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newDeleteObjectsRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketACL returns a URL for GetBucketACL, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketACL(req *GetBucketACLRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketACLRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketCORS returns a URL for GetBucketCORS, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketCORS(req *GetBucketCORSRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketCORSRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketLifecycle returns a URL for GetBucketLifecycle, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketLifecycle(req *GetBucketLifecycleRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketLifecycleRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketLocation returns a URL for GetBucketLocation, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketLocation(req *GetBucketLocationRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketLocationRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketLogging returns a URL for GetBucketLogging, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketLogging(req *GetBucketLoggingRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketLoggingRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketNotification returns a URL for GetBucketNotification, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketNotification(req *GetBucketNotificationRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketNotificationRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketPolicy returns a URL for GetBucketPolicy, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketPolicy(req *GetBucketPolicyRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketPolicyRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketRequestPayment returns a URL for GetBucketRequestPayment, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketRequestPayment(req *GetBucketRequestPaymentRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketRequestPaymentRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketTagging returns a URL for GetBucketTagging, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketTagging(req *GetBucketTaggingRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketTaggingRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketVersioning returns a URL for GetBucketVersioning, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketVersioning(req *GetBucketVersioningRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketVersioningRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetBucketWebsite returns a URL for GetBucketWebsite, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetBucketWebsite(req *GetBucketWebsiteRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetBucketWebsiteRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetObject returns a URL for GetObject, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetObject(req *GetObjectRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetObjectRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetObjectACL returns a URL for GetObjectACL, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetObjectACL(req *GetObjectACLRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetObjectACLRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignGetObjectTorrent returns a URL for GetObjectTorrent, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignGetObjectTorrent(req *GetObjectTorrentRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newGetObjectTorrentRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignHeadBucket returns a URL for HeadBucket, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignHeadBucket(req *HeadBucketRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newHeadBucketRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignHeadObject returns a URL for HeadObject, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignHeadObject(req *HeadObjectRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newHeadObjectRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignListBuckets returns a URL for ListBuckets, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignListBuckets(expires time.Duration) (string, error) {
+	httpReq, err := c.newListBucketsRequest(context.Background())
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignListMultipartUploads returns a URL for ListMultipartUploads, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignListMultipartUploads(req *ListMultipartUploadsRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newListMultipartUploadsRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignListObjectVersions returns a URL for ListObjectVersions, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignListObjectVersions(req *ListObjectVersionsRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newListObjectVersionsRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignListObjects returns a URL for ListObjects, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignListObjects(req *ListObjectsRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newListObjectsRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignListParts returns a URL for ListParts, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignListParts(req *ListPartsRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newListPartsRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketACL returns a URL for PutBucketACL, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketACL(req *PutBucketACLRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketACLRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketCORS returns a URL for PutBucketCORS, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketCORS(req *PutBucketCORSRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketCORSRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketLifecycle returns a URL for PutBucketLifecycle, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketLifecycle(req *PutBucketLifecycleRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketLifecycleRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketLogging returns a URL for PutBucketLogging, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketLogging(req *PutBucketLoggingRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketLoggingRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketNotification returns a URL for PutBucketNotification, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketNotification(req *PutBucketNotificationRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketNotificationRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketPolicy returns a URL for PutBucketPolicy, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketPolicy(req *PutBucketPolicyRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketPolicyRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketRequestPayment returns a URL for PutBucketRequestPayment, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketRequestPayment(req *PutBucketRequestPaymentRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketRequestPaymentRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketTagging returns a URL for PutBucketTagging, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketTagging(req *PutBucketTaggingRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketTaggingRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketVersioning returns a URL for PutBucketVersioning, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketVersioning(req *PutBucketVersioningRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketVersioningRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutBucketWebsite returns a URL for PutBucketWebsite, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutBucketWebsite(req *PutBucketWebsiteRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutBucketWebsiteRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutObject returns a URL for PutObject, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutObject(req *PutObjectRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutObjectRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignPutObjectACL returns a URL for PutObjectACL, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignPutObjectACL(req *PutObjectACLRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newPutObjectACLRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignRestoreObject returns a URL for RestoreObject, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignRestoreObject(req *RestoreObjectRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newRestoreObjectRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignUploadPart returns a URL for UploadPart, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignUploadPart(req *UploadPartRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newUploadPartRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
+// PresignUploadPartCopy returns a URL for UploadPartCopy, signed with query
+// parameters which expire after the given duration.
+func (c *S3) PresignUploadPartCopy(req *UploadPartCopyRequest, expires time.Duration) (string, error) {
+	httpReq, err := c.newUploadPartCopyRequest(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	return c.client.Presign(httpReq, expires)
+}
+
 // AbortMultipartUpload aborts a multipart upload. To verify that all parts
 // have been removed, so you don't get charged for the part storage, you
 // should call the List Parts operation and ensure the parts list is empty.
 func (c *S3) AbortMultipartUpload(req *AbortMultipartUploadRequest) (err error) {
+	return c.AbortMultipartUploadWithContext(context.Background(), req)
+}
+
+// AbortMultipartUploadWithContext is like AbortMultipartUpload, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) AbortMultipartUploadWithContext(ctx context.Context, req *AbortMultipartUploadRequest) (err error) {
 	// NRE
 
+	httpReq, err := c.newAbortMultipartUploadRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("AbortMultipartUpload", httpReq, req, nil)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	return
+}
+
+// newAbortMultipartUploadRequest builds the HTTP request for AbortMultipartUpload.
+func (c *S3) newAbortMultipartUploadRequest(ctx context.Context, req *AbortMultipartUploadRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
@@ -78,10 +1042,14 @@ func (c *S3) AbortMultipartUpload(req *AbortMultipartUploadRequest) (err error)
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -90,21 +1058,58 @@ func (c *S3) AbortMultipartUpload(req *AbortMultipartUploadRequest) (err error)
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CompleteMultipartUpload completes a multipart upload by assembling
+// previously uploaded parts.
+func (c *S3) CompleteMultipartUpload(req *CompleteMultipartUploadRequest) (resp *CompleteMultipartUploadOutput, err error) {
+	return c.CompleteMultipartUploadWithContext(context.Background(), req)
+}
+
+// CompleteMultipartUploadWithContext is like CompleteMultipartUpload, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) CompleteMultipartUploadWithContext(ctx context.Context, req *CompleteMultipartUploadRequest) (resp *CompleteMultipartUploadOutput, err error) {
+	resp = &CompleteMultipartUploadOutput{}
+
+	httpReq, err := c.newCompleteMultipartUploadRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CompleteMultipartUpload", httpReq, req, resp)
 	if err != nil {
 		return
 	}
 
 	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
+		resp.Expiration = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
+		resp.SSEKMSKeyID = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
+		resp.ServerSideEncryption = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
+		resp.VersionID = &s
+	}
 
 	return
 }
 
-// CompleteMultipartUpload completes a multipart upload by assembling
-// previously uploaded parts.
-func (c *S3) CompleteMultipartUpload(req *CompleteMultipartUploadRequest) (resp *CompleteMultipartUploadOutput, err error) {
-	resp = &CompleteMultipartUploadOutput{}
-
+// newCompleteMultipartUploadRequest builds the HTTP request for CompleteMultipartUpload.
+func (c *S3) newCompleteMultipartUploadRequest(ctx context.Context, req *CompleteMultipartUploadRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -124,13 +1129,13 @@ func (c *S3) CompleteMultipartUpload(req *CompleteMultipartUploadRequest) (resp
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
@@ -140,10 +1145,14 @@ func (c *S3) CompleteMultipartUpload(req *CompleteMultipartUploadRequest) (resp
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -152,7 +1161,27 @@ func (c *S3) CompleteMultipartUpload(req *CompleteMultipartUploadRequest) (resp
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CopyObject creates a copy of an object that is already stored in Amazon
+// S3.
+func (c *S3) CopyObject(req *CopyObjectRequest) (resp *CopyObjectOutput, err error) {
+	return c.CopyObjectWithContext(context.Background(), req)
+}
+
+// CopyObjectWithContext is like CopyObject, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) CopyObjectWithContext(ctx context.Context, req *CopyObjectRequest) (resp *CopyObjectOutput, err error) {
+	resp = &CopyObjectOutput{}
+
+	httpReq, err := c.newCopyObjectRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CopyObject", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -163,60 +1192,61 @@ func (c *S3) CompleteMultipartUpload(req *CompleteMultipartUploadRequest) (resp
 		return
 	}
 
-	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
+	if s := httpResp.Header.Get("x-amz-copy-source-version-id"); s != "" {
+		resp.CopySourceVersionID = &s
+	}
 
+	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
 		resp.Expiration = &s
+	}
 
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
+		resp.SSECustomerAlgorithm = &s
 	}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
+		resp.SSECustomerKeyMD5 = &s
+	}
 
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
 		resp.SSEKMSKeyID = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
-
 		resp.ServerSideEncryption = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
-
-		resp.VersionID = &s
-
 	}
 
 	return
 }
 
-// CopyObject creates a copy of an object that is already stored in Amazon
-// S3.
-func (c *S3) CopyObject(req *CopyObjectRequest) (resp *CopyObjectOutput, err error) {
-	resp = &CopyObjectOutput{}
-
+// newCopyObjectRequest builds the HTTP request for CopyObject.
+func (c *S3) newCopyObjectRequest(ctx context.Context, req *CopyObjectRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -257,16 +1287,16 @@ func (c *S3) CopyObject(req *CopyObjectRequest) (resp *CopyObjectOutput, err err
 		httpReq.Header.Set("x-amz-copy-source-if-match", *req.CopySourceIfMatch)
 	}
 
-	if req.CopySourceIfModifiedSince != (time.Time{}) {
-		httpReq.Header.Set("x-amz-copy-source-if-modified-since", req.CopySourceIfModifiedSince.Format(time.RFC822))
+	if !req.CopySourceIfModifiedSince.IsZero() {
+		httpReq.Header.Set("x-amz-copy-source-if-modified-since", aws.FormatTimestamp(req.CopySourceIfModifiedSince, "rfc822"))
 	}
 
 	if req.CopySourceIfNoneMatch != nil {
 		httpReq.Header.Set("x-amz-copy-source-if-none-match", *req.CopySourceIfNoneMatch)
 	}
 
-	if req.CopySourceIfUnmodifiedSince != (time.Time{}) {
-		httpReq.Header.Set("x-amz-copy-source-if-unmodified-since", req.CopySourceIfUnmodifiedSince.Format(time.RFC822))
+	if !req.CopySourceIfUnmodifiedSince.IsZero() {
+		httpReq.Header.Set("x-amz-copy-source-if-unmodified-since", aws.FormatTimestamp(req.CopySourceIfUnmodifiedSince, "rfc822"))
 	}
 
 	if req.CopySourceSSECustomerAlgorithm != nil {
@@ -281,8 +1311,8 @@ func (c *S3) CopyObject(req *CopyObjectRequest) (resp *CopyObjectOutput, err err
 		httpReq.Header.Set("x-amz-copy-source-server-side-encryption-customer-key-MD5", *req.CopySourceSSECustomerKeyMD5)
 	}
 
-	if req.Expires != (time.Time{}) {
-		httpReq.Header.Set("Expires", req.Expires.Format(time.RFC822))
+	if !req.Expires.IsZero() {
+		httpReq.Header.Set("Expires", aws.FormatTimestamp(req.Expires, "rfc822"))
 	}
 
 	if req.GrantFullControl != nil {
@@ -301,6 +1331,10 @@ func (c *S3) CopyObject(req *CopyObjectRequest) (resp *CopyObjectOutput, err err
 		httpReq.Header.Set("x-amz-grant-write-acp", *req.GrantWriteACP)
 	}
 
+	for k, v := range req.Metadata {
+		httpReq.Header.Set("x-amz-meta-"+k, v)
+	}
+
 	if req.MetadataDirective != nil {
 		httpReq.Header.Set("x-amz-metadata-directive", *req.MetadataDirective)
 	}
@@ -309,84 +1343,69 @@ func (c *S3) CopyObject(req *CopyObjectRequest) (resp *CopyObjectOutput, err err
 		httpReq.Header.Set("x-amz-server-side-encryption-customer-algorithm", *req.SSECustomerAlgorithm)
 	}
 
-	if req.SSECustomerKey != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-key", *req.SSECustomerKey)
-	}
-
-	if req.SSECustomerKeyMD5 != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
-	}
-
-	if req.SSEKMSKeyID != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-aws-kms-key-id", *req.SSEKMSKeyID)
-	}
-
-	if req.ServerSideEncryption != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption", *req.ServerSideEncryption)
-	}
-
-	if req.StorageClass != nil {
-		httpReq.Header.Set("x-amz-storage-class", *req.StorageClass)
-	}
-
-	if req.WebsiteRedirectLocation != nil {
-		httpReq.Header.Set("x-amz-website-redirect-location", *req.WebsiteRedirectLocation)
-	}
-
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
-	if s := httpResp.Header.Get("x-amz-copy-source-version-id"); s != "" {
-
-		resp.CopySourceVersionID = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
-
-		resp.Expiration = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
+	if req.SSECustomerKey != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-key", *req.SSECustomerKey)
+	}
 
-		resp.SSECustomerAlgorithm = &s
+	if req.SSECustomerKeyMD5 != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
+	}
 
+	if req.SSEKMSKeyID != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-aws-kms-key-id", *req.SSEKMSKeyID)
 	}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
+	if req.ServerSideEncryption != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption", *req.ServerSideEncryption)
+	}
 
-		resp.SSECustomerKeyMD5 = &s
+	if req.StorageClass != nil {
+		httpReq.Header.Set("x-amz-storage-class", *req.StorageClass)
+	}
 
+	if req.WebsiteRedirectLocation != nil {
+		httpReq.Header.Set("x-amz-website-redirect-location", *req.WebsiteRedirectLocation)
 	}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
+	return
+}
 
-		resp.SSEKMSKeyID = &s
+// CreateBucket is undocumented.
+func (c *S3) CreateBucket(req *CreateBucketRequest) (resp *CreateBucketOutput, err error) {
+	return c.CreateBucketWithContext(context.Background(), req)
+}
+
+// CreateBucketWithContext is like CreateBucket, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) CreateBucketWithContext(ctx context.Context, req *CreateBucketRequest) (resp *CreateBucketOutput, err error) {
+	resp = &CreateBucketOutput{}
 
+	httpReq, err := c.newCreateBucketRequest(ctx, req)
+	if err != nil {
+		return
 	}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
+	httpResp, err := c.client.DoOperation("CreateBucket", httpReq, req, resp)
+	if err != nil {
+		return
+	}
 
-		resp.ServerSideEncryption = &s
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
 
+	if s := httpResp.Header.Get("Location"); s != "" {
+		resp.Location = &s
 	}
 
 	return
 }
 
-// CreateBucket is undocumented.
-func (c *S3) CreateBucket(req *CreateBucketRequest) (resp *CreateBucketOutput, err error) {
-	resp = &CreateBucketOutput{}
-
+// newCreateBucketRequest builds the HTTP request for CreateBucket.
+func (c *S3) newCreateBucketRequest(ctx context.Context, req *CreateBucketRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -406,17 +1425,21 @@ func (c *S3) CreateBucket(req *CreateBucketRequest) (resp *CreateBucketOutput, e
 	uri := c.client.Endpoint + "/{Bucket}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -449,7 +1472,31 @@ func (c *S3) CreateBucket(req *CreateBucketRequest) (resp *CreateBucketOutput, e
 		httpReq.Header.Set("x-amz-grant-write-acp", *req.GrantWriteACP)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// CreateMultipartUpload initiates a multipart upload and returns an upload
+// Note: After you initiate multipart upload and upload one or more parts,
+// you must either complete or abort multipart upload in order to stop
+// getting charged for storage of the uploaded parts. Only after you either
+// complete or abort multipart upload, Amazon S3 frees up the parts storage
+// and stops charging you for the parts storage.
+func (c *S3) CreateMultipartUpload(req *CreateMultipartUploadRequest) (resp *CreateMultipartUploadOutput, err error) {
+	return c.CreateMultipartUploadWithContext(context.Background(), req)
+}
+
+// CreateMultipartUploadWithContext is like CreateMultipartUpload, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) CreateMultipartUploadWithContext(ctx context.Context, req *CreateMultipartUploadRequest) (resp *CreateMultipartUploadOutput, err error) {
+	resp = &CreateMultipartUploadOutput{}
+
+	httpReq, err := c.newCreateMultipartUploadRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("CreateMultipartUpload", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -460,46 +1507,53 @@ func (c *S3) CreateBucket(req *CreateBucketRequest) (resp *CreateBucketOutput, e
 		return
 	}
 
-	if s := httpResp.Header.Get("Location"); s != "" {
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
+		resp.SSECustomerAlgorithm = &s
+	}
 
-		resp.Location = &s
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
+		resp.SSECustomerKeyMD5 = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
+		resp.SSEKMSKeyID = &s
+	}
 
+	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
+		resp.ServerSideEncryption = &s
 	}
 
 	return
 }
 
-// CreateMultipartUpload initiates a multipart upload and returns an upload
-// Note: After you initiate multipart upload and upload one or more parts,
-// you must either complete or abort multipart upload in order to stop
-// getting charged for storage of the uploaded parts. Only after you either
-// complete or abort multipart upload, Amazon S3 frees up the parts storage
-// and stops charging you for the parts storage.
-func (c *S3) CreateMultipartUpload(req *CreateMultipartUploadRequest) (resp *CreateMultipartUploadOutput, err error) {
-	resp = &CreateMultipartUploadOutput{}
-
+// newCreateMultipartUploadRequest builds the HTTP request for CreateMultipartUpload.
+func (c *S3) newCreateMultipartUploadRequest(ctx context.Context, req *CreateMultipartUploadRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}?uploads"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -532,8 +1586,8 @@ func (c *S3) CreateMultipartUpload(req *CreateMultipartUploadRequest) (resp *Cre
 		httpReq.Header.Set("Content-Type", *req.ContentType)
 	}
 
-	if req.Expires != (time.Time{}) {
-		httpReq.Header.Set("Expires", req.Expires.Format(time.RFC822))
+	if !req.Expires.IsZero() {
+		httpReq.Header.Set("Expires", aws.FormatTimestamp(req.Expires, "rfc822"))
 	}
 
 	if req.GrantFullControl != nil {
@@ -552,6 +1606,10 @@ func (c *S3) CreateMultipartUpload(req *CreateMultipartUploadRequest) (resp *Cre
 		httpReq.Header.Set("x-amz-grant-write-acp", *req.GrantWriteACP)
 	}
 
+	for k, v := range req.Metadata {
+		httpReq.Header.Set("x-amz-meta-"+k, v)
+	}
+
 	if req.SSECustomerAlgorithm != nil {
 		httpReq.Header.Set("x-amz-server-side-encryption-customer-algorithm", *req.SSECustomerAlgorithm)
 	}
@@ -580,67 +1638,60 @@ func (c *S3) CreateMultipartUpload(req *CreateMultipartUploadRequest) (resp *Cre
 		httpReq.Header.Set("x-amz-website-redirect-location", *req.WebsiteRedirectLocation)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
-
-		resp.SSECustomerAlgorithm = &s
-
-	}
+	return
+}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
+// DeleteBucket deletes the bucket. All objects (including all object
+// versions and Delete Markers) in the bucket must be deleted before the
+// bucket itself can be deleted.
+func (c *S3) DeleteBucket(req *DeleteBucketRequest) (err error) {
+	return c.DeleteBucketWithContext(context.Background(), req)
+}
 
-		resp.SSECustomerKeyMD5 = &s
+// DeleteBucketWithContext is like DeleteBucket, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteBucketWithContext(ctx context.Context, req *DeleteBucketRequest) (err error) {
+	// NRE
 
+	httpReq, err := c.newDeleteBucketRequest(ctx, req)
+	if err != nil {
+		return
 	}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
-
-		resp.SSEKMSKeyID = &s
-
+	httpResp, err := c.client.DoOperation("DeleteBucket", httpReq, req, nil)
+	if err != nil {
+		return
 	}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
-
-		resp.ServerSideEncryption = &s
-
-	}
+	defer httpResp.Body.Close()
 
 	return
 }
 
-// DeleteBucket deletes the bucket. All objects (including all object
-// versions and Delete Markers) in the bucket must be deleted before the
-// bucket itself can be deleted.
-func (c *S3) DeleteBucket(req *DeleteBucketRequest) (err error) {
-	// NRE
-
+// newDeleteBucketRequest builds the HTTP request for DeleteBucket.
+func (c *S3) newDeleteBucketRequest(ctx context.Context, req *DeleteBucketRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -649,7 +1700,27 @@ func (c *S3) DeleteBucket(req *DeleteBucketRequest) (err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteBucketCORS deletes the cors configuration information set for the
+// bucket.
+func (c *S3) DeleteBucketCORS(req *DeleteBucketCORSRequest) (err error) {
+	return c.DeleteBucketCORSWithContext(context.Background(), req)
+}
+
+// DeleteBucketCORSWithContext is like DeleteBucketCORS, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteBucketCORSWithContext(ctx context.Context, req *DeleteBucketCORSRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteBucketCORSRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteBucketCors", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -659,28 +1730,29 @@ func (c *S3) DeleteBucket(req *DeleteBucketRequest) (err error) {
 	return
 }
 
-// DeleteBucketCORS deletes the cors configuration information set for the
-// bucket.
-func (c *S3) DeleteBucketCORS(req *DeleteBucketCORSRequest) (err error) {
-	// NRE
-
+// newDeleteBucketCORSRequest builds the HTTP request for DeleteBucketCORS.
+func (c *S3) newDeleteBucketCORSRequest(ctx context.Context, req *DeleteBucketCORSRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?cors"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -689,7 +1761,27 @@ func (c *S3) DeleteBucketCORS(req *DeleteBucketCORSRequest) (err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteBucketLifecycle deletes the lifecycle configuration from the
+// bucket.
+func (c *S3) DeleteBucketLifecycle(req *DeleteBucketLifecycleRequest) (err error) {
+	return c.DeleteBucketLifecycleWithContext(context.Background(), req)
+}
+
+// DeleteBucketLifecycleWithContext is like DeleteBucketLifecycle, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteBucketLifecycleWithContext(ctx context.Context, req *DeleteBucketLifecycleRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteBucketLifecycleRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteBucketLifecycle", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -699,28 +1791,29 @@ func (c *S3) DeleteBucketCORS(req *DeleteBucketCORSRequest) (err error) {
 	return
 }
 
-// DeleteBucketLifecycle deletes the lifecycle configuration from the
-// bucket.
-func (c *S3) DeleteBucketLifecycle(req *DeleteBucketLifecycleRequest) (err error) {
-	// NRE
-
+// newDeleteBucketLifecycleRequest builds the HTTP request for DeleteBucketLifecycle.
+func (c *S3) newDeleteBucketLifecycleRequest(ctx context.Context, req *DeleteBucketLifecycleRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?lifecycle"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -729,7 +1822,26 @@ func (c *S3) DeleteBucketLifecycle(req *DeleteBucketLifecycleRequest) (err error
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteBucketPolicy is undocumented.
+func (c *S3) DeleteBucketPolicy(req *DeleteBucketPolicyRequest) (err error) {
+	return c.DeleteBucketPolicyWithContext(context.Background(), req)
+}
+
+// DeleteBucketPolicyWithContext is like DeleteBucketPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteBucketPolicyWithContext(ctx context.Context, req *DeleteBucketPolicyRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteBucketPolicyRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteBucketPolicy", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -739,27 +1851,29 @@ func (c *S3) DeleteBucketLifecycle(req *DeleteBucketLifecycleRequest) (err error
 	return
 }
 
-// DeleteBucketPolicy is undocumented.
-func (c *S3) DeleteBucketPolicy(req *DeleteBucketPolicyRequest) (err error) {
-	// NRE
-
+// newDeleteBucketPolicyRequest builds the HTTP request for DeleteBucketPolicy.
+func (c *S3) newDeleteBucketPolicyRequest(ctx context.Context, req *DeleteBucketPolicyRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?policy"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -768,7 +1882,26 @@ func (c *S3) DeleteBucketPolicy(req *DeleteBucketPolicyRequest) (err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteBucketTagging is undocumented.
+func (c *S3) DeleteBucketTagging(req *DeleteBucketTaggingRequest) (err error) {
+	return c.DeleteBucketTaggingWithContext(context.Background(), req)
+}
+
+// DeleteBucketTaggingWithContext is like DeleteBucketTagging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteBucketTaggingWithContext(ctx context.Context, req *DeleteBucketTaggingRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteBucketTaggingRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteBucketTagging", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -778,27 +1911,29 @@ func (c *S3) DeleteBucketPolicy(req *DeleteBucketPolicyRequest) (err error) {
 	return
 }
 
-// DeleteBucketTagging is undocumented.
-func (c *S3) DeleteBucketTagging(req *DeleteBucketTaggingRequest) (err error) {
-	// NRE
-
+// newDeleteBucketTaggingRequest builds the HTTP request for DeleteBucketTagging.
+func (c *S3) newDeleteBucketTaggingRequest(ctx context.Context, req *DeleteBucketTaggingRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?tagging"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -807,7 +1942,27 @@ func (c *S3) DeleteBucketTagging(req *DeleteBucketTaggingRequest) (err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteBucketWebsite this operation removes the website configuration
+// from the bucket.
+func (c *S3) DeleteBucketWebsite(req *DeleteBucketWebsiteRequest) (err error) {
+	return c.DeleteBucketWebsiteWithContext(context.Background(), req)
+}
+
+// DeleteBucketWebsiteWithContext is like DeleteBucketWebsite, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteBucketWebsiteWithContext(ctx context.Context, req *DeleteBucketWebsiteRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newDeleteBucketWebsiteRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteBucketWebsite", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -817,65 +1972,99 @@ func (c *S3) DeleteBucketTagging(req *DeleteBucketTaggingRequest) (err error) {
 	return
 }
 
-// DeleteBucketWebsite this operation removes the website configuration
-// from the bucket.
-func (c *S3) DeleteBucketWebsite(req *DeleteBucketWebsiteRequest) (err error) {
-	// NRE
-
+// newDeleteBucketWebsiteRequest builds the HTTP request for DeleteBucketWebsite.
+func (c *S3) newDeleteBucketWebsiteRequest(ctx context.Context, req *DeleteBucketWebsiteRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?website"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
-	if len(q) > 0 {
-		uri += "?" + q.Encode()
+	if len(q) > 0 {
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
+	}
+
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
+	if err != nil {
+		return
+	}
+
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	return
+}
+
+// DeleteObject removes the null version (if there is one) of an object and
+// inserts a delete marker, which becomes the latest version of the object.
+// If there isn't a null version, Amazon S3 does not remove any objects.
+func (c *S3) DeleteObject(req *DeleteObjectRequest) (resp *DeleteObjectOutput, err error) {
+	return c.DeleteObjectWithContext(context.Background(), req)
+}
+
+// DeleteObjectWithContext is like DeleteObject, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteObjectWithContext(ctx context.Context, req *DeleteObjectRequest) (resp *DeleteObjectOutput, err error) {
+	resp = &DeleteObjectOutput{}
+
+	httpReq, err := c.newDeleteObjectRequest(ctx, req)
+	if err != nil {
+		return
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpResp, err := c.client.DoOperation("DeleteObject", httpReq, req, resp)
 	if err != nil {
 		return
 	}
 
-	if contentType != "" {
-		httpReq.Header.Set("Content-Type", contentType)
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
+	if s := httpResp.Header.Get("x-amz-delete-marker"); s != "" {
+		var v bool
+		if v, err = strconv.ParseBool(s); err != nil {
+			return
+		}
+		resp.DeleteMarker = &v
 	}
 
-	defer httpResp.Body.Close()
+	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
+		resp.VersionID = &s
+	}
 
 	return
 }
 
-// DeleteObject removes the null version (if there is one) of an object and
-// inserts a delete marker, which becomes the latest version of the object.
-// If there isn't a null version, Amazon S3 does not remove any objects.
-func (c *S3) DeleteObject(req *DeleteObjectRequest) (resp *DeleteObjectOutput, err error) {
-	resp = &DeleteObjectOutput{}
-
+// newDeleteObjectRequest builds the HTTP request for DeleteObject.
+func (c *S3) newDeleteObjectRequest(ctx context.Context, req *DeleteObjectRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
@@ -885,10 +2074,14 @@ func (c *S3) DeleteObject(req *DeleteObjectRequest) (resp *DeleteObjectOutput, e
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("DELETE", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "DELETE", uri, body)
 	if err != nil {
 		return
 	}
@@ -901,7 +2094,27 @@ func (c *S3) DeleteObject(req *DeleteObjectRequest) (resp *DeleteObjectOutput, e
 		httpReq.Header.Set("x-amz-mfa", *req.MFA)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// DeleteObjects this operation enables you to delete multiple objects from
+// a bucket using a single request. You may specify up to 1000 keys.
+func (c *S3) DeleteObjects(req *DeleteObjectsRequest) (resp *DeleteObjectsOutput, err error) {
+	return c.DeleteObjectsWithContext(context.Background(), req)
+}
+
+// DeleteObjectsWithContext is like DeleteObjects, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) DeleteObjectsWithContext(ctx context.Context, req *DeleteObjectsRequest) (resp *DeleteObjectsOutput, err error) {
+	resp = &DeleteObjectsOutput{}
+
+	httpReq, err := c.newDeleteObjectsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("DeleteObjects", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -912,31 +2125,11 @@ func (c *S3) DeleteObject(req *DeleteObjectRequest) (resp *DeleteObjectOutput, e
 		return
 	}
 
-	if s := httpResp.Header.Get("x-amz-delete-marker"); s != "" {
-
-		var v bool
-		v, err = strconv.ParseBool(s)
-		if err != nil {
-			return
-		}
-		resp.DeleteMarker = &v
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
-
-		resp.VersionID = &s
-
-	}
-
 	return
 }
 
-// DeleteObjects this operation enables you to delete multiple objects from
-// a bucket using a single request. You may specify up to 1000 keys.
-func (c *S3) DeleteObjects(req *DeleteObjectsRequest) (resp *DeleteObjectsOutput, err error) {
-	resp = &DeleteObjectsOutput{}
-
+// newDeleteObjectsRequest builds the HTTP request for DeleteObjects.
+func (c *S3) newDeleteObjectsRequest(ctx context.Context, req *DeleteObjectsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -956,17 +2149,21 @@ func (c *S3) DeleteObjects(req *DeleteObjectsRequest) (resp *DeleteObjectsOutput
 	uri := c.client.Endpoint + "/{Bucket}?delete"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -979,7 +2176,26 @@ func (c *S3) DeleteObjects(req *DeleteObjectsRequest) (resp *DeleteObjectsOutput
 		httpReq.Header.Set("x-amz-mfa", *req.MFA)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketACL is undocumented.
+func (c *S3) GetBucketACL(req *GetBucketACLRequest) (resp *GetBucketACLOutput, err error) {
+	return c.GetBucketACLWithContext(context.Background(), req)
+}
+
+// GetBucketACLWithContext is like GetBucketACL, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketACLWithContext(ctx context.Context, req *GetBucketACLRequest) (resp *GetBucketACLOutput, err error) {
+	resp = &GetBucketACLOutput{}
+
+	httpReq, err := c.newGetBucketACLRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketAcl", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -993,27 +2209,29 @@ func (c *S3) DeleteObjects(req *DeleteObjectsRequest) (resp *DeleteObjectsOutput
 	return
 }
 
-// GetBucketACL is undocumented.
-func (c *S3) GetBucketACL(req *GetBucketACLRequest) (resp *GetBucketACLOutput, err error) {
-	resp = &GetBucketACLOutput{}
-
+// newGetBucketACLRequest builds the HTTP request for GetBucketACL.
+func (c *S3) newGetBucketACLRequest(ctx context.Context, req *GetBucketACLRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?acl"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1022,7 +2240,26 @@ func (c *S3) GetBucketACL(req *GetBucketACLRequest) (resp *GetBucketACLOutput, e
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketCORS is undocumented.
+func (c *S3) GetBucketCORS(req *GetBucketCORSRequest) (resp *GetBucketCORSOutput, err error) {
+	return c.GetBucketCORSWithContext(context.Background(), req)
+}
+
+// GetBucketCORSWithContext is like GetBucketCORS, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketCORSWithContext(ctx context.Context, req *GetBucketCORSRequest) (resp *GetBucketCORSOutput, err error) {
+	resp = &GetBucketCORSOutput{}
+
+	httpReq, err := c.newGetBucketCORSRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketCors", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1036,27 +2273,29 @@ func (c *S3) GetBucketACL(req *GetBucketACLRequest) (resp *GetBucketACLOutput, e
 	return
 }
 
-// GetBucketCORS is undocumented.
-func (c *S3) GetBucketCORS(req *GetBucketCORSRequest) (resp *GetBucketCORSOutput, err error) {
-	resp = &GetBucketCORSOutput{}
-
+// newGetBucketCORSRequest builds the HTTP request for GetBucketCORS.
+func (c *S3) newGetBucketCORSRequest(ctx context.Context, req *GetBucketCORSRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?cors"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1065,7 +2304,27 @@ func (c *S3) GetBucketCORS(req *GetBucketCORSRequest) (resp *GetBucketCORSOutput
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketLifecycle returns the lifecycle configuration information set
+// on the bucket.
+func (c *S3) GetBucketLifecycle(req *GetBucketLifecycleRequest) (resp *GetBucketLifecycleOutput, err error) {
+	return c.GetBucketLifecycleWithContext(context.Background(), req)
+}
+
+// GetBucketLifecycleWithContext is like GetBucketLifecycle, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketLifecycleWithContext(ctx context.Context, req *GetBucketLifecycleRequest) (resp *GetBucketLifecycleOutput, err error) {
+	resp = &GetBucketLifecycleOutput{}
+
+	httpReq, err := c.newGetBucketLifecycleRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketLifecycle", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1079,28 +2338,29 @@ func (c *S3) GetBucketCORS(req *GetBucketCORSRequest) (resp *GetBucketCORSOutput
 	return
 }
 
-// GetBucketLifecycle returns the lifecycle configuration information set
-// on the bucket.
-func (c *S3) GetBucketLifecycle(req *GetBucketLifecycleRequest) (resp *GetBucketLifecycleOutput, err error) {
-	resp = &GetBucketLifecycleOutput{}
-
+// newGetBucketLifecycleRequest builds the HTTP request for GetBucketLifecycle.
+func (c *S3) newGetBucketLifecycleRequest(ctx context.Context, req *GetBucketLifecycleRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?lifecycle"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1109,7 +2369,26 @@ func (c *S3) GetBucketLifecycle(req *GetBucketLifecycleRequest) (resp *GetBucket
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketLocation is undocumented.
+func (c *S3) GetBucketLocation(req *GetBucketLocationRequest) (resp *GetBucketLocationOutput, err error) {
+	return c.GetBucketLocationWithContext(context.Background(), req)
+}
+
+// GetBucketLocationWithContext is like GetBucketLocation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketLocationWithContext(ctx context.Context, req *GetBucketLocationRequest) (resp *GetBucketLocationOutput, err error) {
+	resp = &GetBucketLocationOutput{}
+
+	httpReq, err := c.newGetBucketLocationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketLocation", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1123,27 +2402,29 @@ func (c *S3) GetBucketLifecycle(req *GetBucketLifecycleRequest) (resp *GetBucket
 	return
 }
 
-// GetBucketLocation is undocumented.
-func (c *S3) GetBucketLocation(req *GetBucketLocationRequest) (resp *GetBucketLocationOutput, err error) {
-	resp = &GetBucketLocationOutput{}
-
+// newGetBucketLocationRequest builds the HTTP request for GetBucketLocation.
+func (c *S3) newGetBucketLocationRequest(ctx context.Context, req *GetBucketLocationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?location"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1152,7 +2433,28 @@ func (c *S3) GetBucketLocation(req *GetBucketLocationRequest) (resp *GetBucketLo
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketLogging returns the logging status of a bucket and the
+// permissions users have to view and modify that status. To use you must
+// be the bucket owner.
+func (c *S3) GetBucketLogging(req *GetBucketLoggingRequest) (resp *GetBucketLoggingOutput, err error) {
+	return c.GetBucketLoggingWithContext(context.Background(), req)
+}
+
+// GetBucketLoggingWithContext is like GetBucketLogging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketLoggingWithContext(ctx context.Context, req *GetBucketLoggingRequest) (resp *GetBucketLoggingOutput, err error) {
+	resp = &GetBucketLoggingOutput{}
+
+	httpReq, err := c.newGetBucketLoggingRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketLogging", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1166,29 +2468,29 @@ func (c *S3) GetBucketLocation(req *GetBucketLocationRequest) (resp *GetBucketLo
 	return
 }
 
-// GetBucketLogging returns the logging status of a bucket and the
-// permissions users have to view and modify that status. To use you must
-// be the bucket owner.
-func (c *S3) GetBucketLogging(req *GetBucketLoggingRequest) (resp *GetBucketLoggingOutput, err error) {
-	resp = &GetBucketLoggingOutput{}
-
+// newGetBucketLoggingRequest builds the HTTP request for GetBucketLogging.
+func (c *S3) newGetBucketLoggingRequest(ctx context.Context, req *GetBucketLoggingRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?logging"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1197,7 +2499,26 @@ func (c *S3) GetBucketLogging(req *GetBucketLoggingRequest) (resp *GetBucketLogg
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketNotification is undocumented.
+func (c *S3) GetBucketNotification(req *GetBucketNotificationRequest) (resp *GetBucketNotificationOutput, err error) {
+	return c.GetBucketNotificationWithContext(context.Background(), req)
+}
+
+// GetBucketNotificationWithContext is like GetBucketNotification, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketNotificationWithContext(ctx context.Context, req *GetBucketNotificationRequest) (resp *GetBucketNotificationOutput, err error) {
+	resp = &GetBucketNotificationOutput{}
+
+	httpReq, err := c.newGetBucketNotificationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketNotification", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1211,27 +2532,29 @@ func (c *S3) GetBucketLogging(req *GetBucketLoggingRequest) (resp *GetBucketLogg
 	return
 }
 
-// GetBucketNotification is undocumented.
-func (c *S3) GetBucketNotification(req *GetBucketNotificationRequest) (resp *GetBucketNotificationOutput, err error) {
-	resp = &GetBucketNotificationOutput{}
-
+// newGetBucketNotificationRequest builds the HTTP request for GetBucketNotification.
+func (c *S3) newGetBucketNotificationRequest(ctx context.Context, req *GetBucketNotificationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?notification"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1240,7 +2563,26 @@ func (c *S3) GetBucketNotification(req *GetBucketNotificationRequest) (resp *Get
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketPolicy is undocumented.
+func (c *S3) GetBucketPolicy(req *GetBucketPolicyRequest) (resp *GetBucketPolicyOutput, err error) {
+	return c.GetBucketPolicyWithContext(context.Background(), req)
+}
+
+// GetBucketPolicyWithContext is like GetBucketPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketPolicyWithContext(ctx context.Context, req *GetBucketPolicyRequest) (resp *GetBucketPolicyOutput, err error) {
+	resp = &GetBucketPolicyOutput{}
+
+	httpReq, err := c.newGetBucketPolicyRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketPolicy", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1254,27 +2596,29 @@ func (c *S3) GetBucketNotification(req *GetBucketNotificationRequest) (resp *Get
 	return
 }
 
-// GetBucketPolicy is undocumented.
-func (c *S3) GetBucketPolicy(req *GetBucketPolicyRequest) (resp *GetBucketPolicyOutput, err error) {
-	resp = &GetBucketPolicyOutput{}
-
+// newGetBucketPolicyRequest builds the HTTP request for GetBucketPolicy.
+func (c *S3) newGetBucketPolicyRequest(ctx context.Context, req *GetBucketPolicyRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?policy"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1283,7 +2627,27 @@ func (c *S3) GetBucketPolicy(req *GetBucketPolicyRequest) (resp *GetBucketPolicy
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketRequestPayment returns the request payment configuration of a
+// bucket.
+func (c *S3) GetBucketRequestPayment(req *GetBucketRequestPaymentRequest) (resp *GetBucketRequestPaymentOutput, err error) {
+	return c.GetBucketRequestPaymentWithContext(context.Background(), req)
+}
+
+// GetBucketRequestPaymentWithContext is like GetBucketRequestPayment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketRequestPaymentWithContext(ctx context.Context, req *GetBucketRequestPaymentRequest) (resp *GetBucketRequestPaymentOutput, err error) {
+	resp = &GetBucketRequestPaymentOutput{}
+
+	httpReq, err := c.newGetBucketRequestPaymentRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketRequestPayment", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1297,28 +2661,29 @@ func (c *S3) GetBucketPolicy(req *GetBucketPolicyRequest) (resp *GetBucketPolicy
 	return
 }
 
-// GetBucketRequestPayment returns the request payment configuration of a
-// bucket.
-func (c *S3) GetBucketRequestPayment(req *GetBucketRequestPaymentRequest) (resp *GetBucketRequestPaymentOutput, err error) {
-	resp = &GetBucketRequestPaymentOutput{}
-
+// newGetBucketRequestPaymentRequest builds the HTTP request for GetBucketRequestPayment.
+func (c *S3) newGetBucketRequestPaymentRequest(ctx context.Context, req *GetBucketRequestPaymentRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?requestPayment"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1327,7 +2692,26 @@ func (c *S3) GetBucketRequestPayment(req *GetBucketRequestPaymentRequest) (resp
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketTagging is undocumented.
+func (c *S3) GetBucketTagging(req *GetBucketTaggingRequest) (resp *GetBucketTaggingOutput, err error) {
+	return c.GetBucketTaggingWithContext(context.Background(), req)
+}
+
+// GetBucketTaggingWithContext is like GetBucketTagging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketTaggingWithContext(ctx context.Context, req *GetBucketTaggingRequest) (resp *GetBucketTaggingOutput, err error) {
+	resp = &GetBucketTaggingOutput{}
+
+	httpReq, err := c.newGetBucketTaggingRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketTagging", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1341,27 +2725,29 @@ func (c *S3) GetBucketRequestPayment(req *GetBucketRequestPaymentRequest) (resp
 	return
 }
 
-// GetBucketTagging is undocumented.
-func (c *S3) GetBucketTagging(req *GetBucketTaggingRequest) (resp *GetBucketTaggingOutput, err error) {
-	resp = &GetBucketTaggingOutput{}
-
+// newGetBucketTaggingRequest builds the HTTP request for GetBucketTagging.
+func (c *S3) newGetBucketTaggingRequest(ctx context.Context, req *GetBucketTaggingRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?tagging"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1370,50 +2756,26 @@ func (c *S3) GetBucketTagging(req *GetBucketTaggingRequest) (resp *GetBucketTagg
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
 	return
 }
 
 // GetBucketVersioning is undocumented.
 func (c *S3) GetBucketVersioning(req *GetBucketVersioningRequest) (resp *GetBucketVersioningOutput, err error) {
-	resp = &GetBucketVersioningOutput{}
-
-	var body io.Reader
-	var contentType string
-
-	uri := c.client.Endpoint + "/{Bucket}?versioning"
-
-	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
-	}
-
-	q := url.Values{}
+	return c.GetBucketVersioningWithContext(context.Background(), req)
+}
 
-	if len(q) > 0 {
-		uri += "?" + q.Encode()
-	}
+// GetBucketVersioningWithContext is like GetBucketVersioning, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketVersioningWithContext(ctx context.Context, req *GetBucketVersioningRequest) (resp *GetBucketVersioningOutput, err error) {
+	resp = &GetBucketVersioningOutput{}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err := c.newGetBucketVersioningRequest(ctx, req)
 	if err != nil {
 		return
 	}
 
-	if contentType != "" {
-		httpReq.Header.Set("Content-Type", contentType)
-	}
-
-	httpResp, err := c.client.Do(httpReq)
+	httpResp, err := c.client.DoOperation("GetBucketVersioning", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1427,27 +2789,29 @@ func (c *S3) GetBucketVersioning(req *GetBucketVersioningRequest) (resp *GetBuck
 	return
 }
 
-// GetBucketWebsite is undocumented.
-func (c *S3) GetBucketWebsite(req *GetBucketWebsiteRequest) (resp *GetBucketWebsiteOutput, err error) {
-	resp = &GetBucketWebsiteOutput{}
-
+// newGetBucketVersioningRequest builds the HTTP request for GetBucketVersioning.
+func (c *S3) newGetBucketVersioningRequest(ctx context.Context, req *GetBucketVersioningRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
-	uri := c.client.Endpoint + "/{Bucket}?website"
+	uri := c.client.Endpoint + "/{Bucket}?versioning"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1456,7 +2820,26 @@ func (c *S3) GetBucketWebsite(req *GetBucketWebsiteRequest) (resp *GetBucketWebs
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetBucketWebsite is undocumented.
+func (c *S3) GetBucketWebsite(req *GetBucketWebsiteRequest) (resp *GetBucketWebsiteOutput, err error) {
+	return c.GetBucketWebsiteWithContext(context.Background(), req)
+}
+
+// GetBucketWebsiteWithContext is like GetBucketWebsite, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetBucketWebsiteWithContext(ctx context.Context, req *GetBucketWebsiteRequest) (resp *GetBucketWebsiteOutput, err error) {
+	resp = &GetBucketWebsiteOutput{}
+
+	httpReq, err := c.newGetBucketWebsiteRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetBucketWebsite", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1470,60 +2853,29 @@ func (c *S3) GetBucketWebsite(req *GetBucketWebsiteRequest) (resp *GetBucketWebs
 	return
 }
 
-// GetObject is undocumented.
-func (c *S3) GetObject(req *GetObjectRequest) (resp *GetObjectOutput, err error) {
-	resp = &GetObjectOutput{}
-
+// newGetBucketWebsiteRequest builds the HTTP request for GetBucketWebsite.
+func (c *S3) newGetBucketWebsiteRequest(ctx context.Context, req *GetBucketWebsiteRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
-	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
+	uri := c.client.Endpoint + "/{Bucket}?website"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
-	}
-
-	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
-	if req.ResponseCacheControl != nil {
-		q.Set("response-cache-control", *req.ResponseCacheControl)
-	}
-
-	if req.ResponseContentDisposition != nil {
-		q.Set("response-content-disposition", *req.ResponseContentDisposition)
-	}
-
-	if req.ResponseContentEncoding != nil {
-		q.Set("response-content-encoding", *req.ResponseContentEncoding)
-	}
-
-	if req.ResponseContentLanguage != nil {
-		q.Set("response-content-language", *req.ResponseContentLanguage)
-	}
-
-	if req.ResponseContentType != nil {
-		q.Set("response-content-type", *req.ResponseContentType)
-	}
-
-	if req.ResponseExpires != (time.Time{}) {
-		q.Set("response-expires", req.ResponseExpires.Format(time.RFC822))
-	}
-
-	if req.VersionID != nil {
-		q.Set("versionId", *req.VersionID)
-	}
-
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1532,39 +2884,26 @@ func (c *S3) GetObject(req *GetObjectRequest) (resp *GetObjectOutput, err error)
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	if req.IfMatch != nil {
-		httpReq.Header.Set("If-Match", *req.IfMatch)
-	}
-
-	if req.IfModifiedSince != (time.Time{}) {
-		httpReq.Header.Set("If-Modified-Since", req.IfModifiedSince.Format(time.RFC822))
-	}
-
-	if req.IfNoneMatch != nil {
-		httpReq.Header.Set("If-None-Match", *req.IfNoneMatch)
-	}
-
-	if req.IfUnmodifiedSince != (time.Time{}) {
-		httpReq.Header.Set("If-Unmodified-Since", req.IfUnmodifiedSince.Format(time.RFC822))
-	}
-
-	if req.Range != nil {
-		httpReq.Header.Set("Range", *req.Range)
-	}
+	return
+}
 
-	if req.SSECustomerAlgorithm != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-algorithm", *req.SSECustomerAlgorithm)
-	}
+// GetObject is undocumented.
+func (c *S3) GetObject(req *GetObjectRequest) (resp *GetObjectOutput, err error) {
+	return c.GetObjectWithContext(context.Background(), req)
+}
 
-	if req.SSECustomerKey != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-key", *req.SSECustomerKey)
-	}
+// GetObjectWithContext is like GetObject, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetObjectWithContext(ctx context.Context, req *GetObjectRequest) (resp *GetObjectOutput, err error) {
+	resp = &GetObjectOutput{}
 
-	if req.SSECustomerKeyMD5 != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
+	httpReq, err := c.newGetObjectRequest(ctx, req)
+	if err != nil {
+		return
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	httpResp, err := c.client.DoOperation("GetObject", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1572,192 +2911,172 @@ func (c *S3) GetObject(req *GetObjectRequest) (resp *GetObjectOutput, err error)
 	resp.Body = httpResp.Body
 
 	if s := httpResp.Header.Get("accept-ranges"); s != "" {
-
 		resp.AcceptRanges = &s
-
 	}
 
 	if s := httpResp.Header.Get("Cache-Control"); s != "" {
-
 		resp.CacheControl = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Disposition"); s != "" {
-
 		resp.ContentDisposition = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Encoding"); s != "" {
-
 		resp.ContentEncoding = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Language"); s != "" {
-
 		resp.ContentLanguage = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Length"); s != "" {
-
-		var n int64
-		n, err = strconv.ParseInt(s, 10, 64)
-
-		if err != nil {
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, 64); err != nil {
 			return
 		}
-		resp.ContentLength = &n
-
+		resp.ContentLength = &v
 	}
 
 	if s := httpResp.Header.Get("Content-Type"); s != "" {
-
 		resp.ContentType = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-delete-marker"); s != "" {
-
 		var v bool
-		v, err = strconv.ParseBool(s)
-		if err != nil {
+		if v, err = strconv.ParseBool(s); err != nil {
 			return
 		}
 		resp.DeleteMarker = &v
-
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
-
 		resp.Expiration = &s
-
 	}
 
 	if s := httpResp.Header.Get("Expires"); s != "" {
-
-		var t time.Time
-		t, err = time.Parse(time.RFC1123, s)
-		if err != nil {
+		var v time.Time
+		if v, err = aws.ParseTimestamp(s, "rfc822"); err != nil {
 			return
 		}
-		resp.Expires = t
-
+		resp.Expires = v
 	}
 
 	if s := httpResp.Header.Get("Last-Modified"); s != "" {
-
-		var t time.Time
-		t, err = time.Parse(time.RFC1123, s)
-		if err != nil {
+		var v time.Time
+		if v, err = aws.ParseTimestamp(s, "rfc822"); err != nil {
 			return
 		}
-		resp.LastModified = t
-
+		resp.LastModified = v
 	}
 
 	resp.Metadata = map[string]string{}
-	for name := range httpResp.Header {
-		if strings.HasPrefix(name, "headers") {
-			resp.Metadata[name] = httpResp.Header.Get(name)
+	for name, values := range httpResp.Header {
+		if key := strings.ToLower(name); strings.HasPrefix(key, "x-amz-meta-") {
+			s := strings.Join(values, ",")
+			resp.Metadata[key[11:]] = s
 		}
 	}
 
 	if s := httpResp.Header.Get("x-amz-missing-meta"); s != "" {
-
-		var n int
-		n, err = strconv.Atoi(s)
-
-		if err != nil {
+		var v int
+		if v, err = strconv.Atoi(s); err != nil {
 			return
 		}
-		resp.MissingMeta = &n
-
+		resp.MissingMeta = &v
 	}
 
 	if s := httpResp.Header.Get("x-amz-restore"); s != "" {
-
 		resp.Restore = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
-
 		resp.SSECustomerAlgorithm = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
-
 		resp.SSECustomerKeyMD5 = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
-
 		resp.SSEKMSKeyID = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
-
 		resp.ServerSideEncryption = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
-
 		resp.VersionID = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-website-redirect-location"); s != "" {
-
 		resp.WebsiteRedirectLocation = &s
-
 	}
 
 	return
 }
 
-// GetObjectACL is undocumented.
-func (c *S3) GetObjectACL(req *GetObjectACLRequest) (resp *GetObjectACLOutput, err error) {
-	resp = &GetObjectACLOutput{}
-
+// newGetObjectRequest builds the HTTP request for GetObject.
+func (c *S3) newGetObjectRequest(ctx context.Context, req *GetObjectRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
-	uri := c.client.Endpoint + "/{Bucket}/{Key+}?acl"
+	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
 
+	if req.ResponseCacheControl != nil {
+		q.Set("response-cache-control", *req.ResponseCacheControl)
+	}
+
+	if req.ResponseContentDisposition != nil {
+		q.Set("response-content-disposition", *req.ResponseContentDisposition)
+	}
+
+	if req.ResponseContentEncoding != nil {
+		q.Set("response-content-encoding", *req.ResponseContentEncoding)
+	}
+
+	if req.ResponseContentLanguage != nil {
+		q.Set("response-content-language", *req.ResponseContentLanguage)
+	}
+
+	if req.ResponseContentType != nil {
+		q.Set("response-content-type", *req.ResponseContentType)
+	}
+
+	if !req.ResponseExpires.IsZero() {
+		q.Set("response-expires", aws.FormatTimestamp(req.ResponseExpires, "iso8601"))
+	}
+
 	if req.VersionID != nil {
 		q.Set("versionId", *req.VersionID)
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1766,7 +3085,61 @@ func (c *S3) GetObjectACL(req *GetObjectACLRequest) (resp *GetObjectACLOutput, e
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	if req.IfMatch != nil {
+		httpReq.Header.Set("If-Match", *req.IfMatch)
+	}
+
+	if !req.IfModifiedSince.IsZero() {
+		httpReq.Header.Set("If-Modified-Since", aws.FormatTimestamp(req.IfModifiedSince, "rfc822"))
+	}
+
+	if req.IfNoneMatch != nil {
+		httpReq.Header.Set("If-None-Match", *req.IfNoneMatch)
+	}
+
+	if !req.IfUnmodifiedSince.IsZero() {
+		httpReq.Header.Set("If-Unmodified-Since", aws.FormatTimestamp(req.IfUnmodifiedSince, "rfc822"))
+	}
+
+	if req.Range != nil {
+		httpReq.Header.Set("Range", *req.Range)
+	}
+
+	if req.SSECustomerAlgorithm != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-algorithm", *req.SSECustomerAlgorithm)
+	}
+
+	if req.SSECustomerKey != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-key", *req.SSECustomerKey)
+	}
+
+	if req.SSECustomerKeyMD5 != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
+	}
+
+	// the body is streamed as it's stored, so it isn't compressed for transit
+	httpReq.Header.Set("Accept-Encoding", "identity")
+
+	return
+}
+
+// GetObjectACL is undocumented.
+func (c *S3) GetObjectACL(req *GetObjectACLRequest) (resp *GetObjectACLOutput, err error) {
+	return c.GetObjectACLWithContext(context.Background(), req)
+}
+
+// GetObjectACLWithContext is like GetObjectACL, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetObjectACLWithContext(ctx context.Context, req *GetObjectACLRequest) (resp *GetObjectACLOutput, err error) {
+	resp = &GetObjectACLOutput{}
+
+	httpReq, err := c.newGetObjectACLRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetObjectAcl", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1780,32 +3153,38 @@ func (c *S3) GetObjectACL(req *GetObjectACLRequest) (resp *GetObjectACLOutput, e
 	return
 }
 
-// GetObjectTorrent is undocumented.
-func (c *S3) GetObjectTorrent(req *GetObjectTorrentRequest) (resp *GetObjectTorrentOutput, err error) {
-	resp = &GetObjectTorrentOutput{}
-
+// newGetObjectACLRequest builds the HTTP request for GetObjectACL.
+func (c *S3) newGetObjectACLRequest(ctx context.Context, req *GetObjectACLRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
-	uri := c.client.Endpoint + "/{Bucket}/{Key+}?torrent"
+	uri := c.client.Endpoint + "/{Bucket}/{Key+}?acl"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
 
+	if req.VersionID != nil {
+		q.Set("versionId", *req.VersionID)
+	}
+
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -1814,7 +3193,26 @@ func (c *S3) GetObjectTorrent(req *GetObjectTorrentRequest) (resp *GetObjectTorr
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// GetObjectTorrent is undocumented.
+func (c *S3) GetObjectTorrent(req *GetObjectTorrentRequest) (resp *GetObjectTorrentOutput, err error) {
+	return c.GetObjectTorrentWithContext(context.Background(), req)
+}
+
+// GetObjectTorrentWithContext is like GetObjectTorrent, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) GetObjectTorrentWithContext(ctx context.Context, req *GetObjectTorrentRequest) (resp *GetObjectTorrentOutput, err error) {
+	resp = &GetObjectTorrentOutput{}
+
+	httpReq, err := c.newGetObjectTorrentRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("GetObjectTorrent", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1824,37 +3222,66 @@ func (c *S3) GetObjectTorrent(req *GetObjectTorrentRequest) (resp *GetObjectTorr
 	return
 }
 
-// HeadBucket this operation is useful to determine if a bucket exists and
-// you have permission to access it.
-func (c *S3) HeadBucket(req *HeadBucketRequest) (err error) {
-	// NRE
-
+// newGetObjectTorrentRequest builds the HTTP request for GetObjectTorrent.
+func (c *S3) newGetObjectTorrentRequest(ctx context.Context, req *GetObjectTorrentRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
-	uri := c.client.Endpoint + "/{Bucket}"
+	uri := c.client.Endpoint + "/{Bucket}/{Key+}?torrent"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
+	}
+
+	if req.Key != nil {
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
 
-	if len(q) > 0 {
-		uri += "?" + q.Encode()
-	}
+	if len(q) > 0 {
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
+	}
+
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
+	if err != nil {
+		return
+	}
+
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	// the body is streamed as it's stored, so it isn't compressed for transit
+	httpReq.Header.Set("Accept-Encoding", "identity")
+
+	return
+}
+
+// HeadBucket this operation is useful to determine if a bucket exists and
+// you have permission to access it.
+func (c *S3) HeadBucket(req *HeadBucketRequest) (err error) {
+	return c.HeadBucketWithContext(context.Background(), req)
+}
+
+// HeadBucketWithContext is like HeadBucket, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) HeadBucketWithContext(ctx context.Context, req *HeadBucketRequest) (err error) {
+	// NRE
 
-	httpReq, err := http.NewRequest("HEAD", uri, body)
+	httpReq, err := c.newHeadBucketRequest(ctx, req)
 	if err != nil {
 		return
 	}
 
-	if contentType != "" {
-		httpReq.Header.Set("Content-Type", contentType)
-	}
-
-	httpResp, err := c.client.Do(httpReq)
+	httpResp, err := c.client.DoOperation("HeadBucket", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -1864,39 +3291,29 @@ func (c *S3) HeadBucket(req *HeadBucketRequest) (err error) {
 	return
 }
 
-// HeadObject the operation retrieves metadata from an object without
-// returning the object itself. This operation is useful if you're only
-// interested in an object's metadata. To use you must have access to the
-// object.
-func (c *S3) HeadObject(req *HeadObjectRequest) (resp *HeadObjectOutput, err error) {
-	resp = &HeadObjectOutput{}
-
+// newHeadBucketRequest builds the HTTP request for HeadBucket.
+func (c *S3) newHeadBucketRequest(ctx context.Context, req *HeadBucketRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
-	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
+	uri := c.client.Endpoint + "/{Bucket}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
-	}
-
-	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
-	if req.VersionID != nil {
-		q.Set("versionId", *req.VersionID)
-	}
-
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("HEAD", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "HEAD", uri, body)
 	if err != nil {
 		return
 	}
@@ -1905,39 +3322,29 @@ func (c *S3) HeadObject(req *HeadObjectRequest) (resp *HeadObjectOutput, err err
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	if req.IfMatch != nil {
-		httpReq.Header.Set("If-Match", *req.IfMatch)
-	}
-
-	if req.IfModifiedSince != (time.Time{}) {
-		httpReq.Header.Set("If-Modified-Since", req.IfModifiedSince.Format(time.RFC822))
-	}
-
-	if req.IfNoneMatch != nil {
-		httpReq.Header.Set("If-None-Match", *req.IfNoneMatch)
-	}
-
-	if req.IfUnmodifiedSince != (time.Time{}) {
-		httpReq.Header.Set("If-Unmodified-Since", req.IfUnmodifiedSince.Format(time.RFC822))
-	}
-
-	if req.Range != nil {
-		httpReq.Header.Set("Range", *req.Range)
-	}
+	return
+}
 
-	if req.SSECustomerAlgorithm != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-algorithm", *req.SSECustomerAlgorithm)
-	}
+// HeadObject the operation retrieves metadata from an object without
+// returning the object itself. This operation is useful if you're only
+// interested in an object's metadata. To use you must have access to the
+// object.
+func (c *S3) HeadObject(req *HeadObjectRequest) (resp *HeadObjectOutput, err error) {
+	return c.HeadObjectWithContext(context.Background(), req)
+}
 
-	if req.SSECustomerKey != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-key", *req.SSECustomerKey)
-	}
+// HeadObjectWithContext is like HeadObject, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) HeadObjectWithContext(ctx context.Context, req *HeadObjectRequest) (resp *HeadObjectOutput, err error) {
+	resp = &HeadObjectOutput{}
 
-	if req.SSECustomerKeyMD5 != nil {
-		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
+	httpReq, err := c.newHeadObjectRequest(ctx, req)
+	if err != nil {
+		return
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	httpResp, err := c.client.DoOperation("HeadObject", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -1949,157 +3356,186 @@ func (c *S3) HeadObject(req *HeadObjectRequest) (resp *HeadObjectOutput, err err
 	}
 
 	if s := httpResp.Header.Get("accept-ranges"); s != "" {
-
 		resp.AcceptRanges = &s
-
 	}
 
 	if s := httpResp.Header.Get("Cache-Control"); s != "" {
-
 		resp.CacheControl = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Disposition"); s != "" {
-
 		resp.ContentDisposition = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Encoding"); s != "" {
-
 		resp.ContentEncoding = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Language"); s != "" {
-
 		resp.ContentLanguage = &s
-
 	}
 
 	if s := httpResp.Header.Get("Content-Length"); s != "" {
-
-		var n int64
-		n, err = strconv.ParseInt(s, 10, 64)
-
-		if err != nil {
+		var v int64
+		if v, err = strconv.ParseInt(s, 10, 64); err != nil {
 			return
 		}
-		resp.ContentLength = &n
-
+		resp.ContentLength = &v
 	}
 
 	if s := httpResp.Header.Get("Content-Type"); s != "" {
-
 		resp.ContentType = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-delete-marker"); s != "" {
-
 		var v bool
-		v, err = strconv.ParseBool(s)
-		if err != nil {
+		if v, err = strconv.ParseBool(s); err != nil {
 			return
 		}
 		resp.DeleteMarker = &v
-
 	}
 
 	if s := httpResp.Header.Get("ETag"); s != "" {
-
 		resp.ETag = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
-
 		resp.Expiration = &s
-
 	}
 
 	if s := httpResp.Header.Get("Expires"); s != "" {
-
-		var t time.Time
-		t, err = time.Parse(time.RFC1123, s)
-		if err != nil {
+		var v time.Time
+		if v, err = aws.ParseTimestamp(s, "rfc822"); err != nil {
 			return
 		}
-		resp.Expires = t
-
+		resp.Expires = v
 	}
 
 	if s := httpResp.Header.Get("Last-Modified"); s != "" {
-
-		var t time.Time
-		t, err = time.Parse(time.RFC1123, s)
-		if err != nil {
+		var v time.Time
+		if v, err = aws.ParseTimestamp(s, "rfc822"); err != nil {
 			return
 		}
-		resp.LastModified = t
-
+		resp.LastModified = v
 	}
 
 	resp.Metadata = map[string]string{}
-	for name := range httpResp.Header {
-		if strings.HasPrefix(name, "headers") {
-			resp.Metadata[name] = httpResp.Header.Get(name)
+	for name, values := range httpResp.Header {
+		if key := strings.ToLower(name); strings.HasPrefix(key, "x-amz-meta-") {
+			s := strings.Join(values, ",")
+			resp.Metadata[key[11:]] = s
 		}
 	}
 
 	if s := httpResp.Header.Get("x-amz-missing-meta"); s != "" {
-
-		var n int
-		n, err = strconv.Atoi(s)
-
-		if err != nil {
+		var v int
+		if v, err = strconv.Atoi(s); err != nil {
 			return
 		}
-		resp.MissingMeta = &n
-
+		resp.MissingMeta = &v
 	}
 
 	if s := httpResp.Header.Get("x-amz-restore"); s != "" {
-
 		resp.Restore = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
-
 		resp.SSECustomerAlgorithm = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
-
 		resp.SSECustomerKeyMD5 = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
-
 		resp.SSEKMSKeyID = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
-
 		resp.ServerSideEncryption = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
-
 		resp.VersionID = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-website-redirect-location"); s != "" {
-
 		resp.WebsiteRedirectLocation = &s
+	}
+
+	return
+}
+
+// newHeadObjectRequest builds the HTTP request for HeadObject.
+func (c *S3) newHeadObjectRequest(ctx context.Context, req *HeadObjectRequest) (httpReq *http.Request, err error) {
+	var body io.Reader
+	var contentType string
+
+	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
+
+	if req.Bucket != nil {
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
+	}
+
+	if req.Key != nil {
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
+	}
+
+	q := url.Values{}
+
+	if req.VersionID != nil {
+		q.Set("versionId", *req.VersionID)
+	}
+
+	if len(q) > 0 {
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
+	}
 
+	httpReq, err = http.NewRequestWithContext(ctx, "HEAD", uri, body)
+	if err != nil {
+		return
+	}
+
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	if req.IfMatch != nil {
+		httpReq.Header.Set("If-Match", *req.IfMatch)
+	}
+
+	if !req.IfModifiedSince.IsZero() {
+		httpReq.Header.Set("If-Modified-Since", aws.FormatTimestamp(req.IfModifiedSince, "rfc822"))
+	}
+
+	if req.IfNoneMatch != nil {
+		httpReq.Header.Set("If-None-Match", *req.IfNoneMatch)
+	}
+
+	if !req.IfUnmodifiedSince.IsZero() {
+		httpReq.Header.Set("If-Unmodified-Since", aws.FormatTimestamp(req.IfUnmodifiedSince, "rfc822"))
+	}
+
+	if req.Range != nil {
+		httpReq.Header.Set("Range", *req.Range)
+	}
+
+	if req.SSECustomerAlgorithm != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-algorithm", *req.SSECustomerAlgorithm)
+	}
+
+	if req.SSECustomerKey != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-key", *req.SSECustomerKey)
+	}
+
+	if req.SSECustomerKeyMD5 != nil {
+		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
 	}
 
 	return
@@ -2108,8 +3544,36 @@ func (c *S3) HeadObject(req *HeadObjectRequest) (resp *HeadObjectOutput, err err
 // ListBuckets returns a list of all buckets owned by the authenticated
 // sender of the request.
 func (c *S3) ListBuckets() (resp *ListBucketsOutput, err error) {
+	return c.ListBucketsWithContext(context.Background())
+}
+
+// ListBucketsWithContext is like ListBuckets, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) ListBucketsWithContext(ctx context.Context) (resp *ListBucketsOutput, err error) {
 	resp = &ListBucketsOutput{}
 
+	httpReq, err := c.newListBucketsRequest(ctx)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListBuckets", httpReq, nil, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	return
+}
+
+// newListBucketsRequest builds the HTTP request for ListBuckets.
+func (c *S3) newListBucketsRequest(ctx context.Context) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2118,10 +3582,14 @@ func (c *S3) ListBuckets() (resp *ListBucketsOutput, err error) {
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -2130,7 +3598,26 @@ func (c *S3) ListBuckets() (resp *ListBucketsOutput, err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListMultipartUploads this operation lists in-progress multipart uploads.
+func (c *S3) ListMultipartUploads(req *ListMultipartUploadsRequest) (resp *ListMultipartUploadsOutput, err error) {
+	return c.ListMultipartUploadsWithContext(context.Background(), req)
+}
+
+// ListMultipartUploadsWithContext is like ListMultipartUploads, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) ListMultipartUploadsWithContext(ctx context.Context, req *ListMultipartUploadsRequest) (resp *ListMultipartUploadsOutput, err error) {
+	resp = &ListMultipartUploadsOutput{}
+
+	httpReq, err := c.newListMultipartUploadsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListMultipartUploads", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -2144,18 +3631,16 @@ func (c *S3) ListBuckets() (resp *ListBucketsOutput, err error) {
 	return
 }
 
-// ListMultipartUploads this operation lists in-progress multipart uploads.
-func (c *S3) ListMultipartUploads(req *ListMultipartUploadsRequest) (resp *ListMultipartUploadsOutput, err error) {
-	resp = &ListMultipartUploadsOutput{}
-
+// newListMultipartUploadsRequest builds the HTTP request for ListMultipartUploads.
+func (c *S3) newListMultipartUploadsRequest(ctx context.Context, req *ListMultipartUploadsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?uploads"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
@@ -2185,10 +3670,14 @@ func (c *S3) ListMultipartUploads(req *ListMultipartUploadsRequest) (resp *ListM
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -2197,7 +3686,27 @@ func (c *S3) ListMultipartUploads(req *ListMultipartUploadsRequest) (resp *ListM
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListObjectVersions returns metadata about all of the versions of objects
+// in a bucket.
+func (c *S3) ListObjectVersions(req *ListObjectVersionsRequest) (resp *ListObjectVersionsOutput, err error) {
+	return c.ListObjectVersionsWithContext(context.Background(), req)
+}
+
+// ListObjectVersionsWithContext is like ListObjectVersions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) ListObjectVersionsWithContext(ctx context.Context, req *ListObjectVersionsRequest) (resp *ListObjectVersionsOutput, err error) {
+	resp = &ListObjectVersionsOutput{}
+
+	httpReq, err := c.newListObjectVersionsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListObjectVersions", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -2211,19 +3720,16 @@ func (c *S3) ListMultipartUploads(req *ListMultipartUploadsRequest) (resp *ListM
 	return
 }
 
-// ListObjectVersions returns metadata about all of the versions of objects
-// in a bucket.
-func (c *S3) ListObjectVersions(req *ListObjectVersionsRequest) (resp *ListObjectVersionsOutput, err error) {
-	resp = &ListObjectVersionsOutput{}
-
+// newListObjectVersionsRequest builds the HTTP request for ListObjectVersions.
+func (c *S3) newListObjectVersionsRequest(ctx context.Context, req *ListObjectVersionsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}?versions"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
@@ -2253,10 +3759,14 @@ func (c *S3) ListObjectVersions(req *ListObjectVersionsRequest) (resp *ListObjec
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -2265,7 +3775,28 @@ func (c *S3) ListObjectVersions(req *ListObjectVersionsRequest) (resp *ListObjec
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// ListObjects returns some or all (up to 1000) of the objects in a bucket.
+// You can use the request parameters as selection criteria to return a
+// subset of the objects in a bucket.
+func (c *S3) ListObjects(req *ListObjectsRequest) (resp *ListObjectsOutput, err error) {
+	return c.ListObjectsWithContext(context.Background(), req)
+}
+
+// ListObjectsWithContext is like ListObjects, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) ListObjectsWithContext(ctx context.Context, req *ListObjectsRequest) (resp *ListObjectsOutput, err error) {
+	resp = &ListObjectsOutput{}
+
+	httpReq, err := c.newListObjectsRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("ListObjects", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -2279,20 +3810,16 @@ func (c *S3) ListObjectVersions(req *ListObjectVersionsRequest) (resp *ListObjec
 	return
 }
 
-// ListObjects returns some or all (up to 1000) of the objects in a bucket.
-// You can use the request parameters as selection criteria to return a
-// subset of the objects in a bucket.
-func (c *S3) ListObjects(req *ListObjectsRequest) (resp *ListObjectsOutput, err error) {
-	resp = &ListObjectsOutput{}
-
+// newListObjectsRequest builds the HTTP request for ListObjects.
+func (c *S3) newListObjectsRequest(ctx context.Context, req *ListObjectsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
@@ -2317,20 +3844,44 @@ func (c *S3) ListObjects(req *ListObjectsRequest) (resp *ListObjectsOutput, err
 		q.Set("prefix", *req.Prefix)
 	}
 
-	if len(q) > 0 {
-		uri += "?" + q.Encode()
-	}
+	if len(q) > 0 {
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
+	}
+
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
+	if err != nil {
+		return
+	}
+
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	return
+}
+
+// ListParts lists the parts that have been uploaded for a specific
+// multipart upload.
+func (c *S3) ListParts(req *ListPartsRequest) (resp *ListPartsOutput, err error) {
+	return c.ListPartsWithContext(context.Background(), req)
+}
+
+// ListPartsWithContext is like ListParts, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) ListPartsWithContext(ctx context.Context, req *ListPartsRequest) (resp *ListPartsOutput, err error) {
+	resp = &ListPartsOutput{}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err := c.newListPartsRequest(ctx, req)
 	if err != nil {
 		return
 	}
 
-	if contentType != "" {
-		httpReq.Header.Set("Content-Type", contentType)
-	}
-
-	httpResp, err := c.client.Do(httpReq)
+	httpResp, err := c.client.DoOperation("ListParts", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -2344,24 +3895,21 @@ func (c *S3) ListObjects(req *ListObjectsRequest) (resp *ListObjectsOutput, err
 	return
 }
 
-// ListParts lists the parts that have been uploaded for a specific
-// multipart upload.
-func (c *S3) ListParts(req *ListPartsRequest) (resp *ListPartsOutput, err error) {
-	resp = &ListPartsOutput{}
-
+// newListPartsRequest builds the HTTP request for ListParts.
+func (c *S3) newListPartsRequest(ctx context.Context, req *ListPartsRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
@@ -2379,10 +3927,14 @@ func (c *S3) ListParts(req *ListPartsRequest) (resp *ListPartsOutput, err error)
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("GET", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "GET", uri, body)
 	if err != nil {
 		return
 	}
@@ -2391,24 +3943,37 @@ func (c *S3) ListParts(req *ListPartsRequest) (resp *ListPartsOutput, err error)
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketACL sets the permissions on a bucket using access control lists
+func (c *S3) PutBucketACL(req *PutBucketACLRequest) (err error) {
+	return c.PutBucketACLWithContext(context.Background(), req)
+}
+
+// PutBucketACLWithContext is like PutBucketACL, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketACLWithContext(ctx context.Context, req *PutBucketACLRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketACLRequest(ctx, req)
 	if err != nil {
 		return
 	}
 
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
+	httpResp, err := c.client.DoOperation("PutBucketAcl", httpReq, req, nil)
+	if err != nil {
 		return
 	}
 
+	defer httpResp.Body.Close()
+
 	return
 }
 
-// PutBucketACL sets the permissions on a bucket using access control lists
-func (c *S3) PutBucketACL(req *PutBucketACLRequest) (err error) {
-	// NRE
-
+// newPutBucketACLRequest builds the HTTP request for PutBucketACL.
+func (c *S3) newPutBucketACLRequest(ctx context.Context, req *PutBucketACLRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2428,17 +3993,21 @@ func (c *S3) PutBucketACL(req *PutBucketACLRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}?acl"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2475,7 +4044,26 @@ func (c *S3) PutBucketACL(req *PutBucketACLRequest) (err error) {
 		httpReq.Header.Set("x-amz-grant-write-acp", *req.GrantWriteACP)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketCORS is undocumented.
+func (c *S3) PutBucketCORS(req *PutBucketCORSRequest) (err error) {
+	return c.PutBucketCORSWithContext(context.Background(), req)
+}
+
+// PutBucketCORSWithContext is like PutBucketCORS, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketCORSWithContext(ctx context.Context, req *PutBucketCORSRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketCORSRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketCors", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -2485,10 +4073,8 @@ func (c *S3) PutBucketACL(req *PutBucketACLRequest) (err error) {
 	return
 }
 
-// PutBucketCORS is undocumented.
-func (c *S3) PutBucketCORS(req *PutBucketCORSRequest) (err error) {
-	// NRE
-
+// newPutBucketCORSRequest builds the HTTP request for PutBucketCORS.
+func (c *S3) newPutBucketCORSRequest(ctx context.Context, req *PutBucketCORSRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2508,17 +4094,21 @@ func (c *S3) PutBucketCORS(req *PutBucketCORSRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}?cors"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2531,7 +4121,27 @@ func (c *S3) PutBucketCORS(req *PutBucketCORSRequest) (err error) {
 		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketLifecycle sets lifecycle configuration for your bucket. If a
+// lifecycle configuration exists, it replaces it.
+func (c *S3) PutBucketLifecycle(req *PutBucketLifecycleRequest) (err error) {
+	return c.PutBucketLifecycleWithContext(context.Background(), req)
+}
+
+// PutBucketLifecycleWithContext is like PutBucketLifecycle, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketLifecycleWithContext(ctx context.Context, req *PutBucketLifecycleRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketLifecycleRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketLifecycle", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -2541,11 +4151,8 @@ func (c *S3) PutBucketCORS(req *PutBucketCORSRequest) (err error) {
 	return
 }
 
-// PutBucketLifecycle sets lifecycle configuration for your bucket. If a
-// lifecycle configuration exists, it replaces it.
-func (c *S3) PutBucketLifecycle(req *PutBucketLifecycleRequest) (err error) {
-	// NRE
-
+// newPutBucketLifecycleRequest builds the HTTP request for PutBucketLifecycle.
+func (c *S3) newPutBucketLifecycleRequest(ctx context.Context, req *PutBucketLifecycleRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2565,17 +4172,21 @@ func (c *S3) PutBucketLifecycle(req *PutBucketLifecycleRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}?lifecycle"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2588,13 +4199,6 @@ func (c *S3) PutBucketLifecycle(req *PutBucketLifecycleRequest) (err error) {
 		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
 	return
 }
 
@@ -2602,8 +4206,32 @@ func (c *S3) PutBucketLifecycle(req *PutBucketLifecycleRequest) (err error) {
 // permissions for who can view and modify the logging parameters. To set
 // the logging status of a bucket, you must be the bucket owner.
 func (c *S3) PutBucketLogging(req *PutBucketLoggingRequest) (err error) {
+	return c.PutBucketLoggingWithContext(context.Background(), req)
+}
+
+// PutBucketLoggingWithContext is like PutBucketLogging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketLoggingWithContext(ctx context.Context, req *PutBucketLoggingRequest) (err error) {
 	// NRE
 
+	httpReq, err := c.newPutBucketLoggingRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketLogging", httpReq, req, nil)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	return
+}
+
+// newPutBucketLoggingRequest builds the HTTP request for PutBucketLogging.
+func (c *S3) newPutBucketLoggingRequest(ctx context.Context, req *PutBucketLoggingRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2623,17 +4251,21 @@ func (c *S3) PutBucketLogging(req *PutBucketLoggingRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}?logging"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2646,7 +4278,27 @@ func (c *S3) PutBucketLogging(req *PutBucketLoggingRequest) (err error) {
 		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketNotification enables notifications of specified events for a
+// bucket.
+func (c *S3) PutBucketNotification(req *PutBucketNotificationRequest) (err error) {
+	return c.PutBucketNotificationWithContext(context.Background(), req)
+}
+
+// PutBucketNotificationWithContext is like PutBucketNotification, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketNotificationWithContext(ctx context.Context, req *PutBucketNotificationRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketNotificationRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketNotification", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -2656,11 +4308,8 @@ func (c *S3) PutBucketLogging(req *PutBucketLoggingRequest) (err error) {
 	return
 }
 
-// PutBucketNotification enables notifications of specified events for a
-// bucket.
-func (c *S3) PutBucketNotification(req *PutBucketNotificationRequest) (err error) {
-	// NRE
-
+// newPutBucketNotificationRequest builds the HTTP request for PutBucketNotification.
+func (c *S3) newPutBucketNotificationRequest(ctx context.Context, req *PutBucketNotificationRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2680,17 +4329,21 @@ func (c *S3) PutBucketNotification(req *PutBucketNotificationRequest) (err error
 	uri := c.client.Endpoint + "/{Bucket}?notification"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2703,7 +4356,27 @@ func (c *S3) PutBucketNotification(req *PutBucketNotificationRequest) (err error
 		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketPolicy replaces a policy on a bucket. If the bucket already has
+// a policy, the one in this request completely replaces it.
+func (c *S3) PutBucketPolicy(req *PutBucketPolicyRequest) (err error) {
+	return c.PutBucketPolicyWithContext(context.Background(), req)
+}
+
+// PutBucketPolicyWithContext is like PutBucketPolicy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketPolicyWithContext(ctx context.Context, req *PutBucketPolicyRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketPolicyRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketPolicy", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -2713,11 +4386,8 @@ func (c *S3) PutBucketNotification(req *PutBucketNotificationRequest) (err error
 	return
 }
 
-// PutBucketPolicy replaces a policy on a bucket. If the bucket already has
-// a policy, the one in this request completely replaces it.
-func (c *S3) PutBucketPolicy(req *PutBucketPolicyRequest) (err error) {
-	// NRE
-
+// newPutBucketPolicyRequest builds the HTTP request for PutBucketPolicy.
+func (c *S3) newPutBucketPolicyRequest(ctx context.Context, req *PutBucketPolicyRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2732,17 +4402,21 @@ func (c *S3) PutBucketPolicy(req *PutBucketPolicyRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}?policy"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2755,13 +4429,6 @@ func (c *S3) PutBucketPolicy(req *PutBucketPolicyRequest) (err error) {
 		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
 	return
 }
 
@@ -2771,8 +4438,32 @@ func (c *S3) PutBucketPolicy(req *PutBucketPolicyRequest) (err error) {
 // that the person requesting the download will be charged for the
 // download.
 func (c *S3) PutBucketRequestPayment(req *PutBucketRequestPaymentRequest) (err error) {
+	return c.PutBucketRequestPaymentWithContext(context.Background(), req)
+}
+
+// PutBucketRequestPaymentWithContext is like PutBucketRequestPayment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketRequestPaymentWithContext(ctx context.Context, req *PutBucketRequestPaymentRequest) (err error) {
 	// NRE
 
+	httpReq, err := c.newPutBucketRequestPaymentRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketRequestPayment", httpReq, req, nil)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+
+	return
+}
+
+// newPutBucketRequestPaymentRequest builds the HTTP request for PutBucketRequestPayment.
+func (c *S3) newPutBucketRequestPaymentRequest(ctx context.Context, req *PutBucketRequestPaymentRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2792,17 +4483,21 @@ func (c *S3) PutBucketRequestPayment(req *PutBucketRequestPaymentRequest) (err e
 	uri := c.client.Endpoint + "/{Bucket}?requestPayment"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2815,7 +4510,26 @@ func (c *S3) PutBucketRequestPayment(req *PutBucketRequestPaymentRequest) (err e
 		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketTagging is undocumented.
+func (c *S3) PutBucketTagging(req *PutBucketTaggingRequest) (err error) {
+	return c.PutBucketTaggingWithContext(context.Background(), req)
+}
+
+// PutBucketTaggingWithContext is like PutBucketTagging, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketTaggingWithContext(ctx context.Context, req *PutBucketTaggingRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketTaggingRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketTagging", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -2825,10 +4539,8 @@ func (c *S3) PutBucketRequestPayment(req *PutBucketRequestPaymentRequest) (err e
 	return
 }
 
-// PutBucketTagging is undocumented.
-func (c *S3) PutBucketTagging(req *PutBucketTaggingRequest) (err error) {
-	// NRE
-
+// newPutBucketTaggingRequest builds the HTTP request for PutBucketTagging.
+func (c *S3) newPutBucketTaggingRequest(ctx context.Context, req *PutBucketTaggingRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2848,17 +4560,21 @@ func (c *S3) PutBucketTagging(req *PutBucketTaggingRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}?tagging"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2871,7 +4587,27 @@ func (c *S3) PutBucketTagging(req *PutBucketTaggingRequest) (err error) {
 		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketVersioning sets the versioning state of an existing bucket. To
+// set the versioning state, you must be the bucket owner.
+func (c *S3) PutBucketVersioning(req *PutBucketVersioningRequest) (err error) {
+	return c.PutBucketVersioningWithContext(context.Background(), req)
+}
+
+// PutBucketVersioningWithContext is like PutBucketVersioning, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketVersioningWithContext(ctx context.Context, req *PutBucketVersioningRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketVersioningRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketVersioning", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -2881,11 +4617,8 @@ func (c *S3) PutBucketTagging(req *PutBucketTaggingRequest) (err error) {
 	return
 }
 
-// PutBucketVersioning sets the versioning state of an existing bucket. To
-// set the versioning state, you must be the bucket owner.
-func (c *S3) PutBucketVersioning(req *PutBucketVersioningRequest) (err error) {
-	// NRE
-
+// newPutBucketVersioningRequest builds the HTTP request for PutBucketVersioning.
+func (c *S3) newPutBucketVersioningRequest(ctx context.Context, req *PutBucketVersioningRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2905,17 +4638,21 @@ func (c *S3) PutBucketVersioning(req *PutBucketVersioningRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}?versioning"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -2932,7 +4669,26 @@ func (c *S3) PutBucketVersioning(req *PutBucketVersioningRequest) (err error) {
 		httpReq.Header.Set("x-amz-mfa", *req.MFA)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// PutBucketWebsite is undocumented.
+func (c *S3) PutBucketWebsite(req *PutBucketWebsiteRequest) (err error) {
+	return c.PutBucketWebsiteWithContext(context.Background(), req)
+}
+
+// PutBucketWebsiteWithContext is like PutBucketWebsite, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutBucketWebsiteWithContext(ctx context.Context, req *PutBucketWebsiteRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newPutBucketWebsiteRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutBucketWebsite", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -2942,10 +4698,8 @@ func (c *S3) PutBucketVersioning(req *PutBucketVersioningRequest) (err error) {
 	return
 }
 
-// PutBucketWebsite is undocumented.
-func (c *S3) PutBucketWebsite(req *PutBucketWebsiteRequest) (err error) {
-	// NRE
-
+// newPutBucketWebsiteRequest builds the HTTP request for PutBucketWebsite.
+func (c *S3) newPutBucketWebsiteRequest(ctx context.Context, req *PutBucketWebsiteRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -2960,48 +4714,101 @@ func (c *S3) PutBucketWebsite(req *PutBucketWebsiteRequest) (err error) {
 	if err != nil {
 		return
 	}
-	body = bytes.NewReader(b)
-
-	uri := c.client.Endpoint + "/{Bucket}?website"
+	body = bytes.NewReader(b)
+
+	uri := c.client.Endpoint + "/{Bucket}?website"
+
+	if req.Bucket != nil {
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
+	}
+
+	q := url.Values{}
+
+	if len(q) > 0 {
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
+	}
+
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
+	if err != nil {
+		return
+	}
+
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	if req.ContentMD5 != nil {
+		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
+	}
+
+	return
+}
+
+// PutObject is undocumented.
+func (c *S3) PutObject(req *PutObjectRequest) (resp *PutObjectOutput, err error) {
+	return c.PutObjectWithContext(context.Background(), req)
+}
+
+// PutObjectWithContext is like PutObject, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutObjectWithContext(ctx context.Context, req *PutObjectRequest) (resp *PutObjectOutput, err error) {
+	resp = &PutObjectOutput{}
+
+	httpReq, err := c.newPutObjectRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("PutObject", httpReq, req, resp)
+	if err != nil {
+		return
+	}
 
-	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
 	}
 
-	q := url.Values{}
+	if s := httpResp.Header.Get("ETag"); s != "" {
+		resp.ETag = &s
+	}
 
-	if len(q) > 0 {
-		uri += "?" + q.Encode()
+	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
+		resp.Expiration = &s
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
-	if err != nil {
-		return
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
+		resp.SSECustomerAlgorithm = &s
 	}
 
-	if contentType != "" {
-		httpReq.Header.Set("Content-Type", contentType)
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
+		resp.SSECustomerKeyMD5 = &s
 	}
 
-	if req.ContentMD5 != nil {
-		httpReq.Header.Set("Content-MD5", *req.ContentMD5)
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
+		resp.SSEKMSKeyID = &s
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
+	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
+		resp.ServerSideEncryption = &s
 	}
 
-	defer httpResp.Body.Close()
+	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
+		resp.VersionID = &s
+	}
 
 	return
 }
 
-// PutObject is undocumented.
-func (c *S3) PutObject(req *PutObjectRequest) (resp *PutObjectOutput, err error) {
-	resp = &PutObjectOutput{}
-
+// newPutObjectRequest builds the HTTP request for PutObject.
+func (c *S3) newPutObjectRequest(ctx context.Context, req *PutObjectRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -3010,22 +4817,26 @@ func (c *S3) PutObject(req *PutObjectRequest) (resp *PutObjectOutput, err error)
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -3055,7 +4866,7 @@ func (c *S3) PutObject(req *PutObjectRequest) (resp *PutObjectOutput, err error)
 	}
 
 	if req.ContentLength != nil {
-		httpReq.ContentLength = *req.ContentLength
+		httpReq.ContentLength = int64(*req.ContentLength)
 	}
 
 	if req.ContentMD5 != nil {
@@ -3066,8 +4877,8 @@ func (c *S3) PutObject(req *PutObjectRequest) (resp *PutObjectOutput, err error)
 		httpReq.Header.Set("Content-Type", *req.ContentType)
 	}
 
-	if req.Expires != (time.Time{}) {
-		httpReq.Header.Set("Expires", req.Expires.Format(time.RFC822))
+	if !req.Expires.IsZero() {
+		httpReq.Header.Set("Expires", aws.FormatTimestamp(req.Expires, "rfc822"))
 	}
 
 	if req.GrantFullControl != nil {
@@ -3086,6 +4897,10 @@ func (c *S3) PutObject(req *PutObjectRequest) (resp *PutObjectOutput, err error)
 		httpReq.Header.Set("x-amz-grant-write-acp", *req.GrantWriteACP)
 	}
 
+	for k, v := range req.Metadata {
+		httpReq.Header.Set("x-amz-meta-"+k, v)
+	}
+
 	if req.SSECustomerAlgorithm != nil {
 		httpReq.Header.Set("x-amz-server-side-encryption-customer-algorithm", *req.SSECustomerAlgorithm)
 	}
@@ -3114,67 +4929,38 @@ func (c *S3) PutObject(req *PutObjectRequest) (resp *PutObjectOutput, err error)
 		httpReq.Header.Set("x-amz-website-redirect-location", *req.WebsiteRedirectLocation)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
-	if s := httpResp.Header.Get("ETag"); s != "" {
-
-		resp.ETag = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-expiration"); s != "" {
-
-		resp.Expiration = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
-
-		resp.SSECustomerAlgorithm = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
-
-		resp.SSECustomerKeyMD5 = &s
-
-	}
+	return
+}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
+// PutObjectACL uses the acl subresource to set the access control list
+// permissions for an object that already exists in a bucket
+func (c *S3) PutObjectACL(req *PutObjectACLRequest) (err error) {
+	return c.PutObjectACLWithContext(context.Background(), req)
+}
 
-		resp.SSEKMSKeyID = &s
+// PutObjectACLWithContext is like PutObjectACL, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) PutObjectACLWithContext(ctx context.Context, req *PutObjectACLRequest) (err error) {
+	// NRE
 
+	httpReq, err := c.newPutObjectACLRequest(ctx, req)
+	if err != nil {
+		return
 	}
 
-	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
-
-		resp.ServerSideEncryption = &s
-
+	httpResp, err := c.client.DoOperation("PutObjectAcl", httpReq, req, nil)
+	if err != nil {
+		return
 	}
 
-	if s := httpResp.Header.Get("x-amz-version-id"); s != "" {
-
-		resp.VersionID = &s
-
-	}
+	defer httpResp.Body.Close()
 
 	return
 }
 
-// PutObjectACL uses the acl subresource to set the access control list
-// permissions for an object that already exists in a bucket
-func (c *S3) PutObjectACL(req *PutObjectACLRequest) (err error) {
-	// NRE
-
+// newPutObjectACLRequest builds the HTTP request for PutObjectACL.
+func (c *S3) newPutObjectACLRequest(ctx context.Context, req *PutObjectACLRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -3194,22 +4980,26 @@ func (c *S3) PutObjectACL(req *PutObjectACLRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}?acl"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -3246,7 +5036,26 @@ func (c *S3) PutObjectACL(req *PutObjectACLRequest) (err error) {
 		httpReq.Header.Set("x-amz-grant-write-acp", *req.GrantWriteACP)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// RestoreObject restores an archived copy of an object back into Amazon S3
+func (c *S3) RestoreObject(req *RestoreObjectRequest) (err error) {
+	return c.RestoreObjectWithContext(context.Background(), req)
+}
+
+// RestoreObjectWithContext is like RestoreObject, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) RestoreObjectWithContext(ctx context.Context, req *RestoreObjectRequest) (err error) {
+	// NRE
+
+	httpReq, err := c.newRestoreObjectRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("RestoreObject", httpReq, req, nil)
 	if err != nil {
 		return
 	}
@@ -3256,10 +5065,8 @@ func (c *S3) PutObjectACL(req *PutObjectACLRequest) (err error) {
 	return
 }
 
-// RestoreObject restores an archived copy of an object back into Amazon S3
-func (c *S3) RestoreObject(req *RestoreObjectRequest) (err error) {
-	// NRE
-
+// newRestoreObjectRequest builds the HTTP request for RestoreObject.
+func (c *S3) newRestoreObjectRequest(ctx context.Context, req *RestoreObjectRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -3279,13 +5086,13 @@ func (c *S3) RestoreObject(req *RestoreObjectRequest) (err error) {
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}?restore"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
@@ -3295,10 +5102,14 @@ func (c *S3) RestoreObject(req *RestoreObjectRequest) (err error) {
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("POST", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "POST", uri, body)
 	if err != nil {
 		return
 	}
@@ -3307,13 +5118,6 @@ func (c *S3) RestoreObject(req *RestoreObjectRequest) (err error) {
 		httpReq.Header.Set("Content-Type", contentType)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-
 	return
 }
 
@@ -3324,8 +5128,56 @@ func (c *S3) RestoreObject(req *RestoreObjectRequest) (err error) {
 // multipart upload, Amazon S3 frees up the parts storage and stops
 // charging you for the parts storage.
 func (c *S3) UploadPart(req *UploadPartRequest) (resp *UploadPartOutput, err error) {
+	return c.UploadPartWithContext(context.Background(), req)
+}
+
+// UploadPartWithContext is like UploadPart, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) UploadPartWithContext(ctx context.Context, req *UploadPartRequest) (resp *UploadPartOutput, err error) {
 	resp = &UploadPartOutput{}
 
+	httpReq, err := c.newUploadPartRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UploadPart", httpReq, req, resp)
+	if err != nil {
+		return
+	}
+
+	defer httpResp.Body.Close()
+	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+		err = e
+		return
+	}
+
+	if s := httpResp.Header.Get("ETag"); s != "" {
+		resp.ETag = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
+		resp.SSECustomerAlgorithm = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
+		resp.SSECustomerKeyMD5 = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
+		resp.SSEKMSKeyID = &s
+	}
+
+	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
+		resp.ServerSideEncryption = &s
+	}
+
+	return
+}
+
+// newUploadPartRequest builds the HTTP request for UploadPart.
+func (c *S3) newUploadPartRequest(ctx context.Context, req *UploadPartRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
@@ -3334,13 +5186,13 @@ func (c *S3) UploadPart(req *UploadPartRequest) (resp *UploadPartOutput, err err
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
@@ -3354,10 +5206,14 @@ func (c *S3) UploadPart(req *UploadPartRequest) (resp *UploadPartOutput, err err
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -3367,7 +5223,7 @@ func (c *S3) UploadPart(req *UploadPartRequest) (resp *UploadPartOutput, err err
 	}
 
 	if req.ContentLength != nil {
-		httpReq.ContentLength = *req.ContentLength
+		httpReq.ContentLength = int64(*req.ContentLength)
 	}
 
 	if req.ContentMD5 != nil {
@@ -3386,7 +5242,27 @@ func (c *S3) UploadPart(req *UploadPartRequest) (resp *UploadPartOutput, err err
 		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
+	return
+}
+
+// UploadPartCopy uploads a part by copying data from an existing object as
+// data source.
+func (c *S3) UploadPartCopy(req *UploadPartCopyRequest) (resp *UploadPartCopyOutput, err error) {
+	return c.UploadPartCopyWithContext(context.Background(), req)
+}
+
+// UploadPartCopyWithContext is like UploadPartCopy, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *S3) UploadPartCopyWithContext(ctx context.Context, req *UploadPartCopyRequest) (resp *UploadPartCopyOutput, err error) {
+	resp = &UploadPartCopyOutput{}
+
+	httpReq, err := c.newUploadPartCopyRequest(ctx, req)
+	if err != nil {
+		return
+	}
+
+	httpResp, err := c.client.DoOperation("UploadPartCopy", httpReq, req, resp)
 	if err != nil {
 		return
 	}
@@ -3397,57 +5273,44 @@ func (c *S3) UploadPart(req *UploadPartRequest) (resp *UploadPartOutput, err err
 		return
 	}
 
-	if s := httpResp.Header.Get("ETag"); s != "" {
-
-		resp.ETag = &s
-
+	if s := httpResp.Header.Get("x-amz-copy-source-version-id"); s != "" {
+		resp.CopySourceVersionID = &s
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
-
 		resp.SSECustomerAlgorithm = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
-
 		resp.SSECustomerKeyMD5 = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
-
 		resp.SSEKMSKeyID = &s
-
 	}
 
 	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
-
 		resp.ServerSideEncryption = &s
-
 	}
 
 	return
 }
 
-// UploadPartCopy uploads a part by copying data from an existing object as
-// data source.
-func (c *S3) UploadPartCopy(req *UploadPartCopyRequest) (resp *UploadPartCopyOutput, err error) {
-	resp = &UploadPartCopyOutput{}
-
+// newUploadPartCopyRequest builds the HTTP request for UploadPartCopy.
+func (c *S3) newUploadPartCopyRequest(ctx context.Context, req *UploadPartCopyRequest) (httpReq *http.Request, err error) {
 	var body io.Reader
 	var contentType string
 
 	uri := c.client.Endpoint + "/{Bucket}/{Key+}"
 
 	if req.Bucket != nil {
-		uri = strings.Replace(uri, "{"+"Bucket"+"}", *req.Bucket, -1)
-		uri = strings.Replace(uri, "{"+"Bucket+"+"}", *req.Bucket, -1)
+		uri = strings.Replace(uri, "{Bucket}", aws.EscapePath(*req.Bucket, false), -1)
+		uri = strings.Replace(uri, "{Bucket+}", aws.EscapePath(*req.Bucket, true), -1)
 	}
 
 	if req.Key != nil {
-		uri = strings.Replace(uri, "{"+"Key"+"}", *req.Key, -1)
-		uri = strings.Replace(uri, "{"+"Key+"+"}", *req.Key, -1)
+		uri = strings.Replace(uri, "{Key}", aws.EscapePath(*req.Key, false), -1)
+		uri = strings.Replace(uri, "{Key+}", aws.EscapePath(*req.Key, true), -1)
 	}
 
 	q := url.Values{}
@@ -3461,10 +5324,14 @@ func (c *S3) UploadPartCopy(req *UploadPartCopyRequest) (resp *UploadPartCopyOut
 	}
 
 	if len(q) > 0 {
-		uri += "?" + q.Encode()
+		if strings.Contains(uri, "?") {
+			uri += "&" + q.Encode()
+		} else {
+			uri += "?" + q.Encode()
+		}
 	}
 
-	httpReq, err := http.NewRequest("PUT", uri, body)
+	httpReq, err = http.NewRequestWithContext(ctx, "PUT", uri, body)
 	if err != nil {
 		return
 	}
@@ -3481,16 +5348,16 @@ func (c *S3) UploadPartCopy(req *UploadPartCopyRequest) (resp *UploadPartCopyOut
 		httpReq.Header.Set("x-amz-copy-source-if-match", *req.CopySourceIfMatch)
 	}
 
-	if req.CopySourceIfModifiedSince != (time.Time{}) {
-		httpReq.Header.Set("x-amz-copy-source-if-modified-since", req.CopySourceIfModifiedSince.Format(time.RFC822))
+	if !req.CopySourceIfModifiedSince.IsZero() {
+		httpReq.Header.Set("x-amz-copy-source-if-modified-since", aws.FormatTimestamp(req.CopySourceIfModifiedSince, "rfc822"))
 	}
 
 	if req.CopySourceIfNoneMatch != nil {
 		httpReq.Header.Set("x-amz-copy-source-if-none-match", *req.CopySourceIfNoneMatch)
 	}
 
-	if req.CopySourceIfUnmodifiedSince != (time.Time{}) {
-		httpReq.Header.Set("x-amz-copy-source-if-unmodified-since", req.CopySourceIfUnmodifiedSince.Format(time.RFC822))
+	if !req.CopySourceIfUnmodifiedSince.IsZero() {
+		httpReq.Header.Set("x-amz-copy-source-if-unmodified-since", aws.FormatTimestamp(req.CopySourceIfUnmodifiedSince, "rfc822"))
 	}
 
 	if req.CopySourceRange != nil {
@@ -3521,47 +5388,6 @@ func (c *S3) UploadPartCopy(req *UploadPartCopyRequest) (resp *UploadPartCopyOut
 		httpReq.Header.Set("x-amz-server-side-encryption-customer-key-MD5", *req.SSECustomerKeyMD5)
 	}
 
-	httpResp, err := c.client.Do(httpReq)
-	if err != nil {
-		return
-	}
-
-	defer httpResp.Body.Close()
-	if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-		err = e
-		return
-	}
-
-	if s := httpResp.Header.Get("x-amz-copy-source-version-id"); s != "" {
-
-		resp.CopySourceVersionID = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-algorithm"); s != "" {
-
-		resp.SSECustomerAlgorithm = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-customer-key-MD5"); s != "" {
-
-		resp.SSECustomerKeyMD5 = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption-aws-kms-key-id"); s != "" {
-
-		resp.SSEKMSKeyID = &s
-
-	}
-
-	if s := httpResp.Header.Get("x-amz-server-side-encryption"); s != "" {
-
-		resp.ServerSideEncryption = &s
-
-	}
-
 	return
 }
 
@@ -3709,7 +5535,7 @@ type CompleteMultipartUploadOutput struct {
 	Expiration           aws.StringValue `xml:"-"`
 	Key                  aws.StringValue `xml:"Key"`
 	Location             aws.StringValue `xml:"Location"`
-	SSEKMSKeyID          aws.StringValue `xml:"-"`
+	SSEKMSKeyID          aws.StringValue `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption aws.StringValue `xml:"-"`
 	VersionID            aws.StringValue `xml:"-"`
 }
@@ -3776,7 +5602,7 @@ type CopyObjectOutput struct {
 	Expiration           aws.StringValue   `xml:"-"`
 	SSECustomerAlgorithm aws.StringValue   `xml:"-"`
 	SSECustomerKeyMD5    aws.StringValue   `xml:"-"`
-	SSEKMSKeyID          aws.StringValue   `xml:"-"`
+	SSEKMSKeyID          aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption aws.StringValue   `xml:"-"`
 }
 
@@ -3801,7 +5627,7 @@ type CopyObjectRequest struct {
 	CopySourceIfNoneMatch          aws.StringValue   `xml:"-"`
 	CopySourceIfUnmodifiedSince    time.Time         `xml:"-"`
 	CopySourceSSECustomerAlgorithm aws.StringValue   `xml:"-"`
-	CopySourceSSECustomerKey       aws.StringValue   `xml:"-"`
+	CopySourceSSECustomerKey       aws.StringValue   `xml:"-" sensitive:"x-amz-copy-source-server-side-encryption-customer-key"`
 	CopySourceSSECustomerKeyMD5    aws.StringValue   `xml:"-"`
 	Expires                        time.Time         `xml:"-"`
 	GrantFullControl               aws.StringValue   `xml:"-"`
@@ -3812,9 +5638,9 @@ type CopyObjectRequest struct {
 	Metadata                       map[string]string `xml:"-"`
 	MetadataDirective              aws.StringValue   `xml:"-"`
 	SSECustomerAlgorithm           aws.StringValue   `xml:"-"`
-	SSECustomerKey                 aws.StringValue   `xml:"-"`
+	SSECustomerKey                 aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-customer-key"`
 	SSECustomerKeyMD5              aws.StringValue   `xml:"-"`
-	SSEKMSKeyID                    aws.StringValue   `xml:"-"`
+	SSEKMSKeyID                    aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption           aws.StringValue   `xml:"-"`
 	StorageClass                   aws.StringValue   `xml:"-"`
 	WebsiteRedirectLocation        aws.StringValue   `xml:"-"`
@@ -3896,7 +5722,7 @@ type CreateMultipartUploadOutput struct {
 	Key                  aws.StringValue `xml:"Key"`
 	SSECustomerAlgorithm aws.StringValue `xml:"-"`
 	SSECustomerKeyMD5    aws.StringValue `xml:"-"`
-	SSEKMSKeyID          aws.StringValue `xml:"-"`
+	SSEKMSKeyID          aws.StringValue `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption aws.StringValue `xml:"-"`
 	UploadID             aws.StringValue `xml:"UploadId"`
 }
@@ -3924,9 +5750,9 @@ type CreateMultipartUploadRequest struct {
 	Key                     aws.StringValue   `xml:"-"`
 	Metadata                map[string]string `xml:"-"`
 	SSECustomerAlgorithm    aws.StringValue   `xml:"-"`
-	SSECustomerKey          aws.StringValue   `xml:"-"`
+	SSECustomerKey          aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-customer-key"`
 	SSECustomerKeyMD5       aws.StringValue   `xml:"-"`
-	SSEKMSKeyID             aws.StringValue   `xml:"-"`
+	SSEKMSKeyID             aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption    aws.StringValue   `xml:"-"`
 	StorageClass            aws.StringValue   `xml:"-"`
 	WebsiteRedirectLocation aws.StringValue   `xml:"-"`
@@ -4435,7 +6261,7 @@ type GetObjectOutput struct {
 	Restore                 aws.StringValue   `xml:"-"`
 	SSECustomerAlgorithm    aws.StringValue   `xml:"-"`
 	SSECustomerKeyMD5       aws.StringValue   `xml:"-"`
-	SSEKMSKeyID             aws.StringValue   `xml:"-"`
+	SSEKMSKeyID             aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption    aws.StringValue   `xml:"-"`
 	VersionID               aws.StringValue   `xml:"-"`
 	WebsiteRedirectLocation aws.StringValue   `xml:"-"`
@@ -4463,7 +6289,7 @@ type GetObjectRequest struct {
 	ResponseContentType        aws.StringValue `xml:"-"`
 	ResponseExpires            time.Time       `xml:"-"`
 	SSECustomerAlgorithm       aws.StringValue `xml:"-"`
-	SSECustomerKey             aws.StringValue `xml:"-"`
+	SSECustomerKey             aws.StringValue `xml:"-" sensitive:"x-amz-server-side-encryption-customer-key"`
 	SSECustomerKeyMD5          aws.StringValue `xml:"-"`
 	VersionID                  aws.StringValue `xml:"-"`
 }
@@ -4554,7 +6380,7 @@ type HeadObjectOutput struct {
 	Restore                 aws.StringValue   `xml:"-"`
 	SSECustomerAlgorithm    aws.StringValue   `xml:"-"`
 	SSECustomerKeyMD5       aws.StringValue   `xml:"-"`
-	SSEKMSKeyID             aws.StringValue   `xml:"-"`
+	SSEKMSKeyID             aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption    aws.StringValue   `xml:"-"`
 	VersionID               aws.StringValue   `xml:"-"`
 	WebsiteRedirectLocation aws.StringValue   `xml:"-"`
@@ -4576,7 +6402,7 @@ type HeadObjectRequest struct {
 	Key                  aws.StringValue `xml:"-"`
 	Range                aws.StringValue `xml:"-"`
 	SSECustomerAlgorithm aws.StringValue `xml:"-"`
-	SSECustomerKey       aws.StringValue `xml:"-"`
+	SSECustomerKey       aws.StringValue `xml:"-" sensitive:"x-amz-server-side-encryption-customer-key"`
 	SSECustomerKeyMD5    aws.StringValue `xml:"-"`
 	VersionID            aws.StringValue `xml:"-"`
 }
@@ -5157,7 +6983,7 @@ type PutObjectOutput struct {
 	Expiration           aws.StringValue `xml:"-"`
 	SSECustomerAlgorithm aws.StringValue `xml:"-"`
 	SSECustomerKeyMD5    aws.StringValue `xml:"-"`
-	SSEKMSKeyID          aws.StringValue `xml:"-"`
+	SSEKMSKeyID          aws.StringValue `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption aws.StringValue `xml:"-"`
 	VersionID            aws.StringValue `xml:"-"`
 }
@@ -5188,9 +7014,9 @@ type PutObjectRequest struct {
 	Key                     aws.StringValue   `xml:"-"`
 	Metadata                map[string]string `xml:"-"`
 	SSECustomerAlgorithm    aws.StringValue   `xml:"-"`
-	SSECustomerKey          aws.StringValue   `xml:"-"`
+	SSECustomerKey          aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-customer-key"`
 	SSECustomerKeyMD5       aws.StringValue   `xml:"-"`
-	SSEKMSKeyID             aws.StringValue   `xml:"-"`
+	SSEKMSKeyID             aws.StringValue   `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption    aws.StringValue   `xml:"-"`
 	StorageClass            aws.StringValue   `xml:"-"`
 	WebsiteRedirectLocation aws.StringValue   `xml:"-"`
@@ -5399,7 +7225,7 @@ type UploadPartCopyOutput struct {
 	CopySourceVersionID  aws.StringValue `xml:"-"`
 	SSECustomerAlgorithm aws.StringValue `xml:"-"`
 	SSECustomerKeyMD5    aws.StringValue `xml:"-"`
-	SSEKMSKeyID          aws.StringValue `xml:"-"`
+	SSEKMSKeyID          aws.StringValue `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption aws.StringValue `xml:"-"`
 }
 
@@ -5419,12 +7245,12 @@ type UploadPartCopyRequest struct {
 	CopySourceIfUnmodifiedSince    time.Time        `xml:"-"`
 	CopySourceRange                aws.StringValue  `xml:"-"`
 	CopySourceSSECustomerAlgorithm aws.StringValue  `xml:"-"`
-	CopySourceSSECustomerKey       aws.StringValue  `xml:"-"`
+	CopySourceSSECustomerKey       aws.StringValue  `xml:"-" sensitive:"x-amz-copy-source-server-side-encryption-customer-key"`
 	CopySourceSSECustomerKeyMD5    aws.StringValue  `xml:"-"`
 	Key                            aws.StringValue  `xml:"-"`
 	PartNumber                     aws.IntegerValue `xml:"-"`
 	SSECustomerAlgorithm           aws.StringValue  `xml:"-"`
-	SSECustomerKey                 aws.StringValue  `xml:"-"`
+	SSECustomerKey                 aws.StringValue  `xml:"-" sensitive:"x-amz-server-side-encryption-customer-key"`
 	SSECustomerKeyMD5              aws.StringValue  `xml:"-"`
 	UploadID                       aws.StringValue  `xml:"-"`
 }
@@ -5440,7 +7266,7 @@ type UploadPartOutput struct {
 	ETag                 aws.StringValue `xml:"-"`
 	SSECustomerAlgorithm aws.StringValue `xml:"-"`
 	SSECustomerKeyMD5    aws.StringValue `xml:"-"`
-	SSEKMSKeyID          aws.StringValue `xml:"-"`
+	SSEKMSKeyID          aws.StringValue `xml:"-" sensitive:"x-amz-server-side-encryption-aws-kms-key-id"`
 	ServerSideEncryption aws.StringValue `xml:"-"`
 }
 
@@ -5459,7 +7285,7 @@ type UploadPartRequest struct {
 	Key                  aws.StringValue  `xml:"-"`
 	PartNumber           aws.IntegerValue `xml:"-"`
 	SSECustomerAlgorithm aws.StringValue  `xml:"-"`
-	SSECustomerKey       aws.StringValue  `xml:"-"`
+	SSECustomerKey       aws.StringValue  `xml:"-" sensitive:"x-amz-server-side-encryption-customer-key"`
 	SSECustomerKeyMD5    aws.StringValue  `xml:"-"`
 	UploadID             aws.StringValue  `xml:"-"`
 }
@@ -5494,6 +7320,46 @@ func (v *WebsiteConfiguration) MarshalXML(e *xml.Encoder, start xml.StartElement
 	return aws.MarshalXML(v, e, start)
 }
 
+// BucketAlreadyExists is the error returned for the BucketAlreadyExists error code.
+type BucketAlreadyExists struct {
+	aws.APIError
+}
+
+// NoSuchBucket is the error returned for the NoSuchBucket error code.
+type NoSuchBucket struct {
+	aws.APIError
+}
+
+// NoSuchKey is the error returned for the NoSuchKey error code.
+type NoSuchKey struct {
+	aws.APIError
+}
+
+// NoSuchUpload is the error returned for the NoSuchUpload error code.
+type NoSuchUpload struct {
+	aws.APIError
+}
+
+// ObjectAlreadyInActiveTierError is the error returned for the ObjectAlreadyInActiveTierError error code.
+type ObjectAlreadyInActiveTierError struct {
+	aws.APIError
+}
+
+// ObjectNotInActiveTierError is the error returned for the ObjectNotInActiveTierError error code.
+type ObjectNotInActiveTierError struct {
+	aws.APIError
+}
+
+// exceptions maps S3's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"BucketAlreadyExists":            BucketAlreadyExists{},
+	"NoSuchBucket":                   NoSuchBucket{},
+	"NoSuchKey":                      NoSuchKey{},
+	"NoSuchUpload":                   NoSuchUpload{},
+	"ObjectAlreadyInActiveTierError": ObjectAlreadyInActiveTierError{},
+	"ObjectNotInActiveTierError":     ObjectNotInActiveTierError{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
 
@@ -5503,3 +7369,4 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
@@ -4,11 +4,21 @@
 package sdb
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // SDB is a client for Amazon SimpleDB.
@@ -16,37 +26,86 @@ type SDB struct {
 	client *aws.QueryClient
 }
 
-// New returns a new SDB client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *SDB {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "sdb"
-	endpoint, service, region := endpoints.Lookup("sdb", region)
+// New returns a new SDB client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *SDB {
+	cfg := newConfig(region, client, opts)
 
 	return &SDB{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+				Signer:      aws.V2Signer{},
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2009-04-15",
 		},
 	}
 }
 
+// NewFromSession returns a new SDB client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *SDB {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("sdb", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("sdb", "sdb"),
+	}, "AWS_ENDPOINT_URL_SIMPLEDB", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *SDB) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *SDB) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *SDB) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // BatchDeleteAttributes performs multiple DeleteAttributes operations in a
 // single call, which reduces round trips and latencies. This enables
 // Amazon SimpleDB to optimize requests, which generally yields better
 // throughput. The following limitations are enforced for this operation: 1
 // MB request size
 func (c *SDB) BatchDeleteAttributes(req *BatchDeleteAttributesRequest) (err error) {
+	return c.BatchDeleteAttributesWithContext(context.Background(), req)
+}
+
+// BatchDeleteAttributesWithContext is like BatchDeleteAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) BatchDeleteAttributesWithContext(ctx context.Context, req *BatchDeleteAttributesRequest) (err error) {
 	// NRE
-	err = c.client.Do("BatchDeleteAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "BatchDeleteAttributes", "POST", "/", req, nil)
 	return
 }
 
@@ -83,8 +142,15 @@ func (c *SDB) BatchDeleteAttributes(req *BatchDeleteAttributesRequest) (err erro
 // Service Unavailable (503) responses. The following limitations are
 // enforced for this operation: 256 attribute name-value pairs per item
 func (c *SDB) BatchPutAttributes(req *BatchPutAttributesRequest) (err error) {
+	return c.BatchPutAttributesWithContext(context.Background(), req)
+}
+
+// BatchPutAttributesWithContext is like BatchPutAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) BatchPutAttributesWithContext(ctx context.Context, req *BatchPutAttributesRequest) (err error) {
 	// NRE
-	err = c.client.Do("BatchPutAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "BatchPutAttributes", "POST", "/", req, nil)
 	return
 }
 
@@ -95,8 +161,15 @@ func (c *SDB) BatchPutAttributes(req *BatchPutAttributesRequest) (err error) {
 // account. If the client requires additional domains, go to
 // http://aws.amazon.com/contact-us/simpledb-limit-request/ .
 func (c *SDB) CreateDomain(req *CreateDomainRequest) (err error) {
+	return c.CreateDomainWithContext(context.Background(), req)
+}
+
+// CreateDomainWithContext is like CreateDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) CreateDomainWithContext(ctx context.Context, req *CreateDomainRequest) (err error) {
 	// NRE
-	err = c.client.Do("CreateDomain", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "CreateDomain", "POST", "/", req, nil)
 	return
 }
 
@@ -109,8 +182,15 @@ func (c *SDB) CreateDomain(req *CreateDomainRequest) (err error) {
 // operation (read) immediately after a DeleteAttributes or PutAttributes
 // operation (write) might not return updated item data.
 func (c *SDB) DeleteAttributes(req *DeleteAttributesRequest) (err error) {
+	return c.DeleteAttributesWithContext(context.Background(), req)
+}
+
+// DeleteAttributesWithContext is like DeleteAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) DeleteAttributesWithContext(ctx context.Context, req *DeleteAttributesRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteAttributes", "POST", "/", req, nil)
 	return
 }
 
@@ -118,8 +198,15 @@ func (c *SDB) DeleteAttributes(req *DeleteAttributesRequest) (err error) {
 // their attributes) in the domain are deleted as well. The DeleteDomain
 // operation might take 10 or more seconds to complete.
 func (c *SDB) DeleteDomain(req *DeleteDomainRequest) (err error) {
+	return c.DeleteDomainWithContext(context.Background(), req)
+}
+
+// DeleteDomainWithContext is like DeleteDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) DeleteDomainWithContext(ctx context.Context, req *DeleteDomainRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteDomain", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteDomain", "POST", "/", req, nil)
 	return
 }
 
@@ -127,8 +214,15 @@ func (c *SDB) DeleteDomain(req *DeleteDomainRequest) (err error) {
 // domain was created, the number of items and attributes in the domain,
 // and the size of the attribute names and values.
 func (c *SDB) DomainMetadata(req *DomainMetadataRequest) (resp *DomainMetadataResult, err error) {
+	return c.DomainMetadataWithContext(context.Background(), req)
+}
+
+// DomainMetadataWithContext is like DomainMetadata, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) DomainMetadataWithContext(ctx context.Context, req *DomainMetadataRequest) (resp *DomainMetadataResult, err error) {
 	resp = &DomainMetadataResult{}
-	err = c.client.Do("DomainMetadata", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DomainMetadata", "POST", "/", req, resp)
 	return
 }
 
@@ -139,8 +233,15 @@ func (c *SDB) DomainMetadata(req *DomainMetadataRequest) (resp *DomainMetadataRe
 // an empty set is returned. The system does not return an error as it
 // cannot guarantee the item does not exist on other replicas.
 func (c *SDB) GetAttributes(req *GetAttributesRequest) (resp *GetAttributesResult, err error) {
+	return c.GetAttributesWithContext(context.Background(), req)
+}
+
+// GetAttributesWithContext is like GetAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) GetAttributesWithContext(ctx context.Context, req *GetAttributesRequest) (resp *GetAttributesResult, err error) {
 	resp = &GetAttributesResult{}
-	err = c.client.Do("GetAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -151,8 +252,15 @@ func (c *SDB) GetAttributes(req *GetAttributesRequest) (resp *GetAttributesResul
 // the NextToken provided by the operation returns up to MaxNumberOfDomains
 // more domain names with each successive operation call.
 func (c *SDB) ListDomains(req *ListDomainsRequest) (resp *ListDomainsResult, err error) {
+	return c.ListDomainsWithContext(context.Background(), req)
+}
+
+// ListDomainsWithContext is like ListDomains, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) ListDomainsWithContext(ctx context.Context, req *ListDomainsRequest) (resp *ListDomainsResult, err error) {
 	resp = &ListDomainsResult{}
-	err = c.client.Do("ListDomains", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDomains", "POST", "/", req, resp)
 	return
 }
 
@@ -182,8 +290,15 @@ func (c *SDB) ListDomains(req *ListDomainsRequest) (resp *ListDomainsResult, err
 // enforced for this operation: 256 total attribute name-value pairs per
 // item
 func (c *SDB) PutAttributes(req *PutAttributesRequest) (err error) {
+	return c.PutAttributesWithContext(context.Background(), req)
+}
+
+// PutAttributesWithContext is like PutAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) PutAttributesWithContext(ctx context.Context, req *PutAttributesRequest) (err error) {
 	// NRE
-	err = c.client.Do("PutAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PutAttributes", "POST", "/", req, nil)
 	return
 }
 
@@ -198,8 +313,15 @@ func (c *SDB) PutAttributes(req *PutAttributesRequest) (err error) {
 // select expressions, see Using Select to Create Amazon SimpleDB Queries
 // in the Developer Guide.
 func (c *SDB) Select(req *SelectRequest) (resp *SelectResult, err error) {
+	return c.SelectWithContext(context.Background(), req)
+}
+
+// SelectWithContext is like Select, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SDB) SelectWithContext(ctx context.Context, req *SelectRequest) (resp *SelectResult, err error) {
 	resp = &SelectResult{}
-	err = c.client.Do("Select", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "Select", "POST", "/", req, resp)
 	return
 }
 
@@ -211,16 +333,80 @@ type Attribute struct {
 	Value                  aws.StringValue `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the Attribute to the form, prefixing their names.
+func (v *Attribute) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AlternateNameEncoding != nil {
+		form.Set(prefix+"AlternateNameEncoding", *v.AlternateNameEncoding)
+	}
+	if v.AlternateValueEncoding != nil {
+		form.Set(prefix+"AlternateValueEncoding", *v.AlternateValueEncoding)
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // BatchDeleteAttributesRequest is undocumented.
 type BatchDeleteAttributesRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
-	Items      []DeletableItem `query:"Items" xml:"Item"`
+	Items      []DeletableItem `query:"Item,list" xml:"Item"`
+}
+
+// MarshalQuery adds the parameters of the BatchDeleteAttributesRequest to the form, prefixing their names.
+func (v *BatchDeleteAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.Items != nil {
+		if len(v.Items) == 0 {
+			form.Set(prefix+"Item", "")
+		}
+		for i1 := range v.Items {
+			name1 := prefix + "Item." + strconv.Itoa(i1+1)
+			if err := v.Items[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // BatchPutAttributesRequest is undocumented.
 type BatchPutAttributesRequest struct {
 	DomainName aws.StringValue   `query:"DomainName" xml:"DomainName"`
-	Items      []ReplaceableItem `query:"Items" xml:"Item"`
+	Items      []ReplaceableItem `query:"Item,list" xml:"Item"`
+}
+
+// MarshalQuery adds the parameters of the BatchPutAttributesRequest to the form, prefixing their names.
+func (v *BatchPutAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.Items != nil {
+		if len(v.Items) == 0 {
+			form.Set(prefix+"Item", "")
+		}
+		for i1 := range v.Items {
+			name1 := prefix + "Item." + strconv.Itoa(i1+1)
+			if err := v.Items[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // CreateDomainRequest is undocumented.
@@ -228,30 +414,115 @@ type CreateDomainRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the CreateDomainRequest to the form, prefixing their names.
+func (v *CreateDomainRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DeletableItem is undocumented.
 type DeletableItem struct {
-	Attributes []Attribute     `query:"Attributes" xml:"Attribute"`
+	Attributes []Attribute     `query:"Attribute,list" xml:"Attribute"`
 	Name       aws.StringValue `query:"ItemName" xml:"ItemName"`
 }
 
+// MarshalQuery adds the parameters of the DeletableItem to the form, prefixing their names.
+func (v *DeletableItem) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attributes != nil {
+		if len(v.Attributes) == 0 {
+			form.Set(prefix+"Attribute", "")
+		}
+		for i1 := range v.Attributes {
+			name1 := prefix + "Attribute." + strconv.Itoa(i1+1)
+			if err := v.Attributes[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Name != nil {
+		form.Set(prefix+"ItemName", *v.Name)
+	}
+	return nil
+}
+
 // DeleteAttributesRequest is undocumented.
 type DeleteAttributesRequest struct {
-	Attributes []Attribute      `query:"Attributes" xml:"Attribute"`
+	Attributes []Attribute      `query:"Attribute,list" xml:"Attribute"`
 	DomainName aws.StringValue  `query:"DomainName" xml:"DomainName"`
 	Expected   *UpdateCondition `query:"Expected" xml:"Expected"`
 	ItemName   aws.StringValue  `query:"ItemName" xml:"ItemName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteAttributesRequest to the form, prefixing their names.
+func (v *DeleteAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attributes != nil {
+		if len(v.Attributes) == 0 {
+			form.Set(prefix+"Attribute", "")
+		}
+		for i1 := range v.Attributes {
+			name1 := prefix + "Attribute." + strconv.Itoa(i1+1)
+			if err := v.Attributes[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.Expected != nil {
+		if err := v.Expected.MarshalQuery(form, prefix+"Expected."); err != nil {
+			return err
+		}
+	}
+	if v.ItemName != nil {
+		form.Set(prefix+"ItemName", *v.ItemName)
+	}
+	return nil
+}
+
 // DeleteDomainRequest is undocumented.
 type DeleteDomainRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DeleteDomainRequest to the form, prefixing their names.
+func (v *DeleteDomainRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DomainMetadataRequest is undocumented.
 type DomainMetadataRequest struct {
 	DomainName aws.StringValue `query:"DomainName" xml:"DomainName"`
 }
 
+// MarshalQuery adds the parameters of the DomainMetadataRequest to the form, prefixing their names.
+func (v *DomainMetadataRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	return nil
+}
+
 // DomainMetadataResult is undocumented.
 type DomainMetadataResult struct {
 	AttributeNameCount       aws.IntegerValue `query:"AttributeNameCount" xml:"DomainMetadataResult>AttributeNameCount"`
@@ -265,21 +536,47 @@ type DomainMetadataResult struct {
 
 // GetAttributesRequest is undocumented.
 type GetAttributesRequest struct {
-	AttributeNames []string         `query:"AttributeNames" xml:"AttributeName"`
+	AttributeNames []string         `query:"AttributeName,list" xml:"AttributeName"`
 	ConsistentRead aws.BooleanValue `query:"ConsistentRead" xml:"ConsistentRead"`
 	DomainName     aws.StringValue  `query:"DomainName" xml:"DomainName"`
 	ItemName       aws.StringValue  `query:"ItemName" xml:"ItemName"`
 }
 
+// MarshalQuery adds the parameters of the GetAttributesRequest to the form, prefixing their names.
+func (v *GetAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttributeNames != nil {
+		if len(v.AttributeNames) == 0 {
+			form.Set(prefix+"AttributeName", "")
+		}
+		for i1 := range v.AttributeNames {
+			name1 := prefix + "AttributeName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AttributeNames[i1])
+		}
+	}
+	if v.ConsistentRead != nil {
+		form.Set(prefix+"ConsistentRead", strconv.FormatBool(*v.ConsistentRead))
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.ItemName != nil {
+		form.Set(prefix+"ItemName", *v.ItemName)
+	}
+	return nil
+}
+
 // GetAttributesResult is undocumented.
 type GetAttributesResult struct {
-	Attributes []Attribute `query:"Attributes" xml:"GetAttributesResult>Attribute"`
+	Attributes []Attribute `query:"Attribute,list" xml:"GetAttributesResult>Attribute"`
 }
 
 // Item is undocumented.
 type Item struct {
 	AlternateNameEncoding aws.StringValue `query:"AlternateNameEncoding" xml:"AlternateNameEncoding"`
-	Attributes            []Attribute     `query:"Attributes" xml:"Attribute"`
+	Attributes            []Attribute     `query:"Attribute,list" xml:"Attribute"`
 	Name                  aws.StringValue `query:"Name" xml:"Name"`
 }
 
@@ -289,20 +586,64 @@ type ListDomainsRequest struct {
 	NextToken          aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the ListDomainsRequest to the form, prefixing their names.
+func (v *ListDomainsRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.MaxNumberOfDomains != nil {
+		form.Set(prefix+"MaxNumberOfDomains", strconv.FormatInt(int64(*v.MaxNumberOfDomains), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // ListDomainsResult is undocumented.
 type ListDomainsResult struct {
-	DomainNames []string        `query:"DomainNames" xml:"ListDomainsResult>DomainName"`
+	DomainNames []string        `query:"DomainName,list" xml:"ListDomainsResult>DomainName"`
 	NextToken   aws.StringValue `query:"NextToken" xml:"ListDomainsResult>NextToken"`
 }
 
 // PutAttributesRequest is undocumented.
 type PutAttributesRequest struct {
-	Attributes []ReplaceableAttribute `query:"Attributes" xml:"Attribute"`
+	Attributes []ReplaceableAttribute `query:"Attribute,list" xml:"Attribute"`
 	DomainName aws.StringValue        `query:"DomainName" xml:"DomainName"`
 	Expected   *UpdateCondition       `query:"Expected" xml:"Expected"`
 	ItemName   aws.StringValue        `query:"ItemName" xml:"ItemName"`
 }
 
+// MarshalQuery adds the parameters of the PutAttributesRequest to the form, prefixing their names.
+func (v *PutAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attributes != nil {
+		if len(v.Attributes) == 0 {
+			form.Set(prefix+"Attribute", "")
+		}
+		for i1 := range v.Attributes {
+			name1 := prefix + "Attribute." + strconv.Itoa(i1+1)
+			if err := v.Attributes[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.DomainName != nil {
+		form.Set(prefix+"DomainName", *v.DomainName)
+	}
+	if v.Expected != nil {
+		if err := v.Expected.MarshalQuery(form, prefix+"Expected."); err != nil {
+			return err
+		}
+	}
+	if v.ItemName != nil {
+		form.Set(prefix+"ItemName", *v.ItemName)
+	}
+	return nil
+}
+
 // ReplaceableAttribute is undocumented.
 type ReplaceableAttribute struct {
 	Name    aws.StringValue  `query:"Name" xml:"Name"`
@@ -310,12 +651,51 @@ type ReplaceableAttribute struct {
 	Value   aws.StringValue  `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the ReplaceableAttribute to the form, prefixing their names.
+func (v *ReplaceableAttribute) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Replace != nil {
+		form.Set(prefix+"Replace", strconv.FormatBool(*v.Replace))
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
 // ReplaceableItem is undocumented.
 type ReplaceableItem struct {
-	Attributes []ReplaceableAttribute `query:"Attributes" xml:"Attribute"`
+	Attributes []ReplaceableAttribute `query:"Attribute,list" xml:"Attribute"`
 	Name       aws.StringValue        `query:"ItemName" xml:"ItemName"`
 }
 
+// MarshalQuery adds the parameters of the ReplaceableItem to the form, prefixing their names.
+func (v *ReplaceableItem) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Attributes != nil {
+		if len(v.Attributes) == 0 {
+			form.Set(prefix+"Attribute", "")
+		}
+		for i1 := range v.Attributes {
+			name1 := prefix + "Attribute." + strconv.Itoa(i1+1)
+			if err := v.Attributes[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.Name != nil {
+		form.Set(prefix+"ItemName", *v.Name)
+	}
+	return nil
+}
+
 // SelectRequest is undocumented.
 type SelectRequest struct {
 	ConsistentRead   aws.BooleanValue `query:"ConsistentRead" xml:"ConsistentRead"`
@@ -323,9 +703,26 @@ type SelectRequest struct {
 	SelectExpression aws.StringValue  `query:"SelectExpression" xml:"SelectExpression"`
 }
 
+// MarshalQuery adds the parameters of the SelectRequest to the form, prefixing their names.
+func (v *SelectRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ConsistentRead != nil {
+		form.Set(prefix+"ConsistentRead", strconv.FormatBool(*v.ConsistentRead))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.SelectExpression != nil {
+		form.Set(prefix+"SelectExpression", *v.SelectExpression)
+	}
+	return nil
+}
+
 // SelectResult is undocumented.
 type SelectResult struct {
-	Items     []Item          `query:"Items" xml:"SelectResult>Item"`
+	Items     []Item          `query:"Item,list" xml:"SelectResult>Item"`
 	NextToken aws.StringValue `query:"NextToken" xml:"SelectResult>NextToken"`
 }
 
@@ -336,5 +733,168 @@ type UpdateCondition struct {
 	Value  aws.StringValue  `query:"Value" xml:"Value"`
 }
 
+// MarshalQuery adds the parameters of the UpdateCondition to the form, prefixing their names.
+func (v *UpdateCondition) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Exists != nil {
+		form.Set(prefix+"Exists", strconv.FormatBool(*v.Exists))
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Value != nil {
+		form.Set(prefix+"Value", *v.Value)
+	}
+	return nil
+}
+
+// AttributeDoesNotExist is the error returned for the AttributeDoesNotExist error code.
+type AttributeDoesNotExist struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// DuplicateItemName is the error returned for the DuplicateItemName error code.
+type DuplicateItemName struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// InvalidNextToken is the error returned for the InvalidNextToken error code.
+type InvalidNextToken struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// InvalidNumberPredicates is the error returned for the InvalidNumberPredicates error code.
+type InvalidNumberPredicates struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// InvalidNumberValueTests is the error returned for the InvalidNumberValueTests error code.
+type InvalidNumberValueTests struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// InvalidParameterValue is the error returned for the InvalidParameterValue error code.
+type InvalidParameterValue struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// InvalidQueryExpression is the error returned for the InvalidQueryExpression error code.
+type InvalidQueryExpression struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// MissingParameter is the error returned for the MissingParameter error code.
+type MissingParameter struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// NoSuchDomain is the error returned for the NoSuchDomain error code.
+type NoSuchDomain struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// NumberDomainAttributesExceeded is the error returned for the NumberDomainAttributesExceeded error code.
+type NumberDomainAttributesExceeded struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// NumberDomainBytesExceeded is the error returned for the NumberDomainBytesExceeded error code.
+type NumberDomainBytesExceeded struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// NumberDomainsExceeded is the error returned for the NumberDomainsExceeded error code.
+type NumberDomainsExceeded struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// NumberItemAttributesExceeded is the error returned for the NumberItemAttributesExceeded error code.
+type NumberItemAttributesExceeded struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// NumberSubmittedAttributesExceeded is the error returned for the NumberSubmittedAttributesExceeded error code.
+type NumberSubmittedAttributesExceeded struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// NumberSubmittedItemsExceeded is the error returned for the NumberSubmittedItemsExceeded error code.
+type NumberSubmittedItemsExceeded struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// RequestTimeout is the error returned for the RequestTimeout error code.
+type RequestTimeout struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// TooManyRequestedAttributes is the error returned for the TooManyRequestedAttributes error code.
+type TooManyRequestedAttributes struct {
+	aws.APIError
+
+	BoxUsage aws.FloatValue `xml:"BoxUsage"`
+}
+
+// exceptions maps SDB's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AttributeDoesNotExist":             AttributeDoesNotExist{},
+	"DuplicateItemName":                 DuplicateItemName{},
+	"InvalidNextToken":                  InvalidNextToken{},
+	"InvalidNumberPredicates":           InvalidNumberPredicates{},
+	"InvalidNumberValueTests":           InvalidNumberValueTests{},
+	"InvalidParameterValue":             InvalidParameterValue{},
+	"InvalidQueryExpression":            InvalidQueryExpression{},
+	"MissingParameter":                  MissingParameter{},
+	"NoSuchDomain":                      NoSuchDomain{},
+	"NumberDomainAttributesExceeded":    NumberDomainAttributesExceeded{},
+	"NumberDomainBytesExceeded":         NumberDomainBytesExceeded{},
+	"NumberDomainsExceeded":             NumberDomainsExceeded{},
+	"NumberItemAttributesExceeded":      NumberItemAttributesExceeded{},
+	"NumberSubmittedAttributesExceeded": NumberSubmittedAttributesExceeded{},
+	"NumberSubmittedItemsExceeded":      NumberSubmittedItemsExceeded{},
+	"RequestTimeout":                    RequestTimeout{},
+	"TooManyRequestedAttributes":        TooManyRequestedAttributes{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,11 +4,21 @@
 package ses
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // SES is a client for Amazon Simple Email Service.
@@ -16,35 +26,83 @@ type SES struct {
 	client *aws.QueryClient
 }
 
-// New returns a new SES client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *SES {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "email"
-	endpoint, service, region := endpoints.Lookup("email", region)
+// New returns a new SES client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *SES {
+	cfg := newConfig(region, client, opts)
 
 	return &SES{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2010-12-01",
 		},
 	}
 }
 
+// NewFromSession returns a new SES client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *SES {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("email", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("email", "ses"),
+	}, "AWS_ENDPOINT_URL_SES", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *SES) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *SES) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *SES) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // DeleteIdentity deletes the specified identity (email address or domain)
 // from the list of verified identities. This action is throttled at one
 // request per second.
 func (c *SES) DeleteIdentity(req *DeleteIdentityRequest) (resp *DeleteIdentityResult, err error) {
+	return c.DeleteIdentityWithContext(context.Background(), req)
+}
+
+// DeleteIdentityWithContext is like DeleteIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) DeleteIdentityWithContext(ctx context.Context, req *DeleteIdentityRequest) (resp *DeleteIdentityResult, err error) {
 	resp = &DeleteIdentityResult{}
-	err = c.client.Do("DeleteIdentity", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteIdentity", "POST", "/", req, resp)
 	return
 }
 
@@ -54,8 +112,15 @@ func (c *SES) DeleteIdentity(req *DeleteIdentityRequest) (resp *DeleteIdentityRe
 // DeleteIdentity action is now preferred. This action is throttled at one
 // request per second.
 func (c *SES) DeleteVerifiedEmailAddress(req *DeleteVerifiedEmailAddressRequest) (err error) {
+	return c.DeleteVerifiedEmailAddressWithContext(context.Background(), req)
+}
+
+// DeleteVerifiedEmailAddressWithContext is like DeleteVerifiedEmailAddress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) DeleteVerifiedEmailAddressWithContext(ctx context.Context, req *DeleteVerifiedEmailAddressRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteVerifiedEmailAddress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteVerifiedEmailAddress", "POST", "/", req, nil)
 	return
 }
 
@@ -73,8 +138,15 @@ func (c *SES) DeleteVerifiedEmailAddress(req *DeleteVerifiedEmailAddressRequest)
 // information about creating DNS records using tokens, go to the Amazon
 // SES Developer Guide
 func (c *SES) GetIdentityDkimAttributes(req *GetIdentityDkimAttributesRequest) (resp *GetIdentityDkimAttributesResult, err error) {
+	return c.GetIdentityDkimAttributesWithContext(context.Background(), req)
+}
+
+// GetIdentityDkimAttributesWithContext is like GetIdentityDkimAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) GetIdentityDkimAttributesWithContext(ctx context.Context, req *GetIdentityDkimAttributesRequest) (resp *GetIdentityDkimAttributesResult, err error) {
 	resp = &GetIdentityDkimAttributesResult{}
-	err = c.client.Do("GetIdentityDkimAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetIdentityDkimAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -84,8 +156,15 @@ func (c *SES) GetIdentityDkimAttributes(req *GetIdentityDkimAttributesRequest) (
 // request per second. For more information about using notifications with
 // Amazon see the Amazon SES Developer Guide
 func (c *SES) GetIdentityNotificationAttributes(req *GetIdentityNotificationAttributesRequest) (resp *GetIdentityNotificationAttributesResult, err error) {
+	return c.GetIdentityNotificationAttributesWithContext(context.Background(), req)
+}
+
+// GetIdentityNotificationAttributesWithContext is like GetIdentityNotificationAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) GetIdentityNotificationAttributesWithContext(ctx context.Context, req *GetIdentityNotificationAttributesRequest) (resp *GetIdentityNotificationAttributesResult, err error) {
 	resp = &GetIdentityNotificationAttributesResult{}
-	err = c.client.Do("GetIdentityNotificationAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetIdentityNotificationAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -94,16 +173,30 @@ func (c *SES) GetIdentityNotificationAttributes(req *GetIdentityNotificationAttr
 // domain identities) the verification token for each identity. This action
 // is throttled at one request per second.
 func (c *SES) GetIdentityVerificationAttributes(req *GetIdentityVerificationAttributesRequest) (resp *GetIdentityVerificationAttributesResult, err error) {
+	return c.GetIdentityVerificationAttributesWithContext(context.Background(), req)
+}
+
+// GetIdentityVerificationAttributesWithContext is like GetIdentityVerificationAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) GetIdentityVerificationAttributesWithContext(ctx context.Context, req *GetIdentityVerificationAttributesRequest) (resp *GetIdentityVerificationAttributesResult, err error) {
 	resp = &GetIdentityVerificationAttributesResult{}
-	err = c.client.Do("GetIdentityVerificationAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetIdentityVerificationAttributes", "POST", "/", req, resp)
 	return
 }
 
 // GetSendQuota returns the user's current sending limits. This action is
 // throttled at one request per second.
 func (c *SES) GetSendQuota() (resp *GetSendQuotaResult, err error) {
+	return c.GetSendQuotaWithContext(context.Background())
+}
+
+// GetSendQuotaWithContext is like GetSendQuota, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) GetSendQuotaWithContext(ctx context.Context) (resp *GetSendQuotaResult, err error) {
 	resp = &GetSendQuotaResult{}
-	err = c.client.Do("GetSendQuota", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "GetSendQuota", "POST", "/", nil, resp)
 	return
 }
 
@@ -112,8 +205,15 @@ func (c *SES) GetSendQuota() (resp *GetSendQuotaResult, err error) {
 // activity. Each data point in the list contains statistics for a
 // 15-minute interval. This action is throttled at one request per second.
 func (c *SES) GetSendStatistics() (resp *GetSendStatisticsResult, err error) {
+	return c.GetSendStatisticsWithContext(context.Background())
+}
+
+// GetSendStatisticsWithContext is like GetSendStatistics, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) GetSendStatisticsWithContext(ctx context.Context) (resp *GetSendStatisticsResult, err error) {
 	resp = &GetSendStatisticsResult{}
-	err = c.client.Do("GetSendStatistics", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "GetSendStatistics", "POST", "/", nil, resp)
 	return
 }
 
@@ -121,8 +221,15 @@ func (c *SES) GetSendStatistics() (resp *GetSendStatisticsResult, err error) {
 // addresses and domains) for a specific AWS Account, regardless of
 // verification status. This action is throttled at one request per second.
 func (c *SES) ListIdentities(req *ListIdentitiesRequest) (resp *ListIdentitiesResult, err error) {
+	return c.ListIdentitiesWithContext(context.Background(), req)
+}
+
+// ListIdentitiesWithContext is like ListIdentities, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) ListIdentitiesWithContext(ctx context.Context, req *ListIdentitiesRequest) (resp *ListIdentitiesResult, err error) {
 	resp = &ListIdentitiesResult{}
-	err = c.client.Do("ListIdentities", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListIdentities", "POST", "/", req, resp)
 	return
 }
 
@@ -132,8 +239,15 @@ func (c *SES) ListIdentities(req *ListIdentitiesRequest) (resp *ListIdentitiesRe
 // ListIdentities action is now preferred. This action is throttled at one
 // request per second.
 func (c *SES) ListVerifiedEmailAddresses() (resp *ListVerifiedEmailAddressesResult, err error) {
+	return c.ListVerifiedEmailAddressesWithContext(context.Background())
+}
+
+// ListVerifiedEmailAddressesWithContext is like ListVerifiedEmailAddresses, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) ListVerifiedEmailAddressesWithContext(ctx context.Context) (resp *ListVerifiedEmailAddressesResult, err error) {
 	resp = &ListVerifiedEmailAddressesResult{}
-	err = c.client.Do("ListVerifiedEmailAddresses", "POST", "/", nil, resp)
+	err = c.client.DoWithContext(ctx, "ListVerifiedEmailAddresses", "POST", "/", nil, resp)
 	return
 }
 
@@ -154,8 +268,15 @@ func (c *SES) ListVerifiedEmailAddresses() (resp *ListVerifiedEmailAddressesResu
 // information about your sending quota, go to the Amazon SES Developer
 // Guide .
 func (c *SES) SendEmail(req *SendEmailRequest) (resp *SendEmailResult, err error) {
+	return c.SendEmailWithContext(context.Background(), req)
+}
+
+// SendEmailWithContext is like SendEmail, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) SendEmailWithContext(ctx context.Context, req *SendEmailRequest) (resp *SendEmailResult, err error) {
 	resp = &SendEmailResult{}
-	err = c.client.Do("SendEmail", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SendEmail", "POST", "/", req, resp)
 	return
 }
 
@@ -181,8 +302,15 @@ func (c *SES) SendEmail(req *SendEmailRequest) (resp *SendEmailResult, err error
 // in a 24-hour period. For information about your sending quota, go to the
 // Amazon SES Developer Guide .
 func (c *SES) SendRawEmail(req *SendRawEmailRequest) (resp *SendRawEmailResult, err error) {
+	return c.SendRawEmailWithContext(context.Background(), req)
+}
+
+// SendRawEmailWithContext is like SendRawEmail, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) SendRawEmailWithContext(ctx context.Context, req *SendRawEmailRequest) (resp *SendRawEmailResult, err error) {
 	resp = &SendRawEmailResult{}
-	err = c.client.Do("SendRawEmail", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SendRawEmail", "POST", "/", req, resp)
 	return
 }
 
@@ -198,8 +326,15 @@ func (c *SES) SendRawEmail(req *SendRawEmailRequest) (resp *SendRawEmailResult,
 // at one request per second. For more information about Easy signing, go
 // to the Amazon SES Developer Guide
 func (c *SES) SetIdentityDkimEnabled(req *SetIdentityDkimEnabledRequest) (resp *SetIdentityDkimEnabledResult, err error) {
+	return c.SetIdentityDkimEnabledWithContext(context.Background(), req)
+}
+
+// SetIdentityDkimEnabledWithContext is like SetIdentityDkimEnabled, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) SetIdentityDkimEnabledWithContext(ctx context.Context, req *SetIdentityDkimEnabledRequest) (resp *SetIdentityDkimEnabledResult, err error) {
 	resp = &SetIdentityDkimEnabledResult{}
-	err = c.client.Do("SetIdentityDkimEnabled", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SetIdentityDkimEnabled", "POST", "/", req, resp)
 	return
 }
 
@@ -211,8 +346,15 @@ func (c *SES) SetIdentityDkimEnabled(req *SetIdentityDkimEnabledRequest) (resp *
 // one request per second. For more information about using notifications
 // with Amazon see the Amazon SES Developer Guide
 func (c *SES) SetIdentityFeedbackForwardingEnabled(req *SetIdentityFeedbackForwardingEnabledRequest) (resp *SetIdentityFeedbackForwardingEnabledResult, err error) {
+	return c.SetIdentityFeedbackForwardingEnabledWithContext(context.Background(), req)
+}
+
+// SetIdentityFeedbackForwardingEnabledWithContext is like SetIdentityFeedbackForwardingEnabled, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) SetIdentityFeedbackForwardingEnabledWithContext(ctx context.Context, req *SetIdentityFeedbackForwardingEnabledRequest) (resp *SetIdentityFeedbackForwardingEnabledResult, err error) {
 	resp = &SetIdentityFeedbackForwardingEnabledResult{}
-	err = c.client.Do("SetIdentityFeedbackForwardingEnabled", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SetIdentityFeedbackForwardingEnabled", "POST", "/", req, resp)
 	return
 }
 
@@ -223,8 +365,15 @@ func (c *SES) SetIdentityFeedbackForwardingEnabled(req *SetIdentityFeedbackForwa
 // action is throttled at one request per second. For more information
 // about feedback notification, see the Amazon SES Developer Guide
 func (c *SES) SetIdentityNotificationTopic(req *SetIdentityNotificationTopicRequest) (resp *SetIdentityNotificationTopicResult, err error) {
+	return c.SetIdentityNotificationTopicWithContext(context.Background(), req)
+}
+
+// SetIdentityNotificationTopicWithContext is like SetIdentityNotificationTopic, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) SetIdentityNotificationTopicWithContext(ctx context.Context, req *SetIdentityNotificationTopicRequest) (resp *SetIdentityNotificationTopicResult, err error) {
 	resp = &SetIdentityNotificationTopicResult{}
-	err = c.client.Do("SetIdentityNotificationTopic", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SetIdentityNotificationTopic", "POST", "/", req, resp)
 	return
 }
 
@@ -239,16 +388,30 @@ func (c *SES) SetIdentityNotificationTopic(req *SetIdentityNotificationTopicRequ
 // the SetIdentityDkimEnabled action. For more information about creating
 // DNS records using tokens, go to the Amazon SES Developer Guide
 func (c *SES) VerifyDomainDkim(req *VerifyDomainDkimRequest) (resp *VerifyDomainDkimResult, err error) {
+	return c.VerifyDomainDkimWithContext(context.Background(), req)
+}
+
+// VerifyDomainDkimWithContext is like VerifyDomainDkim, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) VerifyDomainDkimWithContext(ctx context.Context, req *VerifyDomainDkimRequest) (resp *VerifyDomainDkimResult, err error) {
 	resp = &VerifyDomainDkimResult{}
-	err = c.client.Do("VerifyDomainDkim", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "VerifyDomainDkim", "POST", "/", req, resp)
 	return
 }
 
 // VerifyDomainIdentity verifies a domain. This action is throttled at one
 // request per second.
 func (c *SES) VerifyDomainIdentity(req *VerifyDomainIdentityRequest) (resp *VerifyDomainIdentityResult, err error) {
+	return c.VerifyDomainIdentityWithContext(context.Background(), req)
+}
+
+// VerifyDomainIdentityWithContext is like VerifyDomainIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) VerifyDomainIdentityWithContext(ctx context.Context, req *VerifyDomainIdentityRequest) (resp *VerifyDomainIdentityResult, err error) {
 	resp = &VerifyDomainIdentityResult{}
-	err = c.client.Do("VerifyDomainIdentity", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "VerifyDomainIdentity", "POST", "/", req, resp)
 	return
 }
 
@@ -258,8 +421,15 @@ func (c *SES) VerifyDomainIdentity(req *VerifyDomainIdentityRequest) (resp *Veri
 // of Domain Verification. The VerifyEmailIdentity action is now preferred.
 // This action is throttled at one request per second.
 func (c *SES) VerifyEmailAddress(req *VerifyEmailAddressRequest) (err error) {
+	return c.VerifyEmailAddressWithContext(context.Background(), req)
+}
+
+// VerifyEmailAddressWithContext is like VerifyEmailAddress, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) VerifyEmailAddressWithContext(ctx context.Context, req *VerifyEmailAddressRequest) (err error) {
 	// NRE
-	err = c.client.Do("VerifyEmailAddress", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "VerifyEmailAddress", "POST", "/", req, nil)
 	return
 }
 
@@ -267,8 +437,15 @@ func (c *SES) VerifyEmailAddress(req *VerifyEmailAddressRequest) (err error) {
 // confirmation email message to be sent to the specified address. This
 // action is throttled at one request per second.
 func (c *SES) VerifyEmailIdentity(req *VerifyEmailIdentityRequest) (resp *VerifyEmailIdentityResult, err error) {
+	return c.VerifyEmailIdentityWithContext(context.Background(), req)
+}
+
+// VerifyEmailIdentityWithContext is like VerifyEmailIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SES) VerifyEmailIdentityWithContext(ctx context.Context, req *VerifyEmailIdentityRequest) (resp *VerifyEmailIdentityResult, err error) {
 	resp = &VerifyEmailIdentityResult{}
-	err = c.client.Do("VerifyEmailIdentity", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "VerifyEmailIdentity", "POST", "/", req, resp)
 	return
 }
 
@@ -278,17 +455,60 @@ type Body struct {
 	Text *Content `query:"Text" xml:"Text"`
 }
 
+// MarshalQuery adds the parameters of the Body to the form, prefixing their names.
+func (v *Body) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.HTML != nil {
+		if err := v.HTML.MarshalQuery(form, prefix+"Html."); err != nil {
+			return err
+		}
+	}
+	if v.Text != nil {
+		if err := v.Text.MarshalQuery(form, prefix+"Text."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Content is undocumented.
 type Content struct {
 	Charset aws.StringValue `query:"Charset" xml:"Charset"`
 	Data    aws.StringValue `query:"Data" xml:"Data"`
 }
 
+// MarshalQuery adds the parameters of the Content to the form, prefixing their names.
+func (v *Content) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Charset != nil {
+		form.Set(prefix+"Charset", *v.Charset)
+	}
+	if v.Data != nil {
+		form.Set(prefix+"Data", *v.Data)
+	}
+	return nil
+}
+
 // DeleteIdentityRequest is undocumented.
 type DeleteIdentityRequest struct {
 	Identity aws.StringValue `query:"Identity" xml:"Identity"`
 }
 
+// MarshalQuery adds the parameters of the DeleteIdentityRequest to the form, prefixing their names.
+func (v *DeleteIdentityRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Identity != nil {
+		form.Set(prefix+"Identity", *v.Identity)
+	}
+	return nil
+}
+
 // DeleteIdentityResponse is undocumented.
 type DeleteIdentityResponse struct {
 }
@@ -298,41 +518,146 @@ type DeleteVerifiedEmailAddressRequest struct {
 	EmailAddress aws.StringValue `query:"EmailAddress" xml:"EmailAddress"`
 }
 
+// MarshalQuery adds the parameters of the DeleteVerifiedEmailAddressRequest to the form, prefixing their names.
+func (v *DeleteVerifiedEmailAddressRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EmailAddress != nil {
+		form.Set(prefix+"EmailAddress", *v.EmailAddress)
+	}
+	return nil
+}
+
 // Destination is undocumented.
 type Destination struct {
-	BCCAddresses []string `query:"BccAddresses.member" xml:"BccAddresses>member"`
-	CCAddresses  []string `query:"CcAddresses.member" xml:"CcAddresses>member"`
-	ToAddresses  []string `query:"ToAddresses.member" xml:"ToAddresses>member"`
+	BCCAddresses []string `query:"BccAddresses,list:member" xml:"BccAddresses>member"`
+	CCAddresses  []string `query:"CcAddresses,list:member" xml:"CcAddresses>member"`
+	ToAddresses  []string `query:"ToAddresses,list:member" xml:"ToAddresses>member"`
+}
+
+// MarshalQuery adds the parameters of the Destination to the form, prefixing their names.
+func (v *Destination) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BCCAddresses != nil {
+		if len(v.BCCAddresses) == 0 {
+			form.Set(prefix+"BccAddresses", "")
+		}
+		for i1 := range v.BCCAddresses {
+			name1 := prefix + "BccAddresses.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.BCCAddresses[i1])
+		}
+	}
+	if v.CCAddresses != nil {
+		if len(v.CCAddresses) == 0 {
+			form.Set(prefix+"CcAddresses", "")
+		}
+		for i1 := range v.CCAddresses {
+			name1 := prefix + "CcAddresses.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.CCAddresses[i1])
+		}
+	}
+	if v.ToAddresses != nil {
+		if len(v.ToAddresses) == 0 {
+			form.Set(prefix+"ToAddresses", "")
+		}
+		for i1 := range v.ToAddresses {
+			name1 := prefix + "ToAddresses.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ToAddresses[i1])
+		}
+	}
+	return nil
+}
+
+// DkimAttributes is undocumented.
+type DkimAttributes map[string]IdentityDkimAttributes
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *DkimAttributes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
 }
 
 // GetIdentityDkimAttributesRequest is undocumented.
 type GetIdentityDkimAttributesRequest struct {
-	Identities []string `query:"Identities.member" xml:"Identities>member"`
+	Identities []string `query:"Identities,list:member" xml:"Identities>member"`
+}
+
+// MarshalQuery adds the parameters of the GetIdentityDkimAttributesRequest to the form, prefixing their names.
+func (v *GetIdentityDkimAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Identities != nil {
+		if len(v.Identities) == 0 {
+			form.Set(prefix+"Identities", "")
+		}
+		for i1 := range v.Identities {
+			name1 := prefix + "Identities.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Identities[i1])
+		}
+	}
+	return nil
 }
 
 // GetIdentityDkimAttributesResponse is undocumented.
 type GetIdentityDkimAttributesResponse struct {
-	DkimAttributes map[string]IdentityDkimAttributes `query:"DkimAttributes" xml:"GetIdentityDkimAttributesResult>DkimAttributes"`
+	DkimAttributes DkimAttributes `query:"DkimAttributes,map:entry:key:value" xml:"GetIdentityDkimAttributesResult>DkimAttributes"`
 }
 
 // GetIdentityNotificationAttributesRequest is undocumented.
 type GetIdentityNotificationAttributesRequest struct {
-	Identities []string `query:"Identities.member" xml:"Identities>member"`
+	Identities []string `query:"Identities,list:member" xml:"Identities>member"`
+}
+
+// MarshalQuery adds the parameters of the GetIdentityNotificationAttributesRequest to the form, prefixing their names.
+func (v *GetIdentityNotificationAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Identities != nil {
+		if len(v.Identities) == 0 {
+			form.Set(prefix+"Identities", "")
+		}
+		for i1 := range v.Identities {
+			name1 := prefix + "Identities.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Identities[i1])
+		}
+	}
+	return nil
 }
 
 // GetIdentityNotificationAttributesResponse is undocumented.
 type GetIdentityNotificationAttributesResponse struct {
-	NotificationAttributes map[string]IdentityNotificationAttributes `query:"NotificationAttributes" xml:"GetIdentityNotificationAttributesResult>NotificationAttributes"`
+	NotificationAttributes NotificationAttributes `query:"NotificationAttributes,map:entry:key:value" xml:"GetIdentityNotificationAttributesResult>NotificationAttributes"`
 }
 
 // GetIdentityVerificationAttributesRequest is undocumented.
 type GetIdentityVerificationAttributesRequest struct {
-	Identities []string `query:"Identities.member" xml:"Identities>member"`
+	Identities []string `query:"Identities,list:member" xml:"Identities>member"`
+}
+
+// MarshalQuery adds the parameters of the GetIdentityVerificationAttributesRequest to the form, prefixing their names.
+func (v *GetIdentityVerificationAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Identities != nil {
+		if len(v.Identities) == 0 {
+			form.Set(prefix+"Identities", "")
+		}
+		for i1 := range v.Identities {
+			name1 := prefix + "Identities.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Identities[i1])
+		}
+	}
+	return nil
 }
 
 // GetIdentityVerificationAttributesResponse is undocumented.
 type GetIdentityVerificationAttributesResponse struct {
-	VerificationAttributes map[string]IdentityVerificationAttributes `query:"VerificationAttributes" xml:"GetIdentityVerificationAttributesResult>VerificationAttributes"`
+	VerificationAttributes VerificationAttributes `query:"VerificationAttributes,map:entry:key:value" xml:"GetIdentityVerificationAttributesResult>VerificationAttributes"`
 }
 
 // GetSendQuotaResponse is undocumented.
@@ -344,13 +669,13 @@ type GetSendQuotaResponse struct {
 
 // GetSendStatisticsResponse is undocumented.
 type GetSendStatisticsResponse struct {
-	SendDataPoints []SendDataPoint `query:"SendDataPoints.member" xml:"GetSendStatisticsResult>SendDataPoints>member"`
+	SendDataPoints []SendDataPoint `query:"SendDataPoints,list:member" xml:"GetSendStatisticsResult>SendDataPoints>member"`
 }
 
 // IdentityDkimAttributes is undocumented.
 type IdentityDkimAttributes struct {
 	DkimEnabled            aws.BooleanValue `query:"DkimEnabled" xml:"DkimEnabled"`
-	DkimTokens             []string         `query:"DkimTokens.member" xml:"DkimTokens>member"`
+	DkimTokens             []string         `query:"DkimTokens,list:member" xml:"DkimTokens>member"`
 	DkimVerificationStatus aws.StringValue  `query:"DkimVerificationStatus" xml:"DkimVerificationStatus"`
 }
 
@@ -381,15 +706,32 @@ type ListIdentitiesRequest struct {
 	NextToken    aws.StringValue  `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the ListIdentitiesRequest to the form, prefixing their names.
+func (v *ListIdentitiesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.IdentityType != nil {
+		form.Set(prefix+"IdentityType", *v.IdentityType)
+	}
+	if v.MaxItems != nil {
+		form.Set(prefix+"MaxItems", strconv.FormatInt(int64(*v.MaxItems), 10))
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // ListIdentitiesResponse is undocumented.
 type ListIdentitiesResponse struct {
-	Identities []string        `query:"Identities.member" xml:"ListIdentitiesResult>Identities>member"`
+	Identities []string        `query:"Identities,list:member" xml:"ListIdentitiesResult>Identities>member"`
 	NextToken  aws.StringValue `query:"NextToken" xml:"ListIdentitiesResult>NextToken"`
 }
 
 // ListVerifiedEmailAddressesResponse is undocumented.
 type ListVerifiedEmailAddressesResponse struct {
-	VerifiedEmailAddresses []string `query:"VerifiedEmailAddresses.member" xml:"ListVerifiedEmailAddressesResult>VerifiedEmailAddresses>member"`
+	VerifiedEmailAddresses []string `query:"VerifiedEmailAddresses,list:member" xml:"ListVerifiedEmailAddressesResult>VerifiedEmailAddresses>member"`
 }
 
 // Message is undocumented.
@@ -398,6 +740,32 @@ type Message struct {
 	Subject *Content `query:"Subject" xml:"Subject"`
 }
 
+// MarshalQuery adds the parameters of the Message to the form, prefixing their names.
+func (v *Message) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Body != nil {
+		if err := v.Body.MarshalQuery(form, prefix+"Body."); err != nil {
+			return err
+		}
+	}
+	if v.Subject != nil {
+		if err := v.Subject.MarshalQuery(form, prefix+"Subject."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NotificationAttributes is undocumented.
+type NotificationAttributes map[string]IdentityNotificationAttributes
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *NotificationAttributes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
 // Possible values for SES.
 const (
 	NotificationTypeBounce    = "Bounce"
@@ -410,6 +778,17 @@ type RawMessage struct {
 	Data []byte `query:"Data" xml:"Data"`
 }
 
+// MarshalQuery adds the parameters of the RawMessage to the form, prefixing their names.
+func (v *RawMessage) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Data != nil {
+		form.Set(prefix+"Data", base64.StdEncoding.EncodeToString(v.Data))
+	}
+	return nil
+}
+
 // SendDataPoint is undocumented.
 type SendDataPoint struct {
 	Bounces          aws.LongValue `query:"Bounces" xml:"Bounces"`
@@ -423,11 +802,44 @@ type SendDataPoint struct {
 type SendEmailRequest struct {
 	Destination      *Destination    `query:"Destination" xml:"Destination"`
 	Message          *Message        `query:"Message" xml:"Message"`
-	ReplyToAddresses []string        `query:"ReplyToAddresses.member" xml:"ReplyToAddresses>member"`
+	ReplyToAddresses []string        `query:"ReplyToAddresses,list:member" xml:"ReplyToAddresses>member"`
 	ReturnPath       aws.StringValue `query:"ReturnPath" xml:"ReturnPath"`
 	Source           aws.StringValue `query:"Source" xml:"Source"`
 }
 
+// MarshalQuery adds the parameters of the SendEmailRequest to the form, prefixing their names.
+func (v *SendEmailRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Destination != nil {
+		if err := v.Destination.MarshalQuery(form, prefix+"Destination."); err != nil {
+			return err
+		}
+	}
+	if v.Message != nil {
+		if err := v.Message.MarshalQuery(form, prefix+"Message."); err != nil {
+			return err
+		}
+	}
+	if v.ReplyToAddresses != nil {
+		if len(v.ReplyToAddresses) == 0 {
+			form.Set(prefix+"ReplyToAddresses", "")
+		}
+		for i1 := range v.ReplyToAddresses {
+			name1 := prefix + "ReplyToAddresses.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ReplyToAddresses[i1])
+		}
+	}
+	if v.ReturnPath != nil {
+		form.Set(prefix+"ReturnPath", *v.ReturnPath)
+	}
+	if v.Source != nil {
+		form.Set(prefix+"Source", *v.Source)
+	}
+	return nil
+}
+
 // SendEmailResponse is undocumented.
 type SendEmailResponse struct {
 	MessageID aws.StringValue `query:"MessageId" xml:"SendEmailResult>MessageId"`
@@ -435,11 +847,36 @@ type SendEmailResponse struct {
 
 // SendRawEmailRequest is undocumented.
 type SendRawEmailRequest struct {
-	Destinations []string        `query:"Destinations.member" xml:"Destinations>member"`
+	Destinations []string        `query:"Destinations,list:member" xml:"Destinations>member"`
 	RawMessage   *RawMessage     `query:"RawMessage" xml:"RawMessage"`
 	Source       aws.StringValue `query:"Source" xml:"Source"`
 }
 
+// MarshalQuery adds the parameters of the SendRawEmailRequest to the form, prefixing their names.
+func (v *SendRawEmailRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Destinations != nil {
+		if len(v.Destinations) == 0 {
+			form.Set(prefix+"Destinations", "")
+		}
+		for i1 := range v.Destinations {
+			name1 := prefix + "Destinations.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Destinations[i1])
+		}
+	}
+	if v.RawMessage != nil {
+		if err := v.RawMessage.MarshalQuery(form, prefix+"RawMessage."); err != nil {
+			return err
+		}
+	}
+	if v.Source != nil {
+		form.Set(prefix+"Source", *v.Source)
+	}
+	return nil
+}
+
 // SendRawEmailResponse is undocumented.
 type SendRawEmailResponse struct {
 	MessageID aws.StringValue `query:"MessageId" xml:"SendRawEmailResult>MessageId"`
@@ -451,6 +888,20 @@ type SetIdentityDkimEnabledRequest struct {
 	Identity    aws.StringValue  `query:"Identity" xml:"Identity"`
 }
 
+// MarshalQuery adds the parameters of the SetIdentityDkimEnabledRequest to the form, prefixing their names.
+func (v *SetIdentityDkimEnabledRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DkimEnabled != nil {
+		form.Set(prefix+"DkimEnabled", strconv.FormatBool(*v.DkimEnabled))
+	}
+	if v.Identity != nil {
+		form.Set(prefix+"Identity", *v.Identity)
+	}
+	return nil
+}
+
 // SetIdentityDkimEnabledResponse is undocumented.
 type SetIdentityDkimEnabledResponse struct {
 }
@@ -461,6 +912,20 @@ type SetIdentityFeedbackForwardingEnabledRequest struct {
 	Identity          aws.StringValue  `query:"Identity" xml:"Identity"`
 }
 
+// MarshalQuery adds the parameters of the SetIdentityFeedbackForwardingEnabledRequest to the form, prefixing their names.
+func (v *SetIdentityFeedbackForwardingEnabledRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ForwardingEnabled != nil {
+		form.Set(prefix+"ForwardingEnabled", strconv.FormatBool(*v.ForwardingEnabled))
+	}
+	if v.Identity != nil {
+		form.Set(prefix+"Identity", *v.Identity)
+	}
+	return nil
+}
+
 // SetIdentityFeedbackForwardingEnabledResponse is undocumented.
 type SetIdentityFeedbackForwardingEnabledResponse struct {
 }
@@ -472,10 +937,35 @@ type SetIdentityNotificationTopicRequest struct {
 	SNSTopic         aws.StringValue `query:"SnsTopic" xml:"SnsTopic"`
 }
 
+// MarshalQuery adds the parameters of the SetIdentityNotificationTopicRequest to the form, prefixing their names.
+func (v *SetIdentityNotificationTopicRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Identity != nil {
+		form.Set(prefix+"Identity", *v.Identity)
+	}
+	if v.NotificationType != nil {
+		form.Set(prefix+"NotificationType", *v.NotificationType)
+	}
+	if v.SNSTopic != nil {
+		form.Set(prefix+"SnsTopic", *v.SNSTopic)
+	}
+	return nil
+}
+
 // SetIdentityNotificationTopicResponse is undocumented.
 type SetIdentityNotificationTopicResponse struct {
 }
 
+// VerificationAttributes is undocumented.
+type VerificationAttributes map[string]IdentityVerificationAttributes
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *VerificationAttributes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
 // Possible values for SES.
 const (
 	VerificationStatusFailed           = "Failed"
@@ -490,9 +980,20 @@ type VerifyDomainDkimRequest struct {
 	Domain aws.StringValue `query:"Domain" xml:"Domain"`
 }
 
+// MarshalQuery adds the parameters of the VerifyDomainDkimRequest to the form, prefixing their names.
+func (v *VerifyDomainDkimRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		form.Set(prefix+"Domain", *v.Domain)
+	}
+	return nil
+}
+
 // VerifyDomainDkimResponse is undocumented.
 type VerifyDomainDkimResponse struct {
-	DkimTokens []string `query:"DkimTokens.member" xml:"VerifyDomainDkimResult>DkimTokens>member"`
+	DkimTokens []string `query:"DkimTokens,list:member" xml:"VerifyDomainDkimResult>DkimTokens>member"`
 }
 
 // VerifyDomainIdentityRequest is undocumented.
@@ -500,6 +1001,17 @@ type VerifyDomainIdentityRequest struct {
 	Domain aws.StringValue `query:"Domain" xml:"Domain"`
 }
 
+// MarshalQuery adds the parameters of the VerifyDomainIdentityRequest to the form, prefixing their names.
+func (v *VerifyDomainIdentityRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		form.Set(prefix+"Domain", *v.Domain)
+	}
+	return nil
+}
+
 // VerifyDomainIdentityResponse is undocumented.
 type VerifyDomainIdentityResponse struct {
 	VerificationToken aws.StringValue `query:"VerificationToken" xml:"VerifyDomainIdentityResult>VerificationToken"`
@@ -510,11 +1022,33 @@ type VerifyEmailAddressRequest struct {
 	EmailAddress aws.StringValue `query:"EmailAddress" xml:"EmailAddress"`
 }
 
+// MarshalQuery adds the parameters of the VerifyEmailAddressRequest to the form, prefixing their names.
+func (v *VerifyEmailAddressRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EmailAddress != nil {
+		form.Set(prefix+"EmailAddress", *v.EmailAddress)
+	}
+	return nil
+}
+
 // VerifyEmailIdentityRequest is undocumented.
 type VerifyEmailIdentityRequest struct {
 	EmailAddress aws.StringValue `query:"EmailAddress" xml:"EmailAddress"`
 }
 
+// MarshalQuery adds the parameters of the VerifyEmailIdentityRequest to the form, prefixing their names.
+func (v *VerifyEmailIdentityRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EmailAddress != nil {
+		form.Set(prefix+"EmailAddress", *v.EmailAddress)
+	}
+	return nil
+}
+
 // VerifyEmailIdentityResponse is undocumented.
 type VerifyEmailIdentityResponse struct {
 }
@@ -525,17 +1059,17 @@ type DeleteIdentityResult struct {
 
 // GetIdentityDkimAttributesResult is a wrapper for GetIdentityDkimAttributesResponse.
 type GetIdentityDkimAttributesResult struct {
-	DkimAttributes map[string]IdentityDkimAttributes `query:"DkimAttributes" xml:"GetIdentityDkimAttributesResult>DkimAttributes"`
+	DkimAttributes DkimAttributes `query:"DkimAttributes,map:entry:key:value" xml:"GetIdentityDkimAttributesResult>DkimAttributes"`
 }
 
 // GetIdentityNotificationAttributesResult is a wrapper for GetIdentityNotificationAttributesResponse.
 type GetIdentityNotificationAttributesResult struct {
-	NotificationAttributes map[string]IdentityNotificationAttributes `query:"NotificationAttributes" xml:"GetIdentityNotificationAttributesResult>NotificationAttributes"`
+	NotificationAttributes NotificationAttributes `query:"NotificationAttributes,map:entry:key:value" xml:"GetIdentityNotificationAttributesResult>NotificationAttributes"`
 }
 
 // GetIdentityVerificationAttributesResult is a wrapper for GetIdentityVerificationAttributesResponse.
 type GetIdentityVerificationAttributesResult struct {
-	VerificationAttributes map[string]IdentityVerificationAttributes `query:"VerificationAttributes" xml:"GetIdentityVerificationAttributesResult>VerificationAttributes"`
+	VerificationAttributes VerificationAttributes `query:"VerificationAttributes,map:entry:key:value" xml:"GetIdentityVerificationAttributesResult>VerificationAttributes"`
 }
 
 // GetSendQuotaResult is a wrapper for GetSendQuotaResponse.
@@ -547,18 +1081,18 @@ type GetSendQuotaResult struct {
 
 // GetSendStatisticsResult is a wrapper for GetSendStatisticsResponse.
 type GetSendStatisticsResult struct {
-	SendDataPoints []SendDataPoint `query:"SendDataPoints.member" xml:"GetSendStatisticsResult>SendDataPoints>member"`
+	SendDataPoints []SendDataPoint `query:"SendDataPoints,list:member" xml:"GetSendStatisticsResult>SendDataPoints>member"`
 }
 
 // ListIdentitiesResult is a wrapper for ListIdentitiesResponse.
 type ListIdentitiesResult struct {
-	Identities []string        `query:"Identities.member" xml:"ListIdentitiesResult>Identities>member"`
+	Identities []string        `query:"Identities,list:member" xml:"ListIdentitiesResult>Identities>member"`
 	NextToken  aws.StringValue `query:"NextToken" xml:"ListIdentitiesResult>NextToken"`
 }
 
 // ListVerifiedEmailAddressesResult is a wrapper for ListVerifiedEmailAddressesResponse.
 type ListVerifiedEmailAddressesResult struct {
-	VerifiedEmailAddresses []string `query:"VerifiedEmailAddresses.member" xml:"ListVerifiedEmailAddressesResult>VerifiedEmailAddresses>member"`
+	VerifiedEmailAddresses []string `query:"VerifiedEmailAddresses,list:member" xml:"ListVerifiedEmailAddressesResult>VerifiedEmailAddresses>member"`
 }
 
 // SendEmailResult is a wrapper for SendEmailResponse.
@@ -585,7 +1119,7 @@ type SetIdentityNotificationTopicResult struct {
 
 // VerifyDomainDkimResult is a wrapper for VerifyDomainDkimResponse.
 type VerifyDomainDkimResult struct {
-	DkimTokens []string `query:"DkimTokens.member" xml:"VerifyDomainDkimResult>DkimTokens>member"`
+	DkimTokens []string `query:"DkimTokens,list:member" xml:"VerifyDomainDkimResult>DkimTokens>member"`
 }
 
 // VerifyDomainIdentityResult is a wrapper for VerifyDomainIdentityResponse.
@@ -597,5 +1131,21 @@ type VerifyDomainIdentityResult struct {
 type VerifyEmailIdentityResult struct {
 }
 
+// MessageRejected is the error returned for the MessageRejected error code.
+type MessageRejected struct {
+	aws.APIError
+}
+
+// exceptions maps SES's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"MessageRejected": MessageRejected{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
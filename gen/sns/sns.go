@@ -4,11 +4,21 @@
 package sns
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // SNS is a client for Amazon Simple Notification Service.
@@ -16,34 +26,82 @@ type SNS struct {
 	client *aws.QueryClient
 }
 
-// New returns a new SNS client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *SNS {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "sns"
-	endpoint, service, region := endpoints.Lookup("sns", region)
+// New returns a new SNS client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *SNS {
+	cfg := newConfig(region, client, opts)
 
 	return &SNS{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2010-03-31",
 		},
 	}
 }
 
+// NewFromSession returns a new SNS client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *SNS {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("sns", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("sns", "sns"),
+	}, "AWS_ENDPOINT_URL_SNS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *SNS) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *SNS) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *SNS) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AddPermission adds a statement to a topic's access control policy,
 // granting access for the specified AWS accounts to the specified actions.
 func (c *SNS) AddPermission(req *AddPermissionInput) (err error) {
+	return c.AddPermissionWithContext(context.Background(), req)
+}
+
+// AddPermissionWithContext is like AddPermission, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) AddPermissionWithContext(ctx context.Context, req *AddPermissionInput) (err error) {
 	// NRE
-	err = c.client.Do("AddPermission", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AddPermission", "POST", "/", req, nil)
 	return
 }
 
@@ -54,8 +112,15 @@ func (c *SNS) AddPermission(req *AddPermissionInput) (err error) {
 // AWS signature only when the AuthenticateOnUnsubscribe flag is set to
 // "true".
 func (c *SNS) ConfirmSubscription(req *ConfirmSubscriptionInput) (resp *ConfirmSubscriptionResult, err error) {
+	return c.ConfirmSubscriptionWithContext(context.Background(), req)
+}
+
+// ConfirmSubscriptionWithContext is like ConfirmSubscription, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) ConfirmSubscriptionWithContext(ctx context.Context, req *ConfirmSubscriptionInput) (resp *ConfirmSubscriptionResult, err error) {
 	resp = &ConfirmSubscriptionResult{}
-	err = c.client.Do("ConfirmSubscription", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ConfirmSubscription", "POST", "/", req, resp)
 	return
 }
 
@@ -74,8 +139,15 @@ func (c *SNS) ConfirmSubscription(req *ConfirmSubscriptionInput) (resp *ConfirmS
 // CreatePlatformEndpoint action. For more information, see Using Amazon
 // SNS Mobile Push Notifications .
 func (c *SNS) CreatePlatformApplication(req *CreatePlatformApplicationInput) (resp *CreatePlatformApplicationResult, err error) {
+	return c.CreatePlatformApplicationWithContext(context.Background(), req)
+}
+
+// CreatePlatformApplicationWithContext is like CreatePlatformApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) CreatePlatformApplicationWithContext(ctx context.Context, req *CreatePlatformApplicationInput) (resp *CreatePlatformApplicationResult, err error) {
 	resp = &CreatePlatformApplicationResult{}
-	err = c.client.Do("CreatePlatformApplication", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreatePlatformApplication", "POST", "/", req, resp)
 	return
 }
 
@@ -94,8 +166,15 @@ func (c *SNS) CreatePlatformApplication(req *CreatePlatformApplicationInput) (re
 // field must also contain the ChannelId. For more information, see
 // Creating an Amazon SNS Endpoint for Baidu .
 func (c *SNS) CreatePlatformEndpoint(req *CreatePlatformEndpointInput) (resp *CreatePlatformEndpointResult, err error) {
+	return c.CreatePlatformEndpointWithContext(context.Background(), req)
+}
+
+// CreatePlatformEndpointWithContext is like CreatePlatformEndpoint, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) CreatePlatformEndpointWithContext(ctx context.Context, req *CreatePlatformEndpointInput) (resp *CreatePlatformEndpointResult, err error) {
 	resp = &CreatePlatformEndpointResult{}
-	err = c.client.Do("CreatePlatformEndpoint", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreatePlatformEndpoint", "POST", "/", req, resp)
 	return
 }
 
@@ -105,8 +184,15 @@ func (c *SNS) CreatePlatformEndpoint(req *CreatePlatformEndpointInput) (resp *Cr
 // requester already owns a topic with the specified name, that topic's ARN
 // is returned without creating a new topic.
 func (c *SNS) CreateTopic(req *CreateTopicInput) (resp *CreateTopicResult, err error) {
+	return c.CreateTopicWithContext(context.Background(), req)
+}
+
+// CreateTopicWithContext is like CreateTopic, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) CreateTopicWithContext(ctx context.Context, req *CreateTopicInput) (resp *CreateTopicResult, err error) {
 	resp = &CreateTopicResult{}
-	err = c.client.Do("CreateTopic", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateTopic", "POST", "/", req, resp)
 	return
 }
 
@@ -114,8 +200,15 @@ func (c *SNS) CreateTopic(req *CreateTopicInput) (resp *CreateTopicResult, err e
 // idempotent. For more information, see Using Amazon SNS Mobile Push
 // Notifications .
 func (c *SNS) DeleteEndpoint(req *DeleteEndpointInput) (err error) {
+	return c.DeleteEndpointWithContext(context.Background(), req)
+}
+
+// DeleteEndpointWithContext is like DeleteEndpoint, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) DeleteEndpointWithContext(ctx context.Context, req *DeleteEndpointInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteEndpoint", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteEndpoint", "POST", "/", req, nil)
 	return
 }
 
@@ -123,8 +216,15 @@ func (c *SNS) DeleteEndpoint(req *DeleteEndpointInput) (err error) {
 // of the supported push notification services, such as and For more
 // information, see Using Amazon SNS Mobile Push Notifications .
 func (c *SNS) DeletePlatformApplication(req *DeletePlatformApplicationInput) (err error) {
+	return c.DeletePlatformApplicationWithContext(context.Background(), req)
+}
+
+// DeletePlatformApplicationWithContext is like DeletePlatformApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) DeletePlatformApplicationWithContext(ctx context.Context, req *DeletePlatformApplicationInput) (err error) {
 	// NRE
-	err = c.client.Do("DeletePlatformApplication", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeletePlatformApplication", "POST", "/", req, nil)
 	return
 }
 
@@ -133,8 +233,15 @@ func (c *SNS) DeletePlatformApplication(req *DeletePlatformApplicationInput) (er
 // delivered to subscribers. This action is idempotent, so deleting a topic
 // that does not exist does not result in an error.
 func (c *SNS) DeleteTopic(req *DeleteTopicInput) (err error) {
+	return c.DeleteTopicWithContext(context.Background(), req)
+}
+
+// DeleteTopicWithContext is like DeleteTopic, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) DeleteTopicWithContext(ctx context.Context, req *DeleteTopicInput) (err error) {
 	// NRE
-	err = c.client.Do("DeleteTopic", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteTopic", "POST", "/", req, nil)
 	return
 }
 
@@ -142,8 +249,15 @@ func (c *SNS) DeleteTopic(req *DeleteTopicInput) (err error) {
 // one of the supported push notification services, such as GCM and For
 // more information, see Using Amazon SNS Mobile Push Notifications .
 func (c *SNS) GetEndpointAttributes(req *GetEndpointAttributesInput) (resp *GetEndpointAttributesResult, err error) {
+	return c.GetEndpointAttributesWithContext(context.Background(), req)
+}
+
+// GetEndpointAttributesWithContext is like GetEndpointAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) GetEndpointAttributesWithContext(ctx context.Context, req *GetEndpointAttributesInput) (resp *GetEndpointAttributesResult, err error) {
 	resp = &GetEndpointAttributesResult{}
-	err = c.client.Do("GetEndpointAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetEndpointAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -152,23 +266,44 @@ func (c *SNS) GetEndpointAttributes(req *GetEndpointAttributesInput) (resp *GetE
 // services, such as and For more information, see Using Amazon SNS Mobile
 // Push Notifications .
 func (c *SNS) GetPlatformApplicationAttributes(req *GetPlatformApplicationAttributesInput) (resp *GetPlatformApplicationAttributesResult, err error) {
+	return c.GetPlatformApplicationAttributesWithContext(context.Background(), req)
+}
+
+// GetPlatformApplicationAttributesWithContext is like GetPlatformApplicationAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) GetPlatformApplicationAttributesWithContext(ctx context.Context, req *GetPlatformApplicationAttributesInput) (resp *GetPlatformApplicationAttributesResult, err error) {
 	resp = &GetPlatformApplicationAttributesResult{}
-	err = c.client.Do("GetPlatformApplicationAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetPlatformApplicationAttributes", "POST", "/", req, resp)
 	return
 }
 
 // GetSubscriptionAttributes is undocumented.
 func (c *SNS) GetSubscriptionAttributes(req *GetSubscriptionAttributesInput) (resp *GetSubscriptionAttributesResult, err error) {
+	return c.GetSubscriptionAttributesWithContext(context.Background(), req)
+}
+
+// GetSubscriptionAttributesWithContext is like GetSubscriptionAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) GetSubscriptionAttributesWithContext(ctx context.Context, req *GetSubscriptionAttributesInput) (resp *GetSubscriptionAttributesResult, err error) {
 	resp = &GetSubscriptionAttributesResult{}
-	err = c.client.Do("GetSubscriptionAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetSubscriptionAttributes", "POST", "/", req, resp)
 	return
 }
 
 // GetTopicAttributes returns all of the properties of a topic. Topic
 // properties returned might differ based on the authorization of the user.
 func (c *SNS) GetTopicAttributes(req *GetTopicAttributesInput) (resp *GetTopicAttributesResult, err error) {
+	return c.GetTopicAttributesWithContext(context.Background(), req)
+}
+
+// GetTopicAttributesWithContext is like GetTopicAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) GetTopicAttributesWithContext(ctx context.Context, req *GetTopicAttributesInput) (resp *GetTopicAttributesResult, err error) {
 	resp = &GetTopicAttributesResult{}
-	err = c.client.Do("GetTopicAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetTopicAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -183,8 +318,15 @@ func (c *SNS) GetTopicAttributes(req *GetTopicAttributesInput) (resp *GetTopicAt
 // return, NextToken will be null. For more information, see Using Amazon
 // SNS Mobile Push Notifications .
 func (c *SNS) ListEndpointsByPlatformApplication(req *ListEndpointsByPlatformApplicationInput) (resp *ListEndpointsByPlatformApplicationResult, err error) {
+	return c.ListEndpointsByPlatformApplicationWithContext(context.Background(), req)
+}
+
+// ListEndpointsByPlatformApplicationWithContext is like ListEndpointsByPlatformApplication, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) ListEndpointsByPlatformApplicationWithContext(ctx context.Context, req *ListEndpointsByPlatformApplicationInput) (resp *ListEndpointsByPlatformApplicationResult, err error) {
 	resp = &ListEndpointsByPlatformApplicationResult{}
-	err = c.client.Do("ListEndpointsByPlatformApplication", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListEndpointsByPlatformApplication", "POST", "/", req, resp)
 	return
 }
 
@@ -198,8 +340,15 @@ func (c *SNS) ListEndpointsByPlatformApplication(req *ListEndpointsByPlatformApp
 // to return, NextToken will be null. For more information, see Using
 // Amazon SNS Mobile Push Notifications .
 func (c *SNS) ListPlatformApplications(req *ListPlatformApplicationsInput) (resp *ListPlatformApplicationsResult, err error) {
+	return c.ListPlatformApplicationsWithContext(context.Background(), req)
+}
+
+// ListPlatformApplicationsWithContext is like ListPlatformApplications, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) ListPlatformApplicationsWithContext(ctx context.Context, req *ListPlatformApplicationsInput) (resp *ListPlatformApplicationsResult, err error) {
 	resp = &ListPlatformApplicationsResult{}
-	err = c.client.Do("ListPlatformApplications", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListPlatformApplications", "POST", "/", req, resp)
 	return
 }
 
@@ -208,8 +357,15 @@ func (c *SNS) ListPlatformApplications(req *ListPlatformApplicationsInput) (resp
 // more subscriptions, a NextToken is also returned. Use the NextToken
 // parameter in a new ListSubscriptions call to get further results.
 func (c *SNS) ListSubscriptions(req *ListSubscriptionsInput) (resp *ListSubscriptionsResult, err error) {
+	return c.ListSubscriptionsWithContext(context.Background(), req)
+}
+
+// ListSubscriptionsWithContext is like ListSubscriptions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) ListSubscriptionsWithContext(ctx context.Context, req *ListSubscriptionsInput) (resp *ListSubscriptionsResult, err error) {
 	resp = &ListSubscriptionsResult{}
-	err = c.client.Do("ListSubscriptions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListSubscriptions", "POST", "/", req, resp)
 	return
 }
 
@@ -219,8 +375,15 @@ func (c *SNS) ListSubscriptions(req *ListSubscriptionsInput) (resp *ListSubscrip
 // the NextToken parameter in a new ListSubscriptionsByTopic call to get
 // further results.
 func (c *SNS) ListSubscriptionsByTopic(req *ListSubscriptionsByTopicInput) (resp *ListSubscriptionsByTopicResult, err error) {
+	return c.ListSubscriptionsByTopicWithContext(context.Background(), req)
+}
+
+// ListSubscriptionsByTopicWithContext is like ListSubscriptionsByTopic, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) ListSubscriptionsByTopicWithContext(ctx context.Context, req *ListSubscriptionsByTopicInput) (resp *ListSubscriptionsByTopicResult, err error) {
 	resp = &ListSubscriptionsByTopicResult{}
-	err = c.client.Do("ListSubscriptionsByTopic", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListSubscriptionsByTopic", "POST", "/", req, resp)
 	return
 }
 
@@ -229,8 +392,15 @@ func (c *SNS) ListSubscriptionsByTopic(req *ListSubscriptionsByTopicInput) (resp
 // is also returned. Use the NextToken parameter in a new ListTopics call
 // to get further results.
 func (c *SNS) ListTopics(req *ListTopicsInput) (resp *ListTopicsResult, err error) {
+	return c.ListTopicsWithContext(context.Background(), req)
+}
+
+// ListTopicsWithContext is like ListTopics, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) ListTopicsWithContext(ctx context.Context, req *ListTopicsInput) (resp *ListTopicsResult, err error) {
 	resp = &ListTopicsResult{}
-	err = c.client.Do("ListTopics", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListTopics", "POST", "/", req, resp)
 	return
 }
 
@@ -245,16 +415,30 @@ func (c *SNS) ListTopics(req *ListTopicsInput) (resp *ListTopicsResult, err erro
 // second example below shows a request and response for publishing to a
 // mobile endpoint.
 func (c *SNS) Publish(req *PublishInput) (resp *PublishResult, err error) {
+	return c.PublishWithContext(context.Background(), req)
+}
+
+// PublishWithContext is like Publish, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) PublishWithContext(ctx context.Context, req *PublishInput) (resp *PublishResult, err error) {
 	resp = &PublishResult{}
-	err = c.client.Do("Publish", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "Publish", "POST", "/", req, resp)
 	return
 }
 
 // RemovePermission removes a statement from a topic's access control
 // policy.
 func (c *SNS) RemovePermission(req *RemovePermissionInput) (err error) {
+	return c.RemovePermissionWithContext(context.Background(), req)
+}
+
+// RemovePermissionWithContext is like RemovePermission, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) RemovePermissionWithContext(ctx context.Context, req *RemovePermissionInput) (err error) {
 	// NRE
-	err = c.client.Do("RemovePermission", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RemovePermission", "POST", "/", req, nil)
 	return
 }
 
@@ -262,8 +446,15 @@ func (c *SNS) RemovePermission(req *RemovePermissionInput) (err error) {
 // on one of the supported push notification services, such as GCM and For
 // more information, see Using Amazon SNS Mobile Push Notifications .
 func (c *SNS) SetEndpointAttributes(req *SetEndpointAttributesInput) (err error) {
+	return c.SetEndpointAttributesWithContext(context.Background(), req)
+}
+
+// SetEndpointAttributesWithContext is like SetEndpointAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) SetEndpointAttributesWithContext(ctx context.Context, req *SetEndpointAttributesInput) (err error) {
 	// NRE
-	err = c.client.Do("SetEndpointAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetEndpointAttributes", "POST", "/", req, nil)
 	return
 }
 
@@ -272,24 +463,45 @@ func (c *SNS) SetEndpointAttributes(req *SetEndpointAttributesInput) (err error)
 // and For more information, see Using Amazon SNS Mobile Push Notifications
 // .
 func (c *SNS) SetPlatformApplicationAttributes(req *SetPlatformApplicationAttributesInput) (err error) {
+	return c.SetPlatformApplicationAttributesWithContext(context.Background(), req)
+}
+
+// SetPlatformApplicationAttributesWithContext is like SetPlatformApplicationAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) SetPlatformApplicationAttributesWithContext(ctx context.Context, req *SetPlatformApplicationAttributesInput) (err error) {
 	// NRE
-	err = c.client.Do("SetPlatformApplicationAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetPlatformApplicationAttributes", "POST", "/", req, nil)
 	return
 }
 
 // SetSubscriptionAttributes allows a subscription owner to set an
 // attribute of the topic to a new value.
 func (c *SNS) SetSubscriptionAttributes(req *SetSubscriptionAttributesInput) (err error) {
+	return c.SetSubscriptionAttributesWithContext(context.Background(), req)
+}
+
+// SetSubscriptionAttributesWithContext is like SetSubscriptionAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) SetSubscriptionAttributesWithContext(ctx context.Context, req *SetSubscriptionAttributesInput) (err error) {
 	// NRE
-	err = c.client.Do("SetSubscriptionAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetSubscriptionAttributes", "POST", "/", req, nil)
 	return
 }
 
 // SetTopicAttributes allows a topic owner to set an attribute of the topic
 // to a new value.
 func (c *SNS) SetTopicAttributes(req *SetTopicAttributesInput) (err error) {
+	return c.SetTopicAttributesWithContext(context.Background(), req)
+}
+
+// SetTopicAttributesWithContext is like SetTopicAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) SetTopicAttributesWithContext(ctx context.Context, req *SetTopicAttributesInput) (err error) {
 	// NRE
-	err = c.client.Do("SetTopicAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetTopicAttributes", "POST", "/", req, nil)
 	return
 }
 
@@ -298,8 +510,15 @@ func (c *SNS) SetTopicAttributes(req *SetTopicAttributesInput) (err error) {
 // owner must call the ConfirmSubscription action with the token from the
 // confirmation message. Confirmation tokens are valid for three days.
 func (c *SNS) Subscribe(req *SubscribeInput) (resp *SubscribeResult, err error) {
+	return c.SubscribeWithContext(context.Background(), req)
+}
+
+// SubscribeWithContext is like Subscribe, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) SubscribeWithContext(ctx context.Context, req *SubscribeInput) (resp *SubscribeResult, err error) {
 	resp = &SubscribeResult{}
-	err = c.client.Do("Subscribe", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "Subscribe", "POST", "/", req, resp)
 	return
 }
 
@@ -311,19 +530,58 @@ func (c *SNS) Subscribe(req *SubscribeInput) (resp *SubscribeResult, err error)
 // the endpoint, so that the endpoint owner can easily resubscribe to the
 // topic if the Unsubscribe request was unintended.
 func (c *SNS) Unsubscribe(req *UnsubscribeInput) (err error) {
+	return c.UnsubscribeWithContext(context.Background(), req)
+}
+
+// UnsubscribeWithContext is like Unsubscribe, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SNS) UnsubscribeWithContext(ctx context.Context, req *UnsubscribeInput) (err error) {
 	// NRE
-	err = c.client.Do("Unsubscribe", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "Unsubscribe", "POST", "/", req, nil)
 	return
 }
 
 // AddPermissionInput is undocumented.
 type AddPermissionInput struct {
-	AWSAccountID []string        `query:"AWSAccountId.member" xml:"AWSAccountId>member"`
-	ActionName   []string        `query:"ActionName.member" xml:"ActionName>member"`
+	AWSAccountID []string        `query:"AWSAccountId,list:member" xml:"AWSAccountId>member"`
+	ActionName   []string        `query:"ActionName,list:member" xml:"ActionName>member"`
 	Label        aws.StringValue `query:"Label" xml:"Label"`
 	TopicARN     aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the AddPermissionInput to the form, prefixing their names.
+func (v *AddPermissionInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AWSAccountID != nil {
+		if len(v.AWSAccountID) == 0 {
+			form.Set(prefix+"AWSAccountId", "")
+		}
+		for i1 := range v.AWSAccountID {
+			name1 := prefix + "AWSAccountId.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AWSAccountID[i1])
+		}
+	}
+	if v.ActionName != nil {
+		if len(v.ActionName) == 0 {
+			form.Set(prefix+"ActionName", "")
+		}
+		for i1 := range v.ActionName {
+			name1 := prefix + "ActionName.member." + strconv.Itoa(i1+1)
+			form.Set(name1, v.ActionName[i1])
+		}
+	}
+	if v.Label != nil {
+		form.Set(prefix+"Label", *v.Label)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // ConfirmSubscriptionInput is undocumented.
 type ConfirmSubscriptionInput struct {
 	AuthenticateOnUnsubscribe aws.StringValue `query:"AuthenticateOnUnsubscribe" xml:"AuthenticateOnUnsubscribe"`
@@ -331,6 +589,23 @@ type ConfirmSubscriptionInput struct {
 	TopicARN                  aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the ConfirmSubscriptionInput to the form, prefixing their names.
+func (v *ConfirmSubscriptionInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AuthenticateOnUnsubscribe != nil {
+		form.Set(prefix+"AuthenticateOnUnsubscribe", *v.AuthenticateOnUnsubscribe)
+	}
+	if v.Token != nil {
+		form.Set(prefix+"Token", *v.Token)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // ConfirmSubscriptionResponse is undocumented.
 type ConfirmSubscriptionResponse struct {
 	SubscriptionARN aws.StringValue `query:"SubscriptionArn" xml:"ConfirmSubscriptionResult>SubscriptionArn"`
@@ -343,11 +618,36 @@ type CreateEndpointResponse struct {
 
 // CreatePlatformApplicationInput is undocumented.
 type CreatePlatformApplicationInput struct {
-	Attributes map[string]string `query:"Attributes" xml:"Attributes"`
+	Attributes MapStringToString `query:"Attributes,map:entry:key:value" xml:"Attributes"`
 	Name       aws.StringValue   `query:"Name" xml:"Name"`
 	Platform   aws.StringValue   `query:"Platform" xml:"Platform"`
 }
 
+// MarshalQuery adds the parameters of the CreatePlatformApplicationInput to the form, prefixing their names.
+func (v *CreatePlatformApplicationInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	keys1 := make([]string, 0, len(v.Attributes))
+	for k := range v.Attributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "Attributes.entry." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"key", k1)
+		v1 := v.Attributes[k1]
+		form.Set(name1+"value", v1)
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Platform != nil {
+		form.Set(prefix+"Platform", *v.Platform)
+	}
+	return nil
+}
+
 // CreatePlatformApplicationResponse is undocumented.
 type CreatePlatformApplicationResponse struct {
 	PlatformApplicationARN aws.StringValue `query:"PlatformApplicationArn" xml:"CreatePlatformApplicationResult>PlatformApplicationArn"`
@@ -355,17 +655,56 @@ type CreatePlatformApplicationResponse struct {
 
 // CreatePlatformEndpointInput is undocumented.
 type CreatePlatformEndpointInput struct {
-	Attributes             map[string]string `query:"Attributes" xml:"Attributes"`
+	Attributes             MapStringToString `query:"Attributes,map:entry:key:value" xml:"Attributes"`
 	CustomUserData         aws.StringValue   `query:"CustomUserData" xml:"CustomUserData"`
 	PlatformApplicationARN aws.StringValue   `query:"PlatformApplicationArn" xml:"PlatformApplicationArn"`
 	Token                  aws.StringValue   `query:"Token" xml:"Token"`
 }
 
+// MarshalQuery adds the parameters of the CreatePlatformEndpointInput to the form, prefixing their names.
+func (v *CreatePlatformEndpointInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	keys1 := make([]string, 0, len(v.Attributes))
+	for k := range v.Attributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "Attributes.entry." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"key", k1)
+		v1 := v.Attributes[k1]
+		form.Set(name1+"value", v1)
+	}
+	if v.CustomUserData != nil {
+		form.Set(prefix+"CustomUserData", *v.CustomUserData)
+	}
+	if v.PlatformApplicationARN != nil {
+		form.Set(prefix+"PlatformApplicationArn", *v.PlatformApplicationARN)
+	}
+	if v.Token != nil {
+		form.Set(prefix+"Token", *v.Token)
+	}
+	return nil
+}
+
 // CreateTopicInput is undocumented.
 type CreateTopicInput struct {
 	Name aws.StringValue `query:"Name" xml:"Name"`
 }
 
+// MarshalQuery adds the parameters of the CreateTopicInput to the form, prefixing their names.
+func (v *CreateTopicInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	return nil
+}
+
 // CreateTopicResponse is undocumented.
 type CreateTopicResponse struct {
 	TopicARN aws.StringValue `query:"TopicArn" xml:"CreateTopicResult>TopicArn"`
@@ -376,19 +715,52 @@ type DeleteEndpointInput struct {
 	EndpointARN aws.StringValue `query:"EndpointArn" xml:"EndpointArn"`
 }
 
+// MarshalQuery adds the parameters of the DeleteEndpointInput to the form, prefixing their names.
+func (v *DeleteEndpointInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EndpointARN != nil {
+		form.Set(prefix+"EndpointArn", *v.EndpointARN)
+	}
+	return nil
+}
+
 // DeletePlatformApplicationInput is undocumented.
 type DeletePlatformApplicationInput struct {
 	PlatformApplicationARN aws.StringValue `query:"PlatformApplicationArn" xml:"PlatformApplicationArn"`
 }
 
+// MarshalQuery adds the parameters of the DeletePlatformApplicationInput to the form, prefixing their names.
+func (v *DeletePlatformApplicationInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PlatformApplicationARN != nil {
+		form.Set(prefix+"PlatformApplicationArn", *v.PlatformApplicationARN)
+	}
+	return nil
+}
+
 // DeleteTopicInput is undocumented.
 type DeleteTopicInput struct {
 	TopicARN aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the DeleteTopicInput to the form, prefixing their names.
+func (v *DeleteTopicInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // Endpoint is undocumented.
 type Endpoint struct {
-	Attributes  map[string]string `query:"Attributes" xml:"Attributes"`
+	Attributes  MapStringToString `query:"Attributes,map:entry:key:value" xml:"Attributes"`
 	EndpointARN aws.StringValue   `query:"EndpointArn" xml:"EndpointArn"`
 }
 
@@ -397,9 +769,20 @@ type GetEndpointAttributesInput struct {
 	EndpointARN aws.StringValue `query:"EndpointArn" xml:"EndpointArn"`
 }
 
+// MarshalQuery adds the parameters of the GetEndpointAttributesInput to the form, prefixing their names.
+func (v *GetEndpointAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EndpointARN != nil {
+		form.Set(prefix+"EndpointArn", *v.EndpointARN)
+	}
+	return nil
+}
+
 // GetEndpointAttributesResponse is undocumented.
 type GetEndpointAttributesResponse struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetEndpointAttributesResult>Attributes"`
+	Attributes MapStringToString `query:"Attributes,map:entry:key:value" xml:"GetEndpointAttributesResult>Attributes"`
 }
 
 // GetPlatformApplicationAttributesInput is undocumented.
@@ -407,9 +790,20 @@ type GetPlatformApplicationAttributesInput struct {
 	PlatformApplicationARN aws.StringValue `query:"PlatformApplicationArn" xml:"PlatformApplicationArn"`
 }
 
+// MarshalQuery adds the parameters of the GetPlatformApplicationAttributesInput to the form, prefixing their names.
+func (v *GetPlatformApplicationAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.PlatformApplicationARN != nil {
+		form.Set(prefix+"PlatformApplicationArn", *v.PlatformApplicationARN)
+	}
+	return nil
+}
+
 // GetPlatformApplicationAttributesResponse is undocumented.
 type GetPlatformApplicationAttributesResponse struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetPlatformApplicationAttributesResult>Attributes"`
+	Attributes MapStringToString `query:"Attributes,map:entry:key:value" xml:"GetPlatformApplicationAttributesResult>Attributes"`
 }
 
 // GetSubscriptionAttributesInput is undocumented.
@@ -417,9 +811,20 @@ type GetSubscriptionAttributesInput struct {
 	SubscriptionARN aws.StringValue `query:"SubscriptionArn" xml:"SubscriptionArn"`
 }
 
+// MarshalQuery adds the parameters of the GetSubscriptionAttributesInput to the form, prefixing their names.
+func (v *GetSubscriptionAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SubscriptionARN != nil {
+		form.Set(prefix+"SubscriptionArn", *v.SubscriptionARN)
+	}
+	return nil
+}
+
 // GetSubscriptionAttributesResponse is undocumented.
 type GetSubscriptionAttributesResponse struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetSubscriptionAttributesResult>Attributes"`
+	Attributes SubscriptionAttributesMap `query:"Attributes,map:entry:key:value" xml:"GetSubscriptionAttributesResult>Attributes"`
 }
 
 // GetTopicAttributesInput is undocumented.
@@ -427,9 +832,20 @@ type GetTopicAttributesInput struct {
 	TopicARN aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the GetTopicAttributesInput to the form, prefixing their names.
+func (v *GetTopicAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // GetTopicAttributesResponse is undocumented.
 type GetTopicAttributesResponse struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetTopicAttributesResult>Attributes"`
+	Attributes TopicAttributesMap `query:"Attributes,map:entry:key:value" xml:"GetTopicAttributesResult>Attributes"`
 }
 
 // ListEndpointsByPlatformApplicationInput is undocumented.
@@ -438,9 +854,23 @@ type ListEndpointsByPlatformApplicationInput struct {
 	PlatformApplicationARN aws.StringValue `query:"PlatformApplicationArn" xml:"PlatformApplicationArn"`
 }
 
+// MarshalQuery adds the parameters of the ListEndpointsByPlatformApplicationInput to the form, prefixing their names.
+func (v *ListEndpointsByPlatformApplicationInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.PlatformApplicationARN != nil {
+		form.Set(prefix+"PlatformApplicationArn", *v.PlatformApplicationARN)
+	}
+	return nil
+}
+
 // ListEndpointsByPlatformApplicationResponse is undocumented.
 type ListEndpointsByPlatformApplicationResponse struct {
-	Endpoints []Endpoint      `query:"Endpoints.member" xml:"ListEndpointsByPlatformApplicationResult>Endpoints>member"`
+	Endpoints []Endpoint      `query:"Endpoints,list:member" xml:"ListEndpointsByPlatformApplicationResult>Endpoints>member"`
 	NextToken aws.StringValue `query:"NextToken" xml:"ListEndpointsByPlatformApplicationResult>NextToken"`
 }
 
@@ -449,10 +879,21 @@ type ListPlatformApplicationsInput struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the ListPlatformApplicationsInput to the form, prefixing their names.
+func (v *ListPlatformApplicationsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // ListPlatformApplicationsResponse is undocumented.
 type ListPlatformApplicationsResponse struct {
 	NextToken            aws.StringValue       `query:"NextToken" xml:"ListPlatformApplicationsResult>NextToken"`
-	PlatformApplications []PlatformApplication `query:"PlatformApplications.member" xml:"ListPlatformApplicationsResult>PlatformApplications>member"`
+	PlatformApplications []PlatformApplication `query:"PlatformApplications,list:member" xml:"ListPlatformApplicationsResult>PlatformApplications>member"`
 }
 
 // ListSubscriptionsByTopicInput is undocumented.
@@ -461,10 +902,24 @@ type ListSubscriptionsByTopicInput struct {
 	TopicARN  aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the ListSubscriptionsByTopicInput to the form, prefixing their names.
+func (v *ListSubscriptionsByTopicInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // ListSubscriptionsByTopicResponse is undocumented.
 type ListSubscriptionsByTopicResponse struct {
 	NextToken     aws.StringValue `query:"NextToken" xml:"ListSubscriptionsByTopicResult>NextToken"`
-	Subscriptions []Subscription  `query:"Subscriptions.member" xml:"ListSubscriptionsByTopicResult>Subscriptions>member"`
+	Subscriptions []Subscription  `query:"Subscriptions,list:member" xml:"ListSubscriptionsByTopicResult>Subscriptions>member"`
 }
 
 // ListSubscriptionsInput is undocumented.
@@ -472,10 +927,21 @@ type ListSubscriptionsInput struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the ListSubscriptionsInput to the form, prefixing their names.
+func (v *ListSubscriptionsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // ListSubscriptionsResponse is undocumented.
 type ListSubscriptionsResponse struct {
 	NextToken     aws.StringValue `query:"NextToken" xml:"ListSubscriptionsResult>NextToken"`
-	Subscriptions []Subscription  `query:"Subscriptions.member" xml:"ListSubscriptionsResult>Subscriptions>member"`
+	Subscriptions []Subscription  `query:"Subscriptions,list:member" xml:"ListSubscriptionsResult>Subscriptions>member"`
 }
 
 // ListTopicsInput is undocumented.
@@ -483,10 +949,37 @@ type ListTopicsInput struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"NextToken"`
 }
 
+// MarshalQuery adds the parameters of the ListTopicsInput to the form, prefixing their names.
+func (v *ListTopicsInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.NextToken != nil {
+		form.Set(prefix+"NextToken", *v.NextToken)
+	}
+	return nil
+}
+
 // ListTopicsResponse is undocumented.
 type ListTopicsResponse struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"ListTopicsResult>NextToken"`
-	Topics    []Topic         `query:"Topics.member" xml:"ListTopicsResult>Topics>member"`
+	Topics    []Topic         `query:"Topics,list:member" xml:"ListTopicsResult>Topics>member"`
+}
+
+// MapStringToString is undocumented.
+type MapStringToString map[string]string
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *MapStringToString) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
+// MessageAttributeMap is undocumented.
+type MessageAttributeMap map[string]MessageAttributeValue
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *MessageAttributeMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "Name", "Value")
 }
 
 // MessageAttributeValue is undocumented.
@@ -496,20 +989,73 @@ type MessageAttributeValue struct {
 	StringValue aws.StringValue `query:"StringValue" xml:"StringValue"`
 }
 
+// MarshalQuery adds the parameters of the MessageAttributeValue to the form, prefixing their names.
+func (v *MessageAttributeValue) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BinaryValue != nil {
+		form.Set(prefix+"BinaryValue", base64.StdEncoding.EncodeToString(v.BinaryValue))
+	}
+	if v.DataType != nil {
+		form.Set(prefix+"DataType", *v.DataType)
+	}
+	if v.StringValue != nil {
+		form.Set(prefix+"StringValue", *v.StringValue)
+	}
+	return nil
+}
+
 // PlatformApplication is undocumented.
 type PlatformApplication struct {
-	Attributes             map[string]string `query:"Attributes" xml:"Attributes"`
+	Attributes             MapStringToString `query:"Attributes,map:entry:key:value" xml:"Attributes"`
 	PlatformApplicationARN aws.StringValue   `query:"PlatformApplicationArn" xml:"PlatformApplicationArn"`
 }
 
 // PublishInput is undocumented.
 type PublishInput struct {
-	Message           aws.StringValue                  `query:"Message" xml:"Message"`
-	MessageAttributes map[string]MessageAttributeValue `query:"MessageAttributes" xml:"MessageAttributes"`
-	MessageStructure  aws.StringValue                  `query:"MessageStructure" xml:"MessageStructure"`
-	Subject           aws.StringValue                  `query:"Subject" xml:"Subject"`
-	TargetARN         aws.StringValue                  `query:"TargetArn" xml:"TargetArn"`
-	TopicARN          aws.StringValue                  `query:"TopicArn" xml:"TopicArn"`
+	Message           aws.StringValue     `query:"Message" xml:"Message"`
+	MessageAttributes MessageAttributeMap `query:"MessageAttributes,map:entry:Name:Value" xml:"MessageAttributes"`
+	MessageStructure  aws.StringValue     `query:"MessageStructure" xml:"MessageStructure"`
+	Subject           aws.StringValue     `query:"Subject" xml:"Subject"`
+	TargetARN         aws.StringValue     `query:"TargetArn" xml:"TargetArn"`
+	TopicARN          aws.StringValue     `query:"TopicArn" xml:"TopicArn"`
+}
+
+// MarshalQuery adds the parameters of the PublishInput to the form, prefixing their names.
+func (v *PublishInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Message != nil {
+		form.Set(prefix+"Message", *v.Message)
+	}
+	keys1 := make([]string, 0, len(v.MessageAttributes))
+	for k := range v.MessageAttributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "MessageAttributes.entry." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"Name", k1)
+		v1 := v.MessageAttributes[k1]
+		if err := v1.MarshalQuery(form, name1+"Value."); err != nil {
+			return err
+		}
+	}
+	if v.MessageStructure != nil {
+		form.Set(prefix+"MessageStructure", *v.MessageStructure)
+	}
+	if v.Subject != nil {
+		form.Set(prefix+"Subject", *v.Subject)
+	}
+	if v.TargetARN != nil {
+		form.Set(prefix+"TargetArn", *v.TargetARN)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
 }
 
 // PublishResponse is undocumented.
@@ -523,18 +1069,76 @@ type RemovePermissionInput struct {
 	TopicARN aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the RemovePermissionInput to the form, prefixing their names.
+func (v *RemovePermissionInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Label != nil {
+		form.Set(prefix+"Label", *v.Label)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // SetEndpointAttributesInput is undocumented.
 type SetEndpointAttributesInput struct {
-	Attributes  map[string]string `query:"Attributes" xml:"Attributes"`
+	Attributes  MapStringToString `query:"Attributes,map:entry:key:value" xml:"Attributes"`
 	EndpointARN aws.StringValue   `query:"EndpointArn" xml:"EndpointArn"`
 }
 
+// MarshalQuery adds the parameters of the SetEndpointAttributesInput to the form, prefixing their names.
+func (v *SetEndpointAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	keys1 := make([]string, 0, len(v.Attributes))
+	for k := range v.Attributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "Attributes.entry." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"key", k1)
+		v1 := v.Attributes[k1]
+		form.Set(name1+"value", v1)
+	}
+	if v.EndpointARN != nil {
+		form.Set(prefix+"EndpointArn", *v.EndpointARN)
+	}
+	return nil
+}
+
 // SetPlatformApplicationAttributesInput is undocumented.
 type SetPlatformApplicationAttributesInput struct {
-	Attributes             map[string]string `query:"Attributes" xml:"Attributes"`
+	Attributes             MapStringToString `query:"Attributes,map:entry:key:value" xml:"Attributes"`
 	PlatformApplicationARN aws.StringValue   `query:"PlatformApplicationArn" xml:"PlatformApplicationArn"`
 }
 
+// MarshalQuery adds the parameters of the SetPlatformApplicationAttributesInput to the form, prefixing their names.
+func (v *SetPlatformApplicationAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	keys1 := make([]string, 0, len(v.Attributes))
+	for k := range v.Attributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "Attributes.entry." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"key", k1)
+		v1 := v.Attributes[k1]
+		form.Set(name1+"value", v1)
+	}
+	if v.PlatformApplicationARN != nil {
+		form.Set(prefix+"PlatformApplicationArn", *v.PlatformApplicationARN)
+	}
+	return nil
+}
+
 // SetSubscriptionAttributesInput is undocumented.
 type SetSubscriptionAttributesInput struct {
 	AttributeName   aws.StringValue `query:"AttributeName" xml:"AttributeName"`
@@ -542,6 +1146,23 @@ type SetSubscriptionAttributesInput struct {
 	SubscriptionARN aws.StringValue `query:"SubscriptionArn" xml:"SubscriptionArn"`
 }
 
+// MarshalQuery adds the parameters of the SetSubscriptionAttributesInput to the form, prefixing their names.
+func (v *SetSubscriptionAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttributeName != nil {
+		form.Set(prefix+"AttributeName", *v.AttributeName)
+	}
+	if v.AttributeValue != nil {
+		form.Set(prefix+"AttributeValue", *v.AttributeValue)
+	}
+	if v.SubscriptionARN != nil {
+		form.Set(prefix+"SubscriptionArn", *v.SubscriptionARN)
+	}
+	return nil
+}
+
 // SetTopicAttributesInput is undocumented.
 type SetTopicAttributesInput struct {
 	AttributeName  aws.StringValue `query:"AttributeName" xml:"AttributeName"`
@@ -549,6 +1170,23 @@ type SetTopicAttributesInput struct {
 	TopicARN       aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the SetTopicAttributesInput to the form, prefixing their names.
+func (v *SetTopicAttributesInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttributeName != nil {
+		form.Set(prefix+"AttributeName", *v.AttributeName)
+	}
+	if v.AttributeValue != nil {
+		form.Set(prefix+"AttributeValue", *v.AttributeValue)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // SubscribeInput is undocumented.
 type SubscribeInput struct {
 	Endpoint aws.StringValue `query:"Endpoint" xml:"Endpoint"`
@@ -556,6 +1194,23 @@ type SubscribeInput struct {
 	TopicARN aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// MarshalQuery adds the parameters of the SubscribeInput to the form, prefixing their names.
+func (v *SubscribeInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Endpoint != nil {
+		form.Set(prefix+"Endpoint", *v.Endpoint)
+	}
+	if v.Protocol != nil {
+		form.Set(prefix+"Protocol", *v.Protocol)
+	}
+	if v.TopicARN != nil {
+		form.Set(prefix+"TopicArn", *v.TopicARN)
+	}
+	return nil
+}
+
 // SubscribeResponse is undocumented.
 type SubscribeResponse struct {
 	SubscriptionARN aws.StringValue `query:"SubscriptionArn" xml:"SubscribeResult>SubscriptionArn"`
@@ -570,16 +1225,43 @@ type Subscription struct {
 	TopicARN        aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// SubscriptionAttributesMap is undocumented.
+type SubscriptionAttributesMap map[string]string
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *SubscriptionAttributesMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
 // Topic is undocumented.
 type Topic struct {
 	TopicARN aws.StringValue `query:"TopicArn" xml:"TopicArn"`
 }
 
+// TopicAttributesMap is undocumented.
+type TopicAttributesMap map[string]string
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *TopicAttributesMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "key", "value")
+}
+
 // UnsubscribeInput is undocumented.
 type UnsubscribeInput struct {
 	SubscriptionARN aws.StringValue `query:"SubscriptionArn" xml:"SubscriptionArn"`
 }
 
+// MarshalQuery adds the parameters of the UnsubscribeInput to the form, prefixing their names.
+func (v *UnsubscribeInput) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.SubscriptionARN != nil {
+		form.Set(prefix+"SubscriptionArn", *v.SubscriptionARN)
+	}
+	return nil
+}
+
 // ConfirmSubscriptionResult is a wrapper for ConfirmSubscriptionResponse.
 type ConfirmSubscriptionResult struct {
 	SubscriptionARN aws.StringValue `query:"SubscriptionArn" xml:"ConfirmSubscriptionResult>SubscriptionArn"`
@@ -602,52 +1284,52 @@ type CreateTopicResult struct {
 
 // GetEndpointAttributesResult is a wrapper for GetEndpointAttributesResponse.
 type GetEndpointAttributesResult struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetEndpointAttributesResult>Attributes"`
+	Attributes MapStringToString `query:"Attributes,map:entry:key:value" xml:"GetEndpointAttributesResult>Attributes"`
 }
 
 // GetPlatformApplicationAttributesResult is a wrapper for GetPlatformApplicationAttributesResponse.
 type GetPlatformApplicationAttributesResult struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetPlatformApplicationAttributesResult>Attributes"`
+	Attributes MapStringToString `query:"Attributes,map:entry:key:value" xml:"GetPlatformApplicationAttributesResult>Attributes"`
 }
 
 // GetSubscriptionAttributesResult is a wrapper for GetSubscriptionAttributesResponse.
 type GetSubscriptionAttributesResult struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetSubscriptionAttributesResult>Attributes"`
+	Attributes SubscriptionAttributesMap `query:"Attributes,map:entry:key:value" xml:"GetSubscriptionAttributesResult>Attributes"`
 }
 
 // GetTopicAttributesResult is a wrapper for GetTopicAttributesResponse.
 type GetTopicAttributesResult struct {
-	Attributes map[string]string `query:"Attributes" xml:"GetTopicAttributesResult>Attributes"`
+	Attributes TopicAttributesMap `query:"Attributes,map:entry:key:value" xml:"GetTopicAttributesResult>Attributes"`
 }
 
 // ListEndpointsByPlatformApplicationResult is a wrapper for ListEndpointsByPlatformApplicationResponse.
 type ListEndpointsByPlatformApplicationResult struct {
-	Endpoints []Endpoint      `query:"Endpoints.member" xml:"ListEndpointsByPlatformApplicationResult>Endpoints>member"`
+	Endpoints []Endpoint      `query:"Endpoints,list:member" xml:"ListEndpointsByPlatformApplicationResult>Endpoints>member"`
 	NextToken aws.StringValue `query:"NextToken" xml:"ListEndpointsByPlatformApplicationResult>NextToken"`
 }
 
 // ListPlatformApplicationsResult is a wrapper for ListPlatformApplicationsResponse.
 type ListPlatformApplicationsResult struct {
 	NextToken            aws.StringValue       `query:"NextToken" xml:"ListPlatformApplicationsResult>NextToken"`
-	PlatformApplications []PlatformApplication `query:"PlatformApplications.member" xml:"ListPlatformApplicationsResult>PlatformApplications>member"`
+	PlatformApplications []PlatformApplication `query:"PlatformApplications,list:member" xml:"ListPlatformApplicationsResult>PlatformApplications>member"`
 }
 
 // ListSubscriptionsByTopicResult is a wrapper for ListSubscriptionsByTopicResponse.
 type ListSubscriptionsByTopicResult struct {
 	NextToken     aws.StringValue `query:"NextToken" xml:"ListSubscriptionsByTopicResult>NextToken"`
-	Subscriptions []Subscription  `query:"Subscriptions.member" xml:"ListSubscriptionsByTopicResult>Subscriptions>member"`
+	Subscriptions []Subscription  `query:"Subscriptions,list:member" xml:"ListSubscriptionsByTopicResult>Subscriptions>member"`
 }
 
 // ListSubscriptionsResult is a wrapper for ListSubscriptionsResponse.
 type ListSubscriptionsResult struct {
 	NextToken     aws.StringValue `query:"NextToken" xml:"ListSubscriptionsResult>NextToken"`
-	Subscriptions []Subscription  `query:"Subscriptions.member" xml:"ListSubscriptionsResult>Subscriptions>member"`
+	Subscriptions []Subscription  `query:"Subscriptions,list:member" xml:"ListSubscriptionsResult>Subscriptions>member"`
 }
 
 // ListTopicsResult is a wrapper for ListTopicsResponse.
 type ListTopicsResult struct {
 	NextToken aws.StringValue `query:"NextToken" xml:"ListTopicsResult>NextToken"`
-	Topics    []Topic         `query:"Topics.member" xml:"ListTopicsResult>Topics>member"`
+	Topics    []Topic         `query:"Topics,list:member" xml:"ListTopicsResult>Topics>member"`
 }
 
 // PublishResult is a wrapper for PublishResponse.
@@ -660,5 +1342,69 @@ type SubscribeResult struct {
 	SubscriptionARN aws.StringValue `query:"SubscriptionArn" xml:"SubscribeResult>SubscriptionArn"`
 }
 
+// AuthorizationErrorException is the error returned for the AuthorizationError error code.
+type AuthorizationErrorException struct {
+	aws.APIError
+}
+
+// EndpointDisabledException is the error returned for the EndpointDisabled error code.
+type EndpointDisabledException struct {
+	aws.APIError
+}
+
+// InternalErrorException is the error returned for the InternalError error code.
+type InternalErrorException struct {
+	aws.APIError
+}
+
+// InvalidParameterException is the error returned for the InvalidParameter error code.
+type InvalidParameterException struct {
+	aws.APIError
+}
+
+// InvalidParameterValueException is the error returned for the ParameterValueInvalid error code.
+type InvalidParameterValueException struct {
+	aws.APIError
+}
+
+// NotFoundException is the error returned for the NotFound error code.
+type NotFoundException struct {
+	aws.APIError
+}
+
+// PlatformApplicationDisabledException is the error returned for the PlatformApplicationDisabled error code.
+type PlatformApplicationDisabledException struct {
+	aws.APIError
+}
+
+// SubscriptionLimitExceededException is the error returned for the SubscriptionLimitExceeded error code.
+type SubscriptionLimitExceededException struct {
+	aws.APIError
+}
+
+// TopicLimitExceededException is the error returned for the TopicLimitExceeded error code.
+type TopicLimitExceededException struct {
+	aws.APIError
+}
+
+// exceptions maps SNS's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AuthorizationError":          AuthorizationErrorException{},
+	"EndpointDisabled":            EndpointDisabledException{},
+	"InternalError":               InternalErrorException{},
+	"InvalidParameter":            InvalidParameterException{},
+	"ParameterValueInvalid":       InvalidParameterValueException{},
+	"NotFound":                    NotFoundException{},
+	"PlatformApplicationDisabled": PlatformApplicationDisabledException{},
+	"SubscriptionLimitExceeded":   SubscriptionLimitExceededException{},
+	"TopicLimitExceeded":          TopicLimitExceededException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,11 +4,21 @@
 package sqs
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // SQS is a client for Amazon Simple Queue Service.
@@ -16,29 +26,70 @@ type SQS struct {
 	client *aws.QueryClient
 }
 
-// New returns a new SQS client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *SQS {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "sqs"
-	endpoint, service, region := endpoints.Lookup("sqs", region)
+// New returns a new SQS client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *SQS {
+	cfg := newConfig(region, client, opts)
 
 	return &SQS{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2012-11-05",
 		},
 	}
 }
 
+// NewFromSession returns a new SQS client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *SQS {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("sqs", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("sqs", "sqs"),
+	}, "AWS_ENDPOINT_URL_SQS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *SQS) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *SQS) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *SQS) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AddPermission adds a permission to a queue for a specific principal .
 // This allows for sharing access to the queue. When you create a queue,
 // you have full control access rights for the queue. Only you (as owner of
@@ -52,8 +103,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *SQS
 // param.n notation. Values of n are integers starting from 1. For example,
 // a parameter list with two elements looks like this:
 func (c *SQS) AddPermission(req *AddPermissionRequest) (err error) {
+	return c.AddPermissionWithContext(context.Background(), req)
+}
+
+// AddPermissionWithContext is like AddPermission, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) AddPermissionWithContext(ctx context.Context, req *AddPermissionRequest) (err error) {
 	// NRE
-	err = c.client.Do("AddPermission", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "AddPermission", "POST", "/", req, nil)
 	return
 }
 
@@ -86,8 +144,15 @@ func (c *SQS) AddPermission(req *AddPermissionRequest) (err error) {
 // timeout value, not the value you set with the ChangeMessageVisibility
 // action.
 func (c *SQS) ChangeMessageVisibility(req *ChangeMessageVisibilityRequest) (err error) {
+	return c.ChangeMessageVisibilityWithContext(context.Background(), req)
+}
+
+// ChangeMessageVisibilityWithContext is like ChangeMessageVisibility, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) ChangeMessageVisibilityWithContext(ctx context.Context, req *ChangeMessageVisibilityRequest) (err error) {
 	// NRE
-	err = c.client.Do("ChangeMessageVisibility", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "ChangeMessageVisibility", "POST", "/", req, nil)
 	return
 }
 
@@ -102,8 +167,15 @@ func (c *SQS) ChangeMessageVisibility(req *ChangeMessageVisibilityRequest) (err
 // specified using the param.n notation. Values of n are integers starting
 // from 1. For example, a parameter list with two elements looks like this:
 func (c *SQS) ChangeMessageVisibilityBatch(req *ChangeMessageVisibilityBatchRequest) (resp *ChangeMessageVisibilityBatchResult, err error) {
+	return c.ChangeMessageVisibilityBatchWithContext(context.Background(), req)
+}
+
+// ChangeMessageVisibilityBatchWithContext is like ChangeMessageVisibilityBatch, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) ChangeMessageVisibilityBatchWithContext(ctx context.Context, req *ChangeMessageVisibilityBatchRequest) (resp *ChangeMessageVisibilityBatchResult, err error) {
 	resp = &ChangeMessageVisibilityBatchResult{}
-	err = c.client.Do("ChangeMessageVisibilityBatch", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ChangeMessageVisibilityBatch", "POST", "/", req, resp)
 	return
 }
 
@@ -125,8 +197,15 @@ func (c *SQS) ChangeMessageVisibilityBatch(req *ChangeMessageVisibilityBatchRequ
 // notation. Values of n are integers starting from 1. For example, a
 // parameter list with two elements looks like this:
 func (c *SQS) CreateQueue(req *CreateQueueRequest) (resp *CreateQueueResult, err error) {
+	return c.CreateQueueWithContext(context.Background(), req)
+}
+
+// CreateQueueWithContext is like CreateQueue, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) CreateQueueWithContext(ctx context.Context, req *CreateQueueRequest) (resp *CreateQueueResult, err error) {
 	resp = &CreateQueueResult{}
-	err = c.client.Do("CreateQueue", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateQueue", "POST", "/", req, resp)
 	return
 }
 
@@ -150,8 +229,15 @@ func (c *SQS) CreateQueue(req *CreateQueueRequest) (resp *CreateQueueResult, err
 // idempotent so that receiving a particular message more than once is not
 // a problem.
 func (c *SQS) DeleteMessage(req *DeleteMessageRequest) (err error) {
+	return c.DeleteMessageWithContext(context.Background(), req)
+}
+
+// DeleteMessageWithContext is like DeleteMessage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) DeleteMessageWithContext(ctx context.Context, req *DeleteMessageRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteMessage", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteMessage", "POST", "/", req, nil)
 	return
 }
 
@@ -165,8 +251,15 @@ func (c *SQS) DeleteMessage(req *DeleteMessageRequest) (err error) {
 // of n are integers starting from 1. For example, a parameter list with
 // two elements looks like this:
 func (c *SQS) DeleteMessageBatch(req *DeleteMessageBatchRequest) (resp *DeleteMessageBatchResult, err error) {
+	return c.DeleteMessageBatchWithContext(context.Background(), req)
+}
+
+// DeleteMessageBatchWithContext is like DeleteMessageBatch, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) DeleteMessageBatchWithContext(ctx context.Context, req *DeleteMessageBatchRequest) (resp *DeleteMessageBatchResult, err error) {
 	resp = &DeleteMessageBatchResult{}
-	err = c.client.Do("DeleteMessageBatch", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteMessageBatch", "POST", "/", req, resp)
 	return
 }
 
@@ -184,8 +277,15 @@ func (c *SQS) DeleteMessageBatch(req *DeleteMessageBatchRequest) (resp *DeleteMe
 // information, see How Amazon SQS Queues Work in the Amazon SQS Developer
 // Guide .
 func (c *SQS) DeleteQueue(req *DeleteQueueRequest) (err error) {
+	return c.DeleteQueueWithContext(context.Background(), req)
+}
+
+// DeleteQueueWithContext is like DeleteQueue, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) DeleteQueueWithContext(ctx context.Context, req *DeleteQueueRequest) (err error) {
 	// NRE
-	err = c.client.Do("DeleteQueue", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeleteQueue", "POST", "/", req, nil)
 	return
 }
 
@@ -221,8 +321,15 @@ func (c *SQS) DeleteQueue(req *DeleteQueueRequest) (err error) {
 // notation. Values of n are integers starting from 1. For example, a
 // parameter list with two elements looks like this:
 func (c *SQS) GetQueueAttributes(req *GetQueueAttributesRequest) (resp *GetQueueAttributesResult, err error) {
+	return c.GetQueueAttributesWithContext(context.Background(), req)
+}
+
+// GetQueueAttributesWithContext is like GetQueueAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) GetQueueAttributesWithContext(ctx context.Context, req *GetQueueAttributesRequest) (resp *GetQueueAttributesResult, err error) {
 	resp = &GetQueueAttributesResult{}
-	err = c.client.Do("GetQueueAttributes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetQueueAttributes", "POST", "/", req, resp)
 	return
 }
 
@@ -234,8 +341,15 @@ func (c *SQS) GetQueueAttributes(req *GetQueueAttributesRequest) (resp *GetQueue
 // information about shared queue access, see AddPermission or go to Shared
 // Queues in the Amazon SQS Developer Guide .
 func (c *SQS) GetQueueURL(req *GetQueueURLRequest) (resp *GetQueueURLResult, err error) {
+	return c.GetQueueURLWithContext(context.Background(), req)
+}
+
+// GetQueueURLWithContext is like GetQueueURL, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) GetQueueURLWithContext(ctx context.Context, req *GetQueueURLRequest) (resp *GetQueueURLResult, err error) {
 	resp = &GetQueueURLResult{}
-	err = c.client.Do("GetQueueUrl", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetQueueUrl", "POST", "/", req, resp)
 	return
 }
 
@@ -244,8 +358,15 @@ func (c *SQS) GetQueueURL(req *GetQueueURLRequest) (resp *GetQueueURLResult, err
 // more information about using dead letter queues, see Using Amazon SQS
 // Dead Letter Queues
 func (c *SQS) ListDeadLetterSourceQueues(req *ListDeadLetterSourceQueuesRequest) (resp *ListDeadLetterSourceQueuesResult, err error) {
+	return c.ListDeadLetterSourceQueuesWithContext(context.Background(), req)
+}
+
+// ListDeadLetterSourceQueuesWithContext is like ListDeadLetterSourceQueues, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) ListDeadLetterSourceQueuesWithContext(ctx context.Context, req *ListDeadLetterSourceQueuesRequest) (resp *ListDeadLetterSourceQueuesResult, err error) {
 	resp = &ListDeadLetterSourceQueuesResult{}
-	err = c.client.Do("ListDeadLetterSourceQueues", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDeadLetterSourceQueues", "POST", "/", req, resp)
 	return
 }
 
@@ -254,8 +375,15 @@ func (c *SQS) ListDeadLetterSourceQueues(req *ListDeadLetterSourceQueuesRequest)
 // QueueNamePrefix parameter, only queues with a name beginning with the
 // specified value are returned.
 func (c *SQS) ListQueues(req *ListQueuesRequest) (resp *ListQueuesResult, err error) {
+	return c.ListQueuesWithContext(context.Background(), req)
+}
+
+// ListQueuesWithContext is like ListQueues, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) ListQueuesWithContext(ctx context.Context, req *ListQueuesRequest) (resp *ListQueuesResult, err error) {
 	resp = &ListQueuesResult{}
-	err = c.client.Do("ListQueues", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListQueues", "POST", "/", req, resp)
 	return
 }
 
@@ -268,8 +396,15 @@ func (c *SQS) ListQueues(req *ListQueuesRequest) (resp *ListQueuesResult, err er
 // before PurgeQueue was called may be received, but will be deleted within
 // the next minute.
 func (c *SQS) PurgeQueue(req *PurgeQueueRequest) (err error) {
+	return c.PurgeQueueWithContext(context.Background(), req)
+}
+
+// PurgeQueueWithContext is like PurgeQueue, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) PurgeQueueWithContext(ctx context.Context, req *PurgeQueueRequest) (err error) {
 	// NRE
-	err = c.client.Do("PurgeQueue", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "PurgeQueue", "POST", "/", req, nil)
 	return
 }
 
@@ -300,8 +435,15 @@ func (c *SQS) PurgeQueue(req *PurgeQueueRequest) (err error) {
 // action, we recommend that you structure your code so that it can handle
 // new attributes gracefully.
 func (c *SQS) ReceiveMessage(req *ReceiveMessageRequest) (resp *ReceiveMessageResult, err error) {
+	return c.ReceiveMessageWithContext(context.Background(), req)
+}
+
+// ReceiveMessageWithContext is like ReceiveMessage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) ReceiveMessageWithContext(ctx context.Context, req *ReceiveMessageRequest) (resp *ReceiveMessageResult, err error) {
 	resp = &ReceiveMessageResult{}
-	err = c.client.Do("ReceiveMessage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ReceiveMessage", "POST", "/", req, resp)
 	return
 }
 
@@ -309,8 +451,15 @@ func (c *SQS) ReceiveMessage(req *ReceiveMessageRequest) (resp *ReceiveMessageRe
 // matches the specified Label parameter. Only the owner of the queue can
 // remove permissions.
 func (c *SQS) RemovePermission(req *RemovePermissionRequest) (err error) {
+	return c.RemovePermissionWithContext(context.Background(), req)
+}
+
+// RemovePermissionWithContext is like RemovePermission, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) RemovePermissionWithContext(ctx context.Context, req *RemovePermissionRequest) (err error) {
 	// NRE
-	err = c.client.Do("RemovePermission", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RemovePermission", "POST", "/", req, nil)
 	return
 }
 
@@ -325,8 +474,15 @@ func (c *SQS) RemovePermission(req *RemovePermissionRequest) (err error) {
 // included in the list, your request will be rejected. #x9 | #xA | #xD |
 // [#x20 to #xD7FF] | [#xE000 to #xFFFD] | [#x10000 to #x10FFFF]
 func (c *SQS) SendMessage(req *SendMessageRequest) (resp *SendMessageResult, err error) {
+	return c.SendMessageWithContext(context.Background(), req)
+}
+
+// SendMessageWithContext is like SendMessage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) SendMessageWithContext(ctx context.Context, req *SendMessageRequest) (resp *SendMessageResult, err error) {
 	resp = &SendMessageResult{}
-	err = c.client.Do("SendMessage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SendMessage", "POST", "/", req, resp)
 	return
 }
 
@@ -349,8 +505,15 @@ func (c *SQS) SendMessage(req *SendMessageRequest) (resp *SendMessageResult, err
 // param.n notation. Values of n are integers starting from 1. For example,
 // a parameter list with two elements looks like this:
 func (c *SQS) SendMessageBatch(req *SendMessageBatchRequest) (resp *SendMessageBatchResult, err error) {
+	return c.SendMessageBatchWithContext(context.Background(), req)
+}
+
+// SendMessageBatchWithContext is like SendMessageBatch, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) SendMessageBatchWithContext(ctx context.Context, req *SendMessageBatchRequest) (resp *SendMessageBatchResult, err error) {
 	resp = &SendMessageBatchResult{}
-	err = c.client.Do("SendMessageBatch", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "SendMessageBatch", "POST", "/", req, resp)
 	return
 }
 
@@ -362,19 +525,66 @@ func (c *SQS) SendMessageBatch(req *SendMessageBatchRequest) (resp *SendMessageB
 // writing code that calls this action, we recommend that you structure
 // your code so that it can handle new attributes gracefully.
 func (c *SQS) SetQueueAttributes(req *SetQueueAttributesRequest) (err error) {
+	return c.SetQueueAttributesWithContext(context.Background(), req)
+}
+
+// SetQueueAttributesWithContext is like SetQueueAttributes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SQS) SetQueueAttributesWithContext(ctx context.Context, req *SetQueueAttributesRequest) (err error) {
 	// NRE
-	err = c.client.Do("SetQueueAttributes", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SetQueueAttributes", "POST", "/", req, nil)
 	return
 }
 
 // AddPermissionRequest is undocumented.
 type AddPermissionRequest struct {
-	AWSAccountIDs []string        `query:"AWSAccountIds" xml:"AWSAccountId"`
-	Actions       []string        `query:"Actions" xml:"ActionName"`
+	AWSAccountIDs []string        `query:"AWSAccountId,list" xml:"AWSAccountId"`
+	Actions       []string        `query:"ActionName,list" xml:"ActionName"`
 	Label         aws.StringValue `query:"Label" xml:"Label"`
 	QueueURL      aws.StringValue `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the AddPermissionRequest to the form, prefixing their names.
+func (v *AddPermissionRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AWSAccountIDs != nil {
+		if len(v.AWSAccountIDs) == 0 {
+			form.Set(prefix+"AWSAccountId", "")
+		}
+		for i1 := range v.AWSAccountIDs {
+			name1 := prefix + "AWSAccountId." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AWSAccountIDs[i1])
+		}
+	}
+	if v.Actions != nil {
+		if len(v.Actions) == 0 {
+			form.Set(prefix+"ActionName", "")
+		}
+		for i1 := range v.Actions {
+			name1 := prefix + "ActionName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.Actions[i1])
+		}
+	}
+	if v.Label != nil {
+		form.Set(prefix+"Label", *v.Label)
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
+// AttributeMap is undocumented.
+type AttributeMap map[string]string
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *AttributeMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "Name", "Value")
+}
+
 // BatchResultErrorEntry is undocumented.
 type BatchResultErrorEntry struct {
 	Code        aws.StringValue  `query:"Code" xml:"Code"`
@@ -385,10 +595,32 @@ type BatchResultErrorEntry struct {
 
 // ChangeMessageVisibilityBatchRequest is undocumented.
 type ChangeMessageVisibilityBatchRequest struct {
-	Entries  []ChangeMessageVisibilityBatchRequestEntry `query:"Entries" xml:"ChangeMessageVisibilityBatchRequestEntry"`
+	Entries  []ChangeMessageVisibilityBatchRequestEntry `query:"ChangeMessageVisibilityBatchRequestEntry,list" xml:"ChangeMessageVisibilityBatchRequestEntry"`
 	QueueURL aws.StringValue                            `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the ChangeMessageVisibilityBatchRequest to the form, prefixing their names.
+func (v *ChangeMessageVisibilityBatchRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Entries != nil {
+		if len(v.Entries) == 0 {
+			form.Set(prefix+"ChangeMessageVisibilityBatchRequestEntry", "")
+		}
+		for i1 := range v.Entries {
+			name1 := prefix + "ChangeMessageVisibilityBatchRequestEntry." + strconv.Itoa(i1+1)
+			if err := v.Entries[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // ChangeMessageVisibilityBatchRequestEntry is undocumented.
 type ChangeMessageVisibilityBatchRequestEntry struct {
 	ID                aws.StringValue  `query:"Id" xml:"Id"`
@@ -396,10 +628,27 @@ type ChangeMessageVisibilityBatchRequestEntry struct {
 	VisibilityTimeout aws.IntegerValue `query:"VisibilityTimeout" xml:"VisibilityTimeout"`
 }
 
+// MarshalQuery adds the parameters of the ChangeMessageVisibilityBatchRequestEntry to the form, prefixing their names.
+func (v *ChangeMessageVisibilityBatchRequestEntry) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		form.Set(prefix+"Id", *v.ID)
+	}
+	if v.ReceiptHandle != nil {
+		form.Set(prefix+"ReceiptHandle", *v.ReceiptHandle)
+	}
+	if v.VisibilityTimeout != nil {
+		form.Set(prefix+"VisibilityTimeout", strconv.FormatInt(int64(*v.VisibilityTimeout), 10))
+	}
+	return nil
+}
+
 // ChangeMessageVisibilityBatchResult is undocumented.
 type ChangeMessageVisibilityBatchResult struct {
-	Failed     []BatchResultErrorEntry                   `query:"Failed" xml:"ChangeMessageVisibilityBatchResult>BatchResultErrorEntry"`
-	Successful []ChangeMessageVisibilityBatchResultEntry `query:"Successful" xml:"ChangeMessageVisibilityBatchResult>ChangeMessageVisibilityBatchResultEntry"`
+	Failed     []BatchResultErrorEntry                   `query:"BatchResultErrorEntry,list" xml:"ChangeMessageVisibilityBatchResult>BatchResultErrorEntry"`
+	Successful []ChangeMessageVisibilityBatchResultEntry `query:"ChangeMessageVisibilityBatchResultEntry,list" xml:"ChangeMessageVisibilityBatchResult>ChangeMessageVisibilityBatchResultEntry"`
 }
 
 // ChangeMessageVisibilityBatchResultEntry is undocumented.
@@ -414,10 +663,49 @@ type ChangeMessageVisibilityRequest struct {
 	VisibilityTimeout aws.IntegerValue `query:"VisibilityTimeout" xml:"VisibilityTimeout"`
 }
 
+// MarshalQuery adds the parameters of the ChangeMessageVisibilityRequest to the form, prefixing their names.
+func (v *ChangeMessageVisibilityRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	if v.ReceiptHandle != nil {
+		form.Set(prefix+"ReceiptHandle", *v.ReceiptHandle)
+	}
+	if v.VisibilityTimeout != nil {
+		form.Set(prefix+"VisibilityTimeout", strconv.FormatInt(int64(*v.VisibilityTimeout), 10))
+	}
+	return nil
+}
+
 // CreateQueueRequest is undocumented.
 type CreateQueueRequest struct {
-	Attributes map[string]string `query:"Attribute" xml:""`
-	QueueName  aws.StringValue   `query:"QueueName" xml:"QueueName"`
+	Attributes AttributeMap    `query:"Attribute,map::Name:Value" xml:"Attribute"`
+	QueueName  aws.StringValue `query:"QueueName" xml:"QueueName"`
+}
+
+// MarshalQuery adds the parameters of the CreateQueueRequest to the form, prefixing their names.
+func (v *CreateQueueRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	keys1 := make([]string, 0, len(v.Attributes))
+	for k := range v.Attributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "Attribute." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"Name", k1)
+		v1 := v.Attributes[k1]
+		form.Set(name1+"Value", v1)
+	}
+	if v.QueueName != nil {
+		form.Set(prefix+"QueueName", *v.QueueName)
+	}
+	return nil
 }
 
 // CreateQueueResult is undocumented.
@@ -427,20 +715,56 @@ type CreateQueueResult struct {
 
 // DeleteMessageBatchRequest is undocumented.
 type DeleteMessageBatchRequest struct {
-	Entries  []DeleteMessageBatchRequestEntry `query:"Entries" xml:"DeleteMessageBatchRequestEntry"`
+	Entries  []DeleteMessageBatchRequestEntry `query:"DeleteMessageBatchRequestEntry,list" xml:"DeleteMessageBatchRequestEntry"`
 	QueueURL aws.StringValue                  `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the DeleteMessageBatchRequest to the form, prefixing their names.
+func (v *DeleteMessageBatchRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Entries != nil {
+		if len(v.Entries) == 0 {
+			form.Set(prefix+"DeleteMessageBatchRequestEntry", "")
+		}
+		for i1 := range v.Entries {
+			name1 := prefix + "DeleteMessageBatchRequestEntry." + strconv.Itoa(i1+1)
+			if err := v.Entries[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // DeleteMessageBatchRequestEntry is undocumented.
 type DeleteMessageBatchRequestEntry struct {
 	ID            aws.StringValue `query:"Id" xml:"Id"`
 	ReceiptHandle aws.StringValue `query:"ReceiptHandle" xml:"ReceiptHandle"`
 }
 
+// MarshalQuery adds the parameters of the DeleteMessageBatchRequestEntry to the form, prefixing their names.
+func (v *DeleteMessageBatchRequestEntry) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		form.Set(prefix+"Id", *v.ID)
+	}
+	if v.ReceiptHandle != nil {
+		form.Set(prefix+"ReceiptHandle", *v.ReceiptHandle)
+	}
+	return nil
+}
+
 // DeleteMessageBatchResult is undocumented.
 type DeleteMessageBatchResult struct {
-	Failed     []BatchResultErrorEntry         `query:"Failed" xml:"DeleteMessageBatchResult>BatchResultErrorEntry"`
-	Successful []DeleteMessageBatchResultEntry `query:"Successful" xml:"DeleteMessageBatchResult>DeleteMessageBatchResultEntry"`
+	Failed     []BatchResultErrorEntry         `query:"BatchResultErrorEntry,list" xml:"DeleteMessageBatchResult>BatchResultErrorEntry"`
+	Successful []DeleteMessageBatchResultEntry `query:"DeleteMessageBatchResultEntry,list" xml:"DeleteMessageBatchResult>DeleteMessageBatchResultEntry"`
 }
 
 // DeleteMessageBatchResultEntry is undocumented.
@@ -454,20 +778,65 @@ type DeleteMessageRequest struct {
 	ReceiptHandle aws.StringValue `query:"ReceiptHandle" xml:"ReceiptHandle"`
 }
 
+// MarshalQuery adds the parameters of the DeleteMessageRequest to the form, prefixing their names.
+func (v *DeleteMessageRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	if v.ReceiptHandle != nil {
+		form.Set(prefix+"ReceiptHandle", *v.ReceiptHandle)
+	}
+	return nil
+}
+
 // DeleteQueueRequest is undocumented.
 type DeleteQueueRequest struct {
 	QueueURL aws.StringValue `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the DeleteQueueRequest to the form, prefixing their names.
+func (v *DeleteQueueRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // GetQueueAttributesRequest is undocumented.
 type GetQueueAttributesRequest struct {
-	AttributeNames []string        `query:"AttributeNames" xml:"AttributeName"`
+	AttributeNames []string        `query:"AttributeName,list" xml:"AttributeName"`
 	QueueURL       aws.StringValue `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the GetQueueAttributesRequest to the form, prefixing their names.
+func (v *GetQueueAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttributeNames != nil {
+		if len(v.AttributeNames) == 0 {
+			form.Set(prefix+"AttributeName", "")
+		}
+		for i1 := range v.AttributeNames {
+			name1 := prefix + "AttributeName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AttributeNames[i1])
+		}
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // GetQueueAttributesResult is undocumented.
 type GetQueueAttributesResult struct {
-	Attributes map[string]string `query:"Attribute" xml:"GetQueueAttributesResult"`
+	Attributes AttributeMap `query:"Attribute,map::Name:Value" xml:"GetQueueAttributesResult>Attribute"`
 }
 
 // GetQueueURLRequest is undocumented.
@@ -476,6 +845,20 @@ type GetQueueURLRequest struct {
 	QueueOwnerAWSAccountID aws.StringValue `query:"QueueOwnerAWSAccountId" xml:"QueueOwnerAWSAccountId"`
 }
 
+// MarshalQuery adds the parameters of the GetQueueURLRequest to the form, prefixing their names.
+func (v *GetQueueURLRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.QueueName != nil {
+		form.Set(prefix+"QueueName", *v.QueueName)
+	}
+	if v.QueueOwnerAWSAccountID != nil {
+		form.Set(prefix+"QueueOwnerAWSAccountId", *v.QueueOwnerAWSAccountID)
+	}
+	return nil
+}
+
 // GetQueueURLResult is undocumented.
 type GetQueueURLResult struct {
 	QueueURL aws.StringValue `query:"QueueUrl" xml:"GetQueueUrlResult>QueueUrl"`
@@ -486,9 +869,20 @@ type ListDeadLetterSourceQueuesRequest struct {
 	QueueURL aws.StringValue `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the ListDeadLetterSourceQueuesRequest to the form, prefixing their names.
+func (v *ListDeadLetterSourceQueuesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // ListDeadLetterSourceQueuesResult is undocumented.
 type ListDeadLetterSourceQueuesResult struct {
-	QueueURLs []string `query:"queueUrls" xml:"ListDeadLetterSourceQueuesResult>QueueUrl"`
+	QueueURLs []string `query:"QueueUrl,list" xml:"ListDeadLetterSourceQueuesResult>QueueUrl"`
 }
 
 // ListQueuesRequest is undocumented.
@@ -496,36 +890,103 @@ type ListQueuesRequest struct {
 	QueueNamePrefix aws.StringValue `query:"QueueNamePrefix" xml:"QueueNamePrefix"`
 }
 
+// MarshalQuery adds the parameters of the ListQueuesRequest to the form, prefixing their names.
+func (v *ListQueuesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.QueueNamePrefix != nil {
+		form.Set(prefix+"QueueNamePrefix", *v.QueueNamePrefix)
+	}
+	return nil
+}
+
 // ListQueuesResult is undocumented.
 type ListQueuesResult struct {
-	QueueURLs []string `query:"QueueUrls" xml:"ListQueuesResult>QueueUrl"`
+	QueueURLs []string `query:"QueueUrl,list" xml:"ListQueuesResult>QueueUrl"`
 }
 
 // Message is undocumented.
 type Message struct {
-	Attributes             map[string]string                `query:"Attribute" xml:""`
-	Body                   aws.StringValue                  `query:"Body" xml:"Body"`
-	MD5OfBody              aws.StringValue                  `query:"MD5OfBody" xml:"MD5OfBody"`
-	MD5OfMessageAttributes aws.StringValue                  `query:"MD5OfMessageAttributes" xml:"MD5OfMessageAttributes"`
-	MessageAttributes      map[string]MessageAttributeValue `query:"MessageAttribute" xml:""`
-	MessageID              aws.StringValue                  `query:"MessageId" xml:"MessageId"`
-	ReceiptHandle          aws.StringValue                  `query:"ReceiptHandle" xml:"ReceiptHandle"`
+	Attributes             AttributeMap        `query:"Attribute,map::Name:Value" xml:"Attribute"`
+	Body                   aws.StringValue     `query:"Body" xml:"Body"`
+	MD5OfBody              aws.StringValue     `query:"MD5OfBody" xml:"MD5OfBody"`
+	MD5OfMessageAttributes aws.StringValue     `query:"MD5OfMessageAttributes" xml:"MD5OfMessageAttributes"`
+	MessageAttributes      MessageAttributeMap `query:"MessageAttribute,map::Name:Value" xml:"MessageAttribute"`
+	MessageID              aws.StringValue     `query:"MessageId" xml:"MessageId"`
+	ReceiptHandle          aws.StringValue     `query:"ReceiptHandle" xml:"ReceiptHandle"`
+}
+
+// MessageAttributeMap is undocumented.
+type MessageAttributeMap map[string]MessageAttributeValue
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *MessageAttributeMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return aws.UnmarshalXMLMap(d, start, m, "Name", "Value")
 }
 
 // MessageAttributeValue is undocumented.
 type MessageAttributeValue struct {
-	BinaryListValues [][]byte        `query:"BinaryListValue.member" xml:"BinaryListValue>BinaryListValue"`
+	BinaryListValues [][]byte        `query:"BinaryListValue,list" xml:"BinaryListValue>BinaryListValue"`
 	BinaryValue      []byte          `query:"BinaryValue" xml:"BinaryValue"`
 	DataType         aws.StringValue `query:"DataType" xml:"DataType"`
-	StringListValues []string        `query:"StringListValue.member" xml:"StringListValue>StringListValue"`
+	StringListValues []string        `query:"StringListValue,list" xml:"StringListValue>StringListValue"`
 	StringValue      aws.StringValue `query:"StringValue" xml:"StringValue"`
 }
 
+// MarshalQuery adds the parameters of the MessageAttributeValue to the form, prefixing their names.
+func (v *MessageAttributeValue) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.BinaryListValues != nil {
+		if len(v.BinaryListValues) == 0 {
+			form.Set(prefix+"BinaryListValue", "")
+		}
+		for i1 := range v.BinaryListValues {
+			name1 := prefix + "BinaryListValue." + strconv.Itoa(i1+1)
+			if v.BinaryListValues[i1] != nil {
+				form.Set(name1, base64.StdEncoding.EncodeToString(v.BinaryListValues[i1]))
+			}
+		}
+	}
+	if v.BinaryValue != nil {
+		form.Set(prefix+"BinaryValue", base64.StdEncoding.EncodeToString(v.BinaryValue))
+	}
+	if v.DataType != nil {
+		form.Set(prefix+"DataType", *v.DataType)
+	}
+	if v.StringListValues != nil {
+		if len(v.StringListValues) == 0 {
+			form.Set(prefix+"StringListValue", "")
+		}
+		for i1 := range v.StringListValues {
+			name1 := prefix + "StringListValue." + strconv.Itoa(i1+1)
+			form.Set(name1, v.StringListValues[i1])
+		}
+	}
+	if v.StringValue != nil {
+		form.Set(prefix+"StringValue", *v.StringValue)
+	}
+	return nil
+}
+
 // PurgeQueueRequest is undocumented.
 type PurgeQueueRequest struct {
 	QueueURL aws.StringValue `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the PurgeQueueRequest to the form, prefixing their names.
+func (v *PurgeQueueRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // Possible values for SQS.
 const (
 	QueueAttributeNameApproximateNumberOfMessages           = "ApproximateNumberOfMessages"
@@ -545,17 +1006,55 @@ const (
 
 // ReceiveMessageRequest is undocumented.
 type ReceiveMessageRequest struct {
-	AttributeNames        []string         `query:"AttributeNames" xml:"AttributeName"`
+	AttributeNames        []string         `query:"AttributeName,list" xml:"AttributeName"`
 	MaxNumberOfMessages   aws.IntegerValue `query:"MaxNumberOfMessages" xml:"MaxNumberOfMessages"`
-	MessageAttributeNames []string         `query:"MessageAttributeNames" xml:"MessageAttributeName"`
+	MessageAttributeNames []string         `query:"MessageAttributeName,list" xml:"MessageAttributeName"`
 	QueueURL              aws.StringValue  `query:"QueueUrl" xml:"QueueUrl"`
 	VisibilityTimeout     aws.IntegerValue `query:"VisibilityTimeout" xml:"VisibilityTimeout"`
 	WaitTimeSeconds       aws.IntegerValue `query:"WaitTimeSeconds" xml:"WaitTimeSeconds"`
 }
 
+// MarshalQuery adds the parameters of the ReceiveMessageRequest to the form, prefixing their names.
+func (v *ReceiveMessageRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.AttributeNames != nil {
+		if len(v.AttributeNames) == 0 {
+			form.Set(prefix+"AttributeName", "")
+		}
+		for i1 := range v.AttributeNames {
+			name1 := prefix + "AttributeName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.AttributeNames[i1])
+		}
+	}
+	if v.MaxNumberOfMessages != nil {
+		form.Set(prefix+"MaxNumberOfMessages", strconv.FormatInt(int64(*v.MaxNumberOfMessages), 10))
+	}
+	if v.MessageAttributeNames != nil {
+		if len(v.MessageAttributeNames) == 0 {
+			form.Set(prefix+"MessageAttributeName", "")
+		}
+		for i1 := range v.MessageAttributeNames {
+			name1 := prefix + "MessageAttributeName." + strconv.Itoa(i1+1)
+			form.Set(name1, v.MessageAttributeNames[i1])
+		}
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	if v.VisibilityTimeout != nil {
+		form.Set(prefix+"VisibilityTimeout", strconv.FormatInt(int64(*v.VisibilityTimeout), 10))
+	}
+	if v.WaitTimeSeconds != nil {
+		form.Set(prefix+"WaitTimeSeconds", strconv.FormatInt(int64(*v.WaitTimeSeconds), 10))
+	}
+	return nil
+}
+
 // ReceiveMessageResult is undocumented.
 type ReceiveMessageResult struct {
-	Messages []Message `query:"Messages" xml:"ReceiveMessageResult>Message"`
+	Messages []Message `query:"Message,list" xml:"ReceiveMessageResult>Message"`
 }
 
 // RemovePermissionRequest is undocumented.
@@ -564,24 +1063,90 @@ type RemovePermissionRequest struct {
 	QueueURL aws.StringValue `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the RemovePermissionRequest to the form, prefixing their names.
+func (v *RemovePermissionRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Label != nil {
+		form.Set(prefix+"Label", *v.Label)
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // SendMessageBatchRequest is undocumented.
 type SendMessageBatchRequest struct {
-	Entries  []SendMessageBatchRequestEntry `query:"Entries" xml:"SendMessageBatchRequestEntry"`
+	Entries  []SendMessageBatchRequestEntry `query:"SendMessageBatchRequestEntry,list" xml:"SendMessageBatchRequestEntry"`
 	QueueURL aws.StringValue                `query:"QueueUrl" xml:"QueueUrl"`
 }
 
+// MarshalQuery adds the parameters of the SendMessageBatchRequest to the form, prefixing their names.
+func (v *SendMessageBatchRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Entries != nil {
+		if len(v.Entries) == 0 {
+			form.Set(prefix+"SendMessageBatchRequestEntry", "")
+		}
+		for i1 := range v.Entries {
+			name1 := prefix + "SendMessageBatchRequestEntry." + strconv.Itoa(i1+1)
+			if err := v.Entries[i1].MarshalQuery(form, name1+"."); err != nil {
+				return err
+			}
+		}
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
 // SendMessageBatchRequestEntry is undocumented.
 type SendMessageBatchRequestEntry struct {
-	DelaySeconds      aws.IntegerValue                 `query:"DelaySeconds" xml:"DelaySeconds"`
-	ID                aws.StringValue                  `query:"Id" xml:"Id"`
-	MessageAttributes map[string]MessageAttributeValue `query:"MessageAttribute" xml:""`
-	MessageBody       aws.StringValue                  `query:"MessageBody" xml:"MessageBody"`
+	DelaySeconds      aws.IntegerValue    `query:"DelaySeconds" xml:"DelaySeconds"`
+	ID                aws.StringValue     `query:"Id" xml:"Id"`
+	MessageAttributes MessageAttributeMap `query:"MessageAttribute,map::Name:Value" xml:"MessageAttribute"`
+	MessageBody       aws.StringValue     `query:"MessageBody" xml:"MessageBody"`
+}
+
+// MarshalQuery adds the parameters of the SendMessageBatchRequestEntry to the form, prefixing their names.
+func (v *SendMessageBatchRequestEntry) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DelaySeconds != nil {
+		form.Set(prefix+"DelaySeconds", strconv.FormatInt(int64(*v.DelaySeconds), 10))
+	}
+	if v.ID != nil {
+		form.Set(prefix+"Id", *v.ID)
+	}
+	keys1 := make([]string, 0, len(v.MessageAttributes))
+	for k := range v.MessageAttributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "MessageAttribute." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"Name", k1)
+		v1 := v.MessageAttributes[k1]
+		if err := v1.MarshalQuery(form, name1+"Value."); err != nil {
+			return err
+		}
+	}
+	if v.MessageBody != nil {
+		form.Set(prefix+"MessageBody", *v.MessageBody)
+	}
+	return nil
 }
 
 // SendMessageBatchResult is undocumented.
 type SendMessageBatchResult struct {
-	Failed     []BatchResultErrorEntry       `query:"Failed" xml:"SendMessageBatchResult>BatchResultErrorEntry"`
-	Successful []SendMessageBatchResultEntry `query:"Successful" xml:"SendMessageBatchResult>SendMessageBatchResultEntry"`
+	Failed     []BatchResultErrorEntry       `query:"BatchResultErrorEntry,list" xml:"SendMessageBatchResult>BatchResultErrorEntry"`
+	Successful []SendMessageBatchResultEntry `query:"SendMessageBatchResultEntry,list" xml:"SendMessageBatchResult>SendMessageBatchResultEntry"`
 }
 
 // SendMessageBatchResultEntry is undocumented.
@@ -594,10 +1159,40 @@ type SendMessageBatchResultEntry struct {
 
 // SendMessageRequest is undocumented.
 type SendMessageRequest struct {
-	DelaySeconds      aws.IntegerValue                 `query:"DelaySeconds" xml:"DelaySeconds"`
-	MessageAttributes map[string]MessageAttributeValue `query:"MessageAttribute" xml:""`
-	MessageBody       aws.StringValue                  `query:"MessageBody" xml:"MessageBody"`
-	QueueURL          aws.StringValue                  `query:"QueueUrl" xml:"QueueUrl"`
+	DelaySeconds      aws.IntegerValue    `query:"DelaySeconds" xml:"DelaySeconds"`
+	MessageAttributes MessageAttributeMap `query:"MessageAttribute,map::Name:Value" xml:"MessageAttribute"`
+	MessageBody       aws.StringValue     `query:"MessageBody" xml:"MessageBody"`
+	QueueURL          aws.StringValue     `query:"QueueUrl" xml:"QueueUrl"`
+}
+
+// MarshalQuery adds the parameters of the SendMessageRequest to the form, prefixing their names.
+func (v *SendMessageRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DelaySeconds != nil {
+		form.Set(prefix+"DelaySeconds", strconv.FormatInt(int64(*v.DelaySeconds), 10))
+	}
+	keys1 := make([]string, 0, len(v.MessageAttributes))
+	for k := range v.MessageAttributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "MessageAttribute." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"Name", k1)
+		v1 := v.MessageAttributes[k1]
+		if err := v1.MarshalQuery(form, name1+"Value."); err != nil {
+			return err
+		}
+	}
+	if v.MessageBody != nil {
+		form.Set(prefix+"MessageBody", *v.MessageBody)
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
 }
 
 // SendMessageResult is undocumented.
@@ -609,9 +1204,137 @@ type SendMessageResult struct {
 
 // SetQueueAttributesRequest is undocumented.
 type SetQueueAttributesRequest struct {
-	Attributes map[string]string `query:"Attribute" xml:""`
-	QueueURL   aws.StringValue   `query:"QueueUrl" xml:"QueueUrl"`
+	Attributes AttributeMap    `query:"Attribute,map::Name:Value" xml:"Attribute"`
+	QueueURL   aws.StringValue `query:"QueueUrl" xml:"QueueUrl"`
+}
+
+// MarshalQuery adds the parameters of the SetQueueAttributesRequest to the form, prefixing their names.
+func (v *SetQueueAttributesRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	keys1 := make([]string, 0, len(v.Attributes))
+	for k := range v.Attributes {
+		keys1 = append(keys1, k)
+	}
+	sort.Strings(keys1)
+	for i1, k1 := range keys1 {
+		name1 := prefix + "Attribute." + strconv.Itoa(i1+1) + "."
+		form.Set(name1+"Name", k1)
+		v1 := v.Attributes[k1]
+		form.Set(name1+"Value", v1)
+	}
+	if v.QueueURL != nil {
+		form.Set(prefix+"QueueUrl", *v.QueueURL)
+	}
+	return nil
+}
+
+// BatchEntryIDsNotDistinct is the error returned for the AWS.SimpleQueueService.BatchEntryIdsNotDistinct error code.
+type BatchEntryIDsNotDistinct struct {
+	aws.APIError
+}
+
+// BatchRequestTooLong is the error returned for the AWS.SimpleQueueService.BatchRequestTooLong error code.
+type BatchRequestTooLong struct {
+	aws.APIError
+}
+
+// EmptyBatchRequest is the error returned for the AWS.SimpleQueueService.EmptyBatchRequest error code.
+type EmptyBatchRequest struct {
+	aws.APIError
+}
+
+// InvalidAttributeName is the error returned for the InvalidAttributeName error code.
+type InvalidAttributeName struct {
+	aws.APIError
+}
+
+// InvalidBatchEntryID is the error returned for the AWS.SimpleQueueService.InvalidBatchEntryId error code.
+type InvalidBatchEntryID struct {
+	aws.APIError
+}
+
+// InvalidIDFormat is the error returned for the InvalidIdFormat error code.
+type InvalidIDFormat struct {
+	aws.APIError
+}
+
+// InvalidMessageContents is the error returned for the InvalidMessageContents error code.
+type InvalidMessageContents struct {
+	aws.APIError
+}
+
+// MessageNotInflight is the error returned for the AWS.SimpleQueueService.MessageNotInflight error code.
+type MessageNotInflight struct {
+	aws.APIError
+}
+
+// OverLimit is the error returned for the OverLimit error code.
+type OverLimit struct {
+	aws.APIError
+}
+
+// PurgeQueueInProgress is the error returned for the AWS.SimpleQueueService.PurgeQueueInProgress error code.
+type PurgeQueueInProgress struct {
+	aws.APIError
+}
+
+// QueueDeletedRecently is the error returned for the AWS.SimpleQueueService.QueueDeletedRecently error code.
+type QueueDeletedRecently struct {
+	aws.APIError
+}
+
+// QueueDoesNotExist is the error returned for the AWS.SimpleQueueService.NonExistentQueue error code.
+type QueueDoesNotExist struct {
+	aws.APIError
+}
+
+// QueueNameExists is the error returned for the QueueAlreadyExists error code.
+type QueueNameExists struct {
+	aws.APIError
+}
+
+// ReceiptHandleIsInvalid is the error returned for the ReceiptHandleIsInvalid error code.
+type ReceiptHandleIsInvalid struct {
+	aws.APIError
+}
+
+// TooManyEntriesInBatchRequest is the error returned for the AWS.SimpleQueueService.TooManyEntriesInBatchRequest error code.
+type TooManyEntriesInBatchRequest struct {
+	aws.APIError
+}
+
+// UnsupportedOperation is the error returned for the AWS.SimpleQueueService.UnsupportedOperation error code.
+type UnsupportedOperation struct {
+	aws.APIError
+}
+
+// exceptions maps SQS's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AWS.SimpleQueueService.BatchEntryIdsNotDistinct": BatchEntryIDsNotDistinct{},
+	"AWS.SimpleQueueService.BatchRequestTooLong":      BatchRequestTooLong{},
+	"AWS.SimpleQueueService.EmptyBatchRequest":        EmptyBatchRequest{},
+	"InvalidAttributeName":                            InvalidAttributeName{},
+	"AWS.SimpleQueueService.InvalidBatchEntryId":      InvalidBatchEntryID{},
+	"InvalidIdFormat":                                 InvalidIDFormat{},
+	"InvalidMessageContents":                          InvalidMessageContents{},
+	"AWS.SimpleQueueService.MessageNotInflight":       MessageNotInflight{},
+	"OverLimit": OverLimit{},
+	"AWS.SimpleQueueService.PurgeQueueInProgress":         PurgeQueueInProgress{},
+	"AWS.SimpleQueueService.QueueDeletedRecently":         QueueDeletedRecently{},
+	"AWS.SimpleQueueService.NonExistentQueue":             QueueDoesNotExist{},
+	"QueueAlreadyExists":                                  QueueNameExists{},
+	"ReceiptHandleIsInvalid":                              ReceiptHandleIsInvalid{},
+	"AWS.SimpleQueueService.TooManyEntriesInBatchRequest": TooManyEntriesInBatchRequest{},
+	"AWS.SimpleQueueService.UnsupportedOperation":         UnsupportedOperation{},
 }
 
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,11 +4,18 @@
 package storagegateway
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // StorageGateway is a client for AWS Storage Gateway.
@@ -16,29 +23,64 @@ type StorageGateway struct {
 	client *aws.JSONClient
 }
 
-// New returns a new StorageGateway client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *StorageGateway {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "storagegateway"
-	endpoint, service, region := endpoints.Lookup("storagegateway", region)
+// New returns a new StorageGateway client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *StorageGateway {
+	cfg := newConfig(region, client, opts)
 
 	return &StorageGateway{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "StorageGateway_20130630",
 		},
 	}
 }
 
+// NewFromSession returns a new StorageGateway client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *StorageGateway {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("storagegateway", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("storagegateway", "storagegateway"),
+	}, "AWS_ENDPOINT_URL_STORAGE_GATEWAY", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *StorageGateway) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *StorageGateway) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // ActivateGateway this operation activates the gateway you previously
 // deployed on your host. For more information, see Activate the AWS
 // Storage Gateway . In the activation process, you specify information
@@ -48,8 +90,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Sto
 // associates your gateway with your account; for more information, see
 // UpdateGatewayInformation
 func (c *StorageGateway) ActivateGateway(req *ActivateGatewayInput) (resp *ActivateGatewayOutput, err error) {
+	return c.ActivateGatewayWithContext(context.Background(), req)
+}
+
+// ActivateGatewayWithContext is like ActivateGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) ActivateGatewayWithContext(ctx context.Context, req *ActivateGatewayInput) (resp *ActivateGatewayOutput, err error) {
 	resp = &ActivateGatewayOutput{}
-	err = c.client.Do("ActivateGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ActivateGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -60,8 +109,15 @@ func (c *StorageGateway) ActivateGateway(req *ActivateGatewayInput) (resp *Activ
 // want to add cache, and one or more disk IDs that you want to configure
 // as cache.
 func (c *StorageGateway) AddCache(req *AddCacheInput) (resp *AddCacheOutput, err error) {
+	return c.AddCacheWithContext(context.Background(), req)
+}
+
+// AddCacheWithContext is like AddCache, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) AddCacheWithContext(ctx context.Context, req *AddCacheInput) (resp *AddCacheOutput, err error) {
 	resp = &AddCacheOutput{}
-	err = c.client.Do("AddCache", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddCache", "POST", "/", req, resp)
 	return
 }
 
@@ -72,8 +128,15 @@ func (c *StorageGateway) AddCache(req *AddCacheInput) (resp *AddCacheOutput, err
 // Name to which you want to add upload buffer, and one or more disk IDs
 // that you want to configure as upload buffer.
 func (c *StorageGateway) AddUploadBuffer(req *AddUploadBufferInput) (resp *AddUploadBufferOutput, err error) {
+	return c.AddUploadBufferWithContext(context.Background(), req)
+}
+
+// AddUploadBufferWithContext is like AddUploadBuffer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) AddUploadBufferWithContext(ctx context.Context, req *AddUploadBufferInput) (resp *AddUploadBufferOutput, err error) {
 	resp = &AddUploadBufferOutput{}
-	err = c.client.Do("AddUploadBuffer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddUploadBuffer", "POST", "/", req, resp)
 	return
 }
 
@@ -85,16 +148,30 @@ func (c *StorageGateway) AddUploadBuffer(req *AddUploadBufferInput) (resp *AddUp
 // which you want to add working storage, and one or more disk IDs that you
 // want to configure as working storage.
 func (c *StorageGateway) AddWorkingStorage(req *AddWorkingStorageInput) (resp *AddWorkingStorageOutput, err error) {
+	return c.AddWorkingStorageWithContext(context.Background(), req)
+}
+
+// AddWorkingStorageWithContext is like AddWorkingStorage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) AddWorkingStorageWithContext(ctx context.Context, req *AddWorkingStorageInput) (resp *AddWorkingStorageOutput, err error) {
 	resp = &AddWorkingStorageOutput{}
-	err = c.client.Do("AddWorkingStorage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddWorkingStorage", "POST", "/", req, resp)
 	return
 }
 
 // CancelArchival cancels archiving of a virtual tape to the virtual tape
 // shelf after the archiving process is initiated.
 func (c *StorageGateway) CancelArchival(req *CancelArchivalInput) (resp *CancelArchivalOutput, err error) {
+	return c.CancelArchivalWithContext(context.Background(), req)
+}
+
+// CancelArchivalWithContext is like CancelArchival, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) CancelArchivalWithContext(ctx context.Context, req *CancelArchivalInput) (resp *CancelArchivalOutput, err error) {
 	resp = &CancelArchivalOutput{}
-	err = c.client.Do("CancelArchival", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CancelArchival", "POST", "/", req, resp)
 	return
 }
 
@@ -102,8 +179,15 @@ func (c *StorageGateway) CancelArchival(req *CancelArchivalInput) (resp *CancelA
 // tape shelf to a gateway after the retrieval process is initiated. The
 // virtual tape is returned to the
 func (c *StorageGateway) CancelRetrieval(req *CancelRetrievalInput) (resp *CancelRetrievalOutput, err error) {
+	return c.CancelRetrievalWithContext(context.Background(), req)
+}
+
+// CancelRetrievalWithContext is like CancelRetrieval, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) CancelRetrievalWithContext(ctx context.Context, req *CancelRetrievalInput) (resp *CancelRetrievalOutput, err error) {
 	resp = &CancelRetrievalOutput{}
-	err = c.client.Do("CancelRetrieval", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CancelRetrieval", "POST", "/", req, resp)
 	return
 }
 
@@ -116,8 +200,15 @@ func (c *StorageGateway) CancelRetrieval(req *CancelRetrievalInput) (resp *Cance
 // about it such as the volume Amazon Resource Name its size, and the iSCSI
 // target ARN that initiators can use to connect to the volume target.
 func (c *StorageGateway) CreateCachediSCSIVolume(req *CreateCachediSCSIVolumeInput) (resp *CreateCachediSCSIVolumeOutput, err error) {
+	return c.CreateCachediSCSIVolumeWithContext(context.Background(), req)
+}
+
+// CreateCachediSCSIVolumeWithContext is like CreateCachediSCSIVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) CreateCachediSCSIVolumeWithContext(ctx context.Context, req *CreateCachediSCSIVolumeInput) (resp *CreateCachediSCSIVolumeOutput, err error) {
 	resp = &CreateCachediSCSIVolumeOutput{}
-	err = c.client.Do("CreateCachediSCSIVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCachediSCSIVolume", "POST", "/", req, resp)
 	return
 }
 
@@ -137,8 +228,15 @@ func (c *StorageGateway) CreateCachediSCSIVolume(req *CreateCachediSCSIVolumeInp
 // to check the snapshot progress or later use it when you want to create a
 // volume from a snapshot.
 func (c *StorageGateway) CreateSnapshot(req *CreateSnapshotInput) (resp *CreateSnapshotOutput, err error) {
+	return c.CreateSnapshotWithContext(context.Background(), req)
+}
+
+// CreateSnapshotWithContext is like CreateSnapshot, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) CreateSnapshotWithContext(ctx context.Context, req *CreateSnapshotInput) (resp *CreateSnapshotOutput, err error) {
 	resp = &CreateSnapshotOutput{}
-	err = c.client.Do("CreateSnapshot", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSnapshot", "POST", "/", req, resp)
 	return
 }
 
@@ -157,8 +255,15 @@ func (c *StorageGateway) CreateSnapshot(req *CreateSnapshotInput) (resp *CreateS
 // to check the snapshot progress or later use it when you want to create a
 // volume from a snapshot.
 func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPoint(req *CreateSnapshotFromVolumeRecoveryPointInput) (resp *CreateSnapshotFromVolumeRecoveryPointOutput, err error) {
+	return c.CreateSnapshotFromVolumeRecoveryPointWithContext(context.Background(), req)
+}
+
+// CreateSnapshotFromVolumeRecoveryPointWithContext is like CreateSnapshotFromVolumeRecoveryPoint, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPointWithContext(ctx context.Context, req *CreateSnapshotFromVolumeRecoveryPointInput) (resp *CreateSnapshotFromVolumeRecoveryPointOutput, err error) {
 	resp = &CreateSnapshotFromVolumeRecoveryPointOutput{}
-	err = c.client.Do("CreateSnapshotFromVolumeRecoveryPoint", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateSnapshotFromVolumeRecoveryPoint", "POST", "/", req, resp)
 	return
 }
 
@@ -174,16 +279,30 @@ func (c *StorageGateway) CreateSnapshotFromVolumeRecoveryPoint(req *CreateSnapsh
 // as the volume Amazon Resource Name its size, and the iSCSI target ARN
 // that initiators can use to connect to the volume target.
 func (c *StorageGateway) CreateStorediSCSIVolume(req *CreateStorediSCSIVolumeInput) (resp *CreateStorediSCSIVolumeOutput, err error) {
+	return c.CreateStorediSCSIVolumeWithContext(context.Background(), req)
+}
+
+// CreateStorediSCSIVolumeWithContext is like CreateStorediSCSIVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) CreateStorediSCSIVolumeWithContext(ctx context.Context, req *CreateStorediSCSIVolumeInput) (resp *CreateStorediSCSIVolumeOutput, err error) {
 	resp = &CreateStorediSCSIVolumeOutput{}
-	err = c.client.Do("CreateStorediSCSIVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateStorediSCSIVolume", "POST", "/", req, resp)
 	return
 }
 
 // CreateTapes creates one or more virtual tapes. You write data to the
 // virtual tapes and then archive the tapes.
 func (c *StorageGateway) CreateTapes(req *CreateTapesInput) (resp *CreateTapesOutput, err error) {
+	return c.CreateTapesWithContext(context.Background(), req)
+}
+
+// CreateTapesWithContext is like CreateTapes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) CreateTapesWithContext(ctx context.Context, req *CreateTapesInput) (resp *CreateTapesOutput, err error) {
 	resp = &CreateTapesOutput{}
-	err = c.client.Do("CreateTapes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateTapes", "POST", "/", req, resp)
 	return
 }
 
@@ -194,8 +313,15 @@ func (c *StorageGateway) CreateTapes(req *CreateTapesInput) (resp *CreateTapesOu
 // to work with, use the Amazon Resource Name of the gateway in your
 // request.
 func (c *StorageGateway) DeleteBandwidthRateLimit(req *DeleteBandwidthRateLimitInput) (resp *DeleteBandwidthRateLimitOutput, err error) {
+	return c.DeleteBandwidthRateLimitWithContext(context.Background(), req)
+}
+
+// DeleteBandwidthRateLimitWithContext is like DeleteBandwidthRateLimit, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DeleteBandwidthRateLimitWithContext(ctx context.Context, req *DeleteBandwidthRateLimitInput) (resp *DeleteBandwidthRateLimitOutput, err error) {
 	resp = &DeleteBandwidthRateLimitOutput{}
-	err = c.client.Do("DeleteBandwidthRateLimit", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteBandwidthRateLimit", "POST", "/", req, resp)
 	return
 }
 
@@ -203,8 +329,15 @@ func (c *StorageGateway) DeleteBandwidthRateLimit(req *DeleteBandwidthRateLimitI
 // Authentication Protocol credentials for a specified iSCSI target and
 // initiator pair.
 func (c *StorageGateway) DeleteChapCredentials(req *DeleteChapCredentialsInput) (resp *DeleteChapCredentialsOutput, err error) {
+	return c.DeleteChapCredentialsWithContext(context.Background(), req)
+}
+
+// DeleteChapCredentialsWithContext is like DeleteChapCredentials, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DeleteChapCredentialsWithContext(ctx context.Context, req *DeleteChapCredentialsInput) (resp *DeleteChapCredentialsOutput, err error) {
 	resp = &DeleteChapCredentialsOutput{}
-	err = c.client.Do("DeleteChapCredentials", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteChapCredentials", "POST", "/", req, resp)
 	return
 }
 
@@ -224,8 +357,15 @@ func (c *StorageGateway) DeleteChapCredentials(req *DeleteChapCredentialsInput)
 // Amazon EC2 console. For more information, see the AWS Storage Gateway
 // Detail Page .
 func (c *StorageGateway) DeleteGateway(req *DeleteGatewayInput) (resp *DeleteGatewayOutput, err error) {
+	return c.DeleteGatewayWithContext(context.Background(), req)
+}
+
+// DeleteGatewayWithContext is like DeleteGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DeleteGatewayWithContext(ctx context.Context, req *DeleteGatewayInput) (resp *DeleteGatewayOutput, err error) {
 	resp = &DeleteGatewayOutput{}
-	err = c.client.Do("DeleteGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -236,23 +376,44 @@ func (c *StorageGateway) DeleteGateway(req *DeleteGatewayInput) (resp *DeleteGat
 // DeleteSnapshotSchedule request, you identify the volume by providing its
 // Amazon Resource Name
 func (c *StorageGateway) DeleteSnapshotSchedule(req *DeleteSnapshotScheduleInput) (resp *DeleteSnapshotScheduleOutput, err error) {
+	return c.DeleteSnapshotScheduleWithContext(context.Background(), req)
+}
+
+// DeleteSnapshotScheduleWithContext is like DeleteSnapshotSchedule, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DeleteSnapshotScheduleWithContext(ctx context.Context, req *DeleteSnapshotScheduleInput) (resp *DeleteSnapshotScheduleOutput, err error) {
 	resp = &DeleteSnapshotScheduleOutput{}
-	err = c.client.Do("DeleteSnapshotSchedule", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteSnapshotSchedule", "POST", "/", req, resp)
 	return
 }
 
 // DeleteTape is undocumented.
 func (c *StorageGateway) DeleteTape(req *DeleteTapeInput) (resp *DeleteTapeOutput, err error) {
+	return c.DeleteTapeWithContext(context.Background(), req)
+}
+
+// DeleteTapeWithContext is like DeleteTape, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DeleteTapeWithContext(ctx context.Context, req *DeleteTapeInput) (resp *DeleteTapeOutput, err error) {
 	resp = &DeleteTapeOutput{}
-	err = c.client.Do("DeleteTape", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteTape", "POST", "/", req, resp)
 	return
 }
 
 // DeleteTapeArchive deletes the specified virtual tape from the virtual
 // tape shelf
 func (c *StorageGateway) DeleteTapeArchive(req *DeleteTapeArchiveInput) (resp *DeleteTapeArchiveOutput, err error) {
+	return c.DeleteTapeArchiveWithContext(context.Background(), req)
+}
+
+// DeleteTapeArchiveWithContext is like DeleteTapeArchive, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DeleteTapeArchiveWithContext(ctx context.Context, req *DeleteTapeArchiveInput) (resp *DeleteTapeArchiveOutput, err error) {
 	resp = &DeleteTapeArchiveOutput{}
-	err = c.client.Do("DeleteTapeArchive", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteTapeArchive", "POST", "/", req, resp)
 	return
 }
 
@@ -269,8 +430,15 @@ func (c *StorageGateway) DeleteTapeArchive(req *DeleteTapeArchiveInput) (resp *D
 // the request, you must provide the Amazon Resource Name of the storage
 // volume you want to delete.
 func (c *StorageGateway) DeleteVolume(req *DeleteVolumeInput) (resp *DeleteVolumeOutput, err error) {
+	return c.DeleteVolumeWithContext(context.Background(), req)
+}
+
+// DeleteVolumeWithContext is like DeleteVolume, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DeleteVolumeWithContext(ctx context.Context, req *DeleteVolumeInput) (resp *DeleteVolumeOutput, err error) {
 	resp = &DeleteVolumeOutput{}
-	err = c.client.Do("DeleteVolume", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DeleteVolume", "POST", "/", req, resp)
 	return
 }
 
@@ -282,8 +450,15 @@ func (c *StorageGateway) DeleteVolume(req *DeleteVolumeInput) (resp *DeleteVolum
 // ARN in the response body. To specify which gateway to describe, use the
 // Amazon Resource Name of the gateway in your request.
 func (c *StorageGateway) DescribeBandwidthRateLimit(req *DescribeBandwidthRateLimitInput) (resp *DescribeBandwidthRateLimitOutput, err error) {
+	return c.DescribeBandwidthRateLimitWithContext(context.Background(), req)
+}
+
+// DescribeBandwidthRateLimitWithContext is like DescribeBandwidthRateLimit, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeBandwidthRateLimitWithContext(ctx context.Context, req *DescribeBandwidthRateLimitInput) (resp *DescribeBandwidthRateLimitOutput, err error) {
 	resp = &DescribeBandwidthRateLimitOutput{}
-	err = c.client.Do("DescribeBandwidthRateLimit", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeBandwidthRateLimit", "POST", "/", req, resp)
 	return
 }
 
@@ -292,8 +467,15 @@ func (c *StorageGateway) DescribeBandwidthRateLimit(req *DescribeBandwidthRateLi
 // architecture. The response includes disk IDs that are configured as
 // cache, and it includes the amount of cache allocated and used.
 func (c *StorageGateway) DescribeCache(req *DescribeCacheInput) (resp *DescribeCacheOutput, err error) {
+	return c.DescribeCacheWithContext(context.Background(), req)
+}
+
+// DescribeCacheWithContext is like DescribeCache, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeCacheWithContext(ctx context.Context, req *DescribeCacheInput) (resp *DescribeCacheOutput, err error) {
 	resp = &DescribeCacheOutput{}
-	err = c.client.Do("DescribeCache", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCache", "POST", "/", req, resp)
 	return
 }
 
@@ -304,8 +486,15 @@ func (c *StorageGateway) DescribeCache(req *DescribeCacheInput) (resp *DescribeC
 // Storage Gateway returns volume information sorted by volume Amazon
 // Resource Name
 func (c *StorageGateway) DescribeCachediSCSIVolumes(req *DescribeCachediSCSIVolumesInput) (resp *DescribeCachediSCSIVolumesOutput, err error) {
+	return c.DescribeCachediSCSIVolumesWithContext(context.Background(), req)
+}
+
+// DescribeCachediSCSIVolumesWithContext is like DescribeCachediSCSIVolumes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeCachediSCSIVolumesWithContext(ctx context.Context, req *DescribeCachediSCSIVolumesInput) (resp *DescribeCachediSCSIVolumesOutput, err error) {
 	resp = &DescribeCachediSCSIVolumesOutput{}
-	err = c.client.Do("DescribeCachediSCSIVolumes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCachediSCSIVolumes", "POST", "/", req, resp)
 	return
 }
 
@@ -313,8 +502,15 @@ func (c *StorageGateway) DescribeCachediSCSIVolumes(req *DescribeCachediSCSIVolu
 // Challenge-Handshake Authentication Protocol credentials information for
 // a specified iSCSI target, one for each target-initiator pair.
 func (c *StorageGateway) DescribeChapCredentials(req *DescribeChapCredentialsInput) (resp *DescribeChapCredentialsOutput, err error) {
+	return c.DescribeChapCredentialsWithContext(context.Background(), req)
+}
+
+// DescribeChapCredentialsWithContext is like DescribeChapCredentials, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeChapCredentialsWithContext(ctx context.Context, req *DescribeChapCredentialsInput) (resp *DescribeChapCredentialsOutput, err error) {
 	resp = &DescribeChapCredentialsOutput{}
-	err = c.client.Do("DescribeChapCredentials", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeChapCredentials", "POST", "/", req, resp)
 	return
 }
 
@@ -324,8 +520,15 @@ func (c *StorageGateway) DescribeChapCredentials(req *DescribeChapCredentialsInp
 // gateway to describe, use the Amazon Resource Name of the gateway in your
 // request.
 func (c *StorageGateway) DescribeGatewayInformation(req *DescribeGatewayInformationInput) (resp *DescribeGatewayInformationOutput, err error) {
+	return c.DescribeGatewayInformationWithContext(context.Background(), req)
+}
+
+// DescribeGatewayInformationWithContext is like DescribeGatewayInformation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeGatewayInformationWithContext(ctx context.Context, req *DescribeGatewayInformationInput) (resp *DescribeGatewayInformationOutput, err error) {
 	resp = &DescribeGatewayInformationOutput{}
-	err = c.client.Do("DescribeGatewayInformation", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeGatewayInformation", "POST", "/", req, resp)
 	return
 }
 
@@ -333,8 +536,15 @@ func (c *StorageGateway) DescribeGatewayInformation(req *DescribeGatewayInformat
 // weekly maintenance start time including the day and time of the week.
 // Note that values are in terms of the gateway's time zone.
 func (c *StorageGateway) DescribeMaintenanceStartTime(req *DescribeMaintenanceStartTimeInput) (resp *DescribeMaintenanceStartTimeOutput, err error) {
+	return c.DescribeMaintenanceStartTimeWithContext(context.Background(), req)
+}
+
+// DescribeMaintenanceStartTimeWithContext is like DescribeMaintenanceStartTime, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeMaintenanceStartTimeWithContext(ctx context.Context, req *DescribeMaintenanceStartTimeInput) (resp *DescribeMaintenanceStartTimeOutput, err error) {
 	resp = &DescribeMaintenanceStartTimeOutput{}
-	err = c.client.Do("DescribeMaintenanceStartTime", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeMaintenanceStartTime", "POST", "/", req, resp)
 	return
 }
 
@@ -343,8 +553,15 @@ func (c *StorageGateway) DescribeMaintenanceStartTime(req *DescribeMaintenanceSt
 // includes intervals at which snapshots are automatically initiated on the
 // volume.
 func (c *StorageGateway) DescribeSnapshotSchedule(req *DescribeSnapshotScheduleInput) (resp *DescribeSnapshotScheduleOutput, err error) {
+	return c.DescribeSnapshotScheduleWithContext(context.Background(), req)
+}
+
+// DescribeSnapshotScheduleWithContext is like DescribeSnapshotSchedule, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeSnapshotScheduleWithContext(ctx context.Context, req *DescribeSnapshotScheduleInput) (resp *DescribeSnapshotScheduleOutput, err error) {
 	resp = &DescribeSnapshotScheduleOutput{}
-	err = c.client.Do("DescribeSnapshotSchedule", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSnapshotSchedule", "POST", "/", req, resp)
 	return
 }
 
@@ -353,8 +570,15 @@ func (c *StorageGateway) DescribeSnapshotSchedule(req *DescribeSnapshotScheduleI
 // the request must be from one gateway. In the response Amazon Storage
 // Gateway returns volume information sorted by volume ARNs.
 func (c *StorageGateway) DescribeStorediSCSIVolumes(req *DescribeStorediSCSIVolumesInput) (resp *DescribeStorediSCSIVolumesOutput, err error) {
+	return c.DescribeStorediSCSIVolumesWithContext(context.Background(), req)
+}
+
+// DescribeStorediSCSIVolumesWithContext is like DescribeStorediSCSIVolumes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeStorediSCSIVolumesWithContext(ctx context.Context, req *DescribeStorediSCSIVolumesInput) (resp *DescribeStorediSCSIVolumesOutput, err error) {
 	resp = &DescribeStorediSCSIVolumesOutput{}
-	err = c.client.Do("DescribeStorediSCSIVolumes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeStorediSCSIVolumes", "POST", "/", req, resp)
 	return
 }
 
@@ -363,8 +587,15 @@ func (c *StorageGateway) DescribeStorediSCSIVolumes(req *DescribeStorediSCSIVolu
 // Storage Gateway returns a description of all virtual tapes found in the
 // VTS associated with your account.
 func (c *StorageGateway) DescribeTapeArchives(req *DescribeTapeArchivesInput) (resp *DescribeTapeArchivesOutput, err error) {
+	return c.DescribeTapeArchivesWithContext(context.Background(), req)
+}
+
+// DescribeTapeArchivesWithContext is like DescribeTapeArchives, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeTapeArchivesWithContext(ctx context.Context, req *DescribeTapeArchivesInput) (resp *DescribeTapeArchivesOutput, err error) {
 	resp = &DescribeTapeArchivesOutput{}
-	err = c.client.Do("DescribeTapeArchives", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTapeArchives", "POST", "/", req, resp)
 	return
 }
 
@@ -374,8 +605,15 @@ func (c *StorageGateway) DescribeTapeArchives(req *DescribeTapeArchivesInput) (r
 // the virtual tape is consistent. If your gateway crashes, virtual tapes
 // that have recovery points can be recovered to a new gateway.
 func (c *StorageGateway) DescribeTapeRecoveryPoints(req *DescribeTapeRecoveryPointsInput) (resp *DescribeTapeRecoveryPointsOutput, err error) {
+	return c.DescribeTapeRecoveryPointsWithContext(context.Background(), req)
+}
+
+// DescribeTapeRecoveryPointsWithContext is like DescribeTapeRecoveryPoints, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeTapeRecoveryPointsWithContext(ctx context.Context, req *DescribeTapeRecoveryPointsInput) (resp *DescribeTapeRecoveryPointsOutput, err error) {
 	resp = &DescribeTapeRecoveryPointsOutput{}
-	err = c.client.Do("DescribeTapeRecoveryPoints", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTapeRecoveryPoints", "POST", "/", req, resp)
 	return
 }
 
@@ -383,8 +621,15 @@ func (c *StorageGateway) DescribeTapeRecoveryPoints(req *DescribeTapeRecoveryPoi
 // Name of virtual tapes. If a TapeARN is not specified, returns a
 // description of all virtual tapes associated with the specified gateway.
 func (c *StorageGateway) DescribeTapes(req *DescribeTapesInput) (resp *DescribeTapesOutput, err error) {
+	return c.DescribeTapesWithContext(context.Background(), req)
+}
+
+// DescribeTapesWithContext is like DescribeTapes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeTapesWithContext(ctx context.Context, req *DescribeTapesInput) (resp *DescribeTapesOutput, err error) {
 	resp = &DescribeTapesOutput{}
-	err = c.client.Do("DescribeTapes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTapes", "POST", "/", req, resp)
 	return
 }
 
@@ -394,8 +639,15 @@ func (c *StorageGateway) DescribeTapes(req *DescribeTapesInput) (resp *DescribeT
 // includes disk IDs that are configured as upload buffer space, and it
 // includes the amount of upload buffer space allocated and used.
 func (c *StorageGateway) DescribeUploadBuffer(req *DescribeUploadBufferInput) (resp *DescribeUploadBufferOutput, err error) {
+	return c.DescribeUploadBufferWithContext(context.Background(), req)
+}
+
+// DescribeUploadBufferWithContext is like DescribeUploadBuffer, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeUploadBufferWithContext(ctx context.Context, req *DescribeUploadBufferInput) (resp *DescribeUploadBufferOutput, err error) {
 	resp = &DescribeUploadBufferOutput{}
-	err = c.client.Do("DescribeUploadBuffer", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeUploadBuffer", "POST", "/", req, resp)
 	return
 }
 
@@ -404,8 +656,15 @@ func (c *StorageGateway) DescribeUploadBuffer(req *DescribeUploadBufferInput) (r
 // VTL device information. The list of VTL devices must be from one
 // gateway.
 func (c *StorageGateway) DescribeVTLDevices(req *DescribeVTLDevicesInput) (resp *DescribeVTLDevicesOutput, err error) {
+	return c.DescribeVTLDevicesWithContext(context.Background(), req)
+}
+
+// DescribeVTLDevicesWithContext is like DescribeVTLDevices, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeVTLDevicesWithContext(ctx context.Context, req *DescribeVTLDevicesInput) (resp *DescribeVTLDevicesOutput, err error) {
 	resp = &DescribeVTLDevicesOutput{}
-	err = c.client.Do("DescribeVTLDevices", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeVTLDevices", "POST", "/", req, resp)
 	return
 }
 
@@ -416,8 +675,15 @@ func (c *StorageGateway) DescribeVTLDevices(req *DescribeVTLDevicesInput) (resp
 // The response includes disk IDs that are configured as working storage,
 // and it includes the amount of working storage allocated and used.
 func (c *StorageGateway) DescribeWorkingStorage(req *DescribeWorkingStorageInput) (resp *DescribeWorkingStorageOutput, err error) {
+	return c.DescribeWorkingStorageWithContext(context.Background(), req)
+}
+
+// DescribeWorkingStorageWithContext is like DescribeWorkingStorage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DescribeWorkingStorageWithContext(ctx context.Context, req *DescribeWorkingStorageInput) (resp *DescribeWorkingStorageOutput, err error) {
 	resp = &DescribeWorkingStorageOutput{}
-	err = c.client.Do("DescribeWorkingStorage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeWorkingStorage", "POST", "/", req, resp)
 	return
 }
 
@@ -427,8 +693,15 @@ func (c *StorageGateway) DescribeWorkingStorage(req *DescribeWorkingStorageInput
 // gateway-VTL that is not reachable or not functioning. Once a gateway is
 // disabled it cannot be enabled.
 func (c *StorageGateway) DisableGateway(req *DisableGatewayInput) (resp *DisableGatewayOutput, err error) {
+	return c.DisableGatewayWithContext(context.Background(), req)
+}
+
+// DisableGatewayWithContext is like DisableGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) DisableGatewayWithContext(ctx context.Context, req *DisableGatewayInput) (resp *DisableGatewayOutput, err error) {
 	resp = &DisableGatewayOutput{}
-	err = c.client.Do("DisableGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DisableGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -442,8 +715,15 @@ func (c *StorageGateway) DisableGateway(req *DisableGatewayInput) (resp *Disable
 // marker that you can specify in your next request to fetch the next page
 // of gateways.
 func (c *StorageGateway) ListGateways(req *ListGatewaysInput) (resp *ListGatewaysOutput, err error) {
+	return c.ListGatewaysWithContext(context.Background(), req)
+}
+
+// ListGatewaysWithContext is like ListGateways, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) ListGatewaysWithContext(ctx context.Context, req *ListGatewaysInput) (resp *ListGatewaysOutput, err error) {
 	resp = &ListGatewaysOutput{}
-	err = c.client.Do("ListGateways", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListGateways", "POST", "/", req, resp)
 	return
 }
 
@@ -453,8 +733,15 @@ func (c *StorageGateway) ListGateways(req *ListGatewaysInput) (resp *ListGateway
 // returns all disks, specifying which are configured as working storage,
 // stored volume or not configured at all.
 func (c *StorageGateway) ListLocalDisks(req *ListLocalDisksInput) (resp *ListLocalDisksOutput, err error) {
+	return c.ListLocalDisksWithContext(context.Background(), req)
+}
+
+// ListLocalDisksWithContext is like ListLocalDisks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) ListLocalDisksWithContext(ctx context.Context, req *ListLocalDisksInput) (resp *ListLocalDisksOutput, err error) {
 	resp = &ListLocalDisksOutput{}
-	err = c.client.Do("ListLocalDisks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListLocalDisks", "POST", "/", req, resp)
 	return
 }
 
@@ -466,8 +753,15 @@ func (c *StorageGateway) ListLocalDisks(req *ListLocalDisksInput) (resp *ListLoc
 // snapshot. To create a snapshot from a volume recovery point use the
 // CreateSnapshotFromVolumeRecoveryPoint operation.
 func (c *StorageGateway) ListVolumeRecoveryPoints(req *ListVolumeRecoveryPointsInput) (resp *ListVolumeRecoveryPointsOutput, err error) {
+	return c.ListVolumeRecoveryPointsWithContext(context.Background(), req)
+}
+
+// ListVolumeRecoveryPointsWithContext is like ListVolumeRecoveryPoints, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) ListVolumeRecoveryPointsWithContext(ctx context.Context, req *ListVolumeRecoveryPointsInput) (resp *ListVolumeRecoveryPointsOutput, err error) {
 	resp = &ListVolumeRecoveryPointsOutput{}
-	err = c.client.Do("ListVolumeRecoveryPoints", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListVolumeRecoveryPoints", "POST", "/", req, resp)
 	return
 }
 
@@ -482,8 +776,15 @@ func (c *StorageGateway) ListVolumeRecoveryPoints(req *ListVolumeRecoveryPointsI
 // this Marker value in your subsequent request to retrieve the next set of
 // volumes.
 func (c *StorageGateway) ListVolumes(req *ListVolumesInput) (resp *ListVolumesOutput, err error) {
+	return c.ListVolumesWithContext(context.Background(), req)
+}
+
+// ListVolumesWithContext is like ListVolumes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) ListVolumesWithContext(ctx context.Context, req *ListVolumesInput) (resp *ListVolumesOutput, err error) {
 	resp = &ListVolumesOutput{}
-	err = c.client.Do("ListVolumes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListVolumes", "POST", "/", req, resp)
 	return
 }
 
@@ -495,8 +796,15 @@ func (c *StorageGateway) ListVolumes(req *ListVolumesInput) (resp *ListVolumesOu
 // again to another gateway. You must archive the tape again before you can
 // retrieve it to another gateway.
 func (c *StorageGateway) RetrieveTapeArchive(req *RetrieveTapeArchiveInput) (resp *RetrieveTapeArchiveOutput, err error) {
+	return c.RetrieveTapeArchiveWithContext(context.Background(), req)
+}
+
+// RetrieveTapeArchiveWithContext is like RetrieveTapeArchive, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) RetrieveTapeArchiveWithContext(ctx context.Context, req *RetrieveTapeArchiveInput) (resp *RetrieveTapeArchiveOutput, err error) {
 	resp = &RetrieveTapeArchiveOutput{}
-	err = c.client.Do("RetrieveTapeArchive", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RetrieveTapeArchive", "POST", "/", req, resp)
 	return
 }
 
@@ -506,8 +814,15 @@ func (c *StorageGateway) RetrieveTapeArchive(req *RetrieveTapeArchiveInput) (res
 // crashes, virtual tapes that have recovery points can be recovered to a
 // new gateway.
 func (c *StorageGateway) RetrieveTapeRecoveryPoint(req *RetrieveTapeRecoveryPointInput) (resp *RetrieveTapeRecoveryPointOutput, err error) {
+	return c.RetrieveTapeRecoveryPointWithContext(context.Background(), req)
+}
+
+// RetrieveTapeRecoveryPointWithContext is like RetrieveTapeRecoveryPoint, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) RetrieveTapeRecoveryPointWithContext(ctx context.Context, req *RetrieveTapeRecoveryPointInput) (resp *RetrieveTapeRecoveryPointOutput, err error) {
 	resp = &RetrieveTapeRecoveryPointOutput{}
-	err = c.client.Do("RetrieveTapeRecoveryPoint", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RetrieveTapeRecoveryPoint", "POST", "/", req, resp)
 	return
 }
 
@@ -523,8 +838,15 @@ func (c *StorageGateway) RetrieveTapeRecoveryPoint(req *RetrieveTapeRecoveryPoin
 // gateway (using DeleteGateway ) to no longer pay software charges
 // associated with the gateway.
 func (c *StorageGateway) ShutdownGateway(req *ShutdownGatewayInput) (resp *ShutdownGatewayOutput, err error) {
+	return c.ShutdownGatewayWithContext(context.Background(), req)
+}
+
+// ShutdownGatewayWithContext is like ShutdownGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) ShutdownGatewayWithContext(ctx context.Context, req *ShutdownGatewayInput) (resp *ShutdownGatewayOutput, err error) {
 	resp = &ShutdownGatewayOutput{}
-	err = c.client.Do("ShutdownGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ShutdownGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -535,8 +857,15 @@ func (c *StorageGateway) ShutdownGateway(req *ShutdownGatewayInput) (resp *Shutd
 // To specify which gateway to start, use the Amazon Resource Name of the
 // gateway in your request.
 func (c *StorageGateway) StartGateway(req *StartGatewayInput) (resp *StartGatewayOutput, err error) {
+	return c.StartGatewayWithContext(context.Background(), req)
+}
+
+// StartGatewayWithContext is like StartGateway, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) StartGatewayWithContext(ctx context.Context, req *StartGatewayInput) (resp *StartGatewayOutput, err error) {
 	resp = &StartGatewayOutput{}
-	err = c.client.Do("StartGateway", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "StartGateway", "POST", "/", req, resp)
 	return
 }
 
@@ -550,8 +879,15 @@ func (c *StorageGateway) StartGateway(req *StartGatewayInput) (resp *StartGatewa
 // gateway to update, use the Amazon Resource Name of the gateway in your
 // request.
 func (c *StorageGateway) UpdateBandwidthRateLimit(req *UpdateBandwidthRateLimitInput) (resp *UpdateBandwidthRateLimitOutput, err error) {
+	return c.UpdateBandwidthRateLimitWithContext(context.Background(), req)
+}
+
+// UpdateBandwidthRateLimitWithContext is like UpdateBandwidthRateLimit, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) UpdateBandwidthRateLimitWithContext(ctx context.Context, req *UpdateBandwidthRateLimitInput) (resp *UpdateBandwidthRateLimitOutput, err error) {
 	resp = &UpdateBandwidthRateLimitOutput{}
-	err = c.client.Do("UpdateBandwidthRateLimit", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateBandwidthRateLimit", "POST", "/", req, resp)
 	return
 }
 
@@ -562,8 +898,15 @@ func (c *StorageGateway) UpdateBandwidthRateLimit(req *UpdateBandwidthRateLimitI
 // on the target are closed and initiators must reconnect with the new
 // credentials.
 func (c *StorageGateway) UpdateChapCredentials(req *UpdateChapCredentialsInput) (resp *UpdateChapCredentialsOutput, err error) {
+	return c.UpdateChapCredentialsWithContext(context.Background(), req)
+}
+
+// UpdateChapCredentialsWithContext is like UpdateChapCredentials, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) UpdateChapCredentialsWithContext(ctx context.Context, req *UpdateChapCredentialsInput) (resp *UpdateChapCredentialsOutput, err error) {
 	resp = &UpdateChapCredentialsOutput{}
-	err = c.client.Do("UpdateChapCredentials", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateChapCredentials", "POST", "/", req, resp)
 	return
 }
 
@@ -572,8 +915,15 @@ func (c *StorageGateway) UpdateChapCredentials(req *UpdateChapCredentialsInput)
 // gateway to update, use the Amazon Resource Name of the gateway in your
 // request.
 func (c *StorageGateway) UpdateGatewayInformation(req *UpdateGatewayInformationInput) (resp *UpdateGatewayInformationOutput, err error) {
+	return c.UpdateGatewayInformationWithContext(context.Background(), req)
+}
+
+// UpdateGatewayInformationWithContext is like UpdateGatewayInformation, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) UpdateGatewayInformationWithContext(ctx context.Context, req *UpdateGatewayInformationInput) (resp *UpdateGatewayInformationOutput, err error) {
 	resp = &UpdateGatewayInformationOutput{}
-	err = c.client.Do("UpdateGatewayInformation", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateGatewayInformation", "POST", "/", req, resp)
 	return
 }
 
@@ -586,8 +936,15 @@ func (c *StorageGateway) UpdateGatewayInformation(req *UpdateGatewayInformationI
 // Windows iSCSI Settings and Customizing Your Linux iSCSI Settings ,
 // respectively.
 func (c *StorageGateway) UpdateGatewaySoftwareNow(req *UpdateGatewaySoftwareNowInput) (resp *UpdateGatewaySoftwareNowOutput, err error) {
+	return c.UpdateGatewaySoftwareNowWithContext(context.Background(), req)
+}
+
+// UpdateGatewaySoftwareNowWithContext is like UpdateGatewaySoftwareNow, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) UpdateGatewaySoftwareNowWithContext(ctx context.Context, req *UpdateGatewaySoftwareNowInput) (resp *UpdateGatewaySoftwareNowOutput, err error) {
 	resp = &UpdateGatewaySoftwareNowOutput{}
-	err = c.client.Do("UpdateGatewaySoftwareNow", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateGatewaySoftwareNow", "POST", "/", req, resp)
 	return
 }
 
@@ -595,8 +952,15 @@ func (c *StorageGateway) UpdateGatewaySoftwareNow(req *UpdateGatewaySoftwareNowI
 // maintenance start time information, including day and time of the week.
 // The maintenance time is the time in your gateway's time zone.
 func (c *StorageGateway) UpdateMaintenanceStartTime(req *UpdateMaintenanceStartTimeInput) (resp *UpdateMaintenanceStartTimeOutput, err error) {
+	return c.UpdateMaintenanceStartTimeWithContext(context.Background(), req)
+}
+
+// UpdateMaintenanceStartTimeWithContext is like UpdateMaintenanceStartTime, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) UpdateMaintenanceStartTimeWithContext(ctx context.Context, req *UpdateMaintenanceStartTimeInput) (resp *UpdateMaintenanceStartTimeOutput, err error) {
 	resp = &UpdateMaintenanceStartTimeOutput{}
-	err = c.client.Do("UpdateMaintenanceStartTime", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateMaintenanceStartTime", "POST", "/", req, resp)
 	return
 }
 
@@ -609,8 +973,15 @@ func (c *StorageGateway) UpdateMaintenanceStartTime(req *UpdateMaintenanceStartT
 // information, including when you want the snapshot to begin on a day and
 // the frequency (in hours) of snapshots.
 func (c *StorageGateway) UpdateSnapshotSchedule(req *UpdateSnapshotScheduleInput) (resp *UpdateSnapshotScheduleOutput, err error) {
+	return c.UpdateSnapshotScheduleWithContext(context.Background(), req)
+}
+
+// UpdateSnapshotScheduleWithContext is like UpdateSnapshotSchedule, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *StorageGateway) UpdateSnapshotScheduleWithContext(ctx context.Context, req *UpdateSnapshotScheduleInput) (resp *UpdateSnapshotScheduleOutput, err error) {
 	resp = &UpdateSnapshotScheduleOutput{}
-	err = c.client.Do("UpdateSnapshotSchedule", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "UpdateSnapshotSchedule", "POST", "/", req, resp)
 	return
 }
 
@@ -625,6 +996,69 @@ type ActivateGatewayInput struct {
 	TapeDriveType     aws.StringValue `json:"TapeDriveType,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ActivateGatewayInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ActivateGatewayInput to b.
+func (v *ActivateGatewayInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ActivationKey\":"...)
+	if v.ActivationKey == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ActivationKey)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayName\":"...)
+	if v.GatewayName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayRegion\":"...)
+	if v.GatewayRegion == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayRegion)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayTimezone\":"...)
+	if v.GatewayTimezone == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayTimezone)
+	}
+	b = append(b, ',')
+	if v.GatewayType != nil {
+		b = append(b, "\"GatewayType\":"...)
+		b = aws.AppendJSONString(b, *v.GatewayType)
+		b = append(b, ',')
+	}
+	if v.MediumChangerType != nil {
+		b = append(b, "\"MediumChangerType\":"...)
+		b = aws.AppendJSONString(b, *v.MediumChangerType)
+		b = append(b, ',')
+	}
+	if v.TapeDriveType != nil {
+		b = append(b, "\"TapeDriveType\":"...)
+		b = aws.AppendJSONString(b, *v.TapeDriveType)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ActivateGatewayOutput is undocumented.
 type ActivateGatewayOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -636,6 +1070,49 @@ type AddCacheInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddCacheInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddCacheInput to b.
+func (v *AddCacheInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DiskIds\":"...)
+	if v.DiskIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.DiskIDs {
+			b = aws.AppendJSONString(b, v.DiskIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddCacheOutput is undocumented.
 type AddCacheOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -647,6 +1124,49 @@ type AddUploadBufferInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddUploadBufferInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddUploadBufferInput to b.
+func (v *AddUploadBufferInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DiskIds\":"...)
+	if v.DiskIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.DiskIDs {
+			b = aws.AppendJSONString(b, v.DiskIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddUploadBufferOutput is undocumented.
 type AddUploadBufferOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -658,6 +1178,49 @@ type AddWorkingStorageInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddWorkingStorageInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddWorkingStorageInput to b.
+func (v *AddWorkingStorageInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DiskIds\":"...)
+	if v.DiskIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.DiskIDs {
+			b = aws.AppendJSONString(b, v.DiskIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddWorkingStorageOutput is undocumented.
 type AddWorkingStorageOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -681,6 +1244,40 @@ type CancelArchivalInput struct {
 	TapeARN    aws.StringValue `json:"TapeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CancelArchivalInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CancelArchivalInput to b.
+func (v *CancelArchivalInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TapeARN\":"...)
+	if v.TapeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TapeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CancelArchivalOutput is undocumented.
 type CancelArchivalOutput struct {
 	TapeARN aws.StringValue `json:"TapeARN,omitempty"`
@@ -692,6 +1289,40 @@ type CancelRetrievalInput struct {
 	TapeARN    aws.StringValue `json:"TapeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CancelRetrievalInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CancelRetrievalInput to b.
+func (v *CancelRetrievalInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TapeARN\":"...)
+	if v.TapeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TapeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CancelRetrievalOutput is undocumented.
 type CancelRetrievalOutput struct {
 	TapeARN aws.StringValue `json:"TapeARN,omitempty"`
@@ -715,6 +1346,66 @@ type CreateCachediSCSIVolumeInput struct {
 	VolumeSizeInBytes  aws.LongValue   `json:"VolumeSizeInBytes"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateCachediSCSIVolumeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateCachediSCSIVolumeInput to b.
+func (v *CreateCachediSCSIVolumeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClientToken\":"...)
+	if v.ClientToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClientToken)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"NetworkInterfaceId\":"...)
+	if v.NetworkInterfaceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.NetworkInterfaceID)
+	}
+	b = append(b, ',')
+	if v.SnapshotID != nil {
+		b = append(b, "\"SnapshotId\":"...)
+		b = aws.AppendJSONString(b, *v.SnapshotID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TargetName\":"...)
+	if v.TargetName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TargetName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"VolumeSizeInBytes\":"...)
+	if v.VolumeSizeInBytes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.VolumeSizeInBytes), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateCachediSCSIVolumeOutput is undocumented.
 type CreateCachediSCSIVolumeOutput struct {
 	TargetARN aws.StringValue `json:"TargetARN,omitempty"`
@@ -727,6 +1418,40 @@ type CreateSnapshotFromVolumeRecoveryPointInput struct {
 	VolumeARN           aws.StringValue `json:"VolumeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateSnapshotFromVolumeRecoveryPointInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateSnapshotFromVolumeRecoveryPointInput to b.
+func (v *CreateSnapshotFromVolumeRecoveryPointInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"SnapshotDescription\":"...)
+	if v.SnapshotDescription == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.SnapshotDescription)
+	}
+	b = append(b, ',')
+	b = append(b, "\"VolumeARN\":"...)
+	if v.VolumeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateSnapshotFromVolumeRecoveryPointOutput is undocumented.
 type CreateSnapshotFromVolumeRecoveryPointOutput struct {
 	SnapshotID              aws.StringValue `json:"SnapshotId,omitempty"`
@@ -740,6 +1465,40 @@ type CreateSnapshotInput struct {
 	VolumeARN           aws.StringValue `json:"VolumeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateSnapshotInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateSnapshotInput to b.
+func (v *CreateSnapshotInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"SnapshotDescription\":"...)
+	if v.SnapshotDescription == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.SnapshotDescription)
+	}
+	b = append(b, ',')
+	b = append(b, "\"VolumeARN\":"...)
+	if v.VolumeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateSnapshotOutput is undocumented.
 type CreateSnapshotOutput struct {
 	SnapshotID aws.StringValue `json:"SnapshotId,omitempty"`
@@ -756,6 +1515,66 @@ type CreateStorediSCSIVolumeInput struct {
 	TargetName           aws.StringValue  `json:"TargetName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateStorediSCSIVolumeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateStorediSCSIVolumeInput to b.
+func (v *CreateStorediSCSIVolumeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DiskId\":"...)
+	if v.DiskID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DiskID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"NetworkInterfaceId\":"...)
+	if v.NetworkInterfaceID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.NetworkInterfaceID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"PreserveExistingData\":"...)
+	if v.PreserveExistingData == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendBool(b, *v.PreserveExistingData)
+	}
+	b = append(b, ',')
+	if v.SnapshotID != nil {
+		b = append(b, "\"SnapshotId\":"...)
+		b = aws.AppendJSONString(b, *v.SnapshotID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TargetName\":"...)
+	if v.TargetName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TargetName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateStorediSCSIVolumeOutput is undocumented.
 type CreateStorediSCSIVolumeOutput struct {
 	TargetARN         aws.StringValue `json:"TargetARN,omitempty"`
@@ -772,6 +1591,61 @@ type CreateTapesInput struct {
 	TapeSizeInBytes   aws.LongValue    `json:"TapeSizeInBytes"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateTapesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateTapesInput to b.
+func (v *CreateTapesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"ClientToken\":"...)
+	if v.ClientToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ClientToken)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"NumTapesToCreate\":"...)
+	if v.NumTapesToCreate == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.NumTapesToCreate), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TapeBarcodePrefix\":"...)
+	if v.TapeBarcodePrefix == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TapeBarcodePrefix)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TapeSizeInBytes\":"...)
+	if v.TapeSizeInBytes == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.TapeSizeInBytes), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateTapesOutput is undocumented.
 type CreateTapesOutput struct {
 	TapeARNs []string `json:"TapeARNs,omitempty"`
@@ -783,6 +1657,40 @@ type DeleteBandwidthRateLimitInput struct {
 	GatewayARN    aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteBandwidthRateLimitInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteBandwidthRateLimitInput to b.
+func (v *DeleteBandwidthRateLimitInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"BandwidthType\":"...)
+	if v.BandwidthType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.BandwidthType)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteBandwidthRateLimitOutput is undocumented.
 type DeleteBandwidthRateLimitOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -794,6 +1702,40 @@ type DeleteChapCredentialsInput struct {
 	TargetARN     aws.StringValue `json:"TargetARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteChapCredentialsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteChapCredentialsInput to b.
+func (v *DeleteChapCredentialsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InitiatorName\":"...)
+	if v.InitiatorName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InitiatorName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TargetARN\":"...)
+	if v.TargetARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TargetARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteChapCredentialsOutput is undocumented.
 type DeleteChapCredentialsOutput struct {
 	InitiatorName aws.StringValue `json:"InitiatorName,omitempty"`
@@ -805,6 +1747,33 @@ type DeleteGatewayInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteGatewayInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteGatewayInput to b.
+func (v *DeleteGatewayInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteGatewayOutput is undocumented.
 type DeleteGatewayOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -815,6 +1784,33 @@ type DeleteSnapshotScheduleInput struct {
 	VolumeARN aws.StringValue `json:"VolumeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteSnapshotScheduleInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteSnapshotScheduleInput to b.
+func (v *DeleteSnapshotScheduleInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"VolumeARN\":"...)
+	if v.VolumeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteSnapshotScheduleOutput is undocumented.
 type DeleteSnapshotScheduleOutput struct {
 	VolumeARN aws.StringValue `json:"VolumeARN,omitempty"`
@@ -825,6 +1821,33 @@ type DeleteTapeArchiveInput struct {
 	TapeARN aws.StringValue `json:"TapeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteTapeArchiveInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteTapeArchiveInput to b.
+func (v *DeleteTapeArchiveInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"TapeARN\":"...)
+	if v.TapeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TapeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteTapeArchiveOutput is undocumented.
 type DeleteTapeArchiveOutput struct {
 	TapeARN aws.StringValue `json:"TapeARN,omitempty"`
@@ -836,6 +1859,40 @@ type DeleteTapeInput struct {
 	TapeARN    aws.StringValue `json:"TapeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteTapeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteTapeInput to b.
+func (v *DeleteTapeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TapeARN\":"...)
+	if v.TapeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TapeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteTapeOutput is undocumented.
 type DeleteTapeOutput struct {
 	TapeARN aws.StringValue `json:"TapeARN,omitempty"`
@@ -846,6 +1903,33 @@ type DeleteVolumeInput struct {
 	VolumeARN aws.StringValue `json:"VolumeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeleteVolumeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeleteVolumeInput to b.
+func (v *DeleteVolumeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"VolumeARN\":"...)
+	if v.VolumeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeleteVolumeOutput is undocumented.
 type DeleteVolumeOutput struct {
 	VolumeARN aws.StringValue `json:"VolumeARN,omitempty"`
@@ -856,6 +1940,33 @@ type DescribeBandwidthRateLimitInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeBandwidthRateLimitInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeBandwidthRateLimitInput to b.
+func (v *DescribeBandwidthRateLimitInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeBandwidthRateLimitOutput is undocumented.
 type DescribeBandwidthRateLimitOutput struct {
 	AverageDownloadRateLimitInBitsPerSec aws.LongValue   `json:"AverageDownloadRateLimitInBitsPerSec,omitempty"`
@@ -868,6 +1979,33 @@ type DescribeCacheInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeCacheInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeCacheInput to b.
+func (v *DescribeCacheInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeCacheOutput is undocumented.
 type DescribeCacheOutput struct {
 	CacheAllocatedInBytes aws.LongValue   `json:"CacheAllocatedInBytes,omitempty"`
@@ -884,6 +2022,42 @@ type DescribeCachediSCSIVolumesInput struct {
 	VolumeARNs []string `json:"VolumeARNs"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeCachediSCSIVolumesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeCachediSCSIVolumesInput to b.
+func (v *DescribeCachediSCSIVolumesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"VolumeARNs\":"...)
+	if v.VolumeARNs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.VolumeARNs {
+			b = aws.AppendJSONString(b, v.VolumeARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeCachediSCSIVolumesOutput is undocumented.
 type DescribeCachediSCSIVolumesOutput struct {
 	CachediSCSIVolumes []CachediSCSIVolume `json:"CachediSCSIVolumes,omitempty"`
@@ -894,6 +2068,33 @@ type DescribeChapCredentialsInput struct {
 	TargetARN aws.StringValue `json:"TargetARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeChapCredentialsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeChapCredentialsInput to b.
+func (v *DescribeChapCredentialsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"TargetARN\":"...)
+	if v.TargetARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TargetARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeChapCredentialsOutput is undocumented.
 type DescribeChapCredentialsOutput struct {
 	ChapCredentials []ChapInfo `json:"ChapCredentials,omitempty"`
@@ -904,6 +2105,33 @@ type DescribeGatewayInformationInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeGatewayInformationInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeGatewayInformationInput to b.
+func (v *DescribeGatewayInformationInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeGatewayInformationOutput is undocumented.
 type DescribeGatewayInformationOutput struct {
 	GatewayARN                 aws.StringValue    `json:"GatewayARN,omitempty"`
@@ -920,6 +2148,33 @@ type DescribeMaintenanceStartTimeInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeMaintenanceStartTimeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeMaintenanceStartTimeInput to b.
+func (v *DescribeMaintenanceStartTimeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeMaintenanceStartTimeOutput is undocumented.
 type DescribeMaintenanceStartTimeOutput struct {
 	DayOfWeek    aws.IntegerValue `json:"DayOfWeek,omitempty"`
@@ -934,6 +2189,33 @@ type DescribeSnapshotScheduleInput struct {
 	VolumeARN aws.StringValue `json:"VolumeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeSnapshotScheduleInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeSnapshotScheduleInput to b.
+func (v *DescribeSnapshotScheduleInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"VolumeARN\":"...)
+	if v.VolumeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeSnapshotScheduleOutput is undocumented.
 type DescribeSnapshotScheduleOutput struct {
 	Description       aws.StringValue  `json:"Description,omitempty"`
@@ -948,6 +2230,42 @@ type DescribeStorediSCSIVolumesInput struct {
 	VolumeARNs []string `json:"VolumeARNs"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeStorediSCSIVolumesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeStorediSCSIVolumesInput to b.
+func (v *DescribeStorediSCSIVolumesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"VolumeARNs\":"...)
+	if v.VolumeARNs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.VolumeARNs {
+			b = aws.AppendJSONString(b, v.VolumeARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeStorediSCSIVolumesOutput is undocumented.
 type DescribeStorediSCSIVolumesOutput struct {
 	StorediSCSIVolumes []StorediSCSIVolume `json:"StorediSCSIVolumes,omitempty"`
@@ -960,6 +2278,50 @@ type DescribeTapeArchivesInput struct {
 	TapeARNs []string         `json:"TapeARNs,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTapeArchivesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTapeArchivesInput to b.
+func (v *DescribeTapeArchivesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if len(v.TapeARNs) != 0 {
+		b = append(b, "\"TapeARNs\":"...)
+		b = append(b, '[')
+		for i1 := range v.TapeARNs {
+			b = aws.AppendJSONString(b, v.TapeARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTapeArchivesOutput is undocumented.
 type DescribeTapeArchivesOutput struct {
 	Marker       aws.StringValue `json:"Marker,omitempty"`
@@ -973,6 +2335,43 @@ type DescribeTapeRecoveryPointsInput struct {
 	Marker     aws.StringValue  `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTapeRecoveryPointsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTapeRecoveryPointsInput to b.
+func (v *DescribeTapeRecoveryPointsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTapeRecoveryPointsOutput is undocumented.
 type DescribeTapeRecoveryPointsOutput struct {
 	GatewayARN             aws.StringValue         `json:"GatewayARN,omitempty"`
@@ -988,6 +2387,57 @@ type DescribeTapesInput struct {
 	TapeARNs   []string         `json:"TapeARNs,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTapesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTapesInput to b.
+func (v *DescribeTapesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if len(v.TapeARNs) != 0 {
+		b = append(b, "\"TapeARNs\":"...)
+		b = append(b, '[')
+		for i1 := range v.TapeARNs {
+			b = aws.AppendJSONString(b, v.TapeARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTapesOutput is undocumented.
 type DescribeTapesOutput struct {
 	Marker aws.StringValue `json:"Marker,omitempty"`
@@ -999,6 +2449,33 @@ type DescribeUploadBufferInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeUploadBufferInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeUploadBufferInput to b.
+func (v *DescribeUploadBufferInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeUploadBufferOutput is undocumented.
 type DescribeUploadBufferOutput struct {
 	DiskIDs                      []string        `json:"DiskIds,omitempty"`
@@ -1015,6 +2492,57 @@ type DescribeVTLDevicesInput struct {
 	VTLDeviceARNs []string         `json:"VTLDeviceARNs,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeVTLDevicesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeVTLDevicesInput to b.
+func (v *DescribeVTLDevicesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if len(v.VTLDeviceARNs) != 0 {
+		b = append(b, "\"VTLDeviceARNs\":"...)
+		b = append(b, '[')
+		for i1 := range v.VTLDeviceARNs {
+			b = aws.AppendJSONString(b, v.VTLDeviceARNs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeVTLDevicesOutput is undocumented.
 type DescribeVTLDevicesOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1027,6 +2555,33 @@ type DescribeWorkingStorageInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeWorkingStorageInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeWorkingStorageInput to b.
+func (v *DescribeWorkingStorageInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeWorkingStorageOutput is undocumented.
 type DescribeWorkingStorageOutput struct {
 	DiskIDs                        []string        `json:"DiskIds,omitempty"`
@@ -1048,6 +2603,33 @@ type DisableGatewayInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DisableGatewayInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DisableGatewayInput to b.
+func (v *DisableGatewayInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DisableGatewayOutput is undocumented.
 type DisableGatewayOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1141,6 +2723,36 @@ type ListGatewaysInput struct {
 	Marker aws.StringValue  `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListGatewaysInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListGatewaysInput to b.
+func (v *ListGatewaysInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListGatewaysOutput is undocumented.
 type ListGatewaysOutput struct {
 	Gateways []GatewayInfo   `json:"Gateways,omitempty"`
@@ -1152,6 +2764,33 @@ type ListLocalDisksInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListLocalDisksInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListLocalDisksInput to b.
+func (v *ListLocalDisksInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListLocalDisksOutput is undocumented.
 type ListLocalDisksOutput struct {
 	Disks      []Disk          `json:"Disks,omitempty"`
@@ -1163,6 +2802,33 @@ type ListVolumeRecoveryPointsInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListVolumeRecoveryPointsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListVolumeRecoveryPointsInput to b.
+func (v *ListVolumeRecoveryPointsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListVolumeRecoveryPointsOutput is undocumented.
 type ListVolumeRecoveryPointsOutput struct {
 	GatewayARN               aws.StringValue           `json:"GatewayARN,omitempty"`
@@ -1176,6 +2842,43 @@ type ListVolumesInput struct {
 	Marker     aws.StringValue  `json:"Marker,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListVolumesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListVolumesInput to b.
+func (v *ListVolumesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if v.Limit != nil {
+		b = append(b, "\"Limit\":"...)
+		b = strconv.AppendInt(b, int64(*v.Limit), 10)
+		b = append(b, ',')
+	}
+	if v.Marker != nil {
+		b = append(b, "\"Marker\":"...)
+		b = aws.AppendJSONString(b, *v.Marker)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListVolumesOutput is undocumented.
 type ListVolumesOutput struct {
 	GatewayARN  aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1196,6 +2899,40 @@ type RetrieveTapeArchiveInput struct {
 	TapeARN    aws.StringValue `json:"TapeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RetrieveTapeArchiveInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RetrieveTapeArchiveInput to b.
+func (v *RetrieveTapeArchiveInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TapeARN\":"...)
+	if v.TapeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TapeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RetrieveTapeArchiveOutput is undocumented.
 type RetrieveTapeArchiveOutput struct {
 	TapeARN aws.StringValue `json:"TapeARN,omitempty"`
@@ -1207,6 +2944,40 @@ type RetrieveTapeRecoveryPointInput struct {
 	TapeARN    aws.StringValue `json:"TapeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RetrieveTapeRecoveryPointInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RetrieveTapeRecoveryPointInput to b.
+func (v *RetrieveTapeRecoveryPointInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"TapeARN\":"...)
+	if v.TapeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TapeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RetrieveTapeRecoveryPointOutput is undocumented.
 type RetrieveTapeRecoveryPointOutput struct {
 	TapeARN aws.StringValue `json:"TapeARN,omitempty"`
@@ -1217,6 +2988,33 @@ type ShutdownGatewayInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ShutdownGatewayInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ShutdownGatewayInput to b.
+func (v *ShutdownGatewayInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ShutdownGatewayOutput is undocumented.
 type ShutdownGatewayOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1227,6 +3025,33 @@ type StartGatewayInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartGatewayInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartGatewayInput to b.
+func (v *StartGatewayInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StartGatewayOutput is undocumented.
 type StartGatewayOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1264,20 +3089,20 @@ type Tape struct {
 
 // TapeArchive is undocumented.
 type TapeArchive struct {
-	CompletionTime  time.Time       `json:"CompletionTime,omitempty"`
-	RetrievedTo     aws.StringValue `json:"RetrievedTo,omitempty"`
-	TapeARN         aws.StringValue `json:"TapeARN,omitempty"`
-	TapeBarcode     aws.StringValue `json:"TapeBarcode,omitempty"`
-	TapeSizeInBytes aws.LongValue   `json:"TapeSizeInBytes,omitempty"`
-	TapeStatus      aws.StringValue `json:"TapeStatus,omitempty"`
+	CompletionTime  *aws.FloatTimestamp `json:"CompletionTime,omitempty"`
+	RetrievedTo     aws.StringValue     `json:"RetrievedTo,omitempty"`
+	TapeARN         aws.StringValue     `json:"TapeARN,omitempty"`
+	TapeBarcode     aws.StringValue     `json:"TapeBarcode,omitempty"`
+	TapeSizeInBytes aws.LongValue       `json:"TapeSizeInBytes,omitempty"`
+	TapeStatus      aws.StringValue     `json:"TapeStatus,omitempty"`
 }
 
 // TapeRecoveryPointInfo is undocumented.
 type TapeRecoveryPointInfo struct {
-	TapeARN               aws.StringValue `json:"TapeARN,omitempty"`
-	TapeRecoveryPointTime time.Time       `json:"TapeRecoveryPointTime,omitempty"`
-	TapeSizeInBytes       aws.LongValue   `json:"TapeSizeInBytes,omitempty"`
-	TapeStatus            aws.StringValue `json:"TapeStatus,omitempty"`
+	TapeARN               aws.StringValue     `json:"TapeARN,omitempty"`
+	TapeRecoveryPointTime *aws.FloatTimestamp `json:"TapeRecoveryPointTime,omitempty"`
+	TapeSizeInBytes       aws.LongValue       `json:"TapeSizeInBytes,omitempty"`
+	TapeStatus            aws.StringValue     `json:"TapeStatus,omitempty"`
 }
 
 // UpdateBandwidthRateLimitInput is undocumented.
@@ -1287,6 +3112,43 @@ type UpdateBandwidthRateLimitInput struct {
 	GatewayARN                           aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateBandwidthRateLimitInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateBandwidthRateLimitInput to b.
+func (v *UpdateBandwidthRateLimitInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AverageDownloadRateLimitInBitsPerSec != nil {
+		b = append(b, "\"AverageDownloadRateLimitInBitsPerSec\":"...)
+		b = strconv.AppendInt(b, int64(*v.AverageDownloadRateLimitInBitsPerSec), 10)
+		b = append(b, ',')
+	}
+	if v.AverageUploadRateLimitInBitsPerSec != nil {
+		b = append(b, "\"AverageUploadRateLimitInBitsPerSec\":"...)
+		b = strconv.AppendInt(b, int64(*v.AverageUploadRateLimitInBitsPerSec), 10)
+		b = append(b, ',')
+	}
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateBandwidthRateLimitOutput is undocumented.
 type UpdateBandwidthRateLimitOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1300,6 +3162,52 @@ type UpdateChapCredentialsInput struct {
 	TargetARN                     aws.StringValue `json:"TargetARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateChapCredentialsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateChapCredentialsInput to b.
+func (v *UpdateChapCredentialsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"InitiatorName\":"...)
+	if v.InitiatorName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.InitiatorName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"SecretToAuthenticateInitiator\":"...)
+	if v.SecretToAuthenticateInitiator == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.SecretToAuthenticateInitiator)
+	}
+	b = append(b, ',')
+	if v.SecretToAuthenticateTarget != nil {
+		b = append(b, "\"SecretToAuthenticateTarget\":"...)
+		b = aws.AppendJSONString(b, *v.SecretToAuthenticateTarget)
+		b = append(b, ',')
+	}
+	b = append(b, "\"TargetARN\":"...)
+	if v.TargetARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TargetARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateChapCredentialsOutput is undocumented.
 type UpdateChapCredentialsOutput struct {
 	InitiatorName aws.StringValue `json:"InitiatorName,omitempty"`
@@ -1313,6 +3221,43 @@ type UpdateGatewayInformationInput struct {
 	GatewayTimezone aws.StringValue `json:"GatewayTimezone,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateGatewayInformationInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateGatewayInformationInput to b.
+func (v *UpdateGatewayInformationInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if v.GatewayName != nil {
+		b = append(b, "\"GatewayName\":"...)
+		b = aws.AppendJSONString(b, *v.GatewayName)
+		b = append(b, ',')
+	}
+	if v.GatewayTimezone != nil {
+		b = append(b, "\"GatewayTimezone\":"...)
+		b = aws.AppendJSONString(b, *v.GatewayTimezone)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateGatewayInformationOutput is undocumented.
 type UpdateGatewayInformationOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1323,6 +3268,33 @@ type UpdateGatewaySoftwareNowInput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateGatewaySoftwareNowInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateGatewaySoftwareNowInput to b.
+func (v *UpdateGatewaySoftwareNowInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateGatewaySoftwareNowOutput is undocumented.
 type UpdateGatewaySoftwareNowOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1336,6 +3308,54 @@ type UpdateMaintenanceStartTimeInput struct {
 	MinuteOfHour aws.IntegerValue `json:"MinuteOfHour"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateMaintenanceStartTimeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateMaintenanceStartTimeInput to b.
+func (v *UpdateMaintenanceStartTimeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"DayOfWeek\":"...)
+	if v.DayOfWeek == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.DayOfWeek), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"GatewayARN\":"...)
+	if v.GatewayARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.GatewayARN)
+	}
+	b = append(b, ',')
+	b = append(b, "\"HourOfDay\":"...)
+	if v.HourOfDay == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.HourOfDay), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"MinuteOfHour\":"...)
+	if v.MinuteOfHour == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.MinuteOfHour), 10)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateMaintenanceStartTimeOutput is undocumented.
 type UpdateMaintenanceStartTimeOutput struct {
 	GatewayARN aws.StringValue `json:"GatewayARN,omitempty"`
@@ -1349,6 +3369,52 @@ type UpdateSnapshotScheduleInput struct {
 	VolumeARN         aws.StringValue  `json:"VolumeARN"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *UpdateSnapshotScheduleInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the UpdateSnapshotScheduleInput to b.
+func (v *UpdateSnapshotScheduleInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Description != nil {
+		b = append(b, "\"Description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	b = append(b, "\"RecurrenceInHours\":"...)
+	if v.RecurrenceInHours == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.RecurrenceInHours), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"StartAt\":"...)
+	if v.StartAt == nil {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, int64(*v.StartAt), 10)
+	}
+	b = append(b, ',')
+	b = append(b, "\"VolumeARN\":"...)
+	if v.VolumeARN == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.VolumeARN)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // UpdateSnapshotScheduleOutput is undocumented.
 type UpdateSnapshotScheduleOutput struct {
 	VolumeARN aws.StringValue `json:"VolumeARN,omitempty"`
@@ -1386,5 +3452,28 @@ type VolumeiSCSIAttributes struct {
 	TargetARN            aws.StringValue  `json:"TargetARN,omitempty"`
 }
 
+// InternalServerError is the error returned for the InternalServerError error code.
+type InternalServerError struct {
+	aws.APIError
+
+	ErrorDetails *StorageGatewayError `json:"error,omitempty"`
+}
+
+// InvalidGatewayRequestException is the error returned for the InvalidGatewayRequestException error code.
+type InvalidGatewayRequestException struct {
+	aws.APIError
+
+	ErrorDetails *StorageGatewayError `json:"error,omitempty"`
+}
+
+// exceptions maps StorageGateway's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"InternalServerError":            InternalServerError{},
+	"InvalidGatewayRequestException": InvalidGatewayRequestException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
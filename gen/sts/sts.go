@@ -4,11 +4,21 @@
 package sts
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
 )
 
 // STS is a client for AWS Security Token Service.
@@ -16,29 +26,70 @@ type STS struct {
 	client *aws.QueryClient
 }
 
-// New returns a new STS client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *STS {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "sts"
-	endpoint, service, region := endpoints.Lookup("sts", region)
+// New returns a new STS client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *STS {
+	cfg := newConfig(region, client, opts)
 
 	return &STS{
 		client: &aws.QueryClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
 			},
-			Client:     client,
-			Endpoint:   endpoint,
+			Client:     cfg.HTTPClient,
+			Endpoint:   cfg.Endpoint,
+			Retry:      cfg.Retry,
+			Exceptions: exceptions,
 			APIVersion: "2011-06-15",
 		},
 	}
 }
 
+// NewFromSession returns a new STS client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *STS {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("sts", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("sts", "sts"),
+	}, "AWS_ENDPOINT_URL_STS", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *STS) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *STS) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *STS) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+	return c.client.Presign(op, req, expires)
+}
+
 // AssumeRole returns a set of temporary security credentials (consisting
 // of an access key ID, a secret access key, and a security token) that you
 // can use to access AWS resources that you might not normally have access
@@ -97,8 +148,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *STS
 // virtual MFA device. The TokenCode is the time-based one-time password
 // that the MFA devices produces.
 func (c *STS) AssumeRole(req *AssumeRoleRequest) (resp *AssumeRoleResult, err error) {
+	return c.AssumeRoleWithContext(context.Background(), req)
+}
+
+// AssumeRoleWithContext is like AssumeRole, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *STS) AssumeRoleWithContext(ctx context.Context, req *AssumeRoleRequest) (resp *AssumeRoleResult, err error) {
 	resp = &AssumeRoleResult{}
-	err = c.client.Do("AssumeRole", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AssumeRole", "POST", "/", req, resp)
 	return
 }
 
@@ -138,8 +196,15 @@ func (c *STS) AssumeRole(req *AssumeRoleRequest) (resp *AssumeRoleResult, err er
 // Federation in Using Temporary Security Credentials . Providers in Using
 // .
 func (c *STS) AssumeRoleWithSAML(req *AssumeRoleWithSAMLRequest) (resp *AssumeRoleWithSAMLResult, err error) {
+	return c.AssumeRoleWithSAMLWithContext(context.Background(), req)
+}
+
+// AssumeRoleWithSAMLWithContext is like AssumeRoleWithSAML, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *STS) AssumeRoleWithSAMLWithContext(ctx context.Context, req *AssumeRoleWithSAMLRequest) (resp *AssumeRoleWithSAMLResult, err error) {
 	resp = &AssumeRoleWithSAMLResult{}
-	err = c.client.Do("AssumeRoleWithSAML", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AssumeRoleWithSAML", "POST", "/", req, resp)
 	return
 }
 
@@ -190,8 +255,15 @@ func (c *STS) AssumeRoleWithSAML(req *AssumeRoleWithSAMLRequest) (resp *AssumeRo
 // article discusses web identity federation and shows an example of how to
 // use web identity federation to get access to content in Amazon S3.
 func (c *STS) AssumeRoleWithWebIdentity(req *AssumeRoleWithWebIdentityRequest) (resp *AssumeRoleWithWebIdentityResult, err error) {
+	return c.AssumeRoleWithWebIdentityWithContext(context.Background(), req)
+}
+
+// AssumeRoleWithWebIdentityWithContext is like AssumeRoleWithWebIdentity, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *STS) AssumeRoleWithWebIdentityWithContext(ctx context.Context, req *AssumeRoleWithWebIdentityRequest) (resp *AssumeRoleWithWebIdentityResult, err error) {
 	resp = &AssumeRoleWithWebIdentityResult{}
-	err = c.client.Do("AssumeRoleWithWebIdentity", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AssumeRoleWithWebIdentity", "POST", "/", req, resp)
 	return
 }
 
@@ -213,8 +285,15 @@ func (c *STS) AssumeRoleWithWebIdentity(req *AssumeRoleWithWebIdentityRequest) (
 // Using . The principal who made the request. The requested resource. The
 // values of condition keys in the context of the user's request.
 func (c *STS) DecodeAuthorizationMessage(req *DecodeAuthorizationMessageRequest) (resp *DecodeAuthorizationMessageResult, err error) {
+	return c.DecodeAuthorizationMessageWithContext(context.Background(), req)
+}
+
+// DecodeAuthorizationMessageWithContext is like DecodeAuthorizationMessage, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *STS) DecodeAuthorizationMessageWithContext(ctx context.Context, req *DecodeAuthorizationMessageRequest) (resp *DecodeAuthorizationMessageResult, err error) {
 	resp = &DecodeAuthorizationMessageResult{}
-	err = c.client.Do("DecodeAuthorizationMessage", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DecodeAuthorizationMessage", "POST", "/", req, resp)
 	return
 }
 
@@ -272,8 +351,15 @@ func (c *STS) DecodeAuthorizationMessage(req *DecodeAuthorizationMessageRequest)
 // Enable Access for Federated Users in Using Temporary Security
 // Credentials .
 func (c *STS) GetFederationToken(req *GetFederationTokenRequest) (resp *GetFederationTokenResult, err error) {
+	return c.GetFederationTokenWithContext(context.Background(), req)
+}
+
+// GetFederationTokenWithContext is like GetFederationToken, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *STS) GetFederationTokenWithContext(ctx context.Context, req *GetFederationTokenRequest) (resp *GetFederationTokenResult, err error) {
 	resp = &GetFederationTokenResult{}
-	err = c.client.Do("GetFederationToken", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetFederationToken", "POST", "/", req, resp)
 	return
 }
 
@@ -303,8 +389,15 @@ func (c *STS) GetFederationToken(req *GetFederationTokenRequest) (resp *GetFeder
 // Temporary Credentials to Enable Access for IAM Users in Using Temporary
 // Security Credentials .
 func (c *STS) GetSessionToken(req *GetSessionTokenRequest) (resp *GetSessionTokenResult, err error) {
+	return c.GetSessionTokenWithContext(context.Background(), req)
+}
+
+// GetSessionTokenWithContext is like GetSessionToken, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *STS) GetSessionTokenWithContext(ctx context.Context, req *GetSessionTokenRequest) (resp *GetSessionTokenResult, err error) {
 	resp = &GetSessionTokenResult{}
-	err = c.client.Do("GetSessionToken", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetSessionToken", "POST", "/", req, resp)
 	return
 }
 
@@ -319,6 +412,35 @@ type AssumeRoleRequest struct {
 	TokenCode       aws.StringValue  `query:"TokenCode" xml:"TokenCode"`
 }
 
+// MarshalQuery adds the parameters of the AssumeRoleRequest to the form, prefixing their names.
+func (v *AssumeRoleRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DurationSeconds != nil {
+		form.Set(prefix+"DurationSeconds", strconv.FormatInt(int64(*v.DurationSeconds), 10))
+	}
+	if v.ExternalID != nil {
+		form.Set(prefix+"ExternalId", *v.ExternalID)
+	}
+	if v.Policy != nil {
+		form.Set(prefix+"Policy", *v.Policy)
+	}
+	if v.RoleARN != nil {
+		form.Set(prefix+"RoleArn", *v.RoleARN)
+	}
+	if v.RoleSessionName != nil {
+		form.Set(prefix+"RoleSessionName", *v.RoleSessionName)
+	}
+	if v.SerialNumber != nil {
+		form.Set(prefix+"SerialNumber", *v.SerialNumber)
+	}
+	if v.TokenCode != nil {
+		form.Set(prefix+"TokenCode", *v.TokenCode)
+	}
+	return nil
+}
+
 // AssumeRoleResponse is undocumented.
 type AssumeRoleResponse struct {
 	AssumedRoleUser  *AssumedRoleUser `query:"AssumedRoleUser" xml:"AssumeRoleResult>AssumedRoleUser"`
@@ -335,6 +457,29 @@ type AssumeRoleWithSAMLRequest struct {
 	SAMLAssertion   aws.StringValue  `query:"SAMLAssertion" xml:"SAMLAssertion"`
 }
 
+// MarshalQuery adds the parameters of the AssumeRoleWithSAMLRequest to the form, prefixing their names.
+func (v *AssumeRoleWithSAMLRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DurationSeconds != nil {
+		form.Set(prefix+"DurationSeconds", strconv.FormatInt(int64(*v.DurationSeconds), 10))
+	}
+	if v.Policy != nil {
+		form.Set(prefix+"Policy", *v.Policy)
+	}
+	if v.PrincipalARN != nil {
+		form.Set(prefix+"PrincipalArn", *v.PrincipalARN)
+	}
+	if v.RoleARN != nil {
+		form.Set(prefix+"RoleArn", *v.RoleARN)
+	}
+	if v.SAMLAssertion != nil {
+		form.Set(prefix+"SAMLAssertion", *v.SAMLAssertion)
+	}
+	return nil
+}
+
 // AssumeRoleWithSAMLResponse is undocumented.
 type AssumeRoleWithSAMLResponse struct {
 	AssumedRoleUser  *AssumedRoleUser `query:"AssumedRoleUser" xml:"AssumeRoleWithSAMLResult>AssumedRoleUser"`
@@ -357,6 +502,32 @@ type AssumeRoleWithWebIdentityRequest struct {
 	WebIdentityToken aws.StringValue  `query:"WebIdentityToken" xml:"WebIdentityToken"`
 }
 
+// MarshalQuery adds the parameters of the AssumeRoleWithWebIdentityRequest to the form, prefixing their names.
+func (v *AssumeRoleWithWebIdentityRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DurationSeconds != nil {
+		form.Set(prefix+"DurationSeconds", strconv.FormatInt(int64(*v.DurationSeconds), 10))
+	}
+	if v.Policy != nil {
+		form.Set(prefix+"Policy", *v.Policy)
+	}
+	if v.ProviderID != nil {
+		form.Set(prefix+"ProviderId", *v.ProviderID)
+	}
+	if v.RoleARN != nil {
+		form.Set(prefix+"RoleArn", *v.RoleARN)
+	}
+	if v.RoleSessionName != nil {
+		form.Set(prefix+"RoleSessionName", *v.RoleSessionName)
+	}
+	if v.WebIdentityToken != nil {
+		form.Set(prefix+"WebIdentityToken", *v.WebIdentityToken)
+	}
+	return nil
+}
+
 // AssumeRoleWithWebIdentityResponse is undocumented.
 type AssumeRoleWithWebIdentityResponse struct {
 	AssumedRoleUser             *AssumedRoleUser `query:"AssumedRoleUser" xml:"AssumeRoleWithWebIdentityResult>AssumedRoleUser"`
@@ -386,6 +557,17 @@ type DecodeAuthorizationMessageRequest struct {
 	EncodedMessage aws.StringValue `query:"EncodedMessage" xml:"EncodedMessage"`
 }
 
+// MarshalQuery adds the parameters of the DecodeAuthorizationMessageRequest to the form, prefixing their names.
+func (v *DecodeAuthorizationMessageRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.EncodedMessage != nil {
+		form.Set(prefix+"EncodedMessage", *v.EncodedMessage)
+	}
+	return nil
+}
+
 // DecodeAuthorizationMessageResponse is undocumented.
 type DecodeAuthorizationMessageResponse struct {
 	DecodedMessage aws.StringValue `query:"DecodedMessage" xml:"DecodeAuthorizationMessageResult>DecodedMessage"`
@@ -404,6 +586,23 @@ type GetFederationTokenRequest struct {
 	Policy          aws.StringValue  `query:"Policy" xml:"Policy"`
 }
 
+// MarshalQuery adds the parameters of the GetFederationTokenRequest to the form, prefixing their names.
+func (v *GetFederationTokenRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DurationSeconds != nil {
+		form.Set(prefix+"DurationSeconds", strconv.FormatInt(int64(*v.DurationSeconds), 10))
+	}
+	if v.Name != nil {
+		form.Set(prefix+"Name", *v.Name)
+	}
+	if v.Policy != nil {
+		form.Set(prefix+"Policy", *v.Policy)
+	}
+	return nil
+}
+
 // GetFederationTokenResponse is undocumented.
 type GetFederationTokenResponse struct {
 	Credentials      *Credentials     `query:"Credentials" xml:"GetFederationTokenResult>Credentials"`
@@ -418,6 +617,23 @@ type GetSessionTokenRequest struct {
 	TokenCode       aws.StringValue  `query:"TokenCode" xml:"TokenCode"`
 }
 
+// MarshalQuery adds the parameters of the GetSessionTokenRequest to the form, prefixing their names.
+func (v *GetSessionTokenRequest) MarshalQuery(form url.Values, prefix string) error {
+	if v == nil {
+		return nil
+	}
+	if v.DurationSeconds != nil {
+		form.Set(prefix+"DurationSeconds", strconv.FormatInt(int64(*v.DurationSeconds), 10))
+	}
+	if v.SerialNumber != nil {
+		form.Set(prefix+"SerialNumber", *v.SerialNumber)
+	}
+	if v.TokenCode != nil {
+		form.Set(prefix+"TokenCode", *v.TokenCode)
+	}
+	return nil
+}
+
 // GetSessionTokenResponse is undocumented.
 type GetSessionTokenResponse struct {
 	Credentials *Credentials `query:"Credentials" xml:"GetSessionTokenResult>Credentials"`
@@ -469,5 +685,57 @@ type GetSessionTokenResult struct {
 	Credentials *Credentials `query:"Credentials" xml:"GetSessionTokenResult>Credentials"`
 }
 
+// ExpiredTokenException is the error returned for the ExpiredTokenException error code.
+type ExpiredTokenException struct {
+	aws.APIError
+}
+
+// IDPCommunicationErrorException is the error returned for the IDPCommunicationError error code.
+type IDPCommunicationErrorException struct {
+	aws.APIError
+}
+
+// IDPRejectedClaimException is the error returned for the IDPRejectedClaim error code.
+type IDPRejectedClaimException struct {
+	aws.APIError
+}
+
+// InvalidAuthorizationMessageException is the error returned for the InvalidAuthorizationMessageException error code.
+type InvalidAuthorizationMessageException struct {
+	aws.APIError
+}
+
+// InvalidIdentityTokenException is the error returned for the InvalidIdentityToken error code.
+type InvalidIdentityTokenException struct {
+	aws.APIError
+}
+
+// MalformedPolicyDocumentException is the error returned for the MalformedPolicyDocument error code.
+type MalformedPolicyDocumentException struct {
+	aws.APIError
+}
+
+// PackedPolicyTooLargeException is the error returned for the PackedPolicyTooLarge error code.
+type PackedPolicyTooLargeException struct {
+	aws.APIError
+}
+
+// exceptions maps STS's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"ExpiredTokenException":                ExpiredTokenException{},
+	"IDPCommunicationError":                IDPCommunicationErrorException{},
+	"IDPRejectedClaim":                     IDPRejectedClaimException{},
+	"InvalidAuthorizationMessageException": InvalidAuthorizationMessageException{},
+	"InvalidIdentityToken":                 InvalidIdentityTokenException{},
+	"MalformedPolicyDocument":              MalformedPolicyDocumentException{},
+	"PackedPolicyTooLarge":                 PackedPolicyTooLargeException{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
@@ -4,11 +4,18 @@
 package support
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // Support is a client for AWS Support.
@@ -16,29 +23,64 @@ type Support struct {
 	client *aws.JSONClient
 }
 
-// New returns a new Support client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *Support {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "support"
-	endpoint, service, region := endpoints.Lookup("support", region)
+// New returns a new Support client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *Support {
+	cfg := newConfig(region, client, opts)
 
 	return &Support{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.1",
 			TargetPrefix: "AWSSupport_20130415",
 		},
 	}
 }
 
+// NewFromSession returns a new Support client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *Support {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("support", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("support", "support"),
+	}, "AWS_ENDPOINT_URL_SUPPORT", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *Support) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *Support) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // AddAttachmentsToSet adds one or more attachments to an attachment set.
 // If an AttachmentSetId is not specified, a new attachment set is created,
 // and the ID of the set is returned in the response. If an AttachmentSetId
@@ -50,8 +92,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *Sup
 // attachments in a set is 3, and the maximum size of any attachment in the
 // set is 5
 func (c *Support) AddAttachmentsToSet(req *AddAttachmentsToSetRequest) (resp *AddAttachmentsToSetResponse, err error) {
+	return c.AddAttachmentsToSetWithContext(context.Background(), req)
+}
+
+// AddAttachmentsToSetWithContext is like AddAttachmentsToSet, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) AddAttachmentsToSetWithContext(ctx context.Context, req *AddAttachmentsToSetRequest) (resp *AddAttachmentsToSetResponse, err error) {
 	resp = &AddAttachmentsToSetResponse{}
-	err = c.client.Do("AddAttachmentsToSet", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddAttachmentsToSet", "POST", "/", req, resp)
 	return
 }
 
@@ -63,8 +112,15 @@ func (c *Support) AddAttachmentsToSet(req *AddAttachmentsToSetRequest) (resp *Ad
 // success or failure of the request. This operation implements a subset of
 // the features of the AWS Support Center.
 func (c *Support) AddCommunicationToCase(req *AddCommunicationToCaseRequest) (resp *AddCommunicationToCaseResponse, err error) {
+	return c.AddCommunicationToCaseWithContext(context.Background(), req)
+}
+
+// AddCommunicationToCaseWithContext is like AddCommunicationToCase, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) AddCommunicationToCaseWithContext(ctx context.Context, req *AddCommunicationToCaseRequest) (resp *AddCommunicationToCaseResponse, err error) {
 	resp = &AddCommunicationToCaseResponse{}
-	err = c.client.Do("AddCommunicationToCase", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "AddCommunicationToCase", "POST", "/", req, resp)
 	return
 }
 
@@ -96,8 +152,15 @@ func (c *Support) AddCommunicationToCase(req *AddCommunicationToCaseRequest) (re
 // request returns an AWS Support case number. Case numbers are used by the
 // DescribeCases operation to retrieve existing AWS Support cases.
 func (c *Support) CreateCase(req *CreateCaseRequest) (resp *CreateCaseResponse, err error) {
+	return c.CreateCaseWithContext(context.Background(), req)
+}
+
+// CreateCaseWithContext is like CreateCase, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) CreateCaseWithContext(ctx context.Context, req *CreateCaseRequest) (resp *CreateCaseResponse, err error) {
 	resp = &CreateCaseResponse{}
-	err = c.client.Do("CreateCase", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CreateCase", "POST", "/", req, resp)
 	return
 }
 
@@ -107,8 +170,15 @@ func (c *Support) CreateCase(req *CreateCaseRequest) (resp *CreateCaseResponse,
 // returned in the AttachmentDetails objects that are returned by the
 // DescribeCommunications operation.
 func (c *Support) DescribeAttachment(req *DescribeAttachmentRequest) (resp *DescribeAttachmentResponse, err error) {
+	return c.DescribeAttachmentWithContext(context.Background(), req)
+}
+
+// DescribeAttachmentWithContext is like DescribeAttachment, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeAttachmentWithContext(ctx context.Context, req *DescribeAttachmentRequest) (resp *DescribeAttachmentResponse, err error) {
 	resp = &DescribeAttachmentResponse{}
-	err = c.client.Do("DescribeAttachment", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeAttachment", "POST", "/", req, resp)
 	return
 }
 
@@ -123,8 +193,15 @@ func (c *Support) DescribeAttachment(req *DescribeAttachmentRequest) (resp *Desc
 // or more NextToken values, which specify where to paginate the returned
 // records represented by the CaseDetails objects.
 func (c *Support) DescribeCases(req *DescribeCasesRequest) (resp *DescribeCasesResponse, err error) {
+	return c.DescribeCasesWithContext(context.Background(), req)
+}
+
+// DescribeCasesWithContext is like DescribeCases, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeCasesWithContext(ctx context.Context, req *DescribeCasesRequest) (resp *DescribeCasesResponse, err error) {
 	resp = &DescribeCasesResponse{}
-	err = c.client.Do("DescribeCases", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCases", "POST", "/", req, resp)
 	return
 }
 
@@ -138,8 +215,15 @@ func (c *Support) DescribeCases(req *DescribeCasesRequest) (resp *DescribeCasesR
 // MaxResults to the number of cases you want displayed on each page, and
 // use NextToken to specify the resumption of pagination.
 func (c *Support) DescribeCommunications(req *DescribeCommunicationsRequest) (resp *DescribeCommunicationsResponse, err error) {
+	return c.DescribeCommunicationsWithContext(context.Background(), req)
+}
+
+// DescribeCommunicationsWithContext is like DescribeCommunications, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeCommunicationsWithContext(ctx context.Context, req *DescribeCommunicationsRequest) (resp *DescribeCommunicationsResponse, err error) {
 	resp = &DescribeCommunicationsResponse{}
-	err = c.client.Do("DescribeCommunications", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeCommunications", "POST", "/", req, resp)
 	return
 }
 
@@ -155,8 +239,15 @@ func (c *Support) DescribeCommunications(req *DescribeCommunicationsRequest) (re
 // ensures that you always have the most recent set of service and category
 // codes.
 func (c *Support) DescribeServices(req *DescribeServicesRequest) (resp *DescribeServicesResponse, err error) {
+	return c.DescribeServicesWithContext(context.Background(), req)
+}
+
+// DescribeServicesWithContext is like DescribeServices, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeServicesWithContext(ctx context.Context, req *DescribeServicesRequest) (resp *DescribeServicesResponse, err error) {
 	resp = &DescribeServicesResponse{}
-	err = c.client.Do("DescribeServices", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeServices", "POST", "/", req, resp)
 	return
 }
 
@@ -164,8 +255,15 @@ func (c *Support) DescribeServices(req *DescribeServicesRequest) (resp *Describe
 // assign to an AWS Support case. The severity level for a case is also a
 // field in the CaseDetails data type included in any CreateCase request.
 func (c *Support) DescribeSeverityLevels(req *DescribeSeverityLevelsRequest) (resp *DescribeSeverityLevelsResponse, err error) {
+	return c.DescribeSeverityLevelsWithContext(context.Background(), req)
+}
+
+// DescribeSeverityLevelsWithContext is like DescribeSeverityLevels, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeSeverityLevelsWithContext(ctx context.Context, req *DescribeSeverityLevelsRequest) (resp *DescribeSeverityLevelsResponse, err error) {
 	resp = &DescribeSeverityLevelsResponse{}
-	err = c.client.Do("DescribeSeverityLevels", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeSeverityLevels", "POST", "/", req, resp)
 	return
 }
 
@@ -173,8 +271,15 @@ func (c *Support) DescribeSeverityLevels(req *DescribeSeverityLevelsRequest) (re
 // the Trusted Advisor checks that have the specified check IDs. Check IDs
 // can be obtained by calling DescribeTrustedAdvisorChecks
 func (c *Support) DescribeTrustedAdvisorCheckRefreshStatuses(req *DescribeTrustedAdvisorCheckRefreshStatusesRequest) (resp *DescribeTrustedAdvisorCheckRefreshStatusesResponse, err error) {
+	return c.DescribeTrustedAdvisorCheckRefreshStatusesWithContext(context.Background(), req)
+}
+
+// DescribeTrustedAdvisorCheckRefreshStatusesWithContext is like DescribeTrustedAdvisorCheckRefreshStatuses, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeTrustedAdvisorCheckRefreshStatusesWithContext(ctx context.Context, req *DescribeTrustedAdvisorCheckRefreshStatusesRequest) (resp *DescribeTrustedAdvisorCheckRefreshStatusesResponse, err error) {
 	resp = &DescribeTrustedAdvisorCheckRefreshStatusesResponse{}
-	err = c.client.Do("DescribeTrustedAdvisorCheckRefreshStatuses", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTrustedAdvisorCheckRefreshStatuses", "POST", "/", req, resp)
 	return
 }
 
@@ -187,8 +292,15 @@ func (c *Support) DescribeTrustedAdvisorCheckRefreshStatuses(req *DescribeTruste
 // "not_available". Timestamp. The time of the last refresh of the check.
 // CheckId. The unique identifier for the check.
 func (c *Support) DescribeTrustedAdvisorCheckResult(req *DescribeTrustedAdvisorCheckResultRequest) (resp *DescribeTrustedAdvisorCheckResultResponse, err error) {
+	return c.DescribeTrustedAdvisorCheckResultWithContext(context.Background(), req)
+}
+
+// DescribeTrustedAdvisorCheckResultWithContext is like DescribeTrustedAdvisorCheckResult, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeTrustedAdvisorCheckResultWithContext(ctx context.Context, req *DescribeTrustedAdvisorCheckResultRequest) (resp *DescribeTrustedAdvisorCheckResultResponse, err error) {
 	resp = &DescribeTrustedAdvisorCheckResultResponse{}
-	err = c.client.Do("DescribeTrustedAdvisorCheckResult", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTrustedAdvisorCheckResult", "POST", "/", req, resp)
 	return
 }
 
@@ -197,8 +309,15 @@ func (c *Support) DescribeTrustedAdvisorCheckResult(req *DescribeTrustedAdvisorC
 // Check IDs can be obtained by calling DescribeTrustedAdvisorChecks The
 // response contains an array of TrustedAdvisorCheckSummary objects.
 func (c *Support) DescribeTrustedAdvisorCheckSummaries(req *DescribeTrustedAdvisorCheckSummariesRequest) (resp *DescribeTrustedAdvisorCheckSummariesResponse, err error) {
+	return c.DescribeTrustedAdvisorCheckSummariesWithContext(context.Background(), req)
+}
+
+// DescribeTrustedAdvisorCheckSummariesWithContext is like DescribeTrustedAdvisorCheckSummaries, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeTrustedAdvisorCheckSummariesWithContext(ctx context.Context, req *DescribeTrustedAdvisorCheckSummariesRequest) (resp *DescribeTrustedAdvisorCheckSummariesResponse, err error) {
 	resp = &DescribeTrustedAdvisorCheckSummariesResponse{}
-	err = c.client.Do("DescribeTrustedAdvisorCheckSummaries", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTrustedAdvisorCheckSummaries", "POST", "/", req, resp)
 	return
 }
 
@@ -208,8 +327,15 @@ func (c *Support) DescribeTrustedAdvisorCheckSummaries(req *DescribeTrustedAdvis
 // ("ja") are currently supported. The response contains a
 // TrustedAdvisorCheckDescription for each check.
 func (c *Support) DescribeTrustedAdvisorChecks(req *DescribeTrustedAdvisorChecksRequest) (resp *DescribeTrustedAdvisorChecksResponse, err error) {
+	return c.DescribeTrustedAdvisorChecksWithContext(context.Background(), req)
+}
+
+// DescribeTrustedAdvisorChecksWithContext is like DescribeTrustedAdvisorChecks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) DescribeTrustedAdvisorChecksWithContext(ctx context.Context, req *DescribeTrustedAdvisorChecksRequest) (resp *DescribeTrustedAdvisorChecksResponse, err error) {
 	resp = &DescribeTrustedAdvisorChecksResponse{}
-	err = c.client.Do("DescribeTrustedAdvisorChecks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeTrustedAdvisorChecks", "POST", "/", req, resp)
 	return
 }
 
@@ -222,8 +348,15 @@ func (c *Support) DescribeTrustedAdvisorChecks(req *DescribeTrustedAdvisorChecks
 // amount of time, in milliseconds, until the check is eligible for
 // refresh. CheckId. The unique identifier for the check.
 func (c *Support) RefreshTrustedAdvisorCheck(req *RefreshTrustedAdvisorCheckRequest) (resp *RefreshTrustedAdvisorCheckResponse, err error) {
+	return c.RefreshTrustedAdvisorCheckWithContext(context.Background(), req)
+}
+
+// RefreshTrustedAdvisorCheckWithContext is like RefreshTrustedAdvisorCheck, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) RefreshTrustedAdvisorCheckWithContext(ctx context.Context, req *RefreshTrustedAdvisorCheckRequest) (resp *RefreshTrustedAdvisorCheckResponse, err error) {
 	resp = &RefreshTrustedAdvisorCheckResponse{}
-	err = c.client.Do("RefreshTrustedAdvisorCheck", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RefreshTrustedAdvisorCheck", "POST", "/", req, resp)
 	return
 }
 
@@ -231,8 +364,15 @@ func (c *Support) RefreshTrustedAdvisorCheck(req *RefreshTrustedAdvisorCheckRequ
 // along with the state of the case after the call to ResolveCase
 // completed.
 func (c *Support) ResolveCase(req *ResolveCaseRequest) (resp *ResolveCaseResponse, err error) {
+	return c.ResolveCaseWithContext(context.Background(), req)
+}
+
+// ResolveCaseWithContext is like ResolveCase, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *Support) ResolveCaseWithContext(ctx context.Context, req *ResolveCaseRequest) (resp *ResolveCaseResponse, err error) {
 	resp = &ResolveCaseResponse{}
-	err = c.client.Do("ResolveCase", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ResolveCase", "POST", "/", req, resp)
 	return
 }
 
@@ -242,6 +382,50 @@ type AddAttachmentsToSetRequest struct {
 	Attachments     []Attachment    `json:"attachments"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddAttachmentsToSetRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddAttachmentsToSetRequest to b.
+func (v *AddAttachmentsToSetRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AttachmentSetID != nil {
+		b = append(b, "\"attachmentSetId\":"...)
+		b = aws.AppendJSONString(b, *v.AttachmentSetID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"attachments\":"...)
+	if v.Attachments == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.Attachments {
+			b, err = v.Attachments[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddAttachmentsToSetResponse is undocumented.
 type AddAttachmentsToSetResponse struct {
 	AttachmentSetID aws.StringValue `json:"attachmentSetId,omitempty"`
@@ -256,6 +440,57 @@ type AddCommunicationToCaseRequest struct {
 	CommunicationBody aws.StringValue `json:"communicationBody"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *AddCommunicationToCaseRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the AddCommunicationToCaseRequest to b.
+func (v *AddCommunicationToCaseRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AttachmentSetID != nil {
+		b = append(b, "\"attachmentSetId\":"...)
+		b = aws.AppendJSONString(b, *v.AttachmentSetID)
+		b = append(b, ',')
+	}
+	if v.CaseID != nil {
+		b = append(b, "\"caseId\":"...)
+		b = aws.AppendJSONString(b, *v.CaseID)
+		b = append(b, ',')
+	}
+	if len(v.CCEmailAddresses) != 0 {
+		b = append(b, "\"ccEmailAddresses\":"...)
+		b = append(b, '[')
+		for i1 := range v.CCEmailAddresses {
+			b = aws.AppendJSONString(b, v.CCEmailAddresses[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"communicationBody\":"...)
+	if v.CommunicationBody == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CommunicationBody)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AddCommunicationToCaseResponse is undocumented.
 type AddCommunicationToCaseResponse struct {
 	Result aws.BooleanValue `json:"result,omitempty"`
@@ -267,6 +502,36 @@ type Attachment struct {
 	FileName aws.StringValue `json:"fileName,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Attachment) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Attachment to b.
+func (v *Attachment) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Data) != 0 {
+		b = append(b, "\"data\":"...)
+		b = aws.AppendJSONBytes(b, v.Data)
+		b = append(b, ',')
+	}
+	if v.FileName != nil {
+		b = append(b, "\"fileName\":"...)
+		b = aws.AppendJSONString(b, *v.FileName)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // AttachmentDetails is undocumented.
 type AttachmentDetails struct {
 	AttachmentID aws.StringValue `json:"attachmentId,omitempty"`
@@ -317,6 +582,84 @@ type CreateCaseRequest struct {
 	Subject           aws.StringValue `json:"subject"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CreateCaseRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CreateCaseRequest to b.
+func (v *CreateCaseRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AttachmentSetID != nil {
+		b = append(b, "\"attachmentSetId\":"...)
+		b = aws.AppendJSONString(b, *v.AttachmentSetID)
+		b = append(b, ',')
+	}
+	if v.CategoryCode != nil {
+		b = append(b, "\"categoryCode\":"...)
+		b = aws.AppendJSONString(b, *v.CategoryCode)
+		b = append(b, ',')
+	}
+	if len(v.CCEmailAddresses) != 0 {
+		b = append(b, "\"ccEmailAddresses\":"...)
+		b = append(b, '[')
+		for i1 := range v.CCEmailAddresses {
+			b = aws.AppendJSONString(b, v.CCEmailAddresses[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"communicationBody\":"...)
+	if v.CommunicationBody == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CommunicationBody)
+	}
+	b = append(b, ',')
+	if v.IssueType != nil {
+		b = append(b, "\"issueType\":"...)
+		b = aws.AppendJSONString(b, *v.IssueType)
+		b = append(b, ',')
+	}
+	if v.Language != nil {
+		b = append(b, "\"language\":"...)
+		b = aws.AppendJSONString(b, *v.Language)
+		b = append(b, ',')
+	}
+	if v.ServiceCode != nil {
+		b = append(b, "\"serviceCode\":"...)
+		b = aws.AppendJSONString(b, *v.ServiceCode)
+		b = append(b, ',')
+	}
+	if v.SeverityCode != nil {
+		b = append(b, "\"severityCode\":"...)
+		b = aws.AppendJSONString(b, *v.SeverityCode)
+		b = append(b, ',')
+	}
+	b = append(b, "\"subject\":"...)
+	if v.Subject == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Subject)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CreateCaseResponse is undocumented.
 type CreateCaseResponse struct {
 	CaseID aws.StringValue `json:"caseId,omitempty"`
@@ -327,6 +670,33 @@ type DescribeAttachmentRequest struct {
 	AttachmentID aws.StringValue `json:"attachmentId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeAttachmentRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeAttachmentRequest to b.
+func (v *DescribeAttachmentRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"attachmentId\":"...)
+	if v.AttachmentID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.AttachmentID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeAttachmentResponse is undocumented.
 type DescribeAttachmentResponse struct {
 	Attachment *Attachment `json:"attachment,omitempty"`
@@ -345,6 +715,80 @@ type DescribeCasesRequest struct {
 	NextToken             aws.StringValue  `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeCasesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeCasesRequest to b.
+func (v *DescribeCasesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AfterTime != nil {
+		b = append(b, "\"afterTime\":"...)
+		b = aws.AppendJSONString(b, *v.AfterTime)
+		b = append(b, ',')
+	}
+	if v.BeforeTime != nil {
+		b = append(b, "\"beforeTime\":"...)
+		b = aws.AppendJSONString(b, *v.BeforeTime)
+		b = append(b, ',')
+	}
+	if len(v.CaseIDList) != 0 {
+		b = append(b, "\"caseIdList\":"...)
+		b = append(b, '[')
+		for i1 := range v.CaseIDList {
+			b = aws.AppendJSONString(b, v.CaseIDList[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.DisplayID != nil {
+		b = append(b, "\"displayId\":"...)
+		b = aws.AppendJSONString(b, *v.DisplayID)
+		b = append(b, ',')
+	}
+	if v.IncludeCommunications != nil {
+		b = append(b, "\"includeCommunications\":"...)
+		b = strconv.AppendBool(b, *v.IncludeCommunications)
+		b = append(b, ',')
+	}
+	if v.IncludeResolvedCases != nil {
+		b = append(b, "\"includeResolvedCases\":"...)
+		b = strconv.AppendBool(b, *v.IncludeResolvedCases)
+		b = append(b, ',')
+	}
+	if v.Language != nil {
+		b = append(b, "\"language\":"...)
+		b = aws.AppendJSONString(b, *v.Language)
+		b = append(b, ',')
+	}
+	if v.MaxResults != nil {
+		b = append(b, "\"maxResults\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaxResults), 10)
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeCasesResponse is undocumented.
 type DescribeCasesResponse struct {
 	Cases     []CaseDetails   `json:"cases,omitempty"`
@@ -360,6 +804,53 @@ type DescribeCommunicationsRequest struct {
 	NextToken  aws.StringValue  `json:"nextToken,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeCommunicationsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeCommunicationsRequest to b.
+func (v *DescribeCommunicationsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.AfterTime != nil {
+		b = append(b, "\"afterTime\":"...)
+		b = aws.AppendJSONString(b, *v.AfterTime)
+		b = append(b, ',')
+	}
+	if v.BeforeTime != nil {
+		b = append(b, "\"beforeTime\":"...)
+		b = aws.AppendJSONString(b, *v.BeforeTime)
+		b = append(b, ',')
+	}
+	b = append(b, "\"caseId\":"...)
+	if v.CaseID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CaseID)
+	}
+	b = append(b, ',')
+	if v.MaxResults != nil {
+		b = append(b, "\"maxResults\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaxResults), 10)
+		b = append(b, ',')
+	}
+	if v.NextToken != nil {
+		b = append(b, "\"nextToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextToken)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeCommunicationsResponse is undocumented.
 type DescribeCommunicationsResponse struct {
 	Communications []Communication `json:"communications,omitempty"`
@@ -372,6 +863,45 @@ type DescribeServicesRequest struct {
 	ServiceCodeList []string        `json:"serviceCodeList,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeServicesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeServicesRequest to b.
+func (v *DescribeServicesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Language != nil {
+		b = append(b, "\"language\":"...)
+		b = aws.AppendJSONString(b, *v.Language)
+		b = append(b, ',')
+	}
+	if len(v.ServiceCodeList) != 0 {
+		b = append(b, "\"serviceCodeList\":"...)
+		b = append(b, '[')
+		for i1 := range v.ServiceCodeList {
+			b = aws.AppendJSONString(b, v.ServiceCodeList[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeServicesResponse is undocumented.
 type DescribeServicesResponse struct {
 	Services []Service `json:"services,omitempty"`
@@ -382,6 +912,31 @@ type DescribeSeverityLevelsRequest struct {
 	Language aws.StringValue `json:"language,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeSeverityLevelsRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeSeverityLevelsRequest to b.
+func (v *DescribeSeverityLevelsRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Language != nil {
+		b = append(b, "\"language\":"...)
+		b = aws.AppendJSONString(b, *v.Language)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeSeverityLevelsResponse is undocumented.
 type DescribeSeverityLevelsResponse struct {
 	SeverityLevels []SeverityLevel `json:"severityLevels,omitempty"`
@@ -392,6 +947,42 @@ type DescribeTrustedAdvisorCheckRefreshStatusesRequest struct {
 	CheckIDs []string `json:"checkIds"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTrustedAdvisorCheckRefreshStatusesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTrustedAdvisorCheckRefreshStatusesRequest to b.
+func (v *DescribeTrustedAdvisorCheckRefreshStatusesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"checkIds\":"...)
+	if v.CheckIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.CheckIDs {
+			b = aws.AppendJSONString(b, v.CheckIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTrustedAdvisorCheckRefreshStatusesResponse is undocumented.
 type DescribeTrustedAdvisorCheckRefreshStatusesResponse struct {
 	Statuses []TrustedAdvisorCheckRefreshStatus `json:"statuses"`
@@ -403,6 +994,38 @@ type DescribeTrustedAdvisorCheckResultRequest struct {
 	Language aws.StringValue `json:"language,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTrustedAdvisorCheckResultRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTrustedAdvisorCheckResultRequest to b.
+func (v *DescribeTrustedAdvisorCheckResultRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"checkId\":"...)
+	if v.CheckID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CheckID)
+	}
+	b = append(b, ',')
+	if v.Language != nil {
+		b = append(b, "\"language\":"...)
+		b = aws.AppendJSONString(b, *v.Language)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTrustedAdvisorCheckResultResponse is undocumented.
 type DescribeTrustedAdvisorCheckResultResponse struct {
 	Result *TrustedAdvisorCheckResult `json:"result,omitempty"`
@@ -413,6 +1036,42 @@ type DescribeTrustedAdvisorCheckSummariesRequest struct {
 	CheckIDs []string `json:"checkIds"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTrustedAdvisorCheckSummariesRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTrustedAdvisorCheckSummariesRequest to b.
+func (v *DescribeTrustedAdvisorCheckSummariesRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"checkIds\":"...)
+	if v.CheckIDs == nil {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, '[')
+		for i1 := range v.CheckIDs {
+			b = aws.AppendJSONString(b, v.CheckIDs[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTrustedAdvisorCheckSummariesResponse is undocumented.
 type DescribeTrustedAdvisorCheckSummariesResponse struct {
 	Summaries []TrustedAdvisorCheckSummary `json:"summaries"`
@@ -423,6 +1082,33 @@ type DescribeTrustedAdvisorChecksRequest struct {
 	Language aws.StringValue `json:"language"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeTrustedAdvisorChecksRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeTrustedAdvisorChecksRequest to b.
+func (v *DescribeTrustedAdvisorChecksRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"language\":"...)
+	if v.Language == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Language)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeTrustedAdvisorChecksResponse is undocumented.
 type DescribeTrustedAdvisorChecksResponse struct {
 	Checks []TrustedAdvisorCheckDescription `json:"checks"`
@@ -439,6 +1125,33 @@ type RefreshTrustedAdvisorCheckRequest struct {
 	CheckID aws.StringValue `json:"checkId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RefreshTrustedAdvisorCheckRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RefreshTrustedAdvisorCheckRequest to b.
+func (v *RefreshTrustedAdvisorCheckRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"checkId\":"...)
+	if v.CheckID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.CheckID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RefreshTrustedAdvisorCheckResponse is undocumented.
 type RefreshTrustedAdvisorCheckResponse struct {
 	Status *TrustedAdvisorCheckRefreshStatus `json:"status"`
@@ -449,6 +1162,31 @@ type ResolveCaseRequest struct {
 	CaseID aws.StringValue `json:"caseId,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ResolveCaseRequest) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ResolveCaseRequest to b.
+func (v *ResolveCaseRequest) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CaseID != nil {
+		b = append(b, "\"caseId\":"...)
+		b = aws.AppendJSONString(b, *v.CaseID)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ResolveCaseResponse is undocumented.
 type ResolveCaseResponse struct {
 	FinalCaseStatus   aws.StringValue `json:"finalCaseStatus,omitempty"`
@@ -532,5 +1270,66 @@ type TrustedAdvisorResourcesSummary struct {
 	ResourcesSuppressed aws.LongValue `json:"resourcesSuppressed"`
 }
 
+// AttachmentIDNotFound is the error returned for the AttachmentIdNotFound error code.
+type AttachmentIDNotFound struct {
+	aws.APIError
+}
+
+// AttachmentLimitExceeded is the error returned for the AttachmentLimitExceeded error code.
+type AttachmentLimitExceeded struct {
+	aws.APIError
+}
+
+// AttachmentSetExpired is the error returned for the AttachmentSetExpired error code.
+type AttachmentSetExpired struct {
+	aws.APIError
+}
+
+// AttachmentSetIDNotFound is the error returned for the AttachmentSetIdNotFound error code.
+type AttachmentSetIDNotFound struct {
+	aws.APIError
+}
+
+// AttachmentSetSizeLimitExceeded is the error returned for the AttachmentSetSizeLimitExceeded error code.
+type AttachmentSetSizeLimitExceeded struct {
+	aws.APIError
+}
+
+// CaseCreationLimitExceeded is the error returned for the CaseCreationLimitExceeded error code.
+type CaseCreationLimitExceeded struct {
+	aws.APIError
+}
+
+// CaseIDNotFound is the error returned for the CaseIdNotFound error code.
+type CaseIDNotFound struct {
+	aws.APIError
+}
+
+// DescribeAttachmentLimitExceeded is the error returned for the DescribeAttachmentLimitExceeded error code.
+type DescribeAttachmentLimitExceeded struct {
+	aws.APIError
+}
+
+// InternalServerError is the error returned for the InternalServerError error code.
+type InternalServerError struct {
+	aws.APIError
+}
+
+// exceptions maps Support's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"AttachmentIdNotFound":            AttachmentIDNotFound{},
+	"AttachmentLimitExceeded":         AttachmentLimitExceeded{},
+	"AttachmentSetExpired":            AttachmentSetExpired{},
+	"AttachmentSetIdNotFound":         AttachmentSetIDNotFound{},
+	"AttachmentSetSizeLimitExceeded":  AttachmentSetSizeLimitExceeded{},
+	"CaseCreationLimitExceeded":       CaseCreationLimitExceeded{},
+	"CaseIdNotFound":                  CaseIDNotFound{},
+	"DescribeAttachmentLimitExceeded": DescribeAttachmentLimitExceeded{},
+	"InternalServerError":             InternalServerError{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -4,11 +4,18 @@
 package swf
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/timesking/aws-go/aws"
 	"github.com/timesking/aws-go/gen/endpoints"
+	"github.com/timesking/aws-go/gen/retry"
+)
+
+import (
+	"sort"
+	"strconv"
 )
 
 // SWF is a client for Amazon Simple Workflow Service.
@@ -16,29 +23,64 @@ type SWF struct {
 	client *aws.JSONClient
 }
 
-// New returns a new SWF client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *SWF {
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	service := "swf"
-	endpoint, service, region := endpoints.Lookup("swf", region)
+// New returns a new SWF client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *SWF {
+	cfg := newConfig(region, client, opts)
 
 	return &SWF{
 		client: &aws.JSONClient{
 			Context: aws.Context{
 				Credentials: creds,
-				Service:     service,
-				Region:      region,
-			}, Client: client,
-			Endpoint:     endpoint,
+				Service:     cfg.SigningName,
+				Region:      cfg.SigningRegion,
+				UserAgent:   cfg.UserAgent,
+				Clock:       cfg.Clock,
+				Logger:      cfg.Logger,
+				LogLevel:    cfg.LogLevel,
+				Compression: cfg.Compression,
+			},
+			Client:       cfg.HTTPClient,
+			Endpoint:     cfg.Endpoint,
+			Retry:        cfg.Retry,
+			Exceptions:   exceptions,
 			JSONVersion:  "1.0",
 			TargetPrefix: "SimpleWorkflowService",
 		},
 	}
 }
 
+// NewFromSession returns a new SWF client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *SWF {
+	return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+	endpoint, service, region := endpoints.Lookup("swf", region)
+	return aws.NewConfig(aws.Config{
+		Endpoint:      endpoint,
+		SigningRegion: region,
+		SigningName:   service,
+		HTTPClient:    client,
+		Retry:         retry.Lookup("swf", "swf"),
+	}, "AWS_ENDPOINT_URL_SWF", opts...)
+}
+
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *SWF) SetRetryPolicy(p *aws.RetryPolicy) {
+	c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *SWF) Handlers() *aws.Handlers {
+	return c.client.Handlers()
+}
+
 // CountClosedWorkflowExecutions returns the number of closed workflow
 // executions within the given domain that meet the specified filtering
 // criteria. You can use IAM policies to control this action's access to
@@ -55,8 +97,15 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *SWF
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) CountClosedWorkflowExecutions(req *CountClosedWorkflowExecutionsInput) (resp *WorkflowExecutionCount, err error) {
+	return c.CountClosedWorkflowExecutionsWithContext(context.Background(), req)
+}
+
+// CountClosedWorkflowExecutionsWithContext is like CountClosedWorkflowExecutions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) CountClosedWorkflowExecutionsWithContext(ctx context.Context, req *CountClosedWorkflowExecutionsInput) (resp *WorkflowExecutionCount, err error) {
 	resp = &WorkflowExecutionCount{}
-	err = c.client.Do("CountClosedWorkflowExecutions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CountClosedWorkflowExecutions", "POST", "/", req, resp)
 	return
 }
 
@@ -76,8 +125,15 @@ func (c *SWF) CountClosedWorkflowExecutions(req *CountClosedWorkflowExecutionsIn
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) CountOpenWorkflowExecutions(req *CountOpenWorkflowExecutionsInput) (resp *WorkflowExecutionCount, err error) {
+	return c.CountOpenWorkflowExecutionsWithContext(context.Background(), req)
+}
+
+// CountOpenWorkflowExecutionsWithContext is like CountOpenWorkflowExecutions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) CountOpenWorkflowExecutionsWithContext(ctx context.Context, req *CountOpenWorkflowExecutionsInput) (resp *WorkflowExecutionCount, err error) {
 	resp = &WorkflowExecutionCount{}
-	err = c.client.Do("CountOpenWorkflowExecutions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CountOpenWorkflowExecutions", "POST", "/", req, resp)
 	return
 }
 
@@ -96,8 +152,15 @@ func (c *SWF) CountOpenWorkflowExecutions(req *CountOpenWorkflowExecutionsInput)
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) CountPendingActivityTasks(req *CountPendingActivityTasksInput) (resp *PendingTaskCount, err error) {
+	return c.CountPendingActivityTasksWithContext(context.Background(), req)
+}
+
+// CountPendingActivityTasksWithContext is like CountPendingActivityTasks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) CountPendingActivityTasksWithContext(ctx context.Context, req *CountPendingActivityTasksInput) (resp *PendingTaskCount, err error) {
 	resp = &PendingTaskCount{}
-	err = c.client.Do("CountPendingActivityTasks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CountPendingActivityTasks", "POST", "/", req, resp)
 	return
 }
 
@@ -116,8 +179,15 @@ func (c *SWF) CountPendingActivityTasks(req *CountPendingActivityTasksInput) (re
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) CountPendingDecisionTasks(req *CountPendingDecisionTasksInput) (resp *PendingTaskCount, err error) {
+	return c.CountPendingDecisionTasksWithContext(context.Background(), req)
+}
+
+// CountPendingDecisionTasksWithContext is like CountPendingDecisionTasks, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) CountPendingDecisionTasksWithContext(ctx context.Context, req *CountPendingDecisionTasksInput) (resp *PendingTaskCount, err error) {
 	resp = &PendingTaskCount{}
-	err = c.client.Do("CountPendingDecisionTasks", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "CountPendingDecisionTasks", "POST", "/", req, resp)
 	return
 }
 
@@ -137,8 +207,15 @@ func (c *SWF) CountPendingDecisionTasks(req *CountPendingDecisionTasksInput) (re
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) DeprecateActivityType(req *DeprecateActivityTypeInput) (err error) {
+	return c.DeprecateActivityTypeWithContext(context.Background(), req)
+}
+
+// DeprecateActivityTypeWithContext is like DeprecateActivityType, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) DeprecateActivityTypeWithContext(ctx context.Context, req *DeprecateActivityTypeInput) (err error) {
 	// NRE
-	err = c.client.Do("DeprecateActivityType", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeprecateActivityType", "POST", "/", req, nil)
 	return
 }
 
@@ -158,8 +235,15 @@ func (c *SWF) DeprecateActivityType(req *DeprecateActivityTypeInput) (err error)
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) DeprecateDomain(req *DeprecateDomainInput) (err error) {
+	return c.DeprecateDomainWithContext(context.Background(), req)
+}
+
+// DeprecateDomainWithContext is like DeprecateDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) DeprecateDomainWithContext(ctx context.Context, req *DeprecateDomainInput) (err error) {
 	// NRE
-	err = c.client.Do("DeprecateDomain", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeprecateDomain", "POST", "/", req, nil)
 	return
 }
 
@@ -180,8 +264,15 @@ func (c *SWF) DeprecateDomain(req *DeprecateDomainInput) (err error) {
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) DeprecateWorkflowType(req *DeprecateWorkflowTypeInput) (err error) {
+	return c.DeprecateWorkflowTypeWithContext(context.Background(), req)
+}
+
+// DeprecateWorkflowTypeWithContext is like DeprecateWorkflowType, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) DeprecateWorkflowTypeWithContext(ctx context.Context, req *DeprecateWorkflowTypeInput) (err error) {
 	// NRE
-	err = c.client.Do("DeprecateWorkflowType", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "DeprecateWorkflowType", "POST", "/", req, nil)
 	return
 }
 
@@ -200,8 +291,15 @@ func (c *SWF) DeprecateWorkflowType(req *DeprecateWorkflowTypeInput) (err error)
 // fails by throwing OperationNotPermitted . For details and example IAM
 // policies, see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) DescribeActivityType(req *DescribeActivityTypeInput) (resp *ActivityTypeDetail, err error) {
+	return c.DescribeActivityTypeWithContext(context.Background(), req)
+}
+
+// DescribeActivityTypeWithContext is like DescribeActivityType, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) DescribeActivityTypeWithContext(ctx context.Context, req *DescribeActivityTypeInput) (resp *ActivityTypeDetail, err error) {
 	resp = &ActivityTypeDetail{}
-	err = c.client.Do("DescribeActivityType", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeActivityType", "POST", "/", req, resp)
 	return
 }
 
@@ -217,8 +315,15 @@ func (c *SWF) DescribeActivityType(req *DescribeActivityTypeInput) (resp *Activi
 // details and example IAM policies, see Using IAM to Manage Access to
 // Amazon SWF Workflows
 func (c *SWF) DescribeDomain(req *DescribeDomainInput) (resp *DomainDetail, err error) {
+	return c.DescribeDomainWithContext(context.Background(), req)
+}
+
+// DescribeDomainWithContext is like DescribeDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) DescribeDomainWithContext(ctx context.Context, req *DescribeDomainInput) (resp *DomainDetail, err error) {
 	resp = &DomainDetail{}
-	err = c.client.Do("DescribeDomain", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeDomain", "POST", "/", req, resp)
 	return
 }
 
@@ -234,8 +339,15 @@ func (c *SWF) DescribeDomain(req *DescribeDomainInput) (resp *DomainDetail, err
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) DescribeWorkflowExecution(req *DescribeWorkflowExecutionInput) (resp *WorkflowExecutionDetail, err error) {
+	return c.DescribeWorkflowExecutionWithContext(context.Background(), req)
+}
+
+// DescribeWorkflowExecutionWithContext is like DescribeWorkflowExecution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) DescribeWorkflowExecutionWithContext(ctx context.Context, req *DescribeWorkflowExecutionInput) (resp *WorkflowExecutionDetail, err error) {
 	resp = &WorkflowExecutionDetail{}
-	err = c.client.Do("DescribeWorkflowExecution", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeWorkflowExecution", "POST", "/", req, resp)
 	return
 }
 
@@ -255,8 +367,15 @@ func (c *SWF) DescribeWorkflowExecution(req *DescribeWorkflowExecutionInput) (re
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) DescribeWorkflowType(req *DescribeWorkflowTypeInput) (resp *WorkflowTypeDetail, err error) {
+	return c.DescribeWorkflowTypeWithContext(context.Background(), req)
+}
+
+// DescribeWorkflowTypeWithContext is like DescribeWorkflowType, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) DescribeWorkflowTypeWithContext(ctx context.Context, req *DescribeWorkflowTypeInput) (resp *WorkflowTypeDetail, err error) {
 	resp = &WorkflowTypeDetail{}
-	err = c.client.Do("DescribeWorkflowType", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "DescribeWorkflowType", "POST", "/", req, resp)
 	return
 }
 
@@ -274,8 +393,15 @@ func (c *SWF) DescribeWorkflowType(req *DescribeWorkflowTypeInput) (resp *Workfl
 // details and example IAM policies, see Using IAM to Manage Access to
 // Amazon SWF Workflows
 func (c *SWF) GetWorkflowExecutionHistory(req *GetWorkflowExecutionHistoryInput) (resp *History, err error) {
+	return c.GetWorkflowExecutionHistoryWithContext(context.Background(), req)
+}
+
+// GetWorkflowExecutionHistoryWithContext is like GetWorkflowExecutionHistory, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) GetWorkflowExecutionHistoryWithContext(ctx context.Context, req *GetWorkflowExecutionHistoryInput) (resp *History, err error) {
 	resp = &History{}
-	err = c.client.Do("GetWorkflowExecutionHistory", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "GetWorkflowExecutionHistory", "POST", "/", req, resp)
 	return
 }
 
@@ -295,8 +421,15 @@ func (c *SWF) GetWorkflowExecutionHistory(req *GetWorkflowExecutionHistoryInput)
 // details and example IAM policies, see Using IAM to Manage Access to
 // Amazon SWF Workflows
 func (c *SWF) ListActivityTypes(req *ListActivityTypesInput) (resp *ActivityTypeInfos, err error) {
+	return c.ListActivityTypesWithContext(context.Background(), req)
+}
+
+// ListActivityTypesWithContext is like ListActivityTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) ListActivityTypesWithContext(ctx context.Context, req *ListActivityTypesInput) (resp *ActivityTypeInfos, err error) {
 	resp = &ActivityTypeInfos{}
-	err = c.client.Do("ListActivityTypes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListActivityTypes", "POST", "/", req, resp)
 	return
 }
 
@@ -318,8 +451,15 @@ func (c *SWF) ListActivityTypes(req *ListActivityTypesInput) (resp *ActivityType
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) ListClosedWorkflowExecutions(req *ListClosedWorkflowExecutionsInput) (resp *WorkflowExecutionInfos, err error) {
+	return c.ListClosedWorkflowExecutionsWithContext(context.Background(), req)
+}
+
+// ListClosedWorkflowExecutionsWithContext is like ListClosedWorkflowExecutions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) ListClosedWorkflowExecutionsWithContext(ctx context.Context, req *ListClosedWorkflowExecutionsInput) (resp *WorkflowExecutionInfos, err error) {
 	resp = &WorkflowExecutionInfos{}
-	err = c.client.Do("ListClosedWorkflowExecutions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListClosedWorkflowExecutions", "POST", "/", req, resp)
 	return
 }
 
@@ -338,8 +478,15 @@ func (c *SWF) ListClosedWorkflowExecutions(req *ListClosedWorkflowExecutionsInpu
 // details and example IAM policies, see Using IAM to Manage Access to
 // Amazon SWF Workflows
 func (c *SWF) ListDomains(req *ListDomainsInput) (resp *DomainInfos, err error) {
+	return c.ListDomainsWithContext(context.Background(), req)
+}
+
+// ListDomainsWithContext is like ListDomains, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) ListDomainsWithContext(ctx context.Context, req *ListDomainsInput) (resp *DomainInfos, err error) {
 	resp = &DomainInfos{}
-	err = c.client.Do("ListDomains", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListDomains", "POST", "/", req, resp)
 	return
 }
 
@@ -360,8 +507,15 @@ func (c *SWF) ListDomains(req *ListDomainsInput) (resp *DomainInfos, err error)
 // throwing OperationNotPermitted . For details and example IAM policies,
 // see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) ListOpenWorkflowExecutions(req *ListOpenWorkflowExecutionsInput) (resp *WorkflowExecutionInfos, err error) {
+	return c.ListOpenWorkflowExecutionsWithContext(context.Background(), req)
+}
+
+// ListOpenWorkflowExecutionsWithContext is like ListOpenWorkflowExecutions, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) ListOpenWorkflowExecutionsWithContext(ctx context.Context, req *ListOpenWorkflowExecutionsInput) (resp *WorkflowExecutionInfos, err error) {
 	resp = &WorkflowExecutionInfos{}
-	err = c.client.Do("ListOpenWorkflowExecutions", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListOpenWorkflowExecutions", "POST", "/", req, resp)
 	return
 }
 
@@ -378,8 +532,15 @@ func (c *SWF) ListOpenWorkflowExecutions(req *ListOpenWorkflowExecutionsInput) (
 // details and example IAM policies, see Using IAM to Manage Access to
 // Amazon SWF Workflows
 func (c *SWF) ListWorkflowTypes(req *ListWorkflowTypesInput) (resp *WorkflowTypeInfos, err error) {
+	return c.ListWorkflowTypesWithContext(context.Background(), req)
+}
+
+// ListWorkflowTypesWithContext is like ListWorkflowTypes, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) ListWorkflowTypesWithContext(ctx context.Context, req *ListWorkflowTypesInput) (resp *WorkflowTypeInfos, err error) {
 	resp = &WorkflowTypeInfos{}
-	err = c.client.Do("ListWorkflowTypes", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "ListWorkflowTypes", "POST", "/", req, resp)
 	return
 }
 
@@ -405,8 +566,15 @@ func (c *SWF) ListWorkflowTypes(req *ListWorkflowTypesInput) (resp *WorkflowType
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) PollForActivityTask(req *PollForActivityTaskInput) (resp *ActivityTask, err error) {
+	return c.PollForActivityTaskWithContext(context.Background(), req)
+}
+
+// PollForActivityTaskWithContext is like PollForActivityTask, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) PollForActivityTaskWithContext(ctx context.Context, req *PollForActivityTaskInput) (resp *ActivityTask, err error) {
 	resp = &ActivityTask{}
-	err = c.client.Do("PollForActivityTask", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PollForActivityTask", "POST", "/", req, resp)
 	return
 }
 
@@ -440,8 +608,15 @@ func (c *SWF) PollForActivityTask(req *PollForActivityTaskInput) (resp *Activity
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) PollForDecisionTask(req *PollForDecisionTaskInput) (resp *DecisionTask, err error) {
+	return c.PollForDecisionTaskWithContext(context.Background(), req)
+}
+
+// PollForDecisionTaskWithContext is like PollForDecisionTask, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) PollForDecisionTaskWithContext(ctx context.Context, req *PollForDecisionTaskInput) (resp *DecisionTask, err error) {
 	resp = &DecisionTask{}
-	err = c.client.Do("PollForDecisionTask", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "PollForDecisionTask", "POST", "/", req, resp)
 	return
 }
 
@@ -472,8 +647,15 @@ func (c *SWF) PollForDecisionTask(req *PollForDecisionTaskInput) (resp *Decision
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) RecordActivityTaskHeartbeat(req *RecordActivityTaskHeartbeatInput) (resp *ActivityTaskStatus, err error) {
+	return c.RecordActivityTaskHeartbeatWithContext(context.Background(), req)
+}
+
+// RecordActivityTaskHeartbeatWithContext is like RecordActivityTaskHeartbeat, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RecordActivityTaskHeartbeatWithContext(ctx context.Context, req *RecordActivityTaskHeartbeatInput) (resp *ActivityTaskStatus, err error) {
 	resp = &ActivityTaskStatus{}
-	err = c.client.Do("RecordActivityTaskHeartbeat", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "RecordActivityTaskHeartbeat", "POST", "/", req, resp)
 	return
 }
 
@@ -495,8 +677,15 @@ func (c *SWF) RecordActivityTaskHeartbeat(req *RecordActivityTaskHeartbeatInput)
 // details and example IAM policies, see Using IAM to Manage Access to
 // Amazon SWF Workflows
 func (c *SWF) RegisterActivityType(req *RegisterActivityTypeInput) (err error) {
+	return c.RegisterActivityTypeWithContext(context.Background(), req)
+}
+
+// RegisterActivityTypeWithContext is like RegisterActivityType, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RegisterActivityTypeWithContext(ctx context.Context, req *RegisterActivityTypeInput) (err error) {
 	// NRE
-	err = c.client.Do("RegisterActivityType", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RegisterActivityType", "POST", "/", req, nil)
 	return
 }
 
@@ -511,8 +700,15 @@ func (c *SWF) RegisterActivityType(req *RegisterActivityTypeInput) (err error) {
 // throwing OperationNotPermitted . For details and example IAM policies,
 // see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) RegisterDomain(req *RegisterDomainInput) (err error) {
+	return c.RegisterDomainWithContext(context.Background(), req)
+}
+
+// RegisterDomainWithContext is like RegisterDomain, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RegisterDomainWithContext(ctx context.Context, req *RegisterDomainInput) (err error) {
 	// NRE
-	err = c.client.Do("RegisterDomain", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RegisterDomain", "POST", "/", req, nil)
 	return
 }
 
@@ -534,8 +730,15 @@ func (c *SWF) RegisterDomain(req *RegisterDomainInput) (err error) {
 // fails by throwing OperationNotPermitted . For details and example IAM
 // policies, see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) RegisterWorkflowType(req *RegisterWorkflowTypeInput) (err error) {
+	return c.RegisterWorkflowTypeWithContext(context.Background(), req)
+}
+
+// RegisterWorkflowTypeWithContext is like RegisterWorkflowType, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RegisterWorkflowTypeWithContext(ctx context.Context, req *RegisterWorkflowTypeInput) (err error) {
 	// NRE
-	err = c.client.Do("RegisterWorkflowType", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RegisterWorkflowType", "POST", "/", req, nil)
 	return
 }
 
@@ -555,8 +758,15 @@ func (c *SWF) RegisterWorkflowType(req *RegisterWorkflowTypeInput) (err error) {
 // details and example IAM policies, see Using IAM to Manage Access to
 // Amazon SWF Workflows
 func (c *SWF) RequestCancelWorkflowExecution(req *RequestCancelWorkflowExecutionInput) (err error) {
+	return c.RequestCancelWorkflowExecutionWithContext(context.Background(), req)
+}
+
+// RequestCancelWorkflowExecutionWithContext is like RequestCancelWorkflowExecution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RequestCancelWorkflowExecutionWithContext(ctx context.Context, req *RequestCancelWorkflowExecutionInput) (err error) {
 	// NRE
-	err = c.client.Do("RequestCancelWorkflowExecution", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RequestCancelWorkflowExecution", "POST", "/", req, nil)
 	return
 }
 
@@ -582,8 +792,15 @@ func (c *SWF) RequestCancelWorkflowExecution(req *RequestCancelWorkflowExecution
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) RespondActivityTaskCanceled(req *RespondActivityTaskCanceledInput) (err error) {
+	return c.RespondActivityTaskCanceledWithContext(context.Background(), req)
+}
+
+// RespondActivityTaskCanceledWithContext is like RespondActivityTaskCanceled, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RespondActivityTaskCanceledWithContext(ctx context.Context, req *RespondActivityTaskCanceledInput) (err error) {
 	// NRE
-	err = c.client.Do("RespondActivityTaskCanceled", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RespondActivityTaskCanceled", "POST", "/", req, nil)
 	return
 }
 
@@ -609,8 +826,15 @@ func (c *SWF) RespondActivityTaskCanceled(req *RespondActivityTaskCanceledInput)
 // throwing OperationNotPermitted . For details and example IAM policies,
 // see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) RespondActivityTaskCompleted(req *RespondActivityTaskCompletedInput) (err error) {
+	return c.RespondActivityTaskCompletedWithContext(context.Background(), req)
+}
+
+// RespondActivityTaskCompletedWithContext is like RespondActivityTaskCompleted, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RespondActivityTaskCompletedWithContext(ctx context.Context, req *RespondActivityTaskCompletedInput) (err error) {
 	// NRE
-	err = c.client.Do("RespondActivityTaskCompleted", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RespondActivityTaskCompleted", "POST", "/", req, nil)
 	return
 }
 
@@ -632,8 +856,15 @@ func (c *SWF) RespondActivityTaskCompleted(req *RespondActivityTaskCompletedInpu
 // throwing OperationNotPermitted . For details and example IAM policies,
 // see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) RespondActivityTaskFailed(req *RespondActivityTaskFailedInput) (err error) {
+	return c.RespondActivityTaskFailedWithContext(context.Background(), req)
+}
+
+// RespondActivityTaskFailedWithContext is like RespondActivityTaskFailed, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RespondActivityTaskFailedWithContext(ctx context.Context, req *RespondActivityTaskFailedInput) (err error) {
 	// NRE
-	err = c.client.Do("RespondActivityTaskFailed", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RespondActivityTaskFailed", "POST", "/", req, nil)
 	return
 }
 
@@ -651,8 +882,15 @@ func (c *SWF) RespondActivityTaskFailed(req *RespondActivityTaskFailedInput) (er
 // including applying conditions to some parameters. For more information,
 // see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) RespondDecisionTaskCompleted(req *RespondDecisionTaskCompletedInput) (err error) {
+	return c.RespondDecisionTaskCompletedWithContext(context.Background(), req)
+}
+
+// RespondDecisionTaskCompletedWithContext is like RespondDecisionTaskCompleted, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) RespondDecisionTaskCompletedWithContext(ctx context.Context, req *RespondDecisionTaskCompletedInput) (err error) {
 	// NRE
-	err = c.client.Do("RespondDecisionTaskCompleted", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "RespondDecisionTaskCompleted", "POST", "/", req, nil)
 	return
 }
 
@@ -670,8 +908,15 @@ func (c *SWF) RespondDecisionTaskCompleted(req *RespondDecisionTaskCompletedInpu
 // throwing OperationNotPermitted . For details and example IAM policies,
 // see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) SignalWorkflowExecution(req *SignalWorkflowExecutionInput) (err error) {
+	return c.SignalWorkflowExecutionWithContext(context.Background(), req)
+}
+
+// SignalWorkflowExecutionWithContext is like SignalWorkflowExecution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) SignalWorkflowExecutionWithContext(ctx context.Context, req *SignalWorkflowExecutionInput) (err error) {
 	// NRE
-	err = c.client.Do("SignalWorkflowExecution", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "SignalWorkflowExecution", "POST", "/", req, nil)
 	return
 }
 
@@ -695,8 +940,15 @@ func (c *SWF) SignalWorkflowExecution(req *SignalWorkflowExecutionInput) (err er
 // OperationNotPermitted . For details and example IAM policies, see Using
 // IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) StartWorkflowExecution(req *StartWorkflowExecutionInput) (resp *Run, err error) {
+	return c.StartWorkflowExecutionWithContext(context.Background(), req)
+}
+
+// StartWorkflowExecutionWithContext is like StartWorkflowExecution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) StartWorkflowExecutionWithContext(ctx context.Context, req *StartWorkflowExecutionInput) (resp *Run, err error) {
 	resp = &Run{}
-	err = c.client.Do("StartWorkflowExecution", "POST", "/", req, resp)
+	err = c.client.DoWithContext(ctx, "StartWorkflowExecution", "POST", "/", req, resp)
 	return
 }
 
@@ -716,8 +968,15 @@ func (c *SWF) StartWorkflowExecution(req *StartWorkflowExecutionInput) (resp *Ru
 // throwing OperationNotPermitted . For details and example IAM policies,
 // see Using IAM to Manage Access to Amazon SWF Workflows
 func (c *SWF) TerminateWorkflowExecution(req *TerminateWorkflowExecutionInput) (err error) {
+	return c.TerminateWorkflowExecutionWithContext(context.Background(), req)
+}
+
+// TerminateWorkflowExecutionWithContext is like TerminateWorkflowExecution, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *SWF) TerminateWorkflowExecutionWithContext(ctx context.Context, req *TerminateWorkflowExecutionInput) (err error) {
 	// NRE
-	err = c.client.Do("TerminateWorkflowExecution", "POST", "/", req, nil)
+	err = c.client.DoWithContext(ctx, "TerminateWorkflowExecution", "POST", "/", req, nil)
 	return
 }
 
@@ -807,6 +1066,40 @@ type ActivityType struct {
 	Version aws.StringValue `json:"version"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ActivityType) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ActivityType to b.
+func (v *ActivityType) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"version\":"...)
+	if v.Version == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Version)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ActivityTypeConfiguration is undocumented.
 type ActivityTypeConfiguration struct {
 	DefaultTaskHeartbeatTimeout       aws.StringValue `json:"defaultTaskHeartbeatTimeout,omitempty"`
@@ -824,11 +1117,11 @@ type ActivityTypeDetail struct {
 
 // ActivityTypeInfo is undocumented.
 type ActivityTypeInfo struct {
-	ActivityType    *ActivityType      `json:"activityType"`
-	CreationDate    *aws.LongTimestamp `json:"creationDate"`
-	DeprecationDate *aws.LongTimestamp `json:"deprecationDate,omitempty"`
-	Description     aws.StringValue    `json:"description,omitempty"`
-	Status          aws.StringValue    `json:"status"`
+	ActivityType    *ActivityType       `json:"activityType"`
+	CreationDate    *aws.FloatTimestamp `json:"creationDate"`
+	DeprecationDate *aws.FloatTimestamp `json:"deprecationDate,omitempty"`
+	Description     aws.StringValue     `json:"description,omitempty"`
+	Status          aws.StringValue     `json:"status"`
 }
 
 // ActivityTypeInfos is undocumented.
@@ -842,6 +1135,33 @@ type CancelTimerDecisionAttributes struct {
 	TimerID aws.StringValue `json:"timerId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CancelTimerDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CancelTimerDecisionAttributes to b.
+func (v *CancelTimerDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"timerId\":"...)
+	if v.TimerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TimerID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	CancelTimerFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
@@ -860,6 +1180,31 @@ type CancelWorkflowExecutionDecisionAttributes struct {
 	Details aws.StringValue `json:"details,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CancelWorkflowExecutionDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CancelWorkflowExecutionDecisionAttributes to b.
+func (v *CancelWorkflowExecutionDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Details != nil {
+		b = append(b, "\"details\":"...)
+		b = aws.AppendJSONString(b, *v.Details)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	CancelWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
@@ -946,11 +1291,63 @@ type CloseStatusFilter struct {
 	Status aws.StringValue `json:"status"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CloseStatusFilter) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CloseStatusFilter to b.
+func (v *CloseStatusFilter) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"status\":"...)
+	if v.Status == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Status)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CompleteWorkflowExecutionDecisionAttributes is undocumented.
 type CompleteWorkflowExecutionDecisionAttributes struct {
 	Result aws.StringValue `json:"result,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CompleteWorkflowExecutionDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CompleteWorkflowExecutionDecisionAttributes to b.
+func (v *CompleteWorkflowExecutionDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Result != nil {
+		b = append(b, "\"result\":"...)
+		b = aws.AppendJSONString(b, *v.Result)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	CompleteWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
@@ -974,6 +1371,73 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	WorkflowTypeVersion          aws.StringValue `json:"workflowTypeVersion,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ContinueAsNewWorkflowExecutionDecisionAttributes to b.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ChildPolicy != nil {
+		b = append(b, "\"childPolicy\":"...)
+		b = aws.AppendJSONString(b, *v.ChildPolicy)
+		b = append(b, ',')
+	}
+	if v.ExecutionStartToCloseTimeout != nil {
+		b = append(b, "\"executionStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.ExecutionStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.Input != nil {
+		b = append(b, "\"input\":"...)
+		b = aws.AppendJSONString(b, *v.Input)
+		b = append(b, ',')
+	}
+	if len(v.TagList) != 0 {
+		b = append(b, "\"tagList\":"...)
+		b = append(b, '[')
+		for i1 := range v.TagList {
+			b = aws.AppendJSONString(b, v.TagList[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.TaskList != nil {
+		b = append(b, "\"taskList\":"...)
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TaskStartToCloseTimeout != nil {
+		b = append(b, "\"taskStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.TaskStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.WorkflowTypeVersion != nil {
+		b = append(b, "\"workflowTypeVersion\":"...)
+		b = aws.AppendJSONString(b, *v.WorkflowTypeVersion)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	ContinueAsNewWorkflowExecutionFailedCauseDefaultChildPolicyUndefined                  = "DEFAULT_CHILD_POLICY_UNDEFINED"
@@ -1003,6 +1467,81 @@ type CountClosedWorkflowExecutionsInput struct {
 	TypeFilter        *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CountClosedWorkflowExecutionsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CountClosedWorkflowExecutionsInput to b.
+func (v *CountClosedWorkflowExecutionsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CloseStatusFilter != nil {
+		b = append(b, "\"closeStatusFilter\":"...)
+		b, err = v.CloseStatusFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CloseTimeFilter != nil {
+		b = append(b, "\"closeTimeFilter\":"...)
+		b, err = v.CloseTimeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.ExecutionFilter != nil {
+		b = append(b, "\"executionFilter\":"...)
+		b, err = v.ExecutionFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.StartTimeFilter != nil {
+		b = append(b, "\"startTimeFilter\":"...)
+		b, err = v.StartTimeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TagFilter != nil {
+		b = append(b, "\"tagFilter\":"...)
+		b, err = v.TagFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TypeFilter != nil {
+		b = append(b, "\"typeFilter\":"...)
+		b, err = v.TypeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CountOpenWorkflowExecutionsInput is undocumented.
 type CountOpenWorkflowExecutionsInput struct {
 	Domain          aws.StringValue          `json:"domain"`
@@ -1012,18 +1551,153 @@ type CountOpenWorkflowExecutionsInput struct {
 	TypeFilter      *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CountOpenWorkflowExecutionsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CountOpenWorkflowExecutionsInput to b.
+func (v *CountOpenWorkflowExecutionsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.ExecutionFilter != nil {
+		b = append(b, "\"executionFilter\":"...)
+		b, err = v.ExecutionFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"startTimeFilter\":"...)
+	if v.StartTimeFilter == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.StartTimeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.TagFilter != nil {
+		b = append(b, "\"tagFilter\":"...)
+		b, err = v.TagFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TypeFilter != nil {
+		b = append(b, "\"typeFilter\":"...)
+		b, err = v.TypeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CountPendingActivityTasksInput is undocumented.
 type CountPendingActivityTasksInput struct {
 	Domain   aws.StringValue `json:"domain"`
 	TaskList *TaskList       `json:"taskList"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CountPendingActivityTasksInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CountPendingActivityTasksInput to b.
+func (v *CountPendingActivityTasksInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"taskList\":"...)
+	if v.TaskList == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // CountPendingDecisionTasksInput is undocumented.
 type CountPendingDecisionTasksInput struct {
 	Domain   aws.StringValue `json:"domain"`
 	TaskList *TaskList       `json:"taskList"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *CountPendingDecisionTasksInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the CountPendingDecisionTasksInput to b.
+func (v *CountPendingDecisionTasksInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"taskList\":"...)
+	if v.TaskList == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Decision is undocumented.
 type Decision struct {
 	CancelTimerDecisionAttributes                            *CancelTimerDecisionAttributes                            `json:"cancelTimerDecisionAttributes,omitempty"`
@@ -1041,6 +1715,129 @@ type Decision struct {
 	StartTimerDecisionAttributes                             *StartTimerDecisionAttributes                             `json:"startTimerDecisionAttributes,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *Decision) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the Decision to b.
+func (v *Decision) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CancelTimerDecisionAttributes != nil {
+		b = append(b, "\"cancelTimerDecisionAttributes\":"...)
+		b, err = v.CancelTimerDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CancelWorkflowExecutionDecisionAttributes != nil {
+		b = append(b, "\"cancelWorkflowExecutionDecisionAttributes\":"...)
+		b, err = v.CancelWorkflowExecutionDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CompleteWorkflowExecutionDecisionAttributes != nil {
+		b = append(b, "\"completeWorkflowExecutionDecisionAttributes\":"...)
+		b, err = v.CompleteWorkflowExecutionDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.ContinueAsNewWorkflowExecutionDecisionAttributes != nil {
+		b = append(b, "\"continueAsNewWorkflowExecutionDecisionAttributes\":"...)
+		b, err = v.ContinueAsNewWorkflowExecutionDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"decisionType\":"...)
+	if v.DecisionType == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.DecisionType)
+	}
+	b = append(b, ',')
+	if v.FailWorkflowExecutionDecisionAttributes != nil {
+		b = append(b, "\"failWorkflowExecutionDecisionAttributes\":"...)
+		b, err = v.FailWorkflowExecutionDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.RecordMarkerDecisionAttributes != nil {
+		b = append(b, "\"recordMarkerDecisionAttributes\":"...)
+		b, err = v.RecordMarkerDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.RequestCancelActivityTaskDecisionAttributes != nil {
+		b = append(b, "\"requestCancelActivityTaskDecisionAttributes\":"...)
+		b, err = v.RequestCancelActivityTaskDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
+		b = append(b, "\"requestCancelExternalWorkflowExecutionDecisionAttributes\":"...)
+		b, err = v.RequestCancelExternalWorkflowExecutionDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.ScheduleActivityTaskDecisionAttributes != nil {
+		b = append(b, "\"scheduleActivityTaskDecisionAttributes\":"...)
+		b, err = v.ScheduleActivityTaskDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.SignalExternalWorkflowExecutionDecisionAttributes != nil {
+		b = append(b, "\"signalExternalWorkflowExecutionDecisionAttributes\":"...)
+		b, err = v.SignalExternalWorkflowExecutionDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.StartChildWorkflowExecutionDecisionAttributes != nil {
+		b = append(b, "\"startChildWorkflowExecutionDecisionAttributes\":"...)
+		b, err = v.StartChildWorkflowExecutionDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.StartTimerDecisionAttributes != nil {
+		b = append(b, "\"startTimerDecisionAttributes\":"...)
+		b, err = v.StartTimerDecisionAttributes.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DecisionTask is undocumented.
 type DecisionTask struct {
 	Events                 []HistoryEvent     `json:"events"`
@@ -1105,40 +1902,279 @@ type DeprecateActivityTypeInput struct {
 	Domain       aws.StringValue `json:"domain"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeprecateActivityTypeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeprecateActivityTypeInput to b.
+func (v *DeprecateActivityTypeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"activityType\":"...)
+	if v.ActivityType == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ActivityType.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeprecateDomainInput is undocumented.
 type DeprecateDomainInput struct {
 	Name aws.StringValue `json:"name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeprecateDomainInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeprecateDomainInput to b.
+func (v *DeprecateDomainInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DeprecateWorkflowTypeInput is undocumented.
 type DeprecateWorkflowTypeInput struct {
 	Domain       aws.StringValue `json:"domain"`
 	WorkflowType *WorkflowType   `json:"workflowType"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DeprecateWorkflowTypeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DeprecateWorkflowTypeInput to b.
+func (v *DeprecateWorkflowTypeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowType\":"...)
+	if v.WorkflowType == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.WorkflowType.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeActivityTypeInput is undocumented.
 type DescribeActivityTypeInput struct {
 	ActivityType *ActivityType   `json:"activityType"`
 	Domain       aws.StringValue `json:"domain"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeActivityTypeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeActivityTypeInput to b.
+func (v *DescribeActivityTypeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"activityType\":"...)
+	if v.ActivityType == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ActivityType.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeDomainInput is undocumented.
 type DescribeDomainInput struct {
 	Name aws.StringValue `json:"name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeDomainInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeDomainInput to b.
+func (v *DescribeDomainInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeWorkflowExecutionInput is undocumented.
 type DescribeWorkflowExecutionInput struct {
 	Domain    aws.StringValue    `json:"domain"`
 	Execution *WorkflowExecution `json:"execution"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeWorkflowExecutionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeWorkflowExecutionInput to b.
+func (v *DescribeWorkflowExecutionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"execution\":"...)
+	if v.Execution == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Execution.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DescribeWorkflowTypeInput is undocumented.
 type DescribeWorkflowTypeInput struct {
 	Domain       aws.StringValue `json:"domain"`
 	WorkflowType *WorkflowType   `json:"workflowType"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *DescribeWorkflowTypeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the DescribeWorkflowTypeInput to b.
+func (v *DescribeWorkflowTypeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowType\":"...)
+	if v.WorkflowType == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.WorkflowType.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // DomainConfiguration is undocumented.
 type DomainConfiguration struct {
 	WorkflowExecutionRetentionPeriodInDays aws.StringValue `json:"workflowExecutionRetentionPeriodInDays"`
@@ -1222,8 +2258,46 @@ const (
 
 // ExecutionTimeFilter is undocumented.
 type ExecutionTimeFilter struct {
-	LatestDate *aws.LongTimestamp `json:"latestDate,omitempty"`
-	OldestDate *aws.LongTimestamp `json:"oldestDate"`
+	LatestDate *aws.FloatTimestamp `json:"latestDate,omitempty"`
+	OldestDate *aws.FloatTimestamp `json:"oldestDate"`
+}
+
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ExecutionTimeFilter) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ExecutionTimeFilter to b.
+func (v *ExecutionTimeFilter) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.LatestDate != nil {
+		b = append(b, "\"latestDate\":"...)
+		b, err = aws.AppendJSON(b, v.LatestDate)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"oldestDate\":"...)
+	if v.OldestDate == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = aws.AppendJSON(b, v.OldestDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
 }
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is undocumented.
@@ -1244,6 +2318,36 @@ type FailWorkflowExecutionDecisionAttributes struct {
 	Reason  aws.StringValue `json:"reason,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *FailWorkflowExecutionDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the FailWorkflowExecutionDecisionAttributes to b.
+func (v *FailWorkflowExecutionDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Details != nil {
+		b = append(b, "\"details\":"...)
+		b = aws.AppendJSONString(b, *v.Details)
+		b = append(b, ',')
+	}
+	if v.Reason != nil {
+		b = append(b, "\"reason\":"...)
+		b = aws.AppendJSONString(b, *v.Reason)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	FailWorkflowExecutionFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
@@ -1265,6 +2369,58 @@ type GetWorkflowExecutionHistoryInput struct {
 	ReverseOrder    aws.BooleanValue   `json:"reverseOrder,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *GetWorkflowExecutionHistoryInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the GetWorkflowExecutionHistoryInput to b.
+func (v *GetWorkflowExecutionHistoryInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"execution\":"...)
+	if v.Execution == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.Execution.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.MaximumPageSize != nil {
+		b = append(b, "\"maximumPageSize\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaximumPageSize), 10)
+		b = append(b, ',')
+	}
+	if v.NextPageToken != nil {
+		b = append(b, "\"nextPageToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextPageToken)
+		b = append(b, ',')
+	}
+	if v.ReverseOrder != nil {
+		b = append(b, "\"reverseOrder\":"...)
+		b = strconv.AppendBool(b, *v.ReverseOrder)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // History is undocumented.
 type History struct {
 	Events        []HistoryEvent  `json:"events"`
@@ -1295,7 +2451,7 @@ type HistoryEvent struct {
 	DecisionTaskStartedEventAttributes                             *DecisionTaskStartedEventAttributes                             `json:"decisionTaskStartedEventAttributes,omitempty"`
 	DecisionTaskTimedOutEventAttributes                            *DecisionTaskTimedOutEventAttributes                            `json:"decisionTaskTimedOutEventAttributes,omitempty"`
 	EventID                                                        aws.LongValue                                                   `json:"eventId"`
-	EventTimestamp                                                 *aws.LongTimestamp                                              `json:"eventTimestamp"`
+	EventTimestamp                                                 *aws.FloatTimestamp                                             `json:"eventTimestamp"`
 	EventType                                                      aws.StringValue                                                 `json:"eventType"`
 	ExternalWorkflowExecutionCancelRequestedEventAttributes        *ExternalWorkflowExecutionCancelRequestedEventAttributes        `json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
@@ -1335,6 +2491,60 @@ type ListActivityTypesInput struct {
 	ReverseOrder       aws.BooleanValue `json:"reverseOrder,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListActivityTypesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListActivityTypesInput to b.
+func (v *ListActivityTypesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.MaximumPageSize != nil {
+		b = append(b, "\"maximumPageSize\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaximumPageSize), 10)
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.NextPageToken != nil {
+		b = append(b, "\"nextPageToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextPageToken)
+		b = append(b, ',')
+	}
+	b = append(b, "\"registrationStatus\":"...)
+	if v.RegistrationStatus == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.RegistrationStatus)
+	}
+	b = append(b, ',')
+	if v.ReverseOrder != nil {
+		b = append(b, "\"reverseOrder\":"...)
+		b = strconv.AppendBool(b, *v.ReverseOrder)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListClosedWorkflowExecutionsInput is undocumented.
 type ListClosedWorkflowExecutionsInput struct {
 	CloseStatusFilter *CloseStatusFilter       `json:"closeStatusFilter,omitempty"`
@@ -1349,6 +2559,96 @@ type ListClosedWorkflowExecutionsInput struct {
 	TypeFilter        *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListClosedWorkflowExecutionsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListClosedWorkflowExecutionsInput to b.
+func (v *ListClosedWorkflowExecutionsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.CloseStatusFilter != nil {
+		b = append(b, "\"closeStatusFilter\":"...)
+		b, err = v.CloseStatusFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.CloseTimeFilter != nil {
+		b = append(b, "\"closeTimeFilter\":"...)
+		b, err = v.CloseTimeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.ExecutionFilter != nil {
+		b = append(b, "\"executionFilter\":"...)
+		b, err = v.ExecutionFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.MaximumPageSize != nil {
+		b = append(b, "\"maximumPageSize\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaximumPageSize), 10)
+		b = append(b, ',')
+	}
+	if v.NextPageToken != nil {
+		b = append(b, "\"nextPageToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextPageToken)
+		b = append(b, ',')
+	}
+	if v.ReverseOrder != nil {
+		b = append(b, "\"reverseOrder\":"...)
+		b = strconv.AppendBool(b, *v.ReverseOrder)
+		b = append(b, ',')
+	}
+	if v.StartTimeFilter != nil {
+		b = append(b, "\"startTimeFilter\":"...)
+		b, err = v.StartTimeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TagFilter != nil {
+		b = append(b, "\"tagFilter\":"...)
+		b, err = v.TagFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TypeFilter != nil {
+		b = append(b, "\"typeFilter\":"...)
+		b, err = v.TypeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListDomainsInput is undocumented.
 type ListDomainsInput struct {
 	MaximumPageSize    aws.IntegerValue `json:"maximumPageSize,omitempty"`
@@ -1357,6 +2657,48 @@ type ListDomainsInput struct {
 	ReverseOrder       aws.BooleanValue `json:"reverseOrder,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListDomainsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListDomainsInput to b.
+func (v *ListDomainsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.MaximumPageSize != nil {
+		b = append(b, "\"maximumPageSize\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaximumPageSize), 10)
+		b = append(b, ',')
+	}
+	if v.NextPageToken != nil {
+		b = append(b, "\"nextPageToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextPageToken)
+		b = append(b, ',')
+	}
+	b = append(b, "\"registrationStatus\":"...)
+	if v.RegistrationStatus == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.RegistrationStatus)
+	}
+	b = append(b, ',')
+	if v.ReverseOrder != nil {
+		b = append(b, "\"reverseOrder\":"...)
+		b = strconv.AppendBool(b, *v.ReverseOrder)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListOpenWorkflowExecutionsInput is undocumented.
 type ListOpenWorkflowExecutionsInput struct {
 	Domain          aws.StringValue          `json:"domain"`
@@ -1369,6 +2711,82 @@ type ListOpenWorkflowExecutionsInput struct {
 	TypeFilter      *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListOpenWorkflowExecutionsInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListOpenWorkflowExecutionsInput to b.
+func (v *ListOpenWorkflowExecutionsInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.ExecutionFilter != nil {
+		b = append(b, "\"executionFilter\":"...)
+		b, err = v.ExecutionFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.MaximumPageSize != nil {
+		b = append(b, "\"maximumPageSize\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaximumPageSize), 10)
+		b = append(b, ',')
+	}
+	if v.NextPageToken != nil {
+		b = append(b, "\"nextPageToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextPageToken)
+		b = append(b, ',')
+	}
+	if v.ReverseOrder != nil {
+		b = append(b, "\"reverseOrder\":"...)
+		b = strconv.AppendBool(b, *v.ReverseOrder)
+		b = append(b, ',')
+	}
+	b = append(b, "\"startTimeFilter\":"...)
+	if v.StartTimeFilter == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.StartTimeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.TagFilter != nil {
+		b = append(b, "\"tagFilter\":"...)
+		b, err = v.TagFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TypeFilter != nil {
+		b = append(b, "\"typeFilter\":"...)
+		b, err = v.TypeFilter.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // ListWorkflowTypesInput is undocumented.
 type ListWorkflowTypesInput struct {
 	Domain             aws.StringValue  `json:"domain"`
@@ -1379,6 +2797,60 @@ type ListWorkflowTypesInput struct {
 	ReverseOrder       aws.BooleanValue `json:"reverseOrder,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ListWorkflowTypesInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ListWorkflowTypesInput to b.
+func (v *ListWorkflowTypesInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.MaximumPageSize != nil {
+		b = append(b, "\"maximumPageSize\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaximumPageSize), 10)
+		b = append(b, ',')
+	}
+	if v.Name != nil {
+		b = append(b, "\"name\":"...)
+		b = aws.AppendJSONString(b, *v.Name)
+		b = append(b, ',')
+	}
+	if v.NextPageToken != nil {
+		b = append(b, "\"nextPageToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextPageToken)
+		b = append(b, ',')
+	}
+	b = append(b, "\"registrationStatus\":"...)
+	if v.RegistrationStatus == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.RegistrationStatus)
+	}
+	b = append(b, ',')
+	if v.ReverseOrder != nil {
+		b = append(b, "\"reverseOrder\":"...)
+		b = strconv.AppendBool(b, *v.ReverseOrder)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // MarkerRecordedEventAttributes is undocumented.
 type MarkerRecordedEventAttributes struct {
 	DecisionTaskCompletedEventID aws.LongValue   `json:"decisionTaskCompletedEventId"`
@@ -1399,6 +2871,48 @@ type PollForActivityTaskInput struct {
 	TaskList *TaskList       `json:"taskList"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PollForActivityTaskInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PollForActivityTaskInput to b.
+func (v *PollForActivityTaskInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.Identity != nil {
+		b = append(b, "\"identity\":"...)
+		b = aws.AppendJSONString(b, *v.Identity)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskList\":"...)
+	if v.TaskList == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // PollForDecisionTaskInput is undocumented.
 type PollForDecisionTaskInput struct {
 	Domain          aws.StringValue  `json:"domain"`
@@ -1409,18 +2923,139 @@ type PollForDecisionTaskInput struct {
 	TaskList        *TaskList        `json:"taskList"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *PollForDecisionTaskInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the PollForDecisionTaskInput to b.
+func (v *PollForDecisionTaskInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.Identity != nil {
+		b = append(b, "\"identity\":"...)
+		b = aws.AppendJSONString(b, *v.Identity)
+		b = append(b, ',')
+	}
+	if v.MaximumPageSize != nil {
+		b = append(b, "\"maximumPageSize\":"...)
+		b = strconv.AppendInt(b, int64(*v.MaximumPageSize), 10)
+		b = append(b, ',')
+	}
+	if v.NextPageToken != nil {
+		b = append(b, "\"nextPageToken\":"...)
+		b = aws.AppendJSONString(b, *v.NextPageToken)
+		b = append(b, ',')
+	}
+	if v.ReverseOrder != nil {
+		b = append(b, "\"reverseOrder\":"...)
+		b = strconv.AppendBool(b, *v.ReverseOrder)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskList\":"...)
+	if v.TaskList == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RecordActivityTaskHeartbeatInput is undocumented.
 type RecordActivityTaskHeartbeatInput struct {
 	Details   aws.StringValue `json:"details,omitempty"`
 	TaskToken aws.StringValue `json:"taskToken"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RecordActivityTaskHeartbeatInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RecordActivityTaskHeartbeatInput to b.
+func (v *RecordActivityTaskHeartbeatInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Details != nil {
+		b = append(b, "\"details\":"...)
+		b = aws.AppendJSONString(b, *v.Details)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskToken\":"...)
+	if v.TaskToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskToken)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RecordMarkerDecisionAttributes is undocumented.
 type RecordMarkerDecisionAttributes struct {
 	Details    aws.StringValue `json:"details,omitempty"`
 	MarkerName aws.StringValue `json:"markerName"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RecordMarkerDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RecordMarkerDecisionAttributes to b.
+func (v *RecordMarkerDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Details != nil {
+		b = append(b, "\"details\":"...)
+		b = aws.AppendJSONString(b, *v.Details)
+		b = append(b, ',')
+	}
+	b = append(b, "\"markerName\":"...)
+	if v.MarkerName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.MarkerName)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	RecordMarkerFailedCauseOperationNotPermitted = "OPERATION_NOT_PERMITTED"
@@ -1446,6 +3081,80 @@ type RegisterActivityTypeInput struct {
 	Version                           aws.StringValue `json:"version"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterActivityTypeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterActivityTypeInput to b.
+func (v *RegisterActivityTypeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DefaultTaskHeartbeatTimeout != nil {
+		b = append(b, "\"defaultTaskHeartbeatTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultTaskHeartbeatTimeout)
+		b = append(b, ',')
+	}
+	if v.DefaultTaskList != nil {
+		b = append(b, "\"defaultTaskList\":"...)
+		b, err = v.DefaultTaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.DefaultTaskScheduleToCloseTimeout != nil {
+		b = append(b, "\"defaultTaskScheduleToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultTaskScheduleToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.DefaultTaskScheduleToStartTimeout != nil {
+		b = append(b, "\"defaultTaskScheduleToStartTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultTaskScheduleToStartTimeout)
+		b = append(b, ',')
+	}
+	if v.DefaultTaskStartToCloseTimeout != nil {
+		b = append(b, "\"defaultTaskStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultTaskStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.Description != nil {
+		b = append(b, "\"description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"version\":"...)
+	if v.Version == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Version)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RegisterDomainInput is undocumented.
 type RegisterDomainInput struct {
 	Description                            aws.StringValue `json:"description,omitempty"`
@@ -1453,6 +3162,45 @@ type RegisterDomainInput struct {
 	WorkflowExecutionRetentionPeriodInDays aws.StringValue `json:"workflowExecutionRetentionPeriodInDays"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterDomainInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterDomainInput to b.
+func (v *RegisterDomainInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Description != nil {
+		b = append(b, "\"description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowExecutionRetentionPeriodInDays\":"...)
+	if v.WorkflowExecutionRetentionPeriodInDays == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowExecutionRetentionPeriodInDays)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RegisterWorkflowTypeInput is undocumented.
 type RegisterWorkflowTypeInput struct {
 	DefaultChildPolicy                  aws.StringValue `json:"defaultChildPolicy,omitempty"`
@@ -1465,6 +3213,75 @@ type RegisterWorkflowTypeInput struct {
 	Version                             aws.StringValue `json:"version"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RegisterWorkflowTypeInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RegisterWorkflowTypeInput to b.
+func (v *RegisterWorkflowTypeInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.DefaultChildPolicy != nil {
+		b = append(b, "\"defaultChildPolicy\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultChildPolicy)
+		b = append(b, ',')
+	}
+	if v.DefaultExecutionStartToCloseTimeout != nil {
+		b = append(b, "\"defaultExecutionStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultExecutionStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.DefaultTaskList != nil {
+		b = append(b, "\"defaultTaskList\":"...)
+		b, err = v.DefaultTaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.DefaultTaskStartToCloseTimeout != nil {
+		b = append(b, "\"defaultTaskStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.DefaultTaskStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.Description != nil {
+		b = append(b, "\"description\":"...)
+		b = aws.AppendJSONString(b, *v.Description)
+		b = append(b, ',')
+	}
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"version\":"...)
+	if v.Version == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Version)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	RegistrationStatusDeprecated = "DEPRECATED"
@@ -1476,6 +3293,33 @@ type RequestCancelActivityTaskDecisionAttributes struct {
 	ActivityID aws.StringValue `json:"activityId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RequestCancelActivityTaskDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RequestCancelActivityTaskDecisionAttributes to b.
+func (v *RequestCancelActivityTaskDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"activityId\":"...)
+	if v.ActivityID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ActivityID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	RequestCancelActivityTaskFailedCauseActivityIDUnknown     = "ACTIVITY_ID_UNKNOWN"
@@ -1496,6 +3340,43 @@ type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 	WorkflowID aws.StringValue `json:"workflowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RequestCancelExternalWorkflowExecutionDecisionAttributes to b.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Control != nil {
+		b = append(b, "\"control\":"...)
+		b = aws.AppendJSONString(b, *v.Control)
+		b = append(b, ',')
+	}
+	if v.RunID != nil {
+		b = append(b, "\"runId\":"...)
+		b = aws.AppendJSONString(b, *v.RunID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	RequestCancelExternalWorkflowExecutionFailedCauseOperationNotPermitted                              = "OPERATION_NOT_PERMITTED"
@@ -1528,18 +3409,121 @@ type RequestCancelWorkflowExecutionInput struct {
 	WorkflowID aws.StringValue `json:"workflowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RequestCancelWorkflowExecutionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RequestCancelWorkflowExecutionInput to b.
+func (v *RequestCancelWorkflowExecutionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.RunID != nil {
+		b = append(b, "\"runId\":"...)
+		b = aws.AppendJSONString(b, *v.RunID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RespondActivityTaskCanceledInput is undocumented.
 type RespondActivityTaskCanceledInput struct {
 	Details   aws.StringValue `json:"details,omitempty"`
 	TaskToken aws.StringValue `json:"taskToken"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RespondActivityTaskCanceledInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RespondActivityTaskCanceledInput to b.
+func (v *RespondActivityTaskCanceledInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Details != nil {
+		b = append(b, "\"details\":"...)
+		b = aws.AppendJSONString(b, *v.Details)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskToken\":"...)
+	if v.TaskToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskToken)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RespondActivityTaskCompletedInput is undocumented.
 type RespondActivityTaskCompletedInput struct {
 	Result    aws.StringValue `json:"result,omitempty"`
 	TaskToken aws.StringValue `json:"taskToken"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RespondActivityTaskCompletedInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RespondActivityTaskCompletedInput to b.
+func (v *RespondActivityTaskCompletedInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Result != nil {
+		b = append(b, "\"result\":"...)
+		b = aws.AppendJSONString(b, *v.Result)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskToken\":"...)
+	if v.TaskToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskToken)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RespondActivityTaskFailedInput is undocumented.
 type RespondActivityTaskFailedInput struct {
 	Details   aws.StringValue `json:"details,omitempty"`
@@ -1547,6 +3531,43 @@ type RespondActivityTaskFailedInput struct {
 	TaskToken aws.StringValue `json:"taskToken"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RespondActivityTaskFailedInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RespondActivityTaskFailedInput to b.
+func (v *RespondActivityTaskFailedInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Details != nil {
+		b = append(b, "\"details\":"...)
+		b = aws.AppendJSONString(b, *v.Details)
+		b = append(b, ',')
+	}
+	if v.Reason != nil {
+		b = append(b, "\"reason\":"...)
+		b = aws.AppendJSONString(b, *v.Reason)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskToken\":"...)
+	if v.TaskToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskToken)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // RespondDecisionTaskCompletedInput is undocumented.
 type RespondDecisionTaskCompletedInput struct {
 	Decisions        []Decision      `json:"decisions,omitempty"`
@@ -1554,6 +3575,55 @@ type RespondDecisionTaskCompletedInput struct {
 	TaskToken        aws.StringValue `json:"taskToken"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *RespondDecisionTaskCompletedInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the RespondDecisionTaskCompletedInput to b.
+func (v *RespondDecisionTaskCompletedInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if len(v.Decisions) != 0 {
+		b = append(b, "\"decisions\":"...)
+		b = append(b, '[')
+		for i1 := range v.Decisions {
+			b, err = v.Decisions[i1].appendJSON(b)
+			if err != nil {
+				return nil, err
+			}
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.ExecutionContext != nil {
+		b = append(b, "\"executionContext\":"...)
+		b = aws.AppendJSONString(b, *v.ExecutionContext)
+		b = append(b, ',')
+	}
+	b = append(b, "\"taskToken\":"...)
+	if v.TaskToken == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TaskToken)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Run is undocumented.
 type Run struct {
 	RunID aws.StringValue `json:"runId,omitempty"`
@@ -1572,6 +3642,81 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	TaskList               *TaskList       `json:"taskList,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *ScheduleActivityTaskDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the ScheduleActivityTaskDecisionAttributes to b.
+func (v *ScheduleActivityTaskDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"activityId\":"...)
+	if v.ActivityID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.ActivityID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"activityType\":"...)
+	if v.ActivityType == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.ActivityType.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if v.Control != nil {
+		b = append(b, "\"control\":"...)
+		b = aws.AppendJSONString(b, *v.Control)
+		b = append(b, ',')
+	}
+	if v.HeartbeatTimeout != nil {
+		b = append(b, "\"heartbeatTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.HeartbeatTimeout)
+		b = append(b, ',')
+	}
+	if v.Input != nil {
+		b = append(b, "\"input\":"...)
+		b = aws.AppendJSONString(b, *v.Input)
+		b = append(b, ',')
+	}
+	if v.ScheduleToCloseTimeout != nil {
+		b = append(b, "\"scheduleToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.ScheduleToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.ScheduleToStartTimeout != nil {
+		b = append(b, "\"scheduleToStartTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.ScheduleToStartTimeout)
+		b = append(b, ',')
+	}
+	if v.StartToCloseTimeout != nil {
+		b = append(b, "\"startToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.StartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.TaskList != nil {
+		b = append(b, "\"taskList\":"...)
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	ScheduleActivityTaskFailedCauseActivityCreationRateExceeded           = "ACTIVITY_CREATION_RATE_EXCEEDED"
@@ -1604,6 +3749,55 @@ type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	WorkflowID aws.StringValue `json:"workflowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SignalExternalWorkflowExecutionDecisionAttributes to b.
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Control != nil {
+		b = append(b, "\"control\":"...)
+		b = aws.AppendJSONString(b, *v.Control)
+		b = append(b, ',')
+	}
+	if v.Input != nil {
+		b = append(b, "\"input\":"...)
+		b = aws.AppendJSONString(b, *v.Input)
+		b = append(b, ',')
+	}
+	if v.RunID != nil {
+		b = append(b, "\"runId\":"...)
+		b = aws.AppendJSONString(b, *v.RunID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"signalName\":"...)
+	if v.SignalName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.SignalName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	SignalExternalWorkflowExecutionFailedCauseOperationNotPermitted                       = "OPERATION_NOT_PERMITTED"
@@ -1640,6 +3834,57 @@ type SignalWorkflowExecutionInput struct {
 	WorkflowID aws.StringValue `json:"workflowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *SignalWorkflowExecutionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the SignalWorkflowExecutionInput to b.
+func (v *SignalWorkflowExecutionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.Input != nil {
+		b = append(b, "\"input\":"...)
+		b = aws.AppendJSONString(b, *v.Input)
+		b = append(b, ',')
+	}
+	if v.RunID != nil {
+		b = append(b, "\"runId\":"...)
+		b = aws.AppendJSONString(b, *v.RunID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"signalName\":"...)
+	if v.SignalName == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.SignalName)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // StartChildWorkflowExecutionDecisionAttributes is undocumented.
 type StartChildWorkflowExecutionDecisionAttributes struct {
 	ChildPolicy                  aws.StringValue `json:"childPolicy,omitempty"`
@@ -1653,6 +3898,90 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	WorkflowType                 *WorkflowType   `json:"workflowType"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartChildWorkflowExecutionDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartChildWorkflowExecutionDecisionAttributes to b.
+func (v *StartChildWorkflowExecutionDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ChildPolicy != nil {
+		b = append(b, "\"childPolicy\":"...)
+		b = aws.AppendJSONString(b, *v.ChildPolicy)
+		b = append(b, ',')
+	}
+	if v.Control != nil {
+		b = append(b, "\"control\":"...)
+		b = aws.AppendJSONString(b, *v.Control)
+		b = append(b, ',')
+	}
+	if v.ExecutionStartToCloseTimeout != nil {
+		b = append(b, "\"executionStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.ExecutionStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.Input != nil {
+		b = append(b, "\"input\":"...)
+		b = aws.AppendJSONString(b, *v.Input)
+		b = append(b, ',')
+	}
+	if len(v.TagList) != 0 {
+		b = append(b, "\"tagList\":"...)
+		b = append(b, '[')
+		for i1 := range v.TagList {
+			b = aws.AppendJSONString(b, v.TagList[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.TaskList != nil {
+		b = append(b, "\"taskList\":"...)
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TaskStartToCloseTimeout != nil {
+		b = append(b, "\"taskStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.TaskStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowType\":"...)
+	if v.WorkflowType == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.WorkflowType.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	StartChildWorkflowExecutionFailedCauseChildCreationRateExceeded                    = "CHILD_CREATION_RATE_EXCEEDED"
@@ -1699,6 +4028,45 @@ type StartTimerDecisionAttributes struct {
 	TimerID            aws.StringValue `json:"timerId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartTimerDecisionAttributes) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartTimerDecisionAttributes to b.
+func (v *StartTimerDecisionAttributes) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.Control != nil {
+		b = append(b, "\"control\":"...)
+		b = aws.AppendJSONString(b, *v.Control)
+		b = append(b, ',')
+	}
+	b = append(b, "\"startToFireTimeout\":"...)
+	if v.StartToFireTimeout == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.StartToFireTimeout)
+	}
+	b = append(b, ',')
+	b = append(b, "\"timerId\":"...)
+	if v.TimerID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.TimerID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	StartTimerFailedCauseOpenTimersLimitExceeded   = "OPEN_TIMERS_LIMIT_EXCEEDED"
@@ -1727,16 +4095,156 @@ type StartWorkflowExecutionInput struct {
 	WorkflowType                 *WorkflowType   `json:"workflowType"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *StartWorkflowExecutionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the StartWorkflowExecutionInput to b.
+func (v *StartWorkflowExecutionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ChildPolicy != nil {
+		b = append(b, "\"childPolicy\":"...)
+		b = aws.AppendJSONString(b, *v.ChildPolicy)
+		b = append(b, ',')
+	}
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.ExecutionStartToCloseTimeout != nil {
+		b = append(b, "\"executionStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.ExecutionStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	if v.Input != nil {
+		b = append(b, "\"input\":"...)
+		b = aws.AppendJSONString(b, *v.Input)
+		b = append(b, ',')
+	}
+	if len(v.TagList) != 0 {
+		b = append(b, "\"tagList\":"...)
+		b = append(b, '[')
+		for i1 := range v.TagList {
+			b = aws.AppendJSONString(b, v.TagList[i1])
+			b = append(b, ',')
+		}
+		if b[len(b)-1] == ',' {
+			b[len(b)-1] = ']'
+		} else {
+			b = append(b, ']')
+		}
+		b = append(b, ',')
+	}
+	if v.TaskList != nil {
+		b = append(b, "\"taskList\":"...)
+		b, err = v.TaskList.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, ',')
+	}
+	if v.TaskStartToCloseTimeout != nil {
+		b = append(b, "\"taskStartToCloseTimeout\":"...)
+		b = aws.AppendJSONString(b, *v.TaskStartToCloseTimeout)
+		b = append(b, ',')
+	}
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowType\":"...)
+	if v.WorkflowType == nil {
+		b = append(b, "null"...)
+	} else {
+		b, err = v.WorkflowType.appendJSON(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TagFilter is undocumented.
 type TagFilter struct {
 	Tag aws.StringValue `json:"tag"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *TagFilter) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the TagFilter to b.
+func (v *TagFilter) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"tag\":"...)
+	if v.Tag == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Tag)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TaskList is undocumented.
 type TaskList struct {
 	Name aws.StringValue `json:"name"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *TaskList) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the TaskList to b.
+func (v *TaskList) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TerminateWorkflowExecutionInput is undocumented.
 type TerminateWorkflowExecutionInput struct {
 	ChildPolicy aws.StringValue `json:"childPolicy,omitempty"`
@@ -1747,6 +4255,60 @@ type TerminateWorkflowExecutionInput struct {
 	WorkflowID  aws.StringValue `json:"workflowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *TerminateWorkflowExecutionInput) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the TerminateWorkflowExecutionInput to b.
+func (v *TerminateWorkflowExecutionInput) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	if v.ChildPolicy != nil {
+		b = append(b, "\"childPolicy\":"...)
+		b = aws.AppendJSONString(b, *v.ChildPolicy)
+		b = append(b, ',')
+	}
+	if v.Details != nil {
+		b = append(b, "\"details\":"...)
+		b = aws.AppendJSONString(b, *v.Details)
+		b = append(b, ',')
+	}
+	b = append(b, "\"domain\":"...)
+	if v.Domain == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Domain)
+	}
+	b = append(b, ',')
+	if v.Reason != nil {
+		b = append(b, "\"reason\":"...)
+		b = aws.AppendJSONString(b, *v.Reason)
+		b = append(b, ',')
+	}
+	if v.RunID != nil {
+		b = append(b, "\"runId\":"...)
+		b = aws.AppendJSONString(b, *v.RunID)
+		b = append(b, ',')
+	}
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // TimerCanceledEventAttributes is undocumented.
 type TimerCanceledEventAttributes struct {
 	DecisionTaskCompletedEventID aws.LongValue   `json:"decisionTaskCompletedEventId"`
@@ -1774,6 +4336,40 @@ type WorkflowExecution struct {
 	WorkflowID aws.StringValue `json:"workflowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *WorkflowExecution) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the WorkflowExecution to b.
+func (v *WorkflowExecution) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"runId\":"...)
+	if v.RunID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.RunID)
+	}
+	b = append(b, ',')
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // Possible values for SWF.
 const (
 	WorkflowExecutionCancelRequestedCauseChildPolicyApplied = "CHILD_POLICY_APPLIED"
@@ -1829,7 +4425,7 @@ type WorkflowExecutionCount struct {
 type WorkflowExecutionDetail struct {
 	ExecutionConfiguration      *WorkflowExecutionConfiguration `json:"executionConfiguration"`
 	ExecutionInfo               *WorkflowExecutionInfo          `json:"executionInfo"`
-	LatestActivityTaskTimestamp *aws.LongTimestamp              `json:"latestActivityTaskTimestamp,omitempty"`
+	LatestActivityTaskTimestamp *aws.FloatTimestamp             `json:"latestActivityTaskTimestamp,omitempty"`
 	LatestExecutionContext      aws.StringValue                 `json:"latestExecutionContext,omitempty"`
 	OpenCounts                  *WorkflowExecutionOpenCounts    `json:"openCounts"`
 }
@@ -1846,17 +4442,44 @@ type WorkflowExecutionFilter struct {
 	WorkflowID aws.StringValue `json:"workflowId"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *WorkflowExecutionFilter) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the WorkflowExecutionFilter to b.
+func (v *WorkflowExecutionFilter) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"workflowId\":"...)
+	if v.WorkflowID == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.WorkflowID)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // WorkflowExecutionInfo is undocumented.
 type WorkflowExecutionInfo struct {
-	CancelRequested aws.BooleanValue   `json:"cancelRequested,omitempty"`
-	CloseStatus     aws.StringValue    `json:"closeStatus,omitempty"`
-	CloseTimestamp  *aws.LongTimestamp `json:"closeTimestamp,omitempty"`
-	Execution       *WorkflowExecution `json:"execution"`
-	ExecutionStatus aws.StringValue    `json:"executionStatus"`
-	Parent          *WorkflowExecution `json:"parent,omitempty"`
-	StartTimestamp  *aws.LongTimestamp `json:"startTimestamp"`
-	TagList         []string           `json:"tagList,omitempty"`
-	WorkflowType    *WorkflowType      `json:"workflowType"`
+	CancelRequested aws.BooleanValue    `json:"cancelRequested,omitempty"`
+	CloseStatus     aws.StringValue     `json:"closeStatus,omitempty"`
+	CloseTimestamp  *aws.FloatTimestamp `json:"closeTimestamp,omitempty"`
+	Execution       *WorkflowExecution  `json:"execution"`
+	ExecutionStatus aws.StringValue     `json:"executionStatus"`
+	Parent          *WorkflowExecution  `json:"parent,omitempty"`
+	StartTimestamp  *aws.FloatTimestamp `json:"startTimestamp"`
+	TagList         []string            `json:"tagList,omitempty"`
+	WorkflowType    *WorkflowType       `json:"workflowType"`
 }
 
 // WorkflowExecutionInfos is undocumented.
@@ -1927,6 +4550,40 @@ type WorkflowType struct {
 	Version aws.StringValue `json:"version"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *WorkflowType) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the WorkflowType to b.
+func (v *WorkflowType) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	b = append(b, "\"version\":"...)
+	if v.Version == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Version)
+	}
+	b = append(b, ',')
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // WorkflowTypeConfiguration is undocumented.
 type WorkflowTypeConfiguration struct {
 	DefaultChildPolicy                  aws.StringValue `json:"defaultChildPolicy,omitempty"`
@@ -1947,13 +4604,45 @@ type WorkflowTypeFilter struct {
 	Version aws.StringValue `json:"version,omitempty"`
 }
 
+// MarshalJSON implements json.Marshaler without reflection.
+func (v *WorkflowTypeFilter) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return v.appendJSON(make([]byte, 0, 256))
+}
+
+// appendJSON appends the JSON encoding of the WorkflowTypeFilter to b.
+func (v *WorkflowTypeFilter) appendJSON(b []byte) ([]byte, error) {
+	var err error
+	b = append(b, '{')
+	b = append(b, "\"name\":"...)
+	if v.Name == nil {
+		b = append(b, "null"...)
+	} else {
+		b = aws.AppendJSONString(b, *v.Name)
+	}
+	b = append(b, ',')
+	if v.Version != nil {
+		b = append(b, "\"version\":"...)
+		b = aws.AppendJSONString(b, *v.Version)
+		b = append(b, ',')
+	}
+	if b[len(b)-1] == ',' {
+		b[len(b)-1] = '}'
+	} else {
+		b = append(b, '}')
+	}
+	return b, err
+}
+
 // WorkflowTypeInfo is undocumented.
 type WorkflowTypeInfo struct {
-	CreationDate    *aws.LongTimestamp `json:"creationDate"`
-	DeprecationDate *aws.LongTimestamp `json:"deprecationDate,omitempty"`
-	Description     aws.StringValue    `json:"description,omitempty"`
-	Status          aws.StringValue    `json:"status"`
-	WorkflowType    *WorkflowType      `json:"workflowType"`
+	CreationDate    *aws.FloatTimestamp `json:"creationDate"`
+	DeprecationDate *aws.FloatTimestamp `json:"deprecationDate,omitempty"`
+	Description     aws.StringValue     `json:"description,omitempty"`
+	Status          aws.StringValue     `json:"status"`
+	WorkflowType    *WorkflowType       `json:"workflowType"`
 }
 
 // WorkflowTypeInfos is undocumented.
@@ -1962,5 +4651,66 @@ type WorkflowTypeInfos struct {
 	TypeInfos     []WorkflowTypeInfo `json:"typeInfos"`
 }
 
+// DefaultUndefinedFault is the error returned for the DefaultUndefinedFault error code.
+type DefaultUndefinedFault struct {
+	aws.APIError
+}
+
+// DomainAlreadyExistsFault is the error returned for the DomainAlreadyExistsFault error code.
+type DomainAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// DomainDeprecatedFault is the error returned for the DomainDeprecatedFault error code.
+type DomainDeprecatedFault struct {
+	aws.APIError
+}
+
+// LimitExceededFault is the error returned for the LimitExceededFault error code.
+type LimitExceededFault struct {
+	aws.APIError
+}
+
+// OperationNotPermittedFault is the error returned for the OperationNotPermittedFault error code.
+type OperationNotPermittedFault struct {
+	aws.APIError
+}
+
+// TypeAlreadyExistsFault is the error returned for the TypeAlreadyExistsFault error code.
+type TypeAlreadyExistsFault struct {
+	aws.APIError
+}
+
+// TypeDeprecatedFault is the error returned for the TypeDeprecatedFault error code.
+type TypeDeprecatedFault struct {
+	aws.APIError
+}
+
+// UnknownResourceFault is the error returned for the UnknownResourceFault error code.
+type UnknownResourceFault struct {
+	aws.APIError
+}
+
+// WorkflowExecutionAlreadyStartedFault is the error returned for the WorkflowExecutionAlreadyStartedFault error code.
+type WorkflowExecutionAlreadyStartedFault struct {
+	aws.APIError
+}
+
+// exceptions maps SWF's error codes to its typed exceptions.
+var exceptions = aws.Exceptions{
+	"DefaultUndefinedFault":                DefaultUndefinedFault{},
+	"DomainAlreadyExistsFault":             DomainAlreadyExistsFault{},
+	"DomainDeprecatedFault":                DomainDeprecatedFault{},
+	"LimitExceededFault":                   LimitExceededFault{},
+	"OperationNotPermittedFault":           OperationNotPermittedFault{},
+	"TypeAlreadyExistsFault":               TypeAlreadyExistsFault{},
+	"TypeDeprecatedFault":                  TypeDeprecatedFault{},
+	"UnknownResourceFault":                 UnknownResourceFault{},
+	"WorkflowExecutionAlreadyStartedFault": WorkflowExecutionAlreadyStartedFault{},
+}
+
 // avoid errors if the packages aren't referenced
 var _ time.Time
+
+var _ = sort.Strings
+var _ = strconv.AppendInt
@@ -0,0 +1,78 @@
+package internal_test
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/dynamodb"
+	"github.com/timesking/aws-go/gen/s3"
+)
+
+func TestS3DeleteObjectsContentMD5(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sum := md5.Sum(body)
+			if v, want := r.Header.Get("Content-MD5"), base64.StdEncoding.EncodeToString(sum[:]); v != want {
+				t.Errorf("Content-MD5 was %q but expected %q", v, want)
+			}
+			fmt.Fprintln(w, `<DeleteResult></DeleteResult>`)
+		},
+	))
+	defer server.Close()
+
+	client := s3.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	_, err := client.DeleteObjects(&s3.DeleteObjectsRequest{
+		Bucket: aws.String("dogs"),
+		Delete: &s3.Delete{Objects: []s3.ObjectIdentifier{{Key: aws.String("spot")}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDynamoDBRetriesCRC32Mismatch(t *testing.T) {
+	var m sync.Mutex
+	var attempts int
+
+	const body = `{"TableNames":["dogs"]}`
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			m.Lock()
+			defer m.Unlock()
+
+			attempts++
+			w.Header().Set("x-amz-crc32", fmt.Sprint(crc32.ChecksumIEEE([]byte(body))))
+			if attempts == 1 {
+				fmt.Fprint(w, `{"TableNames":["cats"]}`)
+				return
+			}
+			fmt.Fprint(w, body)
+		},
+	))
+	defer server.Close()
+
+	client := dynamodb.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	resp, err := client.ListTables(&dynamodb.ListTablesInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := fmt.Sprint(resp.TableNames), "[dogs]"; v != want {
+		t.Errorf("Table names were %s but expected %s", v, want)
+	}
+	if v, want := attempts, 2; v != want {
+		t.Errorf("Made %d attempts but expected %d", v, want)
+	}
+}
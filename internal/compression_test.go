@@ -0,0 +1,73 @@
+package internal_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/s3"
+)
+
+func gzipped(t *testing.T, s string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestS3CompressedResponses(t *testing.T) {
+	object := gzipped(t, "a compressed object")
+	listing := gzipped(t, `<ListBucketResult><Name>dogs</Name></ListBucketResult>`)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			if r.URL.Path == "/dogs/spot.gz" {
+				if v, want := r.Header.Get("Accept-Encoding"), "identity"; v != want {
+					t.Errorf("Accept-Encoding was %q but expected %q", v, want)
+				}
+				_, _ = w.Write(object)
+				return
+			}
+			_, _ = w.Write(listing)
+		},
+	))
+	defer server.Close()
+
+	client := s3.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL), aws.WithCompression())
+
+	list, err := client.ListObjects(&s3.ListObjectsRequest{Bucket: aws.String("dogs")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := list.Name; v == nil || *v != "dogs" {
+		t.Errorf("Name was %v but expected dogs", v)
+	}
+
+	// objects stored compressed are streamed as they're stored
+	resp, err := client.GetObject(&s3.GetObjectRequest{Bucket: aws.String("dogs"), Key: aws.String("spot.gz")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(body, object) {
+		t.Errorf("Object was %q but expected %q", body, object)
+	}
+	if v := resp.ContentEncoding; v == nil || *v != "gzip" {
+		t.Errorf("ContentEncoding was %v but expected gzip", v)
+	}
+}
@@ -0,0 +1,48 @@
+package internal_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/dynamodb"
+)
+
+func TestDynamoDBConditionalCheckFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(400)
+			fmt.Fprintln(w, `{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException","message":"The conditional request failed"}`)
+		},
+	))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := dynamodb.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil)
+	client.Handlers().Build.PushBack(aws.Handler{
+		Name: "test.Endpoint",
+		Fn: func(r *aws.Request) {
+			r.HTTPRequest.URL.Scheme = u.Scheme
+			r.HTTPRequest.URL.Host = u.Host
+			r.HTTPRequest.Host = u.Host
+		},
+	})
+
+	_, err = client.PutItem(&dynamodb.PutItemInput{TableName: aws.String("dogs")})
+
+	if !errors.As(err, &dynamodb.ConditionalCheckFailedException{}) {
+		t.Fatalf("Error was %#v but expected a ConditionalCheckFailedException", err)
+	}
+
+	if v, want := err.Error(), "dynamodb/PutItem: ConditionalCheckFailedException: The conditional request failed (status 400)"; v != want {
+		t.Errorf("Error was %v but expected %v", v, want)
+	}
+}
@@ -0,0 +1,238 @@
+package internal_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/cloudwatch"
+	"github.com/timesking/aws-go/gen/dynamodb"
+	"github.com/timesking/aws-go/gen/ec2"
+	"github.com/timesking/aws-go/gen/kinesis"
+	"github.com/timesking/aws-go/gen/opsworks"
+	"github.com/timesking/aws-go/gen/sqs"
+)
+
+// The reflective types have the fields of the generated request shapes, but
+// not their marshalers, so they're serialized by reflection.
+type (
+	reflectiveSendMessageBatchRequest  sqs.SendMessageBatchRequest
+	reflectiveGetMetricStatisticsInput cloudwatch.GetMetricStatisticsInput
+	reflectiveDescribeInstancesRequest ec2.DescribeInstancesRequest
+	reflectivePutRecordInput           kinesis.PutRecordInput
+	reflectiveAttributeValue           dynamodb.AttributeValue
+	reflectiveAutoScalingThresholds    opsworks.AutoScalingThresholds
+)
+
+var sendMessageBatchRequest = &sqs.SendMessageBatchRequest{
+	QueueURL: aws.String("https://queue.amazonaws.com/123456789012/queue"),
+	Entries: []sqs.SendMessageBatchRequestEntry{
+		{
+			ID:           aws.String("1"),
+			MessageBody:  aws.String("hello & <goodbye>"),
+			DelaySeconds: aws.Integer(10),
+			MessageAttributes: map[string]sqs.MessageAttributeValue{
+				"b": {DataType: aws.String("Binary"), BinaryValue: []byte{0, 1, 2}},
+				"a": {DataType: aws.String("String"), StringValue: aws.String("x")},
+				"c": {DataType: aws.String("String"), StringListValues: []string{}},
+			},
+		},
+		{
+			ID:          aws.String("2"),
+			MessageBody: aws.String(""),
+			MessageAttributes: map[string]sqs.MessageAttributeValue{
+				"l": {DataType: aws.String("Binary"), BinaryListValues: [][]byte{{1}, {}}},
+			},
+		},
+	},
+}
+
+var getMetricStatisticsInput = &cloudwatch.GetMetricStatisticsInput{
+	Namespace:  aws.String("AWS/EC2"),
+	MetricName: aws.String("CPUUtilization"),
+	Dimensions: []cloudwatch.Dimension{{Name: aws.String("InstanceId"), Value: aws.String("i-1")}},
+	StartTime:  time.Date(2015, 1, 25, 8, 0, 0, 0, time.FixedZone("PST", -8*60*60)),
+	Period:     aws.Integer(60),
+	Statistics: []string{"Average", "Maximum"},
+}
+
+var describeInstancesRequest = &ec2.DescribeInstancesRequest{
+	DryRun:      aws.False(),
+	InstanceIDs: []string{},
+	Filters: []ec2.Filter{
+		{Name: aws.String("instance-state-name"), Values: []string{"running", "pending"}},
+		{Name: aws.String("tag:Name"), Values: []string{"web"}},
+	},
+	MaxResults: aws.Integer(100),
+}
+
+// build returns the body of the request the client's Build handlers make for
+// the parameters.
+func build(t testing.TB, h *aws.Handlers, params interface{}) string {
+	r := &aws.Request{Operation: "Op", HTTPMethod: "POST", HTTPPath: "/", Params: params}
+	h.Build.Run(r)
+	if r.Error != nil {
+		t.Fatal(r.Error)
+	}
+	body, err := ioutil.ReadAll(r.HTTPRequest.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestGeneratedQueryMarshalers(t *testing.T) {
+	c := &aws.QueryClient{Endpoint: "https://example.com", APIVersion: "2012-11-05"}
+
+	tests := []struct {
+		generated, reflective interface{}
+	}{
+		{sendMessageBatchRequest, (*reflectiveSendMessageBatchRequest)(sendMessageBatchRequest)},
+		{&sqs.SendMessageBatchRequest{}, &reflectiveSendMessageBatchRequest{}},
+		{(*sqs.SendMessageBatchRequest)(nil), (*reflectiveSendMessageBatchRequest)(nil)},
+		{getMetricStatisticsInput, (*reflectiveGetMetricStatisticsInput)(getMetricStatisticsInput)},
+	}
+
+	for _, test := range tests {
+		generated, reflective := build(t, c.Handlers(), test.generated), build(t, c.Handlers(), test.reflective)
+		if generated != reflective {
+			t.Errorf("%T was serialized as\n%s\nbut expected\n%s", test.generated, generated, reflective)
+		}
+	}
+}
+
+func TestGeneratedEC2Marshalers(t *testing.T) {
+	c := &aws.EC2Client{Endpoint: "https://example.com", APIVersion: "2014-10-01"}
+
+	tests := []struct {
+		generated, reflective interface{}
+	}{
+		{describeInstancesRequest, (*reflectiveDescribeInstancesRequest)(describeInstancesRequest)},
+		{&ec2.DescribeInstancesRequest{}, &reflectiveDescribeInstancesRequest{}},
+	}
+
+	for _, test := range tests {
+		generated, reflective := build(t, c.Handlers(), test.generated), build(t, c.Handlers(), test.reflective)
+		if generated != reflective {
+			t.Errorf("%T was serialized as\n%s\nbut expected\n%s", test.generated, generated, reflective)
+		}
+	}
+}
+
+func TestGeneratedJSONMarshalers(t *testing.T) {
+	nested := &dynamodb.AttributeValue{
+		L: []dynamodb.AttributeValue{{S: aws.String("a")}, {NULL: aws.True()}},
+		M: map[string]dynamodb.AttributeValue{"z": {N: aws.String("1")}, "y": {}},
+	}
+
+	tests := []struct {
+		generated  json.Marshaler
+		reflective interface{}
+	}{
+		{
+			&kinesis.PutRecordInput{
+				Data:         []byte("data"),
+				PartitionKey: aws.String("<\"key\"> & \\ \n\t\x01 \u2028\u2029 \xff \u00e9"),
+				StreamName:   aws.String("stream"),
+			},
+			nil,
+		},
+		{&kinesis.PutRecordInput{}, nil},
+		{&kinesis.PutRecordInput{Data: []byte{}, ExplicitHashKey: aws.String("")}, nil},
+		{(*kinesis.PutRecordInput)(nil), nil},
+		{
+			&dynamodb.AttributeValue{
+				B:    []byte{0xff},
+				BOOL: aws.False(),
+				BS:   [][]byte{nil, {}, {1}},
+				NS:   []string{"1", "2.5"},
+				SS:   []string{},
+			},
+			nil,
+		},
+		{nested, (*reflectiveAttributeValue)(nested)},
+		{
+			&opsworks.AutoScalingThresholds{
+				CPUThreshold:    aws.Double(0.5),
+				LoadThreshold:   aws.Double(1e21),
+				MemoryThreshold: aws.Double(1e-7),
+				InstanceCount:   aws.Integer(-3),
+			},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		// scalar-only values are converted to their reflective types
+		if test.reflective == nil {
+			switch v := test.generated.(type) {
+			case *kinesis.PutRecordInput:
+				test.reflective = (*reflectivePutRecordInput)(v)
+			case *dynamodb.AttributeValue:
+				test.reflective = (*reflectiveAttributeValue)(v)
+			case *opsworks.AutoScalingThresholds:
+				test.reflective = (*reflectiveAutoScalingThresholds)(v)
+			}
+		}
+
+		generated, err := test.generated.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		reflective, err := json.Marshal(test.reflective)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(generated) != string(reflective) {
+			t.Errorf("%T was encoded as\n%s\nbut expected\n%s", test.generated, generated, reflective)
+		}
+	}
+}
+
+func BenchmarkQueryMarshal(b *testing.B) {
+	c := &aws.QueryClient{Endpoint: "https://example.com", APIVersion: "2012-11-05"}
+	b.Run("reflective", func(b *testing.B) {
+		benchmarkBuild(b, c.Handlers(), (*reflectiveSendMessageBatchRequest)(sendMessageBatchRequest))
+	})
+	b.Run("generated", func(b *testing.B) {
+		benchmarkBuild(b, c.Handlers(), sendMessageBatchRequest)
+	})
+}
+
+func BenchmarkEC2Marshal(b *testing.B) {
+	c := &aws.EC2Client{Endpoint: "https://example.com", APIVersion: "2014-10-01"}
+	b.Run("reflective", func(b *testing.B) {
+		benchmarkBuild(b, c.Handlers(), (*reflectiveDescribeInstancesRequest)(describeInstancesRequest))
+	})
+	b.Run("generated", func(b *testing.B) {
+		benchmarkBuild(b, c.Handlers(), describeInstancesRequest)
+	})
+}
+
+func BenchmarkJSONMarshal(b *testing.B) {
+	c := &aws.JSONClient{Endpoint: "https://example.com", JSONVersion: "1.1", TargetPrefix: "Kinesis_20131202"}
+	req := &kinesis.PutRecordInput{
+		Data:         []byte("a record of some length, which is base64 encoded"),
+		PartitionKey: aws.String("partition-key"),
+		StreamName:   aws.String("stream"),
+	}
+	b.Run("reflective", func(b *testing.B) {
+		benchmarkBuild(b, c.Handlers(), (*reflectivePutRecordInput)(req))
+	})
+	b.Run("generated", func(b *testing.B) {
+		benchmarkBuild(b, c.Handlers(), req)
+	})
+}
+
+func benchmarkBuild(b *testing.B, h *aws.Handlers, params interface{}) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := &aws.Request{Operation: "Op", HTTPMethod: "POST", HTTPPath: "/", Params: params}
+		h.Build.Run(r)
+		if r.Error != nil {
+			b.Fatal(r.Error)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package internal_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/s3"
+	"github.com/timesking/aws-go/gen/sqs"
+)
+
+func TestSQSResponseMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, `<ListQueuesResponse><ListQueuesResult><QueueUrl>http://localhost/dogs</QueueUrl></ListQueuesResult><ResponseMetadata><RequestId>725275ae-0b9b-4762-b238-436d7c65a1ac</RequestId></ResponseMetadata></ListQueuesResponse>`)
+		},
+	))
+	defer server.Close()
+
+	client := sqs.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+
+	var md aws.ResponseMetadata
+	ctx := aws.WithResponseMetadata(context.Background(), &md)
+	resp, err := client.ListQueuesWithContext(ctx, &sqs.ListQueuesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := len(resp.QueueURLs), 1; v != want {
+		t.Errorf("Response had %d queues but expected %d", v, want)
+	}
+
+	if v, want := md.RequestID, "725275ae-0b9b-4762-b238-436d7c65a1ac"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+}
+
+func TestS3ResponseMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Amz-Request-Id", "318BC8BC148832E5")
+			w.Header().Set("X-Amz-Id-2", "eftixk72aD6Ap51TnqcoF8eFidJG9Z/2mkiDFu8yU9AS1ed4OpIszj7UDNEHGran")
+		},
+	))
+	defer server.Close()
+
+	client := s3.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+
+	var md aws.ResponseMetadata
+	ctx := aws.WithResponseMetadata(context.Background(), &md)
+	if err := client.HeadBucketWithContext(ctx, &s3.HeadBucketRequest{Bucket: aws.String("dogs")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := md.RequestID, "318BC8BC148832E5"; v != want {
+		t.Errorf("Request ID was %v but expected %v", v, want)
+	}
+
+	if v, want := md.ExtendedRequestID, "eftixk72aD6Ap51TnqcoF8eFidJG9Z/2mkiDFu8yU9AS1ed4OpIszj7UDNEHGran"; v != want {
+		t.Errorf("Extended request ID was %v but expected %v", v, want)
+	}
+}
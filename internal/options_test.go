@@ -0,0 +1,95 @@
+package internal_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/dynamodb"
+)
+
+func TestDynamoDBOptions(t *testing.T) {
+	var userAgent, authorization string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			userAgent = r.Header.Get("User-Agent")
+			authorization = r.Header.Get("Authorization")
+			fmt.Fprintln(w, `{"TableNames":[]}`)
+		},
+	))
+	defer server.Close()
+
+	client := dynamodb.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil,
+		aws.WithEndpoint(server.URL),
+		aws.WithSigningRegion("eu-west-1"),
+		aws.WithUserAgent("myapp/1.0"),
+	)
+
+	if _, err := client.ListTables(&dynamodb.ListTablesInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := userAgent, "aws-go myapp/1.0"; v != want {
+		t.Errorf("User agent was %v but expected %v", v, want)
+	}
+
+	if v, want := authorization, "/eu-west-1/dynamodb/aws4_request"; !strings.Contains(v, want) {
+		t.Errorf("Authorization was %v but expected it to contain %v", v, want)
+	}
+}
+
+func TestDynamoDBEndpointEnv(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			fmt.Fprintln(w, `{"TableNames":[]}`)
+		},
+	))
+	defer server.Close()
+
+	t.Setenv("AWS_ENDPOINT_URL_DYNAMODB", server.URL)
+
+	client := dynamodb.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil)
+	if _, err := client.ListTables(&dynamodb.ListTablesInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := requests, 1; v != want {
+		t.Errorf("Server got %d requests but expected %d", v, want)
+	}
+}
+
+func TestDynamoDBFromSession(t *testing.T) {
+	var userAgent, authorization string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			userAgent = r.Header.Get("User-Agent")
+			authorization = r.Header.Get("Authorization")
+			fmt.Fprintln(w, `{"TableNames":[]}`)
+		},
+	))
+	defer server.Close()
+
+	s := &aws.Session{
+		Credentials: aws.Creds("accessKeyID", "secretAccessKey", ""),
+		Region:      "us-west-2",
+		Options:     []aws.Option{aws.WithUserAgent("myapp/1.0")},
+	}
+
+	client := dynamodb.NewFromSession(s.ForRegion("ap-southeast-2"), aws.WithEndpoint(server.URL))
+	if _, err := client.ListTables(&dynamodb.ListTablesInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := userAgent, "aws-go myapp/1.0"; v != want {
+		t.Errorf("User agent was %v but expected %v", v, want)
+	}
+
+	if v, want := authorization, "/ap-southeast-2/dynamodb/aws4_request"; !strings.Contains(v, want) {
+		t.Errorf("Authorization was %v but expected it to contain %v", v, want)
+	}
+}
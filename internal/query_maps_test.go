@@ -0,0 +1,100 @@
+package internal_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/sns"
+	"github.com/timesking/aws-go/gen/sqs"
+)
+
+// respond returns a server which responds to every request with the body.
+func respond(body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, body)
+		},
+	))
+}
+
+func TestSQSFlattenedMaps(t *testing.T) {
+	server := respond(`<GetQueueAttributesResponse><GetQueueAttributesResult>
+  <Attribute><Name>VisibilityTimeout</Name><Value>30</Value></Attribute>
+  <Attribute><Name>DelaySeconds</Name><Value>0</Value></Attribute>
+</GetQueueAttributesResult></GetQueueAttributesResponse>`)
+	defer server.Close()
+
+	client := sqs.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	resp, err := client.GetQueueAttributes(&sqs.GetQueueAttributesRequest{QueueURL: aws.String(server.URL)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.Attributes, (sqs.AttributeMap{"VisibilityTimeout": "30", "DelaySeconds": "0"}); !reflect.DeepEqual(v, want) {
+		t.Errorf("Attributes were %v but expected %v", v, want)
+	}
+}
+
+func TestSQSMessageAttributes(t *testing.T) {
+	server := respond(`<ReceiveMessageResponse><ReceiveMessageResult>
+  <Message>
+    <MessageId>5fea7756-0ea4-451a-a703-a558b933e274</MessageId>
+    <Body>hello</Body>
+    <Attribute><Name>SenderId</Name><Value>195004372649</Value></Attribute>
+    <MessageAttribute>
+      <Name>color</Name>
+      <Value><StringValue>red</StringValue><DataType>String</DataType></Value>
+    </MessageAttribute>
+    <MessageAttribute>
+      <Name>size</Name>
+      <Value><StringValue>2</StringValue><DataType>Number</DataType></Value>
+    </MessageAttribute>
+  </Message>
+</ReceiveMessageResult></ReceiveMessageResponse>`)
+	defer server.Close()
+
+	client := sqs.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	resp, err := client.ReceiveMessage(&sqs.ReceiveMessageRequest{QueueURL: aws.String(server.URL)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := len(resp.Messages), 1; v != want {
+		t.Fatalf("Response had %d messages but expected %d", v, want)
+	}
+
+	m := resp.Messages[0]
+	if v, want := m.Attributes, (sqs.AttributeMap{"SenderId": "195004372649"}); !reflect.DeepEqual(v, want) {
+		t.Errorf("Attributes were %v but expected %v", v, want)
+	}
+
+	want := sqs.MessageAttributeMap{
+		"color": {StringValue: aws.String("red"), DataType: aws.String("String")},
+		"size":  {StringValue: aws.String("2"), DataType: aws.String("Number")},
+	}
+	if v := m.MessageAttributes; !reflect.DeepEqual(v, want) {
+		t.Errorf("Message attributes were %v but expected %v", v, want)
+	}
+}
+
+func TestSNSWrappedMaps(t *testing.T) {
+	server := respond(`<GetTopicAttributesResponse><GetTopicAttributesResult><Attributes>
+  <entry><key>Owner</key><value>123456789012</value></entry>
+  <entry><key>DisplayName</key><value></value></entry>
+</Attributes></GetTopicAttributesResult></GetTopicAttributesResponse>`)
+	defer server.Close()
+
+	client := sns.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	resp, err := client.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicARN: aws.String("arn:aws:sns:us-west-2:123456789012:topic")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.Attributes, (sns.TopicAttributesMap{"Owner": "123456789012", "DisplayName": ""}); !reflect.DeepEqual(v, want) {
+		t.Errorf("Attributes were %v but expected %v", v, want)
+	}
+}
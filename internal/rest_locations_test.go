@@ -0,0 +1,98 @@
+package internal_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/cloudsearchdomain"
+	"github.com/timesking/aws-go/gen/s3"
+)
+
+func TestS3UserMetadata(t *testing.T) {
+	var metadata http.Header
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if v, want := r.URL.EscapedPath(), "/dogs/photos/spot%20%26%20rover.jpg"; v != want {
+				t.Errorf("Path was %q but expected %q", v, want)
+			}
+
+			switch r.Method {
+			case "PUT":
+				metadata = http.Header{}
+				for name, values := range r.Header {
+					if strings.HasPrefix(name, "X-Amz-Meta-") {
+						metadata[name] = values
+					}
+				}
+			case "HEAD":
+				for name, values := range metadata {
+					w.Header()[name] = values
+				}
+				w.Header().Set("x-amz-missing-meta", "2")
+				w.Header().Set("x-amz-delete-marker", "true")
+			}
+		},
+	))
+	defer server.Close()
+
+	client := s3.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	want := map[string]string{"color": "brown", "owner-name": "Jane"}
+
+	_, err := client.PutObject(&s3.PutObjectRequest{
+		Bucket:   aws.String("dogs"),
+		Key:      aws.String("photos/spot & rover.jpg"),
+		Metadata: want,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.HeadObject(&s3.HeadObjectRequest{
+		Bucket: aws.String("dogs"),
+		Key:    aws.String("photos/spot & rover.jpg"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v := resp.Metadata; !reflect.DeepEqual(v, want) {
+		t.Errorf("Metadata was %v but expected %v", v, want)
+	}
+	if v := resp.MissingMeta; v == nil || *v != 2 {
+		t.Errorf("MissingMeta was %v but expected 2", v)
+	}
+	if v := resp.DeleteMarker; v == nil || !*v {
+		t.Errorf("DeleteMarker was %v but expected true", v)
+	}
+}
+
+func TestRestQueryString(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			for name, want := range map[string]string{"q": "dogs & cats", "partial": "true", "size": "10", "start": "20"} {
+				if v := q.Get(name); v != want {
+					t.Errorf("%s was %q but expected %q", name, v, want)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		},
+	))
+	defer server.Close()
+
+	client := cloudsearchdomain.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	_, err := client.Search(&cloudsearchdomain.SearchRequest{
+		Query:   aws.String("dogs & cats"),
+		Partial: aws.True(),
+		Size:    aws.Long(10),
+		Start:   aws.Long(20),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
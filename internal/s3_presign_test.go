@@ -0,0 +1,50 @@
+package internal_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/s3"
+)
+
+func TestS3PresignGetObject(t *testing.T) {
+	client := s3.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil)
+
+	req := &s3.GetObjectRequest{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	}
+
+	presigned, err := client.PresignGetObject(req, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(presigned)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := u.Path, "/bucket/key"; v != want {
+		t.Errorf("Path was %v but expected %v", v, want)
+	}
+
+	if v, want := u.Query().Get("X-Amz-Expires"), "3600"; v != want {
+		t.Errorf("X-Amz-Expires was %v but expected %v", v, want)
+	}
+
+	generic, err := client.Presign("GetObject", req, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g, err := url.Parse(generic); err != nil || g.Path != u.Path {
+		t.Errorf("Presign returned %v but expected the path %v", generic, u.Path)
+	}
+
+	if _, err := client.Presign("GetObject", &s3.PutObjectRequest{}, time.Hour); err == nil {
+		t.Error("Presigned GetObject with a PutObjectRequest")
+	}
+}
@@ -0,0 +1,54 @@
+package internal_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/timesking/aws-go/aws"
+	"github.com/timesking/aws-go/gen/cloudtrail"
+	"github.com/timesking/aws-go/gen/s3"
+)
+
+func TestS3HeaderTimestamps(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if v, want := r.Header.Get("If-Modified-Since"), "Sun, 25 Jan 2015 08:00:00 GMT"; v != want {
+				t.Errorf("If-Modified-Since was %q but expected %q", v, want)
+			}
+			w.Header().Set("Last-Modified", "Mon, 26 Jan 2015 09:30:00 GMT")
+		},
+	))
+	defer server.Close()
+
+	client := s3.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	resp, err := client.HeadObject(&s3.HeadObjectRequest{
+		Bucket:          aws.String("dogs"),
+		Key:             aws.String("spot"),
+		IfModifiedSince: time.Date(2015, 1, 25, 0, 0, 0, 0, time.FixedZone("PST", -8*60*60)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := resp.LastModified, time.Date(2015, 1, 26, 9, 30, 0, 0, time.UTC); !v.Equal(want) {
+		t.Errorf("LastModified was %v but expected %v", v, want)
+	}
+}
+
+func TestJSONEpochTimestamps(t *testing.T) {
+	server := respond(`{"IsLogging":true,"LatestDeliveryTime":1422172800.25}`)
+	defer server.Close()
+
+	client := cloudtrail.New(aws.Creds("accessKeyID", "secretAccessKey", ""), "us-west-2", nil, aws.WithEndpoint(server.URL))
+	resp, err := client.GetTrailStatus(&cloudtrail.GetTrailStatusRequest{Name: aws.String("trail")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2015, 1, 25, 8, 0, 0, 250000000, time.UTC)
+	if v := resp.LatestDeliveryTime; v == nil || !v.Time.Equal(want) {
+		t.Errorf("LatestDeliveryTime was %v but expected %v", v, want)
+	}
+}
@@ -0,0 +1,308 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A marshaler writes the Go source of a structure shape's generated
+// marshaler, which serializes it as the reflective encoders in package aws
+// would, but without reflection.
+type marshaler struct {
+	bytes.Buffer
+
+	// method is the name of the marshaler's method, e.g. MarshalQuery.
+	method string
+
+	// ec2 names parameters as the EC2 protocol does.
+	ec2 bool
+
+	// depth numbers the variables of nested lists and maps.
+	depth int
+}
+
+func (g *marshaler) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g, format, args...)
+}
+
+// sortedMembers returns the shape's members in the order their fields are
+// declared.
+func sortedMembers(s *Shape) []Member {
+	var names []string
+	members := s.Members()
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sorted := make([]Member, len(names))
+	for i, name := range names {
+		sorted[i] = members[name]
+	}
+	return sorted
+}
+
+// QueryMarshaler returns the MarshalQuery method of a structure shape, which
+// adds its parameters to a Query request as named by its fields' query tags.
+func (s *Shape) QueryMarshaler() string {
+	return s.formMarshaler(&marshaler{method: "MarshalQuery"})
+}
+
+// EC2Marshaler returns the MarshalEC2 method of a structure shape, which adds
+// its parameters to an EC2 request as named by its fields' ec2 tags.
+func (s *Shape) EC2Marshaler() string {
+	return s.formMarshaler(&marshaler{method: "MarshalEC2", ec2: true})
+}
+
+func (s *Shape) formMarshaler(g *marshaler) string {
+	name := exportable(s.Name)
+	g.printf("// %s adds the parameters of the %s to the form, prefixing their names.\n", g.method, name)
+	g.printf("func (v *%s) %s(form url.Values, prefix string) error {\n", name, g.method)
+	g.printf("if v == nil {\nreturn nil\n}\n")
+	for _, m := range sortedMembers(s) {
+		param, containers := m.queryName(), queryContainers(m.Shape(), m.flattened(), false)
+		if g.ec2 {
+			param, containers = m.ec2Name(), queryContainers(m.Shape(), true, true)
+		}
+		g.formValue("v."+exportable(m.Name), m.Shape(), true, concat("prefix", param), containers)
+	}
+	g.printf("return nil\n}\n")
+	return g.String()
+}
+
+// concat returns the Go expression concatenating the expression and the
+// literal, merging literals.
+func concat(expr, lit string) string {
+	if i := strings.LastIndex(expr, "+"); i >= 0 {
+		if prev, err := strconv.Unquote(expr[i+1:]); err == nil {
+			return fmt.Sprintf("%s+%q", expr[:i], prev+lit)
+		}
+	}
+	return fmt.Sprintf("%s+%q", expr, lit)
+}
+
+// formValue writes the code adding the parameters of the Go expression, of
+// the given shape, to the form, named by the Go expression name. Fields hold
+// pointers to scalars and structures, where list and map elements hold their
+// values.
+func (g *marshaler) formValue(expr string, s *Shape, field bool, name string, containers []queryContainer) {
+	switch s.ShapeType {
+	case "structure":
+		if field {
+			g.printf("if %s != nil {\n", expr)
+		}
+		g.printf("if err := %s.%s(form, %s); err != nil {\nreturn err\n}\n", expr, g.method, concat(name, "."))
+		if field {
+			g.printf("}\n")
+		}
+	case "list":
+		c, containers := containers[0], containers[1:]
+		g.depth++
+		i, elem := fmt.Sprintf("i%d", g.depth), fmt.Sprintf("name%d", g.depth)
+		sep := "."
+		if c.member != "" {
+			sep = "." + c.member + "."
+		}
+
+		g.printf("if %s != nil {\n", expr)
+		if !g.ec2 {
+			g.printf("if len(%s) == 0 {\nform.Set(%s, \"\")\n}\n", expr, name)
+		}
+		g.printf("for %s := range %s {\n", i, expr)
+		g.printf("%s := %s + strconv.Itoa(%s+1)\n", elem, concat(name, sep), i)
+		g.formValue(expr+"["+i+"]", s.Member(), false, elem, containers)
+		g.printf("}\n}\n")
+		g.depth--
+	case "map":
+		if t := s.Key().ElementType(); t != "string" {
+			panic(fmt.Errorf("map %q has %s keys", s.Name, t))
+		}
+		c, containers := containers[0], containers[1:]
+		g.depth++
+		keys, i, k, entry, val := fmt.Sprintf("keys%d", g.depth), fmt.Sprintf("i%d", g.depth),
+			fmt.Sprintf("k%d", g.depth), fmt.Sprintf("name%d", g.depth), fmt.Sprintf("v%d", g.depth)
+		sep := "."
+		if c.entry != "" {
+			sep = "." + c.entry + "."
+		}
+
+		// entries are numbered in order of their keys
+		g.printf("%s := make([]string, 0, len(%s))\n", keys, expr)
+		g.printf("for k := range %s {\n%s = append(%s, k)\n}\n", expr, keys, keys)
+		g.printf("sort.Strings(%s)\n", keys)
+		g.printf("for %s, %s := range %s {\n", i, k, keys)
+		g.printf("%s := %s + strconv.Itoa(%s+1) + \".\"\n", entry, concat(name, sep), i)
+		g.printf("form.Set(%s, %s)\n", concat(entry, c.key), k)
+		g.printf("%s := %s[%s]\n", val, expr, k)
+		g.formValue(val, s.Value(), false, concat(entry, c.value), containers)
+		g.printf("}\n")
+		g.depth--
+	case "string":
+		g.formScalar(expr, field, name, "%s")
+	case "integer", "long":
+		g.formScalar(expr, field, name, "strconv.FormatInt(int64(%s), 10)")
+	case "float":
+		g.formScalar(expr, field, name, "strconv.FormatFloat(float64(%s), 'f', -1, 32)")
+	case "double":
+		g.formScalar(expr, field, name, "strconv.FormatFloat(float64(%s), 'f', -1, 64)")
+	case "boolean":
+		g.formScalar(expr, field, name, "strconv.FormatBool(%s)")
+	case "blob":
+		g.printf("if %s != nil {\nform.Set(%s, base64.StdEncoding.EncodeToString(%s))\n}\n", expr, name, expr)
+	case "timestamp":
+		if field && s.Type() != "time.Time" {
+			panic(fmt.Errorf("timestamp %q is a %s", s.Name, s.Type()))
+		}
+		g.printf("if !%s.IsZero() {\nform.Set(%s, %s.UTC().Format(%q))\n}\n", expr, name, expr, "2006-01-02T15:04:05Z")
+	default:
+		panic(fmt.Errorf("type %q (%q) can't be marshaled", s.Name, s.ShapeType))
+	}
+}
+
+func (g *marshaler) formScalar(expr string, field bool, name, format string) {
+	if !field {
+		g.printf("form.Set(%s, %s)\n", name, fmt.Sprintf(format, expr))
+		return
+	}
+	g.printf("if %s != nil {\nform.Set(%s, %s)\n}\n", expr, name, fmt.Sprintf(format, "*"+expr))
+}
+
+// JSONMarshaler returns the MarshalJSON method of a structure shape, which
+// encodes it as json.Marshal would by its fields' json tags, and the
+// appendJSON method it's built on.
+func (s *Shape) JSONMarshaler() string {
+	g := &marshaler{method: "appendJSON"}
+	name := exportable(s.Name)
+
+	g.printf("// MarshalJSON implements json.Marshaler without reflection.\n")
+	g.printf("func (v *%s) MarshalJSON() ([]byte, error) {\n", name)
+	g.printf("if v == nil {\nreturn []byte(\"null\"), nil\n}\n")
+	g.printf("return v.appendJSON(make([]byte, 0, 256))\n}\n\n")
+
+	g.printf("// appendJSON appends the JSON encoding of the %s to b.\n", name)
+	g.printf("func (v *%s) appendJSON(b []byte) ([]byte, error) {\n", name)
+	g.printf("var err error\n")
+	g.printf("b = append(b, '{')\n")
+	for _, m := range sortedMembers(s) {
+		if m.Location != "" || m.Name == "Body" {
+			continue // not part of the body
+		}
+
+		expr := "v." + exportable(m.Name)
+		omitEmpty := !m.Required && jsonOmittable(m.Shape())
+		if omitEmpty {
+			if isJSONPointer(m.Shape()) {
+				g.printf("if %s != nil {\n", expr)
+			} else {
+				g.printf("if len(%s) != 0 {\n", expr)
+			}
+		}
+		g.printf("b = append(b, %q...)\n", fmt.Sprintf("%q:", m.Name))
+		g.jsonValue(expr, m.Shape(), true, !omitEmpty)
+		g.printf("b = append(b, ',')\n")
+		if omitEmpty {
+			g.printf("}\n")
+		}
+	}
+	g.jsonClose('}')
+	g.printf("return b, err\n}\n")
+	return g.String()
+}
+
+// isJSONPointer returns whether a field of the shape is a pointer.
+func isJSONPointer(s *Shape) bool {
+	switch s.ShapeType {
+	case "list", "map", "blob":
+		return false
+	case "timestamp":
+		return s.Type() != "time.Time"
+	}
+	return true
+}
+
+// jsonOmittable returns whether json.Marshal omits empty fields of the shape
+// with the omitempty option: structs, like time.Time, are never empty.
+func jsonOmittable(s *Shape) bool {
+	return s.ShapeType != "timestamp" || s.Type() != "time.Time"
+}
+
+// jsonValue writes the code appending the JSON encoding of the Go expression,
+// of the given shape, to b. Fields hold pointers to scalars and structures;
+// list and map elements hold their values. Nullable values are checked for
+// nil.
+func (g *marshaler) jsonValue(expr string, s *Shape, field, nullable bool) {
+	if nullable && (s.ShapeType == "list" || s.ShapeType == "map" || field && isJSONPointer(s)) {
+		g.printf("if %s == nil {\nb = append(b, \"null\"...)\n} else {\n", expr)
+		defer g.printf("}\n")
+	}
+
+	deref := expr
+	if field && isJSONPointer(s) && s.ShapeType != "structure" && s.ShapeType != "timestamp" {
+		deref = "*" + expr
+	}
+
+	switch s.ShapeType {
+	case "structure":
+		g.printf("b, err = %s.appendJSON(b)\n", expr)
+		g.jsonCheck()
+	case "list":
+		g.depth++
+		i := fmt.Sprintf("i%d", g.depth)
+		g.printf("b = append(b, '[')\n")
+		g.printf("for %s := range %s {\n", i, expr)
+		g.jsonValue(expr+"["+i+"]", s.Member(), false, true)
+		g.printf("b = append(b, ',')\n}\n")
+		g.jsonClose(']')
+		g.depth--
+	case "map":
+		if t := s.Key().ElementType(); t != "string" {
+			panic(fmt.Errorf("map %q has %s keys", s.Name, t))
+		}
+		g.depth++
+		keys, k, val := fmt.Sprintf("keys%d", g.depth), fmt.Sprintf("k%d", g.depth), fmt.Sprintf("v%d", g.depth)
+		g.printf("b = append(b, '{')\n")
+		g.printf("%s := make([]string, 0, len(%s))\n", keys, expr)
+		g.printf("for k := range %s {\n%s = append(%s, k)\n}\n", expr, keys, keys)
+		g.printf("sort.Strings(%s)\n", keys)
+		g.printf("for _, %s := range %s {\n", k, keys)
+		g.printf("b = aws.AppendJSONString(b, %s)\n", k)
+		g.printf("b = append(b, ':')\n")
+		g.printf("%s := %s[%s]\n", val, expr, k)
+		g.jsonValue(val, s.Value(), false, true)
+		g.printf("b = append(b, ',')\n}\n")
+		g.jsonClose('}')
+		g.depth--
+	case "string":
+		g.printf("b = aws.AppendJSONString(b, %s)\n", deref)
+	case "integer", "long":
+		g.printf("b = strconv.AppendInt(b, int64(%s), 10)\n", deref)
+	case "float":
+		g.printf("b, err = aws.AppendJSONFloat(b, float64(%s), 32)\n", deref)
+		g.jsonCheck()
+	case "double":
+		g.printf("b, err = aws.AppendJSONFloat(b, float64(%s), 64)\n", deref)
+		g.jsonCheck()
+	case "boolean":
+		g.printf("b = strconv.AppendBool(b, %s)\n", deref)
+	case "blob":
+		g.printf("b = aws.AppendJSONBytes(b, %s)\n", expr)
+	case "timestamp":
+		g.printf("b, err = aws.AppendJSON(b, %s)\n", expr)
+		g.jsonCheck()
+	default:
+		panic(fmt.Errorf("type %q (%q) can't be marshaled", s.Name, s.ShapeType))
+	}
+}
+
+func (g *marshaler) jsonCheck() {
+	g.printf("if err != nil {\nreturn nil, err\n}\n")
+}
+
+// jsonClose writes the code closing an object or array, replacing the comma
+// after its last element, if any.
+func (g *marshaler) jsonClose(c rune) {
+	g.printf("if b[len(b)-1] == ',' {\nb[len(b)-1] = %q\n} else {\nb = append(b, %q)\n}\n", c, c)
+}
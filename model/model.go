@@ -16,6 +16,7 @@ type Metadata struct {
 	JSONVersion         string
 	ServiceAbbreviation string
 	ServiceFullName     string
+	ServiceID           string
 	SignatureVersion    string
 	TargetPrefix        string
 	Protocol            string
@@ -62,6 +63,8 @@ type ShapeRef struct {
 	Documentation string
 	Location      string
 	LocationName  string
+	QueryName     string
+	Flattened     bool
 	Wrapper       bool
 	ResultWrapper string
 	Streaming     bool
@@ -101,21 +104,33 @@ type Member struct {
 	Required bool
 }
 
+// sensitiveTag returns the field tag part marking members with sensitive
+// shapes, given the member's name on the wire, or an empty string.
+func (m Member) sensitiveTag(name string) string {
+	if !m.Shape().Sensitive {
+		return ""
+	}
+	if m.ShapeRef.Location != "" {
+		name = m.LocationName
+	}
+	return fmt.Sprintf(" sensitive:%q", name)
+}
+
 // JSONTag returns the field tag for JSON protocol members.
 func (m Member) JSONTag() string {
 	if m.ShapeRef.Location != "" || m.Name == "Body" {
-		return "`json:\"-\"`"
+		return "`json:\"-\"" + m.sensitiveTag(m.Name) + "`"
 	}
 	if !m.Required {
-		return fmt.Sprintf("`json:\"%s,omitempty\"`", m.Name)
+		return fmt.Sprintf("`json:\"%s,omitempty\"%s`", m.Name, m.sensitiveTag(m.Name))
 	}
-	return fmt.Sprintf("`json:\"%s\"`", m.Name)
+	return fmt.Sprintf("`json:\"%s\"%s`", m.Name, m.sensitiveTag(m.Name))
 }
 
 // XMLTag returns the field tag for XML protocol members.
 func (m Member) XMLTag(wrapper string) string {
 	if m.ShapeRef.Location != "" || m.Name == "Body" {
-		return "`xml:\"-\"`"
+		return "`xml:\"-\"" + m.sensitiveTag(m.Name) + "`"
 	}
 
 	var path []string
@@ -139,31 +154,23 @@ func (m Member) XMLTag(wrapper string) string {
 	// We can't omit all empty values, because encoding/xml makes it impossible
 	// to marshal pointers to empty values.
 	// https://github.com/golang/go/issues/5452
+	sensitive := m.sensitiveTag(path[len(path)-1])
 	if m.Shape().ShapeType == "list" || m.Shape().ShapeType == "structure" {
-		return fmt.Sprintf("`xml:%q`", strings.Join(path, ">")+",omitempty")
+		return fmt.Sprintf("`xml:%q%s`", strings.Join(path, ">")+",omitempty", sensitive)
 	}
 
-	return fmt.Sprintf("`xml:%q`", strings.Join(path, ">"))
+	return fmt.Sprintf("`xml:%q%s`", strings.Join(path, ">"), sensitive)
 }
 
 // QueryTag returns the field tag for Query protocol members.
 func (m Member) QueryTag(wrapper string) string {
-	var path, prefix []string
+	var path []string
 	if wrapper != "" {
 		path = append(path, wrapper)
 	}
 
-	if m.LocationName != "" {
-		prefix = append(prefix, m.LocationName)
-	} else {
-		prefix = append(prefix, m.Name)
-	}
-
-	if m.Shape().ShapeType == "list" && !m.Shape().Flattened {
-		prefix = append(prefix, "member")
-	}
-
-	if !m.Shape().Flattened {
+	// a flattened map's entries are named after the member
+	if !m.Shape().Flattened || m.Shape().ShapeType == "map" {
 		if m.LocationName != "" {
 			path = append(path, m.LocationName)
 		} else {
@@ -179,13 +186,28 @@ func (m Member) QueryTag(wrapper string) string {
 		path = append(path, loc)
 	}
 
+	name := m.queryName()
 	return fmt.Sprintf(
-		"`query:%q xml:%q`",
-		strings.Join(prefix, "."),
+		"`query:%q xml:%q%s`",
+		name+containerTag(queryContainers(m.Shape(), m.flattened(), false)),
 		strings.Join(path, ">"),
+		m.sensitiveTag(name),
 	)
 }
 
+// queryName returns the name of the member's Query parameter.
+func (m Member) queryName() string {
+	// a flattened list's elements are named after its member
+	name := m.Name
+	if m.LocationName != "" {
+		name = m.LocationName
+	}
+	if s := m.Shape(); s.ShapeType == "list" && m.flattened() && s.MemberRef.LocationName != "" {
+		name = s.MemberRef.LocationName
+	}
+	return name
+}
+
 // EC2Tag returns the field tag for EC2 protocol members.
 func (m Member) EC2Tag() string {
 	var path []string
@@ -203,18 +225,98 @@ func (m Member) EC2Tag() string {
 		path = append(path, loc)
 	}
 
-	// Literally no idea how to distinguish between a location name that's
-	// required (e.g. DescribeImagesRequest#Filters) and one that's weirdly
-	// misleading (e.g. ModifyInstanceAttributeRequest#InstanceId) besides this.
+	name := m.ec2Name()
+	return fmt.Sprintf(
+		"`ec2:%q xml:%q%s`",
+		name+containerTag(queryContainers(m.Shape(), true, true)),
+		strings.Join(path, ">"),
+		m.sensitiveTag(name),
+	)
+}
+
+// ec2Name returns the name of the member's EC2 parameter: its queryName, or
+// else its locationName, capitalized.
+func (m Member) ec2Name() string {
+	if m.QueryName != "" {
+		return m.QueryName
+	}
+	if m.LocationName != "" {
+		return strings.ToUpper(m.LocationName[:1]) + m.LocationName[1:]
+	}
+	return m.Name
+}
+
+// flattened returns whether the member's list or map is flattened.
+func (m Member) flattened() bool {
+	return m.Flattened || m.Shape().Flattened
+}
+
+// A queryContainer describes how the elements of a list or map are named in
+// Query and EC2 requests.
+type queryContainer struct {
+	list bool
+
+	// member names a list's elements, unless it's flattened.
+	member string
+
+	// entry, key and value name a map's entries, unless it's flattened, and
+	// their parts.
+	entry, key, value string
+}
+
+// String returns the container's field tag descriptor, e.g. "list:member".
+func (c queryContainer) String() string {
+	if !c.list {
+		return fmt.Sprintf("map:%s:%s:%s", c.entry, c.key, c.value)
+	}
+	if c.member == "" {
+		return "list"
+	}
+	return "list:" + c.member
+}
+
+// queryContainers returns the containers of the shape's lists and maps, from
+// the outermost in. EC2 lists are always flattened.
+func queryContainers(s *Shape, flattened, ec2 bool) []queryContainer {
+	flattened = flattened || s.Flattened
+	switch s.ShapeType {
+	case "list":
+		c := queryContainer{list: true, member: s.MemberRef.LocationName}
+		if c.member == "" {
+			c.member = "member"
+		}
+		if flattened || ec2 {
+			c.member = ""
+		}
+		return append([]queryContainer{c}, queryContainers(s.MemberRef.Shape(), s.MemberRef.Flattened, ec2)...)
+	case "map":
+		c := queryContainer{entry: "entry", key: s.KeyName(), value: s.ValueName()}
+		if flattened {
+			c.entry = ""
+		}
+		return append([]queryContainer{c}, queryContainers(s.ValueRef.Shape(), s.ValueRef.Flattened, ec2)...)
+	}
+	return nil
+}
 
-	// Use the locationName unless it's missing or unless it starts with a
-	// lowercase letter. Not even making this up.
-	var name = m.LocationName
-	if name == "" || strings.ToLower(name[0:1]) == name[0:1] {
-		name = m.Name
+// containerTag returns the descriptors of the containers, appended to Query
+// and EC2 field tags, e.g. ",list:member".
+func containerTag(containers []queryContainer) string {
+	var tag string
+	for _, c := range containers {
+		tag += "," + c.String()
 	}
+	return tag
+}
 
-	return fmt.Sprintf("`ec2:%q xml:%q`", name, strings.Join(path, ">"))
+// ExceptionTag returns the field tag for members of exceptions, which are
+// decoded from JSON or XML error responses, depending on the protocol.
+func (m Member) ExceptionTag() string {
+	switch service.Metadata.Protocol {
+	case "json", "rest-json":
+		return m.JSONTag()
+	}
+	return m.XMLTag("")
 }
 
 // Shape returns the member's shape.
@@ -227,9 +329,27 @@ func (m Member) Type() string {
 	if m.Streaming {
 		return "io.ReadCloser" // this allows us to pass the S3 body directly
 	}
+	if m.Shape().ShapeType == "timestamp" && m.Location != "" {
+		return "time.Time" // formatted by aws.FormatTimestamp
+	}
 	return m.Shape().Type()
 }
 
+// TimestampFormat returns the format of a timestamp member: its shape's own,
+// or else the default for its location.
+func (m Member) TimestampFormat() string {
+	if f := m.Shape().TimestampFormat; f != "" {
+		return f
+	}
+	switch m.Location {
+	case "header", "headers":
+		return "rfc822"
+	case "querystring", "uri":
+		return "iso8601"
+	}
+	return m.Shape().bodyTimestampFormat()
+}
+
 // An XMLNamespace is an XML namespace. *shrug*
 type XMLNamespace struct {
 	URI string
@@ -334,6 +454,34 @@ func (s *Shape) Members() map[string]Member {
 	return members
 }
 
+// ErrorCode returns the error code of an exception shape.
+func (s *Shape) ErrorCode() string {
+	switch service.Metadata.Protocol {
+	case "query", "ec2":
+		if s.Error.Code != "" {
+			return s.Error.Code
+		}
+	}
+	return s.Name
+}
+
+// ExceptionMembers returns the members of an exception shape, apart from its
+// code and message, which are part of aws.APIError. A member named Error is
+// renamed so it doesn't hide the exception's Error method.
+func (s *Shape) ExceptionMembers() map[string]Member {
+	members := map[string]Member{}
+	for name, m := range s.Members() {
+		switch strings.ToLower(name) {
+		case "code", "message":
+			continue
+		case "error":
+			name = "ErrorDetails"
+		}
+		members[name] = m
+	}
+	return members
+}
+
 // ResultWrapper returns the shape's result wrapper, if and only if a single,
 // unambiguous wrapper can be found in the API's operation outputs.
 func (s *Shape) ResultWrapper() string {
@@ -352,6 +500,12 @@ func (s *Shape) ResultWrapper() string {
 	return ""
 }
 
+// Input returns whether the shape is part of an operation's input, and so
+// needs a marshaler.
+func (s *Shape) Input() bool {
+	return service.inputs[s.Name]
+}
+
 // Literal returns a Go literal of the given shape.
 func (s *Shape) Literal() string {
 	if s.ShapeType == "structure" {
@@ -377,7 +531,10 @@ func (s *Shape) ElementType() string {
 	case "string":
 		return "string"
 	case "map":
-		return "map[" + s.Key().ElementType() + "]" + s.Value().ElementType()
+		if namedMaps() {
+			return exportable(s.Name)
+		}
+		return s.MapType()
 	case "list":
 		return "[]" + s.Member().ElementType()
 	case "boolean":
@@ -385,12 +542,73 @@ func (s *Shape) ElementType() string {
 	case "blob":
 		return "[]byte"
 	case "timestamp":
+		if s.unixTimestamps() {
+			return "aws.FloatTimestamp"
+		}
 		return "time.Time"
 	}
 
 	panic(fmt.Errorf("type %q (%q) not found", s.Name, s.ShapeType))
 }
 
+// bodyTimestampFormat returns the format of a timestamp shape in request and
+// response bodies: its own, or else the service's or protocol's default. XML
+// bodies are always ISO 8601, as S3's rfc822 format is only its headers'.
+func (s *Shape) bodyTimestampFormat() string {
+	if s.TimestampFormat != "" {
+		return s.TimestampFormat
+	}
+	switch service.Metadata.Protocol {
+	case "json", "rest-json":
+		if service.Metadata.TimestampFormat != "" {
+			return service.Metadata.TimestampFormat
+		}
+		return "unixTimestamp"
+	}
+	return "iso8601"
+}
+
+// unixTimestamps returns whether a timestamp shape is encoded in JSON bodies
+// as seconds since the epoch, which aws.FloatTimestamp does.
+func (s *Shape) unixTimestamps() bool {
+	switch service.Metadata.Protocol {
+	case "json", "rest-json":
+		return s.bodyTimestampFormat() == "unixTimestamp"
+	}
+	return false
+}
+
+// MapType returns the Go map type of a map shape.
+func (s *Shape) MapType() string {
+	return "map[" + s.Key().ElementType() + "]" + s.Value().ElementType()
+}
+
+// namedMaps returns whether the service's map shapes have named types, whose
+// UnmarshalXML methods decode them from Query and EC2 responses.
+func namedMaps() bool {
+	switch service.Metadata.Protocol {
+	case "query", "ec2":
+		return true
+	}
+	return false
+}
+
+// KeyName returns the name of the key elements of a map shape's entries.
+func (s *Shape) KeyName() string {
+	if s.KeyRef.LocationName != "" {
+		return s.KeyRef.LocationName
+	}
+	return "key"
+}
+
+// ValueName returns the name of the value elements of a map shape's entries.
+func (s *Shape) ValueName() string {
+	if s.ValueRef.LocationName != "" {
+		return s.ValueRef.LocationName
+	}
+	return "value"
+}
+
 // Type returns the shape's Go type.
 func (s *Shape) Type() string {
 	switch s.ShapeType {
@@ -410,7 +628,7 @@ func (s *Shape) Type() string {
 	case "string":
 		return "aws.StringValue"
 	case "map":
-		return "map[" + s.Key().ElementType() + "]" + s.Value().ElementType()
+		return s.ElementType()
 	case "list":
 		return "[]" + s.Member().ElementType()
 	case "boolean":
@@ -418,17 +636,9 @@ func (s *Shape) Type() string {
 	case "blob":
 		return "[]byte"
 	case "timestamp":
-		// DynamoDB has a magical date format of floating point epoch
-		// seconds. It's only used for a few calls, so we special-case it here
-		// rather than allow that to screw up all the other packages.
-		if service.PackageName == "dynamodb" {
+		if s.unixTimestamps() {
 			return "*aws.FloatTimestamp"
 		}
-
-		if service.Metadata.TimestampFormat == "unixTimestamp" {
-			return "*aws.LongTimestamp"
-		}
-
 		return "time.Time"
 	}
 
@@ -444,6 +654,10 @@ type Service struct {
 	Documentation string
 	Operations    map[string]Operation
 	Shapes        map[string]*Shape
+
+	// inputs are the names of the shapes which are part of an operation's
+	// input.
+	inputs map[string]bool
 }
 
 // Wrappers returns the service's wrapper shapes.
@@ -471,6 +685,86 @@ func (s Service) Wrappers() map[string]*Shape {
 	return wrappers
 }
 
+// serviceIDs are the service IDs of the models which predate the serviceId
+// metadata, by endpoint prefix.
+var serviceIDs = map[string]string{
+	"autoscaling":          "Auto Scaling",
+	"cloudformation":       "CloudFormation",
+	"cloudfront":           "CloudFront",
+	"cloudsearch":          "CloudSearch",
+	"cloudsearchdomain":    "CloudSearch Domain",
+	"cloudtrail":           "CloudTrail",
+	"codedeploy":           "CodeDeploy",
+	"cognito-identity":     "Cognito Identity",
+	"cognito-sync":         "Cognito Sync",
+	"config":               "Config Service",
+	"datapipeline":         "Data Pipeline",
+	"directconnect":        "Direct Connect",
+	"dynamodb":             "DynamoDB",
+	"ec2":                  "EC2",
+	"elasticache":          "ElastiCache",
+	"elasticbeanstalk":     "Elastic Beanstalk",
+	"elasticloadbalancing": "Elastic Load Balancing",
+	"elasticmapreduce":     "EMR",
+	"elastictranscoder":    "Elastic Transcoder",
+	"email":                "SES",
+	"iam":                  "IAM",
+	"importexport":         "ImportExport",
+	"kinesis":              "Kinesis",
+	"kms":                  "KMS",
+	"lambda":               "Lambda",
+	"logs":                 "CloudWatch Logs",
+	"monitoring":           "CloudWatch",
+	"opsworks":             "OpsWorks",
+	"rds":                  "RDS",
+	"redshift":             "Redshift",
+	"route53":              "Route 53",
+	"route53domains":       "Route 53 Domains",
+	"s3":                   "S3",
+	"sdb":                  "SimpleDB",
+	"sns":                  "SNS",
+	"sqs":                  "SQS",
+	"storagegateway":       "Storage Gateway",
+	"sts":                  "STS",
+	"support":              "Support",
+	"swf":                  "SWF",
+}
+
+// EndpointEnv returns the name of the environment variable which overrides the
+// service's endpoint URL, e.g. AWS_ENDPOINT_URL_ELASTIC_LOAD_BALANCING.
+func (s Service) EndpointEnv() string {
+	id := s.Metadata.ServiceID
+	if id == "" {
+		id = serviceIDs[s.Metadata.EndpointPrefix]
+	}
+	if id == "" {
+		id = s.Name
+	}
+	id = strings.Replace(strings.Replace(id, " ", "_", -1), "-", "_", -1)
+	return "AWS_ENDPOINT_URL_" + strings.ToUpper(id)
+}
+
+// addInput marks the shape, and the shapes it contains, as part of an
+// operation's input.
+func (s *Service) addInput(shape *Shape) {
+	if s.inputs[shape.Name] {
+		return
+	}
+	s.inputs[shape.Name] = true
+
+	switch shape.ShapeType {
+	case "structure":
+		for _, ref := range shape.MemberRefs {
+			s.addInput(ref.Shape())
+		}
+	case "list":
+		s.addInput(shape.Member())
+	case "map":
+		s.addInput(shape.Key())
+		s.addInput(shape.Value())
+	}
+}
+
 var service Service
 
 // Load parses the given JSON input and loads it into the singleton instance of
@@ -485,6 +779,13 @@ func Load(name string, r io.Reader) error {
 		shape.Name = name
 	}
 
+	service.inputs = map[string]bool{}
+	for _, op := range service.Operations {
+		if op.InputRef != nil {
+			service.addInput(op.Input())
+		}
+	}
+
 	service.FullName = service.Metadata.ServiceFullName
 	service.PackageName = strings.ToLower(name)
 	service.Name = name
@@ -0,0 +1,225 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A locationWriter writes the Go source which moves a REST operation's member
+// between its field and its location in the HTTP request or response: the
+// URI, the query string, or the headers.
+type locationWriter struct {
+	bytes.Buffer
+	m Member
+}
+
+func (g *locationWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g, format, args...)
+}
+
+// scalarType returns the Go type of a scalar shape, as a field if field is
+// true, or else as an element of a list or map. Fields hold pointers to all
+// but timestamps and blobs.
+func scalarType(s *Shape, field bool) string {
+	if field {
+		switch s.Type() {
+		case "aws.LongValue":
+			return "int64" // a ContentLength integer
+		case "*aws.FloatTimestamp":
+			return "time.Time" // see Member.Type
+		}
+	}
+	switch t := s.ElementType(); t {
+	case "string", "bool", "int", "int64", "float32", "float64", "[]byte", "time.Time", "aws.FloatTimestamp":
+		return t
+	}
+	panic(fmt.Errorf("%s can't be in a location (%q)", s.Name, s.ShapeType))
+}
+
+// timestampFormat returns the format of the timestamp shape: its own, or
+// else the member's.
+func (g *locationWriter) timestampFormat(s *Shape) string {
+	if s.TimestampFormat != "" {
+		return s.TimestampFormat
+	}
+	return g.m.TimestampFormat()
+}
+
+// isSet returns the Go expression which is true if the field is set.
+func isSet(expr, t string) string {
+	if t == "time.Time" {
+		return "!" + expr + ".IsZero()"
+	}
+	return expr + " != nil"
+}
+
+// format returns the Go expression formatting the value of the Go expression,
+// a field if field is true, as a string.
+func (g *locationWriter) format(expr string, s *Shape, field bool) string {
+	t := scalarType(s, field)
+	if field && t != "time.Time" && t != "[]byte" {
+		expr = "*" + expr
+	}
+
+	switch t {
+	case "string":
+		return expr
+	case "bool":
+		return fmt.Sprintf("strconv.FormatBool(%s)", expr)
+	case "int":
+		return fmt.Sprintf("strconv.Itoa(%s)", expr)
+	case "int64":
+		return fmt.Sprintf("strconv.FormatInt(%s, 10)", expr)
+	case "float32":
+		return fmt.Sprintf("strconv.FormatFloat(float64(%s), 'f', -1, 32)", expr)
+	case "float64":
+		return fmt.Sprintf("strconv.FormatFloat(%s, 'f', -1, 64)", expr)
+	case "[]byte":
+		return fmt.Sprintf("base64.StdEncoding.EncodeToString(%s)", expr)
+	case "aws.FloatTimestamp":
+		expr += ".Time"
+	}
+	return fmt.Sprintf("aws.FormatTimestamp(%s, %q)", expr, g.timestampFormat(s))
+}
+
+// parse writes the code parsing the string s as the scalar shape, a field if
+// field is true, returning the error if it can't. It returns the variable
+// holding the parsed value: s itself for strings, or else v.
+func (g *locationWriter) parse(s *Shape, field bool) string {
+	t := scalarType(s, field)
+	switch t {
+	case "string":
+		return "s"
+	case "float32":
+		g.printf("var f float64\nif f, err = strconv.ParseFloat(s, 32); err != nil {\nreturn\n}\n")
+		g.printf("v := float32(f)\n")
+		return "v"
+	case "aws.FloatTimestamp":
+		g.printf("var t time.Time\n")
+		g.printf("if t, err = aws.ParseTimestamp(s, %q); err != nil {\nreturn\n}\n", g.timestampFormat(s))
+		g.printf("v := aws.FloatTimestamp{Time: t}\n")
+		return "v"
+	}
+
+	fn := map[string]string{
+		"bool":      "strconv.ParseBool(s)",
+		"int":       "strconv.Atoi(s)",
+		"int64":     "strconv.ParseInt(s, 10, 64)",
+		"float64":   "strconv.ParseFloat(s, 64)",
+		"[]byte":    "base64.StdEncoding.DecodeString(s)",
+		"time.Time": fmt.Sprintf("aws.ParseTimestamp(s, %q)", g.timestampFormat(s)),
+	}[t]
+	g.printf("var v %s\nif v, err = %s; err != nil {\nreturn\n}\n", t, fn)
+	return "v"
+}
+
+// URIParam returns the code replacing the member's placeholders in the
+// request's URI with its escaped value.
+func (m Member) URIParam() string {
+	g := &locationWriter{m: m}
+	field := "req." + exportable(m.Name)
+	value := g.format(field, m.Shape(), true)
+
+	g.printf("if %s {\n", isSet(field, scalarType(m.Shape(), true)))
+	g.printf("uri = strings.Replace(uri, %q, aws.EscapePath(%s, false), -1)\n", "{"+m.LocationName+"}", value)
+	g.printf("uri = strings.Replace(uri, %q, aws.EscapePath(%s, true), -1)\n", "{"+m.LocationName+"+}", value)
+	g.printf("}\n")
+	return g.String()
+}
+
+// QueryParam returns the code adding the member's value to the request's
+// query string. Lists add a value per element, and maps a parameter per key.
+func (m Member) QueryParam() string {
+	g := &locationWriter{m: m}
+	field, s := "req."+exportable(m.Name), m.Shape()
+
+	switch s.ShapeType {
+	case "list":
+		g.printf("for _, v := range %s {\n", field)
+		g.printf("q.Add(%q, %s)\n", m.LocationName, g.format("v", s.Member(), false))
+		g.printf("}\n")
+	case "map":
+		g.printf("for k, v := range %s {\n", field)
+		if s.Value().ShapeType == "list" {
+			g.printf("for _, v := range v {\n")
+			g.printf("q.Add(k, %s)\n", g.format("v", s.Value().Member(), false))
+			g.printf("}\n")
+		} else {
+			g.printf("q.Set(k, %s)\n", g.format("v", s.Value(), false))
+		}
+		g.printf("}\n")
+	default:
+		g.printf("if %s {\n", isSet(field, scalarType(s, true)))
+		g.printf("q.Set(%q, %s)\n", m.LocationName, g.format(field, s, true))
+		g.printf("}\n")
+	}
+	return g.String()
+}
+
+// RequestHeader returns the code setting the member's header on the request.
+// Lists are comma-separated, and maps set a header per key, prefixed by the
+// member's location name (e.g. x-amz-meta-).
+func (m Member) RequestHeader() string {
+	g := &locationWriter{m: m}
+	field, s := "req."+exportable(m.Name), m.Shape()
+
+	switch {
+	case m.Location == "headers":
+		g.printf("for k, v := range %s {\n", field)
+		g.printf("httpReq.Header.Set(%q+k, %s)\n", m.LocationName, g.format("v", s.Value(), false))
+		g.printf("}\n")
+	case s.ShapeType == "list":
+		g.printf("if len(%s) != 0 {\n", field)
+		if scalarType(s.Member(), false) == "string" {
+			g.printf("httpReq.Header.Set(%q, strings.Join(%s, \",\"))\n", m.LocationName, field)
+		} else {
+			g.printf("values := make([]string, len(%s))\n", field)
+			g.printf("for i, v := range %s {\nvalues[i] = %s\n}\n", field, g.format("v", s.Member(), false))
+			g.printf("httpReq.Header.Set(%q, strings.Join(values, \",\"))\n", m.LocationName)
+		}
+		g.printf("}\n")
+	case m.LocationName == "Content-Length":
+		g.printf("if %s != nil {\nhttpReq.ContentLength = int64(*%s)\n}\n", field, field)
+	default:
+		g.printf("if %s {\n", isSet(field, scalarType(s, true)))
+		g.printf("httpReq.Header.Set(%q, %s)\n", m.LocationName, g.format(field, s, true))
+		g.printf("}\n")
+	}
+	return g.String()
+}
+
+// ResponseHeader returns the code setting the member from the response's
+// headers. Maps collect the headers prefixed by the member's location name,
+// keyed by the rest of their names in lower case, as HTTP header names are
+// case-insensitive.
+func (m Member) ResponseHeader() string {
+	g := &locationWriter{m: m}
+	field, s := "resp."+exportable(m.Name), m.Shape()
+
+	switch {
+	case m.Location == "headers":
+		g.printf("%s = %s{}\n", field, m.Type())
+		g.printf("for name, values := range httpResp.Header {\n")
+		g.printf("if key := strings.ToLower(name); strings.HasPrefix(key, %q) {\n", strings.ToLower(m.LocationName))
+		g.printf("s := strings.Join(values, \",\")\n")
+		g.printf("%s[key[%d:]] = %s\n", field, len(m.LocationName), g.parse(s.Value(), false))
+		g.printf("}\n}\n")
+	case s.ShapeType == "list":
+		dates := s.Member().ShapeType == "timestamp" && g.timestampFormat(s.Member()) == "rfc822"
+		g.printf("for _, h := range httpResp.Header.Values(%q) {\n", m.LocationName)
+		g.printf("if h == \"\" {\ncontinue\n}\n")
+		g.printf("for _, s := range aws.SplitHeader(h, %t) {\n", dates)
+		g.printf("%s = append(%s, %s)\n", field, field, g.parse(s.Member(), false))
+		g.printf("}\n}\n")
+	default:
+		g.printf("if s := httpResp.Header.Get(%q); s != \"\" {\n", m.LocationName)
+		v := g.parse(s, true)
+		if t := scalarType(s, true); t != "time.Time" && t != "[]byte" {
+			v = "&" + v
+		}
+		g.printf("%s = %s\n", field, v)
+		g.printf("}\n")
+	}
+	return g.String()
+}
@@ -0,0 +1,253 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// RetryResponse matches a service's error response.
+type RetryResponse struct {
+	ServiceErrorCode string `json:"service_error_code"`
+	HTTPStatusCode   int    `json:"http_status_code"`
+	CRC32Body        string `json:"crc32body"`
+}
+
+// RetryAppliesWhen is the set of failures a retry policy applies to.
+type RetryAppliesWhen struct {
+	Response     *RetryResponse
+	SocketErrors []string `json:"socket_errors"`
+}
+
+// A RetryPolicy is a named condition under which a request is retried. It is
+// either a reference to a definition or an inline condition.
+type RetryPolicy struct {
+	Ref         string           `json:"$ref"`
+	AppliesWhen RetryAppliesWhen `json:"applies_when"`
+}
+
+// Condition returns a Go literal of the policy's aws.RetryCondition, or an
+// empty string if the policy can't be expressed as one.
+func (p RetryPolicy) Condition() string {
+	if len(p.AppliesWhen.SocketErrors) > 0 {
+		return "{ConnectionError: true}"
+	}
+
+	r := p.AppliesWhen.Response
+	if r == nil {
+		return ""
+	}
+	if r.CRC32Body != "" {
+		return "{Checksum: true}"
+	}
+
+	var fields []string
+	if r.ServiceErrorCode != "" {
+		fields = append(fields, fmt.Sprintf("Code: %q", r.ServiceErrorCode))
+	}
+	if r.HTTPStatusCode != 0 {
+		fields = append(fields, fmt.Sprintf("StatusCode: %d", r.HTTPStatusCode))
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// RetryDelay describes the backoff between attempts.
+type RetryDelay struct {
+	Type         string
+	Base         interface{}
+	GrowthFactor float64 `json:"growth_factor"`
+}
+
+// Literal returns a Go literal of the delay as an aws.Backoff.
+func (d RetryDelay) Literal() string {
+	if d.Type != "exponential" {
+		panic("unknown delay type: " + d.Type)
+	}
+
+	// a base of "rand" is a random number of seconds between 0 and 1
+	base, jitter := time.Second, true
+	if f, ok := d.Base.(float64); ok {
+		base, jitter = time.Duration(f*float64(time.Second)), false
+	}
+
+	return fmt.Sprintf(
+		"aws.Backoff{Base: %s, GrowthFactor: %v, Jitter: %v}",
+		durationLiteral(base), d.GrowthFactor, jitter,
+	)
+}
+
+func durationLiteral(d time.Duration) string {
+	if d%time.Second == 0 {
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	}
+	return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+}
+
+// A RetryConfig is the retry configuration for a service.
+type RetryConfig struct {
+	MaxAttempts int `json:"max_attempts"`
+	Delay       *RetryDelay
+	Policies    map[string]RetryPolicy
+}
+
+// Conditions returns Go literals of the config's retry conditions, ordered by
+// policy name.
+func (c RetryConfig) Conditions() []string {
+	var names []string
+	for name := range c.Policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var conds []string
+	for _, name := range names {
+		if cond := c.Policies[name].Condition(); cond != "" {
+			conds = append(conds, cond)
+		}
+	}
+	return conds
+}
+
+// Retries are the retry configurations for all services.
+type Retries struct {
+	Definitions map[string]RetryPolicy
+	Retry       map[string]json.RawMessage
+}
+
+// Parse parses the JSON description of the retry configurations.
+func (r *Retries) Parse(rd io.Reader) error {
+	return json.NewDecoder(rd).Decode(r)
+}
+
+// Configs returns the resolved configuration of each service, keyed by
+// service name, with the default configuration under "_default".
+func (r Retries) Configs() (map[string]RetryConfig, error) {
+	var def RetryConfig
+	if err := json.Unmarshal(r.Retry["__default__"], &def); err != nil {
+		return nil, err
+	}
+
+	configs := map[string]RetryConfig{"_default": r.resolve(def)}
+	for name, raw := range r.Retry {
+		if name == "__default__" {
+			continue
+		}
+
+		// only service-wide configurations are supported
+		var ops map[string]RetryConfig
+		if err := json.Unmarshal(raw, &ops); err != nil {
+			return nil, err
+		}
+
+		c := RetryConfig{
+			MaxAttempts: def.MaxAttempts,
+			Delay:       def.Delay,
+			Policies:    map[string]RetryPolicy{},
+		}
+		for k, p := range def.Policies {
+			c.Policies[k] = p
+		}
+
+		svc := ops["__default__"]
+		if svc.MaxAttempts != 0 {
+			c.MaxAttempts = svc.MaxAttempts
+		}
+		if svc.Delay != nil {
+			c.Delay = svc.Delay
+		}
+		for k, p := range svc.Policies {
+			c.Policies[k] = p
+		}
+
+		configs[name] = r.resolve(c)
+	}
+	return configs, nil
+}
+
+func (r Retries) resolve(c RetryConfig) RetryConfig {
+	policies := map[string]RetryPolicy{}
+	for name, p := range c.Policies {
+		if p.Ref != "" {
+			def, ok := r.Definitions[p.Ref]
+			if !ok {
+				panic("unknown retry definition: " + p.Ref)
+			}
+			p = def
+		}
+		policies[name] = p
+	}
+	c.Policies = policies
+	return c
+}
+
+// Generate writes a Go file to the given writer.
+func (r Retries) Generate(w io.Writer) error {
+	configs, err := r.Configs()
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("retries").Parse(retryTmpl)
+	if err != nil {
+		return err
+	}
+
+	out := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(out, configs); err != nil {
+		return err
+	}
+
+	b, err := format.Source(bytes.TrimSpace(out.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, bytes.NewReader(b))
+	return err
+}
+
+const retryTmpl = `
+{{ define "policy" }}&aws.RetryPolicy{
+  MaxAttempts: {{ .MaxAttempts }},
+  Delay: {{ .Delay.Literal }},
+  Conditions: []aws.RetryCondition{
+    {{ range .Conditions }}{{ . }},
+    {{ end }}
+  },
+}
+{{ end }}
+
+// Package retry provides the retry policies for all AWS services.
+package retry
+
+import (
+  "time"
+
+  "github.com/stripe/aws-go/aws"
+)
+
+// Lookup returns a new copy of the retry policy for the first of the given
+// service names which has one, or the default policy if none do.
+func Lookup(names ...string) *aws.RetryPolicy {
+  for _, name := range names {
+    switch name {
+      {{ range $name, $config := . }}
+      {{ if ne $name "_default" }}
+      case "{{ $name }}":
+        return {{ template "policy" $config }}
+      {{ end }}
+      {{ end }}
+    }
+  }
+
+  {{ with $config := index . "_default" }}
+  return {{ template "policy" $config }}
+  {{ end }}
+}
+`
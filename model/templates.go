@@ -45,15 +45,84 @@ func common(t *template.Template) (*template.Template, error) {
 package {{ .PackageName }}
 
 import (
+  "context"
   "net/http"
   "time"
 
   "github.com/stripe/aws-go/aws"
   "github.com/stripe/aws-go/gen/endpoints"
+  "github.com/stripe/aws-go/gen/retry"
 )
 
 {{ end }}
 
+{{ define "signer" }}{{ if eq .Metadata.SignatureVersion "v2" }}
+        Signer: aws.V2Signer{},{{ end }}{{ end }}
+
+{{ define "config" }}
+// NewFromSession returns a new {{ .Name }} client configured by the session. The
+// options override the session's.
+func NewFromSession(s *aws.Session, opts ...aws.Option) *{{ .Name }} {
+  return New(s.Credentials, s.Region, s.HTTPClient, s.ClientOptions(opts)...)
+}
+
+// newConfig returns the client's configuration for the region.
+func newConfig(region string, client *http.Client, opts []aws.Option) aws.Config {
+  endpoint, service, region := endpoints.Lookup("{{ .Metadata.EndpointPrefix }}", region)
+  return aws.NewConfig(aws.Config{
+    Endpoint: endpoint,
+    SigningRegion: region,
+    SigningName: service,
+    HTTPClient: client,
+    Retry: retry.Lookup("{{ .Metadata.EndpointPrefix }}", "{{ .PackageName }}"),
+  }, "{{ .EndpointEnv }}", opts...)
+}
+{{ end }}
+
+{{ define "accessors" }}
+// SetRetryPolicy replaces the client's retry policy. A nil policy disables
+// retries.
+func (c *{{ .Name }}) SetRetryPolicy(p *aws.RetryPolicy) {
+  c.client.Retry = p
+}
+
+// Handlers returns the client's request handlers, which can be added to,
+// removed or replaced to customize how requests are built, signed, sent and
+// decoded.
+func (c *{{ .Name }}) Handlers() *aws.Handlers {
+  return c.client.Handlers()
+}
+{{ end }}
+
+{{ define "presign-form" }}
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method.
+func (c *{{ .Name }}) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+  return c.client.Presign(op, req, expires)
+}
+{{ end }}
+
+{{ define "exceptions" }}
+{{ range $name, $s := .Shapes }}
+{{ if $s.Exception }}
+
+// {{ exportable $name }} is the error returned for the {{ $s.ErrorCode }} error code.
+type {{ exportable $name }} struct {
+  aws.APIError
+{{ range $name, $m := $s.ExceptionMembers }}
+{{ exportable $name }} {{ $m.Type }} {{ $m.ExceptionTag }}  {{ end }}
+}
+
+{{ end }}
+{{ end }}
+
+// exceptions maps {{ .Name }}'s error codes to its typed exceptions.
+var exceptions = aws.Exceptions{ {{ range $name, $s := .Shapes }}{{ if $s.Exception }}
+  "{{ $s.ErrorCode }}": {{ exportable $name }}{},{{ end }}{{ end }}
+}
+{{ end }}
+
 {{ define "footer" }}
 // avoid errors if the packages aren't referenced
 var _ time.Time
@@ -67,39 +136,59 @@ func jsonClient(t *template.Template) (*template.Template, error) {
 {{ define "json" }}
 {{ template "header" $ }}
 
+import (
+  "sort"
+  "strconv"
+)
+
 // {{ .Name }} is a client for {{ .FullName }}.
 type {{ .Name }} struct {
   client *aws.JSONClient
 }
 
-// New returns a new {{ .Name }} client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{ .Name }} {
-  if client == nil {
-     client = http.DefaultClient
-  }
-
-  service := "{{ .Metadata.EndpointPrefix }}"
-  endpoint, service, region := endpoints.Lookup("{{ .Metadata.EndpointPrefix }}", region)
+// New returns a new {{ .Name }} client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *{{ .Name }} {
+  cfg := newConfig(region, client, opts)
 
   return &{{ .Name }}{
     client: &aws.JSONClient{
       Context: aws.Context{
         Credentials: creds,
-        Service: service,
-        Region: region,
-      },      Client: client,
-      Endpoint: endpoint,
+        Service: cfg.SigningName,
+        Region: cfg.SigningRegion,
+        UserAgent: cfg.UserAgent,
+        Clock: cfg.Clock,
+        Logger: cfg.Logger,
+        LogLevel: cfg.LogLevel,
+        Compression: cfg.Compression,{{ template "signer" $ }}
+      },
+      Client: cfg.HTTPClient,
+      Endpoint: cfg.Endpoint,
+      Retry: cfg.Retry,
+      Exceptions: exceptions,
       JSONVersion: "{{ .Metadata.JSONVersion }}",
       TargetPrefix: "{{ .Metadata.TargetPrefix }}",
     },
   }
 }
 
+{{ template "config" $ }}
+
+{{ template "accessors" $ }}
+
 {{ range $name, $op := .Operations }}
 
 {{ godoc $name $op.Documentation }} func (c *{{ $.Name }}) {{ exportable $name }}({{ if $op.Input }}req {{ $op.Input.Type }}{{ end }}) ({{ if $op.Output }}resp {{ $op.Output.Type }},{{ end }} err error) {
+  return c.{{ exportable $name }}WithContext(context.Background(){{ if $op.Input }}, req{{ end }})
+}
+
+// {{ exportable $name }}WithContext is like {{ exportable $name }}, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *{{ $.Name }}) {{ exportable $name }}WithContext(ctx context.Context{{ if $op.Input }}, req {{ $op.Input.Type }}{{ end }}) ({{ if $op.Output }}resp {{ $op.Output.Type }},{{ end }} err error) {
   {{ if $op.Output }}resp = {{ $op.Output.Literal }}{{ else }}// NRE{{ end }}
-  err = c.client.Do("{{ $name }}", "{{ $op.HTTP.Method }}", "{{ $op.HTTP.RequestURI }}", {{ if $op.Input }} req {{ else }} nil {{ end }}, {{ if $op.Output }} resp {{ else }} nil {{ end }})
+  err = c.client.DoWithContext(ctx, "{{ $name }}", "{{ $op.HTTP.Method }}", "{{ $op.HTTP.RequestURI }}", {{ if $op.Input }} req {{ else }} nil {{ end }}, {{ if $op.Output }} resp {{ else }} nil {{ end }})
   return
 }
 
@@ -115,6 +204,8 @@ type {{ exportable $name }} struct {
 {{ exportable $name }} {{ $m.Type }} {{ $m.JSONTag }}  {{ end }}
 }
 
+{{ if $s.Input }}{{ $s.JSONMarshaler }}{{ end }}
+
 {{ end }}
 {{ else if $s.Enum }}
 // Possible values for {{ $.Name }}.
@@ -125,7 +216,11 @@ const (
 {{ end }}
 {{ end }}
 
+{{ template "exceptions" $ }}
+
 {{ template "footer" }}
+var _ = sort.Strings
+var _ = strconv.AppendInt
 {{ end }}
 
 `)
@@ -136,39 +231,63 @@ func queryClient(t *template.Template) (*template.Template, error) {
 {{ define "query" }}
 {{ template "header" $ }}
 
+import (
+  "encoding/base64"
+  "encoding/xml"
+  "net/url"
+  "sort"
+  "strconv"
+)
+
 // {{ .Name }} is a client for {{ .FullName }}.
 type {{ .Name }} struct {
   client *aws.QueryClient
 }
 
-// New returns a new {{ .Name }} client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{ .Name }} {
-  if client == nil {
-     client = http.DefaultClient
-  }
-
-  service := "{{ .Metadata.EndpointPrefix }}"
-  endpoint, service, region := endpoints.Lookup("{{ .Metadata.EndpointPrefix }}", region)
+// New returns a new {{ .Name }} client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *{{ .Name }} {
+  cfg := newConfig(region, client, opts)
 
   return &{{ .Name }}{
     client: &aws.QueryClient{
       Context: aws.Context{
         Credentials: creds,
-        Service: service,
-        Region: region,
+        Service: cfg.SigningName,
+        Region: cfg.SigningRegion,
+        UserAgent: cfg.UserAgent,
+        Clock: cfg.Clock,
+        Logger: cfg.Logger,
+        LogLevel: cfg.LogLevel,
+        Compression: cfg.Compression,{{ template "signer" $ }}
       },
-      Client: client,
-      Endpoint: endpoint,
+      Client: cfg.HTTPClient,
+      Endpoint: cfg.Endpoint,
+      Retry: cfg.Retry,
+      Exceptions: exceptions,
       APIVersion: "{{ .Metadata.APIVersion }}",
     },
   }
 }
 
+{{ template "config" $ }}
+
+{{ template "accessors" $ }}
+
+{{ template "presign-form" $ }}
+
 {{ range $name, $op := .Operations }}
 
 {{ godoc $name $op.Documentation }} func (c *{{ $.Name }}) {{ exportable $name }}({{ if $op.InputRef }}req {{ $op.InputRef.WrappedType }}{{ end }}) ({{ if $op.OutputRef }}resp {{ $op.OutputRef.WrappedType }},{{ end }} err error) {
+  return c.{{ exportable $name }}WithContext(context.Background(){{ if $op.InputRef }}, req{{ end }})
+}
+
+// {{ exportable $name }}WithContext is like {{ exportable $name }}, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *{{ $.Name }}) {{ exportable $name }}WithContext(ctx context.Context{{ if $op.InputRef }}, req {{ $op.InputRef.WrappedType }}{{ end }}) ({{ if $op.OutputRef }}resp {{ $op.OutputRef.WrappedType }},{{ end }} err error) {
   {{ if $op.Output }}resp = {{ $op.OutputRef.WrappedLiteral }}{{ else }}// NRE{{ end }}
-  err = c.client.Do("{{ $name }}", "{{ $op.HTTP.Method }}", "{{ $op.HTTP.RequestURI }}", {{ if $op.Input }} req {{ else }} nil {{ end }}, {{ if $op.Output }} resp {{ else }} nil {{ end }})
+  err = c.client.DoWithContext(ctx, "{{ $name }}", "{{ $op.HTTP.Method }}", "{{ $op.HTTP.RequestURI }}", {{ if $op.Input }} req {{ else }} nil {{ end }}, {{ if $op.Output }} resp {{ else }} nil {{ end }})
   return
 }
 
@@ -184,7 +303,19 @@ type {{ exportable $name }} struct {
 {{ exportable $name }} {{ $m.Type }} {{ $m.QueryTag $s.ResultWrapper }}  {{ end }}
 }
 
+{{ if $s.Input }}{{ $s.QueryMarshaler }}{{ end }}
+
 {{ end }}
+{{ else if eq $s.ShapeType "map" }}
+
+// {{ exportable $name }} is undocumented.
+type {{ exportable $name }} {{ $s.MapType }}
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *{{ exportable $name }}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+  return aws.UnmarshalXMLMap(d, start, m, "{{ $s.KeyName }}", "{{ $s.ValueName }}")
+}
+
 {{ else if $s.Enum }}
 // Possible values for {{ $.Name }}.
 const (
@@ -204,7 +335,14 @@ type {{ exportable $wname }} struct {
 
 {{ end }}
 
+{{ template "exceptions" $ }}
+
 {{ template "footer" }}
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
 {{ end }}
 
 `)
@@ -215,39 +353,63 @@ func ec2Client(t *template.Template) (*template.Template, error) {
 {{ define "ec2" }}
 {{ template "header" $ }}
 
+import (
+  "encoding/base64"
+  "encoding/xml"
+  "net/url"
+  "sort"
+  "strconv"
+)
+
 // {{ .Name }} is a client for {{ .FullName }}.
 type {{ .Name }} struct {
   client *aws.EC2Client
 }
 
-// New returns a new {{ .Name }} client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{ .Name }} {
-  if client == nil {
-     client = http.DefaultClient
-  }
-
-  service := "{{ .Metadata.EndpointPrefix }}"
-  endpoint, service, region := endpoints.Lookup("{{ .Metadata.EndpointPrefix }}", region)
+// New returns a new {{ .Name }} client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *{{ .Name }} {
+  cfg := newConfig(region, client, opts)
 
   return &{{ .Name }}{
     client: &aws.EC2Client{
       Context: aws.Context{
         Credentials: creds,
-        Service: service,
-        Region: region,
+        Service: cfg.SigningName,
+        Region: cfg.SigningRegion,
+        UserAgent: cfg.UserAgent,
+        Clock: cfg.Clock,
+        Logger: cfg.Logger,
+        LogLevel: cfg.LogLevel,
+        Compression: cfg.Compression,{{ template "signer" $ }}
       },
-      Client: client,
-      Endpoint: endpoint,
+      Client: cfg.HTTPClient,
+      Endpoint: cfg.Endpoint,
+      Retry: cfg.Retry,
+      Exceptions: exceptions,
       APIVersion: "{{ .Metadata.APIVersion }}",
     },
   }
 }
 
+{{ template "config" $ }}
+
+{{ template "accessors" $ }}
+
+{{ template "presign-form" $ }}
+
 {{ range $name, $op := .Operations }}
 
 {{ godoc $name $op.Documentation }} func (c *{{ $.Name }}) {{ exportable $name }}({{ if $op.InputRef }}req {{ $op.InputRef.WrappedType }}{{ end }}) ({{ if $op.OutputRef }}resp {{ $op.OutputRef.WrappedType }},{{ end }} err error) {
+  return c.{{ exportable $name }}WithContext(context.Background(){{ if $op.InputRef }}, req{{ end }})
+}
+
+// {{ exportable $name }}WithContext is like {{ exportable $name }}, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *{{ $.Name }}) {{ exportable $name }}WithContext(ctx context.Context{{ if $op.InputRef }}, req {{ $op.InputRef.WrappedType }}{{ end }}) ({{ if $op.OutputRef }}resp {{ $op.OutputRef.WrappedType }},{{ end }} err error) {
   {{ if $op.Output }}resp = {{ $op.OutputRef.WrappedLiteral }}{{ else }}// NRE{{ end }}
-  err = c.client.Do("{{ $name }}", "{{ $op.HTTP.Method }}", "{{ $op.HTTP.RequestURI }}", {{ if $op.Input }} req {{ else }} nil {{ end }}, {{ if $op.Output }} resp {{ else }} nil {{ end }})
+  err = c.client.DoWithContext(ctx, "{{ $name }}", "{{ $op.HTTP.Method }}", "{{ $op.HTTP.RequestURI }}", {{ if $op.Input }} req {{ else }} nil {{ end }}, {{ if $op.Output }} resp {{ else }} nil {{ end }})
   return
 }
 
@@ -263,7 +425,19 @@ type {{ exportable $name }} struct {
 {{ exportable $name }} {{ $m.Type }} {{ $m.EC2Tag }}  {{ end }}
 }
 
+{{ if $s.Input }}{{ $s.EC2Marshaler }}{{ end }}
+
 {{ end }}
+{{ else if eq $s.ShapeType "map" }}
+
+// {{ exportable $name }} is undocumented.
+type {{ exportable $name }} {{ $s.MapType }}
+
+// UnmarshalXML decodes the map's entries from XML.
+func (m *{{ exportable $name }}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+  return aws.UnmarshalXMLMap(d, start, m, "{{ $s.KeyName }}", "{{ $s.ValueName }}")
+}
+
 {{ else if $s.Enum }}
 // Possible values for {{ $.Name }}.
 const (
@@ -283,7 +457,14 @@ type {{ exportable $wname }} struct {
 
 {{ end }}
 
+{{ template "exceptions" $ }}
+
 {{ template "footer" }}
+var _ = base64.StdEncoding
+var _ xml.Name
+var _ url.Values
+var _ = sort.Strings
+var _ = strconv.Itoa
 {{ end }}
 
 `)
@@ -291,107 +472,94 @@ type {{ exportable $wname }} struct {
 
 func restCommon(t *template.Template) (*template.Template, error) {
 	return t.Parse(`
+{{ define "rest-accessors" }}
+// SetPayloadSigning selects how the client signs request bodies.
+func (c *{{ .Name }}) SetPayloadSigning(p aws.PayloadSigning) {
+  c.client.Context.PayloadSigning = p
+}
+{{ end }}
+
+{{ define "rest-presign" }}
+// Presign returns a URL for the named operation, signed with query parameters
+// which expire after the given duration. The request must be the operation's
+// input, as passed to its method, or nil if it has none.
+func (c *{{ .Name }}) Presign(op string, req interface{}, expires time.Duration) (string, error) {
+  var httpReq *http.Request
+  var err error
+
+  switch op {
+  {{ range $name, $op := .Operations }}
+  case "{{ $name }}":
+    {{ if $op.Input }}
+    r, ok := req.({{ $op.Input.Type }})
+    if !ok {
+      return "", fmt.Errorf("{{ $.PackageName }}: {{ $name }} takes a %T, not a %T", r, req)
+    }
+    httpReq, err = c.new{{ exportable $name }}Request(context.Background(), r)
+    {{ else }}
+    httpReq, err = c.new{{ exportable $name }}Request(context.Background())
+    {{ end }}
+  {{ end }}
+  default:
+    return "", fmt.Errorf("{{ $.PackageName }}: unknown operation %q", op)
+  }
+
+  if err != nil {
+    return "", err
+  }
+  return c.client.Presign(httpReq, expires)
+}
+
+{{ if eq .Metadata.EndpointPrefix "s3" }}
+{{ range $name, $op := .Operations }}
+// Presign{{ exportable $name }} returns a URL for {{ exportable $name }}, signed with query
+// parameters which expire after the given duration.
+func (c *{{ $.Name }}) Presign{{ exportable $name }}({{ if $op.Input }}req {{ $op.Input.Type }}, {{ end }}expires time.Duration) (string, error) {
+  httpReq, err := c.new{{ exportable $name }}Request(context.Background(){{ if $op.Input }}, req{{ end }})
+  if err != nil {
+    return "", err
+  }
+  return c.client.Presign(httpReq, expires)
+}
+{{ end }}
+{{ end }}
+{{ end }}
+
 {{ define "rest-uri" }}
   {{ if .Input }}
   {{ range $name, $m := .Input.Members }}
   {{ if eq $m.Location "uri" }}
-
-  if req.{{ exportable $name }} != nil {
-    uri = strings.Replace(uri, "{"+"{{ $m.LocationName }}"+"}", *req.{{ exportable $name }}, -1)
-    uri = strings.Replace(uri, "{"+"{{ $m.LocationName }}+"+"}", *req.{{ exportable $name }}, -1)
-  }
-
+  {{ $m.URIParam }}
   {{ end }}
   {{ end }}
   {{ end }}
-
 {{ end }}
 
-
 {{ define "rest-querystring" }}
   q := url.Values{}
 
   {{ if .Input }}
   {{ range $name, $m := .Input.Members }}
   {{ if eq $m.Location "querystring" }}
-
-
-  {{ if eq $m.Shape.ShapeType "string" }}
-
-  if req.{{ exportable $name }} != nil {
-    q.Set("{{ $m.LocationName }}", *req.{{ exportable $name }})
-  }
-
-  {{ else if eq $m.Shape.ShapeType "timestamp" }}
-
-  if req.{{ exportable $name }} != (time.Time{}) {
-    q.Set("{{ $m.LocationName }}", req.{{ exportable $name }}.Format(time.RFC822))
-  }
-
-  {{ else if eq $m.Shape.ShapeType "integer" }}
-
-  if req.{{ exportable $name }} != nil {
-    q.Set("{{ $m.LocationName }}", strconv.Itoa(*req.{{ exportable $name }}))
-  }
-
-  {{ else }}
-
-  if req.{{ exportable $name }} != nil {
-    q.Set("{{ $m.LocationName }}", fmt.Sprintf("%v", req.{{ exportable $name }}))
-  }
-
-  {{ end }}
-
+  {{ $m.QueryParam }}
   {{ end }}
   {{ end }}
   {{ end }}
 
   if len(q) > 0 {
-    uri += "?" + q.Encode()
+    if strings.Contains(uri, "?") {
+      uri += "&" + q.Encode()
+    } else {
+      uri += "?" + q.Encode()
+    }
   }
 {{ end }}
 
 {{ define "rest-reqheaders" }}
   {{ if .Input }}
   {{ range $name, $m := .Input.Members }}
-  {{ if eq $m.Location "header" }}
-
- {{ if eq $m.Shape.ShapeType "string" }}
-
-  if req.{{ exportable $name }} != nil {
-    httpReq.Header.Set("{{ $m.LocationName }}", *req.{{ exportable $name }})
-  }
-
-  {{ else if eq $m.Shape.ShapeType "timestamp" }}
-
-  if req.{{ exportable $name }} != (time.Time{}) {
-    httpReq.Header.Set("{{ $m.LocationName }}", req.{{ exportable $name }}.Format(time.RFC822))
-  }
-
-  {{ else if eq $m.Shape.ShapeType "integer" }}
-
-  {{ if eq $m.LocationName "Content-Length" }}
-
-  if req.{{ exportable $name }} != nil {
-    httpReq.ContentLength = *req.{{ exportable $name }}
-  }
-
-  {{ else }}
-
-  if req.{{ exportable $name }} != nil {
-    httpReq.Header.Set("{{ $m.LocationName }}", strconv.Itoa(*req.{{ exportable $name }}))
-  }
-
-  {{ end }}
-
-  {{ else }}
-
-  if req.{{ exportable $name }} != nil {
-    httpReq.Header.Set("{{ $m.LocationName }}", fmt.Sprintf("%v", req.{{ exportable $name }}))
-  }
-
-  {{ end }}
-
+  {{ if or (eq $m.Location "header") (eq $m.Location "headers") }}
+  {{ $m.RequestHeader }}
   {{ end }}
   {{ end }}
   {{ end }}
@@ -400,47 +568,8 @@ func restCommon(t *template.Template) (*template.Template, error) {
 {{ define "rest-respheaders" }}
  {{ range $name, $m := .Output.Members }}
     {{ if ne $name "Body" }}
-      {{ if eq $m.Location "header" }}
-        if s := httpResp.Header.Get("{{ $m.LocationName }}"); s != "" {
-         {{ if eq $m.Shape.ShapeType "string" }}
-          resp.{{ exportable $name }} = &s
-         {{ else if eq $m.Shape.ShapeType "timestamp" }}
-           var t time.Time
-           t, err = time.Parse(time.RFC1123, s)
-           if err != nil {
-             return
-           }
-           resp.{{ exportable $name }} = t
-         {{ else if eq $m.Shape.ShapeType "integer" }}
-           {{ if eq $m.Shape.Name "ContentLength" }}
-           var n int64
-           n, err = strconv.ParseInt(s, 10, 64)
-           {{ else }}
-           var n int
-           n, err = strconv.Atoi(s)
-           {{ end }}
-           if  err != nil {
-             return
-           }
-           resp.{{ exportable $name }} = &n
-         {{ else if eq $m.Shape.ShapeType "boolean" }}
-           var v bool
-           v, err = strconv.ParseBool(s)
-           if  err != nil {
-             return
-            }
-           resp.{{ exportable $name }} = &v
-         {{ else }}
-         // TODO: add support for {{ $m.Shape.ShapeType }} headers
-         {{ end }}
-        }
-      {{ else if eq $m.Location "headers" }}
-      resp.{{ exportable $name }} = {{ $m.Shape.Type }}{}
-      for name := range httpResp.Header {
-        if strings.HasPrefix(name, "{{ $m.Location  }}") {
-          resp.{{ exportable $name }}[name] = httpResp.Header.Get(name)
-        }
-      }
+      {{ if or (eq $m.Location "header") (eq $m.Location "headers") }}
+      {{ $m.ResponseHeader }}
       {{ else if eq $m.Location "statusCode" }}
         resp.{{ exportable $name }} = aws.Integer(httpResp.StatusCode)
       {{ else if ne $m.Location "" }}
@@ -460,6 +589,7 @@ func restXMLClient(t *template.Template) (*template.Template, error) {
 
 import (
   "bytes"
+  "encoding/base64"
   "encoding/xml"
   "fmt"
   "io"
@@ -474,34 +604,96 @@ type {{ .Name }} struct {
   client *aws.RestClient
 }
 
-// New returns a new {{ .Name }} client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{ .Name }} {
-  if client == nil {
-     client = http.DefaultClient
-  }
-
-  service := "{{ .Metadata.EndpointPrefix }}"
-  endpoint, service, region := endpoints.Lookup("{{ .Metadata.EndpointPrefix }}", region)
+// New returns a new {{ .Name }} client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *{{ .Name }} {
+  cfg := newConfig(region, client, opts)
 
   return &{{ .Name }}{
     client: &aws.RestClient{
       Context: aws.Context{
         Credentials: creds,
-        Service: service,
-        Region: region,
+        Service: cfg.SigningName,
+        Region: cfg.SigningRegion,
+        UserAgent: cfg.UserAgent,
+        Clock: cfg.Clock,
+        Logger: cfg.Logger,
+        LogLevel: cfg.LogLevel,
+        Compression: cfg.Compression,{{ template "signer" $ }}
       },
-      Client: client,
-      Endpoint: endpoint,
-      APIVersion: "{{ .Metadata.APIVersion }}",
+      Client: cfg.HTTPClient,
+      Endpoint: cfg.Endpoint,
+      Retry: cfg.Retry,
+      Exceptions: exceptions,
+      APIVersion: "{{ .Metadata.APIVersion }}",{{ if eq .Metadata.ChecksumFormat "md5" }}
+      ContentMD5: true,{{ end }}
     },
   }
 }
 
+{{ template "config" $ }}
+
+{{ template "accessors" $ }}
+
+{{ template "rest-accessors" $ }}
+
+{{ template "rest-presign" $ }}
+
 {{ range $name, $op := .Operations }}
 
 {{ godoc $name $op.Documentation }} func (c *{{ $.Name }}) {{ exportable $name }}({{ if $op.Input }}req {{ $op.Input.Type }}{{ end }}) ({{ if $op.Output }}resp {{ $op.Output.Type }},{{ end }} err error) {
+  return c.{{ exportable $name }}WithContext(context.Background(){{ if $op.Input }}, req{{ end }})
+}
+
+// {{ exportable $name }}WithContext is like {{ exportable $name }}, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *{{ $.Name }}) {{ exportable $name }}WithContext(ctx context.Context{{ if $op.Input }}, req {{ $op.Input.Type }}{{ end }}) ({{ if $op.Output }}resp {{ $op.Output.Type }},{{ end }} err error) {
   {{ if $op.Output }}resp = {{ $op.Output.Literal }}{{ else }}// NRE{{ end }}
 
+  httpReq, err := c.new{{ exportable $name }}Request(ctx{{ if $op.Input }}, req{{ end }})
+  if err != nil {
+    return
+  }
+
+  httpResp, err := c.client.DoOperation("{{ $name }}", httpReq, {{ if $op.Input }}req{{ else }}nil{{ end }}, {{ if $op.Output }}resp{{ else }}nil{{ end }})
+  if err != nil {
+    return
+  }
+
+  {{ if $op.Output }}
+    {{ with $name := "Body" }}
+    {{ with $m := index $op.Output.Members $name }}
+    {{ if $m }}
+
+      {{ if $m.Streaming }}
+  resp.Body = httpResp.Body
+      {{ else }}
+  defer httpResp.Body.Close()
+  if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
+    err = e
+    return
+  }
+      {{ end }}
+
+
+    {{ else }}
+  defer httpResp.Body.Close()
+    {{ end }}
+    {{ end }}
+
+  {{ template "rest-respheaders" $op }}
+  {{ end }}
+  {{ else }}
+  defer httpResp.Body.Close()
+  {{ end }}
+
+
+  return
+}
+
+// new{{ exportable $name }}Request builds the HTTP request for {{ exportable $name }}.
+func (c *{{ $.Name }}) new{{ exportable $name }}Request(ctx context.Context{{ if $op.Input }}, req {{ $op.Input.Type }}{{ end }}) (httpReq *http.Request, err error) {
   var body io.Reader
   var contentType string
   {{ if $op.Input }}
@@ -546,7 +738,7 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{
 
   {{ template "rest-querystring" $op }}
 
-  httpReq, err := http.NewRequest("{{ $op.HTTP.Method }}", uri, body)
+  httpReq, err = http.NewRequestWithContext(ctx, "{{ $op.HTTP.Method }}", uri, body)
   if err != nil {
     return
   }
@@ -556,39 +748,10 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{
   }
 
   {{ template "rest-reqheaders" $op }}
-
-  httpResp, err := c.client.Do(httpReq)
-  if err != nil {
-    return
-  }
-
-  {{ if $op.Output }}
-    {{ with $name := "Body" }}
-    {{ with $m := index $op.Output.Members $name }}
-    {{ if $m }}
-
-      {{ if $m.Streaming }}
-  resp.Body = httpResp.Body
-      {{ else }}
-  defer httpResp.Body.Close()
-  if e := xml.NewDecoder(httpResp.Body).Decode(resp); e != nil && e != io.EOF {
-    err = e
-    return
-  }
-      {{ end }}
-
-
-    {{ else }}
-  defer httpResp.Body.Close()
-    {{ end }}
-    {{ end }}
-
-  {{ template "rest-respheaders" $op }}
-  {{ end }}
-  {{ else }}
-  defer httpResp.Body.Close()
-  {{ end }}
-
+  {{ if $op.Output }}{{ with $m := index $op.Output.Members "Body" }}{{ if $m.Streaming }}
+  // the body is streamed as it's stored, so it isn't compressed for transit
+  httpReq.Header.Set("Accept-Encoding", "identity")
+  {{ end }}{{ end }}{{ end }}
 
   return
 }
@@ -620,6 +783,8 @@ const (
 {{ end }}
 {{ end }}
 
+{{ template "exceptions" $ }}
+
 {{ template "footer" }}
 var _ bytes.Reader
 var _ url.URL
@@ -627,6 +792,7 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
 {{ end }}
 
 `)
@@ -639,6 +805,7 @@ func restJSONClient(t *template.Template) (*template.Template, error) {
 
 import (
   "bytes"
+  "encoding/base64"
   "encoding/json"
   "fmt"
   "io"
@@ -653,71 +820,59 @@ type {{ .Name }} struct {
   client *aws.RestClient
 }
 
-// New returns a new {{ .Name }} client.
-func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{ .Name }} {
-  if client == nil {
-     client = http.DefaultClient
-  }
-
-  service := "{{ .Metadata.EndpointPrefix }}"
-  endpoint, service, region := endpoints.Lookup("{{ .Metadata.EndpointPrefix }}", region)
+// New returns a new {{ .Name }} client. The options override its default
+// configuration.
+func New(creds aws.CredentialsProvider, region string, client *http.Client, opts ...aws.Option) *{{ .Name }} {
+  cfg := newConfig(region, client, opts)
 
   return &{{ .Name }}{
     client: &aws.RestClient{
       Context: aws.Context{
         Credentials: creds,
-        Service: service,
-        Region: region,
+        Service: cfg.SigningName,
+        Region: cfg.SigningRegion,
+        UserAgent: cfg.UserAgent,
+        Clock: cfg.Clock,
+        Logger: cfg.Logger,
+        LogLevel: cfg.LogLevel,
+        Compression: cfg.Compression,{{ template "signer" $ }}
       },
-      Client: client,
-      Endpoint: endpoint,
-      APIVersion: "{{ .Metadata.APIVersion }}",
+      Client: cfg.HTTPClient,
+      Endpoint: cfg.Endpoint,
+      Retry: cfg.Retry,
+      Exceptions: exceptions,
+      APIVersion: "{{ .Metadata.APIVersion }}",{{ if eq .Metadata.ChecksumFormat "md5" }}
+      ContentMD5: true,{{ end }}
     },
   }
 }
 
-{{ range $name, $op := .Operations }}
+{{ template "config" $ }}
 
-{{ godoc $name $op.Documentation }} func (c *{{ $.Name }}) {{ exportable $name }}({{ if $op.Input }}req {{ $op.Input.Type }}{{ end }}) ({{ if $op.Output }}resp {{ $op.Output.Type }},{{ end }} err error) {
-  {{ if $op.Output }}resp = {{ $op.Output.Literal }}{{ else }}// NRE{{ end }}
+{{ template "accessors" $ }}
 
-  var body io.Reader
-  var contentType string
-  {{ if $op.Input }}
-  {{ if $op.Input.Payload }}
-  {{ with $m := index $op.Input.Members $op.Input.Payload }}
-  {{ if $m.Streaming }}
-  body = req.{{ exportable $m.Name  }}
-  {{ else }}
-  contentType = "application/json"
-  b, err := json.Marshal(req.{{ exportable $m.Name  }})
-  if err != nil {
-    return
-  }
-  body = bytes.NewReader(b)
-  {{ end }}
-  {{ end }}
-  {{ end }}
-  {{ end }}
+{{ template "rest-accessors" $ }}
 
+{{ template "rest-presign" $ }}
 
-  uri := c.client.Endpoint + "{{ $op.HTTP.RequestURI }}"
-  {{ template "rest-uri" $op }}
+{{ range $name, $op := .Operations }}
 
-  {{ template "rest-querystring" $op }}
+{{ godoc $name $op.Documentation }} func (c *{{ $.Name }}) {{ exportable $name }}({{ if $op.Input }}req {{ $op.Input.Type }}{{ end }}) ({{ if $op.Output }}resp {{ $op.Output.Type }},{{ end }} err error) {
+  return c.{{ exportable $name }}WithContext(context.Background(){{ if $op.Input }}, req{{ end }})
+}
 
-  httpReq, err := http.NewRequest("{{ $op.HTTP.Method }}", uri, body)
+// {{ exportable $name }}WithContext is like {{ exportable $name }}, but takes a context.
+// The request, including any retries, is abandoned when the context is done.
+// A context from aws.WithResponseMetadata records the response's metadata.
+func (c *{{ $.Name }}) {{ exportable $name }}WithContext(ctx context.Context{{ if $op.Input }}, req {{ $op.Input.Type }}{{ end }}) ({{ if $op.Output }}resp {{ $op.Output.Type }},{{ end }} err error) {
+  {{ if $op.Output }}resp = {{ $op.Output.Literal }}{{ else }}// NRE{{ end }}
+
+  httpReq, err := c.new{{ exportable $name }}Request(ctx{{ if $op.Input }}, req{{ end }})
   if err != nil {
     return
   }
 
-  if contentType != "" {
-    httpReq.Header.Set("Content-Type", contentType)
-  }
-
-  {{ template "rest-reqheaders" $op }}
-
-  httpResp, err := c.client.Do(httpReq)
+  httpResp, err := c.client.DoOperation("{{ $name }}", httpReq, {{ if $op.Input }}req{{ else }}nil{{ end }}, {{ if $op.Output }}resp{{ else }}nil{{ end }})
   if err != nil {
     return
   }
@@ -755,6 +910,51 @@ func New(creds aws.CredentialsProvider, region string, client *http.Client) *{{
   return
 }
 
+// new{{ exportable $name }}Request builds the HTTP request for {{ exportable $name }}.
+func (c *{{ $.Name }}) new{{ exportable $name }}Request(ctx context.Context{{ if $op.Input }}, req {{ $op.Input.Type }}{{ end }}) (httpReq *http.Request, err error) {
+  var body io.Reader
+  var contentType string
+  {{ if $op.Input }}
+  {{ if $op.Input.Payload }}
+  {{ with $m := index $op.Input.Members $op.Input.Payload }}
+  {{ if $m.Streaming }}
+  body = req.{{ exportable $m.Name  }}
+  {{ else }}
+  contentType = "application/json"
+  b, err := json.Marshal(req.{{ exportable $m.Name  }})
+  if err != nil {
+    return
+  }
+  body = bytes.NewReader(b)
+  {{ end }}
+  {{ end }}
+  {{ end }}
+  {{ end }}
+
+
+  uri := c.client.Endpoint + "{{ $op.HTTP.RequestURI }}"
+  {{ template "rest-uri" $op }}
+
+  {{ template "rest-querystring" $op }}
+
+  httpReq, err = http.NewRequestWithContext(ctx, "{{ $op.HTTP.Method }}", uri, body)
+  if err != nil {
+    return
+  }
+
+  if contentType != "" {
+    httpReq.Header.Set("Content-Type", contentType)
+  }
+
+  {{ template "rest-reqheaders" $op }}
+  {{ if $op.Output }}{{ with $m := index $op.Output.Members "Body" }}{{ if $m.Streaming }}
+  // the body is streamed as it's stored, so it isn't compressed for transit
+  httpReq.Header.Set("Accept-Encoding", "identity")
+  {{ end }}{{ end }}{{ end }}
+
+  return
+}
+
 {{ end }}
 
 {{ range $name, $s := .Shapes }}
@@ -777,6 +977,8 @@ const (
 {{ end }}
 {{ end }}
 
+{{ template "exceptions" $ }}
+
 {{ template "footer" }}
 var _ bytes.Reader
 var _ url.URL
@@ -784,6 +986,7 @@ var _ fmt.Stringer
 var _ strings.Reader
 var _ strconv.NumError
 var _ = ioutil.Discard
+var _ = base64.StdEncoding
 var _ json.RawMessage
 {{ end }}
 `)